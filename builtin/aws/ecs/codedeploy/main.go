@@ -0,0 +1,12 @@
+package codedeploy
+
+import (
+	"github.com/hashicorp/waypoint-plugin-sdk"
+)
+
+//go:generate protoc -I ../../../../.. --go_opt=plugins=grpc --go_out=../../../../.. waypoint/builtin/aws/ecs/codedeploy/plugin.proto
+
+// Options are the SDK options to use for instantiation.
+var Options = []sdk.Option{
+	sdk.WithComponents(&Releaser{}),
+}
@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.15.8
+// source: waypoint/builtin/aws/ecs/codedeploy/plugin.proto
+
+package codedeploy
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Release struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The URL of the production listener of the deployment group
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The ID of the CodeDeploy deployment that released the task definition
+	DeploymentId string `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	// The task definition that was released
+	TaskArn string `protobuf:"bytes,3,opt,name=task_arn,json=taskArn,proto3" json:"task_arn,omitempty"`
+}
+
+func (x *Release) Reset() {
+	*x = Release{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Release) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Release) ProtoMessage() {}
+
+func (x *Release) ProtoReflect() protoreflect.Message {
+	mi := &file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Release.ProtoReflect.Descriptor instead.
+func (*Release) Descriptor() ([]byte, []int) {
+	return file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Release) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Release) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+func (x *Release) GetTaskArn() string {
+	if x != nil {
+		return x.TaskArn
+	}
+	return ""
+}
+
+var File_waypoint_builtin_aws_ecs_codedeploy_plugin_proto protoreflect.FileDescriptor
+
+var file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_rawDesc = []byte{
+	0x0a, 0x30, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x74,
+	0x69, 0x6e, 0x2f, 0x61, 0x77, 0x73, 0x2f, 0x65, 0x63, 0x73, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x64,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x12, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x63, 0x73, 0x2e, 0x63, 0x6f, 0x64, 0x65,
+	0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x22, 0x5b, 0x0a, 0x07, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b,
+	0x5f, 0x61, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x73, 0x6b,
+	0x41, 0x72, 0x6e, 0x42, 0x25, 0x5a, 0x23, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2f,
+	0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x2f, 0x61, 0x77, 0x73, 0x2f, 0x65, 0x63, 0x73, 0x2f,
+	0x63, 0x6f, 0x64, 0x65, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_rawDescOnce sync.Once
+	file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_rawDescData = file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_rawDesc
+)
+
+func file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_rawDescGZIP() []byte {
+	file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_rawDescOnce.Do(func() {
+		file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_rawDescData = protoimpl.X.CompressGZIP(file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_rawDescData)
+	})
+	return file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_rawDescData
+}
+
+var file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_goTypes = []interface{}{
+	(*Release)(nil), // 0: aws.ecs.codedeploy.Release
+}
+var file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_init() }
+func file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_init() {
+	if File_waypoint_builtin_aws_ecs_codedeploy_plugin_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Release); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_goTypes,
+		DependencyIndexes: file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_depIdxs,
+		MessageInfos:      file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_msgTypes,
+	}.Build()
+	File_waypoint_builtin_aws_ecs_codedeploy_plugin_proto = out.File
+	file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_rawDesc = nil
+	file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_goTypes = nil
+	file_waypoint_builtin_aws_ecs_codedeploy_plugin_proto_depIdxs = nil
+}
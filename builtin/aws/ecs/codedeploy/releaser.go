@@ -0,0 +1,642 @@
+package codedeploy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	"github.com/hashicorp/waypoint-plugin-sdk/docs"
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/builtin/aws/ecs"
+	"github.com/hashicorp/waypoint/builtin/aws/utils"
+)
+
+const (
+	// pollInterval is how often the progress of the CodeDeploy deployment
+	// is checked.
+	pollInterval = 10 * time.Second
+
+	// defaultContainerPort matches the default service port of the ECS
+	// platform.
+	defaultContainerPort = 3000
+)
+
+// hookNames are the lifecycle events of an ECS deployment that can run a
+// Lambda function.
+var hookNames = []string{
+	"BeforeInstall",
+	"AfterInstall",
+	"AfterAllowTestTraffic",
+	"BeforeAllowTraffic",
+	"AfterAllowTraffic",
+}
+
+// Releaser is the ReleaseManager implementation for ECS blue/green
+// deployments with CodeDeploy.
+type Releaser struct {
+	config ReleaserConfig
+}
+
+// Config implements Configurable
+func (r *Releaser) Config() (interface{}, error) {
+	return &r.config, nil
+}
+
+// ConfigSet is called after a configuration has been decoded
+// we can use this to validate the config
+func (r *Releaser) ConfigSet(config interface{}) error {
+	c, ok := config.(*ReleaserConfig)
+	if !ok {
+		// this should never happen
+		return fmt.Errorf("Invalid configuration, expected *codedeploy.ReleaserConfig, got %T", config)
+	}
+
+	if c.BakeTime != "" {
+		if _, err := time.ParseDuration(c.BakeTime); err != nil {
+			return fmt.Errorf("bake_time is not a valid duration: %s", err)
+		}
+	}
+
+	for name := range c.Hooks {
+		var found bool
+		for _, h := range hookNames {
+			if h == name {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("unknown hook %q, must be one of: %s",
+				name, strings.Join(hookNames, ", "))
+		}
+	}
+
+	return nil
+}
+
+// ReleaseFunc implements component.ReleaseManager
+func (r *Releaser) ReleaseFunc() interface{} {
+	return r.Release
+}
+
+// Release creates a CodeDeploy deployment that replaces the task set of the
+// service with the task definition of the deployment, and follows it until
+// the traffic is shifted and the bake time is over.
+func (r *Releaser) Release(
+	ctx context.Context,
+	log hclog.Logger,
+	src *component.Source,
+	ui terminal.UI,
+	target *ecs.Deployment,
+) (*Release, error) {
+	if target.TaskArn == "" {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"deployment has no task definition to release")
+	}
+
+	sess, err := utils.GetSession(&utils.SessionConfig{
+		Region: r.config.Region,
+		Logger: log,
+	})
+	if err != nil {
+		return nil, err
+	}
+	cd := codedeploy.New(sess)
+
+	sg := ui.StepGroup()
+	defer sg.Wait()
+
+	step := sg.Add("Creating CodeDeploy deployment in %s/%s...",
+		r.config.Application, r.config.DeploymentGroup)
+	defer func() { step.Abort() }()
+
+	container := r.config.ContainerName
+	if container == "" {
+		container = src.App
+	}
+	port := r.config.ContainerPort
+	if port == 0 {
+		port = defaultContainerPort
+	}
+
+	spec, err := appSpec(target.TaskArn, container, port, r.config.Hooks)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &codedeploy.CreateDeploymentInput{
+		ApplicationName:     aws.String(r.config.Application),
+		DeploymentGroupName: aws.String(r.config.DeploymentGroup),
+		Description:         aws.String("Waypoint release of " + target.TaskArn),
+		Revision: &codedeploy.RevisionLocation{
+			RevisionType: aws.String(codedeploy.RevisionLocationTypeAppSpecContent),
+			AppSpecContent: &codedeploy.AppSpecContent{
+				Content: aws.String(spec),
+			},
+		},
+	}
+	if r.config.DeploymentConfig != "" {
+		input.DeploymentConfigName = aws.String(r.config.DeploymentConfig)
+	}
+	if !r.config.DisableRollback {
+		input.AutoRollbackConfiguration = &codedeploy.AutoRollbackConfiguration{
+			Enabled: aws.Bool(true),
+			Events: aws.StringSlice([]string{
+				codedeploy.AutoRollbackEventDeploymentFailure,
+				codedeploy.AutoRollbackEventDeploymentStopOnAlarm,
+				codedeploy.AutoRollbackEventDeploymentStopOnRequest,
+			}),
+		}
+	}
+
+	log.Debug("creating codedeploy deployment", "task-arn", target.TaskArn)
+	out, err := cd.CreateDeploymentWithContext(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	id := aws.StringValue(out.DeploymentId)
+
+	step.Update("Created CodeDeploy deployment %s", id)
+	step.Done()
+
+	step = sg.Add("Waiting for CodeDeploy deployment %s...", id)
+	if err := r.wait(ctx, log, cd, id, step); err != nil {
+		return nil, err
+	}
+	step.Done()
+
+	url, err := r.url(ctx, sess, cd)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Release{
+		Url:          url,
+		DeploymentId: id,
+		TaskArn:      target.TaskArn,
+	}, nil
+}
+
+// wait follows the deployment until the traffic is shifted to the new task
+// set and the bake time is over. If the release is canceled, the deployment
+// is stopped so that CodeDeploy rolls it back.
+func (r *Releaser) wait(
+	ctx context.Context,
+	log hclog.Logger,
+	cd *codedeploy.CodeDeploy,
+	id string,
+	step terminal.Step,
+) error {
+	var bake time.Duration
+	if r.config.BakeTime != "" {
+		// Validated in ConfigSet
+		bake, _ = time.ParseDuration(r.config.BakeTime)
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var bakeStart time.Time
+	for {
+		out, err := cd.GetDeploymentWithContext(ctx, &codedeploy.GetDeploymentInput{
+			DeploymentId: aws.String(id),
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return r.stop(log, cd, id, step, ctx.Err())
+			}
+
+			return err
+		}
+		info := out.DeploymentInfo
+		state := aws.StringValue(info.Status)
+
+		sets, err := taskSets(ctx, cd, id)
+		if err != nil {
+			if ctx.Err() != nil {
+				return r.stop(log, cd, id, step, ctx.Err())
+			}
+
+			return err
+		}
+
+		log.Trace("codedeploy deployment progress", "status", state)
+		step.Update("CodeDeploy deployment %s: %s", id, progressMessage(state, sets))
+
+		switch state {
+		case codedeploy.DeploymentStatusSucceeded:
+			return nil
+
+		case codedeploy.DeploymentStatusFailed, codedeploy.DeploymentStatusStopped:
+			msg := state
+			if info.ErrorInformation != nil {
+				msg = aws.StringValue(info.ErrorInformation.Message)
+			}
+			if info.RollbackInfo != nil {
+				msg += ". " + aws.StringValue(info.RollbackInfo.RollbackMessage)
+			}
+
+			step.Status(terminal.StatusError)
+			return status.Errorf(codes.Aborted,
+				"CodeDeploy deployment %s failed: %s", id, msg)
+
+		case codedeploy.DeploymentStatusBaking:
+			// All the traffic is on the new task set. CodeDeploy keeps the
+			// old task set until the termination wait time of the
+			// deployment group is over, we only wait for the bake time.
+			if bakeStart.IsZero() {
+				bakeStart = time.Now()
+			}
+			if time.Since(bakeStart) >= bake {
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return r.stop(log, cd, id, step, ctx.Err())
+
+		case <-ticker.C:
+		}
+	}
+}
+
+// stop stops the deployment of a canceled release so that CodeDeploy rolls
+// it back, and returns the cancellation error.
+func (r *Releaser) stop(
+	log hclog.Logger,
+	cd *codedeploy.CodeDeploy,
+	id string,
+	step terminal.Step,
+	cause error,
+) error {
+	log.Info("release canceled, stopping codedeploy deployment", "id", id)
+	step.Update("Stopping CodeDeploy deployment %s...", id)
+
+	// The context is canceled, so stop the deployment without it.
+	_, err := cd.StopDeployment(&codedeploy.StopDeploymentInput{
+		DeploymentId:        aws.String(id),
+		AutoRollbackEnabled: aws.Bool(!r.config.DisableRollback),
+	})
+	if err != nil {
+		log.Error("error stopping codedeploy deployment", "error", err)
+	}
+
+	return cause
+}
+
+// url returns the URL of the production listener of the deployment group.
+// This is empty if the deployment group has no load balancer.
+func (r *Releaser) url(
+	ctx context.Context,
+	sess *session.Session,
+	cd *codedeploy.CodeDeploy,
+) (string, error) {
+	out, err := cd.GetDeploymentGroupWithContext(ctx, &codedeploy.GetDeploymentGroupInput{
+		ApplicationName:     aws.String(r.config.Application),
+		DeploymentGroupName: aws.String(r.config.DeploymentGroup),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	lbInfo := out.DeploymentGroupInfo.LoadBalancerInfo
+	if lbInfo == nil {
+		return "", nil
+	}
+
+	var listeners []*string
+	for _, pair := range lbInfo.TargetGroupPairInfoList {
+		if pair.ProdTrafficRoute != nil {
+			listeners = append(listeners, pair.ProdTrafficRoute.ListenerArns...)
+		}
+	}
+	if len(listeners) == 0 {
+		return "", nil
+	}
+
+	elbsrv := elbv2.New(sess)
+	lo, err := elbsrv.DescribeListenersWithContext(ctx, &elbv2.DescribeListenersInput{
+		ListenerArns: listeners[:1],
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(lo.Listeners) == 0 {
+		return "", nil
+	}
+	listener := lo.Listeners[0]
+
+	dlb, err := elbsrv.DescribeLoadBalancersWithContext(ctx, &elbv2.DescribeLoadBalancersInput{
+		LoadBalancerArns: []*string{listener.LoadBalancerArn},
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(dlb.LoadBalancers) == 0 {
+		return "", nil
+	}
+
+	return listenerURL(
+		aws.StringValue(listener.Protocol),
+		aws.StringValue(dlb.LoadBalancers[0].DNSName),
+		aws.Int64Value(listener.Port),
+	), nil
+}
+
+// taskSets returns the task sets of the ECS target of the deployment. These
+// are empty until CodeDeploy creates the replacement task set.
+func taskSets(
+	ctx context.Context,
+	cd *codedeploy.CodeDeploy,
+	id string,
+) ([]*codedeploy.ECSTaskSet, error) {
+	targets, err := cd.ListDeploymentTargetsWithContext(ctx, &codedeploy.ListDeploymentTargetsInput{
+		DeploymentId: aws.String(id),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(targets.TargetIds) == 0 {
+		return nil, nil
+	}
+
+	out, err := cd.GetDeploymentTargetWithContext(ctx, &codedeploy.GetDeploymentTargetInput{
+		DeploymentId: aws.String(id),
+		TargetId:     targets.TargetIds[0],
+	})
+	if err != nil {
+		return nil, err
+	}
+	if out.DeploymentTarget == nil || out.DeploymentTarget.EcsTarget == nil {
+		return nil, nil
+	}
+
+	return out.DeploymentTarget.EcsTarget.TaskSetsInfo, nil
+}
+
+// appSpec returns the AppSpec of an ECS deployment that replaces the task
+// set of the service with the task definition.
+func appSpec(taskArn, container string, port int64, hooks map[string]string) (string, error) {
+	type loadBalancerInfo struct {
+		ContainerName string
+		ContainerPort int64
+	}
+	type properties struct {
+		TaskDefinition   string
+		LoadBalancerInfo loadBalancerInfo
+	}
+	type targetService struct {
+		Type       string
+		Properties properties
+	}
+	type resource struct {
+		TargetService targetService
+	}
+
+	spec := struct {
+		Version   string
+		Resources []resource
+		Hooks     []map[string]string `json:",omitempty"`
+	}{
+		Version: "0.0",
+		Resources: []resource{
+			{
+				TargetService: targetService{
+					Type: "AWS::ECS::Service",
+					Properties: properties{
+						TaskDefinition: taskArn,
+						LoadBalancerInfo: loadBalancerInfo{
+							ContainerName: container,
+							ContainerPort: port,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// Hooks run in the order of the lifecycle, so keep that order rather
+	// than the order of the map.
+	for _, name := range hookNames {
+		if fn, ok := hooks[name]; ok {
+			spec.Hooks = append(spec.Hooks, map[string]string{name: fn})
+		}
+	}
+
+	bs, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bs), nil
+}
+
+// progressMessage describes the status of the deployment and the traffic
+// of its task sets.
+func progressMessage(state string, sets []*codedeploy.ECSTaskSet) string {
+	var parts []string
+	for _, set := range sets {
+		parts = append(parts, fmt.Sprintf("%s %.0f%% (%d/%d running)",
+			strings.ToLower(aws.StringValue(set.TaskSetLabel)),
+			aws.Float64Value(set.TrafficWeight),
+			aws.Int64Value(set.RunningCount),
+			aws.Int64Value(set.DesiredCount),
+		))
+	}
+
+	if len(parts) == 0 {
+		return state
+	}
+
+	return state + ", " + strings.Join(parts, ", ")
+}
+
+// listenerURL returns the URL of a listener of a load balancer.
+func listenerURL(protocol, host string, port int64) string {
+	scheme := strings.ToLower(protocol)
+	switch {
+	case scheme == "http" && port == 80, scheme == "https" && port == 443:
+		return fmt.Sprintf("%s://%s", scheme, host)
+	default:
+		return fmt.Sprintf("%s://%s:%d", scheme, host, port)
+	}
+}
+
+// ReleaserConfig is the configuration structure for the Releaser.
+type ReleaserConfig struct {
+	// AWS Region of the CodeDeploy application
+	Region string `hcl:"region,optional"`
+
+	// Name of the CodeDeploy application
+	Application string `hcl:"application"`
+
+	// Name of the CodeDeploy deployment group of the ECS service
+	DeploymentGroup string `hcl:"deployment_group"`
+
+	// Name of the CodeDeploy deployment configuration to shift traffic with
+	DeploymentConfig string `hcl:"deployment_config,optional"`
+
+	// Name of the container the load balancer sends traffic to
+	ContainerName string `hcl:"container_name,optional"`
+
+	// Port of the container the load balancer sends traffic to
+	ContainerPort int64 `hcl:"container_port,optional"`
+
+	// How long to watch the deployment after the traffic is shifted
+	BakeTime string `hcl:"bake_time,optional"`
+
+	// Don't roll back failed or stopped deployments
+	DisableRollback bool `hcl:"disable_rollback,optional"`
+
+	// Lambda functions to run at the lifecycle events of the deployment
+	Hooks map[string]string `hcl:"hooks,optional"`
+}
+
+func (r *Releaser) Documentation() (*docs.Documentation, error) {
+	doc, err := docs.New(docs.FromConfig(&ReleaserConfig{}))
+	if err != nil {
+		return nil, err
+	}
+
+	doc.Description(`
+Release ECS deployments with CodeDeploy blue/green deployments.
+
+This releaser creates a CodeDeploy deployment that replaces the task set of an
+existing ECS service with the task definition of the deployment. CodeDeploy
+starts the new task set, shifts the traffic of the load balancer to its target
+group, and keeps the old task set until the termination wait time of the
+deployment group is over. The progress of the deployment, including how the
+traffic is split between the task sets, is shown while the release runs.
+
+### Requirements
+
+The ECS service, its two target groups and the load balancer listeners, and the
+CodeDeploy application and deployment group must already exist. The service
+must use the CODE_DEPLOY deployment controller. Set "codedeploy" in the
+aws-ecs platform so that deployments only register the task definition.
+
+### Bake Time
+
+The release finishes when all the traffic is shifted to the new task set and
+the "bake_time" is over. If the deployment group stops the deployment during
+that time, for example because of a CloudWatch alarm, the release fails.
+
+### Rollback
+
+Failed deployments, deployments stopped by an alarm, and releases that are
+canceled are rolled back by CodeDeploy, which shifts the traffic back to the
+old task set. Set "disable_rollback" to keep the new task set instead.
+`)
+
+	doc.Example(`
+deploy {
+  use "aws-ecs" {
+    region     = "us-east-1"
+    memory     = 512
+    codedeploy = true
+  }
+}
+
+release {
+  use "aws-ecs-codedeploy" {
+    region            = "us-east-1"
+    application       = "myapp"
+    deployment_group  = "myapp-production"
+    deployment_config = "CodeDeployDefault.ECSCanary10Percent5Minutes"
+    bake_time         = "10m"
+
+    hooks = {
+      AfterAllowTestTraffic = "arn:aws:lambda:us-east-1:123456789012:function:smoke-test"
+    }
+  }
+}
+`)
+
+	doc.Input("ecs.Deployment")
+	doc.Output("codedeploy.Release")
+
+	doc.SetField(
+		"region",
+		"the AWS region of the CodeDeploy application",
+		docs.Summary(
+			"if this isn't set, the region of the AWS configuration is used",
+		),
+	)
+
+	doc.SetField(
+		"application",
+		"the name of the CodeDeploy application",
+	)
+
+	doc.SetField(
+		"deployment_group",
+		"the name of the CodeDeploy deployment group of the ECS service",
+	)
+
+	doc.SetField(
+		"deployment_config",
+		"the name of the CodeDeploy deployment configuration",
+		docs.Summary(
+			"this controls how the traffic is shifted, such as",
+			"CodeDeployDefault.ECSLinear10PercentEvery1Minutes. If this isn't set,",
+			"the deployment configuration of the deployment group is used",
+		),
+	)
+
+	doc.SetField(
+		"container_name",
+		"the name of the container the load balancer sends traffic to",
+		docs.Default("the name of the app"),
+	)
+
+	doc.SetField(
+		"container_port",
+		"the port of the container the load balancer sends traffic to",
+		docs.Default("3000"),
+	)
+
+	doc.SetField(
+		"bake_time",
+		"how long to watch the deployment after all the traffic is shifted",
+		docs.Summary(
+			"a duration such as 5m. The release fails if the deployment is",
+			"stopped during this time",
+		),
+		docs.Default("0s"),
+	)
+
+	doc.SetField(
+		"disable_rollback",
+		"don't roll back failed, stopped or canceled deployments",
+	)
+
+	doc.SetField(
+		"hooks",
+		"Lambda functions to run at the lifecycle events of the deployment",
+		docs.Summary(
+			"the keys are the lifecycle events, which are BeforeInstall, AfterInstall,",
+			"AfterAllowTestTraffic, BeforeAllowTraffic and AfterAllowTraffic. The",
+			"values are the names or ARNs of the functions",
+		),
+	)
+
+	return doc, nil
+}
+
+var (
+	_ component.ReleaseManager = (*Releaser)(nil)
+	_ component.Configurable   = (*Releaser)(nil)
+)
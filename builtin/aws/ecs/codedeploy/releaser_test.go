@@ -0,0 +1,87 @@
+package codedeploy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppSpec(t *testing.T) {
+	require := require.New(t)
+
+	spec, err := appSpec("arn:task", "web", 3000, map[string]string{
+		"AfterAllowTraffic":     "post",
+		"AfterAllowTestTraffic": "smoke",
+	})
+	require.NoError(err)
+
+	var result struct {
+		Resources []struct {
+			TargetService struct {
+				Type       string
+				Properties struct {
+					TaskDefinition   string
+					LoadBalancerInfo struct {
+						ContainerName string
+						ContainerPort int64
+					}
+				}
+			}
+		}
+		Hooks []map[string]string
+	}
+	require.NoError(json.Unmarshal([]byte(spec), &result))
+
+	require.Len(result.Resources, 1)
+	svc := result.Resources[0].TargetService
+	require.Equal("AWS::ECS::Service", svc.Type)
+	require.Equal("arn:task", svc.Properties.TaskDefinition)
+	require.Equal("web", svc.Properties.LoadBalancerInfo.ContainerName)
+	require.Equal(int64(3000), svc.Properties.LoadBalancerInfo.ContainerPort)
+
+	// Hooks are in lifecycle order
+	require.Equal([]map[string]string{
+		{"AfterAllowTestTraffic": "smoke"},
+		{"AfterAllowTraffic": "post"},
+	}, result.Hooks)
+}
+
+func TestAppSpec_noHooks(t *testing.T) {
+	require := require.New(t)
+
+	spec, err := appSpec("arn:task", "web", 3000, nil)
+	require.NoError(err)
+	require.NotContains(spec, "Hooks")
+}
+
+func TestProgressMessage(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal("Created", progressMessage("Created", nil))
+	require.Equal("InProgress, blue 90% (2/2 running), green 10% (1/2 running)",
+		progressMessage("InProgress", []*codedeploy.ECSTaskSet{
+			{
+				TaskSetLabel:  aws.String("Blue"),
+				TrafficWeight: aws.Float64(90),
+				RunningCount:  aws.Int64(2),
+				DesiredCount:  aws.Int64(2),
+			},
+			{
+				TaskSetLabel:  aws.String("Green"),
+				TrafficWeight: aws.Float64(10),
+				RunningCount:  aws.Int64(1),
+				DesiredCount:  aws.Int64(2),
+			},
+		}))
+}
+
+func TestListenerURL(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal("http://lb.example.com", listenerURL("HTTP", "lb.example.com", 80))
+	require.Equal("https://lb.example.com", listenerURL("HTTPS", "lb.example.com", 443))
+	require.Equal("http://lb.example.com:8080", listenerURL("HTTP", "lb.example.com", 8080))
+}
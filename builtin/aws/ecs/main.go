@@ -8,5 +8,5 @@ import (
 
 // Options are the SDK options to use for instantiation.
 var Options = []sdk.Option{
-	sdk.WithComponents(&Platform{}),
+	sdk.WithComponents(&Platform{}, &TaskLauncher{}),
 }
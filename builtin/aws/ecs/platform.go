@@ -1141,6 +1141,18 @@ func (p *Platform) Launch(
 
 	s.Update("Registered Task definition: %s", family)
 
+	taskArn := *taskOut.TaskDefinition.TaskDefinitionArn
+
+	// With CodeDeploy, the service already exists and the releaser updates
+	// it to the task definition, so there is nothing else to create.
+	if p.config.CodeDeploy {
+		L.Debug("codedeploy enabled, not creating a service", "task-arn", taskArn)
+		return &Deployment{
+			Cluster: clusterName,
+			TaskArn: taskArn,
+		}, nil
+	}
+
 	serviceName := fmt.Sprintf("%s-%s", app.App, id)
 
 	// We have to clamp at a length of 32 because the Name field to CreateTargetGroup
@@ -1150,8 +1162,6 @@ func (p *Platform) Launch(
 		L.Debug("using a shortened value for service name due to AWS's length limits", "serviceName", serviceName)
 	}
 
-	taskArn := *taskOut.TaskDefinition.TaskDefinitionArn
-
 	var subnets []*string
 	if len(p.config.Subnets) == 0 {
 		s.Update("Using default subnets for Service networking")
@@ -1407,6 +1417,13 @@ func (p *Platform) Destroy(
 		return err
 	}
 
+	// Deployments released with CodeDeploy only have a task definition,
+	// the service belongs to CodeDeploy.
+	if deployment.ServiceArn == "" {
+		log.Debug("deployment has no service, nothing to destroy")
+		return nil
+	}
+
 	if deployment.TargetGroupArn != "" && deployment.LoadBalancerArn != "" {
 		err = destroyALB(ctx, log, sess, deployment)
 		if err != nil {
@@ -1566,6 +1583,10 @@ type Config struct {
 	// If set to true, do not create a load balancer assigned to the service
 	DisableALB bool `hcl:"disable_alb,optional"`
 
+	// If set to true, only register the task definition and leave the
+	// service to be updated by the aws-ecs-codedeploy releaser.
+	CodeDeploy bool `hcl:"codedeploy,optional"`
+
 	// Configuration options for how the ALB will be configured.
 	ALB *ALBConfig `hcl:"alb,block"`
 
@@ -1672,6 +1693,16 @@ deploy {
 		"do not create a load balancer assigned to the service",
 	)
 
+	doc.SetField(
+		"codedeploy",
+		"only register the task definition and release it with CodeDeploy",
+		docs.Summary(
+			"this doesn't create a service or load balancer for the deployment.",
+			"Use the aws-ecs-codedeploy releaser to update an existing service",
+			"that uses the CodeDeploy deployment controller.",
+		),
+	)
+
 	doc.SetField(
 		"static_environment",
 		"static environment variables to make available",
@@ -0,0 +1,114 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	"github.com/hashicorp/waypoint/builtin/aws/utils"
+)
+
+// LogsFunc implements component.LogPlatform
+func (p *Platform) LogsFunc() interface{} {
+	return p.Logs
+}
+
+// Logs reads the logs of the tasks of the deployment from CloudWatch. The
+// log group and stream prefix are read from the task definition since the
+// default prefix is generated for each deployment.
+func (p *Platform) Logs(
+	ctx context.Context,
+	log hclog.Logger,
+	lv *component.LogViewer,
+	app *component.Source,
+	dep *Deployment,
+) error {
+	defer log.Debug("finished with cloudwatchlogs")
+
+	sess, err := utils.GetSession(&utils.SessionConfig{
+		Region: p.config.Region,
+		Logger: log,
+	})
+	if err != nil {
+		return err
+	}
+
+	taskDef, err := ecs.New(sess).DescribeTaskDefinitionWithContext(ctx, &ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: aws.String(dep.TaskArn),
+	})
+	if err != nil {
+		return err
+	}
+
+	var group, prefix string
+	for _, c := range taskDef.TaskDefinition.ContainerDefinitions {
+		lc := c.LogConfiguration
+		if aws.StringValue(c.Name) != app.App || lc == nil ||
+			aws.StringValue(lc.LogDriver) != "awslogs" {
+			continue
+		}
+
+		group = aws.StringValue(lc.Options["awslogs-group"])
+		prefix = fmt.Sprintf("%s/%s/",
+			aws.StringValue(lc.Options["awslogs-stream-prefix"]), app.App)
+	}
+	if group == "" {
+		return fmt.Errorf("the task definition doesn't send the logs of %q to CloudWatch", app.App)
+	}
+
+	logs := cloudwatchlogs.New(sess)
+	input := &cloudwatchlogs.FilterLogEventsInput{
+		LogGroupName:        aws.String(group),
+		LogStreamNamePrefix: aws.String(prefix),
+	}
+	if !lv.StartingAt.IsZero() {
+		input.StartTime = aws.Int64(aws.TimeUnixMilli(lv.StartingAt))
+	}
+
+	limit := int64(lv.Limit)
+	for {
+		if limit > 0 {
+			input.Limit = aws.Int64(limit)
+		}
+
+		output, err := logs.FilterLogEventsWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		log.Debug("chunk of cloudwatch logs", "size", len(output.Events))
+
+		for _, ev := range output.Events {
+			cle := component.LogEvent{
+				Partition: aws.StringValue(ev.LogStreamName),
+				Timestamp: aws.MillisecondsTimeValue(ev.Timestamp),
+				Message:   strings.TrimRight(aws.StringValue(ev.Message), "\n\t"),
+			}
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case lv.Output <- cle:
+				// ok
+			}
+		}
+
+		if limit > 0 {
+			limit -= int64(len(output.Events))
+			if limit <= 0 {
+				return nil
+			}
+		}
+
+		if output.NextToken == nil {
+			return nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
@@ -162,6 +162,62 @@ func (x *Release) GetLoadBalancerArn() string {
 	return ""
 }
 
+// TaskInfo is the state of a task launched as an ECS task.
+type TaskInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Arn     string `protobuf:"bytes,1,opt,name=arn,proto3" json:"arn,omitempty"`
+	Cluster string `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster,omitempty"`
+}
+
+func (x *TaskInfo) Reset() {
+	*x = TaskInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_waypoint_builtin_aws_ecs_plugin_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskInfo) ProtoMessage() {}
+
+func (x *TaskInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_waypoint_builtin_aws_ecs_plugin_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskInfo.ProtoReflect.Descriptor instead.
+func (*TaskInfo) Descriptor() ([]byte, []int) {
+	return file_waypoint_builtin_aws_ecs_plugin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TaskInfo) GetArn() string {
+	if x != nil {
+		return x.Arn
+	}
+	return ""
+}
+
+func (x *TaskInfo) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
 var File_waypoint_builtin_aws_ecs_plugin_proto protoreflect.FileDescriptor
 
 var file_waypoint_builtin_aws_ecs_plugin_proto_rawDesc = []byte{
@@ -185,9 +241,12 @@ var file_waypoint_builtin_aws_ecs_plugin_proto_rawDesc = []byte{
 	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62,
 	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x5f, 0x61, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0f, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x41,
-	0x72, 0x6e, 0x42, 0x1a, 0x5a, 0x18, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2f, 0x62,
-	0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x2f, 0x61, 0x77, 0x73, 0x2f, 0x65, 0x63, 0x73, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x6e, 0x22, 0x36, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10,
+	0x0a, 0x03, 0x61, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x72, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x42, 0x1a, 0x5a, 0x18, 0x77, 0x61,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x2f, 0x61,
+	0x77, 0x73, 0x2f, 0x65, 0x63, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -202,10 +261,11 @@ func file_waypoint_builtin_aws_ecs_plugin_proto_rawDescGZIP() []byte {
 	return file_waypoint_builtin_aws_ecs_plugin_proto_rawDescData
 }
 
-var file_waypoint_builtin_aws_ecs_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_waypoint_builtin_aws_ecs_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_waypoint_builtin_aws_ecs_plugin_proto_goTypes = []interface{}{
 	(*Deployment)(nil), // 0: ecs.Deployment
 	(*Release)(nil),    // 1: ecs.Release
+	(*TaskInfo)(nil),   // 2: ecs.TaskInfo
 }
 var file_waypoint_builtin_aws_ecs_plugin_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -245,6 +305,18 @@ func file_waypoint_builtin_aws_ecs_plugin_proto_init() {
 				return nil
 			}
 		}
+		file_waypoint_builtin_aws_ecs_plugin_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -252,7 +324,7 @@ func file_waypoint_builtin_aws_ecs_plugin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_waypoint_builtin_aws_ecs_plugin_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
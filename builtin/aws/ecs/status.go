@@ -0,0 +1,195 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+
+	"github.com/hashicorp/waypoint/builtin/aws/utils"
+
+	sdk "github.com/hashicorp/waypoint-plugin-sdk/proto/gen"
+)
+
+// StatusFunc implements component.Status
+func (p *Platform) StatusFunc() interface{} {
+	return p.Status
+}
+
+// Status reports the health of the tasks of the deployment's service as
+// ECS sees them.
+func (p *Platform) Status(
+	ctx context.Context,
+	log hclog.Logger,
+	deployment *Deployment,
+	ui terminal.UI,
+) (*sdk.StatusReport, error) {
+	sg := ui.StepGroup()
+	defer sg.Wait()
+
+	step := sg.Add("Gathering health report for ECS service...")
+	defer func() {
+		step.Abort()
+	}()
+
+	sess, err := utils.GetSession(&utils.SessionConfig{
+		Region: p.config.Region,
+		Logger: log,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := serviceTasks(ctx, ecs.New(sess), deployment.Cluster, deployment.ServiceArn)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("building status report", "tasks", len(tasks))
+	result := buildStatusReport(tasks)
+	result.GeneratedTime = ptypes.TimestampNow()
+
+	step.Update(result.HealthMessage)
+	switch result.Health {
+	case sdk.StatusReport_READY:
+	case sdk.StatusReport_ALIVE, sdk.StatusReport_PARTIAL:
+		step.Status(terminal.StatusWarn)
+	default:
+		step.Status(terminal.StatusError)
+	}
+	step.Done()
+
+	return result, nil
+}
+
+// serviceTasks returns the tasks of the service with the given ARN.
+func serviceTasks(
+	ctx context.Context,
+	ecsSvc ecsiface.ECSAPI,
+	cluster, serviceArn string,
+) ([]*ecs.Task, error) {
+	// ListTasks takes the name of the service rather than its ARN. The
+	// name is the last part of the ARN in both the old and new formats.
+	service := serviceArn[strings.LastIndex(serviceArn, "/")+1:]
+
+	var taskArns []*string
+	err := ecsSvc.ListTasksPagesWithContext(ctx, &ecs.ListTasksInput{
+		Cluster:     aws.String(cluster),
+		ServiceName: aws.String(service),
+	}, func(out *ecs.ListTasksOutput, lastPage bool) bool {
+		taskArns = append(taskArns, out.TaskArns...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// DescribeTasks takes at most 100 tasks at a time.
+	var tasks []*ecs.Task
+	for len(taskArns) > 0 {
+		n := len(taskArns)
+		if n > 100 {
+			n = 100
+		}
+
+		out, err := ecsSvc.DescribeTasksWithContext(ctx, &ecs.DescribeTasksInput{
+			Cluster: aws.String(cluster),
+			Tasks:   taskArns[:n],
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		tasks = append(tasks, out.Tasks...)
+		taskArns = taskArns[n:]
+	}
+
+	return tasks, nil
+}
+
+// buildStatusReport builds a status report from the tasks of a service.
+// Each task is a resource of the report.
+func buildStatusReport(tasks []*ecs.Task) *sdk.StatusReport {
+	result := &sdk.StatusReport{External: true}
+
+	var ready, alive, down int
+	for _, task := range tasks {
+		resource := taskHealth(task)
+		result.Resources = append(result.Resources, resource)
+
+		switch resource.Health {
+		case sdk.StatusReport_READY:
+			ready++
+		case sdk.StatusReport_ALIVE:
+			alive++
+		case sdk.StatusReport_DOWN:
+			down++
+		}
+	}
+
+	switch {
+	case len(tasks) == 0:
+		result.Health = sdk.StatusReport_DOWN
+		result.HealthMessage = "no tasks are running"
+	case ready == len(tasks):
+		result.Health = sdk.StatusReport_READY
+		result.HealthMessage = "all tasks are reporting ready"
+	case down == len(tasks):
+		result.Health = sdk.StatusReport_DOWN
+		result.HealthMessage = "all tasks are reporting down"
+	case alive == len(tasks):
+		result.Health = sdk.StatusReport_ALIVE
+		result.HealthMessage = "all tasks are starting"
+	default:
+		result.Health = sdk.StatusReport_PARTIAL
+		result.HealthMessage = fmt.Sprintf(
+			"%d of %d tasks are reporting ready", ready, len(tasks))
+	}
+
+	return result
+}
+
+// taskHealth translates the status of a task into a Waypoint health
+// status. The resource is named after the ID of the task.
+func taskHealth(task *ecs.Task) *sdk.StatusReport_Resource {
+	arn := aws.StringValue(task.TaskArn)
+	resource := &sdk.StatusReport_Resource{
+		Name: arn[strings.LastIndex(arn, "/")+1:],
+	}
+
+	switch aws.StringValue(task.LastStatus) {
+	case "RUNNING":
+		// The health status is only set if the task has containers with
+		// health checks.
+		if aws.StringValue(task.HealthStatus) == ecs.HealthStatusUnhealthy {
+			resource.Health = sdk.StatusReport_DOWN
+			resource.HealthMessage = "task is reporting unhealthy"
+		} else {
+			resource.Health = sdk.StatusReport_READY
+			resource.HealthMessage = "task is running"
+		}
+	case "PROVISIONING", "PENDING", "ACTIVATING":
+		resource.Health = sdk.StatusReport_ALIVE
+		resource.HealthMessage = "task is starting"
+	case "DEACTIVATING", "STOPPING", "DEPROVISIONING", "STOPPED":
+		resource.Health = sdk.StatusReport_DOWN
+		resource.HealthMessage = "task is stopping"
+		if reason := aws.StringValue(task.StoppedReason); reason != "" {
+			resource.HealthMessage = "task is stopping: " + reason
+		}
+	default:
+		resource.Health = sdk.StatusReport_UNKNOWN
+		resource.HealthMessage = "task status could not be determined"
+	}
+
+	return resource
+}
+
+var _ component.Status = (*Platform)(nil)
@@ -0,0 +1,230 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hashicorp/waypoint-plugin-sdk/proto/gen"
+)
+
+func TestBuildStatusReport(t *testing.T) {
+	task := func(id, status, health string) *ecs.Task {
+		return &ecs.Task{
+			TaskArn:      aws.String("arn:aws:ecs:us-east-1:123456789012:task/waypoint/" + id),
+			LastStatus:   aws.String(status),
+			HealthStatus: aws.String(health),
+		}
+	}
+
+	cases := []struct {
+		Name      string
+		Tasks     []*ecs.Task
+		Health    sdk.StatusReport_Health
+		Resources []string
+	}{
+		{
+			"no tasks",
+			nil,
+			sdk.StatusReport_DOWN,
+			nil,
+		},
+
+		{
+			"all running",
+			[]*ecs.Task{
+				task("a", "RUNNING", ecs.HealthStatusUnknown),
+				task("b", "RUNNING", ecs.HealthStatusHealthy),
+			},
+			sdk.StatusReport_READY,
+			[]string{"a", "b"},
+		},
+
+		{
+			"running but unhealthy",
+			[]*ecs.Task{
+				task("a", "RUNNING", ecs.HealthStatusHealthy),
+				task("b", "RUNNING", ecs.HealthStatusUnhealthy),
+			},
+			sdk.StatusReport_PARTIAL,
+			[]string{"a", "b"},
+		},
+
+		{
+			"starting",
+			[]*ecs.Task{
+				task("a", "PROVISIONING", ecs.HealthStatusUnknown),
+			},
+			sdk.StatusReport_ALIVE,
+			[]string{"a"},
+		},
+
+		{
+			"stopped",
+			[]*ecs.Task{
+				task("a", "STOPPED", ecs.HealthStatusUnknown),
+			},
+			sdk.StatusReport_DOWN,
+			[]string{"a"},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			result := buildStatusReport(tt.Tasks)
+			require.True(result.External)
+			require.Equal(tt.Health, result.Health)
+
+			var names []string
+			for _, r := range result.Resources {
+				names = append(names, r.Name)
+			}
+			require.Equal(tt.Resources, names)
+		})
+	}
+}
+
+func TestServiceTasks(t *testing.T) {
+	cases := []struct {
+		Name       string
+		ServiceArn string
+		Tasks      int
+
+		// The number of tasks in each DescribeTasks call
+		Batches []int
+	}{
+		{
+			"no tasks",
+			"arn:aws:ecs:us-east-1:123456789012:service/waypoint/app",
+			0,
+			nil,
+		},
+
+		{
+			"one batch",
+			"arn:aws:ecs:us-east-1:123456789012:service/waypoint/app",
+			3,
+			[]int{3},
+		},
+
+		{
+			"many batches",
+			"arn:aws:ecs:us-east-1:123456789012:service/waypoint/app",
+			250,
+			[]int{100, 100, 50},
+		},
+
+		{
+			"old ARN format",
+			"arn:aws:ecs:us-east-1:123456789012:service/app",
+			3,
+			[]int{3},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			api := &testECSAPI{}
+			for i := 0; i < tt.Tasks; i++ {
+				api.taskArns = append(api.taskArns,
+					fmt.Sprintf("arn:aws:ecs:us-east-1:123456789012:task/waypoint/%d", i))
+			}
+
+			tasks, err := serviceTasks(context.Background(), api, "waypoint", tt.ServiceArn)
+			require.NoError(err)
+			require.Equal("app", api.service)
+			require.Equal(tt.Batches, api.batches)
+
+			// Every task is described once and in order
+			require.Len(tasks, tt.Tasks)
+			for i, task := range tasks {
+				require.Equal(api.taskArns[i], aws.StringValue(task.TaskArn))
+			}
+		})
+	}
+}
+
+// testECSAPI is a fake ECS API with the tasks of one service. Tasks are
+// listed in pages of 10. Running and stopping tasks is faked in
+// task_test.go.
+type testECSAPI struct {
+	ecsiface.ECSAPI
+
+	taskArns []string
+
+	// The service name that tasks were listed for and the number of
+	// tasks in each DescribeTasks call.
+	service string
+	batches []int
+
+	// The clusters that exist with their status, and the clusters created.
+	clusters map[string]string
+	created  []string
+
+	// The result of RunTask.
+	runErr      error
+	runFailures []*ecs.Failure
+
+	// The last task that was run, the task definitions that were
+	// deregistered and the tasks that were stopped.
+	run          *ecs.RunTaskInput
+	deregistered []string
+	stopped      []*ecs.StopTaskInput
+	stopErr      error
+}
+
+func (a *testECSAPI) ListTasksPagesWithContext(
+	ctx aws.Context,
+	input *ecs.ListTasksInput,
+	fn func(*ecs.ListTasksOutput, bool) bool,
+	_ ...request.Option,
+) error {
+	a.service = aws.StringValue(input.ServiceName)
+
+	arns := a.taskArns
+	for {
+		n := len(arns)
+		if n > 10 {
+			n = 10
+		}
+
+		if !fn(&ecs.ListTasksOutput{TaskArns: aws.StringSlice(arns[:n])}, n == len(arns)) ||
+			n == len(arns) {
+			return nil
+		}
+
+		arns = arns[n:]
+	}
+}
+
+func (a *testECSAPI) DescribeTasksWithContext(
+	ctx aws.Context,
+	input *ecs.DescribeTasksInput,
+	_ ...request.Option,
+) (*ecs.DescribeTasksOutput, error) {
+	if len(input.Tasks) > 100 {
+		return nil, fmt.Errorf("too many tasks: %d", len(input.Tasks))
+	}
+
+	a.batches = append(a.batches, len(input.Tasks))
+
+	var out ecs.DescribeTasksOutput
+	for _, arn := range input.Tasks {
+		out.Tasks = append(out.Tasks, &ecs.Task{
+			TaskArn:    arn,
+			LastStatus: aws.String("RUNNING"),
+		})
+	}
+
+	return &out, nil
+}
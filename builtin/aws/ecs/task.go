@@ -0,0 +1,511 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	"github.com/hashicorp/waypoint-plugin-sdk/docs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/builtin/aws/utils"
+)
+
+const (
+	// taskFamily is the family of the task definitions of tasks.
+	taskFamily = "waypoint-task"
+
+	// taskContainerName is the name of the container that runs the task.
+	taskContainerName = "task"
+)
+
+// TaskLauncher launches tasks on ECS using Fargate.
+type TaskLauncher struct {
+	config TaskLauncherConfig
+}
+
+// StartTaskFunc implements component.TaskLauncher
+func (p *TaskLauncher) StartTaskFunc() interface{} {
+	return p.StartTask
+}
+
+// StopTaskFunc implements component.TaskLauncher
+func (p *TaskLauncher) StopTaskFunc() interface{} {
+	return p.StopTask
+}
+
+// TaskLauncherConfig is the configuration structure for the task plugin.
+type TaskLauncherConfig struct {
+	// AWS Region to run the tasks in.
+	Region string `hcl:"region"`
+
+	// Name of the ECS cluster to run the tasks in.
+	Cluster string `hcl:"cluster,optional"`
+
+	// ARN of the IAM role used to pull the image and send logs. Without
+	// it the task's logs aren't sent to CloudWatch Logs.
+	ExecutionRoleArn string `hcl:"execution_role_arn,optional"`
+
+	// ARN of the IAM role the task runs as.
+	TaskRoleArn string `hcl:"task_role_arn,optional"`
+
+	// Cloudwatch Logs group to store the task's logs in.
+	LogGroup string `hcl:"log_group,optional"`
+
+	// Subnets to place the tasks in.
+	Subnets []string `hcl:"subnets,optional"`
+
+	// Security Group IDs of existing security groups to attach to the
+	// tasks' network interfaces.
+	SecurityGroupIDs []string `hcl:"security_group_ids,optional"`
+
+	// How much memory to assign to the task, in MB.
+	Memory int `hcl:"memory,optional"`
+
+	// How many cpu shares the task is able to use.
+	CPU int `hcl:"cpu,optional"`
+
+	// Environment variables that are meant to configure the task in a
+	// static way.
+	StaticEnvVars map[string]string `hcl:"static_environment,optional"`
+}
+
+func (p *TaskLauncher) Documentation() (*docs.Documentation, error) {
+	doc, err := docs.New(
+		docs.FromConfig(&TaskLauncherConfig{}),
+		docs.FromFunc(p.StartTaskFunc()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	doc.Description(`
+Launch an ECS task on Fargate as a task.
+
+Each task registers a task definition for its image and runs it once.
+Stopping the task stops the ECS task. The cluster is created if it
+doesn't exist. IAM roles aren't created, so logs are only sent to
+CloudWatch Logs if an execution role is set.
+`)
+
+	doc.Example(`
+task {
+  use "aws-ecs" {
+    region             = "us-east-1"
+    execution_role_arn = "arn:aws:iam::123456789012:role/waypoint-task-execution"
+    task_role_arn      = "arn:aws:iam::123456789012:role/waypoint-runner"
+  }
+}
+`)
+
+	doc.SetField(
+		"region",
+		"AWS region to run the tasks in",
+	)
+
+	doc.SetField(
+		"cluster",
+		"the name of the ECS cluster to run the tasks in",
+		docs.Default("waypoint"),
+	)
+
+	doc.SetField(
+		"execution_role_arn",
+		"the ARN of the IAM role used to pull the image and send logs",
+		docs.Summary(
+			"the role must allow the ECS tasks service to assume it and",
+			"have the AmazonECSTaskExecutionRolePolicy policy or equivalent",
+			"permissions. Without it the task's logs aren't collected.",
+		),
+	)
+
+	doc.SetField(
+		"task_role_arn",
+		"the ARN of the IAM role the task runs as",
+		docs.Summary(
+			"on-demand runners use the permissions of this role to deploy",
+		),
+	)
+
+	doc.SetField(
+		"log_group",
+		"the CloudWatchLogs log group to store the task's logs in",
+		docs.Default("waypoint-logs"),
+	)
+
+	doc.SetField(
+		"subnets",
+		"the VPC subnets to place the tasks in",
+		docs.Default("public subnets in the default VPC"),
+	)
+
+	doc.SetField(
+		"security_group_ids",
+		"Security Group IDs of existing security groups to attach to the tasks",
+		docs.Default("the default security group of the VPC"),
+	)
+
+	doc.SetField(
+		"memory",
+		"how much memory to assign to the task, in MB",
+		docs.Default("512"),
+	)
+
+	doc.SetField(
+		"cpu",
+		"how many cpu shares the task is able to use",
+		docs.Default("256"),
+		docs.Summary(
+			"the combination of cpu and memory must be one that Fargate",
+			"supports",
+		),
+	)
+
+	doc.SetField(
+		"static_environment",
+		"environment variables to expose to the task",
+		docs.Summary(
+			"these variables are set in addition to the environment",
+			"variables of the task",
+		),
+	)
+
+	return doc, nil
+}
+
+// Config implements Configurable
+func (p *TaskLauncher) Config() (interface{}, error) {
+	return &p.config, nil
+}
+
+// ConfigSet is called after a configuration has been decoded
+// we can use this to validate the config
+func (p *TaskLauncher) ConfigSet(config interface{}) error {
+	c, ok := config.(*TaskLauncherConfig)
+	if !ok {
+		// this should never happen
+		return fmt.Errorf("Invalid configuration, expected *ecs.TaskLauncherConfig, got %T", config)
+	}
+
+	memory, cpu := c.resources()
+	for _, v := range fargateResources[memory] {
+		if v == cpu {
+			return nil
+		}
+	}
+
+	return status.Errorf(codes.InvalidArgument,
+		"Fargate doesn't support %d cpu shares with %d MB of memory", cpu, memory)
+}
+
+// resources returns the memory and cpu shares of the task.
+func (c *TaskLauncherConfig) resources() (memory, cpu int) {
+	memory, cpu = c.Memory, c.CPU
+	if memory == 0 {
+		memory = 512
+	}
+	if cpu == 0 {
+		cpu = 256
+	}
+
+	return memory, cpu
+}
+
+// StartTask runs an ECS task for the task.
+func (p *TaskLauncher) StartTask(
+	ctx context.Context,
+	log hclog.Logger,
+	tli *component.TaskLaunchInfo,
+) (*TaskInfo, error) {
+	sess, err := utils.GetSession(&utils.SessionConfig{
+		Region: p.config.Region,
+		Logger: log,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ecsSvc := ecs.New(sess)
+
+	cluster, err := p.setupCluster(ctx, log, ecsSvc)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to set up ECS cluster: %s", err)
+	}
+
+	var logGroup string
+	if p.config.ExecutionRoleArn != "" {
+		logGroup, err = p.setupLogs(ctx, log, sess)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to set up log group: %s", err)
+		}
+	}
+
+	subnets := aws.StringSlice(p.config.Subnets)
+	if len(subnets) == 0 {
+		subnets, err = defaultSubnets(ctx, sess)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to find default subnets: %s", err)
+		}
+	}
+
+	return p.runTask(ctx, log, ecsSvc, cluster, logGroup, subnets, tli)
+}
+
+// runTask registers the task definition of the task and runs it in the
+// cluster. The task definition is deregistered whether or not the task
+// runs.
+func (p *TaskLauncher) runTask(
+	ctx context.Context,
+	log hclog.Logger,
+	ecsSvc ecsiface.ECSAPI,
+	cluster, logGroup string,
+	subnets []*string,
+	tli *component.TaskLaunchInfo,
+) (*TaskInfo, error) {
+	def, err := ecsSvc.RegisterTaskDefinitionWithContext(ctx, p.taskDefinition(tli, logGroup))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to register task definition: %s", err)
+	}
+	defArn := aws.StringValue(def.TaskDefinition.TaskDefinitionArn)
+
+	// The task definition is only used for this task. Running tasks aren't
+	// affected by deregistering their definition, so deregister it right
+	// away rather than leaving a revision behind for every task.
+	defer func() {
+		_, err := ecsSvc.DeregisterTaskDefinitionWithContext(ctx, &ecs.DeregisterTaskDefinitionInput{
+			TaskDefinition: aws.String(defArn),
+		})
+		if err != nil {
+			log.Warn("error deregistering task definition", "arn", defArn, "error", err)
+		}
+	}()
+
+	log.Debug("running ECS task", "cluster", cluster, "task-definition", defArn)
+	out, err := ecsSvc.RunTaskWithContext(ctx, &ecs.RunTaskInput{
+		Cluster:        aws.String(cluster),
+		Count:          aws.Int64(1),
+		LaunchType:     aws.String(ecs.LaunchTypeFargate),
+		StartedBy:      aws.String("waypoint"),
+		TaskDefinition: aws.String(defArn),
+		NetworkConfiguration: &ecs.NetworkConfiguration{
+			AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+				Subnets:        subnets,
+				SecurityGroups: aws.StringSlice(p.config.SecurityGroupIDs),
+				AssignPublicIp: aws.String(ecs.AssignPublicIpEnabled),
+			},
+		},
+		Overrides: &ecs.TaskOverride{
+			ContainerOverrides: []*ecs.ContainerOverride{
+				{
+					Name:        aws.String(taskContainerName),
+					Environment: taskEnvironment(tli.EnvironmentVariables),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to run ECS task: %s", err)
+	}
+	if len(out.Tasks) == 0 {
+		var reasons []string
+		for _, f := range out.Failures {
+			reasons = append(reasons, aws.StringValue(f.Reason))
+		}
+
+		return nil, status.Errorf(codes.Internal,
+			"unable to run ECS task: %s", strings.Join(reasons, ", "))
+	}
+
+	taskArn := aws.StringValue(out.Tasks[0].TaskArn)
+	log.Info("launched ECS task", "cluster", cluster, "arn", taskArn)
+
+	return &TaskInfo{
+		Arn:     taskArn,
+		Cluster: cluster,
+	}, nil
+}
+
+// StopTask stops the ECS task of the task.
+func (p *TaskLauncher) StopTask(
+	ctx context.Context,
+	log hclog.Logger,
+	ti *TaskInfo,
+) error {
+	sess, err := utils.GetSession(&utils.SessionConfig{
+		Region: p.config.Region,
+		Logger: log,
+	})
+	if err != nil {
+		return err
+	}
+
+	return p.stopTask(ctx, log, ecs.New(sess), ti)
+}
+
+// stopTask stops the ECS task of the task.
+func (p *TaskLauncher) stopTask(
+	ctx context.Context,
+	log hclog.Logger,
+	ecsSvc ecsiface.ECSAPI,
+	ti *TaskInfo,
+) error {
+	// Stopping a task that already stopped succeeds, so there is no need
+	// to check the state of the task first.
+	_, err := ecsSvc.StopTaskWithContext(ctx, &ecs.StopTaskInput{
+		Cluster: aws.String(ti.Cluster),
+		Task:    aws.String(ti.Arn),
+		Reason:  aws.String("Stopped by Waypoint"),
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "unable to stop ECS task: %s", err)
+	}
+
+	log.Info("stopped ECS task", "cluster", ti.Cluster, "arn", ti.Arn)
+	return nil
+}
+
+// setupCluster returns the name of the cluster to run the task in,
+// creating the cluster if it doesn't exist.
+func (p *TaskLauncher) setupCluster(
+	ctx context.Context,
+	log hclog.Logger,
+	ecsSvc ecsiface.ECSAPI,
+) (string, error) {
+	cluster := p.config.Cluster
+	if cluster == "" {
+		cluster = "waypoint"
+	}
+
+	desc, err := ecsSvc.DescribeClustersWithContext(ctx, &ecs.DescribeClustersInput{
+		Clusters: []*string{aws.String(cluster)},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for _, c := range desc.Clusters {
+		if aws.StringValue(c.ClusterName) == cluster && strings.ToLower(aws.StringValue(c.Status)) == "active" {
+			return cluster, nil
+		}
+	}
+
+	log.Info("creating ECS cluster", "cluster", cluster)
+	_, err = ecsSvc.CreateClusterWithContext(ctx, &ecs.CreateClusterInput{
+		ClusterName: aws.String(cluster),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return cluster, nil
+}
+
+// setupLogs returns the log group to store the task's logs in, creating
+// the group if it doesn't exist.
+func (p *TaskLauncher) setupLogs(
+	ctx context.Context,
+	log hclog.Logger,
+	sess *session.Session,
+) (string, error) {
+	logGroup := p.config.LogGroup
+	if logGroup == "" {
+		logGroup = "waypoint-logs"
+	}
+
+	cwl := cloudwatchlogs.New(sess)
+	groups, err := cwl.DescribeLogGroupsWithContext(ctx, &cloudwatchlogs.DescribeLogGroupsInput{
+		Limit:              aws.Int64(1),
+		LogGroupNamePrefix: aws.String(logGroup),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(groups.LogGroups) == 0 {
+		log.Info("creating log group", "group", logGroup)
+		_, err = cwl.CreateLogGroupWithContext(ctx, &cloudwatchlogs.CreateLogGroupInput{
+			LogGroupName: aws.String(logGroup),
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return logGroup, nil
+}
+
+// taskDefinition returns the task definition of the task. The task's
+// environment variables aren't part of the definition since task
+// definitions are kept by ECS; they're set when the task is run instead.
+// If logGroup is empty, the task's logs aren't collected.
+func (p *TaskLauncher) taskDefinition(
+	tli *component.TaskLaunchInfo,
+	logGroup string,
+) *ecs.RegisterTaskDefinitionInput {
+	def := &ecs.ContainerDefinition{
+		Name:        aws.String(taskContainerName),
+		Image:       aws.String(tli.OciUrl),
+		Essential:   aws.Bool(true),
+		Environment: taskEnvironment(p.config.StaticEnvVars),
+	}
+	if len(tli.Arguments) > 0 {
+		def.Command = aws.StringSlice(tli.Arguments)
+	}
+	if logGroup != "" {
+		def.LogConfiguration = &ecs.LogConfiguration{
+			LogDriver: aws.String(ecs.LogDriverAwslogs),
+			Options:   buildLoggingOptions(nil, p.config.Region, logGroup, taskFamily),
+		}
+	}
+
+	memory, cpu := p.config.resources()
+	input := &ecs.RegisterTaskDefinitionInput{
+		ContainerDefinitions:    []*ecs.ContainerDefinition{def},
+		Cpu:                     aws.String(strconv.Itoa(cpu)),
+		Memory:                  aws.String(strconv.Itoa(memory)),
+		Family:                  aws.String(taskFamily),
+		NetworkMode:             aws.String(ecs.NetworkModeAwsvpc),
+		RequiresCompatibilities: []*string{aws.String(ecs.CompatibilityFargate)},
+	}
+	if p.config.ExecutionRoleArn != "" {
+		input.ExecutionRoleArn = aws.String(p.config.ExecutionRoleArn)
+	}
+	if p.config.TaskRoleArn != "" {
+		input.TaskRoleArn = aws.String(p.config.TaskRoleArn)
+	}
+
+	return input
+}
+
+// taskEnvironment returns vars as ECS environment variables, sorted by
+// name.
+func taskEnvironment(vars map[string]string) []*ecs.KeyValuePair {
+	var result []*ecs.KeyValuePair
+	for k, v := range vars {
+		result = append(result, &ecs.KeyValuePair{
+			Name:  aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return aws.StringValue(result[i].Name) < aws.StringValue(result[j].Name)
+	})
+
+	return result
+}
+
+var (
+	_ component.TaskLauncher = (*TaskLauncher)(nil)
+	_ component.Configurable = (*TaskLauncher)(nil)
+	_ component.Documented   = (*TaskLauncher)(nil)
+)
@@ -0,0 +1,321 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTaskLauncherConfig(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Config TaskLauncherConfig
+		Err    bool
+	}{
+		{
+			"defaults",
+			TaskLauncherConfig{Region: "us-east-1"},
+			false,
+		},
+
+		{
+			"supported combination",
+			TaskLauncherConfig{Region: "us-east-1", Memory: 4096, CPU: 2048},
+			false,
+		},
+
+		{
+			"too few cpu shares for memory",
+			TaskLauncherConfig{Region: "us-east-1", Memory: 8192, CPU: 256},
+			true,
+		},
+
+		{
+			"unsupported memory",
+			TaskLauncherConfig{Region: "us-east-1", Memory: 100},
+			true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			var p TaskLauncher
+			err := p.ConfigSet(&tt.Config)
+			if tt.Err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskLauncher_taskDefinition(t *testing.T) {
+	tli := &component.TaskLaunchInfo{
+		OciUrl:    "hashicorp/waypoint:latest",
+		Arguments: []string{"runner", "agent"},
+		EnvironmentVariables: map[string]string{
+			"TOKEN": "secret",
+		},
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		require := require.New(t)
+
+		p := &TaskLauncher{config: TaskLauncherConfig{Region: "us-east-1"}}
+		input := p.taskDefinition(tli, "")
+
+		require.Equal("512", aws.StringValue(input.Memory))
+		require.Equal("256", aws.StringValue(input.Cpu))
+		require.Equal(ecs.NetworkModeAwsvpc, aws.StringValue(input.NetworkMode))
+		require.Nil(input.ExecutionRoleArn)
+		require.Nil(input.TaskRoleArn)
+
+		require.Len(input.ContainerDefinitions, 1)
+		def := input.ContainerDefinitions[0]
+		require.Equal(taskContainerName, aws.StringValue(def.Name))
+		require.Equal(tli.OciUrl, aws.StringValue(def.Image))
+		require.Equal(tli.Arguments, aws.StringValueSlice(def.Command))
+		require.Nil(def.LogConfiguration)
+
+		// The task's variables are only set when the task is run.
+		require.Empty(def.Environment)
+	})
+
+	t.Run("roles, logs and static environment", func(t *testing.T) {
+		require := require.New(t)
+
+		p := &TaskLauncher{config: TaskLauncherConfig{
+			Region:           "us-east-1",
+			ExecutionRoleArn: "arn:execution",
+			TaskRoleArn:      "arn:task",
+			StaticEnvVars:    map[string]string{"B": "2", "A": "1"},
+		}}
+		input := p.taskDefinition(tli, "waypoint-logs")
+
+		require.Equal("arn:execution", aws.StringValue(input.ExecutionRoleArn))
+		require.Equal("arn:task", aws.StringValue(input.TaskRoleArn))
+
+		def := input.ContainerDefinitions[0]
+		require.Equal(ecs.LogDriverAwslogs, aws.StringValue(def.LogConfiguration.LogDriver))
+		require.Equal("waypoint-logs",
+			aws.StringValue(def.LogConfiguration.Options["awslogs-group"]))
+		require.Equal([]*ecs.KeyValuePair{
+			{Name: aws.String("A"), Value: aws.String("1")},
+			{Name: aws.String("B"), Value: aws.String("2")},
+		}, def.Environment)
+	})
+}
+
+func TestTaskLauncher_setupCluster(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Clusters map[string]string
+		Created  []string
+	}{
+		{
+			"active",
+			map[string]string{"waypoint": "ACTIVE"},
+			nil,
+		},
+
+		{
+			"missing",
+			nil,
+			[]string{"waypoint"},
+		},
+
+		{
+			"inactive",
+			map[string]string{"waypoint": "INACTIVE"},
+			[]string{"waypoint"},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			api := &testECSAPI{clusters: tt.Clusters}
+			p := &TaskLauncher{}
+			cluster, err := p.setupCluster(context.Background(), hclog.L(), api)
+			require.NoError(err)
+			require.Equal("waypoint", cluster)
+			require.Equal(tt.Created, api.created)
+		})
+	}
+}
+
+func TestTaskLauncher_runTask(t *testing.T) {
+	ctx := context.Background()
+	tli := &component.TaskLaunchInfo{
+		OciUrl: "hashicorp/waypoint:latest",
+		EnvironmentVariables: map[string]string{
+			"TOKEN": "secret",
+		},
+	}
+	subnets := aws.StringSlice([]string{"subnet-1"})
+
+	t.Run("runs the task", func(t *testing.T) {
+		require := require.New(t)
+
+		api := &testECSAPI{}
+		p := &TaskLauncher{config: TaskLauncherConfig{Region: "us-east-1"}}
+		ti, err := p.runTask(ctx, hclog.L(), api, "waypoint", "", subnets, tli)
+		require.NoError(err)
+		require.Equal("waypoint", ti.Cluster)
+		require.Equal("arn:task", ti.Arn)
+
+		require.NotNil(api.run)
+		require.Equal("arn:waypoint-task:1", aws.StringValue(api.run.TaskDefinition))
+		require.Equal(subnets, api.run.NetworkConfiguration.AwsvpcConfiguration.Subnets)
+		require.Equal([]*ecs.KeyValuePair{
+			{Name: aws.String("TOKEN"), Value: aws.String("secret")},
+		}, api.run.Overrides.ContainerOverrides[0].Environment)
+
+		// The task definition isn't kept
+		require.Equal([]string{"arn:waypoint-task:1"}, api.deregistered)
+	})
+
+	t.Run("task can't be run", func(t *testing.T) {
+		require := require.New(t)
+
+		api := &testECSAPI{runErr: fmt.Errorf("access denied")}
+		p := &TaskLauncher{config: TaskLauncherConfig{Region: "us-east-1"}}
+		_, err := p.runTask(ctx, hclog.L(), api, "waypoint", "", subnets, tli)
+		require.Error(err)
+		require.Equal(codes.Internal, status.Code(err))
+		require.Contains(err.Error(), "access denied")
+		require.Equal([]string{"arn:waypoint-task:1"}, api.deregistered)
+	})
+
+	t.Run("task fails to start", func(t *testing.T) {
+		require := require.New(t)
+
+		api := &testECSAPI{runFailures: []*ecs.Failure{
+			{Reason: aws.String("RESOURCE:MEMORY")},
+			{Reason: aws.String("AGENT")},
+		}}
+		p := &TaskLauncher{config: TaskLauncherConfig{Region: "us-east-1"}}
+		_, err := p.runTask(ctx, hclog.L(), api, "waypoint", "", subnets, tli)
+		require.Error(err)
+		require.Equal(codes.Internal, status.Code(err))
+		require.Contains(err.Error(), "RESOURCE:MEMORY, AGENT")
+		require.Equal([]string{"arn:waypoint-task:1"}, api.deregistered)
+	})
+}
+
+func TestTaskLauncher_stopTask(t *testing.T) {
+	ctx := context.Background()
+	ti := &TaskInfo{Arn: "arn:task", Cluster: "waypoint"}
+
+	t.Run("stops the task", func(t *testing.T) {
+		require := require.New(t)
+
+		api := &testECSAPI{}
+		p := &TaskLauncher{}
+		require.NoError(p.stopTask(ctx, hclog.L(), api, ti))
+
+		require.Len(api.stopped, 1)
+		require.Equal("waypoint", aws.StringValue(api.stopped[0].Cluster))
+		require.Equal("arn:task", aws.StringValue(api.stopped[0].Task))
+	})
+
+	t.Run("task can't be stopped", func(t *testing.T) {
+		require := require.New(t)
+
+		api := &testECSAPI{stopErr: fmt.Errorf("access denied")}
+		p := &TaskLauncher{}
+		err := p.stopTask(ctx, hclog.L(), api, ti)
+		require.Error(err)
+		require.Equal(codes.Internal, status.Code(err))
+	})
+}
+
+func (a *testECSAPI) DescribeClustersWithContext(
+	ctx aws.Context,
+	input *ecs.DescribeClustersInput,
+	_ ...request.Option,
+) (*ecs.DescribeClustersOutput, error) {
+	var out ecs.DescribeClustersOutput
+	for _, name := range input.Clusters {
+		if s, ok := a.clusters[aws.StringValue(name)]; ok {
+			out.Clusters = append(out.Clusters, &ecs.Cluster{
+				ClusterName: name,
+				Status:      aws.String(s),
+			})
+		}
+	}
+
+	return &out, nil
+}
+
+func (a *testECSAPI) CreateClusterWithContext(
+	ctx aws.Context,
+	input *ecs.CreateClusterInput,
+	_ ...request.Option,
+) (*ecs.CreateClusterOutput, error) {
+	a.created = append(a.created, aws.StringValue(input.ClusterName))
+	return &ecs.CreateClusterOutput{}, nil
+}
+
+func (a *testECSAPI) RegisterTaskDefinitionWithContext(
+	ctx aws.Context,
+	input *ecs.RegisterTaskDefinitionInput,
+	_ ...request.Option,
+) (*ecs.RegisterTaskDefinitionOutput, error) {
+	return &ecs.RegisterTaskDefinitionOutput{
+		TaskDefinition: &ecs.TaskDefinition{
+			TaskDefinitionArn: aws.String("arn:" + aws.StringValue(input.Family) + ":1"),
+		},
+	}, nil
+}
+
+func (a *testECSAPI) DeregisterTaskDefinitionWithContext(
+	ctx aws.Context,
+	input *ecs.DeregisterTaskDefinitionInput,
+	_ ...request.Option,
+) (*ecs.DeregisterTaskDefinitionOutput, error) {
+	a.deregistered = append(a.deregistered, aws.StringValue(input.TaskDefinition))
+	return &ecs.DeregisterTaskDefinitionOutput{}, nil
+}
+
+func (a *testECSAPI) RunTaskWithContext(
+	ctx aws.Context,
+	input *ecs.RunTaskInput,
+	_ ...request.Option,
+) (*ecs.RunTaskOutput, error) {
+	a.run = input
+	if a.runErr != nil {
+		return nil, a.runErr
+	}
+	if len(a.runFailures) > 0 {
+		return &ecs.RunTaskOutput{Failures: a.runFailures}, nil
+	}
+
+	return &ecs.RunTaskOutput{
+		Tasks: []*ecs.Task{{TaskArn: aws.String("arn:task")}},
+	}, nil
+}
+
+func (a *testECSAPI) StopTaskWithContext(
+	ctx aws.Context,
+	input *ecs.StopTaskInput,
+	_ ...request.Option,
+) (*ecs.StopTaskOutput, error) {
+	a.stopped = append(a.stopped, input)
+	if a.stopErr != nil {
+		return nil, a.stopErr
+	}
+
+	return &ecs.StopTaskOutput{}, nil
+}
@@ -0,0 +1,316 @@
+package lambda
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	"github.com/hashicorp/waypoint-plugin-sdk/docs"
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+
+	"github.com/hashicorp/waypoint/builtin/aws/utils"
+)
+
+// archiveModTime is the modification time of every file in an archive. We
+// use a fixed time so that the same files always result in the same
+// archive, which lets us skip uploading archives that didn't change.
+var archiveModTime = time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Builder packages a function into a zip archive and uploads it to S3,
+// where the platform deploys it from.
+type Builder struct {
+	config BuilderConfig
+}
+
+// BuildFunc implements component.Builder
+func (b *Builder) BuildFunc() interface{} {
+	return b.Build
+}
+
+// BuilderConfig is the configuration structure for the builder.
+type BuilderConfig struct {
+	// The AWS region of the S3 bucket. This must be the region the function
+	// is deployed to.
+	Region string `hcl:"region"`
+
+	// The S3 bucket to upload the archive to.
+	Bucket string `hcl:"bucket"`
+
+	// The Lambda runtime and the handler of the function.
+	Runtime string `hcl:"runtime"`
+	Handler string `hcl:"handler"`
+
+	// The directory to package, relative to the app. Defaults to the
+	// directory of the app.
+	Path string `hcl:"path,optional"`
+
+	// Patterns of files and directories to leave out of the archive.
+	Ignore []string `hcl:"ignore,optional"`
+}
+
+// Config implements Configurable
+func (b *Builder) Config() (interface{}, error) {
+	return &b.config, nil
+}
+
+// Build packages the function and uploads the archive to S3.
+func (b *Builder) Build(
+	ctx context.Context,
+	log hclog.Logger,
+	ui terminal.UI,
+	src *component.Source,
+) (*Archive, error) {
+	sg := ui.StepGroup()
+	defer sg.Wait()
+
+	dir := src.Path
+	if b.config.Path != "" {
+		dir = b.config.Path
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(src.Path, dir)
+		}
+	}
+
+	step := sg.Add("Packaging %s", dir)
+	defer func() {
+		step.Abort()
+	}()
+
+	f, err := ioutil.TempFile("", "waypoint-lambda-*.zip")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	sum, err := writeArchive(f, dir, b.config.Ignore)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	// The key is derived from the contents so that builds of the same files
+	// reuse the archive.
+	key := "waypoint/" + src.App + "/" + hex.EncodeToString(sum) + ".zip"
+	log.Debug("packaged function", "key", key)
+
+	step.Done()
+	step = sg.Add("Uploading archive to s3://%s/%s", b.config.Bucket, key)
+
+	sess, err := utils.GetSession(&utils.SessionConfig{
+		Region: b.config.Region,
+		Logger: log,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s3.New(sess).HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(b.config.Bucket),
+		Key:    aws.String(key),
+	})
+	if err == nil {
+		step.Update("Archive already uploaded to s3://%s/%s", b.config.Bucket, key)
+	} else {
+		// HeadObject has no body, so a missing object is only reported
+		// with the status code.
+		if aerr, ok := err.(awserr.RequestFailure); !ok || aerr.StatusCode() != 404 {
+			return nil, err
+		}
+
+		_, err = s3manager.NewUploader(sess).UploadWithContext(ctx, &s3manager.UploadInput{
+			Bucket: aws.String(b.config.Bucket),
+			Key:    aws.String(key),
+			Body:   f,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		step.Update("Uploaded archive to s3://%s/%s", b.config.Bucket, key)
+	}
+	step.Done()
+
+	return &Archive{
+		Region:  b.config.Region,
+		Bucket:  b.config.Bucket,
+		Key:     key,
+		Sha256:  base64.StdEncoding.EncodeToString(sum),
+		Runtime: b.config.Runtime,
+		Handler: b.config.Handler,
+	}, nil
+}
+
+// writeArchive writes a zip archive of the files in dir to w and returns the
+// SHA256 of the archive. Files and directories that match one of the ignore
+// patterns, either by their path relative to dir or their name, are left
+// out.
+func writeArchive(w io.Writer, dir string, ignore []string) ([]byte, error) {
+	h := sha256.New()
+	zw := zip.NewWriter(io.MultiWriter(w, h))
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+
+		for _, pattern := range ignore {
+			matchPath, err := filepath.Match(pattern, rel)
+			if err != nil {
+				return err
+			}
+			matchName, _ := filepath.Match(pattern, info.Name())
+			if matchPath || matchName {
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+
+				return nil
+			}
+		}
+
+		// We include the files symlinks point to, since the links likely
+		// point outside the archive.
+		if info.Mode()&os.ModeSymlink != 0 {
+			info, err = os.Stat(path)
+			if err != nil {
+				return err
+			}
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		header.Name = rel
+		header.Method = zip.Deflate
+		header.Modified = archiveModTime
+
+		fw, err := zw.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(fw, f)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return h.Sum(nil), nil
+}
+
+func (b *Builder) Documentation() (*docs.Documentation, error) {
+	doc, err := docs.New(docs.FromConfig(&BuilderConfig{}), docs.FromFunc(b.BuildFunc()))
+	if err != nil {
+		return nil, err
+	}
+
+	doc.Description(`
+Package a function as a zip archive for AWS Lambda
+
+The archive is uploaded to an S3 bucket that the aws-lambda platform deploys
+it from. The key of the archive is derived from its contents, so an archive
+is only uploaded once. To deploy functions as container images instead, build
+an image and push it with the aws-ecr registry plugin.
+`)
+
+	doc.Example(`
+build {
+  use "aws-lambda" {
+    region  = "us-east-1"
+    bucket  = "my-functions"
+    runtime = "nodejs14.x"
+    handler = "index.handler"
+    ignore  = ["*.md", "test"]
+  }
+}
+`)
+
+	doc.Output("lambda.Archive")
+
+	doc.SetField(
+		"region",
+		"the AWS region of the S3 bucket",
+		docs.Summary(
+			"Lambda requires the archive to be in the region of the function,",
+			"so this is the region the function is deployed to",
+		),
+	)
+
+	doc.SetField(
+		"bucket",
+		"the S3 bucket to upload the archive to",
+	)
+
+	doc.SetField(
+		"runtime",
+		"the Lambda runtime of the function, such as nodejs14.x or python3.8",
+	)
+
+	doc.SetField(
+		"handler",
+		"the function in the code that Lambda calls, such as index.handler",
+	)
+
+	doc.SetField(
+		"path",
+		"the directory to package, relative to the app",
+		docs.Default("the directory of the app"),
+	)
+
+	doc.SetField(
+		"ignore",
+		"patterns of files and directories to leave out of the archive",
+		docs.Summary(
+			"patterns match either the path relative to the packaged directory",
+			"or the name of a file or directory",
+		),
+	)
+
+	return doc, nil
+}
+
+var (
+	_ component.Builder      = (*Builder)(nil)
+	_ component.Configurable = (*Builder)(nil)
+	_ component.Documented   = (*Builder)(nil)
+)
@@ -0,0 +1,52 @@
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteArchive(t *testing.T) {
+	require := require.New(t)
+
+	dir, err := ioutil.TempDir("", "waypoint-lambda")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+
+	require.NoError(os.MkdirAll(filepath.Join(dir, "lib"), 0755))
+	require.NoError(os.MkdirAll(filepath.Join(dir, "test"), 0755))
+	require.NoError(ioutil.WriteFile(filepath.Join(dir, "bootstrap"), []byte("bin"), 0755))
+	require.NoError(ioutil.WriteFile(filepath.Join(dir, "lib", "util.js"), []byte("util"), 0644))
+	require.NoError(ioutil.WriteFile(filepath.Join(dir, "test", "util_test.js"), []byte("test"), 0644))
+	require.NoError(ioutil.WriteFile(filepath.Join(dir, "README.md"), []byte("readme"), 0644))
+
+	var buf bytes.Buffer
+	sum, err := writeArchive(&buf, dir, []string{"test", "*.md"})
+	require.NoError(err)
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(err)
+
+	files := map[string]os.FileMode{}
+	for _, f := range zr.File {
+		files[f.Name] = f.Mode()
+	}
+	require.Len(files, 2)
+	require.Contains(files, "lib/util.js")
+	require.Contains(files, "bootstrap")
+
+	// Lambda requires executables to keep their mode.
+	require.Equal(os.FileMode(0755), files["bootstrap"].Perm())
+
+	// The same files result in the same archive.
+	var buf2 bytes.Buffer
+	sum2, err := writeArchive(&buf2, dir, []string{"test", "*.md"})
+	require.NoError(err)
+	require.Equal(sum, sum2)
+	require.Equal(buf.Bytes(), buf2.Bytes())
+}
@@ -8,7 +8,8 @@ import (
 //go:generate protoc -I ../../../.. --go_opt=plugins=grpc --go_out=../../../.. waypoint/builtin/aws/lambda/plugin.proto
 
 // Options are the SDK options to use for instantiation for
-// the Google Cloud Run plugin.
+// the AWS Lambda plugin.
 var Options = []sdk.Option{
-	sdk.WithComponents(&Platform{}),
+	sdk.WithComponents(&Builder{}, &Platform{}, &Releaser{}),
+	sdk.WithMappers(ECRImageCodeMapper, ArchiveCodeMapper),
 }
@@ -0,0 +1,19 @@
+package lambda
+
+import (
+	"github.com/hashicorp/waypoint/builtin/aws/ecr"
+)
+
+// ECRImageCodeMapper maps an ecr.Image to the code of a function.
+func ECRImageCodeMapper(src *ecr.Image) *Code {
+	return &Code{
+		Source: &Code_ImageUri{ImageUri: src.Name()},
+	}
+}
+
+// ArchiveCodeMapper maps an Archive to the code of a function.
+func ArchiveCodeMapper(src *Archive) *Code {
+	return &Code{
+		Source: &Code_Archive{Archive: src},
+	}
+}
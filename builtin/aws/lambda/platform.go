@@ -24,6 +24,8 @@ import (
 	"github.com/hashicorp/waypoint/builtin/aws/utils"
 	wpssh "github.com/hashicorp/waypoint/internal/ssh"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/hashicorp/waypoint-plugin-sdk/component"
 	"github.com/hashicorp/waypoint-plugin-sdk/docs"
@@ -77,6 +79,16 @@ func (p *Platform) LogsFunc() interface{} {
 	return p.Logs
 }
 
+// StatusFunc implements component.Status
+func (p *Platform) StatusFunc() interface{} {
+	return p.Status
+}
+
+// DefaultReleaserFunc implements component.PlatformReleaser
+func (p *Platform) DefaultReleaserFunc() interface{} {
+	return func() *Releaser { return &Releaser{} }
+}
+
 // ValidateAuthFunc implements component.Authenticator
 func (p *Platform) ValidateAuthFunc() interface{} {
 	return p.ValidateAuth
@@ -176,12 +188,12 @@ func (p *Platform) setupRole(L hclog.Logger, app *component.Source, sess *sessio
 	return roleArn, nil
 }
 
-// Deploy deploys an image to AWS Lambda.
+// Deploy deploys a container image or a zip archive to AWS Lambda.
 func (p *Platform) Deploy(
 	ctx context.Context,
 	log hclog.Logger,
 	src *component.Source,
-	img *ecr.Image,
+	code *Code,
 	deployConfig *component.DeploymentConfig,
 	ui terminal.UI,
 ) (*Deployment, error) {
@@ -233,6 +245,22 @@ func (p *Platform) Deploy(
 		timeout = DefaultTimeout
 	}
 
+	// The package type of a function can't be changed, so we need to know
+	// which one we deploy before we create or update the function.
+	packageType := lambda.PackageTypeImage
+	archive := code.GetArchive()
+	if archive != nil {
+		packageType = lambda.PackageTypeZip
+
+		// Lambda only reads archives from buckets in the region of the
+		// function.
+		if archive.Region != p.config.Region {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"the archive is in region %q but the function is deployed to region %q",
+				archive.Region, p.config.Region)
+		}
+	}
+
 	step.Done()
 
 	step = sg.Add("Reading Lambda function: %s", src.App)
@@ -248,6 +276,13 @@ func (p *Platform) Deploy(
 	if err == nil {
 		step.Update("Updating Lambda function with new code")
 
+		if cur := aws.StringValue(curFunc.Configuration.PackageType); cur != "" && cur != packageType {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"the Lambda function %q has the package type %s and can't be "+
+					"deployed as %s, delete the function to change its package type",
+				src.App, cur, packageType)
+		}
+
 		var reset bool
 		var update lambda.UpdateFunctionConfigurationInput
 
@@ -261,6 +296,18 @@ func (p *Platform) Deploy(
 			reset = true
 		}
 
+		if archive != nil {
+			if aws.StringValue(curFunc.Configuration.Runtime) != archive.Runtime {
+				update.Runtime = aws.String(archive.Runtime)
+				reset = true
+			}
+
+			if aws.StringValue(curFunc.Configuration.Handler) != archive.Handler {
+				update.Handler = aws.String(archive.Handler)
+				reset = true
+			}
+		}
+
 		if reset {
 			update.FunctionName = curFunc.Configuration.FunctionArn
 
@@ -270,43 +317,67 @@ func (p *Platform) Deploy(
 			}
 		}
 
-		funcCfg, err := lamSvc.UpdateFunctionCode(&lambda.UpdateFunctionCodeInput{
+		codeInput := &lambda.UpdateFunctionCodeInput{
 			FunctionName: aws.String(src.App),
-			ImageUri:     aws.String(img.Name()),
-		})
-
-		if err != nil {
-			return nil, err
+		}
+		if archive != nil {
+			codeInput.S3Bucket = aws.String(archive.Bucket)
+			codeInput.S3Key = aws.String(archive.Key)
+		} else {
+			codeInput.ImageUri = aws.String(code.GetImageUri())
 		}
 
-		funcarn = *funcCfg.FunctionArn
+		// Updating the configuration is asynchronous, so the code update
+		// conflicts until the configuration is updated.
+		var funcCfg *lambda.FunctionConfiguration
+		for i := 0; i < 30; i++ {
+			funcCfg, err = lamSvc.UpdateFunctionCode(codeInput)
+			if aerr, ok := err.(awserr.Error); ok && aerr.Code() == lambda.ErrCodeResourceConflictException {
+				time.Sleep(2 * time.Second)
+				continue
+			}
 
+			break
+		}
 		if err != nil {
 			return nil, err
 		}
 
+		funcarn = *funcCfg.FunctionArn
+
 		// We couldn't read the function before, so we'll go ahead and create one.
 	} else {
 		step.Update("Creating new Lambda function")
 
+		input := &lambda.CreateFunctionInput{
+			Description:  aws.String(fmt.Sprintf("waypoint %s", src.App)),
+			FunctionName: aws.String(src.App),
+			Role:         aws.String(roleArn),
+			Timeout:      aws.Int64(timeout),
+			MemorySize:   aws.Int64(mem),
+			Tags: map[string]*string{
+				"waypoint.app": aws.String(src.App),
+			},
+			PackageType: aws.String(packageType),
+		}
+		if archive != nil {
+			input.Runtime = aws.String(archive.Runtime)
+			input.Handler = aws.String(archive.Handler)
+			input.Code = &lambda.FunctionCode{
+				S3Bucket: aws.String(archive.Bucket),
+				S3Key:    aws.String(archive.Key),
+			}
+		} else {
+			input.Code = &lambda.FunctionCode{
+				ImageUri: aws.String(code.GetImageUri()),
+			}
+			input.ImageConfig = &lambda.ImageConfig{}
+		}
+
 		// Run this in a loop to guard against eventual consistency errors with the specified
 		// role not showing up within lambda right away.
 		for i := 0; i < 30; i++ {
-			funcOut, err := lamSvc.CreateFunction(&lambda.CreateFunctionInput{
-				Description:  aws.String(fmt.Sprintf("waypoint %s", src.App)),
-				FunctionName: aws.String(src.App),
-				Role:         aws.String(roleArn),
-				Timeout:      aws.Int64(timeout),
-				MemorySize:   aws.Int64(mem),
-				Tags: map[string]*string{
-					"waypoint.app": aws.String(src.App),
-				},
-				PackageType: aws.String("Image"),
-				Code: &lambda.FunctionCode{
-					ImageUri: aws.String(img.Name()),
-				},
-				ImageConfig: &lambda.ImageConfig{},
-			})
+			funcOut, err := lamSvc.CreateFunction(input)
 
 			if err != nil {
 				// if we encounter an unrecoverable error, exit now.
@@ -733,7 +804,14 @@ func (p *Platform) Documentation() (*docs.Documentation, error) {
 		return nil, err
 	}
 
-	doc.Description("Deploy functions as OCI Images to AWS Lambda")
+	doc.Description(`
+Deploy functions to AWS Lambda
+
+Functions are deployed either as OCI images pushed with the aws-ecr registry
+plugin or as zip archives built with the aws-lambda builder. Every deployment
+publishes a new version of the function. Releases route traffic to a version
+with an alias, see the aws-lambda release plugin.
+`)
 
 	doc.Example(
 		`
@@ -745,7 +823,7 @@ deploy {
 }
 `)
 
-	doc.Input("ecr.Image")
+	doc.Input("lambda.Code")
 	doc.Output("lambda.Deployment")
 
 	doc.SetField(
@@ -795,6 +873,8 @@ type Config struct {
 }
 
 var (
-	_ component.Platform     = (*Platform)(nil)
-	_ component.Configurable = (*Platform)(nil)
+	_ component.Platform         = (*Platform)(nil)
+	_ component.PlatformReleaser = (*Platform)(nil)
+	_ component.Configurable     = (*Platform)(nil)
+	_ component.Status           = (*Platform)(nil)
 )
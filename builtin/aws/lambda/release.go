@@ -0,0 +1,9 @@
+package lambda
+
+import "github.com/hashicorp/waypoint-plugin-sdk/component"
+
+// URL implements component.Release. Aliases are invoked through the Lambda
+// API and have no URL.
+func (r *Release) URL() string { return "" }
+
+var _ component.Release = (*Release)(nil)
@@ -0,0 +1,206 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	"github.com/hashicorp/waypoint-plugin-sdk/docs"
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+
+	"github.com/hashicorp/waypoint/builtin/aws/utils"
+	"github.com/hashicorp/waypoint/builtin/canary"
+)
+
+// DefaultAlias is the name of the alias releases update by default.
+const DefaultAlias = "live"
+
+// Releaser is the ReleaseManager implementation for AWS Lambda. A release
+// points an alias of the function at the version of the deployment, or
+// shifts part of the traffic of the alias to it for a canary release.
+type Releaser struct {
+	config ReleaserConfig
+}
+
+// ReleaserConfig is the configuration structure for the Releaser.
+type ReleaserConfig struct {
+	// The name of the alias to update. Defaults to "live".
+	Alias string `hcl:"alias,optional"`
+}
+
+// Config implements Configurable
+func (r *Releaser) Config() (interface{}, error) {
+	return &r.config, nil
+}
+
+// ReleaseFunc implements component.ReleaseManager
+func (r *Releaser) ReleaseFunc() interface{} {
+	return r.Release
+}
+
+// Release updates the alias of the function to route traffic to the
+// version of the deployment. A canary release keeps the alias pointing at
+// the version it pointed at before and routes the canary percentage of its
+// traffic to the version of the deployment.
+func (r *Releaser) Release(
+	ctx context.Context,
+	log hclog.Logger,
+	ui terminal.UI,
+	target *Deployment,
+	c *canary.Canary,
+) (*Release, error) {
+	sess, err := utils.GetSession(&utils.SessionConfig{
+		Region: target.Region,
+		Logger: log,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.release(ctx, ui, lambda.New(sess), target, c)
+}
+
+func (r *Releaser) release(
+	ctx context.Context,
+	ui terminal.UI,
+	lamSvc lambdaiface.LambdaAPI,
+	target *Deployment,
+	c *canary.Canary,
+) (*Release, error) {
+	sg := ui.StepGroup()
+	defer sg.Wait()
+
+	alias := r.config.Alias
+	if alias == "" {
+		alias = DefaultAlias
+	}
+
+	weight := c.Percent()
+
+	step := sg.Add("Reading Lambda alias %q", alias)
+	defer func() {
+		step.Abort()
+	}()
+
+	result := &Release{
+		Region:  target.Region,
+		Alias:   alias,
+		Version: target.Version,
+		Weight:  100,
+	}
+
+	cur, err := lamSvc.GetAliasWithContext(ctx, &lambda.GetAliasInput{
+		FunctionName: aws.String(target.FuncArn),
+		Name:         aws.String(alias),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != lambda.ErrCodeResourceNotFoundException {
+			return nil, err
+		}
+
+		// There is no version to shift traffic from, so the new alias routes
+		// all traffic to the version.
+		step.Update("Creating Lambda alias %q for version %s", alias, target.Version)
+		out, err := lamSvc.CreateAliasWithContext(ctx, &lambda.CreateAliasInput{
+			FunctionName:    aws.String(target.FuncArn),
+			FunctionVersion: aws.String(target.Version),
+			Name:            aws.String(alias),
+			Description:     aws.String("managed by waypoint"),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		result.AliasArn = aws.StringValue(out.AliasArn)
+		step.Update("Lambda alias %q routes all traffic to version %s", alias, target.Version)
+		step.Done()
+		return result, nil
+	}
+
+	input := &lambda.UpdateAliasInput{
+		FunctionName: aws.String(target.FuncArn),
+		Name:         aws.String(alias),
+	}
+
+	prev := aws.StringValue(cur.FunctionVersion)
+	if weight == 100 || prev == target.Version {
+		// Clear any traffic shifting of previous releases.
+		input.FunctionVersion = aws.String(target.Version)
+		input.RoutingConfig = &lambda.AliasRoutingConfiguration{
+			AdditionalVersionWeights: map[string]*float64{},
+		}
+
+		step.Update("Routing all traffic of Lambda alias %q to version %s", alias, target.Version)
+	} else {
+		// The alias keeps pointing at the previous version and routes part
+		// of its traffic to the new version.
+		input.FunctionVersion = aws.String(prev)
+		input.RoutingConfig = &lambda.AliasRoutingConfiguration{
+			AdditionalVersionWeights: map[string]*float64{
+				target.Version: aws.Float64(float64(weight) / 100),
+			},
+		}
+
+		result.PreviousVersion = prev
+		result.Weight = int32(weight)
+		step.Update("Routing %d%% of the traffic of Lambda alias %q to version %s",
+			weight, alias, target.Version)
+	}
+
+	out, err := lamSvc.UpdateAliasWithContext(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	result.AliasArn = aws.StringValue(out.AliasArn)
+	step.Done()
+
+	return result, nil
+}
+
+func (r *Releaser) Documentation() (*docs.Documentation, error) {
+	doc, err := docs.New(docs.FromConfig(&ReleaserConfig{}), docs.FromFunc(r.ReleaseFunc()))
+	if err != nil {
+		return nil, err
+	}
+
+	doc.Description(`
+Route traffic to Lambda function versions with an alias
+
+A release points the alias at the version of the deployment. A canary
+release, such as with "waypoint release -percentage", keeps the alias
+pointing at the version it pointed at before and routes that percentage of
+its traffic to the version of the deployment instead. Promoting the canary
+points the alias at the version of the deployment and aborting it routes
+all traffic back to the previous version.
+`)
+
+	doc.Example(`
+release {
+  use "aws-lambda" {
+    alias = "live"
+  }
+}
+`)
+
+	doc.Input("lambda.Deployment")
+	doc.Output("lambda.Release")
+
+	doc.SetField(
+		"alias",
+		"the name of the alias to update",
+		docs.Default(DefaultAlias),
+	)
+
+	return doc, nil
+}
+
+var (
+	_ component.ReleaseManager = (*Releaser)(nil)
+	_ component.Configurable   = (*Releaser)(nil)
+	_ component.Documented     = (*Releaser)(nil)
+)
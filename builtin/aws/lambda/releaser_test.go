@@ -0,0 +1,174 @@
+package lambda
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/builtin/canary"
+)
+
+func TestReleaserRelease(t *testing.T) {
+	cases := []struct {
+		Name    string
+		Current *lambda.AliasConfiguration
+		Version string
+		Canary  *canary.Canary
+
+		// The expected version and additional version weights of the alias
+		ExpectedVersion string
+		ExpectedWeights map[string]float64
+
+		// The expected previous version and weight of the release
+		PreviousVersion string
+		Weight          int32
+	}{
+		{
+			"new alias",
+			nil,
+			"2",
+			&canary.Canary{},
+			"2",
+			nil,
+			"",
+			100,
+		},
+		{
+			"canary without a previous version",
+			nil,
+			"2",
+			&canary.Canary{Percentage: 10},
+			"2",
+			nil,
+			"",
+			100,
+		},
+		{
+			"full release",
+			testAlias("1", nil),
+			"2",
+			&canary.Canary{},
+			"2",
+			map[string]float64{},
+			"",
+			100,
+		},
+		{
+			"canary",
+			testAlias("1", nil),
+			"2",
+			&canary.Canary{Percentage: 10},
+			"1",
+			map[string]float64{"2": 0.1},
+			"1",
+			10,
+		},
+		{
+			"promote",
+			testAlias("1", map[string]float64{"2": 0.1}),
+			"2",
+			&canary.Canary{},
+			"2",
+			map[string]float64{},
+			"",
+			100,
+		},
+		{
+			"abort",
+			testAlias("1", map[string]float64{"2": 0.1}),
+			"1",
+			&canary.Canary{},
+			"1",
+			map[string]float64{},
+			"",
+			100,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := context.Background()
+
+			api := &testLambdaAPI{alias: tt.Current}
+			r := &Releaser{}
+			result, err := r.release(ctx, terminal.NonInteractiveUI(ctx), api, &Deployment{
+				Region:  "us-east-1",
+				FuncArn: "arn:aws:lambda:us-east-1:123456789012:function:app",
+				Version: tt.Version,
+			}, tt.Canary)
+			require.NoError(err)
+
+			require.Equal(DefaultAlias, result.Alias)
+			require.Equal(tt.Version, result.Version)
+			require.Equal(tt.PreviousVersion, result.PreviousVersion)
+			require.Equal(tt.Weight, result.Weight)
+
+			require.NotNil(api.alias)
+			require.Equal(tt.ExpectedVersion, aws.StringValue(api.alias.FunctionVersion))
+			var weights map[string]float64
+			if rc := api.alias.RoutingConfig; rc != nil {
+				weights = aws.Float64ValueMap(rc.AdditionalVersionWeights)
+			}
+			require.Equal(tt.ExpectedWeights, weights)
+		})
+	}
+}
+
+func testAlias(version string, weights map[string]float64) *lambda.AliasConfiguration {
+	result := &lambda.AliasConfiguration{
+		AliasArn:        aws.String("arn:aws:lambda:us-east-1:123456789012:function:app:live"),
+		FunctionVersion: aws.String(version),
+	}
+	if weights != nil {
+		result.RoutingConfig = &lambda.AliasRoutingConfiguration{
+			AdditionalVersionWeights: aws.Float64Map(weights),
+		}
+	}
+
+	return result
+}
+
+// testLambdaAPI is a fake Lambda API with one alias of a function.
+type testLambdaAPI struct {
+	lambdaiface.LambdaAPI
+
+	// alias is nil if the alias doesn't exist.
+	alias *lambda.AliasConfiguration
+}
+
+func (a *testLambdaAPI) GetAliasWithContext(
+	ctx aws.Context, input *lambda.GetAliasInput, _ ...request.Option,
+) (*lambda.AliasConfiguration, error) {
+	if a.alias == nil {
+		return nil, awserr.New(lambda.ErrCodeResourceNotFoundException, "alias not found", nil)
+	}
+
+	return a.alias, nil
+}
+
+func (a *testLambdaAPI) CreateAliasWithContext(
+	ctx aws.Context, input *lambda.CreateAliasInput, _ ...request.Option,
+) (*lambda.AliasConfiguration, error) {
+	a.alias = testAlias(aws.StringValue(input.FunctionVersion), nil)
+	return a.alias, nil
+}
+
+func (a *testLambdaAPI) UpdateAliasWithContext(
+	ctx aws.Context, input *lambda.UpdateAliasInput, _ ...request.Option,
+) (*lambda.AliasConfiguration, error) {
+	a.alias = &lambda.AliasConfiguration{
+		AliasArn:        a.alias.AliasArn,
+		FunctionVersion: input.FunctionVersion,
+		RoutingConfig:   input.RoutingConfig,
+	}
+
+	return a.alias, nil
+}
@@ -0,0 +1,192 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+
+	"github.com/hashicorp/waypoint/builtin/aws/utils"
+
+	sdk "github.com/hashicorp/waypoint-plugin-sdk/proto/gen"
+)
+
+// statusWindow is how far back the status report looks at the CloudWatch
+// metrics of a function version.
+const statusWindow = 15 * time.Minute
+
+// Status reports the health of the function version from its state and the
+// errors of its invocations in CloudWatch.
+func (p *Platform) Status(
+	ctx context.Context,
+	log hclog.Logger,
+	deployment *Deployment,
+	ui terminal.UI,
+) (*sdk.StatusReport, error) {
+	sg := ui.StepGroup()
+	defer sg.Wait()
+
+	step := sg.Add("Gathering health report for Lambda function version %s...", deployment.Version)
+	defer func() {
+		step.Abort()
+	}()
+
+	sess, err := utils.GetSession(&utils.SessionConfig{
+		Region: deployment.Region,
+		Logger: log,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := lambda.New(sess).GetFunctionConfigurationWithContext(ctx, &lambda.GetFunctionConfigurationInput{
+		FunctionName: aws.String(deployment.FuncArn),
+		Qualifier:    aws.String(deployment.Version),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result sdk.StatusReport
+	result.External = true
+
+	state := versionStateHealth(cfg)
+	result.Resources = append(result.Resources, state)
+
+	log.Debug("querying cloudwatch for invocation metrics")
+	metrics, err := invocationHealth(ctx, sess, aws.StringValue(cfg.FunctionName), deployment.Version)
+	if err != nil {
+		return nil, err
+	}
+	// The invocations are part of the function version rather than a
+	// resource of their own, so name them after the version.
+	metrics.Name = state.Name + "/" + metrics.Name
+	result.Resources = append(result.Resources, metrics)
+
+	// A version that isn't active can't be invoked, so its state decides
+	// the health. Otherwise the invocations do.
+	if state.Health != sdk.StatusReport_READY {
+		result.Health = state.Health
+		result.HealthMessage = state.HealthMessage
+	} else {
+		result.Health = metrics.Health
+		result.HealthMessage = metrics.HealthMessage
+	}
+	result.GeneratedTime = ptypes.TimestampNow()
+
+	step.Update(result.HealthMessage)
+	switch result.Health {
+	case sdk.StatusReport_READY:
+	case sdk.StatusReport_ALIVE, sdk.StatusReport_PARTIAL:
+		step.Status(terminal.StatusWarn)
+	default:
+		step.Status(terminal.StatusError)
+	}
+	step.Done()
+
+	return &result, nil
+}
+
+// versionStateHealth translates the state of a function version into a
+// Waypoint health status.
+func versionStateHealth(cfg *lambda.FunctionConfiguration) *sdk.StatusReport_Resource {
+	resource := &sdk.StatusReport_Resource{
+		Name: fmt.Sprintf("%s:%s", aws.StringValue(cfg.FunctionName), aws.StringValue(cfg.Version)),
+	}
+
+	switch aws.StringValue(cfg.State) {
+	case lambda.StateActive:
+		resource.Health = sdk.StatusReport_READY
+		resource.HealthMessage = "function version is active"
+	case lambda.StateInactive:
+		// Lambda deactivates versions that aren't invoked for a while and
+		// activates them again on the next invocation.
+		resource.Health = sdk.StatusReport_READY
+		resource.HealthMessage = "function version is inactive and is activated when invoked"
+	case lambda.StatePending:
+		resource.Health = sdk.StatusReport_ALIVE
+		resource.HealthMessage = "function version is being created"
+	case lambda.StateFailed:
+		resource.Health = sdk.StatusReport_DOWN
+		resource.HealthMessage = fmt.Sprintf(
+			"function version failed: %s", aws.StringValue(cfg.StateReason))
+	default:
+		resource.Health = sdk.StatusReport_UNKNOWN
+		resource.HealthMessage = "function version state could not be determined"
+	}
+
+	return resource
+}
+
+// invocationHealth determines the health of the invocations of a function
+// version from the CloudWatch metrics of the last statusWindow.
+func invocationHealth(
+	ctx context.Context,
+	sess *session.Session,
+	name, version string,
+) (*sdk.StatusReport_Resource, error) {
+	cw := cloudwatch.New(sess)
+	end := time.Now()
+	start := end.Add(-statusWindow)
+
+	sums := map[string]float64{}
+	for _, metric := range []string{"Invocations", "Errors", "Throttles"} {
+		out, err := cw.GetMetricStatisticsWithContext(ctx, &cloudwatch.GetMetricStatisticsInput{
+			Namespace:  aws.String("AWS/Lambda"),
+			MetricName: aws.String(metric),
+			Dimensions: []*cloudwatch.Dimension{
+				{Name: aws.String("FunctionName"), Value: aws.String(name)},
+				{Name: aws.String("Resource"), Value: aws.String(name + ":" + version)},
+			},
+			StartTime:  aws.Time(start),
+			EndTime:    aws.Time(end),
+			Period:     aws.Int64(int64(statusWindow.Seconds())),
+			Statistics: []*string{aws.String(cloudwatch.StatisticSum)},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, dp := range out.Datapoints {
+			sums[metric] += aws.Float64Value(dp.Sum)
+		}
+	}
+
+	return metricsHealth(sums["Invocations"], sums["Errors"], sums["Throttles"]), nil
+}
+
+// metricsHealth translates the number of invocations, errors and throttles
+// of a function version into a Waypoint health status.
+func metricsHealth(invocations, errors, throttles float64) *sdk.StatusReport_Resource {
+	resource := &sdk.StatusReport_Resource{Name: "invocations"}
+	failed := errors + throttles
+
+	switch {
+	case invocations == 0 && throttles == 0:
+		resource.Health = sdk.StatusReport_READY
+		resource.HealthMessage = fmt.Sprintf(
+			"no invocations in the last %s", statusWindow)
+	case failed == 0:
+		resource.Health = sdk.StatusReport_READY
+		resource.HealthMessage = fmt.Sprintf(
+			"%.0f invocations in the last %s without errors", invocations, statusWindow)
+	case failed >= invocations+throttles:
+		resource.Health = sdk.StatusReport_DOWN
+		resource.HealthMessage = fmt.Sprintf(
+			"all %.0f invocations in the last %s failed", invocations+throttles, statusWindow)
+	default:
+		resource.Health = sdk.StatusReport_PARTIAL
+		resource.HealthMessage = fmt.Sprintf(
+			"%.0f errors and %.0f throttles of %.0f invocations in the last %s",
+			errors, throttles, invocations+throttles, statusWindow)
+	}
+
+	return resource
+}
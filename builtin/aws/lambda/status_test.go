@@ -0,0 +1,34 @@
+package lambda
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hashicorp/waypoint-plugin-sdk/proto/gen"
+)
+
+func TestMetricsHealth(t *testing.T) {
+	cases := []struct {
+		Name        string
+		Invocations float64
+		Errors      float64
+		Throttles   float64
+		Health      sdk.StatusReport_Health
+	}{
+		{"no invocations", 0, 0, 0, sdk.StatusReport_READY},
+		{"no errors", 10, 0, 0, sdk.StatusReport_READY},
+		{"some errors", 10, 2, 0, sdk.StatusReport_PARTIAL},
+		{"some throttles", 10, 0, 2, sdk.StatusReport_PARTIAL},
+		{"all errors", 10, 10, 0, sdk.StatusReport_DOWN},
+		{"all throttled", 0, 0, 5, sdk.StatusReport_DOWN},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			resource := metricsHealth(tt.Invocations, tt.Errors, tt.Throttles)
+			require.Equal(t, tt.Health, resource.Health)
+			require.NotEmpty(t, resource.HealthMessage)
+		})
+	}
+}
@@ -0,0 +1,311 @@
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/go-hclog"
+	"github.com/mitchellh/mapstructure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	"github.com/hashicorp/waypoint-plugin-sdk/docs"
+	pb "github.com/hashicorp/waypoint-plugin-sdk/proto/gen"
+)
+
+var (
+	// refreshPeriod is the interval between reading secrets again. If a
+	// Read is called again during this period, we will return cached
+	// values.
+	refreshPeriod = 30 * time.Second
+)
+
+// ConfigSourcer implements component.ConfigSourcer for AWS Secrets Manager
+type ConfigSourcer struct {
+	config sourceConfig
+
+	cacheMu sync.Mutex
+
+	// secrets are the cached secret strings by cache key.
+	secrets map[string]string
+
+	// lastRead is the time the secrets were cached.
+	lastRead time.Time
+}
+
+// Config implements component.Configurable
+func (cs *ConfigSourcer) Config() (interface{}, error) {
+	return &cs.config, nil
+}
+
+// ReadFunc implements component.ConfigSourcer
+func (cs *ConfigSourcer) ReadFunc() interface{} {
+	return cs.read
+}
+
+// StopFunc implements component.ConfigSourcer
+func (cs *ConfigSourcer) StopFunc() interface{} {
+	return cs.stop
+}
+
+func (cs *ConfigSourcer) read(
+	ctx context.Context,
+	log hclog.Logger,
+	reqs []*component.ConfigRequest,
+) ([]*pb.ConfigSource_Value, error) {
+	cs.cacheMu.Lock()
+	defer cs.cacheMu.Unlock()
+
+	// Read the secrets again if our cache is stale.
+	if cs.secrets == nil || time.Since(cs.lastRead) > refreshPeriod {
+		cs.secrets = map[string]string{}
+		cs.lastRead = time.Now()
+	}
+
+	var client *secretsmanager.SecretsManager
+	result := make([]*pb.ConfigSource_Value, 0, len(reqs))
+	for _, req := range reqs {
+		value := &pb.ConfigSource_Value{Name: req.Name}
+		result = append(result, value)
+
+		// Decode our configuration
+		var secretReq reqConfig
+		if err := mapstructure.WeakDecode(req.Config, &secretReq); err != nil {
+			value.Result = &pb.ConfigSource_Value_Error{
+				Error: status.New(codes.Aborted, err.Error()).Proto(),
+			}
+
+			continue
+		}
+
+		L := log.With("secret_id", secretReq.SecretId, "key", secretReq.Key)
+
+		secret, ok := cs.secrets[secretReq.CacheKey()]
+		if !ok {
+			// Only initialize the client if we have to read a secret.
+			if client == nil {
+				var err error
+				client, err = cs.client(log)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			L.Debug("reading secret")
+			input := &secretsmanager.GetSecretValueInput{
+				SecretId: aws.String(secretReq.SecretId),
+			}
+			if secretReq.VersionStage != "" {
+				input.VersionStage = aws.String(secretReq.VersionStage)
+			}
+
+			resp, err := client.GetSecretValueWithContext(ctx, input)
+			if err != nil {
+				L.Warn("error reading secret", "err", err)
+				value.Result = &pb.ConfigSource_Value_Error{
+					Error: status.New(codes.Aborted, err.Error()).Proto(),
+				}
+
+				continue
+			}
+
+			// Binary secrets are returned as is.
+			secret = string(resp.SecretBinary)
+			if resp.SecretString != nil {
+				secret = *resp.SecretString
+			}
+
+			cs.secrets[secretReq.CacheKey()] = secret
+		}
+
+		if secretReq.Key == "" {
+			value.Result = &pb.ConfigSource_Value_Value{Value: secret}
+			continue
+		}
+
+		// With a key the secret must be a JSON object, which is how the
+		// console stores secrets with multiple key/value pairs.
+		v, err := secretKey(secret, secretReq.Key)
+		if err != nil {
+			L.Warn("error reading key of secret", "err", err)
+			value.Result = &pb.ConfigSource_Value_Error{
+				Error: status.New(codes.Aborted, err.Error()).Proto(),
+			}
+
+			continue
+		}
+
+		value.Result = &pb.ConfigSource_Value_Value{Value: v}
+	}
+
+	return result, nil
+}
+
+func (cs *ConfigSourcer) stop() error {
+	cs.cacheMu.Lock()
+	defer cs.cacheMu.Unlock()
+
+	// Nullify everything which will force a refresh
+	cs.secrets = nil
+
+	return nil
+}
+
+// client returns a new Secrets Manager client from our config.
+func (cs *ConfigSourcer) client(log hclog.Logger) (*secretsmanager.SecretsManager, error) {
+	var awsConfig awsbase.Config
+	if err := mapstructure.WeakDecode(cs.config, &awsConfig); err != nil {
+		log.Warn("error decoding the config source config", "err", err)
+		return nil, err
+	}
+	awsConfig.CallerName = "Waypoint"
+	awsConfig.CallerDocumentationURL = "https://www.waypointproject.io/"
+
+	log.Debug("retrieving AWS session")
+	sess, err := awsbase.GetSession(&awsConfig)
+	if err != nil {
+		log.Warn("error initializing AWS session", "err", err)
+		return nil, err
+	}
+
+	return secretsmanager.New(sess), nil
+}
+
+// secretKey returns the value of the key of a secret that is a JSON
+// object. Values that aren't strings are returned as JSON.
+func secretKey(secret, key string) (string, error) {
+	var values map[string]interface{}
+	if err := json.Unmarshal([]byte(secret), &values); err != nil {
+		return "", fmt.Errorf("secret must be a JSON object to read key %q", key)
+	}
+
+	v, ok := values[key]
+	if !ok {
+		return "", fmt.Errorf("secret doesn't have key %q", key)
+	}
+
+	if s, ok := v.(string); ok {
+		return s, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+func (cs *ConfigSourcer) Documentation() (*docs.Documentation, error) {
+	doc, err := docs.New(
+		docs.FromConfig(&sourceConfig{}),
+		docs.RequestFromStruct(&reqConfig{}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	doc.Description("Read configuration values from AWS Secrets Manager.")
+
+	doc.Example(`
+config {
+  env = {
+    DATABASE_PASSWORD = configdynamic("aws-secretsmanager", {
+      secret_id = "prod/db"
+      key       = "password"
+    })
+  }
+}
+`)
+
+	doc.SetRequestField(
+		"secret_id",
+		"the name or ARN of the secret to read.",
+	)
+
+	doc.SetRequestField(
+		"key",
+		"the key to read from a secret that is a JSON object.",
+		docs.Summary(
+			"secrets created in the console with multiple key/value pairs",
+			"are stored as a JSON object. If this isn't set, the entire",
+			"secret is the value.",
+		),
+	)
+
+	doc.SetRequestField(
+		"version_stage",
+		"the version stage of the secret to read.",
+		docs.Default("AWSCURRENT"),
+	)
+
+	doc.SetField(
+		"access_key",
+		"This is the AWS access key. It must be provided, but it can also be sourced from the `AWS_ACCESS_KEY_ID` environment variable, or via a shared credentials file if `profile` is specified",
+	)
+
+	doc.SetField(
+		"assume_role_arn",
+		"Amazon Resource Name (ARN) of the IAM Role to assume.",
+	)
+
+	doc.SetField(
+		"assume_role_session_name",
+		"Session name to use when assuming the role.",
+	)
+
+	doc.SetField(
+		"profile",
+		"This is the AWS profile name as set in the shared credentials file.",
+	)
+
+	doc.SetField(
+		"region",
+		"This is the AWS region. It must be provided, but it can also be sourced from the `AWS_DEFAULT_REGION` environment variables, or via a shared credentials file if profile is specified.",
+	)
+
+	doc.SetField(
+		"secret_key",
+		"This is the AWS secret key. It must be provided, but it can also be sourced from the `AWS_SECRET_ACCESS_KEY` environment variable, or via a shared credentials file if `profile` is specified.",
+	)
+
+	doc.SetField(
+		"shared_credentials_file",
+		"This is the path to the shared credentials file. If this is not set and a profile is specified, `~/.aws/credentials` will be used.",
+	)
+
+	doc.SetField(
+		"token",
+		"Session token for validating temporary credentials.",
+	)
+
+	return doc, nil
+}
+
+type reqConfig struct {
+	SecretId     string `hcl:"secret_id,attr"`
+	Key          string `hcl:"key,optional"`
+	VersionStage string `hcl:"version_stage,optional"`
+}
+
+func (c *reqConfig) CacheKey() string {
+	return c.SecretId + "@" + c.VersionStage
+}
+
+type sourceConfig struct {
+	AccessKey             string `hcl:"access_key,optional"`
+	AssumeRoleARN         string `hcl:"assume_role_arn,optional"`
+	AssumeRoleSessionName string `hcl:"assume_role_session_name,optional"`
+	CredsFilename         string `hcl:"shared_credentials_file,optional"`
+	Profile               string `hcl:"profile,optional"`
+	Region                string `hcl:"region,optional"`
+	SecretKey             string `hcl:"secret_key,optional"`
+	Token                 string `hcl:"token,optional"`
+}
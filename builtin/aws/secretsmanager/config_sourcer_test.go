@@ -0,0 +1,28 @@
+package secretsmanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretKey(t *testing.T) {
+	require := require.New(t)
+
+	secret := `{"username": "admin", "port": 5432}`
+
+	v, err := secretKey(secret, "username")
+	require.NoError(err)
+	require.Equal("admin", v)
+
+	// Non-string values are returned as JSON
+	v, err = secretKey(secret, "port")
+	require.NoError(err)
+	require.Equal("5432", v)
+
+	_, err = secretKey(secret, "password")
+	require.Error(err)
+
+	_, err = secretKey("hunter2", "password")
+	require.Error(err)
+}
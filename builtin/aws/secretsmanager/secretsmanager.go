@@ -0,0 +1,12 @@
+// Package secretsmanager contains components for syncing configuration
+// with AWS Secrets Manager.
+package secretsmanager
+
+import (
+	"github.com/hashicorp/waypoint-plugin-sdk"
+)
+
+// Options are the SDK options to use for instantiation for this plugin.
+var Options = []sdk.Option{
+	sdk.WithComponents(&ConfigSourcer{}),
+}
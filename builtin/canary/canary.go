@@ -0,0 +1,29 @@
+// Package canary contains the argument that release managers accept to
+// support canary releases.
+//
+// A release manager opts in to canary releases by accepting a *Canary
+// argument in its release function:
+//
+//	func (r *Releaser) Release(
+//		ctx context.Context,
+//		target *Deployment,
+//		c *canary.Canary,
+//	) (*Release, error)
+//
+// Waypoint always passes the argument to these release managers. A zero
+// percentage releases the deployment to all traffic, such as when a canary
+// is promoted. Aborting a canary releases the previous deployment to all
+// traffic.
+package canary
+
+//go:generate protoc -I ../../.. --go_opt=plugins=grpc --go_out=../../.. waypoint/builtin/canary/canary.proto
+
+// Percent returns the percentage of traffic that the released deployment
+// gets, from 1 to 100. A nil canary or a zero percentage gets all traffic.
+func (c *Canary) Percent() uint32 {
+	if c == nil || c.Percentage == 0 || c.Percentage > 100 {
+		return 100
+	}
+
+	return c.Percentage
+}
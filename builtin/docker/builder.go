@@ -16,6 +16,7 @@ import (
 	"github.com/docker/docker/pkg/idtools"
 	"github.com/docker/docker/pkg/jsonmessage"
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/hashicorp/go-argmapper"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/waypoint-plugin-sdk/component"
 	"github.com/hashicorp/waypoint-plugin-sdk/docs"
@@ -55,6 +56,9 @@ type BuilderConfig struct {
 
 	// Controls the passing of build context
 	Context string `hcl:"context,optional"`
+
+	// Build without a Docker daemon and push the image to a registry
+	Daemonless *DaemonlessConfig `hcl:"daemonless,block"`
 }
 
 func (b *Builder) Documentation() (*docs.Documentation, error) {
@@ -88,6 +92,21 @@ setting that is often not enabled by default. For GKE, you must not use Containe
 For AKS (Azure) and EKS (AWS), you must use a custom AMI that has user namespaces
 enabled. Please search for your distro how to enable user namespaces, it is
 usually a single line configuration.
+
+### Kaniko and BuildKit
+
+Runners in Kubernetes can instead build images with
+[kaniko](https://github.com/GoogleContainerTools/kaniko) or a
+[buildkitd](https://github.com/moby/buildkit) server, which don't need a
+Docker daemon, user namespaces or privileged containers. Select them with
+the "daemonless" block. Kaniko builds require the runner to run in a kaniko
+executor image, and BuildKit builds require "buildctl" and a buildkitd server
+the runner can reach.
+
+These builds push the image to the registry set in the "daemonless" block
+themselves, so the app must not have a "registry" stanza. The Waypoint
+entrypoint can't be injected into these images. To use features such as logs
+and exec, add the entrypoint in the Dockerfile.
 `)
 
 	doc.Example(`
@@ -97,6 +116,19 @@ build {
 	disable_entrypoint = false
   }
 }
+`)
+
+	doc.Example(`
+build {
+  use "docker" {
+    daemonless {
+      tool  = "kaniko"
+      image = "registry.example.com/my-app"
+      tag   = gitrefpretty()
+      cache = true
+    }
+  }
+}
 `)
 
 	doc.Output("docker.Image")
@@ -138,6 +170,73 @@ build {
 		"Build context path",
 	)
 
+	doc.SetField(
+		"daemonless",
+		"Build the image without a Docker daemon and push it to a registry",
+		docs.SubFields(func(doc *docs.SubFieldDoc) {
+			doc.SetField(
+				"tool",
+				"The tool to build the image with",
+				docs.Summary(
+					"This is either \"kaniko\" or \"buildkit\".",
+				),
+			)
+
+			doc.SetField(
+				"image",
+				"The image to push the built image to, fully qualified",
+			)
+
+			doc.SetField(
+				"tag",
+				"The tag for the built image",
+			)
+
+			doc.SetField(
+				"address",
+				"The address of the buildkitd server",
+				docs.Summary(
+					"For example, tcp://buildkitd:1234. Only used by BuildKit builds.",
+					"Defaults to the BUILDKIT_HOST environment variable.",
+				),
+			)
+
+			doc.SetField(
+				"encoded_auth",
+				"The authentication information to log into the registry",
+				docs.Summary(
+					"The format of this is base64-encoded JSON, like the \"encoded_auth\"",
+					"field of the \"docker\" registry.",
+				),
+			)
+
+			doc.SetField(
+				"cache",
+				"Cache the layers of the build in the registry",
+				docs.Summary(
+					"Kaniko builds keep the cache in IMAGE/cache, and BuildKit",
+					"builds in IMAGE:buildcache.",
+				),
+			)
+
+			doc.SetField(
+				"server_cache",
+				"Cache the layers of the build in the Waypoint server",
+				docs.Summary(
+					"Only BuildKit builds support this. The cache is stored in the",
+					"build cache of the application in the server, so builds on",
+					"ephemeral runners can reuse it without a registry for the cache.",
+					"This can't be combined with \"cache\".",
+				),
+			)
+
+			doc.SetField(
+				"insecure",
+				"Push over plain HTTP or to a registry with an untrusted certificate",
+			)
+		}),
+	)
+
 	return doc, nil
 }
 
@@ -146,13 +245,39 @@ func (b *Builder) Config() (interface{}, error) {
 	return &b.config, nil
 }
 
+// We use the struct form of arguments so that we can access named
+// values (such as "HasRegistry").
+type buildArgs struct {
+	argmapper.Struct
+
+	Ctx         context.Context
+	UI          terminal.UI
+	Src         *component.Source
+	Log         hclog.Logger
+	HasRegistry bool
+
+	// BuildCacheDir is a directory whose contents Waypoint stores in the
+	// server between builds.
+	BuildCacheDir string
+}
+
 // Build
-func (b *Builder) Build(
-	ctx context.Context,
-	ui terminal.UI,
-	src *component.Source,
-	log hclog.Logger,
-) (*Image, error) {
+func (b *Builder) Build(args buildArgs) (*Image, error) {
+	// Pull all the args out to top-level values. This is mostly done
+	// cause the struct was added later, but also because these are very common.
+	ctx := args.Ctx
+	ui := args.UI
+	src := args.Src
+	log := args.Log
+
+	// Daemonless builds push the image themselves, so there is nothing
+	// left for a registry to push.
+	if b.config.Daemonless != nil && args.HasRegistry {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"daemonless builds push the image to %q, remove the registry "+
+				"stanza from the app", b.config.Daemonless.Image)
+	}
+
 	sg := ui.StepGroup()
 	defer sg.Wait()
 	step := sg.Add("Initializing Docker client...")
@@ -215,6 +340,30 @@ func (b *Builder) Build(
 		"dockerfile", relDockerfile,
 	)
 
+	if b.config.Daemonless != nil {
+		step.Done()
+		step = nil
+
+		result.Image = b.config.Daemonless.Image
+		result.Tag = b.config.Daemonless.Tag
+		result.Location = &Image_Registry{Registry: &empty.Empty{}}
+		if err := b.buildDaemonless(
+			ctx, log, sg, contextDir, relDockerfile, result, b.config.BuildArgs,
+			args.BuildCacheDir,
+		); err != nil {
+			return nil, err
+		}
+
+		if !b.config.DisableCEB {
+			step = sg.Add("The Waypoint entrypoint can't be injected into daemonless builds")
+			step.Status(terminal.StatusWarn)
+			step.Done()
+			step = nil
+		}
+
+		return result, nil
+	}
+
 	// We now test if Docker is actually functional. We do this here because we
 	// need all of the above to complete the actual build.
 	log.Debug("testing if we should use a Docker fallback")
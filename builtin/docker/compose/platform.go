@@ -0,0 +1,576 @@
+package compose
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"github.com/docker/go-connections/nat"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	"github.com/hashicorp/waypoint-plugin-sdk/docs"
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/builtin/docker"
+	wpdockerclient "github.com/hashicorp/waypoint/builtin/docker/client"
+
+	sdk "github.com/hashicorp/waypoint-plugin-sdk/proto/gen"
+)
+
+const (
+	labelId        = "waypoint.hashicorp.com/id"
+	labelApp       = "waypoint.hashicorp.com/app"
+	labelWorkspace = "waypoint.hashicorp.com/workspace"
+	labelNetwork   = "waypoint.hashicorp.com/network"
+
+	// labelManaged marks the networks this plugin created, which it
+	// removes once they have no containers left.
+	labelManaged = "waypoint.hashicorp.com/compose"
+
+	// DefaultNetwork is the Docker network containers are connected to if
+	// no network is configured.
+	DefaultNetwork = "waypoint"
+
+	// defaultServicePort is the service port of images that don't expose
+	// any port.
+	defaultServicePort = 3000
+)
+
+// Platform deploys the apps of a project as containers to the local Docker
+// daemon. The containers of every app are connected to a shared network on
+// which each app is reachable by its name, like the services of a Docker
+// Compose project.
+type Platform struct {
+	config PlatformConfig
+}
+
+// PlatformConfig is the configuration structure for the Platform.
+type PlatformConfig struct {
+	// The Docker network to connect the container to. The network is
+	// created if it doesn't exist. Defaults to "waypoint".
+	Network string `hcl:"network,optional"`
+
+	// The port the app listens on in the container. Defaults to the
+	// lowest port the image exposes, or 3000.
+	ServicePort uint `hcl:"service_port,optional"`
+
+	// The port on the Docker host to publish the service port on.
+	// Defaults to a random port.
+	HostPort uint `hcl:"host_port,optional"`
+
+	// The command to run in the container instead of the image entrypoint.
+	Command []string `hcl:"command,optional"`
+
+	// A list of "source:destination" folders to mount into the container.
+	Binds []string `hcl:"binds,optional"`
+
+	// Environment variables that are meant to configure the application in
+	// a static way. Most configuration should use waypoint config.
+	StaticEnvVars map[string]string `hcl:"static_environment,optional"`
+}
+
+// Config implements Configurable
+func (p *Platform) Config() (interface{}, error) {
+	return &p.config, nil
+}
+
+// DeployFunc implements component.Platform
+func (p *Platform) DeployFunc() interface{} {
+	return p.Deploy
+}
+
+// DestroyFunc implements component.Destroyer
+func (p *Platform) DestroyFunc() interface{} {
+	return p.Destroy
+}
+
+// StatusFunc implements component.Status
+func (p *Platform) StatusFunc() interface{} {
+	return p.Status
+}
+
+// DefaultReleaserFunc implements component.PlatformReleaser
+func (p *Platform) DefaultReleaserFunc() interface{} {
+	return func() *Releaser { return &Releaser{} }
+}
+
+// Deploy starts a container for the image on the network of the project
+// and removes the containers of the previous deployments of the app.
+func (p *Platform) Deploy(
+	ctx context.Context,
+	log hclog.Logger,
+	src *component.Source,
+	job *component.JobInfo,
+	img *docker.Image,
+	deployConfig *component.DeploymentConfig,
+	ui terminal.UI,
+) (*Deployment, error) {
+	sg := ui.StepGroup()
+	defer sg.Wait()
+
+	id, err := component.Id()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Deployment{
+		Id:      id,
+		Name:    src.App,
+		Network: p.config.Network,
+	}
+	if result.Network == "" {
+		result.Network = DefaultNetwork
+	}
+
+	cli, err := wpdockerclient.NewClientWithOpts(client.FromEnv)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "unable to create Docker client: %s", err)
+	}
+	cli.NegotiateAPIVersion(ctx)
+
+	s := sg.Add("Setting up network %s...", result.Network)
+	defer func() { s.Abort() }()
+
+	if err := ensureNetwork(ctx, cli, result.Network); err != nil {
+		return nil, err
+	}
+	s.Done()
+
+	ref := img.Image + ":" + img.Tag
+	s = sg.Add("Inspecting image %s...", ref)
+	info, err := ensureImage(ctx, log, cli, s, ref)
+	if err != nil {
+		return nil, err
+	}
+	s.Done()
+
+	var exposed nat.PortSet
+	if info.Config != nil {
+		exposed = info.Config.ExposedPorts
+	}
+	port, err := servicePort(p.config.ServicePort, exposed)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every port the image exposes is published on a random host port so
+	// that the app can be reached from the host, like "docker run -P".
+	portBindings := nat.PortMap{}
+	exposedPorts := nat.PortSet{port: struct{}{}}
+	for ep := range exposed {
+		exposedPorts[ep] = struct{}{}
+	}
+	for ep := range exposedPorts {
+		portBindings[ep] = []nat.PortBinding{{HostPort: ""}}
+	}
+	if p.config.HostPort != 0 {
+		portBindings[port] = []nat.PortBinding{{HostPort: fmt.Sprint(p.config.HostPort)}}
+	}
+
+	cfg := container.Config{
+		Image:        ref,
+		ExposedPorts: exposedPorts,
+		Env:          []string{"PORT=" + port.Port()},
+		Labels: map[string]string{
+			labelId:        result.Id,
+			labelApp:       src.App,
+			labelWorkspace: job.Workspace,
+			labelNetwork:   result.Network,
+		},
+	}
+	if c := p.config.Command; len(c) > 0 {
+		cfg.Cmd = c
+	}
+	for k, v := range p.config.StaticEnvVars {
+		cfg.Env = append(cfg.Env, k+"="+v)
+	}
+	for k, v := range deployConfig.Env() {
+		cfg.Env = append(cfg.Env, k+"="+v)
+	}
+
+	hostconfig := container.HostConfig{
+		Binds:        p.config.Binds,
+		PortBindings: portBindings,
+	}
+
+	// The alias lets the other apps on the network reach this app by its
+	// name. Previous deployments are removed below, so only one container
+	// answers to the alias.
+	netconfig := network.NetworkingConfig{
+		EndpointsConfig: map[string]*network.EndpointSettings{
+			result.Network: {Aliases: []string{src.App}},
+		},
+	}
+
+	s = sg.Add("Creating container...")
+	name := src.App + "-" + result.Id
+	cr, err := cli.ContainerCreate(ctx, &cfg, &hostconfig, &netconfig, nil, name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to create Docker container: %s", err)
+	}
+	result.Container = cr.ID
+
+	// We remove the previous containers before we start the new one, since
+	// they would otherwise answer to the alias and may hold the host port.
+	prev, err := cli.ContainerList(ctx, types.ContainerListOptions{
+		All: true,
+		Filters: filters.NewArgs(
+			filters.Arg("label", labelApp+"="+src.App),
+			filters.Arg("label", labelWorkspace+"="+job.Workspace),
+			filters.Arg("label", labelNetwork+"="+result.Network),
+		),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to list Docker containers: %s", err)
+	}
+	for _, c := range prev {
+		if c.ID == cr.ID {
+			continue
+		}
+
+		s.Update("Removing previous container %s...", c.ID[:12])
+		log.Debug("removing previous container", "id", c.ID)
+		err := cli.ContainerRemove(ctx, c.ID, types.ContainerRemoveOptions{Force: true})
+		if err != nil && !client.IsErrNotFound(err) {
+			return nil, status.Errorf(codes.Internal, "unable to remove Docker container: %s", err)
+		}
+	}
+
+	s.Update("Starting container %s...", name)
+	if err := cli.ContainerStart(ctx, cr.ID, types.ContainerStartOptions{}); err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to start Docker container: %s", err)
+	}
+
+	inspect, err := cli.ContainerInspect(ctx, cr.ID)
+	if err != nil {
+		return nil, err
+	}
+	if inspect.NetworkSettings != nil {
+		result.Url = hostURL(inspect.NetworkSettings.Ports, port)
+	}
+
+	s.Update("App deployed as container %s, reachable as %q on network %s",
+		name, src.App, result.Network)
+	s.Done()
+
+	return result, nil
+}
+
+// Destroy removes the container of the deployment. If this leaves the
+// network without containers and the network was created by this plugin,
+// the network is removed too.
+func (p *Platform) Destroy(
+	ctx context.Context,
+	log hclog.Logger,
+	deployment *Deployment,
+	ui terminal.UI,
+) error {
+	sg := ui.StepGroup()
+	defer sg.Wait()
+
+	cli, err := wpdockerclient.NewClientWithOpts(client.FromEnv)
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "unable to create Docker client: %s", err)
+	}
+	cli.NegotiateAPIVersion(ctx)
+
+	s := sg.Add("Deleting container %s...", deployment.Container)
+	defer func() { s.Abort() }()
+
+	// The container is already gone if a later deployment replaced it.
+	err = cli.ContainerRemove(ctx, deployment.Container, types.ContainerRemoveOptions{Force: true})
+	if err != nil && !client.IsErrNotFound(err) {
+		return err
+	}
+	s.Done()
+
+	net, err := cli.NetworkInspect(ctx, deployment.Network, types.NetworkInspectOptions{})
+	if err != nil {
+		if client.IsErrNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+	if len(net.Containers) > 0 || net.Labels[labelManaged] != "true" {
+		return nil
+	}
+
+	s = sg.Add("Deleting network %s...", deployment.Network)
+	log.Debug("removing network without containers", "network", deployment.Network)
+	if err := cli.NetworkRemove(ctx, net.ID); err != nil && !client.IsErrNotFound(err) {
+		return err
+	}
+	s.Done()
+
+	return nil
+}
+
+// Status reports the health of the container of the deployment.
+func (p *Platform) Status(
+	ctx context.Context,
+	log hclog.Logger,
+	deployment *Deployment,
+	ui terminal.UI,
+) (*sdk.StatusReport, error) {
+	sg := ui.StepGroup()
+	defer sg.Wait()
+
+	s := sg.Add("Gathering health report for container...")
+	defer func() { s.Abort() }()
+
+	cli, err := wpdockerclient.NewClientWithOpts(client.FromEnv)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "unable to create Docker client: %s", err)
+	}
+	cli.NegotiateAPIVersion(ctx)
+
+	var result sdk.StatusReport
+	result.External = true
+
+	log.Debug("querying docker for container health")
+	info, err := cli.ContainerInspect(ctx, deployment.Container)
+	switch {
+	case client.IsErrNotFound(err):
+		result.Health = sdk.StatusReport_MISSING
+		result.HealthMessage = "container was removed"
+
+	case err != nil:
+		return nil, err
+
+	default:
+		result.Health, result.HealthMessage = containerHealth(info.State)
+		result.Resources = []*sdk.StatusReport_Resource{{
+			Name:          info.Name,
+			Health:        result.Health,
+			HealthMessage: result.HealthMessage,
+		}}
+	}
+
+	result.GeneratedTime = ptypes.TimestampNow()
+
+	s.Update(result.HealthMessage)
+	if result.Health != sdk.StatusReport_READY {
+		s.Status(terminal.StatusWarn)
+	}
+	s.Done()
+
+	return &result, nil
+}
+
+// ensureNetwork creates the network if it doesn't exist.
+func ensureNetwork(ctx context.Context, cli *client.Client, name string) error {
+	_, err := cli.NetworkInspect(ctx, name, types.NetworkInspectOptions{})
+	if err == nil {
+		return nil
+	}
+	if !client.IsErrNotFound(err) {
+		return status.Errorf(codes.FailedPrecondition, "unable to inspect Docker network: %s", err)
+	}
+
+	_, err = cli.NetworkCreate(ctx, name, types.NetworkCreate{
+		Driver:         "bridge",
+		CheckDuplicate: true,
+		Attachable:     true,
+		Labels: map[string]string{
+			"use":        "waypoint",
+			labelManaged: "true",
+		},
+	})
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "unable to create Docker network: %s", err)
+	}
+
+	return nil
+}
+
+// ensureImage returns the image from the Docker daemon and pulls it first
+// if the daemon doesn't have it, such as for images of the docker-pull
+// builder.
+func ensureImage(
+	ctx context.Context,
+	log hclog.Logger,
+	cli *client.Client,
+	s terminal.Step,
+	ref string,
+) (types.ImageInspect, error) {
+	info, _, err := cli.ImageInspectWithRaw(ctx, ref)
+	if err == nil || !client.IsErrNotFound(err) {
+		return info, err
+	}
+
+	s.Update("Pulling image %s...", ref)
+	log.Debug("pulling image", "image", ref)
+	out, err := cli.ImagePull(ctx, ref, types.ImagePullOptions{})
+	if err != nil {
+		return info, status.Errorf(codes.FailedPrecondition, "unable to pull image: %s", err)
+	}
+	defer out.Close()
+
+	if err := jsonmessage.DisplayJSONMessagesStream(out, s.TermOutput(), 0, false, nil); err != nil {
+		return info, status.Errorf(codes.FailedPrecondition, "unable to pull image: %s", err)
+	}
+
+	info, _, err = cli.ImageInspectWithRaw(ctx, ref)
+	return info, err
+}
+
+// servicePort returns the port the app listens on: the configured port,
+// the lowest TCP port the image exposes or the default port.
+func servicePort(configured uint, exposed nat.PortSet) (nat.Port, error) {
+	if configured != 0 {
+		return nat.NewPort("tcp", fmt.Sprint(configured))
+	}
+
+	var ports []nat.Port
+	for p := range exposed {
+		if p.Proto() == "tcp" {
+			ports = append(ports, p)
+		}
+	}
+	if len(ports) == 0 {
+		return nat.NewPort("tcp", fmt.Sprint(defaultServicePort))
+	}
+
+	sort.Slice(ports, func(i, j int) bool { return ports[i].Int() < ports[j].Int() })
+	return ports[0], nil
+}
+
+// hostURL returns the URL of the port on the Docker host, or an empty
+// string if the port isn't published.
+func hostURL(ports nat.PortMap, port nat.Port) string {
+	for _, b := range ports[port] {
+		if b.HostPort == "" {
+			continue
+		}
+
+		return "http://localhost:" + b.HostPort
+	}
+
+	return ""
+}
+
+// containerHealth translates the state of a container into a Waypoint
+// health status. Containers with a health check report its status.
+func containerHealth(state *types.ContainerState) (sdk.StatusReport_Health, string) {
+	switch {
+	case state == nil:
+		return sdk.StatusReport_UNKNOWN, "container state could not be determined"
+
+	case state.Running && state.Health != nil:
+		switch state.Health.Status {
+		case types.Healthy:
+			return sdk.StatusReport_READY, "container is healthy"
+		case types.Starting:
+			return sdk.StatusReport_ALIVE, "container is starting"
+		default:
+			return sdk.StatusReport_DOWN, "container is unhealthy"
+		}
+
+	case state.Running:
+		return sdk.StatusReport_READY, "container is running"
+
+	case state.Restarting || state.Status == "created":
+		return sdk.StatusReport_ALIVE, "container is starting"
+
+	default:
+		return sdk.StatusReport_DOWN, fmt.Sprintf(
+			"container exited with code %d", state.ExitCode)
+	}
+}
+
+func (p *Platform) Documentation() (*docs.Documentation, error) {
+	doc, err := docs.New(docs.FromConfig(&PlatformConfig{}), docs.FromFunc(p.DeployFunc()))
+	if err != nil {
+		return nil, err
+	}
+
+	doc.Description(`
+Deploy the apps of a project as containers to the local Docker daemon
+
+The containers of every app are connected to a shared Docker network, on
+which each app is reachable by its name, like the services of a Docker
+Compose project. Deploying an app replaces the container of its previous
+deployment. The port the app listens on is published on the Docker host.
+
+"waypoint up -local-compose" uses this plugin to deploy every app of a
+project without changing the waypoint.hcl.
+`)
+
+	doc.Example(`
+deploy {
+  use "docker-compose" {
+    network      = "my-project"
+    service_port = 8080
+    host_port    = 8080
+  }
+}
+`)
+
+	doc.Input("docker.Image")
+	doc.Output("compose.Deployment")
+
+	doc.SetField(
+		"network",
+		"the Docker network to connect the container to",
+		docs.Summary(
+			"the network is created if it doesn't exist. Apps on the same",
+			"network reach each other by the names of the apps",
+		),
+		docs.Default(DefaultNetwork),
+	)
+
+	doc.SetField(
+		"service_port",
+		"the port the app listens on in the container",
+		docs.Summary(
+			"the app gets this port in the PORT environment variable",
+		),
+		docs.Default("the lowest port the image exposes, or 3000"),
+	)
+
+	doc.SetField(
+		"host_port",
+		"the port on the Docker host to publish the service port on",
+		docs.Default("a random port"),
+	)
+
+	doc.SetField(
+		"command",
+		"the command to run to start the app in the container",
+		docs.Default("the image entrypoint"),
+	)
+
+	doc.SetField(
+		"binds",
+		"a 'source:destination' list of folders to mount into the container",
+	)
+
+	doc.SetField(
+		"static_environment",
+		"environment variables to expose to the app",
+		docs.Summary(
+			"most configuration should use waypoint config instead",
+		),
+	)
+
+	return doc, nil
+}
+
+var (
+	_ component.Platform         = (*Platform)(nil)
+	_ component.PlatformReleaser = (*Platform)(nil)
+	_ component.Configurable     = (*Platform)(nil)
+	_ component.Destroyer        = (*Platform)(nil)
+	_ component.Documented       = (*Platform)(nil)
+	_ component.Status           = (*Platform)(nil)
+)
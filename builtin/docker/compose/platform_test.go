@@ -0,0 +1,68 @@
+package compose
+
+import (
+	"testing"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServicePort(t *testing.T) {
+	cases := []struct {
+		Name       string
+		Configured uint
+		Exposed    nat.PortSet
+		Expected   nat.Port
+	}{
+		{
+			"configured port",
+			8080,
+			nat.PortSet{"5000/tcp": {}},
+			"8080/tcp",
+		},
+
+		{
+			"lowest exposed tcp port",
+			0,
+			nat.PortSet{"9000/tcp": {}, "53/udp": {}, "5000/tcp": {}},
+			"5000/tcp",
+		},
+
+		{
+			"default port",
+			0,
+			nat.PortSet{"53/udp": {}},
+			"3000/tcp",
+		},
+
+		{
+			"no exposed ports",
+			0,
+			nil,
+			"3000/tcp",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			port, err := servicePort(tt.Configured, tt.Exposed)
+			require.NoError(err)
+			require.Equal(tt.Expected, port)
+		})
+	}
+}
+
+func TestHostURL(t *testing.T) {
+	require := require.New(t)
+
+	ports := nat.PortMap{
+		"3000/tcp": {{HostIP: "0.0.0.0", HostPort: "49153"}},
+		"9000/tcp": nil,
+	}
+
+	require.Equal("http://localhost:49153", hostURL(ports, "3000/tcp"))
+	require.Equal("", hostURL(ports, "9000/tcp"))
+	require.Equal("", hostURL(ports, "8080/tcp"))
+}
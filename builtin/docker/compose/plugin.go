@@ -0,0 +1,13 @@
+package compose
+
+import (
+	"github.com/hashicorp/waypoint-plugin-sdk"
+)
+
+//go:generate protoc -I ../../../.. --go_opt=plugins=grpc --go_out=../../../.. waypoint/builtin/docker/compose/plugin.proto
+
+// Options are the SDK options to use for instantiation for
+// the Docker Compose plugin.
+var Options = []sdk.Option{
+	sdk.WithComponents(&Platform{}, &Releaser{}),
+}
@@ -0,0 +1,70 @@
+package compose
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	"github.com/hashicorp/waypoint-plugin-sdk/docs"
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+)
+
+// Releaser is the ReleaseManager implementation for Docker Compose. A
+// deployment replaces the container of the previous deployment, so there
+// is nothing to switch over and the release only reports the URL of the
+// app on the Docker host.
+type Releaser struct{}
+
+// ReleaseFunc implements component.ReleaseManager
+func (r *Releaser) ReleaseFunc() interface{} {
+	return r.Release
+}
+
+// Release returns the URL of the deployment on the Docker host.
+func (r *Releaser) Release(
+	ctx context.Context,
+	log hclog.Logger,
+	ui terminal.UI,
+	target *Deployment,
+) (*Release, error) {
+	sg := ui.StepGroup()
+	defer sg.Wait()
+
+	s := sg.Add("App %q is reachable on network %s", target.Name, target.Network)
+	if target.Url != "" {
+		s.Update("App %q is reachable on network %s and at %s",
+			target.Name, target.Network, target.Url)
+	}
+	s.Done()
+
+	return &Release{Url: target.Url}, nil
+}
+
+func (r *Releaser) Documentation() (*docs.Documentation, error) {
+	doc, err := docs.New(docs.FromFunc(r.ReleaseFunc()))
+	if err != nil {
+		return nil, err
+	}
+
+	doc.Description(`
+Report the URL of an app deployed with the docker-compose platform
+
+A deployment replaces the container of the previous deployment of the app,
+so a release doesn't change any traffic. The release URL is the service
+port of the app on the Docker host. This is the default releaser of the
+docker-compose platform.
+`)
+
+	doc.Input("compose.Deployment")
+	doc.Output("compose.Release")
+
+	return doc, nil
+}
+
+func (r *Release) URL() string { return r.Url }
+
+var (
+	_ component.ReleaseManager = (*Releaser)(nil)
+	_ component.Documented     = (*Releaser)(nil)
+	_ component.Release        = (*Release)(nil)
+)
@@ -0,0 +1,304 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// daemonlessKaniko builds with the kaniko executor.
+	// kaniko: https://github.com/GoogleContainerTools/kaniko
+	daemonlessKaniko = "kaniko"
+
+	// daemonlessBuildKit builds with buildctl against a buildkitd server.
+	// buildkit: https://github.com/moby/buildkit
+	daemonlessBuildKit = "buildkit"
+)
+
+// DaemonlessConfig configures builds that run without a Docker daemon and
+// push the image directly to a registry.
+type DaemonlessConfig struct {
+	// The tool to build with, either "kaniko" or "buildkit".
+	Tool string `hcl:"tool,attr"`
+
+	// The image and tag to push the built image to.
+	Image string `hcl:"image,attr"`
+	Tag   string `hcl:"tag,attr"`
+
+	// The address of the buildkitd server, such as "tcp://buildkitd:1234".
+	// Defaults to the BUILDKIT_HOST environment variable.
+	Address string `hcl:"address,optional"`
+
+	// The docker specific encoded authentication string to use to talk
+	// to the registry.
+	EncodedAuth string `hcl:"encoded_auth,optional"`
+
+	// Cache the layers of the build in the registry.
+	Cache bool `hcl:"cache,optional"`
+
+	// Cache the layers of the build in the build cache of the Waypoint
+	// server. Only BuildKit builds support this.
+	ServerCache bool `hcl:"server_cache,optional"`
+
+	// Push to a registry over plain HTTP or with an untrusted certificate.
+	Insecure bool `hcl:"insecure,optional"`
+}
+
+// kanikoPath returns the path to the kaniko executor. The executor is
+// named "executor" and lives in /kaniko in the official kaniko images.
+func kanikoPath() (string, error) {
+	if path, err := exec.LookPath("executor"); err == nil {
+		return path, nil
+	}
+
+	return exec.LookPath("/kaniko/executor")
+}
+
+func (b *Builder) buildDaemonless(
+	ctx context.Context,
+	log hclog.Logger,
+	sg terminal.StepGroup,
+	contextDir string,
+	relDockerfile string,
+	target *Image,
+	buildArgs map[string]*string,
+	cacheDir string,
+) error {
+	cfg := b.config.Daemonless
+	if cfg.ServerCache {
+		if cfg.Tool != daemonlessBuildKit {
+			return status.Errorf(codes.InvalidArgument,
+				"server_cache is only supported by BuildKit builds")
+		}
+		if cfg.Cache {
+			return status.Errorf(codes.InvalidArgument,
+				"only one of cache and server_cache can be set")
+		}
+	}
+
+	step := sg.Add("Preparing Docker configuration...")
+	defer func() {
+		if step != nil {
+			step.Abort()
+		}
+	}()
+
+	env := os.Environ()
+	if path, err := TempDockerConfig(log, target, cfg.EncodedAuth); err != nil {
+		return err
+	} else if path != "" {
+		defer os.RemoveAll(path)
+		env = append(env, "DOCKER_CONFIG="+path)
+	}
+
+	var args []string
+	var localCache buildkitLocalCache
+	switch cfg.Tool {
+	case daemonlessKaniko:
+		path, err := kanikoPath()
+		if err != nil {
+			return status.Errorf(codes.FailedPrecondition,
+				"the kaniko executor was not found: %s", err)
+		}
+
+		args = append([]string{path},
+			kanikoArgs(cfg, contextDir, relDockerfile, target, buildArgs)...)
+
+	case daemonlessBuildKit:
+		path, err := exec.LookPath("buildctl")
+		if err != nil {
+			return status.Errorf(codes.FailedPrecondition,
+				"buildctl was not found: %s", err)
+		}
+
+		if cfg.ServerCache && cacheDir != "" {
+			localCache, err = newBuildkitLocalCache(cacheDir)
+			if err != nil {
+				return err
+			}
+		}
+
+		args = append([]string{path},
+			buildctlArgs(cfg, contextDir, relDockerfile, target, buildArgs, localCache)...)
+
+	default:
+		return status.Errorf(codes.InvalidArgument,
+			"unknown daemonless build tool %q, must be %q or %q",
+			cfg.Tool, daemonlessKaniko, daemonlessBuildKit)
+	}
+
+	step.Done()
+	step = sg.Add("Building and pushing image with %s: %s", cfg.Tool, target.Name())
+
+	log.Debug("running daemonless build", "args", args)
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Dir = contextDir
+	cmd.Env = env
+
+	// Command output should go to the step
+	cmd.Stdout = step.TermOutput()
+	cmd.Stderr = cmd.Stdout
+
+	if err := cmd.Run(); err != nil {
+		return status.Errorf(codes.Internal,
+			"error building image with %s: %s", cfg.Tool, err)
+	}
+
+	if err := localCache.commit(); err != nil {
+		return err
+	}
+
+	step.Done()
+	step = nil
+	return nil
+}
+
+// buildkitLocalCache is the directories in the build cache directory that
+// BuildKit imports its cache from and exports it to. The cache is exported
+// to a new directory that replaces the old one after the build, since
+// BuildKit doesn't remove unused layers from an existing directory.
+type buildkitLocalCache struct {
+	Import string
+	Export string
+}
+
+// newBuildkitLocalCache returns the local cache directories in cacheDir.
+// Import is empty if there is no cache to import.
+func newBuildkitLocalCache(cacheDir string) (buildkitLocalCache, error) {
+	c := buildkitLocalCache{
+		Import: filepath.Join(cacheDir, "buildkit"),
+		Export: filepath.Join(cacheDir, "buildkit.new"),
+	}
+
+	// BuildKit fails to import a directory that doesn't contain a cache.
+	if _, err := os.Stat(filepath.Join(c.Import, "index.json")); err != nil {
+		c.Import = ""
+	}
+
+	if err := os.RemoveAll(c.Export); err != nil {
+		return c, err
+	}
+
+	return c, os.MkdirAll(cacheDir, 0755)
+}
+
+// commit replaces the imported cache with the exported one.
+func (c buildkitLocalCache) commit() error {
+	if c.Export == "" {
+		return nil
+	}
+
+	dir := filepath.Join(filepath.Dir(c.Export), "buildkit")
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+
+	return os.Rename(c.Export, dir)
+}
+
+// kanikoArgs returns the arguments to the kaniko executor to build the
+// Dockerfile in contextDir and push it to target.
+func kanikoArgs(
+	cfg *DaemonlessConfig,
+	contextDir string,
+	relDockerfile string,
+	target *Image,
+	buildArgs map[string]*string,
+) []string {
+	args := []string{
+		"--context", "dir://" + contextDir,
+		"--dockerfile", filepath.Join(contextDir, relDockerfile),
+		"--destination", target.Name(),
+	}
+	args = append(args, buildArgFlags("--build-arg", "", buildArgs)...)
+
+	if cfg.Cache {
+		args = append(args, "--cache=true", "--cache-repo", target.Image+"/cache")
+	}
+
+	if cfg.Insecure {
+		args = append(args, "--insecure", "--skip-tls-verify")
+	}
+
+	return args
+}
+
+// buildctlArgs returns the arguments to buildctl to build the Dockerfile
+// in contextDir and push it to target.
+func buildctlArgs(
+	cfg *DaemonlessConfig,
+	contextDir string,
+	relDockerfile string,
+	target *Image,
+	buildArgs map[string]*string,
+	localCache buildkitLocalCache,
+) []string {
+	var args []string
+	if cfg.Address != "" {
+		args = append(args, "--addr", cfg.Address)
+	}
+
+	dockerfile := filepath.Join(contextDir, relDockerfile)
+	args = append(args,
+		"build",
+		"--frontend", "dockerfile.v0",
+		"--local", "context="+contextDir,
+		"--local", "dockerfile="+filepath.Dir(dockerfile),
+		"--opt", "filename="+filepath.Base(dockerfile),
+	)
+	args = append(args, buildArgFlags("--opt", "build-arg:", buildArgs)...)
+
+	output := fmt.Sprintf("type=image,name=%s,push=true", target.Name())
+	if cfg.Insecure {
+		output += ",registry.insecure=true"
+	}
+	args = append(args, "--output", output)
+
+	if cfg.Cache {
+		cache := fmt.Sprintf("type=registry,ref=%s:buildcache", target.Image)
+		args = append(args,
+			"--export-cache", cache+",mode=max",
+			"--import-cache", cache,
+		)
+	}
+
+	if localCache.Export != "" {
+		args = append(args,
+			"--export-cache", "type=local,dest="+localCache.Export+",mode=max")
+	}
+	if localCache.Import != "" {
+		args = append(args,
+			"--import-cache", "type=local,src="+localCache.Import)
+	}
+
+	return args
+}
+
+// buildArgFlags returns the flags for every build arg, sorted by name so that
+// the arguments are stable.
+func buildArgFlags(flag, prefix string, buildArgs map[string]*string) []string {
+	keys := make([]string, 0, len(buildArgs))
+	for k, v := range buildArgs {
+		// v should always not be nil but guard just in case to avoid a panic
+		if v != nil {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var args []string
+	for _, k := range keys {
+		args = append(args, flag, prefix+k+"="+*buildArgs[k])
+	}
+
+	return args
+}
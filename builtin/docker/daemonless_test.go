@@ -0,0 +1,266 @@
+package docker
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKanikoArgs(t *testing.T) {
+	target := &Image{Image: "registry.example.com/app", Tag: "v1"}
+
+	cases := []struct {
+		Name      string
+		Config    DaemonlessConfig
+		BuildArgs map[string]*string
+		Expected  []string
+	}{
+		{
+			"defaults",
+			DaemonlessConfig{},
+			nil,
+			[]string{
+				"--context", "dir:///src",
+				"--dockerfile", "/src/Dockerfile",
+				"--destination", "registry.example.com/app:v1",
+			},
+		},
+
+		{
+			"build args",
+			DaemonlessConfig{},
+			map[string]*string{
+				"B": strPtr("2"),
+				"A": strPtr("1"),
+			},
+			[]string{
+				"--context", "dir:///src",
+				"--dockerfile", "/src/Dockerfile",
+				"--destination", "registry.example.com/app:v1",
+				"--build-arg", "A=1",
+				"--build-arg", "B=2",
+			},
+		},
+
+		{
+			"cache and insecure",
+			DaemonlessConfig{Cache: true, Insecure: true},
+			nil,
+			[]string{
+				"--context", "dir:///src",
+				"--dockerfile", "/src/Dockerfile",
+				"--destination", "registry.example.com/app:v1",
+				"--cache=true", "--cache-repo", "registry.example.com/app/cache",
+				"--insecure", "--skip-tls-verify",
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+			args := kanikoArgs(&tt.Config, "/src", "Dockerfile", target, tt.BuildArgs)
+			require.Equal(tt.Expected, args)
+		})
+	}
+}
+
+func TestBuildctlArgs(t *testing.T) {
+	target := &Image{Image: "registry.example.com/app", Tag: "v1"}
+
+	cases := []struct {
+		Name       string
+		Config     DaemonlessConfig
+		BuildArgs  map[string]*string
+		LocalCache buildkitLocalCache
+		Expected   []string
+	}{
+		{
+			"defaults",
+			DaemonlessConfig{},
+			nil,
+			buildkitLocalCache{},
+			[]string{
+				"build",
+				"--frontend", "dockerfile.v0",
+				"--local", "context=/src",
+				"--local", "dockerfile=/src/docker",
+				"--opt", "filename=Dockerfile.prod",
+				"--output", "type=image,name=registry.example.com/app:v1,push=true",
+			},
+		},
+
+		{
+			"address and build args",
+			DaemonlessConfig{Address: "tcp://buildkitd:1234"},
+			map[string]*string{"VERSION": strPtr("1.0")},
+			buildkitLocalCache{},
+			[]string{
+				"--addr", "tcp://buildkitd:1234",
+				"build",
+				"--frontend", "dockerfile.v0",
+				"--local", "context=/src",
+				"--local", "dockerfile=/src/docker",
+				"--opt", "filename=Dockerfile.prod",
+				"--opt", "build-arg:VERSION=1.0",
+				"--output", "type=image,name=registry.example.com/app:v1,push=true",
+			},
+		},
+
+		{
+			"cache and insecure",
+			DaemonlessConfig{Cache: true, Insecure: true},
+			nil,
+			buildkitLocalCache{},
+			[]string{
+				"build",
+				"--frontend", "dockerfile.v0",
+				"--local", "context=/src",
+				"--local", "dockerfile=/src/docker",
+				"--opt", "filename=Dockerfile.prod",
+				"--output", "type=image,name=registry.example.com/app:v1,push=true,registry.insecure=true",
+				"--export-cache", "type=registry,ref=registry.example.com/app:buildcache,mode=max",
+				"--import-cache", "type=registry,ref=registry.example.com/app:buildcache",
+			},
+		},
+
+		{
+			"server cache",
+			DaemonlessConfig{ServerCache: true},
+			nil,
+			buildkitLocalCache{
+				Import: "/cache/buildkit",
+				Export: "/cache/buildkit.new",
+			},
+			[]string{
+				"build",
+				"--frontend", "dockerfile.v0",
+				"--local", "context=/src",
+				"--local", "dockerfile=/src/docker",
+				"--opt", "filename=Dockerfile.prod",
+				"--output", "type=image,name=registry.example.com/app:v1,push=true",
+				"--export-cache", "type=local,dest=/cache/buildkit.new,mode=max",
+				"--import-cache", "type=local,src=/cache/buildkit",
+			},
+		},
+
+		{
+			"server cache without a cache to import",
+			DaemonlessConfig{ServerCache: true},
+			nil,
+			buildkitLocalCache{Export: "/cache/buildkit.new"},
+			[]string{
+				"build",
+				"--frontend", "dockerfile.v0",
+				"--local", "context=/src",
+				"--local", "dockerfile=/src/docker",
+				"--opt", "filename=Dockerfile.prod",
+				"--output", "type=image,name=registry.example.com/app:v1,push=true",
+				"--export-cache", "type=local,dest=/cache/buildkit.new,mode=max",
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+			args := buildctlArgs(&tt.Config, "/src", "docker/Dockerfile.prod",
+				target, tt.BuildArgs, tt.LocalCache)
+			require.Equal(tt.Expected, args)
+		})
+	}
+}
+
+func TestBuildkitLocalCache(t *testing.T) {
+	require := require.New(t)
+
+	td, err := ioutil.TempDir("", "docker")
+	require.NoError(err)
+	defer os.RemoveAll(td)
+	dir := filepath.Join(td, "cache")
+
+	// No cache to import yet
+	c, err := newBuildkitLocalCache(dir)
+	require.NoError(err)
+	require.Empty(c.Import)
+	require.Equal(filepath.Join(dir, "buildkit.new"), c.Export)
+
+	// Pretend BuildKit exported a cache
+	require.NoError(os.MkdirAll(c.Export, 0755))
+	require.NoError(ioutil.WriteFile(filepath.Join(c.Export, "index.json"), []byte("{}"), 0644))
+	require.NoError(c.commit())
+
+	// The exported cache is imported by the next build
+	c, err = newBuildkitLocalCache(dir)
+	require.NoError(err)
+	require.Equal(filepath.Join(dir, "buildkit"), c.Import)
+	_, err = os.Stat(c.Export)
+	require.True(os.IsNotExist(err))
+}
+
+func TestBuildArgFlags(t *testing.T) {
+	cases := []struct {
+		Name      string
+		Flag      string
+		Prefix    string
+		BuildArgs map[string]*string
+		Expected  []string
+	}{
+		{
+			"empty",
+			"--build-arg",
+			"",
+			nil,
+			nil,
+		},
+
+		{
+			"sorted",
+			"--build-arg",
+			"",
+			map[string]*string{
+				"C": strPtr("3"),
+				"A": strPtr("1"),
+				"B": strPtr("2"),
+			},
+			[]string{
+				"--build-arg", "A=1",
+				"--build-arg", "B=2",
+				"--build-arg", "C=3",
+			},
+		},
+
+		{
+			"nil values are skipped",
+			"--build-arg",
+			"",
+			map[string]*string{
+				"A": strPtr("1"),
+				"B": nil,
+			},
+			[]string{"--build-arg", "A=1"},
+		},
+
+		{
+			"prefix",
+			"--opt",
+			"build-arg:",
+			map[string]*string{"A": strPtr("")},
+			[]string{"--opt", "build-arg:A="},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tt.Expected, buildArgFlags(tt.Flag, tt.Prefix, tt.BuildArgs))
+		})
+	}
+}
+
+func strPtr(v string) *string {
+	return &v
+}
@@ -44,9 +44,21 @@ func (d *Deployment) apiRevisionName() string {
 
 // apiService returns the API service for GCP client usage.
 func (d *Deployment) apiService(ctx context.Context) (*run.APIService, error) {
-	result, err := run.NewService(ctx,
-		option.WithEndpoint("https://"+d.Resource.Location+"-run.googleapis.com"),
-	)
+	return locationAPIService(ctx, d.Resource.Location)
+}
+
+// locationAPIOptions returns the client options for the regional endpoint
+// of the location. Tests replace this to use a fake API.
+var locationAPIOptions = func(location string) []option.ClientOption {
+	return []option.ClientOption{
+		option.WithEndpoint("https://" + location + "-run.googleapis.com"),
+	}
+}
+
+// locationAPIService returns the API service for the regional endpoint of
+// the location.
+func locationAPIService(ctx context.Context, location string) (*run.APIService, error) {
+	result, err := run.NewService(ctx, locationAPIOptions(location)...)
 	if err != nil {
 		return nil, status.Errorf(codes.Aborted, err.Error())
 	}
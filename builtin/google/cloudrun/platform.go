@@ -48,6 +48,11 @@ func (p *Platform) DeployFunc() interface{} {
 	return p.Deploy
 }
 
+// StatusFunc implements component.Status
+func (p *Platform) StatusFunc() interface{} {
+	return p.Status
+}
+
 // DestroyFunc implements component.Destroyer
 func (p *Platform) DestroyFunc() interface{} {
 	return p.Destroy
@@ -668,4 +673,5 @@ type VPCAccess struct {
 var (
 	_ component.Platform     = (*Platform)(nil)
 	_ component.Configurable = (*Platform)(nil)
+	_ component.Status       = (*Platform)(nil)
 )
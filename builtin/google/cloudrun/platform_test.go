@@ -39,6 +39,7 @@ func TestRelease(t *testing.T) {
 		log,
 		ui,
 		d,
+		nil,
 	)
 
 	require.NoError(t, err)
@@ -105,6 +105,10 @@ type Release struct {
 	unknownFields protoimpl.UnknownFields
 
 	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The service of the release, used for status reports
+	Resource *Deployment_Resource `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	// The traffic split of the service after the release
+	Traffic []*Release_Traffic `protobuf:"bytes,3,rep,name=traffic,proto3" json:"traffic,omitempty"`
 }
 
 func (x *Release) Reset() {
@@ -146,6 +150,20 @@ func (x *Release) GetUrl() string {
 	return ""
 }
 
+func (x *Release) GetResource() *Deployment_Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *Release) GetTraffic() []*Release_Traffic {
+	if x != nil {
+		return x.Traffic
+	}
+	return nil
+}
+
 type Deployment_Resource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -209,6 +227,61 @@ func (x *Deployment_Resource) GetName() string {
 	return ""
 }
 
+type Release_Traffic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RevisionId string `protobuf:"bytes,1,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	Percent    int64  `protobuf:"varint,2,opt,name=percent,proto3" json:"percent,omitempty"`
+}
+
+func (x *Release_Traffic) Reset() {
+	*x = Release_Traffic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_waypoint_builtin_google_cloudrun_plugin_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Release_Traffic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Release_Traffic) ProtoMessage() {}
+
+func (x *Release_Traffic) ProtoReflect() protoreflect.Message {
+	mi := &file_waypoint_builtin_google_cloudrun_plugin_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Release_Traffic.ProtoReflect.Descriptor instead.
+func (*Release_Traffic) Descriptor() ([]byte, []int) {
+	return file_waypoint_builtin_google_cloudrun_plugin_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Release_Traffic) GetRevisionId() string {
+	if x != nil {
+		return x.RevisionId
+	}
+	return ""
+}
+
+func (x *Release_Traffic) GetPercent() int64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
 var File_waypoint_builtin_google_cloudrun_plugin_proto protoreflect.FileDescriptor
 
 var file_waypoint_builtin_google_cloudrun_plugin_proto_rawDesc = []byte{
@@ -232,11 +305,23 @@ var file_waypoint_builtin_google_cloudrun_plugin_proto_rawDesc = []byte{
 	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x12,
 	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x1b, 0x0a, 0x07, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x42,
-	0x22, 0x5a, 0x20, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2f, 0x62, 0x75, 0x69, 0x6c,
-	0x74, 0x69, 0x6e, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64,
-	0x72, 0x75, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x65, 0x22, 0xdf, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
+	0x12, 0x40, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x72, 0x75, 0x6e, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x72, 0x75, 0x6e, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2e, 0x54, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x07, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x1a, 0x44,
+	0x0a, 0x07, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x42, 0x22, 0x5a, 0x20, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x2f, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x72, 0x75, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -251,19 +336,22 @@ func file_waypoint_builtin_google_cloudrun_plugin_proto_rawDescGZIP() []byte {
 	return file_waypoint_builtin_google_cloudrun_plugin_proto_rawDescData
 }
 
-var file_waypoint_builtin_google_cloudrun_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_waypoint_builtin_google_cloudrun_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_waypoint_builtin_google_cloudrun_plugin_proto_goTypes = []interface{}{
 	(*Deployment)(nil),          // 0: google.cloudrun.Deployment
 	(*Release)(nil),             // 1: google.cloudrun.Release
 	(*Deployment_Resource)(nil), // 2: google.cloudrun.Deployment.Resource
+	(*Release_Traffic)(nil),     // 3: google.cloudrun.Release.Traffic
 }
 var file_waypoint_builtin_google_cloudrun_plugin_proto_depIdxs = []int32{
 	2, // 0: google.cloudrun.Deployment.resource:type_name -> google.cloudrun.Deployment.Resource
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 1: google.cloudrun.Release.resource:type_name -> google.cloudrun.Deployment.Resource
+	3, // 2: google.cloudrun.Release.traffic:type_name -> google.cloudrun.Release.Traffic
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_waypoint_builtin_google_cloudrun_plugin_proto_init() }
@@ -308,6 +396,18 @@ func file_waypoint_builtin_google_cloudrun_plugin_proto_init() {
 				return nil
 			}
 		}
+		file_waypoint_builtin_google_cloudrun_plugin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Release_Traffic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -315,7 +415,7 @@ func file_waypoint_builtin_google_cloudrun_plugin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_waypoint_builtin_google_cloudrun_plugin_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
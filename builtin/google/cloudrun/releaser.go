@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
+	"sort"
 
 	"github.com/hashicorp/go-hclog"
 	run "google.golang.org/api/run/v1"
@@ -13,6 +15,8 @@ import (
 	"github.com/hashicorp/waypoint-plugin-sdk/component"
 	"github.com/hashicorp/waypoint-plugin-sdk/docs"
 	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+
+	"github.com/hashicorp/waypoint/builtin/canary"
 )
 
 // Releaser is the ReleaseManager implementation for Google Cloud Run.
@@ -30,12 +34,21 @@ func (r *Releaser) ReleaseFunc() interface{} {
 	return r.Release
 }
 
-// Release deploys an image to GCR.
+// StatusFunc implements component.Status
+func (r *Releaser) StatusFunc() interface{} {
+	return r.Status
+}
+
+// Release routes the traffic of the service to the revision of the
+// deployment. A canary release only routes the canary percentage of the
+// traffic to it. If the service doesn't get ready with the new traffic
+// split, the traffic is rolled back to the previous split.
 func (r *Releaser) Release(
 	ctx context.Context,
 	log hclog.Logger,
 	ui terminal.UI,
 	target *Deployment,
+	c *canary.Canary,
 ) (*Release, error) {
 	// Get the API service
 	apiService, err := target.apiService(ctx)
@@ -55,14 +68,29 @@ func (r *Releaser) Release(
 		return nil, status.Errorf(codes.Aborted, "Unable to fetch service information from Google Cloud: %s", err.Error())
 	}
 
-	// Update the service with the traffic info. This code is laid out this
-	// way to make it more trivial in the future to add traffic splitting.
-	service.Spec.Traffic = nil
-	log.Debug("Setting traffic target", "revision", target.RevisionId, "percent", 100)
-	service.Spec.Traffic = append(service.Spec.Traffic, &run.TrafficTarget{
-		RevisionName: target.RevisionId,
-		Percent:      100,
-	})
+	percent := int64(c.Percent())
+
+	// Keep the current traffic split so that we can roll back to it. A
+	// target of the latest revision is resolved to that revision, since
+	// the latest revision changes with every deployment.
+	previous := service.Spec.Traffic
+	var current []*run.TrafficTarget
+	for _, t := range previous {
+		t := *t
+		if t.LatestRevision {
+			t.LatestRevision = false
+			t.ConfigurationName = ""
+			t.RevisionName = service.Status.LatestReadyRevisionName
+		}
+
+		current = append(current, &t)
+	}
+
+	// Update the service with the traffic info.
+	service.Spec.Traffic = splitTraffic(current, target.RevisionId, percent)
+	for _, t := range service.Spec.Traffic {
+		log.Debug("Setting traffic target", "revision", t.RevisionName, "percent", t.Percent)
+	}
 
 	// Replace the service
 	st.Update("Deploying routing changes")
@@ -80,7 +108,15 @@ func (r *Releaser) Release(
 	st.Update("Waiting for revision to be ready")
 	service, err = target.pollServiceReady(ctx, log)
 	if err != nil {
-		return nil, status.Errorf(codes.Aborted, "Timeout waiting for revision to be ready: %s", err)
+		st.Step(terminal.StatusError, "Revision failed to get ready, rolling back traffic")
+		if rerr := r.rollback(ctx, client, target, previous); rerr != nil {
+			return nil, status.Errorf(codes.Aborted,
+				"Revision failed to get ready: %s. Rolling back traffic failed: %s", err, rerr)
+		}
+
+		st.Step(terminal.StatusWarn, "Rolled back traffic to the previous revisions")
+		return nil, status.Errorf(codes.Aborted,
+			"Revision failed to get ready, traffic was rolled back: %s", err)
 	}
 
 	// If we have tracing enabled we just dump the full service as we know it
@@ -93,9 +129,94 @@ func (r *Releaser) Release(
 	// Note: it is not possible to add custom domain mappings as there are a number of steps
 	// such as adding the DNS record which is out of control of Waypoint
 
-	return &Release{
-		Url: service.Status.Url,
-	}, nil
+	result := &Release{
+		Url:      service.Status.Url,
+		Resource: target.Resource,
+	}
+	for _, t := range service.Spec.Traffic {
+		result.Traffic = append(result.Traffic, &Release_Traffic{
+			RevisionId: t.RevisionName,
+			Percent:    t.Percent,
+		})
+		st.Step(terminal.StatusOK, fmt.Sprintf(
+			"Revision %s receives %d%% of the traffic", t.RevisionName, t.Percent))
+	}
+
+	return result, nil
+}
+
+// rollback restores the traffic split of the service.
+func (r *Releaser) rollback(
+	ctx context.Context,
+	client *run.NamespacesServicesService,
+	target *Deployment,
+	traffic []*run.TrafficTarget,
+) error {
+	service, err := client.Get(target.apiName()).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	service.Spec.Traffic = traffic
+	_, err = client.ReplaceService(target.apiName(), service).Context(ctx).Do()
+	return err
+}
+
+// splitTraffic returns the traffic targets that route percent of the
+// traffic to the revision. The remaining traffic is split between the other
+// revisions that serve traffic now, in proportion to the traffic they serve.
+func splitTraffic(current []*run.TrafficTarget, revision string, percent int64) []*run.TrafficTarget {
+	result := []*run.TrafficTarget{{RevisionName: revision, Percent: percent}}
+
+	// Sum the traffic of the other revisions. A revision can have multiple
+	// targets, for example with different tags.
+	var others []string
+	traffic := map[string]int64{}
+	var total int64
+	for _, t := range current {
+		if t.RevisionName == "" || t.RevisionName == revision || t.Percent == 0 {
+			continue
+		}
+
+		if _, ok := traffic[t.RevisionName]; !ok {
+			others = append(others, t.RevisionName)
+		}
+		traffic[t.RevisionName] += t.Percent
+		total += t.Percent
+	}
+
+	// If no other revision serves traffic, the revision gets all of it.
+	if percent >= 100 || total == 0 {
+		result[0].Percent = 100
+		return result
+	}
+
+	// Revisions that serve more traffic come first, so they get the
+	// traffic left over from rounding.
+	sort.SliceStable(others, func(i, j int) bool {
+		return traffic[others[i]] > traffic[others[j]]
+	})
+
+	remaining := 100 - percent
+	left := remaining
+	split := make([]*run.TrafficTarget, len(others))
+	for i, name := range others {
+		p := traffic[name] * remaining / total
+		left -= p
+		split[i] = &run.TrafficTarget{RevisionName: name, Percent: p}
+	}
+	for i := 0; left > 0; i++ {
+		split[i%len(split)].Percent++
+		left--
+	}
+
+	for _, t := range split {
+		if t.Percent > 0 {
+			result = append(result, t)
+		}
+	}
+
+	return result
 }
 
 // setNoAuthPolicy sets the IAM policy on the deployment so that anyone
@@ -127,12 +248,33 @@ func (r *Releaser) setNoAuthPolicy(
 type ReleaserConfig struct{}
 
 func (r *Releaser) Documentation() (*docs.Documentation, error) {
-	doc, err := docs.New(docs.FromConfig(&ReleaserConfig{}))
+	doc, err := docs.New(docs.FromConfig(&ReleaserConfig{}), docs.FromFunc(r.ReleaseFunc()))
 	if err != nil {
 		return nil, err
 	}
 
-	doc.Description("Manipulates the Cloud Run APIs to make deployments active")
+	doc.Description(`
+Manipulates the Cloud Run APIs to make deployments active
+
+A release routes all traffic of the service to the revision of the
+deployment. A canary release, such as with "waypoint release -percentage",
+only routes that percentage of the traffic to the revision, and the
+revisions that serve traffic now share the rest in proportion to the
+traffic they serve. Promoting the canary routes all traffic to the revision
+and aborting it routes all traffic back to the previous revision.
+
+If the service doesn't get ready with the new traffic split, the release
+rolls the traffic back to the previous split and fails.
+`)
+
+	doc.Example(`
+release {
+  use "google-cloud-run" {}
+}
+`)
+
+	doc.Input("cloudrun.Deployment")
+	doc.Output("cloudrun.Release")
 
 	return doc, nil
 }
@@ -142,5 +284,6 @@ func (r *Release) URL() string { return r.Url }
 var (
 	_ component.ReleaseManager = (*Releaser)(nil)
 	_ component.Configurable   = (*Releaser)(nil)
+	_ component.Status         = (*Releaser)(nil)
 	_ component.Release        = (*Release)(nil)
 )
@@ -0,0 +1,258 @@
+package cloudrun
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+	run "google.golang.org/api/run/v1"
+
+	"github.com/hashicorp/waypoint/builtin/canary"
+)
+
+func TestSplitTraffic(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Current  []*run.TrafficTarget
+		Percent  int64
+		Expected map[string]int64
+	}{
+		{
+			"all traffic",
+			[]*run.TrafficTarget{{RevisionName: "a", Percent: 100}},
+			100,
+			map[string]int64{"new": 100},
+		},
+		{
+			"no other revisions",
+			nil,
+			20,
+			map[string]int64{"new": 100},
+		},
+		{
+			"split with one revision",
+			[]*run.TrafficTarget{{RevisionName: "a", Percent: 100}},
+			20,
+			map[string]int64{"new": 20, "a": 80},
+		},
+		{
+			"split in proportion",
+			[]*run.TrafficTarget{
+				{RevisionName: "a", Percent: 50},
+				{RevisionName: "b", Percent: 25},
+				{RevisionName: "c", Percent: 25},
+			},
+			50,
+			map[string]int64{"new": 50, "a": 26, "b": 12, "c": 12},
+		},
+		{
+			"increase the traffic of the revision",
+			[]*run.TrafficTarget{
+				{RevisionName: "a", Percent: 90},
+				{RevisionName: "new", Percent: 10},
+			},
+			50,
+			map[string]int64{"new": 50, "a": 50},
+		},
+		{
+			"targets of the same revision",
+			[]*run.TrafficTarget{
+				{RevisionName: "a", Percent: 60},
+				{RevisionName: "a", Percent: 40, Tag: "old"},
+				{RevisionName: "b", Percent: 0},
+			},
+			10,
+			map[string]int64{"new": 10, "a": 90},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			result := splitTraffic(tt.Current, "new", tt.Percent)
+
+			actual := map[string]int64{}
+			var total int64
+			for _, target := range result {
+				actual[target.RevisionName] += target.Percent
+				total += target.Percent
+			}
+			require.Equal(t, tt.Expected, actual)
+			require.EqualValues(t, 100, total)
+		})
+	}
+}
+
+func TestReleaserRelease(t *testing.T) {
+	deployment := func(revision string) *Deployment {
+		return &Deployment{
+			Resource: &Deployment_Resource{
+				Project:  "project",
+				Location: "us-central1",
+				Name:     "app",
+			},
+			RevisionId: revision,
+		}
+	}
+
+	cases := []struct {
+		Name     string
+		Current  []*run.TrafficTarget
+		Target   string
+		Canary   *canary.Canary
+		Ready    string
+		Expected map[string]int64
+		Error    string
+	}{
+		{
+			"full release",
+			[]*run.TrafficTarget{{RevisionName: "a", Percent: 100}},
+			"b",
+			&canary.Canary{},
+			"True",
+			map[string]int64{"b": 100},
+			"",
+		},
+		{
+			"canary",
+			[]*run.TrafficTarget{{LatestRevision: true, Percent: 100}},
+			"b",
+			&canary.Canary{Percentage: 10},
+			"True",
+			map[string]int64{"a": 90, "b": 10},
+			"",
+		},
+		{
+			"promote",
+			[]*run.TrafficTarget{
+				{RevisionName: "a", Percent: 90},
+				{RevisionName: "b", Percent: 10},
+			},
+			"b",
+			&canary.Canary{},
+			"True",
+			map[string]int64{"b": 100},
+			"",
+		},
+		{
+			"abort",
+			[]*run.TrafficTarget{
+				{RevisionName: "a", Percent: 90},
+				{RevisionName: "b", Percent: 10},
+			},
+			"a",
+			&canary.Canary{},
+			"True",
+			map[string]int64{"a": 100},
+			"",
+		},
+		{
+			"rolled back when not ready",
+			[]*run.TrafficTarget{{RevisionName: "a", Percent: 100}},
+			"b",
+			&canary.Canary{Percentage: 10},
+			"False",
+			map[string]int64{"a": 100},
+			"traffic was rolled back",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			api := &testRunAPI{traffic: tt.Current, ready: tt.Ready}
+			srv := httptest.NewServer(api)
+			defer srv.Close()
+
+			old := locationAPIOptions
+			defer func() { locationAPIOptions = old }()
+			locationAPIOptions = func(string) []option.ClientOption {
+				return []option.ClientOption{
+					option.WithEndpoint(srv.URL + "/"),
+					option.WithoutAuthentication(),
+				}
+			}
+
+			r := &Releaser{}
+			result, err := r.Release(
+				context.Background(),
+				hclog.L(),
+				&StubbedUI{hclog.L()},
+				deployment(tt.Target),
+				tt.Canary,
+			)
+
+			actual := map[string]int64{}
+			for _, target := range api.traffic {
+				actual[target.RevisionName] += target.Percent
+			}
+			require.Equal(tt.Expected, actual)
+
+			if tt.Error != "" {
+				require.Error(err)
+				require.Contains(err.Error(), tt.Error)
+				return
+			}
+			require.NoError(err)
+
+			released := map[string]int64{}
+			for _, traffic := range result.Traffic {
+				released[traffic.RevisionId] += traffic.Percent
+			}
+			require.Equal(tt.Expected, released)
+		})
+	}
+}
+
+// testRunAPI is a fake Cloud Run API with one service whose latest ready
+// revision is "a". Replacing the service sets its traffic and its Ready
+// condition to ready.
+type testRunAPI struct {
+	sync.Mutex
+
+	traffic []*run.TrafficTarget
+	ready   string
+}
+
+func (a *testRunAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.Lock()
+	defer a.Unlock()
+
+	switch {
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, ":setIamPolicy"):
+		json.NewEncoder(w).Encode(&run.Policy{})
+		return
+
+	case r.Method == http.MethodPut:
+		var service run.Service
+		if err := json.NewDecoder(r.Body).Decode(&service); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		a.traffic = service.Spec.Traffic
+
+	case r.Method != http.MethodGet:
+		http.NotFound(w, r)
+		return
+	}
+
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"traffic": a.traffic,
+		},
+		"status": map[string]interface{}{
+			"url":                     "https://app.run.app",
+			"latestReadyRevisionName": "a",
+			"conditions": []map[string]string{
+				{"type": "Ready", "status": a.ready},
+			},
+		},
+	})
+}
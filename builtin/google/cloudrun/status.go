@@ -0,0 +1,210 @@
+package cloudrun
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-hclog"
+	run "google.golang.org/api/run/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+
+	sdk "github.com/hashicorp/waypoint-plugin-sdk/proto/gen"
+)
+
+// Status reports the health of the revision of the deployment from its
+// conditions.
+func (p *Platform) Status(
+	ctx context.Context,
+	log hclog.Logger,
+	deployment *Deployment,
+	ui terminal.UI,
+) (*sdk.StatusReport, error) {
+	sg := ui.StepGroup()
+	defer sg.Wait()
+
+	s := sg.Add("Gathering health report for Cloud Run revision %s...", deployment.RevisionId)
+	defer func() { s.Abort() }()
+
+	apiService, err := deployment.apiService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("querying cloud run for revision health", "revision", deployment.RevisionId)
+	rev, err := run.NewNamespacesRevisionsService(apiService).
+		Get(deployment.apiRevisionName()).Context(ctx).Do()
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted,
+			"Unable to fetch revision information from Google Cloud: %s", err)
+	}
+
+	var result sdk.StatusReport
+	result.External = true
+	result.Health, result.HealthMessage = revisionHealth(rev)
+
+	// Every condition of the revision is a resource of the report, so that
+	// the report shows why a revision isn't ready.
+	if rev.Status != nil {
+		for _, cond := range rev.Status.Conditions {
+			msg := cond.Message
+			if msg == "" {
+				msg = fmt.Sprintf("%s is %s", cond.Type, cond.Status)
+			}
+
+			result.Resources = append(result.Resources, &sdk.StatusReport_Resource{
+				Name:          cond.Type,
+				Health:        conditionHealth(cond.Type, cond.Status),
+				HealthMessage: msg,
+			})
+		}
+	}
+
+	result.GeneratedTime = ptypes.TimestampNow()
+
+	statusStep(s, &result)
+	return &result, nil
+}
+
+// Status reports the health of the revisions that serve traffic after the
+// release.
+func (r *Releaser) Status(
+	ctx context.Context,
+	log hclog.Logger,
+	release *Release,
+	ui terminal.UI,
+) (*sdk.StatusReport, error) {
+	sg := ui.StepGroup()
+	defer sg.Wait()
+
+	s := sg.Add("Gathering health report for Cloud Run release...")
+	defer func() { s.Abort() }()
+
+	var result sdk.StatusReport
+	result.External = true
+
+	// Releases from before traffic splitting don't know their service.
+	if release.Resource == nil {
+		result.Health = sdk.StatusReport_UNKNOWN
+		result.HealthMessage = "release has no service information"
+		result.GeneratedTime = ptypes.TimestampNow()
+		statusStep(s, &result)
+		return &result, nil
+	}
+
+	apiService, err := locationAPIService(ctx, release.Resource.Location)
+	if err != nil {
+		return nil, err
+	}
+	client := run.NewNamespacesRevisionsService(apiService)
+
+	var ready, down int
+	for _, t := range release.Traffic {
+		log.Debug("querying cloud run for revision health", "revision", t.RevisionId)
+		rev, err := client.Get(fmt.Sprintf("namespaces/%s/revisions/%s",
+			release.Resource.Project, t.RevisionId)).Context(ctx).Do()
+		if err != nil {
+			return nil, status.Errorf(codes.Aborted,
+				"Unable to fetch revision information from Google Cloud: %s", err)
+		}
+
+		health, msg := revisionHealth(rev)
+		switch health {
+		case sdk.StatusReport_READY:
+			ready++
+		case sdk.StatusReport_DOWN:
+			down++
+		}
+
+		result.Resources = append(result.Resources, &sdk.StatusReport_Resource{
+			Name:          fmt.Sprintf("%s (%d%%)", t.RevisionId, t.Percent),
+			Health:        health,
+			HealthMessage: msg,
+		})
+	}
+
+	switch {
+	case ready == len(release.Traffic):
+		result.Health = sdk.StatusReport_READY
+		result.HealthMessage = "all revisions that serve traffic are ready"
+	case down == len(release.Traffic):
+		result.Health = sdk.StatusReport_DOWN
+		result.HealthMessage = "all revisions that serve traffic are down"
+	default:
+		result.Health = sdk.StatusReport_PARTIAL
+		result.HealthMessage = fmt.Sprintf(
+			"%d of %d revisions that serve traffic are ready", ready, len(release.Traffic))
+	}
+
+	result.GeneratedTime = ptypes.TimestampNow()
+
+	statusStep(s, &result)
+	return &result, nil
+}
+
+// revisionHealth translates the conditions of a revision into a Waypoint
+// health status. The Ready condition summarizes the other conditions.
+func revisionHealth(rev *run.Revision) (sdk.StatusReport_Health, string) {
+	if rev.Status != nil {
+		for _, cond := range rev.Status.Conditions {
+			if cond.Type != "Ready" {
+				continue
+			}
+
+			msg := cond.Message
+			switch cond.Status {
+			case "True":
+				msg = "revision is ready"
+			case "False":
+				if msg == "" {
+					msg = "revision failed to get ready"
+				}
+			default:
+				if msg == "" {
+					msg = "revision is getting ready"
+				}
+			}
+
+			return conditionHealth(cond.Type, cond.Status), msg
+		}
+	}
+
+	return sdk.StatusReport_UNKNOWN, "revision health could not be determined"
+}
+
+// conditionHealth translates the status of a condition into a Waypoint
+// health status.
+func conditionHealth(typ, condStatus string) sdk.StatusReport_Health {
+	switch condStatus {
+	case "True":
+		return sdk.StatusReport_READY
+	case "False":
+		// Revisions that don't serve traffic are scaled down and reported
+		// inactive, which isn't a problem with the revision.
+		if typ == "Active" {
+			return sdk.StatusReport_ALIVE
+		}
+
+		return sdk.StatusReport_DOWN
+	case "Unknown":
+		return sdk.StatusReport_ALIVE
+	default:
+		return sdk.StatusReport_UNKNOWN
+	}
+}
+
+// statusStep completes the step with the overall health of the report.
+func statusStep(s terminal.Step, result *sdk.StatusReport) {
+	s.Update(result.HealthMessage)
+	switch result.Health {
+	case sdk.StatusReport_READY:
+	case sdk.StatusReport_ALIVE, sdk.StatusReport_PARTIAL:
+		s.Status(terminal.StatusWarn)
+	default:
+		s.Status(terminal.StatusError)
+	}
+	s.Done()
+}
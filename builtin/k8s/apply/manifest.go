@@ -0,0 +1,172 @@
+package apply
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// readManifests reads the YAML and JSON files at path, recursively if it is
+// a directory, into a single stream of documents. This matches the files
+// "kubectl apply -R -f" reads.
+func readManifests(path string) (string, error) {
+	var docs []string
+	err := filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		switch filepath.Ext(p) {
+		case ".yaml", ".yml", ".json":
+		default:
+			// kubectl only skips files with other extensions in
+			// directories, a single file is always read.
+			if p != path {
+				return nil
+			}
+		}
+
+		bs, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+
+		docs = append(docs, string(bs))
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(docs, "\n---\n"), nil
+}
+
+// replaceImages replaces the images of the containers in the manifests.
+// images maps image names, without a tag or digest, to the image reference
+// that replaces them.
+func replaceImages(manifests string, images map[string]string) (string, error) {
+	dec := k8syaml.NewYAMLOrJSONDecoder(strings.NewReader(manifests), 4096)
+
+	var docs []string
+	for {
+		var obj map[string]interface{}
+		if err := dec.Decode(&obj); err != nil {
+			if err == io.EOF {
+				break
+			}
+
+			return "", err
+		}
+
+		// Skip empty documents
+		if len(obj) == 0 {
+			continue
+		}
+
+		replaceContainerImages(obj, images)
+
+		bs, err := yaml.Marshal(obj)
+		if err != nil {
+			return "", err
+		}
+
+		docs = append(docs, string(bs))
+	}
+
+	return strings.Join(docs, "---\n"), nil
+}
+
+// replaceContainerImages walks the object and replaces the images of any
+// container it finds. Walking the whole object finds the containers of
+// every workload type, including nested templates such as in CronJobs.
+func replaceContainerImages(v interface{}, images map[string]string) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, child := range v {
+			switch k {
+			case "containers", "initContainers", "ephemeralContainers":
+				list, _ := child.([]interface{})
+				for _, c := range list {
+					container, ok := c.(map[string]interface{})
+					if !ok {
+						continue
+					}
+
+					image, ok := container["image"].(string)
+					if !ok {
+						continue
+					}
+
+					if ref, ok := images[imageName(image)]; ok {
+						container["image"] = ref
+					}
+				}
+			}
+
+			replaceContainerImages(child, images)
+		}
+
+	case []interface{}:
+		for _, child := range v {
+			replaceContainerImages(child, images)
+		}
+	}
+}
+
+// imageName returns the image reference without its tag or digest.
+func imageName(ref string) string {
+	if idx := strings.Index(ref, "@"); idx >= 0 {
+		ref = ref[:idx]
+	}
+
+	// A colon before the last slash is the port of the registry.
+	if idx := strings.LastIndex(ref, ":"); idx > strings.LastIndex(ref, "/") {
+		ref = ref[:idx]
+	}
+
+	return ref
+}
+
+// appliedResources parses the output of "kubectl apply -o name".
+func appliedResources(output string) []string {
+	var result []string
+	for _, line := range strings.Split(output, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			result = append(result, line)
+		}
+	}
+
+	return result
+}
+
+// resourceTypes returns the unique types of the resources as a comma
+// separated list for kubectl.
+func resourceTypes(resources []string) string {
+	seen := map[string]struct{}{}
+	var result []string
+	for _, r := range resources {
+		typ := r
+		if idx := strings.Index(r, "/"); idx >= 0 {
+			typ = r[:idx]
+		}
+
+		if _, ok := seen[typ]; ok {
+			continue
+		}
+		seen[typ] = struct{}{}
+
+		result = append(result, typ)
+	}
+
+	sort.Strings(result)
+	return strings.Join(result, ",")
+}
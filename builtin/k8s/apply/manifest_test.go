@@ -0,0 +1,72 @@
+package apply
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageName(t *testing.T) {
+	cases := []struct {
+		Ref      string
+		Expected string
+	}{
+		{"myapp", "myapp"},
+		{"myapp:latest", "myapp"},
+		{"registry:5000/team/myapp:1.0", "registry:5000/team/myapp"},
+		{"registry:5000/team/myapp", "registry:5000/team/myapp"},
+		{"myapp@sha256:abcd", "myapp"},
+		{"myapp:1.0@sha256:abcd", "myapp"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Ref, func(t *testing.T) {
+			require.Equal(t, tt.Expected, imageName(tt.Ref))
+		})
+	}
+}
+
+func TestReplaceImages(t *testing.T) {
+	require := require.New(t)
+
+	result, err := replaceImages(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  template:
+    spec:
+      initContainers:
+        - name: migrate
+          image: myapp:dev
+      containers:
+        - name: web
+          image: myapp
+        - name: proxy
+          image: envoyproxy/envoy:v1.16.0
+---
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+`, map[string]string{
+		"myapp": "registry.example.com/myapp:abcd",
+	})
+	require.NoError(err)
+
+	require.Contains(result, "image: registry.example.com/myapp:abcd")
+	require.NotContains(result, "image: myapp")
+	require.Contains(result, "image: envoyproxy/envoy:v1.16.0")
+	require.Contains(result, "kind: Service")
+}
+
+func TestResourceTypes(t *testing.T) {
+	require.Equal(t, "configmap,deployment.apps,service", resourceTypes([]string{
+		"service/web",
+		"deployment.apps/web",
+		"configmap/web",
+		"deployment.apps/worker",
+	}))
+}
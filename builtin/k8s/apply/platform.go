@@ -1,8 +1,11 @@
 package apply
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"os/exec"
+	"strings"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/waypoint-plugin-sdk/component"
@@ -53,23 +56,82 @@ func (p *Platform) Deploy(
 		PruneLabel: p.config.PruneLabel,
 	}
 
+	var args []string
+	switch {
+	case p.config.Path != "" && p.config.Kustomize != "":
+		return nil, status.Errorf(codes.InvalidArgument,
+			"only one of path or kustomize may be set")
+	case p.config.Kustomize != "":
+		args = []string{"-k", p.config.Kustomize}
+	case p.config.Path != "":
+		args = []string{"-R", "-f", p.config.Path}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument,
+			"one of path or kustomize must be set")
+	}
+
+	// If images are replaced, we render the manifests ourselves and
+	// apply the result from stdin.
+	var stdin io.Reader
+	if len(p.config.Images) > 0 {
+		s.Update("Rendering manifests...")
+		manifests, err := p.render(ctx, s)
+		if err != nil {
+			return nil, err
+		}
+
+		manifests, err = replaceImages(manifests, p.config.Images)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"failed to parse manifests: %s", err)
+		}
+
+		args = []string{"-f", "-"}
+		stdin = strings.NewReader(manifests)
+	}
+
 	s.Update("Executing kubectl apply...")
-	cmd, err := p.cmd(ctx, s, "apply",
-		"-R",
-		"-f", p.config.Path,
+	cmd, err := p.cmd(ctx, s, "apply", append(args,
 		"--prune", "-l", deployment.PruneLabel,
-	)
+		"-o", "name",
+	)...)
 	if err != nil {
 		return nil, err
 	}
+
+	// Record the applied resources so that destroy knows what to delete.
+	var out bytes.Buffer
+	cmd.Stdin = stdin
+	cmd.Stdout = io.MultiWriter(s.TermOutput(), &out)
 	if err := cmd.Run(); err != nil {
 		return nil, err
 	}
+	deployment.Resources = appliedResources(out.String())
 	s.Done()
 
 	return deployment, nil
 }
 
+// render returns the manifests to apply as a single stream of documents.
+func (p *Platform) render(ctx context.Context, step terminal.Step) (string, error) {
+	if p.config.Kustomize == "" {
+		return readManifests(p.config.Path)
+	}
+
+	cmd, err := p.cmd(ctx, step, "kustomize", p.config.Kustomize)
+	if err != nil {
+		return "", err
+	}
+
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	if err := cmd.Run(); err != nil {
+		return "", err
+	}
+
+	return out.String(), nil
+}
+
 // Destroy
 func (p *Platform) Destroy(
 	ctx context.Context,
@@ -88,8 +150,17 @@ func (p *Platform) Destroy(
 		return nil
 	}
 
-	// Apply it
-	cmd, err := p.cmd(ctx, s, "delete", "all", "-l", deployment.PruneLabel)
+	// Deployments from before we recorded the applied resources only
+	// delete the resources in the "all" category.
+	types := "all"
+	if len(deployment.Resources) > 0 {
+		types = resourceTypes(deployment.Resources)
+	}
+
+	cmd, err := p.cmd(ctx, s, "delete", types,
+		"-l", deployment.PruneLabel,
+		"--ignore-not-found",
+	)
 	if err != nil {
 		return err
 	}
@@ -144,7 +215,14 @@ func (p *Platform) cmd(
 // Config is the configuration structure for the Platform.
 type Config struct {
 	// The path to the job specification to load.
-	Path string `hcl:"path,attr"`
+	Path string `hcl:"path,optional"`
+
+	// The path to a kustomize directory to apply instead of path.
+	Kustomize string `hcl:"kustomize,optional"`
+
+	// Images to replace in the containers of the resources, keyed by
+	// the image name without a tag.
+	Images map[string]string `hcl:"images,optional"`
 
 	// Prune label is the label to use to destroy resources that don't match.
 	PruneLabel string `hcl:"prune_label,attr"`
@@ -164,7 +242,7 @@ func (p *Platform) Documentation() (*docs.Documentation, error) {
 
 	doc.Description(`
 Deploy Kubernetes resources directly from a single file or a directory of YAML
-or JSON files.
+or JSON files, or from a kustomize directory.
 
 This plugin lets you use any pre-existing set of Kubernetes resource files
 to deploy to Kubernetes. This plugin supports all the features of Waypoint.
@@ -191,6 +269,20 @@ An example below shows this by using ` + "`templatedir`" + ` mixed with
 variables such as ` + "`artifact.image`" + ` to dynamically configure the
 Docker image within a Kubernetes Deployment.
 
+Alternatively, the "images" parameter replaces the images of the containers
+in the resources without templating them. This is useful with resources that
+are also applied outside of Waypoint, and with kustomize directories.
+
+### Kustomize
+
+Set "kustomize" instead of "path" to apply a kustomize directory, such as an
+overlay for an environment. This uses the kustomize built into "kubectl".
+
+### Destroy
+
+Destroying a deployment deletes the resources with the "prune_label" of the
+types that were applied.
+
 ### Entrypoint Functionality
 
 Waypoint [entrypoint functionality](/docs/entrypoint#functionality) such
@@ -272,6 +364,20 @@ spec:
             value: "3000"
 `)
 
+	doc.Example(`
+deploy {
+  use "kubernetes-apply" {
+    kustomize   = "${path.app}/k8s/overlays/${workspace.name}"
+    prune_label = "app=myapp"
+
+    // Replaces "image: myapp" in the containers of the resources
+    images = {
+      "myapp" = "${artifact.image}:${artifact.tag}"
+    }
+  }
+}
+`)
+
 	doc.SetField(
 		"path",
 		"Path to a file or directory of YAML or  JSON files.",
@@ -280,7 +386,27 @@ spec:
 			"Kubernetes resources. Pair this with `templatefile` or `templatedir`",
 			"[templating functions](/docs/waypoint-hcl/functions/template)",
 			"to inject dynamic elements into your Kubernetes resources.",
-			"Subdirectories are included recursively.",
+			"Subdirectories are included recursively. One of `path` or",
+			"`kustomize` must be set.",
+		),
+	)
+
+	doc.SetField(
+		"kustomize",
+		"Path to a kustomize directory to apply.",
+		docs.Summary(
+			"This will be used for `kubectl apply -k` instead of `path`.",
+		),
+	)
+
+	doc.SetField(
+		"images",
+		"Images to replace in the containers of the resources.",
+		docs.Summary(
+			"The keys are image names without a tag, such as `myapp` or",
+			"`registry.example.com/myapp`. Containers with that image, with any",
+			"tag, use the value instead. Set the value to",
+			"`${artifact.image}:${artifact.tag}` to deploy the artifact.",
 		),
 	)
 
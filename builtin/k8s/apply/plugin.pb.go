@@ -26,6 +26,9 @@ type Deployment struct {
 	unknownFields protoimpl.UnknownFields
 
 	PruneLabel string `protobuf:"bytes,1,opt,name=prune_label,json=pruneLabel,proto3" json:"prune_label,omitempty"`
+	// resources are the resources kubectl applied, as "type/name". Destroy
+	// deletes the resources of these types with the prune label.
+	Resources []string `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources,omitempty"`
 }
 
 func (x *Deployment) Reset() {
@@ -67,18 +70,27 @@ func (x *Deployment) GetPruneLabel() string {
 	return ""
 }
 
+func (x *Deployment) GetResources() []string {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
 var File_waypoint_builtin_k8s_apply_plugin_proto protoreflect.FileDescriptor
 
 var file_waypoint_builtin_k8s_apply_plugin_proto_rawDesc = []byte{
 	0x0a, 0x27, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x74,
 	0x69, 0x6e, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x2f, 0x70, 0x6c, 0x75,
 	0x67, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6b, 0x38, 0x73, 0x61, 0x70,
-	0x70, 0x6c, 0x79, 0x22, 0x2d, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
+	0x70, 0x6c, 0x79, 0x22, 0x4b, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
 	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x4c, 0x61, 0x62,
-	0x65, 0x6c, 0x42, 0x1c, 0x5a, 0x1a, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2f, 0x62,
-	0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x79,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x42, 0x1c, 0x5a, 0x1a, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2f, 0x62, 0x75, 0x69,
+	0x6c, 0x74, 0x69, 0x6e, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -0,0 +1,50 @@
+package helm
+
+import (
+	"io"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// manifestObject is the subset of a Kubernetes resource that identifies it.
+type manifestObject struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Metadata   struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+	} `json:"metadata"`
+}
+
+// parseManifest returns the resources of a rendered Helm manifest, which
+// is a stream of YAML documents.
+func parseManifest(manifest string) ([]*Deployment_Resource, error) {
+	dec := yaml.NewYAMLOrJSONDecoder(strings.NewReader(manifest), 4096)
+
+	var result []*Deployment_Resource
+	for {
+		var obj manifestObject
+		if err := dec.Decode(&obj); err != nil {
+			if err == io.EOF {
+				break
+			}
+
+			return nil, err
+		}
+
+		// Templates that render nothing leave empty documents behind.
+		if obj.Kind == "" {
+			continue
+		}
+
+		result = append(result, &Deployment_Resource{
+			ApiVersion: obj.APIVersion,
+			Kind:       obj.Kind,
+			Name:       obj.Metadata.Name,
+			Namespace:  obj.Metadata.Namespace,
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,43 @@
+package helm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseManifest(t *testing.T) {
+	require := require.New(t)
+
+	result, err := parseManifest(`
+---
+# Source: app/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: app
+spec:
+  ports:
+    - port: 80
+---
+# Source: app/templates/ingress.yaml
+---
+# Source: app/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+  namespace: other
+`)
+	require.NoError(err)
+	require.Len(result, 2)
+
+	require.Equal("v1", result[0].ApiVersion)
+	require.Equal("Service", result[0].Kind)
+	require.Equal("app", result[0].Name)
+	require.Empty(result[0].Namespace)
+
+	require.Equal("apps/v1", result[1].ApiVersion)
+	require.Equal("Deployment", result[1].Kind)
+	require.Equal("other", result[1].Namespace)
+}
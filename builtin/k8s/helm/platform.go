@@ -0,0 +1,533 @@
+package helm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	"github.com/hashicorp/waypoint-plugin-sdk/docs"
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Platform is the Platform implementation
+type Platform struct {
+	config Config
+}
+
+// Config implements Configurable
+func (p *Platform) Config() (interface{}, error) {
+	return &p.config, nil
+}
+
+// DeployFunc implements component.Platform
+func (p *Platform) DeployFunc() interface{} {
+	return p.Deploy
+}
+
+// DestroyFunc implements component.Destroyer
+func (p *Platform) DestroyFunc() interface{} {
+	return p.Destroy
+}
+
+// GenerationFunc implements component.Generation
+func (p *Platform) GenerationFunc() interface{} {
+	return p.Generation
+}
+
+// StatusFunc implements component.Status
+func (p *Platform) StatusFunc() interface{} {
+	return p.Status
+}
+
+// Deploy installs or upgrades the Helm release. Every deployment is a
+// revision of the same release.
+func (p *Platform) Deploy(
+	ctx context.Context,
+	log hclog.Logger,
+	deployConfig *component.DeploymentConfig,
+	ui terminal.UI,
+) (*Deployment, error) {
+	sg := ui.StepGroup()
+	defer sg.Wait()
+	s := sg.Add("")
+	defer func() { s.Abort() }()
+
+	// Helm only reads values from files, so write each values document
+	// to a temporary file for the duration of the upgrade.
+	td, err := ioutil.TempDir("", "waypoint-helm")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(td)
+
+	args := []string{p.config.Name, p.config.Chart, "--install"}
+	if p.config.Repository != "" {
+		args = append(args, "--repo", p.config.Repository)
+	}
+	if p.config.Version != "" {
+		args = append(args, "--version", p.config.Version)
+	}
+	if p.config.CreateNamespace {
+		args = append(args, "--create-namespace")
+	}
+	if p.config.Wait {
+		args = append(args, "--wait")
+	}
+	for i, v := range p.config.Values {
+		path := fmt.Sprintf("%s/values-%d.yaml", td, i)
+		if err := ioutil.WriteFile(path, []byte(v), 0600); err != nil {
+			return nil, err
+		}
+
+		args = append(args, "--values", path)
+	}
+	args = append(args, setArgs(p.config.Set)...)
+
+	s.Update("Executing helm upgrade for release %q...", p.config.Name)
+	cmd, err := p.cmd(ctx, s, "upgrade", args...)
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Run(); err != nil {
+		return nil, status.Errorf(codes.Aborted,
+			"helm upgrade failed for release %q: %s", p.config.Name, err)
+	}
+
+	s.Update("Reading the rendered resources of release %q...", p.config.Name)
+	info, err := p.releaseStatus(ctx, s, p.config.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := p.output(ctx, s, "get", "manifest", p.config.Name,
+		"--revision", strconv.Itoa(info.Version))
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := parseManifest(manifest)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal,
+			"failed to parse the manifest of release %q: %s", p.config.Name, err)
+	}
+
+	log.Debug("release deployed",
+		"release", info.Name,
+		"namespace", info.Namespace,
+		"revision", info.Version,
+		"resources", len(resources))
+
+	s.Update("Deployed release %q revision %d with %d resources",
+		info.Name, info.Version, len(resources))
+	s.Done()
+
+	return &Deployment{
+		Release:   info.Name,
+		Namespace: info.Namespace,
+		Revision:  int32(info.Version),
+		Chart:     p.config.Chart,
+		Resources: resources,
+	}, nil
+}
+
+// Destroy uninstalls the Helm release. All the deployments of an app are
+// revisions of the same release, so this only uninstalls the release if
+// the deployment is its current revision.
+func (p *Platform) Destroy(
+	ctx context.Context,
+	log hclog.Logger,
+	deployment *Deployment,
+	ui terminal.UI,
+) error {
+	sg := ui.StepGroup()
+	defer sg.Wait()
+	s := sg.Add("Checking release %q...", deployment.Release)
+	defer func() { s.Abort() }()
+
+	info, err := p.releaseStatus(ctx, s, deployment.Release,
+		"--namespace", deployment.Namespace)
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			return err
+		}
+
+		s.Update("Release %q is already uninstalled", deployment.Release)
+		s.Done()
+		return nil
+	}
+
+	if int32(info.Version) != deployment.Revision {
+		log.Debug("release has a newer revision, not uninstalling",
+			"revision", deployment.Revision,
+			"current", info.Version)
+		s.Update("Release %q is at revision %d, not uninstalling revision %d",
+			deployment.Release, info.Version, deployment.Revision)
+		s.Done()
+		return nil
+	}
+
+	s.Update("Executing helm uninstall for release %q...", deployment.Release)
+	cmd, err := p.cmd(ctx, s, "uninstall", deployment.Release,
+		"--namespace", deployment.Namespace)
+	if err != nil {
+		return err
+	}
+	if err := cmd.Run(); err != nil {
+		return status.Errorf(codes.Aborted,
+			"helm uninstall failed for release %q: %s", deployment.Release, err)
+	}
+
+	s.Update("Uninstalled release %q", deployment.Release)
+	s.Done()
+	return nil
+}
+
+// Generation returns the generation ID.
+func (p *Platform) Generation(
+	ctx context.Context,
+) ([]byte, error) {
+	// Every deployment upgrades the same release, so they all share a
+	// generation. This keeps release pruning from uninstalling the
+	// release that was just upgraded.
+	return []byte(fmt.Sprintf("helm/%s/%s", p.config.Namespace, p.config.Name)), nil
+}
+
+// releaseInfo is the subset of the output of "helm status -o json" that
+// the plugin uses.
+type releaseInfo struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Version   int    `json:"version"`
+}
+
+// releaseStatus returns the status of the named release. The error
+// has the NotFound code if the release doesn't exist.
+func (p *Platform) releaseStatus(
+	ctx context.Context,
+	step terminal.Step,
+	name string,
+	args ...string,
+) (*releaseInfo, error) {
+	out, err := p.output(ctx, step, "status",
+		append([]string{name, "--output", "json"}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	var info releaseInfo
+	if err := json.Unmarshal([]byte(out), &info); err != nil {
+		return nil, status.Errorf(codes.Internal,
+			"failed to decode the status of release %q: %s", name, err)
+	}
+
+	return &info, nil
+}
+
+// output runs a helm command and returns its output rather than writing
+// it to the step. Errors are still written to the step.
+func (p *Platform) output(
+	ctx context.Context,
+	step terminal.Step,
+	subcmd string, args ...string,
+) (string, error) {
+	cmd, err := p.cmd(ctx, step, subcmd, args...)
+	if err != nil {
+		return "", err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if strings.Contains(msg, "release: not found") {
+			return "", status.Errorf(codes.NotFound, "%s", msg)
+		}
+
+		step.TermOutput().Write(stderr.Bytes())
+		return "", status.Errorf(codes.Aborted,
+			"helm %s failed: %s", subcmd, err)
+	}
+
+	return stdout.String(), nil
+}
+
+func (p *Platform) cmd(
+	ctx context.Context,
+	step terminal.Step,
+	subcmd string, args ...string,
+) (*exec.Cmd, error) {
+	path, err := exec.LookPath("helm")
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"helm could not be found on the PATH")
+	}
+
+	// Build the args we'll send to helm, which are:
+	// <subcmd> <flags> <flags + args provided to this func>
+	realArgs := []string{subcmd}
+	if p.config.KubeconfigPath != "" {
+		realArgs = append(realArgs, "--kubeconfig="+p.config.KubeconfigPath)
+	}
+	if p.config.Context != "" {
+		realArgs = append(realArgs, "--kube-context="+p.config.Context)
+	}
+	if p.config.Namespace != "" {
+		realArgs = append(realArgs, "--namespace="+p.config.Namespace)
+	}
+	realArgs = append(realArgs, args...)
+
+	// Build our command
+	cmd := exec.CommandContext(ctx, path, realArgs...)
+
+	// Ensure output goes to our step
+	cmd.Stdout = step.TermOutput()
+	cmd.Stderr = cmd.Stdout
+	return cmd, nil
+}
+
+// setArgs turns the set values into helm flags, sorted by key so that
+// the command is the same between runs.
+func setArgs(set map[string]string) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var result []string
+	for _, k := range keys {
+		result = append(result, "--set-string", k+"="+set[k])
+	}
+
+	return result
+}
+
+// Config is the configuration structure for the Platform.
+type Config struct {
+	// The name of the release.
+	Name string `hcl:"name,attr"`
+
+	// The chart to install. This is a path to a local chart or the name
+	// of a chart in the repository.
+	Chart string `hcl:"chart,attr"`
+
+	// The URL of the chart repository.
+	Repository string `hcl:"repository,optional"`
+
+	// The version of the chart to install.
+	Version string `hcl:"version,optional"`
+
+	// Values documents in YAML, in order of precedence.
+	Values []string `hcl:"values,optional"`
+
+	// Set are individual values, which take precedence over Values.
+	Set map[string]string `hcl:"set,optional"`
+
+	// The namespace to install the release in.
+	Namespace string `hcl:"namespace,optional"`
+
+	// Create the namespace if it doesn't exist.
+	CreateNamespace bool `hcl:"create_namespace,optional"`
+
+	// Wait for the resources of the release to be ready.
+	Wait bool `hcl:"wait,optional"`
+
+	// KubeconfigPath is the path to the kubeconfig file.
+	KubeconfigPath string `hcl:"kubeconfig,optional"`
+
+	// Kubernetes context to use in the kubeconfig
+	Context string `hcl:"context,optional"`
+}
+
+func (p *Platform) Documentation() (*docs.Documentation, error) {
+	doc, err := docs.New(docs.FromConfig(&Config{}), docs.FromFunc(p.DeployFunc()))
+	if err != nil {
+		return nil, err
+	}
+
+	doc.Description(`
+Deploy a Helm chart to Kubernetes.
+
+Every deployment installs or upgrades the same Helm release, so each
+Waypoint deployment is a revision of the release. The chart may be a path to
+a local chart or the name of a chart in a chart repository.
+
+### Requirements
+
+This plugin requires "helm" version 3 to be installed since this plugin works
+by subprocessing to "helm upgrade --install".
+
+"helm" must also be configured to access your Kubernetes cluster. You
+may specify an alternate kubeconfig file using the "kubeconfig" configuration
+parameter. If this isn't specified, the default lookup paths will be used.
+
+### Values
+
+The "values" parameter is a list of YAML documents that are passed to Helm
+in order, later documents taking precedence. Combine it with Waypoint's
+[templating features](/docs/waypoint-hcl/functions/template) to set values
+from Waypoint variables or the artifact from the build or push stages, such
+as ` + "`artifact.image`" + ` and ` + "`artifact.tag`" + `.
+
+The "set" parameter sets individual values and takes precedence over
+"values". These are always strings; use "values" for other types.
+
+### Entrypoint Functionality
+
+Waypoint [entrypoint functionality](/docs/entrypoint#functionality) requires
+the entrypoint environment variables to be set in your pods. **This does
+not happen automatically.** Pass ` + "`entrypoint.env`" + ` to your chart with
+the values and use it in the templates of the chart.
+
+### Status Reports
+
+The status report checks every resource the chart rendered for the
+deployed revision. Workloads such as Deployments, StatefulSets and DaemonSets
+are ready when all their replicas are ready. Other resources are ready when
+they exist.
+
+### Destroy
+
+Destroying the deployment that is the current revision of the release
+uninstalls the release. Destroying older deployments doesn't change the
+release.
+`)
+
+	doc.Example(`
+deploy {
+  use "helm" {
+    name  = "myapp"
+    chart = "${path.app}/chart"
+
+    set = {
+      "image.repository" = artifact.image
+      "image.tag"        = artifact.tag
+    }
+  }
+}
+`)
+
+	doc.Example(`
+deploy {
+  use "helm" {
+    name       = "postgres"
+    repository = "https://charts.bitnami.com/bitnami"
+    chart      = "postgresql"
+    version    = "10.3.11"
+    namespace  = "data"
+
+    create_namespace = true
+    wait             = true
+
+    values = [
+      templatefile("${path.app}/values.yaml.tpl", {
+        replicas = var.replicas
+      }),
+    ]
+  }
+}
+`)
+
+	doc.SetField(
+		"name",
+		"Name of the Helm release.",
+		docs.Summary(
+			"Every deployment upgrades this release.",
+		),
+	)
+
+	doc.SetField(
+		"chart",
+		"The chart to deploy.",
+		docs.Summary(
+			"This is a path to a local chart, or the name of a chart in",
+			"the `repository`.",
+		),
+	)
+
+	doc.SetField(
+		"repository",
+		"URL of the chart repository to fetch the chart from.",
+	)
+
+	doc.SetField(
+		"version",
+		"Version of the chart to deploy.",
+		docs.Summary(
+			"If this isn't set, the latest version is deployed.",
+		),
+	)
+
+	doc.SetField(
+		"values",
+		"Values for the chart as YAML documents.",
+		docs.Summary(
+			"Later documents take precedence over earlier ones. Pair this with",
+			"`file` or `templatefile`",
+			"[templating functions](/docs/waypoint-hcl/functions/template)",
+			"to load values from files.",
+		),
+	)
+
+	doc.SetField(
+		"set",
+		"Individual values for the chart, by their path in the values.",
+		docs.Summary(
+			"These take precedence over `values` and are always strings.",
+		),
+	)
+
+	doc.SetField(
+		"namespace",
+		"Namespace to install the release in.",
+		docs.Summary(
+			"If this isn't set, the namespace of the kubeconfig context is used.",
+		),
+	)
+
+	doc.SetField(
+		"create_namespace",
+		"Create the namespace if it doesn't exist.",
+	)
+
+	doc.SetField(
+		"wait",
+		"Wait for the resources of the release to be ready before finishing the deployment.",
+	)
+
+	doc.SetField(
+		"kubeconfig",
+		"Path to the kubeconfig file to use.",
+		docs.Summary(
+			"If this isn't set, the default lookup used by `helm` will be used.",
+		),
+		docs.EnvVar("KUBECONFIG"),
+	)
+
+	doc.SetField(
+		"context",
+		"The kubectl context to use, as defined in the kubeconfig file.",
+	)
+
+	return doc, nil
+}
+
+var (
+	_ component.Generation   = (*Platform)(nil)
+	_ component.Platform     = (*Platform)(nil)
+	_ component.Configurable = (*Platform)(nil)
+	_ component.Destroyer    = (*Platform)(nil)
+	_ component.Status       = (*Platform)(nil)
+)
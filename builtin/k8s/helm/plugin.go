@@ -0,0 +1,13 @@
+package helm
+
+import (
+	"github.com/hashicorp/waypoint-plugin-sdk"
+)
+
+//go:generate protoc -I ../../../.. --go_opt=plugins=grpc --go_out=../../../.. waypoint/builtin/k8s/helm/plugin.proto
+
+// Options are the SDK options to use for instantiation for
+// the Helm plugin.
+var Options = []sdk.Option{
+	sdk.WithComponents(&Platform{}),
+}
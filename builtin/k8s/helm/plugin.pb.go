@@ -0,0 +1,282 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.15.8
+// source: waypoint/builtin/k8s/helm/plugin.proto
+
+package helm
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Deployment is a revision of a Helm release.
+type Deployment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Release   string `protobuf:"bytes,1,opt,name=release,proto3" json:"release,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Revision  int32  `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	Chart     string `protobuf:"bytes,4,opt,name=chart,proto3" json:"chart,omitempty"`
+	// resources are the Kubernetes resources the chart rendered for this
+	// revision. Status reports are built from the health of these.
+	Resources []*Deployment_Resource `protobuf:"bytes,5,rep,name=resources,proto3" json:"resources,omitempty"`
+}
+
+func (x *Deployment) Reset() {
+	*x = Deployment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_waypoint_builtin_k8s_helm_plugin_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Deployment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deployment) ProtoMessage() {}
+
+func (x *Deployment) ProtoReflect() protoreflect.Message {
+	mi := &file_waypoint_builtin_k8s_helm_plugin_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deployment.ProtoReflect.Descriptor instead.
+func (*Deployment) Descriptor() ([]byte, []int) {
+	return file_waypoint_builtin_k8s_helm_plugin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Deployment) GetRelease() string {
+	if x != nil {
+		return x.Release
+	}
+	return ""
+}
+
+func (x *Deployment) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Deployment) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *Deployment) GetChart() string {
+	if x != nil {
+		return x.Chart
+	}
+	return ""
+}
+
+func (x *Deployment) GetResources() []*Deployment_Resource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+type Deployment_Resource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiVersion string `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	Kind       string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name       string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// namespace is empty for cluster-scoped resources and for resources
+	// in the namespace of the release.
+	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *Deployment_Resource) Reset() {
+	*x = Deployment_Resource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_waypoint_builtin_k8s_helm_plugin_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Deployment_Resource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deployment_Resource) ProtoMessage() {}
+
+func (x *Deployment_Resource) ProtoReflect() protoreflect.Message {
+	mi := &file_waypoint_builtin_k8s_helm_plugin_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deployment_Resource.ProtoReflect.Descriptor instead.
+func (*Deployment_Resource) Descriptor() ([]byte, []int) {
+	return file_waypoint_builtin_k8s_helm_plugin_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Deployment_Resource) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *Deployment_Resource) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Deployment_Resource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Deployment_Resource) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+var File_waypoint_builtin_k8s_helm_plugin_proto protoreflect.FileDescriptor
+
+var file_waypoint_builtin_k8s_helm_plugin_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x74,
+	0x69, 0x6e, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x68, 0x65, 0x6c, 0x6d, 0x2f, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x68, 0x65, 0x6c, 0x6d, 0x22, 0xa2,
+	0x02, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x65, 0x6c,
+	0x6d, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x1a, 0x71, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x42, 0x1b, 0x5a, 0x19, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2f,
+	0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x68, 0x65, 0x6c, 0x6d,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_waypoint_builtin_k8s_helm_plugin_proto_rawDescOnce sync.Once
+	file_waypoint_builtin_k8s_helm_plugin_proto_rawDescData = file_waypoint_builtin_k8s_helm_plugin_proto_rawDesc
+)
+
+func file_waypoint_builtin_k8s_helm_plugin_proto_rawDescGZIP() []byte {
+	file_waypoint_builtin_k8s_helm_plugin_proto_rawDescOnce.Do(func() {
+		file_waypoint_builtin_k8s_helm_plugin_proto_rawDescData = protoimpl.X.CompressGZIP(file_waypoint_builtin_k8s_helm_plugin_proto_rawDescData)
+	})
+	return file_waypoint_builtin_k8s_helm_plugin_proto_rawDescData
+}
+
+var file_waypoint_builtin_k8s_helm_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_waypoint_builtin_k8s_helm_plugin_proto_goTypes = []interface{}{
+	(*Deployment)(nil),          // 0: helm.Deployment
+	(*Deployment_Resource)(nil), // 1: helm.Deployment.Resource
+}
+var file_waypoint_builtin_k8s_helm_plugin_proto_depIdxs = []int32{
+	1, // 0: helm.Deployment.resources:type_name -> helm.Deployment.Resource
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_waypoint_builtin_k8s_helm_plugin_proto_init() }
+func file_waypoint_builtin_k8s_helm_plugin_proto_init() {
+	if File_waypoint_builtin_k8s_helm_plugin_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_waypoint_builtin_k8s_helm_plugin_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Deployment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_waypoint_builtin_k8s_helm_plugin_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Deployment_Resource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_waypoint_builtin_k8s_helm_plugin_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_waypoint_builtin_k8s_helm_plugin_proto_goTypes,
+		DependencyIndexes: file_waypoint_builtin_k8s_helm_plugin_proto_depIdxs,
+		MessageInfos:      file_waypoint_builtin_k8s_helm_plugin_proto_msgTypes,
+	}.Build()
+	File_waypoint_builtin_k8s_helm_plugin_proto = out.File
+	file_waypoint_builtin_k8s_helm_plugin_proto_rawDesc = nil
+	file_waypoint_builtin_k8s_helm_plugin_proto_goTypes = nil
+	file_waypoint_builtin_k8s_helm_plugin_proto_depIdxs = nil
+}
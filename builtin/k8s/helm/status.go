@@ -0,0 +1,223 @@
+package helm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/clientcmd"
+
+	sdk "github.com/hashicorp/waypoint-plugin-sdk/proto/gen"
+)
+
+// Status reports the health of the resources the chart rendered for the
+// revision of the deployment.
+func (p *Platform) Status(
+	ctx context.Context,
+	log hclog.Logger,
+	deployment *Deployment,
+	ui terminal.UI,
+) (*sdk.StatusReport, error) {
+	sg := ui.StepGroup()
+	defer sg.Wait()
+
+	s := sg.Add("Gathering health report for release %q revision %d...",
+		deployment.Release, deployment.Revision)
+	defer func() { s.Abort() }()
+
+	// Use the same lookup as helm, which falls back to in-cluster
+	// configuration if there is no kubeconfig.
+	loader := clientcmd.NewDefaultClientConfigLoadingRules()
+	if p.config.KubeconfigPath != "" {
+		loader.ExplicitPath = p.config.KubeconfigPath
+	}
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		loader,
+		&clientcmd.ConfigOverrides{
+			CurrentContext: p.config.Context,
+		},
+	).ClientConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted,
+			"failed to initialize K8S client configuration: %s", err)
+	}
+
+	client, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted,
+			"failed to initialize K8S client: %s", err)
+	}
+	dc, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted,
+			"failed to initialize K8S client: %s", err)
+	}
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(dc))
+
+	var result sdk.StatusReport
+	result.External = true
+
+	var ready, down int
+	for _, r := range deployment.Resources {
+		name := fmt.Sprintf("%s/%s", r.Kind, r.Name)
+
+		gv, err := schema.ParseGroupVersion(r.ApiVersion)
+		if err != nil {
+			return nil, err
+		}
+		mapping, err := mapper.RESTMapping(gv.WithKind(r.Kind).GroupKind(), gv.Version)
+		if err != nil {
+			return nil, status.Errorf(codes.Aborted,
+				"failed to find the API of %s: %s", name, err)
+		}
+
+		var ri dynamic.ResourceInterface = client.Resource(mapping.Resource)
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			ns := r.Namespace
+			if ns == "" {
+				ns = deployment.Namespace
+			}
+
+			ri = client.Resource(mapping.Resource).Namespace(ns)
+		}
+
+		log.Debug("querying resource health", "resource", name)
+		resource := &sdk.StatusReport_Resource{Name: name}
+		obj, err := ri.Get(ctx, r.Name, metav1.GetOptions{})
+		switch {
+		case errors.IsNotFound(err):
+			resource.Health = sdk.StatusReport_MISSING
+			resource.HealthMessage = "resource does not exist"
+		case err != nil:
+			return nil, status.Errorf(codes.Aborted,
+				"failed to get %s: %s", name, err)
+		default:
+			resource.Health, resource.HealthMessage = resourceHealth(obj)
+		}
+
+		switch resource.Health {
+		case sdk.StatusReport_READY:
+			ready++
+		case sdk.StatusReport_DOWN, sdk.StatusReport_MISSING:
+			down++
+		}
+
+		result.Resources = append(result.Resources, resource)
+	}
+
+	total := len(deployment.Resources)
+	switch {
+	case total == 0:
+		result.Health = sdk.StatusReport_UNKNOWN
+		result.HealthMessage = "release has no resources"
+	case ready == total:
+		result.Health = sdk.StatusReport_READY
+		result.HealthMessage = fmt.Sprintf("all %d resources are ready", total)
+	case down == total:
+		result.Health = sdk.StatusReport_DOWN
+		result.HealthMessage = fmt.Sprintf("all %d resources are down", total)
+	default:
+		result.Health = sdk.StatusReport_PARTIAL
+		result.HealthMessage = fmt.Sprintf("%d of %d resources are ready", ready, total)
+	}
+
+	result.GeneratedTime = ptypes.TimestampNow()
+
+	s.Update(result.HealthMessage)
+	switch result.Health {
+	case sdk.StatusReport_READY:
+	case sdk.StatusReport_ALIVE, sdk.StatusReport_PARTIAL, sdk.StatusReport_UNKNOWN:
+		s.Status(terminal.StatusWarn)
+	default:
+		s.Status(terminal.StatusError)
+	}
+	s.Done()
+
+	return &result, nil
+}
+
+// resourceHealth translates the status of a resource into a Waypoint health
+// status. Workloads are checked for ready replicas, other resources are
+// ready if they exist.
+func resourceHealth(obj *unstructured.Unstructured) (sdk.StatusReport_Health, string) {
+	switch obj.GetKind() {
+	case "Deployment", "StatefulSet", "ReplicaSet":
+		desired, ok := nestedInt(obj, "spec", "replicas")
+		if !ok {
+			desired = 1
+		}
+		ready, _ := nestedInt(obj, "status", "readyReplicas")
+		return replicaHealth(ready, desired)
+
+	case "DaemonSet":
+		desired, _ := nestedInt(obj, "status", "desiredNumberScheduled")
+		ready, _ := nestedInt(obj, "status", "numberReady")
+		return replicaHealth(ready, desired)
+
+	case "Pod":
+		phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+		switch phase {
+		case "Running", "Succeeded":
+			return sdk.StatusReport_READY, fmt.Sprintf("pod is %s", phase)
+		case "Pending":
+			return sdk.StatusReport_ALIVE, "pod is pending"
+		case "Failed":
+			return sdk.StatusReport_DOWN, "pod failed"
+		default:
+			return sdk.StatusReport_UNKNOWN, "pod phase could not be determined"
+		}
+
+	case "Job":
+		succeeded, _ := nestedInt(obj, "status", "succeeded")
+		failed, _ := nestedInt(obj, "status", "failed")
+		active, _ := nestedInt(obj, "status", "active")
+		switch {
+		case succeeded > 0:
+			return sdk.StatusReport_READY, "job succeeded"
+		case failed > 0 && active == 0:
+			return sdk.StatusReport_DOWN, "job failed"
+		default:
+			return sdk.StatusReport_ALIVE, "job is running"
+		}
+
+	default:
+		return sdk.StatusReport_READY, "resource exists"
+	}
+}
+
+// replicaHealth translates the ready and desired replicas of a workload
+// into a Waypoint health status.
+func replicaHealth(ready, desired int64) (sdk.StatusReport_Health, string) {
+	msg := fmt.Sprintf("%d of %d replicas are ready", ready, desired)
+	switch {
+	case ready >= desired:
+		return sdk.StatusReport_READY, msg
+	case ready == 0:
+		return sdk.StatusReport_DOWN, msg
+	default:
+		return sdk.StatusReport_PARTIAL, msg
+	}
+}
+
+// nestedInt returns the integer field of the object at the path.
+func nestedInt(obj *unstructured.Unstructured, fields ...string) (int64, bool) {
+	v, ok, err := unstructured.NestedInt64(obj.Object, fields...)
+	if err != nil || !ok {
+		return 0, false
+	}
+
+	return v, true
+}
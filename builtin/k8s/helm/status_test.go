@@ -0,0 +1,102 @@
+package helm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	sdk "github.com/hashicorp/waypoint-plugin-sdk/proto/gen"
+)
+
+func TestResourceHealth(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Object   map[string]interface{}
+		Expected sdk.StatusReport_Health
+	}{
+		{
+			"deployment ready",
+			map[string]interface{}{
+				"kind":   "Deployment",
+				"spec":   map[string]interface{}{"replicas": int64(3)},
+				"status": map[string]interface{}{"readyReplicas": int64(3)},
+			},
+			sdk.StatusReport_READY,
+		},
+
+		{
+			"deployment partially ready",
+			map[string]interface{}{
+				"kind":   "Deployment",
+				"spec":   map[string]interface{}{"replicas": int64(3)},
+				"status": map[string]interface{}{"readyReplicas": int64(1)},
+			},
+			sdk.StatusReport_PARTIAL,
+		},
+
+		{
+			"statefulset with default replicas down",
+			map[string]interface{}{
+				"kind": "StatefulSet",
+			},
+			sdk.StatusReport_DOWN,
+		},
+
+		{
+			"deployment scaled to zero",
+			map[string]interface{}{
+				"kind": "Deployment",
+				"spec": map[string]interface{}{"replicas": int64(0)},
+			},
+			sdk.StatusReport_READY,
+		},
+
+		{
+			"daemonset ready",
+			map[string]interface{}{
+				"kind": "DaemonSet",
+				"status": map[string]interface{}{
+					"desiredNumberScheduled": int64(2),
+					"numberReady":            int64(2),
+				},
+			},
+			sdk.StatusReport_READY,
+		},
+
+		{
+			"pod pending",
+			map[string]interface{}{
+				"kind":   "Pod",
+				"status": map[string]interface{}{"phase": "Pending"},
+			},
+			sdk.StatusReport_ALIVE,
+		},
+
+		{
+			"job failed",
+			map[string]interface{}{
+				"kind":   "Job",
+				"status": map[string]interface{}{"failed": int64(1)},
+			},
+			sdk.StatusReport_DOWN,
+		},
+
+		{
+			"other resource",
+			map[string]interface{}{
+				"kind": "ConfigMap",
+			},
+			sdk.StatusReport_READY,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			health, _ := resourceHealth(&unstructured.Unstructured{Object: tt.Object})
+			require.Equal(tt.Expected, health)
+		})
+	}
+}
@@ -10,5 +10,5 @@ import (
 // Options are the SDK options to use for instantiation for
 // the Kubernetes plugin.
 var Options = []sdk.Option{
-	sdk.WithComponents(&Platform{}, &Releaser{}, &ConfigSourcer{}),
+	sdk.WithComponents(&Platform{}, &Releaser{}, &ConfigSourcer{}, &TaskLauncher{}),
 }
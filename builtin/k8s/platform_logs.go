@@ -0,0 +1,118 @@
+package k8s
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+)
+
+// LogsFunc implements component.LogPlatform
+func (p *Platform) LogsFunc() interface{} {
+	return p.Logs
+}
+
+// Logs reads the logs of the pods of the deployment from the Kubernetes
+// API. This is used for apps deployed without the entrypoint, or when no
+// entrypoint instances are connected.
+func (p *Platform) Logs(
+	ctx context.Context,
+	log hclog.Logger,
+	lv *component.LogViewer,
+	deployment *Deployment,
+) error {
+	csInfo, err := p.getClientset()
+	if err != nil {
+		return err
+	}
+	clientSet := csInfo.Clientset
+	namespace := csInfo.Namespace
+	if p.config.Namespace != "" {
+		namespace = p.config.Namespace
+	}
+
+	podClient := clientSet.CoreV1().Pods(namespace)
+	podList, err := podClient.List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("app=%s", deployment.Name),
+	})
+	if err != nil {
+		return err
+	}
+
+	opts := &corev1.PodLogOptions{
+		// The app container is named after the deployment, so we only read
+		// that one if there are sidecars.
+		Container:  deployment.Name,
+		Timestamps: true,
+	}
+	if !lv.StartingAt.IsZero() {
+		since := metav1.NewTime(lv.StartingAt)
+		opts.SinceTime = &since
+	}
+	if lv.Limit > 0 {
+		limit := int64(lv.Limit)
+		opts.TailLines = &limit
+	}
+
+	log.Debug("reading pod logs", "pods", len(podList.Items))
+
+	var wg sync.WaitGroup
+	errCh := make(chan error, len(podList.Items))
+	for _, pod := range podList.Items {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+
+			stream, err := podClient.GetLogs(name, opts).Stream(ctx)
+			if err != nil {
+				errCh <- err
+				return
+			}
+			defer stream.Close()
+
+			scanner := bufio.NewScanner(stream)
+			for scanner.Scan() {
+				// With Timestamps set, each line starts with an RFC3339
+				// timestamp followed by a space.
+				line := scanner.Text()
+				ts := time.Now()
+				if idx := strings.IndexByte(line, ' '); idx > 0 {
+					if t, err := time.Parse(time.RFC3339Nano, line[:idx]); err == nil {
+						ts = t
+						line = line[idx+1:]
+					}
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case lv.Output <- component.LogEvent{
+					Partition: name,
+					Timestamp: ts,
+					Message:   line,
+				}:
+				}
+			}
+			if err := scanner.Err(); err != nil {
+				errCh <- err
+			}
+		}(pod.Name)
+	}
+
+	wg.Wait()
+	close(errCh)
+	for err := range errCh {
+		log.Warn("error reading pod logs", "err", err)
+		return err
+	}
+
+	return nil
+}
@@ -104,14 +104,21 @@ func buildStatusReport(
 			Name:          pod.ObjectMeta.Name,
 		})
 
-		// Pod containers health
+		// Pod containers health. Containers are named after their pod
+		// since they are part of the pod rather than instances themselves.
 		for _, containerStatus := range podStatus.ContainerStatuses {
-			resources = append(resources, containerStatusToHealth(containerStatus))
+			resource := containerStatusToHealth(containerStatus)
+			resource.Name = pod.ObjectMeta.Name + "/" + resource.Name
+			resources = append(resources, resource)
 		}
 	}
+	result.Resources = resources
 
 	// Overall health status for report
-	if ready == len(podList.Items) {
+	if len(podList.Items) == 0 {
+		result.Health = sdk.StatusReport_DOWN
+		result.HealthMessage = "no pods are running"
+	} else if ready == len(podList.Items) {
 		result.Health = sdk.StatusReport_READY
 		result.HealthMessage = "all pods are reporting ready"
 	} else if down == len(podList.Items) {
@@ -0,0 +1,95 @@
+package k8s
+
+import (
+	"testing"
+
+	sdk "github.com/hashicorp/waypoint-plugin-sdk/proto/gen"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBuildStatusReport(t *testing.T) {
+	pod := func(name string, phase corev1.PodPhase, ready bool, containers ...corev1.ContainerStatus) corev1.Pod {
+		p := corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: corev1.PodStatus{
+				Phase:             phase,
+				ContainerStatuses: containers,
+			},
+		}
+		if ready {
+			p.Status.Conditions = []corev1.PodCondition{{
+				Type:   corev1.PodReady,
+				Status: corev1.ConditionTrue,
+			}}
+		}
+
+		return p
+	}
+
+	running := corev1.ContainerStatus{
+		Name:  "app",
+		Ready: true,
+		State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+	}
+
+	cases := []struct {
+		Name      string
+		Pods      []corev1.Pod
+		Health    sdk.StatusReport_Health
+		Resources []string
+	}{
+		{
+			"no pods",
+			nil,
+			sdk.StatusReport_DOWN,
+			nil,
+		},
+
+		{
+			"all ready",
+			[]corev1.Pod{
+				pod("a", corev1.PodRunning, true, running),
+				pod("b", corev1.PodRunning, true, running),
+			},
+			sdk.StatusReport_READY,
+			[]string{"a", "a/app", "b", "b/app"},
+		},
+
+		{
+			"some down",
+			[]corev1.Pod{
+				pod("a", corev1.PodRunning, true, running),
+				pod("b", corev1.PodFailed, false),
+			},
+			sdk.StatusReport_PARTIAL,
+			[]string{"a", "a/app", "b"},
+		},
+
+		{
+			"all pending",
+			[]corev1.Pod{
+				pod("a", corev1.PodPending, false),
+			},
+			sdk.StatusReport_ALIVE,
+			[]string{"a"},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			result := buildStatusReport(&corev1.PodList{Items: tt.Pods})
+			require.True(result.External)
+			require.Equal(tt.Health, result.Health)
+
+			var names []string
+			for _, r := range result.Resources {
+				names = append(names, r.Name)
+			}
+			require.Equal(tt.Resources, names)
+		})
+	}
+}
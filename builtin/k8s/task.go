@@ -0,0 +1,358 @@
+package k8s
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8sresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	"github.com/hashicorp/waypoint-plugin-sdk/docs"
+)
+
+// taskJobTTL is how long a finished task Job is kept before Kubernetes
+// deletes it, so that its logs can still be inspected after it completes.
+const taskJobTTL = 60 * 60 // 1 hour
+
+// TaskLauncher launches tasks as Kubernetes Jobs.
+type TaskLauncher struct {
+	config TaskLauncherConfig
+}
+
+// StartTaskFunc implements component.TaskLauncher
+func (p *TaskLauncher) StartTaskFunc() interface{} {
+	return p.StartTask
+}
+
+// StopTaskFunc implements component.TaskLauncher
+func (p *TaskLauncher) StopTaskFunc() interface{} {
+	return p.StopTask
+}
+
+// TaskLauncherConfig is the configuration structure for the task plugin.
+type TaskLauncherConfig struct {
+	// Context specifies the kube context to use.
+	Context string `hcl:"context,optional"`
+
+	// The name of the Kubernetes secret to use to pull the image stored
+	// in the registry.
+	ImageSecret string `hcl:"image_secret,optional"`
+
+	// KubeconfigPath is the path to the kubeconfig file. If this is
+	// blank then we default to the home directory.
+	KubeconfigPath string `hcl:"kubeconfig,optional"`
+
+	// A map of key vals to label the Job and its pod with.
+	Labels map[string]string `hcl:"labels,optional"`
+
+	// Namespace is the Kubernetes namespace to create the Job in. If this
+	// is blank then the namespace of the kube context is used.
+	Namespace string `hcl:"namespace,optional"`
+
+	// A map of resource limits and requests, in the same format as the
+	// platform plugin, e.g. "limits_memory" or "requests_cpu".
+	Resources map[string]string `hcl:"resources,optional"`
+
+	// An optional name of the Kubernetes service account to run the
+	// task's pod as.
+	ServiceAccount string `hcl:"service_account,optional"`
+
+	// Environment variables that are meant to configure the task in a
+	// static way.
+	StaticEnvVars map[string]string `hcl:"static_environment,optional"`
+}
+
+func (p *TaskLauncher) Documentation() (*docs.Documentation, error) {
+	doc, err := docs.New(
+		docs.FromConfig(&TaskLauncherConfig{}),
+		docs.FromFunc(p.StartTaskFunc()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	doc.Description(`
+Launch a Kubernetes Job as a task.
+
+Each task runs as a Job with a single pod that isn't restarted. Stopping
+the task deletes the Job and its pod. Finished Jobs are deleted by
+Kubernetes an hour after they complete if the TTL controller is enabled.
+`)
+
+	doc.Example(`
+task {
+  use "kubernetes" {
+    namespace       = "waypoint-runners"
+    service_account = "waypoint-runner"
+  }
+}
+`)
+
+	doc.SetField(
+		"context",
+		"the kubectl context to use, as defined in the kubeconfig file",
+	)
+
+	doc.SetField(
+		"image_secret",
+		"name of the Kubernetes secret to use for the image",
+		docs.Summary(
+			"this references an existing secret, waypoint does not create this secret",
+		),
+	)
+
+	doc.SetField(
+		"kubeconfig",
+		"path to the kubeconfig file to use",
+		docs.Summary("by default uses from current user's home directory"),
+		docs.EnvVar("KUBECONFIG"),
+	)
+
+	doc.SetField(
+		"labels",
+		"a map of key value labels to apply to the Job and its pod",
+	)
+
+	doc.SetField(
+		"namespace",
+		"namespace to create the Job in",
+		docs.Summary(
+			"namespace is the name of the Kubernetes namespace to create the",
+			"Job in. If no namespace is set, the namespace of the kube context",
+			"is used.",
+		),
+	)
+
+	doc.SetField(
+		"resources",
+		"a map of resource limits and requests to apply to the task's container",
+		docs.Summary(
+			"the keys are the resource name prefixed with `limits_` or",
+			"`requests_`, for example `limits_memory` or `requests_cpu`",
+		),
+	)
+
+	doc.SetField(
+		"service_account",
+		"service account name to run the task's pod as",
+	)
+
+	doc.SetField(
+		"static_environment",
+		"environment variables to expose to the task",
+		docs.Summary(
+			"these variables are set in addition to the environment",
+			"variables of the task",
+		),
+	)
+
+	return doc, nil
+}
+
+// Config implements Configurable
+func (p *TaskLauncher) Config() (interface{}, error) {
+	return &p.config, nil
+}
+
+// StartTask creates a Kubernetes Job for the task.
+func (p *TaskLauncher) StartTask(
+	ctx context.Context,
+	log hclog.Logger,
+	tli *component.TaskLaunchInfo,
+) (*TaskInfo, error) {
+	cs, ns, _, err := clientset(p.config.KubeconfigPath, p.config.Context)
+	if err != nil {
+		return nil, err
+	}
+	if p.config.Namespace != "" {
+		ns = p.config.Namespace
+	}
+
+	return p.startTask(ctx, log, cs, ns, tli)
+}
+
+// startTask creates the Job of the task in the namespace ns.
+func (p *TaskLauncher) startTask(
+	ctx context.Context,
+	log hclog.Logger,
+	cs kubernetes.Interface,
+	ns string,
+	tli *component.TaskLaunchInfo,
+) (*TaskInfo, error) {
+	id, err := ulid.New(ulid.Now(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	name := "waypoint-task-" + strings.ToLower(id.String())
+
+	job, err := p.taskJob(name, tli)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid task configuration: %s", err)
+	}
+
+	log.Debug("creating Job for task", "name", name, "namespace", ns, "image", tli.OciUrl)
+	job, err = cs.BatchV1().Jobs(ns).Create(ctx, job, metav1.CreateOptions{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to create Job: %s", err)
+	}
+
+	log.Info("launched task Job", "name", job.Name, "namespace", ns)
+
+	return &TaskInfo{
+		Id:        job.Name,
+		Namespace: ns,
+	}, nil
+}
+
+// StopTask deletes the Job of the task along with its pod.
+func (p *TaskLauncher) StopTask(
+	ctx context.Context,
+	log hclog.Logger,
+	ti *TaskInfo,
+) error {
+	cs, _, _, err := clientset(p.config.KubeconfigPath, p.config.Context)
+	if err != nil {
+		return err
+	}
+
+	return p.stopTask(ctx, log, cs, ti)
+}
+
+// stopTask deletes the Job of the task.
+func (p *TaskLauncher) stopTask(
+	ctx context.Context,
+	log hclog.Logger,
+	cs kubernetes.Interface,
+	ti *TaskInfo,
+) error {
+	policy := metav1.DeletePropagationBackground
+	err := cs.BatchV1().Jobs(ti.Namespace).Delete(ctx, ti.Id, metav1.DeleteOptions{
+		PropagationPolicy: &policy,
+	})
+	if errors.IsNotFound(err) {
+		// The Job is already gone, e.g. removed by the TTL controller.
+		log.Debug("task Job not found", "name", ti.Id, "namespace", ti.Namespace)
+		return nil
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "unable to delete Job: %s", err)
+	}
+
+	log.Info("deleted task Job", "name", ti.Id, "namespace", ti.Namespace)
+	return nil
+}
+
+// taskJob returns the Job that runs the task.
+func (p *TaskLauncher) taskJob(name string, tli *component.TaskLaunchInfo) (*batchv1.Job, error) {
+	resources, err := taskResources(p.config.Resources)
+	if err != nil {
+		return nil, err
+	}
+
+	// The task's variables take precedence over the static ones.
+	vars := map[string]string{}
+	for k, v := range p.config.StaticEnvVars {
+		vars[k] = v
+	}
+	for k, v := range tli.EnvironmentVariables {
+		vars[k] = v
+	}
+
+	// Sort the variables so that the Job is the same for the same input.
+	var env []corev1.EnvVar
+	for k, v := range vars {
+		env = append(env, corev1.EnvVar{Name: k, Value: v})
+	}
+	sort.Slice(env, func(i, j int) bool { return env[i].Name < env[j].Name })
+
+	labels := map[string]string{}
+	for k, v := range p.config.Labels {
+		labels[k] = v
+	}
+	labels["app.kubernetes.io/managed-by"] = "waypoint"
+
+	var pullSecrets []corev1.LocalObjectReference
+	if p.config.ImageSecret != "" {
+		pullSecrets = []corev1.LocalObjectReference{{Name: p.config.ImageSecret}}
+	}
+
+	backoffLimit := int32(0)
+	ttl := int32(taskJobTTL)
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+		Spec: batchv1.JobSpec{
+			// Tasks aren't retried since a task may not be safe to run twice.
+			BackoffLimit:            &backoffLimit,
+			TTLSecondsAfterFinished: &ttl,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy:      corev1.RestartPolicyNever,
+					ServiceAccountName: p.config.ServiceAccount,
+					ImagePullSecrets:   pullSecrets,
+					Containers: []corev1.Container{
+						{
+							Name:      "task",
+							Image:     tli.OciUrl,
+							Args:      tli.Arguments,
+							Env:       env,
+							Resources: resources,
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// taskResources parses the resources configuration of a task.
+func taskResources(config map[string]string) (corev1.ResourceRequirements, error) {
+	var result corev1.ResourceRequirements
+	for k, v := range config {
+		var list *corev1.ResourceList
+		switch {
+		case strings.HasPrefix(k, "limits_"):
+			list = &result.Limits
+		case strings.HasPrefix(k, "requests_"):
+			list = &result.Requests
+		default:
+			return result, fmt.Errorf("unrecognized resources key %q", k)
+		}
+
+		quantity, err := k8sresource.ParseQuantity(v)
+		if err != nil {
+			return result, fmt.Errorf("invalid quantity for %q: %w", k, err)
+		}
+
+		if *list == nil {
+			*list = corev1.ResourceList{}
+		}
+		(*list)[corev1.ResourceName(strings.SplitN(k, "_", 2)[1])] = quantity
+	}
+
+	return result, nil
+}
+
+var (
+	_ component.TaskLauncher = (*TaskLauncher)(nil)
+	_ component.Configurable = (*TaskLauncher)(nil)
+	_ component.Documented   = (*TaskLauncher)(nil)
+)
@@ -0,0 +1,242 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8sresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestTaskLauncher_taskJob(t *testing.T) {
+	tli := &component.TaskLaunchInfo{
+		OciUrl:    "hashicorp/waypoint:latest",
+		Arguments: []string{"runner", "agent"},
+		EnvironmentVariables: map[string]string{
+			"B": "task",
+			"C": "task",
+		},
+	}
+
+	cases := []struct {
+		Name   string
+		Config TaskLauncherConfig
+		Check  func(*testing.T, *corev1.PodSpec)
+		Err    string
+	}{
+		{
+			"defaults",
+			TaskLauncherConfig{},
+			func(t *testing.T, spec *corev1.PodSpec) {
+				require := require.New(t)
+				require.Equal(corev1.RestartPolicyNever, spec.RestartPolicy)
+				require.Empty(spec.ServiceAccountName)
+				require.Empty(spec.ImagePullSecrets)
+
+				require.Len(spec.Containers, 1)
+				c := spec.Containers[0]
+				require.Equal(tli.OciUrl, c.Image)
+				require.Equal(tli.Arguments, c.Args)
+				require.Equal([]corev1.EnvVar{
+					{Name: "B", Value: "task"},
+					{Name: "C", Value: "task"},
+				}, c.Env)
+			},
+			"",
+		},
+
+		{
+			"task variables override static ones",
+			TaskLauncherConfig{
+				StaticEnvVars: map[string]string{"A": "static", "B": "static"},
+			},
+			func(t *testing.T, spec *corev1.PodSpec) {
+				require.Equal(t, []corev1.EnvVar{
+					{Name: "A", Value: "static"},
+					{Name: "B", Value: "task"},
+					{Name: "C", Value: "task"},
+				}, spec.Containers[0].Env)
+			},
+			"",
+		},
+
+		{
+			"service account and image secret",
+			TaskLauncherConfig{
+				ServiceAccount: "runner",
+				ImageSecret:    "registry",
+			},
+			func(t *testing.T, spec *corev1.PodSpec) {
+				require.Equal(t, "runner", spec.ServiceAccountName)
+				require.Equal(t, []corev1.LocalObjectReference{{Name: "registry"}},
+					spec.ImagePullSecrets)
+			},
+			"",
+		},
+
+		{
+			"resources",
+			TaskLauncherConfig{
+				Resources: map[string]string{
+					"limits_memory": "512Mi",
+					"requests_cpu":  "250m",
+				},
+			},
+			func(t *testing.T, spec *corev1.PodSpec) {
+				require := require.New(t)
+				r := spec.Containers[0].Resources
+				require.Equal(k8sresource.MustParse("512Mi"), r.Limits[corev1.ResourceMemory])
+				require.Equal(k8sresource.MustParse("250m"), r.Requests[corev1.ResourceCPU])
+				require.Len(r.Limits, 1)
+				require.Len(r.Requests, 1)
+			},
+			"",
+		},
+
+		{
+			"unrecognized resource key",
+			TaskLauncherConfig{
+				Resources: map[string]string{"memory": "512Mi"},
+			},
+			nil,
+			"unrecognized",
+		},
+
+		{
+			"invalid quantity",
+			TaskLauncherConfig{
+				Resources: map[string]string{"limits_memory": "lots"},
+			},
+			nil,
+			"invalid quantity",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			p := &TaskLauncher{config: tt.Config}
+			job, err := p.taskJob("waypoint-task-test", tli)
+			if tt.Err != "" {
+				require.Error(err)
+				require.Contains(err.Error(), tt.Err)
+				return
+			}
+			require.NoError(err)
+
+			require.Equal("waypoint-task-test", job.Name)
+			require.Equal(int32(0), *job.Spec.BackoffLimit)
+			require.Equal("waypoint", job.Labels["app.kubernetes.io/managed-by"])
+			require.Equal(job.Labels, job.Spec.Template.Labels)
+			tt.Check(t, &job.Spec.Template.Spec)
+		})
+	}
+}
+
+func TestTaskLauncher_startTask(t *testing.T) {
+	ctx := context.Background()
+	tli := &component.TaskLaunchInfo{
+		OciUrl:    "hashicorp/waypoint:latest",
+		Arguments: []string{"runner", "agent"},
+	}
+
+	t.Run("creates a Job", func(t *testing.T) {
+		require := require.New(t)
+
+		cs := fake.NewSimpleClientset()
+		p := &TaskLauncher{}
+		ti, err := p.startTask(ctx, hclog.L(), cs, "runners", tli)
+		require.NoError(err)
+		require.Equal("runners", ti.Namespace)
+		require.Contains(ti.Id, "waypoint-task-")
+
+		job, err := cs.BatchV1().Jobs("runners").Get(ctx, ti.Id, metav1.GetOptions{})
+		require.NoError(err)
+		require.Equal(tli.OciUrl, job.Spec.Template.Spec.Containers[0].Image)
+	})
+
+	t.Run("invalid configuration", func(t *testing.T) {
+		require := require.New(t)
+
+		cs := fake.NewSimpleClientset()
+		p := &TaskLauncher{config: TaskLauncherConfig{
+			Resources: map[string]string{"memory": "512Mi"},
+		}}
+		_, err := p.startTask(ctx, hclog.L(), cs, "runners", tli)
+		require.Error(err)
+		require.Equal(codes.InvalidArgument, status.Code(err))
+
+		// Nothing is created
+		require.Empty(cs.Actions())
+	})
+
+	t.Run("Job can't be created", func(t *testing.T) {
+		require := require.New(t)
+
+		cs := fake.NewSimpleClientset()
+		cs.PrependReactor("create", "jobs", func(k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, fmt.Errorf("quota exceeded")
+		})
+
+		p := &TaskLauncher{}
+		_, err := p.startTask(ctx, hclog.L(), cs, "runners", tli)
+		require.Error(err)
+		require.Equal(codes.Internal, status.Code(err))
+		require.Contains(err.Error(), "quota exceeded")
+	})
+}
+
+func TestTaskLauncher_stopTask(t *testing.T) {
+	ctx := context.Background()
+	ti := &TaskInfo{Id: "waypoint-task-test", Namespace: "runners"}
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ti.Id,
+			Namespace: ti.Namespace,
+		},
+	}
+
+	t.Run("deletes the Job", func(t *testing.T) {
+		require := require.New(t)
+
+		cs := fake.NewSimpleClientset(job)
+		p := &TaskLauncher{}
+		require.NoError(p.stopTask(ctx, hclog.L(), cs, ti))
+
+		_, err := cs.BatchV1().Jobs(ti.Namespace).Get(ctx, ti.Id, metav1.GetOptions{})
+		require.True(errors.IsNotFound(err))
+	})
+
+	t.Run("Job is already gone", func(t *testing.T) {
+		cs := fake.NewSimpleClientset()
+		p := &TaskLauncher{}
+		require.NoError(t, p.stopTask(ctx, hclog.L(), cs, ti))
+	})
+
+	t.Run("Job can't be deleted", func(t *testing.T) {
+		require := require.New(t)
+
+		cs := fake.NewSimpleClientset(job)
+		cs.PrependReactor("delete", "jobs", func(k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, fmt.Errorf("forbidden")
+		})
+
+		p := &TaskLauncher{}
+		err := p.stopTask(ctx, hclog.L(), cs, ti)
+		require.Error(err)
+		require.Equal(codes.Internal, status.Code(err))
+	})
+}
@@ -15,6 +15,8 @@ import (
 
 	"github.com/hashicorp/waypoint/builtin/docker"
 	"github.com/hashicorp/waypoint/builtin/nomad"
+
+	sdk "github.com/hashicorp/waypoint-plugin-sdk/proto/gen"
 )
 
 const (
@@ -41,6 +43,16 @@ func (p *Platform) DestroyFunc() interface{} {
 	return p.Destroy
 }
 
+// DefaultReleaserFunc implements component.PlatformReleaser
+func (p *Platform) DefaultReleaserFunc() interface{} {
+	return func() *Releaser { return &Releaser{} }
+}
+
+// StatusFunc implements component.Status
+func (p *Platform) StatusFunc() interface{} {
+	return p.Status
+}
+
 // GenerationFunc implements component.Generation
 func (p *Platform) GenerationFunc() interface{} {
 	return p.Generation
@@ -99,6 +111,18 @@ func (p *Platform) Deploy(
 	if err := nomad.NewMonitor(st, client).Monitor(evalID); err != nil {
 		return nil, err
 	}
+
+	// If the job uses canaries, the Nomad deployment waits for the
+	// canaries to be promoted, which happens when we release.
+	d, _, err := jobclient.LatestDeployment(result.Name, nil)
+	if err != nil {
+		return nil, err
+	}
+	if d != nil && awaitingPromotion(d) {
+		st.Step(terminal.StatusOK, fmt.Sprintf(
+			"Canaries placed, Nomad deployment %q waits for the release to promote them", d.ID))
+		return &result, nil
+	}
 	st.Step(terminal.StatusOK, "Deployment successfully rolled out!")
 
 	return &result, nil
@@ -125,6 +149,44 @@ func (p *Platform) Destroy(
 	return err
 }
 
+// Status builds a status report for the job from the health of its
+// allocations.
+func (p *Platform) Status(
+	ctx context.Context,
+	log hclog.Logger,
+	deployment *nomad.Deployment,
+	ui terminal.UI,
+) (*sdk.StatusReport, error) {
+	sg := ui.StepGroup()
+	defer sg.Wait()
+
+	s := sg.Add("Gathering health report for Nomad job %q...", deployment.Name)
+	defer func() { s.Abort() }()
+
+	client, err := api.NewClient(api.DefaultConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("querying nomad for allocation health")
+	result, err := nomad.BuildStatusReport(client, deployment.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Update(result.HealthMessage)
+	switch result.Health {
+	case sdk.StatusReport_READY:
+	case sdk.StatusReport_ALIVE, sdk.StatusReport_PARTIAL:
+		s.Status(terminal.StatusWarn)
+	default:
+		s.Status(terminal.StatusError)
+	}
+	s.Done()
+
+	return result, nil
+}
+
 // Generation returns the generation ID. The ID we use is the name of the
 // job since this is the unique ID that determines insert vs. update behavior
 // for Nomad.
@@ -227,6 +289,14 @@ The PORT environment variable should be the port that your web service
 is listening on that the URL service will connect to. See one of the examples
 below for more details.
 
+### Canaries
+
+If the job specification configures canaries with the "canary" parameter
+of the "update" stanza, a deployment only places the canary allocations.
+The canaries are promoted when the deployment is released, which happens
+automatically with "waypoint up" unless a different release plugin is
+configured. See the "nomad-jobspec" release plugin for details.
+
 `)
 
 	doc.Example(`
@@ -282,8 +352,10 @@ job "web" {
 }
 
 var (
-	_ component.Generation   = (*Platform)(nil)
-	_ component.Platform     = (*Platform)(nil)
-	_ component.Configurable = (*Platform)(nil)
-	_ component.Destroyer    = (*Platform)(nil)
+	_ component.Generation       = (*Platform)(nil)
+	_ component.Platform         = (*Platform)(nil)
+	_ component.PlatformReleaser = (*Platform)(nil)
+	_ component.Configurable     = (*Platform)(nil)
+	_ component.Destroyer        = (*Platform)(nil)
+	_ component.Status           = (*Platform)(nil)
 )
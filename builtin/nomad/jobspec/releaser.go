@@ -0,0 +1,217 @@
+package jobspec
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	"github.com/hashicorp/waypoint-plugin-sdk/docs"
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/builtin/nomad"
+)
+
+const (
+	// deploymentWait is the amount of time to wait between polls of the
+	// Nomad deployment.
+	deploymentWait = 2 * time.Second
+)
+
+// Releaser is the ReleaseManager implementation for Nomad jobs. If the job
+// uses canaries, the release promotes the canaries of the Nomad deployment
+// once they are healthy.
+type Releaser struct{}
+
+// ReleaseFunc implements component.ReleaseManager
+func (r *Releaser) ReleaseFunc() interface{} {
+	return r.Release
+}
+
+// Release promotes the canaries of the Nomad deployment of the job.
+func (r *Releaser) Release(
+	ctx context.Context,
+	log hclog.Logger,
+	ui terminal.UI,
+	target *nomad.Deployment,
+) (*nomad.Release, error) {
+	sg := ui.StepGroup()
+	defer sg.Wait()
+	s := sg.Add("Checking the Nomad deployment of job %q...", target.Name)
+	defer func() { s.Abort() }()
+
+	client, err := api.NewClient(api.DefaultConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &nomad.Release{Name: target.Name}
+
+	// Canaries can only be promoted for the version of the job that we
+	// deployed, since promoting replaces every allocation of the job.
+	job, _, err := client.Jobs().Info(target.Name, nil)
+	if err != nil {
+		return nil, err
+	}
+	if job.Meta[metaId] != target.Id {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"job %q was updated by another deployment, only the latest "+
+				"deployment of the job can be released", target.Name)
+	}
+
+	d, _, err := client.Jobs().LatestDeployment(target.Name, nil)
+	if err != nil {
+		return nil, err
+	}
+	if d == nil || job.Version == nil || d.JobVersion != *job.Version {
+		s.Update("Job %q has no Nomad deployment to promote", target.Name)
+		s.Done()
+		return result, nil
+	}
+	if d.Status == "failed" {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"Nomad deployment %q failed: %s", d.ID, d.StatusDescription)
+	}
+	if !awaitingPromotion(d) {
+		s.Update("Nomad deployment %q has no canaries to promote", d.ID)
+		s.Done()
+		return result, nil
+	}
+	result.DeploymentId = d.ID
+	s.Done()
+
+	s = sg.Add("Waiting for the canaries of Nomad deployment %q to be healthy...", d.ID)
+	err = waitDeployment(ctx, client, d.ID, func(d *api.Deployment) (bool, error) {
+		if d.Status != "running" {
+			return false, fmt.Errorf("Nomad deployment %q is %s: %s",
+				d.ID, d.Status, d.StatusDescription)
+		}
+
+		for name, tg := range d.TaskGroups {
+			if tg.DesiredCanaries == 0 || tg.Promoted {
+				continue
+			}
+			if tg.UnhealthyAllocs > 0 {
+				return false, fmt.Errorf("canaries of group %q are unhealthy", name)
+			}
+			if tg.HealthyAllocs < tg.DesiredCanaries {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.Done()
+
+	s = sg.Add("Promoting the canaries of Nomad deployment %q...", d.ID)
+	if _, _, err := client.Deployments().PromoteAll(d.ID, nil); err != nil {
+		return nil, err
+	}
+
+	s.Update("Waiting for Nomad deployment %q to complete...", d.ID)
+	err = waitDeployment(ctx, client, d.ID, func(d *api.Deployment) (bool, error) {
+		switch d.Status {
+		case "successful":
+			return true, nil
+		case "running":
+			return false, nil
+		default:
+			return false, fmt.Errorf("Nomad deployment %q is %s: %s",
+				d.ID, d.Status, d.StatusDescription)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.Update("Promoted the canaries of Nomad deployment %q", d.ID)
+	s.Done()
+
+	return result, nil
+}
+
+// awaitingPromotion returns true if the deployment has canaries that
+// weren't promoted yet.
+func awaitingPromotion(d *api.Deployment) bool {
+	if d.Status != "running" {
+		return false
+	}
+
+	for _, tg := range d.TaskGroups {
+		if tg.DesiredCanaries > 0 && !tg.Promoted {
+			return true
+		}
+	}
+
+	return false
+}
+
+// waitDeployment polls the Nomad deployment until done returns true or an
+// error.
+func waitDeployment(
+	ctx context.Context,
+	client *api.Client,
+	id string,
+	done func(*api.Deployment) (bool, error),
+) error {
+	for {
+		d, _, err := client.Deployments().Info(id, nil)
+		if err != nil {
+			return err
+		}
+
+		ok, err := done(d)
+		if err != nil || ok {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(deploymentWait):
+		}
+	}
+}
+
+func (r *Releaser) Documentation() (*docs.Documentation, error) {
+	doc, err := docs.New(docs.FromFunc(r.ReleaseFunc()))
+	if err != nil {
+		return nil, err
+	}
+
+	doc.Description(`
+Promote the canaries of a Nomad deployment.
+
+If the job specification configures canaries with the "canary" parameter of
+the "update" stanza, a deployment only places the canary allocations and the
+Nomad deployment waits to be promoted. Releasing the deployment waits for
+the canaries to be healthy and then promotes them, which replaces the
+remaining allocations of the job. Jobs without canaries require no
+promotion and releasing them does nothing.
+
+Only the latest deployment of a job can be released, since every deployment
+updates the same Nomad job.
+`)
+
+	doc.Example(`
+release {
+  use "nomad-jobspec" {}
+}
+`)
+
+	doc.Input("nomad.Deployment")
+	doc.Output("nomad.Release")
+
+	return doc, nil
+}
+
+var (
+	_ component.ReleaseManager = (*Releaser)(nil)
+	_ component.Documented     = (*Releaser)(nil)
+)
@@ -7,7 +7,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/golang/protobuf/ptypes"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/nomad/api"
 	"github.com/hashicorp/waypoint-plugin-sdk/component"
@@ -244,7 +243,6 @@ func (p *Platform) Status(
 	if err != nil {
 		return nil, err
 	}
-	jobclient := client.Jobs()
 
 	sg := ui.StepGroup()
 	defer sg.Wait()
@@ -252,36 +250,12 @@ func (p *Platform) Status(
 	s := sg.Add("Gathering health report for Nomad platform...")
 	defer func() { s.Abort() }()
 
-	// Create our status report
-	var result sdk.StatusReport
-	result.External = true
-
-	log.Debug("querying nomad for job health")
+	log.Debug("querying nomad for allocation health")
 
-	job, _, err := jobclient.Info(deployment.Name, &api.QueryOptions{})
+	result, err := BuildStatusReport(client, deployment.Name)
 	if err != nil {
 		return nil, err
 	}
-	if *job.Status == "running" {
-		result.Health = sdk.StatusReport_READY
-		result.HealthMessage = fmt.Sprintf("Job %q is reporting ready!", deployment.Name)
-	} else if *job.Status == "queued" || *job.Status == "started" {
-		result.Health = sdk.StatusReport_ALIVE
-		result.HealthMessage = fmt.Sprintf("Job %q is reporting alive!", deployment.Name)
-	} else if *job.Status == "completed" {
-		result.Health = sdk.StatusReport_PARTIAL
-		result.HealthMessage = fmt.Sprintf("Job %q is reporting partially available!", deployment.Name)
-	} else if *job.Status == "failed" || *job.Status == "lost" {
-		result.Health = sdk.StatusReport_DOWN
-		result.HealthMessage = fmt.Sprintf("Job %q is reporting down!", deployment.Name)
-	} else {
-		result.Health = sdk.StatusReport_UNKNOWN
-		result.HealthMessage = fmt.Sprintf("Job %q is reporting unknown!", deployment.Name)
-	}
-
-	result.HealthMessage = *job.StatusDescription
-
-	result.GeneratedTime = ptypes.TimestampNow()
 
 	s.Update("Finished building report for Nomad platform")
 	s.Done()
@@ -291,7 +265,7 @@ func (p *Platform) Status(
 	st := ui.Status()
 	defer st.Close()
 
-	st.Update("Determining overall container health...")
+	st.Update("Determining overall allocation health...")
 	if result.Health == sdk.StatusReport_READY {
 		st.Step(terminal.StatusOK, result.HealthMessage)
 	} else {
@@ -306,7 +280,7 @@ func (p *Platform) Status(
 		st.Step(terminal.StatusWarn, mixedHealthWarn)
 	}
 
-	return &result, nil
+	return result, nil
 }
 
 // Config is the configuration structure for the Platform.
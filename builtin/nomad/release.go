@@ -0,0 +1,8 @@
+package nomad
+
+import "github.com/hashicorp/waypoint-plugin-sdk/component"
+
+// URL implements component.Release. Nomad doesn't expose jobs at a URL.
+func (r *Release) URL() string { return "" }
+
+var _ component.Release = (*Release)(nil)
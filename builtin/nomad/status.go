@@ -0,0 +1,169 @@
+package nomad
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/nomad/api"
+
+	sdk "github.com/hashicorp/waypoint-plugin-sdk/proto/gen"
+)
+
+// BuildStatusReport builds a status report for the job from the health of
+// its allocations.
+func BuildStatusReport(client *api.Client, jobID string) (*sdk.StatusReport, error) {
+	allocs, _, err := client.Jobs().Allocations(jobID, false, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := buildStatusReport(jobID, allocs)
+	result.GeneratedTime = ptypes.TimestampNow()
+	return result, nil
+}
+
+// buildStatusReport builds a status report from the allocations of a job.
+// Every allocation the job wants running is a resource of the report,
+// followed by the states of its tasks.
+func buildStatusReport(jobID string, allocs []*api.AllocationListStub) *sdk.StatusReport {
+	var result sdk.StatusReport
+	result.External = true
+
+	// Build health for every allocation for the overall health report
+	var total, ready, alive, down, unknown int
+	for _, alloc := range allocs {
+		// Allocations that are replaced or stopped don't matter for the
+		// health of the job.
+		if alloc.DesiredStatus != "run" {
+			continue
+		}
+		total++
+
+		health, msg := allocHealth(alloc)
+		switch health {
+		case sdk.StatusReport_READY:
+			ready++
+			alive++
+		case sdk.StatusReport_ALIVE, sdk.StatusReport_PARTIAL:
+			alive++
+		case sdk.StatusReport_DOWN:
+			down++
+		default:
+			unknown++
+		}
+
+		name := alloc.Name
+		if alloc.DeploymentStatus != nil && alloc.DeploymentStatus.Canary {
+			name += " (canary)"
+		}
+		result.Resources = append(result.Resources, &sdk.StatusReport_Resource{
+			Name:          name,
+			Health:        health,
+			HealthMessage: msg,
+		})
+
+		// Sort the tasks so that the report is stable
+		tasks := make([]string, 0, len(alloc.TaskStates))
+		for task := range alloc.TaskStates {
+			tasks = append(tasks, task)
+		}
+		sort.Strings(tasks)
+		for _, task := range tasks {
+			result.Resources = append(result.Resources,
+				taskStateToHealth(task, alloc.TaskStates[task]))
+		}
+	}
+
+	// Overall health status for report
+	switch {
+	case total == 0:
+		result.Health = sdk.StatusReport_DOWN
+		result.HealthMessage = fmt.Sprintf("Job %q has no running allocations", jobID)
+	case ready == total:
+		result.Health = sdk.StatusReport_READY
+		result.HealthMessage = fmt.Sprintf("All %d allocations of job %q are reporting ready", total, jobID)
+	case down == total:
+		result.Health = sdk.StatusReport_DOWN
+		result.HealthMessage = fmt.Sprintf("All %d allocations of job %q are reporting down", total, jobID)
+	case unknown == total:
+		result.Health = sdk.StatusReport_UNKNOWN
+		result.HealthMessage = fmt.Sprintf("All %d allocations of job %q are reporting unknown", total, jobID)
+	case alive == total:
+		result.Health = sdk.StatusReport_ALIVE
+		result.HealthMessage = fmt.Sprintf("All %d allocations of job %q are reporting alive", total, jobID)
+	default:
+		result.Health = sdk.StatusReport_PARTIAL
+		result.HealthMessage = fmt.Sprintf(
+			"%d of %d allocations of job %q are reporting ready", ready, total, jobID)
+	}
+
+	return &result
+}
+
+// allocHealth translates the client status of an allocation and, if the
+// allocation is part of a Nomad deployment, its deployment health into a
+// Waypoint health status.
+func allocHealth(alloc *api.AllocationListStub) (sdk.StatusReport_Health, string) {
+	msg := alloc.ClientDescription
+
+	switch alloc.ClientStatus {
+	case "pending":
+		return sdk.StatusReport_ALIVE, msg
+
+	case "running":
+		// Allocations of a Nomad deployment are only healthy once they
+		// passed their health checks.
+		if ds := alloc.DeploymentStatus; ds != nil {
+			if ds.Healthy == nil {
+				return sdk.StatusReport_ALIVE, "allocation is waiting on health checks"
+			}
+			if !*ds.Healthy {
+				return sdk.StatusReport_DOWN, "allocation is reporting unhealthy"
+			}
+		}
+
+		return sdk.StatusReport_READY, msg
+
+	case "complete":
+		return sdk.StatusReport_PARTIAL, msg
+
+	case "failed", "lost":
+		return sdk.StatusReport_DOWN, msg
+
+	default:
+		return sdk.StatusReport_UNKNOWN, msg
+	}
+}
+
+// taskStateToHealth translates the state of a task into a Waypoint health
+// status.
+func taskStateToHealth(name string, state *api.TaskState) *sdk.StatusReport_Resource {
+	resource := &sdk.StatusReport_Resource{Name: name}
+
+	switch {
+	case state.State == "running":
+		resource.Health = sdk.StatusReport_READY
+		resource.HealthMessage = "task is running"
+	case state.State == "pending":
+		resource.Health = sdk.StatusReport_ALIVE
+		resource.HealthMessage = "task is pending"
+	case state.State == "dead" && state.Failed:
+		resource.Health = sdk.StatusReport_DOWN
+		resource.HealthMessage = "task failed"
+	case state.State == "dead":
+		resource.Health = sdk.StatusReport_PARTIAL
+		resource.HealthMessage = "task is complete"
+	default:
+		resource.Health = sdk.StatusReport_UNKNOWN
+		resource.HealthMessage = "task health could not be determined"
+	}
+
+	// The most recent event explains the state best, such as why a task
+	// is restarting.
+	if n := len(state.Events); n > 0 && state.Events[n-1].DisplayMessage != "" {
+		resource.HealthMessage = state.Events[n-1].DisplayMessage
+	}
+
+	return resource
+}
@@ -0,0 +1,216 @@
+package nomad
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hashicorp/waypoint-plugin-sdk/proto/gen"
+)
+
+func TestBuildStatusReport(t *testing.T) {
+	healthy, unhealthy := true, false
+
+	alloc := func(name, desired, client string, tasks map[string]*api.TaskState) *api.AllocationListStub {
+		return &api.AllocationListStub{
+			Name:          name,
+			DesiredStatus: desired,
+			ClientStatus:  client,
+			TaskStates:    tasks,
+		}
+	}
+
+	running := map[string]*api.TaskState{
+		"web": {State: "running"},
+	}
+
+	// The resources of the report in order, with their health
+	type resource struct {
+		Name   string
+		Health sdk.StatusReport_Health
+	}
+
+	cases := []struct {
+		Name      string
+		Allocs    []*api.AllocationListStub
+		Health    sdk.StatusReport_Health
+		Resources []resource
+	}{
+		{
+			"no allocations",
+			nil,
+			sdk.StatusReport_DOWN,
+			nil,
+		},
+
+		{
+			"all running",
+			[]*api.AllocationListStub{
+				alloc("app.web[0]", "run", "running", running),
+				alloc("app.web[1]", "run", "running", running),
+			},
+			sdk.StatusReport_READY,
+			[]resource{
+				{"app.web[0]", sdk.StatusReport_READY},
+				{"web", sdk.StatusReport_READY},
+				{"app.web[1]", sdk.StatusReport_READY},
+				{"web", sdk.StatusReport_READY},
+			},
+		},
+
+		{
+			"stopped allocations are ignored",
+			[]*api.AllocationListStub{
+				alloc("app.web[0]", "run", "running", running),
+				alloc("app.web[0]", "stop", "failed", nil),
+			},
+			sdk.StatusReport_READY,
+			[]resource{
+				{"app.web[0]", sdk.StatusReport_READY},
+				{"web", sdk.StatusReport_READY},
+			},
+		},
+
+		{
+			"some failed",
+			[]*api.AllocationListStub{
+				alloc("app.web[0]", "run", "running", running),
+				alloc("app.web[1]", "run", "failed", map[string]*api.TaskState{
+					"web": {
+						State:  "dead",
+						Failed: true,
+						Events: []*api.TaskEvent{{DisplayMessage: "Exit Code: 1"}},
+					},
+				}),
+			},
+			sdk.StatusReport_PARTIAL,
+			[]resource{
+				{"app.web[0]", sdk.StatusReport_READY},
+				{"web", sdk.StatusReport_READY},
+				{"app.web[1]", sdk.StatusReport_DOWN},
+				{"web", sdk.StatusReport_DOWN},
+			},
+		},
+
+		{
+			"all pending",
+			[]*api.AllocationListStub{
+				alloc("app.web[0]", "run", "pending", map[string]*api.TaskState{
+					"web": {State: "pending"},
+				}),
+			},
+			sdk.StatusReport_ALIVE,
+			[]resource{
+				{"app.web[0]", sdk.StatusReport_ALIVE},
+				{"web", sdk.StatusReport_ALIVE},
+			},
+		},
+
+		{
+			"deployment health",
+			[]*api.AllocationListStub{
+				{
+					Name:          "app.web[0]",
+					DesiredStatus: "run",
+					ClientStatus:  "running",
+					DeploymentStatus: &api.AllocDeploymentStatus{
+						Healthy: &healthy,
+					},
+				},
+				{
+					Name:          "app.web[1]",
+					DesiredStatus: "run",
+					ClientStatus:  "running",
+					DeploymentStatus: &api.AllocDeploymentStatus{
+						Healthy: &unhealthy,
+						Canary:  true,
+					},
+				},
+				{
+					Name:             "app.web[2]",
+					DesiredStatus:    "run",
+					ClientStatus:     "running",
+					DeploymentStatus: &api.AllocDeploymentStatus{},
+				},
+			},
+			sdk.StatusReport_PARTIAL,
+			[]resource{
+				{"app.web[0]", sdk.StatusReport_READY},
+				{"app.web[1] (canary)", sdk.StatusReport_DOWN},
+				{"app.web[2]", sdk.StatusReport_ALIVE},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			result := buildStatusReport("app", tt.Allocs)
+			require.True(result.External)
+			require.Equal(tt.Health, result.Health)
+			require.NotEmpty(result.HealthMessage)
+
+			var resources []resource
+			for _, r := range result.Resources {
+				resources = append(resources, resource{r.Name, r.Health})
+			}
+			require.Equal(tt.Resources, resources)
+		})
+	}
+}
+
+func TestTaskStateToHealth(t *testing.T) {
+	cases := []struct {
+		Name    string
+		State   *api.TaskState
+		Health  sdk.StatusReport_Health
+		Message string
+	}{
+		{
+			"running",
+			&api.TaskState{State: "running"},
+			sdk.StatusReport_READY,
+			"task is running",
+		},
+
+		{
+			"complete",
+			&api.TaskState{State: "dead"},
+			sdk.StatusReport_PARTIAL,
+			"task is complete",
+		},
+
+		{
+			"latest event explains the state",
+			&api.TaskState{
+				State: "pending",
+				Events: []*api.TaskEvent{
+					{DisplayMessage: "Task received by client"},
+					{DisplayMessage: "Restarting task in 15s"},
+				},
+			},
+			sdk.StatusReport_ALIVE,
+			"Restarting task in 15s",
+		},
+
+		{
+			"unknown",
+			&api.TaskState{State: "weird"},
+			sdk.StatusReport_UNKNOWN,
+			"task health could not be determined",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			r := taskStateToHealth("web", tt.State)
+			require.Equal("web", r.Name)
+			require.Equal(tt.Health, r.Health)
+			require.Equal(tt.Message, r.HealthMessage)
+		})
+	}
+}
@@ -41,6 +41,19 @@ type BuilderConfig struct {
 	// The Buildpack builder image to use, defaults to the standard heroku one.
 	Builder string `hcl:"builder,optional"`
 
+	// The run image to use instead of the one of the builder's stack.
+	RunImage string `hcl:"run_image,optional"`
+
+	// Run all the lifecycle phases in a single container, giving the
+	// builder access to the registry credentials.
+	TrustBuilder bool `hcl:"trust_builder,optional"`
+
+	// Clear the build cache before building.
+	ClearCache bool `hcl:"clear_cache,optional"`
+
+	// Volumes to mount into the build container, as "source:target[:mode]".
+	Volumes []string `hcl:"volumes,optional"`
+
 	// The exact buildpacks to use.
 	Buildpacks []string `hcl:"buildpacks,optional"`
 
@@ -131,11 +144,17 @@ func (b *Builder) Build(
 	step.Done()
 
 	bo := pack.BuildOptions{
-		Image:      src.App,
-		Builder:    builder,
-		AppPath:    src.Path,
-		Env:        b.config.StaticEnvVars,
-		Buildpacks: b.config.Buildpacks,
+		Image:        src.App,
+		Builder:      builder,
+		RunImage:     b.config.RunImage,
+		AppPath:      src.Path,
+		Env:          b.config.StaticEnvVars,
+		Buildpacks:   b.config.Buildpacks,
+		TrustBuilder: b.config.TrustBuilder,
+		ClearCache:   b.config.ClearCache,
+		ContainerConfig: pack.ContainerConfig{
+			Volumes: b.config.Volumes,
+		},
 		ProjectDescriptor: project.Descriptor{
 			Build: project.Build{
 				Exclude: b.config.Ignore,
@@ -263,6 +282,40 @@ as exposing the Docker socket, enabling Docker-in-Docker, etc. Unfortunately,
 pack doesn't support dockerless builds. Configuring Docker access within
 a Docker container is outside the scope of these docs, please search the
 internet for "Docker in Docker" or other terms for more information.
+
+### Build Cache
+
+Pack keeps the build cache in Docker volumes named after the image, which is
+the name of the app. Builds of the same app with the same Docker daemon reuse
+the cache, including builds on a static [runner](/docs/runner). Set
+"clear_cache" to build without it. To keep other caches between builds, such
+as a package manager cache, mount a named volume with "volumes".
+
+### Build Environment
+
+The "static_environment" variables are only available during the build. To
+give the build access to the app configuration set with
+[` + "`waypoint config`" + `](/commands/config-set), such as a token for a
+private package registry, use ` + "`config.env`" + `. The app configuration is
+only read for builds that use it.
+`)
+
+	doc.Example(`
+build {
+  use "pack" {
+    builder       = "registry.example.com/builders/java:latest"
+    run_image     = "registry.example.com/stacks/run:base"
+    trust_builder = true
+
+    // Keep the Maven repository between builds
+    volumes = ["maven-cache:/home/cnb/.m2"]
+
+    static_environment = {
+      BP_JVM_VERSION  = "11"
+      MAVEN_REPO_PASS = config.env["MAVEN_REPO_PASS"]
+    }
+  }
+}
 `)
 
 	doc.Example(`
@@ -298,6 +351,43 @@ build {
 		docs.Default(DefaultBuilder),
 	)
 
+	doc.SetField(
+		"run_image",
+		"The run image to use for the app image",
+		docs.Summary(
+			"This replaces the run image of the builder's stack, which is the",
+			"base of the image that is built. It must be compatible with the stack.",
+		),
+	)
+
+	doc.SetField(
+		"trust_builder",
+		"Trust the builder",
+		docs.Summary(
+			"Trusted builders run all the phases of the build in a single container",
+			"which is faster, but gives the buildpacks access to the registry",
+			"credentials. Only trust builders from a source you trust.",
+		),
+	)
+
+	doc.SetField(
+		"clear_cache",
+		"Build without the cache of previous builds",
+		docs.Summary(
+			"The cache is cleared and filled again by this build.",
+		),
+	)
+
+	doc.SetField(
+		"volumes",
+		"Volumes to mount into the build container",
+		docs.Summary(
+			"Each volume is `source:target` with an optional `:ro` or `:rw` mode.",
+			"The source may be a named Docker volume, which is kept between builds,",
+			"or a path on the Docker host.",
+		),
+	)
+
 	doc.SetField(
 		"buildpacks",
 		"The exact buildpacks to use",
@@ -734,8 +734,13 @@ func buildAppConfig(
 
 	funcs.AddEntrypointFunctions(&ectx)
 
+	// Static values that aren't templates can be referenced by templates,
+	// such as config files set with the CLI that reference env vars.
+	env, internal := staticEvalVars(staticVars)
+
 	// If we have no dynamic values, then we just return the static ones.
 	if len(dynamic) == 0 {
+		ectx.Variables = configEvalVars(env, internal)
 		return expandStaticVars(log, &ectx, staticVars)
 	}
 
@@ -751,11 +756,6 @@ func buildAppConfig(
 	// partial evaluation at this stage because there is never a further step, so we can
 	// presume all the variables are present OR there is an error. In the case of an error,
 	// we log about the issue and set the variable to empty string.
-	ectx.Variables = map[string]cty.Value{}
-
-	env := map[string]cty.Value{}
-	internal := map[string]cty.Value{}
-
 	// Ininitialize our result with the static values
 	var envVars []string
 
@@ -845,6 +845,37 @@ func buildAppConfig(
 		}
 	}
 
+	ectx.Variables = configEvalVars(env, internal)
+	staticEnv, staticFiles := expandStaticVars(log, &ectx, staticVars)
+
+	return append(envVars, staticEnv...), staticFiles
+}
+
+// staticEvalVars returns the values of the static env and internal vars
+// that aren't templates, by name.
+func staticEvalVars(vars []*staticVar) (env, internal map[string]cty.Value) {
+	env = map[string]cty.Value{}
+	internal = map[string]cty.Value{}
+	for _, v := range vars {
+		if v.cv.NameIsPath ||
+			strings.Contains(v.value, "${") || strings.Contains(v.value, "%{") {
+			continue
+		}
+
+		if v.cv.Internal {
+			internal[v.cv.Name] = cty.StringVal(v.value)
+		} else {
+			env[v.cv.Name] = cty.StringVal(v.value)
+		}
+	}
+
+	return env, internal
+}
+
+// configEvalVars returns the variables of the eval context for templates,
+// which can reference the env and internal vars as config.env.NAME and
+// config.internal.NAME.
+func configEvalVars(env, internal map[string]cty.Value) map[string]cty.Value {
 	// MapVal REALLY does not want an empty map (due to typing) so we do this dance.
 	config := map[string]cty.Value{}
 
@@ -856,13 +887,12 @@ func buildAppConfig(
 		config["internal"] = cty.MapVal(internal)
 	}
 
+	result := map[string]cty.Value{}
 	if len(config) > 0 {
-		ectx.Variables["config"] = cty.MapVal(config)
+		result["config"] = cty.MapVal(config)
 	}
 
-	staticEnv, staticFiles := expandStaticVars(log, &ectx, staticVars)
-
-	return append(envVars, staticEnv...), staticFiles
+	return result
 }
 
 // expandStaticVars will parse any value that appears to be a HCL template as one and then
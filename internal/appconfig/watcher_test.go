@@ -424,6 +424,65 @@ func TestWatcher_variableEscape(t *testing.T) {
 	require.Equal([]string{"V1=connect://${get_hostname()}"}, env.EnvVars)
 }
 
+func TestWatcher_fileTemplate(t *testing.T) {
+	t.Parallel()
+
+	require := require.New(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	w, err := NewWatcher(WithRefreshInterval(10 * time.Millisecond))
+	require.NoError(err)
+	defer w.Close()
+
+	// A file that references static env and internal vars
+	w.UpdateVars(ctx, []*pb.ConfigVar{
+		{
+			Name:  "PORT",
+			Value: &pb.ConfigVar_Static{Static: "8080"},
+		},
+		{
+			Name:     "db_host",
+			Internal: true,
+			Value:    &pb.ConfigVar_Static{Static: "db.service"},
+		},
+		{
+			Name:       "/app/config.yml",
+			NameIsPath: true,
+			Value: &pb.ConfigVar_Static{
+				Static: "port: ${config.env.PORT}\nhost: ${config.internal.db_host}\n",
+			},
+		},
+	})
+
+	env, iter, err := w.Next(ctx, 0)
+	require.NoError(err)
+	require.Equal([]string{"PORT=8080"}, env.EnvVars)
+	require.Len(env.Files, 1)
+	require.Equal("/app/config.yml", env.Files[0].Path)
+	require.Equal("port: 8080\nhost: db.service\n", string(env.Files[0].Data))
+
+	// Changing the referenced var renders the file again
+	w.UpdateVars(ctx, []*pb.ConfigVar{
+		{
+			Name:  "PORT",
+			Value: &pb.ConfigVar_Static{Static: "9090"},
+		},
+		{
+			Name:       "/app/config.yml",
+			NameIsPath: true,
+			Value: &pb.ConfigVar_Static{
+				Static: "port: ${config.env.PORT}\n",
+			},
+		},
+	})
+
+	env, _, err = w.Next(ctx, iter)
+	require.NoError(err)
+	require.True(env.UpdatedFiles)
+	require.Equal("port: 9090\n", string(env.Files[0].Data))
+}
+
 type testConfigSourcer struct {
 	sync.Mutex
 
@@ -0,0 +1,236 @@
+// Package pat looks up the identity of GitHub and GitLab users from
+// their personal access tokens for the PAT auth method.
+package pat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/waypoint/internal/pkg/githubapi"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+const (
+	// DefaultGitHubURL and DefaultGitLabURL are the public APIs used if
+	// the auth method has no API URL.
+	DefaultGitHubURL = githubapi.DefaultURL
+	DefaultGitLabURL = "https://gitlab.com/api/v4"
+)
+
+// ErrInvalidToken is returned by Lookup if the provider rejects the token.
+var ErrInvalidToken = errors.New("personal access token is invalid or expired")
+
+// Identity is the identity of a user with a provider.
+type Identity struct {
+	// ID uniquely identifies the user with the provider. Unlike the
+	// username, this never changes.
+	ID       string
+	Username string
+	Email    string
+
+	// Orgs and Teams that the user is a member of. Teams are in the form
+	// "org/team".
+	Orgs  []string
+	Teams []string
+}
+
+// APIURL returns the API URL for the auth method.
+func APIURL(am *pb.AuthMethod_PAT) string {
+	if am.ApiUrl != "" {
+		return strings.TrimSuffix(am.ApiUrl, "/")
+	}
+
+	switch am.Provider {
+	case pb.AuthMethod_PAT_GITLAB:
+		return DefaultGitLabURL
+	default:
+		return DefaultGitHubURL
+	}
+}
+
+// Lookup returns the identity of the owner of the token. This returns
+// an error if the token is invalid or the provider can't be reached.
+func Lookup(ctx context.Context, am *pb.AuthMethod_PAT, token string) (*Identity, error) {
+	c := newClient(am, token)
+
+	var id *Identity
+	var err error
+	switch am.Provider {
+	case pb.AuthMethod_PAT_GITHUB:
+		id, err = github(ctx, c)
+	case pb.AuthMethod_PAT_GITLAB:
+		id, err = gitlab(ctx, c)
+	default:
+		return nil, fmt.Errorf("unknown provider: %s", am.Provider)
+	}
+	if err == githubapi.ErrUnauthorized {
+		err = ErrInvalidToken
+	}
+
+	return id, err
+}
+
+// Check verifies that the API of the auth method can be reached and
+// looks like the API of the provider. This doesn't require a token.
+func Check(ctx context.Context, am *pb.AuthMethod_PAT) error {
+	// Without a token, the user endpoint of both providers responds with
+	// unauthorized. Anything else means this isn't the API we expect.
+	var v struct{}
+	err := newClient(am, "").Get(ctx, "/user", &v)
+	switch {
+	case err == githubapi.ErrUnauthorized:
+		return nil
+	case err == nil:
+		return fmt.Errorf("%s/user doesn't require authentication, "+
+			"is this the %s API?", APIURL(am), ProviderName(am.Provider))
+	default:
+		return fmt.Errorf("error calling the %s API at %s, "+
+			"make sure it is reachable from the server: %s",
+			ProviderName(am.Provider), APIURL(am), err)
+	}
+}
+
+// Allowed returns true if the identity is a member of any of the orgs or
+// teams of the auth method, or if the auth method has neither.
+func Allowed(am *pb.AuthMethod_PAT, id *Identity) bool {
+	if len(am.Orgs) == 0 && len(am.Teams) == 0 {
+		return true
+	}
+
+	return containsAny(am.Orgs, id.Orgs) || containsAny(am.Teams, id.Teams)
+}
+
+// SelectorData returns the data for go-bexpr for selector evaluation.
+// This matches the shape of OIDC selector data so access selectors
+// read the same, i.e. "acme in list.orgs".
+func SelectorData(id *Identity) map[string]interface{} {
+	return map[string]interface{}{
+		"value": map[string]interface{}{
+			"id":       id.ID,
+			"username": id.Username,
+			"email":    id.Email,
+		},
+		"list": map[string]interface{}{
+			"orgs":  id.Orgs,
+			"teams": id.Teams,
+		},
+	}
+}
+
+// ProviderName returns the human name of the provider.
+func ProviderName(p pb.AuthMethod_PAT_Provider) string {
+	switch p {
+	case pb.AuthMethod_PAT_GITHUB:
+		return "GitHub"
+	case pb.AuthMethod_PAT_GITLAB:
+		return "GitLab"
+	default:
+		return p.String()
+	}
+}
+
+func containsAny(allowed, values []string) bool {
+	for _, a := range allowed {
+		for _, v := range values {
+			// Org and team names are case-insensitive with both providers.
+			if strings.EqualFold(a, v) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// newClient returns a client for the API of the auth method.
+func newClient(am *pb.AuthMethod_PAT, token string) *githubapi.Client {
+	c := &githubapi.Client{URL: APIURL(am), Token: token}
+	if am.Provider == pb.AuthMethod_PAT_GITLAB {
+		c.Accept = "application/json"
+	}
+
+	return c
+}
+
+func github(ctx context.Context, c *githubapi.Client) (*Identity, error) {
+	var user struct {
+		ID    int64  `json:"id"`
+		Login string `json:"login"`
+		Email string `json:"email"`
+	}
+	if err := c.Get(ctx, "/user", &user); err != nil {
+		return nil, err
+	}
+
+	result := &Identity{
+		ID:       strconv.FormatInt(user.ID, 10),
+		Username: user.Login,
+		Email:    user.Email,
+	}
+
+	var orgs []struct {
+		Login string `json:"login"`
+	}
+	if err := c.GetAll(ctx, "/user/orgs", func() interface{} { return &orgs }, func() {
+		for _, o := range orgs {
+			result.Orgs = append(result.Orgs, o.Login)
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	var teams []struct {
+		Slug         string `json:"slug"`
+		Organization struct {
+			Login string `json:"login"`
+		} `json:"organization"`
+	}
+	if err := c.GetAll(ctx, "/user/teams", func() interface{} { return &teams }, func() {
+		for _, t := range teams {
+			result.Teams = append(result.Teams, t.Organization.Login+"/"+t.Slug)
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func gitlab(ctx context.Context, c *githubapi.Client) (*Identity, error) {
+	var user struct {
+		ID       int64  `json:"id"`
+		Username string `json:"username"`
+		Email    string `json:"email"`
+	}
+	if err := c.Get(ctx, "/user", &user); err != nil {
+		return nil, err
+	}
+
+	result := &Identity{
+		ID:       strconv.FormatInt(user.ID, 10),
+		Username: user.Username,
+		Email:    user.Email,
+	}
+
+	// Top-level groups are orgs and subgroups are teams. Listing groups
+	// only returns groups the user is a member of.
+	var groups []struct {
+		FullPath string `json:"full_path"`
+	}
+	if err := c.GetAll(ctx, "/groups", func() interface{} { return &groups }, func() {
+		for _, g := range groups {
+			if strings.Contains(g.FullPath, "/") {
+				result.Teams = append(result.Teams, g.FullPath)
+			} else {
+				result.Orgs = append(result.Orgs, g.FullPath)
+			}
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
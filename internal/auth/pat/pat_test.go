@@ -0,0 +1,126 @@
+package pat
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/pkg/githubapi"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestLookup_github(t *testing.T) {
+	require := require.New(t)
+
+	am := &pb.AuthMethod_PAT{
+		Provider: pb.AuthMethod_PAT_GITHUB,
+		ApiUrl:   testGitHubURL(t),
+	}
+
+	id, err := Lookup(context.Background(), am, "secret")
+	require.NoError(err)
+	require.Equal("42", id.ID)
+	require.Equal("alice", id.Username)
+	require.Equal([]string{"acme", "globex"}, id.Orgs)
+	require.Equal([]string{"acme/ops"}, id.Teams)
+
+	// Invalid token
+	_, err = Lookup(context.Background(), am, "nope")
+	require.Equal(ErrInvalidToken, err)
+}
+
+func TestLookup_gitlab(t *testing.T) {
+	require := require.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal("Bearer secret", r.Header.Get("Authorization"))
+
+		switch r.URL.Path {
+		case "/user":
+			fmt.Fprint(w, `{"id": 7, "username": "bob"}`)
+		case "/groups":
+			fmt.Fprint(w, `[{"full_path": "acme"}, {"full_path": "acme/ops/oncall"}]`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	id, err := Lookup(context.Background(), &pb.AuthMethod_PAT{
+		Provider: pb.AuthMethod_PAT_GITLAB,
+		ApiUrl:   srv.URL + "/",
+	}, "secret")
+	require.NoError(err)
+	require.Equal("7", id.ID)
+	require.Equal("bob", id.Username)
+	require.Equal([]string{"acme"}, id.Orgs)
+	require.Equal([]string{"acme/ops/oncall"}, id.Teams)
+}
+
+func TestCheck(t *testing.T) {
+	require := require.New(t)
+
+	url := testGitHubURL(t)
+
+	// GitHub API
+	require.NoError(Check(context.Background(), &pb.AuthMethod_PAT{
+		Provider: pb.AuthMethod_PAT_GITHUB,
+		ApiUrl:   url,
+	}))
+
+	// Something else
+	err := Check(context.Background(), &pb.AuthMethod_PAT{
+		Provider: pb.AuthMethod_PAT_GITHUB,
+		ApiUrl:   url + "/nope",
+	})
+	require.Error(err)
+	require.Contains(err.Error(), "not found")
+}
+
+func TestAllowed(t *testing.T) {
+	id := &Identity{
+		Orgs:  []string{"acme"},
+		Teams: []string{"acme/ops"},
+	}
+
+	cases := []struct {
+		Name   string
+		Orgs   []string
+		Teams  []string
+		Result bool
+	}{
+		{"no restrictions", nil, nil, true},
+		{"org match", []string{"ACME"}, nil, true},
+		{"org mismatch", []string{"globex"}, nil, false},
+		{"team match", []string{"globex"}, []string{"acme/ops"}, true},
+		{"team mismatch", nil, []string{"acme/dev"}, false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require.Equal(t, tt.Result, Allowed(&pb.AuthMethod_PAT{
+				Orgs:  tt.Orgs,
+				Teams: tt.Teams,
+			}, id))
+		})
+	}
+}
+
+// testGitHubURL starts a fake GitHub API and returns its URL. The token
+// "secret" belongs to alice, who is in two orgs and one team.
+func testGitHubURL(t *testing.T) string {
+	return githubapi.TestServer(t, &githubapi.TestAPI{
+		Users: map[string]*githubapi.TestUser{
+			"secret": {
+				ID:    42,
+				Login: "alice",
+				Orgs:  []string{"acme", "globex"},
+				Teams: []string{"acme/ops"},
+			},
+		},
+	})
+}
@@ -209,6 +209,84 @@ func TestConfig_fileChange(t *testing.T) {
 	require.Equal(data, data2)
 }
 
+func TestConfig_fileChangeRestart(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Start up the server
+	impl := singleprocess.TestImpl(t)
+	client := server.TestServer(t, impl, server.TestWithContext(ctx))
+
+	// Create a temporary directory for our test
+	td, err := ioutil.TempDir("", "test")
+	require.NoError(err)
+	defer os.RemoveAll(td)
+
+	path := filepath.Join(td, "hello")
+
+	// The config file is in a directory that doesn't exist yet
+	fooPath := filepath.Join(td, "config", "foo.txt")
+
+	// Start the CEB
+	ceb := testRun(t, context.Background(), &testRunOpts{
+		Client: client,
+		Helper: "read-file",
+		HelperEnv: map[string]string{
+			"HELPER_PATH": path,
+			"READ_PATH":   fooPath,
+		},
+	})
+
+	// The child should still start up
+	var data []byte
+	require.Eventually(func() bool {
+		var err error
+		data, err = ioutil.ReadFile(path)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	pid := strings.SplitN(string(data), ",", 2)[0]
+
+	// Get our deployment
+	deployment, err := client.GetDeployment(ctx, &pb.GetDeploymentRequest{
+		Ref: &pb.Ref_Operation{
+			Target: &pb.Ref_Operation_Id{
+				Id: ceb.DeploymentId(),
+			},
+		},
+	})
+	require.NoError(err)
+
+	client.UpsertApplication(ctx, &pb.UpsertApplicationRequest{
+		Project:          &pb.Ref_Project{Project: deployment.Application.Project},
+		Name:             deployment.Application.Application,
+		FileChangeSignal: "restart",
+	})
+
+	// Change our config
+	_, err = client.SetConfig(ctx, &pb.ConfigSetRequest{
+		Variables: []*pb.ConfigVar{
+			{
+				Scope: &pb.ConfigVar_Application{
+					Application: deployment.Application,
+				},
+				Name:       fooPath,
+				NameIsPath: true,
+				Value:      &pb.ConfigVar_Static{Static: "via config"},
+			},
+		},
+	})
+	require.NoError(err)
+
+	// The child should be restarted and read the file on startup
+	require.Eventually(func() bool {
+		var err error
+		data, err = ioutil.ReadFile(path)
+		return err == nil && strings.Contains(string(data), "via config")
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NotEqual(pid, strings.SplitN(string(data), ",", 2)[0])
+}
+
 // Test that we read dynamic config variables.
 func TestConfig_dynamicSuccess(t *testing.T) {
 	require := require.New(t)
@@ -101,6 +101,12 @@ type CEB struct {
 	urlAgentCtx    context.Context
 	urlAgentCancel func()
 
+	// healthCheckMu protects the health check fields. healthCheckEnv is the
+	// app config env vars used for command checks.
+	healthCheckMu     sync.Mutex
+	healthCheckCancel func()
+	healthCheckEnv    []string
+
 	//---------------------------------------------------------------
 	// Config sourcing
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 
 	"github.com/hashicorp/waypoint/internal/appconfig"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
 )
 
 var (
@@ -116,6 +118,10 @@ func (ceb *CEB) watchConfig(
 	// with the latest/current view on appCfg vars too.
 	var appCfg *appconfig.UpdatedConfig
 
+	// healthChecks are the health checks we're currently running. These
+	// are only restarted when they change.
+	var healthChecks []*pb.HealthCheck
+
 	// Start the app config watcher. This runs in its own goroutine so that
 	// stuff like dynamic config fetching doesn't block starting things like
 	// exec sessions.
@@ -165,6 +171,11 @@ func (ceb *CEB) watchConfig(
 			// Respect any value sent down right away.
 			cfg.FileRewriteSignal = config.FileChangeSignal
 
+			if !serverptypes.HealthChecksEqual(healthChecks, config.HealthChecks) {
+				healthChecks = config.HealthChecks
+				ceb.initHealthChecks(ctx, cfg.URLServicePort, healthChecks)
+			}
+
 			// Configure our env vars for the child command. We always send
 			// these even if they're nil since the app config watcher will
 			// de-dup and we want to handle removing env vars.
@@ -179,11 +190,12 @@ func (ceb *CEB) watchConfig(
 
 			log.Trace("received new config")
 
+			var restart bool
 			if appCfg.UpdatedFiles && len(appCfg.Files) > 0 {
-				ceb.writeFiles(log, cfg, appCfg)
+				restart = ceb.writeFiles(log, cfg, appCfg)
 			}
 
-			if !appCfg.UpdatedEnv {
+			if !appCfg.UpdatedEnv && !restart {
 				log.Trace("updated env did not include new env vars, skipping restart")
 				continue
 			}
@@ -191,13 +203,14 @@ func (ceb *CEB) watchConfig(
 			// Process it for any keys that we handle differently (such as
 			// WAYPOINT_LOG_LEVEL)
 			ceb.processAppEnv(appCfg.EnvVars)
+			ceb.setHealthCheckEnv(appCfg.EnvVars)
 
 			// Set our new env vars
 			newCmd := ceb.copyCmd(ceb.childCmdBase)
 			newCmd.Env = append(newCmd.Env, appCfg.EnvVars...)
 
 			// Restart
-			log.Info("env vars changed, sending new child command")
+			log.Info("config changed, sending new child command")
 			select {
 			case ceb.childCmdCh <- newCmd:
 			case <-ctx.Done():
@@ -263,13 +276,21 @@ var sigMap = map[string]os.Signal{
 	"WINCH":  unix.SIGWINCH,
 }
 
-func (ceb *CEB) writeFiles(log hclog.Logger, cfg *config, env *appconfig.UpdatedConfig) {
+// fileChangeRestart is the file change signal that restarts the
+// application rather than sending it a signal, for applications that only
+// read their config files on startup.
+const fileChangeRestart = "restart"
+
+// writeFiles writes the application config files to disk and sends the
+// file change signal to the child command. This returns true if the child
+// command must be restarted instead.
+func (ceb *CEB) writeFiles(log hclog.Logger, cfg *config, env *appconfig.UpdatedConfig) bool {
 	log.Debug("writing application files to disk", "count", len(env.Files))
 
 	var sendSignal bool
 
 	for _, fc := range env.Files {
-		err := ioutil.WriteFile(fc.Path, fc.Data, 0644)
+		err := writeFileAtomic(fc.Path, fc.Data)
 		if err != nil {
 			log.Error("error writing application file", "error", err, "path", fc.Path)
 		} else {
@@ -278,13 +299,51 @@ func (ceb *CEB) writeFiles(log hclog.Logger, cfg *config, env *appconfig.Updated
 		}
 	}
 
-	if sendSignal && cfg.FileRewriteSignal != "" {
-		if sig, ok := sigMap[strings.ToUpper(cfg.FileRewriteSignal)]; ok {
-			ceb.childSigCh <- sig
-		} else {
-			log.Error("unknown signal defined for file restart", "signal", cfg.FileRewriteSignal)
-		}
+	if !sendSignal || cfg.FileRewriteSignal == "" {
+		return false
+	}
+
+	if strings.EqualFold(cfg.FileRewriteSignal, fileChangeRestart) {
+		return true
+	}
+
+	if sig, ok := sigMap[strings.ToUpper(cfg.FileRewriteSignal)]; ok {
+		ceb.childSigCh <- sig
+	} else {
+		log.Error("unknown signal defined for file restart", "signal", cfg.FileRewriteSignal)
 	}
+
+	return false
+}
+
+// writeFileAtomic writes the file by writing a temporary file in the same
+// directory and renaming it, so the application never reads a partially
+// written file. The directory is created if it doesn't exist.
+func writeFileAtomic(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(dir, "."+filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Chmod(0644); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
 }
 
 func (ceb *CEB) recvConfig(
@@ -69,6 +69,18 @@ func (ceb *CEB) startExec(execConfig *pb.EntrypointConfig_Exec, env []string) {
 		return
 	}
 
+	// Port forwarding sessions connect to a port rather than run a command.
+	if pf := execConfig.PortForward; pf != nil {
+		ceb.startPortForward(log, client, pf.Port)
+		return
+	}
+
+	// File copy sessions read or write a path rather than run a command.
+	if fc := execConfig.FileCopy; fc != nil {
+		ceb.startFileCopy(log, client, fc)
+		return
+	}
+
 	// Build our command
 	cmd, err := ceb.buildCmd(ceb.context, execConfig.Args)
 	if err != nil {
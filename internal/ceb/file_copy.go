@@ -0,0 +1,91 @@
+package ceb
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/waypoint/internal/ceb/execwriter"
+	"github.com/hashicorp/waypoint/internal/pkg/tarcopy"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// startFileCopy copies the path of the file copy to or from the exec
+// stream as a tar stream. Like a command, this writes any error to stderr
+// and exits with a non-zero code so that the client can show the error.
+func (ceb *CEB) startFileCopy(
+	log hclog.Logger,
+	client pb.Waypoint_EntrypointExecStreamClient,
+	fc *pb.ExecStreamRequest_FileCopy,
+) {
+	log = log.With("path", fc.Path, "upload", fc.Upload)
+	log.Info("starting file copy")
+
+	var err error
+	if fc.Upload {
+		err = ceb.fileCopyUpload(log, client, fc.Path)
+	} else {
+		err = tarcopy.Write(
+			execwriter.Writer(client, pb.EntrypointExecRequest_Output_STDOUT), fc.Path)
+	}
+
+	var code int32
+	if err != nil {
+		log.Warn("error copying files", "err", err)
+		code = 1
+
+		stderr := execwriter.Writer(client, pb.EntrypointExecRequest_Output_STDERR)
+		fmt.Fprintf(stderr, "%s\n", err)
+	}
+
+	log.Info("file copy finished", "code", code)
+	if err := client.Send(&pb.EntrypointExecRequest{
+		Event: &pb.EntrypointExecRequest_Exit_{
+			Exit: &pb.EntrypointExecRequest_Exit{
+				Code: code,
+			},
+		},
+	}); err != nil {
+		log.Warn("error sending exit message", "err", err)
+	}
+}
+
+// fileCopyUpload extracts the input of the stream to path.
+func (ceb *CEB) fileCopyUpload(
+	log hclog.Logger,
+	client pb.Waypoint_EntrypointExecStreamClient,
+	path string,
+) error {
+	pr, pw := io.Pipe()
+
+	// If the extraction fails early, this unblocks the writes below.
+	defer pr.Close()
+
+	go func() {
+		for {
+			resp, err := client.Recv()
+			if err != nil {
+				if err == io.EOF {
+					err = io.ErrUnexpectedEOF
+				}
+				pw.CloseWithError(err)
+				return
+			}
+
+			switch event := resp.Event.(type) {
+			case *pb.EntrypointExecResponse_Input:
+				if _, err := pw.Write(event.Input); err != nil {
+					return
+				}
+
+			case *pb.EntrypointExecResponse_InputEof:
+				log.Trace("input EOF, all files received")
+				pw.Close()
+				return
+			}
+		}
+	}()
+
+	return tarcopy.Extract(pr, path)
+}
@@ -0,0 +1,205 @@
+package ceb
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os/exec"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-hclog"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+const (
+	// healthCheckDefaultInterval and healthCheckDefaultTimeout are used
+	// for checks that don't set an interval or timeout.
+	healthCheckDefaultInterval = 30 * time.Second
+	healthCheckDefaultTimeout  = 5 * time.Second
+)
+
+// initHealthChecks starts running the health checks of the app, stopping
+// any checks that were started previously. port is the port of the app,
+// used for checks that don't set one. The latest results of all checks are
+// reported to the server after each check.
+func (ceb *CEB) initHealthChecks(ctx context.Context, port int, checks []*pb.HealthCheck) {
+	ceb.healthCheckMu.Lock()
+	defer ceb.healthCheckMu.Unlock()
+
+	if ceb.healthCheckCancel != nil {
+		ceb.healthCheckCancel()
+		ceb.healthCheckCancel = nil
+	}
+
+	if len(checks) == 0 {
+		return
+	}
+
+	L := ceb.logger.Named("health")
+	L.Debug("starting health checks", "count", len(checks))
+
+	ctx, ceb.healthCheckCancel = context.WithCancel(ctx)
+
+	var resultsMu sync.Mutex
+	results := map[string]*pb.HealthCheckResult{}
+	for _, check := range checks {
+		go func(check *pb.HealthCheck) {
+			// The durations are validated when the config is loaded so
+			// we just fall back to the defaults if they don't parse.
+			interval, err := time.ParseDuration(check.Interval)
+			if err != nil || interval <= 0 {
+				interval = healthCheckDefaultInterval
+			}
+			timeout, err := time.ParseDuration(check.Timeout)
+			if err != nil || timeout <= 0 {
+				timeout = healthCheckDefaultTimeout
+			}
+
+			for {
+				result := ceb.runHealthCheck(ctx, port, check, timeout)
+				if ctx.Err() != nil {
+					return
+				}
+
+				resultsMu.Lock()
+				results[check.Name] = result
+				var list []*pb.HealthCheckResult
+				for _, r := range results {
+					list = append(list, r)
+				}
+				resultsMu.Unlock()
+
+				sort.Slice(list, func(i, j int) bool {
+					return list[i].Name < list[j].Name
+				})
+				ceb.reportHealthChecks(ctx, L, list)
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(interval):
+				}
+			}
+		}(check)
+	}
+}
+
+// runHealthCheck runs a single health check and returns the result.
+func (ceb *CEB) runHealthCheck(
+	ctx context.Context,
+	port int,
+	check *pb.HealthCheck,
+	timeout time.Duration,
+) *pb.HealthCheckResult {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if check.Port > 0 {
+		port = int(check.Port)
+	}
+	addr := fmt.Sprintf("127.0.0.1:%d", port)
+
+	var err error
+	switch check.Type {
+	case pb.HealthCheck_HTTP:
+		err = healthCheckHTTP(ctx, "http://"+addr+check.Path)
+
+	case pb.HealthCheck_TCP:
+		var d net.Dialer
+		var conn net.Conn
+		conn, err = d.DialContext(ctx, "tcp", addr)
+		if err == nil {
+			conn.Close()
+		}
+
+	case pb.HealthCheck_COMMAND:
+		err = ceb.healthCheckCommand(ctx, check.Command)
+
+	default:
+		err = fmt.Errorf("unknown health check type: %s", check.Type)
+	}
+
+	result := &pb.HealthCheckResult{
+		Name:         check.Name,
+		HealthStatus: "READY",
+		Time:         ptypes.TimestampNow(),
+	}
+	if err != nil {
+		result.HealthStatus = "DOWN"
+		result.HealthMessage = err.Error()
+	}
+
+	return result
+}
+
+// healthCheckHTTP sends a GET request to the url. This returns an error if
+// the request fails or the response status is 400 or above.
+func healthCheckHTTP(ctx context.Context, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	return nil
+}
+
+// healthCheckCommand runs the command with the same environment as the
+// child command. This returns an error if the command doesn't exit 0.
+func (ceb *CEB) healthCheckCommand(ctx context.Context, args []string) error {
+	ceb.healthCheckMu.Lock()
+	env := ceb.healthCheckEnv
+	ceb.healthCheckMu.Unlock()
+
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Dir = ceb.childCmdBase.Dir
+	cmd.Env = append(append([]string{}, ceb.childCmdBase.Env...), env...)
+
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		if len(out) > 0 {
+			return fmt.Errorf("%s: %s", err, out)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// reportHealthChecks sends the results to the server.
+func (ceb *CEB) reportHealthChecks(
+	ctx context.Context,
+	log hclog.Logger,
+	results []*pb.HealthCheckResult,
+) {
+	client := ceb.waitClient()
+	_, err := client.EntrypointHealthReport(ctx, &pb.EntrypointHealthReportRequest{
+		InstanceId: ceb.id,
+		Results:    results,
+	})
+	if err != nil && ctx.Err() == nil {
+		log.Warn("error reporting health checks", "err", err)
+	}
+}
+
+// setHealthCheckEnv sets the app config env vars used for command checks.
+func (ceb *CEB) setHealthCheckEnv(env []string) {
+	ceb.healthCheckMu.Lock()
+	defer ceb.healthCheckMu.Unlock()
+	ceb.healthCheckEnv = env
+}
@@ -0,0 +1,68 @@
+package ceb
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestCEB_runHealthCheck(t *testing.T) {
+	ctx := context.Background()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/healthz" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	_, portStr, err := net.SplitHostPort(srv.Listener.Addr().String())
+	require.NoError(t, err)
+	port, err := net.LookupPort("tcp", portStr)
+	require.NoError(t, err)
+
+	ceb := &CEB{}
+
+	t.Run("http passing", func(t *testing.T) {
+		require := require.New(t)
+
+		result := ceb.runHealthCheck(ctx, port, &pb.HealthCheck{
+			Name: "web",
+			Type: pb.HealthCheck_HTTP,
+			Path: "/healthz",
+		}, time.Second)
+		require.Equal("web", result.Name)
+		require.Equal("READY", result.HealthStatus)
+		require.NotNil(result.Time)
+	})
+
+	t.Run("http failing", func(t *testing.T) {
+		require := require.New(t)
+
+		result := ceb.runHealthCheck(ctx, port, &pb.HealthCheck{
+			Name: "web",
+			Type: pb.HealthCheck_HTTP,
+			Path: "/nope",
+		}, time.Second)
+		require.Equal("DOWN", result.HealthStatus)
+		require.Contains(result.HealthMessage, "503")
+	})
+
+	t.Run("tcp passing", func(t *testing.T) {
+		require := require.New(t)
+
+		result := ceb.runHealthCheck(ctx, 0, &pb.HealthCheck{
+			Name: "tcp",
+			Type: pb.HealthCheck_TCP,
+			Port: int32(port),
+		}, time.Second)
+		require.Equal("READY", result.HealthStatus)
+	})
+}
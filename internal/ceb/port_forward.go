@@ -0,0 +1,101 @@
+package ceb
+
+import (
+	"io"
+	"net"
+	"strconv"
+
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/internal/ceb/execwriter"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// startPortForward connects to the given local port and copies data
+// between the connection and the exec stream until either side closes.
+// Data from the connection is sent as stdout output and the exit message
+// is sent once the connection is closed.
+func (ceb *CEB) startPortForward(
+	log hclog.Logger,
+	client pb.Waypoint_EntrypointExecStreamClient,
+	port int32,
+) {
+	log = log.With("port", port)
+	log.Info("connecting to port for port forwarding")
+
+	var d net.Dialer
+	conn, err := d.DialContext(ceb.context, "tcp",
+		net.JoinHostPort("localhost", strconv.Itoa(int(port))))
+	if err != nil {
+		log.Warn("error connecting to port", "err", err)
+		st := status.Newf(codes.Unavailable, "failed to connect to port %d: %s", port, err)
+		if err := client.Send(&pb.EntrypointExecRequest{
+			Event: &pb.EntrypointExecRequest_Error_{
+				Error: &pb.EntrypointExecRequest_Error{
+					Error: st.Proto(),
+				},
+			},
+		}); err != nil {
+			log.Warn("error sending error message", "err", err)
+		}
+
+		return
+	}
+	defer conn.Close()
+
+	// Copy data from the server to the connection. If the stream ends, we
+	// close the connection which ends the copy below.
+	go func() {
+		defer conn.Close()
+
+		for {
+			resp, err := client.Recv()
+			if err != nil {
+				if err == io.EOF {
+					log.Info("port forward stream ended by client")
+				} else {
+					log.Warn("error receiving from server stream", "err", err)
+				}
+				return
+			}
+
+			switch event := resp.Event.(type) {
+			case *pb.EntrypointExecResponse_Input:
+				if _, err := conn.Write(event.Input); err != nil {
+					log.Warn("error writing to connection", "err", err)
+					return
+				}
+
+			case *pb.EntrypointExecResponse_InputEof:
+				// The client closed its side, so we close our writing side
+				// and keep reading until the app closes the connection.
+				log.Trace("input EOF, closing the writing side of the connection")
+				if tcp, ok := conn.(*net.TCPConn); ok {
+					tcp.CloseWrite()
+				}
+			}
+		}
+	}()
+
+	// Copy data from the connection to the server until the connection
+	// closes. This is the only goroutine sending to the stream, so the
+	// sends don't need a lock.
+	var code int32
+	if _, err := io.Copy(execwriter.Writer(client, pb.EntrypointExecRequest_Output_STDOUT), conn); err != nil {
+		log.Debug("port forward connection closed with error", "err", err)
+		code = 1
+	}
+
+	log.Info("port forward connection closed")
+	if err := client.Send(&pb.EntrypointExecRequest{
+		Event: &pb.EntrypointExecRequest_Exit_{
+			Exit: &pb.EntrypointExecRequest_Exit{
+				Code: code,
+			},
+		},
+	}); err != nil {
+		log.Warn("error sending exit message", "err", err)
+	}
+}
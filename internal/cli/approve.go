@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type ApproveCommand struct {
+	*baseCommand
+
+	flagStep string
+}
+
+func (c *ApproveCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("A single pipeline run ID is required.\n\n"+c.Help(),
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	run, err := c.project.Client().ApprovePipelineRun(c.Ctx, &pb.ApprovePipelineRunRequest{
+		RunId: c.args[0],
+		Step:  c.flagStep,
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	for _, step := range run.Steps {
+		if step.ApprovedBy == "" {
+			continue
+		}
+		if c.flagStep != "" && step.Name != c.flagStep {
+			continue
+		}
+
+		c.ui.Output("Step %q of pipeline %q (run %d) approved by %s.",
+			step.Name, run.Pipeline.Name, run.Sequence, step.ApprovedBy,
+			terminal.WithSuccessStyle())
+	}
+
+	return 0
+}
+
+func (c *ApproveCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.StringVar(&flag.StringVar{
+			Name:   "step",
+			Target: &c.flagStep,
+			Usage: "Name of the step to approve. This is required if more " +
+				"than one step of the run is waiting for approval.",
+		})
+	})
+}
+
+func (c *ApproveCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ApproveCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ApproveCommand) Synopsis() string {
+	return "Approve a pipeline run that is waiting for approval."
+}
+
+func (c *ApproveCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint approve [options] RUN-ID
+
+  Approve a pipeline run that is waiting for approval.
+
+  Pipelines can have approval steps that pause the run until a user
+  approves it. Once approved, the steps that depend on the approval step
+  start. The user that approved the step is recorded with the run and can
+  be seen with "waypoint pipeline inspect RUN-ID".
+
+` + c.Flags().Help())
+}
@@ -47,7 +47,7 @@ func (c *ArtifactBuildCommand) Run(args []string) int {
 }
 
 func (c *ArtifactBuildCommand) Flags() *flag.Sets {
-	return c.flagSet(flagSetOperation, func(set *flag.Sets) {
+	return c.flagSet(flagSetOperation|flagSetParallel, func(set *flag.Sets) {
 		f := set.NewSet("Command Options")
 		f.BoolVar(&flag.BoolVar{
 			Name:    "push",
@@ -0,0 +1,251 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type ArtifactInspectCommand struct {
+	*baseCommand
+
+	flagVulnerabilities bool
+}
+
+func (c *ArtifactInspectCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithSingleApp(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("A single artifact sequence number or ID is required.\n\n"+c.Help(),
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	client := c.project.Client()
+	a, err := client.GetPushedArtifact(c.Ctx, &pb.GetPushedArtifactRequest{
+		Ref: artifactRef(c.refApp, c.args[0]),
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	var size string
+	if v := a.Artifact.GetSize(); v > 0 {
+		size = humanize.Bytes(v)
+	}
+
+	c.ui.Output("Artifact Info:", terminal.WithHeaderStyle())
+	c.ui.NamedValues([]terminal.NamedValue{
+		{
+			Name: "id", Value: a.Id,
+		},
+		{
+			Name: "sequence", Value: a.Sequence,
+		},
+		{
+			Name: "workspace", Value: a.Workspace.GetWorkspace(),
+		},
+		{
+			Name: "registry", Value: a.Component.GetName(),
+		},
+		{
+			Name: "status", Value: a.Status.GetState().String(),
+		},
+		{
+			Name: "build id", Value: a.BuildId,
+		},
+		{
+			Name: "digest", Value: a.Artifact.GetDigest(),
+		},
+		{
+			Name: "size", Value: size,
+		},
+		{
+			Name: "sbom", Value: a.Artifact.GetSbom(),
+		},
+		{
+			Name: "image", Value: a.Artifact.GetImage(),
+		},
+	}, terminal.WithInfoStyle())
+
+	if len(a.Artifact.GetAttestations()) > 0 {
+		c.ui.Output("")
+		table := terminal.NewTable("Attestation", "Reference", "Signed")
+		for _, att := range a.Artifact.Attestations {
+			table.Rich([]string{
+				strings.ToLower(att.Type.String()),
+				att.Reference,
+				humanize.Time(att.Time.AsTime()),
+			}, nil)
+		}
+		c.ui.Table(table)
+	}
+
+	if scan := a.Artifact.GetScan(); scan != nil {
+		c.showScan(scan)
+	}
+
+	if len(a.Labels) == 0 {
+		return 0
+	}
+
+	var keys []string
+	for k := range a.Labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	c.ui.Output("")
+	table := terminal.NewTable("Label", "Value")
+	for _, k := range keys {
+		table.Rich([]string{k, a.Labels[k]}, nil)
+	}
+	c.ui.Table(table)
+
+	return 0
+}
+
+// showScan shows the result of the vulnerability scan of an artifact.
+func (c *ArtifactInspectCommand) showScan(scan *pb.ScanResult) {
+	counts := map[pb.Vulnerability_Severity]int{}
+	for _, v := range scan.Vulnerabilities {
+		counts[v.Severity]++
+	}
+
+	var found []string
+	for sev := pb.Vulnerability_CRITICAL; sev >= pb.Vulnerability_UNKNOWN; sev-- {
+		if n := counts[sev]; n > 0 {
+			found = append(found, fmt.Sprintf("%d %s", n, strings.ToLower(sev.String())))
+		}
+	}
+	if len(found) == 0 {
+		found = append(found, "none")
+	}
+
+	result := "passed"
+	if !scan.Passed {
+		result = "failed"
+	}
+
+	c.ui.Output("")
+	c.ui.Output("Vulnerability Scan:", terminal.WithHeaderStyle())
+	c.ui.NamedValues([]terminal.NamedValue{
+		{
+			Name: "tool", Value: scan.Tool,
+		},
+		{
+			Name: "image", Value: scan.Reference,
+		},
+		{
+			Name: "threshold", Value: strings.ToLower(scan.Threshold.String()),
+		},
+		{
+			Name: "result", Value: result,
+		},
+		{
+			Name: "vulnerabilities", Value: strings.Join(found, ", "),
+		},
+		{
+			Name: "scanned", Value: humanize.Time(scan.Time.AsTime()),
+		},
+	}, terminal.WithInfoStyle())
+
+	if !c.flagVulnerabilities || len(scan.Vulnerabilities) == 0 {
+		return
+	}
+
+	c.ui.Output("")
+	table := terminal.NewTable("Severity", "ID", "Package", "Installed", "Fixed In", "Title")
+	for _, v := range scan.Vulnerabilities {
+		color := ""
+		if v.Severity >= scan.Threshold {
+			color = terminal.Red
+		}
+
+		table.Rich([]string{
+			strings.ToLower(v.Severity.String()),
+			v.Id,
+			v.Package,
+			v.InstalledVersion,
+			v.FixedVersion,
+			v.Title,
+		}, []string{color, "", "", "", "", ""})
+	}
+	c.ui.Table(table)
+}
+
+// artifactRef returns the ref for an artifact specified by sequence
+// number, such as "3" or "v3", or by long ID.
+func artifactRef(app *pb.Ref_Application, v string) *pb.Ref_Operation {
+	if n, err := strconv.ParseUint(strings.TrimPrefix(v, "v"), 10, 64); err == nil {
+		return &pb.Ref_Operation{
+			Target: &pb.Ref_Operation_Sequence{
+				Sequence: &pb.Ref_OperationSeq{
+					Application: app,
+					Number:      n,
+				},
+			},
+		}
+	}
+
+	return &pb.Ref_Operation{
+		Target: &pb.Ref_Operation_Id{Id: v},
+	}
+}
+
+func (c *ArtifactInspectCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.BoolVar(&flag.BoolVar{
+			Name:   "vulnerabilities",
+			Target: &c.flagVulnerabilities,
+			Usage:  "List the vulnerabilities found by the vulnerability scan.",
+		})
+	})
+}
+
+func (c *ArtifactInspectCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ArtifactInspectCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ArtifactInspectCommand) Synopsis() string {
+	return "Show detailed information about a pushed artifact."
+}
+
+func (c *ArtifactInspectCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint artifact inspect [options] ID
+
+  Show detailed information about a pushed artifact.
+
+  The artifact can be specified by sequence number, such as "3" or "v3",
+  or by long ID. The digest, size, and SBOM reference are only shown if
+  the registry plugin reports them.
+
+  If the image was scanned for vulnerabilities by a scan stanza in the
+  build stanza, a summary of the scan is shown. Specify -vulnerabilities
+  to list the vulnerabilities that were found.
+
+` + c.Flags().Help())
+}
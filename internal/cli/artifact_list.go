@@ -27,6 +27,7 @@ type ArtifactListCommand struct {
 	flagVerbose      bool
 	flagJson         bool
 	flagId           idFormat
+	flagLabels       map[string]string
 	filterFlags      filterFlags
 }
 
@@ -55,6 +56,7 @@ func (c *ArtifactListCommand) Run(args []string) int {
 			Workspace:    wsRef,
 			Order:        c.filterFlags.orderOp(),
 			IncludeBuild: true,
+			Labels:       c.flagLabels,
 		})
 		if err != nil {
 			c.project.UI.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
@@ -108,6 +110,16 @@ func (c *ArtifactListCommand) Run(args []string) int {
 			details = append(details, fmt.Sprintf("build:%s", c.flagId.FormatId(b.Build.Sequence, b.Build.Id)))
 
 			if c.flagVerbose {
+				if v := b.Artifact.GetDigest(); v != "" {
+					extraDetails = append(extraDetails, "digest:"+v)
+				}
+				if v := b.Artifact.GetSize(); v > 0 {
+					extraDetails = append(extraDetails, "size:"+humanize.Bytes(v))
+				}
+				if v := b.Artifact.GetSbom(); v != "" {
+					extraDetails = append(extraDetails, "sbom:"+v)
+				}
+
 				for k, val := range b.Labels {
 					if strings.HasPrefix(k, "waypoint/") {
 						continue
@@ -187,6 +199,9 @@ func (c *ArtifactListCommand) displayJson(artifacts []*pb.PushedArtifact) error
 		i["status"] = c.statusJson(art.Status)
 		i["workspace"] = art.Workspace.Workspace
 		i["build"] = c.buildJson(art.Build)
+		i["digest"] = art.Artifact.GetDigest()
+		i["size"] = art.Artifact.GetSize()
+		i["sbom"] = art.Artifact.GetSbom()
 
 		output = append(output, i)
 	}
@@ -244,6 +259,14 @@ func (c *ArtifactListCommand) Flags() *flag.Sets {
 			Usage:  "Output the deployment information as JSON.",
 		})
 
+		f.StringMapVar(&flag.StringMapVar{
+			Name:   "label",
+			Target: &c.flagLabels,
+			Usage: "Only list artifacts with the given label, in the format " +
+				"key=value. This can be specified multiple times and all " +
+				"labels must match.",
+		})
+
 		initIdFormat(f, &c.flagId)
 		initFilterFlags(set, &c.filterFlags, filterOptionOrder)
 	})
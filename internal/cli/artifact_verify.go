@@ -0,0 +1,189 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type ArtifactVerifyCommand struct {
+	*baseCommand
+
+	flagKey string
+}
+
+func (c *ArtifactVerifyCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithSingleApp(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) > 1 {
+		c.ui.Output(c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	client := c.project.Client()
+
+	var a *pb.PushedArtifact
+	var err error
+	if len(c.args) == 1 {
+		a, err = client.GetPushedArtifact(c.Ctx, &pb.GetPushedArtifactRequest{
+			Ref: artifactRef(c.refApp, c.args[0]),
+		})
+	} else {
+		a, err = client.GetLatestPushedArtifact(c.Ctx, &pb.GetLatestPushedArtifactRequest{
+			Application: c.refApp,
+			Workspace:   c.refWorkspace,
+		})
+	}
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	attestations := a.Artifact.GetAttestations()
+	if len(attestations) == 0 {
+		c.ui.Output(
+			"Artifact v%d has no attestations. Add a sign stanza to the build "+
+				"stanza of the app to sign artifacts when they are pushed.",
+			a.Sequence,
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	path, err := exec.LookPath("cosign")
+	if err != nil {
+		c.ui.Output("cosign is required to verify artifacts: %s", err,
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	table := terminal.NewTable("Attestation", "Reference", "Result")
+	failed := false
+	for _, att := range attestations {
+		key := c.flagKey
+		if key == "" {
+			key, err = c.writePublicKey(att)
+			if err != nil {
+				c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+				return 1
+			}
+		}
+
+		args := []string{"verify", "--key", key}
+		if att.Type == pb.Attestation_SBOM {
+			args = []string{"verify-attestation", "--key", key, "--type", "spdxjson"}
+		}
+		if !att.TransparencyLog {
+			args = append(args, "--insecure-ignore-tlog=true")
+		}
+		args = append(args, att.Reference)
+
+		c.Log.Debug("running cosign", "args", args)
+		out, err := exec.CommandContext(c.Ctx, path, args...).CombinedOutput()
+		if c.flagKey == "" {
+			os.Remove(key)
+		}
+
+		result, color := "verified", terminal.Green
+		if err != nil {
+			failed = true
+			result, color = "failed", terminal.Red
+			c.Log.Warn("verification failed", "output", string(out))
+		}
+
+		table.Rich([]string{
+			strings.ToLower(att.Type.String()),
+			att.Reference,
+			result,
+		}, []string{"", "", color})
+	}
+
+	c.ui.Table(table)
+	if failed {
+		c.ui.Output("Artifact v%d failed verification. Run with -vv to see the "+
+			"output of cosign.", a.Sequence, terminal.WithErrorStyle())
+		return 1
+	}
+
+	return 0
+}
+
+// writePublicKey writes the public key recorded with an attestation to a
+// temporary file for cosign. The caller should remove the file.
+func (c *ArtifactVerifyCommand) writePublicKey(att *pb.Attestation) (string, error) {
+	if att.PublicKey == "" {
+		return "", fmt.Errorf(
+			"no public key was recorded for the %s attestation, specify one with -key",
+			strings.ToLower(att.Type.String()))
+	}
+
+	f, err := ioutil.TempFile("", "waypoint-cosign")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(att.PublicKey); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+
+	return f.Name(), nil
+}
+
+func (c *ArtifactVerifyCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.StringVar(&flag.StringVar{
+			Name:   "key",
+			Target: &c.flagKey,
+			Usage: "Public key to verify with, in any format cosign accepts. " +
+				"Defaults to the public key recorded when the artifact was signed.",
+		})
+	})
+}
+
+func (c *ArtifactVerifyCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ArtifactVerifyCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ArtifactVerifyCommand) Synopsis() string {
+	return "Verify the signature and SBOM of a pushed artifact."
+}
+
+func (c *ArtifactVerifyCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint artifact verify [options] [ID]
+
+  Verify the signature and SBOM of a pushed artifact with cosign.
+
+  The artifact can be specified by sequence number, such as "3" or "v3",
+  or by long ID. If no artifact is specified, the latest pushed artifact
+  is verified. Only artifacts pushed with a sign stanza in the build
+  stanza have attestations to verify.
+
+  By default the public key recorded when the artifact was signed is used.
+  Specify -key to verify against a key you trust independently of the
+  Waypoint server.
+
+` + c.Flags().Help())
+}
@@ -45,9 +45,14 @@ func (c *AuthMethodListCommand) Run(args []string) int {
 
 	for _, am := range resp.AuthMethods {
 		method := fmt.Sprintf("%T", am.Method)
-		switch am.Method.(type) {
+		switch v := am.Method.(type) {
 		case *pb.AuthMethod_Oidc:
 			method = "OIDC"
+		case *pb.AuthMethod_Pat:
+			method = "GitHub PAT"
+			if v.Pat.Provider == pb.AuthMethod_PAT_GITLAB {
+				method = "GitLab PAT"
+			}
 		}
 
 		table.Rich([]string{
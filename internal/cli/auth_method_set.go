@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// setAuthMethod upserts the auth method. If test is true, the auth method
+// is only tested with the server and not saved. This is shared by the
+// "auth-method set" and "auth-method test" commands of every type.
+func (c *baseCommand) setAuthMethod(am *pb.AuthMethod, test bool) int {
+	if test {
+		_, err := c.project.Client().TestAuthMethod(c.Ctx, &pb.TestAuthMethodRequest{
+			AuthMethod: am,
+		})
+		if err != nil {
+			c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return 1
+		}
+
+		c.ui.Output("Auth method test passed. The auth method was not saved.",
+			terminal.WithSuccessStyle())
+		return 0
+	}
+
+	_, err := c.project.Client().UpsertAuthMethod(c.Ctx, &pb.UpsertAuthMethodRequest{
+		AuthMethod: am,
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	c.ui.Output("Auth method configured.", terminal.WithSuccessStyle())
+	return 0
+}
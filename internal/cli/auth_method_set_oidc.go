@@ -1,8 +1,9 @@
 package cli
 
 import (
+	"strings"
+
 	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
-	"github.com/hashicorp/waypoint/internal/clierrors"
 	"github.com/hashicorp/waypoint/internal/pkg/flag"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 	"github.com/posener/complete"
@@ -11,9 +12,14 @@ import (
 type AuthMethodSetOIDCCommand struct {
 	*baseCommand
 
+	// test is true for "auth-method test oidc" which tests the auth
+	// method instead of saving it.
+	test bool
+
 	flagDisplayName    string
 	flagDescription    string
 	flagAccessSelector string
+	flagRoleMappings   []string
 	flagMethod         pb.AuthMethod_OIDC
 }
 
@@ -34,6 +40,22 @@ func (c *AuthMethodSetOIDCCommand) Run(args []string) int {
 	}
 	name := c.args[0]
 
+	// Role mappings are given as "role=selector".
+	c.flagMethod.RoleMappings = nil
+	for _, v := range c.flagRoleMappings {
+		idx := strings.Index(v, "=")
+		if idx <= 0 {
+			c.ui.Output("role mapping must be in the form 'role=selector': %s", v,
+				terminal.WithErrorStyle())
+			return 1
+		}
+
+		c.flagMethod.RoleMappings = append(c.flagMethod.RoleMappings, &pb.AuthMethod_OIDC_RoleMapping{
+			Role:     v[:idx],
+			Selector: v[idx+1:],
+		})
+	}
+
 	am := &pb.AuthMethod{
 		Name:           name,
 		DisplayName:    c.flagDisplayName,
@@ -44,16 +66,7 @@ func (c *AuthMethodSetOIDCCommand) Run(args []string) int {
 		},
 	}
 
-	_, err := c.project.Client().UpsertAuthMethod(c.Ctx, &pb.UpsertAuthMethodRequest{
-		AuthMethod: am,
-	})
-	if err != nil {
-		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
-		return 1
-	}
-
-	c.ui.Output("Auth method configured.", terminal.WithSuccessStyle())
-	return 0
+	return c.setAuthMethod(am, c.test)
 }
 
 func (c *AuthMethodSetOIDCCommand) Flags() *flag.Sets {
@@ -139,6 +152,15 @@ func (c *AuthMethodSetOIDCCommand) Flags() *flag.Sets {
 			Usage: "Same as claim-mapping but for list values. " +
 				"This can be repeated multiple times.",
 		})
+
+		f.StringSliceVar(&flag.StringSliceVar{
+			Name:   "role-mapping",
+			Target: &c.flagRoleMappings,
+			Usage: "Grant a role to users matching a selector, in the form " +
+				"'role=selector'. The selector uses the claim mappings like " +
+				"the access selector. This can be repeated multiple times. " +
+				"Example value: 'admin=admins in list.groups'",
+		})
 	})
 }
 
@@ -151,14 +173,37 @@ func (c *AuthMethodSetOIDCCommand) AutocompleteFlags() complete.Flags {
 }
 
 func (c *AuthMethodSetOIDCCommand) Synopsis() string {
+	if c.test {
+		return "Test an OIDC auth method configuration without saving it"
+	}
+
 	return "Configure an OIDC auth method"
 }
 
 func (c *AuthMethodSetOIDCCommand) Help() string {
+	if c.test {
+		return formatHelp(`
+Usage: waypoint auth-method test oidc [options] name
+
+  Test an OIDC auth method configuration without saving it.
+
+  This takes the same options as "waypoint auth-method set oidc". The
+  configuration is validated and the server performs OIDC discovery with
+  the issuer to verify that it is reachable. The client ID and secret are
+  only verified by the provider when a user logs in.
+
+` + c.Flags().Help())
+	}
+
 	return formatHelp(`
 Usage: waypoint auth-method set oidc [options] name
 
   Configure an OIDC auth method.
 
+  Users can be granted roles based on their claims with "-role-mapping".
+  The roles of every matching mapping are stored in the token issued at
+  login and are used instead of the roles of the Waypoint user. If no
+  mapping matches, the roles of the Waypoint user are used.
+
 ` + c.Flags().Help())
 }
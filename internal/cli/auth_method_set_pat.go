@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/auth/pat"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// AuthMethodSetPATCommand configures a PAT auth method. The same command
+// is used for GitHub and GitLab with the provider set on registration.
+type AuthMethodSetPATCommand struct {
+	*baseCommand
+
+	provider pb.AuthMethod_PAT_Provider
+
+	// test is true for "auth-method test" which tests the auth method
+	// instead of saving it.
+	test bool
+
+	flagDisplayName    string
+	flagDescription    string
+	flagAccessSelector string
+	flagMethod         pb.AuthMethod_PAT
+}
+
+func (c *AuthMethodSetPATCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoAutoServer(), // no auth in local mode
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) == 0 {
+		c.ui.Output("name required for the auth method", terminal.WithErrorStyle())
+		return 1
+	}
+	name := c.args[0]
+
+	c.flagMethod.Provider = c.provider
+	am := &pb.AuthMethod{
+		Name:           name,
+		DisplayName:    c.flagDisplayName,
+		Description:    c.flagDescription,
+		AccessSelector: c.flagAccessSelector,
+		Method: &pb.AuthMethod_Pat{
+			Pat: &c.flagMethod,
+		},
+	}
+
+	return c.setAuthMethod(am, c.test)
+}
+
+// providerName returns the human name of the provider.
+func (c *AuthMethodSetPATCommand) providerName() string {
+	return pat.ProviderName(c.provider)
+}
+
+func (c *AuthMethodSetPATCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.StringVar(&flag.StringVar{
+			Name:   "display-name",
+			Target: &c.flagDisplayName,
+			Usage:  "Display name for the UI. Optional.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "description",
+			Target: &c.flagDescription,
+			Usage:  "Short description of this auth method. Optional.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "access-selector",
+			Target: &c.flagAccessSelector,
+			Usage: "Selector expression to control access based on the user's " +
+				"username and memberships. See docs for more details.",
+		})
+
+		defaultURL := pat.DefaultGitHubURL
+		orgUsage := "Organization"
+		teamUsage := "Team, in the form 'org/team',"
+		if c.provider == pb.AuthMethod_PAT_GITLAB {
+			defaultURL = pat.DefaultGitLabURL
+			orgUsage = "Top-level group"
+			teamUsage = "Subgroup, by its full path such as 'group/subgroup',"
+		}
+
+		f = set.NewSet(c.providerName() + " Auth Method Options")
+		f.StringVar(&flag.StringVar{
+			Name:   "api-url",
+			Target: &c.flagMethod.ApiUrl,
+			Usage: "URL of the " + c.providerName() + " API. This only needs " +
+				"to be set for self-hosted installations. Defaults to " +
+				defaultURL + ".",
+		})
+
+		f.StringSliceVar(&flag.StringSliceVar{
+			Name:   "org",
+			Target: &c.flagMethod.Orgs,
+			Usage: orgUsage + " that users must be a member of. " +
+				"May be specified multiple times.",
+		})
+
+		f.StringSliceVar(&flag.StringSliceVar{
+			Name:   "team",
+			Target: &c.flagMethod.Teams,
+			Usage: teamUsage + " that users must be a member of. " +
+				"May be specified multiple times.",
+		})
+	})
+}
+
+func (c *AuthMethodSetPATCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *AuthMethodSetPATCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *AuthMethodSetPATCommand) Synopsis() string {
+	if c.test {
+		return "Test a " + c.providerName() + " auth method configuration without saving it"
+	}
+
+	return "Configure a " + c.providerName() + " personal access token auth method"
+}
+
+func (c *AuthMethodSetPATCommand) Help() string {
+	if c.test {
+		return formatHelp(`
+Usage: waypoint auth-method test ` + strings.ToLower(c.providerName()) + ` [options] name
+
+  Test a ` + c.providerName() + ` auth method configuration without saving it.
+
+  This takes the same options as "waypoint auth-method set ` + strings.ToLower(c.providerName()) + `".
+  The configuration is validated and the server calls the ` + c.providerName() + ` API
+  to verify that it is reachable.
+
+` + c.Flags().Help())
+	}
+
+	return formatHelp(`
+Usage: waypoint auth-method set ` + strings.ToLower(c.providerName()) + ` [options] name
+
+  Configure a ` + c.providerName() + ` personal access token auth method.
+
+  Users log in with "waypoint login -auth-method=<name> -pat=<token>".
+  The token is validated with the ` + c.providerName() + ` API and is never
+  stored. Users are linked to a Waypoint user by their ` + c.providerName() + `
+  account, and a new user is created on their first login.
+
+  If "-org" or "-team" are specified, users must be a member of at least
+  one of them. The access selector can additionally use "value.username"
+  and the lists "list.orgs" and "list.teams".
+
+` + c.Flags().Help())
+}
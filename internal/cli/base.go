@@ -4,14 +4,19 @@ import (
 	"context"
 	"errors"
 	stdflag "flag"
+	"fmt"
 	"io"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/adrg/xdg"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-multierror"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
 	"github.com/hashicorp/waypoint/internal/clicontext"
@@ -22,6 +27,7 @@ import (
 	"github.com/hashicorp/waypoint/internal/pkg/flag"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 	"github.com/hashicorp/waypoint/internal/server/grpcmetadata"
+	"github.com/hashicorp/waypoint/internal/telemetry"
 )
 
 // baseCommand is embedded in all commands to provide common logic and data.
@@ -96,9 +102,19 @@ type baseCommand struct {
 	// flagApp is the app to target.
 	flagApp string
 
+	// flagParallel is the number of apps to operate on concurrently. This
+	// is set via -parallel if flagSetParallel is set. If this is less than
+	// one, the command operates on a single app.
+	flagParallel int
+
 	// flagWorkspace is the workspace to work in.
 	flagWorkspace string
 
+	// flagLocalCompose is set via -local-compose on up and destroy. If set,
+	// every app is deployed to the local Docker daemon with the
+	// docker-compose plugin instead of its configured platform.
+	flagLocalCompose bool
+
 	// flagConnection contains manual flag-based connection info.
 	flagConnection clicontext.Config
 
@@ -172,8 +188,9 @@ func (c *baseCommand) Init(opts ...Option) error {
 		return err
 	}
 
-	// Reset the UI to plain if that was set
-	if c.flagPlain {
+	// Reset the UI to plain if that was set. Parallel operations always
+	// use the plain UI since the output of multiple apps is interleaved.
+	if c.flagPlain || c.flagParallel > 1 {
 		c.ui = terminal.NonInteractiveUI(c.Ctx)
 	}
 
@@ -187,6 +204,21 @@ func (c *baseCommand) Init(opts ...Option) error {
 		c.args = c.args[1:]
 	}
 
+	// Local compose deployments go to their own workspace unless one is
+	// set, so that they don't mix with the deployments of the configured
+	// platforms.
+	if c.flagLocalCompose {
+		workspaceSet := false
+		baseCfg.Flags.Visit(func(f *stdflag.Flag) {
+			if f.Name == "workspace" {
+				workspaceSet = true
+			}
+		})
+		if !workspaceSet {
+			c.flagWorkspace = localComposeWorkspace
+		}
+	}
+
 	// With the flags we now know what workspace we're targeting
 	c.refWorkspace = &pb.Ref_Workspace{Workspace: c.flagWorkspace}
 
@@ -278,6 +310,16 @@ func (c *baseCommand) Init(opts ...Option) error {
 	}
 	c.variables = vars
 
+	// Local compose deployments are executed by the local runner, since
+	// the local runner applies the deploy override.
+	if c.flagLocalCompose && c.flagRemote {
+		err := errors.New(
+			"The `-local-compose` flag can't be used with remote operations, since\n" +
+				"the apps are deployed to the Docker daemon of this machine.")
+		c.logError(c.Log, "", err)
+		return err
+	}
+
 	// Create our client
 	if baseCfg.Client {
 		c.project, err = c.initClient(nil)
@@ -301,9 +343,22 @@ func (c *baseCommand) Init(opts ...Option) error {
 		}
 	}
 
+	// Validate the local configuration with the server before any remote
+	// job is queued so errors are reported with their position.
+	if c.flagRemote && c.project != nil && c.cfg != nil && len(c.cfg.Source()) > 0 {
+		if err := c.validateRemoteConfig(); err != nil {
+			if err != ErrSentinel {
+				c.logError(c.Log, "failed to validate configuration", err)
+			}
+			return err
+		}
+	}
+
 	// If this is a single app mode then make sure that we only have
-	// one app or that we have an app target.
-	if baseCfg.AppTargetRequired {
+	// one app or that we have an app target. Parallel and local compose
+	// operations target every app unless an app target was given.
+	if baseCfg.AppTargetRequired && !baseCfg.AppTargetMultiple &&
+		c.flagParallel < 1 && !c.flagLocalCompose {
 		if c.refApp == nil {
 			if len(c.cfg.Apps()) != 1 {
 				c.ui.Output(errAppModeSingle, terminal.WithErrorStyle())
@@ -331,12 +386,26 @@ func (c *baseCommand) Init(opts ...Option) error {
 // If you want to early exit all the running functions, you should use
 // the callback closure properties to cancel the passed in context. This
 // will stop any remaining callbacks and exit early.
+//
+// Apps are operated on in the order of their depends_on settings. If an
+// app fails, the apps that depend on it are skipped.
 func (c *baseCommand) DoApp(ctx context.Context, f func(context.Context, *clientpkg.App) error) error {
+	// If we're operating on every app, order them so that every app
+	// comes after the apps it depends on.
 	var appTargets []string
+	deps := map[string][]string{}
 	if c.refApp != nil {
 		appTargets = []string{c.refApp.Application}
 	} else if c.cfg != nil {
-		appTargets = append(appTargets, c.cfg.Apps()...)
+		order, err := c.cfg.AppOrder()
+		if err != nil {
+			return err
+		}
+
+		appTargets = order
+		for _, appName := range appTargets {
+			deps[appName] = c.cfg.AppDependsOn(appName)
+		}
 	}
 
 	var apps []*clientpkg.App
@@ -352,23 +421,116 @@ func (c *baseCommand) DoApp(ctx context.Context, f func(context.Context, *client
 		ctx = grpcmetadata.AddRunner(ctx, id)
 	}
 
-	// Just a serialize loop for now, one day we'll parallelize.
-	var finalErr error
-	var didErrSentinel bool
+	// If we're operating on multiple apps at once, prefix the output of
+	// each app with its name so the interleaved output can be told apart.
+	parallel := c.flagParallel
+	if parallel < 1 {
+		parallel = 1
+	}
+	if parallel > 1 && len(apps) > 1 {
+		var mu sync.Mutex
+		for _, app := range apps {
+			app.UI = &prefixUI{
+				UI:     app.UI,
+				prefix: app.Ref().Application,
+				mu:     &mu,
+			}
+		}
+	}
+
+	var (
+		wg             sync.WaitGroup
+		mu             sync.Mutex
+		finalErr       error
+		didErrSentinel bool
+		failed         = map[string]bool{}
+	)
+
+	// done has a channel for each app that is closed when the app is done.
+	// Apps wait on the channels of the apps they depend on. Since apps are
+	// started in dependency order, an app only waits on apps that have
+	// already started so this can't deadlock.
+	done := map[string]chan struct{}{}
+	for _, app := range apps {
+		done[app.Ref().Application] = make(chan struct{})
+	}
+
+	sem := make(chan struct{}, parallel)
 	for _, app := range apps {
-		// Support cancellation
+		// Wait for a free slot, supporting cancellation
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
 		if err := ctx.Err(); err != nil {
+			wg.Wait()
 			return err
 		}
 
-		if err := f(ctx, app); err != nil {
+		wg.Add(1)
+		go func(app *clientpkg.App) {
+			name := app.Ref().Application
+			defer wg.Done()
+			defer func() { <-sem }()
+			defer close(done[name])
+
+			// Wait for the apps we depend on. If any of them failed, we
+			// fail fast and skip this app.
+			var upstreamErr error
+			for _, dep := range deps[name] {
+				select {
+				case <-done[dep]:
+				case <-ctx.Done():
+					return
+				}
+
+				mu.Lock()
+				if failed[dep] {
+					upstreamErr = fmt.Errorf("app %q failed", dep)
+				}
+				mu.Unlock()
+				if upstreamErr != nil {
+					break
+				}
+			}
+
+			err := upstreamErr
+			if err == nil {
+				// Every app is its own trace. The jobs queued for the app
+				// continue the trace on the runner.
+				appCtx, span := telemetry.Tracer().Start(ctx, "cli.app",
+					trace.WithAttributes(
+						attribute.String("waypoint.project", app.Ref().Project),
+						attribute.String("waypoint.application", name),
+					),
+				)
+				err = f(appCtx, app)
+				if err != nil && err != ErrSentinel {
+					span.RecordError(err)
+					span.SetStatus(otelcodes.Error, err.Error())
+				}
+				span.End()
+			} else {
+				app.UI.Output("Skipping %q because %s.", name, upstreamErr,
+					terminal.WithErrorStyle())
+				err = ErrSentinel
+			}
+			if err == nil {
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			failed[name] = true
 			if err != ErrSentinel {
 				finalErr = multierror.Append(finalErr, err)
 			} else {
 				didErrSentinel = true
 			}
-		}
+		}(app)
 	}
+	wg.Wait()
+
 	if finalErr == nil && didErrSentinel {
 		finalErr = ErrSentinel
 	}
@@ -483,6 +645,18 @@ func (c *baseCommand) flagSet(bit flagSetBit, f func(*flag.Sets)) *flag.Sets {
 		})
 	}
 
+	if bit&flagSetParallel != 0 {
+		f := set.NewSet("Parallel Options")
+		f.IntVar(&flag.IntVar{
+			Name:   "parallel",
+			Target: &c.flagParallel,
+			Usage: "Operate on up to this many apps at once. If this is set, " +
+				"every app in the project is targeted unless -app is set. " +
+				"Each app runs as a separate job and its output is prefixed " +
+				"with the app name.",
+		})
+	}
+
 	if f != nil {
 		// Configure our values
 		f(set)
@@ -571,6 +745,7 @@ const (
 	flagSetNone       flagSetBit = 1 << iota
 	flagSetOperation             // shared flags for operations (build, deploy, etc)
 	flagSetConnection            // shared flags for server connections
+	flagSetParallel              // flags for operating on multiple apps at once
 )
 
 var (
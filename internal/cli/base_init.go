@@ -101,10 +101,19 @@ func (c *baseCommand) initClient(ctx context.Context) (*clientpkg.Project, error
 		clientpkg.WithVariables(c.variables),
 		clientpkg.WithLabels(c.flagLabels),
 		clientpkg.WithSourceOverrides(c.flagRemoteSource),
+		clientpkg.WithParallel(c.flagParallel),
 	}
 	if !c.flagRemote && c.autoServer {
 		opts = append(opts, clientpkg.WithLocal())
 	}
+	if c.flagLocalCompose {
+		use, err := localComposeUse(c.cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, clientpkg.WithDeployOverride(use))
+	}
 
 	if c.ui != nil {
 		opts = append(opts, clientpkg.WithUI(c.ui))
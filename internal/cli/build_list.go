@@ -21,6 +21,7 @@ type BuildListCommand struct {
 
 	flagWorkspaceAll bool
 	flagId           idFormat
+	filterFlags      filterFlags
 }
 
 func (c *BuildListCommand) Run(args []string) int {
@@ -43,20 +44,22 @@ func (c *BuildListCommand) Run(args []string) int {
 		}
 
 		// List builds
-		resp, err := client.ListBuilds(c.Ctx, &pb.ListBuildsRequest{
+		builds, err := listBuilds(c.Ctx, client, &pb.ListBuildsRequest{
 			Application: app.Ref(),
 			Workspace:   wsRef,
+			Status:      c.filterFlags.statusFilters(),
+			Filter:      c.filterFlags.operationFilter(),
 		})
 		if err != nil {
 			c.project.UI.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
 			return ErrSentinel
 		}
-		sort.Sort(serversort.BuildStartDesc(resp.Builds))
+		sort.Sort(serversort.BuildStartDesc(builds))
 
 		const bullet = "●"
 
 		table := terminal.NewTable("", "ID", "Workspace", "Builder", "Started", "Completed")
-		for _, b := range resp.Builds {
+		for _, b := range builds {
 			// Determine our bullet
 			status := ""
 			statusColor := ""
@@ -116,6 +119,8 @@ func (c *BuildListCommand) Flags() *flag.Sets {
 		})
 
 		initIdFormat(f, &c.flagId)
+		initFilterFlags(set, &c.filterFlags,
+			filterOptionState|filterOptionLabel|filterOptionTime)
 	})
 }
 
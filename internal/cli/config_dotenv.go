@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// reDotEnvName matches the names that can be written to a dotenv file.
+var reDotEnvName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`)
+
+// dotEnvVar is a single variable of a dotenv file.
+type dotEnvVar struct {
+	Name  string
+	Value string
+}
+
+// parseDotEnv parses a dotenv file. Lines are in the form NAME=VALUE and
+// may start with "export ". Blank lines and lines starting with "#" are
+// ignored. Values may be double quoted, in which case the escapes \n, \t,
+// \" and \\ are supported, or single quoted, in which case the value is
+// taken literally. Unquoted values end at a " #" comment and are trimmed.
+func parseDotEnv(r io.Reader) ([]dotEnvVar, error) {
+	var result []dotEnvVar
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		text = strings.TrimPrefix(text, "export ")
+
+		idx := strings.IndexByte(text, '=')
+		if idx <= 0 {
+			return nil, fmt.Errorf("line %d: variables must be in the form NAME=VALUE", line)
+		}
+
+		name := strings.TrimSpace(text[:idx])
+		if !reDotEnvName.MatchString(name) {
+			return nil, fmt.Errorf("line %d: invalid variable name %q", line, name)
+		}
+
+		value, err := parseDotEnvValue(strings.TrimSpace(text[idx+1:]))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", line, err)
+		}
+
+		result = append(result, dotEnvVar{Name: name, Value: value})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func parseDotEnvValue(v string) (string, error) {
+	switch {
+	case strings.HasPrefix(v, `"`):
+		end := strings.LastIndexByte(v, '"')
+		if end == 0 || !dotEnvTrailer(v[end+1:]) {
+			return "", fmt.Errorf("unterminated double quoted value")
+		}
+
+		// strconv handles more escapes than we document, but it handles
+		// the ones we write and the common ones other tools write.
+		result, err := strconv.Unquote(v[:end+1])
+		if err != nil {
+			return "", fmt.Errorf("invalid double quoted value: %s", err)
+		}
+
+		return result, nil
+
+	case strings.HasPrefix(v, "'"):
+		end := strings.LastIndexByte(v, '\'')
+		if end == 0 || !dotEnvTrailer(v[end+1:]) {
+			return "", fmt.Errorf("unterminated single quoted value")
+		}
+
+		return v[1:end], nil
+
+	default:
+		if idx := strings.Index(v, " #"); idx >= 0 {
+			v = v[:idx]
+		}
+
+		return strings.TrimSpace(v), nil
+	}
+}
+
+// dotEnvTrailer returns true if the text after a quoted value is empty or
+// a comment.
+func dotEnvTrailer(v string) bool {
+	v = strings.TrimSpace(v)
+	return v == "" || strings.HasPrefix(v, "#")
+}
+
+// writeDotEnv writes the variables as a dotenv file that parseDotEnv and
+// most other tools can read. Values are double quoted unless they only
+// contain characters that never need quoting.
+func writeDotEnv(w io.Writer, vars []dotEnvVar) error {
+	for _, v := range vars {
+		if !reDotEnvName.MatchString(v.Name) {
+			return fmt.Errorf("variable %q can't be written to a dotenv file", v.Name)
+		}
+
+		value := v.Value
+		if !reDotEnvBare.MatchString(value) {
+			value = strconv.Quote(value)
+		}
+
+		if _, err := fmt.Fprintf(w, "%s=%s\n", v.Name, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reDotEnvBare matches the values that are written without quotes.
+var reDotEnvBare = regexp.MustCompile(`^[A-Za-z0-9_./:@,+-]*$`)
@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDotEnv(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    string
+		Expected []dotEnvVar
+		Error    string
+	}{
+		{
+			"basic",
+			"FOO=bar\nBAZ=qux\n",
+			[]dotEnvVar{{"FOO", "bar"}, {"BAZ", "qux"}},
+			"",
+		},
+
+		{
+			"comments, blank lines and export",
+			"# database\n\nexport DB_HOST=localhost # local only\n",
+			[]dotEnvVar{{"DB_HOST", "localhost"}},
+			"",
+		},
+
+		{
+			"double quoted",
+			`GREETING="hello \"world\"\n" # comment`,
+			[]dotEnvVar{{"GREETING", "hello \"world\"\n"}},
+			"",
+		},
+
+		{
+			"single quoted",
+			`PATTERN='a\nb #c'`,
+			[]dotEnvVar{{"PATTERN", `a\nb #c`}},
+			"",
+		},
+
+		{
+			"empty value",
+			"EMPTY=",
+			[]dotEnvVar{{"EMPTY", ""}},
+			"",
+		},
+
+		{
+			"value with equals",
+			"URL=postgres://db?sslmode=disable",
+			[]dotEnvVar{{"URL", "postgres://db?sslmode=disable"}},
+			"",
+		},
+
+		{
+			"no equals",
+			"FOO",
+			nil,
+			"line 1",
+		},
+
+		{
+			"invalid name",
+			"\nFOO BAR=baz",
+			nil,
+			"line 2: invalid variable name",
+		},
+
+		{
+			"unterminated quote",
+			`FOO="bar`,
+			nil,
+			"unterminated",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			vars, err := parseDotEnv(strings.NewReader(tt.Input))
+			if tt.Error != "" {
+				require.Error(err)
+				require.Contains(err.Error(), tt.Error)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.Expected, vars)
+		})
+	}
+}
+
+func TestWriteDotEnv(t *testing.T) {
+	require := require.New(t)
+
+	vars := []dotEnvVar{
+		{"PLAIN", "postgres://db:5432/app"},
+		{"SPACES", "hello world"},
+		{"QUOTES", `say "hi"`},
+		{"MULTILINE", "a\nb"},
+		{"EMPTY", ""},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(writeDotEnv(&buf, vars))
+	require.Equal(`PLAIN=postgres://db:5432/app
+SPACES="hello world"
+QUOTES="say \"hi\""
+MULTILINE="a\nb"
+EMPTY=
+`, buf.String())
+
+	// Round trip
+	parsed, err := parseDotEnv(&buf)
+	require.NoError(err)
+	require.Equal(vars, parsed)
+
+	// Names that can't be written
+	require.Error(writeDotEnv(&buf, []dotEnvVar{{"/etc/app.conf", "x"}}))
+}
@@ -0,0 +1,216 @@
+package cli
+
+import (
+	"encoding/json"
+	stdflag "flag"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+// configFormats are the values of the -format flag of config export and
+// import.
+var configFormats = []string{"dotenv", "json"}
+
+type ConfigExportCommand struct {
+	*baseCommand
+
+	flagFormat []string
+}
+
+func (c *ConfigExportCommand) Run(args []string) int {
+	flagSet := c.Flags()
+
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(flagSet),
+
+		// Don't allow a local in-mem server because configuration
+		// makes no sense with the local server.
+		WithNoAutoServer(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) > 0 {
+		c.ui.Output("config export takes no arguments.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	// The workspace flag always has a value, so we check whether it was
+	// set explicitly to decide if we include workspace config.
+	var workspaceSet bool
+	flagSet.Visit(func(f *stdflag.Flag) {
+		if f.Name == "workspace" {
+			workspaceSet = true
+		}
+	})
+
+	req := &pb.ConfigGetRequest{
+		Scope: &pb.ConfigGetRequest_Project{Project: c.project.Ref()},
+	}
+	if c.flagApp != "" {
+		req.Scope = &pb.ConfigGetRequest_Application{
+			Application: &pb.Ref_Application{
+				Project:     c.project.Ref().Project,
+				Application: c.flagApp,
+			},
+		}
+	}
+	if workspaceSet {
+		req.Workspace = c.refWorkspace
+	}
+
+	resp, err := c.project.Client().GetConfig(c.Ctx, req)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	vars := c.exportVars(resp.Variables)
+
+	format := "dotenv"
+	if len(c.flagFormat) > 0 {
+		format = c.flagFormat[len(c.flagFormat)-1]
+	}
+
+	// Write to our direct stdout handle so that the output can be
+	// redirected to a file.
+	out, _, err := c.ui.OutputWriters()
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	switch format {
+	case "json":
+		values := map[string]string{}
+		for _, v := range vars {
+			values[v.Name] = v.Value
+		}
+
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(values)
+
+	default:
+		err = writeDotEnv(out, vars)
+	}
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	return 0
+}
+
+// exportVars returns the variables to export, sorted by name. The server
+// returns the variables of every app for a project, so only the project
+// scoped ones are kept in that case. Values set for the workspace override
+// the values that aren't. Variables that can't be written as a value are
+// skipped with a warning.
+func (c *ConfigExportCommand) exportVars(vs []*pb.ConfigVar) []dotEnvVar {
+	merged := map[string]*pb.ConfigVar{}
+	for _, v := range vs {
+		if _, ok := v.Scope.(*pb.ConfigVar_Project); c.flagApp == "" && !ok {
+			continue
+		}
+
+		if prev, ok := merged[v.Name]; ok && prev.Workspace != nil {
+			continue
+		}
+
+		merged[v.Name] = v
+	}
+
+	var result []dotEnvVar
+	for name, v := range merged {
+		if v.NameIsPath {
+			fmt.Fprintf(os.Stderr, "skipping %q: file config can't be exported\n", name)
+			continue
+		}
+
+		var value string
+		switch val := v.Value.(type) {
+		case *pb.ConfigVar_Static:
+			value = val.Static
+
+		case *pb.ConfigVar_Dynamic:
+			// Secret references can be imported again as is.
+			value = serverptypes.SecretRefString(val.Dynamic)
+			if value == "" {
+				fmt.Fprintf(os.Stderr,
+					"skipping %q: dynamic config from %q can't be exported\n",
+					name, val.Dynamic.From)
+				continue
+			}
+
+		default:
+			continue
+		}
+
+		result = append(result, dotEnvVar{Name: name, Value: value})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
+func (c *ConfigExportCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.EnumVar(&flag.EnumVar{
+			Name:    "format",
+			Target:  &c.flagFormat,
+			Values:  configFormats,
+			Default: []string{"dotenv"},
+			Usage:   "Format to export the config in. One of \"dotenv\" or \"json\".",
+		})
+	})
+}
+
+func (c *ConfigExportCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ConfigExportCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ConfigExportCommand) Synopsis() string {
+	return "Export config variables to a dotenv or JSON file."
+}
+
+func (c *ConfigExportCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint config export [options]
+
+  Export config variables in the dotenv or JSON format to stdout.
+
+  This exports the variables set for the project by default. Specify the
+  "-app" flag to export the variables of an application, merged with the
+  project variables as they would be for a deployment. Specify the
+  "-workspace" flag to include the variables set for that workspace.
+
+  Secret references are exported as references. Other dynamic config and
+  file config can't be exported and are skipped with a warning.
+
+  The output can be imported with "waypoint config import":
+
+    $ waypoint config export -app=web > web.env
+    $ waypoint config import -app=web -workspace=staging web.env
+
+` + c.Flags().Help())
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"encoding/json"
+	stdflag "flag"
 	"fmt"
 	"os"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/hashicorp/waypoint/internal/clierrors"
 	"github.com/hashicorp/waypoint/internal/pkg/flag"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
 	"github.com/posener/complete"
 )
 
@@ -21,9 +23,10 @@ type ConfigGetCommand struct {
 }
 
 func (c *ConfigGetCommand) Run(args []string) int {
+	flagSet := c.Flags()
 	initOpts := []Option{
 		WithArgs(args),
-		WithFlags(c.Flags()),
+		WithFlags(flagSet),
 
 		// Don't allow a local in-mem server because configuration
 		// makes no sense with the local server.
@@ -47,6 +50,15 @@ func (c *ConfigGetCommand) Run(args []string) int {
 		return 1
 	}
 
+	// The workspace flag always has a value, so we check whether it was
+	// set explicitly to decide if we include workspace config.
+	var workspaceSet bool
+	flagSet.Visit(func(f *stdflag.Flag) {
+		if f.Name == "workspace" {
+			workspaceSet = true
+		}
+	})
+
 	// Get our API client
 	client := c.project.Client()
 
@@ -86,6 +98,9 @@ func (c *ConfigGetCommand) Run(args []string) int {
 	default:
 		req.Scope = &pb.ConfigGetRequest_Project{Project: c.project.Ref()}
 	}
+	if workspaceSet && !c.flagRunner {
+		req.Workspace = c.refWorkspace
+	}
 
 	resp, err := client.GetConfig(c.Ctx, req)
 	if err != nil {
@@ -111,7 +126,7 @@ func (c *ConfigGetCommand) Run(args []string) int {
 				value = v.Static
 
 			case *pb.ConfigVar_Dynamic:
-				value = fmt.Sprintf("<dynamic via %s>", v.Dynamic.From)
+				value = configDynamicString(v.Dynamic)
 			}
 
 			vars[cv.Name] = value
@@ -151,27 +166,39 @@ func (c *ConfigGetCommand) Run(args []string) int {
 		return 0
 	}
 
-	table := terminal.NewTable("Scope", "Name", "Value")
+	table := terminal.NewTable("Scope", "Workspace", "Name", "Value")
 	for _, v := range resp.Variables {
 		var app string
 		if scope, ok := v.Scope.(*pb.ConfigVar_Application); ok {
 			app = scope.Application.Application
 		}
 
+		var workspace string
+		if v.Workspace != nil {
+			workspace = v.Workspace.Workspace
+		}
+
 		value := ""
-		switch v := v.Value.(type) {
+		switch val := v.Value.(type) {
 		case *pb.ConfigVar_Static:
-			value = v.Static
+			value = val.Static
+
+			// Files are usually too long to show in a table.
+			if v.NameIsPath {
+				value = fmt.Sprintf("<file, %d bytes>", len(val.Static))
+			}
 
 		case *pb.ConfigVar_Dynamic:
-			value = fmt.Sprintf("<dynamic via %s>", v.Dynamic.From)
+			value = configDynamicString(val.Dynamic)
 		}
 
 		table.Rich([]string{
 			app,
+			workspace,
 			v.Name,
 			value,
 		}, []string{
+			"",
 			"",
 			terminal.Green,
 			"",
@@ -183,6 +210,16 @@ func (c *ConfigGetCommand) Run(args []string) int {
 	return 0
 }
 
+// configDynamicString returns the value to show for a dynamic value. This
+// is the secret reference if the value was set with one.
+func configDynamicString(v *pb.ConfigVar_DynamicVal) string {
+	if ref := serverptypes.SecretRefString(v); ref != "" {
+		return ref
+	}
+
+	return fmt.Sprintf("<dynamic via %s>", v.From)
+}
+
 func (c *ConfigGetCommand) Flags() *flag.Sets {
 	return c.flagSet(0, func(set *flag.Sets) {
 		f := set.NewSet("Command Options")
@@ -231,5 +268,9 @@ Usage: waypoint config-get [prefix]
   By specifying the "-app" flag you can look at config variables for
   a specific application rather than the project.
 
+  By specifying the "-workspace" flag, the config variables set for that
+  workspace are included. With "-app", the values are merged as they
+  would be for a deployment in the workspace.
+
 ` + c.Flags().Help())
 }
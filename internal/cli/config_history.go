@@ -0,0 +1,149 @@
+package cli
+
+import (
+	stdflag "flag"
+	"strconv"
+
+	"github.com/dustin/go-humanize"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type ConfigHistoryCommand struct {
+	*baseCommand
+}
+
+func (c *ConfigHistoryCommand) Run(args []string) int {
+	flagSet := c.Flags()
+
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(flagSet),
+
+		// Don't allow a local in-mem server because configuration
+		// makes no sense with the local server.
+		WithNoAutoServer(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("config history requires one argument: the variable name.\n\n"+c.Help(),
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	ref := c.configVarRef(flagSet, c.args[0])
+	resp, err := c.project.Client().GetConfigHistory(c.Ctx, &pb.GetConfigHistoryRequest{
+		Variable: ref,
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	if len(resp.Versions) == 0 {
+		c.ui.Output("No history found for config variable %q.", ref.Name)
+		return 0
+	}
+
+	tbl := terminal.NewTable("Version", "Time", "User", "Value", "Note")
+	for _, v := range resp.Versions {
+		var value, note string
+		switch val := v.Variable.Value.(type) {
+		case *pb.ConfigVar_Static:
+			value = val.Static
+
+		case *pb.ConfigVar_Dynamic:
+			value = configDynamicString(val.Dynamic)
+
+		case *pb.ConfigVar_Unset:
+			note = "unset"
+		}
+		if v.RollbackVersion > 0 {
+			note = "rollback to v" + strconv.FormatUint(v.RollbackVersion, 10)
+		}
+
+		when := "n/a"
+		if t, err := ptypes.Timestamp(v.Time); err == nil {
+			when = humanize.Time(t)
+		}
+
+		tbl.Rich([]string{
+			"v" + strconv.FormatUint(v.Version, 10),
+			when,
+			v.Username,
+			value,
+			note,
+		}, nil)
+	}
+	c.ui.Table(tbl)
+
+	return 0
+}
+
+// configVarRef returns the config var that identifies the variable name
+// in the scope set by the flags, like "waypoint config set".
+func (c *baseCommand) configVarRef(flagSet *flag.Sets, name string) *pb.ConfigVar {
+	ref := &pb.ConfigVar{
+		Name:  name,
+		Scope: &pb.ConfigVar_Project{Project: c.project.Ref()},
+	}
+	if c.flagApp != "" {
+		ref.Scope = &pb.ConfigVar_Application{
+			Application: &pb.Ref_Application{
+				Project:     c.project.Ref().Project,
+				Application: c.flagApp,
+			},
+		}
+	}
+
+	// The workspace flag always has a value, so we only scope the config
+	// to the workspace if it was set explicitly.
+	flagSet.Visit(func(f *stdflag.Flag) {
+		if f.Name == "workspace" {
+			ref.Workspace = c.refWorkspace
+		}
+	})
+
+	return ref
+}
+
+func (c *ConfigHistoryCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *ConfigHistoryCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ConfigHistoryCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ConfigHistoryCommand) Synopsis() string {
+	return "Show the previous values of a config variable."
+}
+
+func (c *ConfigHistoryCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint config history [options] NAME
+
+  Show the previous values of a config variable, newest first.
+
+  A new version is recorded with the user and time every time the
+  variable is set or unset. Use "waypoint config rollback" to set the
+  variable back to the value of a previous version.
+
+  This shows the variable set for the project by default. Specify the
+  "-app" flag to show the variable of an application and the "-workspace"
+  flag to show the variable set for that workspace.
+
+` + c.Flags().Help())
+}
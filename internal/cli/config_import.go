@@ -0,0 +1,215 @@
+package cli
+
+import (
+	"encoding/json"
+	stdflag "flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+type ConfigImportCommand struct {
+	*baseCommand
+
+	flagFormat []string
+	flagStatic bool
+}
+
+func (c *ConfigImportCommand) Run(args []string) int {
+	flagSet := c.Flags()
+
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(flagSet),
+
+		// Don't allow a local in-mem server because configuration
+		// makes no sense with the local server.
+		WithNoAutoServer(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("config import requires one argument: the file to import.\n\n"+c.Help(),
+			terminal.WithErrorStyle())
+		return 1
+	}
+	path := c.args[0]
+
+	// The workspace flag always has a value, so we only scope the config
+	// to the workspace if it was set explicitly.
+	var workspace *pb.Ref_Workspace
+	flagSet.Visit(func(f *stdflag.Flag) {
+		if f.Name == "workspace" {
+			workspace = c.refWorkspace
+		}
+	})
+
+	// The format defaults to the extension of the file.
+	format := "dotenv"
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		format = "json"
+	}
+	if len(c.flagFormat) > 0 {
+		format = c.flagFormat[len(c.flagFormat)-1]
+	}
+
+	vars, err := readConfigFile(path, format)
+	if err != nil {
+		c.ui.Output("Error reading %s: %s", path, clierrors.Humanize(err),
+			terminal.WithErrorStyle())
+		return 1
+	}
+	if len(vars) == 0 {
+		c.ui.Output("No variables found in %s.", path, terminal.WithWarningStyle())
+		return 0
+	}
+
+	var req pb.ConfigSetRequest
+	for _, v := range vars {
+		configVar := &pb.ConfigVar{
+			Name:      v.Name,
+			Value:     &pb.ConfigVar_Static{Static: v.Value},
+			Workspace: workspace,
+		}
+
+		// Secret references are stored as dynamic values, like with
+		// "waypoint config set".
+		if !c.flagStatic && serverptypes.IsSecretRef(v.Value) {
+			dynamic, err := serverptypes.ParseSecretRef(v.Value)
+			if err != nil {
+				c.ui.Output("Error importing %q: %s", v.Name, err, terminal.WithErrorStyle())
+				return 1
+			}
+
+			configVar.Value = &pb.ConfigVar_Dynamic{Dynamic: dynamic}
+		}
+
+		if c.flagApp == "" {
+			configVar.Scope = &pb.ConfigVar_Project{
+				Project: c.project.Ref(),
+			}
+		} else {
+			configVar.Scope = &pb.ConfigVar_Application{
+				Application: &pb.Ref_Application{
+					Project:     c.project.Ref().Project,
+					Application: c.flagApp,
+				},
+			}
+		}
+
+		req.Variables = append(req.Variables, configVar)
+	}
+
+	// All variables are set in a single request, which the server applies
+	// in a single transaction, so an import is never partially applied.
+	if _, err := c.project.Client().SetConfig(c.Ctx, &req); err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	c.ui.Output("Imported %d config variables from %s.", len(vars), path,
+		terminal.WithSuccessStyle())
+	return 0
+}
+
+// readConfigFile reads the variables of a dotenv or JSON file. A JSON file
+// must be an object with string values, like "waypoint config export"
+// writes.
+func readConfigFile(path, format string) ([]dotEnvVar, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if format != "json" {
+		return parseDotEnv(f)
+	}
+
+	var values map[string]interface{}
+	if err := json.NewDecoder(f).Decode(&values); err != nil {
+		return nil, err
+	}
+
+	var result []dotEnvVar
+	for name, raw := range values {
+		value, ok := raw.(string)
+		if !ok {
+			return nil, fmt.Errorf("value of %q must be a string", name)
+		}
+
+		result = append(result, dotEnvVar{Name: name, Value: value})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+func (c *ConfigImportCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.EnumVar(&flag.EnumVar{
+			Name:   "format",
+			Target: &c.flagFormat,
+			Values: configFormats,
+			Usage: "Format of the file. One of \"dotenv\" or \"json\". Defaults " +
+				"to \"json\" for files ending in .json and \"dotenv\" otherwise.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "static",
+			Target: &c.flagStatic,
+			Usage: "Store values as is, even if they look like secret " +
+				"references such as \"vault:secret/data/app#token\".",
+			Default: false,
+		})
+	})
+}
+
+func (c *ConfigImportCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictFiles("*")
+}
+
+func (c *ConfigImportCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ConfigImportCommand) Synopsis() string {
+	return "Import config variables from a dotenv or JSON file."
+}
+
+func (c *ConfigImportCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint config import [options] FILE
+
+  Import config variables from a dotenv or JSON file.
+
+  Dotenv files have a NAME=VALUE pair per line. Lines may start with
+  "export " and values may be quoted. JSON files must be an object with
+  string values. This is the format "waypoint config export" writes.
+
+  This sets the variables for the project by default. Specify the "-app"
+  flag to set them for an application and the "-workspace" flag to set
+  them only for that workspace. All variables are set at once, so if any
+  variable is invalid, none are set. Empty values unset the variable.
+
+  Values that are secret references, such as "vault:secret/data/app#token",
+  are stored as references like with "waypoint config set".
+
+` + c.Flags().Help())
+}
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type ConfigRollbackCommand struct {
+	*baseCommand
+
+	flagTo uint
+}
+
+func (c *ConfigRollbackCommand) Run(args []string) int {
+	flagSet := c.Flags()
+
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(flagSet),
+
+		// Don't allow a local in-mem server because configuration
+		// makes no sense with the local server.
+		WithNoAutoServer(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("config rollback requires one argument: the variable name.\n\n"+c.Help(),
+			terminal.WithErrorStyle())
+		return 1
+	}
+	if c.flagTo == 0 {
+		c.ui.Output("The version to roll back to must be set with -to.\n\n"+c.Help(),
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	ref := c.configVarRef(flagSet, c.args[0])
+	resp, err := c.project.Client().RollbackConfig(c.Ctx, &pb.RollbackConfigRequest{
+		Variable: ref,
+		Version:  uint64(c.flagTo),
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	c.ui.Output("Rolled back %q to v%d as v%d.", ref.Name, c.flagTo, resp.Version.Version,
+		terminal.WithSuccessStyle())
+	return 0
+}
+
+func (c *ConfigRollbackCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.UintVar(&flag.UintVar{
+			Name:   "to",
+			Target: &c.flagTo,
+			Usage:  "Version to roll back to, as shown by \"waypoint config history\".",
+		})
+	})
+}
+
+func (c *ConfigRollbackCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ConfigRollbackCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ConfigRollbackCommand) Synopsis() string {
+	return "Set a config variable back to a previous value."
+}
+
+func (c *ConfigRollbackCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint config rollback [options] -to=VERSION NAME
+
+  Set a config variable back to the value of a previous version.
+
+  The rollback is recorded as a new version, so it can be rolled back too.
+  Rolling back to a version where the variable was unset unsets it. Like
+  any other change, running deployments pick up the value and restart.
+
+  This uses the variable set for the project by default. Specify the
+  "-app" flag to use the variable of an application and the "-workspace"
+  flag to use the variable set for that workspace.
+
+` + c.Flags().Help())
+}
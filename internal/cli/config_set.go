@@ -2,7 +2,9 @@ package cli
 
 import (
 	"bufio"
+	stdflag "flag"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"strings"
 
@@ -10,19 +12,24 @@ import (
 	"github.com/hashicorp/waypoint/internal/clierrors"
 	"github.com/hashicorp/waypoint/internal/pkg/flag"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
 	"github.com/posener/complete"
 )
 
 type ConfigSetCommand struct {
 	*baseCommand
 
-	flagRunner bool
+	flagRunner   bool
+	flagStatic   bool
+	flagFile     bool
+	flagTemplate bool
 }
 
 func (c *ConfigSetCommand) Run(args []string) int {
+	flagSet := c.Flags()
 	initOpts := []Option{
 		WithArgs(args),
-		WithFlags(c.Flags()),
+		WithFlags(flagSet),
 
 		// Don't allow a local in-mem server because configuration
 		// makes no sense with the local server.
@@ -46,6 +53,25 @@ func (c *ConfigSetCommand) Run(args []string) int {
 		return 1
 	}
 
+	// The workspace flag always has a value, so we only scope the config
+	// to the workspace if it was set explicitly.
+	var workspace *pb.Ref_Workspace
+	flagSet.Visit(func(f *stdflag.Flag) {
+		if f.Name == "workspace" {
+			workspace = c.refWorkspace
+		}
+	})
+	if workspace != nil && c.flagRunner {
+		c.ui.Output("Runner configuration can't be scoped to a workspace.",
+			terminal.WithErrorStyle())
+		return 1
+	}
+	if c.flagFile && c.flagRunner {
+		c.ui.Output("Use \"waypoint runner config set\" to set files on runners.",
+			terminal.WithErrorStyle())
+		return 1
+	}
+
 	// If there are no command arguments, check if the command has
 	// been invoked with a pipe like `cat .env | waypoint config set`.
 	if len(c.args) == 0 {
@@ -84,6 +110,38 @@ func (c *ConfigSetCommand) Run(args []string) int {
 			Value: &pb.ConfigVar_Static{
 				Static: arg[idx+1:],
 			},
+			Workspace: workspace,
+		}
+
+		// For files, the value is the local file to read the contents of.
+		if c.flagFile {
+			data, err := ioutil.ReadFile(arg[idx+1:])
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "error reading file for %q: %s", arg[:idx], err)
+				return 1
+			}
+
+			// Files are rendered as templates by the entrypoint, so we
+			// escape them unless they are meant to be templates.
+			value := string(data)
+			if !c.flagTemplate {
+				value = configTemplateEscaper.Replace(value)
+			}
+
+			configVar.NameIsPath = true
+			configVar.Value = &pb.ConfigVar_Static{Static: value}
+		}
+
+		// Secret references are stored as dynamic values so that the
+		// secret is read by the entrypoint or runner and never stored.
+		if value := arg[idx+1:]; !c.flagFile && !c.flagStatic && serverptypes.IsSecretRef(value) {
+			dynamic, err := serverptypes.ParseSecretRef(value)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%s", err)
+				return 1
+			}
+
+			configVar.Value = &pb.ConfigVar_Dynamic{Dynamic: dynamic}
 		}
 
 		switch {
@@ -122,6 +180,10 @@ func (c *ConfigSetCommand) Run(args []string) int {
 	return 0
 }
 
+// configTemplateEscaper escapes the template sequences of a value so the
+// value is used as is when the entrypoint renders it.
+var configTemplateEscaper = strings.NewReplacer("${", "$${", "%{", "%%{")
+
 func (c *ConfigSetCommand) Flags() *flag.Sets {
 	return c.flagSet(0, func(set *flag.Sets) {
 		f := set.NewSet("Command Options")
@@ -135,6 +197,34 @@ func (c *ConfigSetCommand) Flags() *flag.Sets {
 				"to deployed applications.",
 			Default: false,
 		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "file",
+			Target: &c.flagFile,
+			Usage: "Set config files instead of environment variables. Arguments " +
+				"are in the form PATH=LOCAL_FILE. The contents of LOCAL_FILE are " +
+				"stored on the server and written to PATH in each instance. " +
+				"Relative paths are relative to the working directory of the " +
+				"application.",
+			Default: false,
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "template",
+			Target: &c.flagTemplate,
+			Usage: "With -file, render the file as a template in each instance. " +
+				"Templates can reference config variables such as " +
+				"${config.env.PORT} or ${config.internal.db_password}.",
+			Default: false,
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "static",
+			Target: &c.flagStatic,
+			Usage: "Store values as is, even if they look like secret " +
+				"references such as \"vault:secret/data/app#token\".",
+			Default: false,
+		})
 	})
 }
 
@@ -160,5 +250,21 @@ Usage: waypoint config set <name>=<value>
   This will scope the variable to the entire project by default.
   Specify the "-app" flag to set a config variable for a specific app.
 
+  Specify the "-workspace" flag to set a config variable that is only used
+  in that workspace. Workspace values override the values that aren't set
+  for a workspace, so this can be used to set a different database URL
+  for "prod" and "staging", for example.
+
+  Values can be references to secrets in Vault or AWS Secrets Manager,
+  such as "vault:secret/data/app#token" or "aws-secretsmanager:prod/db#password".
+  The server only stores the reference. The secret is read by the
+  entrypoint or runner when the config is used, with the config source
+  set with "waypoint config source-set".
+
+  Specify the "-file" flag to set config files that are written to each
+  instance instead, such as "-file config/app.yml=./app.yml". When a file
+  changes, the application is sent the file change signal of the app,
+  which can be set to "restart" to restart the application instead.
+
 ` + c.Flags().Help())
 }
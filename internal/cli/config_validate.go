@@ -0,0 +1,211 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/posener/complete"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	configpkg "github.com/hashicorp/waypoint/internal/config"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	"github.com/hashicorp/waypoint/internal/plugin"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type ConfigValidateCommand struct {
+	*baseCommand
+}
+
+func (c *ConfigValidateCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI. We
+	// load the config ourselves so that we can report every error in it.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoConfig(),
+		WithClient(false),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) > 1 {
+		c.ui.Output("At most one argument is expected.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	var filename string
+	if len(c.args) == 1 {
+		filename = c.args[0]
+	}
+
+	path, err := c.initConfigPath(filename)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+	if path == "" {
+		c.ui.Output("A Waypoint configuration file (waypoint.hcl) is required but wasn't found.",
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	cfg, err := configpkg.Load(path, &configpkg.LoadOptions{
+		Pwd:       filepath.Dir(path),
+		Workspace: c.refWorkspace.Workspace,
+	})
+	if err != nil {
+		diags, ok := err.(hcl.Diagnostics)
+		if !ok {
+			c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return 1
+		}
+
+		c.outputDiagnostics(diags, nil)
+		return 1
+	}
+
+	// Builtin plugins are launched to read their schemas. Other plugins
+	// aren't validated until they're used.
+	diags := cfg.ValidateFull(&configpkg.ValidateOptions{
+		Schemas: plugin.NewSchemas(c.Log).Get,
+	})
+	c.outputDiagnostics(diags, map[string]*hcl.File{
+		path: {Bytes: cfg.Source()},
+	})
+	if diags.HasErrors() {
+		return 1
+	}
+
+	c.ui.Output("The configuration is valid.", terminal.WithSuccessStyle())
+	return 0
+}
+
+// validateRemoteConfig validates the local configuration with the server
+// before a remote job is queued, so that errors are shown with their
+// position rather than failing the job. Servers that don't support this
+// are skipped.
+func (c *baseCommand) validateRemoteConfig() error {
+	src := c.cfg.Source()
+
+	// JSON configuration is an object, while HCL can't start with a brace.
+	format := pb.Project_HCL
+	if bytes.HasPrefix(bytes.TrimSpace(src), []byte("{")) {
+		format = pb.Project_JSON
+	}
+
+	resp, err := c.project.Client().ValidateWaypointHcl(c.Ctx, &pb.ValidateWaypointHclRequest{
+		WaypointHcl: src,
+		Format:      format,
+		Workspace:   c.refWorkspace,
+	})
+	if status.Code(err) == codes.Unimplemented {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var diags hcl.Diagnostics
+	for _, d := range resp.Diagnostics {
+		diag := &hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  d.Summary,
+			Detail:   d.Detail,
+		}
+		if d.Severity == pb.ValidateWaypointHclResponse_WARNING {
+			diag.Severity = hcl.DiagWarning
+		}
+
+		if r := d.Range; r != nil {
+			diag.Subject = &hcl.Range{
+				Filename: r.Filename,
+				Start: hcl.Pos{
+					Line:   int(r.Start.GetLine()),
+					Column: int(r.Start.GetColumn()),
+					Byte:   int(r.Start.GetByte()),
+				},
+				End: hcl.Pos{
+					Line:   int(r.End.GetLine()),
+					Column: int(r.End.GetColumn()),
+					Byte:   int(r.End.GetByte()),
+				},
+			}
+		}
+
+		diags = append(diags, diag)
+	}
+
+	// Warnings are only shown by "waypoint config validate" since they
+	// are usually about things the server can't check.
+	if !diags.HasErrors() {
+		return nil
+	}
+
+	c.outputDiagnostics(diags, map[string]*hcl.File{
+		configpkg.Filename: {Bytes: src},
+	})
+	return ErrSentinel
+}
+
+// outputDiagnostics writes the diagnostics with the source they refer to.
+// files are the sources by file name, which may be nil.
+func (c *baseCommand) outputDiagnostics(diags hcl.Diagnostics, files map[string]*hcl.File) {
+	if len(diags) == 0 {
+		return
+	}
+
+	out, _, err := c.ui.OutputWriters()
+	if err != nil {
+		c.ui.Output(diags.Error(), terminal.WithErrorStyle())
+		return
+	}
+
+	w := hcl.NewDiagnosticTextWriter(out, files, 78, !c.flagPlain)
+	if err := w.WriteDiagnostics(diags); err != nil {
+		c.ui.Output(diags.Error(), terminal.WithErrorStyle())
+	}
+	fmt.Fprintln(out)
+}
+
+func (c *ConfigValidateCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *ConfigValidateCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictFiles("*.hcl")
+}
+
+func (c *ConfigValidateCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ConfigValidateCommand) Synopsis() string {
+	return "Validate the waypoint.hcl file."
+}
+
+func (c *ConfigValidateCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint config validate [options] [FILE]
+
+  Validate a Waypoint configuration file.
+
+  This decodes the full configuration, including every app and stage,
+  and checks the configuration of each builtin plugin against the fields
+  the plugin supports. Errors are reported with their position in the
+  file. Plugins that aren't builtin aren't validated.
+
+  Input variables without a default value aren't known when validating,
+  so errors that depend on their values are only found by operations.
+
+  FILE defaults to the waypoint.hcl file of the current project. Remote
+  operations, such as "waypoint up -remote", also validate the
+  configuration with the server before queueing the job.
+
+` + c.Flags().Help())
+}
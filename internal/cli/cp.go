@@ -0,0 +1,197 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	clientpkg "github.com/hashicorp/waypoint/internal/client"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	"github.com/hashicorp/waypoint/internal/pkg/tarcopy"
+	"github.com/hashicorp/waypoint/internal/server/execclient"
+)
+
+type CpCommand struct {
+	*baseCommand
+
+	flagInstanceId string
+}
+
+func (c *CpCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithSingleApp(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 2 {
+		c.ui.Output("Two arguments are required.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	src, dst := c.args[0], c.args[1]
+	srcRemote, dstRemote := strings.HasPrefix(src, ":"), strings.HasPrefix(dst, ":")
+	if srcRemote == dstRemote {
+		c.ui.Output(
+			"Exactly one of the paths must be a path in the instance, prefixed with \":\".\n\n"+c.Help(),
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	client := c.project.Client()
+	err := c.DoApp(c.Ctx, func(ctx context.Context, app *clientpkg.App) error {
+		fc := &execclient.FileCopy{
+			Logger:  c.Log,
+			Context: ctx,
+			Client:  client,
+		}
+
+		if c.flagInstanceId != "" {
+			if err := checkAppInstance(ctx, app, client, c.flagInstanceId); err != nil {
+				return err
+			}
+
+			fc.InstanceId = c.flagInstanceId
+		} else {
+			deployment, err := c.latestDeployment(ctx, app, client)
+			if err != nil {
+				return err
+			}
+
+			fc.DeploymentId = deployment.Id
+		}
+
+		var err error
+		if srcRemote {
+			fc.Path = strings.TrimPrefix(src, ":")
+			err = c.download(fc, dst)
+		} else {
+			fc.Path = strings.TrimPrefix(dst, ":")
+			err = c.upload(fc, src)
+		}
+		if err != nil {
+			app.UI.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return ErrSentinel
+		}
+
+		app.UI.Output("Copied %s to %s", src, dst, terminal.WithSuccessStyle())
+		return nil
+	})
+	if err != nil {
+		return 1
+	}
+
+	return 0
+}
+
+// download copies the path of the instance to the local path dst. If dst
+// is an existing directory, the path is copied into it.
+func (c *CpCommand) download(fc *execclient.FileCopy, dst string) error {
+	if fi, err := os.Stat(dst); err == nil && fi.IsDir() {
+		dst = filepath.Join(dst, path.Base(fc.Path))
+	}
+
+	pr, pw := io.Pipe()
+	errCh := make(chan error, 1)
+	go func() {
+		err := tarcopy.Extract(pr, dst)
+		if err == nil {
+			// Read any padding after the end of the archive.
+			_, err = io.Copy(ioutil.Discard, pr)
+		}
+
+		// Unblock the download if the extraction failed early.
+		pr.CloseWithError(err)
+		errCh <- err
+	}()
+
+	err := fc.Download(pw)
+	pw.CloseWithError(err)
+	if extractErr := <-errCh; err == nil {
+		err = extractErr
+	}
+
+	return err
+}
+
+// upload copies the local path src to the path of the instance.
+func (c *CpCommand) upload(fc *execclient.FileCopy, src string) error {
+	if _, err := os.Stat(src); err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(tarcopy.Write(pw, src))
+	}()
+
+	err := fc.Upload(pr)
+	pr.Close()
+	if err != nil {
+		return fmt.Errorf("error uploading %s: %w", src, err)
+	}
+
+	return nil
+}
+
+func (c *CpCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(s *flag.Sets) {
+		f := s.NewSet("Command Options")
+		f.StringVar(&flag.StringVar{
+			Name:   "instance",
+			Usage:  "Copy files to or from this specific instance.",
+			Target: &c.flagInstanceId,
+		})
+	})
+}
+
+func (c *CpCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictFiles("*")
+}
+
+func (c *CpCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *CpCommand) Synopsis() string {
+	return "Copy files to or from a running application instance"
+}
+
+func (c *CpCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint cp [options] [PROJECT/APP] SRC DST
+
+  Copy a file or directory to or from a running application instance.
+
+  Paths in the instance are prefixed with ":" and exactly one of SRC and
+  DST must be one. For example, to download a heap dump and upload a
+  config file:
+
+      $ waypoint cp :/tmp/heap.hprof .
+      $ waypoint cp ./app.conf :/etc/app/app.conf
+
+  Directories are copied recursively. When downloading to an existing
+  local directory, the file or directory is copied into it. When
+  uploading, DST is the full path to write to and existing files are
+  overwritten. Local paths that look like PROJECT/APP must be written as
+  "./PATH".
+
+  Files are copied by the Waypoint entrypoint of a random instance of the
+  latest deployment, or of the instance set with "-instance". This isn't
+  supported for deployments that use an exec plugin. Copies are recorded
+  like "waypoint exec" sessions.
+
+` + c.Flags().Help())
+}
@@ -161,7 +161,7 @@ func printInplaceInfo(inplace bool, app *clientpkg.App) {
 }
 
 func (c *DeploymentCreateCommand) Flags() *flag.Sets {
-	return c.flagSet(flagSetOperation, func(set *flag.Sets) {
+	return c.flagSet(flagSetOperation|flagSetParallel, func(set *flag.Sets) {
 		f := set.NewSet("Command Options")
 		f.BoolVar(&flag.BoolVar{
 			Name:    "release",
@@ -0,0 +1,201 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type DeploymentDiffCommand struct {
+	*baseCommand
+}
+
+func (c *DeploymentDiffCommand) Run(args []string) int {
+	flags := c.Flags()
+
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(flags),
+		WithSingleApp(),
+	); err != nil {
+		return 1
+	}
+	args = flags.Args()
+
+	if len(args) != 2 {
+		c.ui.Output("Two deployments are required.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	resp, err := c.project.Client().GetDeploymentDiff(c.Ctx, &pb.GetDeploymentDiffRequest{
+		From: c.deploymentRef(args[0]),
+		To:   c.deploymentRef(args[1]),
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	if resp.From.Snapshot == nil || resp.To.Snapshot == nil {
+		c.ui.Output(strings.TrimSpace(deploymentDiffNoSnapshot), terminal.WithWarningStyle())
+	}
+
+	if len(resp.Changes) == 0 {
+		c.ui.Output("No changes between v%d and v%d.",
+			resp.From.Sequence, resp.To.Sequence, terminal.WithSuccessStyle())
+		return 0
+	}
+
+	// Group the changes by section, keeping the order from the server.
+	sections := map[pb.GetDeploymentDiffResponse_Section][]*pb.GetDeploymentDiffResponse_Change{}
+	for _, change := range resp.Changes {
+		sections[change.Section] = append(sections[change.Section], change)
+	}
+
+	for _, section := range []pb.GetDeploymentDiffResponse_Section{
+		pb.GetDeploymentDiffResponse_ARTIFACT,
+		pb.GetDeploymentDiffResponse_CONFIG,
+		pb.GetDeploymentDiffResponse_VARIABLE,
+	} {
+		changes := sections[section]
+		if len(changes) == 0 {
+			continue
+		}
+
+		c.ui.Output(deploymentDiffHeaders[section], terminal.WithHeaderStyle())
+		table := terminal.NewTable("Name",
+			"v"+strconv.FormatUint(resp.From.Sequence, 10),
+			"v"+strconv.FormatUint(resp.To.Sequence, 10),
+		)
+		for _, change := range changes {
+			table.Rich([]string{change.Name, change.From, change.To}, nil)
+		}
+		c.ui.Table(table)
+	}
+
+	for _, change := range sections[pb.GetDeploymentDiffResponse_WAYPOINT_HCL] {
+		c.ui.Output(deploymentDiffHeaders[change.Section], terminal.WithHeaderStyle())
+		for _, line := range lineDiff(change.From, change.To) {
+			c.ui.Output(line)
+		}
+	}
+
+	return 0
+}
+
+// deploymentRef returns the reference for a deployment given as a
+// sequence number, such as "42" or "v42", or a long ID.
+func (c *DeploymentDiffCommand) deploymentRef(v string) *pb.Ref_Operation {
+	if n, err := strconv.ParseUint(strings.TrimPrefix(v, "v"), 10, 64); err == nil {
+		return &pb.Ref_Operation{
+			Target: &pb.Ref_Operation_Sequence{
+				Sequence: &pb.Ref_OperationSeq{
+					Application: c.refApp,
+					Number:      n,
+				},
+			},
+		}
+	}
+
+	return &pb.Ref_Operation{
+		Target: &pb.Ref_Operation_Id{Id: v},
+	}
+}
+
+// lineDiff returns the lines of a and b prefixed with "-" for lines only
+// in a, "+" for lines only in b, and spaces for lines in both. This uses
+// the longest common subsequence, which is fine for files the size of a
+// waypoint.hcl.
+func lineDiff(a, b string) []string {
+	as := strings.Split(strings.TrimSuffix(a, "\n"), "\n")
+	bs := strings.Split(strings.TrimSuffix(b, "\n"), "\n")
+
+	// lcs[i][j] is the length of the longest common subsequence of
+	// as[i:] and bs[j:].
+	lcs := make([][]int, len(as)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(bs)+1)
+	}
+	for i := len(as) - 1; i >= 0; i-- {
+		for j := len(bs) - 1; j >= 0; j-- {
+			switch {
+			case as[i] == bs[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var result []string
+	i, j := 0, 0
+	for i < len(as) || j < len(bs) {
+		switch {
+		case i < len(as) && j < len(bs) && as[i] == bs[j]:
+			result = append(result, "  "+as[i])
+			i++
+			j++
+		case j == len(bs) || (i < len(as) && lcs[i+1][j] >= lcs[i][j+1]):
+			result = append(result, "- "+as[i])
+			i++
+		default:
+			result = append(result, "+ "+bs[j])
+			j++
+		}
+	}
+
+	return result
+}
+
+func (c *DeploymentDiffCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *DeploymentDiffCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *DeploymentDiffCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *DeploymentDiffCommand) Synopsis() string {
+	return "Show what changed between two deployments"
+}
+
+func (c *DeploymentDiffCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint deployment diff [options] FROM TO
+
+  Show what changed between two deployments.
+
+  The deployments can be specified by sequence number, such as "41" or
+  "v41", or by long ID. This compares the artifacts, the config variables,
+  the input variables, and the waypoint.hcl of the deployments.
+
+  Config, input variables, and waypoint.hcl are only recorded for
+  deployments created by this version of Waypoint or later.
+
+` + c.Flags().Help())
+}
+
+var deploymentDiffHeaders = map[pb.GetDeploymentDiffResponse_Section]string{
+	pb.GetDeploymentDiffResponse_ARTIFACT:     "Artifact",
+	pb.GetDeploymentDiffResponse_CONFIG:       "Config Variables",
+	pb.GetDeploymentDiffResponse_VARIABLE:     "Input Variables",
+	pb.GetDeploymentDiffResponse_WAYPOINT_HCL: "waypoint.hcl",
+}
+
+const deploymentDiffNoSnapshot = `
+One of the deployments was created before Waypoint recorded the inputs of
+deployments. Only the artifacts can be compared.
+`
@@ -0,0 +1,19 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLineDiff(t *testing.T) {
+	require.Equal(t, []string{
+		"  a",
+		"- b",
+		"+ x",
+		"  c",
+		"+ d",
+	}, lineDiff("a\nb\nc\n", "a\nx\nc\nd\n"))
+
+	require.Equal(t, []string{"  a"}, lineDiff("a", "a\n"))
+}
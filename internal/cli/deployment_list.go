@@ -95,19 +95,20 @@ func (c *DeploymentListCommand) Run(args []string) int {
 		}
 
 		// List builds
-		resp, err := client.ListDeployments(c.Ctx, &pb.ListDeploymentsRequest{
+		deployments, err := listDeployments(c.Ctx, client, &pb.ListDeploymentsRequest{
 			Application:   app.Ref(),
 			Workspace:     wsRef,
 			PhysicalState: phyState,
 			Status:        c.filterFlags.statusFilters(),
 			Order:         c.filterFlags.orderOp(),
+			Filter:        c.filterFlags.operationFilter(),
 			LoadDetails:   pb.Deployment_BUILD,
 		})
 		if err != nil {
 			c.project.UI.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
 			return ErrSentinel
 		}
-		sort.Sort(serversort.DeploymentCompleteDesc(resp.Deployments))
+		sort.Sort(serversort.DeploymentCompleteDesc(deployments))
 
 		// get status reports
 		statusReportsResp, err := client.ListStatusReports(ctx, &pb.ListStatusReportsRequest{
@@ -125,7 +126,7 @@ func (c *DeploymentListCommand) Run(args []string) int {
 		}
 
 		if c.flagJson {
-			return c.displayJson(resp.Deployments)
+			return c.displayJson(deployments)
 		}
 
 		headers := []string{
@@ -140,7 +141,7 @@ func (c *DeploymentListCommand) Run(args []string) int {
 
 		const bullet = "●"
 
-		for _, b := range resp.Deployments {
+		for _, b := range deployments {
 			// Determine our bullet
 			status := ""
 			statusColor := ""
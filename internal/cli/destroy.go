@@ -66,6 +66,14 @@ func (c *DestroyCommand) Flags() *flag.Sets {
 			Default: false,
 			Usage:   "Confirm destroying all resources.",
 		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "local-compose",
+			Target: &c.flagLocalCompose,
+			Usage: "Destroy the deployments of every app made with " +
+				"\"waypoint up -local-compose\". This operates in the \"" +
+				localComposeWorkspace + "\" workspace unless -workspace is set.",
+		})
 	})
 }
 
@@ -90,5 +98,9 @@ Usage: waypoint destroy [options]
   This targets one app in one workspace. You must call this for each workspace
   you've used if you want to destroy everything.
 
+  With -local-compose, this destroys the deployments that
+  "waypoint up -local-compose" made for every app, including the Docker
+  network the apps share.
+
 ` + c.Flags().Help())
 }
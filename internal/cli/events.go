@@ -0,0 +1,249 @@
+package cli
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// eventsPageSize is the number of events requested from the server at a
+// time.
+const eventsPageSize = 100
+
+// appEventTypes maps the values of the -type flag to the event types in
+// the API.
+var appEventTypes = map[string]pb.AppEvent_Type{
+	"build-started":      pb.AppEvent_BUILD_STARTED,
+	"artifact-pushed":    pb.AppEvent_ARTIFACT_PUSHED,
+	"deployment-created": pb.AppEvent_DEPLOYMENT_CREATED,
+	"release-switched":   pb.AppEvent_RELEASE_SWITCHED,
+	"health-changed":     pb.AppEvent_HEALTH_CHANGED,
+}
+
+type EventsCommand struct {
+	*baseCommand
+
+	flagJson  bool
+	flagLimit uint
+	flagTypes []string
+}
+
+func (c *EventsCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithConfig(true),
+	); err != nil {
+		return 1
+	}
+
+	// The application is either given as "project/app" or is the app
+	// set with "-app" in the project of the current directory.
+	var appRef *pb.Ref_Application
+	switch {
+	case len(c.args) > 1:
+		c.ui.Output("At most one argument is expected.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+
+	case len(c.args) == 1:
+		match := reAppTarget.FindStringSubmatch(c.args[0])
+		if match == nil {
+			c.ui.Output("The argument must be in the form \"project/app\".\n\n"+c.Help(),
+				terminal.WithErrorStyle())
+			return 1
+		}
+
+		appRef = &pb.Ref_Application{Project: match[1], Application: match[2]}
+
+	case c.cfg != nil && c.flagApp != "":
+		appRef = &pb.Ref_Application{Project: c.cfg.Project, Application: c.flagApp}
+
+	default:
+		c.ui.Output("An application must be specified.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	events, err := c.events(appRef)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	if c.flagJson {
+		data, err := json.MarshalIndent(appEventsJson(events), "", "  ")
+		if err != nil {
+			c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return 1
+		}
+
+		c.ui.Output(string(data))
+		return 0
+	}
+
+	if len(events) == 0 {
+		c.ui.Output("No events found for application %q in workspace %q.",
+			appRef.Application, c.refWorkspace.Workspace)
+		return 0
+	}
+
+	c.ui.Output("Events of %q in workspace %q:",
+		appRef.Project+"/"+appRef.Application, c.refWorkspace.Workspace, terminal.WithHeaderStyle())
+
+	tbl := terminal.NewTable("Time", "Event", "Message")
+	for _, e := range events {
+		var colors []string
+		if e.Type == pb.AppEvent_HEALTH_CHANGED {
+			colors = []string{"", terminal.Yellow}
+		}
+
+		tbl.Rich([]string{
+			appEventTime(e),
+			appEventTypeString(e.Type),
+			e.Message,
+		}, colors)
+	}
+	c.ui.Table(tbl)
+
+	return 0
+}
+
+// events returns the events of the application in the current workspace,
+// newest first, up to the limit.
+func (c *EventsCommand) events(appRef *pb.Ref_Application) ([]*pb.AppEvent, error) {
+	var types []pb.AppEvent_Type
+	for _, t := range c.flagTypes {
+		types = append(types, appEventTypes[t])
+	}
+
+	var result []*pb.AppEvent
+	var token string
+	for {
+		resp, err := c.project.Client().ListAppEvents(c.Ctx, &pb.ListAppEventsRequest{
+			Application: appRef,
+			Workspace:   c.refWorkspace,
+			Types:       types,
+			PageSize:    eventsPageSize,
+			PageToken:   token,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, resp.Events...)
+		if c.flagLimit > 0 && len(result) >= int(c.flagLimit) {
+			return result[:c.flagLimit], nil
+		}
+
+		token = resp.NextPageToken
+		if token == "" {
+			return result, nil
+		}
+	}
+}
+
+// appEventTime returns the time of the event in the local time zone. We
+// show the full time rather than a relative time since events are usually
+// compared with the times in other logs.
+func appEventTime(e *pb.AppEvent) string {
+	t, err := ptypes.Timestamp(e.Time)
+	if err != nil {
+		return "n/a"
+	}
+
+	return t.Local().Format(time.RFC3339)
+}
+
+// appEventTypeString returns the value of the -type flag for the type.
+func appEventTypeString(t pb.AppEvent_Type) string {
+	return strings.ReplaceAll(strings.ToLower(t.String()), "_", "-")
+}
+
+func appEventsJson(events []*pb.AppEvent) []interface{} {
+	result := []interface{}{}
+	for _, e := range events {
+		result = append(result, map[string]interface{}{
+			"id":                 e.Id,
+			"time":               appEventTime(e),
+			"type":               appEventTypeString(e.Type),
+			"workspace":          e.Workspace.GetWorkspace(),
+			"operation_id":       e.OperationId,
+			"operation_sequence": e.OperationSequence,
+			"message":            e.Message,
+		})
+	}
+
+	return result
+}
+
+func (c *EventsCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		var types []string
+		for t := range appEventTypes {
+			types = append(types, t)
+		}
+		sort.Strings(types)
+
+		f := set.NewSet("Command Options")
+		f.BoolVar(&flag.BoolVar{
+			Name:    "json",
+			Target:  &c.flagJson,
+			Default: false,
+			Usage:   "Output the events as JSON.",
+		})
+
+		f.UintVar(&flag.UintVar{
+			Name:    "limit",
+			Target:  &c.flagLimit,
+			Default: 50,
+			Usage:   "Maximum number of events to show. Set to 0 to show all.",
+		})
+
+		f.EnumVar(&flag.EnumVar{
+			Name:   "type",
+			Target: &c.flagTypes,
+			Values: types,
+			Usage: "Type of event to show. This can be repeated. If this isn't " +
+				"set, events of all types are shown.",
+		})
+	})
+}
+
+func (c *EventsCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *EventsCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *EventsCommand) Synopsis() string {
+	return "Show the timeline of events of an application."
+}
+
+func (c *EventsCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint events [options] [project/app]
+
+  Show the timeline of events of an application, newest first.
+
+  The server records an event when a build starts, an artifact is pushed,
+  a deployment is created, a release switches traffic to a deployment,
+  and when the health reported for the application changes. Use this to
+  reconstruct what happened to an application, such as during an incident.
+
+  With no arguments, the application set with "-app" in the project of
+  the current directory is used. Only events for the current workspace
+  are shown.
+
+` + c.Flags().Help())
+}
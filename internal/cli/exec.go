@@ -25,6 +25,41 @@ func (c *ExecCommand) targeted(
 	app *clientpkg.App,
 	client pb.WaypointClient,
 	ec *execclient.Client,
+) error {
+	if err := checkAppInstance(ctx, app, client, c.flagInstanceId); err != nil {
+		return err
+	}
+
+	// Ok, the instance id is fine, let's go ahead and have execclient do it's thing.
+	ec.InstanceId = c.flagInstanceId
+
+	return nil
+}
+
+func (c *ExecCommand) searchDeployments(
+	ctx context.Context,
+	app *clientpkg.App,
+	client pb.WaypointClient,
+	ec *execclient.Client,
+) error {
+	deployment, err := c.latestDeployment(ctx, app, client)
+	if err != nil {
+		return err
+	}
+
+	ec.DeploymentId = deployment.Id
+	ec.DeploymentSeq = deployment.Sequence
+
+	return nil
+}
+
+// checkAppInstance verifies that the instance with the given ID is an
+// instance of the app. The error is output to the UI of the app.
+func checkAppInstance(
+	ctx context.Context,
+	app *clientpkg.App,
+	client pb.WaypointClient,
+	instanceId string,
 ) error {
 	resp, err := client.ListInstances(ctx, &pb.ListInstancesRequest{
 		Scope: &pb.ListInstancesRequest_Application_{
@@ -38,30 +73,23 @@ func (c *ExecCommand) targeted(
 		return ErrSentinel
 	}
 
-	var found bool
 	for _, i := range resp.Instances {
-		if i.Id == c.flagInstanceId {
-			found = true
+		if i.Id == instanceId {
+			return nil
 		}
 	}
 
-	if !found {
-		app.UI.Output("Unable to find instance: %s", c.flagInstanceId, terminal.WithErrorStyle())
-		return ErrSentinel
-	}
-
-	// Ok, the instance id is fine, let's go ahead and have execclient do it's thing.
-	ec.InstanceId = c.flagInstanceId
-
-	return nil
+	app.UI.Output("Unable to find instance: %s", instanceId, terminal.WithErrorStyle())
+	return ErrSentinel
 }
 
-func (c *ExecCommand) searchDeployments(
+// latestDeployment returns the latest deployment of the app that is
+// running. The error is output to the UI of the app.
+func (c *baseCommand) latestDeployment(
 	ctx context.Context,
 	app *clientpkg.App,
 	client pb.WaypointClient,
-	ec *execclient.Client,
-) error {
+) (*pb.Deployment, error) {
 	// Get the latest deployment
 	c.Log.Debug("looking up deployments to use for app", "app", app.Ref().String())
 
@@ -77,21 +105,16 @@ func (c *ExecCommand) searchDeployments(
 	})
 	if err != nil {
 		app.UI.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
-		return ErrSentinel
+		return nil, ErrSentinel
 	}
 	if len(resp.Deployments) == 0 {
 		app.UI.Output("No successful deployments found.", terminal.WithErrorStyle())
-		return ErrSentinel
+		return nil, ErrSentinel
 	}
 
 	c.Log.Debug("found deployment", "deployment-id", resp.Deployments[0].Id)
 
-	deployment := resp.Deployments[0]
-
-	ec.DeploymentId = deployment.Id
-	ec.DeploymentSeq = deployment.Sequence
-
-	return nil
+	return resp.Deployments[0], nil
 }
 
 func (c *ExecCommand) Run(args []string) int {
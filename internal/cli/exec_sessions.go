@@ -0,0 +1,308 @@
+package cli
+
+import (
+	"encoding/json"
+	stdflag "flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type ExecSessionsCommand struct {
+	*baseCommand
+
+	flagUsername string
+	flagLimit    uint
+	flagJson     bool
+}
+
+func (c *ExecSessionsCommand) Run(args []string) int {
+	flagSet := c.Flags()
+
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	// The configuration is optional: if we're in a project directory we
+	// only list the sessions of that project, otherwise we list all.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(flagSet),
+		WithConfig(true),
+		WithNoAutoServer(), // local mode has no sessions worth viewing
+	); err != nil {
+		return 1
+	}
+
+	switch len(c.args) {
+	case 0:
+		return c.list(flagSet)
+	case 1:
+		return c.inspect(c.args[0])
+	default:
+		c.ui.Output(c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+}
+
+func (c *ExecSessionsCommand) list(flagSet *flag.Sets) int {
+	req := &pb.ListExecSessionsRequest{
+		Limit: uint32(c.flagLimit),
+	}
+	if c.refProject != nil {
+		req.Application = &pb.Ref_Application{
+			Project:     c.refProject.Project,
+			Application: c.flagApp,
+		}
+	}
+	flagSet.Visit(func(f *stdflag.Flag) {
+		if f.Name == "workspace" {
+			req.Workspace = c.refWorkspace
+		}
+	})
+	if c.flagUsername != "" {
+		req.User = &pb.Ref_User{
+			Ref: &pb.Ref_User_Username{
+				Username: &pb.Ref_UserUsername{
+					Username: c.flagUsername,
+				},
+			},
+		}
+	}
+
+	resp, err := c.project.Client().ListExecSessions(c.Ctx, req)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	if c.flagJson {
+		data, err := json.MarshalIndent(resp.Sessions, "", "  ")
+		if err != nil {
+			c.ui.Output("Error rendering json: %s", err, terminal.WithErrorStyle())
+			return 1
+		}
+
+		c.ui.Output(string(data))
+		return 0
+	}
+
+	if len(resp.Sessions) == 0 {
+		c.ui.Output("No exec sessions found.")
+		return 0
+	}
+
+	tbl := terminal.NewTable("ID", "User", "App", "Workspace", "Instance", "Command", "Started", "Duration", "Exit")
+	for _, s := range resp.Sessions {
+		var started string
+		if t, err := ptypes.Timestamp(s.StartTime); err == nil {
+			started = humanize.Time(t)
+		}
+
+		user := s.Username
+		if user == "" {
+			user = "-"
+		}
+
+		tbl.Rich([]string{
+			s.Id,
+			user,
+			s.Application.GetApplication(),
+			s.Workspace.GetWorkspace(),
+			s.InstanceId,
+			execSessionCommand(s),
+			started,
+			execSessionDuration(s),
+			execSessionExit(s),
+		}, nil)
+	}
+	c.ui.Table(tbl)
+
+	return 0
+}
+
+func (c *ExecSessionsCommand) inspect(id string) int {
+	s, err := c.project.Client().GetExecSession(c.Ctx, &pb.GetExecSessionRequest{
+		SessionId: id,
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	if c.flagJson {
+		data, err := json.MarshalIndent(s, "", "  ")
+		if err != nil {
+			c.ui.Output("Error rendering json: %s", err, terminal.WithErrorStyle())
+			return 1
+		}
+
+		c.ui.Output(string(data))
+		return 0
+	}
+
+	var started string
+	if t, err := ptypes.Timestamp(s.StartTime); err == nil {
+		started = t.Local().Format(time.RFC3339)
+	}
+
+	c.ui.Output("Exec Session:", terminal.WithHeaderStyle())
+	c.ui.NamedValues([]terminal.NamedValue{
+		{Name: "id", Value: s.Id},
+		{Name: "user", Value: s.Username},
+		{Name: "project", Value: s.Application.GetProject()},
+		{Name: "app", Value: s.Application.GetApplication()},
+		{Name: "workspace", Value: s.Workspace.GetWorkspace()},
+		{Name: "deployment id", Value: s.DeploymentId},
+		{Name: "instance id", Value: s.InstanceId},
+		{Name: "command", Value: execSessionCommand(s)},
+		{Name: "pty", Value: s.Pty},
+		{Name: "started", Value: started},
+		{Name: "duration", Value: execSessionDuration(s)},
+		{Name: "exit code", Value: execSessionExit(s)},
+	}, terminal.WithInfoStyle())
+
+	if len(s.Transcript) == 0 {
+		c.ui.Output("\nNo transcript was recorded for this session.")
+		return 0
+	}
+
+	stdout, stderr, err := c.ui.OutputWriters()
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	// We replay the transcript as the user saw it. A PTY echoes the input,
+	// so the input is only written for sessions without one.
+	c.ui.Output("\nTranscript:", terminal.WithHeaderStyle())
+	for _, entry := range s.Transcript {
+		switch entry.Channel {
+		case pb.ExecSession_TranscriptEntry_STDIN:
+			if !s.Pty {
+				stdout.Write(entry.Data)
+			}
+		case pb.ExecSession_TranscriptEntry_STDERR:
+			stderr.Write(entry.Data)
+		default:
+			stdout.Write(entry.Data)
+		}
+	}
+
+	if s.TranscriptTruncated {
+		c.ui.Output("\nThe transcript was truncated because it exceeded the maximum size.",
+			terminal.WithWarningStyle())
+	}
+
+	return 0
+}
+
+// execSessionCommand returns the command of the session for humans.
+func execSessionCommand(s *pb.ExecSession) string {
+	if s.PortForwardPort > 0 {
+		return fmt.Sprintf("(port-forward %d)", s.PortForwardPort)
+	}
+
+	if fc := s.FileCopy; fc != nil {
+		if fc.Upload {
+			return fmt.Sprintf("(cp to %s)", fc.Path)
+		}
+
+		return fmt.Sprintf("(cp from %s)", fc.Path)
+	}
+
+	return strings.Join(s.Args, " ")
+}
+
+// execSessionDuration returns how long the session ran, or "running" if it
+// hasn't ended.
+func execSessionDuration(s *pb.ExecSession) string {
+	if s.EndTime == nil {
+		return "running"
+	}
+
+	start, err := ptypes.Timestamp(s.StartTime)
+	if err != nil {
+		return ""
+	}
+	end, err := ptypes.Timestamp(s.EndTime)
+	if err != nil {
+		return ""
+	}
+
+	return end.Sub(start).Round(time.Second).String()
+}
+
+// execSessionExit returns the exit code of the session, or "-" if it
+// ended without the command exiting.
+func execSessionExit(s *pb.ExecSession) string {
+	if s.EndTime == nil || s.ExitCode < 0 {
+		return "-"
+	}
+
+	return strconv.Itoa(int(s.ExitCode))
+}
+
+func (c *ExecSessionsCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.StringVar(&flag.StringVar{
+			Name:   "username",
+			Target: &c.flagUsername,
+			Usage:  "Only list sessions of this user.",
+		})
+
+		f.UintVar(&flag.UintVar{
+			Name:    "limit",
+			Target:  &c.flagLimit,
+			Default: 50,
+			Usage:   "Maximum number of sessions to list.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "json",
+			Target: &c.flagJson,
+			Usage:  "Output the sessions as JSON.",
+		})
+	})
+}
+
+func (c *ExecSessionsCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ExecSessionsCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ExecSessionsCommand) Synopsis() string {
+	return "List the recorded exec sessions"
+}
+
+func (c *ExecSessionsCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint exec sessions [options] [ID]
+
+  List the recorded "waypoint exec" sessions, newest first, or show a
+  single session and its transcript.
+
+  Every exec session is recorded with the user that started it, the
+  instance it ran in, the command, and when it started and ended. If the
+  server runs with "-exec-transcripts", the input and output of sessions
+  are recorded too and shown when a session ID is given.
+
+  If this is run in a project directory, only the sessions of that
+  project are listed. The sessions can be filtered further by app with
+  -app, by workspace with -workspace and by user with -username. The
+  server keeps a limited number of the most recent sessions. This
+  requires the "admin" role.
+
+` + c.Flags().Help())
+}
@@ -2,6 +2,9 @@ package cli
 
 import (
 	"sort"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
 
 	"github.com/hashicorp/waypoint/internal/pkg/flag"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
@@ -10,15 +13,19 @@ import (
 type filterOption int
 
 const (
-	fillterOptionAll filterOption = iota
-	filterOptionState
+	fillterOptionAll  filterOption = 0
+	filterOptionState filterOption = 1 << iota
 	filterOptionPhyState
 	filterOptionOrder
+	filterOptionLabel
+	filterOptionTime
 )
 
 type filterFlags struct {
 	flagStatusFilter []string
 	flagPhysState    string
+	flagLabels       map[string]string
+	flagSince        time.Duration
 
 	order *pb.OperationOrder
 }
@@ -116,6 +123,21 @@ func (ff *filterFlags) orderOp() *pb.OperationOrder {
 	return ff.order
 }
 
+// operationFilter returns the filter for the label and time flags, or nil
+// if none of them are set.
+func (ff *filterFlags) operationFilter() *pb.OperationFilter {
+	if len(ff.flagLabels) == 0 && ff.flagSince <= 0 {
+		return nil
+	}
+
+	result := &pb.OperationFilter{Labels: ff.flagLabels}
+	if ff.flagSince > 0 {
+		result.StartedAfter, _ = ptypes.TimestampProto(time.Now().Add(-ff.flagSince))
+	}
+
+	return result
+}
+
 func initFilterFlags(set *flag.Sets, ff *filterFlags, opts filterOption) {
 	f := set.NewSet("Filter Options")
 
@@ -127,6 +149,24 @@ func initFilterFlags(set *flag.Sets, ff *filterFlags, opts filterOption) {
 		f.EnumSingleVar(phyStateFlagVar(&ff.flagPhysState))
 	}
 
+	if opts == fillterOptionAll || opts&filterOptionLabel != 0 {
+		f.StringMapVar(&flag.StringMapVar{
+			Name:   "label",
+			Target: &ff.flagLabels,
+			Usage: "Filter values to have the given label. This can be " +
+				"specified multiple times in the format key=value and all " +
+				"labels must match.",
+		})
+	}
+
+	if opts == fillterOptionAll || opts&filterOptionTime != 0 {
+		f.DurationVar(&flag.DurationVar{
+			Name:   "since",
+			Target: &ff.flagSince,
+			Usage:  "Filter values to those started within this duration, such as \"24h\".",
+		})
+	}
+
 	if opts == fillterOptionAll || opts&filterOptionOrder != 0 {
 		f.EnumSingleVar(&flag.EnumSingleVar{
 			Name:   "order-by",
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -18,6 +19,7 @@ type FmtCommand struct {
 	*baseCommand
 
 	flagWrite bool
+	flagCheck bool
 }
 
 func (c *FmtCommand) Run(args []string) int {
@@ -37,13 +39,12 @@ func (c *FmtCommand) Run(args []string) int {
 		return 1
 	}
 
-	// If we have no args, default to the filename
+	// If we have no args, default to the current directory
 	if len(c.args) == 0 {
-		c.args = []string{configpkg.Filename}
+		c.args = []string{"."}
 	}
 
-	// Read the input
-	src, err := c.readInput()
+	paths, err := c.inputPaths()
 	if err != nil {
 		c.ui.Output(
 			"Error reading input to format: %s", clierrors.Humanize(err),
@@ -52,43 +53,108 @@ func (c *FmtCommand) Run(args []string) int {
 		return 1
 	}
 
-	// Format it
-	name := "<stdin>"
-	stdin := true
-	if c.args[0] != "-" {
-		name = filepath.Base(c.args[0])
-		stdin = false
-	}
-	out, err := configpkg.Format(src, name)
-	if err != nil {
-		c.ui.Output(
-			"Error formatting: %s", clierrors.Humanize(err),
-			terminal.WithErrorStyle(),
-		)
-		return 1
-	}
+	unformatted := false
+	for _, path := range paths {
+		// Read the input
+		src, err := c.readInput(path)
+		if err != nil {
+			c.ui.Output(
+				"Error reading input to format: %s", clierrors.Humanize(err),
+				terminal.WithErrorStyle(),
+			)
+			return 1
+		}
 
-	// If we're writing then write it to the file. stdin never writes to a file
-	if c.flagWrite && !stdin {
-		if err := ioutil.WriteFile(c.args[0], out, 0644); err != nil {
+		// Format it
+		name := "<stdin>"
+		stdin := path == "-"
+		if !stdin {
+			name = filepath.Base(path)
+		}
+		out, err := configpkg.Format(src, name)
+		if err != nil {
 			c.ui.Output(
-				"Error writing formatted output: %s", clierrors.Humanize(err),
+				"Error formatting: %s", clierrors.Humanize(err),
 				terminal.WithErrorStyle(),
 			)
 			return 1
 		}
-	} else {
-		// We must use fmt here and not c.ui since c.ui may wordwrap and trim.
-		fmt.Print(string(out))
+
+		changed := !bytes.Equal(src, out)
+		if !stdin {
+			name = path
+		}
+
+		switch {
+		case c.flagCheck:
+			// Check mode never writes anything, it only lists the inputs
+			// that aren't formatted so it can be used in CI.
+			if changed {
+				unformatted = true
+				fmt.Println(name)
+			}
+
+		case c.flagWrite && !stdin:
+			// stdin never writes to a file
+			if !changed {
+				continue
+			}
+
+			if err := ioutil.WriteFile(path, out, 0644); err != nil {
+				c.ui.Output(
+					"Error writing formatted output: %s", clierrors.Humanize(err),
+					terminal.WithErrorStyle(),
+				)
+				return 1
+			}
+
+			// List the files we changed
+			fmt.Println(name)
+
+		default:
+			// We must use fmt here and not c.ui since c.ui may wordwrap and trim.
+			fmt.Print(string(out))
+		}
+	}
+
+	if unformatted {
+		return 3
 	}
 
 	return 0
 }
 
-func (c *FmtCommand) readInput() ([]byte, error) {
+// inputPaths returns the paths to format for the argument, which may be
+// a file, a directory or "-" for stdin.
+func (c *FmtCommand) inputPaths() ([]string, error) {
+	if c.args[0] == "-" {
+		return c.args, nil
+	}
+
+	fi, err := os.Stat(c.args[0])
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		return c.args, nil
+	}
+
+	paths, err := configpkg.FormatPaths(c.args[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf(
+			"no %s or *.wpvars files were found in %q", configpkg.Filename, c.args[0])
+	}
+
+	return paths, nil
+}
+
+func (c *FmtCommand) readInput(path string) ([]byte, error) {
 	// If we have non-stdin input then read it
-	if c.args[0] != "-" {
-		return ioutil.ReadFile(c.args[0])
+	if path != "-" {
+		return ioutil.ReadFile(path)
 	}
 
 	// Otherwise it is stdin
@@ -107,6 +173,14 @@ func (c *FmtCommand) Flags() *flag.Sets {
 				"output will be written to STDOUT. This has no effect when formatting " +
 				"from STDIN.",
 		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "check",
+			Target: &c.flagCheck,
+			Usage: "Check if the input is formatted without modifying it. The " +
+				"names of the inputs that aren't formatted are listed and the " +
+				"exit code is 3 if any input isn't formatted, or 0 otherwise.",
+		})
 	})
 }
 
@@ -124,16 +198,21 @@ func (c *FmtCommand) Synopsis() string {
 
 func (c *FmtCommand) Help() string {
 	return formatHelp(`
-Usage: waypoint fmt [FILE]
+Usage: waypoint fmt [options] [FILE]
 
   Rewrite a waypoint.hcl file to a canonical format.
 
-  This only works for HCL-formatted Waypoint configuration files. JSON-formatted
-  files do not work and will result in an error.
+  This only works for HCL-formatted Waypoint configuration files and
+  variable (*.wpvars) files. JSON-formatted files do not work and will
+  result in an error.
+
+  If FILE is a directory, then the "waypoint.hcl" file and any *.wpvars
+  files in that directory are formatted. If FILE is not specified, then
+  the current directory is used. If FILE is "-" then the content will be
+  read from stdin. The names of the files that were changed are listed.
 
-  If FILE is not specified, then the current directory will be searched
-  for a "waypoint.hcl" file. If FILE is "-" then the content will be read
-  from stdin.
+  With -check, nothing is written and the exit code is 3 if any input
+  isn't formatted, which is useful in CI.
 
   This command does not validate the waypoint.hcl configuration. This will
   work for older and newer configuration formats.
@@ -1,13 +1,16 @@
 package cli
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
+	"text/template"
 
+	"github.com/golang/protobuf/proto"
 	getter "github.com/hashicorp/go-getter"
 	"github.com/posener/complete"
 	"google.golang.org/grpc/codes"
@@ -31,6 +34,8 @@ type InitCommand struct {
 	into        string
 	update      bool
 	from        string
+	template    string
+	interactive bool
 
 	project *clientpkg.Project
 	cfg     *configpkg.Config
@@ -137,8 +142,43 @@ func (c *InitCommand) Run(args []string) int {
 		return 1
 	}
 
-	// If we have no config, initialize a new one.
-	if path == "" {
+	// If we're interactive, generate the config and then continue with
+	// the normal initialization.
+	var tpl *pb.ProjectTemplate
+	if c.interactive {
+		if c.template != "" {
+			c.ui.Output("The -interactive and -template flags can't be used together.",
+				terminal.WithErrorStyle())
+			return 1
+		}
+		if path != "" {
+			c.ui.Output(
+				"A Waypoint configuration already exists at %q. A configuration can\n"+
+					"only be generated in a directory without one.",
+				path, terminal.WithErrorStyle(),
+			)
+			return 1
+		}
+
+		if !c.initInteractive() {
+			return 1
+		}
+	} else if c.template != "" {
+		if path != "" {
+			c.ui.Output(
+				"A Waypoint configuration already exists at %q. A project can only\n"+
+					"be created from a template in a directory without one.",
+				path, terminal.WithErrorStyle(),
+			)
+			return 1
+		}
+
+		tpl = c.initTemplate()
+		if tpl == nil {
+			return 1
+		}
+	} else if path == "" {
+		// If we have no config, initialize a new one.
 		if !c.initNew() {
 			return 1
 		}
@@ -157,6 +197,9 @@ func (c *InitCommand) Run(args []string) int {
 		// it in the future.
 		// c.validateAuth,
 	}
+	if tpl != nil {
+		steps = append(steps, func() bool { return c.setTemplateConfig(tpl) })
+	}
 	for _, step := range steps {
 		if !step() {
 			c.ui.Output("Project had errors during initialization.\n"+
@@ -209,6 +252,107 @@ validate the configuration and initialize your project.
 	return true
 }
 
+// initTemplate writes the Waypoint configuration from the project template
+// named by the -template flag. The project name in the template is the
+// name of the current directory. This returns nil if there was an error.
+func (c *InitCommand) initTemplate() *pb.ProjectTemplate {
+	client, err := c.initClient(nil)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return nil
+	}
+
+	resp, err := client.Client().GetProjectTemplate(c.Ctx, &pb.GetProjectTemplateRequest{
+		Template: &pb.Ref_ProjectTemplate{Name: c.template},
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return nil
+	}
+
+	pwd, err := os.Getwd()
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return nil
+	}
+
+	t, err := template.New(configpkg.Filename).Parse(string(resp.Template.WaypointHcl))
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return nil
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, map[string]string{
+		"Project": filepath.Base(pwd),
+	}); err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return nil
+	}
+
+	if err := ioutil.WriteFile(configpkg.Filename, buf.Bytes(), 0644); err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return nil
+	}
+
+	c.ui.Output("Waypoint configuration created from template %q.",
+		resp.Template.Name, terminal.WithSuccessStyle())
+	return resp.Template
+}
+
+// setTemplateConfig sets the config variables of the template on the
+// project. Values already set on the project are kept.
+func (c *InitCommand) setTemplateConfig(tpl *pb.ProjectTemplate) bool {
+	if len(tpl.ConfigVars) == 0 {
+		return true
+	}
+
+	sg := c.ui.StepGroup()
+	defer sg.Wait()
+
+	s := sg.Add("Setting config variables from template %q...", tpl.Name)
+
+	client := c.project.Client()
+	ref := c.project.Ref()
+	resp, err := client.GetConfig(c.Ctx, &pb.ConfigGetRequest{
+		Scope: &pb.ConfigGetRequest_Project{Project: ref},
+	})
+	if err != nil {
+		c.stepError(s, initStepProject, err)
+		return false
+	}
+
+	existing := map[string]struct{}{}
+	for _, v := range resp.Variables {
+		existing[v.Name] = struct{}{}
+	}
+
+	var req pb.ConfigSetRequest
+	for name, value := range tpl.ConfigVars {
+		if _, ok := existing[name]; ok {
+			continue
+		}
+
+		req.Variables = append(req.Variables, &pb.ConfigVar{
+			Name:  name,
+			Value: &pb.ConfigVar_Static{Static: value},
+			Scope: &pb.ConfigVar_Project{Project: ref},
+		})
+	}
+
+	if len(req.Variables) > 0 {
+		if _, err := client.SetConfig(c.Ctx, &req); err != nil {
+			c.stepError(s, initStepProject, err)
+			return false
+		}
+	}
+
+	s.Update("Config variables from template %q are set", tpl.Name)
+	s.Status(terminal.StatusOK)
+	s.Done()
+	return true
+}
+
 func (c *InitCommand) validateConfig() bool {
 	sg := c.ui.StepGroup()
 	defer sg.Wait()
@@ -317,6 +461,11 @@ func (c *InitCommand) validateProject() bool {
 				RemoteEnabled:  c.cfg.Runner.Enabled,
 				DataSource:     ds,
 				DataSourcePoll: poll,
+				Labels:         c.cfg.Labels,
+				RunnerLabels:   c.cfg.Runner.TargetLabels,
+				OndemandRunner: runnerProfileRef(c.cfg.Runner.Profile),
+				JobTimeouts:    jobTimeouts(c.cfg.Runner.Timeouts),
+				RemoteOnly:     c.cfg.Runner.RemoteOnly,
 			},
 		})
 		if err != nil {
@@ -325,24 +474,63 @@ func (c *InitCommand) validateProject() bool {
 		}
 		s.Status(terminal.StatusOK)
 
+		project = resp.Project
+	} else if !labelsEqual(project.Labels, c.cfg.Labels) ||
+		!labelsEqual(project.RunnerLabels, c.cfg.Runner.TargetLabels) ||
+		project.OndemandRunner.GetName() != c.cfg.Runner.Profile ||
+		project.RemoteOnly != c.cfg.Runner.RemoteOnly ||
+		!proto.Equal(project.JobTimeouts, jobTimeouts(c.cfg.Runner.Timeouts)) {
+		// The labels or runner settings in the configuration changed,
+		// update them.
+		s.Update("Updating labels of project %q...", ref.Project)
+
+		project.Labels = c.cfg.Labels
+		project.RunnerLabels = c.cfg.Runner.TargetLabels
+		project.OndemandRunner = runnerProfileRef(c.cfg.Runner.Profile)
+		project.JobTimeouts = jobTimeouts(c.cfg.Runner.Timeouts)
+		project.RemoteOnly = c.cfg.Runner.RemoteOnly
+		resp, err := client.UpsertProject(c.Ctx, &pb.UpsertProjectRequest{
+			Project: project,
+		})
+		if err != nil {
+			c.stepError(s, initStepProject, err)
+			return false
+		}
+
 		project = resp.Project
 	}
 
 	pt := &serverptypes.Project{Project: project}
 	for _, name := range c.cfg.Apps() {
-		if pt.App(name) >= 0 {
-			continue
+		appCfg, err := c.cfg.App(name, c.cfg.HCLContext())
+		if err != nil {
+			c.stepError(s, initStepProject, err)
+			return false
 		}
 
-		// Missing an application, register it.
-		s.Status(terminal.StatusWarn)
-		s.Update("Application %q is not registered with the server. Registering...", name)
-
-		_, err := client.UpsertApplication(c.Ctx, &pb.UpsertApplicationRequest{
+		req := &pb.UpsertApplicationRequest{
 			Project: ref,
 			Name:    name,
-		})
-		if err != nil {
+			Labels:  appCfg.Labels,
+		}
+
+		if idx := pt.App(name); idx >= 0 {
+			app := project.Applications[idx]
+			if labelsEqual(app.Labels, appCfg.Labels) {
+				continue
+			}
+
+			// The labels in the configuration changed, update them.
+			s.Update("Updating labels of application %q...", name)
+			req.FileChangeSignal = app.FileChangeSignal
+			req.HealthChecks = app.HealthChecks
+		} else {
+			// Missing an application, register it.
+			s.Status(terminal.StatusWarn)
+			s.Update("Application %q is not registered with the server. Registering...", name)
+		}
+
+		if _, err := client.UpsertApplication(c.Ctx, req); err != nil {
 			c.stepError(s, initStepProject, err)
 			return false
 		}
@@ -355,6 +543,36 @@ func (c *InitCommand) validateProject() bool {
 	return true
 }
 
+// labelsEqual returns true if both sets of labels are the same. A nil
+// set is the same as an empty one.
+func labelsEqual(a, b map[string]string) bool {
+	return len(a) == len(b) && serverptypes.LabelsMatch(a, b)
+}
+
+// runnerProfileRef returns the reference to the runner profile with the
+// given name, or nil if the name is empty.
+func runnerProfileRef(name string) *pb.Ref_OnDemandRunnerConfig {
+	if name == "" {
+		return nil
+	}
+
+	return &pb.Ref_OnDemandRunnerConfig{Name: name}
+}
+
+// jobTimeouts returns the job timeouts of the project for the given
+// configuration, or nil if there are none.
+func jobTimeouts(cfg *configpkg.Timeouts) *pb.Project_JobTimeouts {
+	if cfg == nil {
+		return nil
+	}
+
+	return &pb.Project_JobTimeouts{
+		Build:   cfg.Build,
+		Deploy:  cfg.Deploy,
+		Release: cfg.Release,
+	}
+}
+
 func (c *InitCommand) validatePlugins() bool {
 	sg := c.ui.StepGroup()
 	defer sg.Wait()
@@ -584,6 +802,21 @@ func (c *InitCommand) Flags() *flag.Sets {
 			Usage: "Update the project configuration if it already exists. This can be used " +
 				"to update settings such as the remote runner data source.",
 		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "interactive",
+			Target: &c.interactive,
+			Usage: "Generate waypoint.hcl by inspecting the current directory and " +
+				"asking how to build and deploy the application.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "template",
+			Target: &c.template,
+			Usage: "Create the project from the named project template on the server. " +
+				"This writes waypoint.hcl from the template and sets the template's " +
+				"config variables on the project.",
+		})
 	})
 }
 
@@ -612,6 +845,17 @@ Usage: waypoint init [options]
   This command is always safe to run multiple times. This command will never
   delete your configuration or any data in the server.
 
+  With -template, a new project is created from a project template
+  registered with the server. The template's waypoint.hcl is written to
+  the current directory, using the name of the directory as the project
+  name, and its config variables are set on the project. Templates are
+  managed with "waypoint project template".
+
+  With -interactive, the current directory is inspected for a Dockerfile,
+  files that buildpacks detect and Kubernetes manifests. You are then asked
+  how to build and deploy the application and waypoint.hcl is written with
+  the builder and platform plugins configured.
+
 ` + c.Flags().Help())
 }
 
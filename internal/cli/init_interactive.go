@@ -0,0 +1,398 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	configpkg "github.com/hashicorp/waypoint/internal/config"
+)
+
+// initBuildpackFiles are files that the buildpacks of the default builder
+// detect, in the order we report them.
+var initBuildpackFiles = []string{
+	"project.toml",
+	"Procfile",
+	"package.json",
+	"go.mod",
+	"requirements.txt",
+	"Pipfile",
+	"Gemfile",
+	"pom.xml",
+	"build.gradle",
+	"composer.json",
+}
+
+// initManifestDirs are the directories, in addition to the project
+// directory, where we look for Kubernetes manifests.
+var initManifestDirs = []string{"k8s", "kubernetes", "deploy", "manifests"}
+
+// initPlatforms are the platforms offered by waypoint init -interactive.
+var initPlatforms = []string{"docker", "kubernetes", "nomad"}
+
+// initDetected is what was detected about the application in a directory.
+type initDetected struct {
+	// Dockerfile is true if the directory has a Dockerfile.
+	Dockerfile bool
+
+	// Buildpack is the file that buildpacks will detect, or empty if
+	// there is none.
+	Buildpack string
+
+	// Manifests are the paths of the Kubernetes manifests, relative to
+	// the directory.
+	Manifests []string
+
+	// Port is the first port exposed by the Dockerfile, or 0.
+	Port uint
+}
+
+// initAnswers are the answers to the questions of waypoint init -interactive
+// that the configuration is generated from.
+type initAnswers struct {
+	Project  string
+	App      string
+	Builder  string
+	Platform string
+
+	// Image is the image to push to, which is required by all platforms
+	// but docker since they don't run on the local Docker daemon.
+	Image string
+
+	Port uint
+}
+
+// initDetect inspects the directory to suggest how to build and deploy
+// the application in it.
+func initDetect(dir string) (*initDetected, error) {
+	var result initDetected
+
+	dockerfile := filepath.Join(dir, "Dockerfile")
+	if _, err := os.Stat(dockerfile); err == nil {
+		result.Dockerfile = true
+
+		port, err := initDockerfilePort(dockerfile)
+		if err != nil {
+			return nil, err
+		}
+		result.Port = port
+	}
+
+	for _, name := range initBuildpackFiles {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			result.Buildpack = name
+			break
+		}
+	}
+
+	for _, sub := range append([]string{""}, initManifestDirs...) {
+		entries, err := ioutil.ReadDir(filepath.Join(dir, sub))
+		if err != nil {
+			// Most of the directories won't exist.
+			continue
+		}
+
+		for _, entry := range entries {
+			ext := filepath.Ext(entry.Name())
+			if entry.IsDir() || (ext != ".yaml" && ext != ".yml") {
+				continue
+			}
+
+			path := filepath.Join(sub, entry.Name())
+			ok, err := initIsManifest(filepath.Join(dir, path))
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				result.Manifests = append(result.Manifests, path)
+			}
+		}
+	}
+
+	return &result, nil
+}
+
+// initDockerfilePort returns the first port exposed by the Dockerfile,
+// or 0 if no port is exposed.
+func initDockerfilePort(path string) (uint, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || !strings.EqualFold(fields[0], "EXPOSE") {
+			continue
+		}
+
+		// Ports may have a protocol, such as "8080/tcp".
+		port, err := strconv.ParseUint(strings.SplitN(fields[1], "/", 2)[0], 10, 16)
+		if err == nil {
+			return uint(port), nil
+		}
+	}
+
+	return 0, scanner.Err()
+}
+
+// initIsManifest returns true if the YAML file looks like a Kubernetes
+// manifest, which is a document with an apiVersion and a kind.
+func initIsManifest(path string) (bool, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+
+	var apiVersion, kind bool
+	for _, line := range strings.Split(string(data), "\n") {
+		apiVersion = apiVersion || strings.HasPrefix(line, "apiVersion:")
+		kind = kind || strings.HasPrefix(line, "kind:")
+	}
+
+	return apiVersion && kind, nil
+}
+
+var initInteractiveTemplate = template.Must(template.New(configpkg.Filename).Parse(`
+project = {{printf "%q" .Project}}
+
+app {{printf "%q" .App}} {
+  build {
+    use {{printf "%q" .Builder}} {}
+{{- if .Image}}
+
+    registry {
+      use "docker" {
+        image = {{printf "%q" .Image}}
+        tag   = "latest"
+      }
+    }
+{{- end}}
+  }
+
+  deploy {
+    use {{printf "%q" .Platform}} {
+{{- if .Port}}
+      service_port = {{.Port}}
+{{- end}}
+    }
+  }
+{{- if eq .Platform "kubernetes"}}
+
+  release {
+    use "kubernetes" {
+      load_balancer = true
+    }
+  }
+{{- end}}
+}
+`))
+
+// initGenerate returns the Waypoint configuration for the answers.
+func initGenerate(a *initAnswers) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := initInteractiveTemplate.Execute(&buf, a); err != nil {
+		return nil, err
+	}
+
+	return configpkg.Format(bytes.TrimLeft(buf.Bytes(), "\n"), configpkg.Filename)
+}
+
+// initInteractive inspects the current directory, asks how to build and
+// deploy the application and writes the Waypoint configuration. This
+// returns false if there was an error.
+func (c *InitCommand) initInteractive() bool {
+	if !c.ui.Interactive() {
+		c.ui.Output("The -interactive flag requires an interactive terminal.",
+			terminal.WithErrorStyle())
+		return false
+	}
+
+	pwd, err := os.Getwd()
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return false
+	}
+
+	detected, err := initDetect(pwd)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return false
+	}
+
+	// Show what we found so the defaults of the questions make sense.
+	c.ui.Output("Inspected the current directory", terminal.WithHeaderStyle())
+	var found []terminal.NamedValue
+	if detected.Dockerfile {
+		found = append(found, terminal.NamedValue{Name: "Dockerfile", Value: "found"})
+	}
+	if detected.Buildpack != "" {
+		found = append(found, terminal.NamedValue{Name: "Buildpacks", Value: detected.Buildpack})
+	}
+	if len(detected.Manifests) > 0 {
+		found = append(found, terminal.NamedValue{
+			Name:  "Kubernetes manifests",
+			Value: strings.Join(detected.Manifests, ", "),
+		})
+	}
+	if len(found) == 0 {
+		c.ui.Output("Nothing was detected. Waypoint will use buildpacks to build the application.")
+	} else {
+		c.ui.NamedValues(found, terminal.WithInfoStyle())
+	}
+	c.ui.Output("")
+
+	var answers initAnswers
+	builder := "pack"
+	if detected.Dockerfile {
+		builder = "docker"
+	}
+	platform := "docker"
+	if len(detected.Manifests) > 0 {
+		platform = "kubernetes"
+	}
+	port := "3000"
+	if detected.Port > 0 {
+		port = strconv.FormatUint(uint64(detected.Port), 10)
+	}
+
+	questions := []func() error{
+		func() (err error) {
+			answers.Project, err = c.inputValue("Project name", filepath.Base(pwd), nil)
+			return err
+		},
+		func() (err error) {
+			answers.App, err = c.inputValue("App name", answers.Project, nil)
+			return err
+		},
+		func() (err error) {
+			answers.Builder, err = c.inputValue("Builder (docker, pack)", builder,
+				initChoice([]string{"docker", "pack"}))
+			return err
+		},
+		func() (err error) {
+			answers.Platform, err = c.inputValue(
+				fmt.Sprintf("Platform (%s)", strings.Join(initPlatforms, ", ")),
+				platform, initChoice(initPlatforms))
+			return err
+		},
+		func() error {
+			// Only the docker platform can run images that aren't pushed.
+			if answers.Platform == "docker" {
+				return nil
+			}
+
+			image, err := c.inputValue("Image to push to, such as registry.example.com/app", "",
+				func(v string) error {
+					if v == "" {
+						return fmt.Errorf("The %s platform requires an image to push to.", answers.Platform)
+					}
+					return nil
+				})
+			answers.Image = image
+			return err
+		},
+		func() error {
+			v, err := c.inputValue("Port the application listens on", port, func(v string) error {
+				if _, err := strconv.ParseUint(v, 10, 16); err != nil {
+					return fmt.Errorf("%q is not a valid port.", v)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			p, _ := strconv.ParseUint(v, 10, 16)
+			answers.Port = uint(p)
+			return nil
+		},
+	}
+	for _, q := range questions {
+		if err := q(); err != nil {
+			c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return false
+		}
+	}
+
+	data, err := initGenerate(&answers)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return false
+	}
+
+	if err := ioutil.WriteFile(configpkg.Filename, data, 0644); err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return false
+	}
+
+	c.ui.Output("")
+	c.ui.Output("Waypoint configuration created in %q.", configpkg.Filename,
+		terminal.WithSuccessStyle())
+	if len(detected.Manifests) > 0 && answers.Platform == "kubernetes" {
+		c.ui.Output(
+			"The kubernetes plugin creates its own deployment and service, so the\n"+
+				"Kubernetes manifests in this directory aren't used by Waypoint.",
+			terminal.WithWarningStyle(),
+		)
+	}
+	c.ui.Output("")
+
+	return true
+}
+
+// inputValue asks for a value until it is valid. An empty answer is the
+// default value. validate may be nil.
+func (c *InitCommand) inputValue(prompt, def string, validate func(string) error) (string, error) {
+	if def != "" {
+		prompt = fmt.Sprintf("%s [%s]", prompt, def)
+	}
+
+	for {
+		result, err := c.ui.Input(&terminal.Input{
+			Prompt: prompt + ": ",
+			Style:  terminal.HeaderStyle,
+		})
+		if err != nil {
+			return "", err
+		}
+
+		result = strings.TrimSpace(result)
+		if result == "" {
+			result = def
+		}
+
+		if validate != nil {
+			if err := validate(result); err != nil {
+				c.ui.Output(err.Error(), terminal.WithErrorStyle())
+				continue
+			}
+		}
+
+		return result, nil
+	}
+}
+
+// initChoice returns a validation function for inputValue that requires
+// one of the choices.
+func initChoice(choices []string) func(string) error {
+	return func(v string) error {
+		for _, choice := range choices {
+			if v == choice {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Please enter one of: %s.", strings.Join(choices, ", "))
+	}
+}
@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	configpkg "github.com/hashicorp/waypoint/internal/config"
+)
+
+func TestInitDetect(t *testing.T) {
+	write := func(t *testing.T, dir, path, content string) {
+		path = filepath.Join(dir, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		require := require.New(t)
+
+		dir, err := ioutil.TempDir("", "waypoint-init")
+		require.NoError(err)
+		defer os.RemoveAll(dir)
+
+		detected, err := initDetect(dir)
+		require.NoError(err)
+		require.Equal(&initDetected{}, detected)
+	})
+
+	t.Run("dockerfile and manifests", func(t *testing.T) {
+		require := require.New(t)
+
+		dir, err := ioutil.TempDir("", "waypoint-init")
+		require.NoError(err)
+		defer os.RemoveAll(dir)
+
+		write(t, dir, "Dockerfile", "FROM node:14\nexpose 8080/tcp\nEXPOSE 9090\n")
+		write(t, dir, "package.json", "{}")
+		write(t, dir, "go.mod", "module example")
+		write(t, dir, "k8s/deployment.yaml", "apiVersion: apps/v1\nkind: Deployment\n")
+		write(t, dir, "k8s/values.yaml", "replicas: 2\n")
+		write(t, dir, "service.yml", "apiVersion: v1\nkind: Service\n")
+
+		detected, err := initDetect(dir)
+		require.NoError(err)
+		require.Equal(&initDetected{
+			Dockerfile: true,
+			Buildpack:  "package.json",
+			Manifests: []string{
+				"service.yml",
+				filepath.Join("k8s", "deployment.yaml"),
+			},
+			Port: 8080,
+		}, detected)
+	})
+}
+
+func TestInitGenerate(t *testing.T) {
+	cases := []struct {
+		Name    string
+		Answers initAnswers
+	}{
+		{
+			"docker",
+			initAnswers{
+				Project:  "example",
+				App:      "web",
+				Builder:  "docker",
+				Platform: "docker",
+				Port:     8080,
+			},
+		},
+
+		{
+			"kubernetes",
+			initAnswers{
+				Project:  "example",
+				App:      "web",
+				Builder:  "pack",
+				Platform: "kubernetes",
+				Image:    "registry.example.com/web",
+				Port:     3000,
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			src, err := initGenerate(&tt.Answers)
+			require.NoError(err)
+
+			// The generated configuration is formatted
+			formatted, err := configpkg.Format(src, configpkg.Filename)
+			require.NoError(err)
+			require.Equal(string(formatted), string(src))
+
+			// The generated configuration is valid
+			cfg, err := configpkg.Load(configpkg.Filename, &configpkg.LoadOptions{
+				Pwd:    ".",
+				Source: src,
+			})
+			require.NoError(err)
+			require.NoError(cfg.Validate())
+			require.Equal(tt.Answers.Project, cfg.Project)
+			require.Equal([]string{tt.Answers.App}, cfg.Apps())
+
+			app, err := cfg.App(tt.Answers.App, nil)
+			require.NoError(err)
+			require.Equal(tt.Answers.Builder, app.BuildRaw.Use.Type)
+			require.Equal(tt.Answers.Platform, app.DeployRaw.Use.Type)
+			require.Equal(tt.Answers.Image != "", app.BuildRaw.Registry != nil)
+			require.Equal(tt.Answers.Platform == "kubernetes", app.ReleaseRaw != nil)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/posener/complete"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type JobCancelCommand struct {
+	*baseCommand
+}
+
+func (c *JobCancelCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("A single job ID is required.\n\n"+c.Help(),
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	client := c.project.Client()
+	_, err := client.CancelJob(c.Ctx, &pb.CancelJobRequest{
+		JobId: c.args[0],
+	})
+	if err != nil {
+		c.ui.Output(jobCancelError(c.args[0], err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	// Cancelling a completed job does nothing, so we look at the job to
+	// tell the user what actually happened.
+	job, err := client.GetJob(c.Ctx, &pb.GetJobRequest{
+		JobId: c.args[0],
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	msg, cancelled := jobCancelOutput(job)
+	style := terminal.WithSuccessStyle()
+	if !cancelled {
+		style = terminal.WithWarningStyle()
+	}
+
+	c.ui.Output(msg, style)
+	return 0
+}
+
+func (c *JobCancelCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *JobCancelCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *JobCancelCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *JobCancelCommand) Synopsis() string {
+	return "Cancel a queued or running job."
+}
+
+func (c *JobCancelCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint job cancel [options] JOB-ID
+
+  Cancel a queued or running job.
+
+  A queued job is cancelled immediately. A running job is cancelled by its
+  runner, so it may keep running for a short time. Use "waypoint job
+  inspect" to see when the job completes. Cancelling a completed job does
+  nothing.
+
+` + c.Flags().Help())
+}
+
+// jobCancelError returns the message to show for an error cancelling the
+// job with the given ID.
+func jobCancelError(id string, err error) string {
+	if status.Code(err) == codes.NotFound {
+		return fmt.Sprintf("Job %q was not found. The server only keeps a "+
+			"limited number of completed jobs.", id)
+	}
+
+	return clierrors.Humanize(err)
+}
+
+// jobCancelOutput returns the message describing the outcome of cancelling
+// the job and whether the cancellation had any effect. The job must be read
+// after it was cancelled.
+func jobCancelOutput(job *pb.Job) (string, bool) {
+	switch {
+	case job.CancelTime == nil || job.State == pb.Job_SUCCESS:
+		return fmt.Sprintf("Job %q already completed, nothing was cancelled.", job.Id), false
+
+	case job.State == pb.Job_ERROR:
+		return fmt.Sprintf("Job %q cancelled.", job.Id), true
+
+	default:
+		return fmt.Sprintf("Job %q is being cancelled. It may take time for "+
+			"the runner to stop it.", job.Id), true
+	}
+}
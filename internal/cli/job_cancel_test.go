@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestJobCancelError(t *testing.T) {
+	require := require.New(t)
+
+	msg := jobCancelError("J1", status.Error(codes.NotFound, "job not found: J1"))
+	require.Contains(msg, `Job "J1" was not found`)
+
+	msg = jobCancelError("J1", status.Error(codes.PermissionDenied, "not allowed"))
+	require.Contains(msg, "not allowed")
+	require.NotContains(msg, "was not found")
+}
+
+func TestJobCancelOutput(t *testing.T) {
+	now := ptypes.TimestampNow()
+
+	cases := []struct {
+		Name      string
+		Job       *pb.Job
+		Cancelled bool
+		Contains  string
+	}{
+		{
+			"already succeeded",
+			&pb.Job{Id: "J1", State: pb.Job_SUCCESS, CompleteTime: now},
+			false,
+			"already completed",
+		},
+
+		{
+			"already failed",
+			&pb.Job{Id: "J1", State: pb.Job_ERROR, CompleteTime: now},
+			false,
+			"already completed",
+		},
+
+		{
+			"succeeded while cancelling",
+			&pb.Job{Id: "J1", State: pb.Job_SUCCESS, CancelTime: now, CompleteTime: now},
+			false,
+			"already completed",
+		},
+
+		{
+			"queued",
+			&pb.Job{Id: "J1", State: pb.Job_ERROR, CancelTime: now},
+			true,
+			`Job "J1" cancelled.`,
+		},
+
+		{
+			"running",
+			&pb.Job{Id: "J1", State: pb.Job_RUNNING, CancelTime: now},
+			true,
+			"is being cancelled",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			msg, cancelled := jobCancelOutput(tt.Job)
+			require.Equal(tt.Cancelled, cancelled)
+			require.Contains(msg, tt.Contains)
+		})
+	}
+}
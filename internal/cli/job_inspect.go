@@ -0,0 +1,226 @@
+package cli
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type JobInspectCommand struct {
+	*baseCommand
+
+	flagJson bool
+}
+
+func (c *JobInspectCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("A single job ID is required.\n\n"+c.Help(),
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	job, err := c.project.Client().GetJob(c.Ctx, &pb.GetJobRequest{
+		JobId: c.args[0],
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	if c.flagJson {
+		data, err := json.MarshalIndent(job, "", "  ")
+		if err != nil {
+			c.ui.Output("Error rendering json: %s", err, terminal.WithErrorStyle())
+			return 1
+		}
+
+		c.ui.Output(string(data))
+		return 0
+	}
+
+	var runner string
+	if job.AssignedRunner != nil {
+		runner = job.AssignedRunner.Id
+	}
+
+	c.ui.Output("Job Info:", terminal.WithHeaderStyle())
+	c.ui.NamedValues([]terminal.NamedValue{
+		{
+			Name: "id", Value: job.Id,
+		},
+		{
+			Name: "project", Value: job.Application.GetProject(),
+		},
+		{
+			Name: "app", Value: job.Application.GetApplication(),
+		},
+		{
+			Name: "workspace", Value: job.Workspace.GetWorkspace(),
+		},
+		{
+			Name: "operation", Value: jobOpString(job),
+		},
+		{
+			Name: "priority", Value: strings.ToLower(job.Priority.String()),
+		},
+		{
+			Name: "state", Value: jobStateString(job),
+		},
+		{
+			Name: "runner", Value: runner,
+		},
+		{
+			Name: "timeout", Value: job.Timeout,
+		},
+		{
+			Name: "queued", Value: jobTimeString(job.QueueTime),
+		},
+		{
+			Name: "assigned", Value: jobTimeString(job.AssignTime),
+		},
+		{
+			Name: "started", Value: jobTimeString(job.AckTime),
+		},
+		{
+			Name: "completed", Value: jobTimeString(job.CompleteTime),
+		},
+		{
+			Name: "cancelled", Value: jobTimeString(job.CancelTime),
+		},
+	}, terminal.WithInfoStyle())
+
+	if len(job.Labels) > 0 {
+		c.ui.Output("")
+		c.ui.Output("Labels:", terminal.WithHeaderStyle())
+		c.ui.NamedValues(jobMapValues(job.Labels), terminal.WithInfoStyle())
+	}
+
+	if job.DataSource != nil {
+		values := []terminal.NamedValue{}
+		switch src := job.DataSource.Source.(type) {
+		case *pb.Job_DataSource_Local:
+			values = append(values, terminal.NamedValue{Name: "type", Value: "local"})
+		case *pb.Job_DataSource_Git:
+			values = append(values,
+				terminal.NamedValue{Name: "type", Value: "git"},
+				terminal.NamedValue{Name: "url", Value: src.Git.Url},
+				terminal.NamedValue{Name: "ref", Value: src.Git.Ref},
+				terminal.NamedValue{Name: "path", Value: src.Git.Path},
+			)
+		}
+		if ref := job.DataSourceRef.GetGit(); ref != nil {
+			values = append(values, terminal.NamedValue{Name: "commit", Value: ref.Commit})
+		}
+		values = append(values, jobMapValues(job.DataSourceOverrides)...)
+
+		c.ui.Output("")
+		c.ui.Output("Data Source:", terminal.WithHeaderStyle())
+		c.ui.NamedValues(values, terminal.WithInfoStyle())
+	}
+
+	if job.Error != nil {
+		c.ui.Output("")
+		c.ui.Output("Error:", terminal.WithHeaderStyle())
+		c.ui.Output(job.Error.Message, terminal.WithErrorStyle())
+	}
+
+	if job.Result != nil {
+		data, err := json.MarshalIndent(job.Result, "", "  ")
+		if err != nil {
+			c.ui.Output("Error rendering json: %s", err, terminal.WithErrorStyle())
+			return 1
+		}
+
+		c.ui.Output("")
+		c.ui.Output("Result:", terminal.WithHeaderStyle())
+		c.ui.Output(string(data))
+	}
+
+	return 0
+}
+
+func (c *JobInspectCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.BoolVar(&flag.BoolVar{
+			Name:   "json",
+			Target: &c.flagJson,
+			Usage:  "Output the full job as JSON.",
+		})
+	})
+}
+
+func (c *JobInspectCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *JobInspectCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *JobInspectCommand) Synopsis() string {
+	return "Show detailed information about a job."
+}
+
+func (c *JobInspectCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint job inspect [options] JOB-ID
+
+  Show detailed information about a job.
+
+  This shows the configuration of the job, such as its operation and data
+  source, and its result once it completes. Use -json to see every field
+  of the job.
+
+` + c.Flags().Help())
+}
+
+// jobTimeString returns the time relative to now, or an empty string if
+// the time isn't set.
+func jobTimeString(ts *timestamp.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		return ""
+	}
+
+	return humanize.Time(t)
+}
+
+// jobMapValues returns the named values of the map sorted by key.
+func jobMapValues(m map[string]string) []terminal.NamedValue {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	values := make([]terminal.NamedValue, len(keys))
+	for i, k := range keys {
+		values[i] = terminal.NamedValue{Name: k, Value: m[k]}
+	}
+
+	return values
+}
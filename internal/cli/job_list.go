@@ -0,0 +1,279 @@
+package cli
+
+import (
+	"encoding/json"
+	stdflag "flag"
+	"sort"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/posener/complete"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type JobListCommand struct {
+	*baseCommand
+
+	flagState []string
+	flagJson  bool
+}
+
+func (c *JobListCommand) Run(args []string) int {
+	flagSet := c.Flags()
+
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	// The configuration is optional: if we're in a project directory we
+	// only list the jobs of that project, otherwise we list all jobs.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(flagSet),
+		WithConfig(true),
+	); err != nil {
+		return 1
+	}
+
+	var workspaceSet bool
+	flagSet.Visit(func(f *stdflag.Flag) {
+		if f.Name == "workspace" {
+			workspaceSet = true
+		}
+	})
+
+	req, err := c.listRequest(workspaceSet)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	resp, err := c.project.Client().XListJobs(c.Ctx, req)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	// Newest jobs first
+	jobs := resp.Jobs
+	sort.SliceStable(jobs, func(i, j int) bool {
+		ti, _ := ptypes.Timestamp(jobs[i].QueueTime)
+		tj, _ := ptypes.Timestamp(jobs[j].QueueTime)
+		return ti.After(tj)
+	})
+
+	if c.flagJson {
+		data, err := json.MarshalIndent(jobs, "", "  ")
+		if err != nil {
+			c.ui.Output("Error rendering json: %s", err, terminal.WithErrorStyle())
+			return 1
+		}
+
+		c.ui.Output(string(data))
+		return 0
+	}
+
+	if len(jobs) == 0 {
+		c.ui.Output("No jobs found.")
+		return 0
+	}
+
+	tbl := terminal.NewTable("ID", "Project", "App", "Workspace", "Op", "State", "Queued", "Completed")
+	for _, job := range jobs {
+		var queued, completed string
+		if t, err := ptypes.Timestamp(job.QueueTime); err == nil {
+			queued = humanize.Time(t)
+		}
+		if t, err := ptypes.Timestamp(job.CompleteTime); err == nil {
+			completed = humanize.Time(t)
+		}
+
+		var colors []string
+		switch job.State {
+		case pb.Job_ERROR:
+			colors = make([]string, 8)
+			colors[5] = terminal.Red
+		case pb.Job_SUCCESS:
+			colors = make([]string, 8)
+			colors[5] = terminal.Green
+		}
+
+		tbl.Rich([]string{
+			job.Id,
+			job.Application.GetProject(),
+			job.Application.GetApplication(),
+			job.Workspace.GetWorkspace(),
+			jobOpString(job),
+			jobStateString(job),
+			queued,
+			completed,
+		}, colors)
+	}
+	c.ui.Table(tbl)
+
+	return 0
+}
+
+// listRequest builds the request for the jobs matching the filters. The
+// workspace is only filtered on if it was set explicitly.
+func (c *JobListCommand) listRequest(workspaceSet bool) (*pb.ListJobsRequest, error) {
+	req := &pb.ListJobsRequest{}
+	if c.refProject != nil {
+		req.Application = &pb.Ref_Application{
+			Project:     c.refProject.Project,
+			Application: c.flagApp,
+		}
+	}
+	if workspaceSet {
+		req.Workspace = c.refWorkspace
+	}
+
+	states, err := jobStates(c.flagState)
+	if err != nil {
+		return nil, err
+	}
+	req.JobState = states
+
+	return req, nil
+}
+
+func (c *JobListCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.EnumVar(&flag.EnumVar{
+			Name:   "state",
+			Target: &c.flagState,
+			Values: []string{"queued", "waiting", "running", "error", "success"},
+			Usage:  "Only list jobs in the given states, i.e. 'queued,running'.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "json",
+			Target: &c.flagJson,
+			Usage:  "Output the jobs as JSON.",
+		})
+	})
+}
+
+func (c *JobListCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *JobListCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *JobListCommand) Synopsis() string {
+	return "List the jobs of the server."
+}
+
+func (c *JobListCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint job list [options]
+
+  List the jobs of the server, newest first.
+
+  If this is run in a project directory, only the jobs of that project
+  are listed. The jobs can be filtered further by app with -app, by
+  workspace with -workspace and by state with -state. The server only
+  keeps a limited number of completed jobs.
+
+` + c.Flags().Help())
+}
+
+// jobStates parses job state names such as "running" as given to -state.
+// The flag already validates its values, but we check them again so that
+// an unknown name is never sent as the UNKNOWN state, which matches no jobs.
+func jobStates(values []string) ([]pb.Job_State, error) {
+	var result []pb.Job_State
+	for _, v := range values {
+		name := strings.ToUpper(strings.TrimSpace(v))
+		state, ok := pb.Job_State_value[name]
+		if !ok || state == int32(pb.Job_UNKNOWN) {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid job state %q", v)
+		}
+
+		result = append(result, pb.Job_State(state))
+	}
+
+	return result, nil
+}
+
+// jobOpString returns a human friendly string for the operation of a job.
+func jobOpString(job *pb.Job) string {
+	switch job.Operation.(type) {
+	case *pb.Job_Noop_:
+		return "noop"
+	case *pb.Job_Build:
+		return "build"
+	case *pb.Job_Push:
+		return "push"
+	case *pb.Job_Deploy:
+		return "deploy"
+	case *pb.Job_Destroy:
+		return "destroy"
+	case *pb.Job_Release:
+		return "release"
+	case *pb.Job_Validate:
+		return "validate"
+	case *pb.Job_Auth:
+		return "auth"
+	case *pb.Job_Docs:
+		return "docs"
+	case *pb.Job_ConfigSync:
+		return "config sync"
+	case *pb.Job_Exec:
+		return "exec"
+	case *pb.Job_Up:
+		return "up"
+	case *pb.Job_Logs:
+		return "logs"
+	case *pb.Job_QueueProject:
+		return "queue project"
+	case *pb.Job_Poll:
+		return "poll"
+	case *pb.Job_StatusReport:
+		return "status report"
+	case *pb.Job_StartTask:
+		return "start task"
+	case *pb.Job_StopTask:
+		return "stop task"
+	case *pb.Job_RunCommand:
+		return "run command"
+	default:
+		return "unknown"
+	}
+}
+
+// jobStateString returns a human friendly string for the state of a job.
+func jobStateString(job *pb.Job) string {
+	var result string
+	switch job.State {
+	case pb.Job_QUEUED:
+		result = "queued"
+	case pb.Job_WAITING:
+		result = "waiting"
+	case pb.Job_RUNNING:
+		result = "running"
+	case pb.Job_ERROR:
+		result = "error"
+		if job.TimedOut {
+			result = "timed out"
+		}
+	case pb.Job_SUCCESS:
+		result = "success"
+	default:
+		result = "unknown"
+	}
+
+	if job.CancelTime != nil && job.CompleteTime == nil {
+		result += " (cancelling)"
+	}
+
+	return result
+}
@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestJobListRequest(t *testing.T) {
+	cases := []struct {
+		Name         string
+		Project      string
+		App          string
+		WorkspaceSet bool
+		States       []string
+		Expected     *pb.ListJobsRequest
+		Err          string
+	}{
+		{
+			"no filters",
+			"", "", false, nil,
+			&pb.ListJobsRequest{},
+			"",
+		},
+
+		{
+			"project",
+			"p", "", false, nil,
+			&pb.ListJobsRequest{
+				Application: &pb.Ref_Application{Project: "p"},
+			},
+			"",
+		},
+
+		{
+			"project and app",
+			"p", "a", false, nil,
+			&pb.ListJobsRequest{
+				Application: &pb.Ref_Application{Project: "p", Application: "a"},
+			},
+			"",
+		},
+
+		{
+			"app without a project",
+			"", "a", false, nil,
+			&pb.ListJobsRequest{},
+			"",
+		},
+
+		{
+			"workspace",
+			"", "", true, nil,
+			&pb.ListJobsRequest{
+				Workspace: &pb.Ref_Workspace{Workspace: "dev"},
+			},
+			"",
+		},
+
+		{
+			"states",
+			"", "", false, []string{"queued", " Running "},
+			&pb.ListJobsRequest{
+				JobState: []pb.Job_State{pb.Job_QUEUED, pb.Job_RUNNING},
+			},
+			"",
+		},
+
+		{
+			"unknown state",
+			"", "", false, []string{"done"},
+			nil,
+			`invalid job state "done"`,
+		},
+
+		{
+			"the unknown state",
+			"", "", false, []string{"unknown"},
+			nil,
+			"invalid job state",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			c := &JobListCommand{
+				baseCommand: &baseCommand{
+					flagApp:      tt.App,
+					refWorkspace: &pb.Ref_Workspace{Workspace: "dev"},
+				},
+				flagState: tt.States,
+			}
+			if tt.Project != "" {
+				c.refProject = &pb.Ref_Project{Project: tt.Project}
+			}
+
+			req, err := c.listRequest(tt.WorkspaceSet)
+			if tt.Err != "" {
+				require.Error(err)
+				require.Contains(err.Error(), tt.Err)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.Expected, req)
+		})
+	}
+}
+
+func TestJobStateString(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Job      *pb.Job
+		Expected string
+	}{
+		{
+			"running",
+			&pb.Job{State: pb.Job_RUNNING},
+			"running",
+		},
+
+		{
+			"cancelling",
+			&pb.Job{State: pb.Job_RUNNING, CancelTime: ptypes.TimestampNow()},
+			"running (cancelling)",
+		},
+
+		{
+			"timed out",
+			&pb.Job{State: pb.Job_ERROR, TimedOut: true},
+			"timed out",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require.Equal(t, tt.Expected, jobStateString(tt.Job))
+		})
+	}
+}
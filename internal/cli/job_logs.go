@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+)
+
+type JobLogsCommand struct {
+	*baseCommand
+}
+
+func (c *JobLogsCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("A single job ID is required.\n\n"+c.Help(),
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	// If the job failed, this returns the error of the job.
+	_, err := c.project.StreamJob(c.Ctx, c.args[0], c.ui)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	return 0
+}
+
+func (c *JobLogsCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *JobLogsCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *JobLogsCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *JobLogsCommand) Synopsis() string {
+	return "Show the output of a job."
+}
+
+func (c *JobLogsCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint job logs [options] JOB-ID
+
+  Show the output of a job.
+
+  The output the job has produced so far is shown first. If the job hasn't
+  completed, this then follows its output until it completes. Interrupting
+  this command does not cancel the job.
+
+  The server stores the output of jobs, so this works for jobs that ran
+  before the server restarted, such as a deploy run from CI.
+
+` + c.Flags().Help())
+}
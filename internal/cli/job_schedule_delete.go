@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type JobScheduleDeleteCommand struct {
+	*baseCommand
+}
+
+func (c *JobScheduleDeleteCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("A single schedule name is required.\n\n"+c.Help(),
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	_, err := c.project.Client().DeleteJobSchedule(c.Ctx, &pb.DeleteJobScheduleRequest{
+		Project: c.refProject,
+		Name:    c.args[0],
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	c.ui.Output("Job schedule %q deleted.", c.args[0], terminal.WithSuccessStyle())
+	return 0
+}
+
+func (c *JobScheduleDeleteCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *JobScheduleDeleteCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *JobScheduleDeleteCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *JobScheduleDeleteCommand) Synopsis() string {
+	return "Delete a scheduled job."
+}
+
+func (c *JobScheduleDeleteCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint job schedule delete [options] NAME
+
+  Delete a scheduled job.
+
+  Jobs that were already queued by the schedule are not cancelled.
+
+` + c.Flags().Help())
+}
@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"github.com/dustin/go-humanize"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type JobScheduleListCommand struct {
+	*baseCommand
+}
+
+func (c *JobScheduleListCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+	); err != nil {
+		return 1
+	}
+
+	resp, err := c.project.Client().ListJobSchedules(c.Ctx, &pb.ListJobSchedulesRequest{
+		Project: c.refProject,
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	if len(resp.Schedules) == 0 {
+		c.ui.Output("No job schedules found.")
+		return 0
+	}
+
+	tbl := terminal.NewTable("Name", "App", "Workspace", "Op", "Cron", "Next", "Last", "Last Job ID")
+	for _, s := range resp.Schedules {
+		var next, last string
+		if t, err := ptypes.Timestamp(s.NextTime); err == nil {
+			next = humanize.Time(t)
+		}
+		if t, err := ptypes.Timestamp(s.LastTime); err == nil {
+			last = humanize.Time(t)
+		}
+
+		var colors []string
+		if s.LastError != "" {
+			colors = make([]string, 8)
+			colors[6] = terminal.Red
+		}
+
+		tbl.Rich([]string{
+			s.Name,
+			s.Application.Application,
+			s.Workspace.Workspace,
+			jobScheduleOpString(s.Op),
+			s.Cron,
+			next,
+			last,
+			s.LastJobId,
+		}, colors)
+	}
+	c.ui.Table(tbl)
+
+	// Show the errors after the table since they can be long.
+	for _, s := range resp.Schedules {
+		if s.LastError != "" {
+			c.ui.Output("")
+			c.ui.Output("The last job of %q could not be queued: %s", s.Name, s.LastError,
+				terminal.WithErrorStyle())
+		}
+	}
+
+	return 0
+}
+
+func (c *JobScheduleListCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *JobScheduleListCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *JobScheduleListCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *JobScheduleListCommand) Synopsis() string {
+	return "List the scheduled jobs of the project."
+}
+
+func (c *JobScheduleListCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint job schedule list [options]
+
+  List the scheduled jobs of the project.
+
+  This shows when the job of each schedule is next queued and when it was
+  last queued. If the last job couldn't be queued, such as when the
+  project has no data source, the error is shown after the table.
+
+` + c.Flags().Help())
+}
@@ -0,0 +1,149 @@
+package cli
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// jobScheduleOps maps the values of the -op flag to the operations in
+// the API.
+var jobScheduleOps = map[string]pb.JobSchedule_Op{
+	"up":            pb.JobSchedule_UP,
+	"build":         pb.JobSchedule_BUILD,
+	"status-report": pb.JobSchedule_STATUS_REPORT,
+}
+
+type JobScheduleSetCommand struct {
+	*baseCommand
+
+	flagCron string
+	flagOp   string
+}
+
+func (c *JobScheduleSetCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithSingleApp(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("A single schedule name is required.\n\n"+c.Help(),
+			terminal.WithErrorStyle())
+		return 1
+	}
+	if c.flagCron == "" {
+		c.ui.Output("The -cron flag is required.\n\n"+c.Help(),
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	resp, err := c.project.Client().UpsertJobSchedule(c.Ctx, &pb.UpsertJobScheduleRequest{
+		Schedule: &pb.JobSchedule{
+			Project:     c.refProject,
+			Name:        c.args[0],
+			Application: c.refApp,
+			Workspace:   c.refWorkspace,
+			Op:          jobScheduleOps[c.flagOp],
+			Cron:        c.flagCron,
+		},
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	c.ui.Output("Job schedule %q set.", resp.Schedule.Name, terminal.WithSuccessStyle())
+	if t, err := ptypes.Timestamp(resp.Schedule.NextTime); err == nil {
+		c.ui.Output("The next job will be queued at %s.",
+			t.Local().Format(time.RFC1123), terminal.WithInfoStyle())
+	} else {
+		c.ui.Output("The cron expression never matches so no jobs will be queued.",
+			terminal.WithWarningStyle())
+	}
+
+	return 0
+}
+
+func (c *JobScheduleSetCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		var ops []string
+		for op := range jobScheduleOps {
+			ops = append(ops, op)
+		}
+		sort.Strings(ops)
+
+		f := set.NewSet("Command Options")
+		f.StringVar(&flag.StringVar{
+			Name:   "cron",
+			Target: &c.flagCron,
+			Usage: "Cron expression of when to queue the job, such as \"0 2 * * *\" " +
+				"for every night at 2am. The expression is evaluated in UTC.",
+		})
+
+		f.EnumSingleVar(&flag.EnumSingleVar{
+			Name:    "op",
+			Target:  &c.flagOp,
+			Values:  ops,
+			Default: "up",
+			Usage:   "The operation to queue for the app.",
+		})
+	})
+}
+
+func (c *JobScheduleSetCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *JobScheduleSetCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *JobScheduleSetCommand) Synopsis() string {
+	return "Create or update a scheduled job."
+}
+
+func (c *JobScheduleSetCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint job schedule set [options] NAME
+
+  Create or update a scheduled job for an app.
+
+  The server queues the operation for the app every time the cron
+  expression matches. Scheduled jobs run on a remote runner using the
+  data source of the project, such as Git, so the project must have a
+  data source configured.
+
+  If the last job of the schedule is still waiting for a runner when the
+  schedule matches again, it is replaced by the new job so jobs don't pile
+  up.
+
+  Examples:
+
+    Run "waypoint up" every night at 2am UTC:
+
+      waypoint job schedule set -cron="0 2 * * *" nightly
+
+    Refresh the status report every hour:
+
+      waypoint job schedule set -op=status-report -cron="@hourly" status
+
+` + c.Flags().Help())
+}
+
+// jobScheduleOpString returns the -op flag value of an operation.
+func jobScheduleOpString(op pb.JobSchedule_Op) string {
+	return strings.ReplaceAll(strings.ToLower(op.String()), "_", "-")
+}
@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// listPageSize is the page size used when listing. Servers that don't
+// support paging ignore this and return everything in one response.
+const listPageSize = 100
+
+// listProjects returns all projects, requesting them a page at a time.
+func listProjects(ctx context.Context, client pb.WaypointClient) ([]*pb.Ref_Project, error) {
+	req := &pb.ListProjectsRequest{PageSize: listPageSize}
+
+	var result []*pb.Ref_Project
+	for {
+		resp, err := client.ListProjects(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, resp.Projects...)
+		if resp.NextPageToken == "" {
+			return result, nil
+		}
+
+		req.PageToken = resp.NextPageToken
+	}
+}
+
+// listBuilds returns all builds matching the request, requesting them a
+// page at a time. If the request has a limit, at most that many builds
+// are returned.
+func listBuilds(
+	ctx context.Context,
+	client pb.WaypointClient,
+	req *pb.ListBuildsRequest,
+) ([]*pb.Build, error) {
+	req = proto.Clone(req).(*pb.ListBuildsRequest)
+	req.PageSize = listPageSize
+
+	var result []*pb.Build
+	for {
+		resp, err := client.ListBuilds(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, resp.Builds...)
+		if o := req.Order; o != nil && o.Limit > 0 && len(result) >= int(o.Limit) {
+			return result[:o.Limit], nil
+		}
+		if resp.NextPageToken == "" {
+			return result, nil
+		}
+
+		req.PageToken = resp.NextPageToken
+	}
+}
+
+// listDeployments returns all deployments matching the request,
+// requesting them a page at a time. If the request has a limit, at most
+// that many deployments are returned.
+func listDeployments(
+	ctx context.Context,
+	client pb.WaypointClient,
+	req *pb.ListDeploymentsRequest,
+) ([]*pb.Deployment, error) {
+	req = proto.Clone(req).(*pb.ListDeploymentsRequest)
+	req.PageSize = listPageSize
+
+	var result []*pb.Deployment
+	for {
+		resp, err := client.ListDeployments(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, resp.Deployments...)
+		if o := req.Order; o != nil && o.Limit > 0 && len(result) >= int(o.Limit) {
+			return result[:o.Limit], nil
+		}
+		if resp.NextPageToken == "" {
+			return result, nil
+		}
+
+		req.PageToken = resp.NextPageToken
+	}
+}
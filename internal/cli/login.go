@@ -25,6 +25,7 @@ type LoginCommand struct {
 
 	flagAuthMethod string
 	flagToken      string
+	flagPAT        string
 }
 
 func (c *LoginCommand) Run(args []string) int {
@@ -64,6 +65,9 @@ func (c *LoginCommand) Run(args []string) int {
 	case c.flagToken != "":
 		authFunc = c.loginToken
 
+	case c.flagPAT != "":
+		authFunc = c.loginPAT
+
 	default:
 		authFunc = c.loginOIDC
 	}
@@ -145,6 +149,31 @@ func (c *LoginCommand) loginToken() (string, int) {
 	return convertResp.Token, 0
 }
 
+func (c *LoginCommand) loginPAT() (string, int) {
+	// PAT auth methods aren't listed without auth so we can't default
+	// to the only one like OIDC.
+	if c.flagAuthMethod == "" {
+		c.ui.Output(strings.TrimSpace(errPATAuthMethod), terminal.WithErrorStyle())
+		return "", 1
+	}
+
+	resp, err := c.project.Client().CompletePATAuth(c.Ctx, &pb.CompletePATAuthRequest{
+		AuthMethod: &pb.Ref_AuthMethod{Name: c.flagAuthMethod},
+		Token:      c.flagPAT,
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return "", 1
+	}
+
+	c.Log.Debug("PAT authentication complete",
+		"user_id", resp.User.Id,
+		"username", resp.User.Username,
+	)
+
+	return resp.Token, 0
+}
+
 func (c *LoginCommand) loginOIDC() (string, int) {
 	// Get our OIDC auth methods
 	respList, err := c.project.Client().ListOIDCAuthMethods(c.Ctx, &empty.Empty{})
@@ -255,6 +284,15 @@ func (c *LoginCommand) Flags() *flag.Sets {
 			Usage: "Auth with a token. This will force auth-method to 'token'. " +
 				"This works with both login and invite tokens.",
 		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "pat",
+			Target: &c.flagPAT,
+			EnvVar: "WAYPOINT_LOGIN_PAT",
+			Usage: "Auth with a GitHub or GitLab personal access token. This " +
+				"requires auth-method to be set to a GitHub or GitLab auth method. " +
+				"The token is only sent to the server to verify your identity.",
+		})
 	})
 }
 
@@ -287,6 +325,10 @@ Usage: waypoint login [server address]
   other forms such as OIDC. You can use "-token" to specify a login or
   invite token and configure the CLI to access the server.
 
+  If the server has a GitHub or GitLab auth method, you can use "-pat"
+  with "-auth-method" to log in with a personal access token of that
+  provider.
+
 ` + c.Flags().Help())
 }
 
@@ -314,6 +356,12 @@ waypoint login example.com
 or
 
 waypoint login https://example.com
+`
+
+	errPATAuthMethod = `
+Logging in with a personal access token requires the "-auth-method" flag
+to specify the GitHub or GitLab auth method to use. Please ask your
+Waypoint server administrator for the name of the auth method.
 `
 
 	errTokenInvalid = `
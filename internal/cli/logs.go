@@ -2,11 +2,19 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/posener/complete"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
 	clientpkg "github.com/hashicorp/waypoint/internal/client"
@@ -17,6 +25,11 @@ import (
 
 type LogsCommand struct {
 	*baseCommand
+
+	flagSince    time.Duration
+	flagInstance []string
+	flagGrep     string
+	flagJson     bool
 }
 
 var logColors = map[pb.LogBatch_Entry_Source]*color.Color{
@@ -24,83 +37,373 @@ var logColors = map[pb.LogBatch_Entry_Source]*color.Color{
 	pb.LogBatch_Entry_ENTRYPOINT: color.New(color.FgCyan),
 }
 
+// logInstanceColors are the colors of the instance prefixes. Instances
+// are given the colors in the order they're first seen.
+var logInstanceColors = []*color.Color{
+	color.New(color.FgYellow),
+	color.New(color.FgMagenta),
+	color.New(color.FgBlue),
+	color.New(color.FgRed),
+	color.New(color.FgHiGreen),
+	color.New(color.FgHiCyan),
+}
+
 func (c *LogsCommand) Run(args []string) int {
 	// Initialize. If we fail, we just exit since Init handles the UI.
 	if err := c.Init(
 		WithArgs(args),
 		WithFlags(c.Flags()),
-		WithSingleApp(),
+		WithMultipleApps(),
 	); err != nil {
 		return 1
 	}
 
-	err := c.DoApp(c.Ctx, func(ctx context.Context, app *clientpkg.App) error {
-		stream, err := app.Logs(ctx)
+	filter, err := newLogFilter(c.flagSince, c.flagInstance, c.flagGrep)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	// We stream the logs of every targeted app at once.
+	var appNames []string
+	if c.refApp != nil {
+		appNames = []string{c.refApp.Application}
+	} else {
+		appNames = c.cfg.Apps()
+	}
+
+	out := &logOutput{
+		ui:      c.ui,
+		appName: len(appNames) > 1,
+		colors:  map[string]*color.Color{},
+	}
+	if c.flagJson {
+		stdout, _, err := c.ui.OutputWriters()
+		if err != nil {
+			c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return 1
+		}
+
+		out.json = json.NewEncoder(stdout)
+	}
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		failed bool
+	)
+	for _, name := range appNames {
+		wg.Add(1)
+		go func(app *clientpkg.App) {
+			defer wg.Done()
+
+			if err := c.streamLogs(c.Ctx, app, filter, out); err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+				failed = true
+			}
+		}(c.project.App(name))
+	}
+	wg.Wait()
+
+	if failed {
+		return 1
+	}
+
+	return 0
+}
+
+// streamLogs streams the logs of a single app to out until the stream ends.
+func (c *LogsCommand) streamLogs(
+	ctx context.Context,
+	app *clientpkg.App,
+	filter *logFilter,
+	out *logOutput,
+) error {
+	// If we only show recent logs, show the archived logs first and then
+	// request the whole backlog so that every line since then is shown
+	// rather than only the newest.
+	var limitBacklog int32
+	var archived map[string]time.Time
+	if !filter.since.IsZero() {
+		limitBacklog = -1
+
+		var err error
+		archived, err = c.searchLogs(ctx, app, filter, out)
+		if err != nil {
+			app.UI.Output("Error searching logs: %s", clierrors.Humanize(err), terminal.WithErrorStyle())
+			return ErrSentinel
+		}
+	}
+
+	stream, err := app.Logs(ctx, limitBacklog)
+	if err != nil {
+		if !clierrors.IsCanceled(err) {
+			app.UI.Output("Error reading logs: %s", err, terminal.WithErrorStyle())
+		}
+		return ErrSentinel
+	}
+
+	for {
+		batch, err := stream.Recv()
 		if err != nil {
 			if !clierrors.IsCanceled(err) {
 				app.UI.Output("Error reading logs: %s", err, terminal.WithErrorStyle())
 			}
+
 			return ErrSentinel
 		}
 
-		for {
-			batch, err := stream.Recv()
-			if err != nil {
-				if !clierrors.IsCanceled(err) {
-					app.UI.Output("Error reading logs: %s", err, terminal.WithErrorStyle())
-				}
+		if len(batch.Lines) == 0 {
+			return nil
+		}
 
-				return ErrSentinel
+		for _, event := range batch.Lines {
+			event.Line = strings.TrimSuffix(event.Line, "\n")
+			if !filter.match(batch.InstanceId, event) {
+				continue
 			}
 
-			if len(batch.Lines) == 0 {
-				break
+			// Skip the lines we already showed from the archive.
+			if last, ok := archived[batch.InstanceId]; ok {
+				ts, err := ptypes.Timestamp(event.Timestamp)
+				if err == nil && !ts.After(last) {
+					continue
+				}
 			}
 
-			for _, event := range batch.Lines {
-				event.Line = strings.TrimSuffix(event.Line, "\n")
-
-				// We use this format rather than regular RFC3339Nano because we use .0
-				// instead of .9, which preserves the spacing so the output is always
-				// lined up
-				tsRaw, _ := ptypes.Timestamp(event.Timestamp)
-				ts := tsRaw.Format("2006-01-02T15:04:05.000Z07:00")
-				short := batch.InstanceId
-				if len(short) > 6 {
-					short = short[len(short)-6:]
-				}
+			out.output(app.Ref().Application, batch.DeploymentId, batch.InstanceId, event)
+		}
+	}
+}
 
-				color, ok := logColors[event.Source]
-				if !ok {
-					color = logColors[pb.LogBatch_Entry_APP]
-				}
+// searchLogs shows the lines of the app from the log archive of the
+// server. This returns the time of the last line shown for each instance.
+// If the server has no log archive, this shows nothing.
+func (c *LogsCommand) searchLogs(
+	ctx context.Context,
+	app *clientpkg.App,
+	filter *logFilter,
+	out *logOutput,
+) (map[string]time.Time, error) {
+	since, err := ptypes.TimestampProto(filter.since)
+	if err != nil {
+		return nil, err
+	}
 
-				header := color.Sprintf("%s %s: ", ts, short)
-				if strings.IndexByte(event.Line, '\n') != -1 {
-					parts := strings.Split(event.Line, "\n")
-
-					for _, part := range parts {
-						m := header + part
-						c.ui.Output(m)
-					}
-				} else {
-					m := header + event.Line
-					c.ui.Output(m)
-				}
+	resp, err := app.SearchLogs(ctx, since)
+	if status.Code(err) == codes.FailedPrecondition ||
+		status.Code(err) == codes.Unimplemented {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if resp.Truncated && out.json == nil {
+		app.UI.Output("Only the newest archived logs of %q are shown.",
+			app.Ref().Application, terminal.WithWarningStyle())
+	}
+
+	// We show the lines in time order across the instances.
+	type line struct {
+		deploymentId string
+		instanceId   string
+		entry        *pb.LogBatch_Entry
+		time         time.Time
+	}
+	var lines []line
+	for _, batch := range resp.Batches {
+		for _, entry := range batch.Lines {
+			ts, err := ptypes.Timestamp(entry.Timestamp)
+			if err != nil {
+				continue
 			}
-		}
 
-		return nil
+			lines = append(lines, line{batch.DeploymentId, batch.InstanceId, entry, ts})
+		}
+	}
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].time.Before(lines[j].time)
 	})
-	if err != nil {
-		return 1
+
+	last := map[string]time.Time{}
+	for _, l := range lines {
+		last[l.instanceId] = l.time
+		if filter.match(l.instanceId, l.entry) {
+			out.output(app.Ref().Application, l.deploymentId, l.instanceId, l.entry)
+		}
 	}
 
-	return 0
+	return last, nil
+}
+
+// logOutput writes the log lines of one or more apps to the UI. This is
+// safe to use concurrently.
+type logOutput struct {
+	ui terminal.UI
+
+	// appName is true if lines are prefixed with the app name. This is
+	// set when the logs of multiple apps are shown.
+	appName bool
+
+	// json, if set, is where lines are written as JSON objects instead of
+	// to the UI.
+	json *json.Encoder
+
+	mu     sync.Mutex
+	colors map[string]*color.Color
+}
+
+// logJSONLine is a line of the output of "waypoint logs -json".
+type logJSONLine struct {
+	Timestamp    time.Time `json:"timestamp"`
+	App          string    `json:"app"`
+	DeploymentId string    `json:"deployment"`
+	InstanceId   string    `json:"instance"`
+	Source       string    `json:"source"`
+	Message      string    `json:"message"`
+}
+
+func (o *logOutput) output(app, deploymentId, instanceId string, event *pb.LogBatch_Entry) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.json != nil {
+		ts, _ := ptypes.Timestamp(event.Timestamp)
+		o.json.Encode(&logJSONLine{
+			Timestamp:    ts,
+			App:          app,
+			DeploymentId: deploymentId,
+			InstanceId:   instanceId,
+			Source:       strings.ToLower(event.Source.String()),
+			Message:      event.Line,
+		})
+		return
+	}
+
+	// We use this format rather than regular RFC3339Nano because we use .0
+	// instead of .9, which preserves the spacing so the output is always
+	// lined up
+	tsRaw, _ := ptypes.Timestamp(event.Timestamp)
+	ts := tsRaw.Format("2006-01-02T15:04:05.000Z07:00")
+	short := instanceId
+	if len(short) > 6 {
+		short = short[len(short)-6:]
+	}
+	if o.appName {
+		short = app + "/" + short
+	}
+
+	sourceColor, ok := logColors[event.Source]
+	if !ok {
+		sourceColor = logColors[pb.LogBatch_Entry_APP]
+	}
+
+	instanceColor, ok := o.colors[instanceId]
+	if !ok {
+		instanceColor = logInstanceColors[len(o.colors)%len(logInstanceColors)]
+		o.colors[instanceId] = instanceColor
+	}
+
+	header := sourceColor.Sprintf("%s ", ts) + instanceColor.Sprintf("%s: ", short)
+	for _, part := range strings.Split(event.Line, "\n") {
+		o.ui.Output(header + part)
+	}
+}
+
+// logFilter filters the log lines that are shown.
+type logFilter struct {
+	// since is the time before which lines are hidden. This is zero if
+	// lines aren't filtered by time.
+	since time.Time
+
+	// instances are the instance IDs, or suffixes of them, whose lines
+	// are shown. If this is empty, lines of all instances are shown.
+	instances []string
+
+	// grep is the expression lines must match. This is nil if lines
+	// aren't filtered by content.
+	grep *regexp.Regexp
+}
+
+func newLogFilter(since time.Duration, instances []string, grep string) (*logFilter, error) {
+	f := &logFilter{instances: instances}
+	if since > 0 {
+		f.since = time.Now().Add(-since)
+	}
+
+	if grep != "" {
+		var err error
+		f.grep, err = regexp.Compile(grep)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid -grep expression: %s", err)
+		}
+	}
+
+	return f, nil
+}
+
+// match returns true if the line of the given instance should be shown.
+func (f *logFilter) match(instanceId string, event *pb.LogBatch_Entry) bool {
+	if len(f.instances) > 0 {
+		found := false
+		for _, v := range f.instances {
+			if strings.HasSuffix(instanceId, v) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if !f.since.IsZero() {
+		ts, err := ptypes.Timestamp(event.Timestamp)
+		if err == nil && ts.Before(f.since) {
+			return false
+		}
+	}
+
+	if f.grep != nil && !f.grep.MatchString(event.Line) {
+		return false
+	}
+
+	return true
 }
 
 func (c *LogsCommand) Flags() *flag.Sets {
-	return c.flagSet(0, nil)
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.DurationVar(&flag.DurationVar{
+			Name:   "since",
+			Target: &c.flagSince,
+			Usage: "Only show logs from within this duration, such as \"24h\". " +
+				"If the server has a log archive, this includes the archived " +
+				"logs of past instances.",
+		})
+
+		f.StringSliceVar(&flag.StringSliceVar{
+			Name:   "instance",
+			Target: &c.flagInstance,
+			Usage: "Only show logs of the instance with this ID. The six " +
+				"character ID shown in the logs can be used. This can be " +
+				"specified multiple times.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "grep",
+			Target: &c.flagGrep,
+			Usage:  "Only show log lines that match this regular expression.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "json",
+			Target: &c.flagJson,
+			Usage: "Output each log line as a JSON object with the timestamp, " +
+				"app, deployment, instance, source and message.",
+		})
+	})
 }
 
 func (c *LogsCommand) AutocompleteArgs() complete.Predictor {
@@ -124,9 +427,13 @@ Usage: waypoint logs [options]
   The logs will include output from deployments that aren't released.
   As new deployments are made, their logs will appear automatically.
 
+  If the project has multiple apps and no app is targeted with "-app",
+  the logs of every app are shown at once and each line is prefixed with
+  the name of its app.
+
   The six character text after the date on a log line is the last six
   characters of the instance ID. This can be used to trace any logs back
-  to a specific deployment or filter out certain log messages.
+  to a specific deployment or filter the logs with "-instance".
 
 ` + c.Flags().Help())
 }
@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestLogFilter(t *testing.T) {
+	entry := func(line string, age time.Duration) *pb.LogBatch_Entry {
+		ts, err := ptypes.TimestampProto(time.Now().Add(-age))
+		require.NoError(t, err)
+		return &pb.LogBatch_Entry{Line: line, Timestamp: ts}
+	}
+
+	cases := []struct {
+		Name      string
+		Since     time.Duration
+		Instances []string
+		Grep      string
+		Instance  string
+		Entry     *pb.LogBatch_Entry
+		Match     bool
+	}{
+		{
+			"no filter",
+			0, nil, "",
+			"I_ABCDEF",
+			entry("hello", time.Hour),
+			true,
+		},
+
+		{
+			"since, recent",
+			10 * time.Minute, nil, "",
+			"I_ABCDEF",
+			entry("hello", time.Minute),
+			true,
+		},
+
+		{
+			"since, old",
+			10 * time.Minute, nil, "",
+			"I_ABCDEF",
+			entry("hello", time.Hour),
+			false,
+		},
+
+		{
+			"instance suffix",
+			0, []string{"ABCDEF"}, "",
+			"I_ABCDEF",
+			entry("hello", 0),
+			true,
+		},
+
+		{
+			"instance mismatch",
+			0, []string{"ZZZZZZ"}, "",
+			"I_ABCDEF",
+			entry("hello", 0),
+			false,
+		},
+
+		{
+			"grep match",
+			0, nil, "^GET /[a-z]+",
+			"I_ABCDEF",
+			entry("GET /health 200", 0),
+			true,
+		},
+
+		{
+			"grep mismatch",
+			0, nil, "^GET",
+			"I_ABCDEF",
+			entry("POST /health 200", 0),
+			false,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			f, err := newLogFilter(tt.Since, tt.Instances, tt.Grep)
+			require.NoError(err)
+			require.Equal(tt.Match, f.match(tt.Instance, tt.Entry))
+		})
+	}
+
+	t.Run("invalid grep", func(t *testing.T) {
+		_, err := newLogFilter(0, nil, "(")
+		require.Error(t, err)
+	})
+}
+
+func TestLogOutput_json(t *testing.T) {
+	require := require.New(t)
+
+	var buf bytes.Buffer
+	out := &logOutput{json: json.NewEncoder(&buf)}
+
+	ts, err := ptypes.TimestampProto(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(err)
+	out.output("web", "D_1", "I_ABCDEF", &pb.LogBatch_Entry{
+		Timestamp: ts,
+		Source:    pb.LogBatch_Entry_ENTRYPOINT,
+		Line:      "hello",
+	})
+
+	require.JSONEq(`{
+		"timestamp": "2021-01-01T00:00:00Z",
+		"app": "web",
+		"deployment": "D_1",
+		"instance": "I_ABCDEF",
+		"source": "entrypoint",
+		"message": "hello"
+	}`, buf.String())
+}
@@ -20,6 +20,8 @@ import (
 	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
 	"github.com/hashicorp/waypoint/internal/env"
 	"github.com/hashicorp/waypoint/internal/pkg/signalcontext"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/telemetry"
 	"github.com/hashicorp/waypoint/internal/version"
 )
 
@@ -91,6 +93,21 @@ func Main(args []string) int {
 	ctx, closer := signalcontext.WithInterrupt(context.Background(), log)
 	defer closer()
 
+	// Setup tracing. Spans are exported if an OTLP endpoint is set with
+	// env vars. "waypoint server run" and "waypoint runner agent" replace
+	// this with their own configuration.
+	tcfg, err := telemetry.ConfigFromEnv("waypoint")
+	if err == nil {
+		var shutdownTracing func(context.Context) error
+		shutdownTracing, err = telemetry.Init(ctx, tcfg)
+		if err == nil {
+			defer shutdownTracing(context.Background())
+		}
+	}
+	if err != nil {
+		log.Warn("error setting up tracing, spans won't be exported", "err", err)
+	}
+
 	// Get our base command
 	base, commands := Commands(ctx, log, logOutput)
 	defer base.Close()
@@ -192,6 +209,36 @@ func Commands(
 				baseCommand: baseCommand,
 			}, nil
 		},
+		"exec sessions": func() (cli.Command, error) {
+			return &ExecSessionsCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+		"cp": func() (cli.Command, error) {
+			return &CpCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+		"port-forward": func() (cli.Command, error) {
+			return &PortForwardCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+		"status": func() (cli.Command, error) {
+			return &StatusCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+		"status history": func() (cli.Command, error) {
+			return &StatusHistoryCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+		"events": func() (cli.Command, error) {
+			return &EventsCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
 		"config": func() (cli.Command, error) {
 			return &helpCommand{
 				SynopsisText: helpText["config"][0],
@@ -203,6 +250,26 @@ func Commands(
 				baseCommand: baseCommand,
 			}, nil
 		},
+		"config export": func() (cli.Command, error) {
+			return &ConfigExportCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+		"config history": func() (cli.Command, error) {
+			return &ConfigHistoryCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+		"config import": func() (cli.Command, error) {
+			return &ConfigImportCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+		"config rollback": func() (cli.Command, error) {
+			return &ConfigRollbackCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
 		"config set": func() (cli.Command, error) {
 			return &ConfigSetCommand{
 				baseCommand: baseCommand,
@@ -223,6 +290,11 @@ func Commands(
 				baseCommand: baseCommand,
 			}, nil
 		},
+		"config validate": func() (cli.Command, error) {
+			return &ConfigValidateCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
 		"logs": func() (cli.Command, error) {
 			return &LogsCommand{
 				baseCommand: baseCommand,
@@ -241,6 +313,12 @@ func Commands(
 			}, nil
 		},
 
+		"artifact inspect": func() (cli.Command, error) {
+			return &ArtifactInspectCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
 		"artifact list": func() (cli.Command, error) {
 			return &ArtifactListCommand{
 				baseCommand: baseCommand,
@@ -259,6 +337,12 @@ func Commands(
 			}, nil
 		},
 
+		"artifact verify": func() (cli.Command, error) {
+			return &ArtifactVerifyCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
 		"deployment": func() (cli.Command, error) {
 			return &helpCommand{
 				SynopsisText: helpText["deployment"][0],
@@ -278,6 +362,12 @@ func Commands(
 			}, nil
 		},
 
+		"deployment diff": func() (cli.Command, error) {
+			return &DeploymentDiffCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
 		"deployment list": func() (cli.Command, error) {
 			return &DeploymentListCommand{
 				baseCommand: baseCommand,
@@ -290,6 +380,124 @@ func Commands(
 			}, nil
 		},
 
+		"release promote": func() (cli.Command, error) {
+			return &ReleasePromoteCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"release abort": func() (cli.Command, error) {
+			return &ReleaseAbortCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"promote": func() (cli.Command, error) {
+			return &PromoteCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"pipeline": func() (cli.Command, error) {
+			return &helpCommand{
+				SynopsisText: helpText["pipeline"][0],
+				HelpText:     helpText["pipeline"][1],
+			}, nil
+		},
+		"pipeline run": func() (cli.Command, error) {
+			return &PipelineRunCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"pipeline list": func() (cli.Command, error) {
+			return &PipelineListCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"pipeline inspect": func() (cli.Command, error) {
+			return &PipelineInspectCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"approve": func() (cli.Command, error) {
+			return &ApproveCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"job": func() (cli.Command, error) {
+			return &helpCommand{
+				SynopsisText: helpText["job"][0],
+				HelpText:     helpText["job"][1],
+			}, nil
+		},
+		"job list": func() (cli.Command, error) {
+			return &JobListCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+		"job inspect": func() (cli.Command, error) {
+			return &JobInspectCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+		"job cancel": func() (cli.Command, error) {
+			return &JobCancelCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+		"job logs": func() (cli.Command, error) {
+			return &JobLogsCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+		"job schedule": func() (cli.Command, error) {
+			return &helpCommand{
+				SynopsisText: helpText["job-schedule"][0],
+				HelpText:     helpText["job-schedule"][1],
+			}, nil
+		},
+		"job schedule set": func() (cli.Command, error) {
+			return &JobScheduleSetCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+		"job schedule list": func() (cli.Command, error) {
+			return &JobScheduleListCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+		"job schedule delete": func() (cli.Command, error) {
+			return &JobScheduleDeleteCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"notifier": func() (cli.Command, error) {
+			return &helpCommand{
+				SynopsisText: helpText["notifier"][0],
+				HelpText:     helpText["notifier"][1],
+			}, nil
+		},
+		"notifier set": func() (cli.Command, error) {
+			return &NotifierSetCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+		"notifier list": func() (cli.Command, error) {
+			return &NotifierListCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+		"notifier delete": func() (cli.Command, error) {
+			return &NotifierDeleteCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
 		"server": func() (cli.Command, error) {
 			return &helpCommand{
 				SynopsisText: helpText["server"][0],
@@ -331,16 +539,46 @@ func Commands(
 				baseCommand: baseCommand,
 			}, nil
 		},
+		"server prune": func() (cli.Command, error) {
+			return &ServerPruneCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+		"server rotate-config-key": func() (cli.Command, error) {
+			return &ServerRotateConfigKeyCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
 		"server upgrade": func() (cli.Command, error) {
 			return &ServerUpgradeCommand{
 				baseCommand: baseCommand,
 			}, nil
 		},
+		"server audit": func() (cli.Command, error) {
+			return &ServerAuditCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
 		"plugin": func() (cli.Command, error) {
 			return &PluginCommand{
 				baseCommand: baseCommand,
 			}, nil
 		},
+		"plugin host": func() (cli.Command, error) {
+			return &PluginHostCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+		"plugin install": func() (cli.Command, error) {
+			return &PluginInstallCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+		"plugin list": func() (cli.Command, error) {
+			return &PluginListCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
 		"version": func() (cli.Command, error) {
 			return &VersionCommand{
 				baseCommand: baseCommand,
@@ -404,6 +642,68 @@ func Commands(
 			}, nil
 		},
 
+		"runner list": func() (cli.Command, error) {
+			return &RunnerListCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"runner adopt": func() (cli.Command, error) {
+			return &RunnerAdoptCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"runner reject": func() (cli.Command, error) {
+			return &RunnerRejectCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"runner token": func() (cli.Command, error) {
+			return &RunnerTokenCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"runner config": func() (cli.Command, error) {
+			return &helpCommand{
+				SynopsisText: helpText["runner-config"][0],
+				HelpText:     helpText["runner-config"][1],
+			}, nil
+		},
+
+		"runner config set": func() (cli.Command, error) {
+			return &RunnerConfigSetCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"runner profile": func() (cli.Command, error) {
+			return &helpCommand{
+				SynopsisText: helpText["runner-profile"][0],
+				HelpText:     helpText["runner-profile"][1],
+			}, nil
+		},
+
+		"runner profile set": func() (cli.Command, error) {
+			return &RunnerProfileSetCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"runner profile list": func() (cli.Command, error) {
+			return &RunnerProfileListCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"runner profile delete": func() (cli.Command, error) {
+			return &RunnerProfileDeleteCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
 		"context": func() (cli.Command, error) {
 			return &ContextHelpCommand{
 				baseCommand:  baseCommand,
@@ -481,6 +781,49 @@ func Commands(
 			}, nil
 		},
 
+		"project destroy": func() (cli.Command, error) {
+			return &ProjectDestroyCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"project previews": func() (cli.Command, error) {
+			return &ProjectPreviewsCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"project import-org": func() (cli.Command, error) {
+			return &ProjectImportOrgCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"project template": func() (cli.Command, error) {
+			return &helpCommand{
+				SynopsisText: helpText["project-template"][0],
+				HelpText:     helpText["project-template"][1],
+			}, nil
+		},
+
+		"project template set": func() (cli.Command, error) {
+			return &ProjectTemplateSetCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"project template list": func() (cli.Command, error) {
+			return &ProjectTemplateListCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"project template delete": func() (cli.Command, error) {
+			return &ProjectTemplateDeleteCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
 		"fmt": func() (cli.Command, error) {
 			return &FmtCommand{
 				baseCommand: baseCommand,
@@ -507,6 +850,50 @@ func Commands(
 			}, nil
 		},
 
+		"auth-method set github": func() (cli.Command, error) {
+			return &AuthMethodSetPATCommand{
+				baseCommand: baseCommand,
+				provider:    pb.AuthMethod_PAT_GITHUB,
+			}, nil
+		},
+
+		"auth-method set gitlab": func() (cli.Command, error) {
+			return &AuthMethodSetPATCommand{
+				baseCommand: baseCommand,
+				provider:    pb.AuthMethod_PAT_GITLAB,
+			}, nil
+		},
+
+		"auth-method test": func() (cli.Command, error) {
+			return &helpCommand{
+				SynopsisText: helpText["auth-method-test"][0],
+				HelpText:     helpText["auth-method-test"][1],
+			}, nil
+		},
+
+		"auth-method test oidc": func() (cli.Command, error) {
+			return &AuthMethodSetOIDCCommand{
+				baseCommand: baseCommand,
+				test:        true,
+			}, nil
+		},
+
+		"auth-method test github": func() (cli.Command, error) {
+			return &AuthMethodSetPATCommand{
+				baseCommand: baseCommand,
+				provider:    pb.AuthMethod_PAT_GITHUB,
+				test:        true,
+			}, nil
+		},
+
+		"auth-method test gitlab": func() (cli.Command, error) {
+			return &AuthMethodSetPATCommand{
+				baseCommand: baseCommand,
+				provider:    pb.AuthMethod_PAT_GITLAB,
+				test:        true,
+			}, nil
+		},
+
 		"auth-method inspect": func() (cli.Command, error) {
 			return &AuthMethodInspectCommand{
 				baseCommand: baseCommand,
@@ -525,6 +912,37 @@ func Commands(
 			}, nil
 		},
 
+		"service-account": func() (cli.Command, error) {
+			return &helpCommand{
+				SynopsisText: helpText["service-account"][0],
+				HelpText:     helpText["service-account"][1],
+			}, nil
+		},
+
+		"service-account create": func() (cli.Command, error) {
+			return &ServiceAccountCreateCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"service-account list": func() (cli.Command, error) {
+			return &ServiceAccountListCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"service-account delete": func() (cli.Command, error) {
+			return &ServiceAccountDeleteCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"service-account token": func() (cli.Command, error) {
+			return &ServiceAccountTokenCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
 		"user": func() (cli.Command, error) {
 			return &helpCommand{
 				SynopsisText: helpText["user"][0],
@@ -555,6 +973,63 @@ func Commands(
 				baseCommand: baseCommand,
 			}, nil
 		},
+
+		"user token revoke": func() (cli.Command, error) {
+			return &UserTokenRevokeCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"user role": func() (cli.Command, error) {
+			return &helpCommand{
+				SynopsisText: helpText["user role"][0],
+				HelpText:     helpText["user role"][1],
+			}, nil
+		},
+
+		"user role grant": func() (cli.Command, error) {
+			return &UserRoleCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"user role revoke": func() (cli.Command, error) {
+			return &UserRoleCommand{
+				baseCommand: baseCommand,
+				revoke:      true,
+			}, nil
+		},
+
+		"workspace": func() (cli.Command, error) {
+			return &helpCommand{
+				SynopsisText: helpText["workspace"][0],
+				HelpText:     helpText["workspace"][1],
+			}, nil
+		},
+
+		"workspace create": func() (cli.Command, error) {
+			return &WorkspaceCreateCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"workspace list": func() (cli.Command, error) {
+			return &WorkspaceListCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"workspace inspect": func() (cli.Command, error) {
+			return &WorkspaceInspectCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+
+		"workspace delete": func() (cli.Command, error) {
+			return &WorkspaceDeleteCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
 	}
 
 	// register our aliases
@@ -786,6 +1261,18 @@ end users.
 `,
 	},
 
+	"auth-method-test": {
+		"Test an auth method configuration without saving it",
+		`
+Test an auth method configuration without saving it.
+
+This command takes the same arguments as "waypoint auth-method set" for
+each auth method type. The server validates the configuration and checks
+that the provider can be reached, reporting any problem found. Use this
+before "waypoint auth-method set" to avoid saving a broken auth method.
+`,
+	},
+
 	"config": {
 		"Application configuration management",
 		`
@@ -835,6 +1322,51 @@ For more information see: https://waypointproject.io/docs/url
 `,
 	},
 
+	"job": {
+		"Job management",
+		`
+Job management.
+
+Jobs are the operations, such as builds and deploys, that runners execute.
+These commands list and inspect the jobs of the server, cancel jobs, show
+the output of jobs and manage jobs that are queued by the server itself.
+`,
+	},
+
+	"job-schedule": {
+		"Scheduled job management",
+		`
+Scheduled job management.
+
+Job schedules queue an operation for an app on a cron schedule, such as
+a nightly "up" from Git or an hourly status report. Scheduled jobs run on
+a remote runner using the data source of the project.
+`,
+	},
+
+	"notifier": {
+		"Notification management",
+		`
+Notification management.
+
+Notifiers send a notification to Slack, a webhook, or by email when the
+health of an app changes, a deployment completes, or a job fails for an
+app of the project.
+`,
+	},
+
+	"pipeline": {
+		"Pipeline management",
+		`
+Pipeline management.
+
+Pipelines are defined in the waypoint.hcl with "pipeline" stanzas. A
+pipeline is a set of steps, each of which runs an operation such as a
+build, deploy, release, or command for an app. The server runs every step
+as a job once the steps it depends on have succeeded.
+`,
+	},
+
 	"project": {
 		"Project management",
 		`
@@ -845,6 +1377,18 @@ to a VCS repository (if one exists).
 `,
 	},
 
+	"project-template": {
+		"Project template management",
+		`
+Project template management.
+
+Project templates are waypoint.hcl skeletons with default config variables
+that are stored on the Waypoint server. Platform teams can use them to
+standardize how new applications are onboarded. Create a project from a
+template with "waypoint init -template=NAME".
+`,
+	},
+
 	"runner": {
 		"Runner management",
 		`
@@ -856,6 +1400,29 @@ entire section.
 `,
 	},
 
+	"runner-config": {
+		"Runner configuration management",
+		`
+Runner configuration management.
+
+Runner configuration is a set of environment variables and files that the
+server sends to runners, such as credentials for cloud platforms. Runners
+apply changes to the configuration without restarting.
+`,
+	},
+
+	"runner-profile": {
+		"Runner profile management",
+		`
+Runner profile management.
+
+Runner profiles configure on-demand runners. Projects that use a profile
+don't need a runner to be running for their remote jobs: the server
+launches a runner for each job with a task launcher plugin and stops it
+once the job completes.
+`,
+	},
+
 	"server": {
 		"Server management",
 		`
@@ -879,6 +1446,18 @@ accesses a Waypoint server requires a token.
 `,
 	},
 
+	"service-account": {
+		"Service account management",
+		`
+Create and manage service accounts.
+
+Service accounts are identities for automation such as CI systems. They
+have roles like users but can't log in. Tokens for a service account are
+generated with "waypoint service-account token" and stop working when the
+service account is deleted.
+`,
+	},
+
 	"user": {
 		"User information and management",
 		`
@@ -890,6 +1469,29 @@ about the currently logged in user, generate new access, and invite new
 users directly into the Waypoint server.
 
 If you are looking to log in to Waypoint, use "waypoint login".
+`,
+	},
+
+	"user role": {
+		"Manage user roles",
+		`
+Grant and revoke user roles.
+
+Roles determine which APIs a user may call. The roles are "admin",
+"operator", and "read-only". Only admins may grant and revoke roles.
+`,
+	},
+
+	"workspace": {
+		"Workspace management",
+		`
+Create, list, inspect, and delete workspaces.
+
+Workspaces separate the builds, deployments, and releases of a project,
+such as for staging and production. Workspaces are created automatically
+the first time an operation runs in them, or ahead of time with
+"waypoint workspace create". Select the workspace for an operation with
+the -workspace flag.
 `,
 	},
 }
@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type NotifierDeleteCommand struct {
+	*baseCommand
+}
+
+func (c *NotifierDeleteCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("A single notifier name is required.\n\n"+c.Help(),
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	_, err := c.project.Client().DeleteNotifier(c.Ctx, &pb.DeleteNotifierRequest{
+		Project: c.refProject,
+		Name:    c.args[0],
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	c.ui.Output("Notifier %q deleted.", c.args[0], terminal.WithSuccessStyle())
+	return 0
+}
+
+func (c *NotifierDeleteCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *NotifierDeleteCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *NotifierDeleteCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *NotifierDeleteCommand) Synopsis() string {
+	return "Delete a notifier."
+}
+
+func (c *NotifierDeleteCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint notifier delete [options] NAME
+
+  Delete a notifier of the project.
+
+` + c.Flags().Help())
+}
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type NotifierListCommand struct {
+	*baseCommand
+}
+
+func (c *NotifierListCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+	); err != nil {
+		return 1
+	}
+
+	resp, err := c.project.Client().ListNotifiers(c.Ctx, &pb.ListNotifiersRequest{
+		Project: c.refProject,
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	if len(resp.Notifiers) == 0 {
+		c.ui.Output("No notifiers found.")
+		return 0
+	}
+
+	tbl := terminal.NewTable("Name", "Type", "Destination", "Events")
+	for _, n := range resp.Notifiers {
+		dest := n.Url
+		if n.Type == pb.Notifier_EMAIL {
+			dest = strings.Join(n.EmailTo, ", ")
+		}
+
+		var colors []string
+		if n.LastError != "" {
+			colors = make([]string, 4)
+			colors[0] = terminal.Red
+		}
+
+		tbl.Rich([]string{
+			n.Name,
+			strings.ToLower(n.Type.String()),
+			dest,
+			notifierEventsString(n.Events),
+		}, colors)
+	}
+	c.ui.Table(tbl)
+
+	// Show the errors after the table since they can be long.
+	for _, n := range resp.Notifiers {
+		if n.LastError != "" {
+			c.ui.Output("")
+			c.ui.Output("The last notification of %q could not be sent: %s", n.Name, n.LastError,
+				terminal.WithErrorStyle())
+		}
+	}
+
+	return 0
+}
+
+func (c *NotifierListCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *NotifierListCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *NotifierListCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *NotifierListCommand) Synopsis() string {
+	return "List the notifiers of the project."
+}
+
+func (c *NotifierListCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint notifier list [options]
+
+  List the notifiers of the project.
+
+  If the last notification of a notifier couldn't be sent, such as when
+  the webhook URL is wrong, the error is shown after the table.
+
+` + c.Flags().Help())
+}
@@ -0,0 +1,175 @@
+package cli
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// notifierTypes maps the values of the -type flag to the types in the API.
+var notifierTypes = map[string]pb.Notifier_Type{
+	"slack":   pb.Notifier_SLACK,
+	"webhook": pb.Notifier_WEBHOOK,
+	"email":   pb.Notifier_EMAIL,
+}
+
+// notifierEvents maps the values of the -event flag to the events in the
+// API.
+var notifierEvents = map[string]pb.Notifier_Event{
+	"health-down":     pb.Notifier_HEALTH_DOWN,
+	"health-ready":    pb.Notifier_HEALTH_READY,
+	"deploy-complete": pb.Notifier_DEPLOY_COMPLETE,
+	"job-failed":      pb.Notifier_JOB_FAILED,
+}
+
+type NotifierSetCommand struct {
+	*baseCommand
+
+	flagType    string
+	flagURL     string
+	flagEmailTo []string
+	flagEvents  []string
+}
+
+func (c *NotifierSetCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("A single notifier name is required.\n\n"+c.Help(),
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	var events []pb.Notifier_Event
+	for _, e := range c.flagEvents {
+		events = append(events, notifierEvents[e])
+	}
+
+	resp, err := c.project.Client().UpsertNotifier(c.Ctx, &pb.UpsertNotifierRequest{
+		Notifier: &pb.Notifier{
+			Project: c.refProject,
+			Name:    c.args[0],
+			Type:    notifierTypes[c.flagType],
+			Url:     c.flagURL,
+			EmailTo: c.flagEmailTo,
+			Events:  events,
+		},
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	c.ui.Output("Notifier %q set.", resp.Notifier.Name, terminal.WithSuccessStyle())
+	return 0
+}
+
+func (c *NotifierSetCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		var types, events []string
+		for t := range notifierTypes {
+			types = append(types, t)
+		}
+		for e := range notifierEvents {
+			events = append(events, e)
+		}
+		sort.Strings(types)
+		sort.Strings(events)
+
+		f := set.NewSet("Command Options")
+		f.EnumSingleVar(&flag.EnumSingleVar{
+			Name:    "type",
+			Target:  &c.flagType,
+			Values:  types,
+			Default: "webhook",
+			Usage:   "Where to send notifications.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "url",
+			Target: &c.flagURL,
+			Usage: "The Slack incoming webhook URL for \"slack\" notifiers, or " +
+				"the URL that notifications are POSTed to as JSON for \"webhook\" " +
+				"notifiers.",
+		})
+
+		f.StringSliceVar(&flag.StringSliceVar{
+			Name:   "email-to",
+			Target: &c.flagEmailTo,
+			Usage: "Address to send notifications to for \"email\" notifiers. " +
+				"This can be repeated.",
+		})
+
+		f.EnumVar(&flag.EnumVar{
+			Name:   "event",
+			Target: &c.flagEvents,
+			Values: events,
+			Usage: "Event to send notifications for. This can be repeated. " +
+				"If this isn't set, all events are sent.",
+		})
+	})
+}
+
+func (c *NotifierSetCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *NotifierSetCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *NotifierSetCommand) Synopsis() string {
+	return "Create or update a notifier."
+}
+
+func (c *NotifierSetCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint notifier set [options] NAME
+
+  Create or update a notifier for the project.
+
+  The server sends a notification to the notifier when the health of a
+  deployment or release changes between READY and DOWN, when a deployment
+  completes, or when a job fails for an app of the project. Email is sent
+  with the mail server configured with "waypoint server run -smtp-addr".
+
+  Examples:
+
+    Post to a Slack channel when an app goes down or comes back:
+
+      waypoint notifier set -type=slack -url=https://hooks.slack.com/... \
+        -event=health-down -event=health-ready slack
+
+    Email the team when a job fails:
+
+      waypoint notifier set -type=email -email-to=ops@example.com \
+        -event=job-failed ops
+
+` + c.Flags().Help())
+}
+
+// notifierEventsString returns the -event flag values of the events.
+func notifierEventsString(events []pb.Notifier_Event) string {
+	if len(events) == 0 {
+		return "all"
+	}
+
+	var result []string
+	for _, e := range events {
+		result = append(result, strings.ReplaceAll(strings.ToLower(e.String()), "_", "-"))
+	}
+
+	return strings.Join(result, ", ")
+}
@@ -34,6 +34,17 @@ func WithSingleApp() Option {
 	}
 }
 
+// WithMultipleApps configures the CLI like WithSingleApp but if no app
+// is targeted, every app is targeted instead of requiring a single app.
+func WithMultipleApps() Option {
+	return func(c *baseConfig) {
+		c.AppTargetRequired = true
+		c.AppTargetMultiple = true
+		c.Config = false
+		c.Client = true
+	}
+}
+
 // WithNoConfig configures the CLI to not expect any project configuration.
 // This will not read any configuration files.
 func WithNoConfig() Option {
@@ -91,6 +102,10 @@ type baseConfig struct {
 	AppTargetRequired bool
 	UI                terminal.UI
 
+	// AppTargetMultiple is true if every app is targeted when no app
+	// target is given. See WithMultipleApps.
+	AppTargetMultiple bool
+
 	// NoAutoServer is true if an in-memory server is not allowed.
 	NoAutoServer bool
 
@@ -0,0 +1,207 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/posener/complete"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type PipelineInspectCommand struct {
+	*baseCommand
+}
+
+func (c *PipelineInspectCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("A single pipeline name or run ID is required.\n\n"+c.Help(),
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	// If the argument is the name of a pipeline, show the pipeline.
+	// Otherwise we assume it is the ID of a run.
+	for _, name := range c.cfg.PipelineNames() {
+		if name == c.args[0] {
+			return c.inspectPipeline(name)
+		}
+	}
+
+	run, err := c.project.Client().GetPipelineRun(c.Ctx, &pb.GetPipelineRunRequest{
+		Id: c.args[0],
+	})
+	if status.Code(err) == codes.NotFound {
+		c.ui.Output("No pipeline or pipeline run found for %q.", c.args[0],
+			terminal.WithErrorStyle())
+		return 1
+	}
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	return c.inspectRun(run)
+}
+
+func (c *PipelineInspectCommand) inspectPipeline(name string) int {
+	pipeline, err := c.cfg.Pipeline(name)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	c.ui.Output("Pipeline %q:", pipeline.Name, terminal.WithHeaderStyle())
+	tbl := terminal.NewTable("Step", "App", "Op", "Depends On")
+	for _, step := range pipeline.Steps {
+		op := strings.ToLower(step.Op.String())
+		if step.Op == pb.Pipeline_EXEC {
+			op += ": " + strings.Join(step.Command, " ")
+		}
+
+		tbl.Rich([]string{
+			step.Name,
+			step.Application.GetApplication(),
+			op,
+			strings.Join(step.DependsOn, ", "),
+		}, nil)
+	}
+	c.ui.Table(tbl)
+
+	runs, err := c.project.Client().ListPipelineRuns(c.Ctx, &pb.ListPipelineRunsRequest{
+		Project: c.refProject,
+		Name:    name,
+		Limit:   10,
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+	if len(runs.Runs) == 0 {
+		return 0
+	}
+
+	c.ui.Output("")
+	c.ui.Output("Recent Runs:", terminal.WithHeaderStyle())
+	tbl = terminal.NewTable("Run", "ID", "State", "Started", "Completed")
+	for _, run := range runs.Runs {
+		var started, completed string
+		if t, err := ptypes.Timestamp(run.QueueTime); err == nil {
+			started = humanize.Time(t)
+		}
+		if t, err := ptypes.Timestamp(run.CompleteTime); err == nil {
+			completed = humanize.Time(t)
+		}
+
+		tbl.Rich([]string{
+			fmt.Sprintf("%d", run.Sequence),
+			run.Id,
+			pipelineStateString(run.State),
+			started,
+			completed,
+		}, nil)
+	}
+	c.ui.Table(tbl)
+
+	return 0
+}
+
+func (c *PipelineInspectCommand) inspectRun(run *pb.PipelineRun) int {
+	var started, completed string
+	if t, err := ptypes.Timestamp(run.QueueTime); err == nil {
+		started = humanize.Time(t)
+	}
+	if t, err := ptypes.Timestamp(run.CompleteTime); err == nil {
+		completed = humanize.Time(t)
+	}
+
+	c.ui.Output("Pipeline Run Info:", terminal.WithHeaderStyle())
+	c.ui.NamedValues([]terminal.NamedValue{
+		{
+			Name: "id", Value: run.Id,
+		},
+		{
+			Name: "pipeline", Value: run.Pipeline.Name,
+		},
+		{
+			Name: "run", Value: run.Sequence,
+		},
+		{
+			Name: "workspace", Value: run.Workspace.GetWorkspace(),
+		},
+		{
+			Name: "state", Value: pipelineStateString(run.State),
+		},
+		{
+			Name: "started", Value: started,
+		},
+		{
+			Name: "completed", Value: completed,
+		},
+	}, terminal.WithInfoStyle())
+
+	c.ui.Output("")
+	tbl := terminal.NewTable("Step", "State", "Job ID", "Approved By", "Error")
+	for _, step := range run.Steps {
+		var errMsg string
+		if step.Error != nil {
+			errMsg = step.Error.Message
+		}
+
+		tbl.Rich([]string{
+			step.Name,
+			pipelineStateString(step.State),
+			step.JobId,
+			step.ApprovedBy,
+			errMsg,
+		}, nil)
+	}
+	c.ui.Table(tbl)
+
+	return 0
+}
+
+func (c *PipelineInspectCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *PipelineInspectCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *PipelineInspectCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *PipelineInspectCommand) Synopsis() string {
+	return "Show detailed information about a pipeline or a pipeline run."
+}
+
+func (c *PipelineInspectCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint pipeline inspect [options] NAME|RUN-ID
+
+  Show detailed information about a pipeline or a pipeline run.
+
+  If the argument is the name of a pipeline in the waypoint.hcl, this
+  shows the steps of the pipeline and its recent runs. Otherwise, the
+  argument is the ID of a pipeline run and this shows the state of every
+  step of the run.
+
+` + c.Flags().Help())
+}
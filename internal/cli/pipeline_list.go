@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/dustin/go-humanize"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type PipelineListCommand struct {
+	*baseCommand
+}
+
+func (c *PipelineListCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+	); err != nil {
+		return 1
+	}
+
+	client := c.project.Client()
+	resp, err := client.ListPipelines(c.Ctx, &pb.ListPipelinesRequest{
+		Project: c.refProject,
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	// Pipelines are only synced to the server when they're run, so we
+	// also include the pipelines in the configuration.
+	pipelines := map[string]*pb.Pipeline{}
+	for _, p := range resp.Pipelines {
+		pipelines[p.Name] = p
+	}
+	for _, name := range c.cfg.PipelineNames() {
+		p, err := c.cfg.Pipeline(name)
+		if err != nil {
+			c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return 1
+		}
+
+		pipelines[name] = p
+	}
+
+	if len(pipelines) == 0 {
+		c.ui.Output("No pipelines found.")
+		return 0
+	}
+
+	var names []string
+	for name := range pipelines {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tbl := terminal.NewTable("Name", "Steps", "Last Run", "State", "Started")
+	for _, name := range names {
+		var seq, state, started string
+		runs, err := client.ListPipelineRuns(c.Ctx, &pb.ListPipelineRunsRequest{
+			Project: c.refProject,
+			Name:    name,
+			Limit:   1,
+		})
+		if err != nil {
+			c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return 1
+		}
+		if len(runs.Runs) > 0 {
+			run := runs.Runs[0]
+			seq = fmt.Sprintf("%d", run.Sequence)
+			state = pipelineStateString(run.State)
+			if t, err := ptypes.Timestamp(run.QueueTime); err == nil {
+				started = humanize.Time(t)
+			}
+		}
+
+		tbl.Rich([]string{
+			name,
+			fmt.Sprintf("%d", len(pipelines[name].Steps)),
+			seq,
+			state,
+			started,
+		}, nil)
+	}
+
+	c.ui.Table(tbl)
+	return 0
+}
+
+func (c *PipelineListCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *PipelineListCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *PipelineListCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *PipelineListCommand) Synopsis() string {
+	return "List the pipelines of the project."
+}
+
+func (c *PipelineListCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint pipeline list [options]
+
+  List the pipelines of the project along with their latest run.
+
+  This includes the pipelines defined in the waypoint.hcl, even if they
+  have never been run.
+
+` + c.Flags().Help())
+}
+
+// pipelineStateString returns the state of a pipeline run or step in the
+// format we show to users.
+func pipelineStateString(s pb.PipelineRun_State) string {
+	switch s {
+	case pb.PipelineRun_PENDING:
+		return "pending"
+	case pb.PipelineRun_RUNNING:
+		return "running"
+	case pb.PipelineRun_SUCCESS:
+		return "success"
+	case pb.PipelineRun_ERROR:
+		return "error"
+	case pb.PipelineRun_SKIPPED:
+		return "skipped"
+	case pb.PipelineRun_WAITING_APPROVAL:
+		return "waiting approval"
+	default:
+		return "unknown"
+	}
+}
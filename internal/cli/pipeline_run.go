@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"time"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type PipelineRunCommand struct {
+	*baseCommand
+}
+
+func (c *PipelineRunCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("A single pipeline name is required.\n\n"+c.Help(),
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	pipeline, err := c.cfg.Pipeline(c.args[0])
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	run, err := c.project.RunPipeline(c.Ctx, pipeline)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	c.ui.Output("Running pipeline %q (run %d, ID: %s)",
+		pipeline.Name, run.Sequence, run.Id, terminal.WithHeaderStyle())
+
+	// Watch the run, outputting every step as its state changes.
+	client := c.project.Client()
+	seen := map[string]pb.PipelineRun_State{}
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		for _, step := range run.Steps {
+			if seen[step.Name] == step.State {
+				continue
+			}
+			seen[step.Name] = step.State
+
+			switch step.State {
+			case pb.PipelineRun_RUNNING:
+				c.ui.Output("Step %q started", step.Name, terminal.WithInfoStyle())
+			case pb.PipelineRun_SUCCESS:
+				c.ui.Output("Step %q succeeded", step.Name, terminal.WithSuccessStyle())
+			case pb.PipelineRun_ERROR:
+				c.ui.Output("Step %q failed: %s", step.Name, step.Error.GetMessage(),
+					terminal.WithErrorStyle())
+			case pb.PipelineRun_SKIPPED:
+				c.ui.Output("Step %q skipped", step.Name, terminal.WithWarningStyle())
+			case pb.PipelineRun_WAITING_APPROVAL:
+				c.ui.Output("Step %q is waiting for approval. Run \"waypoint approve %s\" to continue.",
+					step.Name, run.Id, terminal.WithWarningStyle())
+			}
+		}
+
+		switch run.State {
+		case pb.PipelineRun_SUCCESS:
+			c.ui.Output("Pipeline %q completed successfully.", pipeline.Name,
+				terminal.WithSuccessStyle())
+			return 0
+
+		case pb.PipelineRun_ERROR:
+			c.ui.Output("Pipeline %q failed.", pipeline.Name, terminal.WithErrorStyle())
+			return 1
+		}
+
+		select {
+		case <-ticker.C:
+		case <-c.Ctx.Done():
+			c.ui.Output("Interrupted. The pipeline run continues on the server. "+
+				"Use \"waypoint pipeline inspect %s\" to check on it.", run.Id,
+				terminal.WithWarningStyle())
+			return 1
+		}
+
+		run, err = client.GetPipelineRun(c.Ctx, &pb.GetPipelineRunRequest{Id: run.Id})
+		if err != nil {
+			c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return 1
+		}
+	}
+}
+
+func (c *PipelineRunCommand) Flags() *flag.Sets {
+	return c.flagSet(flagSetOperation, nil)
+}
+
+func (c *PipelineRunCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *PipelineRunCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *PipelineRunCommand) Synopsis() string {
+	return "Run a pipeline."
+}
+
+func (c *PipelineRunCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint pipeline run [options] NAME
+
+  Run a pipeline defined in the waypoint.hcl.
+
+  The pipeline is synced to the server and the server queues a job for
+  every step once the steps it depends on have succeeded. This waits for
+  the run to complete and shows the progress of every step. If a step
+  fails, the steps that haven't started yet are skipped.
+
+  Approval steps wait until a user approves the run with "waypoint approve".
+  If you stop watching the run, it continues on the server.
+
+` + c.Flags().Help())
+}
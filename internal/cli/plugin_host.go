@@ -0,0 +1,207 @@
+package cli
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	"github.com/hashicorp/waypoint/internal/plugin"
+)
+
+type PluginHostCommand struct {
+	*baseCommand
+
+	flagListen      string
+	flagToken       string
+	flagTLSCertFile string
+	flagTLSKeyFile  string
+}
+
+func (c *PluginHostCommand) Run(args []string) int {
+	flagSet := c.Flags()
+
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(flagSet),
+		WithNoConfig(),
+		WithClient(false),
+		WithNoAutoServer(),
+	); err != nil {
+		return 1
+	}
+	args = flagSet.Args()
+
+	if len(args) == 0 {
+		c.ui.Output("At least one plugin to host is required.\n\n"+c.Help(),
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	// Find the plugins we host. External plugins take precedence over
+	// builtin plugins just like when a runner loads plugins.
+	wd, err := os.Getwd()
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+	paths, err := plugin.DefaultPaths(wd)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	var values []terminal.NamedValue
+	launchers := map[string]func(hclog.Logger) (*plugin.RemoteLaunch, error){}
+	for _, name := range args {
+		cmd, err := plugin.Discover(&plugin.Config{Name: name}, paths)
+		if err != nil {
+			c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return 1
+		}
+
+		source := "builtin"
+		if cmd != nil {
+			source = cmd.Path
+		} else if _, ok := plugin.Builtins[name]; ok {
+			cmd = plugin.BuiltinCommand(name)
+		} else {
+			c.ui.Output(fmt.Sprintf("Plugin %q not found.", name), terminal.WithErrorStyle())
+			return 1
+		}
+
+		launchers[name] = func(log hclog.Logger) (*plugin.RemoteLaunch, error) {
+			return plugin.LaunchCommand(log, cmd)
+		}
+		values = append(values, terminal.NamedValue{Name: name, Value: source})
+	}
+
+	var ln net.Listener
+	if c.flagTLSCertFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.flagTLSCertFile, c.flagTLSKeyFile)
+		if err != nil {
+			c.ui.Output(
+				"Error loading TLS certificate: %s", clierrors.Humanize(err),
+				terminal.WithErrorStyle())
+			return 1
+		}
+
+		ln, err = tls.Listen("tcp", c.flagListen, &tls.Config{
+			Certificates: []tls.Certificate{cert},
+		})
+	} else {
+		ln, err = net.Listen("tcp", c.flagListen)
+	}
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+	defer ln.Close()
+
+	host := &plugin.RemoteHost{
+		Log:   c.Log.Named("plugin-host"),
+		Token: c.flagToken,
+		Launch: func(log hclog.Logger, name string) (*plugin.RemoteLaunch, error) {
+			launch, ok := launchers[name]
+			if !ok {
+				return nil, fmt.Errorf("plugin %q is not hosted by this plugin host", name)
+			}
+
+			return launch(log)
+		},
+	}
+
+	c.ui.Output("Plugin host listening on %s", ln.Addr().String(), terminal.WithHeaderStyle())
+	c.ui.NamedValues(values)
+
+	go func() {
+		<-c.Ctx.Done()
+		ln.Close()
+	}()
+
+	if err := host.Serve(ln); err != nil && c.Ctx.Err() == nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	return 0
+}
+
+func (c *PluginHostCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.StringVar(&flag.StringVar{
+			Name:    "listen",
+			Target:  &c.flagListen,
+			Default: ":9705",
+			Usage:   "Address to listen on for runner connections.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "token",
+			Target: &c.flagToken,
+			EnvVar: "WAYPOINT_PLUGIN_HOST_TOKEN",
+			Usage: "Token that runners must present to use the plugins. This " +
+				"is set in the host stanza of the plugin in the waypoint.hcl. " +
+				"If this isn't set, any runner that can connect can use the plugins.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "tls-cert-file",
+			Target: &c.flagTLSCertFile,
+			Usage: "Path to a PEM-encoded certificate file for TLS. If this " +
+				"isn't set, TLS is not used.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "tls-key-file",
+			Target: &c.flagTLSKeyFile,
+			Usage: "Path to a PEM-encoded private key file for the TLS certificate " +
+				"specified with -tls-cert-file.",
+		})
+	})
+}
+
+func (c *PluginHostCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *PluginHostCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *PluginHostCommand) Synopsis() string {
+	return "Run plugins for runners over the network."
+}
+
+func (c *PluginHostCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint plugin host [options] NAME...
+
+  Run plugins for runners over the network.
+
+  A plugin host runs plugins on behalf of runners instead of the runners
+  launching them. This is useful for plugins that require software that
+  can't be installed on every runner, such as a licensed SDK. Plugins are
+  found like runners find them: plugin binaries in the plugin directories
+  take precedence over builtin plugins.
+
+  Runners connect to the plugin host for plugins that have a "host" stanza
+  in the waypoint.hcl. Every operation of a runner launches a new plugin
+  process on the plugin host, which is stopped when the operation ends.
+
+  Runners authenticate with the token set with "-token". If no token is
+  set, the plugin host is unauthenticated and any runner that can connect
+  can use the plugins. TLS is only enabled if "-tls-cert-file" is set;
+  otherwise the token and plugin traffic are sent in plaintext. Set both
+  unless the plugin host is only reachable from a trusted network.
+
+` + c.Flags().Help())
+}
@@ -0,0 +1,240 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/posener/complete"
+	"golang.org/x/crypto/openpgp"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	"github.com/hashicorp/waypoint/internal/plugin"
+)
+
+type PluginInstallCommand struct {
+	*baseCommand
+
+	flagSource        string
+	flagPublicKey     string
+	flagSkipSignature bool
+	flagDir           string
+}
+
+func (c *PluginInstallCommand) Run(args []string) int {
+	flagSet := c.Flags()
+
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(flagSet),
+		WithNoConfig(),
+		WithClient(false),
+		WithNoAutoServer(),
+	); err != nil {
+		return 1
+	}
+	args = flagSet.Args()
+
+	if len(args) != 1 {
+		c.ui.Output(c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	name, version := args[0], ""
+	if idx := strings.Index(name, "@"); idx != -1 {
+		name, version = name[:idx], name[idx+1:]
+	}
+	if name == "" {
+		c.ui.Output("A plugin name is required.", terminal.WithErrorStyle())
+		return 1
+	}
+	if err := plugin.ValidateName(name); err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	dir := c.flagDir
+	if dir == "" {
+		var err error
+		dir, err = plugin.DefaultInstallDir()
+		if err != nil {
+			c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return 1
+		}
+	}
+
+	var path string
+	var err error
+	if c.flagSource == "server" {
+		path, version, err = c.installFromServer(name, version, dir)
+	} else {
+		path, version, err = c.installRelease(name, version, dir)
+	}
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	c.ui.Output("Installed plugin %q version %s to %s", name, version, path,
+		terminal.WithSuccessStyle())
+	return 0
+}
+
+// installFromServer installs the plugin from the plugin registry of the
+// server. The checksum of the binary is verified. The binary isn't signed
+// since only admins can upload binaries to the registry.
+func (c *PluginInstallCommand) installFromServer(name, version, dir string) (string, string, error) {
+	project, err := c.initClient(nil)
+	if err != nil {
+		return "", "", err
+	}
+	client := project.Client()
+
+	constraint := ">= 0"
+	if version != "" {
+		constraint = "= " + version
+	}
+
+	binary, err := plugin.ResolveBinary(c.Ctx, client, name, constraint)
+	if err != nil {
+		return "", "", err
+	}
+	if binary == nil {
+		if version == "" {
+			return "", "", fmt.Errorf(
+				"plugin %q is not in the plugin registry of the server", name)
+		}
+
+		return "", "", fmt.Errorf(
+			"plugin %q version %s is not in the plugin registry of the server", name, version)
+	}
+
+	path, err := plugin.InstallBinary(c.Ctx, client, binary, dir)
+	if err != nil {
+		return "", "", err
+	}
+
+	return path, binary.Version, nil
+}
+
+// installRelease installs the plugin from a release published on GitHub or
+// an HTTP registry. The signature of the release is verified with the
+// public key unless -skip-signature is set.
+func (c *PluginInstallCommand) installRelease(name, version, dir string) (string, string, error) {
+	source, err := plugin.ParseSource(c.flagSource)
+	if err != nil {
+		return "", "", err
+	}
+
+	var keyring openpgp.EntityList
+	switch {
+	case c.flagPublicKey != "":
+		f, err := os.Open(c.flagPublicKey)
+		if err != nil {
+			return "", "", err
+		}
+		defer f.Close()
+
+		keyring, err = openpgp.ReadArmoredKeyRing(f)
+		if err != nil {
+			return "", "", fmt.Errorf("error reading public key: %s", err)
+		}
+
+	case !c.flagSkipSignature:
+		return "", "", fmt.Errorf(
+			"a public key to verify the signature of the plugin is required. " +
+				"Specify the key with -public-key or disable the verification " +
+				"with -skip-signature.")
+	}
+
+	r, err := source.Release(c.Ctx, name, version)
+	if err != nil {
+		return "", "", err
+	}
+
+	path, err := plugin.InstallRelease(c.Ctx, c.Log, r, &plugin.InstallOptions{
+		Dir:     dir,
+		Keyring: keyring,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return path, r.Version, nil
+}
+
+func (c *PluginInstallCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.StringVar(&flag.StringVar{
+			Name:    "source",
+			Target:  &c.flagSource,
+			Default: "server",
+			Usage: "Where to install the plugin from. This is \"server\" for the " +
+				"plugin registry of the server, \"github.com/OWNER/REPO\" for the " +
+				"GitHub releases of a repository, or the URL of a plugin registry.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "public-key",
+			Target: &c.flagPublicKey,
+			Usage: "Path to an ASCII-armored PGP public key that the checksums of " +
+				"the release must be signed with. This is required unless the source " +
+				"is the server or -skip-signature is set.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "skip-signature",
+			Target: &c.flagSkipSignature,
+			Usage: "Don't verify the signature of the release. The checksum of " +
+				"the plugin is still verified.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "dir",
+			Target: &c.flagDir,
+			Usage: "Directory to install the plugin into. This defaults to the " +
+				"plugin directory in the Waypoint configuration directory.",
+		})
+	})
+}
+
+func (c *PluginInstallCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *PluginInstallCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *PluginInstallCommand) Synopsis() string {
+	return "Install a plugin."
+}
+
+func (c *PluginInstallCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint plugin install [options] NAME[@VERSION]
+
+  Install a plugin.
+
+  This downloads the plugin binary for this platform and installs it into
+  the plugin directory, where Waypoint finds it when the plugin is used by
+  a waypoint.hcl. If no version is given, the latest version is installed.
+
+  By default the plugin is installed from the plugin registry of the
+  server. Plugins can also be installed from the GitHub releases of a
+  repository or from a plugin registry served over HTTP with -source. A
+  release must include the archive "waypoint-plugin-NAME_VERSION_OS_ARCH.zip",
+  the checksums "waypoint-plugin-NAME_VERSION_SHA256SUMS", and the PGP
+  signature of the checksums "waypoint-plugin-NAME_VERSION_SHA256SUMS.sig".
+
+  Examples:
+
+    waypoint plugin install -source=github.com/acme/waypoint-plugins \
+      -public-key=acme.asc fly@0.2.0
+
+` + c.Flags().Help())
+}
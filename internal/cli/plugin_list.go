@@ -0,0 +1,238 @@
+package cli
+
+import (
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	"github.com/hashicorp/waypoint/internal/plugin"
+)
+
+type PluginListCommand struct {
+	*baseCommand
+
+	flagAll bool
+}
+
+func (c *PluginListCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithConfig(true),
+		WithClient(false),
+		WithNoAutoServer(),
+	); err != nil {
+		return 1
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	paths, err := plugin.DefaultPaths(wd)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	if c.cfg == nil || c.flagAll {
+		return c.listAll(paths)
+	}
+
+	return c.listProject(paths)
+}
+
+// listProject lists the plugins used by the project and where the runner
+// loads them from.
+func (c *PluginListCommand) listProject(paths []string) int {
+	plugins := c.cfg.Plugins()
+	if len(plugins) == 0 {
+		c.ui.Output("The project doesn't use any plugins.")
+		return 0
+	}
+
+	table := terminal.NewTable("Name", "Types", "Source")
+	for _, p := range plugins {
+		var source, color string
+		switch {
+		case p.Host != nil:
+			source = "plugin host " + p.Host.Address
+
+		case p.Version != "":
+			source = "registry " + p.Version
+
+		default:
+			cmd, err := plugin.Discover(&plugin.Config{
+				Name:     p.Name,
+				Checksum: p.Checksum,
+			}, paths)
+			if err != nil {
+				source = err.Error()
+				color = terminal.Red
+				break
+			}
+
+			if cmd != nil {
+				source = cmd.Path
+			} else if _, ok := plugin.Builtins[p.Name]; ok {
+				source = "builtin"
+			} else {
+				source = "not found"
+				color = terminal.Red
+			}
+		}
+
+		table.Rich([]string{
+			p.Name,
+			pluginTypesString(p.Types()),
+			source,
+		}, []string{"", "", color})
+	}
+
+	c.ui.Table(table)
+	return 0
+}
+
+// listAll lists the builtin plugins and the plugins in the plugin paths.
+// The plugins are launched to find the component types they implement.
+func (c *PluginListCommand) listAll(paths []string) int {
+	installed, err := plugin.ListInstalled(paths)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	// An installed plugin takes precedence over a builtin plugin with the
+	// same name, just like when the runner loads plugins.
+	sources := map[string]string{}
+	for name := range plugin.Builtins {
+		sources[name] = "builtin"
+	}
+	for _, p := range installed {
+		sources[p.Name] = p.Path
+	}
+
+	names := make([]string, 0, len(sources))
+	for name := range sources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	s := c.ui.Status()
+	s.Update("Loading plugins...")
+
+	var wg sync.WaitGroup
+	types := make([][]component.Type, len(names))
+	errs := make([]error, len(names))
+	for i, name := range names {
+		cmd := plugin.BuiltinCommand(name)
+		if sources[name] != "builtin" {
+			cmd = exec.Command(sources[name])
+		}
+
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			types[i], errs[i] = plugin.ComponentTypes(c.Log.Named(name), cmd)
+		}(i, name)
+	}
+	wg.Wait()
+	s.Close()
+
+	table := terminal.NewTable("Name", "Types", "Source")
+	for i, name := range names {
+		typesStr, color := pluginTypesString(types[i]), ""
+		if err := errs[i]; err != nil {
+			typesStr, color = "error: "+err.Error(), terminal.Red
+		}
+
+		table.Rich([]string{
+			name,
+			typesStr,
+			sources[name],
+		}, []string{"", color, ""})
+	}
+
+	c.ui.Table(table)
+	return 0
+}
+
+func (c *PluginListCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.BoolVar(&flag.BoolVar{
+			Name:   "all",
+			Target: &c.flagAll,
+			Usage: "List all builtin and installed plugins instead of the plugins " +
+				"used by the project. This launches every plugin to find the " +
+				"component types it implements.",
+		})
+	})
+}
+
+func (c *PluginListCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *PluginListCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *PluginListCommand) Synopsis() string {
+	return "List plugins and their component types."
+}
+
+func (c *PluginListCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint plugin list [options]
+
+  List plugins and their component types.
+
+  In a project directory, this lists the plugins used by the waypoint.hcl
+  and where they're loaded from: a builtin plugin, a plugin binary in the
+  plugin directories, the plugin registry of the server for plugins with
+  a version constraint, or a plugin host.
+
+  Outside of a project directory or with -all, this lists all builtin
+  plugins and the plugin binaries in the plugin directories. Plugins are
+  launched to find the component types they implement.
+
+` + c.Flags().Help())
+}
+
+// pluginTypesString returns the names of the component types as used in
+// the waypoint.hcl, such as "build, deploy".
+func pluginTypesString(types []component.Type) string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		switch t {
+		case component.BuilderType:
+			names[i] = "build"
+		case component.RegistryType:
+			names[i] = "registry"
+		case component.PlatformType:
+			names[i] = "deploy"
+		case component.ReleaseManagerType:
+			names[i] = "release"
+		case component.ConfigSourcerType:
+			names[i] = "config"
+		case component.TaskLauncherType:
+			names[i] = "task"
+		default:
+			names[i] = strings.ToLower(t.String())
+		}
+	}
+
+	return strings.Join(names, ", ")
+}
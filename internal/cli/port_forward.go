@@ -0,0 +1,186 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	clientpkg "github.com/hashicorp/waypoint/internal/client"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	"github.com/hashicorp/waypoint/internal/server/execclient"
+)
+
+type PortForwardCommand struct {
+	*baseCommand
+
+	flagInstanceId string
+	flagAddress    string
+}
+
+func (c *PortForwardCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithSingleApp(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("A port argument is required.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	local, remote, err := parsePortForward(c.args[0])
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	client := c.project.Client()
+	err = c.DoApp(c.Ctx, func(ctx context.Context, app *clientpkg.App) error {
+		pf := &execclient.PortForward{
+			Logger:  c.Log,
+			Context: ctx,
+			Client:  client,
+			Port:    int32(remote),
+		}
+
+		if c.flagInstanceId != "" {
+			if err := checkAppInstance(ctx, app, client, c.flagInstanceId); err != nil {
+				return err
+			}
+
+			pf.InstanceId = c.flagInstanceId
+		} else {
+			deployment, err := c.latestDeployment(ctx, app, client)
+			if err != nil {
+				return err
+			}
+
+			pf.DeploymentId = deployment.Id
+		}
+
+		ln, err := net.Listen("tcp", net.JoinHostPort(c.flagAddress, strconv.Itoa(local)))
+		if err != nil {
+			app.UI.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return ErrSentinel
+		}
+		defer ln.Close()
+
+		// Stop accepting connections once we're interrupted.
+		go func() {
+			<-ctx.Done()
+			ln.Close()
+		}()
+
+		app.UI.Output("Forwarding from %s to port %d of %q. Press Ctrl-C to stop.",
+			ln.Addr().String(), remote, app.Ref().Application, terminal.WithSuccessStyle())
+
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+
+				app.UI.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+				return ErrSentinel
+			}
+
+			c.Log.Debug("forwarding connection", "remote_addr", conn.RemoteAddr().String())
+			go func() {
+				if err := pf.Forward(conn); err != nil && ctx.Err() == nil {
+					app.UI.Output("Error forwarding connection: %s",
+						clierrors.Humanize(err), terminal.WithWarningStyle())
+				}
+			}()
+		}
+	})
+	if err != nil {
+		return 1
+	}
+
+	return 0
+}
+
+// parsePortForward parses a "LOCAL:REMOTE" argument into the local and
+// remote port. If only one port is given, it is used for both. A local
+// port of zero picks a free port.
+func parsePortForward(v string) (int, int, error) {
+	localRaw, remoteRaw := v, v
+	if idx := strings.Index(v, ":"); idx >= 0 {
+		localRaw, remoteRaw = v[:idx], v[idx+1:]
+	}
+
+	local, err := strconv.Atoi(localRaw)
+	if err != nil || local < 0 || local > 65535 {
+		return 0, 0, fmt.Errorf("Invalid local port %q, must be between 0 and 65535.", localRaw)
+	}
+
+	remote, err := strconv.Atoi(remoteRaw)
+	if err != nil || remote < 1 || remote > 65535 {
+		return 0, 0, fmt.Errorf("Invalid remote port %q, must be between 1 and 65535.", remoteRaw)
+	}
+
+	return local, remote, nil
+}
+
+func (c *PortForwardCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(s *flag.Sets) {
+		f := s.NewSet("Command Options")
+		f.StringVar(&flag.StringVar{
+			Name:   "instance",
+			Usage:  "Forward connections to this specific instance.",
+			Target: &c.flagInstanceId,
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:    "address",
+			Usage:   "Local address to listen on.",
+			Target:  &c.flagAddress,
+			Default: "127.0.0.1",
+		})
+	})
+}
+
+func (c *PortForwardCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *PortForwardCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *PortForwardCommand) Synopsis() string {
+	return "Forward a local port to a port of a running application instance"
+}
+
+func (c *PortForwardCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint port-forward [options] [PROJECT/APP] LOCAL:REMOTE
+
+  Forward connections to a local port to a port of a running application
+  instance.
+
+  Connections are tunneled through the server to the entrypoint of the
+  instance, which connects to the port inside the instance. This lets you
+  reach ports that aren't exposed publicly, such as debug or admin ports.
+  Only TCP is supported.
+
+  If only one port is given, it is used as both the local and the remote
+  port. A local port of 0 picks a free port. Each connection is made to a
+  random instance of the latest deployment unless "-instance" is set.
+
+  Port forwarding requires the Waypoint entrypoint in the instance and
+  is recorded like "waypoint exec" sessions.
+
+` + c.Flags().Help())
+}
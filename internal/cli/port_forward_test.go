@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePortForward(t *testing.T) {
+	cases := []struct {
+		Input  string
+		Local  int
+		Remote int
+		Error  string
+	}{
+		{"8080", 8080, 8080, ""},
+		{"9000:8080", 9000, 8080, ""},
+		{"0:8080", 0, 8080, ""},
+		{"9000:", 0, 0, "remote port"},
+		{"9000:0", 0, 0, "remote port"},
+		{"foo:8080", 0, 0, "local port"},
+		{"70000:8080", 0, 0, "local port"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Input, func(t *testing.T) {
+			require := require.New(t)
+
+			local, remote, err := parsePortForward(tt.Input)
+			if tt.Error != "" {
+				require.Error(err)
+				require.Contains(err.Error(), tt.Error)
+				return
+			}
+
+			require.NoError(err)
+			require.Equal(tt.Local, local)
+			require.Equal(tt.Remote, remote)
+		})
+	}
+}
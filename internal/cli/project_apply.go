@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	stdflag "flag"
 	"io/ioutil"
 	"path/filepath"
 	"strings"
@@ -37,6 +40,14 @@ type ProjectApplyCommand struct {
 	flagPollInterval          string
 	flagAppStatusPoll         bool
 	flagAppStatusPollInterval string
+	flagWebhook               bool
+	flagWebhookSecret         string
+	flagWebhookPreviews       bool
+	flagWebhookProviderToken  string
+	flagProjectLabels         map[string]string
+	flagMaxConcurrentJobs     int
+	flagRunnerProfile         string
+	flagRemoteOnly            bool
 }
 
 func (c *ProjectApplyCommand) Run(args []string) int {
@@ -88,6 +99,10 @@ func (c *ProjectApplyCommand) Run(args []string) int {
 	// Setup our project that we're going to override
 	var proj *pb.Project
 	var updated bool
+
+	// webhookSecret is set if we generated a webhook secret so that we
+	// can show it once the project is saved.
+	var webhookSecret string
 	if resp != nil {
 		s.Update("Updating project %q...", name)
 		updated = true
@@ -122,6 +137,12 @@ func (c *ProjectApplyCommand) Run(args []string) int {
 			return 1
 		}
 
+		proj.Labels = cfg.Labels
+		proj.RunnerLabels = cfg.Runner.TargetLabels
+		proj.OndemandRunner = runnerProfileRef(cfg.Runner.Profile)
+		proj.JobTimeouts = jobTimeouts(cfg.Runner.Timeouts)
+		proj.RemoteOnly = cfg.Runner.RemoteOnly
+
 		// Load the data source configuration
 		if dscfg := cfg.Runner.DataSource; dscfg != nil {
 			factory, ok := datasource.FromString[dscfg.Type]
@@ -182,6 +203,39 @@ func (c *ProjectApplyCommand) Run(args []string) int {
 			proj.StatusReportPoll.Interval = c.flagAppStatusPollInterval
 		}
 
+		if c.flagWebhook || c.flagWebhookSecret != "" || c.flagWebhookPreviews {
+			if proj.Webhook == nil {
+				proj.Webhook = &pb.Project_Webhook{}
+			}
+
+			proj.Webhook.Enabled = true
+			if v := c.flagWebhookSecret; v != "" {
+				proj.Webhook.Secret = v
+			}
+			if c.flagWebhookPreviews {
+				proj.Webhook.Previews = true
+			}
+			if v := c.flagWebhookProviderToken; v != "" {
+				proj.Webhook.ProviderToken = v
+			}
+
+			// Generate a secret if we don't have one so that webhooks
+			// are always verified.
+			if proj.Webhook.Secret == "" {
+				bs := make([]byte, 20)
+				if _, err := rand.Read(bs); err != nil {
+					c.ui.Output(
+						"Error generating webhook secret: %s", clierrors.Humanize(err),
+						terminal.WithErrorStyle(),
+					)
+					return 1
+				}
+
+				proj.Webhook.Secret = hex.EncodeToString(bs)
+				webhookSecret = proj.Webhook.Secret
+			}
+		}
+
 		// If the project existing datasource is Git, then we're overriding.
 		// If the existing datasource is not Git or not set, then we set it
 		// to Git and create new.
@@ -340,6 +394,33 @@ func (c *ProjectApplyCommand) Run(args []string) int {
 		proj.WaypointHclFormat = format
 	}
 
+	// Labels from flags are merged with the existing labels.
+	if len(c.flagProjectLabels) > 0 {
+		if proj.Labels == nil {
+			proj.Labels = map[string]string{}
+		}
+
+		for k, v := range c.flagProjectLabels {
+			proj.Labels[k] = v
+		}
+	}
+
+	// A negative limit means the flag wasn't set so we keep the limit.
+	if v := c.flagMaxConcurrentJobs; v >= 0 {
+		proj.MaxConcurrentJobs = uint32(v)
+	}
+
+	// The runner profile and remote only setting are only changed if their
+	// flags are set. An empty runner profile removes the profile.
+	flagSet.Visit(func(f *stdflag.Flag) {
+		switch f.Name {
+		case "runner-profile":
+			proj.OndemandRunner = runnerProfileRef(c.flagRunnerProfile)
+		case "remote-only":
+			proj.RemoteOnly = c.flagRemoteOnly
+		}
+	})
+
 	// Upsert
 	_, err = c.project.Client().UpsertProject(ctx, &pb.UpsertProjectRequest{
 		Project: proj,
@@ -359,6 +440,10 @@ func (c *ProjectApplyCommand) Run(args []string) int {
 	}
 	s.Done()
 
+	if webhookSecret != "" {
+		c.ui.Output(strings.TrimSpace(projectApplyWebhookMsg), webhookSecret, terminal.WithInfoStyle())
+	}
+
 	return 0
 }
 
@@ -388,6 +473,38 @@ func (c *ProjectApplyCommand) Flags() *flag.Sets {
 				"the waypoint.hcl file may depend on files in the source repository.",
 		})
 
+		f.StringMapVar(&flag.StringMapVar{
+			Name:   "label",
+			Target: &c.flagProjectLabels,
+			Usage: "Label to set on the project in the format key=value. Labels " +
+				"are merged with the existing labels of the project. This can be " +
+				"specified multiple times.",
+		})
+
+		f.IntVar(&flag.IntVar{
+			Name:    "max-concurrent-jobs",
+			Target:  &c.flagMaxConcurrentJobs,
+			Default: -1,
+			Usage: "The maximum number of jobs of the project that run at once. " +
+				"Additional jobs stay queued until a running job completes. Set " +
+				"to 0 to remove the limit.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "runner-profile",
+			Target: &c.flagRunnerProfile,
+			Usage: "The runner profile that launches an on-demand runner for " +
+				"each remote job of the project. Set to an empty value to run " +
+				"the jobs on the registered runners instead.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "remote-only",
+			Target: &c.flagRemoteOnly,
+			Usage: "Require the operations of the project to run on remote " +
+				"runners. Operations executed locally by the CLI are rejected.",
+		})
+
 		f.StringVar(&flag.StringVar{
 			Name:    "data-source",
 			Target:  &c.flagDataSource,
@@ -480,6 +597,40 @@ func (c *ProjectApplyCommand) Flags() *flag.Sets {
 			Default: "5m",
 			Usage:   "Interval between polling to generate status reports if polling is enabled.",
 		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:    "webhook",
+			Target:  &c.flagWebhook,
+			Default: false,
+			Usage: "Enable Git webhooks. This is only valid if a Git data source is " +
+				"supplied. A push to the repository will trigger a remote 'up' without " +
+				"waiting for polling. A secret is generated if one isn't set.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:    "webhook-secret",
+			Target:  &c.flagWebhookSecret,
+			Default: "",
+			Usage: "Secret used to verify Git webhooks. This implies -webhook. " +
+				"The same secret must be configured on the webhook in the Git provider.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:    "webhook-previews",
+			Target:  &c.flagWebhookPreviews,
+			Default: false,
+			Usage: "Enable preview environments for pull requests. This implies -webhook. " +
+				"Opening a pull request deploys it to a workspace named 'pr-<number>' " +
+				"and closing it destroys the workspace. Supported for GitHub and GitLab.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:    "webhook-provider-token",
+			Target:  &c.flagWebhookProviderToken,
+			Default: "",
+			Usage: "API token of the Git provider. If set, the URLs of preview " +
+				"environments are commented on their pull requests.",
+		})
 	})
 }
 
@@ -514,3 +665,12 @@ Usage: waypoint project apply [OPTIONS] NAME
 
 ` + c.Flags().Help())
 }
+
+const projectApplyWebhookMsg = `
+Webhooks are enabled with the generated secret below. Configure a push
+webhook in your Git provider with this secret that sends to:
+
+  https://<server address>/v1/webhook/<github|gitlab|bitbucket>
+
+Secret: %s
+`
@@ -0,0 +1,276 @@
+package cli
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/posener/complete"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	clientpkg "github.com/hashicorp/waypoint/internal/client"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type ProjectDestroyCommand struct {
+	*baseCommand
+
+	flagConfirm bool
+	flagForce   bool
+}
+
+func (c *ProjectDestroyCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("Single argument required.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	ref := &pb.Ref_Project{Project: c.args[0]}
+	client := c.project.Client()
+
+	resp, err := client.GetProject(c.Ctx, &pb.GetProjectRequest{Project: ref})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	if !c.flagConfirm {
+		ok, err := c.confirm(resp.Project.Name)
+		if err != nil {
+			c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return 1
+		}
+		if !ok {
+			c.ui.Output("Project %q was not destroyed.", resp.Project.Name)
+			return 1
+		}
+	}
+
+	if !c.flagForce {
+		for _, ws := range resp.Workspaces {
+			for _, app := range ws.Applications {
+				if err := c.destroyApp(c.Ctx, app.Application, ws.Workspace); err != nil {
+					c.ui.Output(
+						"Error destroying app %q in workspace %q: %s\n\n"+
+							"The project was not deleted. Fix the error and try again, or use "+
+							"-force to delete the project without destroying its resources.",
+						app.Application.Application, ws.Workspace.Workspace,
+						clierrors.Humanize(err),
+						terminal.WithErrorStyle(),
+					)
+					return 1
+				}
+			}
+		}
+	}
+
+	if _, err := client.DeleteProject(c.Ctx, &pb.DeleteProjectRequest{
+		Project: ref,
+		Force:   c.flagForce,
+	}); err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	c.ui.Output("Project %q deleted.", resp.Project.Name, terminal.WithSuccessStyle())
+	return 0
+}
+
+// confirm asks the user to confirm destroying the project by typing its
+// name. This fails if the UI isn't interactive.
+func (c *ProjectDestroyCommand) confirm(name string) (bool, error) {
+	if !c.ui.Interactive() {
+		return false, status.Errorf(codes.FailedPrecondition,
+			"destroying a project requires confirmation with -auto-approve")
+	}
+
+	verb := "destroy all the resources of"
+	if c.flagForce {
+		verb = "delete"
+	}
+
+	c.ui.Output("This will %s project %q. This can't be undone.", verb, name,
+		terminal.WithWarningStyle())
+	result, err := c.ui.Input(&terminal.Input{
+		Prompt: "Type the project name to confirm: ",
+		Style:  terminal.WarningStyle,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return strings.TrimSpace(result) == name, nil
+}
+
+// destroyApp destroys the resources of an app in a workspace with a
+// destroy job and deletes the hostnames registered for it. Apps with no
+// deployments or releases with resources are skipped, so they don't
+// require a runner.
+func (c *ProjectDestroyCommand) destroyApp(
+	ctx context.Context,
+	ref *pb.Ref_Application,
+	ws *pb.Ref_Workspace,
+) error {
+	client := c.project.Client()
+
+	live, err := c.hasResources(ctx, ref, ws)
+	if err != nil {
+		return err
+	}
+
+	if live {
+		c.ui.Output("Destroying app %q in workspace %q...",
+			ref.Application, ws.Workspace, terminal.WithHeaderStyle())
+
+		// The destroy job runs on a remote runner using the project's
+		// data source.
+		proj, err := clientpkg.New(ctx,
+			clientpkg.WithLogger(c.Log),
+			clientpkg.WithClient(client),
+			clientpkg.WithUI(c.ui),
+			clientpkg.WithProjectRef(&pb.Ref_Project{Project: ref.Project}),
+			clientpkg.WithWorkspaceRef(ws),
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := proj.App(ref.Application).Destroy(ctx, &pb.Job_DestroyOp{
+			Target: &pb.Job_DestroyOp_Workspace{
+				Workspace: &empty.Empty{},
+			},
+		}); err != nil {
+			return err
+		}
+	}
+
+	// Delete the hostnames. If the URL service isn't enabled there are
+	// no hostnames to delete.
+	resp, err := client.ListHostnames(ctx, &pb.ListHostnamesRequest{
+		Target: &pb.Hostname_Target{
+			Target: &pb.Hostname_Target_Application{
+				Application: &pb.Hostname_TargetApp{
+					Application: ref,
+					Workspace:   ws,
+				},
+			},
+		},
+	})
+	if status.Code(err) == codes.FailedPrecondition {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, h := range resp.Hostnames {
+		if _, err := client.DeleteHostname(ctx, &pb.DeleteHostnameRequest{
+			Hostname: h.Hostname,
+		}); err != nil {
+			return err
+		}
+
+		c.ui.Output("Deleted hostname %q", h.Fqdn)
+	}
+
+	return nil
+}
+
+// hasResources returns true if the app has deployments or releases in
+// the workspace whose resources haven't been destroyed.
+func (c *ProjectDestroyCommand) hasResources(
+	ctx context.Context,
+	ref *pb.Ref_Application,
+	ws *pb.Ref_Workspace,
+) (bool, error) {
+	client := c.project.Client()
+
+	deployments, err := client.ListDeployments(ctx, &pb.ListDeploymentsRequest{
+		Application:   ref,
+		Workspace:     ws,
+		PhysicalState: pb.Operation_CREATED,
+		Order:         &pb.OperationOrder{Limit: 1},
+	})
+	if err != nil {
+		return false, err
+	}
+	if len(deployments.Deployments) > 0 {
+		return true, nil
+	}
+
+	releases, err := client.ListReleases(ctx, &pb.ListReleasesRequest{
+		Application:   ref,
+		Workspace:     ws,
+		PhysicalState: pb.Operation_CREATED,
+		Order:         &pb.OperationOrder{Limit: 1},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return len(releases.Releases) > 0, nil
+}
+
+func (c *ProjectDestroyCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.BoolVar(&flag.BoolVar{
+			Name:   "auto-approve",
+			Target: &c.flagConfirm,
+			Usage:  "Confirm destroying the project without a prompt.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "force",
+			Target: &c.flagForce,
+			Usage: "Delete the project without destroying the resources of its " +
+				"deployments and releases. The resources must be cleaned up manually.",
+		})
+	})
+}
+
+func (c *ProjectDestroyCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ProjectDestroyCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ProjectDestroyCommand) Synopsis() string {
+	return "Destroy a project and all the resources of its applications."
+}
+
+func (c *ProjectDestroyCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint project destroy [options] NAME
+
+  Destroy a project and all the resources of its applications.
+
+  For every application and workspace with deployments or releases whose
+  resources exist, this queues a destroy job and waits for it to complete.
+  These jobs run on a remote runner, so the project must have a data
+  source such as Git configured. The hostnames of the applications are
+  deleted, and then the project is deleted from the server.
+
+  With -force, the resources aren't destroyed and the project is deleted
+  regardless. Use this if the resources were already destroyed or will be
+  cleaned up another way.
+
+  This asks for confirmation unless -auto-approve is set.
+
+` + c.Flags().Help())
+}
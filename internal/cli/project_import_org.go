@@ -0,0 +1,277 @@
+package cli
+
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/posener/complete"
+	"github.com/zclconf/go-cty/cty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	"github.com/hashicorp/waypoint/internal/pkg/githuborg"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type ProjectImportOrgCommand struct {
+	*baseCommand
+
+	flagGitHubOrg    string
+	flagGitHubURL    string
+	flagGitHubToken  string
+	flagGitUsername  string
+	flagGitPassword  string
+	flagPoll         bool
+	flagPollInterval string
+	flagDryRun       bool
+}
+
+func (c *ProjectImportOrgCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	if c.flagGitHubOrg == "" {
+		c.ui.Output("-github-org is required.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	sg := c.ui.StepGroup()
+	defer sg.Wait()
+
+	s := sg.Add("Finding repositories with a waypoint.hcl in %q...", c.flagGitHubOrg)
+	defer func() { s.Abort() }()
+
+	gh := &githuborg.Client{URL: c.flagGitHubURL, Token: c.flagGitHubToken}
+	repos, err := gh.Repos(c.Ctx, c.flagGitHubOrg)
+	if err != nil {
+		s.Update("Error listing repositories of %q", c.flagGitHubOrg)
+		s.Status(terminal.StatusError)
+		s.Done()
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	s.Update("Found %d repositories with a waypoint.hcl in %q", len(repos), c.flagGitHubOrg)
+	s.Status(terminal.StatusOK)
+	s.Done()
+
+	failed := false
+	for _, repo := range repos {
+		name := projectNameFromHcl(repo.WaypointHcl)
+		if name == "" {
+			name = repo.Name
+		}
+
+		s = sg.Add("Importing %q as project %q...", repo.Name, name)
+		if c.flagDryRun {
+			s.Update("Would import %q as project %q", repo.Name, name)
+			s.Status(terminal.StatusOK)
+			s.Done()
+			continue
+		}
+
+		updated, err := c.importRepo(repo, name)
+		if err != nil {
+			s.Update("Error importing %q as project %q: %s",
+				repo.Name, name, clierrors.Humanize(err))
+			s.Status(terminal.StatusError)
+			s.Done()
+			failed = true
+			continue
+		}
+
+		if updated {
+			s.Update("Updated project %q from %q", name, repo.Name)
+		} else {
+			s.Update("Created project %q from %q", name, repo.Name)
+		}
+		s.Status(terminal.StatusOK)
+		s.Done()
+	}
+
+	if failed {
+		return 1
+	}
+
+	return 0
+}
+
+// importRepo creates or updates the project for the repository. The Git
+// data source is set to the repository and its default branch. Other
+// settings of existing projects are kept. This returns true if the
+// project already existed.
+func (c *ProjectImportOrgCommand) importRepo(repo *githuborg.Repo, name string) (bool, error) {
+	client := c.project.Client()
+
+	proj := &pb.Project{Name: name}
+	resp, err := client.GetProject(c.Ctx, &pb.GetProjectRequest{
+		Project: &pb.Ref_Project{Project: name},
+	})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return false, err
+	}
+	updated := err == nil
+	if updated {
+		proj = resp.Project
+	}
+
+	git := &pb.Job_Git{
+		Url: repo.CloneURL,
+		Ref: repo.DefaultBranch,
+	}
+	if c.flagGitUsername != "" || c.flagGitPassword != "" {
+		git.Auth = &pb.Job_Git_Basic_{
+			Basic: &pb.Job_Git_Basic{
+				Username: c.flagGitUsername,
+				Password: c.flagGitPassword,
+			},
+		}
+	}
+
+	proj.RemoteEnabled = true
+	proj.DataSource = &pb.Job_DataSource{
+		Source: &pb.Job_DataSource_Git{Git: git},
+	}
+	if c.flagPoll {
+		proj.DataSourcePoll = &pb.Project_Poll{
+			Enabled:  true,
+			Interval: c.flagPollInterval,
+		}
+	}
+
+	if _, err := client.UpsertProject(c.Ctx, &pb.UpsertProjectRequest{
+		Project: proj,
+	}); err != nil {
+		return false, err
+	}
+
+	return updated, nil
+}
+
+// projectNameFromHcl returns the value of the project attribute of a
+// waypoint.hcl file. This returns an empty string if the file can't be
+// parsed or the project isn't a literal string.
+func projectNameFromHcl(src []byte) string {
+	f, diag := hclsyntax.ParseConfig(src, "waypoint.hcl", hcl.Pos{})
+	if diag.HasErrors() {
+		return ""
+	}
+
+	content, _, diag := f.Body.PartialContent(&hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{{Name: "project"}},
+	})
+	if diag.HasErrors() {
+		return ""
+	}
+
+	attr, ok := content.Attributes["project"]
+	if !ok {
+		return ""
+	}
+
+	v, diag := attr.Expr.Value(nil)
+	if diag.HasErrors() || v.IsNull() || !v.IsKnown() || v.Type() != cty.String {
+		return ""
+	}
+
+	return v.AsString()
+}
+
+func (c *ProjectImportOrgCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.StringVar(&flag.StringVar{
+			Name:   "github-org",
+			Target: &c.flagGitHubOrg,
+			Usage:  "GitHub organization to import projects from. Required.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:    "github-url",
+			Target:  &c.flagGitHubURL,
+			Default: githuborg.DefaultURL,
+			Usage:   "URL of the GitHub API. Set this for GitHub Enterprise.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "github-token",
+			Target: &c.flagGitHubToken,
+			EnvVar: "GITHUB_TOKEN",
+			Usage: "Token used to list the repositories of the organization. " +
+				"Without a token, only public repositories are found.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "dry-run",
+			Target: &c.flagDryRun,
+			Usage:  "Show the projects that would be imported without importing them.",
+		})
+
+		f = set.NewSet("Git Options")
+		f.StringVar(&flag.StringVar{
+			Name:   "git-username",
+			Target: &c.flagGitUsername,
+			Usage: "Username for basic auth when cloning the repositories. " +
+				"Required for private repositories.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "git-password",
+			Target: &c.flagGitPassword,
+			Usage: "Password or token for basic auth when cloning the " +
+				"repositories.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "poll",
+			Target: &c.flagPoll,
+			Usage:  "Enable polling of the repositories for changes.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:    "poll-interval",
+			Target:  &c.flagPollInterval,
+			Default: "30s",
+			Usage:   "Interval between polls if polling is enabled.",
+		})
+	})
+}
+
+func (c *ProjectImportOrgCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ProjectImportOrgCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ProjectImportOrgCommand) Synopsis() string {
+	return "Create or update projects for the repositories of a GitHub organization."
+}
+
+func (c *ProjectImportOrgCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint project import-org [options]
+
+  Create or update projects for the repositories of a GitHub organization.
+
+  Every repository of the organization with a waypoint.hcl file in the
+  root of its default branch is imported. The project is named by the
+  project setting in waypoint.hcl, or after the repository if that can't
+  be read. The project's data source is set to the repository and its
+  default branch and remote operations are enabled. Other settings of
+  existing projects are kept. Archived repositories are skipped.
+
+  Applications are registered the first time a remote operation runs for
+  the project, or with "waypoint init" in a checkout of the repository.
+
+` + c.Flags().Help())
+}
@@ -3,7 +3,6 @@ package cli
 import (
 	"sort"
 
-	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/posener/complete"
 
 	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
@@ -25,14 +24,14 @@ func (c *ProjectListCommand) Run(args []string) int {
 		return 1
 	}
 
-	resp, err := c.project.Client().ListProjects(c.Ctx, &empty.Empty{})
+	projects, err := listProjects(c.Ctx, c.project.Client())
 	if err != nil {
 		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
 		return 1
 	}
 
 	var result []string
-	for _, p := range resp.Projects {
+	for _, p := range projects {
 		result = append(result, p.Project)
 	}
 
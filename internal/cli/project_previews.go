@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/dustin/go-humanize"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type ProjectPreviewsCommand struct {
+	*baseCommand
+}
+
+func (c *ProjectPreviewsCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+	); err != nil {
+		return 1
+	}
+
+	resp, err := c.project.Client().ListPreviews(c.Ctx, &pb.ListPreviewsRequest{
+		Project: c.refProject,
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	if len(resp.Previews) == 0 {
+		c.ui.Output("No preview environments found.")
+		return 0
+	}
+
+	tbl := terminal.NewTable("Pull Request", "Workspace", "State", "Commit", "URL", "Updated", "Job ID")
+	for _, p := range resp.Previews {
+		var updated string
+		if t, err := ptypes.Timestamp(p.UpdateTime); err == nil {
+			updated = humanize.Time(t)
+		}
+
+		var colors []string
+		if p.State == pb.Preview_ERROR {
+			colors = make([]string, 7)
+			colors[2] = terminal.Red
+		}
+
+		commit := p.Commit
+		if len(commit) > 7 {
+			commit = commit[:7]
+		}
+
+		tbl.Rich([]string{
+			fmt.Sprintf("#%d", p.Number),
+			p.Workspace.Workspace,
+			previewStateString(p.State),
+			commit,
+			p.Url,
+			updated,
+			p.JobId,
+		}, colors)
+	}
+	c.ui.Table(tbl)
+
+	// Show the errors after the table since they can be long.
+	for _, p := range resp.Previews {
+		if p.State == pb.Preview_ERROR && p.Error != nil {
+			c.ui.Output("")
+			c.ui.Output("The preview of #%d failed: %s", p.Number, p.Error.Message,
+				terminal.WithErrorStyle())
+		}
+	}
+
+	return 0
+}
+
+func (c *ProjectPreviewsCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *ProjectPreviewsCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ProjectPreviewsCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ProjectPreviewsCommand) Synopsis() string {
+	return "List the preview environments of pull requests."
+}
+
+func (c *ProjectPreviewsCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint project previews [options]
+
+  List the preview environments of pull requests for the project.
+
+  Previews are created by pull request webhooks when they are enabled with
+  "waypoint project apply -webhook-previews". Each pull request is deployed
+  to its own workspace that is destroyed when the pull request closes.
+
+` + c.Flags().Help())
+}
+
+// previewStateString returns a human friendly string for a preview state.
+func previewStateString(s pb.Preview_State) string {
+	switch s {
+	case pb.Preview_DEPLOYING:
+		return "deploying"
+	case pb.Preview_READY:
+		return "ready"
+	case pb.Preview_ERROR:
+		return "error"
+	case pb.Preview_DESTROYING:
+		return "destroying"
+	case pb.Preview_DESTROYED:
+		return "destroyed"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type ProjectTemplateDeleteCommand struct {
+	*baseCommand
+}
+
+func (c *ProjectTemplateDeleteCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoAutoServer(), // templates are only useful on a shared server
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) == 0 {
+		c.ui.Output("project template name required for deletion", terminal.WithErrorStyle())
+		return 1
+	}
+
+	_, err := c.project.Client().DeleteProjectTemplate(c.Ctx, &pb.DeleteProjectTemplateRequest{
+		Template: &pb.Ref_ProjectTemplate{Name: c.args[0]},
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	c.ui.Output("Project template deleted.", terminal.WithSuccessStyle())
+	return 0
+}
+
+func (c *ProjectTemplateDeleteCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *ProjectTemplateDeleteCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ProjectTemplateDeleteCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ProjectTemplateDeleteCommand) Synopsis() string {
+	return "Delete a project template."
+}
+
+func (c *ProjectTemplateDeleteCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint project template delete NAME
+
+  Delete a project template.
+
+  Projects that were created from the template aren't changed.
+
+`)
+}
@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+)
+
+type ProjectTemplateListCommand struct {
+	*baseCommand
+}
+
+func (c *ProjectTemplateListCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoAutoServer(), // templates are only useful on a shared server
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	resp, err := c.project.Client().ListProjectTemplates(c.Ctx, &empty.Empty{})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	if len(resp.Templates) == 0 {
+		c.ui.Output("No project templates found.")
+		return 0
+	}
+
+	table := terminal.NewTable("Name", "Description", "Config")
+	for _, t := range resp.Templates {
+		var names []string
+		for name := range t.ConfigVars {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		table.Rich([]string{
+			t.Name,
+			t.Description,
+			strings.Join(names, ", "),
+		}, nil)
+	}
+
+	c.ui.Table(table)
+	return 0
+}
+
+func (c *ProjectTemplateListCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *ProjectTemplateListCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ProjectTemplateListCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ProjectTemplateListCommand) Synopsis() string {
+	return "List project templates."
+}
+
+func (c *ProjectTemplateListCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint project template list
+
+  List the project templates registered with the Waypoint server.
+
+  The config column lists the names of the config variables that are set
+  on new projects created from the template.
+
+`)
+}
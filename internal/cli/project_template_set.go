@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"io/ioutil"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type ProjectTemplateSetCommand struct {
+	*baseCommand
+
+	flagDescription string
+	flagWaypointHcl string
+	flagConfigVars  map[string]string
+}
+
+func (c *ProjectTemplateSetCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoAutoServer(), // templates are only useful on a shared server
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) == 0 {
+		c.ui.Output("name required for the project template", terminal.WithErrorStyle())
+		return 1
+	}
+
+	if c.flagWaypointHcl == "" {
+		c.ui.Output("-waypoint-hcl is required", terminal.WithErrorStyle())
+		return 1
+	}
+
+	data, err := ioutil.ReadFile(c.flagWaypointHcl)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	_, err = c.project.Client().UpsertProjectTemplate(c.Ctx, &pb.UpsertProjectTemplateRequest{
+		Template: &pb.ProjectTemplate{
+			Name:        c.args[0],
+			Description: c.flagDescription,
+			WaypointHcl: data,
+			ConfigVars:  c.flagConfigVars,
+		},
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	c.ui.Output("Project template %q set.", c.args[0], terminal.WithSuccessStyle())
+	return 0
+}
+
+func (c *ProjectTemplateSetCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.StringVar(&flag.StringVar{
+			Name:   "description",
+			Target: &c.flagDescription,
+			Usage:  "Description of the template. Optional.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "waypoint-hcl",
+			Target: &c.flagWaypointHcl,
+			Usage: "Path to the waypoint.hcl template for new projects. The name " +
+				"of the new project is available as {{.Project}}. Required.",
+		})
+
+		f.StringMapVar(&flag.StringMapVar{
+			Name:   "config",
+			Target: &c.flagConfigVars,
+			Usage: "Config variable to set on new projects, in the form " +
+				"'NAME=value'. May be specified multiple times.",
+		})
+	})
+}
+
+func (c *ProjectTemplateSetCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ProjectTemplateSetCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ProjectTemplateSetCommand) Synopsis() string {
+	return "Create or update a project template."
+}
+
+func (c *ProjectTemplateSetCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint project template set [options] NAME
+
+  Create or update a project template.
+
+  A project template is a waypoint.hcl skeleton and a set of default
+  config variables. New projects are created from a template with
+  "waypoint init -template=NAME". Changing a template doesn't change
+  projects that were already created from it.
+
+  Only admins may set project templates.
+
+` + c.Flags().Help())
+}
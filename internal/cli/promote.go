@@ -0,0 +1,191 @@
+package cli
+
+import (
+	"context"
+	"strings"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	clientpkg "github.com/hashicorp/waypoint/internal/client"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type PromoteCommand struct {
+	*baseCommand
+
+	flagFrom    string
+	flagTo      string
+	flagRelease bool
+}
+
+func (c *PromoteCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithSingleApp(),
+	); err != nil {
+		return 1
+	}
+
+	if c.flagFrom == "" || c.flagTo == "" {
+		c.ui.Output("Both -from and -to are required.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+	if strings.EqualFold(c.flagFrom, c.flagTo) {
+		c.ui.Output("The -from and -to workspaces must be different.", terminal.WithErrorStyle())
+		return 1
+	}
+
+	from := &pb.Ref_Workspace{Workspace: c.flagFrom}
+	to := &pb.Ref_Workspace{Workspace: c.flagTo}
+
+	client := c.project.Client()
+	err := c.DoApp(c.Ctx, func(ctx context.Context, app *clientpkg.App) error {
+		// Get the latest successful deployment in the source workspace
+		// along with the artifact that it deployed.
+		resp, err := client.ListDeployments(ctx, &pb.ListDeploymentsRequest{
+			Application: app.Ref(),
+			Workspace:   from,
+			Status: []*pb.StatusFilter{{
+				Filters: []*pb.StatusFilter_Filter{{
+					Filter: &pb.StatusFilter_Filter_State{
+						State: pb.Status_SUCCESS,
+					},
+				}},
+			}},
+			Order: &pb.OperationOrder{
+				Limit: 1,
+				Order: pb.OperationOrder_COMPLETE_TIME,
+				Desc:  true,
+			},
+			LoadDetails: pb.Deployment_ARTIFACT,
+		})
+		if err != nil {
+			app.UI.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return ErrSentinel
+		}
+		if len(resp.Deployments) == 0 {
+			app.UI.Output("No successful deployments found in workspace %q.",
+				c.flagFrom, terminal.WithErrorStyle())
+			return ErrSentinel
+		}
+		source := resp.Deployments[0]
+
+		artifact := source.Preload.GetArtifact()
+		if artifact == nil {
+			app.UI.Output("The artifact of deployment v%d in workspace %q could not be found.",
+				source.Sequence, c.flagFrom, terminal.WithErrorStyle())
+			return ErrSentinel
+		}
+
+		// All further operations happen in the target workspace.
+		app = app.InWorkspace(to)
+
+		app.UI.Output("Promoting deployment v%d from %q to %q...",
+			source.Sequence, c.flagFrom, c.flagTo, terminal.WithHeaderStyle())
+		result, err := app.Deploy(ctx, &pb.Job_DeployOp{
+			Artifact: artifact,
+			Promotion: &pb.Deployment_Promotion{
+				FromWorkspace: from,
+				DeploymentId:  source.Id,
+			},
+		})
+		if err != nil {
+			app.UI.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return ErrSentinel
+		}
+		deployment := result.Deployment
+
+		var releaseUrl string
+		if c.flagRelease {
+			app.UI.Output("Releasing...", terminal.WithHeaderStyle())
+			releaseResult, err := app.Release(ctx, &pb.Job_ReleaseOp{
+				Deployment: deployment,
+				Prune:      true,
+			})
+			if err != nil {
+				app.UI.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+				return ErrSentinel
+			}
+
+			if releaseResult != nil && releaseResult.Release != nil {
+				releaseUrl = releaseResult.Release.Url
+			}
+		}
+
+		app.UI.Output("")
+		app.UI.Output("Deployment v%d in %q was promoted to v%d in %q.",
+			source.Sequence, c.flagFrom, deployment.Sequence, c.flagTo,
+			terminal.WithSuccessStyle())
+		if releaseUrl != "" {
+			app.UI.Output("   Release URL: %s", releaseUrl, terminal.WithSuccessStyle())
+		}
+		if v := deployment.Preload.GetDeployUrl(); v != "" {
+			app.UI.Output("Deployment URL: https://%s", v, terminal.WithSuccessStyle())
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 1
+	}
+
+	return 0
+}
+
+func (c *PromoteCommand) Flags() *flag.Sets {
+	return c.flagSet(flagSetOperation, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.StringVar(&flag.StringVar{
+			Name:   "from",
+			Target: &c.flagFrom,
+			Usage:  "Workspace to promote the latest successful deployment from. Required.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "to",
+			Target: &c.flagTo,
+			Usage:  "Workspace to deploy the artifact to. Required.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:    "release",
+			Target:  &c.flagRelease,
+			Usage:   "Release the new deployment immediately.",
+			Default: true,
+		})
+	})
+}
+
+func (c *PromoteCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *PromoteCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *PromoteCommand) Synopsis() string {
+	return "Promote a deployment from one workspace to another"
+}
+
+func (c *PromoteCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint promote [options] -from=WORKSPACE -to=WORKSPACE [PROJECT/APP]
+
+  Promote the latest successful deployment of an application from one
+  workspace to another.
+
+  The artifact of the latest successful deployment in the "-from" workspace
+  is deployed to the "-to" workspace without building it again. The new
+  deployment records the deployment and workspace it was promoted from.
+
+  The application can be given as PROJECT/APP to run the promotion
+  remotely, otherwise the waypoint.hcl in the current directory is used.
+
+` + c.Flags().Help())
+}
@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	clientpkg "github.com/hashicorp/waypoint/internal/client"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type ReleaseAbortCommand struct {
+	*baseCommand
+}
+
+func (c *ReleaseAbortCommand) Run(args []string) int {
+	defer c.Close()
+
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithSingleApp(),
+	); err != nil {
+		return 1
+	}
+
+	client := c.project.Client()
+	err := c.DoApp(c.Ctx, func(ctx context.Context, app *clientpkg.App) error {
+		release, err := activeCanaryRelease(ctx, client, app, c.project.WorkspaceRef())
+		if err != nil {
+			app.UI.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return ErrSentinel
+		}
+
+		// Send all traffic back to the deployment that was released
+		// before the canary.
+		deploy, err := client.GetDeployment(ctx, &pb.GetDeploymentRequest{
+			Ref: &pb.Ref_Operation{
+				Target: &pb.Ref_Operation_Id{Id: release.Canary.PreviousDeploymentId},
+			},
+		})
+		if err != nil {
+			app.UI.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return ErrSentinel
+		}
+
+		app.UI.Output("Aborting canary, releasing deployment v%d to all traffic...",
+			deploy.Sequence, terminal.WithHeaderStyle())
+		if _, err := app.Release(ctx, &pb.Job_ReleaseOp{
+			Deployment: deploy,
+		}); err != nil {
+			app.UI.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return ErrSentinel
+		}
+
+		app.UI.Output("\nCanary aborted. Deployment v%d is released to all traffic.",
+			deploy.Sequence, terminal.WithSuccessStyle())
+		return nil
+	})
+	if err != nil {
+		return 1
+	}
+
+	return 0
+}
+
+func (c *ReleaseAbortCommand) Flags() *flag.Sets {
+	return c.flagSet(flagSetOperation, nil)
+}
+
+func (c *ReleaseAbortCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ReleaseAbortCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ReleaseAbortCommand) Synopsis() string {
+	return "Send all traffic back to the deployment released before the canary"
+}
+
+func (c *ReleaseAbortCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint release abort [options]
+
+  Abort the active canary release.
+
+  All traffic is sent back to the deployment that was released before the
+  canary. The canary deployment is kept so it can be inspected or
+  destroyed with "waypoint deployment destroy".
+
+` + c.Flags().Help())
+}
@@ -19,10 +19,14 @@ import (
 type ReleaseCreateCommand struct {
 	*baseCommand
 
-	flagRepeat      bool
-	flagDeployment  string
-	flagPrune       bool
-	flagPruneRetain int
+	flagRepeat         bool
+	flagDeployment     string
+	flagPrune          bool
+	flagPruneRetain    int
+	flagPercentage     uint
+	flagVerify         bool
+	flagVerifyWindow   string
+	flagVerifyInterval string
 }
 
 func (c *ReleaseCreateCommand) Run(args []string) int {
@@ -37,6 +41,16 @@ func (c *ReleaseCreateCommand) Run(args []string) int {
 		return 1
 	}
 
+	if c.flagPercentage > 100 {
+		c.ui.Output("-percentage must be between 0 and 100.", terminal.WithErrorStyle())
+		return 1
+	}
+	canary := c.flagPercentage > 0 && c.flagPercentage < 100
+	if canary && c.flagVerify {
+		c.ui.Output("-verify can't be used with -percentage.", terminal.WithErrorStyle())
+		return 1
+	}
+
 	client := c.project.Client()
 	err := c.DoApp(c.Ctx, func(ctx context.Context, app *clientpkg.App) error {
 		// Get the latest release
@@ -107,8 +121,11 @@ func (c *ReleaseCreateCommand) Run(args []string) int {
 			}
 		}
 
-		// If the latest release already deployed this then we're done.
-		if release != nil && release.DeploymentId == deploy.Id {
+		// If the latest release already deployed this then we're done. Canaries
+		// change the traffic split so they're never up to date.
+		activeCanary := release != nil && release.Canary != nil &&
+			release.Canary.Phase == pb.Release_Canary_ACTIVE
+		if release != nil && release.DeploymentId == deploy.Id && !canary && !activeCanary {
 			if c.flagRepeat {
 				c.Log.Warn("deployment already released but -repeat specified, will re-release")
 			} else {
@@ -127,11 +144,26 @@ func (c *ReleaseCreateCommand) Run(args []string) int {
 		}
 
 		// UI
-		app.UI.Output("Releasing...", terminal.WithHeaderStyle())
+		if canary {
+			app.UI.Output("Releasing to %d%% of traffic...", c.flagPercentage,
+				terminal.WithHeaderStyle())
+		} else {
+			app.UI.Output("Releasing...", terminal.WithHeaderStyle())
+		}
 
 		// Release
+		var verify *pb.Job_ReleaseOp_Verify
+		if c.flagVerify {
+			verify = &pb.Job_ReleaseOp_Verify{
+				Window:   c.flagVerifyWindow,
+				Interval: c.flagVerifyInterval,
+			}
+		}
+
 		result, err := app.Release(ctx, &pb.Job_ReleaseOp{
 			Deployment: deploy,
+			Percentage: uint32(c.flagPercentage),
+			Verify:     verify,
 
 			Prune:               c.flagPrune,
 			PruneRetain:         int32(c.flagPruneRetain),
@@ -216,6 +248,39 @@ func (c *ReleaseCreateCommand) Flags() *flag.Sets {
 				"all unreleased deployments, set this to 0.",
 			Default: -1,
 		})
+
+		f.UintVar(&flag.UintVar{
+			Name:   "percentage",
+			Target: &c.flagPercentage,
+			Usage: "Release the deployment to this percentage of traffic as a " +
+				"canary. The rest of the traffic stays on the currently released " +
+				"deployment. Requires a release manager that supports canaries. " +
+				"Use \"waypoint release promote\" or \"waypoint release abort\" " +
+				"to end the canary.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "verify",
+			Target: &c.flagVerify,
+			Usage: "Verify the health of the deployment with its status report " +
+				"before switching traffic to it, and the health of the release " +
+				"after. If the release is still DOWN after -verify-window, " +
+				"traffic is switched back to the previous deployment.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:    "verify-window",
+			Target:  &c.flagVerifyWindow,
+			Default: "1m",
+			Usage:   "How long to wait for the deployment and release to become healthy.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:    "verify-interval",
+			Target:  &c.flagVerifyInterval,
+			Default: "10s",
+			Usage:   "Time between status reports while verifying.",
+		})
 	})
 }
 
@@ -240,6 +305,17 @@ Usage: waypoint release [options] [id]
   This defaults to the latest deployment. Other deployments can be
   specified by sequence number or long ID.
 
+  With -percentage, the deployment is released as a canary and only gets
+  the given percentage of traffic. Running this again with a different
+  percentage changes the traffic split. Old deployments aren't pruned
+  while a canary is active.
+
+  With -verify, the release is a blue/green release. The deployment must be
+  healthy before traffic is switched to it. If the release isn't healthy
+  within the verify window, traffic is switched back to the previously
+  released deployment and the release is marked as failed. Verification
+  requires plugins that generate status reports.
+
 ` + c.Flags().Help())
 }
 
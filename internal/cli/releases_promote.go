@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/posener/complete"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	clientpkg "github.com/hashicorp/waypoint/internal/client"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type ReleasePromoteCommand struct {
+	*baseCommand
+}
+
+func (c *ReleasePromoteCommand) Run(args []string) int {
+	defer c.Close()
+
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithSingleApp(),
+	); err != nil {
+		return 1
+	}
+
+	client := c.project.Client()
+	err := c.DoApp(c.Ctx, func(ctx context.Context, app *clientpkg.App) error {
+		release, err := activeCanaryRelease(ctx, client, app, c.project.WorkspaceRef())
+		if err != nil {
+			app.UI.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return ErrSentinel
+		}
+
+		deploy, err := client.GetDeployment(ctx, &pb.GetDeploymentRequest{
+			Ref: &pb.Ref_Operation{
+				Target: &pb.Ref_Operation_Id{Id: release.DeploymentId},
+			},
+		})
+		if err != nil {
+			app.UI.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return ErrSentinel
+		}
+
+		app.UI.Output("Promoting deployment v%d to all traffic...", deploy.Sequence,
+			terminal.WithHeaderStyle())
+		result, err := app.Release(ctx, &pb.Job_ReleaseOp{
+			Deployment: deploy,
+			Prune:      true,
+		})
+		if err != nil {
+			app.UI.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return ErrSentinel
+		}
+
+		app.UI.Output("\nDeployment v%d is released to all traffic.", deploy.Sequence,
+			terminal.WithSuccessStyle())
+		if result.Release.Url != "" {
+			app.UI.Output("Release URL: %s", result.Release.Url, terminal.WithSuccessStyle())
+		}
+		return nil
+	})
+	if err != nil {
+		return 1
+	}
+
+	return 0
+}
+
+// activeCanaryRelease returns the latest release of the app if it is a
+// canary that hasn't been promoted or aborted yet.
+func activeCanaryRelease(
+	ctx context.Context,
+	client pb.WaypointClient,
+	app *clientpkg.App,
+	ws *pb.Ref_Workspace,
+) (*pb.Release, error) {
+	release, err := client.GetLatestRelease(ctx, &pb.GetLatestReleaseRequest{
+		Application: app.Ref(),
+		Workspace:   ws,
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"the application has no releases")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if release.Canary == nil || release.Canary.Phase != pb.Release_Canary_ACTIVE {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"the latest release is not an active canary release")
+	}
+
+	return release, nil
+}
+
+func (c *ReleasePromoteCommand) Flags() *flag.Sets {
+	return c.flagSet(flagSetOperation, nil)
+}
+
+func (c *ReleasePromoteCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ReleasePromoteCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ReleasePromoteCommand) Synopsis() string {
+	return "Release the active canary deployment to all traffic"
+}
+
+func (c *ReleasePromoteCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint release promote [options]
+
+  Release the active canary deployment to all traffic.
+
+  The canary must have been created with "waypoint release -percentage".
+  After the canary is promoted, old unreleased deployments are pruned.
+
+` + c.Flags().Help())
+}
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type RunnerAdoptCommand struct {
+	*baseCommand
+}
+
+func (c *RunnerAdoptCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoAutoServer(), // local runners never need to be adopted
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) == 0 {
+		c.ui.Output("runner ID required for adoption", terminal.WithErrorStyle())
+		return 1
+	}
+
+	_, err := c.project.Client().AdoptRunner(c.Ctx, &pb.AdoptRunnerRequest{
+		RunnerId: c.args[0],
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	c.ui.Output("Runner adopted.", terminal.WithSuccessStyle())
+	return 0
+}
+
+func (c *RunnerAdoptCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *RunnerAdoptCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *RunnerAdoptCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *RunnerAdoptCommand) Synopsis() string {
+	return "Adopt a runner so that it is assigned jobs."
+}
+
+func (c *RunnerAdoptCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint runner adopt ID
+
+  Adopt a runner so that it is assigned jobs.
+
+  If the server is run with "-require-runner-adoption", new runners wait
+  to be adopted before they're assigned jobs. The ID of a waiting runner
+  is in its logs. The runner doesn't have to be connected, so runners can
+  also be adopted before they're started with "waypoint runner agent -id".
+
+  The adoption is kept when the runner reconnects. Use "waypoint runner
+  reject" to revoke it. This requires the admin role.
+
+`)
+}
@@ -17,6 +17,7 @@ import (
 	runnerpkg "github.com/hashicorp/waypoint/internal/runner"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 	"github.com/hashicorp/waypoint/internal/serverclient"
+	"github.com/hashicorp/waypoint/internal/telemetry"
 )
 
 type RunnerAgentCommand struct {
@@ -30,6 +31,21 @@ type RunnerAgentCommand struct {
 	// Specifies an address to setup a noop TCP server on that can be
 	// used for liveness probes.
 	flagLivenessTCPAddr string
+
+	// The number of jobs the runner executes at once.
+	flagMaxConcurrentJobs uint
+
+	// The labels of the runner that jobs can target.
+	flagLabels map[string]string
+
+	// The ID of the runner and whether it runs a single job that targets
+	// that ID. This is set for on-demand runners launched by the server.
+	flagId       string
+	flagOnDemand bool
+
+	// The OTLP endpoint that the spans of jobs are exported to.
+	flagOTLPEndpoint string
+	flagOTLPInsecure bool
 }
 
 func (c *RunnerAgentCommand) Run(args []string) int {
@@ -47,6 +63,30 @@ func (c *RunnerAgentCommand) Run(args []string) int {
 		return 1
 	}
 
+	if c.flagOnDemand && c.flagId == "" {
+		c.ui.Output("-ondemand requires -id to be set.\n\n"+c.Help(),
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	// Export the spans of jobs if tracing is configured. This replaces
+	// the tracing configuration of the CLI.
+	if c.flagOTLPEndpoint != "" {
+		shutdown, err := telemetry.Init(ctx, telemetry.Config{
+			ServiceName: "waypoint-runner",
+			Endpoint:    c.flagOTLPEndpoint,
+			Insecure:    c.flagOTLPInsecure,
+		})
+		if err != nil {
+			c.ui.Output(
+				"Error setting up tracing: %s", err.Error(),
+				terminal.WithErrorStyle(),
+			)
+			return 1
+		}
+		defer shutdown(context.Background())
+	}
+
 	// Connect to the server
 	log.Info("sourcing credentials and connecting to the Waypoint server")
 	conn, err := serverclient.Connect(ctx,
@@ -98,11 +138,20 @@ func (c *RunnerAgentCommand) Run(args []string) int {
 
 	// Create our runner
 	log.Info("initializing the runner")
-	runner, err := runnerpkg.New(
+	opts := []runnerpkg.Option{
 		runnerpkg.WithClient(client),
 		runnerpkg.WithLogger(log.Named("runner")),
 		runnerpkg.WithDynamicConfig(c.flagDynConfig),
-	)
+		runnerpkg.WithMaxConcurrentJobs(uint32(c.flagMaxConcurrentJobs)),
+		runnerpkg.WithLabels(c.flagLabels),
+	}
+	if c.flagId != "" {
+		opts = append(opts, runnerpkg.WithId(c.flagId))
+	}
+	if c.flagOnDemand {
+		opts = append(opts, runnerpkg.ByIdOnly())
+	}
+	runner, err := runnerpkg.New(opts...)
 	if err != nil {
 		c.ui.Output(
 			"Error initializing the runner: %s", err.Error(),
@@ -151,36 +200,27 @@ func (c *RunnerAgentCommand) Run(args []string) int {
 		}()
 	}
 
-	// Accept jobs in goroutine so that we can interrupt it.
+	// Accept jobs in goroutines so that we can interrupt them. We run one
+	// accept loop per concurrent job. If any loop exits, the runner stops.
 	ctx, cancel := context.WithCancel(ctx)
-	go func() {
-		defer cancel()
-
-		for {
-			if err := runner.Accept(ctx); err != nil {
-				if err == runnerpkg.ErrClosed {
-					return
-				}
-
+	defer cancel()
+	if c.flagMaxConcurrentJobs == 0 {
+		c.flagMaxConcurrentJobs = 1
+	}
+	if c.flagOnDemand {
+		// On-demand runners run the job they were launched for and exit.
+		// The server stops the task of the runner afterwards.
+		go func() {
+			defer cancel()
+			if err := runner.Accept(ctx); err != nil && err != runnerpkg.ErrClosed {
 				log.Error("error running job", "err", err)
-
-				switch status.Code(err) {
-				case codes.NotFound:
-					// This error code means that the runner is deregistered.
-					// There is no recover from this and we have to restart
-					// the runner.
-					log.Error("runner unexpectedly deregistered, exiting")
-					return
-
-				case codes.Unavailable:
-					// Server became unavailable. We retry on this after
-					// a short sleep to allow the server to come back online.
-					log.Warn("server unavailable, sleeping before retry")
-					time.Sleep(2 * time.Second)
-				}
 			}
+		}()
+	} else {
+		for i := uint(0); i < c.flagMaxConcurrentJobs; i++ {
+			go c.acceptLoop(ctx, cancel, log, runner)
 		}
-	}()
+	}
 
 	// Wait for end. This ends either via an interrupt (parent context)
 	// or via the runner accept loop erroring in some way.
@@ -196,6 +236,42 @@ func (c *RunnerAgentCommand) Run(args []string) int {
 	return 0
 }
 
+// acceptLoop accepts and executes jobs one at a time until the runner is
+// closed or can't continue. cancel is called when this returns.
+func (c *RunnerAgentCommand) acceptLoop(
+	ctx context.Context,
+	cancel context.CancelFunc,
+	log hclog.Logger,
+	runner *runnerpkg.Runner,
+) {
+	defer cancel()
+
+	for {
+		if err := runner.Accept(ctx); err != nil {
+			if err == runnerpkg.ErrClosed {
+				return
+			}
+
+			log.Error("error running job", "err", err)
+
+			switch status.Code(err) {
+			case codes.NotFound:
+				// This error code means that the runner is deregistered.
+				// There is no recover from this and we have to restart
+				// the runner.
+				log.Error("runner unexpectedly deregistered, exiting")
+				return
+
+			case codes.Unavailable:
+				// Server became unavailable. We retry on this after
+				// a short sleep to allow the server to come back online.
+				log.Warn("server unavailable, sleeping before retry")
+				time.Sleep(2 * time.Second)
+			}
+		}
+	}
+}
+
 func (c *RunnerAgentCommand) Flags() *flag.Sets {
 	return c.flagSet(0, func(set *flag.Sets) {
 		f := set.NewSet("Command Options")
@@ -212,6 +288,53 @@ func (c *RunnerAgentCommand) Flags() *flag.Sets {
 				"address when it is running. This can be used as a liveness probe " +
 				"endpoint. The TCP server serves no other purpose.",
 		})
+
+		f.UintVar(&flag.UintVar{
+			Name:    "max-concurrent-jobs",
+			Target:  &c.flagMaxConcurrentJobs,
+			Default: 1,
+			Usage: "The number of jobs that the runner executes at once. The server " +
+				"won't assign the runner more jobs than this.",
+		})
+
+		f.StringMapVar(&flag.StringMapVar{
+			Name:   "label",
+			Target: &c.flagLabels,
+			Usage: "Labels of the runner, such as 'env=prod'. Jobs that target " +
+				"runners by label only run on runners with all of their labels. " +
+				"Can be specified multiple times.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "id",
+			Target: &c.flagId,
+			Usage: "The ID of the runner. If this isn't set, a unique ID is " +
+				"generated.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "ondemand",
+			Target: &c.flagOnDemand,
+			Usage: "Run the single job that targets the runner by ID and exit. " +
+				"This is used by the on-demand runners that the server launches " +
+				"and requires -id.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "otlp-endpoint",
+			Target: &c.flagOTLPEndpoint,
+			EnvVar: telemetry.EnvOTLPEndpoint,
+			Usage: "OTLP gRPC endpoint, such as an OpenTelemetry collector, " +
+				"to export the trace spans of jobs to. If this isn't set, spans " +
+				"aren't exported.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "otlp-insecure",
+			Target: &c.flagOTLPInsecure,
+			EnvVar: telemetry.EnvOTLPInsecure,
+			Usage:  "Connect to the OTLP endpoint without TLS.",
+		})
 	})
 }
 
@@ -233,5 +356,15 @@ Usage: waypoint runner agent [options]
 
   Run a runner for executing remote operations.
 
+  Runners can be given labels with -label. Projects that set "target_labels"
+  in the runner configuration of their waypoint.hcl only run their remote
+  jobs on runners with all of those labels. For example, a runner started
+  with "-label env=prod" inside a production network runs the jobs of
+  projects that target "env=prod".
+
+  The server launches on-demand runners with -ondemand for the jobs of
+  projects that use an on-demand runner configuration. See "waypoint
+  runner profile" for more information.
+
 ` + c.Flags().Help())
 }
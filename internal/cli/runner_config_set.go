@@ -0,0 +1,160 @@
+package cli
+
+import (
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type RunnerConfigSetCommand struct {
+	*baseCommand
+
+	flagId    string
+	flagFiles map[string]string
+}
+
+func (c *RunnerConfigSetCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoAutoServer(), // runner configuration is only for remote runners
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) == 0 && len(c.flagFiles) == 0 {
+		c.ui.Output("at least one NAME=VALUE entry or -file is required.\n\n"+c.Help(),
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	ref := &pb.Ref_Runner{
+		Target: &pb.Ref_Runner_Any{
+			Any: &pb.Ref_RunnerAny{},
+		},
+	}
+	if c.flagId != "" {
+		ref = &pb.Ref_Runner{
+			Target: &pb.Ref_Runner_Id{
+				Id: &pb.Ref_RunnerId{Id: c.flagId},
+			},
+		}
+	}
+
+	var req pb.ConfigSetRequest
+	for _, arg := range c.args {
+		idx := strings.IndexByte(arg, '=')
+		if idx == -1 || idx == 0 {
+			c.ui.Output("variables must be in the form NAME=VALUE", terminal.WithErrorStyle())
+			return 1
+		}
+
+		req.Variables = append(req.Variables, &pb.ConfigVar{
+			Scope: &pb.ConfigVar_Runner{Runner: ref},
+			Name:  arg[:idx],
+			Value: &pb.ConfigVar_Static{
+				Static: arg[idx+1:],
+			},
+		})
+	}
+
+	// Sort the files so that errors are reported in a stable order.
+	paths := make([]string, 0, len(c.flagFiles))
+	for path := range c.flagFiles {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		// An empty local path unsets the file.
+		var data []byte
+		if local := c.flagFiles[path]; local != "" {
+			var err error
+			data, err = ioutil.ReadFile(local)
+			if err != nil {
+				c.ui.Output("Error reading file %q: %s", local, err, terminal.WithErrorStyle())
+				return 1
+			}
+		}
+
+		req.Variables = append(req.Variables, &pb.ConfigVar{
+			Scope:      &pb.ConfigVar_Runner{Runner: ref},
+			Name:       path,
+			NameIsPath: true,
+			Value: &pb.ConfigVar_Static{
+				Static: string(data),
+			},
+		})
+	}
+
+	if _, err := c.project.Client().SetConfig(c.Ctx, &req); err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	c.ui.Output("Runner configuration set.", terminal.WithSuccessStyle())
+	return 0
+}
+
+func (c *RunnerConfigSetCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.StringVar(&flag.StringVar{
+			Name:   "id",
+			Target: &c.flagId,
+			Usage: "The ID of the runner to set the configuration for. If this " +
+				"isn't set, the configuration is set for all runners.",
+		})
+
+		f.StringMapVar(&flag.StringMapVar{
+			Name:   "file",
+			Target: &c.flagFiles,
+			Usage: "A file to write on the runners, in the form " +
+				"'RUNNER_PATH=LOCAL_PATH'. The contents of the local file are " +
+				"written to the absolute path on the runners. An empty local path " +
+				"deletes the file. Can be specified multiple times.",
+		})
+	})
+}
+
+func (c *RunnerConfigSetCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *RunnerConfigSetCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *RunnerConfigSetCommand) Synopsis() string {
+	return "Set environment variables and files on runners."
+}
+
+func (c *RunnerConfigSetCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint runner config set [options] [NAME=VALUE...]
+
+  Set environment variables and files on runners.
+
+  The configuration is stored on the server and sent to the runners when
+  they register and whenever it changes, so plugins can get credentials
+  such as cloud provider keys without baking them into runner images.
+  The configuration is never exposed to deployed applications.
+
+  Environment variables are set with NAME=VALUE arguments. An empty value
+  unsets the variable. Files are set with "-file", for example:
+
+      waypoint runner config set -file /root/.aws/credentials=./credentials
+
+  Use "waypoint config get -runner" to see the configuration.
+
+` + c.Flags().Help())
+}
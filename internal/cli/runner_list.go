@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+)
+
+type RunnerListCommand struct {
+	*baseCommand
+}
+
+func (c *RunnerListCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoAutoServer(), // local runners only exist while the CLI runs
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	resp, err := c.project.Client().ListRunners(c.Ctx, &empty.Empty{})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	if len(resp.Runners) == 0 {
+		c.ui.Output("No runners found.")
+		return 0
+	}
+
+	table := terminal.NewTable("ID", "State", "Version", "Labels", "Last Seen", "Jobs")
+	for _, r := range resp.Runners {
+		var labels []string
+		for k, v := range r.Runner.Labels {
+			labels = append(labels, k+"="+v)
+		}
+		sort.Strings(labels)
+
+		var lastSeen string
+		if t, err := ptypes.Timestamp(r.Runner.LastSeen); err == nil {
+			lastSeen = humanize.Time(t)
+		}
+
+		table.Rich([]string{
+			r.Runner.Id,
+			strings.ToLower(r.Runner.AdoptionState.String()),
+			r.Runner.Version,
+			strings.Join(labels, ", "),
+			lastSeen,
+			strings.Join(r.JobIds, ", "),
+		}, nil)
+	}
+
+	c.ui.Table(table)
+	return 0
+}
+
+func (c *RunnerListCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *RunnerListCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *RunnerListCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *RunnerListCommand) Synopsis() string {
+	return "List the registered runners."
+}
+
+func (c *RunnerListCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint runner list
+
+  List the registered runners.
+
+  This shows the adoption state, version, and labels of each runner, the
+  last time the server heard from it, and the jobs it is running. Runners
+  send a heartbeat to the server periodically. If a runner stops sending
+  heartbeats or disconnects, it is removed from the list and the jobs it
+  was running fail.
+
+`)
+}
@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type RunnerProfileDeleteCommand struct {
+	*baseCommand
+}
+
+func (c *RunnerProfileDeleteCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoAutoServer(), // on-demand runners are launched by a shared server
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) == 0 {
+		c.ui.Output("runner profile name required for deletion", terminal.WithErrorStyle())
+		return 1
+	}
+
+	_, err := c.project.Client().DeleteOnDemandRunnerConfig(c.Ctx, &pb.DeleteOnDemandRunnerConfigRequest{
+		Config: &pb.Ref_OnDemandRunnerConfig{Name: c.args[0]},
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	c.ui.Output("Runner profile deleted.", terminal.WithSuccessStyle())
+	return 0
+}
+
+func (c *RunnerProfileDeleteCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *RunnerProfileDeleteCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *RunnerProfileDeleteCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *RunnerProfileDeleteCommand) Synopsis() string {
+	return "Delete a runner profile."
+}
+
+func (c *RunnerProfileDeleteCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint runner profile delete NAME
+
+  Delete a runner profile.
+
+  Jobs of projects that use the profile fail to queue until the projects
+  use another profile. Runners that were already launched are still
+  stopped once their jobs complete.
+
+`)
+}
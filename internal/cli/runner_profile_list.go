@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+)
+
+type RunnerProfileListCommand struct {
+	*baseCommand
+}
+
+func (c *RunnerProfileListCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoAutoServer(), // on-demand runners are launched by a shared server
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	resp, err := c.project.Client().ListOnDemandRunnerConfigs(c.Ctx, &empty.Empty{})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	if len(resp.Configs) == 0 {
+		c.ui.Output("No runner profiles found.")
+		return 0
+	}
+
+	table := terminal.NewTable("Name", "Plugin Type", "OCI Url", "Target Labels")
+	for _, p := range resp.Configs {
+		var labels []string
+		for k, v := range p.TargetLabels {
+			labels = append(labels, k+"="+v)
+		}
+		sort.Strings(labels)
+
+		table.Rich([]string{
+			p.Name,
+			p.PluginType,
+			p.OciUrl,
+			strings.Join(labels, ", "),
+		}, nil)
+	}
+
+	c.ui.Table(table)
+	return 0
+}
+
+func (c *RunnerProfileListCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *RunnerProfileListCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *RunnerProfileListCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *RunnerProfileListCommand) Synopsis() string {
+	return "List runner profiles."
+}
+
+func (c *RunnerProfileListCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint runner profile list
+
+  List the runner profiles registered with the Waypoint server.
+
+  An empty OCI url means that on-demand runners use the default Waypoint
+  image.
+
+`)
+}
@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type RunnerProfileSetCommand struct {
+	*baseCommand
+
+	flagOCIUrl       string
+	flagPluginType   string
+	flagPluginConfig string
+	flagEnvVars      map[string]string
+	flagTargetLabels map[string]string
+}
+
+func (c *RunnerProfileSetCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoAutoServer(), // on-demand runners are launched by a shared server
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) == 0 {
+		c.ui.Output("name required for the runner profile", terminal.WithErrorStyle())
+		return 1
+	}
+
+	if c.flagPluginType == "" {
+		c.ui.Output("-plugin-type is required", terminal.WithErrorStyle())
+		return 1
+	}
+
+	config := &pb.OnDemandRunnerConfig{
+		Name:                 c.args[0],
+		OciUrl:               c.flagOCIUrl,
+		EnvironmentVariables: c.flagEnvVars,
+		PluginType:           c.flagPluginType,
+		TargetLabels:         c.flagTargetLabels,
+	}
+
+	if c.flagPluginConfig != "" {
+		data, err := ioutil.ReadFile(c.flagPluginConfig)
+		if err != nil {
+			c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return 1
+		}
+
+		config.PluginConfig = data
+		if filepath.Ext(c.flagPluginConfig) == ".json" {
+			config.ConfigFormat = pb.Project_JSON
+		}
+	}
+
+	_, err := c.project.Client().UpsertOnDemandRunnerConfig(c.Ctx, &pb.UpsertOnDemandRunnerConfigRequest{
+		Config: config,
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	c.ui.Output("Runner profile %q set.", c.args[0], terminal.WithSuccessStyle())
+	return 0
+}
+
+func (c *RunnerProfileSetCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.StringVar(&flag.StringVar{
+			Name:   "plugin-type",
+			Target: &c.flagPluginType,
+			Usage: "The task launcher plugin that launches the runners, such as " +
+				"'docker'. Required.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "plugin-config",
+			Target: &c.flagPluginConfig,
+			Usage: "Path to the configuration of the task launcher plugin. This " +
+				"is HCL, or JSON if the file ends in '.json'. Optional.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "oci-url",
+			Target: &c.flagOCIUrl,
+			Usage: "The OCI image of the runners. Defaults to the Waypoint " +
+				"image, which must match the version of the server.",
+		})
+
+		f.StringMapVar(&flag.StringMapVar{
+			Name:   "env-var",
+			Target: &c.flagEnvVars,
+			Usage: "Environment variable to set on the runners, in the form " +
+				"'NAME=value'. May be specified multiple times.",
+		})
+
+		f.StringMapVar(&flag.StringMapVar{
+			Name:   "target-label",
+			Target: &c.flagTargetLabels,
+			Usage: "Label that the runners that launch on-demand runners must " +
+				"have, in the form 'key=value'. If this isn't set, on-demand " +
+				"runners are launched by the runners that the job would have run " +
+				"on. May be specified multiple times.",
+		})
+	})
+}
+
+func (c *RunnerProfileSetCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *RunnerProfileSetCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *RunnerProfileSetCommand) Synopsis() string {
+	return "Create or update a runner profile."
+}
+
+func (c *RunnerProfileSetCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint runner profile set [options] NAME
+
+  Create or update a runner profile.
+
+  A runner profile configures how the server launches an on-demand runner
+  for each remote job of the projects that use it. A registered runner
+  launches the on-demand runner with a task launcher plugin, the job runs
+  on it, and the server stops it once the job completes.
+
+  Projects use a profile by setting "profile" in the runner block of
+  their waypoint.hcl, or with "waypoint project apply -runner-profile".
+  The server must have an advertise address so that on-demand runners
+  can connect to it.
+
+  Only admins may set runner profiles.
+
+` + c.Flags().Help())
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type RunnerRejectCommand struct {
+	*baseCommand
+}
+
+func (c *RunnerRejectCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoAutoServer(), // local runners never need to be adopted
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) == 0 {
+		c.ui.Output("runner ID required for rejection", terminal.WithErrorStyle())
+		return 1
+	}
+
+	_, err := c.project.Client().RejectRunner(c.Ctx, &pb.RejectRunnerRequest{
+		RunnerId: c.args[0],
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	c.ui.Output("Runner rejected.", terminal.WithSuccessStyle())
+	return 0
+}
+
+func (c *RunnerRejectCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *RunnerRejectCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *RunnerRejectCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *RunnerRejectCommand) Synopsis() string {
+	return "Reject a runner so that it can't register with the server."
+}
+
+func (c *RunnerRejectCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint runner reject ID
+
+  Reject a runner so that it can't register with the server.
+
+  A connected runner with the ID is disconnected and the runner can't
+  register again, even with a token that pre-authorizes runners. Runners
+  that were adopted before can be rejected too. To allow the runner again,
+  adopt it with "waypoint runner adopt". This requires the admin role.
+
+`)
+}
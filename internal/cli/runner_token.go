@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type RunnerTokenCommand struct {
+	*baseCommand
+
+	flagDuration time.Duration
+	flagLabels   map[string]string
+}
+
+func (c *RunnerTokenCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoAutoServer(), // local mode has no need for tokens
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	resp, err := c.project.Client().GenerateLoginToken(c.Ctx, &pb.LoginTokenRequest{
+		Duration: c.flagDuration.String(),
+		RunnerAdoption: &pb.Token_RunnerAdoption{
+			Labels: c.flagLabels,
+		},
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	// We use fmt here and not the UI helpers because UI helpers will
+	// trim tokens horizontally on terminals that are narrow.
+	fmt.Println(resp.Token)
+	return 0
+}
+
+func (c *RunnerTokenCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.DurationVar(&flag.DurationVar{
+			Name:    "expires-in",
+			Target:  &c.flagDuration,
+			Usage:   "The duration until the token expires. i.e. '5m'.",
+			Default: 720 * time.Hour, // 30 days
+		})
+
+		f.StringMapVar(&flag.StringMapVar{
+			Name:   "label",
+			Target: &c.flagLabels,
+			Usage: "Label that runners must have to be adopted with the token, " +
+				"such as 'env=prod'. Can be specified multiple times.",
+		})
+	})
+}
+
+func (c *RunnerTokenCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *RunnerTokenCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *RunnerTokenCommand) Synopsis() string {
+	return "Create a token that pre-authorizes runners."
+}
+
+func (c *RunnerTokenCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint runner token [options]
+
+  Create a token that pre-authorizes runners.
+
+  If the server is run with "-require-runner-adoption", runners that
+  register with this token are adopted without "waypoint runner adopt"
+  if they have all of the labels set with "-label". Without any labels,
+  every runner that uses the token is adopted.
+
+  Runners use the token like any other token, for example with the
+  WAYPOINT_SERVER_TOKEN environment variable. The token is a login
+  token for the current user, so it has the same access. This requires
+  the admin role.
+
+` + c.Flags().Help())
+}
@@ -0,0 +1,187 @@
+package cli
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/posener/complete"
+	"google.golang.org/grpc/codes"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type ServerAuditCommand struct {
+	*baseCommand
+
+	flagUsername string
+	flagEndpoint string
+	flagLimit    uint
+	flagJson     bool
+}
+
+func (c *ServerAuditCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoConfig(),
+		WithNoAutoServer(), // local mode has no audit log worth viewing
+	); err != nil {
+		return 1
+	}
+
+	req := &pb.ListAuditEventsRequest{
+		Endpoint: c.flagEndpoint,
+		Limit:    uint32(c.flagLimit),
+	}
+	if c.flagUsername != "" {
+		req.User = &pb.Ref_User{
+			Ref: &pb.Ref_User_Username{
+				Username: &pb.Ref_UserUsername{
+					Username: c.flagUsername,
+				},
+			},
+		}
+	}
+
+	resp, err := c.project.Client().ListAuditEvents(c.Ctx, req)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	if c.flagJson {
+		data, err := json.MarshalIndent(auditEventsJson(resp.Events), "", "  ")
+		if err != nil {
+			c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return 1
+		}
+
+		c.ui.Output(string(data))
+		return 0
+	}
+
+	if len(resp.Events) == 0 {
+		c.ui.Output("No audit events found.")
+		return 0
+	}
+
+	tbl := terminal.NewTable("Time", "User", "Endpoint", "Result", "Summary")
+	for _, e := range resp.Events {
+		t := "n/a"
+		if v, err := ptypes.Timestamp(e.Time); err == nil {
+			t = v.Local().Format(time.RFC3339)
+		}
+
+		user := e.Username
+		if user == "" {
+			user = "-"
+		}
+
+		result, color := "ok", terminal.Green
+		if e.Error != nil {
+			result, color = codes.Code(e.Error.Code).String(), terminal.Red
+		}
+
+		tbl.Rich(
+			[]string{t, user, e.Endpoint, result, e.Summary},
+			[]string{"", "", "", color, ""},
+		)
+	}
+
+	c.ui.Table(tbl)
+	return 0
+}
+
+// auditEventsJson returns the JSON representation of the audit events.
+func auditEventsJson(events []*pb.AuditEvent) []interface{} {
+	result := []interface{}{}
+	for _, e := range events {
+		v := map[string]interface{}{
+			"id":       e.Id,
+			"user_id":  e.UserId,
+			"username": e.Username,
+			"endpoint": e.Endpoint,
+			"summary":  e.Summary,
+		}
+
+		if t, err := ptypes.Timestamp(e.Time); err == nil {
+			v["time"] = t.Format(time.RFC3339Nano)
+		}
+
+		if e.Error != nil {
+			v["error"] = map[string]interface{}{
+				"code":    codes.Code(e.Error.Code).String(),
+				"message": e.Error.Message,
+			}
+		}
+
+		result = append(result, v)
+	}
+
+	return result
+}
+
+func (c *ServerAuditCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.StringVar(&flag.StringVar{
+			Name:   "username",
+			Target: &c.flagUsername,
+			Usage:  "Only show events of this user.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "endpoint",
+			Target: &c.flagEndpoint,
+			Usage:  "Only show events of this API, i.e. 'UpsertProject'.",
+		})
+
+		f.UintVar(&flag.UintVar{
+			Name:    "limit",
+			Target:  &c.flagLimit,
+			Default: 50,
+			Usage:   "Maximum number of events to show.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:    "json",
+			Target:  &c.flagJson,
+			Default: false,
+			Usage:   "Output the events as JSON.",
+		})
+	})
+}
+
+func (c *ServerAuditCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ServerAuditCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ServerAuditCommand) Synopsis() string {
+	return "List the audit log of changes made through the server"
+}
+
+func (c *ServerAuditCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint server audit [options]
+
+  List the audit log of calls to server APIs that change state, newest first.
+
+  Each event records who made the call, when, the API that was called,
+  whether it succeeded, and a summary of what the call targeted such as
+  the project and workspace. Request values other than references are
+  never recorded so that secrets don't end up in the audit log.
+
+  The server keeps a limited number of the most recent events. This
+  requires the "admin" role.
+
+` + c.Flags().Help())
+}
@@ -0,0 +1,127 @@
+package cli
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type ServerPruneCommand struct {
+	*baseCommand
+
+	flagKeepLast int
+	flagMaxAge   time.Duration
+	flagDryRun   bool
+}
+
+func (c *ServerPruneCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	req := &pb.PruneStateRequest{
+		KeepLast: int32(c.flagKeepLast),
+		DryRun:   c.flagDryRun,
+	}
+	if c.flagMaxAge > 0 {
+		req.MaxAge = c.flagMaxAge.String()
+	}
+
+	resp, err := c.project.Client().PruneState(c.Ctx, req)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	var keys []string
+	for k := range resp.Deleted {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	header := "Deleted"
+	if c.flagDryRun {
+		header = "Would Delete"
+	}
+
+	table := terminal.NewTable("Type", header)
+	for _, k := range keys {
+		table.Rich([]string{
+			k,
+			strconv.Itoa(int(resp.Deleted[k])),
+		}, nil)
+	}
+
+	c.ui.Table(table)
+	return 0
+}
+
+func (c *ServerPruneCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.IntVar(&flag.IntVar{
+			Name:   "keep-last",
+			Target: &c.flagKeepLast,
+			Usage: "Keep the newest records of each type for each application " +
+				"and workspace.",
+		})
+
+		f.DurationVar(&flag.DurationVar{
+			Name:   "max-age",
+			Target: &c.flagMaxAge,
+			Usage:  "Keep records that completed within this duration, such as '720h'.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "dry-run",
+			Target: &c.flagDryRun,
+			Usage:  "Show the number of records that would be deleted without deleting them.",
+		})
+	})
+}
+
+func (c *ServerPruneCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ServerPruneCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ServerPruneCommand) Synopsis() string {
+	return "Delete old records from the server data"
+}
+
+func (c *ServerPruneCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint server prune [options]
+
+  Delete old builds, artifacts, deployments, releases, status reports,
+  and jobs from the server data.
+
+  A record is kept if it is one of the newest "-keep-last" records of its
+  application and workspace, or if it completed within "-max-age". If
+  neither is set, the retention policy configured for the server with
+  "waypoint server run" is used.
+
+  The newest record of each application and workspace, operations that
+  are still running, live deployments and releases, and the builds and
+  artifacts they are made from are always kept.
+
+  Use "-dry-run" to see how many records would be deleted. Consider
+  taking a snapshot with "waypoint server snapshot" first.
+
+` + c.Flags().Help())
+}
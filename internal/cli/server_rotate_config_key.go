@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+)
+
+type ServerRotateConfigKeyCommand struct {
+	*baseCommand
+}
+
+func (c *ServerRotateConfigKeyCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	resp, err := c.project.Client().RotateConfigEncryptionKey(c.Ctx, &empty.Empty{})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	c.ui.Output("Config encryption key rotated. New key: %s", resp.KeyId,
+		terminal.WithSuccessStyle())
+	c.ui.Output("Re-encrypted %d config values.", resp.Reencrypted)
+	return 0
+}
+
+func (c *ServerRotateConfigKeyCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *ServerRotateConfigKeyCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ServerRotateConfigKeyCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ServerRotateConfigKeyCommand) Synopsis() string {
+	return "Rotate the key that config values are encrypted with"
+}
+
+func (c *ServerRotateConfigKeyCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint server rotate-config-key
+
+  Rotate the key that config values are encrypted with.
+
+  This creates a new data key, wrapped with the root key configured with
+  "waypoint server run", and re-encrypts every config value with it. Old
+  keys are kept so that snapshots taken before the rotation can still be
+  restored.
+
+  This requires config encryption to be enabled on the server with
+  "-config-encryption-key-file" or "-config-encryption-kms-key-id". To
+  rotate the root key itself, restart the server with the new root key
+  and the old one set as "-config-encryption-previous-key-file" or
+  "-config-encryption-previous-kms-key-id".
+
+` + c.Flags().Help())
+}
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+type ServiceAccountCreateCommand struct {
+	*baseCommand
+
+	flagDescription string
+	flagRoles       []string
+}
+
+func (c *ServiceAccountCreateCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoConfig(),
+		WithNoAutoServer(), // local mode has no need for service accounts
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("A single name argument is required.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	roles := c.flagRoles
+	if len(roles) == 0 {
+		roles = []string{serverptypes.RoleOperator}
+	}
+
+	resp, err := c.project.Client().CreateServiceAccount(c.Ctx, &pb.CreateServiceAccountRequest{
+		ServiceAccount: &pb.ServiceAccount{
+			Name:        c.args[0],
+			Description: c.flagDescription,
+			Roles:       roles,
+		},
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	c.ui.Output("Service account %q created with the roles: %s",
+		resp.ServiceAccount.Name, strings.Join(resp.ServiceAccount.Roles, ", "),
+		terminal.WithSuccessStyle())
+	return 0
+}
+
+func (c *ServiceAccountCreateCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.StringVar(&flag.StringVar{
+			Name:   "description",
+			Target: &c.flagDescription,
+			Usage:  "Short description of the service account. Optional.",
+		})
+
+		f.StringSliceVar(&flag.StringSliceVar{
+			Name:   "role",
+			Target: &c.flagRoles,
+			Usage: "Role of the service account. One of \"admin\", \"operator\", " +
+				"or \"read-only\". May be specified multiple times. Defaults " +
+				"to \"operator\".",
+		})
+	})
+}
+
+func (c *ServiceAccountCreateCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ServiceAccountCreateCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ServiceAccountCreateCommand) Synopsis() string {
+	return "Create a service account"
+}
+
+func (c *ServiceAccountCreateCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint service-account create [options] NAME
+
+  Create a service account.
+
+  Service accounts are identities for automation such as CI systems. They
+  can't log in; use "waypoint service-account token" to generate tokens
+  for them. Requests with these tokens have the roles of the service
+  account and are shown as "service-account/NAME" in the audit log.
+
+  Only admins may manage service accounts.
+
+` + c.Flags().Help())
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type ServiceAccountDeleteCommand struct {
+	*baseCommand
+}
+
+func (c *ServiceAccountDeleteCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoConfig(),
+		WithNoAutoServer(), // local mode has no need for service accounts
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("A single name argument is required.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	_, err := c.project.Client().DeleteServiceAccount(c.Ctx, &pb.DeleteServiceAccountRequest{
+		ServiceAccount: &pb.Ref_ServiceAccount{Name: c.args[0]},
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	c.ui.Output("Service account deleted.", terminal.WithSuccessStyle())
+	return 0
+}
+
+func (c *ServiceAccountDeleteCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *ServiceAccountDeleteCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ServiceAccountDeleteCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ServiceAccountDeleteCommand) Synopsis() string {
+	return "Delete a service account"
+}
+
+func (c *ServiceAccountDeleteCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint service-account delete NAME
+
+  Delete a service account.
+
+  All tokens of the service account stop working immediately, even if a
+  new service account with the same name is created.
+
+`)
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+)
+
+type ServiceAccountListCommand struct {
+	*baseCommand
+}
+
+func (c *ServiceAccountListCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoConfig(),
+		WithNoAutoServer(), // local mode has no need for service accounts
+	); err != nil {
+		return 1
+	}
+
+	resp, err := c.project.Client().ListServiceAccounts(c.Ctx, &empty.Empty{})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	if len(resp.ServiceAccounts) == 0 {
+		c.ui.Output("No service accounts found.")
+		return 0
+	}
+
+	table := terminal.NewTable("Name", "Roles", "Description")
+	for _, sa := range resp.ServiceAccounts {
+		table.Rich([]string{
+			sa.Name,
+			strings.Join(sa.Roles, ", "),
+			sa.Description,
+		}, nil)
+	}
+
+	c.ui.Table(table)
+	return 0
+}
+
+func (c *ServiceAccountListCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *ServiceAccountListCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ServiceAccountListCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ServiceAccountListCommand) Synopsis() string {
+	return "List all service accounts"
+}
+
+func (c *ServiceAccountListCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint service-account list
+
+  List all the service accounts of the Waypoint server.
+
+`)
+}
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	stdflag "flag"
+	"fmt"
+	"time"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type ServiceAccountTokenCommand struct {
+	*baseCommand
+
+	flagDuration time.Duration
+	flagProject  string
+}
+
+func (c *ServiceAccountTokenCommand) Run(args []string) int {
+	flagSet := c.Flags()
+
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(flagSet),
+		WithNoConfig(),
+		WithNoAutoServer(), // local mode has no need for tokens
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("A single name argument is required.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	// The global workspace flag always has a value, so the token is only
+	// limited to a workspace if the flag is explicitly set.
+	var scope *pb.Token_Scope
+	if c.flagProject != "" {
+		scope = &pb.Token_Scope{Project: c.flagProject}
+	}
+	flagSet.Visit(func(f *stdflag.Flag) {
+		if f.Name == "workspace" {
+			if scope == nil {
+				scope = &pb.Token_Scope{}
+			}
+
+			scope.Workspace = c.flagWorkspace
+		}
+	})
+
+	resp, err := c.project.Client().GenerateServiceAccountToken(c.Ctx, &pb.ServiceAccountTokenRequest{
+		ServiceAccount: &pb.Ref_ServiceAccount{Name: c.args[0]},
+		Duration:       c.flagDuration.String(),
+		Scope:          scope,
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	// We use fmt here and not the UI helpers because UI helpers will
+	// trim tokens horizontally on terminals that are narrow.
+	fmt.Println(resp.Token)
+	return 0
+}
+
+func (c *ServiceAccountTokenCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.DurationVar(&flag.DurationVar{
+			Name:    "expires-in",
+			Target:  &c.flagDuration,
+			Usage:   "The duration until the token expires. i.e. '5m'. Set to 0 for a token that doesn't expire.",
+			Default: 720 * time.Hour, // 30 days
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "project",
+			Target: &c.flagProject,
+			Usage: "Project to limit the token to. The server rejects requests " +
+				"with the token that target any other project.",
+		})
+	})
+}
+
+func (c *ServiceAccountTokenCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ServiceAccountTokenCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ServiceAccountTokenCommand) Synopsis() string {
+	return "Generate a token for a service account"
+}
+
+func (c *ServiceAccountTokenCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint service-account token [options] NAME
+
+  Generate a new token for a service account.
+
+  The token can be limited to a single project with "-project" and to a
+  single workspace with "-workspace", like "waypoint user token".
+
+  To rotate a token, generate a new token and then revoke the old one
+  with "waypoint user token revoke OLD-TOKEN".
+
+` + c.Flags().Help())
+}
@@ -51,7 +51,7 @@ func (c *SnapshotBackupCommand) Run(args []string) int {
 		return 1
 	}
 
-	w, closer, err := c.initWriter(args)
+	w, closer, err := c.initWriter(c.args)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to open output: %s", err)
 		return 1
@@ -67,7 +67,7 @@ func (c *SnapshotBackupCommand) Run(args []string) int {
 	}
 
 	if w != os.Stdout {
-		c.ui.Output("Snapshot written to '%s'", args[0])
+		c.ui.Output("Snapshot written to '%s'", c.args[0])
 	}
 
 	return 0
@@ -55,7 +55,7 @@ func (c *SnapshotRestoreCommand) Run(args []string) int {
 
 	r, closer, err := c.initReader(c.args)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "failed to open output: %s", err)
+		fmt.Fprintf(os.Stderr, "failed to open input: %s", err)
 		return 1
 	}
 
@@ -0,0 +1,2129 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	stdflag "flag"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/posener/complete"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+type StatusCommand struct {
+	*baseCommand
+
+	flagJson            bool
+	flagWatch           bool
+	flagRefreshInterval time.Duration
+	flagId              idFormat
+	flagSort            string
+	flagFilterHealth    []string
+	flagLabels          map[string]string
+	flagAllProjects     bool
+	flagCheck           bool
+	flagOutput          string
+	flagVerbose         bool
+	flagServer          bool
+
+	// workspaceSet is true if the workspace was explicitly set with the
+	// -workspace flag. If it wasn't, status is shown for every workspace
+	// that a project or app is present in.
+	workspaceSet bool
+}
+
+// appStatus is the status information gathered for a single application
+// in a single workspace. Every output format of the status command is
+// rendered from a list of these.
+type appStatus struct {
+	Project   string
+	App       string
+	Workspace string
+
+	// Report is the latest status report for the application. This is
+	// nil if no status report has ever been generated.
+	Report *pb.StatusReport
+
+	// Build, Deployment and Release are the latest build, deployment, and
+	// release for the application. Any may be nil.
+	Build      *pb.Build
+	Deployment *pb.Deployment
+	Release    *pb.Release
+
+	// Events are the most recent operations for the application, newest
+	// first. This is only populated for the single application view.
+	Events []*statusEvent
+
+	// Instances is the number of running instances of the latest
+	// deployment. This is only populated for the single application
+	// view and the wide and csv output formats, and is -1 otherwise.
+	Instances int
+
+	// Jobs are the build, deploy, and release jobs for the application
+	// that are queued or running.
+	Jobs []*pb.Job
+}
+
+// statusEvent is a single operation in the history of an application.
+type statusEvent struct {
+	Operation string
+	Sequence  uint64
+	Id        string
+	State     pb.Status_State
+	Time      time.Time
+}
+
+// statusEventLimit is the number of events shown for an application.
+const statusEventLimit = 5
+
+// statusConcurrency is the maximum number of concurrent requests made
+// when querying the status of each application individually.
+const statusConcurrency = 8
+
+// statusCheckExitCode is the exit code when -check is set and an app is
+// unhealthy. This is distinct from the exit code of 1 used for errors so
+// that scripts can tell an unhealthy app apart from a failure to check.
+const statusCheckExitCode = 2
+
+// projectRow is a single row of the all projects view: the apps of a
+// project in a single workspace with their health rolled up.
+type projectRow struct {
+	Project   string
+	Workspace string
+	Statuses  []*appStatus
+
+	Health  string
+	Ready   int
+	Total   int
+	Updated time.Time
+}
+
+// statusHealthValues are the known health values in order from least to
+// most healthy. This is the order used when sorting by health.
+var statusHealthValues = []string{"DOWN", "PARTIAL", "UNKNOWN", "ALIVE", "READY"}
+
+func (c *StatusCommand) Run(args []string) int {
+	flagSet := c.Flags()
+
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	// The configuration is optional: if we're in a project directory we
+	// default to that project, otherwise we show all projects.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(flagSet),
+		WithConfig(true),
+	); err != nil {
+		return 1
+	}
+
+	flagSet.Visit(func(f *stdflag.Flag) {
+		if f.Name == "workspace" {
+			c.workspaceSet = true
+		}
+	})
+
+	if len(c.args) > 1 {
+		c.ui.Output("At most one argument is expected.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	projectTarget, appTarget := c.statusScope()
+
+	if c.flagCheck && c.flagWatch {
+		c.ui.Output("The -check and -watch flags can't be used together.", terminal.WithErrorStyle())
+		return 1
+	}
+
+	if c.flagServer {
+		if len(c.args) > 0 || c.flagWatch {
+			c.ui.Output("The -server flag can't be used with an argument or -watch.",
+				terminal.WithErrorStyle())
+			return 1
+		}
+
+		return c.runServerStatus()
+	}
+
+	if !c.flagWatch {
+		statuses, err := c.outputStatus(projectTarget, appTarget)
+		if err != nil {
+			if err != ErrSentinel {
+				c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			}
+
+			return 1
+		}
+
+		if c.flagCheck {
+			// Nothing to check is a failure so that a mistyped target or
+			// a project without apps doesn't pass the gate.
+			if len(statuses) == 0 {
+				if !c.flagJson {
+					c.ui.Output("")
+					c.ui.Output("No applications were checked.", terminal.WithErrorStyle())
+				}
+
+				return statusCheckExitCode
+			}
+
+			if unhealthy := unhealthyApps(statuses); len(unhealthy) > 0 {
+				if !c.flagJson {
+					c.ui.Output("")
+					c.ui.Output("Unhealthy applications: %s", strings.Join(unhealthy, ", "),
+						terminal.WithErrorStyle())
+				}
+
+				return statusCheckExitCode
+			}
+		}
+
+		return 0
+	}
+
+	if c.flagRefreshInterval < time.Second {
+		c.ui.Output("The refresh interval must be at least one second.", terminal.WithErrorStyle())
+		return 1
+	}
+
+	// Watch mode: re-poll until the command is interrupted. Errors are
+	// shown but don't stop watching since they're often transient while
+	// a deploy is in progress.
+	changeCh := c.watchStatusReports(projectTarget, appTarget)
+	for {
+		if !c.flagJson && c.ui.Interactive() {
+			if out, _, err := c.ui.OutputWriters(); err == nil {
+				// Clear the screen and move the cursor home so the
+				// table is redrawn in place.
+				fmt.Fprint(out, "\033[H\033[2J")
+			}
+		}
+
+		if _, err := c.outputStatus(projectTarget, appTarget); err != nil {
+			if status.Code(err) == codes.Canceled || c.Ctx.Err() != nil {
+				return 0
+			}
+
+			if err != ErrSentinel {
+				c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			}
+		}
+
+		if !c.flagJson {
+			c.ui.Output("")
+			c.ui.Output("Refreshed %s. Refreshing every %s, press Ctrl-C to exit.",
+				time.Now().Format(time.Kitchen), c.flagRefreshInterval)
+		}
+
+		select {
+		case <-c.Ctx.Done():
+			return 0
+		case <-changeCh:
+		case <-time.After(c.flagRefreshInterval):
+		}
+	}
+}
+
+// statusScope returns the project and app to show the status of. The
+// argument is either "project" or "project/app". With no argument, we use
+// the project of the current directory if there is one and all projects
+// otherwise. An empty project is all projects and an empty app is every
+// app in the project.
+func (c *StatusCommand) statusScope() (project, app string) {
+	if len(c.args) == 1 {
+		if match := reAppTarget.FindStringSubmatch(c.args[0]); match != nil {
+			return match[1], match[2]
+		}
+
+		return c.args[0], ""
+	}
+
+	if c.cfg != nil && !c.flagAllProjects {
+		return c.cfg.Project, c.flagApp
+	}
+
+	return "", ""
+}
+
+// watchStatusReports returns a channel that receives a value every time
+// the status reports of the app change, so that watch mode can refresh
+// immediately instead of waiting for the next refresh. If there is no
+// app target or the server doesn't support watching, the channel never
+// receives and the status is only refreshed on the interval.
+func (c *StatusCommand) watchStatusReports(projectTarget, appTarget string) <-chan struct{} {
+	ch := make(chan struct{}, 1)
+	if appTarget == "" {
+		return ch
+	}
+
+	stream, err := c.project.Client().WatchStatusReports(c.Ctx, &pb.ListStatusReportsRequest{
+		Application: &pb.Ref_Application{
+			Project:     projectTarget,
+			Application: appTarget,
+		},
+	})
+	if err != nil {
+		return ch
+	}
+
+	go func() {
+		// The first response is the current list, which is shown already.
+		if _, err := stream.Recv(); err != nil {
+			return
+		}
+
+		for {
+			// This errors when the command exits, and with Unimplemented
+			// on older servers. Either way we fall back to the interval.
+			if _, err := stream.Recv(); err != nil {
+				return
+			}
+
+			// Coalesce changes that happen before the next refresh.
+			select {
+			case ch <- struct{}{}:
+			default:
+			}
+		}
+	}()
+
+	return ch
+}
+
+// outputStatus outputs the status for the given scope once. An empty
+// project target is all projects and an empty app target is every app
+// in the project. The statuses of the apps that were shown are returned.
+func (c *StatusCommand) outputStatus(projectTarget, appTarget string) ([]*appStatus, error) {
+	switch {
+	case projectTarget == "":
+		return c.FormatProjectStatus()
+	case appTarget == "":
+		return c.FormatProjectAppStatus(projectTarget)
+	default:
+		return c.FormatAppStatus(projectTarget, appTarget)
+	}
+}
+
+// FormatProjectStatus outputs the status of every application in every
+// project known to the server.
+func (c *StatusCommand) FormatProjectStatus() ([]*appStatus, error) {
+	projects, result, err := c.statusSummary("")
+	if err != nil {
+		return nil, err
+	}
+	if err := c.loadJobs("", result); err != nil {
+		return nil, err
+	}
+
+	rows := c.projectRows(projects, result)
+
+	// The apps of the projects that match the health filter
+	var shown []*appStatus
+	for _, row := range rows {
+		shown = append(shown, row.Statuses...)
+	}
+
+	if c.flagJson {
+		// Group the rows back into projects, keeping the sorted order of
+		// the first row of each project.
+		var order []string
+		byProject := map[string][]*appStatus{}
+		for _, row := range rows {
+			if _, ok := byProject[row.Project]; !ok {
+				order = append(order, row.Project)
+			}
+
+			byProject[row.Project] = append(byProject[row.Project], row.Statuses...)
+		}
+
+		output := []interface{}{}
+		for _, project := range order {
+			output = append(output, c.projectJson(project, byProject[project]))
+		}
+
+		return shown, c.outputJson(output)
+	}
+
+	// The wide and csv formats show a row per application rather than
+	// rolling the applications up into projects.
+	if c.flagOutput == "wide" || c.flagOutput == "csv" {
+		apps := c.sortFilterApps(result)
+		if err := c.loadInstances(apps); err != nil {
+			return nil, err
+		}
+
+		if c.flagOutput == "csv" {
+			return apps, c.outputCsv(apps)
+		}
+
+		c.ui.Output("Current status of projects:", terminal.WithHeaderStyle())
+		c.ui.Table(c.wideTable(apps, true))
+
+		return apps, nil
+	}
+
+	if len(projects) == 0 {
+		if len(c.flagLabels) > 0 {
+			c.ui.Output("No projects have applications matching the labels.")
+		} else {
+			c.ui.Output("No projects found.")
+		}
+
+		return nil, nil
+	}
+
+	if len(rows) == 0 {
+		c.ui.Output("No projects match the health filter.")
+		return nil, nil
+	}
+
+	c.ui.Output("Current status of projects:", terminal.WithHeaderStyle())
+	c.ui.Table(c.projectTable(rows))
+
+	return shown, nil
+}
+
+// FormatProjectAppStatus outputs the status of every application in
+// a single project.
+func (c *StatusCommand) FormatProjectAppStatus(project string) ([]*appStatus, error) {
+	_, result, err := c.statusSummary(project)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.loadJobs(project, result); err != nil {
+		return nil, err
+	}
+
+	filtered := c.sortFilterApps(result)
+
+	if c.flagJson {
+		return filtered, c.outputJson(c.projectJson(project, filtered))
+	}
+
+	if c.flagOutput == "wide" || c.flagOutput == "csv" {
+		if err := c.loadInstances(filtered); err != nil {
+			return nil, err
+		}
+
+		if c.flagOutput == "csv" {
+			return filtered, c.outputCsv(filtered)
+		}
+	}
+
+	if len(result) == 0 {
+		if len(c.flagLabels) > 0 {
+			c.ui.Output("No applications in project %q match the labels.", project)
+		} else {
+			c.ui.Output("No applications found for project %q.", project)
+		}
+
+		return nil, nil
+	}
+
+	if len(filtered) == 0 {
+		c.ui.Output("No applications in project %q match the health filter.", project)
+		return nil, nil
+	}
+
+	c.ui.Output("Current status of applications in project %q:", project, terminal.WithHeaderStyle())
+	if c.flagOutput == "wide" {
+		c.ui.Table(c.wideTable(filtered, false))
+	} else {
+		c.ui.Table(c.statusTable(filtered))
+	}
+
+	return filtered, nil
+}
+
+// FormatAppStatus outputs the status of a single application. If no
+// workspace was specified, the app is shown once for every workspace
+// it is present in.
+func (c *StatusCommand) FormatAppStatus(project, app string) ([]*appStatus, error) {
+	appRef := &pb.Ref_Application{
+		Project:     project,
+		Application: app,
+	}
+
+	workspaces := []*pb.Ref_Workspace{c.refWorkspace}
+	if !c.workspaceSet {
+		resp, err := c.project.Client().ListWorkspaces(c.Ctx, &pb.ListWorkspacesRequest{
+			Scope: &pb.ListWorkspacesRequest_Application{
+				Application: appRef,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(resp.Workspaces) > 0 {
+			workspaces = nil
+			for _, ws := range resp.Workspaces {
+				workspaces = append(workspaces, &pb.Ref_Workspace{Workspace: ws.Name})
+			}
+			sort.Slice(workspaces, func(i, j int) bool {
+				return workspaces[i].Workspace < workspaces[j].Workspace
+			})
+		}
+	}
+
+	var result []*appStatus
+	for _, ws := range workspaces {
+		s, err := c.appStatus(appRef, ws)
+		if err != nil {
+			return nil, err
+		}
+
+		s.Events, err = c.appEvents(appRef, ws)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, s)
+	}
+	result = c.sortFilterApps(result)
+
+	if err := c.loadInstances(result); err != nil {
+		return nil, err
+	}
+	if err := c.loadJobs(project, result); err != nil {
+		return nil, err
+	}
+
+	if c.flagJson {
+		if len(result) == 1 {
+			return result, c.outputJson(c.appJson(result[0]))
+		}
+
+		var output []interface{}
+		for _, s := range result {
+			output = append(output, c.appJson(s))
+		}
+
+		return result, c.outputJson(output)
+	}
+
+	if c.flagOutput == "csv" {
+		return result, c.outputCsv(result)
+	}
+
+	for i, s := range result {
+		if i > 0 {
+			c.ui.Output("")
+		}
+
+		c.outputAppDetail(s)
+	}
+
+	return result, nil
+}
+
+// outputAppDetail outputs the detailed view of a single application in
+// a single workspace.
+func (c *StatusCommand) outputAppDetail(s *appStatus) {
+	c.ui.Output("Application %q in project %q, workspace %q:",
+		s.App, s.Project, s.Workspace, terminal.WithHeaderStyle())
+
+	health, _ := statusHealth(s.Report)
+	healthMessage := "n/a"
+	updated := "n/a"
+	if s.Report != nil {
+		if s.Report.Health != nil && s.Report.Health.HealthMessage != "" {
+			healthMessage = s.Report.Health.HealthMessage
+		}
+
+		if t, err := ptypes.Timestamp(s.Report.GeneratedTime); err == nil {
+			updated = humanize.Time(t)
+		}
+	}
+
+	c.ui.NamedValues([]terminal.NamedValue{
+		{
+			Name: "Build", Value: c.buildId(s.Build),
+		},
+		{
+			Name: "Deployment", Value: c.deploymentId(s.Deployment),
+		},
+		{
+			Name: "Release", Value: c.releaseId(s.Release),
+		},
+		{
+			Name: "URL", Value: appURL(s),
+		},
+		{
+			Name: "Instances", Value: instanceCount(s),
+		},
+		{
+			Name: "Last Deployed", Value: lastDeployed(s, humanize.Time),
+		},
+		{
+			Name: "Health", Value: health,
+		},
+		{
+			Name: "Health Message", Value: healthMessage,
+		},
+		{
+			Name: "Active Jobs", Value: jobActivity(s, "none"),
+		},
+		{
+			Name: "Last Updated", Value: updated,
+		},
+	}, terminal.WithInfoStyle())
+
+	if c.flagVerbose && s.Report != nil && len(s.Report.Resources) > 0 {
+		c.ui.Output("")
+		c.ui.Output("Resources:", terminal.WithHeaderStyle())
+		c.ui.Table(resourcesTable(s.Report.Resources))
+	} else if s.Report != nil && len(s.Report.ResourcesHealth) > 0 {
+		c.ui.Output("")
+		c.ui.Output("Resources:", terminal.WithHeaderStyle())
+
+		tbl := terminal.NewTable("Name", "Health", "Message")
+		for _, r := range s.Report.ResourcesHealth {
+			name := r.Name
+			if name == "" {
+				name = r.Id
+			}
+
+			tbl.Rich(
+				[]string{name, r.HealthStatus, r.HealthMessage},
+				[]string{"", healthColor(r.HealthStatus), ""},
+			)
+		}
+
+		c.ui.Table(tbl)
+	}
+
+	if len(s.Events) > 0 {
+		c.ui.Output("")
+		c.ui.Output("Recent Events:", terminal.WithHeaderStyle())
+
+		tbl := terminal.NewTable("Operation", "ID", "Status", "Time")
+		for _, e := range s.Events {
+			statusColor := ""
+			switch e.State {
+			case pb.Status_RUNNING:
+				statusColor = terminal.Yellow
+			case pb.Status_SUCCESS:
+				statusColor = terminal.Green
+			case pb.Status_ERROR:
+				statusColor = terminal.Red
+			}
+
+			tbl.Rich(
+				[]string{
+					e.Operation,
+					c.flagId.FormatId(e.Sequence, e.Id),
+					strings.ToLower(e.State.String()),
+					humanize.Time(e.Time),
+				},
+				[]string{"", "", statusColor, ""},
+			)
+		}
+
+		c.ui.Table(tbl)
+	}
+}
+
+// resourcesTable builds a table with the details of each resource of a
+// status report. Resources are shown after the resource that created them
+// so that, for example, the pods of a deployment follow the deployment.
+func resourcesTable(resources []*pb.StatusReport_Resource) *terminal.Table {
+	var hasURL bool
+	children := map[string][]*pb.StatusReport_Resource{}
+	ids := map[string]struct{}{}
+	for _, r := range resources {
+		ids[r.Id] = struct{}{}
+		if r.PlatformUrl != "" {
+			hasURL = true
+		}
+	}
+	for _, r := range resources {
+		// Resources whose parent isn't in the report are shown at the top.
+		parent := r.ParentResourceId
+		if _, ok := ids[parent]; !ok || parent == r.Id {
+			parent = ""
+		}
+
+		children[parent] = append(children[parent], r)
+	}
+
+	headers := []string{"Type", "Name", "ID", "Health", "Message", "Created"}
+	if hasURL {
+		headers = append(headers, "URL")
+	}
+
+	tbl := terminal.NewTable(headers...)
+
+	var add func(parent string, depth int)
+	add = func(parent string, depth int) {
+		for _, r := range children[parent] {
+			name := r.Name
+			if name == "" {
+				name = "n/a"
+			}
+			if depth > 0 {
+				name = strings.Repeat("  ", depth-1) + "└ " + name
+			}
+
+			health := "UNKNOWN"
+			if r.Health != nil && r.Health.HealthStatus != "" {
+				health = r.Health.HealthStatus
+			}
+
+			message := r.HealthMessage
+			if message == "" && r.Health != nil {
+				message = r.Health.HealthMessage
+			}
+
+			created := "n/a"
+			if t, err := ptypes.Timestamp(r.CreatedTime); err == nil {
+				created = humanize.Time(t)
+			}
+
+			columns := []string{r.Type, name, r.Id, health, message, created}
+			if hasURL {
+				columns = append(columns, r.PlatformUrl)
+			}
+
+			tbl.Rich(columns, []string{"", "", "", healthColor(health)})
+
+			add(r.Id, depth+1)
+		}
+	}
+	add("", 0)
+
+	return tbl
+}
+
+// statusSummary gathers the status of every application in a project,
+// or in every project if project is empty. The sorted list of project
+// names is also returned. If no workspace was specified, this includes
+// every workspace that each project is present in.
+func (c *StatusCommand) statusSummary(project string) ([]string, []*appStatus, error) {
+	resp, err := c.project.Client().GetProjectStatusSummary(c.Ctx, c.statusSummaryRequest(project))
+	if status.Code(err) == codes.Unimplemented {
+		// Older servers don't have the summary API so we have to query
+		// each application individually.
+		return c.statusSummaryPerApp(project)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var projects []string
+	var result []*appStatus
+	for _, p := range resp.Projects {
+		projects = append(projects, p.Project.Project)
+
+		for _, app := range p.Applications {
+			result = append(result, &appStatus{
+				Project:    app.Application.Project,
+				App:        app.Application.Application,
+				Workspace:  app.Workspace.Workspace,
+				Report:     app.LatestStatusReport,
+				Build:      app.LatestBuild,
+				Deployment: app.LatestDeployment,
+				Release:    app.LatestRelease,
+				Instances:  -1,
+			})
+		}
+	}
+
+	return projects, result, nil
+}
+
+// statusSummaryRequest returns the request for the status of a project,
+// or every project if project is empty. The request is only limited to a
+// workspace if one was explicitly set.
+func (c *StatusCommand) statusSummaryRequest(project string) *pb.GetProjectStatusSummaryRequest {
+	req := &pb.GetProjectStatusSummaryRequest{}
+	if project != "" {
+		req.Project = &pb.Ref_Project{Project: project}
+	}
+	if c.workspaceSet {
+		req.Workspace = c.refWorkspace
+	}
+	req.Labels = c.flagLabels
+
+	return req
+}
+
+// statusSummaryPerApp is the same as statusSummary but makes a request
+// for every application. This is used for servers that don't support
+// the GetProjectStatusSummary API. The requests are made concurrently
+// with at most statusConcurrency in flight at a time.
+func (c *StatusCommand) statusSummaryPerApp(project string) ([]string, []*appStatus, error) {
+	projects := []string{project}
+	if project == "" {
+		refs, err := listProjects(c.Ctx, c.project.Client())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		projects = nil
+		for _, p := range refs {
+			projects = append(projects, p.Project)
+		}
+		sort.Strings(projects)
+	}
+
+	// Determine the apps and workspaces to query for each project.
+	projectTargets := make([][]*statusTarget, len(projects))
+	err := parallel(statusConcurrency, len(projects), func(i int) error {
+		targets, err := c.projectTargets(projects[i])
+		if err != nil {
+			return err
+		}
+
+		projectTargets[i] = targets
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var targets []*statusTarget
+	for i, t := range projectTargets {
+		// With a label selector, projects without matching apps are left
+		// out like they are by the summary API.
+		if len(t) == 0 && len(c.flagLabels) > 0 {
+			projects[i] = ""
+			continue
+		}
+
+		targets = append(targets, t...)
+	}
+
+	var matched []string
+	for _, p := range projects {
+		if p != "" {
+			matched = append(matched, p)
+		}
+	}
+	projects = matched
+
+	// Query the status of every target. The results are stored by index
+	// so that they're in the same order as the targets.
+	result := make([]*appStatus, len(targets))
+	err = parallel(statusConcurrency, len(targets), func(i int) error {
+		s, err := c.appStatus(targets[i].App, targets[i].Workspace)
+		if err != nil {
+			return err
+		}
+
+		result[i] = s
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return projects, result, nil
+}
+
+// statusTarget is a single application in a single workspace to query
+// the status of.
+type statusTarget struct {
+	App       *pb.Ref_Application
+	Workspace *pb.Ref_Workspace
+}
+
+// projectTargets returns the applications and workspaces to query the
+// status of for a project, sorted by workspace and then application. If
+// no workspace was specified, this includes every workspace that the
+// project is present in. Only apps matching the -label flag are included.
+func (c *StatusCommand) projectTargets(project string) ([]*statusTarget, error) {
+	resp, err := c.project.Client().GetProject(c.Ctx, &pb.GetProjectRequest{
+		Project: &pb.Ref_Project{Project: project},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the list of apps to query for each workspace. If the workspace
+	// was specified, or the project hasn't been used in any workspace yet,
+	// then we use every app in the project in the targeted workspace.
+	targets := map[string][]string{}
+	if !c.workspaceSet {
+		for _, ws := range resp.Workspaces {
+			var apps []string
+			for _, app := range ws.Applications {
+				apps = append(apps, app.Application.Application)
+			}
+
+			targets[ws.Workspace.Workspace] = apps
+		}
+	}
+	if len(targets) == 0 {
+		var apps []string
+		for _, app := range resp.Project.Applications {
+			apps = append(apps, app.Name)
+		}
+
+		targets[c.refWorkspace.Workspace] = apps
+	}
+
+	var workspaces []string
+	for ws := range targets {
+		workspaces = append(workspaces, ws)
+	}
+	sort.Strings(workspaces)
+
+	pt := &serverptypes.Project{Project: resp.Project}
+	var result []*statusTarget
+	for _, ws := range workspaces {
+		apps := targets[ws]
+		sort.Strings(apps)
+
+		for _, app := range apps {
+			if !serverptypes.LabelsMatch(c.flagLabels, pt.AppLabels(app)) {
+				continue
+			}
+
+			result = append(result, &statusTarget{
+				App: &pb.Ref_Application{
+					Project:     project,
+					Application: app,
+				},
+				Workspace: &pb.Ref_Workspace{Workspace: ws},
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// parallel calls f for every index from 0 to n-1 with at most limit
+// calls running at a time. If any call returns an error, no further
+// calls are started and the first error is returned.
+func parallel(limit, n int, f func(i int) error) error {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	failed := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return firstErr != nil
+	}
+
+	indexCh := make(chan int)
+	for w := 0; w < limit && w < n; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexCh {
+				if err := f(i); err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < n && !failed(); i++ {
+		indexCh <- i
+	}
+	close(indexCh)
+	wg.Wait()
+
+	return firstErr
+}
+
+// appStatus gathers the latest status report, deployment, and release
+// for a single application in a single workspace.
+func (c *StatusCommand) appStatus(appRef *pb.Ref_Application, ws *pb.Ref_Workspace) (*appStatus, error) {
+	client := c.project.Client()
+
+	result := &appStatus{
+		Project:   appRef.Project,
+		App:       appRef.Application,
+		Workspace: ws.Workspace,
+		Instances: -1,
+	}
+
+	report, err := client.GetLatestStatusReport(c.Ctx, &pb.GetLatestStatusReportRequest{
+		Application: appRef,
+		Workspace:   ws,
+	})
+	if status.Code(err) == codes.NotFound {
+		err = nil
+		report = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	result.Report = report
+
+	build, err := client.GetLatestBuild(c.Ctx, &pb.GetLatestBuildRequest{
+		Application: appRef,
+		Workspace:   ws,
+	})
+	if status.Code(err) == codes.NotFound {
+		err = nil
+		build = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	result.Build = build
+
+	deployResp, err := client.ListDeployments(c.Ctx, &pb.ListDeploymentsRequest{
+		Application:   appRef,
+		Workspace:     ws,
+		PhysicalState: pb.Operation_CREATED,
+		Order: &pb.OperationOrder{
+			Order: pb.OperationOrder_COMPLETE_TIME,
+			Desc:  true,
+			Limit: 1,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(deployResp.Deployments) > 0 {
+		result.Deployment = deployResp.Deployments[0]
+	}
+
+	release, err := client.GetLatestRelease(c.Ctx, &pb.GetLatestReleaseRequest{
+		Application: appRef,
+		Workspace:   ws,
+	})
+	if status.Code(err) == codes.NotFound {
+		err = nil
+		release = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	result.Release = release
+
+	return result, nil
+}
+
+// appEvents returns the most recent builds, deployments, and releases
+// for an application merged together, newest first.
+func (c *StatusCommand) appEvents(appRef *pb.Ref_Application, ws *pb.Ref_Workspace) ([]*statusEvent, error) {
+	client := c.project.Client()
+	order := &pb.OperationOrder{
+		Order: pb.OperationOrder_START_TIME,
+		Desc:  true,
+		Limit: statusEventLimit,
+	}
+
+	var result []*statusEvent
+	add := func(op string, seq uint64, id string, st *pb.Status) {
+		if st == nil {
+			return
+		}
+
+		t, err := ptypes.Timestamp(st.StartTime)
+		if err != nil {
+			return
+		}
+
+		result = append(result, &statusEvent{
+			Operation: op,
+			Sequence:  seq,
+			Id:        id,
+			State:     st.State,
+			Time:      t,
+		})
+	}
+
+	builds, err := client.ListBuilds(c.Ctx, &pb.ListBuildsRequest{
+		Application: appRef,
+		Workspace:   ws,
+		Order:       order,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range builds.Builds {
+		add("build", b.Sequence, b.Id, b.Status)
+	}
+
+	deployments, err := client.ListDeployments(c.Ctx, &pb.ListDeploymentsRequest{
+		Application: appRef,
+		Workspace:   ws,
+		Order:       order,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range deployments.Deployments {
+		add("deployment", d.Sequence, d.Id, d.Status)
+	}
+
+	releases, err := client.ListReleases(c.Ctx, &pb.ListReleasesRequest{
+		Application: appRef,
+		Workspace:   ws,
+		Order:       order,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range releases.Releases {
+		if r.Unimplemented {
+			continue
+		}
+
+		add("release", r.Sequence, r.Id, r.Status)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Time.After(result[j].Time)
+	})
+	if len(result) > statusEventLimit {
+		result = result[:statusEventLimit]
+	}
+
+	return result, nil
+}
+
+// loadInstances sets the number of running instances of the latest
+// deployment for each of the statuses. For deployments without the
+// entrypoint the server counts the instances of the latest status report.
+func (c *StatusCommand) loadInstances(statuses []*appStatus) error {
+	for _, s := range statuses {
+		if s.Deployment == nil {
+			continue
+		}
+
+		resp, err := c.project.Client().ListInstances(c.Ctx, &pb.ListInstancesRequest{
+			Scope: &pb.ListInstancesRequest_DeploymentId{
+				DeploymentId: s.Deployment.Id,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		s.Instances = len(resp.Instances)
+	}
+
+	return nil
+}
+
+// wideTable builds the table for the wide output format, which is a row
+// per application with additional details about the deployment and release.
+func (c *StatusCommand) wideTable(statuses []*appStatus, includeProject bool) *terminal.Table {
+	headers := []string{
+		"App", "Workspace", "Build", "Deployment", "Deployment ID", "Release",
+		"Release URL", "Instances", "Last Deployed", "Health", "Active Jobs", "Last Updated",
+	}
+	if includeProject {
+		headers = append([]string{"Project"}, headers...)
+	}
+
+	tbl := terminal.NewTable(headers...)
+	for _, s := range statuses {
+		health, healthColor := statusHealth(s.Report)
+
+		deploymentId := "n/a"
+		if s.Deployment != nil {
+			deploymentId = s.Deployment.Id
+		}
+
+		releaseURL := "n/a"
+		if s.Release != nil && !s.Release.Unimplemented && s.Release.Url != "" {
+			releaseURL = s.Release.Url
+		}
+
+		updated := "n/a"
+		if t, ok := lastReportTime([]*appStatus{s}); ok {
+			updated = humanize.Time(t)
+		}
+
+		columns := []string{
+			s.App,
+			s.Workspace,
+			c.buildId(s.Build),
+			c.deploymentId(s.Deployment),
+			deploymentId,
+			c.releaseId(s.Release),
+			releaseURL,
+			instanceCount(s),
+			lastDeployed(s, humanize.Time),
+			health,
+			jobActivity(s, ""),
+			updated,
+		}
+		colors := make([]string, len(columns))
+		colors[len(colors)-3] = healthColor
+		colors[len(colors)-2] = terminal.Yellow
+		if includeProject {
+			columns = append([]string{s.Project}, columns...)
+			colors = append([]string{""}, colors...)
+		}
+
+		tbl.Rich(columns, colors)
+	}
+
+	return tbl
+}
+
+// outputCsv outputs a row per application in CSV format.
+func (c *StatusCommand) outputCsv(statuses []*appStatus) error {
+	out, _, err := c.ui.OutputWriters()
+	if err != nil {
+		return err
+	}
+
+	return writeCsv(out, statuses)
+}
+
+// writeCsv writes a header and a row per application to out.
+func writeCsv(out io.Writer, statuses []*appStatus) error {
+	rfc3339 := func(t time.Time) string { return t.Format(time.RFC3339) }
+	optional := func(v string) string {
+		// Missing values are "n/a", or "N/A" for the health
+		if strings.EqualFold(v, "n/a") {
+			return ""
+		}
+
+		return v
+	}
+
+	w := csv.NewWriter(out)
+	w.Write([]string{
+		"project", "app", "workspace", "health", "health_message",
+		"build_id", "build_sequence",
+		"deployment_id", "deployment_sequence", "deployment_url",
+		"release_id", "release_sequence", "release_url",
+		"instances", "last_deployed", "active_jobs", "last_updated",
+	})
+	for _, s := range statuses {
+		health := reportHealth(s.Report)
+		healthMessage := ""
+		if s.Report != nil && s.Report.Health != nil {
+			healthMessage = s.Report.Health.HealthMessage
+		}
+
+		var buildId, buildSeq string
+		if s.Build != nil {
+			buildId = s.Build.Id
+			buildSeq = strconv.FormatUint(s.Build.Sequence, 10)
+		}
+
+		var deployId, deploySeq, deployURL string
+		if s.Deployment != nil {
+			deployId = s.Deployment.Id
+			deploySeq = strconv.FormatUint(s.Deployment.Sequence, 10)
+			deployURL = s.Deployment.Url
+		}
+
+		var releaseId, releaseSeq, releaseURL string
+		if s.Release != nil && !s.Release.Unimplemented {
+			releaseId = s.Release.Id
+			releaseSeq = strconv.FormatUint(s.Release.Sequence, 10)
+			releaseURL = s.Release.Url
+		}
+
+		var updated string
+		if t, ok := lastReportTime([]*appStatus{s}); ok {
+			updated = rfc3339(t)
+		}
+
+		w.Write([]string{
+			s.Project, s.App, s.Workspace, optional(health), healthMessage,
+			buildId, buildSeq,
+			deployId, deploySeq, deployURL,
+			releaseId, releaseSeq, releaseURL,
+			optional(instanceCount(s)), optional(lastDeployed(s, rfc3339)), jobActivity(s, ""), updated,
+		})
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+// loadJobs sets the queued and running jobs of each of the statuses. If
+// project is empty, jobs for every project are loaded.
+func (c *StatusCommand) loadJobs(project string, statuses []*appStatus) error {
+	if len(statuses) == 0 {
+		return nil
+	}
+
+	req := &pb.ListJobsRequest{
+		JobState: []pb.Job_State{pb.Job_QUEUED, pb.Job_WAITING, pb.Job_RUNNING},
+	}
+	if project != "" {
+		req.Application = &pb.Ref_Application{Project: project}
+	}
+	if c.workspaceSet {
+		req.Workspace = c.refWorkspace
+	}
+
+	resp, err := c.project.Client().XListJobs(c.Ctx, req)
+	if status.Code(err) == codes.Unimplemented {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	byApp := map[string]*appStatus{}
+	for _, s := range statuses {
+		byApp[s.Project+"/"+s.App+"/"+s.Workspace] = s
+	}
+
+	for _, job := range resp.Jobs {
+		// Older servers ignore the filters so we check the state here too.
+		switch job.State {
+		case pb.Job_QUEUED, pb.Job_WAITING, pb.Job_RUNNING:
+		default:
+			continue
+		}
+
+		if job.Application == nil || job.Workspace == nil || jobOperation(job) == "" {
+			continue
+		}
+
+		s, ok := byApp[job.Application.Project+"/"+job.Application.Application+"/"+job.Workspace.Workspace]
+		if !ok {
+			continue
+		}
+
+		s.Jobs = append(s.Jobs, job)
+	}
+
+	return nil
+}
+
+// jobOperation returns the name of the operation of a job, or an empty
+// string if it isn't an operation that changes what is deployed.
+func jobOperation(job *pb.Job) string {
+	switch job.Operation.(type) {
+	case *pb.Job_Build:
+		return "build"
+	case *pb.Job_Push:
+		return "push"
+	case *pb.Job_Deploy:
+		return "deploy"
+	case *pb.Job_Release:
+		return "release"
+	case *pb.Job_Destroy:
+		return "destroy"
+	case *pb.Job_Up:
+		return "up"
+	default:
+		return ""
+	}
+}
+
+// jobActivity returns a summary of the active jobs of an application such
+// as "deploy running, release queued", or none if there are none.
+func jobActivity(s *appStatus, none string) string {
+	if len(s.Jobs) == 0 {
+		return none
+	}
+
+	var result []string
+	for _, job := range s.Jobs {
+		state := "queued"
+		if job.State == pb.Job_RUNNING {
+			state = "running"
+		}
+
+		result = append(result, jobOperation(job)+" "+state)
+	}
+
+	return strings.Join(result, ", ")
+}
+
+// instanceCount returns the display value for the number of instances
+// of the latest deployment.
+func instanceCount(s *appStatus) string {
+	if s.Deployment == nil || s.Instances < 0 {
+		return "n/a"
+	}
+
+	return strconv.Itoa(s.Instances)
+}
+
+// lastDeployed returns the time the latest deployment completed formatted
+// with the given function, or "n/a" if there is no deployment.
+func lastDeployed(s *appStatus, format func(time.Time) string) string {
+	if s.Deployment == nil || s.Deployment.Status == nil {
+		return "n/a"
+	}
+
+	t, err := ptypes.Timestamp(s.Deployment.Status.CompleteTime)
+	if err != nil {
+		return "n/a"
+	}
+
+	return format(t)
+}
+
+// projectRows builds the rows of the all projects view, one per project
+// and workspace, with the -filter-health and -sort flags applied.
+func (c *StatusCommand) projectRows(projects []string, statuses []*appStatus) []*projectRow {
+	var rows []*projectRow
+	for _, project := range projects {
+		projectStatuses := filterProject(statuses, project)
+
+		workspaces := statusWorkspaces(projectStatuses)
+		if len(workspaces) == 0 {
+			rows = append(rows, &projectRow{
+				Project:   project,
+				Workspace: "n/a",
+				Health:    "N/A",
+			})
+
+			continue
+		}
+
+		for _, ws := range workspaces {
+			row := &projectRow{
+				Project:   project,
+				Workspace: ws,
+			}
+
+			for _, s := range projectStatuses {
+				if s.Workspace == ws {
+					row.Statuses = append(row.Statuses, s)
+				}
+			}
+
+			row.Health, row.Ready, row.Total = aggregateHealth(row.Statuses)
+			row.Updated, _ = lastReportTime(row.Statuses)
+			rows = append(rows, row)
+		}
+	}
+
+	var result []*projectRow
+	for _, row := range rows {
+		if c.healthMatches(row.Health) {
+			result = append(result, row)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		return c.statusLess(
+			a.Project, a.Workspace, a.Health, a.Updated,
+			b.Project, b.Workspace, b.Health, b.Updated,
+		)
+	})
+
+	return result
+}
+
+// sortFilterApps returns the app statuses with the -filter-health and
+// -sort flags applied.
+func (c *StatusCommand) sortFilterApps(statuses []*appStatus) []*appStatus {
+	var result []*appStatus
+	for _, s := range statuses {
+		if c.healthMatches(reportHealth(s.Report)) {
+			result = append(result, s)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		aTime, _ := lastReportTime([]*appStatus{a})
+		bTime, _ := lastReportTime([]*appStatus{b})
+		return c.statusLess(
+			a.Project+"/"+a.App, a.Workspace, reportHealth(a.Report), aTime,
+			b.Project+"/"+b.App, b.Workspace, reportHealth(b.Report), bTime,
+		)
+	})
+
+	return result
+}
+
+// healthMatches returns true if the health matches the -filter-health
+// flag. Everything matches if the flag isn't set.
+func (c *StatusCommand) healthMatches(health string) bool {
+	if len(c.flagFilterHealth) == 0 {
+		return true
+	}
+
+	for _, v := range c.flagFilterHealth {
+		if v == health {
+			return true
+		}
+	}
+
+	return false
+}
+
+// statusLess compares two rows according to the -sort flag. Ties are
+// broken by name and then workspace so the output is stable.
+func (c *StatusCommand) statusLess(
+	aName, aWs, aHealth string, aTime time.Time,
+	bName, bWs, bHealth string, bTime time.Time,
+) bool {
+	switch c.flagSort {
+	case "workspace":
+		if aWs != bWs {
+			return aWs < bWs
+		}
+
+	case "health":
+		if a, b := healthRank(aHealth), healthRank(bHealth); a != b {
+			return a < b
+		}
+
+	case "time":
+		// Most recently updated first
+		if !aTime.Equal(bTime) {
+			return aTime.After(bTime)
+		}
+	}
+
+	if aName != bName {
+		return aName < bName
+	}
+
+	return aWs < bWs
+}
+
+// healthRank returns the sort rank of a health value, least healthy
+// first. Unknown values, including "N/A", sort after the known values.
+func healthRank(health string) int {
+	for i, v := range statusHealthValues {
+		if v == health {
+			return i
+		}
+	}
+
+	return len(statusHealthValues)
+}
+
+// projectTable builds the table shown for the all projects view. Each
+// project is a single row per workspace with the health of its apps
+// in that workspace rolled up.
+func (c *StatusCommand) projectTable(rows []*projectRow) *terminal.Table {
+	tbl := terminal.NewTable("Project", "Workspace", "App Statuses", "Health", "Last Updated")
+	for _, row := range rows {
+		breakdown := "n/a"
+		if row.Total > 0 {
+			breakdown = fmt.Sprintf("%d/%d READY", row.Ready, row.Total)
+		}
+
+		updated := "n/a"
+		if !row.Updated.IsZero() {
+			updated = humanize.Time(row.Updated)
+		}
+
+		tbl.Rich(
+			[]string{row.Project, row.Workspace, breakdown, row.Health, updated},
+			[]string{"", "", "", healthColor(row.Health), ""},
+		)
+	}
+
+	return tbl
+}
+
+// filterProject returns the statuses that belong to the given project.
+func filterProject(statuses []*appStatus, project string) []*appStatus {
+	var result []*appStatus
+	for _, s := range statuses {
+		if s.Project == project {
+			result = append(result, s)
+		}
+	}
+
+	return result
+}
+
+// statusWorkspaces returns the sorted, unique list of workspaces
+// present in statuses.
+func statusWorkspaces(statuses []*appStatus) []string {
+	seen := map[string]struct{}{}
+	var result []string
+	for _, s := range statuses {
+		if _, ok := seen[s.Workspace]; ok {
+			continue
+		}
+
+		seen[s.Workspace] = struct{}{}
+		result = append(result, s.Workspace)
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+// statusTable builds the table shown for a list of app statuses.
+func (c *StatusCommand) statusTable(statuses []*appStatus) *terminal.Table {
+	tbl := terminal.NewTable(
+		"App", "Workspace", "Build", "Deployment", "Release", "URL", "Health", "Active Jobs",
+		"Last Updated")
+	for _, s := range statuses {
+		health, healthColor := statusHealth(s.Report)
+
+		updated := "n/a"
+		if s.Report != nil {
+			if t, err := ptypes.Timestamp(s.Report.GeneratedTime); err == nil {
+				updated = humanize.Time(t)
+			}
+		}
+
+		tbl.Rich(
+			[]string{
+				s.App,
+				s.Workspace,
+				c.buildId(s.Build),
+				c.deploymentId(s.Deployment),
+				c.releaseId(s.Release),
+				appURL(s),
+				health,
+				jobActivity(s, ""),
+				updated,
+			},
+			[]string{"", "", "", "", "", "", healthColor, terminal.Yellow, ""},
+		)
+	}
+
+	return tbl
+}
+
+func (c *StatusCommand) buildId(b *pb.Build) string {
+	if b == nil {
+		return "n/a"
+	}
+
+	return c.flagId.FormatId(b.Sequence, b.Id)
+}
+
+func (c *StatusCommand) deploymentId(d *pb.Deployment) string {
+	if d == nil {
+		return "n/a"
+	}
+
+	return c.flagId.FormatId(d.Sequence, d.Id)
+}
+
+func (c *StatusCommand) releaseId(r *pb.Release) string {
+	if r == nil || r.Unimplemented {
+		return "n/a"
+	}
+
+	return c.flagId.FormatId(r.Sequence, r.Id)
+}
+
+// appURL returns the URL that an application can be reached at. This
+// is the release URL if there is one, falling back to the deployment URL.
+func appURL(s *appStatus) string {
+	if s.Release != nil && !s.Release.Unimplemented && s.Release.Url != "" {
+		return s.Release.Url
+	}
+
+	if s.Deployment != nil && s.Deployment.Url != "" {
+		return s.Deployment.Url
+	}
+
+	return "n/a"
+}
+
+// statusHealth returns the display value and color of the health for
+// a status report.
+func statusHealth(report *pb.StatusReport) (string, string) {
+	health := reportHealth(report)
+	return health, healthColor(health)
+}
+
+// reportHealth returns the health status of a report, or "N/A" if
+// the report doesn't exist or has no health set.
+func reportHealth(report *pb.StatusReport) string {
+	if report == nil || report.Health == nil || report.Health.HealthStatus == "" {
+		return "N/A"
+	}
+
+	return report.Health.HealthStatus
+}
+
+func healthColor(health string) string {
+	switch health {
+	case "READY", "ALIVE":
+		return terminal.Green
+	case "DOWN":
+		return terminal.Red
+	case "PARTIAL":
+		return terminal.Yellow
+	default:
+		return ""
+	}
+}
+
+// aggregateHealth rolls the health of a set of apps up into a single
+// value. Any DOWN app makes the aggregate DOWN, and the aggregate is only
+// READY if every app is READY. If every app is at least ALIVE the
+// aggregate is ALIVE. If none of the apps have a status report the
+// aggregate is "N/A". Any other combination, including some apps missing
+// a status report, is PARTIAL.
+//
+// The number of READY apps and the total number of apps are also
+// returned so callers can show a breakdown.
+func aggregateHealth(statuses []*appStatus) (health string, ready, total int) {
+	counts := map[string]int{}
+	reported := 0
+	for _, s := range statuses {
+		h := reportHealth(s.Report)
+		counts[h]++
+		if h != "N/A" {
+			reported++
+		}
+	}
+
+	total = len(statuses)
+	ready = counts["READY"]
+
+	switch {
+	case reported == 0:
+		health = "N/A"
+	case counts["DOWN"] > 0:
+		health = "DOWN"
+	case ready == total:
+		health = "READY"
+	case ready+counts["ALIVE"] == total:
+		health = "ALIVE"
+	case counts["UNKNOWN"] == reported:
+		health = "UNKNOWN"
+	default:
+		health = "PARTIAL"
+	}
+
+	return health, ready, total
+}
+
+// unhealthyApps returns the names of the apps that are DOWN or PARTIAL,
+// in the form "project/app (workspace)". Apps without a status report are
+// unhealthy too, since nothing shows that they're running.
+func unhealthyApps(statuses []*appStatus) []string {
+	var result []string
+	for _, s := range statuses {
+		switch reportHealth(s.Report) {
+		case "DOWN", "PARTIAL", "N/A":
+			result = append(result, fmt.Sprintf("%s/%s (%s)", s.Project, s.App, s.Workspace))
+		}
+	}
+
+	return result
+}
+
+// lastReportTime returns the most recent generated time of the status
+// reports for a set of apps. The boolean is false if there are none.
+func lastReportTime(statuses []*appStatus) (time.Time, bool) {
+	var result time.Time
+	for _, s := range statuses {
+		if s.Report == nil {
+			continue
+		}
+
+		t, err := ptypes.Timestamp(s.Report.GeneratedTime)
+		if err != nil {
+			continue
+		}
+
+		if t.After(result) {
+			result = t
+		}
+	}
+
+	return result, !result.IsZero()
+}
+
+// outputJson outputs v as JSON.
+func (c *StatusCommand) outputJson(v interface{}) error {
+	data, err := c.marshalJson(v)
+	if err != nil {
+		return err
+	}
+
+	c.ui.Output(string(data))
+
+	return nil
+}
+
+// marshalJson encodes v as JSON. In watch mode each value is encoded on
+// a single line so that the output is a stream of JSON lines.
+func (c *StatusCommand) marshalJson(v interface{}) ([]byte, error) {
+	if c.flagWatch {
+		return json.Marshal(v)
+	}
+
+	return json.MarshalIndent(v, "", "  ")
+}
+
+// projectJson returns the JSON representation of a project, including
+// only the entries of statuses that belong to it.
+func (c *StatusCommand) projectJson(project string, statuses []*appStatus) interface{} {
+	projectStatuses := filterProject(statuses, project)
+
+	apps := []interface{}{}
+	for _, s := range projectStatuses {
+		apps = append(apps, c.appJson(s))
+	}
+
+	health, ready, total := aggregateHealth(projectStatuses)
+
+	i := map[string]interface{}{}
+	i["project"] = project
+	i["workspaces"] = statusWorkspaces(projectStatuses)
+	i["health"] = health
+	i["ready_count"] = ready
+	i["app_count"] = total
+	i["applications"] = apps
+
+	return i
+}
+
+func (c *StatusCommand) appJson(s *appStatus) interface{} {
+	i := map[string]interface{}{}
+
+	i["project"] = s.Project
+	i["application"] = s.App
+	i["workspace"] = s.Workspace
+	i["status_report"] = statusReportJson(s.Report)
+	i["url"] = nil
+	i["build"] = nil
+	i["deployment"] = nil
+	i["release"] = nil
+
+	if url := appURL(s); url != "n/a" {
+		i["url"] = url
+	}
+
+	if b := s.Build; b != nil {
+		build := map[string]interface{}{}
+		build["id"] = b.Id
+		build["sequence"] = b.Sequence
+		build["component"] = b.Component.Name
+		build["status"] = c.statusJson(b.Status)
+		i["build"] = build
+	}
+
+	if d := s.Deployment; d != nil {
+		dep := map[string]interface{}{}
+		dep["id"] = d.Id
+		dep["sequence"] = d.Sequence
+		dep["url"] = d.Url
+		dep["component"] = d.Component.Name
+		dep["status"] = c.statusJson(d.Status)
+		i["deployment"] = dep
+	}
+
+	if r := s.Release; r != nil && !r.Unimplemented {
+		rel := map[string]interface{}{}
+		rel["id"] = r.Id
+		rel["sequence"] = r.Sequence
+		rel["url"] = r.Url
+		rel["deployment_id"] = r.DeploymentId
+		rel["component"] = r.Component.Name
+		rel["status"] = c.statusJson(r.Status)
+		i["release"] = rel
+	}
+
+	jobs := []interface{}{}
+	for _, job := range s.Jobs {
+		jobs = append(jobs, map[string]interface{}{
+			"id":         job.Id,
+			"operation":  jobOperation(job),
+			"state":      job.State.String(),
+			"queue_time": timeJson(job.QueueTime),
+		})
+	}
+	i["active_jobs"] = jobs
+
+	if s.Events != nil {
+		events := []interface{}{}
+		for _, e := range s.Events {
+			event := map[string]interface{}{}
+			event["operation"] = e.Operation
+			event["id"] = e.Id
+			event["sequence"] = e.Sequence
+			event["state"] = e.State.String()
+			event["time"] = e.Time.Format(time.RFC3339Nano)
+			events = append(events, event)
+		}
+		i["events"] = events
+	}
+
+	return i
+}
+
+func statusReportJson(report *pb.StatusReport) interface{} {
+	if report == nil {
+		return nil
+	}
+
+	i := map[string]interface{}{}
+	i["id"] = report.Id
+	i["external"] = report.External
+	i["generated_time"] = timeJson(report.GeneratedTime)
+	i["health"] = healthJson(report.Health)
+
+	switch target := report.TargetId.(type) {
+	case *pb.StatusReport_DeploymentId:
+		i["deployment_id"] = target.DeploymentId
+	case *pb.StatusReport_ReleaseId:
+		i["release_id"] = target.ReleaseId
+	}
+
+	resources := []interface{}{}
+	for _, h := range report.ResourcesHealth {
+		resources = append(resources, healthJson(h))
+	}
+	i["resources_health"] = resources
+
+	details := []interface{}{}
+	for _, r := range report.Resources {
+		details = append(details, resourceJson(r))
+	}
+	i["resources"] = details
+
+	return i
+}
+
+func resourceJson(r *pb.StatusReport_Resource) interface{} {
+	i := map[string]interface{}{}
+	i["id"] = r.Id
+	i["name"] = r.Name
+	i["type"] = r.Type
+	i["platform"] = r.Platform
+	i["platform_url"] = r.PlatformUrl
+	i["parent_resource_id"] = r.ParentResourceId
+	i["created_time"] = timeJson(r.CreatedTime)
+	i["health"] = healthJson(r.Health)
+	i["health_message"] = r.HealthMessage
+
+	return i
+}
+
+func (c *StatusCommand) statusJson(s *pb.Status) interface{} {
+	if s == nil {
+		return nil
+	}
+
+	i := map[string]interface{}{}
+	i["state"] = s.State.String()
+	i["start_time"] = timeJson(s.StartTime)
+	i["complete_time"] = timeJson(s.CompleteTime)
+
+	return i
+}
+
+func healthJson(h *pb.StatusReport_Health) interface{} {
+	if h == nil {
+		return nil
+	}
+
+	i := map[string]interface{}{}
+	i["health_status"] = h.HealthStatus
+	i["health_message"] = h.HealthMessage
+
+	if h.Name != "" {
+		i["name"] = h.Name
+	}
+	if h.Id != "" {
+		i["id"] = h.Id
+	}
+
+	return i
+}
+
+// timeJson formats a timestamp for JSON output. An unset timestamp
+// is returned as nil so that it is encoded as null.
+func timeJson(ts *timestamp.Timestamp) interface{} {
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		return nil
+	}
+
+	return t.Format(time.RFC3339Nano)
+}
+
+func (c *StatusCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "json",
+			Target: &c.flagJson,
+			Usage:  "Output the status information as JSON.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "all-projects",
+			Target: &c.flagAllProjects,
+			Usage: "Show the status of every project, even when run from within " +
+				"a project directory.",
+		})
+
+		initIdFormat(f, &c.flagId)
+
+		f.EnumSingleVar(&flag.EnumSingleVar{
+			Name:    "sort",
+			Target:  &c.flagSort,
+			Values:  []string{"name", "workspace", "health", "time"},
+			Default: "name",
+			Usage: "Sort the output. Sorting by health shows the least healthy first, " +
+				"and sorting by time shows the most recently updated first.",
+		})
+
+		f.EnumVar(&flag.EnumVar{
+			Name:   "filter-health",
+			Target: &c.flagFilterHealth,
+			Values: statusHealthValues,
+			Usage: "Only show entries with the given health, i.e. 'DOWN,PARTIAL'. " +
+				"When viewing all projects, this filters on the rolled up project health.",
+		})
+
+		f.StringMapVar(&flag.StringMapVar{
+			Name:   "label",
+			Target: &c.flagLabels,
+			Usage: "Only show applications with the given label, in the format " +
+				"key=value. The labels of an application include the labels of " +
+				"its project. This can be specified multiple times and all labels " +
+				"must match.",
+		})
+
+		f.EnumSingleVar(&flag.EnumSingleVar{
+			Name:    "output",
+			Target:  &c.flagOutput,
+			Values:  []string{"table", "wide", "csv"},
+			Default: "table",
+			Usage: "Output format. The wide and csv formats show a row per application " +
+				"with additional columns such as the instance count and last deploy time. " +
+				"This is ignored if -json is set.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:    "verbose",
+			Aliases: []string{"V"},
+			Target:  &c.flagVerbose,
+			Usage: "Display details about each resource of an application, such as " +
+				"its type, ID, and the reason for its health. This only applies " +
+				"when viewing a single application.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "server",
+			Target: &c.flagServer,
+			Usage: "Show the health of the Waypoint server itself instead of " +
+				"applications, such as its version, database size, runners, and " +
+				"job queue depth.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "check",
+			Target: &c.flagCheck,
+			Usage: "Exit with code 2 if any of the shown applications are DOWN, " +
+				"PARTIAL, or have no status report, or if no applications are " +
+				"shown. This can be used as a health gate in scripts and CI.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "watch",
+			Target: &c.flagWatch,
+			Usage: "Continuously refresh the status until interrupted. With -json, " +
+				"a JSON document is output on a single line for every refresh.",
+		})
+
+		f.DurationVar(&flag.DurationVar{
+			Name:    "refresh-interval",
+			Target:  &c.flagRefreshInterval,
+			Usage:   "How often to refresh the status when -watch is set. i.e. '10s'.",
+			Default: 5 * time.Second,
+		})
+	})
+}
+
+func (c *StatusCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *StatusCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *StatusCommand) Synopsis() string {
+	return "List the status of projects and applications."
+}
+
+func (c *StatusCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint status [options] [project[/app]]
+
+  View the current status of projects and applications managed by Waypoint.
+
+  With no arguments, the status of the project in the current directory
+  is shown, or a single application of it if "-app" is set. Outside of a
+  project directory, or with "-all-projects", the status of every project
+  is shown instead. A single project name shows the latest build,
+  deployment, and release of every application in that project, and
+  "project/app" shows a detailed view of a single application including
+  the health of each of its resources and its most recent events. Use
+  "-verbose" with "project/app" to see the type, ID, and health reason of
+  every resource, such as each pod or task, to find a failing replica.
+
+  Use "waypoint status history" to see previous status reports of an
+  application.
+
+  By default, status is shown for every workspace that a project or
+  application is present in so the same application can be compared across
+  workspaces. Specify "-workspace" to only show a single workspace.
+
+  Use "-output=wide" to show more details for each application, or
+  "-output=csv" to export the status of every application as CSV.
+
+  Use "-filter-health" and "-sort" to surface unhealthy applications
+  when managing many projects, for example "-filter-health=DOWN,PARTIAL".
+
+  Use "-label" to only show applications with the given labels, for
+  example "-label team=payments". Labels are set with the "labels"
+  setting of the project and apps in waypoint.hcl.
+
+  The "Active Jobs" shown are the build, deploy, and release operations
+  that are queued or running for each application. An application that
+  is DOWN while a deploy is running is likely mid-rollout rather than
+  failing.
+
+  Use "-check" to use this command as a health gate. With "-check", the
+  exit code is 0 if every shown application is healthy, 1 if the status
+  couldn't be retrieved, and 2 if any shown application is DOWN or PARTIAL
+  or has no status report. The exit code is also 2 if no applications are
+  shown, such as for a project without applications or a "-filter-health"
+  that matches none of them.
+
+  Use "-server" to view the health of the Waypoint server itself: its
+  version, database size, registered runners, job queue depth, and URL
+  service connectivity. With "-check", the exit code is 2 if jobs are
+  queued but no runners are registered or the URL service is enabled but
+  not connected.
+
+  Use "-watch" to keep refreshing the status, for example while a deploy
+  is in progress, to see health transitions as they happen.
+
+  The health shown is taken from the latest status report generated for
+  each application. When viewing all projects, the health of each project
+  is rolled up from its applications: it is DOWN if any application is
+  DOWN, READY only if every application is READY, and PARTIAL otherwise.
+
+` + c.Flags().Help())
+}
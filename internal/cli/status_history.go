@@ -0,0 +1,350 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/dustin/go-humanize"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// statusHistoryPageSize is the number of status reports requested from
+// the server at a time.
+const statusHistoryPageSize = 50
+
+type StatusHistoryCommand struct {
+	*baseCommand
+
+	flagJson  bool
+	flagLimit uint
+}
+
+// resourceChange is a change to the health of a single resource between
+// two status reports.
+type resourceChange struct {
+	Name string
+
+	// Change is one of "added", "removed", or "health".
+	Change string
+
+	// From and To are the health of the resource before and after the
+	// change. From is empty for added resources and To is empty for
+	// removed resources.
+	From string
+	To   string
+}
+
+func (c *StatusHistoryCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithConfig(true),
+	); err != nil {
+		return 1
+	}
+
+	// The application is either given as "project/app" or is the app
+	// set with "-app" in the project of the current directory.
+	var appRef *pb.Ref_Application
+	switch {
+	case len(c.args) > 1:
+		c.ui.Output("At most one argument is expected.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+
+	case len(c.args) == 1:
+		match := reAppTarget.FindStringSubmatch(c.args[0])
+		if match == nil {
+			c.ui.Output("The argument must be in the form \"project/app\".\n\n"+c.Help(),
+				terminal.WithErrorStyle())
+			return 1
+		}
+
+		appRef = &pb.Ref_Application{Project: match[1], Application: match[2]}
+
+	case c.cfg != nil && c.flagApp != "":
+		appRef = &pb.Ref_Application{Project: c.cfg.Project, Application: c.flagApp}
+
+	default:
+		c.ui.Output("An application must be specified.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	reports, err := c.statusReports(appRef)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	if c.flagJson {
+		if err := c.outputJson(reports); err != nil {
+			c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return 1
+		}
+
+		return 0
+	}
+
+	if len(reports) == 0 {
+		c.ui.Output("No status reports found for application %q in workspace %q.",
+			appRef.Application, c.refWorkspace.Workspace)
+		return 0
+	}
+
+	c.ui.Output("Status report history of %q in workspace %q:",
+		appRef.Project+"/"+appRef.Application, c.refWorkspace.Workspace, terminal.WithHeaderStyle())
+
+	tbl := terminal.NewTable("Generated", "Health", "Transition", "Target", "Resource Changes")
+	for i, report := range reports {
+		var prev *pb.StatusReport
+		if i+1 < len(reports) {
+			prev = reports[i+1]
+		}
+
+		generated := "n/a"
+		if t, err := ptypes.Timestamp(report.GeneratedTime); err == nil {
+			generated = humanize.Time(t)
+		}
+
+		health, color := statusHealth(report)
+
+		transition := ""
+		if prev != nil && reportHealth(prev) != health {
+			transition = reportHealth(prev) + " → " + health
+		}
+
+		target := "n/a"
+		switch t := report.TargetId.(type) {
+		case *pb.StatusReport_DeploymentId:
+			target = "deployment:" + t.DeploymentId
+		case *pb.StatusReport_ReleaseId:
+			target = "release:" + t.ReleaseId
+		}
+
+		var changes []string
+		for _, ch := range resourceChanges(prev, report) {
+			changes = append(changes, ch.String())
+		}
+		if len(changes) == 0 {
+			changes = []string{""}
+		}
+
+		tbl.Rich(
+			[]string{generated, health, transition, target, changes[0]},
+			[]string{"", color},
+		)
+
+		// Additional changes each get their own row so that reports with
+		// many changed resources remain readable.
+		for _, ch := range changes[1:] {
+			tbl.Rich([]string{"", "", "", "", ch}, nil)
+		}
+	}
+
+	c.ui.Table(tbl)
+
+	return 0
+}
+
+// statusReports returns the status reports of the application in the
+// current workspace, newest first, up to the limit.
+func (c *StatusHistoryCommand) statusReports(appRef *pb.Ref_Application) ([]*pb.StatusReport, error) {
+	var result []*pb.StatusReport
+	var token string
+	for {
+		resp, err := c.project.Client().ListStatusReports(c.Ctx, &pb.ListStatusReportsRequest{
+			Application: appRef,
+			Workspace:   c.refWorkspace,
+			Order: &pb.OperationOrder{
+				Order: pb.OperationOrder_COMPLETE_TIME,
+				Desc:  true,
+			},
+			PageSize:  statusHistoryPageSize,
+			PageToken: token,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, resp.StatusReports...)
+		if c.flagLimit > 0 && len(result) >= int(c.flagLimit) {
+			return result[:c.flagLimit], nil
+		}
+
+		// Older servers don't paginate and return everything at once
+		// without a next page token.
+		token = resp.NextPageToken
+		if token == "" {
+			return result, nil
+		}
+	}
+}
+
+// resourceChanges returns the changes to the health of the resources of
+// cur compared to prev. If prev is nil, there are no changes.
+func resourceChanges(prev, cur *pb.StatusReport) []*resourceChange {
+	if prev == nil || cur == nil {
+		return nil
+	}
+
+	key := func(h *pb.StatusReport_Health) string {
+		if h.Id != "" {
+			return h.Id
+		}
+
+		return h.Name
+	}
+
+	name := func(h *pb.StatusReport_Health) string {
+		if h.Name != "" {
+			return h.Name
+		}
+
+		return h.Id
+	}
+
+	previous := map[string]*pb.StatusReport_Health{}
+	for _, h := range prev.ResourcesHealth {
+		previous[key(h)] = h
+	}
+
+	var result []*resourceChange
+	seen := map[string]struct{}{}
+	for _, h := range cur.ResourcesHealth {
+		k := key(h)
+		seen[k] = struct{}{}
+
+		p, ok := previous[k]
+		switch {
+		case !ok:
+			result = append(result, &resourceChange{
+				Name:   name(h),
+				Change: "added",
+				To:     h.HealthStatus,
+			})
+
+		case p.HealthStatus != h.HealthStatus:
+			result = append(result, &resourceChange{
+				Name:   name(h),
+				Change: "health",
+				From:   p.HealthStatus,
+				To:     h.HealthStatus,
+			})
+		}
+	}
+
+	// Removed resources are reported in the order of the previous report.
+	for _, h := range prev.ResourcesHealth {
+		if _, ok := seen[key(h)]; !ok {
+			result = append(result, &resourceChange{
+				Name:   name(h),
+				Change: "removed",
+				From:   h.HealthStatus,
+			})
+		}
+	}
+
+	return result
+}
+
+func (ch *resourceChange) String() string {
+	switch ch.Change {
+	case "added":
+		return fmt.Sprintf("+%s (%s)", ch.Name, ch.To)
+	case "removed":
+		return fmt.Sprintf("-%s", ch.Name)
+	default:
+		return fmt.Sprintf("%s: %s → %s", ch.Name, ch.From, ch.To)
+	}
+}
+
+func (c *StatusHistoryCommand) outputJson(reports []*pb.StatusReport) error {
+	output := []interface{}{}
+	for i, report := range reports {
+		var prev *pb.StatusReport
+		if i+1 < len(reports) {
+			prev = reports[i+1]
+		}
+
+		v := statusReportJson(report).(map[string]interface{})
+		if prev != nil {
+			v["previous_health"] = healthJson(prev.Health)
+		}
+
+		changes := []interface{}{}
+		for _, ch := range resourceChanges(prev, report) {
+			changes = append(changes, map[string]interface{}{
+				"name":   ch.Name,
+				"change": ch.Change,
+				"from":   ch.From,
+				"to":     ch.To,
+			})
+		}
+		v["resource_changes"] = changes
+
+		output = append(output, v)
+	}
+
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	c.ui.Output(string(data))
+
+	return nil
+}
+
+func (c *StatusHistoryCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.BoolVar(&flag.BoolVar{
+			Name:    "json",
+			Target:  &c.flagJson,
+			Default: false,
+			Usage:   "Output the status reports as JSON.",
+		})
+
+		f.UintVar(&flag.UintVar{
+			Name:    "limit",
+			Target:  &c.flagLimit,
+			Default: 20,
+			Usage:   "Maximum number of status reports to show. Set to 0 to show all.",
+		})
+	})
+}
+
+func (c *StatusHistoryCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *StatusHistoryCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *StatusHistoryCommand) Synopsis() string {
+	return "List previous status reports of an application."
+}
+
+func (c *StatusHistoryCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint status history [options] [project/app]
+
+  List previous status reports of an application, newest first.
+
+  Each report shows its overall health, the transition from the health
+  of the report before it, and the resources that were added, removed,
+  or changed health since the report before it.
+
+  With no arguments, the application set with "-app" in the project of
+  the current directory is used. Only reports for the current workspace
+  are shown.
+
+` + c.Flags().Help())
+}
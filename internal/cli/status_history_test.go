@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestResourceChanges(t *testing.T) {
+	report := func(resources ...*pb.StatusReport_Health) *pb.StatusReport {
+		return &pb.StatusReport{ResourcesHealth: resources}
+	}
+
+	resource := func(id, health string) *pb.StatusReport_Health {
+		return &pb.StatusReport_Health{Id: id, Name: "pod-" + id, HealthStatus: health}
+	}
+
+	t.Run("no previous report", func(t *testing.T) {
+		require.Empty(t, resourceChanges(nil, report(resource("1", "READY"))))
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		require.Empty(t, resourceChanges(
+			report(resource("1", "READY")),
+			report(resource("1", "READY")),
+		))
+	})
+
+	t.Run("added, removed, and changed", func(t *testing.T) {
+		changes := resourceChanges(
+			report(resource("1", "READY"), resource("2", "READY")),
+			report(resource("1", "DOWN"), resource("3", "ALIVE")),
+		)
+
+		var result []string
+		for _, ch := range changes {
+			result = append(result, ch.String())
+		}
+
+		require.Equal(t, []string{
+			"pod-1: READY → DOWN",
+			"+pod-3 (ALIVE)",
+			"-pod-2",
+		}, result)
+	})
+}
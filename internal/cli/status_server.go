@@ -0,0 +1,149 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/dustin/go-humanize"
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// runServerStatus outputs the health of the server and returns the exit
+// code for the command.
+func (c *StatusCommand) runServerStatus() int {
+	resp, err := c.project.Client().GetServerStatus(c.Ctx, &empty.Empty{})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	problems := serverProblems(resp)
+
+	if c.flagJson {
+		if err := c.outputJson(serverStatusJson(resp, problems)); err != nil {
+			c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			return 1
+		}
+	} else {
+		c.outputServerStatus(resp)
+
+		if len(problems) > 0 {
+			c.ui.Output("")
+			for _, p := range problems {
+				c.ui.Output(p, terminal.WithWarningStyle())
+			}
+		}
+	}
+
+	if c.flagCheck && len(problems) > 0 {
+		return statusCheckExitCode
+	}
+
+	return 0
+}
+
+func (c *StatusCommand) outputServerStatus(resp *pb.GetServerStatusResponse) {
+	version := "n/a"
+	api := "n/a"
+	if v := resp.Version; v != nil {
+		if v.Version != "" {
+			version = v.Version
+		}
+
+		if v.Api != nil {
+			api = fmt.Sprintf("%d (minimum %d)", v.Api.Current, v.Api.Minimum)
+		}
+	}
+
+	urlService := "disabled"
+	if u := resp.UrlService; u != nil && u.Enabled {
+		urlService = "not connected"
+		if u.Connected {
+			urlService = "connected"
+		}
+
+		if u.ApiAddress != "" {
+			urlService += " (" + u.ApiAddress + ")"
+		}
+	}
+
+	c.ui.Output("Waypoint server status:", terminal.WithHeaderStyle())
+	c.ui.NamedValues([]terminal.NamedValue{
+		{
+			Name: "Version", Value: version,
+		},
+		{
+			Name: "API Protocol", Value: api,
+		},
+		{
+			Name: "Database Size", Value: humanize.IBytes(uint64(resp.DbSizeBytes)),
+		},
+		{
+			Name: "Runners", Value: resp.RunnerCount,
+		},
+		{
+			Name: "Queued Jobs", Value: resp.QueuedJobCount,
+		},
+		{
+			Name: "Running Jobs", Value: resp.RunningJobCount,
+		},
+		{
+			Name: "URL Service", Value: urlService,
+		},
+	}, terminal.WithInfoStyle())
+}
+
+// serverProblems returns a description of each problem with the health
+// of the server, or nil if it is healthy.
+func serverProblems(resp *pb.GetServerStatusResponse) []string {
+	var result []string
+	if resp.RunnerCount == 0 && resp.QueuedJobCount > 0 {
+		result = append(result,
+			"Jobs are queued but no runners are registered to execute them.")
+	}
+
+	if u := resp.UrlService; u != nil && u.Enabled && !u.Connected {
+		result = append(result,
+			"The URL service is enabled but the server isn't connected to it.")
+	}
+
+	return result
+}
+
+func serverStatusJson(resp *pb.GetServerStatusResponse, problems []string) interface{} {
+	i := map[string]interface{}{}
+	i["db_size_bytes"] = resp.DbSizeBytes
+	i["runner_count"] = resp.RunnerCount
+	i["queued_job_count"] = resp.QueuedJobCount
+	i["running_job_count"] = resp.RunningJobCount
+	i["healthy"] = len(problems) == 0
+
+	if problems == nil {
+		problems = []string{}
+	}
+	i["problems"] = problems
+
+	i["version"] = nil
+	if v := resp.Version; v != nil {
+		version := map[string]interface{}{}
+		version["version"] = v.Version
+		if v.Api != nil {
+			version["api_current"] = v.Api.Current
+			version["api_minimum"] = v.Api.Minimum
+		}
+		i["version"] = version
+	}
+
+	urlService := map[string]interface{}{}
+	if u := resp.UrlService; u != nil {
+		urlService["enabled"] = u.Enabled
+		urlService["connected"] = u.Connected
+		urlService["api_address"] = u.ApiAddress
+	}
+	i["url_service"] = urlService
+
+	return i
+}
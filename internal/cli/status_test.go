@@ -0,0 +1,1137 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	clientpkg "github.com/hashicorp/waypoint/internal/client"
+	configpkg "github.com/hashicorp/waypoint/internal/config"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/server/singleprocess"
+)
+
+func TestAggregateHealth(t *testing.T) {
+	report := func(health string) *appStatus {
+		return &appStatus{
+			Report: &pb.StatusReport{
+				Health: &pb.StatusReport_Health{HealthStatus: health},
+			},
+		}
+	}
+
+	cases := []struct {
+		Name     string
+		Statuses []*appStatus
+		Health   string
+		Ready    int
+	}{
+		{
+			"no apps",
+			nil,
+			"N/A",
+			0,
+		},
+
+		{
+			"no reports",
+			[]*appStatus{{}, {}},
+			"N/A",
+			0,
+		},
+
+		{
+			"all ready",
+			[]*appStatus{report("READY"), report("READY")},
+			"READY",
+			2,
+		},
+
+		{
+			"ready and alive",
+			[]*appStatus{report("READY"), report("ALIVE")},
+			"ALIVE",
+			1,
+		},
+
+		{
+			"any down",
+			[]*appStatus{report("READY"), report("DOWN"), report("READY")},
+			"DOWN",
+			2,
+		},
+
+		{
+			"missing report is partial",
+			[]*appStatus{report("READY"), {}},
+			"PARTIAL",
+			1,
+		},
+
+		{
+			"mixed",
+			[]*appStatus{report("READY"), report("UNKNOWN")},
+			"PARTIAL",
+			1,
+		},
+
+		{
+			"all unknown",
+			[]*appStatus{report("UNKNOWN"), report("UNKNOWN")},
+			"UNKNOWN",
+			0,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			health, ready, total := aggregateHealth(tt.Statuses)
+			require.Equal(tt.Health, health)
+			require.Equal(tt.Ready, ready)
+			require.Equal(len(tt.Statuses), total)
+		})
+	}
+}
+
+func TestStatusCommandSortFilterApps(t *testing.T) {
+	app := func(name, ws, health string) *appStatus {
+		return &appStatus{
+			Project:   "p",
+			App:       name,
+			Workspace: ws,
+			Report: &pb.StatusReport{
+				Health: &pb.StatusReport_Health{HealthStatus: health},
+			},
+		}
+	}
+
+	names := func(statuses []*appStatus) []string {
+		var result []string
+		for _, s := range statuses {
+			result = append(result, s.App+"@"+s.Workspace)
+		}
+
+		return result
+	}
+
+	statuses := []*appStatus{
+		app("web", "staging", "READY"),
+		app("api", "production", "DOWN"),
+		app("web", "production", "PARTIAL"),
+		app("api", "staging", "READY"),
+	}
+
+	t.Run("sort by name", func(t *testing.T) {
+		c := &StatusCommand{flagSort: "name"}
+		require.Equal(t, []string{
+			"api@production", "api@staging", "web@production", "web@staging",
+		}, names(c.sortFilterApps(statuses)))
+	})
+
+	t.Run("sort by workspace", func(t *testing.T) {
+		c := &StatusCommand{flagSort: "workspace"}
+		require.Equal(t, []string{
+			"api@production", "web@production", "api@staging", "web@staging",
+		}, names(c.sortFilterApps(statuses)))
+	})
+
+	t.Run("sort by health", func(t *testing.T) {
+		c := &StatusCommand{flagSort: "health"}
+		require.Equal(t, []string{
+			"api@production", "web@production", "api@staging", "web@staging",
+		}, names(c.sortFilterApps(statuses)))
+	})
+
+	t.Run("filter health", func(t *testing.T) {
+		c := &StatusCommand{
+			flagSort:         "name",
+			flagFilterHealth: []string{"DOWN", "PARTIAL"},
+		}
+		require.Equal(t, []string{
+			"api@production", "web@production",
+		}, names(c.sortFilterApps(statuses)))
+	})
+}
+
+func TestUnhealthyApps(t *testing.T) {
+	app := func(name, health string) *appStatus {
+		s := &appStatus{Project: "p", App: name, Workspace: "default"}
+		if health != "" {
+			s.Report = &pb.StatusReport{
+				Health: &pb.StatusReport_Health{HealthStatus: health},
+			}
+		}
+
+		return s
+	}
+
+	require.Empty(t, unhealthyApps([]*appStatus{
+		app("a", "READY"),
+		app("b", "ALIVE"),
+	}))
+
+	require.Equal(t, []string{"p/b (default)", "p/c (default)"}, unhealthyApps([]*appStatus{
+		app("a", "READY"),
+		app("b", "DOWN"),
+		app("c", "PARTIAL"),
+	}))
+
+	// An app without a status report may not be running at all
+	require.Equal(t, []string{"p/b (default)"}, unhealthyApps([]*appStatus{
+		app("a", "READY"),
+		app("b", ""),
+	}))
+}
+
+func TestStatusCommandFormatProjectAppStatus(t *testing.T) {
+	ctx := context.Background()
+	client := singleprocess.TestServer(t)
+
+	for app, health := range map[string]string{"web": "READY", "api": "DOWN"} {
+		ref := &pb.Ref_Application{Project: "p", Application: app}
+		singleprocess.TestApp(t, client, ref)
+
+		_, err := client.UpsertStatusReport(ctx, &pb.UpsertStatusReportRequest{
+			StatusReport: &pb.StatusReport{
+				Application: ref,
+				Workspace:   &pb.Ref_Workspace{Workspace: "default"},
+				Status:      &pb.Status{State: pb.Status_SUCCESS},
+				Health:      &pb.StatusReport_Health{HealthStatus: health},
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	_, err := client.UpsertProject(ctx, &pb.UpsertProjectRequest{
+		Project: &pb.Project{Name: "empty"},
+	})
+	require.NoError(t, err)
+
+	cases := []struct {
+		Name    string
+		Project string
+		Filter  []string
+		Apps    []string
+	}{
+		{
+			"every app",
+			"p",
+			nil,
+			[]string{"api", "web"},
+		},
+
+		{
+			"health filter",
+			"p",
+			[]string{"DOWN"},
+			[]string{"api"},
+		},
+
+		{
+			"health filter matches nothing",
+			"p",
+			[]string{"PARTIAL"},
+			nil,
+		},
+
+		{
+			"project without apps",
+			"empty",
+			nil,
+			nil,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			c := testStatusCommand(t, client)
+			c.flagFilterHealth = tt.Filter
+
+			statuses, err := c.FormatProjectAppStatus(tt.Project)
+			require.NoError(err)
+
+			var apps []string
+			for _, s := range statuses {
+				apps = append(apps, s.App)
+			}
+			require.Equal(tt.Apps, apps)
+		})
+	}
+}
+
+// testStatusCommand returns a status command that uses the given server.
+func testStatusCommand(t *testing.T, client pb.WaypointClient) *StatusCommand {
+	ctx := context.Background()
+
+	project, err := clientpkg.New(ctx, clientpkg.WithClient(client))
+	require.NoError(t, err)
+	t.Cleanup(func() { project.Close() })
+
+	return &StatusCommand{
+		baseCommand: &baseCommand{
+			Ctx:          ctx,
+			ui:           terminal.NonInteractiveUI(ctx),
+			project:      project,
+			refWorkspace: &pb.Ref_Workspace{Workspace: "default"},
+		},
+	}
+}
+
+func TestParallel(t *testing.T) {
+	t.Run("calls every index with bounded concurrency", func(t *testing.T) {
+		require := require.New(t)
+
+		var running, max int32
+		result := make([]int, 20)
+		err := parallel(3, len(result), func(i int) error {
+			cur := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				old := atomic.LoadInt32(&max)
+				if cur <= old || atomic.CompareAndSwapInt32(&max, old, cur) {
+					break
+				}
+			}
+
+			result[i] = i * 2
+			return nil
+		})
+		require.NoError(err)
+		require.True(atomic.LoadInt32(&max) <= 3)
+		for i, v := range result {
+			require.Equal(i*2, v)
+		}
+	})
+
+	t.Run("returns the error", func(t *testing.T) {
+		err := parallel(2, 10, func(i int) error {
+			if i == 4 {
+				return errors.New("failed")
+			}
+
+			return nil
+		})
+		require.EqualError(t, err, "failed")
+	})
+
+	t.Run("no work", func(t *testing.T) {
+		require.NoError(t, parallel(4, 0, func(i int) error {
+			t.Fatal("should not be called")
+			return nil
+		}))
+	})
+}
+
+func TestJobActivity(t *testing.T) {
+	s := &appStatus{}
+	require.Equal(t, "none", jobActivity(s, "none"))
+
+	s.Jobs = []*pb.Job{
+		{
+			State:     pb.Job_RUNNING,
+			Operation: &pb.Job_Deploy{Deploy: &pb.Job_DeployOp{}},
+		},
+		{
+			State:     pb.Job_QUEUED,
+			Operation: &pb.Job_Release{Release: &pb.Job_ReleaseOp{}},
+		},
+	}
+	require.Equal(t, "deploy running, release queued", jobActivity(s, "none"))
+}
+
+func TestServerProblems(t *testing.T) {
+	require.Empty(t, serverProblems(&pb.GetServerStatusResponse{
+		RunnerCount:    1,
+		QueuedJobCount: 3,
+		UrlService:     &pb.GetServerStatusResponse_URLService{},
+	}))
+
+	require.Len(t, serverProblems(&pb.GetServerStatusResponse{
+		QueuedJobCount: 1,
+		UrlService: &pb.GetServerStatusResponse_URLService{
+			Enabled: true,
+		},
+	}), 2)
+}
+
+func TestStatusCommandAppJson(t *testing.T) {
+	generated := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	generatedPb, err := ptypes.TimestampProto(generated)
+	require.NoError(t, err)
+
+	deployment := &pb.Deployment{
+		Id:        "D1",
+		Sequence:  3,
+		Url:       "https://deploy.example.com",
+		Component: &pb.Component{Name: "docker"},
+		Status:    &pb.Status{State: pb.Status_SUCCESS},
+	}
+
+	cases := []struct {
+		Name   string
+		Status *appStatus
+		Check  func(*testing.T, map[string]interface{})
+	}{
+		{
+			"no report or operations",
+			&appStatus{Project: "p", App: "web", Workspace: "default"},
+			func(t *testing.T, v map[string]interface{}) {
+				require := require.New(t)
+				require.Equal("p", v["project"])
+				require.Equal("web", v["application"])
+				require.Equal("default", v["workspace"])
+				require.Nil(v["status_report"])
+				require.Nil(v["url"])
+				require.Nil(v["build"])
+				require.Nil(v["deployment"])
+				require.Nil(v["release"])
+				require.Equal([]interface{}{}, v["active_jobs"])
+				require.NotContains(v, "events")
+			},
+		},
+
+		{
+			"status report",
+			&appStatus{
+				Report: &pb.StatusReport{
+					Id:            "R1",
+					GeneratedTime: generatedPb,
+					TargetId:      &pb.StatusReport_DeploymentId{DeploymentId: "D1"},
+					Health: &pb.StatusReport_Health{
+						HealthStatus:  "PARTIAL",
+						HealthMessage: "1/2 pods ready",
+					},
+					ResourcesHealth: []*pb.StatusReport_Health{
+						{Id: "pod-1", Name: "web-1", HealthStatus: "DOWN"},
+					},
+				},
+			},
+			func(t *testing.T, v map[string]interface{}) {
+				require := require.New(t)
+				report := v["status_report"].(map[string]interface{})
+				require.Equal("R1", report["id"])
+				require.Equal("D1", report["deployment_id"])
+				require.Equal("2021-06-01T12:00:00Z", report["generated_time"])
+				require.Equal(map[string]interface{}{
+					"health_status":  "PARTIAL",
+					"health_message": "1/2 pods ready",
+				}, report["health"])
+				require.Equal([]interface{}{
+					map[string]interface{}{
+						"id":             "pod-1",
+						"name":           "web-1",
+						"health_status":  "DOWN",
+						"health_message": "",
+					},
+				}, report["resources_health"])
+			},
+		},
+
+		{
+			"deployment and release",
+			&appStatus{
+				Deployment: deployment,
+				Release: &pb.Release{
+					Id:           "R1",
+					Sequence:     2,
+					Url:          "https://release.example.com",
+					DeploymentId: "D1",
+					Component:    &pb.Component{Name: "kubernetes"},
+				},
+			},
+			func(t *testing.T, v map[string]interface{}) {
+				require := require.New(t)
+				require.Equal("https://release.example.com", v["url"])
+
+				dep := v["deployment"].(map[string]interface{})
+				require.Equal("D1", dep["id"])
+				require.Equal(float64(3), dep["sequence"])
+				require.Equal("docker", dep["component"])
+				require.Equal("SUCCESS", dep["status"].(map[string]interface{})["state"])
+
+				rel := v["release"].(map[string]interface{})
+				require.Equal("R1", rel["id"])
+				require.Equal("D1", rel["deployment_id"])
+				require.Nil(rel["status"])
+			},
+		},
+
+		{
+			"unimplemented release",
+			&appStatus{
+				Deployment: deployment,
+				Release:    &pb.Release{Unimplemented: true},
+			},
+			func(t *testing.T, v map[string]interface{}) {
+				require.Equal(t, "https://deploy.example.com", v["url"])
+				require.Nil(t, v["release"])
+			},
+		},
+
+		{
+			"events",
+			&appStatus{
+				Events: []*statusEvent{
+					{
+						Operation: "deploy",
+						Id:        "D1",
+						Sequence:  3,
+						State:     pb.Status_ERROR,
+						Time:      generated,
+					},
+				},
+			},
+			func(t *testing.T, v map[string]interface{}) {
+				require.Equal(t, []interface{}{
+					map[string]interface{}{
+						"operation": "deploy",
+						"id":        "D1",
+						"sequence":  float64(3),
+						"state":     "ERROR",
+						"time":      "2021-06-01T12:00:00Z",
+					},
+				}, v["events"])
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			c := &StatusCommand{}
+
+			// Round trip through JSON so we check what scripts would see
+			data, err := json.Marshal(c.appJson(tt.Status))
+			require.NoError(t, err)
+
+			var v map[string]interface{}
+			require.NoError(t, json.Unmarshal(data, &v))
+			tt.Check(t, v)
+		})
+	}
+}
+
+func TestStatusCommandProjectJson(t *testing.T) {
+	require := require.New(t)
+
+	app := func(project, name, ws, health string) *appStatus {
+		return &appStatus{
+			Project:   project,
+			App:       name,
+			Workspace: ws,
+			Report: &pb.StatusReport{
+				Health: &pb.StatusReport_Health{HealthStatus: health},
+			},
+		}
+	}
+
+	c := &StatusCommand{}
+	data, err := json.Marshal(c.projectJson("p", []*appStatus{
+		app("p", "web", "staging", "READY"),
+		app("other", "web", "default", "DOWN"),
+		app("p", "api", "production", "DOWN"),
+	}))
+	require.NoError(err)
+
+	var v map[string]interface{}
+	require.NoError(json.Unmarshal(data, &v))
+
+	// Only the apps of the project are included
+	require.Equal("p", v["project"])
+	require.Equal([]interface{}{"production", "staging"}, v["workspaces"])
+	require.Equal("DOWN", v["health"])
+	require.Equal(float64(1), v["ready_count"])
+	require.Equal(float64(2), v["app_count"])
+	require.Len(v["applications"], 2)
+}
+
+func TestStatusCommandStatusSummaryRequest(t *testing.T) {
+	staging := &pb.Ref_Workspace{Workspace: "staging"}
+
+	cases := []struct {
+		Name         string
+		Project      string
+		WorkspaceSet bool
+		Expected     *pb.GetProjectStatusSummaryRequest
+	}{
+		{
+			"every workspace of every project",
+			"",
+			false,
+			&pb.GetProjectStatusSummaryRequest{},
+		},
+
+		{
+			"every workspace of a project",
+			"p",
+			false,
+			&pb.GetProjectStatusSummaryRequest{
+				Project: &pb.Ref_Project{Project: "p"},
+			},
+		},
+
+		{
+			"workspace",
+			"p",
+			true,
+			&pb.GetProjectStatusSummaryRequest{
+				Project:   &pb.Ref_Project{Project: "p"},
+				Workspace: staging,
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			c := &StatusCommand{
+				baseCommand:  &baseCommand{refWorkspace: staging},
+				workspaceSet: tt.WorkspaceSet,
+			}
+			require.Equal(t, tt.Expected, c.statusSummaryRequest(tt.Project))
+		})
+	}
+}
+
+func TestStatusCommandProjectRows(t *testing.T) {
+	app := func(project, name, ws, health string) *appStatus {
+		return &appStatus{
+			Project:   project,
+			App:       name,
+			Workspace: ws,
+			Report: &pb.StatusReport{
+				Health: &pb.StatusReport_Health{HealthStatus: health},
+			},
+		}
+	}
+
+	statuses := []*appStatus{
+		app("p", "web", "staging", "READY"),
+		app("p", "api", "staging", "READY"),
+		app("p", "web", "production", "DOWN"),
+		app("q", "web", "default", "ALIVE"),
+	}
+
+	// A row of the view, named "project@workspace"
+	type row struct {
+		Name   string
+		Health string
+		Ready  int
+		Total  int
+	}
+
+	cases := []struct {
+		Name     string
+		Projects []string
+		Filter   []string
+		Rows     []row
+	}{
+		{
+			"a row per project and workspace",
+			[]string{"p", "q"},
+			nil,
+			[]row{
+				{"p@production", "DOWN", 0, 1},
+				{"p@staging", "READY", 2, 2},
+				{"q@default", "ALIVE", 0, 1},
+			},
+		},
+
+		{
+			"project without apps",
+			[]string{"empty", "q"},
+			nil,
+			[]row{
+				{"empty@n/a", "N/A", 0, 0},
+				{"q@default", "ALIVE", 0, 1},
+			},
+		},
+
+		{
+			"health filter",
+			[]string{"p", "q"},
+			[]string{"DOWN"},
+			[]row{
+				{"p@production", "DOWN", 0, 1},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			c := &StatusCommand{flagSort: "name", flagFilterHealth: tt.Filter}
+
+			var rows []row
+			for _, r := range c.projectRows(tt.Projects, statuses) {
+				rows = append(rows, row{r.Project + "@" + r.Workspace, r.Health, r.Ready, r.Total})
+			}
+			require.Equal(t, tt.Rows, rows)
+		})
+	}
+}
+
+func TestStatusCommandMarshalJson(t *testing.T) {
+	v := map[string]interface{}{
+		"project":      "p",
+		"applications": []interface{}{map[string]interface{}{"application": "web"}},
+	}
+
+	cases := []struct {
+		Name  string
+		Watch bool
+		Lines int
+	}{
+		{"indented", false, 8},
+		{"a line per refresh when watching", true, 1},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			c := &StatusCommand{flagWatch: tt.Watch}
+			data, err := c.marshalJson(v)
+			require.NoError(err)
+			require.Len(strings.Split(string(data), "\n"), tt.Lines)
+
+			var decoded map[string]interface{}
+			require.NoError(json.Unmarshal(data, &decoded))
+			require.Equal(v, decoded)
+		})
+	}
+}
+
+func TestStatusCommandAppDetail(t *testing.T) {
+	completed := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	completedPb, err := ptypes.TimestampProto(completed)
+	require.NoError(t, err)
+
+	deployment := &pb.Deployment{
+		Id:       "D1",
+		Sequence: 3,
+		Url:      "https://deploy.example.com",
+		Status:   &pb.Status{CompleteTime: completedPb},
+	}
+
+	// The values of the detail view in order: build, deployment, release,
+	// URL, instances and last deployed.
+	cases := []struct {
+		Name    string
+		LongIds bool
+		Status  *appStatus
+		Values  []string
+	}{
+		{
+			"nothing deployed",
+			false,
+			&appStatus{Instances: -1},
+			[]string{"n/a", "n/a", "n/a", "n/a", "n/a", "n/a"},
+		},
+
+		{
+			"deployed",
+			false,
+			&appStatus{
+				Build:      &pb.Build{Id: "B1", Sequence: 2},
+				Deployment: deployment,
+				Instances:  2,
+			},
+			[]string{"2", "3", "n/a", "https://deploy.example.com", "2", "2021-06-01T12:00:00Z"},
+		},
+
+		{
+			"released",
+			false,
+			&appStatus{
+				Deployment: deployment,
+				Release:    &pb.Release{Id: "R1", Sequence: 4, Url: "https://release.example.com"},
+				Instances:  -1,
+			},
+			[]string{"n/a", "3", "4", "https://release.example.com", "n/a", "2021-06-01T12:00:00Z"},
+		},
+
+		{
+			"unimplemented release",
+			false,
+			&appStatus{
+				Deployment: deployment,
+				Release:    &pb.Release{Unimplemented: true},
+				Instances:  0,
+			},
+			[]string{"n/a", "3", "n/a", "https://deploy.example.com", "0", "2021-06-01T12:00:00Z"},
+		},
+
+		{
+			"deployment still running",
+			false,
+			&appStatus{
+				Deployment: &pb.Deployment{Id: "D2", Sequence: 4, Status: &pb.Status{}},
+				Instances:  1,
+			},
+			[]string{"n/a", "4", "n/a", "n/a", "1", "n/a"},
+		},
+
+		{
+			"long ids",
+			true,
+			&appStatus{
+				Build:      &pb.Build{Id: "B1", Sequence: 2},
+				Deployment: deployment,
+				Release:    &pb.Release{Id: "R1", Sequence: 4},
+				Instances:  -1,
+			},
+			[]string{"2 (B1)", "3 (D1)", "4 (R1)", "https://deploy.example.com", "n/a", "2021-06-01T12:00:00Z"},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			c := &StatusCommand{flagId: idFormat{long: tt.LongIds}}
+			s := tt.Status
+
+			require.Equal(t, tt.Values, []string{
+				c.buildId(s.Build),
+				c.deploymentId(s.Deployment),
+				c.releaseId(s.Release),
+				appURL(s),
+				instanceCount(s),
+				lastDeployed(s, func(t time.Time) string { return t.Format(time.RFC3339) }),
+			})
+		})
+	}
+}
+
+func TestStatusCommandStatusScope(t *testing.T) {
+	cfg := &configpkg.Config{}
+	cfg.Project = "dir"
+
+	cases := []struct {
+		Name        string
+		Args        []string
+		Config      *configpkg.Config
+		App         string
+		AllProjects bool
+		Project     string
+		ExpectedApp string
+	}{
+		{
+			"outside a project directory",
+			nil, nil, "", false,
+			"", "",
+		},
+
+		{
+			"project directory",
+			nil, cfg, "", false,
+			"dir", "",
+		},
+
+		{
+			"app of the project directory",
+			nil, cfg, "web", false,
+			"dir", "web",
+		},
+
+		{
+			"all projects from a project directory",
+			nil, cfg, "", true,
+			"", "",
+		},
+
+		{
+			"project argument",
+			[]string{"p"}, cfg, "", false,
+			"p", "",
+		},
+
+		{
+			"project and app argument",
+			[]string{"p/web"}, nil, "", false,
+			"p", "web",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			c := &StatusCommand{
+				baseCommand: &baseCommand{
+					args:    tt.Args,
+					cfg:     tt.Config,
+					flagApp: tt.App,
+				},
+				flagAllProjects: tt.AllProjects,
+			}
+
+			project, app := c.statusScope()
+			require.Equal(t, tt.Project, project)
+			require.Equal(t, tt.ExpectedApp, app)
+		})
+	}
+}
+
+func TestWriteCsv(t *testing.T) {
+	generatedPb, err := ptypes.TimestampProto(time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	cases := []struct {
+		Name   string
+		Status *appStatus
+		Row    []string
+	}{
+		{
+			"nothing deployed",
+			&appStatus{Project: "p", App: "web", Workspace: "default", Instances: -1},
+			[]string{
+				"p", "web", "default", "", "",
+				"", "",
+				"", "", "",
+				"", "", "",
+				"", "", "", "",
+			},
+		},
+
+		{
+			"deployed and released",
+			&appStatus{
+				Project:   "p",
+				App:       "web",
+				Workspace: "default",
+				Report: &pb.StatusReport{
+					GeneratedTime: generatedPb,
+					Health: &pb.StatusReport_Health{
+						HealthStatus:  "READY",
+						HealthMessage: "all pods ready",
+					},
+				},
+				Build: &pb.Build{Id: "B1", Sequence: 2},
+				Deployment: &pb.Deployment{
+					Id:       "D1",
+					Sequence: 3,
+					Url:      "https://deploy.example.com",
+					Status:   &pb.Status{CompleteTime: generatedPb},
+				},
+				Release: &pb.Release{Id: "R1", Sequence: 4, Url: "https://release.example.com"},
+				Jobs: []*pb.Job{{
+					State:     pb.Job_QUEUED,
+					Operation: &pb.Job_Deploy{Deploy: &pb.Job_DeployOp{}},
+				}},
+				Instances: 2,
+			},
+			[]string{
+				"p", "web", "default", "READY", "all pods ready",
+				"B1", "2",
+				"D1", "3", "https://deploy.example.com",
+				"R1", "4", "https://release.example.com",
+				"2", "2021-06-01T12:00:00Z", "deploy queued", "2021-06-01T12:00:00Z",
+			},
+		},
+
+		{
+			"unimplemented release",
+			&appStatus{
+				Project:   "p",
+				App:       "web",
+				Workspace: "default",
+				Deployment: &pb.Deployment{
+					Id:       "D1",
+					Sequence: 3,
+				},
+				Release:   &pb.Release{Unimplemented: true},
+				Instances: 0,
+			},
+			[]string{
+				"p", "web", "default", "", "",
+				"", "",
+				"D1", "3", "",
+				"", "", "",
+				"0", "", "", "",
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			var buf bytes.Buffer
+			require.NoError(writeCsv(&buf, []*appStatus{tt.Status}))
+
+			records, err := csv.NewReader(&buf).ReadAll()
+			require.NoError(err)
+			require.Len(records, 2)
+			require.Len(records[0], len(tt.Row))
+			require.Equal(tt.Row, records[1])
+		})
+	}
+}
+
+func TestStatusCommandWideTable(t *testing.T) {
+	statuses := []*appStatus{
+		{
+			Project:   "p",
+			App:       "web",
+			Workspace: "default",
+			Build:     &pb.Build{Id: "B1", Sequence: 2},
+			Deployment: &pb.Deployment{
+				Id:       "D1",
+				Sequence: 3,
+			},
+			Release:   &pb.Release{Id: "R1", Sequence: 4, Url: "https://release.example.com"},
+			Instances: 2,
+		},
+		{
+			Project:   "p",
+			App:       "api",
+			Workspace: "default",
+			Instances: -1,
+		},
+	}
+
+	cases := []struct {
+		Name           string
+		IncludeProject bool
+		Headers        int
+		Rows           [][]string
+	}{
+		{
+			"single project",
+			false,
+			12,
+			[][]string{
+				{"web", "default", "2", "3", "D1", "4", "https://release.example.com", "2", "n/a", "N/A", "", "n/a"},
+				{"api", "default", "n/a", "n/a", "n/a", "n/a", "n/a", "n/a", "n/a", "N/A", "", "n/a"},
+			},
+		},
+
+		{
+			"all projects",
+			true,
+			13,
+			[][]string{
+				{"p", "web", "default", "2", "3", "D1", "4", "https://release.example.com", "2", "n/a", "N/A", "", "n/a"},
+				{"p", "api", "default", "n/a", "n/a", "n/a", "n/a", "n/a", "n/a", "n/a", "N/A", "", "n/a"},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			c := &StatusCommand{}
+			tbl := c.wideTable(statuses, tt.IncludeProject)
+			require.Len(t, tbl.Headers, tt.Headers)
+			require.Equal(t, tt.Rows, tableValues(tbl))
+		})
+	}
+}
+
+func TestResourcesTable(t *testing.T) {
+	ready := &pb.StatusReport_Health{HealthStatus: "READY", HealthMessage: "ready"}
+
+	cases := []struct {
+		Name      string
+		Resources []*pb.StatusReport_Resource
+		Headers   []string
+		Rows      [][]string
+	}{
+		{
+			"children follow their parent",
+			[]*pb.StatusReport_Resource{
+				{Id: "pod-1", ParentResourceId: "rs", Type: "pod", Name: "web-1", Health: ready},
+				{Id: "deploy", Type: "deployment", Name: "web", Health: ready},
+				{Id: "container", ParentResourceId: "pod-1", Type: "container", Name: "app", Health: ready},
+				{Id: "rs", ParentResourceId: "deploy", Type: "replicaset", Name: "web-abc", Health: ready},
+				{Id: "pod-2", ParentResourceId: "rs", Type: "pod", Name: "web-2", Health: ready},
+			},
+			[]string{"Type", "Name", "ID", "Health", "Message", "Created"},
+			[][]string{
+				{"deployment", "web", "deploy", "READY", "ready", "n/a"},
+				{"replicaset", "└ web-abc", "rs", "READY", "ready", "n/a"},
+				{"pod", "  └ web-1", "pod-1", "READY", "ready", "n/a"},
+				{"container", "    └ app", "container", "READY", "ready", "n/a"},
+				{"pod", "  └ web-2", "pod-2", "READY", "ready", "n/a"},
+			},
+		},
+
+		{
+			"unknown and own parents are shown at the top",
+			[]*pb.StatusReport_Resource{
+				{Id: "a", ParentResourceId: "missing", Type: "pod", Name: "a", Health: ready},
+				{Id: "b", ParentResourceId: "b", Type: "pod", Name: "b", Health: ready},
+			},
+			[]string{"Type", "Name", "ID", "Health", "Message", "Created"},
+			[][]string{
+				{"pod", "a", "a", "READY", "ready", "n/a"},
+				{"pod", "b", "b", "READY", "ready", "n/a"},
+			},
+		},
+
+		{
+			"url column if any resource has one",
+			[]*pb.StatusReport_Resource{
+				{Id: "svc", Type: "service", Name: "web", Health: ready, PlatformUrl: "https://console.example.com/web"},
+				{Id: "pod", ParentResourceId: "svc", Type: "pod", Name: "web-1", Health: ready},
+			},
+			[]string{"Type", "Name", "ID", "Health", "Message", "Created", "URL"},
+			[][]string{
+				{"service", "web", "svc", "READY", "ready", "n/a", "https://console.example.com/web"},
+				{"pod", "└ web-1", "pod", "READY", "ready", "n/a", ""},
+			},
+		},
+
+		{
+			"missing values",
+			[]*pb.StatusReport_Resource{
+				{Id: "no-health", Type: "pod"},
+				{Id: "no-status", Type: "pod", Health: &pb.StatusReport_Health{HealthMessage: "starting"}},
+				{
+					Id:            "own-message",
+					Type:          "pod",
+					Health:        ready,
+					HealthMessage: "all containers ready",
+				},
+			},
+			[]string{"Type", "Name", "ID", "Health", "Message", "Created"},
+			[][]string{
+				{"pod", "n/a", "no-health", "UNKNOWN", "", "n/a"},
+				{"pod", "n/a", "no-status", "UNKNOWN", "starting", "n/a"},
+				{"pod", "n/a", "own-message", "READY", "all containers ready", "n/a"},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			tbl := resourcesTable(tt.Resources)
+			require.Equal(tt.Headers, tbl.Headers)
+			require.Equal(tt.Rows, tableValues(tbl))
+		})
+	}
+}
+
+// tableValues returns the values of the rows of a table.
+func tableValues(tbl *terminal.Table) [][]string {
+	var result [][]string
+	for _, row := range tbl.Rows {
+		var columns []string
+		for _, entry := range row {
+			columns = append(columns, entry.Value)
+		}
+		result = append(result, columns)
+	}
+
+	return result
+}
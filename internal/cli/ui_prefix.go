@@ -0,0 +1,176 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+)
+
+// prefixUI is a terminal.UI that prefixes all output with a name, such as
+// the name of an app. This is used when operating on multiple apps in
+// parallel so that the interleaved output can be told apart. The mutex is
+// shared by every prefixUI writing to the same underlying UI so that
+// multi-line output isn't interleaved.
+type prefixUI struct {
+	terminal.UI
+
+	prefix string
+	mu     *sync.Mutex
+}
+
+func (u *prefixUI) Output(msg string, raw ...interface{}) {
+	// Split the options from the format arguments so we can format the
+	// message ourselves and prefix every line of it.
+	var args []interface{}
+	var opts []interface{}
+	for _, v := range raw {
+		if _, ok := v.(terminal.Option); ok {
+			opts = append(opts, v)
+		} else {
+			args = append(args, v)
+		}
+	}
+	if len(args) > 0 {
+		msg = fmt.Sprintf(msg, args...)
+	}
+
+	lines := strings.Split(msg, "\n")
+	for i, line := range lines {
+		lines[i] = u.linePrefix() + line
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.UI.Output("%s", append([]interface{}{strings.Join(lines, "\n")}, opts...)...)
+}
+
+func (u *prefixUI) NamedValues(values []terminal.NamedValue, opts ...terminal.Option) {
+	prefixed := make([]terminal.NamedValue, len(values))
+	for i, v := range values {
+		prefixed[i] = v
+		prefixed[i].Name = u.linePrefix() + v.Name
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.UI.NamedValues(prefixed, opts...)
+}
+
+func (u *prefixUI) Table(tbl *terminal.Table, opts ...terminal.Option) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.UI.Output("%s", u.prefix+":", terminal.WithHeaderStyle())
+	u.UI.Table(tbl, opts...)
+}
+
+func (u *prefixUI) OutputWriters() (io.Writer, io.Writer, error) {
+	stdout, stderr, err := u.UI.OutputWriters()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return u.writer(stdout), u.writer(stderr), nil
+}
+
+func (u *prefixUI) Status() terminal.Status {
+	return &prefixStatus{Status: u.UI.Status(), ui: u}
+}
+
+func (u *prefixUI) StepGroup() terminal.StepGroup {
+	return &prefixStepGroup{StepGroup: u.UI.StepGroup(), ui: u}
+}
+
+func (u *prefixUI) linePrefix() string {
+	return "[" + u.prefix + "] "
+}
+
+func (u *prefixUI) writer(w io.Writer) io.Writer {
+	return &prefixWriter{w: w, prefix: []byte(u.linePrefix()), mu: u.mu, start: true}
+}
+
+type prefixStatus struct {
+	terminal.Status
+
+	ui *prefixUI
+}
+
+func (s *prefixStatus) Update(msg string) {
+	s.Status.Update(s.ui.linePrefix() + msg)
+}
+
+func (s *prefixStatus) Step(status, msg string) {
+	s.Status.Step(status, s.ui.linePrefix()+msg)
+}
+
+type prefixStepGroup struct {
+	terminal.StepGroup
+
+	ui *prefixUI
+}
+
+func (g *prefixStepGroup) Add(str string, args ...interface{}) terminal.Step {
+	return &prefixStep{
+		Step: g.StepGroup.Add("%s", g.ui.linePrefix()+fmt.Sprintf(str, args...)),
+		ui:   g.ui,
+	}
+}
+
+type prefixStep struct {
+	terminal.Step
+
+	ui *prefixUI
+}
+
+func (s *prefixStep) TermOutput() io.Writer {
+	return s.ui.writer(s.Step.TermOutput())
+}
+
+func (s *prefixStep) Update(str string, args ...interface{}) {
+	s.Step.Update("%s", s.ui.linePrefix()+fmt.Sprintf(str, args...))
+}
+
+// prefixWriter is an io.Writer that writes the prefix at the start of
+// every line.
+type prefixWriter struct {
+	w      io.Writer
+	prefix []byte
+	mu     *sync.Mutex
+
+	// start is true if the next byte written starts a new line.
+	start bool
+}
+
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	var buf bytes.Buffer
+	for _, line := range bytes.SplitAfter(p, []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+
+		if w.start {
+			buf.Write(w.prefix)
+		}
+		buf.Write(line)
+		w.start = line[len(line)-1] == '\n'
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, err := w.w.Write(buf.Bytes()); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+var (
+	_ terminal.UI        = (*prefixUI)(nil)
+	_ terminal.Status    = (*prefixStatus)(nil)
+	_ terminal.StepGroup = (*prefixStepGroup)(nil)
+	_ terminal.Step      = (*prefixStep)(nil)
+	_ io.Writer          = (*prefixWriter)(nil)
+)
@@ -0,0 +1,22 @@
+package cli
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrefixWriter(t *testing.T) {
+	require := require.New(t)
+
+	var buf bytes.Buffer
+	w := &prefixWriter{w: &buf, prefix: []byte("[web] "), mu: &sync.Mutex{}, start: true}
+
+	_, err := w.Write([]byte("one\ntw"))
+	require.NoError(err)
+	_, err = w.Write([]byte("o\n\nthree"))
+	require.NoError(err)
+	require.Equal("[web] one\n[web] two\n[web] \n[web] three", buf.String())
+}
@@ -2,18 +2,32 @@ package cli
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
 	clientpkg "github.com/hashicorp/waypoint/internal/client"
 	"github.com/hashicorp/waypoint/internal/clierrors"
+	configpkg "github.com/hashicorp/waypoint/internal/config"
 	"github.com/hashicorp/waypoint/internal/pkg/flag"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 )
 
+const (
+	// localComposePlugin is the plugin "up -local-compose" deploys every
+	// app with.
+	localComposePlugin = "docker-compose"
+
+	// localComposeWorkspace is the workspace "up -local-compose" operates
+	// in unless -workspace is set.
+	localComposeWorkspace = "local"
+)
+
 type UpCommand struct {
 	*baseCommand
 
@@ -213,7 +227,7 @@ func (c *UpCommand) legacyUp(
 }
 
 func (c *UpCommand) Flags() *flag.Sets {
-	return c.flagSet(flagSetOperation, func(set *flag.Sets) {
+	return c.flagSet(flagSetOperation|flagSetParallel, func(set *flag.Sets) {
 		f := set.NewSet("Command Options")
 
 		f.BoolVar(&flag.BoolVar{
@@ -223,6 +237,15 @@ func (c *UpCommand) Flags() *flag.Sets {
 			Default: true,
 		})
 
+		f.BoolVar(&flag.BoolVar{
+			Name:   "local-compose",
+			Target: &c.flagLocalCompose,
+			Usage: "Deploy every app to the local Docker daemon on a shared " +
+				"network instead of its configured platform. The registry and " +
+				"release stages are skipped. This operates in the \"" +
+				localComposeWorkspace + "\" workspace unless -workspace is set.",
+		})
+
 		f.IntVar(&flag.IntVar{
 			Name:   "prune-retain",
 			Target: &c.flagPruneRetain,
@@ -235,6 +258,24 @@ func (c *UpCommand) Flags() *flag.Sets {
 	})
 }
 
+// localComposeUse returns the deploy stage that "up -local-compose" uses
+// for every app. The apps of the project share a network named after the
+// project, on which they reach each other by the names of the apps.
+func localComposeUse(cfg *configpkg.Config) (*configpkg.Use, error) {
+	network := "waypoint"
+	if cfg != nil && cfg.Project != "" {
+		network = "waypoint-" + cfg.Project
+	}
+
+	f, diag := hclsyntax.ParseConfig(
+		[]byte(fmt.Sprintf("network = %q\n", network)), "<local-compose>", hcl.InitialPos)
+	if diag.HasErrors() {
+		return nil, diag
+	}
+
+	return &configpkg.Use{Type: localComposePlugin, Body: f.Body}, nil
+}
+
 func (c *UpCommand) Synopsis() string {
 	return "Perform the build, deploy, and release steps for the app"
 }
@@ -245,5 +286,10 @@ Usage: waypoint up [options]
 
   Perform the build, deploy, and release steps for the app.
 
+  With -local-compose, every app of the project is deployed to the local
+  Docker daemon instead of its configured platform. The apps share a
+  Docker network on which they reach each other by the names of the apps,
+  for fast local iteration before deploying to the configured platforms.
+
 ` + c.Flags().Help())
 }
@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/stretchr/testify/require"
+
+	configpkg "github.com/hashicorp/waypoint/internal/config"
+)
+
+func TestLocalComposeUse(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Project  string
+		Expected string
+	}{
+		{
+			"project",
+			"foo",
+			"waypoint-foo",
+		},
+
+		{
+			"no project",
+			"",
+			"waypoint",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			cfg := &configpkg.Config{}
+			cfg.Project = tt.Project
+
+			use, err := localComposeUse(cfg)
+			require.NoError(err)
+			require.Equal(localComposePlugin, use.Type)
+
+			var body struct {
+				Network string `hcl:"network"`
+			}
+			require.False(gohcl.DecodeBody(use.Body, nil, &body).HasErrors())
+			require.Equal(tt.Expected, body.Network)
+		})
+	}
+}
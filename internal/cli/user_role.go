@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+type UserRoleCommand struct {
+	*baseCommand
+
+	// revoke is true if this command revokes the role rather than
+	// granting it.
+	revoke bool
+
+	flagUsername string
+}
+
+func (c *UserRoleCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoConfig(),
+		WithNoAutoServer(), // local mode has no need for roles
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("A single role argument is required.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+	if c.flagUsername == "" {
+		c.ui.Output("The -username flag is required.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	req := &pb.UserRoleRequest{
+		User: &pb.Ref_User{
+			Ref: &pb.Ref_User_Username{
+				Username: &pb.Ref_UserUsername{
+					Username: c.flagUsername,
+				},
+			},
+		},
+		Role: c.args[0],
+	}
+
+	client := c.project.Client()
+	call, verb := client.GrantUserRole, "granted to"
+	if c.revoke {
+		call, verb = client.RevokeUserRole, "revoked from"
+	}
+
+	resp, err := call(c.Ctx, req)
+	if err != nil {
+		c.project.UI.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	c.ui.Output("Role %q %s user %q. The user now has the roles: %s",
+		req.Role, verb, resp.User.Username, strings.Join(resp.User.Roles, ", "),
+		terminal.WithSuccessStyle())
+	return 0
+}
+
+func (c *UserRoleCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.StringVar(&flag.StringVar{
+			Name:   "username",
+			Target: &c.flagUsername,
+			Usage:  "The user to modify the roles of.",
+		})
+	})
+}
+
+func (c *UserRoleCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictSet(serverptypes.Roles...)
+}
+
+func (c *UserRoleCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *UserRoleCommand) Synopsis() string {
+	if c.revoke {
+		return "Revoke a role from a user"
+	}
+
+	return "Grant a role to a user"
+}
+
+func (c *UserRoleCommand) Help() string {
+	if c.revoke {
+		return formatHelp(`
+Usage: waypoint user role revoke [options] ROLE
+
+  Revoke a role from a user.
+
+  The last role of a user can't be revoked, grant the user another role
+  first. The admin role can't be revoked from the last admin. Only admins
+  may revoke roles.
+
+` + c.Flags().Help())
+	}
+
+	return formatHelp(`
+Usage: waypoint user role grant [options] ROLE
+
+  Grant a role to a user.
+
+  Roles determine which APIs a user may call:
+
+    admin      All APIs, including managing users, roles, auth methods,
+               server configuration, and snapshots.
+
+    operator   All APIs except those that require the admin role. This
+               includes building, deploying, and releasing applications.
+
+    read-only  Only APIs that don't modify anything, such as viewing
+               the status of applications.
+
+  Users created before roles existed have no roles and are treated as
+  admins. Granting them a role keeps them an admin, revoke "admin" to
+  restrict them. Only admins may grant roles.
+
+` + c.Flags().Help())
+}
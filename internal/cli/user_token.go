@@ -1,6 +1,7 @@
 package cli
 
 import (
+	stdflag "flag"
 	"fmt"
 	"strings"
 	"time"
@@ -17,19 +18,38 @@ type UserTokenCommand struct {
 
 	flagDuration time.Duration
 	flagUsername string
+	flagProject  string
 }
 
 func (c *UserTokenCommand) Run(args []string) int {
+	flagSet := c.Flags()
+
 	// Initialize. If we fail, we just exit since Init handles the UI.
 	if err := c.Init(
 		WithArgs(args),
-		WithFlags(c.Flags()),
+		WithFlags(flagSet),
 		WithNoConfig(),
 		WithNoAutoServer(), // local mode has no need for tokens
 	); err != nil {
 		return 1
 	}
 
+	// The global workspace flag always has a value, so the token is only
+	// limited to a workspace if the flag is explicitly set.
+	var scope *pb.Token_Scope
+	if c.flagProject != "" {
+		scope = &pb.Token_Scope{Project: c.flagProject}
+	}
+	flagSet.Visit(func(f *stdflag.Flag) {
+		if f.Name == "workspace" {
+			if scope == nil {
+				scope = &pb.Token_Scope{}
+			}
+
+			scope.Workspace = c.flagWorkspace
+		}
+	})
+
 	var refUser *pb.Ref_User
 	if c.flagUsername != "" {
 		refUser = &pb.Ref_User{
@@ -46,6 +66,7 @@ func (c *UserTokenCommand) Run(args []string) int {
 	resp, err := client.GenerateLoginToken(c.Ctx, &pb.LoginTokenRequest{
 		Duration: c.flagDuration.String(),
 		User:     refUser,
+		Scope:    scope,
 	})
 	if err != nil {
 		c.project.UI.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
@@ -74,6 +95,13 @@ func (c *UserTokenCommand) Flags() *flag.Sets {
 			Usage: "Username to generate the login token for. This defaults " +
 				"to the currently logged in user.",
 		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "project",
+			Target: &c.flagProject,
+			Usage: "Project to limit the token to. The server rejects requests " +
+				"with the token that target any other project.",
+		})
 	})
 }
 
@@ -103,6 +131,18 @@ Usage: waypoint user token [options]
   its recommended to generate an invite token with "waypoint user invite"
   or share the UI URL for logging in.
 
+  The token can be limited to a single project with "-project" and to a
+  single workspace with "-workspace". The server rejects requests with a
+  limited token that target any other project or workspace, and requests
+  that don't target its project and workspace. Requests with a token
+  limited to a workspace that don't specify a workspace use that
+  workspace. This is useful for giving CI systems credentials that only
+  work for one team's apps.
+
+  Tokens that expire are refreshed automatically by the CLI when they are
+  in the last quarter of their lifetime. If the token is saved in a CLI
+  context, the refreshed token is saved to the context.
+
 ` + c.Flags().Help()
 
 	return strings.TrimSpace(helpText)
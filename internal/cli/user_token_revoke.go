@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"github.com/mr-tron/base58"
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type UserTokenRevokeCommand struct {
+	*baseCommand
+
+	flagUsername string
+}
+
+func (c *UserTokenRevokeCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoConfig(),
+		WithNoAutoServer(), // local mode has no need for tokens
+	); err != nil {
+		return 1
+	}
+
+	client := c.project.Client()
+
+	req := &pb.RevokeTokenRequest{}
+	switch {
+	case len(c.args) > 1:
+		c.ui.Output("At most one argument is expected.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+
+	case len(c.args) == 1 && c.flagUsername != "":
+		c.ui.Output("A token can't be specified with -username.\n\n"+c.Help(),
+			terminal.WithErrorStyle())
+		return 1
+
+	case len(c.args) == 1:
+		// The argument is either the token itself or the ID of a token. If
+		// it decodes as a token, we revoke it using its ID.
+		id := c.args[0]
+		resp, err := client.DecodeToken(c.Ctx, &pb.DecodeTokenRequest{Token: id})
+		if err == nil && len(resp.Token.AccessorId) > 0 {
+			id = base58.Encode(resp.Token.AccessorId)
+		}
+
+		req.Target = &pb.RevokeTokenRequest_TokenId{TokenId: id}
+
+	case c.flagUsername != "":
+		req.Target = &pb.RevokeTokenRequest_User{
+			User: &pb.Ref_User{
+				Ref: &pb.Ref_User_Username{
+					Username: &pb.Ref_UserUsername{
+						Username: c.flagUsername,
+					},
+				},
+			},
+		}
+
+	default:
+		c.ui.Output("A token or -username is required.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	if _, err := client.RevokeToken(c.Ctx, req); err != nil {
+		c.project.UI.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	if c.flagUsername != "" {
+		c.ui.Output("All existing tokens of user %q revoked.", c.flagUsername,
+			terminal.WithSuccessStyle())
+	} else {
+		c.ui.Output("Token revoked.", terminal.WithSuccessStyle())
+	}
+
+	return 0
+}
+
+func (c *UserTokenRevokeCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.StringVar(&flag.StringVar{
+			Name:   "username",
+			Target: &c.flagUsername,
+			Usage: "Revoke every token issued to this user so far instead of " +
+				"a single token.",
+		})
+	})
+}
+
+func (c *UserTokenRevokeCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *UserTokenRevokeCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *UserTokenRevokeCommand) Synopsis() string {
+	return "Revoke a token or every token of a user"
+}
+
+func (c *UserTokenRevokeCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint user token revoke [options] [token-or-id]
+
+  Revoke a token or every token of a user.
+
+  With an argument, the single token is revoked. The argument can be the
+  token itself or the ID of the token. Anyone who has a token or its ID
+  can revoke it.
+
+  With "-username", every token issued to the user so far is revoked,
+  including tokens from logging in. Tokens issued afterwards are valid.
+  Revoking the tokens of another user requires the "admin" role.
+
+  Revoked tokens are rejected by the server immediately.
+
+` + c.Flags().Help())
+}
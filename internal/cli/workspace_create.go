@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type WorkspaceCreateCommand struct {
+	*baseCommand
+
+	flagDescription string
+}
+
+func (c *WorkspaceCreateCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("A single name argument is required.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	resp, err := c.project.Client().UpsertWorkspace(c.Ctx, &pb.UpsertWorkspaceRequest{
+		Workspace: &pb.Workspace{
+			Name:        c.args[0],
+			Description: c.flagDescription,
+		},
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	c.ui.Output("Workspace %q created.", resp.Workspace.Name, terminal.WithSuccessStyle())
+	return 0
+}
+
+func (c *WorkspaceCreateCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.StringVar(&flag.StringVar{
+			Name:   "description",
+			Target: &c.flagDescription,
+			Usage:  "Short description of the workspace. Optional.",
+		})
+	})
+}
+
+func (c *WorkspaceCreateCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *WorkspaceCreateCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *WorkspaceCreateCommand) Synopsis() string {
+	return "Create a workspace or update its description."
+}
+
+func (c *WorkspaceCreateCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint workspace create [options] NAME
+
+  Create a workspace or update its description.
+
+  Workspaces are also created automatically the first time an operation
+  runs in them. Creating a workspace ahead of time lets you describe its
+  purpose before it is used.
+
+` + c.Flags().Help())
+}
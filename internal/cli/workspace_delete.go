@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type WorkspaceDeleteCommand struct {
+	*baseCommand
+}
+
+func (c *WorkspaceDeleteCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("A single name argument is required.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	_, err := c.project.Client().DeleteWorkspace(c.Ctx, &pb.DeleteWorkspaceRequest{
+		Workspace: &pb.Ref_Workspace{Workspace: c.args[0]},
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	c.ui.Output("Workspace deleted.", terminal.WithSuccessStyle())
+	return 0
+}
+
+func (c *WorkspaceDeleteCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *WorkspaceDeleteCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *WorkspaceDeleteCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *WorkspaceDeleteCommand) Synopsis() string {
+	return "Delete an empty workspace."
+}
+
+func (c *WorkspaceDeleteCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint workspace delete NAME
+
+  Delete an empty workspace.
+
+  Only workspaces that no project has been used in can be deleted. The
+  default workspace can't be deleted.
+
+`)
+}
@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type WorkspaceInspectCommand struct {
+	*baseCommand
+}
+
+func (c *WorkspaceInspectCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("A single name argument is required.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	resp, err := c.project.Client().GetWorkspace(c.Ctx, &pb.GetWorkspaceRequest{
+		Workspace: &pb.Ref_Workspace{Workspace: c.args[0]},
+	})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+	ws := resp.Workspace
+
+	c.ui.Output("Workspace Info:", terminal.WithHeaderStyle())
+	c.ui.NamedValues([]terminal.NamedValue{
+		{
+			Name: "name", Value: ws.Name,
+		},
+		{
+			Name: "description", Value: ws.Description,
+		},
+		{
+			Name: "last active", Value: workspaceActiveTime(ws.ActiveTime),
+		},
+	}, terminal.WithInfoStyle())
+
+	if len(ws.Projects) == 0 {
+		c.ui.Output("")
+		c.ui.Output("No projects have been used in this workspace.")
+		return 0
+	}
+
+	sort.Slice(ws.Projects, func(i, j int) bool {
+		return ws.Projects[i].Project.Project < ws.Projects[j].Project.Project
+	})
+
+	c.ui.Output("")
+	table := terminal.NewTable("Project", "Applications", "Last Active")
+	for _, p := range ws.Projects {
+		var apps []string
+		for _, app := range p.Applications {
+			apps = append(apps, app.Application.Application)
+		}
+		sort.Strings(apps)
+
+		table.Rich([]string{
+			p.Project.Project,
+			strings.Join(apps, ", "),
+			workspaceActiveTime(p.ActiveTime),
+		}, nil)
+	}
+
+	c.ui.Table(table)
+	return 0
+}
+
+// workspaceActiveTime formats an active time for output. Workspaces
+// that were created but never used have no active time.
+func workspaceActiveTime(ts *timestamp.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		return ""
+	}
+
+	return humanize.Time(t)
+}
+
+func (c *WorkspaceInspectCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *WorkspaceInspectCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *WorkspaceInspectCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *WorkspaceInspectCommand) Synopsis() string {
+	return "Show detailed information about a workspace."
+}
+
+func (c *WorkspaceInspectCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint workspace inspect NAME
+
+  Show detailed information about a workspace.
+
+  This includes the projects and applications that have been used in
+  the workspace and when each was last active.
+
+`)
+}
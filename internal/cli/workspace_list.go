@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type WorkspaceListCommand struct {
+	*baseCommand
+}
+
+func (c *WorkspaceListCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	resp, err := c.project.Client().ListWorkspaces(c.Ctx, &pb.ListWorkspacesRequest{})
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	if len(resp.Workspaces) == 0 {
+		c.ui.Output("No workspaces found.")
+		return 0
+	}
+
+	sort.Slice(resp.Workspaces, func(i, j int) bool {
+		return resp.Workspaces[i].Name < resp.Workspaces[j].Name
+	})
+
+	table := terminal.NewTable("Name", "Description", "Projects", "Last Active")
+	for _, ws := range resp.Workspaces {
+		var projects []string
+		for _, p := range ws.Projects {
+			projects = append(projects, p.Project.Project)
+		}
+		sort.Strings(projects)
+
+		table.Rich([]string{
+			ws.Name,
+			ws.Description,
+			strings.Join(projects, ", "),
+			workspaceActiveTime(ws.ActiveTime),
+		}, nil)
+	}
+
+	c.ui.Table(table)
+	return 0
+}
+
+func (c *WorkspaceListCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *WorkspaceListCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *WorkspaceListCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *WorkspaceListCommand) Synopsis() string {
+	return "List workspaces."
+}
+
+func (c *WorkspaceListCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint workspace list
+
+  List workspaces and the projects that have been used in each.
+
+`)
+}
@@ -13,6 +13,9 @@ type App struct {
 
 	project     *Project
 	application *pb.Ref_Application
+
+	// workspace, if set, overrides the workspace of the project client.
+	workspace *pb.Ref_Workspace
 }
 
 // App returns the app-specific operations client.
@@ -32,11 +35,22 @@ func (c *App) Ref() *pb.Ref_Application {
 	return c.application
 }
 
+// InWorkspace returns a copy of the app client that performs operations
+// in the given workspace rather than the workspace of the project client.
+func (c *App) InWorkspace(ws *pb.Ref_Workspace) *App {
+	result := *c
+	result.workspace = ws
+	return &result
+}
+
 // job is the same as Project.job except this also sets the application
-// reference.
+// reference and workspace override.
 func (c *App) job() *pb.Job {
 	job := c.project.job()
 	job.Application = c.application
+	if c.workspace != nil {
+		job.Workspace = c.workspace
+	}
 	return job
 }
 
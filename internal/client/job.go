@@ -38,6 +38,10 @@ func (c *Project) job() *pb.Job {
 		Operation: &pb.Job_Noop_{
 			Noop: &pb.Job_Noop{},
 		},
+
+		// Jobs from the client are usually interactive so they are
+		// assigned before background jobs such as polling.
+		Priority: pb.Job_HIGH,
 	}
 
 	// If we're not local, we set a nil data source so it defaults to
@@ -112,10 +116,34 @@ func (c *Project) queueAndStreamJob(
 	}
 	log = log.With("job_id", queueResp.JobId)
 
+	return c.streamJob(ctx, log, queueResp.JobId, ui, monCh, c.local)
+}
+
+// StreamJob streams the output of an existing job to the UI and waits for
+// it to complete. The job is not cancelled if ctx is cancelled.
+func (c *Project) StreamJob(
+	ctx context.Context,
+	jobId string,
+	ui terminal.UI,
+) (*pb.Job_Result, error) {
+	return c.streamJob(ctx, c.logger.With("job_id", jobId), jobId, ui, nil, false)
+}
+
+// streamJob streams the output of the job to the UI and waits for it to
+// complete. If local is true, the job is cancelled if ctx is cancelled
+// and the terminal output is only shown if local output streaming is on.
+func (c *Project) streamJob(
+	ctx context.Context,
+	log hclog.Logger,
+	jobId string,
+	ui terminal.UI,
+	monCh chan pb.Job_State,
+	local bool,
+) (*pb.Job_Result, error) {
 	// Get the stream
 	log.Debug("opening job stream")
 	stream, err := c.client.GetJobStream(ctx, &pb.GetJobStreamRequest{
-		JobId: queueResp.JobId,
+		JobId: jobId,
 	})
 	if err != nil {
 		return nil, err
@@ -151,7 +179,7 @@ func (c *Project) queueAndStreamJob(
 		steps = map[int32]*stepData{}
 	)
 
-	if c.local {
+	if local {
 		defer func() {
 			// If we completed then do nothing, or if the context is still
 			// active since this means that we're not cancelled.
@@ -164,7 +192,7 @@ func (c *Project) queueAndStreamJob(
 
 			log.Warn("canceling job")
 			_, err := c.client.CancelJob(ctx, &pb.CancelJobRequest{
-				JobId: queueResp.JobId,
+				JobId: jobId,
 			})
 			if err != nil {
 				log.Warn("error canceling job", "err", err)
@@ -206,8 +234,9 @@ func (c *Project) queueAndStreamJob(
 			return nil, st.Err()
 
 		case *pb.GetJobStreamResponse_Terminal_:
-			// Ignore this for local jobs since we're using our UI directly.
-			if c.local {
+			// Ignore this for local jobs since we're using our UI directly,
+			// unless we're streaming local output.
+			if local && !c.localStream() {
 				continue
 			}
 
@@ -3,6 +3,8 @@ package client
 import (
 	"context"
 
+	"github.com/golang/protobuf/ptypes/timestamp"
+
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 )
 
@@ -194,7 +196,9 @@ func (c *App) Release(ctx context.Context, op *pb.Job_ReleaseOp) (*pb.Job_Releas
 	return result.Release, nil
 }
 
-func (a *App) Logs(ctx context.Context) (pb.Waypoint_GetLogStreamClient, error) {
+// Logs streams the logs of the app. limitBacklog is the maximum number of
+// past lines to return for each instance, see GetLogStreamRequest.
+func (a *App) Logs(ctx context.Context, limitBacklog int32) (pb.Waypoint_GetLogStreamClient, error) {
 	log := a.project.logger.Named("logs")
 
 	// First we attempt to query the server for logs for this deployment.
@@ -206,6 +210,7 @@ func (a *App) Logs(ctx context.Context) (pb.Waypoint_GetLogStreamClient, error)
 				Workspace:   a.project.WorkspaceRef(),
 			},
 		},
+		LimitBacklog: limitBacklog,
 	})
 	if err != nil {
 		return nil, err
@@ -215,6 +220,18 @@ func (a *App) Logs(ctx context.Context) (pb.Waypoint_GetLogStreamClient, error)
 	return client, nil
 }
 
+// SearchLogs returns the logs of the app since the given time from the
+// log archive of the server.
+func (a *App) SearchLogs(ctx context.Context, since *timestamp.Timestamp) (*pb.SearchLogsResponse, error) {
+	return a.project.client.SearchLogs(ctx, &pb.SearchLogsRequest{
+		Application: &pb.GetLogStreamRequest_Application{
+			Application: a.Ref(),
+			Workspace:   a.project.WorkspaceRef(),
+		},
+		Since: since,
+	})
+}
+
 func (c *App) ConfigSync(ctx context.Context, op *pb.Job_ConfigSyncOp) (*pb.Job_ConfigSyncResult, error) {
 	if op == nil {
 		op = &pb.Job_ConfigSyncOp{}
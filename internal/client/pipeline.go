@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// RunPipeline syncs the pipeline to the server and starts a run of it.
+// The jobs for the steps are targeted the same as the other operations of
+// this client, so in local mode the steps run on the local runner. This
+// returns as soon as the run is started.
+func (c *Project) RunPipeline(ctx context.Context, p *pb.Pipeline) (*pb.PipelineRun, error) {
+	_, err := c.client.UpsertPipeline(ctx, &pb.UpsertPipelineRequest{
+		Pipeline: p,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The server sets the application and operation for every step.
+	job := c.job()
+	job.Application = nil
+	job.Operation = nil
+	if c.local {
+		job.TargetRunner = &pb.Ref_Runner{
+			Target: &pb.Ref_Runner_Id{
+				Id: &pb.Ref_RunnerId{
+					Id: c.activeRunner.Id(),
+				},
+			},
+		}
+	}
+
+	resp, err := c.client.RunPipeline(ctx, &pb.RunPipelineRequest{
+		Project:     p.Project,
+		Name:        p.Name,
+		JobTemplate: job,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Run, nil
+}
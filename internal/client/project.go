@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/go-hclog"
 
 	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	configpkg "github.com/hashicorp/waypoint/internal/config"
 	"github.com/hashicorp/waypoint/internal/runner"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 	"github.com/hashicorp/waypoint/internal/serverclient"
@@ -32,6 +33,15 @@ type Project struct {
 
 	local bool
 
+	// parallel is the number of jobs that may run at once. For local
+	// operations, this is the number of jobs the local runner accepts
+	// at once.
+	parallel int
+
+	// deployOverride replaces the deploy stage of every app for the jobs
+	// of the local runner.
+	deployOverride *configpkg.Use
+
 	localServer bool // True when a local server is created
 
 	// These are used to manage a local runner and it's job processing
@@ -103,11 +113,17 @@ func New(ctx context.Context, opts ...Option) (*Project, error) {
 		// Because this runner's lifetime is bound to a CLI context and therefore transient, we don't
 		// want to accept jobs that aren't related to local activities (job's queued or RPCs made)
 		// because they'll hang the CLI randomly as those jobs run (it's also a security issue).
-		client.wg.Add(1)
-		go func() {
-			defer client.wg.Done()
-			r.AcceptMany(client.bg)
-		}()
+		accepts := 1
+		if client.parallel > 1 {
+			accepts = client.parallel
+		}
+		for i := 0; i < accepts; i++ {
+			client.wg.Add(1)
+			go func() {
+				defer client.wg.Done()
+				r.AcceptMany(client.bg)
+			}()
+		}
 	}
 
 	return client, nil
@@ -262,6 +278,28 @@ func WithLocal() Option {
 	}
 }
 
+// WithParallel sets the number of jobs that may run at once. If this is
+// greater than one and the client is in local mode, the local runner
+// accepts that many jobs at once and the output of each job is streamed
+// to the UI of the app that queued it rather than written directly to
+// the client UI.
+func WithParallel(n int) Option {
+	return func(c *Project, cfg *config) error {
+		c.parallel = n
+		return nil
+	}
+}
+
+// WithDeployOverride replaces the deploy stage of every app with the given
+// plugin. This requires local mode since the local runner applies the
+// override to the configuration it loads.
+func WithDeployOverride(use *configpkg.Use) Option {
+	return func(c *Project, cfg *config) error {
+		c.deployOverride = use
+		return nil
+	}
+}
+
 // WithLogger sets the logger for the client.
 func WithLogger(log hclog.Logger) Option {
 	return func(c *Project, cfg *config) error {
@@ -1,19 +1,34 @@
 package client
 
 import (
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+
 	"github.com/hashicorp/waypoint/internal/runner"
 )
 
 // startRunner initializes and starts a local runner. If the returned
 // runner is non-nil, you must call Close on it to clean up resources properly.
 func (c *Project) startRunner() (*runner.Runner, error) {
-	// Initialize our runner
-	r, err := runner.New(
+	// The runner writes directly to our UI unless we run jobs in parallel.
+	// In that case, the output of each job is streamed to the UI of the
+	// app that queued it so that the output can be told apart.
+	var ui terminal.UI
+	if !c.localStream() {
+		ui = c.UI
+	}
+
+	opts := []runner.Option{
 		runner.WithClient(c.client),
 		runner.WithLogger(c.logger.Named("runner")),
-		runner.ByIdOnly(),      // We'll direct target this
-		runner.WithLocal(c.UI), // Local mode
-	)
+		runner.ByIdOnly(),    // We'll direct target this
+		runner.WithLocal(ui), // Local mode
+	}
+	if c.deployOverride != nil {
+		opts = append(opts, runner.WithDeployOverride(c.deployOverride))
+	}
+
+	// Initialize our runner
+	r, err := runner.New(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -25,3 +40,9 @@ func (c *Project) startRunner() (*runner.Runner, error) {
 
 	return r, nil
 }
+
+// localStream returns true if the output of local jobs is streamed from
+// the server rather than written directly to the UI by the local runner.
+func (c *Project) localStream() bool {
+	return c.local && c.parallel > 1
+}
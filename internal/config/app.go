@@ -1,10 +1,12 @@
 package config
 
 import (
+	"fmt"
 	"path/filepath"
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/mitchellh/copystructure"
 
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
@@ -18,6 +20,19 @@ type App struct {
 	URL    *AppURL           `hcl:"url,block" default:"{}"`
 	Config *genericConfig    `hcl:"config,block"`
 
+	// DependsOn are the names of the apps that must be operated on
+	// before this app. See Config.AppOrder.
+	DependsOn []string `hcl:"depends_on,optional"`
+
+	// DisableEntrypoint deploys the app without the Waypoint entrypoint.
+	// Config is resolved at deploy time and logs, exec and health come
+	// from the platform plugin instead of the entrypoint.
+	DisableEntrypoint bool `hcl:"disable_entrypoint,optional"`
+
+	// HealthChecks are checks the entrypoint runs in each instance.
+	// See HealthCheckProtos.
+	HealthChecks []*HealthCheck `hcl:"health_check,block"`
+
 	BuildRaw   *hclBuild `hcl:"build,block"`
 	DeployRaw  *hclStage `hcl:"deploy,block"`
 	ReleaseRaw *hclStage `hcl:"release,block"`
@@ -34,8 +49,9 @@ type AppURL struct {
 }
 
 type hclApp struct {
-	Name string `hcl:",label"`
-	Path string `hcl:"path,optional"`
+	Name      string   `hcl:",label"`
+	Path      string   `hcl:"path,optional"`
+	DependsOn []string `hcl:"depends_on,optional"`
 
 	// We need these raw values to determine the plugins need to be used.
 	BuildRaw   *hclBuild `hcl:"build,block"`
@@ -178,7 +194,7 @@ func (c *App) Build(ctx *hcl.EvalContext) (*Build, error) {
 // Registry loads the Registry section of the configuration.
 func (c *App) Registry(ctx *hcl.EvalContext) (*Registry, error) {
 	// Registry is optional
-	if c.BuildRaw == nil || c.BuildRaw.Registry == nil {
+	if c.BuildRaw == nil || c.BuildRaw.Registry == nil || c.config.deployOverride != nil {
 		return nil, nil
 	}
 
@@ -192,10 +208,44 @@ func (c *App) Registry(ctx *hcl.EvalContext) (*Registry, error) {
 	return &b, nil
 }
 
+// BuildSign returns the "sign" stanza of the build stanza, or nil if
+// the pushed image isn't signed.
+func (c *App) BuildSign(ctx *hcl.EvalContext) (*Sign, error) {
+	if c.BuildRaw == nil {
+		return nil, nil
+	}
+
+	b, err := c.Build(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.Sign, nil
+}
+
+// BuildScan returns the "scan" stanza of the build stanza, or nil if
+// the pushed image isn't scanned.
+func (c *App) BuildScan(ctx *hcl.EvalContext) (*Scan, error) {
+	if c.BuildRaw == nil {
+		return nil, nil
+	}
+
+	b, err := c.Build(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.Scan, nil
+}
+
 // Deploy loads the associated section of the configuration.
 func (c *App) Deploy(ctx *hcl.EvalContext) (*Deploy, error) {
 	ctx = appendContext(c.ctx, ctx)
 
+	if v := c.config.deployOverride; v != nil {
+		return &Deploy{Use: v, ctx: ctx}, nil
+	}
+
 	var b Deploy
 	if diag := gohcl.DecodeBody(c.DeployRaw.Body, finalizeContext(ctx), &b); diag.HasErrors() {
 		return nil, diag
@@ -205,9 +255,41 @@ func (c *App) Deploy(ctx *hcl.EvalContext) (*Deploy, error) {
 	return &b, nil
 }
 
+// DeployMaxUnreleased returns the "max_unreleased" setting of the deploy
+// stanza. This is the number of unreleased deployments to keep when
+// pruning after a release. If it isn't set, this returns -1.
+func (c *App) DeployMaxUnreleased(ctx *hcl.EvalContext) (int, error) {
+	if c.DeployRaw == nil {
+		return -1, nil
+	}
+
+	content, _, diag := c.DeployRaw.Body.PartialContent(&hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{{Name: "max_unreleased"}},
+	})
+	if diag.HasErrors() {
+		return -1, diag
+	}
+
+	attr, ok := content.Attributes["max_unreleased"]
+	if !ok {
+		return -1, nil
+	}
+
+	var result int
+	ctx = appendContext(c.ctx, ctx)
+	if diag := gohcl.DecodeExpression(attr.Expr, finalizeContext(ctx), &result); diag.HasErrors() {
+		return -1, diag
+	}
+	if result < 0 {
+		return -1, fmt.Errorf("deploy: max_unreleased must not be negative")
+	}
+
+	return result, nil
+}
+
 // Release loads the associated section of the configuration.
 func (c *App) Release(ctx *hcl.EvalContext) (*Release, error) {
-	if c.ReleaseRaw == nil {
+	if c.ReleaseRaw == nil || c.config.deployOverride != nil {
 		return nil, nil
 	}
 
@@ -230,9 +312,35 @@ func (c *App) BuildUse() string {
 	return c.BuildRaw.Use.Type
 }
 
+// BuildUsesConfigEnv returns true if the build plugin configuration
+// references `config.env`, so that the app config is only resolved for the
+// builds that use it. Configuration that isn't in the native syntax is
+// assumed to use it.
+func (c *App) BuildUsesConfigEnv() bool {
+	if c.BuildRaw == nil || c.BuildRaw.Use == nil {
+		return false
+	}
+
+	body, ok := c.BuildRaw.Use.Body.(*hclsyntax.Body)
+	if !ok {
+		return true
+	}
+
+	var found bool
+	hclsyntax.VisitAll(body, func(n hclsyntax.Node) hcl.Diagnostics {
+		if expr, ok := n.(*hclsyntax.ScopeTraversalExpr); ok && expr.Traversal.RootName() == "config" {
+			found = true
+		}
+
+		return nil
+	})
+
+	return found
+}
+
 // RegistryUse returns the plugin "use" value.
 func (c *App) RegistryUse() string {
-	if c.BuildRaw == nil || c.BuildRaw.Registry == nil {
+	if c.BuildRaw == nil || c.BuildRaw.Registry == nil || c.config.deployOverride != nil {
 		return ""
 	}
 
@@ -241,6 +349,10 @@ func (c *App) RegistryUse() string {
 
 // DeployUse returns the plugin "use" value.
 func (c *App) DeployUse() string {
+	if v := c.config.deployOverride; v != nil {
+		return v.Type
+	}
+
 	if c.DeployRaw == nil {
 		return ""
 	}
@@ -250,7 +362,7 @@ func (c *App) DeployUse() string {
 
 // ReleaseUse returns the plugin "use" value.
 func (c *App) ReleaseUse() string {
-	if c.ReleaseRaw == nil {
+	if c.ReleaseRaw == nil || c.config.deployOverride != nil {
 		return ""
 	}
 
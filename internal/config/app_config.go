@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/waypoint/internal/pkg/partial"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
 )
 
 // genericConfig represents the `config` stanza that can be placed
@@ -52,6 +53,7 @@ func (c *genericConfig) envVars() ([]*pb.ConfigVar, error) {
 	ctx = appendContext(ctx, &hcl.EvalContext{
 		Functions: map[string]function.Function{
 			"configdynamic": configDynamicFunc,
+			"secretref":     secretRefFunc,
 		},
 	})
 	ctx = finalizeContext(ctx)
@@ -200,4 +202,25 @@ var (
 			}), nil
 		},
 	})
+
+	// secretRefFunc implements the secretref() HCL function. This is a
+	// shorthand for configdynamic() for secrets in Vault and AWS Secrets
+	// Manager, i.e. secretref("vault:secret/data/app#token").
+	secretRefFunc = function.New(&function.Spec{
+		Params: []function.Parameter{
+			{
+				Name: "ref",
+				Type: cty.String,
+			},
+		},
+		Type: function.StaticReturnType(typeDynamicConfig),
+		Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+			v, err := serverptypes.ParseSecretRef(args[0].AsString())
+			if err != nil {
+				return cty.NilVal, err
+			}
+
+			return cty.CapsuleVal(typeDynamicConfig, v), nil
+		},
+	})
 )
@@ -0,0 +1,106 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AppLoopError is returned when the depends_on settings of the apps have a
+// loop. This means the apps can never be ordered.
+type AppLoopError struct {
+	LoopApps []string
+}
+
+func (e *AppLoopError) Error() string {
+	return fmt.Sprintf("loop detected amongst app dependencies: %s",
+		strings.Join(e.LoopApps, " -> "))
+}
+
+// AppDependsOn returns the names of the apps that the app named n depends
+// on. This returns nil if the app doesn't exist or has no dependencies.
+func (c *Config) AppDependsOn(n string) []string {
+	for _, app := range c.hclConfig.Apps {
+		if app.Name == n {
+			return app.DependsOn
+		}
+	}
+
+	return nil
+}
+
+// AppOrder returns the names of all the apps in an order where every app
+// comes after the apps it depends on. Apps without dependencies between
+// them keep the order they are defined in. This returns an error if an
+// app depends on an app that doesn't exist or if the dependencies have
+// a loop.
+func (c *Config) AppOrder() ([]string, error) {
+	deps := map[string][]string{}
+	for _, app := range c.hclConfig.Apps {
+		deps[app.Name] = app.DependsOn
+	}
+
+	for _, app := range c.hclConfig.Apps {
+		for _, dep := range app.DependsOn {
+			if _, ok := deps[dep]; !ok {
+				return nil, fmt.Errorf(
+					"app %q: depends_on references unknown app %q", app.Name, dep)
+			}
+		}
+	}
+
+	var names []string
+	for _, app := range c.hclConfig.Apps {
+		names = append(names, app.Name)
+	}
+
+	result, loop := dependencyOrder(names, deps)
+	if loop != nil {
+		return nil, &AppLoopError{LoopApps: loop}
+	}
+
+	return result, nil
+}
+
+// dependencyOrder returns the names in an order where every name comes
+// after the names it depends on, keeping the given order otherwise. Every
+// dependency must be one of the names. If the dependencies have a loop,
+// the loop is returned instead.
+func dependencyOrder(names []string, deps map[string][]string) ([]string, []string) {
+	// This is a depth-first topological sort. We track the path we're
+	// visiting so we can report the loop if we find one.
+	var result []string
+	done := map[string]bool{}
+	var path []string
+	var visit func(n string) []string
+	visit = func(n string) []string {
+		if done[n] {
+			return nil
+		}
+
+		for i, p := range path {
+			if p == n {
+				return append(append([]string{}, path[i:]...), n)
+			}
+		}
+
+		path = append(path, n)
+		for _, dep := range deps[n] {
+			if loop := visit(dep); loop != nil {
+				return loop
+			}
+		}
+		path = path[:len(path)-1]
+
+		done[n] = true
+		result = append(result, n)
+		return nil
+	}
+
+	for _, n := range names {
+		if loop := visit(n); loop != nil {
+			return nil, loop
+		}
+	}
+
+	return result, nil
+}
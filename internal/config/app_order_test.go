@@ -0,0 +1,72 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigAppOrder(t *testing.T) {
+	cases := []struct {
+		File     string
+		Expected []string
+		Err      string
+	}{
+		{
+			"app.hcl",
+			[]string{"foo"},
+			"",
+		},
+
+		{
+			"app_depends_on.hcl",
+			[]string{"db-migrator", "api", "web", "worker"},
+			"",
+		},
+
+		{
+			"app_depends_on_loop.hcl",
+			nil,
+			"web -> api -> web",
+		},
+
+		{
+			"app_depends_on_unknown.hcl",
+			nil,
+			"unknown app \"api\"",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.File, func(t *testing.T) {
+			require := require.New(t)
+
+			cfg, err := Load(filepath.Join("testdata", "compare", tt.File), nil)
+			require.NoError(err)
+
+			order, err := cfg.AppOrder()
+			if tt.Err != "" {
+				require.Error(err)
+				require.Contains(err.Error(), tt.Err)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.Expected, order)
+		})
+	}
+}
+
+func TestConfigAppDependsOn(t *testing.T) {
+	require := require.New(t)
+
+	cfg, err := Load(filepath.Join("testdata", "compare", "app_depends_on.hcl"), nil)
+	require.NoError(err)
+	require.Equal([]string{"api"}, cfg.AppDependsOn("web"))
+	require.Empty(cfg.AppDependsOn("worker"))
+	require.Empty(cfg.AppDependsOn("dontexist"))
+
+	app, err := cfg.App("web", nil)
+	require.NoError(err)
+	require.Equal([]string{"api"}, app.DependsOn)
+}
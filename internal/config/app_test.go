@@ -5,6 +5,7 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/hashicorp/hcl/v2"
 	"github.com/stretchr/testify/require"
 
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
@@ -99,6 +100,22 @@ func TestConfigApp_compare(t *testing.T) {
 			},
 		},
 
+		{
+			"build_use.hcl",
+			"test",
+			func(t *testing.T, c *App) {
+				require.False(t, c.BuildUsesConfigEnv())
+			},
+		},
+
+		{
+			"build_config_env.hcl",
+			"test",
+			func(t *testing.T, c *App) {
+				require.True(t, c.BuildUsesConfigEnv())
+			},
+		},
+
 		{
 			"build_registry.hcl",
 			"test",
@@ -110,6 +127,114 @@ func TestConfigApp_compare(t *testing.T) {
 			},
 		},
 
+		{
+			"build.hcl",
+			"test",
+			func(t *testing.T, c *App) {
+				n, err := c.DeployMaxUnreleased(nil)
+				require.NoError(t, err)
+				require.Equal(t, -1, n)
+			},
+		},
+
+		{
+			"build.hcl",
+			"test",
+			func(t *testing.T, c *App) {
+				require.False(t, c.DisableEntrypoint)
+			},
+		},
+
+		{
+			"app_disable_entrypoint.hcl",
+			"test",
+			func(t *testing.T, c *App) {
+				require.True(t, c.DisableEntrypoint)
+			},
+		},
+
+		{
+			"app_health_check.hcl",
+			"test",
+			func(t *testing.T, c *App) {
+				require := require.New(t)
+
+				checks, err := c.HealthCheckProtos()
+				require.NoError(err)
+				require.Len(checks, 2)
+				require.Equal("web", checks[0].Name)
+				require.Equal(pb.HealthCheck_HTTP, checks[0].Type)
+				require.Equal("/healthz", checks[0].Path)
+				require.Equal("10s", checks[0].Interval)
+				require.Equal(pb.HealthCheck_COMMAND, checks[1].Type)
+				require.Equal([]string{"pg_isready", "-h", "db"}, checks[1].Command)
+			},
+		},
+
+		{
+			"app_health_check_invalid.hcl",
+			"test",
+			func(t *testing.T, c *App) {
+				_, err := c.HealthCheckProtos()
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "path is only valid")
+			},
+		},
+
+		{
+			"build.hcl",
+			"test",
+			func(t *testing.T, c *App) {
+				sign, err := c.BuildSign(nil)
+				require.NoError(t, err)
+				require.Nil(t, sign)
+
+				scan, err := c.BuildScan(nil)
+				require.NoError(t, err)
+				require.Nil(t, scan)
+			},
+		},
+
+		{
+			"build_sign.hcl",
+			"test",
+			func(t *testing.T, c *App) {
+				require := require.New(t)
+
+				sign, err := c.BuildSign(nil)
+				require.NoError(err)
+				require.NotNil(sign)
+				require.Equal("awskms:///alias/waypoint", sign.Key)
+				require.True(sign.SBOM)
+				require.False(sign.TransparencyLog)
+			},
+		},
+
+		{
+			"build_scan.hcl",
+			"test",
+			func(t *testing.T, c *App) {
+				require := require.New(t)
+
+				scan, err := c.BuildScan(nil)
+				require.NoError(err)
+				require.NotNil(scan)
+				require.Equal("grype", scan.Tool)
+				require.Equal("high", scan.Severity)
+				require.True(scan.IgnoreUnfixed)
+			},
+		},
+
+		{
+			"deploy_max_unreleased.hcl",
+			"test",
+			func(t *testing.T, c *App) {
+				n, err := c.DeployMaxUnreleased(nil)
+				require.NoError(t, err)
+				require.Equal(t, 3, n)
+			},
+		},
+
 		{
 			"config_env.hcl",
 			"test",
@@ -148,6 +273,27 @@ func TestConfigApp_compare(t *testing.T) {
 			},
 		},
 
+		{
+			"config_env_secretref.hcl",
+			"test",
+			func(t *testing.T, c *App) {
+				require := require.New(t)
+
+				vars, err := c.Config.ConfigVars()
+				require.NoError(err)
+
+				require.Len(vars, 1)
+				val, ok := vars[0].Value.(*pb.ConfigVar_Dynamic)
+				require.True(ok)
+				require.Equal("TOKEN", vars[0].Name)
+				require.Equal("vault", val.Dynamic.From)
+				require.Equal(map[string]string{
+					"path": "secret/data/app",
+					"key":  "data/token",
+				}, val.Dynamic.Config)
+			},
+		},
+
 		{
 			"config_env_merge.hcl",
 			"test",
@@ -360,6 +506,49 @@ func TestAppValidate(t *testing.T) {
 	}
 }
 
+func TestConfigOverrideDeploy(t *testing.T) {
+	require := require.New(t)
+
+	cfg, err := Load(filepath.Join("testdata", "compare", "deploy_override.hcl"), &LoadOptions{
+		Workspace: "default",
+	})
+	require.NoError(err)
+	cfg.OverrideDeploy(&Use{Type: "docker-compose", Body: hcl.EmptyBody()})
+
+	app, err := cfg.App("test", nil)
+	require.NoError(err)
+
+	// The build stage is kept but the registry is skipped
+	require.Equal("docker", app.BuildUse())
+	require.Empty(app.RegistryUse())
+	r, err := app.Registry(nil)
+	require.NoError(err)
+	require.Nil(r)
+
+	// The deploy stage is replaced
+	require.Equal("docker-compose", app.DeployUse())
+	d, err := app.Deploy(nil)
+	require.NoError(err)
+	require.Equal("docker-compose", d.Use.Type)
+
+	// The release stage is skipped
+	require.Empty(app.ReleaseUse())
+	rel, err := app.Release(nil)
+	require.NoError(err)
+	require.Nil(rel)
+
+	// Only the plugins that are still used are loaded
+	var names []string
+	for _, p := range cfg.Plugins() {
+		names = append(names, p.Name)
+		if p.Name == "docker-compose" {
+			require.True(p.Type.Platform)
+			require.True(p.Type.Releaser)
+		}
+	}
+	require.ElementsMatch([]string{"docker", "docker-compose"}, names)
+}
+
 // testPluginBuildConfig implements component.Configurable to test that we
 // decode HCL properly.
 type testPluginBuildConfig struct {
@@ -20,6 +20,11 @@ type Config struct {
 	ctx      *hcl.EvalContext
 	path     string
 	pathData map[string]string
+	src      []byte
+
+	// deployOverride replaces the deploy stage of every app if set.
+	// See OverrideDeploy.
+	deployOverride *Use
 
 	InputVariables map[string]*variables.Variable
 }
@@ -32,6 +37,7 @@ type hclConfig struct {
 	Plugin    []*Plugin                `hcl:"plugin,block"`
 	Config    *genericConfig           `hcl:"config,block"`
 	Apps      []*hclApp                `hcl:"app,block"`
+	Pipelines []*Pipeline              `hcl:"pipeline,block"`
 	Body      hcl.Body                 `hcl:",body"`
 }
 
@@ -46,6 +52,22 @@ type Runner struct {
 
 	// Poll are the settings related to polling.
 	Poll *Poll `hcl:"poll,block"`
+
+	// TargetLabels are the labels that runners must have to run the
+	// remote jobs of the project.
+	TargetLabels map[string]string `hcl:"target_labels,optional"`
+
+	// Profile is the name of the on-demand runner configuration that
+	// launches a runner for each remote job of the project.
+	Profile string `hcl:"profile,optional"`
+
+	// RemoteOnly requires the operations of the project to run on remote
+	// runners. Operations executed locally by the CLI are rejected.
+	RemoteOnly bool `hcl:"remote_only,optional"`
+
+	// Timeouts are the maximum durations of the jobs of the project by
+	// operation. These override the defaults of the server.
+	Timeouts *Timeouts `hcl:"timeouts,block"`
 }
 
 // DataSource configures the data source for the runner.
@@ -60,6 +82,14 @@ type Poll struct {
 	Interval string `hcl:"interval,optional"`
 }
 
+// Timeouts configures the job timeouts for a project. Each value is a
+// duration string such as "30m".
+type Timeouts struct {
+	Build   string `hcl:"build,optional"`
+	Deploy  string `hcl:"deploy,optional"`
+	Release string `hcl:"release,optional"`
+}
+
 // LoadOptions should be set for the Load function.
 type LoadOptions struct {
 	// Pwd is the current working directory. This is used to setup the
@@ -69,6 +99,11 @@ type LoadOptions struct {
 	// Workspace is the workspace that we are executing in. This is used to
 	// setup `workspace.name` variables.
 	Workspace string
+
+	// Source, if set, is the contents of the configuration file. The file
+	// at the path isn't read and doesn't have to exist, but the path is
+	// still used for the path variables and in errors.
+	Source []byte
 }
 
 // Load loads the configuration file from the given path.
@@ -117,9 +152,19 @@ func Load(path string, opts *LoadOptions) (*Config, error) {
 	addPathValue(ctx, pathData)
 	addWorkspaceValue(ctx, opts.Workspace)
 
-	// Decode
+	// Decode. We keep the source around so that it can be recorded
+	// with operations.
+	src := opts.Source
+	if src == nil {
+		var err error
+		src, err = ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var cfg hclConfig
-	if err := hclsimple.DecodeFile(path, finalizeContext(ctx), &cfg); err != nil {
+	if err := hclsimple.Decode(path, src, finalizeContext(ctx), &cfg); err != nil {
 		return nil, err
 	}
 
@@ -153,11 +198,28 @@ func Load(path string, opts *LoadOptions) (*Config, error) {
 		ctx:            ctx,
 		path:           filepath.Dir(path),
 		pathData:       pathData,
+		src:            src,
 		InputVariables: vs,
 	}, nil
 }
 
+// Source returns the contents of the configuration file that this
+// configuration was loaded from.
+func (c *Config) Source() []byte {
+	return c.src
+}
+
 // HCLContext returns the eval context for this configuration.
 func (c *Config) HCLContext() *hcl.EvalContext {
 	return c.ctx.NewChild()
 }
+
+// OverrideDeploy replaces the deploy stage of every app with the given
+// plugin and removes the registry and release stages, so that the apps
+// are deployed with the default releaser of the plugin. This is used to
+// deploy a project somewhere other than the platforms it configures, such
+// as "waypoint up -local-compose" deploying every app to the local Docker
+// daemon.
+func (c *Config) OverrideDeploy(use *Use) {
+	c.deployOverride = use
+}
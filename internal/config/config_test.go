@@ -46,6 +46,30 @@ func TestLoad_compare(t *testing.T) {
 				require.Equal(t, "HELLO", c.Project)
 			},
 		},
+
+		{
+			"runner_target_labels.hcl",
+			"",
+			func(t *testing.T, c *Config) {
+				require.Equal(t, map[string]string{"env": "prod"}, c.Runner.TargetLabels)
+			},
+		},
+
+		{
+			"runner_profile.hcl",
+			"",
+			func(t *testing.T, c *Config) {
+				require.Equal(t, "docker", c.Runner.Profile)
+			},
+		},
+
+		{
+			"runner_timeouts.hcl",
+			"",
+			func(t *testing.T, c *Config) {
+				require.Equal(t, &Timeouts{Build: "10m", Deploy: "30m"}, c.Runner.Timeouts)
+			},
+		},
 	}
 
 	for _, tt := range cases {
@@ -1,6 +1,10 @@
 package config
 
 import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/hashicorp/hcl/v2/hclwrite"
@@ -30,6 +34,31 @@ func Format(input []byte, path string) ([]byte, error) {
 	return f.Bytes(), nil
 }
 
+// FormatPaths returns the paths of the files in the directory that Format
+// can format: the Waypoint configuration and any variable (*.wpvars) files.
+// JSON files are never returned since they can't be formatted. The paths
+// are sorted by name.
+func FormatPaths(dir string) ([]string, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		name := entry.Name()
+		if name == Filename || strings.HasSuffix(name, ".wpvars") {
+			result = append(result, filepath.Join(dir, name))
+		}
+	}
+
+	return result, nil
+}
+
 func formatBody(body *hclwrite.Body) {
 	for name, attr := range body.Attributes() {
 		body.SetAttributeRaw(
@@ -2,6 +2,7 @@ package config
 
 import (
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -43,3 +44,31 @@ func TestFormat(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatPaths(t *testing.T) {
+	require := require.New(t)
+
+	dir, err := ioutil.TempDir("", "waypoint-fmt")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{
+		Filename,
+		"waypoint.hcl.json",
+		"prod.wpvars",
+		"dev.auto.wpvars",
+		"dev.auto.wpvars.json",
+		"main.tf",
+	} {
+		require.NoError(ioutil.WriteFile(filepath.Join(dir, name), nil, 0644))
+	}
+	require.NoError(os.Mkdir(filepath.Join(dir, "nested.wpvars"), 0755))
+
+	paths, err := FormatPaths(dir)
+	require.NoError(err)
+	require.Equal([]string{
+		filepath.Join(dir, "dev.auto.wpvars"),
+		filepath.Join(dir, "prod.wpvars"),
+		filepath.Join(dir, Filename),
+	}, paths)
+}
@@ -0,0 +1,108 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// HealthCheck is a health check that the entrypoint runs in each instance
+// of the app. The results are added to the status reports of deployments.
+type HealthCheck struct {
+	Name string `hcl:",label"`
+
+	// Type is the type of the check: "http", "tcp" or "command".
+	Type string `hcl:"type"`
+
+	// Path is the path of the HTTP GET request for "http" checks.
+	Path string `hcl:"path,optional"`
+
+	// Port is the port for "http" and "tcp" checks. This defaults to the
+	// PORT of the app.
+	Port int `hcl:"port,optional"`
+
+	// Command is the command to run for "command" checks.
+	Command []string `hcl:"command,optional"`
+
+	// Interval and Timeout are durations such as "30s".
+	Interval string `hcl:"interval,optional"`
+	Timeout  string `hcl:"timeout,optional"`
+}
+
+// healthCheckTypes maps the type values in the configuration to the types
+// in the API.
+var healthCheckTypes = map[string]pb.HealthCheck_Type{
+	"http":    pb.HealthCheck_HTTP,
+	"tcp":     pb.HealthCheck_TCP,
+	"command": pb.HealthCheck_COMMAND,
+}
+
+// HealthCheckProtos returns the health checks of the app converted to the
+// API representation. This returns an error if a check isn't valid.
+func (c *App) HealthCheckProtos() ([]*pb.HealthCheck, error) {
+	var result []*pb.HealthCheck
+	seen := map[string]struct{}{}
+	for _, hc := range c.HealthChecks {
+		if _, ok := seen[hc.Name]; ok {
+			return nil, fmt.Errorf("health_check %q: defined more than once", hc.Name)
+		}
+		seen[hc.Name] = struct{}{}
+
+		typ, ok := healthCheckTypes[hc.Type]
+		if !ok {
+			return nil, fmt.Errorf(
+				"health_check %q: type must be one of \"http\", \"tcp\" or \"command\"", hc.Name)
+		}
+
+		switch typ {
+		case pb.HealthCheck_COMMAND:
+			if len(hc.Command) == 0 {
+				return nil, fmt.Errorf("health_check %q: command is required", hc.Name)
+			}
+
+		default:
+			if len(hc.Command) > 0 {
+				return nil, fmt.Errorf(
+					"health_check %q: command is only valid for \"command\" checks", hc.Name)
+			}
+		}
+
+		if hc.Path != "" && typ != pb.HealthCheck_HTTP {
+			return nil, fmt.Errorf(
+				"health_check %q: path is only valid for \"http\" checks", hc.Name)
+		}
+		if hc.Port < 0 || hc.Port > 65535 {
+			return nil, fmt.Errorf("health_check %q: port must be between 1 and 65535", hc.Name)
+		}
+
+		for _, d := range []struct{ name, value string }{
+			{"interval", hc.Interval},
+			{"timeout", hc.Timeout},
+		} {
+			if d.value == "" {
+				continue
+			}
+
+			v, err := time.ParseDuration(d.value)
+			if err != nil {
+				return nil, fmt.Errorf("health_check %q: invalid %s: %s", hc.Name, d.name, err)
+			}
+			if v <= 0 {
+				return nil, fmt.Errorf("health_check %q: %s must be positive", hc.Name, d.name)
+			}
+		}
+
+		result = append(result, &pb.HealthCheck{
+			Name:     hc.Name,
+			Type:     typ,
+			Path:     hc.Path,
+			Port:     int32(hc.Port),
+			Command:  hc.Command,
+			Interval: hc.Interval,
+			Timeout:  hc.Timeout,
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,171 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// Pipeline is a named set of steps that are run together by the server.
+// Each step runs a single operation for an app as its own job.
+type Pipeline struct {
+	Name  string          `hcl:",label"`
+	Steps []*PipelineStep `hcl:"step,block"`
+}
+
+// PipelineStep is a single step within a pipeline.
+type PipelineStep struct {
+	Name string `hcl:",label"`
+
+	// App is the app that this step operates on. This can be omitted if
+	// the project only has a single app. This isn't used for approvals.
+	App string `hcl:"app,optional"`
+
+	// Op is the operation to run: "build", "deploy", "release", "up",
+	// "exec", or "approval". An approval step waits until a user runs
+	// "waypoint approve" for the run.
+	Op string `hcl:"op"`
+
+	// DependsOn are the names of the steps that must succeed before this
+	// step runs. If this isn't set, the step depends on the step defined
+	// before it. Set this to an empty list to run the step right away.
+	DependsOn *[]string `hcl:"depends_on,optional"`
+
+	// Command is the command to run for the "exec" operation. The command
+	// is run in the app directory on the runner.
+	Command []string `hcl:"command,optional"`
+}
+
+// pipelineOps maps the op values in the configuration to the operations
+// in the API.
+var pipelineOps = map[string]pb.Pipeline_Op{
+	"build":    pb.Pipeline_BUILD,
+	"deploy":   pb.Pipeline_DEPLOY,
+	"release":  pb.Pipeline_RELEASE,
+	"up":       pb.Pipeline_UP,
+	"exec":     pb.Pipeline_EXEC,
+	"approval": pb.Pipeline_APPROVAL,
+}
+
+// PipelineNames returns the names of all the pipelines in the order they
+// are defined.
+func (c *Config) PipelineNames() []string {
+	var result []string
+	for _, p := range c.hclConfig.Pipelines {
+		result = append(result, p.Name)
+	}
+
+	return result
+}
+
+// Pipeline returns the pipeline with the given name converted to the API
+// representation. Defaults for the steps are filled in. This returns an
+// error if the pipeline doesn't exist or isn't valid.
+func (c *Config) Pipeline(n string) (*pb.Pipeline, error) {
+	var p *Pipeline
+	for _, v := range c.hclConfig.Pipelines {
+		if v.Name == n {
+			p = v
+			break
+		}
+	}
+	if p == nil {
+		return nil, fmt.Errorf("pipeline %q not found", n)
+	}
+
+	apps := map[string]struct{}{}
+	for _, app := range c.hclConfig.Apps {
+		apps[app.Name] = struct{}{}
+	}
+
+	if len(p.Steps) == 0 {
+		return nil, fmt.Errorf("pipeline %q: at least one step is required", p.Name)
+	}
+
+	result := &pb.Pipeline{
+		Project: &pb.Ref_Project{Project: c.Project},
+		Name:    p.Name,
+	}
+
+	var names []string
+	deps := map[string][]string{}
+	for i, step := range p.Steps {
+		if _, ok := deps[step.Name]; ok {
+			return nil, fmt.Errorf(
+				"pipeline %q: step %q is defined more than once", p.Name, step.Name)
+		}
+
+		op, ok := pipelineOps[step.Op]
+		if !ok {
+			return nil, fmt.Errorf(
+				"pipeline %q, step %q: op must be one of build, deploy, release, up, exec, or approval",
+				p.Name, step.Name)
+		}
+		if op == pb.Pipeline_EXEC && len(step.Command) == 0 {
+			return nil, fmt.Errorf(
+				"pipeline %q, step %q: command is required for the exec op",
+				p.Name, step.Name)
+		}
+
+		// Approvals don't operate on an app.
+		var appRef *pb.Ref_Application
+		if op != pb.Pipeline_APPROVAL {
+			app := step.App
+			if app == "" {
+				if len(c.hclConfig.Apps) != 1 {
+					return nil, fmt.Errorf(
+						"pipeline %q, step %q: app is required if there is more than one app",
+						p.Name, step.Name)
+				}
+
+				app = c.hclConfig.Apps[0].Name
+			}
+			if _, ok := apps[app]; !ok {
+				return nil, fmt.Errorf(
+					"pipeline %q, step %q: unknown app %q", p.Name, step.Name, app)
+			}
+
+			appRef = &pb.Ref_Application{
+				Project:     c.Project,
+				Application: app,
+			}
+		}
+
+		// By default, steps run in the order they're defined.
+		var dependsOn []string
+		if step.DependsOn != nil {
+			dependsOn = *step.DependsOn
+		} else if i > 0 {
+			dependsOn = []string{p.Steps[i-1].Name}
+		}
+
+		names = append(names, step.Name)
+		deps[step.Name] = dependsOn
+		result.Steps = append(result.Steps, &pb.Pipeline_Step{
+			Name:        step.Name,
+			Application: appRef,
+			Op:          op,
+			DependsOn:   dependsOn,
+			Command:     step.Command,
+		})
+	}
+
+	for _, step := range result.Steps {
+		for _, dep := range step.DependsOn {
+			if _, ok := deps[dep]; !ok {
+				return nil, fmt.Errorf(
+					"pipeline %q, step %q: depends_on references unknown step %q",
+					p.Name, step.Name, dep)
+			}
+		}
+	}
+
+	if _, loop := dependencyOrder(names, deps); loop != nil {
+		return nil, fmt.Errorf(
+			"pipeline %q: loop detected amongst step dependencies: %s",
+			p.Name, strings.Join(loop, " -> "))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,115 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestConfigPipeline(t *testing.T) {
+	cases := []struct {
+		File     string
+		Pipeline string
+		Func     func(*testing.T, *pb.Pipeline)
+		Err      string
+	}{
+		{
+			"pipeline.hcl",
+			"release",
+			func(t *testing.T, p *pb.Pipeline) {
+				require := require.New(t)
+				require.Equal("foo", p.Project.Project)
+				require.Equal("release", p.Name)
+				require.Len(p.Steps, 4)
+
+				require.Equal("api", p.Steps[0].Application.Application)
+				require.Equal(pb.Pipeline_BUILD, p.Steps[0].Op)
+				require.Empty(p.Steps[0].DependsOn)
+
+				require.Empty(p.Steps[1].DependsOn)
+
+				require.Equal(pb.Pipeline_EXEC, p.Steps[2].Op)
+				require.Equal([]string{"make", "test"}, p.Steps[2].Command)
+				require.Equal([]string{"build-api", "build-web"}, p.Steps[2].DependsOn)
+
+				require.Equal([]string{"test"}, p.Steps[3].DependsOn)
+			},
+			"",
+		},
+
+		{
+			"pipeline_single_app.hcl",
+			"ship",
+			func(t *testing.T, p *pb.Pipeline) {
+				require := require.New(t)
+				require.Len(p.Steps, 2)
+				require.Equal("web", p.Steps[0].Application.Application)
+				require.Equal("web", p.Steps[1].Application.Application)
+				require.Equal([]string{"build"}, p.Steps[1].DependsOn)
+			},
+			"",
+		},
+
+		{
+			"pipeline_approval.hcl",
+			"release",
+			func(t *testing.T, p *pb.Pipeline) {
+				require := require.New(t)
+				require.Len(p.Steps, 3)
+				require.Equal(pb.Pipeline_APPROVAL, p.Steps[1].Op)
+				require.Nil(p.Steps[1].Application)
+				require.Equal([]string{"approve"}, p.Steps[2].DependsOn)
+			},
+			"",
+		},
+
+		{
+			"pipeline_single_app.hcl",
+			"nope",
+			nil,
+			"not found",
+		},
+
+		{
+			"pipeline_loop.hcl",
+			"ship",
+			nil,
+			"build -> deploy -> build",
+		},
+
+		{
+			"pipeline_unknown_step.hcl",
+			"ship",
+			nil,
+			"unknown step \"build\"",
+		},
+
+		{
+			"pipeline_exec_no_command.hcl",
+			"ship",
+			nil,
+			"command is required",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.File+"/"+tt.Pipeline, func(t *testing.T) {
+			require := require.New(t)
+
+			cfg, err := Load(filepath.Join("testdata", "compare", tt.File), nil)
+			require.NoError(err)
+
+			p, err := cfg.Pipeline(tt.Pipeline)
+			if tt.Err != "" {
+				require.Error(err)
+				require.Contains(err.Error(), tt.Err)
+				return
+			}
+			require.NoError(err)
+			tt.Func(t, p)
+		})
+	}
+}
@@ -21,6 +21,34 @@ type Plugin struct {
 
 	// Checksum is the SHA256 checksum to validate this plugin.
 	Checksum string `hcl:"checksum,optional"`
+
+	// Version is a version constraint for the plugin, such as ">= 0.4".
+	// If this is set, the plugin is downloaded from the plugin registry of
+	// the server so that every runner executes the same binary.
+	Version string `hcl:"version,optional"`
+
+	// Host configures a remote plugin host that runs the plugin. If this
+	// is set, the plugin isn't launched by the runner.
+	Host *PluginHost `hcl:"host,block"`
+}
+
+// PluginHost configures a remote plugin host. A plugin host is started
+// with "waypoint plugin host" and runs plugins on behalf of runners, for
+// example for plugins that require an SDK that can't be installed on
+// every runner.
+type PluginHost struct {
+	// Address is the address of the plugin host, such as "host:4455".
+	Address string `hcl:"address,attr"`
+
+	// Token is the token the plugin host requires, if any. If this isn't
+	// set, the WAYPOINT_PLUGIN_HOST_TOKEN environment variable of the
+	// runner is used.
+	Token string `hcl:"token,optional"`
+
+	// TLS enables TLS for the connection to the plugin host.
+	// TLSSkipVerify disables the verification of its certificate.
+	TLS           bool `hcl:"tls,optional"`
+	TLSSkipVerify bool `hcl:"tls_skip_verify,optional"`
 }
 
 // Plugins returns all the plugins defined by this configuration. This
@@ -38,10 +66,19 @@ func (c *Config) Plugins() []*Plugin {
 		// Get all the implied stage plugins: build, deploy, etc.
 		if v := app.BuildRaw; v != nil {
 			result = trackPlugin(result, known, v.Use, component.BuilderType)
-			if v := v.Registry; v != nil {
+			if v := v.Registry; v != nil && c.deployOverride == nil {
 				result = trackPlugin(result, known, v.Use, component.RegistryType)
 			}
 		}
+
+		// If the deploy stage is overridden, the override is the only
+		// deploy plugin and it releases the deployments too.
+		if v := c.deployOverride; v != nil {
+			result = trackPlugin(result, known, v, component.PlatformType)
+			result = trackPlugin(result, known, v, component.ReleaseManagerType)
+			continue
+		}
+
 		if v := app.DeployRaw; v != nil {
 			result = trackPlugin(result, known, v.Use, component.PlatformType)
 		}
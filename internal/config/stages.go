@@ -27,9 +27,51 @@ type Build struct {
 	// Instead, use App.Registry().
 	Registry *Registry `hcl:"registry,block"`
 
+	// Sign configures signing the image after it is pushed. Use
+	// App.BuildSign to read this.
+	Sign *Sign `hcl:"sign,block"`
+
+	// Scan configures scanning the image for vulnerabilities after it is
+	// pushed. Use App.BuildScan to read this.
+	Scan *Scan `hcl:"scan,block"`
+
 	ctx *hcl.EvalContext
 }
 
+// Sign configures signing the pushed image with cosign and attaching an
+// SBOM generated with syft.
+type Sign struct {
+	// Key is the cosign key to sign with, such as "env://COSIGN_PRIVATE_KEY"
+	// or "awskms:///alias/waypoint". This defaults to the
+	// COSIGN_PRIVATE_KEY environment variable so that the key can be set
+	// with runner config variables.
+	Key string `hcl:"key,optional"`
+
+	// SBOM generates an SBOM of the image with syft and attaches it to the
+	// image as a signed attestation.
+	SBOM bool `hcl:"sbom,optional"`
+
+	// TransparencyLog uploads the signatures to the Rekor transparency log.
+	// This is off by default since the log is public.
+	TransparencyLog bool `hcl:"transparency_log,optional"`
+}
+
+// Scan configures scanning the pushed image for vulnerabilities with
+// trivy or grype.
+type Scan struct {
+	// Tool is the scanner to use, "trivy" or "grype". This defaults
+	// to trivy.
+	Tool string `hcl:"tool,optional"`
+
+	// Severity is the lowest severity that fails the scan: "low",
+	// "medium", "high" or "critical". This defaults to "critical".
+	Severity string `hcl:"severity,optional"`
+
+	// IgnoreUnfixed ignores vulnerabilities that have no fixed version
+	// when checking the threshold.
+	IgnoreUnfixed bool `hcl:"ignore_unfixed,optional"`
+}
+
 // Registry are the registry settings.
 type Registry struct {
 	Labels map[string]string `hcl:"labels,optional"`
@@ -45,6 +87,11 @@ type Deploy struct {
 	Hooks  []*Hook           `hcl:"hook,block"`
 	Use    *Use              `hcl:"use,block"`
 
+	// MaxUnreleased is the number of unreleased deployments to keep after
+	// a release. Use App.DeployMaxUnreleased to read this, since this
+	// can be read without the rest of the deploy context.
+	MaxUnreleased *int `hcl:"max_unreleased,optional"`
+
 	ctx *hcl.EvalContext
 }
 
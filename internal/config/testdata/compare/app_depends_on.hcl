@@ -0,0 +1,15 @@
+project = "foo"
+
+app "web" {
+  depends_on = ["api"]
+}
+
+app "worker" {
+}
+
+app "api" {
+  depends_on = ["db-migrator"]
+}
+
+app "db-migrator" {
+}
@@ -0,0 +1,9 @@
+project = "foo"
+
+app "web" {
+  depends_on = ["api"]
+}
+
+app "api" {
+  depends_on = ["web"]
+}
@@ -0,0 +1,5 @@
+project = "foo"
+
+app "web" {
+  depends_on = ["api"]
+}
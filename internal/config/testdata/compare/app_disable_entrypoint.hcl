@@ -0,0 +1,13 @@
+project = "foo"
+
+app "test" {
+    disable_entrypoint = true
+
+    build {
+        use "docker" {}
+    }
+
+    deploy {
+        use "kubernetes" {}
+    }
+}
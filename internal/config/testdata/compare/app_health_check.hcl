@@ -0,0 +1,23 @@
+project = "foo"
+
+app "test" {
+    health_check "web" {
+        type     = "http"
+        path     = "/healthz"
+        interval = "10s"
+    }
+
+    health_check "db" {
+        type    = "command"
+        command = ["pg_isready", "-h", "db"]
+        timeout = "2s"
+    }
+
+    build {
+        use "docker" {}
+    }
+
+    deploy {
+        use "docker" {}
+    }
+}
@@ -0,0 +1,16 @@
+project = "foo"
+
+app "test" {
+    health_check "web" {
+        type = "tcp"
+        path = "/healthz"
+    }
+
+    build {
+        use "docker" {}
+    }
+
+    deploy {
+        use "docker" {}
+    }
+}
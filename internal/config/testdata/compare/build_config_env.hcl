@@ -0,0 +1,9 @@
+project = "foo"
+
+app "test" {
+    build {
+        use "test" {
+            foo = config.env["NPM_TOKEN"]
+        }
+    }
+}
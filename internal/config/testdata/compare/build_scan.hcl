@@ -0,0 +1,21 @@
+project = "foo"
+
+app "test" {
+    build {
+        use "docker" {}
+
+        registry {
+            use "docker" {}
+        }
+
+        scan {
+            tool           = "grype"
+            severity       = "high"
+            ignore_unfixed = true
+        }
+    }
+
+    deploy {
+        use "docker" {}
+    }
+}
@@ -0,0 +1,20 @@
+project = "foo"
+
+app "test" {
+    build {
+        use "docker" {}
+
+        registry {
+            use "docker" {}
+        }
+
+        sign {
+            key  = "awskms:///alias/waypoint"
+            sbom = true
+        }
+    }
+
+    deploy {
+        use "docker" {}
+    }
+}
@@ -0,0 +1,9 @@
+project = "foo"
+
+app "test" {
+    config {
+        env = {
+            TOKEN = secretref("vault:secret/data/app#token")
+        }
+    }
+}
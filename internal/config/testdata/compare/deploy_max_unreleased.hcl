@@ -0,0 +1,13 @@
+project = "foo"
+
+app "test" {
+    build {
+        use "docker" {}
+    }
+
+    deploy {
+        use "docker" {}
+
+        max_unreleased = 3
+    }
+}
@@ -0,0 +1,19 @@
+project = "foo"
+
+app "test" {
+    build {
+        use "docker" {}
+
+        registry {
+            use "aws-ecr" {}
+        }
+    }
+
+    deploy {
+        use "kubernetes" {}
+    }
+
+    release {
+        use "kubernetes" {}
+    }
+}
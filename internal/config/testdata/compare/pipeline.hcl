@@ -0,0 +1,32 @@
+project = "foo"
+
+app "web" {
+}
+
+app "api" {
+}
+
+pipeline "release" {
+  step "build-api" {
+    app = "api"
+    op  = "build"
+  }
+
+  step "build-web" {
+    app        = "web"
+    op         = "build"
+    depends_on = []
+  }
+
+  step "test" {
+    app        = "api"
+    op         = "exec"
+    command    = ["make", "test"]
+    depends_on = ["build-api", "build-web"]
+  }
+
+  step "deploy-api" {
+    app = "api"
+    op  = "deploy"
+  }
+}
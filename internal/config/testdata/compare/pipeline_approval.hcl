@@ -0,0 +1,23 @@
+project = "foo"
+
+app "web" {
+}
+
+app "api" {
+}
+
+pipeline "release" {
+  step "deploy" {
+    app = "web"
+    op  = "deploy"
+  }
+
+  step "approve" {
+    op = "approval"
+  }
+
+  step "release" {
+    app = "web"
+    op  = "release"
+  }
+}
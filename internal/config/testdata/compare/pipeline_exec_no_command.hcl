@@ -0,0 +1,10 @@
+project = "foo"
+
+app "web" {
+}
+
+pipeline "ship" {
+  step "test" {
+    op = "exec"
+  }
+}
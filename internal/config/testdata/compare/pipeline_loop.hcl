@@ -0,0 +1,15 @@
+project = "foo"
+
+app "web" {
+}
+
+pipeline "ship" {
+  step "build" {
+    op         = "build"
+    depends_on = ["deploy"]
+  }
+
+  step "deploy" {
+    op = "deploy"
+  }
+}
@@ -0,0 +1,14 @@
+project = "foo"
+
+app "web" {
+}
+
+pipeline "ship" {
+  step "build" {
+    op = "build"
+  }
+
+  step "deploy" {
+    op = "deploy"
+  }
+}
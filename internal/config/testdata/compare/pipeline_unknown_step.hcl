@@ -0,0 +1,11 @@
+project = "foo"
+
+app "web" {
+}
+
+pipeline "ship" {
+  step "deploy" {
+    op         = "deploy"
+    depends_on = ["build"]
+  }
+}
@@ -0,0 +1,6 @@
+project = "hello"
+
+runner {
+  enabled = true
+  profile = "docker"
+}
@@ -0,0 +1,9 @@
+project = "hello"
+
+runner {
+  enabled = true
+
+  target_labels = {
+    env = "prod"
+  }
+}
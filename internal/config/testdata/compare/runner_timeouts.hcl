@@ -0,0 +1,10 @@
+project = "hello"
+
+runner {
+  enabled = true
+
+  timeouts {
+    build  = "10m"
+    deploy = "30m"
+  }
+}
@@ -0,0 +1,17 @@
+project = "foo"
+
+app "web" {
+  depends_on = ["api"]
+
+  build {}
+
+  deploy {}
+}
+
+app "api" {
+  depends_on = ["web"]
+
+  build {}
+
+  deploy {}
+}
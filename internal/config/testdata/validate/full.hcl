@@ -0,0 +1,24 @@
+project = "foo"
+
+variable "tag" {
+  type = string
+}
+
+app "web" {
+  build {
+    use "test" {
+      image = "foo:${var.tag}"
+      bogus = true
+    }
+  }
+
+  deploy {
+    use "test" {
+      region = "us-east-1"
+
+      scaling {
+        max = 2
+      }
+    }
+  }
+}
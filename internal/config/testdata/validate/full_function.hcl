@@ -0,0 +1,17 @@
+project = "foo"
+
+app "web" {
+  build {
+    labels = {
+      "config" = file("config.json")
+    }
+
+    use "test" {
+      image = "foo"
+    }
+  }
+
+  deploy {
+    use "test" {}
+  }
+}
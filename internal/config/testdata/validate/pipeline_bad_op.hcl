@@ -0,0 +1,13 @@
+project = "foo"
+
+app "web" {
+  build {}
+
+  deploy {}
+}
+
+pipeline "ship" {
+  step "build" {
+    op = "compile"
+  }
+}
@@ -0,0 +1,23 @@
+project = "foo"
+
+plugin "licensed" {
+  version = ">= 1.0"
+
+  type {
+    deploy = true
+  }
+
+  host {
+    address = "plugins.internal:4455"
+  }
+}
+
+app "web" {
+  build {
+    use "docker" {}
+  }
+
+  deploy {
+    use "licensed" {}
+  }
+}
@@ -0,0 +1,19 @@
+project = "foo"
+
+plugin "kubernetes" {
+  version = "not a version"
+
+  type {
+    deploy = true
+  }
+}
+
+app "web" {
+  build {
+    use "docker" {}
+  }
+
+  deploy {
+    use "kubernetes" {}
+  }
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/go-multierror"
+	goversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/gohcl"
 	"github.com/zclconf/go-cty/cty"
@@ -24,6 +25,7 @@ type validateStruct struct {
 	Plugin    []*Plugin           `hcl:"plugin,block"`
 	Apps      []*validateApp      `hcl:"app,block"`
 	Config    *genericConfig      `hcl:"config,block"`
+	Pipelines []*Pipeline         `hcl:"pipeline,block"`
 }
 
 type validateApp struct {
@@ -35,6 +37,11 @@ type validateApp struct {
 	Deploy  *Deploy           `hcl:"deploy,block"`
 	Release *Release          `hcl:"release,block"`
 	Config  *genericConfig    `hcl:"config,block"`
+
+	DependsOn         []string `hcl:"depends_on,optional"`
+	DisableEntrypoint bool     `hcl:"disable_entrypoint,optional"`
+
+	HealthChecks []*HealthCheck `hcl:"health_check,block"`
 }
 
 // validateVariable is separate from HclVariable because of the limitations
@@ -85,6 +92,43 @@ func (c *Config) Validate() error {
 		result = multierror.Append(result, errs...)
 	}
 
+	// Validate plugin version constraints
+	for _, p := range c.Plugin {
+		if p.Host != nil && p.Version != "" {
+			result = multierror.Append(result, fmt.Errorf(
+				"plugin %q can't set both a version constraint and a plugin host", p.Name))
+		}
+
+		if p.Version == "" {
+			continue
+		}
+
+		if _, err := goversion.NewConstraint(p.Version); err != nil {
+			result = multierror.Append(result, fmt.Errorf(
+				"plugin %q has an invalid version constraint %q: %s", p.Name, p.Version, err))
+		}
+	}
+
+	// Validate app dependencies
+	if _, err := c.AppOrder(); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	// Validate pipelines
+	seen := map[string]struct{}{}
+	for _, n := range c.PipelineNames() {
+		if _, ok := seen[n]; ok {
+			result = multierror.Append(result, fmt.Errorf(
+				"pipeline %q is defined more than once", n))
+			continue
+		}
+		seen[n] = struct{}{}
+
+		if _, err := c.Pipeline(n); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
 	return result
 }
 
@@ -162,6 +206,10 @@ func (c *App) Validate() error {
 			"deploy stage with a 'use' stanza is required"))
 	}
 
+	if _, err := c.HealthCheckProtos(); err != nil {
+		result = multierror.Append(result, err)
+	}
+
 	return result
 }
 
@@ -0,0 +1,273 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	"github.com/hashicorp/waypoint-plugin-sdk/docs"
+
+	"github.com/hashicorp/waypoint/internal/config/variables"
+)
+
+// PluginSchemaFunc returns the documentation of the plugin with the given
+// name for the component type, which lists the fields of its configuration.
+// This returns nil if the plugin isn't known, in which case the fields of
+// the plugin aren't validated.
+type PluginSchemaFunc func(typ component.Type, name string) (*docs.Documentation, error)
+
+// ValidateOptions are the options for ValidateFull.
+type ValidateOptions struct {
+	// Schemas returns the schemas of plugins. If this is nil, the fields
+	// of plugins aren't validated.
+	Schemas PluginSchemaFunc
+
+	// NoFiles is true if the files of the project aren't available, such
+	// as when validating on the server. Errors from functions, which may
+	// read files, are reported as warnings since they may be valid where
+	// the files are available.
+	NoFiles bool
+}
+
+// ValidateFull validates the full configuration. Unlike Validate, this
+// decodes every app and stage and checks the fields of the plugins used
+// against the schemas of the plugins, so that errors are found before
+// any operation is executed.
+//
+// Input variables are set to their default values, or unknown values if
+// they have no default, since the values of an operation aren't known.
+// The returned diagnostics include the position of each error.
+func (c *Config) ValidateFull(opts *ValidateOptions) hcl.Diagnostics {
+	if opts == nil {
+		opts = &ValidateOptions{}
+	}
+
+	// If the structure is invalid then the apps can't be decoded.
+	diags := errorDiagnostics(c.Validate())
+	if diags.HasErrors() {
+		return diags
+	}
+
+	ctx := c.validateContext()
+	for _, name := range c.Apps() {
+		app, err := c.App(name, ctx)
+		if err != nil {
+			diags = append(diags, errorDiagnostics(err)...)
+			continue
+		}
+
+		diags = append(diags, errorDiagnostics(app.Validate())...)
+		diags = append(diags, app.validateStages(ctx, opts.Schemas)...)
+
+		if _, err := app.ConfigVars(); err != nil {
+			diags = append(diags, errorDiagnostics(err)...)
+		}
+	}
+
+	if opts.NoFiles {
+		for _, diag := range diags {
+			if diag.Severity == hcl.DiagError && diag.Summary == "Error in function call" {
+				diag.Severity = hcl.DiagWarning
+			}
+		}
+	}
+
+	return diags
+}
+
+// validateContext returns the context used to decode the configuration
+// for validation.
+func (c *Config) validateContext() *hcl.EvalContext {
+	values := variables.Values{}
+	for name, v := range c.InputVariables {
+		value := cty.UnknownVal(cty.DynamicPseudoType)
+		switch {
+		case v.Default != nil:
+			value = v.Default.Value
+
+		case v.Type != cty.NilType:
+			value = cty.UnknownVal(v.Type)
+		}
+
+		values[name] = &variables.Value{Value: value}
+	}
+
+	return AddVariables(&hcl.EvalContext{}, values)
+}
+
+// validateStages decodes each stage of the app and validates the fields
+// of the plugin used by the stage.
+func (c *App) validateStages(ctx *hcl.EvalContext, schemas PluginSchemaFunc) hcl.Diagnostics {
+	type stage struct {
+		Type component.Type
+		Use  *Use
+		Load func() error
+	}
+
+	stages := []stage{
+		{
+			Type: component.BuilderType,
+			Use:  c.BuildRaw.Use,
+			Load: func() error { _, err := c.Build(ctx); return err },
+		},
+		{
+			Type: component.PlatformType,
+			Use:  c.DeployRaw.Use,
+			Load: func() error { _, err := c.Deploy(ctx); return err },
+		},
+	}
+	if c.BuildRaw.Registry != nil {
+		stages = append(stages, stage{
+			Type: component.RegistryType,
+			Use:  c.BuildRaw.Registry.Use,
+			Load: func() error { _, err := c.Registry(ctx); return err },
+		})
+	}
+	if c.ReleaseRaw != nil {
+		stages = append(stages, stage{
+			Type: component.ReleaseManagerType,
+			Use:  c.ReleaseRaw.Use,
+			Load: func() error { _, err := c.Release(ctx); return err },
+		})
+	}
+
+	var diags hcl.Diagnostics
+	for _, s := range stages {
+		if err := s.Load(); err != nil {
+			diags = append(diags, errorDiagnostics(err)...)
+		}
+
+		if s.Use != nil && schemas != nil {
+			diags = append(diags, validateUse(s.Type, s.Use, schemas)...)
+		}
+	}
+
+	return diags
+}
+
+// validateUse validates the arguments and blocks of the use stanza against
+// the schema of the plugin. Only the names are validated since the values
+// may depend on values only known when the operation is executed.
+func validateUse(typ component.Type, use *Use, schemas PluginSchemaFunc) hcl.Diagnostics {
+	doc, err := schemas(typ, use.Type)
+	if err != nil {
+		return hcl.Diagnostics{{
+			Severity: hcl.DiagWarning,
+			Summary:  "Unable to validate plugin configuration",
+			Detail: fmt.Sprintf("The schema of the %q plugin couldn't be loaded, "+
+				"so its configuration wasn't validated: %s", use.Type, err),
+			Subject: use.Body.MissingItemRange().Ptr(),
+		}}
+	}
+	if doc == nil {
+		return nil
+	}
+
+	// We can only validate the native syntax, since JSON bodies don't
+	// know what is an argument or a block without a schema.
+	body, ok := use.Body.(*hclsyntax.Body)
+	if !ok {
+		return nil
+	}
+
+	// Nested fields are documented with their full path, such as
+	// "auth.username", so only the first part is a field of the body.
+	fields := map[string]struct{}{}
+	for _, f := range doc.Fields() {
+		fields[strings.SplitN(f.Field, ".", 2)[0]] = struct{}{}
+	}
+
+	var names []string
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	supported := "The plugin has no configuration."
+	if len(names) > 0 {
+		supported = fmt.Sprintf("The supported fields are: %s.", strings.Join(names, ", "))
+	}
+
+	var diags hcl.Diagnostics
+	for name, attr := range body.Attributes {
+		if _, ok := fields[name]; !ok {
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Unsupported argument",
+				Detail: fmt.Sprintf("An argument named %q is not expected for the %q %s plugin. %s",
+					name, use.Type, typeName(typ), supported),
+				Subject: attr.NameRange.Ptr(),
+			})
+		}
+	}
+	for _, block := range body.Blocks {
+		if _, ok := fields[block.Type]; !ok {
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Unsupported block type",
+				Detail: fmt.Sprintf("Blocks of type %q are not expected for the %q %s plugin. %s",
+					block.Type, use.Type, typeName(typ), supported),
+				Subject: block.TypeRange.Ptr(),
+			})
+		}
+	}
+
+	// Sort so the diagnostics are in the order of the file.
+	sort.SliceStable(diags, func(i, j int) bool {
+		return diags[i].Subject.Start.Byte < diags[j].Subject.Start.Byte
+	})
+
+	return diags
+}
+
+// typeName returns the name of the component type used in errors.
+func typeName(typ component.Type) string {
+	switch typ {
+	case component.BuilderType:
+		return "build"
+	case component.RegistryType:
+		return "registry"
+	case component.PlatformType:
+		return "deploy"
+	case component.ReleaseManagerType:
+		return "release"
+	default:
+		return strings.ToLower(typ.String())
+	}
+}
+
+// errorDiagnostics returns the diagnostics of the error, which may be
+// diagnostics, a multierror of diagnostics or any other error. Other errors
+// become diagnostics without a position.
+func errorDiagnostics(err error) hcl.Diagnostics {
+	switch err := err.(type) {
+	case nil:
+		return nil
+
+	case hcl.Diagnostics:
+		return err
+
+	case *hcl.Diagnostic:
+		return hcl.Diagnostics{err}
+
+	case *multierror.Error:
+		var diags hcl.Diagnostics
+		for _, e := range err.Errors {
+			diags = append(diags, errorDiagnostics(e)...)
+		}
+
+		return diags
+
+	default:
+		return hcl.Diagnostics{{
+			Severity: hcl.DiagError,
+			Summary:  err.Error(),
+		}}
+	}
+}
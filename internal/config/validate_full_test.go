@@ -0,0 +1,91 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	"github.com/hashicorp/waypoint-plugin-sdk/docs"
+)
+
+func TestConfigValidateFull(t *testing.T) {
+	type testBuildConfig struct {
+		Image string   `hcl:"image"`
+		Tags  []string `hcl:"tags,optional"`
+	}
+
+	type testDeployConfig struct {
+		Region string `hcl:"region,optional"`
+	}
+
+	schemas := func(typ component.Type, name string) (*docs.Documentation, error) {
+		if name != "test" {
+			return nil, nil
+		}
+
+		switch typ {
+		case component.BuilderType:
+			return docs.New(docs.FromConfig(&testBuildConfig{}))
+
+		case component.PlatformType:
+			return docs.New(docs.FromConfig(&testDeployConfig{}))
+		}
+
+		return nil, nil
+	}
+
+	load := func(t *testing.T, file string) *Config {
+		cfg, err := Load(filepath.Join("testdata", "validate", file), nil)
+		require.NoError(t, err)
+		return cfg
+	}
+
+	t.Run("plugin fields", func(t *testing.T) {
+		require := require.New(t)
+
+		diags := load(t, "full.hcl").ValidateFull(&ValidateOptions{Schemas: schemas})
+		require.True(diags.HasErrors())
+		require.Len(diags, 2)
+
+		require.Equal("Unsupported argument", diags[0].Summary)
+		require.Contains(diags[0].Detail, `"bogus"`)
+		require.Equal(11, diags[0].Subject.Start.Line)
+
+		require.Equal("Unsupported block type", diags[1].Summary)
+		require.Contains(diags[1].Detail, `"scaling"`)
+		require.Contains(diags[1].Detail, "The supported fields are: region.")
+		require.Equal(19, diags[1].Subject.Start.Line)
+	})
+
+	t.Run("without schemas", func(t *testing.T) {
+		require := require.New(t)
+
+		diags := load(t, "full.hcl").ValidateFull(nil)
+		require.False(diags.HasErrors(), diags.Error())
+	})
+
+	t.Run("structure", func(t *testing.T) {
+		require := require.New(t)
+
+		diags := load(t, "no_build.hcl").ValidateFull(&ValidateOptions{Schemas: schemas})
+		require.True(diags.HasErrors())
+		require.Contains(diags.Error(), "'build' stanza")
+	})
+
+	t.Run("function errors without files", func(t *testing.T) {
+		require := require.New(t)
+
+		cfg := load(t, "full_function.hcl")
+		diags := cfg.ValidateFull(&ValidateOptions{Schemas: schemas})
+		require.True(diags.HasErrors())
+		require.Equal("Error in function call", diags[0].Summary)
+
+		diags = cfg.ValidateFull(&ValidateOptions{Schemas: schemas, NoFiles: true})
+		require.False(diags.HasErrors(), diags.Error())
+		require.Len(diags, 1)
+		require.Equal(hcl.DiagWarning, diags[0].Severity)
+	})
+}
@@ -20,6 +20,22 @@ func TestConfigValidate(t *testing.T) {
 			"no_build.hcl",
 			"'build' stanza",
 		},
+		{
+			"depends_on_loop.hcl",
+			"loop detected",
+		},
+		{
+			"pipeline_bad_op.hcl",
+			"op must be one of",
+		},
+		{
+			"plugin_version.hcl",
+			"invalid version constraint",
+		},
+		{
+			"plugin_host.hcl",
+			"both a version constraint and a plugin host",
+		},
 	}
 
 	for _, tt := range cases {
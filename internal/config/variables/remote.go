@@ -0,0 +1,537 @@
+package variables
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+)
+
+// RemoteSource is a remote system that the value of a variable is read
+// from at job time, when no other value is set for the variable. It is
+// set with a "source" block in the variable block.
+type RemoteSource struct {
+	// Type is the type of the source, such as "terraform-cloud".
+	Type string
+
+	// Config are the attributes of the source block.
+	Config map[string]string
+
+	// The location of the source block in the waypoint.hcl
+	Range hcl.Range
+}
+
+// remoteSource is a type of remote source that variables can be read from.
+type remoteSource struct {
+	// Name is the human readable name of the source for errors.
+	Name string
+
+	// Required and Optional are the attributes of the source block.
+	Required []string
+	Optional []string
+
+	// Read reads the value. The cache should be used for any requests so
+	// that jobs don't read the same values over and over.
+	Read func(ctx context.Context, c *remoteCache, cfg map[string]string) (string, error)
+}
+
+// remoteSources are the types of remote sources, by the label of the
+// source block.
+var remoteSources = map[string]*remoteSource{
+	"terraform-cloud": {
+		Name:     "Terraform Cloud",
+		Required: []string{"organization", "workspace", "output"},
+		Optional: []string{"token", "base_url"},
+		Read:     readTerraformCloud,
+	},
+
+	"consul": {
+		Name:     "Consul",
+		Required: []string{"key"},
+		Optional: []string{"address", "token", "datacenter"},
+		Read:     readConsul,
+	},
+}
+
+var (
+	// remoteCacheTTL is how long values read from remote sources are
+	// cached. Runners execute many jobs, so this avoids reading the same
+	// values for every job while still picking up changes quickly.
+	remoteCacheTTL = 1 * time.Minute
+
+	// remoteTimeout is the maximum time we wait for a remote source.
+	remoteTimeout = 30 * time.Second
+
+	// defaultRemoteCache is the cache shared by all jobs of this process.
+	defaultRemoteCache = &remoteCache{}
+)
+
+// decodeRemoteSource decodes and validates a source block of a variable.
+func decodeRemoteSource(block *hcl.Block) (*RemoteSource, hcl.Diagnostics) {
+	typ := block.Labels[0]
+	rs := &RemoteSource{
+		Type:   typ,
+		Config: map[string]string{},
+		Range:  block.DefRange,
+	}
+
+	source, ok := remoteSources[typ]
+	if !ok {
+		var types []string
+		for k := range remoteSources {
+			types = append(types, fmt.Sprintf("%q", k))
+		}
+		sort.Strings(types)
+
+		return nil, hcl.Diagnostics{{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid variable source",
+			Detail: fmt.Sprintf("Unknown source %q. The source must be one of: %s.",
+				typ, strings.Join(types, ", ")),
+			Subject: &block.LabelRanges[0],
+		}}
+	}
+
+	attrs, diags := block.Body.JustAttributes()
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	for name, attr := range attrs {
+		if !stringInSlice(name, source.Required) && !stringInSlice(name, source.Optional) {
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Unsupported argument",
+				Detail: fmt.Sprintf("An argument named %q is not expected for the %q source.",
+					name, typ),
+				Subject: attr.NameRange.Ptr(),
+			})
+			continue
+		}
+
+		val, valDiags := attr.Expr.Value(nil)
+		diags = append(diags, valDiags...)
+		if valDiags.HasErrors() {
+			continue
+		}
+
+		val, err := convert.Convert(val, cty.String)
+		if err != nil || val.IsNull() {
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Invalid argument value",
+				Detail:   fmt.Sprintf("The value of %q must be a string.", name),
+				Subject:  attr.Expr.Range().Ptr(),
+			})
+			continue
+		}
+
+		rs.Config[name] = val.AsString()
+	}
+
+	for _, name := range source.Required {
+		if _, ok := attrs[name]; !ok {
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Missing required argument",
+				Detail: fmt.Sprintf("The argument %q is required for the %q source.",
+					name, typ),
+				Subject: block.Body.MissingItemRange().Ptr(),
+			})
+		}
+	}
+
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	return rs, diags
+}
+
+// LoadRemoteValues reads the values of variables that have a remote source
+// and no other value set, from the CLI, files, the server, etc. These take
+// precedence over the default value of the variable. This should be called
+// with the values returned by EvaluateVariables.
+//
+// Values are cached for a short time so that jobs executed by the same
+// runner don't read the same values over and over.
+func LoadRemoteValues(
+	ctx context.Context,
+	iv Values,
+	vs map[string]*Variable,
+	log hclog.Logger,
+) hcl.Diagnostics {
+	return loadRemoteValues(ctx, defaultRemoteCache, iv, vs, log)
+}
+
+func loadRemoteValues(
+	ctx context.Context,
+	c *remoteCache,
+	iv Values,
+	vs map[string]*Variable,
+	log hclog.Logger,
+) hcl.Diagnostics {
+	var diags hcl.Diagnostics
+	for name, variable := range vs {
+		if variable.Remote == nil {
+			continue
+		}
+
+		// Any other value that is set takes precedence.
+		if v := iv[name]; v != nil && v.Source != sourceDefault {
+			continue
+		}
+
+		source := remoteSources[variable.Remote.Type]
+		L := log.With("variable", name, "source", variable.Remote.Type)
+		L.Debug("reading variable value from remote source")
+
+		readCtx, cancel := context.WithTimeout(ctx, remoteTimeout)
+		raw, err := source.Read(readCtx, c, variable.Remote.Config)
+		cancel()
+		if err != nil {
+			L.Warn("error reading variable value from remote source", "error", err)
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  fmt.Sprintf("Unable to read variable %q from %s", name, source.Name),
+				Detail:   err.Error(),
+				Subject:  variable.Remote.Range.Ptr(),
+			})
+			continue
+		}
+
+		val, valDiags := remoteValue(name, variable, raw)
+		diags = append(diags, valDiags...)
+		if valDiags.HasErrors() {
+			continue
+		}
+
+		iv[name] = &Value{
+			Source: sourceRemote,
+			Value:  val,
+		}
+	}
+
+	return diags
+}
+
+// remoteValue converts the raw value read from a remote source to the
+// type of the variable. Like values from the CLI, values of complex types
+// are parsed as HCL, which includes JSON.
+func remoteValue(name string, variable *Variable, raw string) (cty.Value, hcl.Diagnostics) {
+	val := cty.StringVal(raw)
+	if variable.Type == cty.NilType {
+		return val, nil
+	}
+
+	if !variable.Type.IsPrimitiveType() {
+		fakeFilename := fmt.Sprintf("<value for var.%s from source %q>", name, sourceRemote)
+		expr, diags := hclsyntax.ParseExpression([]byte(raw), fakeFilename, hcl.Pos{Line: 1, Column: 1})
+		if diags.HasErrors() {
+			return cty.DynamicVal, diags
+		}
+
+		var valDiags hcl.Diagnostics
+		val, valDiags = expr.Value(nil)
+		if valDiags.HasErrors() {
+			return cty.DynamicVal, valDiags
+		}
+	}
+
+	val, err := convert.Convert(val, variable.Type)
+	if err != nil {
+		return cty.DynamicVal, hcl.Diagnostics{{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid value for variable",
+			Detail: fmt.Sprintf(
+				"The value read for variable %q from source %q is not compatible with the variable's type constraint: %s.",
+				name, variable.Remote.Type, err),
+			Subject: variable.Remote.Range.Ptr(),
+		}}
+	}
+
+	return val, nil
+}
+
+// readTerraformCloud reads an output of the current state of a Terraform
+// Cloud workspace. All outputs of the workspace are cached so that
+// variables reading different outputs only read the workspace once.
+func readTerraformCloud(ctx context.Context, c *remoteCache, cfg map[string]string) (string, error) {
+	baseURL := strings.TrimSuffix(cfg["base_url"], "/")
+	if baseURL == "" {
+		baseURL = "https://app.terraform.io"
+	}
+
+	token := cfg["token"]
+	if token == "" {
+		token = os.Getenv("TFE_TOKEN")
+	}
+	if token == "" {
+		return "", fmt.Errorf("no Terraform Cloud token set. Set the \"token\" " +
+			"argument or the TFE_TOKEN environment variable on the runner")
+	}
+
+	// The token is part of the key since tokens may have access to
+	// different workspaces.
+	org, ws := cfg["organization"], cfg["workspace"]
+	key := fmt.Sprintf("terraform-cloud %s %s/%s %x", baseURL, org, ws, sha256.Sum256([]byte(token)))
+	raw, err := c.Get(key, func() (interface{}, error) {
+		get := func(path string, result interface{}) error {
+			return remoteGetJSON(ctx, baseURL+path, map[string]string{
+				"Authorization": "Bearer " + token,
+				"Content-Type":  "application/vnd.api+json",
+			}, result)
+		}
+
+		var wsResp struct {
+			Data struct {
+				Id string `json:"id"`
+			} `json:"data"`
+		}
+		err := get(fmt.Sprintf("/api/v2/organizations/%s/workspaces/%s",
+			url.PathEscape(org), url.PathEscape(ws)), &wsResp)
+		if err != nil {
+			if err, ok := err.(*remoteStatusError); ok && err.StatusCode == http.StatusNotFound {
+				return nil, fmt.Errorf("workspace %q wasn't found in organization %q, "+
+					"or the token doesn't have access to it", ws, org)
+			}
+
+			return nil, err
+		}
+
+		var outputsResp struct {
+			Data []struct {
+				Attributes struct {
+					Name      string          `json:"name"`
+					Sensitive bool            `json:"sensitive"`
+					Value     json.RawMessage `json:"value"`
+				} `json:"attributes"`
+			} `json:"data"`
+		}
+		err = get(fmt.Sprintf("/api/v2/workspaces/%s/current-state-version-outputs",
+			url.PathEscape(wsResp.Data.Id)), &outputsResp)
+		if err != nil {
+			return nil, err
+		}
+
+		outputs := map[string]json.RawMessage{}
+		for _, o := range outputsResp.Data {
+			outputs[o.Attributes.Name] = o.Attributes.Value
+		}
+
+		return outputs, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	name := cfg["output"]
+	value, ok := raw.(map[string]json.RawMessage)[name]
+	if !ok {
+		return "", fmt.Errorf("workspace %q has no output %q", ws, name)
+	}
+	if len(value) == 0 || string(value) == "null" {
+		return "", fmt.Errorf("output %q of workspace %q has no value. Sensitive "+
+			"outputs are only returned for tokens that can read the state", name, ws)
+	}
+
+	// Strings are used as is, other values are kept as JSON which is
+	// parsed to the type of the variable.
+	var str string
+	if err := json.Unmarshal(value, &str); err == nil {
+		return str, nil
+	}
+
+	return string(value), nil
+}
+
+// readConsul reads a key from the Consul KV store. The address and token
+// default to the standard Consul environment variables.
+func readConsul(ctx context.Context, c *remoteCache, cfg map[string]string) (string, error) {
+	addr := cfg["address"]
+	if addr == "" {
+		addr = os.Getenv("CONSUL_HTTP_ADDR")
+	}
+	if addr == "" {
+		addr = "127.0.0.1:8500"
+	}
+	if !strings.Contains(addr, "://") {
+		addr = "http://" + addr
+	}
+	addr = strings.TrimSuffix(addr, "/")
+
+	token := cfg["token"]
+	if token == "" {
+		token = os.Getenv("CONSUL_HTTP_TOKEN")
+	}
+
+	key := strings.TrimPrefix(cfg["key"], "/")
+	u := addr + "/v1/kv/" + key + "?raw=true"
+	if dc := cfg["datacenter"]; dc != "" {
+		u += "&dc=" + url.QueryEscape(dc)
+	}
+
+	cacheKey := fmt.Sprintf("consul %s %x", u, sha256.Sum256([]byte(token)))
+	raw, err := c.Get(cacheKey, func() (interface{}, error) {
+		headers := map[string]string{}
+		if token != "" {
+			headers["X-Consul-Token"] = token
+		}
+
+		data, err := remoteGet(ctx, u, headers)
+		if err != nil {
+			if err, ok := err.(*remoteStatusError); ok && err.StatusCode == http.StatusNotFound {
+				return nil, fmt.Errorf("key %q wasn't found in Consul at %s", key, addr)
+			}
+
+			return nil, err
+		}
+
+		return string(data), nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return raw.(string), nil
+}
+
+// remoteStatusError is returned for unsuccessful responses of remote
+// sources.
+type remoteStatusError struct {
+	URL        string
+	StatusCode int
+	Body       string
+}
+
+func (e *remoteStatusError) Error() string {
+	msg := fmt.Sprintf("request to %s failed with status %d", e.URL, e.StatusCode)
+	switch e.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		msg += ", check that the token is valid and has access"
+	}
+	if e.Body != "" {
+		msg += ": " + e.Body
+	}
+
+	return msg
+}
+
+// remoteGet sends a GET request and returns the body of the response.
+func remoteGet(ctx context.Context, u string, headers map[string]string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	// We don't include the query in errors since it may be long.
+	display := u
+	if idx := strings.Index(display, "?"); idx >= 0 {
+		display = display[:idx]
+	}
+
+	resp, err := cleanhttp.DefaultClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to reach %s: %s", display, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response from %s: %s", display, err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body := strings.TrimSpace(string(data))
+		if len(body) > 256 {
+			body = body[:256] + "..."
+		}
+
+		return nil, &remoteStatusError{
+			URL:        display,
+			StatusCode: resp.StatusCode,
+			Body:       body,
+		}
+	}
+
+	return data, nil
+}
+
+// remoteGetJSON sends a GET request and decodes the JSON response.
+func remoteGetJSON(ctx context.Context, u string, headers map[string]string, result interface{}) error {
+	data, err := remoteGet(ctx, u, headers)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(data, result); err != nil {
+		return fmt.Errorf("error decoding response from %s: %s", u, err)
+	}
+
+	return nil
+}
+
+// remoteCache caches the values read from remote sources for
+// remoteCacheTTL. Errors are never cached.
+type remoteCache struct {
+	mu      sync.Mutex
+	entries map[string]*remoteCacheEntry
+}
+
+type remoteCacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// Get returns the cached value for key or calls read to read it.
+func (c *remoteCache) Get(key string, read func() (interface{}, error)) (interface{}, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[key]; ok && time.Now().Before(e.expires) {
+		return e.value, nil
+	}
+
+	value, err := read()
+	if err != nil {
+		return nil, err
+	}
+
+	if c.entries == nil {
+		c.entries = map[string]*remoteCacheEntry{}
+	}
+	c.entries[key] = &remoteCacheEntry{
+		value:   value,
+		expires: time.Now().Add(remoteCacheTTL),
+	}
+
+	return value, nil
+}
+
+func stringInSlice(v string, list []string) bool {
+	for _, s := range list {
+		if s == v {
+			return true
+		}
+	}
+
+	return false
+}
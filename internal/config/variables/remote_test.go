@@ -0,0 +1,150 @@
+package variables
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclsimple"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestLoadRemoteValues(t *testing.T) {
+	var tfcRequests, consulRequests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/organizations/foocorp/workspaces/databases":
+			tfcRequests++
+			if r.Header.Get("Authorization") != "Bearer secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Write([]byte(`{"data": {"id": "ws-123"}}`))
+
+		case "/api/v2/workspaces/ws-123/current-state-version-outputs":
+			w.Write([]byte(`{"data": [
+				{"attributes": {"name": "db_host", "value": "db.internal"}},
+				{"attributes": {"name": "regions", "value": ["us-east-1", "eu-west-1"]}}
+			]}`))
+
+		case "/v1/kv/apps/web/replicas":
+			consulRequests++
+			w.Write([]byte("3"))
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	load := func(t *testing.T, file string) map[string]*Variable {
+		var base testConfig
+		require.NoError(t, hclsimple.DecodeFile(filepath.Join("testdata", file), nil, &base))
+
+		schema, _ := gohcl.ImpliedBodySchema(&testConfig{})
+		content, diags := base.Body.Content(schema)
+		require.False(t, diags.HasErrors())
+
+		vs, diags := DecodeVariableBlocks(content)
+		require.False(t, diags.HasErrors(), diags.Error())
+
+		// Point all the sources at our test server
+		for _, v := range vs {
+			v.Remote.Config["base_url"] = srv.URL
+			v.Remote.Config["address"] = srv.URL
+			v.Remote.Config["token"] = "secret"
+		}
+
+		return vs
+	}
+
+	t.Run("reads values without another value", func(t *testing.T) {
+		require := require.New(t)
+		tfcRequests, consulRequests = 0, 0
+
+		vs := load(t, "remote.hcl")
+		iv, diags := EvaluateVariables(nil, vs, hclog.L())
+		require.False(diags.HasErrors(), diags.Error())
+
+		var c remoteCache
+		diags = loadRemoteValues(context.Background(), &c, iv, vs, hclog.L())
+		require.False(diags.HasErrors(), diags.Error())
+
+		require.Equal(cty.StringVal("db.internal"), iv["db_host"].Value)
+		require.Equal(sourceRemote, iv["db_host"].Source)
+		require.True(iv["regions"].Value.RawEquals(stringListVal("us-east-1", "eu-west-1")))
+		require.True(iv["replicas"].Value.RawEquals(cty.NumberIntVal(3)))
+
+		// Both outputs are read from the same workspace
+		require.Equal(1, tfcRequests)
+
+		// Values are cached
+		iv, _ = EvaluateVariables(nil, vs, hclog.L())
+		diags = loadRemoteValues(context.Background(), &c, iv, vs, hclog.L())
+		require.False(diags.HasErrors(), diags.Error())
+		require.Equal(1, tfcRequests)
+		require.Equal(1, consulRequests)
+	})
+
+	t.Run("other values take precedence", func(t *testing.T) {
+		require := require.New(t)
+		consulRequests = 0
+
+		vs := load(t, "remote.hcl")
+		iv, diags := EvaluateVariables([]*pb.Variable{
+			{
+				Name:   "replicas",
+				Value:  &pb.Variable_Num{Num: 5},
+				Source: &pb.Variable_Cli{},
+			},
+		}, vs, hclog.L())
+		require.False(diags.HasErrors(), diags.Error())
+
+		var c remoteCache
+		diags = loadRemoteValues(context.Background(), &c, iv, vs, hclog.L())
+		require.False(diags.HasErrors(), diags.Error())
+		require.True(iv["replicas"].Value.RawEquals(cty.NumberIntVal(5)))
+		require.Equal(sourceCLI, iv["replicas"].Source)
+		require.Equal(0, consulRequests)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		require := require.New(t)
+
+		vs := load(t, "remote.hcl")
+		vs["db_host"].Remote.Config["token"] = "wrong"
+		vs["replicas"].Remote.Config["key"] = "missing"
+
+		iv, diags := EvaluateVariables(nil, vs, hclog.L())
+		require.False(diags.HasErrors(), diags.Error())
+
+		var c remoteCache
+		diags = loadRemoteValues(context.Background(), &c, iv, vs, hclog.L())
+		require.True(diags.HasErrors())
+		require.Contains(diags.Error(), `Unable to read variable "db_host" from Terraform Cloud`)
+		require.Contains(diags.Error(), "status 401")
+		require.Contains(diags.Error(), `key "missing" wasn't found in Consul`)
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		require := require.New(t)
+
+		vs := load(t, "remote.hcl")
+		vs["replicas"].Remote.Config["address"] = "127.0.0.1:1"
+
+		iv, diags := EvaluateVariables(nil, vs, hclog.L())
+		require.False(diags.HasErrors(), diags.Error())
+
+		var c remoteCache
+		diags = loadRemoteValues(context.Background(), &c, iv, vs, hclog.L())
+		require.True(diags.HasErrors())
+		require.Contains(diags.Error(), "unable to reach http://127.0.0.1:1/v1/kv/apps/web/replicas")
+	})
+}
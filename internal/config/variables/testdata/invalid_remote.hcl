@@ -0,0 +1,7 @@
+variable "db_host" {
+  type = string
+
+  source "etcd" {
+    key = "db_host"
+  }
+}
@@ -0,0 +1,8 @@
+variable "db_host" {
+  type = string
+
+  source "terraform-cloud" {
+    organization = "foocorp"
+    output       = "db_host"
+  }
+}
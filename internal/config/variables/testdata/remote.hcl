@@ -0,0 +1,28 @@
+variable "db_host" {
+  type = string
+
+  source "terraform-cloud" {
+    organization = "foocorp"
+    workspace    = "databases"
+    output       = "db_host"
+  }
+}
+
+variable "replicas" {
+  default = 1
+  type    = number
+
+  source "consul" {
+    key = "apps/web/replicas"
+  }
+}
+
+variable "regions" {
+  type = list(string)
+
+  source "terraform-cloud" {
+    organization = "foocorp"
+    workspace    = "databases"
+    output       = "regions"
+  }
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/hcl/v2/ext/typeexpr"
 	"github.com/hashicorp/hcl/v2/gohcl"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
 	hcljson "github.com/hashicorp/hcl/v2/json"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 	"github.com/zclconf/go-cty/cty"
@@ -31,6 +32,7 @@ const (
 	sourceEnv     = "env"
 	sourceVCS     = "vcs"
 	sourceServer  = "server"
+	sourceRemote  = "remote"
 	sourceDefault = "default"
 )
 
@@ -58,6 +60,12 @@ var (
 				Name: "description",
 			},
 		},
+		Blocks: []hcl.BlockHeaderSchema{
+			{
+				Type:       "source",
+				LabelNames: []string{"type"},
+			},
+		},
 	}
 )
 
@@ -79,6 +87,10 @@ type Variable struct {
 	// Description of the variable
 	Description string
 
+	// Remote is the remote source the value is read from at job time if
+	// no other value is set. This takes precedence over the default.
+	Remote *RemoteSource
+
 	// The location of the variable definition block in the waypoint.hcl
 	Range hcl.Range
 }
@@ -93,6 +105,14 @@ type HclVariable struct {
 	Default     cty.Value      `hcl:"default,optional"`
 	Type        hcl.Expression `hcl:"type,optional"`
 	Description string         `hcl:"description,optional"`
+	Source      *HclSource     `hcl:"source,block"`
+}
+
+// HclSource is the source block of a variable, used like HclVariable to
+// verify the HCL syntax. The body is decoded later based on the type.
+type HclSource struct {
+	Type string   `hcl:",label"`
+	Body hcl.Body `hcl:",remain"`
 }
 
 // Values are used to store values collected from various sources.
@@ -110,6 +130,25 @@ type Value struct {
 	Range hcl.Range
 }
 
+// Strings returns the values formatted as strings. String values are
+// returned as-is and other values use HCL syntax. Unknown values are
+// skipped.
+func (vs Values) Strings() map[string]string {
+	result := map[string]string{}
+	for name, v := range vs {
+		switch {
+		case v == nil || !v.Value.IsKnown():
+			continue
+		case !v.Value.IsNull() && v.Value.Type() == cty.String:
+			result[name] = v.Value.AsString()
+		default:
+			result[name] = string(hclwrite.TokensForValue(v.Value).Bytes())
+		}
+	}
+
+	return result
+}
+
 // DecodeVariableBlocks uses the hclConfig schema to iterate over all
 // variable blocks, validating names and types and checking for duplicates.
 // It returns the final map of Variables to store for later reference.
@@ -165,6 +204,25 @@ func decodeVariableBlock(block *hcl.Block) (*Variable, hcl.Diagnostics) {
 		}
 	}
 
+	for _, block := range content.Blocks.OfType("source") {
+		if v.Remote != nil {
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Duplicate variable source",
+				Detail:   "A variable may only have one source block.",
+				Subject:  &block.DefRange,
+			})
+			return nil, diags
+		}
+
+		rs, sourceDiags := decodeRemoteSource(block)
+		diags = append(diags, sourceDiags...)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		v.Remote = rs
+	}
+
 	if attr, ok := content.Attributes["type"]; ok {
 		t, moreDiags := typeexpr.Type(attr.Expr)
 		diags = append(diags, moreDiags...)
@@ -398,10 +456,11 @@ func EvaluateVariables(
 		}
 	}
 
-	// check that all variables have a set value, including default of null
-	for name := range vs {
+	// check that all variables have a set value, including default of null.
+	// Variables with a remote source are read later by LoadRemoteValues.
+	for name, variable := range vs {
 		v, ok := iv[name]
-		if !ok || v == nil {
+		if (!ok || v == nil) && variable.Remote == nil {
 			return nil, append(diags, &hcl.Diagnostic{
 				Severity: hcl.DiagError,
 				Summary:  fmt.Sprintf("Unset variable %q", name),
@@ -416,6 +475,25 @@ func EvaluateVariables(
 	return iv, diags
 }
 
+// ServerValues returns the variable values stored on the server that apply
+// to the given workspace. Values without a workspace come first, followed
+// by the values for the workspace, so that the workspace values take
+// precedence when evaluated. Values for other workspaces are dropped.
+func ServerValues(pbvars []*pb.Variable, workspace string) []*pb.Variable {
+	var global, scoped []*pb.Variable
+	for _, v := range pbvars {
+		switch {
+		case v.Workspace == nil || v.Workspace.Workspace == "":
+			global = append(global, v)
+
+		case strings.EqualFold(v.Workspace.Workspace, workspace):
+			scoped = append(scoped, v)
+		}
+	}
+
+	return append(global, scoped...)
+}
+
 // LoadAutoFiles loads any *.auto.wpvars(.json) files in the source repo
 func LoadAutoFiles(wd string) ([]*pb.Variable, hcl.Diagnostics) {
 	var pbv []*pb.Variable
@@ -584,6 +662,11 @@ func readFileValues(filename string) (*hcl.File, hcl.Diagnostics) {
 func (iv Values) values() map[string]cty.Value {
 	res := map[string]cty.Value{}
 	for k, v := range iv {
+		// Values of remote sources that weren't read yet are nil.
+		if v == nil {
+			continue
+		}
+
 		res[k] = v.Value
 	}
 	return res
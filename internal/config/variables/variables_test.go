@@ -34,6 +34,18 @@ func TestVariables_DecodeVariableBlock(t *testing.T) {
 			"invalid_def.hcl",
 			"Invalid default value",
 		},
+		{
+			"remote.hcl",
+			"",
+		},
+		{
+			"invalid_remote.hcl",
+			"Invalid variable source",
+		},
+		{
+			"invalid_remote_args.hcl",
+			"Missing required argument",
+		},
 	}
 
 	for _, tt := range cases {
@@ -396,6 +408,59 @@ func TestVariables_SetJobInputVariables(t *testing.T) {
 	}
 }
 
+func TestVariables_ServerValues(t *testing.T) {
+	require := require.New(t)
+
+	vars := []*pb.Variable{
+		{
+			Name:  "db",
+			Value: &pb.Variable_Str{Str: "prod"},
+			Workspace: &pb.Ref_Workspace{
+				Workspace: "prod",
+			},
+		},
+		{
+			Name:  "db",
+			Value: &pb.Variable_Str{Str: "default"},
+		},
+		{
+			Name:  "db",
+			Value: &pb.Variable_Str{Str: "staging"},
+			Workspace: &pb.Ref_Workspace{
+				Workspace: "staging",
+			},
+		},
+	}
+
+	result := ServerValues(vars, "prod")
+	require.Len(result, 2)
+	require.Equal("default", result[0].Value.(*pb.Variable_Str).Str)
+	require.Equal("prod", result[1].Value.(*pb.Variable_Str).Str)
+
+	result = ServerValues(vars, "dev")
+	require.Len(result, 1)
+	require.Equal("default", result[0].Value.(*pb.Variable_Str).Str)
+}
+
+func TestValues_Strings(t *testing.T) {
+	require := require.New(t)
+
+	vs := Values{
+		"str":  &Value{Value: cty.StringVal("hello")},
+		"num":  &Value{Value: cty.NumberIntVal(42)},
+		"list": &Value{Value: cty.ListVal([]cty.Value{cty.StringVal("a")})},
+		"null": &Value{Value: cty.NullVal(cty.String)},
+		"skip": &Value{Value: cty.UnknownVal(cty.String)},
+	}
+
+	require.Equal(map[string]string{
+		"str":  "hello",
+		"num":  "42",
+		"list": `["a"]`,
+		"null": "null",
+	}, vs.Strings())
+}
+
 // helper functions
 var ctyValueComparer = cmp.Comparer(func(x, y cty.Value) bool {
 	return x.RawEquals(y)
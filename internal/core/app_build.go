@@ -7,6 +7,8 @@ import (
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/hashicorp/go-argmapper"
 	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
 
 	"github.com/hashicorp/waypoint-plugin-sdk/component"
 	"github.com/hashicorp/waypoint/internal/config"
@@ -25,23 +27,59 @@ func (a *App) Build(ctx context.Context, optFuncs ...BuildOption) (
 		return nil, nil, err
 	}
 
+	// The app config is only resolved for builds that use `config.env`,
+	// since it may read dynamic values from config sources.
+	var evalCtx *hcl.EvalContext
+	if a.config.BuildUsesConfigEnv() {
+		// The config has to be synced before it can be resolved.
+		if err := a.ConfigSync(ctx); err != nil {
+			return nil, nil, err
+		}
+
+		env, err := a.ConfigEnv(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		evalCtx = &hcl.EvalContext{
+			Variables: map[string]cty.Value{
+				"config": configEnvVal(env),
+			},
+		}
+	}
+
 	// Render the config
-	c, err := componentCreatorMap[component.BuilderType].Create(ctx, a, nil)
+	c, err := componentCreatorMap[component.BuilderType].Create(ctx, a, evalCtx)
 	if err != nil {
 		return nil, nil, err
 	}
 	defer c.Close()
 
+	// Restore the cache of the builder from the server so that builds on
+	// ephemeral runners can reuse it. The cache only speeds up builds, so
+	// failing to restore or save it doesn't fail the build.
+	log := a.logger.Named("build")
+	cacheKey := buildCacheKey(c)
+	cacheDir := a.buildCacheDir(c)
+	if err := a.restoreBuildCache(ctx, log, cacheKey, cacheDir); err != nil {
+		log.Warn("error restoring build cache", "key", cacheKey, "err", err)
+	}
+
 	// First we do the build
-	_, msg, err := a.doOperation(ctx, a.logger.Named("build"), &buildOperation{
+	_, msg, err := a.doOperation(ctx, log, &buildOperation{
 		Component:   c,
 		HasRegistry: a.config.RegistryUse() != "",
+		CacheDir:    cacheDir,
 	})
 	if err != nil {
 		return nil, nil, err
 	}
 	build := msg.(*pb.Build)
 
+	if err := a.saveBuildCache(ctx, log, cacheKey, cacheDir); err != nil {
+		log.Warn("error saving build cache", "key", cacheKey, "err", err)
+	}
+
 	// If we're not pushing, then we're done!
 	if !opts.Push {
 		return build, nil, nil
@@ -95,6 +133,10 @@ type buildOperation struct {
 	Build     *pb.Build
 
 	HasRegistry bool
+
+	// CacheDir is the directory the builder can keep a cache in. Its
+	// contents are stored in the build cache of the server between builds.
+	CacheDir string
 }
 
 func (op *buildOperation) Init(app *App) (proto.Message, error) {
@@ -135,6 +177,7 @@ func (op *buildOperation) Do(ctx context.Context, log hclog.Logger, app *App, _
 		op.Component,
 		op.Component.Value.(component.Builder).BuildFunc(),
 		argmapper.Named("HasRegistry", op.HasRegistry),
+		argmapper.Named("BuildCacheDir", op.CacheDir),
 	)
 }
 
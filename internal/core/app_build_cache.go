@@ -0,0 +1,216 @@
+package core
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/internal/pkg/tarcopy"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// buildCacheChunkSize is the size of the chunks that build cache data is
+// uploaded in.
+const buildCacheChunkSize = 1024 * 1024 // 1 MB
+
+// buildCacheKey returns the key of the build cache of a builder. The cache
+// of each builder is kept apart so that switching builders doesn't restore
+// data the new builder doesn't understand.
+func buildCacheKey(c *Component) string {
+	return "build/" + c.Info.Name
+}
+
+// buildCacheDir returns the local directory of the build cache of a
+// builder. Builders receive it as the "BuildCacheDir" argument.
+func (a *App) buildCacheDir(c *Component) string {
+	return filepath.Join(a.dir.CacheDir(), "build", c.Info.Name)
+}
+
+// restoreBuildCache replaces the contents of dir with the build cache
+// stored in the server under key. If the server has no data for the key,
+// dir is left as is.
+func (a *App) restoreBuildCache(
+	ctx context.Context,
+	log hclog.Logger,
+	key string,
+	dir string,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := a.client.DownloadBuildCache(ctx, &pb.DownloadBuildCacheRequest{
+		Application: a.ref,
+		Key:         key,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The first message is always the Open event, or the error if there
+	// is no data.
+	resp, err := stream.Recv()
+	if status.Code(err) == codes.NotFound {
+		log.Debug("no build cache stored", "key", key)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	open, ok := resp.Event.(*pb.DownloadBuildCacheResponse_Open_)
+	if !ok {
+		return fmt.Errorf("first message of the build cache wasn't Open")
+	}
+	entry := open.Open.Entry
+
+	// Extract to a temporary directory first so that a failed download
+	// doesn't leave a partial cache behind.
+	if err := os.MkdirAll(filepath.Dir(dir), 0755); err != nil {
+		return err
+	}
+	td, err := ioutil.TempDir(filepath.Dir(dir), filepath.Base(dir)+".restore")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(td)
+
+	pr, pw := io.Pipe()
+	h := sha256.New()
+	go func() {
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				pw.Close()
+				return
+			}
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+
+			chunk, ok := resp.Event.(*pb.DownloadBuildCacheResponse_Chunk)
+			if !ok {
+				pw.CloseWithError(fmt.Errorf("expected a chunk of build cache data"))
+				return
+			}
+
+			if _, err := pw.Write(chunk.Chunk); err != nil {
+				return
+			}
+		}
+	}()
+
+	gzr, err := gzip.NewReader(io.TeeReader(pr, h))
+	if err == nil {
+		err = tarcopy.Extract(gzr, td)
+	}
+	if err == nil {
+		// Read any trailing data so that the digest covers all of it.
+		_, err = io.Copy(ioutil.Discard, io.TeeReader(pr, h))
+	}
+	if err != nil {
+		pr.CloseWithError(err)
+		return fmt.Errorf("error extracting build cache: %w", err)
+	}
+
+	if digest := "sha256:" + hex.EncodeToString(h.Sum(nil)); digest != entry.Digest {
+		return fmt.Errorf("build cache digest mismatch, expected %s, got %s",
+			entry.Digest, digest)
+	}
+
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	if err := os.Rename(td, dir); err != nil {
+		return err
+	}
+
+	log.Debug("restored build cache", "key", key, "digest", entry.Digest, "size", entry.Size)
+	return nil
+}
+
+// saveBuildCache uploads the contents of dir to the server under key. If
+// dir doesn't exist or is empty, nothing is uploaded.
+func (a *App) saveBuildCache(
+	ctx context.Context,
+	log hclog.Logger,
+	key string,
+	dir string,
+) error {
+	entries, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) || (err == nil && len(entries) == 0) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := a.client.UploadBuildCache(ctx)
+	if err != nil {
+		return err
+	}
+	if err := stream.Send(&pb.UploadBuildCacheRequest{
+		Event: &pb.UploadBuildCacheRequest_Open_{
+			Open: &pb.UploadBuildCacheRequest_Open{
+				Application: a.ref,
+				Key:         key,
+			},
+		},
+	}); err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		gzw := gzip.NewWriter(pw)
+		err := tarcopy.Write(gzw, dir)
+		if err == nil {
+			err = gzw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+	defer pr.Close()
+
+	for {
+		// A new buffer for every chunk since the stream may still use
+		// the previous message after Send returns.
+		buf := make([]byte, buildCacheChunkSize)
+		n, err := io.ReadFull(pr, buf)
+		if n > 0 {
+			if err := stream.Send(&pb.UploadBuildCacheRequest{
+				Event: &pb.UploadBuildCacheRequest_Chunk{
+					Chunk: buf[:n],
+				},
+			}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("error archiving build cache: %w", err)
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+
+	log.Debug("saved build cache", "key", key,
+		"digest", resp.Entry.Digest, "size", resp.Entry.Size)
+	return nil
+}
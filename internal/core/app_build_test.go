@@ -2,6 +2,9 @@ package core
 
 import (
 	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/go-argmapper"
@@ -10,6 +13,7 @@ import (
 	"github.com/hashicorp/waypoint-plugin-sdk/component"
 	componentmocks "github.com/hashicorp/waypoint-plugin-sdk/component/mocks"
 	"github.com/hashicorp/waypoint/internal/config"
+	"github.com/hashicorp/waypoint/internal/server/singleprocess"
 )
 
 func TestAppBuild_happy(t *testing.T) {
@@ -143,6 +147,59 @@ func TestAppBuild_hasRegistry(t *testing.T) {
 	})
 }
 
+// Test that the contents of the build cache directory are restored by
+// later builds, even with a different data directory such as on another
+// runner.
+func TestAppBuild_buildCache(t *testing.T) {
+	require := require.New(t)
+	client := singleprocess.TestServer(t)
+
+	build := func(fn func(dir string)) {
+		mock := &componentmocks.Builder{}
+		factory := TestFactory(t, component.BuilderType)
+		TestFactoryRegister(t, factory, "test", mock)
+
+		// Every project has its own data directory
+		app := TestApp(t, TestProject(t,
+			WithClient(client),
+			WithConfig(config.TestConfig(t, testBuildConfig)),
+			WithFactory(component.BuilderType, factory),
+		), "test")
+
+		artifact := &componentmocks.Artifact{}
+		artifact.On("Labels").Return(map[string]string{})
+		mock.On("BuildFunc").Return(func(args struct {
+			argmapper.Struct
+
+			BuildCacheDir string
+		}) component.Artifact {
+			fn(args.BuildCacheDir)
+			return artifact
+		})
+
+		_, _, err := app.Build(context.Background(), BuildWithPush(false))
+		require.NoError(err)
+	}
+
+	// The first build has no cache and writes to it
+	build(func(dir string) {
+		require.NotEmpty(dir)
+		_, err := os.Stat(dir)
+		require.True(os.IsNotExist(err))
+
+		require.NoError(os.MkdirAll(filepath.Join(dir, "layers"), 0755))
+		require.NoError(ioutil.WriteFile(
+			filepath.Join(dir, "layers", "a"), []byte("hello"), 0644))
+	})
+
+	// The next build gets the cache back
+	build(func(dir string) {
+		data, err := ioutil.ReadFile(filepath.Join(dir, "layers", "a"))
+		require.NoError(err)
+		require.Equal("hello", string(data))
+	})
+}
+
 const testBuildConfig = `
 project = "test"
 
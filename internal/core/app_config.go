@@ -3,8 +3,14 @@ package core
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/waypoint/internal/appconfig"
+	"github.com/hashicorp/waypoint/internal/plugin"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
 )
 
 // ConfigSync writes all the app configuration in the waypoint.hcl file to
@@ -61,10 +67,18 @@ func (a *App) ConfigSync(ctx context.Context) error {
 	}
 
 	if appMD != nil {
-		_, err := a.client.UpsertApplication(ctx, &pb.UpsertApplicationRequest{
+		// The upsert replaces the health checks, so we send them too.
+		checks, err := a.config.HealthCheckProtos()
+		if err != nil {
+			return err
+		}
+
+		_, err = a.client.UpsertApplication(ctx, &pb.UpsertApplicationRequest{
 			Project:          a.project.Ref(),
 			Name:             a.Ref().Application,
 			FileChangeSignal: appMD.FileChangeSignal,
+			Labels:           a.config.Labels,
+			HealthChecks:     checks,
 		})
 		if err != nil {
 			return err
@@ -73,3 +87,132 @@ func (a *App) ConfigSync(ctx context.Context) error {
 
 	return nil
 }
+
+// HealthCheckSync writes the health checks of the app in the waypoint.hcl
+// file to the server. The entrypoints of new instances run these checks.
+func (a *App) HealthCheckSync(ctx context.Context) error {
+	checks, err := a.config.HealthCheckProtos()
+	if err != nil {
+		return err
+	}
+
+	resp, err := a.client.GetProject(ctx, &pb.GetProjectRequest{
+		Project: a.project.Ref(),
+	})
+	if err != nil {
+		return err
+	}
+
+	// Keep the file change signal since the upsert replaces it.
+	req := &pb.UpsertApplicationRequest{
+		Project:      a.project.Ref(),
+		Name:         a.Ref().Application,
+		Labels:       a.config.Labels,
+		HealthChecks: checks,
+	}
+	for _, app := range resp.Project.Applications {
+		if app.Name != req.Name {
+			continue
+		}
+
+		if serverptypes.HealthChecksEqual(app.HealthChecks, checks) {
+			return nil
+		}
+
+		req.FileChangeSignal = app.FileChangeSignal
+	}
+
+	a.logger.Info("syncing health checks", "len", len(checks))
+	_, err = a.client.UpsertApplication(ctx, req)
+	return err
+}
+
+// ConfigEnv resolves the app configuration on the server to the env vars
+// the app would see, the way the entrypoint does. This is used to deploy
+// apps without the entrypoint. Dynamic values are read once, so changes
+// only take effect with a new deployment.
+func (a *App) ConfigEnv(ctx context.Context) (map[string]string, error) {
+	resp, err := a.client.GetConfig(ctx, &pb.ConfigGetRequest{
+		Scope: &pb.ConfigGetRequest_Application{
+			Application: a.ref,
+		},
+		Workspace: a.workspace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]string{}
+	if len(resp.Variables) == 0 {
+		return result, nil
+	}
+
+	w, err := appconfig.NewWatcher(
+		appconfig.WithLogger(a.logger.Named("appconfig")),
+		appconfig.WithPlugins(plugin.ConfigSourcers),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer w.Close()
+
+	// We only need the config sources if there is a dynamic value.
+	for _, v := range resp.Variables {
+		if _, ok := v.Value.(*pb.ConfigVar_Dynamic); !ok {
+			continue
+		}
+
+		sources, err := a.client.GetConfigSource(ctx, &pb.GetConfigSourceRequest{
+			Scope: &pb.GetConfigSourceRequest_Global{
+				Global: &pb.Ref_Global{},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := w.UpdateSources(ctx, sources.ConfigSources); err != nil {
+			return nil, err
+		}
+
+		break
+	}
+
+	if err := w.UpdateVars(ctx, resp.Variables); err != nil {
+		return nil, err
+	}
+
+	cfg, _, err := w.Next(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, kv := range cfg.EnvVars {
+		idx := strings.Index(kv, "=")
+		if idx == -1 {
+			continue
+		}
+
+		result[kv[:idx]] = kv[idx+1:]
+	}
+
+	return result, nil
+}
+
+// configEnvVal returns the `config` variable of an HCL eval context, with
+// the resolved app config as `config.env`.
+func configEnvVal(env map[string]string) cty.Value {
+	// MapVal doesn't accept an empty map, so an app without config
+	// gets an empty object for `config.env`.
+	configEnv := cty.EmptyObjectVal
+	if len(env) > 0 {
+		vals := map[string]cty.Value{}
+		for k, v := range env {
+			vals[k] = cty.StringVal(v)
+		}
+		configEnv = cty.MapVal(vals)
+	}
+
+	return cty.ObjectVal(map[string]cty.Value{
+		"env": configEnv,
+	})
+}
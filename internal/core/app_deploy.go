@@ -23,6 +23,17 @@ import (
 // Deploy deploys the given artifact.
 // TODO(mitchellh): test
 func (a *App) Deploy(ctx context.Context, push *pb.PushedArtifact) (*pb.Deployment, error) {
+	// Artifacts that failed the vulnerability scan of the build stanza
+	// are recorded so that the result can be inspected, but can't be
+	// deployed.
+	if scan := push.Artifact.GetScan(); scan != nil && !scan.Passed {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"artifact v%d failed the vulnerability scan and can't be deployed. Run "+
+				"`waypoint artifact inspect -vulnerabilities v%d` to list the "+
+				"vulnerabilities.",
+			push.Sequence, push.Sequence)
+	}
+
 	// Add our build to our config
 	var evalCtx hcl.EvalContext
 	evalCtx.Variables = map[string]cty.Value{}
@@ -30,6 +41,15 @@ func (a *App) Deploy(ctx context.Context, push *pb.PushedArtifact) (*pb.Deployme
 		a.logger.Warn("failed to prepare template variables, will not be available",
 			"err", err)
 	}
+
+	// Without the entrypoint the config is resolved for the eval context,
+	// so it has to be synced before the operation does it.
+	if a.config.DisableEntrypoint {
+		if err := a.ConfigSync(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	deployConfig, err := a.deployEvalContext(ctx, &evalCtx)
 	if err != nil {
 		return nil, err
@@ -40,6 +60,8 @@ func (a *App) Deploy(ctx context.Context, push *pb.PushedArtifact) (*pb.Deployme
 		EvalContext:      &evalCtx,
 		Push:             push,
 		DeploymentConfig: deployConfig,
+
+		entrypointDisabled: a.config.DisableEntrypoint,
 	}
 	defer op.Close()
 
@@ -51,6 +73,12 @@ func (a *App) Deploy(ctx context.Context, push *pb.PushedArtifact) (*pb.Deployme
 	return msg.(*pb.Deployment), nil
 }
 
+// DeployMaxUnreleased returns the number of unreleased deployments to keep
+// when pruning after a release, or -1 if the app doesn't set this.
+func (a *App) DeployMaxUnreleased() (int, error) {
+	return a.config.DeployMaxUnreleased(nil)
+}
+
 // deployEvalContext sets the HCL evaluation context for `deploy` blocks.
 //
 // Note that the eval context set won't be entirely identical to the eval
@@ -58,6 +86,10 @@ func (a *App) Deploy(ctx context.Context, push *pb.PushedArtifact) (*pb.Deployme
 // operation, the `entrypoint.env` map will contain an auth token that is
 // generated just-in-time for the deploy. We omit this for non-deploy operations
 // such as destroys or default releasers.
+//
+// If the app disables the entrypoint, the entrypoint env only disables any
+// entrypoint in the image and the resolved app config is set as `config.env`
+// instead so that it can be given to the platform.
 func (a *App) deployEvalContext(
 	ctx context.Context,
 	evalCtx *hcl.EvalContext,
@@ -78,6 +110,18 @@ func (a *App) deployEvalContext(
 		ServerTls:           resp.ServerTls,
 		ServerTlsSkipVerify: resp.ServerTlsSkipVerify,
 	}
+	if a.config.DisableEntrypoint {
+		// Without a server address the env tells the entrypoint to
+		// only run the app.
+		deployConfig = &component.DeploymentConfig{}
+
+		env, err := a.ConfigEnv(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		evalCtx.Variables["config"] = configEnvVal(env)
+	}
 	deployEnv := map[string]cty.Value{}
 	for k, v := range deployConfig.Env() {
 		deployEnv[k] = cty.StringVal(v)
@@ -136,6 +180,10 @@ type deployOperation struct {
 	// Set by init
 	autoHostname pb.UpsertDeploymentRequest_Tristate
 
+	// entrypointDisabled is true if the app is deployed without the
+	// entrypoint, so no entrypoint token is generated.
+	entrypointDisabled bool
+
 	// component is initialized and recycled at various points in the deployment
 	// operation as we have more templating information for the config.
 	component *Component
@@ -242,11 +290,37 @@ func (op *deployOperation) Init(app *App) (proto.Message, error) {
 		State:       pb.Operation_CREATED,
 		HasEntrypointConfig: op.DeploymentConfig != nil &&
 			op.DeploymentConfig.ServerAddr != "",
+		EntrypointDisabled: op.entrypointDisabled,
+		Snapshot:           app.deploySnapshot(context.Background()),
 	}
 
 	return deployment, nil
 }
 
+// deploySnapshot returns the inputs of a deployment that are recorded
+// so that deployments can be compared later. Errors aren't fatal since
+// the snapshot is informational, so they are only logged.
+func (a *App) deploySnapshot(ctx context.Context) *pb.Deployment_Snapshot {
+	result := &pb.Deployment_Snapshot{
+		WaypointHcl: a.project.configSrc,
+		Variables:   a.project.variables.Strings(),
+	}
+
+	resp, err := a.client.GetConfig(ctx, &pb.ConfigGetRequest{
+		Scope: &pb.ConfigGetRequest_Application{
+			Application: a.ref,
+		},
+		Workspace: a.workspace,
+	})
+	if err != nil {
+		a.logger.Warn("error reading config for deployment snapshot", "err", err)
+	} else {
+		result.ConfigVars = resp.Variables
+	}
+
+	return result
+}
+
 func (op *deployOperation) Hooks(app *App) map[string][]*config.Hook {
 	return op.component.hooks
 }
@@ -268,6 +342,16 @@ func (op *deployOperation) Upsert(
 		return nil, err
 	}
 
+	// Apps without the entrypoint don't need a token.
+	if op.entrypointDisabled {
+		op.id = resp.Deployment.Id
+
+		dconfig := *op.DeploymentConfig
+		dconfig.Id = op.id
+		op.DeploymentConfig = &dconfig
+		return resp.Deployment, nil
+	}
+
 	if op.id == "" {
 		// Set our internal ID for the Do step
 		op.id = resp.Deployment.Id
@@ -318,6 +402,9 @@ func (op *deployOperation) Do(ctx context.Context, log hclog.Logger, app *App, m
 	if err := app.ConfigSync(ctx); err != nil {
 		return nil, err
 	}
+	if err := app.HealthCheckSync(ctx); err != nil {
+		return nil, err
+	}
 
 	baseArgs := op.args()
 
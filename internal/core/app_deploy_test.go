@@ -51,6 +51,37 @@ func TestAppDeploy_withDeclaredResource(t *testing.T) {
 	require.NotEmpty(deploy.DeclaredResources)
 }
 
+// Test that artifacts that failed the vulnerability scan can't be deployed
+func TestAppDeploy_scanFailed(t *testing.T) {
+	require := require.New(t)
+
+	// Make our factory for platforms
+	mock := &componentmocks.Platform{}
+	factory := TestFactory(t, component.PlatformType)
+	TestFactoryRegister(t, factory, "test", mock)
+
+	// Make our app
+	app := TestApp(t, TestProject(t,
+		WithConfig(config.TestConfig(t, testDeployConfig)),
+		WithFactory(component.PlatformType, factory),
+	), "test")
+
+	push := &pb.PushedArtifact{
+		Sequence: 3,
+		Artifact: &pb.Artifact{
+			Scan: &pb.ScanResult{
+				Tool:   "trivy",
+				Passed: false,
+			},
+		},
+	}
+
+	_, err := app.Deploy(context.Background(), push)
+	require.Error(err)
+	require.Contains(err.Error(), "failed the vulnerability scan")
+	mock.AssertNotCalled(t, "DeployFunc")
+}
+
 // Test that we set the correct generation ID.
 func TestAppDeploy_generation(t *testing.T) {
 	t.Run("with no generation implementation", func(t *testing.T) {
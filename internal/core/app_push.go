@@ -62,8 +62,31 @@ func (a *App) PushBuild(ctx context.Context, optFuncs ...PushBuildOption) (*pb.P
 	if err != nil {
 		return nil, err
 	}
+	artifact := msg.(*pb.PushedArtifact)
 
-	return msg.(*pb.PushedArtifact), nil
+	// Scan the pushed image if the build stanza asks for it. This happens
+	// before signing so that images that fail the scan aren't signed.
+	scan, err := a.config.BuildScan(nil)
+	if err != nil {
+		return nil, err
+	}
+	if scan != nil {
+		artifact, err = a.scanArtifact(ctx, a.logger.Named("scan"), scan, artifact)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Sign the pushed image if the build stanza asks for it
+	sign, err := a.config.BuildSign(nil)
+	if err != nil {
+		return nil, err
+	}
+	if sign != nil {
+		return a.signArtifact(ctx, a.logger.Named("sign"), sign, artifact)
+	}
+
+	return artifact, nil
 }
 
 // PushBuildOption is used to configure a Build
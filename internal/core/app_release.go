@@ -2,23 +2,27 @@ package core
 
 import (
 	"context"
+	"reflect"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/any"
+	"github.com/hashicorp/go-argmapper"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/hcl/v2"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	canarypb "github.com/hashicorp/waypoint/builtin/canary"
 	"github.com/hashicorp/waypoint/internal/config"
 	"github.com/hashicorp/waypoint/internal/plugin"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 )
 
-// Release releases a set of deploys.
-// TODO(mitchellh): test
-func (a *App) Release(ctx context.Context, target *pb.Deployment) (
+// Release releases a set of deploys. If canary is non-nil, the target
+// only receives the canary percentage of traffic. This requires a release
+// manager plugin that supports canaries.
+func (a *App) Release(ctx context.Context, target *pb.Deployment, canary *pb.Release_Canary) (
 	*pb.Release,
 	component.Release,
 	error,
@@ -70,9 +74,18 @@ func (a *App) Release(ctx context.Context, target *pb.Deployment) (
 	}
 	defer c.Close()
 
+	// Canaries require a releaser that can split traffic. Without one
+	// we'd release the deployment fully, so we fail instead.
+	if canary != nil && (c == nil ||
+		!releaseFuncSupportsCanary(c.Value.(component.ReleaseManager).ReleaseFunc())) {
+		return nil, nil, status.Errorf(codes.FailedPrecondition,
+			"the release manager plugin doesn't support canary releases")
+	}
+
 	result, releasepb, err := a.doOperation(ctx, a.logger.Named("release"), &releaseOperation{
 		Component: c,
 		Target:    target,
+		Canary:    canary,
 	})
 	if err != nil {
 		return nil, nil, err
@@ -157,9 +170,35 @@ func (a *App) createReleaser(ctx context.Context, hclCtx *hcl.EvalContext) (*Com
 	return platformC, nil
 }
 
+// releaseFuncSupportsCanary returns true if the release function accepts
+// a canary.Canary argument. This is how release manager plugins opt in to
+// canary releases. Functions of plugins accept it as an Any with the
+// canary.Canary subtype.
+func releaseFuncSupportsCanary(f interface{}) bool {
+	rawFunc, ok := f.(*argmapper.Func)
+	if !ok {
+		var err error
+		rawFunc, err = argmapper.NewFunc(f)
+		if err != nil {
+			return false
+		}
+	}
+
+	canaryType := reflect.TypeOf((*canarypb.Canary)(nil))
+	canaryName := proto.MessageName(&canarypb.Canary{})
+	for _, v := range rawFunc.Input().Values() {
+		if v.Type == canaryType || v.Subtype == canaryName {
+			return true
+		}
+	}
+
+	return false
+}
+
 type releaseOperation struct {
 	Component *Component
 	Target    *pb.Deployment
+	Canary    *pb.Release_Canary
 
 	result component.Release
 }
@@ -172,6 +211,7 @@ func (op *releaseOperation) Init(app *App) (proto.Message, error) {
 		State:         pb.Operation_CREATED,
 		Component:     op.Target.Component,
 		Unimplemented: true,
+		Canary:        op.Canary,
 	}
 
 	if v := op.Component; v != nil {
@@ -224,12 +264,22 @@ func (op *releaseOperation) Do(ctx context.Context, log hclog.Logger, app *App,
 		return nil, nil
 	}
 
+	// Plugins that support canaries always get the canary argument so
+	// they can also release to all traffic. A zero percentage means the
+	// release gets all traffic.
+	args := []argmapper.Arg{
+		plugin.ArgNamedAny("target", op.Target.Deployment),
+		argmapper.Named("canary", &canarypb.Canary{
+			Percentage: op.Canary.GetPercentage(),
+		}),
+	}
+
 	result, err := app.callDynamicFunc(ctx,
 		log,
 		(*component.Release)(nil),
 		op.Component,
 		op.Component.Value.(component.ReleaseManager).ReleaseFunc(),
-		plugin.ArgNamedAny("target", op.Target.Deployment),
+		args...,
 	)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,124 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	componentmocks "github.com/hashicorp/waypoint-plugin-sdk/component/mocks"
+	"github.com/hashicorp/waypoint/builtin/aws/lambda"
+	canarypb "github.com/hashicorp/waypoint/builtin/canary"
+	"github.com/hashicorp/waypoint/builtin/google/cloudrun"
+	"github.com/hashicorp/waypoint/builtin/k8s"
+	"github.com/hashicorp/waypoint/internal/config"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestAppRelease_canary(t *testing.T) {
+	require := require.New(t)
+
+	mock := &componentmocks.ReleaseManager{}
+	factory := TestFactory(t, component.ReleaseManagerType)
+	TestFactoryRegister(t, factory, "test", mock)
+
+	app := TestApp(t, TestProject(t,
+		WithConfig(config.TestConfig(t, testReleaseManagerConfig)),
+		WithFactory(component.ReleaseManagerType, factory),
+	), "test")
+
+	var percentage uint32
+	mock.On("ReleaseFunc").Return(func(c *canarypb.Canary) (component.Release, error) {
+		require.NotNil(c)
+		percentage = c.Percentage
+
+		release := &componentmocks.Release{}
+		release.On("URL").Return("")
+		return release, nil
+	})
+
+	canary := &pb.Release_Canary{
+		Percentage:           10,
+		PreviousDeploymentId: "A",
+		Phase:                pb.Release_Canary_ACTIVE,
+	}
+
+	// The percentage reaches the plugin and the canary is recorded
+	release, _, err := app.Release(context.Background(), testReleaseDeployment(t), canary)
+	require.NoError(err)
+	require.Equal(uint32(10), percentage)
+	require.NotNil(release.Canary)
+	require.Equal("A", release.Canary.PreviousDeploymentId)
+	require.Equal(pb.Release_Canary_ACTIVE, release.Canary.Phase)
+
+	// A full release still calls the plugin, with a zero percentage
+	release, _, err = app.Release(context.Background(), testReleaseDeployment(t), nil)
+	require.NoError(err)
+	require.Equal(uint32(0), percentage)
+	require.Nil(release.Canary)
+}
+
+func TestAppRelease_canaryNotSupported(t *testing.T) {
+	require := require.New(t)
+
+	mock := &componentmocks.ReleaseManager{}
+	factory := TestFactory(t, component.ReleaseManagerType)
+	TestFactoryRegister(t, factory, "test", mock)
+
+	app := TestApp(t, TestProject(t,
+		WithConfig(config.TestConfig(t, testReleaseManagerConfig)),
+		WithFactory(component.ReleaseManagerType, factory),
+	), "test")
+
+	mock.On("ReleaseFunc").Return(func() (component.Release, error) {
+		release := &componentmocks.Release{}
+		release.On("URL").Return("")
+		return release, nil
+	})
+
+	_, _, err := app.Release(context.Background(), testReleaseDeployment(t), &pb.Release_Canary{
+		Percentage: 10,
+		Phase:      pb.Release_Canary_ACTIVE,
+	})
+	require.Error(err)
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+
+	// The plugin can still release to all traffic
+	_, _, err = app.Release(context.Background(), testReleaseDeployment(t), nil)
+	require.NoError(err)
+}
+
+func TestReleaseFuncSupportsCanary(t *testing.T) {
+	require := require.New(t)
+
+	require.True(releaseFuncSupportsCanary(func(*canarypb.Canary) {}))
+	require.False(releaseFuncSupportsCanary(func(*pb.Release_Canary) {}))
+	require.False(releaseFuncSupportsCanary(func() {}))
+	require.False(releaseFuncSupportsCanary(42))
+
+	// Builtin release managers that support canaries
+	require.True(releaseFuncSupportsCanary((&cloudrun.Releaser{}).ReleaseFunc()))
+	require.True(releaseFuncSupportsCanary((&lambda.Releaser{}).ReleaseFunc()))
+	require.False(releaseFuncSupportsCanary((&k8s.Releaser{}).ReleaseFunc()))
+}
+
+// testReleaseDeployment returns a deployment that can be released without
+// querying its artifact.
+func testReleaseDeployment(t *testing.T) *pb.Deployment {
+	deployment, err := ptypes.MarshalAny(&empty.Empty{})
+	require.NoError(t, err)
+
+	return serverptypes.TestValidDeployment(t, &pb.Deployment{
+		Id:         "B",
+		Deployment: deployment,
+		Preload: &pb.Deployment_Preload{
+			Artifact: serverptypes.TestValidArtifact(t, nil),
+		},
+	})
+}
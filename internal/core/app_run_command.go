@@ -0,0 +1,42 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+)
+
+// RunCommand runs the given command in the directory of the app. The
+// output of the command is written to the app UI. This returns an error
+// if the command can't be started or exits with a non-zero status.
+func (a *App) RunCommand(ctx context.Context, command []string) error {
+	if len(command) == 0 {
+		return errors.New("command must not be empty")
+	}
+
+	log := a.logger.Named("run_command")
+	log.Debug("running command", "command", command)
+
+	// Get our writers
+	stdout, stderr, err := a.UI.OutputWriters()
+	if err != nil {
+		log.Warn("error getting UI stdout/stderr", "err", err)
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Dir = a.source.Path
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Run(); err != nil {
+		L := log
+		if exiterr, ok := err.(*exec.ExitError); ok {
+			L = L.With("code", exiterr.ExitCode())
+		}
+
+		L.Warn("error running command", "err", err)
+		return err
+	}
+
+	return nil
+}
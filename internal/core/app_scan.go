@@ -0,0 +1,261 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/internal/config"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+const (
+	// defaultScanTool is the scanner used if the scan stanza doesn't set
+	// one.
+	defaultScanTool = "trivy"
+
+	// defaultScanSeverity is the threshold used if the scan stanza doesn't
+	// set one.
+	defaultScanSeverity = pb.Vulnerability_CRITICAL
+)
+
+// scanArtifact scans the image of a pushed artifact for vulnerabilities
+// with trivy or grype. The result is recorded on the artifact and the
+// updated artifact is returned. If vulnerabilities at or above the
+// configured severity are found, the result is still recorded but an
+// error is returned so that the push fails.
+func (a *App) scanArtifact(
+	ctx context.Context,
+	log hclog.Logger,
+	cfg *config.Scan,
+	artifact *pb.PushedArtifact,
+) (*pb.PushedArtifact, error) {
+	ref := artifactImageRef(artifact.Artifact)
+	if ref == "" {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"the artifact can't be scanned because the registry plugin %q doesn't "+
+				"report a container image for it",
+			artifact.Component.GetName())
+	}
+
+	threshold, err := scanThreshold(cfg.Severity)
+	if err != nil {
+		return nil, err
+	}
+
+	tool := cfg.Tool
+	if tool == "" {
+		tool = defaultScanTool
+	}
+
+	var args []string
+	var parse func([]byte) ([]*pb.Vulnerability, error)
+	switch tool {
+	case "trivy":
+		args = []string{"image", "--format", "json", "--quiet", ref}
+		parse = parseTrivyReport
+
+	case "grype":
+		args = []string{ref, "-o", "json", "-q"}
+		parse = parseGrypeReport
+
+	default:
+		return nil, status.Errorf(codes.InvalidArgument,
+			"unknown scan tool %q, must be \"trivy\" or \"grype\"", tool)
+	}
+
+	sg := a.UI.StepGroup()
+	defer sg.Wait()
+
+	step := sg.Add("Scanning image %s with %s...", ref, tool)
+	var report bytes.Buffer
+	if err := runArtifactTool(ctx, log, step, &report, tool, args...); err != nil {
+		return nil, err
+	}
+
+	vulns, err := parse(report.Bytes())
+	if err != nil {
+		step.Abort()
+		return nil, status.Errorf(codes.Internal,
+			"error reading the report of %s: %s", tool, err)
+	}
+	sortVulnerabilities(vulns)
+
+	failing := countFailingVulnerabilities(vulns, threshold, cfg.IgnoreUnfixed)
+	threshStr := strings.ToLower(threshold.String())
+	step.Update("Found %d vulnerabilities in %s, %d at or above severity %s",
+		len(vulns), ref, failing, threshStr)
+	if failing > 0 {
+		step.Abort()
+	} else {
+		step.Done()
+	}
+
+	artifact.Artifact.Scan = &pb.ScanResult{
+		Tool:            tool,
+		Reference:       ref,
+		Threshold:       threshold,
+		Passed:          failing == 0,
+		Vulnerabilities: vulns,
+		Time:            ptypes.TimestampNow(),
+	}
+	resp, err := a.client.UpsertPushedArtifact(ctx, &pb.UpsertPushedArtifactRequest{
+		Artifact: artifact,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if failing > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"image %s has %d vulnerabilities at or above severity %s. Run "+
+				"`waypoint artifact inspect -vulnerabilities v%d` to list them.",
+			ref, failing, threshStr, resp.Artifact.Sequence)
+	}
+
+	return resp.Artifact, nil
+}
+
+// scanThreshold parses the severity of a scan stanza.
+func scanThreshold(v string) (pb.Vulnerability_Severity, error) {
+	if v == "" {
+		return defaultScanSeverity, nil
+	}
+
+	sev, ok := pb.Vulnerability_Severity_value[strings.ToUpper(v)]
+	if !ok || sev == int32(pb.Vulnerability_UNKNOWN) {
+		return 0, status.Errorf(codes.InvalidArgument,
+			"invalid scan severity %q, must be one of \"low\", \"medium\", "+
+				"\"high\" or \"critical\"", v)
+	}
+
+	return pb.Vulnerability_Severity(sev), nil
+}
+
+// vulnerabilitySeverity converts the severity reported by a scanner. Both
+// trivy and grype use the same names with different cases, except that
+// grype also reports "Negligible" which we treat as low.
+func vulnerabilitySeverity(v string) pb.Vulnerability_Severity {
+	v = strings.ToUpper(v)
+	if v == "NEGLIGIBLE" {
+		return pb.Vulnerability_LOW
+	}
+
+	return pb.Vulnerability_Severity(pb.Vulnerability_Severity_value[v])
+}
+
+// countFailingVulnerabilities returns the number of vulnerabilities at or
+// above the threshold.
+func countFailingVulnerabilities(
+	vulns []*pb.Vulnerability,
+	threshold pb.Vulnerability_Severity,
+	ignoreUnfixed bool,
+) int {
+	count := 0
+	for _, v := range vulns {
+		if v.Severity < threshold {
+			continue
+		}
+		if ignoreUnfixed && v.FixedVersion == "" {
+			continue
+		}
+
+		count++
+	}
+
+	return count
+}
+
+// sortVulnerabilities sorts vulnerabilities with the most severe first,
+// then by ID and package.
+func sortVulnerabilities(vulns []*pb.Vulnerability) {
+	sort.SliceStable(vulns, func(i, j int) bool {
+		if vulns[i].Severity != vulns[j].Severity {
+			return vulns[i].Severity > vulns[j].Severity
+		}
+		if vulns[i].Id != vulns[j].Id {
+			return vulns[i].Id < vulns[j].Id
+		}
+
+		return vulns[i].Package < vulns[j].Package
+	})
+}
+
+// parseTrivyReport parses the output of `trivy image --format json`.
+func parseTrivyReport(data []byte) ([]*pb.Vulnerability, error) {
+	var report struct {
+		Results []struct {
+			Vulnerabilities []struct {
+				VulnerabilityID  string
+				PkgName          string
+				InstalledVersion string
+				FixedVersion     string
+				Severity         string
+				Title            string
+			}
+		}
+	}
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, err
+	}
+
+	var result []*pb.Vulnerability
+	for _, r := range report.Results {
+		for _, v := range r.Vulnerabilities {
+			result = append(result, &pb.Vulnerability{
+				Id:               v.VulnerabilityID,
+				Package:          v.PkgName,
+				InstalledVersion: v.InstalledVersion,
+				FixedVersion:     v.FixedVersion,
+				Severity:         vulnerabilitySeverity(v.Severity),
+				Title:            v.Title,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// parseGrypeReport parses the output of `grype -o json`.
+func parseGrypeReport(data []byte) ([]*pb.Vulnerability, error) {
+	var report struct {
+		Matches []struct {
+			Vulnerability struct {
+				ID          string `json:"id"`
+				Severity    string `json:"severity"`
+				Description string `json:"description"`
+				Fix         struct {
+					Versions []string `json:"versions"`
+				} `json:"fix"`
+			} `json:"vulnerability"`
+			Artifact struct {
+				Name    string `json:"name"`
+				Version string `json:"version"`
+			} `json:"artifact"`
+		} `json:"matches"`
+	}
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, err
+	}
+
+	var result []*pb.Vulnerability
+	for _, m := range report.Matches {
+		result = append(result, &pb.Vulnerability{
+			Id:               m.Vulnerability.ID,
+			Package:          m.Artifact.Name,
+			InstalledVersion: m.Artifact.Version,
+			FixedVersion:     strings.Join(m.Vulnerability.Fix.Versions, ", "),
+			Severity:         vulnerabilitySeverity(m.Vulnerability.Severity),
+			Title:            m.Vulnerability.Description,
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,183 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestParseTrivyReport(t *testing.T) {
+	require := require.New(t)
+
+	vulns, err := parseTrivyReport([]byte(`{
+  "SchemaVersion": 2,
+  "ArtifactName": "example.com/app@sha256:abcd",
+  "Results": [
+    {
+      "Target": "example.com/app (alpine 3.14.2)",
+      "Vulnerabilities": [
+        {
+          "VulnerabilityID": "CVE-2021-3711",
+          "PkgName": "libssl1.1",
+          "InstalledVersion": "1.1.1k-r0",
+          "FixedVersion": "1.1.1l-r0",
+          "Severity": "CRITICAL",
+          "Title": "openssl: SM2 Decryption Buffer Overflow"
+        }
+      ]
+    },
+    {
+      "Target": "app/go.sum"
+    },
+    {
+      "Target": "app/package-lock.json",
+      "Vulnerabilities": [
+        {
+          "VulnerabilityID": "CVE-2021-23337",
+          "PkgName": "lodash",
+          "InstalledVersion": "4.17.20",
+          "Severity": "HIGH"
+        }
+      ]
+    }
+  ]
+}`))
+	require.NoError(err)
+	require.Equal([]*pb.Vulnerability{
+		{
+			Id:               "CVE-2021-3711",
+			Package:          "libssl1.1",
+			InstalledVersion: "1.1.1k-r0",
+			FixedVersion:     "1.1.1l-r0",
+			Severity:         pb.Vulnerability_CRITICAL,
+			Title:            "openssl: SM2 Decryption Buffer Overflow",
+		},
+		{
+			Id:               "CVE-2021-23337",
+			Package:          "lodash",
+			InstalledVersion: "4.17.20",
+			Severity:         pb.Vulnerability_HIGH,
+		},
+	}, vulns)
+}
+
+func TestParseGrypeReport(t *testing.T) {
+	require := require.New(t)
+
+	vulns, err := parseGrypeReport([]byte(`{
+  "matches": [
+    {
+      "vulnerability": {
+        "id": "CVE-2021-3711",
+        "severity": "Critical",
+        "description": "SM2 Decryption Buffer Overflow",
+        "fix": {"versions": ["1.1.1l-r0"], "state": "fixed"}
+      },
+      "artifact": {"name": "libssl1.1", "version": "1.1.1k-r0"}
+    },
+    {
+      "vulnerability": {
+        "id": "CVE-2019-12900",
+        "severity": "Negligible",
+        "fix": {"versions": [], "state": "not-fixed"}
+      },
+      "artifact": {"name": "bzip2", "version": "1.0.6-9.2"}
+    }
+  ]
+}`))
+	require.NoError(err)
+	require.Equal([]*pb.Vulnerability{
+		{
+			Id:               "CVE-2021-3711",
+			Package:          "libssl1.1",
+			InstalledVersion: "1.1.1k-r0",
+			FixedVersion:     "1.1.1l-r0",
+			Severity:         pb.Vulnerability_CRITICAL,
+			Title:            "SM2 Decryption Buffer Overflow",
+		},
+		{
+			Id:               "CVE-2019-12900",
+			Package:          "bzip2",
+			InstalledVersion: "1.0.6-9.2",
+			Severity:         pb.Vulnerability_LOW,
+		},
+	}, vulns)
+}
+
+func TestScanThreshold(t *testing.T) {
+	cases := []struct {
+		Value    string
+		Expected pb.Vulnerability_Severity
+		Err      bool
+	}{
+		{"", pb.Vulnerability_CRITICAL, false},
+		{"low", pb.Vulnerability_LOW, false},
+		{"Medium", pb.Vulnerability_MEDIUM, false},
+		{"HIGH", pb.Vulnerability_HIGH, false},
+		{"unknown", 0, true},
+		{"severe", 0, true},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Value, func(t *testing.T) {
+			require := require.New(t)
+
+			sev, err := scanThreshold(tt.Value)
+			if tt.Err {
+				require.Error(err)
+				return
+			}
+
+			require.NoError(err)
+			require.Equal(tt.Expected, sev)
+		})
+	}
+}
+
+func TestCountFailingVulnerabilities(t *testing.T) {
+	vulns := []*pb.Vulnerability{
+		{Id: "A", Severity: pb.Vulnerability_CRITICAL, FixedVersion: "2"},
+		{Id: "B", Severity: pb.Vulnerability_HIGH},
+		{Id: "C", Severity: pb.Vulnerability_MEDIUM, FixedVersion: "2"},
+		{Id: "D", Severity: pb.Vulnerability_UNKNOWN},
+	}
+
+	cases := []struct {
+		Name          string
+		Threshold     pb.Vulnerability_Severity
+		IgnoreUnfixed bool
+		Expected      int
+	}{
+		{"critical", pb.Vulnerability_CRITICAL, false, 1},
+		{"high", pb.Vulnerability_HIGH, false, 2},
+		{"low", pb.Vulnerability_LOW, false, 3},
+		{"high ignoring unfixed", pb.Vulnerability_HIGH, true, 1},
+		{"low ignoring unfixed", pb.Vulnerability_LOW, true, 2},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require.Equal(t, tt.Expected,
+				countFailingVulnerabilities(vulns, tt.Threshold, tt.IgnoreUnfixed))
+		})
+	}
+}
+
+func TestSortVulnerabilities(t *testing.T) {
+	vulns := []*pb.Vulnerability{
+		{Id: "B", Severity: pb.Vulnerability_LOW},
+		{Id: "C", Severity: pb.Vulnerability_CRITICAL},
+		{Id: "A", Severity: pb.Vulnerability_LOW},
+		{Id: "D", Severity: pb.Vulnerability_HIGH},
+	}
+
+	sortVulnerabilities(vulns)
+
+	var ids []string
+	for _, v := range vulns {
+		ids = append(ids, v.Id)
+	}
+	require.Equal(t, []string{"C", "D", "A", "B"}, ids)
+}
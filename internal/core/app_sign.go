@@ -0,0 +1,194 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/config"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+const (
+	// defaultSignKey is the cosign key used if the sign stanza doesn't set
+	// one. Runner config variables are set in the environment of the
+	// runner, so the key can be stored on the server with:
+	//
+	//   waypoint config set -runner COSIGN_PRIVATE_KEY=@cosign.key
+	//
+	defaultSignKey = "env://COSIGN_PRIVATE_KEY"
+
+	// sbomPredicateType is the in-toto predicate type of SPDX SBOMs.
+	sbomPredicateType = "https://spdx.dev/Document"
+)
+
+// signArtifact signs the image of a pushed artifact with cosign and, if
+// configured, attaches a signed SBOM generated with syft. The attestations
+// are recorded on the artifact and the updated artifact is returned.
+func (a *App) signArtifact(
+	ctx context.Context,
+	log hclog.Logger,
+	cfg *config.Sign,
+	artifact *pb.PushedArtifact,
+) (*pb.PushedArtifact, error) {
+	ref := artifactImageRef(artifact.Artifact)
+	if ref == "" {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"the artifact can't be signed because the registry plugin %q doesn't "+
+				"report a container image for it",
+			artifact.Component.GetName())
+	}
+
+	key := cfg.Key
+	if key == "" {
+		key = defaultSignKey
+	}
+
+	tlog := fmt.Sprintf("--tlog-upload=%t", cfg.TransparencyLog)
+
+	sg := a.UI.StepGroup()
+	defer sg.Wait()
+
+	// The public key is recorded with the attestations so that the image
+	// can be verified without access to the private key.
+	step := sg.Add("Reading the public key of %s...", key)
+	var publicKey bytes.Buffer
+	if err := runArtifactTool(ctx, log, step, &publicKey, "cosign", "public-key", "--key", key); err != nil {
+		return nil, err
+	}
+	step.Done()
+
+	step = sg.Add("Signing image %s...", ref)
+	if err := runArtifactTool(ctx, log, step, nil, "cosign", "sign", "--key", key, "--yes", tlog, ref); err != nil {
+		return nil, err
+	}
+	step.Done()
+
+	attestations := []*pb.Attestation{
+		{
+			Type:            pb.Attestation_SIGNATURE,
+			Reference:       ref,
+			PublicKey:       publicKey.String(),
+			TransparencyLog: cfg.TransparencyLog,
+			Time:            ptypes.TimestampNow(),
+		},
+	}
+
+	if cfg.SBOM {
+		f, err := ioutil.TempFile("", "waypoint-sbom")
+		if err != nil {
+			return nil, err
+		}
+		defer os.Remove(f.Name())
+		defer f.Close()
+
+		step = sg.Add("Generating SBOM of %s with syft...", ref)
+		if err := runArtifactTool(ctx, log, step, f, "syft", ref, "-o", "spdx-json"); err != nil {
+			return nil, err
+		}
+		step.Done()
+
+		step = sg.Add("Attaching the signed SBOM to %s...", ref)
+		if err := runArtifactTool(ctx, log, step, nil,
+			"cosign", "attest", "--key", key, "--yes", tlog,
+			"--type", "spdxjson", "--predicate", f.Name(), ref,
+		); err != nil {
+			return nil, err
+		}
+		step.Done()
+
+		attestations = append(attestations, &pb.Attestation{
+			Type:            pb.Attestation_SBOM,
+			Reference:       ref,
+			PredicateType:   sbomPredicateType,
+			PublicKey:       publicKey.String(),
+			TransparencyLog: cfg.TransparencyLog,
+			Time:            ptypes.TimestampNow(),
+		})
+
+		// The SBOM is stored in the registry as an attestation of the image.
+		if artifact.Artifact.Sbom == "" {
+			artifact.Artifact.Sbom = ref
+		}
+	}
+
+	artifact.Artifact.Attestations = attestations
+	resp, err := a.client.UpsertPushedArtifact(ctx, &pb.UpsertPushedArtifactRequest{
+		Artifact: artifact,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Artifact, nil
+}
+
+// runArtifactTool runs a tool such as cosign, syft or trivy against a
+// pushed image. The output of the command is written to the step, and
+// stdout is written to the given writer instead if it is not nil. The
+// step is aborted if the command fails.
+func runArtifactTool(
+	ctx context.Context,
+	log hclog.Logger,
+	step terminal.Step,
+	stdout io.Writer,
+	name string,
+	args ...string,
+) error {
+	path, err := exec.LookPath(name)
+	if err != nil {
+		step.Abort()
+		return status.Errorf(codes.FailedPrecondition,
+			"%s is required by the build stanza but wasn't found: %s", name, err)
+	}
+
+	log.Debug("running command", "command", path, "args", args)
+	cmd := exec.CommandContext(ctx, path, args...)
+	cmd.Stdout = step.TermOutput()
+	cmd.Stderr = step.TermOutput()
+	if stdout != nil {
+		cmd.Stdout = stdout
+	}
+
+	if err := cmd.Run(); err != nil {
+		step.Abort()
+		return status.Errorf(codes.Internal,
+			"error running %s %s: %s", name, args[0], err)
+	}
+
+	return nil
+}
+
+// artifactImageRef returns the image of the artifact to sign. This is by
+// digest if the digest is known, so that the signature covers exactly the
+// image that was pushed and not whatever the tag points to later.
+func artifactImageRef(a *pb.Artifact) string {
+	image := a.GetImage()
+	if image == "" {
+		return ""
+	}
+
+	digest := a.GetDigest()
+	if !strings.HasPrefix(digest, "sha256:") {
+		return image
+	}
+
+	// Strip the tag. A colon before the last slash is a registry port.
+	repo := image
+	if idx := strings.LastIndex(repo, ":"); idx > strings.LastIndex(repo, "/") {
+		repo = repo[:idx]
+	}
+
+	return repo + "@" + digest
+}
@@ -0,0 +1,59 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestArtifactImageRef(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Artifact *pb.Artifact
+		Expected string
+	}{
+		{
+			"no image",
+			&pb.Artifact{},
+			"",
+		},
+
+		{
+			"no digest",
+			&pb.Artifact{Image: "example.com/app:v1"},
+			"example.com/app:v1",
+		},
+
+		{
+			"digest",
+			&pb.Artifact{Image: "example.com/app:v1", Digest: "sha256:abcd"},
+			"example.com/app@sha256:abcd",
+		},
+
+		{
+			"registry port",
+			&pb.Artifact{Image: "localhost:5000/app:v1", Digest: "sha256:abcd"},
+			"localhost:5000/app@sha256:abcd",
+		},
+
+		{
+			"registry port without tag",
+			&pb.Artifact{Image: "localhost:5000/app", Digest: "sha256:abcd"},
+			"localhost:5000/app@sha256:abcd",
+		},
+
+		{
+			"digest that isn't an image digest",
+			&pb.Artifact{Image: "example.com/app:v1", Digest: "md5:abcd"},
+			"example.com/app:v1",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require.Equal(t, tt.Expected, artifactImageRef(tt.Artifact))
+		})
+	}
+}
@@ -133,6 +133,9 @@ func (a *App) doOperation(
 			// Set the deployment URL, if possible
 			msgUpdateURL(msg, result)
 
+			// Set the artifact metadata, if the plugin reports any
+			msgUpdateArtifactMetadata(msg, result)
+
 			// Set our template data. Any errors here are logged but ignored
 			// since we don't want to leave dangling physical resources.
 			if err := msgUpdateTemplateData(msg, result); err != nil {
@@ -211,6 +214,52 @@ func msgUpdateURL(msg proto.Message, result interface{}) {
 	}
 }
 
+// msgUpdateArtifactMetadata sets the metadata of the artifact of a build
+// or pushed artifact. Plugins report metadata by having "digest", "size",
+// "sbom", or "image" and "tag" fields in their artifact proto, so we look
+// for the getters of those fields on the result.
+func msgUpdateArtifactMetadata(msg proto.Message, result interface{}) {
+	var artifact **pb.Artifact
+	switch v := msg.(type) {
+	case *pb.Build:
+		artifact = &v.Artifact
+	case *pb.PushedArtifact:
+		artifact = &v.Artifact
+	default:
+		return
+	}
+	if *artifact == nil {
+		*artifact = &pb.Artifact{}
+	}
+
+	if v, ok := result.(interface{ GetDigest() string }); ok {
+		(*artifact).Digest = v.GetDigest()
+	}
+
+	switch v := result.(type) {
+	case interface{ GetSize() uint64 }:
+		(*artifact).Size = v.GetSize()
+	case interface{ GetSize() int64 }:
+		if size := v.GetSize(); size > 0 {
+			(*artifact).Size = uint64(size)
+		}
+	}
+
+	if v, ok := result.(interface{ GetSbom() string }); ok {
+		(*artifact).Sbom = v.GetSbom()
+	}
+
+	if v, ok := result.(interface {
+		GetImage() string
+		GetTag() string
+	}); ok && v.GetImage() != "" {
+		(*artifact).Image = v.GetImage()
+		if tag := v.GetTag(); tag != "" {
+			(*artifact).Image += ":" + tag
+		}
+	}
+}
+
 func msgUpdateLabels(
 	app *App,
 	base map[string]string,
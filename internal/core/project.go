@@ -64,6 +64,9 @@ type Project struct {
 
 	// variables is the final map of values to use when evaluating config vars
 	variables variables.Values
+
+	// configSrc is the contents of the waypoint.hcl for the project.
+	configSrc []byte
 }
 
 // NewProject creates a new Project with the given options.
@@ -122,6 +125,7 @@ func NewProject(ctx context.Context, os ...Option) (*Project, error) {
 
 	// Set our labels
 	p.labels = opts.Config.Labels
+	p.configSrc = opts.Config.Source()
 
 	// Set our final job info
 	p.jobInfo.Workspace = p.workspace
@@ -0,0 +1,155 @@
+// Package githubapi is a minimal client for the REST API of GitHub. It
+// only supports the GET requests and pagination that Waypoint needs to
+// read users, organizations and repositories.
+package githubapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+// DefaultURL is the public GitHub API used if the client has no URL.
+const DefaultURL = "https://api.github.com"
+
+var (
+	// ErrNotFound is returned for 404 responses.
+	ErrNotFound = errors.New("not found")
+
+	// ErrUnauthorized is returned for 401 responses, which means the token
+	// is missing, invalid or expired.
+	ErrUnauthorized = errors.New("unauthorized")
+)
+
+// Client makes requests to the GitHub API.
+type Client struct {
+	// URL is the API URL. This defaults to DefaultURL and should be set
+	// for GitHub Enterprise.
+	URL string
+
+	// Token is the token used to authenticate. This is optional, but
+	// without it only public data can be read and the API is heavily
+	// rate limited.
+	Token string
+
+	// Accept is the media type requested. This defaults to the v3 API of
+	// GitHub. GitLab paginates its API the same way, so the client can be
+	// used with GitLab by setting this to "application/json".
+	Accept string
+
+	// HTTP is the client used for requests. This defaults to a clean
+	// client with no shared state.
+	HTTP *http.Client
+}
+
+// Get requests the path, which may include a query, and decodes the JSON
+// response into v.
+func (c *Client) Get(ctx context.Context, path string, v interface{}) error {
+	_, err := c.do(ctx, c.url()+path, v)
+	return err
+}
+
+// GetAll requests every page of a list. For each page, the response is
+// decoded into the value returned by target and then page is called.
+func (c *Client) GetAll(
+	ctx context.Context,
+	path string,
+	target func() interface{},
+	page func(),
+) error {
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+
+	next := c.url() + path + sep + "per_page=100"
+	for next != "" {
+		resp, err := c.do(ctx, next, target())
+		if err != nil {
+			return err
+		}
+		page()
+
+		next = nextLink(resp.Header.Get("Link"))
+	}
+
+	return nil
+}
+
+func (c *Client) url() string {
+	if c.URL != "" {
+		return strings.TrimSuffix(c.URL, "/")
+	}
+
+	return DefaultURL
+}
+
+// do requests the URL and decodes the JSON response into v.
+func (c *Client) do(ctx context.Context, u string, v interface{}) (*http.Response, error) {
+	client := c.HTTP
+	if client == nil {
+		client = cleanhttp.DefaultClient()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	accept := c.Accept
+	if accept == "" {
+		accept = "application/vnd.github.v3+json"
+	}
+	req.Header.Set("Accept", accept)
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, ErrNotFound
+	case resp.StatusCode == http.StatusUnauthorized:
+		return nil, ErrUnauthorized
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("unexpected status from %s: %s", req.URL.Path, resp.Status)
+	}
+
+	return resp, json.NewDecoder(resp.Body).Decode(v)
+}
+
+// nextLink returns the URL of the "next" relation of a Link header, or
+// an empty string if there is none. The header has the form:
+//
+//	<https://api.github.com/user/orgs?page=2>; rel="next", <...>; rel="last"
+func nextLink(header string) string {
+	for _, part := range strings.Split(header, ",") {
+		segments := strings.Split(part, ";")
+		if len(segments) < 2 {
+			continue
+		}
+
+		u := strings.TrimSpace(segments[0])
+		if !strings.HasPrefix(u, "<") || !strings.HasSuffix(u, ">") {
+			continue
+		}
+
+		for _, param := range segments[1:] {
+			if strings.TrimSpace(param) == `rel="next"` {
+				return u[1 : len(u)-1]
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,79 @@
+package githubapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientGetAll(t *testing.T) {
+	require := require.New(t)
+
+	url := TestServer(t, &TestAPI{
+		Users: map[string]*TestUser{
+			"secret": {ID: 42, Login: "alice", Orgs: []string{"acme", "globex", "initech"}},
+		},
+		PageSize: 2,
+	})
+
+	c := &Client{URL: url + "/", Token: "secret"}
+
+	var orgs []struct {
+		Login string `json:"login"`
+	}
+	var result []string
+	var pages int
+	require.NoError(c.GetAll(context.Background(), "/user/orgs", func() interface{} { return &orgs }, func() {
+		pages++
+		for _, o := range orgs {
+			result = append(result, o.Login)
+		}
+	}))
+	require.Equal([]string{"acme", "globex", "initech"}, result)
+	require.Equal(2, pages)
+}
+
+func TestClientGet(t *testing.T) {
+	url := TestServer(t, &TestAPI{
+		Users: map[string]*TestUser{
+			"secret": {ID: 42, Login: "alice"},
+		},
+	})
+
+	var user struct {
+		ID    int64  `json:"id"`
+		Login string `json:"login"`
+	}
+
+	cases := []struct {
+		Name  string
+		Token string
+		Path  string
+		Err   error
+	}{
+		{"ok", "secret", "/user", nil},
+		{"no token", "", "/user", ErrUnauthorized},
+		{"invalid token", "nope", "/user", ErrUnauthorized},
+		{"not found", "secret", "/nope", ErrNotFound},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			c := &Client{URL: url, Token: tt.Token}
+			err := c.Get(context.Background(), tt.Path, &user)
+			require.Equal(t, tt.Err, err)
+		})
+	}
+
+	require.Equal(t, "alice", user.Login)
+}
+
+func TestNextLink(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal("", nextLink(""))
+	require.Equal("https://example.com/?page=2", nextLink(
+		`<https://example.com/?page=2>; rel="next", <https://example.com/?page=5>; rel="last"`))
+	require.Equal("", nextLink(`<https://example.com/?page=1>; rel="prev"`))
+}
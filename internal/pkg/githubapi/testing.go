@@ -0,0 +1,176 @@
+package githubapi
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+
+	"github.com/mitchellh/go-testing-interface"
+)
+
+// TestAPI is the data served by TestServer.
+type TestAPI struct {
+	// Users are the users by their token. Requests without the token of
+	// one of these users are unauthorized.
+	Users map[string]*TestUser
+
+	// Repos are the repositories of each organization.
+	Repos map[string][]*TestRepo
+
+	// PageSize is the number of items in each page of a list. This
+	// defaults to 1 so that tests exercise pagination.
+	PageSize int
+}
+
+// TestUser is a user of TestServer.
+type TestUser struct {
+	ID    int64
+	Login string
+	Orgs  []string
+
+	// Teams are in the form "org/team".
+	Teams []string
+}
+
+// TestRepo is a repository of TestServer.
+type TestRepo struct {
+	Name          string
+	DefaultBranch string
+	Archived      bool
+
+	// WaypointHcl is the contents of waypoint.hcl in the root of the
+	// default branch. The repository has no such file if this is empty.
+	WaypointHcl string
+}
+
+// TestServer starts a fake of the parts of the GitHub API that we use and
+// returns its URL. We use t.Cleanup to stop the server.
+func TestServer(t testing.T, api *TestAPI) string {
+	srv := httptest.NewServer(http.HandlerFunc(api.serve))
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+func (a *TestAPI) serve(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	known := r.URL.Path == "/user" ||
+		r.URL.Path == "/user/orgs" ||
+		r.URL.Path == "/user/teams" ||
+		(len(parts) == 3 && parts[0] == "orgs" && parts[2] == "repos") ||
+		(len(parts) == 5 && parts[0] == "repos" && parts[3] == "contents")
+	if !known {
+		http.NotFound(w, r)
+		return
+	}
+
+	user, ok := a.Users[strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")]
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	switch {
+	case r.URL.Path == "/user":
+		a.write(w, map[string]interface{}{"id": user.ID, "login": user.Login})
+
+	case r.URL.Path == "/user/orgs":
+		var orgs []interface{}
+		for _, org := range user.Orgs {
+			orgs = append(orgs, map[string]interface{}{"login": org})
+		}
+		a.writePage(w, r, orgs)
+
+	case r.URL.Path == "/user/teams":
+		var teams []interface{}
+		for _, team := range user.Teams {
+			idx := strings.Index(team, "/")
+			teams = append(teams, map[string]interface{}{
+				"slug":         team[idx+1:],
+				"organization": map[string]interface{}{"login": team[:idx]},
+			})
+		}
+		a.writePage(w, r, teams)
+
+	case len(parts) == 3 && parts[0] == "orgs" && parts[2] == "repos":
+		repos, ok := a.Repos[parts[1]]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		var result []interface{}
+		for _, repo := range repos {
+			result = append(result, map[string]interface{}{
+				"name":           repo.Name,
+				"clone_url":      "https://github.com/" + parts[1] + "/" + repo.Name + ".git",
+				"default_branch": repo.DefaultBranch,
+				"archived":       repo.Archived,
+			})
+		}
+		a.writePage(w, r, result)
+
+	case len(parts) == 5 && parts[0] == "repos" && parts[3] == "contents" &&
+		parts[4] == "waypoint.hcl":
+		for _, repo := range a.Repos[parts[1]] {
+			if repo.Name != parts[2] || repo.WaypointHcl == "" ||
+				r.URL.Query().Get("ref") != repo.DefaultBranch {
+				continue
+			}
+
+			// GitHub wraps the content with newlines.
+			a.write(w, map[string]interface{}{
+				"type":     "file",
+				"encoding": "base64",
+				"content":  base64.StdEncoding.EncodeToString([]byte(repo.WaypointHcl)) + "\n",
+			})
+			return
+		}
+
+		http.NotFound(w, r)
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// writePage writes the page of the list requested by the page parameter
+// and links to the next page if there is one.
+func (a *TestAPI) writePage(w http.ResponseWriter, r *http.Request, items []interface{}) {
+	size := a.PageSize
+	if size <= 0 {
+		size = 1
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	start, end := (page-1)*size, page*size
+	if start > len(items) {
+		start = len(items)
+	}
+	if end < len(items) {
+		next := *r.URL
+		q := next.Query()
+		q.Set("page", strconv.Itoa(page+1))
+		next.RawQuery = q.Encode()
+		w.Header().Set("Link", `<http://`+r.Host+next.RequestURI()+`>; rel="next"`)
+	} else {
+		end = len(items)
+	}
+
+	result := items[start:end]
+	if result == nil {
+		result = []interface{}{}
+	}
+	a.write(w, result)
+}
+
+func (a *TestAPI) write(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,136 @@
+// Package githuborg finds the repositories of a GitHub organization that
+// contain a Waypoint configuration. This is used to import the projects
+// of an organization in bulk.
+package githuborg
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/waypoint/internal/pkg/githubapi"
+)
+
+// DefaultURL is the public GitHub API used if the client has no URL.
+const DefaultURL = githubapi.DefaultURL
+
+// Repo is a repository with a Waypoint configuration.
+type Repo struct {
+	// Name is the name of the repository without the organization.
+	Name string
+
+	// CloneURL is the HTTPS URL to clone the repository.
+	CloneURL string
+
+	// DefaultBranch is the branch that the configuration was read from.
+	DefaultBranch string
+
+	// WaypointHcl is the contents of waypoint.hcl in the root of the
+	// default branch.
+	WaypointHcl []byte
+}
+
+// Client lists repositories using the GitHub API.
+type Client struct {
+	// URL is the API URL. This defaults to DefaultURL and should be set
+	// for GitHub Enterprise.
+	URL string
+
+	// Token is the token used to authenticate. This is optional, but
+	// without it only public repositories are found and the API is
+	// heavily rate limited.
+	Token string
+
+	// HTTP is the client used for requests. This defaults to a clean
+	// client with no shared state.
+	HTTP *http.Client
+}
+
+// Repos returns the repositories of the organization that have a
+// waypoint.hcl file in the root of their default branch. Archived
+// repositories are skipped.
+func (c *Client) Repos(ctx context.Context, org string) ([]*Repo, error) {
+	var page []struct {
+		Name          string `json:"name"`
+		CloneURL      string `json:"clone_url"`
+		DefaultBranch string `json:"default_branch"`
+		Archived      bool   `json:"archived"`
+	}
+
+	var repos []*Repo
+	err := c.api().GetAll(ctx, "/orgs/"+url.PathEscape(org)+"/repos", func() interface{} {
+		page = nil
+		return &page
+	}, func() {
+		for _, r := range page {
+			if r.Archived {
+				continue
+			}
+
+			repos = append(repos, &Repo{
+				Name:          r.Name,
+				CloneURL:      r.CloneURL,
+				DefaultBranch: r.DefaultBranch,
+			})
+		}
+	})
+	if err == githubapi.ErrNotFound {
+		return nil, fmt.Errorf("GitHub organization %q not found", org)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*Repo
+	for _, r := range repos {
+		hcl, err := c.waypointHcl(ctx, org, r.Name, r.DefaultBranch)
+		if err != nil {
+			return nil, err
+		}
+		if hcl == nil {
+			continue
+		}
+
+		r.WaypointHcl = hcl
+		result = append(result, r)
+	}
+
+	return result, nil
+}
+
+// waypointHcl returns the contents of waypoint.hcl in the root of the
+// repository at the given ref, or nil if there is no such file.
+func (c *Client) waypointHcl(ctx context.Context, org, repo, ref string) ([]byte, error) {
+	var file struct {
+		Type     string `json:"type"`
+		Encoding string `json:"encoding"`
+		Content  string `json:"content"`
+	}
+
+	err := c.api().Get(ctx, fmt.Sprintf("/repos/%s/%s/contents/waypoint.hcl?ref=%s",
+		url.PathEscape(org), url.PathEscape(repo), url.QueryEscape(ref),
+	), &file)
+	if err == githubapi.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if file.Type != "file" || file.Encoding != "base64" {
+		return nil, nil
+	}
+
+	// The content is wrapped with newlines.
+	return base64.StdEncoding.DecodeString(strings.ReplaceAll(file.Content, "\n", ""))
+}
+
+func (c *Client) api() *githubapi.Client {
+	return &githubapi.Client{
+		URL:   c.URL,
+		Token: c.Token,
+		HTTP:  c.HTTP,
+	}
+}
@@ -0,0 +1,55 @@
+package githuborg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/pkg/githubapi"
+)
+
+func TestClientRepos(t *testing.T) {
+	require := require.New(t)
+
+	c := &Client{URL: testOrgServer(t) + "/", Token: "secret"}
+	repos, err := c.Repos(context.Background(), "acme")
+	require.NoError(err)
+	require.Len(repos, 2)
+
+	require.Equal("web", repos[0].Name)
+	require.Equal("https://github.com/acme/web.git", repos[0].CloneURL)
+	require.Equal("main", repos[0].DefaultBranch)
+	require.Equal(`project = "web"`, string(repos[0].WaypointHcl))
+
+	require.Equal("api", repos[1].Name)
+	require.Equal("trunk", repos[1].DefaultBranch)
+}
+
+func TestClientRepos_orgNotFound(t *testing.T) {
+	require := require.New(t)
+
+	c := &Client{URL: testOrgServer(t), Token: "secret"}
+	_, err := c.Repos(context.Background(), "globex")
+	require.Error(err)
+	require.Contains(err.Error(), "not found")
+}
+
+// testOrgServer starts a fake GitHub API and returns its URL. The acme org
+// has four repos: two with a waypoint.hcl, one without, and an archived
+// one.
+func testOrgServer(t *testing.T) string {
+	return githubapi.TestServer(t, &githubapi.TestAPI{
+		Users: map[string]*githubapi.TestUser{
+			"secret": {ID: 42, Login: "alice", Orgs: []string{"acme"}},
+		},
+		Repos: map[string][]*githubapi.TestRepo{
+			"acme": {
+				{Name: "web", DefaultBranch: "main", WaypointHcl: `project = "web"`},
+				{Name: "docs", DefaultBranch: "main"},
+				{Name: "api", DefaultBranch: "trunk", WaypointHcl: `project = "api"`},
+				{Name: "old", DefaultBranch: "main", Archived: true, WaypointHcl: `project = "old"`},
+			},
+		},
+	})
+}
@@ -0,0 +1,87 @@
+package tarcopy
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteExtract(t *testing.T) {
+	t.Run("file", func(t *testing.T) {
+		require := require.New(t)
+
+		src := testTempDir(t)
+		dst := testTempDir(t)
+		require.NoError(ioutil.WriteFile(filepath.Join(src, "a.txt"), []byte("hello"), 0640))
+
+		var buf bytes.Buffer
+		require.NoError(Write(&buf, filepath.Join(src, "a.txt")))
+		require.NoError(Extract(&buf, filepath.Join(dst, "b.txt")))
+
+		data, err := ioutil.ReadFile(filepath.Join(dst, "b.txt"))
+		require.NoError(err)
+		require.Equal("hello", string(data))
+
+		fi, err := os.Stat(filepath.Join(dst, "b.txt"))
+		require.NoError(err)
+		require.Equal(os.FileMode(0640), fi.Mode().Perm())
+	})
+
+	t.Run("directory", func(t *testing.T) {
+		require := require.New(t)
+
+		src := testTempDir(t)
+		dst := testTempDir(t)
+		require.NoError(os.MkdirAll(filepath.Join(src, "dir", "sub"), 0755))
+		require.NoError(ioutil.WriteFile(filepath.Join(src, "dir", "sub", "a.txt"), []byte("hello"), 0644))
+		require.NoError(os.Symlink("sub/a.txt", filepath.Join(src, "dir", "link")))
+
+		var buf bytes.Buffer
+		require.NoError(Write(&buf, filepath.Join(src, "dir")))
+		require.NoError(Extract(&buf, filepath.Join(dst, "copy")))
+
+		data, err := ioutil.ReadFile(filepath.Join(dst, "copy", "sub", "a.txt"))
+		require.NoError(err)
+		require.Equal("hello", string(data))
+
+		link, err := os.Readlink(filepath.Join(dst, "copy", "link"))
+		require.NoError(err)
+		require.Equal("sub/a.txt", link)
+	})
+
+	t.Run("rejects paths outside of the destination", func(t *testing.T) {
+		require := require.New(t)
+
+		var buf bytes.Buffer
+		tw := tar.NewWriter(&buf)
+		require.NoError(tw.WriteHeader(&tar.Header{
+			Name:     "../evil",
+			Typeflag: tar.TypeReg,
+			Mode:     0644,
+		}))
+		require.NoError(tw.Close())
+
+		err := Extract(&buf, testTempDir(t))
+		require.Error(err)
+		require.Contains(err.Error(), "invalid path")
+	})
+
+	t.Run("missing source", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.Error(t, Write(&buf, filepath.Join(testTempDir(t), "nope")))
+	})
+}
+
+func testTempDir(t *testing.T) string {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "tarcopy")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
@@ -8,7 +8,6 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
-	"runtime"
 	"strings"
 
 	"github.com/adrg/xdg"
@@ -38,10 +37,7 @@ type Config struct {
 //
 func Discover(cfg *Config, paths []string) (*exec.Cmd, error) {
 	// Expected filename
-	expected := "waypoint-plugin-" + cfg.Name
-	if runtime.GOOS == "windows " {
-		expected += ".exe"
-	}
+	expected := binaryName(cfg.Name)
 
 	// Search our paths
 	for _, path := range paths {
@@ -14,6 +14,8 @@ import (
 
 	"github.com/hashicorp/waypoint-plugin-sdk/component"
 	"github.com/hashicorp/waypoint-plugin-sdk/internal-shared/pluginclient"
+
+	"github.com/hashicorp/waypoint/internal/telemetry"
 )
 
 // exePath contains the value of os.Executable. We cache the value because
@@ -51,6 +53,10 @@ func Factory(cmd *exec.Cmd, typ component.Type) interface{} {
 		config.Cmd = &cmdCopy
 		config.Logger = log
 
+		// Record a span for every call to the plugin and pass on the
+		// trace context.
+		config.GRPCDialOptions = append(config.GRPCDialOptions, telemetry.ClientOptions()...)
+
 		// Log that we're going to launch this
 		log.Info("launching plugin", "type", typ, "path", cmd.Path, "args", cmd.Args)
 
@@ -94,6 +100,11 @@ func Factory(cmd *exec.Cmd, typ component.Type) interface{} {
 
 // BuiltinFactory creates a factory for a built-in plugin type.
 func BuiltinFactory(name string, typ component.Type) interface{} {
+	return Factory(BuiltinCommand(name), typ)
+}
+
+// BuiltinCommand returns the command that launches a built-in plugin.
+func BuiltinCommand(name string) *exec.Cmd {
 	cmd := exec.Command(exePath, "plugin", name)
 
 	// For non-windows systems, we attach stdout/stderr as extra fds
@@ -102,7 +113,7 @@ func BuiltinFactory(name string, typ component.Type) interface{} {
 		cmd.ExtraFiles = []*os.File{os.Stdout, os.Stderr}
 	}
 
-	return Factory(cmd, typ)
+	return cmd
 }
 
 // ReattachPluginFactory produces a provider factory that uses the passed
@@ -113,6 +124,7 @@ func ReattachPluginFactory(reattach *plugin.ReattachConfig, typ component.Type)
 		config := pluginclient.ClientConfig(log)
 		config.Logger = log
 		config.Reattach = reattach
+		config.GRPCDialOptions = append(config.GRPCDialOptions, telemetry.ClientOptions()...)
 
 		// Verify that we know about the requested plugin's protocol version
 		plugins, ok := config.VersionedPlugins[reattach.ProtocolVersion]
@@ -0,0 +1,447 @@
+package plugin
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/adrg/xdg"
+	"github.com/hashicorp/go-hclog"
+	goversion "github.com/hashicorp/go-version"
+	"golang.org/x/crypto/openpgp"
+
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+// This file contains the installation of plugins from releases published
+// outside of Waypoint, such as GitHub releases. A release of a plugin
+// has three files, named like the releases of HashiCorp tools:
+//
+//   * waypoint-plugin-NAME_VERSION_OS_ARCH.zip - an archive with the
+//     binary for each platform.
+//   * waypoint-plugin-NAME_VERSION_SHA256SUMS - the SHA256 checksums of
+//     the archives in the format of sha256sum.
+//   * waypoint-plugin-NAME_VERSION_SHA256SUMS.sig - a detached PGP
+//     signature of the checksums.
+
+// ValidateName returns an error if name isn't a valid plugin name. Names
+// are part of paths and URLs, so this must be checked before either is
+// built from a name.
+func ValidateName(name string) error {
+	if !serverptypes.PluginNameRegexp.MatchString(name) {
+		return fmt.Errorf(
+			"invalid plugin name %q, names may only contain letters, numbers, "+
+				"\"-\", \"_\" and single \".\" characters", name)
+	}
+
+	return nil
+}
+
+// Release is a release of a plugin that can be installed.
+type Release struct {
+	Name    string
+	Version string
+
+	// The URLs of the archive for this platform, the checksums and the
+	// signature of the checksums.
+	ArchiveURL   string
+	SumsURL      string
+	SignatureURL string
+}
+
+// ReleaseSource finds the releases of plugins.
+type ReleaseSource interface {
+	// Release returns the release of the plugin with the given version.
+	// If version is empty, the latest release is returned.
+	Release(ctx context.Context, name, version string) (*Release, error)
+}
+
+// ParseSource returns the release source for a string. This is either
+// "github.com/OWNER/REPO" for the GitHub releases of a repository or an
+// http(s) URL of a registry. The plugin registry of the Waypoint server
+// isn't a release source since binaries are downloaded from it directly.
+func ParseSource(s string) (ReleaseSource, error) {
+	switch {
+	case strings.HasPrefix(s, "github.com/"):
+		parts := strings.Split(strings.TrimPrefix(s, "github.com/"), "/")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("GitHub source must be in the form github.com/OWNER/REPO, got %q", s)
+		}
+
+		return &GitHubSource{Owner: parts[0], Repo: parts[1]}, nil
+
+	case strings.HasPrefix(s, "https://"), strings.HasPrefix(s, "http://"):
+		return &HTTPSource{URL: strings.TrimRight(s, "/")}, nil
+
+	default:
+		return nil, fmt.Errorf(
+			"unknown plugin source %q, expected github.com/OWNER/REPO or an http(s) URL", s)
+	}
+}
+
+// GitHubSource finds releases in the GitHub releases of a repository. The
+// tag of a release is the version, optionally prefixed with "v".
+type GitHubSource struct {
+	Owner string
+	Repo  string
+
+	// APIURL is the URL of the GitHub API. This defaults to the public
+	// GitHub API.
+	APIURL string
+
+	// Client is the HTTP client to use. This defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+func (s *GitHubSource) Release(ctx context.Context, name, version string) (*Release, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+
+	api := s.APIURL
+	if api == "" {
+		api = "https://api.github.com"
+	}
+	base := fmt.Sprintf("%s/repos/%s/%s/releases", strings.TrimRight(api, "/"), s.Owner, s.Repo)
+
+	var release struct {
+		TagName string `json:"tag_name"`
+		Assets  []struct {
+			Name string `json:"name"`
+			URL  string `json:"browser_download_url"`
+		} `json:"assets"`
+	}
+
+	// Tags are usually prefixed with "v" but we accept both.
+	var err error
+	if version == "" {
+		err = httpGetJSON(ctx, s.Client, base+"/latest", &release)
+	} else {
+		for _, tag := range []string{"v" + version, version} {
+			err = httpGetJSON(ctx, s.Client, base+"/tags/"+tag, &release)
+			if err != errHTTPNotFound {
+				break
+			}
+		}
+	}
+	if err == errHTTPNotFound {
+		if version == "" {
+			return nil, fmt.Errorf("no releases found for github.com/%s/%s", s.Owner, s.Repo)
+		}
+
+		return nil, fmt.Errorf("release %s not found in github.com/%s/%s", version, s.Owner, s.Repo)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Release{
+		Name:    name,
+		Version: strings.TrimPrefix(release.TagName, "v"),
+	}
+	names := releaseFileNames(name, result.Version)
+	for _, asset := range release.Assets {
+		switch asset.Name {
+		case names.Archive:
+			result.ArchiveURL = asset.URL
+		case names.Sums:
+			result.SumsURL = asset.URL
+		case names.Signature:
+			result.SignatureURL = asset.URL
+		}
+	}
+	if result.ArchiveURL == "" || result.SumsURL == "" {
+		return nil, fmt.Errorf(
+			"release %s of github.com/%s/%s has no %s or %s asset",
+			release.TagName, s.Owner, s.Repo, names.Archive, names.Sums)
+	}
+
+	return result, nil
+}
+
+// HTTPSource finds releases in a registry served over HTTP. The files of a
+// release are at URL/NAME/VERSION/FILE. The versions of a plugin are listed
+// in URL/NAME/index.json in the form {"versions": {"0.1.0": {}}}, which is
+// only required to install the latest version.
+type HTTPSource struct {
+	URL string
+
+	// Client is the HTTP client to use. This defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+func (s *HTTPSource) Release(ctx context.Context, name, version string) (*Release, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+
+	if version == "" {
+		var index struct {
+			Versions map[string]json.RawMessage `json:"versions"`
+		}
+		err := httpGetJSON(ctx, s.Client, fmt.Sprintf("%s/%s/index.json", s.URL, name), &index)
+		if err == errHTTPNotFound {
+			return nil, fmt.Errorf("plugin %q not found in %s", name, s.URL)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// Find the newest version, ignoring pre-releases.
+		var latest *goversion.Version
+		for v := range index.Versions {
+			parsed, err := goversion.NewVersion(v)
+			if err != nil || parsed.Prerelease() != "" {
+				continue
+			}
+
+			if latest == nil || parsed.GreaterThan(latest) {
+				latest = parsed
+			}
+		}
+		if latest == nil {
+			return nil, fmt.Errorf("no releases of plugin %q found in %s", name, s.URL)
+		}
+
+		version = latest.Original()
+	}
+
+	base := fmt.Sprintf("%s/%s/%s/", s.URL, name, version)
+	names := releaseFileNames(name, version)
+	return &Release{
+		Name:         name,
+		Version:      version,
+		ArchiveURL:   base + names.Archive,
+		SumsURL:      base + names.Sums,
+		SignatureURL: base + names.Signature,
+	}, nil
+}
+
+// InstallOptions are the options for InstallRelease.
+type InstallOptions struct {
+	// Dir is the directory the binary is installed into.
+	Dir string
+
+	// Keyring are the keys the checksums must be signed with. If this is
+	// nil, the signature isn't verified and only the checksum of the
+	// archive is.
+	Keyring openpgp.EntityList
+
+	// Client is the HTTP client to use. This defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+// InstallRelease downloads the archive of the release, verifies the
+// signature of the checksums and the checksum of the archive, and extracts
+// the binary into the directory. This returns the path of the binary.
+func InstallRelease(
+	ctx context.Context,
+	log hclog.Logger,
+	r *Release,
+	opts *InstallOptions,
+) (string, error) {
+	if err := ValidateName(r.Name); err != nil {
+		return "", err
+	}
+
+	names := releaseFileNames(r.Name, r.Version)
+
+	log.Debug("downloading checksums", "url", r.SumsURL)
+	sums, err := httpGet(ctx, opts.Client, r.SumsURL)
+	if err != nil {
+		return "", fmt.Errorf("error downloading checksums: %s", err)
+	}
+
+	if opts.Keyring != nil {
+		if r.SignatureURL == "" {
+			return "", fmt.Errorf("release %s of plugin %q has no signature", r.Version, r.Name)
+		}
+
+		log.Debug("downloading signature", "url", r.SignatureURL)
+		sig, err := httpGet(ctx, opts.Client, r.SignatureURL)
+		if err != nil {
+			return "", fmt.Errorf("error downloading signature: %s", err)
+		}
+
+		if _, err := openpgp.CheckDetachedSignature(
+			opts.Keyring, bytes.NewReader(sums), bytes.NewReader(sig)); err != nil {
+			return "", fmt.Errorf("signature of the checksums of plugin %q is invalid: %s", r.Name, err)
+		}
+	}
+
+	expected, err := releaseChecksum(sums, names.Archive)
+	if err != nil {
+		return "", err
+	}
+
+	log.Debug("downloading archive", "url", r.ArchiveURL)
+	archive, err := httpGet(ctx, opts.Client, r.ArchiveURL)
+	if err != nil {
+		return "", fmt.Errorf("error downloading plugin archive: %s", err)
+	}
+
+	sum := sha256.Sum256(archive)
+	if actual := hex.EncodeToString(sum[:]); actual != expected {
+		return "", fmt.Errorf(
+			"plugin %q archive checksum mismatch. expected: %s, got: %s",
+			r.Name, expected, actual)
+	}
+
+	// Find the binary in the archive.
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return "", fmt.Errorf("error reading plugin archive: %s", err)
+	}
+
+	var bin *zip.File
+	for _, f := range zr.File {
+		if filepath.Base(f.Name) == binaryName(r.Name) {
+			bin = f
+			break
+		}
+	}
+	if bin == nil {
+		return "", fmt.Errorf("plugin archive doesn't contain %s", binaryName(r.Name))
+	}
+
+	rc, err := bin.Open()
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	return writeBinary(opts.Dir, binaryName(r.Name), rc, nil)
+}
+
+// DefaultInstallDir returns the directory plugins are installed into by
+// default. This is one of the DefaultPaths.
+func DefaultInstallDir() (string, error) {
+	path, err := xdg.ConfigFile("waypoint/plugins/.ignore")
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Dir(path), nil
+}
+
+// writeBinary atomically writes an executable file into the directory. If
+// verify is non-nil, it is called once all data is written and the file is
+// only moved into place if it returns nil.
+func writeBinary(dir, name string, r io.Reader, verify func() error) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	f, err := ioutil.TempFile(dir, ".install")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if _, err := io.Copy(f, r); err != nil {
+		return "", err
+	}
+	if verify != nil {
+		if err := verify(); err != nil {
+			return "", err
+		}
+	}
+	if err := f.Chmod(0755); err != nil {
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, name)
+	if err := os.Rename(f.Name(), path); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// releaseFiles are the names of the files of a release.
+type releaseFiles struct {
+	Archive   string
+	Sums      string
+	Signature string
+}
+
+func releaseFileNames(name, version string) releaseFiles {
+	prefix := fmt.Sprintf("waypoint-plugin-%s_%s", name, version)
+	return releaseFiles{
+		Archive:   fmt.Sprintf("%s_%s_%s.zip", prefix, runtime.GOOS, runtime.GOARCH),
+		Sums:      prefix + "_SHA256SUMS",
+		Signature: prefix + "_SHA256SUMS.sig",
+	}
+}
+
+// releaseChecksum returns the checksum of the file from the checksums in
+// the format of sha256sum.
+func releaseChecksum(sums []byte, file string) (string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(sums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && strings.TrimPrefix(fields[1], "*") == file {
+			return strings.ToLower(fields[0]), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	return "", fmt.Errorf("checksums don't include %s, the plugin may not support %s/%s",
+		file, runtime.GOOS, runtime.GOARCH)
+}
+
+// errHTTPNotFound is returned by httpGet for a 404 response.
+var errHTTPNotFound = fmt.Errorf("not found")
+
+func httpGet(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errHTTPNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+
+	return ioutil.ReadAll(resp.Body)
+}
+
+func httpGetJSON(ctx context.Context, client *http.Client, url string, v interface{}) error {
+	data, err := httpGet(ctx, client, url)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
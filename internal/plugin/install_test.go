@@ -0,0 +1,296 @@
+package plugin
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/openpgp"
+)
+
+func TestParseSource(t *testing.T) {
+	cases := []struct {
+		Input  string
+		Result ReleaseSource
+		Err    string
+	}{
+		{
+			"github.com/hashicorp/waypoint-plugin-examples",
+			&GitHubSource{Owner: "hashicorp", Repo: "waypoint-plugin-examples"},
+			"",
+		},
+
+		{
+			"github.com/hashicorp",
+			nil,
+			"OWNER/REPO",
+		},
+
+		{
+			"https://releases.example.com/plugins/",
+			&HTTPSource{URL: "https://releases.example.com/plugins"},
+			"",
+		},
+
+		{
+			"server",
+			nil,
+			"unknown plugin source",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Input, func(t *testing.T) {
+			require := require.New(t)
+
+			result, err := ParseSource(tt.Input)
+			if tt.Err != "" {
+				require.Error(err)
+				require.Contains(err.Error(), tt.Err)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.Result, result)
+		})
+	}
+}
+
+func TestValidateName(t *testing.T) {
+	cases := []struct {
+		Name  string
+		Valid bool
+	}{
+		{"docker", true},
+		{"aws-ecs", true},
+		{"google_cloud.run", true},
+		{"fly2", true},
+		{"", false},
+		{"-flag", false},
+		{".hidden", false},
+		{"..", false},
+		{"a..b", false},
+		{"trailing.", false},
+		{"../../bin/sh", false},
+		{"a/b", false},
+		{`a\b`, false},
+		{"a b", false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			err := ValidateName(tt.Name)
+			if tt.Valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestInstallRelease_invalidName(t *testing.T) {
+	ctx := context.Background()
+
+	// No requests may be made for an invalid name
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.NotFound(w, r)
+	}))
+	defer srv.Close()
+
+	for _, source := range []ReleaseSource{
+		&HTTPSource{URL: srv.URL},
+		&GitHubSource{Owner: "acme", Repo: "plugins", APIURL: srv.URL},
+	} {
+		_, err := source.Release(ctx, "../fake", "1.0.0")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid plugin name")
+	}
+
+	dir := testInstallDir(t)
+	_, err := InstallRelease(ctx, hclog.L(), &Release{
+		Name:       "../fake",
+		Version:    "1.0.0",
+		ArchiveURL: srv.URL + "/archive",
+		SumsURL:    srv.URL + "/sums",
+	}, &InstallOptions{Dir: dir})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid plugin name")
+	require.Zero(t, requests)
+
+	entries, err := ioutil.ReadDir(filepath.Dir(dir))
+	require.NoError(t, err)
+	for _, e := range entries {
+		require.NotEqual(t, binaryName("fake"), e.Name())
+	}
+}
+
+func TestInstallRelease(t *testing.T) {
+	ctx := context.Background()
+	log := hclog.L()
+
+	// Create a signing key and a release that is signed with it.
+	entity, err := openpgp.NewEntity("test", "", "test@example.com", nil)
+	require.NoError(t, err)
+	files := testRelease(t, "fake", "1.2.0", entity)
+
+	mux := http.NewServeMux()
+	for name, data := range files {
+		data := data
+		mux.HandleFunc("/fake/1.2.0/"+name, func(w http.ResponseWriter, r *http.Request) {
+			w.Write(data)
+		})
+	}
+	mux.HandleFunc("/fake/index.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"versions": {"1.0.0": {}, "1.2.0": {}, "1.3.0-beta1": {}}}`)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	source := &HTTPSource{URL: srv.URL}
+
+	t.Run("latest version with a valid signature", func(t *testing.T) {
+		require := require.New(t)
+
+		r, err := source.Release(ctx, "fake", "")
+		require.NoError(err)
+		require.Equal("1.2.0", r.Version)
+
+		dir := testInstallDir(t)
+		path, err := InstallRelease(ctx, log, r, &InstallOptions{
+			Dir:     dir,
+			Keyring: openpgp.EntityList{entity},
+		})
+		require.NoError(err)
+		require.Equal(filepath.Join(dir, binaryName("fake")), path)
+
+		data, err := ioutil.ReadFile(path)
+		require.NoError(err)
+		require.Equal("binary", string(data))
+	})
+
+	t.Run("signed by another key", func(t *testing.T) {
+		require := require.New(t)
+
+		other, err := openpgp.NewEntity("other", "", "other@example.com", nil)
+		require.NoError(err)
+
+		r, err := source.Release(ctx, "fake", "1.2.0")
+		require.NoError(err)
+
+		dir := testInstallDir(t)
+		_, err = InstallRelease(ctx, log, r, &InstallOptions{
+			Dir:     dir,
+			Keyring: openpgp.EntityList{other},
+		})
+		require.Error(err)
+		require.Contains(err.Error(), "signature")
+
+		_, err = os.Stat(filepath.Join(dir, binaryName("fake")))
+		require.True(os.IsNotExist(err))
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		require := require.New(t)
+
+		r, err := source.Release(ctx, "fake", "1.2.0")
+		require.NoError(err)
+
+		// Serve the checksums of another archive.
+		sums := fmt.Sprintf("%s  %s\n",
+			strings.Repeat("0", 64), releaseFileNames("fake", "1.2.0").Archive)
+		sumsSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, sums)
+		}))
+		defer sumsSrv.Close()
+		r.SumsURL = sumsSrv.URL
+
+		_, err = InstallRelease(ctx, log, r, &InstallOptions{Dir: testInstallDir(t)})
+		require.Error(err)
+		require.Contains(err.Error(), "checksum mismatch")
+	})
+}
+
+func TestGitHubSource(t *testing.T) {
+	require := require.New(t)
+
+	names := releaseFileNames("fake", "0.2.0")
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/acme/plugins/releases/tags/v0.2.0", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{
+			"tag_name": "v0.2.0",
+			"assets": [
+				{"name": %q, "browser_download_url": "https://example.com/archive"},
+				{"name": %q, "browser_download_url": "https://example.com/sums"}
+			]
+		}`, names.Archive, names.Sums)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	source := &GitHubSource{Owner: "acme", Repo: "plugins", APIURL: srv.URL}
+	r, err := source.Release(context.Background(), "fake", "0.2.0")
+	require.NoError(err)
+	require.Equal(&Release{
+		Name:       "fake",
+		Version:    "0.2.0",
+		ArchiveURL: "https://example.com/archive",
+		SumsURL:    "https://example.com/sums",
+	}, r)
+
+	_, err = source.Release(context.Background(), "fake", "0.3.0")
+	require.Error(err)
+	require.Contains(err.Error(), "not found")
+}
+
+// testRelease returns the files of a release of a plugin for this
+// platform, signed by the entity if it isn't nil.
+func testRelease(t *testing.T, name, version string, entity *openpgp.Entity) map[string][]byte {
+	t.Helper()
+
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	w, err := zw.Create(binaryName(name))
+	require.NoError(t, err)
+	_, err = w.Write([]byte("binary"))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	names := releaseFileNames(name, version)
+	sum := sha256.Sum256(archive.Bytes())
+	sums := []byte(fmt.Sprintf("%s  %s\n", hex.EncodeToString(sum[:]), names.Archive))
+
+	result := map[string][]byte{
+		names.Archive: archive.Bytes(),
+		names.Sums:    sums,
+	}
+	if entity != nil {
+		var sig bytes.Buffer
+		require.NoError(t, openpgp.DetachSign(&sig, entity, bytes.NewReader(sums), nil))
+		result[names.Signature] = sig.Bytes()
+	}
+
+	return result
+}
+
+func testInstallDir(t *testing.T) string {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "waypoint-plugin-install")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
@@ -0,0 +1,121 @@
+package plugin
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-plugin"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	"github.com/hashicorp/waypoint-plugin-sdk/internal-shared/pluginclient"
+)
+
+// componentTypes are the component types a plugin can implement, in the
+// order they're listed.
+var componentTypes = []component.Type{
+	component.BuilderType,
+	component.RegistryType,
+	component.PlatformType,
+	component.ReleaseManagerType,
+	component.ConfigSourcerType,
+	component.TaskLauncherType,
+}
+
+// Installed is a plugin binary found in the plugin paths.
+type Installed struct {
+	Name string
+	Path string
+}
+
+// ListInstalled returns the plugin binaries in the paths, sorted by name.
+// If a plugin is in multiple paths, the first path wins just like with
+// Discover.
+func ListInstalled(paths []string) ([]*Installed, error) {
+	seen := map[string]struct{}{}
+	var result []*Installed
+	for _, dir := range paths {
+		entries, err := ioutil.ReadDir(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			if entry.IsDir() || !strings.HasPrefix(entry.Name(), "waypoint-plugin-") {
+				continue
+			}
+
+			name := strings.TrimPrefix(entry.Name(), "waypoint-plugin-")
+			if runtime.GOOS == "windows" {
+				if !strings.HasSuffix(name, ".exe") {
+					continue
+				}
+
+				name = strings.TrimSuffix(name, ".exe")
+			}
+
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+
+			result = append(result, &Installed{
+				Name: name,
+				Path: filepath.Join(dir, entry.Name()),
+			})
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+// ComponentTypes launches the plugin and returns the component types it
+// implements. A plugin implements a component type if it returns the
+// documentation for it, which every component built with the SDK does.
+func ComponentTypes(log hclog.Logger, cmd *exec.Cmd) ([]component.Type, error) {
+	// We have to copy the command because go-plugin will set some
+	// fields on it.
+	cmdCopy := *cmd
+
+	config := pluginclient.ClientConfig(log)
+	config.Cmd = &cmdCopy
+	config.Logger = log
+
+	client := plugin.NewClient(config)
+	defer client.Kill()
+
+	rpcClient, err := client.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []component.Type
+	for _, typ := range componentTypes {
+		raw, err := rpcClient.Dispense(strings.ToLower(typ.String()))
+		if err != nil {
+			continue
+		}
+
+		if _, err := component.Documentation(raw); err != nil {
+			log.Trace("plugin doesn't implement component type", "type", typ, "err", err)
+			continue
+		}
+
+		result = append(result, typ)
+	}
+
+	return result, nil
+}
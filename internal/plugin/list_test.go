@@ -0,0 +1,28 @@
+package plugin
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListInstalled(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test plugins don't have the .exe extension")
+	}
+
+	require := require.New(t)
+
+	result, err := ListInstalled([]string{
+		filepath.Join("testdata", "pathA"),
+		filepath.Join("testdata", "pathB"),
+		filepath.Join("testdata", "nope"),
+	})
+	require.NoError(err)
+	require.Equal([]*Installed{
+		{Name: "a", Path: filepath.Join("testdata", "pathA", "waypoint-plugin-a")},
+		{Name: "b", Path: filepath.Join("testdata", "pathB", "waypoint-plugin-b")},
+	}, result)
+}
@@ -10,16 +10,20 @@ import (
 	"github.com/hashicorp/waypoint/builtin/aws/ec2"
 	"github.com/hashicorp/waypoint/builtin/aws/ecr"
 	"github.com/hashicorp/waypoint/builtin/aws/ecs"
+	ecscodedeploy "github.com/hashicorp/waypoint/builtin/aws/ecs/codedeploy"
 	"github.com/hashicorp/waypoint/builtin/aws/lambda"
+	"github.com/hashicorp/waypoint/builtin/aws/secretsmanager"
 	"github.com/hashicorp/waypoint/builtin/aws/ssm"
 	"github.com/hashicorp/waypoint/builtin/azure/aci"
 	"github.com/hashicorp/waypoint/builtin/docker"
+	dockercompose "github.com/hashicorp/waypoint/builtin/docker/compose"
 	dockerpull "github.com/hashicorp/waypoint/builtin/docker/pull"
 	"github.com/hashicorp/waypoint/builtin/exec"
 	"github.com/hashicorp/waypoint/builtin/files"
 	"github.com/hashicorp/waypoint/builtin/google/cloudrun"
 	"github.com/hashicorp/waypoint/builtin/k8s"
 	k8sapply "github.com/hashicorp/waypoint/builtin/k8s/apply"
+	k8shelm "github.com/hashicorp/waypoint/builtin/k8s/helm"
 	"github.com/hashicorp/waypoint/builtin/nomad"
 	"github.com/hashicorp/waypoint/builtin/nomad/jobspec"
 	"github.com/hashicorp/waypoint/builtin/pack"
@@ -35,12 +39,15 @@ var (
 		"pack":                     pack.Options,
 		"docker":                   docker.Options,
 		"docker-pull":              dockerpull.Options,
+		"docker-compose":           dockercompose.Options,
 		"exec":                     exec.Options,
 		"google-cloud-run":         cloudrun.Options,
 		"azure-container-instance": aci.Options,
 		"kubernetes":               k8s.Options,
 		"kubernetes-apply":         k8sapply.Options,
+		"helm":                     k8shelm.Options,
 		"aws-ecs":                  ecs.Options,
+		"aws-ecs-codedeploy":       ecscodedeploy.Options,
 		"aws-ecr":                  ecr.Options,
 		"nomad":                    nomad.Options,
 		"nomad-jobspec":            jobspec.Options,
@@ -48,6 +55,7 @@ var (
 		"aws-ec2":                  ec2.Options,
 		"aws-alb":                  alb.Options,
 		"aws-ssm":                  ssm.Options,
+		"aws-secretsmanager":       secretsmanager.Options,
 		"aws-lambda":               lambda.Options,
 		"vault":                    vault.Options,
 		"terraform-cloud":          tfc.Options,
@@ -73,6 +81,9 @@ var (
 		"aws-ssm": {
 			Component: &ssm.ConfigSourcer{},
 		},
+		"aws-secretsmanager": {
+			Component: &secretsmanager.ConfigSourcer{},
+		},
 		"kubernetes": {
 			Component: &k8s.ConfigSourcer{},
 		},
@@ -0,0 +1,246 @@
+package plugin
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path/filepath"
+	"runtime"
+
+	"github.com/adrg/xdg"
+	"github.com/hashicorp/go-hclog"
+	goversion "github.com/hashicorp/go-version"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// This file contains the client side of the plugin registry of the server.
+// Plugin binaries are uploaded to the server and runners download the
+// binary matching the version constraint of a plugin in the waypoint.hcl,
+// so that every runner executes the same binary.
+
+// registryChunkSize is the size of the chunks of an upload.
+const registryChunkSize = 1024 * 1024
+
+// DefaultCacheDir returns the directory where binaries downloaded from
+// the plugin registry are stored.
+func DefaultCacheDir() (string, error) {
+	path, err := xdg.CacheFile("waypoint/plugins/.ignore")
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Dir(path), nil
+}
+
+// UploadBinary uploads the plugin binary read from r to the plugin registry
+// of the server.
+func UploadBinary(
+	ctx context.Context,
+	client pb.WaypointClient,
+	open *pb.UploadPluginRequest_Open,
+	r io.Reader,
+) (*pb.PluginBinary, error) {
+	stream, err := client.UploadPlugin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := stream.Send(&pb.UploadPluginRequest{
+		Event: &pb.UploadPluginRequest_Open_{Open: open},
+	}); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, registryChunkSize)
+	for {
+		n, rerr := io.ReadFull(r, buf)
+		if n > 0 {
+			err := stream.Send(&pb.UploadPluginRequest{
+				Event: &pb.UploadPluginRequest_Chunk{Chunk: buf[:n]},
+			})
+
+			// An EOF means the server closed the stream with an error,
+			// which CloseAndRecv returns.
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if rerr == io.EOF || rerr == io.ErrUnexpectedEOF {
+			break
+		}
+		if rerr != nil {
+			return nil, rerr
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Plugin, nil
+}
+
+// ResolveBinary returns the newest binary of the plugin in the registry
+// that matches the version constraint and runs on this platform. This
+// returns nil if no binary matches.
+func ResolveBinary(
+	ctx context.Context,
+	client pb.WaypointClient,
+	name, constraint string,
+) (*pb.PluginBinary, error) {
+	constraints, err := goversion.NewConstraint(constraint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version constraint %q for plugin %q: %s",
+			constraint, name, err)
+	}
+
+	resp, err := client.ListPlugins(ctx, &pb.ListPluginsRequest{
+		Name: name,
+		Os:   runtime.GOOS,
+		Arch: runtime.GOARCH,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The binaries are sorted newest first.
+	for _, binary := range resp.Plugins {
+		v, err := goversion.NewVersion(binary.Version)
+		if err != nil {
+			continue
+		}
+
+		if constraints.Check(v) {
+			return binary, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// FetchBinary downloads the binary from the plugin registry into cacheDir
+// unless it is already there, and returns the directory that contains the
+// binary. The binary is verified against its checksum.
+func FetchBinary(
+	ctx context.Context,
+	log hclog.Logger,
+	client pb.WaypointClient,
+	binary *pb.PluginBinary,
+	cacheDir string,
+) (string, error) {
+	// The binary comes from the server so we check its name and version
+	// before they become part of the path.
+	if err := ValidateName(binary.Name); err != nil {
+		return "", err
+	}
+	if _, err := goversion.NewVersion(binary.Version); err != nil {
+		return "", fmt.Errorf("invalid version %q for plugin %q: %s",
+			binary.Version, binary.Name, err)
+	}
+
+	dir := filepath.Join(cacheDir, binary.Name, binary.Version, binary.Os+"_"+binary.Arch)
+	path := filepath.Join(dir, binaryName(binary.Name))
+
+	// If we already have the binary, we're done. We check the checksum in
+	// case the binary was modified or only partially written.
+	if actual, err := checksum(path); err == nil && actual == binary.Checksum {
+		log.Debug("plugin binary found in cache", "path", path)
+		return dir, nil
+	}
+
+	log.Info("downloading plugin binary from the registry",
+		"name", binary.Name, "version", binary.Version, "path", path)
+	if _, err := InstallBinary(ctx, client, binary, dir); err != nil {
+		return "", err
+	}
+
+	return dir, nil
+}
+
+// InstallBinary downloads the binary from the plugin registry into the
+// directory and returns the path of the binary. The binary is verified
+// against its checksum before it is moved into place.
+func InstallBinary(
+	ctx context.Context,
+	client pb.WaypointClient,
+	binary *pb.PluginBinary,
+	dir string,
+) (string, error) {
+	if err := ValidateName(binary.Name); err != nil {
+		return "", err
+	}
+
+	stream, err := client.DownloadPlugin(ctx, &pb.DownloadPluginRequest{
+		Name:    binary.Name,
+		Version: binary.Version,
+		Os:      binary.Os,
+		Arch:    binary.Arch,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(readBinaryStream(stream, pw))
+	}()
+	defer pr.Close()
+
+	h := sha256.New()
+	return writeBinary(dir, binaryName(binary.Name), io.TeeReader(pr, h), func() error {
+		if actual := hex.EncodeToString(h.Sum(nil)); actual != binary.Checksum {
+			return fmt.Errorf(
+				"plugin %q checksum mismatch after download. expected: %s, got: %s",
+				binary.Name, binary.Checksum, actual)
+		}
+
+		return nil
+	})
+}
+
+// readBinaryStream writes the chunks of a download to w.
+func readBinaryStream(stream pb.Waypoint_DownloadPluginClient, w io.Writer) error {
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch event := resp.Event.(type) {
+		case *pb.DownloadPluginResponse_Open_:
+			// The binary is what we expect, the data is verified by the caller.
+
+		case *pb.DownloadPluginResponse_Chunk:
+			if _, err := w.Write(event.Chunk); err != nil {
+				return err
+			}
+
+		default:
+			return status.Errorf(codes.Internal,
+				"unexpected download event: %T", resp.Event)
+		}
+	}
+}
+
+// binaryName returns the file name of the binary of a plugin.
+func binaryName(name string) string {
+	result := "waypoint-plugin-" + name
+	if runtime.GOOS == "windows" {
+		result += ".exe"
+	}
+
+	return result
+}
@@ -0,0 +1,362 @@
+package plugin
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-plugin"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+)
+
+// This file contains the runner side of remote plugins. A remote plugin
+// runs on a plugin host (see RemoteHost) instead of being launched by the
+// runner. The runner dials the plugin host and connects to the plugin
+// through a local listener, so that go-plugin can attach to the plugin as
+// if it was running locally.
+//
+// Plugins built with the SDK don't only serve requests, they also dial
+// back to services the runner serves with the go-plugin broker, such as the
+// terminal UI. The broker exchanges local addresses, so the plugin host
+// rewrites them and both sides forward the connections over new
+// connections to the plugin host. The protocol is:
+//
+//   * Every connection starts with a remoteHeader as a JSON line.
+//   * A "session" connection launches the plugin. The host replies with a
+//     remoteReply and then sends remoteControl messages for the lifetime
+//     of the session. The plugin is stopped when the connection closes.
+//   * A "grpc" connection carries the gRPC connection to the plugin.
+//   * A "dial" connection is opened by the runner for a remoteControl
+//     "dial" message and is forwarded to a service of the runner.
+//   * A "connect" connection is opened by the runner for a connection to
+//     a service of the plugin that was announced with a "listen" message.
+
+// The types of connections and control messages.
+const (
+	remoteSession = "session"
+	remoteGRPC    = "grpc"
+	remoteDial    = "dial"
+	remoteConnect = "connect"
+	remoteListen  = "listen"
+)
+
+// remoteHeader is the first message of every connection to a plugin host.
+type remoteHeader struct {
+	Type    string `json:"type"`
+	Plugin  string `json:"plugin,omitempty"`
+	Token   string `json:"token,omitempty"`
+	Session string `json:"session,omitempty"`
+	ID      uint64 `json:"id,omitempty"`
+}
+
+// remoteReply is the reply of the plugin host to a session connection.
+type remoteReply struct {
+	Error           string `json:"error,omitempty"`
+	Session         string `json:"session,omitempty"`
+	ProtocolVersion int    `json:"protocol_version,omitempty"`
+}
+
+// remoteControl is a message on the session connection. The plugin host
+// sends "dial" and "listen" messages and the runner replies to "listen"
+// messages with the address it listens on.
+type remoteControl struct {
+	Op      string `json:"op,omitempty"`
+	ID      uint64 `json:"id"`
+	Network string `json:"network,omitempty"`
+	Address string `json:"address,omitempty"`
+}
+
+// RemoteConfig is the configuration to connect to a plugin on a remote
+// plugin host.
+type RemoteConfig struct {
+	// Name is the name of the plugin.
+	Name string
+
+	// Address is the address of the plugin host.
+	Address string
+
+	// Token is the token the plugin host requires, if any.
+	Token string
+
+	// TLS is the TLS configuration for the connection to the plugin host.
+	// If this is nil, TLS is not used.
+	TLS *tls.Config
+}
+
+// RemoteFactory returns the factory function for a plugin that runs on a
+// remote plugin host. Like Factory, this returns an *Instance.
+func RemoteFactory(cfg *RemoteConfig, typ component.Type) interface{} {
+	return func(log hclog.Logger) (interface{}, error) {
+		log = log.With("plugin_host", cfg.Address)
+		log.Info("connecting to plugin host", "type", typ)
+
+		sess, err := dialRemote(log, cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		// We attach to the plugin with the reattach support of go-plugin.
+		// In test mode go-plugin doesn't manage the process, which is this
+		// process since it owns the listener, and never kills it.
+		raw, err := ReattachPluginFactory(&plugin.ReattachConfig{
+			Protocol:        plugin.ProtocolGRPC,
+			ProtocolVersion: sess.protocolVersion,
+			Addr:            sess.ln.Addr(),
+			Pid:             os.Getpid(),
+			Test:            true,
+		}, typ).(func(hclog.Logger) (interface{}, error))(log)
+		if err != nil {
+			sess.Close()
+			return nil, err
+		}
+
+		inst := raw.(*Instance)
+		closeFn := inst.Close
+		inst.Close = func() {
+			closeFn()
+			sess.Close()
+		}
+
+		return inst, nil
+	}
+}
+
+// remoteClientSession is a session with a plugin host.
+type remoteClientSession struct {
+	log             hclog.Logger
+	cfg             *RemoteConfig
+	id              string
+	protocolVersion int
+
+	// conn is the session connection and ln the local listener the runner
+	// connects to the plugin through.
+	conn net.Conn
+	ln   net.Listener
+
+	mu        sync.Mutex
+	listeners []net.Listener
+	closed    bool
+}
+
+func dialRemote(log hclog.Logger, cfg *RemoteConfig) (*remoteClientSession, error) {
+	conn, err := remoteDialHost(cfg, &remoteHeader{
+		Type:   remoteSession,
+		Plugin: cfg.Name,
+		Token:  cfg.Token,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to plugin host %s: %s", cfg.Address, err)
+	}
+
+	// The reply is a single line so that the rest of the connection can be
+	// read with a new decoder.
+	br := bufio.NewReader(conn)
+	line, err := br.ReadBytes('\n')
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("error reading reply from plugin host %s: %s", cfg.Address, err)
+	}
+
+	var reply remoteReply
+	if err := json.Unmarshal(line, &reply); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if reply.Error != "" {
+		conn.Close()
+		return nil, fmt.Errorf("plugin host %s: %s", cfg.Address, reply.Error)
+	}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	sess := &remoteClientSession{
+		log:             log,
+		cfg:             cfg,
+		id:              reply.Session,
+		protocolVersion: reply.ProtocolVersion,
+		conn:            conn,
+		ln:              ln,
+	}
+	go sess.forward(ln, &remoteHeader{Type: remoteGRPC})
+	go sess.control(br)
+
+	log.Debug("connected to plugin host",
+		"session", sess.id, "protocol_version", sess.protocolVersion)
+	return sess, nil
+}
+
+// Close closes the session, which stops the plugin on the plugin host.
+func (s *remoteClientSession) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+
+	for _, ln := range s.listeners {
+		ln.Close()
+	}
+	s.ln.Close()
+	return s.conn.Close()
+}
+
+// control handles the control messages of the plugin host.
+func (s *remoteClientSession) control(r io.Reader) {
+	defer s.Close()
+
+	enc := json.NewEncoder(s.conn)
+	dec := json.NewDecoder(r)
+	for {
+		var msg remoteControl
+		if err := dec.Decode(&msg); err != nil {
+			if err != io.EOF {
+				s.log.Debug("plugin host session ended", "err", err)
+			}
+
+			return
+		}
+
+		switch msg.Op {
+		case remoteDial:
+			// The plugin connects to a service of the runner.
+			go s.dial(&msg)
+
+		case remoteListen:
+			// The plugin serves a service the runner connects to. We listen
+			// locally and forward the connections to the plugin host.
+			reply := remoteControl{ID: msg.ID}
+			ln, err := s.listen()
+			if err != nil {
+				s.log.Warn("error listening for plugin service", "err", err)
+			} else {
+				reply.Network = ln.Addr().Network()
+				reply.Address = ln.Addr().String()
+				go s.forward(ln, &remoteHeader{Type: remoteConnect, ID: msg.ID})
+			}
+
+			if err := enc.Encode(&reply); err != nil {
+				return
+			}
+
+		default:
+			s.log.Warn("unknown control message from plugin host", "op", msg.Op)
+		}
+	}
+}
+
+func (s *remoteClientSession) listen() (net.Listener, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil, fmt.Errorf("session closed")
+	}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s.listeners = append(s.listeners, ln)
+	return ln, nil
+}
+
+// dial connects to the local service and forwards it over a new
+// connection to the plugin host.
+func (s *remoteClientSession) dial(msg *remoteControl) {
+	local, err := net.DialTimeout(msg.Network, msg.Address, 10*time.Second)
+	if err != nil {
+		s.log.Warn("error connecting to local service for plugin",
+			"address", msg.Address, "err", err)
+		return
+	}
+
+	remote, err := remoteDialHost(s.cfg, &remoteHeader{
+		Type:    remoteDial,
+		Session: s.id,
+		ID:      msg.ID,
+	})
+	if err != nil {
+		local.Close()
+		s.log.Warn("error connecting to plugin host", "err", err)
+		return
+	}
+
+	remotePipe(local, remote)
+}
+
+// forward accepts connections on the listener and forwards them over new
+// connections to the plugin host with the given header.
+func (s *remoteClientSession) forward(ln net.Listener, h *remoteHeader) {
+	header := *h
+	header.Session = s.id
+	for {
+		local, err := ln.Accept()
+		if err != nil {
+			return
+		}
+
+		go func() {
+			remote, err := remoteDialHost(s.cfg, &header)
+			if err != nil {
+				local.Close()
+				s.log.Warn("error connecting to plugin host", "err", err)
+				return
+			}
+
+			remotePipe(local, remote)
+		}()
+	}
+}
+
+// remoteDialHost opens a connection to the plugin host and sends the header.
+func remoteDialHost(cfg *RemoteConfig, h *remoteHeader) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: 10 * time.Second, KeepAlive: 30 * time.Second}
+
+	var conn net.Conn
+	var err error
+	if cfg.TLS != nil {
+		conn, err = tls.DialWithDialer(dialer, "tcp", cfg.Address, cfg.TLS)
+	} else {
+		conn, err = dialer.Dial("tcp", cfg.Address)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.NewEncoder(conn).Encode(h); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+// remotePipe copies data between the connections until either is closed.
+func remotePipe(a, b net.Conn) {
+	var once sync.Once
+	closeBoth := func() {
+		a.Close()
+		b.Close()
+	}
+
+	go func() {
+		io.Copy(a, b)
+		once.Do(closeBoth)
+	}()
+
+	io.Copy(b, a)
+	once.Do(closeBoth)
+}
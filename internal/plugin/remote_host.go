@@ -0,0 +1,692 @@
+package plugin
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/internal-shared/pluginclient"
+)
+
+// brokerMethod is the method of the go-plugin broker stream. The addresses
+// in the messages of this stream are rewritten by the plugin host.
+const brokerMethod = "/plugin.GRPCBroker/StartStream"
+
+// RemoteLaunch is a plugin launched by a plugin host.
+type RemoteLaunch struct {
+	// Addr is the address the plugin serves on.
+	Addr net.Addr
+
+	// ProtocolVersion is the negotiated plugin protocol version.
+	ProtocolVersion int
+
+	// Close stops the plugin.
+	Close func()
+}
+
+// RemoteHost runs plugins for runners that connect over the network. Each
+// runner session launches a new plugin process which is stopped when the
+// session ends, just like plugins launched by the runner.
+type RemoteHost struct {
+	Log hclog.Logger
+
+	// Token is the token runners must present. If this is empty, any
+	// runner that can connect to the plugin host can use the plugins.
+	Token string
+
+	// Launch launches the plugin with the given name. LaunchCommand can be
+	// used to launch a plugin binary.
+	Launch func(log hclog.Logger, name string) (*RemoteLaunch, error)
+
+	mu       sync.Mutex
+	sessions map[string]*remoteHostSession
+}
+
+// LaunchCommand launches the plugin for the command for a plugin host.
+func LaunchCommand(log hclog.Logger, cmd *exec.Cmd) (*RemoteLaunch, error) {
+	// We have to copy the command because go-plugin will set some
+	// fields on it.
+	cmdCopy := *cmd
+
+	config := pluginclient.ClientConfig(log)
+	config.Cmd = &cmdCopy
+	config.Logger = log
+
+	client := plugin.NewClient(config)
+	addr, err := client.Start()
+	if err != nil {
+		client.Kill()
+		return nil, err
+	}
+
+	return &RemoteLaunch{
+		Addr:            addr,
+		ProtocolVersion: client.NegotiatedVersion(),
+		Close:           client.Kill,
+	}, nil
+}
+
+// Serve accepts runner connections on the listener until it is closed.
+func (h *RemoteHost) Serve(ln net.Listener) error {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return err
+		}
+
+		go h.handle(conn)
+	}
+}
+
+func (h *RemoteHost) handle(conn net.Conn) {
+	// Read the header. The connection is read through the buffered reader
+	// afterwards since it may have read past the header.
+	conn.SetReadDeadline(time.Now().Add(30 * time.Second))
+	br := bufio.NewReader(conn)
+	line, err := br.ReadBytes('\n')
+	if err != nil {
+		conn.Close()
+		return
+	}
+	conn.SetReadDeadline(time.Time{})
+
+	var header remoteHeader
+	if err := json.Unmarshal(line, &header); err != nil {
+		h.Log.Debug("invalid connection header", "err", err)
+		conn.Close()
+		return
+	}
+	bc := &remoteBufConn{Conn: conn, r: br}
+
+	if header.Type == remoteSession {
+		h.session(bc, &header)
+		return
+	}
+
+	// All other connections belong to a session. The session ID is random
+	// and only known to the runner that created the session.
+	h.mu.Lock()
+	sess, ok := h.sessions[header.Session]
+	h.mu.Unlock()
+	if !ok {
+		conn.Close()
+		return
+	}
+
+	switch header.Type {
+	case remoteGRPC:
+		sess.grpcLn.push(bc)
+
+	case remoteDial:
+		sess.dialed(header.ID, bc)
+
+	case remoteConnect:
+		sess.connect(header.ID, bc)
+
+	default:
+		conn.Close()
+	}
+}
+
+// session launches the plugin for a session connection and serves the
+// session until the connection is closed.
+func (h *RemoteHost) session(conn net.Conn, header *remoteHeader) {
+	defer conn.Close()
+	enc := json.NewEncoder(conn)
+
+	if h.Token != "" &&
+		subtle.ConstantTimeCompare([]byte(h.Token), []byte(header.Token)) != 1 {
+		h.Log.Warn("rejected runner with an invalid token", "addr", conn.RemoteAddr())
+		enc.Encode(&remoteReply{Error: "invalid token"})
+		return
+	}
+
+	var idBytes [16]byte
+	if _, err := rand.Read(idBytes[:]); err != nil {
+		enc.Encode(&remoteReply{Error: err.Error()})
+		return
+	}
+
+	log := h.Log.With("plugin", header.Plugin, "addr", conn.RemoteAddr())
+	log.Info("launching plugin for runner")
+	launch, err := h.Launch(log, header.Plugin)
+	if err != nil {
+		log.Warn("error launching plugin", "err", err)
+		enc.Encode(&remoteReply{Error: err.Error()})
+		return
+	}
+	defer launch.Close()
+
+	sess, err := newRemoteHostSession(log, hex.EncodeToString(idBytes[:]), launch, enc)
+	if err != nil {
+		enc.Encode(&remoteReply{Error: err.Error()})
+		return
+	}
+	defer sess.Close()
+
+	h.mu.Lock()
+	if h.sessions == nil {
+		h.sessions = map[string]*remoteHostSession{}
+	}
+	h.sessions[sess.id] = sess
+	h.mu.Unlock()
+	defer func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		delete(h.sessions, sess.id)
+	}()
+
+	if err := sess.send(&remoteReply{
+		Session:         sess.id,
+		ProtocolVersion: launch.ProtocolVersion,
+	}); err != nil {
+		return
+	}
+
+	// Read the replies to our control messages until the runner closes
+	// the session.
+	dec := json.NewDecoder(conn)
+	for {
+		var msg remoteControl
+		if err := dec.Decode(&msg); err != nil {
+			log.Info("runner session ended")
+			return
+		}
+
+		sess.replied(&msg)
+	}
+}
+
+// remoteHostSession is a runner session of a plugin host.
+type remoteHostSession struct {
+	log hclog.Logger
+	id  string
+
+	grpcLn  *remoteChanListener
+	server  *grpc.Server
+	backend *grpc.ClientConn
+
+	encLock sync.Mutex
+	enc     *json.Encoder
+
+	mu        sync.Mutex
+	nextID    uint64
+	waiters   map[uint64]chan net.Conn
+	replies   map[uint64]chan *remoteControl
+	services  map[uint64]net.Addr
+	listeners []net.Listener
+	closed    bool
+}
+
+func newRemoteHostSession(
+	log hclog.Logger,
+	id string,
+	launch *RemoteLaunch,
+	enc *json.Encoder,
+) (*remoteHostSession, error) {
+	codec := remoteCodec{}
+	backend, err := grpc.Dial("unused",
+		grpc.WithInsecure(),
+		grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+			return net.Dial(launch.Addr.Network(), launch.Addr.String())
+		}),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &remoteHostSession{
+		log:      log,
+		id:       id,
+		grpcLn:   newRemoteChanListener(),
+		backend:  backend,
+		enc:      enc,
+		waiters:  map[uint64]chan net.Conn{},
+		replies:  map[uint64]chan *remoteControl{},
+		services: map[uint64]net.Addr{},
+	}
+	s.server = grpc.NewServer(
+		grpc.CustomCodec(codec),
+		grpc.UnknownServiceHandler(s.proxy),
+	)
+	go s.server.Serve(s.grpcLn)
+
+	return s, nil
+}
+
+// Close stops serving the session.
+func (s *remoteHostSession) Close() error {
+	s.mu.Lock()
+	s.closed = true
+	for _, ln := range s.listeners {
+		ln.Close()
+	}
+	s.mu.Unlock()
+
+	s.server.Stop()
+	return s.backend.Close()
+}
+
+func (s *remoteHostSession) send(v interface{}) error {
+	s.encLock.Lock()
+	defer s.encLock.Unlock()
+	return s.enc.Encode(v)
+}
+
+// proxy forwards a gRPC call of the runner to the plugin. This handles
+// every method since the plugin host doesn't know the services of plugins.
+func (s *remoteHostSession) proxy(srv interface{}, ss grpc.ServerStream) error {
+	method, ok := grpc.MethodFromServerStream(ss)
+	if !ok {
+		return fmt.Errorf("unknown method")
+	}
+
+	ctx, cancel := context.WithCancel(ss.Context())
+	defer cancel()
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = metadata.NewOutgoingContext(ctx, md.Copy())
+	}
+
+	cs, err := s.backend.NewStream(ctx, &grpc.StreamDesc{
+		ServerStreams: true,
+		ClientStreams: true,
+	}, method)
+	if err != nil {
+		return err
+	}
+
+	broker := method == brokerMethod
+
+	// Runner to plugin
+	go func() {
+		for {
+			f := &remoteFrame{}
+			if err := ss.RecvMsg(f); err != nil {
+				if err == io.EOF {
+					cs.CloseSend()
+				} else {
+					cancel()
+				}
+
+				return
+			}
+
+			if broker {
+				if err := s.rewriteRunnerService(f); err != nil {
+					s.log.Warn("error forwarding runner service to plugin", "err", err)
+					cancel()
+					return
+				}
+			}
+
+			if err := cs.SendMsg(f); err != nil {
+				cancel()
+				return
+			}
+		}
+	}()
+
+	// Plugin to runner
+	if md, err := cs.Header(); err == nil {
+		if err := ss.SendHeader(md); err != nil {
+			return err
+		}
+	}
+	for {
+		f := &remoteFrame{}
+		if err := cs.RecvMsg(f); err != nil {
+			ss.SetTrailer(cs.Trailer())
+			if err == io.EOF {
+				return nil
+			}
+
+			return err
+		}
+
+		if broker {
+			if err := s.rewritePluginService(f); err != nil {
+				s.log.Warn("error forwarding plugin service to runner", "err", err)
+				return err
+			}
+		}
+
+		if err := ss.SendMsg(f); err != nil {
+			return err
+		}
+	}
+}
+
+// rewriteRunnerService rewrites a service the runner announced to the
+// plugin on the broker. We listen locally for the plugin and forward each
+// connection to the runner with a "dial" control message.
+func (s *remoteHostSession) rewriteRunnerService(f *remoteFrame) error {
+	info, err := decodeConnInfo(f.data)
+	if err != nil {
+		return err
+	}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		ln.Close()
+		return fmt.Errorf("session closed")
+	}
+	s.listeners = append(s.listeners, ln)
+	s.mu.Unlock()
+
+	target := &remoteControl{Op: remoteDial, Network: info.Network, Address: info.Address}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+
+			go s.dialRunner(conn, target)
+		}
+	}()
+
+	info.Network = ln.Addr().Network()
+	info.Address = ln.Addr().String()
+	f.data = info.encode()
+	return nil
+}
+
+// dialRunner asks the runner to connect to its service and forwards the
+// connection of the plugin to it.
+func (s *remoteHostSession) dialRunner(conn net.Conn, target *remoteControl) {
+	s.mu.Lock()
+	s.nextID++
+	msg := *target
+	msg.ID = s.nextID
+	ch := make(chan net.Conn, 1)
+	s.waiters[msg.ID] = ch
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.waiters, msg.ID)
+	}()
+
+	if err := s.send(&msg); err != nil {
+		conn.Close()
+		return
+	}
+
+	select {
+	case remote := <-ch:
+		remotePipe(conn, remote)
+
+	case <-time.After(30 * time.Second):
+		s.log.Warn("runner didn't connect to forward a service", "address", target.Address)
+		conn.Close()
+	}
+}
+
+// dialed is called for a "dial" connection of the runner.
+func (s *remoteHostSession) dialed(id uint64, conn net.Conn) {
+	s.mu.Lock()
+	ch, ok := s.waiters[id]
+	delete(s.waiters, id)
+	s.mu.Unlock()
+	if !ok {
+		conn.Close()
+		return
+	}
+
+	ch <- conn
+}
+
+// rewritePluginService rewrites a service the plugin announced to the
+// runner on the broker. The runner listens locally and forwards each
+// connection with a "connect" connection.
+func (s *remoteHostSession) rewritePluginService(f *remoteFrame) error {
+	info, err := decodeConnInfo(f.data)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.nextID++
+	id := s.nextID
+	s.services[id] = &remoteAddr{network: info.Network, address: info.Address}
+	ch := make(chan *remoteControl, 1)
+	s.replies[id] = ch
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.replies, id)
+	}()
+
+	if err := s.send(&remoteControl{Op: remoteListen, ID: id}); err != nil {
+		return err
+	}
+
+	var reply *remoteControl
+	select {
+	case reply = <-ch:
+	case <-time.After(30 * time.Second):
+		return fmt.Errorf("timeout waiting for the runner to listen")
+	}
+	if reply.Address == "" {
+		return fmt.Errorf("runner couldn't listen for the plugin service")
+	}
+
+	info.Network = reply.Network
+	info.Address = reply.Address
+	f.data = info.encode()
+	return nil
+}
+
+// replied is called for a control message of the runner.
+func (s *remoteHostSession) replied(msg *remoteControl) {
+	s.mu.Lock()
+	ch, ok := s.replies[msg.ID]
+	s.mu.Unlock()
+	if ok {
+		ch <- msg
+	}
+}
+
+// connect is called for a "connect" connection of the runner, which is
+// forwarded to a service of the plugin.
+func (s *remoteHostSession) connect(id uint64, conn net.Conn) {
+	s.mu.Lock()
+	addr, ok := s.services[id]
+	s.mu.Unlock()
+	if !ok {
+		conn.Close()
+		return
+	}
+
+	local, err := net.DialTimeout(addr.Network(), addr.String(), 10*time.Second)
+	if err != nil {
+		s.log.Warn("error connecting to plugin service", "address", addr.String(), "err", err)
+		conn.Close()
+		return
+	}
+
+	remotePipe(conn, local)
+}
+
+// remoteConnInfo is the ConnInfo message of the go-plugin broker. We decode
+// it manually since the go-plugin protobufs are internal. Fields we don't
+// know are kept as is.
+type remoteConnInfo struct {
+	ServiceID uint32
+	Network   string
+	Address   string
+	unknown   []byte
+}
+
+func decodeConnInfo(b []byte) (*remoteConnInfo, error) {
+	var info remoteConnInfo
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+
+		var m int
+		switch {
+		case num == 1 && typ == protowire.VarintType:
+			var v uint64
+			v, m = protowire.ConsumeVarint(b[n:])
+			info.ServiceID = uint32(v)
+
+		case num == 2 && typ == protowire.BytesType:
+			info.Network, m = protowire.ConsumeString(b[n:])
+
+		case num == 3 && typ == protowire.BytesType:
+			info.Address, m = protowire.ConsumeString(b[n:])
+
+		default:
+			m = protowire.ConsumeFieldValue(num, typ, b[n:])
+			if m >= 0 {
+				info.unknown = append(info.unknown, b[:n+m]...)
+			}
+		}
+		if m < 0 {
+			return nil, protowire.ParseError(m)
+		}
+
+		b = b[n+m:]
+	}
+
+	return &info, nil
+}
+
+func (info *remoteConnInfo) encode() []byte {
+	var b []byte
+	if info.ServiceID != 0 {
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(info.ServiceID))
+	}
+	if info.Network != "" {
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, info.Network)
+	}
+	if info.Address != "" {
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		b = protowire.AppendString(b, info.Address)
+	}
+
+	return append(b, info.unknown...)
+}
+
+// remoteFrame is a gRPC message that is forwarded without decoding it.
+type remoteFrame struct {
+	data []byte
+}
+
+// remoteCodec is the codec for remoteFrame. It implements both the
+// encoding.Codec interface for clients and the grpc.Codec interface for
+// the server.
+type remoteCodec struct{}
+
+func (remoteCodec) Marshal(v interface{}) ([]byte, error) {
+	f, ok := v.(*remoteFrame)
+	if !ok {
+		return nil, fmt.Errorf("unexpected message type %T", v)
+	}
+
+	return f.data, nil
+}
+
+func (remoteCodec) Unmarshal(data []byte, v interface{}) error {
+	f, ok := v.(*remoteFrame)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", v)
+	}
+
+	f.data = append([]byte(nil), data...)
+	return nil
+}
+
+func (remoteCodec) Name() string   { return "proto" }
+func (remoteCodec) String() string { return "proto" }
+
+// remoteBufConn is a connection that is read through a buffered reader.
+type remoteBufConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *remoteBufConn) Read(p []byte) (int, error) {
+	return c.r.Read(p)
+}
+
+// remoteChanListener is a net.Listener for connections that are accepted
+// elsewhere, which is how the gRPC server of a session gets the "grpc"
+// connections of the runner.
+type remoteChanListener struct {
+	ch     chan net.Conn
+	once   sync.Once
+	closed chan struct{}
+}
+
+func newRemoteChanListener() *remoteChanListener {
+	return &remoteChanListener{
+		ch:     make(chan net.Conn),
+		closed: make(chan struct{}),
+	}
+}
+
+func (l *remoteChanListener) push(conn net.Conn) {
+	select {
+	case l.ch <- conn:
+	case <-l.closed:
+		conn.Close()
+	}
+}
+
+func (l *remoteChanListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.ch:
+		return conn, nil
+	case <-l.closed:
+		return nil, fmt.Errorf("listener closed")
+	}
+}
+
+func (l *remoteChanListener) Close() error {
+	l.once.Do(func() { close(l.closed) })
+	return nil
+}
+
+func (l *remoteChanListener) Addr() net.Addr {
+	return &remoteAddr{network: "remote", address: "remote"}
+}
+
+// remoteAddr is a net.Addr for a network and address.
+type remoteAddr struct {
+	network string
+	address string
+}
+
+func (a *remoteAddr) Network() string { return a.network }
+func (a *remoteAddr) String() string  { return a.address }
@@ -0,0 +1,190 @@
+package plugin
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestRemoteHost(t *testing.T) {
+	log := hclog.L()
+
+	// The "plugin" is a gRPC server with the health service.
+	pluginLn, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	pluginSrv := grpc.NewServer()
+	healthpb.RegisterHealthServer(pluginSrv, health.NewServer())
+	go pluginSrv.Serve(pluginLn)
+	defer pluginSrv.Stop()
+
+	host := &RemoteHost{
+		Log:   log,
+		Token: "secret",
+		Launch: func(log hclog.Logger, name string) (*RemoteLaunch, error) {
+			if name != "fake" {
+				return nil, fmt.Errorf("plugin %q is not hosted", name)
+			}
+
+			return &RemoteLaunch{
+				Addr:            pluginLn.Addr(),
+				ProtocolVersion: 1,
+				Close:           func() {},
+			}, nil
+		},
+	}
+	hostLn, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go host.Serve(hostLn)
+	defer hostLn.Close()
+
+	cfg := &RemoteConfig{
+		Name:    "fake",
+		Address: hostLn.Addr().String(),
+		Token:   "secret",
+	}
+
+	t.Run("calls are forwarded to the plugin", func(t *testing.T) {
+		require := require.New(t)
+
+		sess, err := dialRemote(log, cfg)
+		require.NoError(err)
+		defer sess.Close()
+		require.Equal(1, sess.protocolVersion)
+
+		conn, err := grpc.Dial(sess.ln.Addr().String(), grpc.WithInsecure())
+		require.NoError(err)
+		defer conn.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+		require.NoError(err)
+		require.Equal(healthpb.HealthCheckResponse_SERVING, resp.Status)
+	})
+
+	t.Run("broker services are forwarded", func(t *testing.T) {
+		require := require.New(t)
+
+		sess, err := dialRemote(log, cfg)
+		require.NoError(err)
+		defer sess.Close()
+
+		host.mu.Lock()
+		hostSess := host.sessions[sess.id]
+		host.mu.Unlock()
+		require.NotNil(hostSess)
+
+		// A service of the runner that the plugin connects to.
+		runnerSvc := testEchoServer(t, "runner")
+		f := &remoteFrame{data: (&remoteConnInfo{
+			ServiceID: 1,
+			Network:   "tcp",
+			Address:   runnerSvc.String(),
+		}).encode()}
+		require.NoError(hostSess.rewriteRunnerService(f))
+		info, err := decodeConnInfo(f.data)
+		require.NoError(err)
+		require.EqualValues(1, info.ServiceID)
+		require.NotEqual(runnerSvc.String(), info.Address)
+		require.Equal("runner", testEcho(t, info.Network, info.Address))
+
+		// A service of the plugin that the runner connects to.
+		pluginSvc := testEchoServer(t, "plugin")
+		f = &remoteFrame{data: (&remoteConnInfo{
+			ServiceID: 2,
+			Network:   "tcp",
+			Address:   pluginSvc.String(),
+		}).encode()}
+		require.NoError(hostSess.rewritePluginService(f))
+		info, err = decodeConnInfo(f.data)
+		require.NoError(err)
+		require.EqualValues(2, info.ServiceID)
+		require.NotEqual(pluginSvc.String(), info.Address)
+		require.Equal("plugin", testEcho(t, info.Network, info.Address))
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		require := require.New(t)
+
+		cfg := *cfg
+		cfg.Token = "nope"
+		_, err := dialRemote(log, &cfg)
+		require.Error(err)
+		require.Contains(err.Error(), "invalid token")
+	})
+
+	t.Run("plugin isn't hosted", func(t *testing.T) {
+		require := require.New(t)
+
+		cfg := *cfg
+		cfg.Name = "other"
+		_, err := dialRemote(log, &cfg)
+		require.Error(err)
+		require.Contains(err.Error(), "not hosted")
+	})
+}
+
+func TestConnInfo(t *testing.T) {
+	require := require.New(t)
+
+	info := &remoteConnInfo{
+		ServiceID: 42,
+		Network:   "unix",
+		Address:   "/tmp/plugin123",
+	}
+
+	// Unknown fields are kept.
+	data := append(info.encode(), 0x20, 0x01)
+	decoded, err := decodeConnInfo(data)
+	require.NoError(err)
+	require.EqualValues(42, decoded.ServiceID)
+	require.Equal("unix", decoded.Network)
+	require.Equal("/tmp/plugin123", decoded.Address)
+	require.Equal(data, decoded.encode())
+}
+
+// testEchoServer starts a server that writes the message to every
+// connection and returns its address.
+func testEchoServer(t *testing.T, msg string) net.Addr {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+
+			fmt.Fprintln(conn, msg)
+			conn.Close()
+		}
+	}()
+
+	return ln.Addr()
+}
+
+func testEcho(t *testing.T, network, address string) string {
+	t.Helper()
+
+	conn, err := net.DialTimeout(network, address, 10*time.Second)
+	require.NoError(t, err)
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	require.NoError(t, err)
+	return line[:len(line)-1]
+}
@@ -0,0 +1,71 @@
+package plugin
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	"github.com/hashicorp/waypoint-plugin-sdk/docs"
+)
+
+// Schemas returns the documentation of builtin plugins, which lists the
+// fields of their configuration. Plugins are launched the first time their
+// documentation is requested and the documentation is cached, since it
+// doesn't change for the builtin plugins of this binary.
+type Schemas struct {
+	log hclog.Logger
+
+	mu    sync.Mutex
+	cache map[string]*schemaResult
+}
+
+type schemaResult struct {
+	doc *docs.Documentation
+	err error
+}
+
+// NewSchemas returns a new Schemas.
+func NewSchemas(log hclog.Logger) *Schemas {
+	return &Schemas{
+		log:   log,
+		cache: map[string]*schemaResult{},
+	}
+}
+
+// Get returns the documentation of the plugin with the given name for the
+// component type. This returns nil if the plugin isn't a builtin plugin.
+func (s *Schemas) Get(typ component.Type, name string) (*docs.Documentation, error) {
+	if _, ok := Builtins[name]; !ok {
+		return nil, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := typ.String() + "/" + name
+	if r, ok := s.cache[key]; ok {
+		return r.doc, r.err
+	}
+
+	doc, err := s.load(typ, name)
+	s.cache[key] = &schemaResult{doc: doc, err: err}
+	return doc, err
+}
+
+func (s *Schemas) load(typ component.Type, name string) (*docs.Documentation, error) {
+	log := s.log.With("type", typ.String(), "name", name)
+	log.Debug("launching plugin to read its schema")
+
+	f := BuiltinFactory(name, typ).(func(hclog.Logger) (interface{}, error))
+	raw, err := f(log)
+	if err != nil {
+		return nil, fmt.Errorf("error launching plugin %q: %s", name, err)
+	}
+
+	inst := raw.(*Instance)
+	defer inst.Close()
+
+	return component.Documentation(inst.Component)
+}
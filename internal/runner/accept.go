@@ -9,11 +9,15 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-hclog"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/telemetry"
 	"github.com/pkg/errors"
 )
 
@@ -270,11 +274,30 @@ func (r *Runner) accept(ctx context.Context, id string) error {
 		}
 	}()
 
+	// Continue the trace of the call that queued the job so that the job
+	// and the plugin calls it makes show up in the same trace.
+	job := assignment.Assignment.Job
+	jobCtx, span := telemetry.Tracer().Start(
+		telemetry.Extract(ctx, job.TraceContext), "runner.job",
+		trace.WithAttributes(
+			attribute.String("waypoint.job.id", job.Id),
+			attribute.String("waypoint.job.operation", fmt.Sprintf("%T", job.Operation)),
+			attribute.String("waypoint.project", job.Application.GetProject()),
+			attribute.String("waypoint.application", job.Application.GetApplication()),
+			attribute.String("waypoint.workspace", job.Workspace.GetWorkspace()),
+		),
+	)
+
 	// The job stream setup is done. Actually run the job, download any
 	// data necessary, setup the core, etc
 	log.Info("starting job execution")
-	result, err := r.prepareAndExecuteJob(ctx, log, ui, &sendMutex, client, assignment.Assignment.Job)
+	result, err := r.prepareAndExecuteJob(jobCtx, log, ui, &sendMutex, client, job)
 	log.Debug("job finished", "error", err)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+	}
+	span.End()
 
 	// We won't output anything else to the UI anymore.
 	if ui, ok := ui.(*runnerUI); ok {
@@ -358,6 +381,8 @@ func (r *Runner) prepareAndExecuteJob(
 		return r.executePollOp(ctx, log, ui, job)
 	case *pb.Job_StartTask:
 		return r.executeStartTaskOp(ctx, log, ui, job)
+	case *pb.Job_StopTask:
+		return r.executeStopTaskOp(ctx, log, ui, job)
 	}
 
 	// We need to get our data source next prior to executing.
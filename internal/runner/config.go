@@ -4,8 +4,13 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
 
 	"github.com/hashicorp/waypoint/internal/clierrors"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
@@ -59,6 +64,10 @@ func (r *Runner) handleConfig(c *pb.RunnerConfig) {
 			// that. This lets unset runtime config get reset back to the
 			// original process start env.
 			for _, v := range old.ConfigVars {
+				if v.NameIsPath {
+					continue
+				}
+
 				if _, ok := env[v.Name]; !ok {
 					env[v.Name] = ""
 				}
@@ -67,6 +76,10 @@ func (r *Runner) handleConfig(c *pb.RunnerConfig) {
 
 		// Set the config variables
 		for _, v := range c.ConfigVars {
+			if v.NameIsPath {
+				continue
+			}
+
 			static, ok := v.Value.(*pb.ConfigVar_Static)
 			if !ok {
 				r.logger.Warn("unknown value type for config var, ignoring",
@@ -101,6 +114,63 @@ func (r *Runner) handleConfig(c *pb.RunnerConfig) {
 			}
 		}
 	}
+
+	// Handle config file changes
+	{
+		files := configFiles(r.logger, c)
+		oldFiles := configFiles(r.logger, old)
+
+		// Delete the files of the previous config that are no longer set.
+		for path := range oldFiles {
+			if _, ok := files[path]; ok {
+				continue
+			}
+
+			r.logger.Info("deleting config file", "path", path)
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				r.logger.Warn("error deleting config file", "path", path, "err", err)
+			}
+		}
+
+		// Write the files that changed. Config files usually contain
+		// credentials so only the runner can read them.
+		for path, data := range files {
+			if prev, ok := oldFiles[path]; ok && prev == data {
+				continue
+			}
+
+			r.logger.Info("writing config file", "path", path)
+			if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+				r.logger.Warn("error creating config file directory", "path", path, "err", err)
+				continue
+			}
+			if err := ioutil.WriteFile(path, []byte(data), 0600); err != nil {
+				r.logger.Warn("error writing config file", "path", path, "err", err)
+			}
+		}
+	}
+}
+
+// configFiles returns the contents of the config files of the config by
+// path. The config may be nil.
+func configFiles(log hclog.Logger, c *pb.RunnerConfig) map[string]string {
+	result := map[string]string{}
+	for _, v := range c.GetConfigVars() {
+		if !v.NameIsPath {
+			continue
+		}
+
+		static, ok := v.Value.(*pb.ConfigVar_Static)
+		if !ok {
+			log.Warn("unknown value type for config file, ignoring",
+				"type", fmt.Sprintf("%T", v.Value))
+			continue
+		}
+
+		result[v.Name] = static.Static
+	}
+
+	return result
 }
 
 func (r *Runner) recvConfig(
@@ -133,3 +203,43 @@ func (r *Runner) recvConfig(
 		ch <- resp.Config
 	}
 }
+
+// heartbeatInterval is how often the runner sends heartbeats to the
+// server. This must be well below the server's heartbeat timeout.
+const heartbeatInterval = 10 * time.Second
+
+// sendHeartbeats sits in a goroutine sending heartbeats to the server so
+// that the server knows that the runner is alive.
+func (r *Runner) sendHeartbeats(
+	ctx context.Context,
+	client pb.Waypoint_RunnerConfigClient,
+) {
+	log := r.logger.Named("heartbeat")
+	defer log.Trace("exiting heartbeat goroutine")
+
+	tick := time.NewTicker(heartbeatInterval)
+	defer tick.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-tick.C:
+		}
+
+		err := client.Send(&pb.RunnerConfigRequest{
+			Event: &pb.RunnerConfigRequest_Heartbeat_{
+				Heartbeat: &pb.RunnerConfigRequest_Heartbeat{},
+			},
+		})
+		if err != nil {
+			if err == io.EOF || clierrors.IsCanceled(err) {
+				return
+			}
+
+			log.Warn("error sending heartbeat, exiting", "err", err)
+			return
+		}
+	}
+}
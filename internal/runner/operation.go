@@ -2,6 +2,7 @@ package runner
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -9,11 +10,13 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"runtime"
 	"strings"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-multierror"
 	goplugin "github.com/hashicorp/go-plugin"
+	goversion "github.com/hashicorp/go-version"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -27,6 +30,7 @@ import (
 	"github.com/hashicorp/waypoint/internal/factory"
 	"github.com/hashicorp/waypoint/internal/plugin"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/version"
 )
 
 // executeJob executes an assigned job. This will source the data (if necessary),
@@ -112,6 +116,14 @@ func (r *Runner) executeJob(
 		return nil, err
 	}
 
+	// Replace the deploy stages if we were asked to, such as for
+	// "waypoint up -local-compose". We do this after validation since the
+	// configuration must be valid as written.
+	if r.deployOverride != nil {
+		log.Debug("overriding deploy stage", "plugin", r.deployOverride.Type)
+		cfg.OverrideDeploy(r.deployOverride)
+	}
+
 	// Setup our project data directory.
 	projDir, err := datadir.NewProject(filepath.Join(wd, ".waypoint"))
 	if err != nil {
@@ -119,7 +131,7 @@ func (r *Runner) executeJob(
 	}
 
 	// Find all our plugins
-	factories, err := r.pluginFactories(log, cfg.Plugins(), wd)
+	factories, err := r.pluginFactories(ctx, log, cfg.Plugins(), wd)
 	if err != nil {
 		return nil, err
 	}
@@ -127,13 +139,14 @@ func (r *Runner) executeJob(
 	// Here we'll load our values from auto vars files and the server/UI, and
 	// combine them with any values set on the job
 	// The order values are added to our final pbVars slice is the order
-	// of precedence
+	// of precedence. Server values for the job's workspace override the
+	// other server values.
 	vcsVars, diags := variables.LoadAutoFiles(wd)
 	if diags.HasErrors() {
 		return nil, diags
 	}
 
-	pbVars := resp.Project.GetVariables()
+	pbVars := variables.ServerValues(resp.Project.GetVariables(), job.Workspace.Workspace)
 	pbVars = append(pbVars, vcsVars...)
 	pbVars = append(pbVars, job.Variables...)
 
@@ -143,6 +156,12 @@ func (r *Runner) executeJob(
 		return nil, diags
 	}
 
+	// Read the variables that come from remote sources such as Terraform
+	// Cloud, now that we know which have no other value set.
+	if diags := variables.LoadRemoteValues(ctx, inputVars, cfg.InputVariables, log); diags.HasErrors() {
+		return nil, diags
+	}
+
 	// Build our job info
 	jobInfo := &component.JobInfo{
 		Id:    job.Id,
@@ -228,12 +247,16 @@ func (r *Runner) executeJob(
 	case *pb.Job_StatusReport:
 		return r.executeStatusReportOp(ctx, log, job, project)
 
+	case *pb.Job_RunCommand:
+		return r.executeRunCommandOp(ctx, job, project)
+
 	default:
 		return nil, status.Errorf(codes.Aborted, "unknown operation %T", job.Operation)
 	}
 }
 
 func (r *Runner) pluginFactories(
+	ctx context.Context,
 	log hclog.Logger,
 	plugins []*configpkg.Plugin,
 	wd string,
@@ -278,11 +301,66 @@ func (r *Runner) pluginFactories(
 			continue
 		}
 
+		// If the plugin runs on a plugin host, we connect to it instead of
+		// launching the plugin.
+		if host := pluginCfg.Host; host != nil {
+			plog.Debug("plugin runs on a plugin host", "address", host.Address)
+			remoteCfg := &plugin.RemoteConfig{
+				Name:    pluginCfg.Name,
+				Address: host.Address,
+				Token:   host.Token,
+			}
+			if remoteCfg.Token == "" {
+				remoteCfg.Token = os.Getenv("WAYPOINT_PLUGIN_HOST_TOKEN")
+			}
+			if host.TLS {
+				remoteCfg.TLS = &tls.Config{InsecureSkipVerify: host.TLSSkipVerify}
+			}
+
+			for _, t := range pluginCfg.Types() {
+				result[t].Register(pluginCfg.Name, plugin.RemoteFactory(remoteCfg, t))
+			}
+			continue
+		}
+
+		// If the version is constrained, the plugin comes from the plugin
+		// registry of the server so that every runner uses the same binary.
+		paths := pluginPaths
+		checksum := pluginCfg.Checksum
+		if pluginCfg.Version != "" {
+			dir, binary, err := r.registryPlugin(ctx, plog, pluginCfg)
+			if err != nil {
+				plog.Warn("error fetching plugin from registry", "err", err)
+				perr = multierror.Append(perr, err)
+				continue
+			}
+
+			// A nil binary means the builtin plugin matches the constraint.
+			if binary == nil {
+				plog.Debug("builtin plugin matches version constraint")
+				for _, t := range pluginCfg.Types() {
+					result[t].Register(pluginCfg.Name, plugin.BuiltinFactory(pluginCfg.Name, t))
+				}
+
+				continue
+			}
+
+			if checksum != "" && !strings.EqualFold(checksum, binary.Checksum) {
+				perr = multierror.Append(perr, fmt.Errorf(
+					"plugin %q version %s in the registry doesn't match the checksum %s",
+					pluginCfg.Name, binary.Version, checksum))
+				continue
+			}
+
+			paths = []string{dir}
+			checksum = binary.Checksum
+		}
+
 		// Find our plugin.
 		cmd, err := plugin.Discover(&plugin.Config{
 			Name:     pluginCfg.Name,
-			Checksum: pluginCfg.Checksum,
-		}, pluginPaths)
+			Checksum: checksum,
+		}, paths)
 		if err != nil {
 			plog.Warn("error searching for plugin", "err", err)
 			perr = multierror.Append(perr, err)
@@ -318,6 +396,55 @@ func (r *Runner) pluginFactories(
 	return result, perr
 }
 
+// registryPlugin downloads the newest binary of the plugin in the plugin
+// registry that matches its version constraint and returns the directory
+// with the binary. If the registry has no matching binary but the plugin
+// is builtin and the version of Waypoint matches, this returns a nil
+// binary to use the builtin plugin.
+func (r *Runner) registryPlugin(
+	ctx context.Context,
+	log hclog.Logger,
+	cfg *configpkg.Plugin,
+) (string, *pb.PluginBinary, error) {
+	binary, err := plugin.ResolveBinary(ctx, r.client, cfg.Name, cfg.Version)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if binary == nil {
+		if _, ok := plugin.Builtins[cfg.Name]; ok {
+			constraints, err := goversion.NewConstraint(cfg.Version)
+			if err != nil {
+				return "", nil, err
+			}
+
+			if v, err := goversion.NewVersion(version.Version); err == nil && constraints.Check(v) {
+				return "", nil, nil
+			}
+		}
+
+		return "", nil, fmt.Errorf(
+			"no version of plugin %q matching %q for %s/%s is in the plugin registry",
+			cfg.Name, cfg.Version, runtime.GOOS, runtime.GOARCH)
+	}
+
+	cacheDir := r.pluginCacheDir
+	if cacheDir == "" {
+		cacheDir, err = plugin.DefaultCacheDir()
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	log.Debug("using plugin from registry", "version", binary.Version)
+	dir, err := plugin.FetchBinary(ctx, log, r.client, binary, cacheDir)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return dir, binary, nil
+}
+
 // parse information on reattaching to plugins out of a
 // JSON-encoded environment variable.
 func parseReattachPlugins(in string) (map[string]*goplugin.ReattachConfig, error) {
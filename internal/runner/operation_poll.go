@@ -90,6 +90,9 @@ func (r *Runner) executePollOp(
 		Operation: &pb.Job_Up{
 			Up: &pb.Job_UpOp{},
 		},
+
+		// Polling is in the background so interactive jobs go first.
+		Priority: pb.Job_LOW,
 	}
 
 	// If we're ignoring, we change the job to a noop job. This will
@@ -127,6 +130,7 @@ func (r *Runner) executePollOp(
 			Workspace:           jobTemplate.Workspace,
 			DataSource:          jobTemplate.DataSource,
 			DataSourceOverrides: jobTemplate.DataSourceOverrides,
+			Priority:            jobTemplate.Priority,
 
 			// Doing a plain old "up"
 			Operation: &pb.Job_QueueProject{
@@ -33,41 +33,123 @@ func (r *Runner) executeReleaseOp(
 	// Our target deployment
 	target := op.Release.Deployment
 
-	// Get our last release. If its the same generation, then release is
+	if op.Release.Percentage > 100 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"release percentage must be between 0 and 100, got %d", op.Release.Percentage)
+	}
+
+	// Get our last release.
+	latest, err := r.client.GetLatestRelease(ctx, &pb.GetLatestReleaseRequest{
+		Application: app.Ref(),
+		Workspace:   project.WorkspaceRef(),
+		LoadDetails: pb.Release_DEPLOYMENT,
+	})
+	if status.Code(err) == codes.NotFound {
+		err = nil
+		latest = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// activeCanary is true if the last release is a canary that hasn't
+	// been promoted or aborted yet.
+	activeCanary := latest != nil && latest.Canary != nil &&
+		latest.Canary.Phase == pb.Release_Canary_ACTIVE
+
+	// If this is a canary, the previously released deployment keeps the
+	// rest of the traffic. If we're changing the percentage of an active
+	// canary, that is still the deployment from before the canary.
+	var canary *pb.Release_Canary
+	if p := op.Release.Percentage; p > 0 && p < 100 {
+		if latest == nil {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"a canary release requires a released deployment to split traffic with")
+		}
+
+		previous := latest.DeploymentId
+		if activeCanary {
+			previous = latest.Canary.PreviousDeploymentId
+		}
+		if previous == target.Id {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"the deployment is already released to all traffic")
+		}
+
+		canary = &pb.Release_Canary{
+			Percentage:           p,
+			PreviousDeploymentId: previous,
+			Phase:                pb.Release_Canary_ACTIVE,
+		}
+	}
+
+	// If the last release is the same generation, then release is
 	// a no-op and return this value. We only do this if we have a generation.
-	// We SHOULD but if we have an old client, its possible we don't.
+	// We SHOULD but if we have an old client, its possible we don't. Canaries
+	// always release since they change the traffic split.
 	var release *pb.Release
-	if target.Generation != nil {
-		resp, err := r.client.GetLatestRelease(ctx, &pb.GetLatestReleaseRequest{
-			Application: app.Ref(),
-			Workspace:   project.WorkspaceRef(),
-			LoadDetails: pb.Release_DEPLOYMENT,
-		})
-		if status.Code(err) == codes.NotFound {
-			err = nil
-			resp = nil
+	if target.Generation != nil && canary == nil && !activeCanary && latest != nil {
+		if latest.Preload != nil && latest.Preload.Deployment != nil {
+			d := latest.Preload.Deployment
+			if d.Generation != nil && d.Generation.Id == target.Generation.Id {
+				release = latest
+			}
+		}
+	}
+
+	// For blue/green releases, the deployment must be healthy before any
+	// traffic is switched to it.
+	var verifier *releaseVerifier
+	var rollbackId string
+	if op.Release.Verify != nil && release == nil {
+		if canary != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"canary releases can't be verified")
 		}
+
+		// The deployment to switch traffic back to if verification fails.
+		// If a canary is active, that is the deployment it split with.
+		if latest != nil {
+			rollbackId = latest.DeploymentId
+			if activeCanary {
+				rollbackId = latest.Canary.PreviousDeploymentId
+			}
+		}
+
+		verifier, err = newReleaseVerifier(op.Release.Verify)
 		if err != nil {
 			return nil, err
 		}
-		if resp != nil {
-			if resp.Preload != nil && resp.Preload.Deployment != nil {
-				d := resp.Preload.Deployment
-				if d.Generation != nil && d.Generation.Id == target.Generation.Id {
-					release = resp
-				}
-			}
+
+		app.UI.Output("Verifying deployment health...", terminal.WithHeaderStyle())
+		if err := verifier.Wait(ctx, log, app.UI, "deployment",
+			func(ctx context.Context) (*pb.StatusReport, error) {
+				return app.DeploymentStatusReport(ctx, target)
+			},
+		); err != nil {
+			return nil, err
 		}
 	}
 
 	// If we're pruning, then let's query the deployments we want to prune
-	// ahead of time so that fails fast.
+	// ahead of time so that fails fast. We never prune for canaries since
+	// the previous deployment is still receiving traffic.
+	//
+	// If the app sets max_unreleased, pruning is always enforced. A retain
+	// count from the job still takes precedence.
+	maxUnreleased, err := app.DeployMaxUnreleased()
+	if err != nil {
+		return nil, err
+	}
+
 	var pruneDeploys []*pb.Deployment
-	if op.Release.Prune {
+	if (op.Release.Prune || maxUnreleased >= 0) && canary == nil {
 		// Determine the number of deployments to keep around.
 		retain := 2
 		if op.Release.PruneRetainOverride {
 			retain = int(op.Release.PruneRetain) + 1 // add 1 to make this the total number
+		} else if maxUnreleased >= 0 {
+			retain = maxUnreleased + 1
 		}
 
 		log.Debug("pruning requested, gathering deployments to prune",
@@ -127,10 +209,41 @@ func (r *Runner) executeReleaseOp(
 
 	// Do the release
 	if release == nil {
-		release, _, err = app.Release(ctx, op.Release.Deployment)
+		release, _, err = app.Release(ctx, op.Release.Deployment, canary)
 		if err != nil {
 			return nil, err
 		}
+
+		// A full release ends an active canary. The canary is promoted if
+		// its deployment now gets all traffic and aborted otherwise.
+		if canary == nil && activeCanary {
+			latest.Canary.Phase = pb.Release_Canary_ABORTED
+			if latest.DeploymentId == target.Id {
+				latest.Canary.Phase = pb.Release_Canary_PROMOTED
+			}
+
+			log.Info("ending canary release",
+				"release", latest.Id, "phase", latest.Canary.Phase.String())
+			latest.Preload = nil
+			if _, err := r.client.UpsertRelease(ctx, &pb.UpsertReleaseRequest{
+				Release: latest,
+			}); err != nil {
+				return nil, err
+			}
+		}
+
+		// Verify the release now that traffic is switched. If it doesn't
+		// become healthy we switch traffic back to the previous release.
+		if verifier != nil {
+			app.UI.Output("Verifying release health...", terminal.WithHeaderStyle())
+			if err := verifier.Wait(ctx, log, app.UI, "release",
+				func(ctx context.Context) (*pb.StatusReport, error) {
+					return app.ReleaseStatusReport(ctx, release)
+				},
+			); err != nil {
+				return nil, r.rollbackRelease(ctx, log, app, rollbackId, release, err)
+			}
+		}
 	} else {
 		log.Info("not releasing since last released deploy has a matching generation",
 			"gen", target.Generation.Id)
@@ -0,0 +1,295 @@
+package runner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	componentmocks "github.com/hashicorp/waypoint-plugin-sdk/component/mocks"
+	canarypb "github.com/hashicorp/waypoint/builtin/canary"
+	configpkg "github.com/hashicorp/waypoint/internal/config"
+	"github.com/hashicorp/waypoint/internal/core"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestRunnerReleaseOp_canary(t *testing.T) {
+	t.Run("percentage flows through and pruning is skipped", func(t *testing.T) {
+		require := require.New(t)
+		h := testReleaseHarness(t)
+
+		var deploys []*pb.Deployment
+		for i := 0; i < 4; i++ {
+			deploys = append(deploys, h.Deploy(t))
+		}
+		h.Release(t, deploys[2], nil)
+
+		result, err := h.Run(t, &pb.Job_ReleaseOp{
+			Deployment: deploys[3],
+			Prune:      true,
+			Percentage: 10,
+		})
+		require.NoError(err)
+		require.Equal(uint32(10), h.Percentage)
+
+		canary := result.Release.Release.Canary
+		require.NotNil(canary)
+		require.Equal(uint32(10), canary.Percentage)
+		require.Equal(deploys[2].Id, canary.PreviousDeploymentId)
+		require.Equal(pb.Release_Canary_ACTIVE, canary.Phase)
+
+		h.Platform.Destroyer.AssertNotCalled(t, "DestroyFunc")
+	})
+
+	t.Run("full releases prune", func(t *testing.T) {
+		require := require.New(t)
+		h := testReleaseHarness(t)
+
+		var deploys []*pb.Deployment
+		for i := 0; i < 4; i++ {
+			deploys = append(deploys, h.Deploy(t))
+		}
+		h.Release(t, deploys[2], nil)
+
+		result, err := h.Run(t, &pb.Job_ReleaseOp{
+			Deployment: deploys[3],
+			Prune:      true,
+		})
+		require.NoError(err)
+		require.Nil(result.Release.Release.Canary)
+		require.Equal(uint32(0), h.Percentage)
+
+		h.Platform.Destroyer.AssertCalled(t, "DestroyFunc")
+	})
+
+	t.Run("changing the percentage keeps the previous deployment", func(t *testing.T) {
+		require := require.New(t)
+		h := testReleaseHarness(t)
+
+		d1, d2 := h.Deploy(t), h.Deploy(t)
+		h.Release(t, d1, nil)
+		h.Release(t, d2, &pb.Release_Canary{
+			Percentage:           10,
+			PreviousDeploymentId: d1.Id,
+			Phase:                pb.Release_Canary_ACTIVE,
+		})
+
+		result, err := h.Run(t, &pb.Job_ReleaseOp{
+			Deployment: d2,
+			Percentage: 50,
+		})
+		require.NoError(err)
+		require.Equal(d1.Id, result.Release.Release.Canary.PreviousDeploymentId)
+	})
+
+	t.Run("requires a released deployment", func(t *testing.T) {
+		require := require.New(t)
+		h := testReleaseHarness(t)
+
+		_, err := h.Run(t, &pb.Job_ReleaseOp{
+			Deployment: h.Deploy(t),
+			Percentage: 10,
+		})
+		require.Error(err)
+		require.Contains(err.Error(), "requires a released deployment")
+	})
+
+	t.Run("invalid percentage", func(t *testing.T) {
+		require := require.New(t)
+		h := testReleaseHarness(t)
+
+		_, err := h.Run(t, &pb.Job_ReleaseOp{
+			Deployment: h.Deploy(t),
+			Percentage: 101,
+		})
+		require.Error(err)
+		require.Contains(err.Error(), "between 0 and 100")
+	})
+}
+
+func TestRunnerReleaseOp_canaryEnd(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Promote  bool
+		Expected pb.Release_Canary_Phase
+	}{
+		{"promote", true, pb.Release_Canary_PROMOTED},
+		{"abort", false, pb.Release_Canary_ABORTED},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+			h := testReleaseHarness(t)
+			ctx := context.Background()
+
+			d1, d2 := h.Deploy(t), h.Deploy(t)
+			h.Release(t, d1, nil)
+			canary := h.Release(t, d2, &pb.Release_Canary{
+				Percentage:           10,
+				PreviousDeploymentId: d1.Id,
+				Phase:                pb.Release_Canary_ACTIVE,
+			})
+
+			// Promoting releases the canary's deployment to all traffic
+			// and aborting releases the previous one.
+			target := d1
+			if tt.Promote {
+				target = d2
+			}
+			result, err := h.Run(t, &pb.Job_ReleaseOp{Deployment: target})
+			require.NoError(err)
+			require.Nil(result.Release.Release.Canary)
+
+			release, err := h.Runner.client.GetRelease(ctx, &pb.GetReleaseRequest{
+				Ref: &pb.Ref_Operation{
+					Target: &pb.Ref_Operation_Id{Id: canary.Id},
+				},
+			})
+			require.NoError(err)
+			require.Equal(tt.Expected, release.Canary.Phase)
+		})
+	}
+}
+
+func TestRunnerReleaseOp_canaryNotSupported(t *testing.T) {
+	require := require.New(t)
+	h := testReleaseHarness(t)
+
+	// A release manager without the canary argument
+	h.ReleaseManager.Mock = mock.Mock{}
+	h.ReleaseManager.On("ReleaseFunc").Return(func() (component.Release, error) {
+		return testRelease(), nil
+	})
+
+	d1, d2 := h.Deploy(t), h.Deploy(t)
+	h.Release(t, d1, nil)
+
+	_, err := h.Run(t, &pb.Job_ReleaseOp{
+		Deployment: d2,
+		Percentage: 10,
+	})
+	require.Error(err)
+	require.Contains(err.Error(), "doesn't support canary releases")
+}
+
+// releaseHarness runs release operations against a project whose release
+// manager supports canaries.
+type releaseHarness struct {
+	Runner         *Runner
+	Project        *core.Project
+	App            *core.App
+	ReleaseManager *componentmocks.ReleaseManager
+	Platform       struct {
+		*componentmocks.Platform
+		*componentmocks.Destroyer
+	}
+
+	// Percentage is the canary percentage given to the last release.
+	Percentage uint32
+}
+
+func testReleaseHarness(t *testing.T) *releaseHarness {
+	h := &releaseHarness{
+		Runner:         TestRunner(t),
+		ReleaseManager: &componentmocks.ReleaseManager{},
+	}
+	h.Platform.Platform = &componentmocks.Platform{}
+	h.Platform.Destroyer = &componentmocks.Destroyer{}
+	h.Platform.Destroyer.On("DestroyFunc").Return(func() error { return nil })
+	h.ReleaseManager.On("ReleaseFunc").Return(func(c *canarypb.Canary) (component.Release, error) {
+		h.Percentage = c.Percentage
+		return testRelease(), nil
+	})
+
+	releaseFactory := core.TestFactory(t, component.ReleaseManagerType)
+	core.TestFactoryRegister(t, releaseFactory, "test", h.ReleaseManager)
+	platformFactory := core.TestFactory(t, component.PlatformType)
+	core.TestFactoryRegister(t, platformFactory, "test", h.Platform)
+
+	h.Project = core.TestProject(t,
+		core.WithClient(h.Runner.client),
+		core.WithConfig(configpkg.TestConfig(t, testReleaseConfig)),
+		core.WithFactory(component.ReleaseManagerType, releaseFactory),
+		core.WithFactory(component.PlatformType, platformFactory),
+	)
+	h.App = core.TestApp(t, h.Project, "test")
+
+	return h
+}
+
+// Deploy creates a successful deployment.
+func (h *releaseHarness) Deploy(t *testing.T) *pb.Deployment {
+	resp, err := h.Runner.client.UpsertDeployment(context.Background(), &pb.UpsertDeploymentRequest{
+		Deployment: serverptypes.TestValidDeployment(t, &pb.Deployment{
+			Application: h.App.Ref(),
+			Workspace:   h.Project.WorkspaceRef(),
+			State:       pb.Operation_CREATED,
+			Component: &pb.Component{
+				Type: pb.Component_PLATFORM,
+				Name: "test",
+			},
+		}),
+	})
+	require.NoError(t, err)
+
+	// Preload the artifact so that releasing doesn't query it.
+	d := resp.Deployment
+	d.Preload = &pb.Deployment_Preload{
+		Artifact: serverptypes.TestValidArtifact(t, nil),
+	}
+
+	return d
+}
+
+// Release creates a successful release of the deployment.
+func (h *releaseHarness) Release(t *testing.T, d *pb.Deployment, canary *pb.Release_Canary) *pb.Release {
+	resp, err := h.Runner.client.UpsertRelease(context.Background(), &pb.UpsertReleaseRequest{
+		Release: serverptypes.TestValidRelease(t, &pb.Release{
+			Application:  h.App.Ref(),
+			Workspace:    h.Project.WorkspaceRef(),
+			DeploymentId: d.Id,
+			State:        pb.Operation_CREATED,
+			Canary:       canary,
+		}),
+	})
+	require.NoError(t, err)
+	return resp.Release
+}
+
+// Run executes the release operation.
+func (h *releaseHarness) Run(t *testing.T, op *pb.Job_ReleaseOp) (*pb.Job_Result, error) {
+	return h.Runner.executeReleaseOp(context.Background(), hclog.L(), &pb.Job{
+		Application: h.App.Ref(),
+		Workspace:   h.Project.WorkspaceRef(),
+		Operation:   &pb.Job_Release{Release: op},
+	}, h.Project)
+}
+
+func testRelease() component.Release {
+	release := &componentmocks.Release{}
+	release.On("URL").Return("")
+	return release
+}
+
+const testReleaseConfig = `
+project = "test"
+
+app "test" {
+	build {
+		use "test" {}
+	}
+
+	deploy {
+		use "test" {}
+	}
+
+	release {
+		use "test" {}
+	}
+}
+`
@@ -0,0 +1,158 @@
+package runner
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/core"
+	"github.com/hashicorp/waypoint/internal/server"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+const (
+	defaultVerifyWindow   = 1 * time.Minute
+	defaultVerifyInterval = 10 * time.Second
+)
+
+// releaseVerifier waits for status reports to become healthy during a
+// blue/green release.
+type releaseVerifier struct {
+	Window   time.Duration
+	Interval time.Duration
+}
+
+func newReleaseVerifier(v *pb.Job_ReleaseOp_Verify) (*releaseVerifier, error) {
+	result := &releaseVerifier{
+		Window:   defaultVerifyWindow,
+		Interval: defaultVerifyInterval,
+	}
+
+	if v.Window != "" {
+		d, err := time.ParseDuration(v.Window)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid verify window %q: %s", v.Window, err)
+		}
+		result.Window = d
+	}
+
+	if v.Interval != "" {
+		d, err := time.ParseDuration(v.Interval)
+		if err != nil || d <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid verify interval %q", v.Interval)
+		}
+		result.Interval = d
+	}
+
+	return result, nil
+}
+
+// Wait runs the status report on an interval until it is healthy or the
+// window elapses. An error is returned if the health is still DOWN at the
+// end of the window or if the report has no health to verify. Any other
+// health at the end of the window is accepted with a warning.
+func (v *releaseVerifier) Wait(
+	ctx context.Context,
+	log hclog.Logger,
+	ui terminal.UI,
+	name string,
+	report func(context.Context) (*pb.StatusReport, error),
+) error {
+	deadline := time.Now().Add(v.Window)
+	for {
+		r, err := report(ctx)
+		if err != nil {
+			return err
+		}
+		if r == nil || r.Health == nil || r.Health.HealthStatus == "" {
+			return status.Errorf(codes.FailedPrecondition,
+				"the plugin for the %s doesn't generate status reports, "+
+					"so its health can't be verified", name)
+		}
+
+		health := r.Health.HealthStatus
+		log.Debug("verifying health", "target", name, "health", health)
+		switch health {
+		case "READY", "ALIVE":
+			ui.Output("The %s is healthy (%s).", name, health, terminal.WithSuccessStyle())
+			return nil
+		}
+
+		if !time.Now().Before(deadline) {
+			if health == "DOWN" {
+				return status.Errorf(codes.Aborted,
+					"the %s is still DOWN after %s: %s", name, v.Window, r.Health.HealthMessage)
+			}
+
+			ui.Output("The %s is %s after %s, continuing.", name, health, v.Window,
+				terminal.WithWarningStyle())
+			return nil
+		}
+
+		ui.Output("The %s is %s, checking again in %s...", name, health, v.Interval,
+			terminal.WithInfoStyle())
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(v.Interval):
+		}
+	}
+}
+
+// rollbackRelease switches traffic back to the previously released
+// deployment and marks the failed release as failed. The returned error is
+// always non-nil and includes the cause of the rollback.
+func (r *Runner) rollbackRelease(
+	ctx context.Context,
+	log hclog.Logger,
+	app *core.App,
+	previousId string,
+	failed *pb.Release,
+	cause error,
+) error {
+	// Mark the release as failed first so that it isn't treated as the
+	// latest successful release even if the rollback fails.
+	if failed.Status == nil {
+		failed.Status = server.NewStatus(pb.Status_RUNNING)
+	}
+	server.StatusSetError(failed.Status, cause)
+	failed.Preload = nil
+	if _, err := r.client.UpsertRelease(ctx, &pb.UpsertReleaseRequest{
+		Release: failed,
+	}); err != nil {
+		log.Warn("error marking release as failed", "release", failed.Id, "err", err)
+	}
+
+	if previousId == "" {
+		app.UI.Output("No previous release to roll back to.", terminal.WithErrorStyle())
+		return cause
+	}
+
+	deploy, err := r.client.GetDeployment(ctx, &pb.GetDeploymentRequest{
+		Ref: &pb.Ref_Operation{
+			Target: &pb.Ref_Operation_Id{Id: previousId},
+		},
+	})
+	if err != nil {
+		return status.Errorf(codes.Aborted,
+			"%s; rolling back failed: %s", status.Convert(cause).Message(), err)
+	}
+
+	log.Info("rolling back release", "deployment", deploy.Id)
+	app.UI.Output("Rolling back to deployment v%d...", deploy.Sequence,
+		terminal.WithHeaderStyle())
+	if _, _, err := app.Release(ctx, deploy, nil); err != nil {
+		return status.Errorf(codes.Aborted,
+			"%s; rolling back failed: %s", status.Convert(cause).Message(), err)
+	}
+
+	app.UI.Output("Traffic was switched back to deployment v%d.", deploy.Sequence,
+		terminal.WithWarningStyle())
+	return cause
+}
@@ -0,0 +1,85 @@
+package runner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestReleaseVerifierWait(t *testing.T) {
+	ctx := context.Background()
+	log := hclog.L()
+	ui := terminal.NonInteractiveUI(ctx)
+
+	// reports returns a report func that returns the given health values
+	// in order, repeating the last one.
+	reports := func(health ...string) func(context.Context) (*pb.StatusReport, error) {
+		return func(context.Context) (*pb.StatusReport, error) {
+			h := health[0]
+			if len(health) > 1 {
+				health = health[1:]
+			}
+
+			return &pb.StatusReport{
+				Health: &pb.StatusReport_Health{HealthStatus: h},
+			}, nil
+		}
+	}
+
+	v := &releaseVerifier{
+		Window:   50 * time.Millisecond,
+		Interval: 10 * time.Millisecond,
+	}
+
+	t.Run("becomes healthy", func(t *testing.T) {
+		require := require.New(t)
+		require.NoError(v.Wait(ctx, log, ui, "release", reports("DOWN", "DOWN", "READY")))
+	})
+
+	t.Run("still down", func(t *testing.T) {
+		require := require.New(t)
+		err := v.Wait(ctx, log, ui, "release", reports("DOWN"))
+		require.Error(err)
+		require.Equal(codes.Aborted, status.Code(err))
+	})
+
+	t.Run("partial is accepted after the window", func(t *testing.T) {
+		require := require.New(t)
+		require.NoError(v.Wait(ctx, log, ui, "release", reports("PARTIAL")))
+	})
+
+	t.Run("no status report", func(t *testing.T) {
+		require := require.New(t)
+		err := v.Wait(ctx, log, ui, "release",
+			func(context.Context) (*pb.StatusReport, error) {
+				return &pb.StatusReport{}, nil
+			})
+		require.Error(err)
+		require.Equal(codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func TestNewReleaseVerifier(t *testing.T) {
+	require := require.New(t)
+
+	v, err := newReleaseVerifier(&pb.Job_ReleaseOp_Verify{})
+	require.NoError(err)
+	require.Equal(defaultVerifyWindow, v.Window)
+	require.Equal(defaultVerifyInterval, v.Interval)
+
+	v, err = newReleaseVerifier(&pb.Job_ReleaseOp_Verify{Window: "2m", Interval: "5s"})
+	require.NoError(err)
+	require.Equal(2*time.Minute, v.Window)
+	require.Equal(5*time.Second, v.Interval)
+
+	_, err = newReleaseVerifier(&pb.Job_ReleaseOp_Verify{Interval: "0s"})
+	require.Error(err)
+}
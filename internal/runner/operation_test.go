@@ -0,0 +1,93 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	configpkg "github.com/hashicorp/waypoint/internal/config"
+	"github.com/hashicorp/waypoint/internal/plugin"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestRunnerPluginFactories_registry(t *testing.T) {
+	ctx := context.Background()
+	cacheDir := testTempDir(t)
+	runner := TestRunner(t, WithPluginCacheDir(cacheDir))
+
+	// Upload a few versions of a plugin
+	for _, v := range []string{"1.2.0", "1.2.5", "1.3.0"} {
+		_, err := plugin.UploadBinary(ctx, runner.client, &pb.UploadPluginRequest_Open{
+			Name:    "fake",
+			Version: v,
+			Os:      runtime.GOOS,
+			Arch:    runtime.GOARCH,
+		}, bytes.NewReader([]byte("binary "+v)))
+		require.NoError(t, err)
+	}
+
+	pluginCfg := func(name, version string) *configpkg.Plugin {
+		p := &configpkg.Plugin{Name: name, Version: version}
+		p.Type.Builder = true
+		return p
+	}
+
+	t.Run("downloads the newest matching version", func(t *testing.T) {
+		require := require.New(t)
+
+		factories, err := runner.pluginFactories(ctx, hclog.L(), []*configpkg.Plugin{
+			pluginCfg("fake", "~> 1.2.0"),
+		}, ".")
+		require.NoError(err)
+		require.Contains(factories[component.BuilderType].Registered(), "fake")
+
+		path := filepath.Join(cacheDir, "fake", "1.2.5", runtime.GOOS+"_"+runtime.GOARCH, "waypoint-plugin-fake")
+		if runtime.GOOS == "windows" {
+			path += ".exe"
+		}
+		data, err := ioutil.ReadFile(path)
+		require.NoError(err)
+		require.Equal("binary 1.2.5", string(data))
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		require := require.New(t)
+
+		p := pluginCfg("fake", "1.3.0")
+		p.Checksum = "abc"
+		_, err := runner.pluginFactories(ctx, hclog.L(), []*configpkg.Plugin{p}, ".")
+		require.Error(err)
+		require.Contains(err.Error(), "doesn't match the checksum")
+	})
+
+	t.Run("no matching version", func(t *testing.T) {
+		require := require.New(t)
+
+		_, err := runner.pluginFactories(ctx, hclog.L(), []*configpkg.Plugin{
+			pluginCfg("fake", ">= 2.0"),
+		}, ".")
+		require.Error(err)
+		require.Contains(err.Error(), `no version of plugin "fake" matching ">= 2.0"`)
+	})
+
+	t.Run("builtin plugins match the Waypoint version", func(t *testing.T) {
+		require := require.New(t)
+
+		_, err := runner.pluginFactories(ctx, hclog.L(), []*configpkg.Plugin{
+			pluginCfg("docker", ">= 0.1"),
+		}, ".")
+		require.NoError(err)
+
+		_, err = runner.pluginFactories(ctx, hclog.L(), []*configpkg.Plugin{
+			pluginCfg("docker", "< 0.1"),
+		}, ".")
+		require.Error(err)
+	})
+}
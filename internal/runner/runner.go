@@ -11,10 +11,12 @@ import (
 
 	"github.com/hashicorp/waypoint-plugin-sdk/component"
 	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	configpkg "github.com/hashicorp/waypoint/internal/config"
 	"github.com/hashicorp/waypoint/internal/factory"
 	"github.com/hashicorp/waypoint/internal/plugin"
 	"github.com/hashicorp/waypoint/internal/server"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/version"
 )
 
 var ErrClosed = errors.New("runner is closed")
@@ -50,6 +52,14 @@ type Runner struct {
 	local       bool
 	tempDir     string
 
+	// pluginCacheDir is where plugins downloaded from the plugin registry
+	// are stored. If this is empty, plugin.DefaultCacheDir is used.
+	pluginCacheDir string
+
+	// deployOverride replaces the deploy stage of every app of the jobs
+	// this runner executes. See WithDeployOverride.
+	deployOverride *configpkg.Use
+
 	// protects whether or not the runner is active or not.
 	runningCond *sync.Cond
 	shutdown    bool
@@ -85,7 +95,10 @@ func New(opts ...Option) (*Runner, error) {
 	runner := &Runner{
 		id:     id,
 		logger: hclog.L(),
-		runner: &pb.Runner{Id: id},
+		runner: &pb.Runner{
+			Id:      id,
+			Version: version.GetVersion().FullVersionNumber(true),
+		},
 		factories: map[component.Type]*factory.Factory{
 			component.MapperType:         plugin.BaseFactories[component.MapperType],
 			component.BuilderType:        plugin.BaseFactories[component.BuilderType],
@@ -155,8 +168,11 @@ func (r *Runner) Start() error {
 		return err
 	}
 
-	// Wait for an initial config as confirmation we're registered.
-	log.Trace("runner connected, waiting for initial config")
+	// Wait for an initial config as confirmation we're registered. If the
+	// server requires runners to be adopted, this blocks until we are.
+	log.Info("runner connected, waiting for initial config. If the server "+
+		"requires runner adoption, adopt this runner with "+
+		"\"waypoint runner adopt\"", "runner_id", r.runner.Id)
 	resp, err := client.Recv()
 	if err != nil {
 		return err
@@ -172,6 +188,9 @@ func (r *Runner) Start() error {
 	// Start the goroutine that waits for all other configs
 	go r.recvConfig(r.runningCtx, client, ch)
 
+	// Start the goroutine that tells the server we're alive
+	go r.sendHeartbeats(r.runningCtx, client)
+
 	log.Info("runner registered with server")
 	return nil
 }
@@ -257,6 +276,56 @@ func ByIdOnly() Option {
 	}
 }
 
+// WithMaxConcurrentJobs sets the maximum number of jobs that the server
+// assigns to this runner at once. Zero means there is no limit. This
+// should match the number of concurrent calls to Accept.
+func WithMaxConcurrentJobs(n uint32) Option {
+	return func(r *Runner, cfg *config) error {
+		r.runner.MaxConcurrentJobs = n
+		return nil
+	}
+}
+
+// WithId sets the ID of this runner rather than generating one. This is
+// used by on-demand runners since the server launches them for a job that
+// already targets their ID.
+func WithId(id string) Option {
+	return func(r *Runner, cfg *config) error {
+		r.id = id
+		r.runner.Id = id
+		return nil
+	}
+}
+
+// WithLabels sets the labels of this runner. Jobs can target runners
+// by label.
+func WithLabels(m map[string]string) Option {
+	return func(r *Runner, cfg *config) error {
+		r.runner.Labels = m
+		return nil
+	}
+}
+
+// WithPluginCacheDir sets the directory where plugins downloaded from the
+// plugin registry of the server are stored.
+func WithPluginCacheDir(dir string) Option {
+	return func(r *Runner, cfg *config) error {
+		r.pluginCacheDir = dir
+		return nil
+	}
+}
+
+// WithDeployOverride replaces the deploy stage of every app with the given
+// plugin for the jobs this runner executes. This is only useful for local
+// runners, since the override isn't part of the job. See
+// config.Config.OverrideDeploy.
+func WithDeployOverride(use *configpkg.Use) Option {
+	return func(r *Runner, cfg *config) error {
+		r.deployOverride = use
+		return nil
+	}
+}
+
 func WithDynamicConfig(set bool) Option {
 	return func(r *Runner, cfg *config) error {
 		r.enableDynConfig = set
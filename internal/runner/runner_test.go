@@ -2,7 +2,9 @@ package runner
 
 import (
 	"context"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -142,4 +144,54 @@ func TestRunnerStart_config(t *testing.T) {
 			return os.Getenv(cfgVar.Name) == "ORIGINAL"
 		}, 1000*time.Millisecond, 50*time.Millisecond)
 	})
+	t.Run("files", func(t *testing.T) {
+		require := require.New(t)
+		ctx := context.Background()
+		client := singleprocess.TestServer(t)
+
+		td, err := ioutil.TempDir("", "waypoint")
+		require.NoError(err)
+		defer os.RemoveAll(td)
+
+		cfgVar := &pb.ConfigVar{
+			Scope: &pb.ConfigVar_Runner{
+				Runner: &pb.Ref_Runner{
+					Target: &pb.Ref_Runner_Any{
+						Any: &pb.Ref_RunnerAny{},
+					},
+				},
+			},
+
+			Name:       filepath.Join(td, "creds", "creds.json"),
+			NameIsPath: true,
+			Value:      &pb.ConfigVar_Static{Static: "hello"},
+		}
+
+		// Initialize our runner
+		runner := TestRunner(t, WithClient(client))
+		defer runner.Close()
+		require.NoError(runner.Start())
+
+		// Set the file
+		_, err = client.SetConfig(ctx, &pb.ConfigSetRequest{Variables: []*pb.ConfigVar{cfgVar}})
+		require.NoError(err)
+
+		// Should be written and not be an env var
+		require.Eventually(func() bool {
+			data, err := ioutil.ReadFile(cfgVar.Name)
+			return err == nil && string(data) == "hello"
+		}, 1000*time.Millisecond, 50*time.Millisecond)
+		require.Empty(os.Getenv(cfgVar.Name))
+
+		// Unset
+		cfgVar.Value = &pb.ConfigVar_Static{Static: ""}
+		_, err = client.SetConfig(ctx, &pb.ConfigSetRequest{Variables: []*pb.ConfigVar{cfgVar}})
+		require.NoError(err)
+
+		// Should be deleted
+		require.Eventually(func() bool {
+			_, err := os.Stat(cfgVar.Name)
+			return os.IsNotExist(err)
+		}, 1000*time.Millisecond, 50*time.Millisecond)
+	})
 }
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/grpc"
+)
+
+// AuditLogger can optionally be implemented by the service to record the
+// calls to unary APIs that mutate state, i.e. that don't have the
+// "readonly" effect.
+type AuditLogger interface {
+	// AuditRequest is called after the request is handled with the
+	// context of the request, the request message, and the error of the
+	// handler, if any.
+	AuditRequest(ctx context.Context, endpoint string, req interface{}, err error)
+}
+
+// auditUnaryInterceptor returns a gRPC unary interceptor that calls the
+// AuditLogger for every handled call to an API that mutates state.
+func auditUnaryInterceptor(logger AuditLogger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+
+		// The reflection API never mutates anything
+		if strings.HasPrefix(info.FullMethod, "/grpc.reflection.v1alpha.ServerReflection/") {
+			return resp, err
+		}
+
+		name := filepath.Base(info.FullMethod)
+		if !isReadonly(name) {
+			logger.AuditRequest(ctx, name, req, err)
+		}
+
+		return resp, err
+	}
+}
+
+// isReadonly returns true if the endpoint has the "readonly" effect.
+func isReadonly(endpoint string) bool {
+	effects, ok := Effects[endpoint]
+	if !ok {
+		effects = DefaultEffects
+	}
+
+	for _, effect := range effects {
+		if effect == "readonly" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,44 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type trivialAudit struct {
+	endpoints []string
+	err       error
+}
+
+func (t *trivialAudit) AuditRequest(ctx context.Context, endpoint string, req interface{}, err error) {
+	t.endpoints = append(t.endpoints, endpoint)
+	t.err = err
+}
+
+func TestAuditUnaryInterceptor(t *testing.T) {
+	require := require.New(t)
+
+	var al trivialAudit
+	f := auditUnaryInterceptor(&al)
+
+	handlerErr := errors.New("failed")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "hello", handlerErr
+	}
+
+	// Mutable endpoints are audited with their error
+	resp, err := f(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/foo/UpsertProject"}, handler)
+	require.Equal("hello", resp)
+	require.Equal(handlerErr, err)
+	require.Equal([]string{"UpsertProject"}, al.endpoints)
+	require.Equal(handlerErr, al.err)
+
+	// Readonly endpoints are not
+	_, err = f(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/foo/GetProject"}, handler)
+	require.Error(err)
+	require.Equal([]string{"UpsertProject"}, al.endpoints)
+}
@@ -25,12 +25,92 @@ type AuthChecker interface {
 	) (context.Context, error)
 }
 
+// RequestAuthorizer can optionally be implemented by an AuthChecker to
+// authorize the request messages of an RPC after it is authenticated.
+// For streams, this is called for every message received from the client.
+type RequestAuthorizer interface {
+	// AuthorizeRequest is called with the context returned by Authenticate
+	// and the request message. An error rejects the request. The request
+	// may be modified, for example to limit it to what the caller can
+	// access.
+	AuthorizeRequest(ctx context.Context, endpoint string, req interface{}) error
+}
+
 var readonly = []string{"readonly"}
 
 // Information about the effects of endpoints that are authenticated. If an endpoint
 // is not listed, the DefaultEffect value is used.
 var Effects = map[string][]string{
-	"ListBuilds": readonly,
+	"GetUser":                   readonly,
+	"ListUsers":                 readonly,
+	"GetAuthMethod":             readonly,
+	"ListAuthMethods":           readonly,
+	"TestAuthMethod":            readonly,
+	"ListServiceAccounts":       readonly,
+	"ListWorkspaces":            readonly,
+	"GetWorkspace":              readonly,
+	"GetProject":                readonly,
+	"ListProjects":              readonly,
+	"WatchProjects":             readonly,
+	"GetProjectTemplate":        readonly,
+	"ListProjectTemplates":      readonly,
+	"ListBuilds":                readonly,
+	"GetBuild":                  readonly,
+	"GetLatestBuild":            readonly,
+	"DownloadBuildCache":        readonly,
+	"ListPushedArtifacts":       readonly,
+	"GetPushedArtifact":         readonly,
+	"GetLatestPushedArtifact":   readonly,
+	"ListDeployments":           readonly,
+	"GetDeployment":             readonly,
+	"ListInstances":             readonly,
+	"ListReleases":              readonly,
+	"GetRelease":                readonly,
+	"GetLatestRelease":          readonly,
+	"GetStatusReport":           readonly,
+	"GetLatestStatusReport":     readonly,
+	"ListStatusReports":         readonly,
+	"WatchStatusReports":        readonly,
+	"GetProjectStatusSummary":   readonly,
+	"ListAppEvents":             readonly,
+	"GetLogStream":              readonly,
+	"SearchLogs":                readonly,
+	"GetConfig":                 readonly,
+	"GetConfigHistory":          readonly,
+	"GetConfigSource":           readonly,
+	"ListHostnames":             readonly,
+	"GetJob":                    readonly,
+	"_ListJobs":                 readonly,
+	"WatchJobs":                 readonly,
+	"GetJobStream":              readonly,
+	"GetJobLogs":                readonly,
+	"ValidateJob":               readonly,
+	"GetRunner":                 readonly,
+	"ListRunners":               readonly,
+	"GetOnDemandRunnerConfig":   readonly,
+	"ListOnDemandRunnerConfigs": readonly,
+	"ListPlugins":               readonly,
+	"DownloadPlugin":            readonly,
+	"ListPipelines":             readonly,
+	"GetPipelineRun":            readonly,
+	"ListPipelineRuns":          readonly,
+	"GetJobSchedule":            readonly,
+	"ListJobSchedules":          readonly,
+	"GetNotifier":               readonly,
+	"ListNotifiers":             readonly,
+	"ListPreviews":              readonly,
+	"GetServerConfig":           readonly,
+	"GetServerStatus":           readonly,
+	"GetSnapshotStatus":         readonly,
+	"WaypointHclFmt":            readonly,
+	"ValidateWaypointHcl":       readonly,
+	"ListAuditEvents":           readonly,
+	"ListExecSessions":          readonly,
+	"GetExecSession":            readonly,
+	"GetVersionInfo":            readonly,
+	"DecodeToken":               readonly,
+	"ListOIDCAuthMethods":       readonly,
+	"GetOIDCAuthURL":            readonly,
 }
 
 var DefaultEffects = []string{"mutable"}
@@ -77,6 +157,12 @@ func authUnaryInterceptor(checker AuthChecker) grpc.UnaryServerInterceptor {
 			ctx = newCtx
 		}
 
+		if ra, ok := checker.(RequestAuthorizer); ok {
+			if err := ra.AuthorizeRequest(ctx, name, req); err != nil {
+				return nil, err
+			}
+		}
+
 		return handler(ctx, req)
 	}
 }
@@ -124,6 +210,10 @@ func authStreamInterceptor(checker AuthChecker) grpc.StreamServerInterceptor {
 			ss = &ssContextOverride{ServerStream: ss, Ctx: newCtx}
 		}
 
+		if ra, ok := checker.(RequestAuthorizer); ok {
+			ss = &ssAuthorizeRequest{ServerStream: ss, Authorizer: ra, Endpoint: name}
+		}
+
 		// Invoke the handler.
 		return handler(srv, ss)
 	}
@@ -140,4 +230,23 @@ func (ss *ssContextOverride) Context() context.Context {
 	return ss.Ctx
 }
 
-var _ grpc.ServerStream = (*ssContextOverride)(nil)
+// ssAuthorizeRequest implements grpc.ServerStream and authorizes every
+// message received with a RequestAuthorizer.
+type ssAuthorizeRequest struct {
+	grpc.ServerStream
+	Authorizer RequestAuthorizer
+	Endpoint   string
+}
+
+func (ss *ssAuthorizeRequest) RecvMsg(m interface{}) error {
+	if err := ss.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return ss.Authorizer.AuthorizeRequest(ss.Context(), ss.Endpoint, m)
+}
+
+var (
+	_ grpc.ServerStream = (*ssContextOverride)(nil)
+	_ grpc.ServerStream = (*ssAuthorizeRequest)(nil)
+)
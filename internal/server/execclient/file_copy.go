@@ -0,0 +1,171 @@
+package execclient
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/hashicorp/go-hclog"
+	grpc_net_conn "github.com/mitchellh/go-grpc-net-conn"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// FileCopy copies a file or directory to or from an instance through an
+// exec stream to the entrypoint of the instance. The data is a tar stream
+// as written by the tarcopy package.
+type FileCopy struct {
+	Logger  hclog.Logger
+	Context context.Context
+	Client  pb.WaypointClient
+
+	// Path is the path of the file or directory in the instance.
+	Path string
+
+	// Either DeploymentId or InstanceId have to be set. If both are set,
+	// then InstanceId takes priority. See Client.
+	DeploymentId string
+	InstanceId   string
+}
+
+// Download writes the tar stream of the path in the instance to w.
+func (c *FileCopy) Download(w io.Writer) error {
+	return c.run(false, nil, w)
+}
+
+// Upload writes the tar stream read from r to the path in the instance.
+func (c *FileCopy) Upload(r io.Reader) error {
+	return c.run(true, r, nil)
+}
+
+func (c *FileCopy) run(upload bool, r io.Reader, w io.Writer) error {
+	ctx, cancel := context.WithCancel(c.Context)
+	defer cancel()
+
+	client, err := c.Client.StartExecStream(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.CloseSend()
+
+	start := &pb.ExecStreamRequest_Start{
+		FileCopy: &pb.ExecStreamRequest_FileCopy{
+			Path:   c.Path,
+			Upload: upload,
+		},
+	}
+	if c.InstanceId != "" {
+		start.Target = &pb.ExecStreamRequest_Start_InstanceId{
+			InstanceId: c.InstanceId,
+		}
+	} else {
+		start.Target = &pb.ExecStreamRequest_Start_DeploymentId{
+			DeploymentId: c.DeploymentId,
+		}
+	}
+
+	if err := client.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Start_{
+			Start: start,
+		},
+	}); err != nil {
+		return err
+	}
+
+	// Receive our open message. If this fails then we weren't assigned.
+	resp, err := client.Recv()
+	if err != nil {
+		return err
+	}
+	if _, ok := resp.Event.(*pb.ExecStreamResponse_Open_); !ok {
+		return fmt.Errorf("internal protocol error: unexpected opening message")
+	}
+
+	// For uploads, send the data followed by an EOF.
+	if upload {
+		var streamLock sync.Mutex
+		go func() {
+			_, err := io.Copy(&grpc_net_conn.Conn{
+				Stream:       client,
+				Request:      &pb.ExecStreamRequest{},
+				ResponseLock: &streamLock,
+				Encode: grpc_net_conn.SimpleEncoder(func(msg proto.Message) *[]byte {
+					req := msg.(*pb.ExecStreamRequest)
+					if req.Event == nil {
+						req.Event = &pb.ExecStreamRequest_Input_{
+							Input: &pb.ExecStreamRequest_Input{},
+						}
+					}
+
+					return &req.Event.(*pb.ExecStreamRequest_Input_).Input.Data
+				}),
+			}, r)
+			if err != nil {
+				// Without an EOF, the entrypoint fails the copy rather
+				// than writing a partial file.
+				c.Logger.Warn("error reading files to upload", "err", err)
+				cancel()
+				return
+			}
+
+			streamLock.Lock()
+			defer streamLock.Unlock()
+			if err := client.Send(&pb.ExecStreamRequest{
+				Event: &pb.ExecStreamRequest_InputEof{
+					InputEof: &empty.Empty{},
+				},
+			}); err != nil {
+				c.Logger.Warn("error sending InputEOF event", "err", err)
+			}
+		}()
+	}
+
+	// The entrypoint writes errors to stderr like a command would, so we
+	// collect them to return them.
+	var stderr bytes.Buffer
+	for {
+		resp, err := client.Recv()
+		if err != nil {
+			if err == io.EOF {
+				err = fmt.Errorf("the stream ended before the copy completed")
+			}
+
+			return err
+		}
+
+		switch event := resp.Event.(type) {
+		case *pb.ExecStreamResponse_Output_:
+			switch event.Output.Channel {
+			case pb.ExecStreamResponse_Output_STDOUT:
+				if w != nil {
+					if _, err := w.Write(event.Output.Data); err != nil {
+						return err
+					}
+				}
+			case pb.ExecStreamResponse_Output_STDERR:
+				stderr.Write(event.Output.Data)
+			}
+
+		case *pb.ExecStreamResponse_Exit_:
+			if event.Exit.Code == 0 {
+				return nil
+			}
+
+			msg := strings.TrimSpace(stderr.String())
+			if msg == "" {
+				msg = "the entrypoint may not support copying files"
+			}
+
+			return fmt.Errorf("copying %s failed: %s", c.Path, msg)
+
+		default:
+			c.Logger.Warn("unknown event type",
+				"type", fmt.Sprintf("%T", resp.Event))
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package execclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/hashicorp/go-hclog"
+	grpc_net_conn "github.com/mitchellh/go-grpc-net-conn"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// PortForward forwards connections to a TCP port of an instance. Every
+// connection is tunneled through its own exec stream to the entrypoint of
+// the instance, which connects to the port.
+type PortForward struct {
+	Logger  hclog.Logger
+	Context context.Context
+	Client  pb.WaypointClient
+
+	// Port is the port of the instance to connect to.
+	Port int32
+
+	// Either DeploymentId or InstanceId have to be set. If both are set,
+	// then InstanceId takes priority. See Client.
+	DeploymentId string
+	InstanceId   string
+}
+
+// Forward copies data between conn and the port of the instance until
+// either side closes the connection. This closes conn.
+func (p *PortForward) Forward(conn net.Conn) error {
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(p.Context)
+	defer cancel()
+
+	client, err := p.Client.StartExecStream(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.CloseSend()
+
+	start := &pb.ExecStreamRequest_Start{
+		PortForward: &pb.ExecStreamRequest_PortForward{
+			Port: p.Port,
+		},
+	}
+	if p.InstanceId != "" {
+		start.Target = &pb.ExecStreamRequest_Start_InstanceId{
+			InstanceId: p.InstanceId,
+		}
+	} else {
+		start.Target = &pb.ExecStreamRequest_Start_DeploymentId{
+			DeploymentId: p.DeploymentId,
+		}
+	}
+
+	if err := client.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Start_{
+			Start: start,
+		},
+	}); err != nil {
+		return err
+	}
+
+	// Receive our open message. If this fails then we weren't assigned.
+	resp, err := client.Recv()
+	if err != nil {
+		return err
+	}
+	if _, ok := resp.Event.(*pb.ExecStreamResponse_Open_); !ok {
+		return fmt.Errorf("internal protocol error: unexpected opening message")
+	}
+
+	// Copy the data of the connection to the stream. Once the connection
+	// closes its writing side, we send an EOF so that the entrypoint
+	// closes the writing side of its connection too.
+	var streamLock sync.Mutex
+	go func() {
+		io.Copy(&grpc_net_conn.Conn{
+			Stream:       client,
+			Request:      &pb.ExecStreamRequest{},
+			ResponseLock: &streamLock,
+			Encode: grpc_net_conn.SimpleEncoder(func(msg proto.Message) *[]byte {
+				req := msg.(*pb.ExecStreamRequest)
+				if req.Event == nil {
+					req.Event = &pb.ExecStreamRequest_Input_{
+						Input: &pb.ExecStreamRequest_Input{},
+					}
+				}
+
+				return &req.Event.(*pb.ExecStreamRequest_Input_).Input.Data
+			}),
+		}, conn)
+
+		streamLock.Lock()
+		defer streamLock.Unlock()
+		if err := client.Send(&pb.ExecStreamRequest{
+			Event: &pb.ExecStreamRequest_InputEof{
+				InputEof: &empty.Empty{},
+			},
+		}); err != nil {
+			p.Logger.Debug("error sending InputEOF event", "err", err)
+		}
+	}()
+
+	// Copy the data of the stream to the connection until the entrypoint
+	// reports that its connection closed.
+	for {
+		resp, err := client.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch event := resp.Event.(type) {
+		case *pb.ExecStreamResponse_Output_:
+			if _, err := conn.Write(event.Output.Data); err != nil {
+				return err
+			}
+
+		case *pb.ExecStreamResponse_Exit_:
+			if event.Exit.Code != 0 {
+				return fmt.Errorf("the connection to port %d of the instance failed", p.Port)
+			}
+
+			return nil
+
+		default:
+			p.Logger.Warn("unknown event type",
+				"type", fmt.Sprintf("%T", resp.Event))
+		}
+	}
+}
@@ -18,6 +18,66 @@ type WaypointClient struct {
 	mock.Mock
 }
 
+// AdoptRunner provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) AdoptRunner(ctx context.Context, in *gen.AdoptRunnerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AdoptRunnerRequest, ...grpc.CallOption) *emptypb.Empty); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*emptypb.Empty)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.AdoptRunnerRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovePipelineRun provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ApprovePipelineRun(ctx context.Context, in *gen.ApprovePipelineRunRequest, opts ...grpc.CallOption) (*gen.PipelineRun, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.PipelineRun
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ApprovePipelineRunRequest, ...grpc.CallOption) *gen.PipelineRun); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.PipelineRun)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ApprovePipelineRunRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BootstrapToken provides a mock function with given fields: ctx, in, opts
 func (_m *WaypointClient) BootstrapToken(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*gen.NewTokenResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -108,6 +168,36 @@ func (_m *WaypointClient) CompleteOIDCAuth(ctx context.Context, in *gen.Complete
 	return r0, r1
 }
 
+// CompletePATAuth provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) CompletePATAuth(ctx context.Context, in *gen.CompletePATAuthRequest, opts ...grpc.CallOption) (*gen.CompletePATAuthResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.CompletePATAuthResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.CompletePATAuthRequest, ...grpc.CallOption) *gen.CompletePATAuthResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.CompletePATAuthResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.CompletePATAuthRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ConvertInviteToken provides a mock function with given fields: ctx, in, opts
 func (_m *WaypointClient) ConvertInviteToken(ctx context.Context, in *gen.ConvertInviteTokenRequest, opts ...grpc.CallOption) (*gen.NewTokenResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -168,6 +258,36 @@ func (_m *WaypointClient) CreateHostname(ctx context.Context, in *gen.CreateHost
 	return r0, r1
 }
 
+// CreateServiceAccount provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) CreateServiceAccount(ctx context.Context, in *gen.CreateServiceAccountRequest, opts ...grpc.CallOption) (*gen.CreateServiceAccountResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.CreateServiceAccountResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.CreateServiceAccountRequest, ...grpc.CallOption) *gen.CreateServiceAccountResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.CreateServiceAccountResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.CreateServiceAccountRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateSnapshot provides a mock function with given fields: ctx, in, opts
 func (_m *WaypointClient) CreateSnapshot(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (gen.Waypoint_CreateSnapshotClient, error) {
 	_va := make([]interface{}, len(opts))
@@ -288,8 +408,8 @@ func (_m *WaypointClient) DeleteHostname(ctx context.Context, in *gen.DeleteHost
 	return r0, r1
 }
 
-// DeleteUser provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) DeleteUser(ctx context.Context, in *gen.DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+// DeleteJobSchedule provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) DeleteJobSchedule(ctx context.Context, in *gen.DeleteJobScheduleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -300,7 +420,7 @@ func (_m *WaypointClient) DeleteUser(ctx context.Context, in *gen.DeleteUserRequ
 	ret := _m.Called(_ca...)
 
 	var r0 *emptypb.Empty
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteUserRequest, ...grpc.CallOption) *emptypb.Empty); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteJobScheduleRequest, ...grpc.CallOption) *emptypb.Empty); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -309,7 +429,7 @@ func (_m *WaypointClient) DeleteUser(ctx context.Context, in *gen.DeleteUserRequ
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.DeleteUserRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DeleteJobScheduleRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -318,8 +438,8 @@ func (_m *WaypointClient) DeleteUser(ctx context.Context, in *gen.DeleteUserRequ
 	return r0, r1
 }
 
-// EntrypointConfig provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) EntrypointConfig(ctx context.Context, in *gen.EntrypointConfigRequest, opts ...grpc.CallOption) (gen.Waypoint_EntrypointConfigClient, error) {
+// DeleteNotifier provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) DeleteNotifier(ctx context.Context, in *gen.DeleteNotifierRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -329,17 +449,17 @@ func (_m *WaypointClient) EntrypointConfig(ctx context.Context, in *gen.Entrypoi
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 gen.Waypoint_EntrypointConfigClient
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.EntrypointConfigRequest, ...grpc.CallOption) gen.Waypoint_EntrypointConfigClient); ok {
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteNotifierRequest, ...grpc.CallOption) *emptypb.Empty); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(gen.Waypoint_EntrypointConfigClient)
+			r0 = ret.Get(0).(*emptypb.Empty)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.EntrypointConfigRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DeleteNotifierRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -348,29 +468,29 @@ func (_m *WaypointClient) EntrypointConfig(ctx context.Context, in *gen.Entrypoi
 	return r0, r1
 }
 
-// EntrypointExecStream provides a mock function with given fields: ctx, opts
-func (_m *WaypointClient) EntrypointExecStream(ctx context.Context, opts ...grpc.CallOption) (gen.Waypoint_EntrypointExecStreamClient, error) {
+// DeleteOnDemandRunnerConfig provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) DeleteOnDemandRunnerConfig(ctx context.Context, in *gen.DeleteOnDemandRunnerConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, ctx)
+	_ca = append(_ca, ctx, in)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 gen.Waypoint_EntrypointExecStreamClient
-	if rf, ok := ret.Get(0).(func(context.Context, ...grpc.CallOption) gen.Waypoint_EntrypointExecStreamClient); ok {
-		r0 = rf(ctx, opts...)
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteOnDemandRunnerConfigRequest, ...grpc.CallOption) *emptypb.Empty); ok {
+		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(gen.Waypoint_EntrypointExecStreamClient)
+			r0 = ret.Get(0).(*emptypb.Empty)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, ...grpc.CallOption) error); ok {
-		r1 = rf(ctx, opts...)
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DeleteOnDemandRunnerConfigRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -378,29 +498,29 @@ func (_m *WaypointClient) EntrypointExecStream(ctx context.Context, opts ...grpc
 	return r0, r1
 }
 
-// EntrypointLogStream provides a mock function with given fields: ctx, opts
-func (_m *WaypointClient) EntrypointLogStream(ctx context.Context, opts ...grpc.CallOption) (gen.Waypoint_EntrypointLogStreamClient, error) {
+// DeletePlugin provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) DeletePlugin(ctx context.Context, in *gen.DeletePluginRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, ctx)
+	_ca = append(_ca, ctx, in)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 gen.Waypoint_EntrypointLogStreamClient
-	if rf, ok := ret.Get(0).(func(context.Context, ...grpc.CallOption) gen.Waypoint_EntrypointLogStreamClient); ok {
-		r0 = rf(ctx, opts...)
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeletePluginRequest, ...grpc.CallOption) *emptypb.Empty); ok {
+		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(gen.Waypoint_EntrypointLogStreamClient)
+			r0 = ret.Get(0).(*emptypb.Empty)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, ...grpc.CallOption) error); ok {
-		r1 = rf(ctx, opts...)
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DeletePluginRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -408,8 +528,8 @@ func (_m *WaypointClient) EntrypointLogStream(ctx context.Context, opts ...grpc.
 	return r0, r1
 }
 
-// FindExecInstance provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) FindExecInstance(ctx context.Context, in *gen.FindExecInstanceRequest, opts ...grpc.CallOption) (*gen.FindExecInstanceResponse, error) {
+// DeleteProject provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) DeleteProject(ctx context.Context, in *gen.DeleteProjectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -419,17 +539,17 @@ func (_m *WaypointClient) FindExecInstance(ctx context.Context, in *gen.FindExec
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.FindExecInstanceResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.FindExecInstanceRequest, ...grpc.CallOption) *gen.FindExecInstanceResponse); ok {
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteProjectRequest, ...grpc.CallOption) *emptypb.Empty); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.FindExecInstanceResponse)
+			r0 = ret.Get(0).(*emptypb.Empty)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.FindExecInstanceRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DeleteProjectRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -438,8 +558,8 @@ func (_m *WaypointClient) FindExecInstance(ctx context.Context, in *gen.FindExec
 	return r0, r1
 }
 
-// GenerateInviteToken provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) GenerateInviteToken(ctx context.Context, in *gen.InviteTokenRequest, opts ...grpc.CallOption) (*gen.NewTokenResponse, error) {
+// DeleteProjectTemplate provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) DeleteProjectTemplate(ctx context.Context, in *gen.DeleteProjectTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -449,17 +569,17 @@ func (_m *WaypointClient) GenerateInviteToken(ctx context.Context, in *gen.Invit
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.NewTokenResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.InviteTokenRequest, ...grpc.CallOption) *gen.NewTokenResponse); ok {
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteProjectTemplateRequest, ...grpc.CallOption) *emptypb.Empty); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.NewTokenResponse)
+			r0 = ret.Get(0).(*emptypb.Empty)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.InviteTokenRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DeleteProjectTemplateRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -468,8 +588,8 @@ func (_m *WaypointClient) GenerateInviteToken(ctx context.Context, in *gen.Invit
 	return r0, r1
 }
 
-// GenerateLoginToken provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) GenerateLoginToken(ctx context.Context, in *gen.LoginTokenRequest, opts ...grpc.CallOption) (*gen.NewTokenResponse, error) {
+// DeleteServiceAccount provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) DeleteServiceAccount(ctx context.Context, in *gen.DeleteServiceAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -479,17 +599,17 @@ func (_m *WaypointClient) GenerateLoginToken(ctx context.Context, in *gen.LoginT
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.NewTokenResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.LoginTokenRequest, ...grpc.CallOption) *gen.NewTokenResponse); ok {
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteServiceAccountRequest, ...grpc.CallOption) *emptypb.Empty); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.NewTokenResponse)
+			r0 = ret.Get(0).(*emptypb.Empty)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.LoginTokenRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DeleteServiceAccountRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -498,8 +618,8 @@ func (_m *WaypointClient) GenerateLoginToken(ctx context.Context, in *gen.LoginT
 	return r0, r1
 }
 
-// GetAuthMethod provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) GetAuthMethod(ctx context.Context, in *gen.GetAuthMethodRequest, opts ...grpc.CallOption) (*gen.GetAuthMethodResponse, error) {
+// DeleteUser provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) DeleteUser(ctx context.Context, in *gen.DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -509,17 +629,17 @@ func (_m *WaypointClient) GetAuthMethod(ctx context.Context, in *gen.GetAuthMeth
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.GetAuthMethodResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetAuthMethodRequest, ...grpc.CallOption) *gen.GetAuthMethodResponse); ok {
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteUserRequest, ...grpc.CallOption) *emptypb.Empty); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.GetAuthMethodResponse)
+			r0 = ret.Get(0).(*emptypb.Empty)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetAuthMethodRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DeleteUserRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -528,8 +648,8 @@ func (_m *WaypointClient) GetAuthMethod(ctx context.Context, in *gen.GetAuthMeth
 	return r0, r1
 }
 
-// GetBuild provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) GetBuild(ctx context.Context, in *gen.GetBuildRequest, opts ...grpc.CallOption) (*gen.Build, error) {
+// DeleteWorkspace provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) DeleteWorkspace(ctx context.Context, in *gen.DeleteWorkspaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -539,17 +659,17 @@ func (_m *WaypointClient) GetBuild(ctx context.Context, in *gen.GetBuildRequest,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.Build
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetBuildRequest, ...grpc.CallOption) *gen.Build); ok {
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteWorkspaceRequest, ...grpc.CallOption) *emptypb.Empty); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.Build)
+			r0 = ret.Get(0).(*emptypb.Empty)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetBuildRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DeleteWorkspaceRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -558,8 +678,8 @@ func (_m *WaypointClient) GetBuild(ctx context.Context, in *gen.GetBuildRequest,
 	return r0, r1
 }
 
-// GetConfig provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) GetConfig(ctx context.Context, in *gen.ConfigGetRequest, opts ...grpc.CallOption) (*gen.ConfigGetResponse, error) {
+// DownloadBuildCache provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) DownloadBuildCache(ctx context.Context, in *gen.DownloadBuildCacheRequest, opts ...grpc.CallOption) (gen.Waypoint_DownloadBuildCacheClient, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -569,17 +689,17 @@ func (_m *WaypointClient) GetConfig(ctx context.Context, in *gen.ConfigGetReques
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.ConfigGetResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.ConfigGetRequest, ...grpc.CallOption) *gen.ConfigGetResponse); ok {
+	var r0 gen.Waypoint_DownloadBuildCacheClient
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DownloadBuildCacheRequest, ...grpc.CallOption) gen.Waypoint_DownloadBuildCacheClient); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ConfigGetResponse)
+			r0 = ret.Get(0).(gen.Waypoint_DownloadBuildCacheClient)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.ConfigGetRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DownloadBuildCacheRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -588,8 +708,8 @@ func (_m *WaypointClient) GetConfig(ctx context.Context, in *gen.ConfigGetReques
 	return r0, r1
 }
 
-// GetConfigSource provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) GetConfigSource(ctx context.Context, in *gen.GetConfigSourceRequest, opts ...grpc.CallOption) (*gen.GetConfigSourceResponse, error) {
+// DownloadPlugin provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) DownloadPlugin(ctx context.Context, in *gen.DownloadPluginRequest, opts ...grpc.CallOption) (gen.Waypoint_DownloadPluginClient, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -599,17 +719,17 @@ func (_m *WaypointClient) GetConfigSource(ctx context.Context, in *gen.GetConfig
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.GetConfigSourceResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetConfigSourceRequest, ...grpc.CallOption) *gen.GetConfigSourceResponse); ok {
+	var r0 gen.Waypoint_DownloadPluginClient
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DownloadPluginRequest, ...grpc.CallOption) gen.Waypoint_DownloadPluginClient); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.GetConfigSourceResponse)
+			r0 = ret.Get(0).(gen.Waypoint_DownloadPluginClient)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetConfigSourceRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DownloadPluginRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -618,8 +738,8 @@ func (_m *WaypointClient) GetConfigSource(ctx context.Context, in *gen.GetConfig
 	return r0, r1
 }
 
-// GetDeployment provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) GetDeployment(ctx context.Context, in *gen.GetDeploymentRequest, opts ...grpc.CallOption) (*gen.Deployment, error) {
+// EntrypointConfig provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) EntrypointConfig(ctx context.Context, in *gen.EntrypointConfigRequest, opts ...grpc.CallOption) (gen.Waypoint_EntrypointConfigClient, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -629,17 +749,17 @@ func (_m *WaypointClient) GetDeployment(ctx context.Context, in *gen.GetDeployme
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.Deployment
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetDeploymentRequest, ...grpc.CallOption) *gen.Deployment); ok {
+	var r0 gen.Waypoint_EntrypointConfigClient
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.EntrypointConfigRequest, ...grpc.CallOption) gen.Waypoint_EntrypointConfigClient); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.Deployment)
+			r0 = ret.Get(0).(gen.Waypoint_EntrypointConfigClient)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetDeploymentRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.EntrypointConfigRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -648,29 +768,29 @@ func (_m *WaypointClient) GetDeployment(ctx context.Context, in *gen.GetDeployme
 	return r0, r1
 }
 
-// GetJob provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) GetJob(ctx context.Context, in *gen.GetJobRequest, opts ...grpc.CallOption) (*gen.Job, error) {
+// EntrypointExecStream provides a mock function with given fields: ctx, opts
+func (_m *WaypointClient) EntrypointExecStream(ctx context.Context, opts ...grpc.CallOption) (gen.Waypoint_EntrypointExecStreamClient, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, ctx)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.Job
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetJobRequest, ...grpc.CallOption) *gen.Job); ok {
-		r0 = rf(ctx, in, opts...)
+	var r0 gen.Waypoint_EntrypointExecStreamClient
+	if rf, ok := ret.Get(0).(func(context.Context, ...grpc.CallOption) gen.Waypoint_EntrypointExecStreamClient); ok {
+		r0 = rf(ctx, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.Job)
+			r0 = ret.Get(0).(gen.Waypoint_EntrypointExecStreamClient)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetJobRequest, ...grpc.CallOption) error); ok {
-		r1 = rf(ctx, in, opts...)
+	if rf, ok := ret.Get(1).(func(context.Context, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -678,8 +798,8 @@ func (_m *WaypointClient) GetJob(ctx context.Context, in *gen.GetJobRequest, opt
 	return r0, r1
 }
 
-// GetJobStream provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) GetJobStream(ctx context.Context, in *gen.GetJobStreamRequest, opts ...grpc.CallOption) (gen.Waypoint_GetJobStreamClient, error) {
+// EntrypointHealthReport provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) EntrypointHealthReport(ctx context.Context, in *gen.EntrypointHealthReportRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -689,17 +809,17 @@ func (_m *WaypointClient) GetJobStream(ctx context.Context, in *gen.GetJobStream
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 gen.Waypoint_GetJobStreamClient
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetJobStreamRequest, ...grpc.CallOption) gen.Waypoint_GetJobStreamClient); ok {
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.EntrypointHealthReportRequest, ...grpc.CallOption) *emptypb.Empty); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(gen.Waypoint_GetJobStreamClient)
+			r0 = ret.Get(0).(*emptypb.Empty)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetJobStreamRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.EntrypointHealthReportRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -708,29 +828,29 @@ func (_m *WaypointClient) GetJobStream(ctx context.Context, in *gen.GetJobStream
 	return r0, r1
 }
 
-// GetLatestBuild provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) GetLatestBuild(ctx context.Context, in *gen.GetLatestBuildRequest, opts ...grpc.CallOption) (*gen.Build, error) {
+// EntrypointLogStream provides a mock function with given fields: ctx, opts
+func (_m *WaypointClient) EntrypointLogStream(ctx context.Context, opts ...grpc.CallOption) (gen.Waypoint_EntrypointLogStreamClient, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, ctx)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.Build
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetLatestBuildRequest, ...grpc.CallOption) *gen.Build); ok {
-		r0 = rf(ctx, in, opts...)
+	var r0 gen.Waypoint_EntrypointLogStreamClient
+	if rf, ok := ret.Get(0).(func(context.Context, ...grpc.CallOption) gen.Waypoint_EntrypointLogStreamClient); ok {
+		r0 = rf(ctx, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.Build)
+			r0 = ret.Get(0).(gen.Waypoint_EntrypointLogStreamClient)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetLatestBuildRequest, ...grpc.CallOption) error); ok {
-		r1 = rf(ctx, in, opts...)
+	if rf, ok := ret.Get(1).(func(context.Context, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -738,8 +858,8 @@ func (_m *WaypointClient) GetLatestBuild(ctx context.Context, in *gen.GetLatestB
 	return r0, r1
 }
 
-// GetLatestPushedArtifact provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) GetLatestPushedArtifact(ctx context.Context, in *gen.GetLatestPushedArtifactRequest, opts ...grpc.CallOption) (*gen.PushedArtifact, error) {
+// FindExecInstance provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) FindExecInstance(ctx context.Context, in *gen.FindExecInstanceRequest, opts ...grpc.CallOption) (*gen.FindExecInstanceResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -749,17 +869,17 @@ func (_m *WaypointClient) GetLatestPushedArtifact(ctx context.Context, in *gen.G
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.PushedArtifact
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetLatestPushedArtifactRequest, ...grpc.CallOption) *gen.PushedArtifact); ok {
+	var r0 *gen.FindExecInstanceResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.FindExecInstanceRequest, ...grpc.CallOption) *gen.FindExecInstanceResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.PushedArtifact)
+			r0 = ret.Get(0).(*gen.FindExecInstanceResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetLatestPushedArtifactRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.FindExecInstanceRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -768,8 +888,8 @@ func (_m *WaypointClient) GetLatestPushedArtifact(ctx context.Context, in *gen.G
 	return r0, r1
 }
 
-// GetLatestRelease provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) GetLatestRelease(ctx context.Context, in *gen.GetLatestReleaseRequest, opts ...grpc.CallOption) (*gen.Release, error) {
+// GenerateInviteToken provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GenerateInviteToken(ctx context.Context, in *gen.InviteTokenRequest, opts ...grpc.CallOption) (*gen.NewTokenResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -779,17 +899,17 @@ func (_m *WaypointClient) GetLatestRelease(ctx context.Context, in *gen.GetLates
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.Release
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetLatestReleaseRequest, ...grpc.CallOption) *gen.Release); ok {
+	var r0 *gen.NewTokenResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.InviteTokenRequest, ...grpc.CallOption) *gen.NewTokenResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.Release)
+			r0 = ret.Get(0).(*gen.NewTokenResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetLatestReleaseRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.InviteTokenRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -798,8 +918,8 @@ func (_m *WaypointClient) GetLatestRelease(ctx context.Context, in *gen.GetLates
 	return r0, r1
 }
 
-// GetLatestStatusReport provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) GetLatestStatusReport(ctx context.Context, in *gen.GetLatestStatusReportRequest, opts ...grpc.CallOption) (*gen.StatusReport, error) {
+// GenerateLoginToken provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GenerateLoginToken(ctx context.Context, in *gen.LoginTokenRequest, opts ...grpc.CallOption) (*gen.NewTokenResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -809,17 +929,17 @@ func (_m *WaypointClient) GetLatestStatusReport(ctx context.Context, in *gen.Get
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.StatusReport
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetLatestStatusReportRequest, ...grpc.CallOption) *gen.StatusReport); ok {
+	var r0 *gen.NewTokenResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.LoginTokenRequest, ...grpc.CallOption) *gen.NewTokenResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.StatusReport)
+			r0 = ret.Get(0).(*gen.NewTokenResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetLatestStatusReportRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.LoginTokenRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -828,8 +948,8 @@ func (_m *WaypointClient) GetLatestStatusReport(ctx context.Context, in *gen.Get
 	return r0, r1
 }
 
-// GetLogStream provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) GetLogStream(ctx context.Context, in *gen.GetLogStreamRequest, opts ...grpc.CallOption) (gen.Waypoint_GetLogStreamClient, error) {
+// GenerateServiceAccountToken provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GenerateServiceAccountToken(ctx context.Context, in *gen.ServiceAccountTokenRequest, opts ...grpc.CallOption) (*gen.NewTokenResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -839,17 +959,17 @@ func (_m *WaypointClient) GetLogStream(ctx context.Context, in *gen.GetLogStream
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 gen.Waypoint_GetLogStreamClient
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetLogStreamRequest, ...grpc.CallOption) gen.Waypoint_GetLogStreamClient); ok {
+	var r0 *gen.NewTokenResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ServiceAccountTokenRequest, ...grpc.CallOption) *gen.NewTokenResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(gen.Waypoint_GetLogStreamClient)
+			r0 = ret.Get(0).(*gen.NewTokenResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetLogStreamRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ServiceAccountTokenRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -858,8 +978,8 @@ func (_m *WaypointClient) GetLogStream(ctx context.Context, in *gen.GetLogStream
 	return r0, r1
 }
 
-// GetOIDCAuthURL provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) GetOIDCAuthURL(ctx context.Context, in *gen.GetOIDCAuthURLRequest, opts ...grpc.CallOption) (*gen.GetOIDCAuthURLResponse, error) {
+// GetAuthMethod provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetAuthMethod(ctx context.Context, in *gen.GetAuthMethodRequest, opts ...grpc.CallOption) (*gen.GetAuthMethodResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -869,17 +989,17 @@ func (_m *WaypointClient) GetOIDCAuthURL(ctx context.Context, in *gen.GetOIDCAut
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.GetOIDCAuthURLResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetOIDCAuthURLRequest, ...grpc.CallOption) *gen.GetOIDCAuthURLResponse); ok {
+	var r0 *gen.GetAuthMethodResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetAuthMethodRequest, ...grpc.CallOption) *gen.GetAuthMethodResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.GetOIDCAuthURLResponse)
+			r0 = ret.Get(0).(*gen.GetAuthMethodResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetOIDCAuthURLRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetAuthMethodRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -888,8 +1008,8 @@ func (_m *WaypointClient) GetOIDCAuthURL(ctx context.Context, in *gen.GetOIDCAut
 	return r0, r1
 }
 
-// GetProject provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) GetProject(ctx context.Context, in *gen.GetProjectRequest, opts ...grpc.CallOption) (*gen.GetProjectResponse, error) {
+// GetBuild provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetBuild(ctx context.Context, in *gen.GetBuildRequest, opts ...grpc.CallOption) (*gen.Build, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -899,17 +1019,17 @@ func (_m *WaypointClient) GetProject(ctx context.Context, in *gen.GetProjectRequ
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.GetProjectResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetProjectRequest, ...grpc.CallOption) *gen.GetProjectResponse); ok {
+	var r0 *gen.Build
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetBuildRequest, ...grpc.CallOption) *gen.Build); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.GetProjectResponse)
+			r0 = ret.Get(0).(*gen.Build)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetProjectRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetBuildRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -918,8 +1038,8 @@ func (_m *WaypointClient) GetProject(ctx context.Context, in *gen.GetProjectRequ
 	return r0, r1
 }
 
-// GetPushedArtifact provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) GetPushedArtifact(ctx context.Context, in *gen.GetPushedArtifactRequest, opts ...grpc.CallOption) (*gen.PushedArtifact, error) {
+// GetConfig provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetConfig(ctx context.Context, in *gen.ConfigGetRequest, opts ...grpc.CallOption) (*gen.ConfigGetResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -929,17 +1049,17 @@ func (_m *WaypointClient) GetPushedArtifact(ctx context.Context, in *gen.GetPush
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.PushedArtifact
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetPushedArtifactRequest, ...grpc.CallOption) *gen.PushedArtifact); ok {
+	var r0 *gen.ConfigGetResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ConfigGetRequest, ...grpc.CallOption) *gen.ConfigGetResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.PushedArtifact)
+			r0 = ret.Get(0).(*gen.ConfigGetResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetPushedArtifactRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ConfigGetRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -948,8 +1068,8 @@ func (_m *WaypointClient) GetPushedArtifact(ctx context.Context, in *gen.GetPush
 	return r0, r1
 }
 
-// GetRelease provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) GetRelease(ctx context.Context, in *gen.GetReleaseRequest, opts ...grpc.CallOption) (*gen.Release, error) {
+// GetConfigHistory provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetConfigHistory(ctx context.Context, in *gen.GetConfigHistoryRequest, opts ...grpc.CallOption) (*gen.GetConfigHistoryResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -959,17 +1079,17 @@ func (_m *WaypointClient) GetRelease(ctx context.Context, in *gen.GetReleaseRequ
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.Release
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetReleaseRequest, ...grpc.CallOption) *gen.Release); ok {
+	var r0 *gen.GetConfigHistoryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetConfigHistoryRequest, ...grpc.CallOption) *gen.GetConfigHistoryResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.Release)
+			r0 = ret.Get(0).(*gen.GetConfigHistoryResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetReleaseRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetConfigHistoryRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -978,8 +1098,8 @@ func (_m *WaypointClient) GetRelease(ctx context.Context, in *gen.GetReleaseRequ
 	return r0, r1
 }
 
-// GetRunner provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) GetRunner(ctx context.Context, in *gen.GetRunnerRequest, opts ...grpc.CallOption) (*gen.Runner, error) {
+// GetConfigSource provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetConfigSource(ctx context.Context, in *gen.GetConfigSourceRequest, opts ...grpc.CallOption) (*gen.GetConfigSourceResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -989,17 +1109,17 @@ func (_m *WaypointClient) GetRunner(ctx context.Context, in *gen.GetRunnerReques
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.Runner
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetRunnerRequest, ...grpc.CallOption) *gen.Runner); ok {
+	var r0 *gen.GetConfigSourceResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetConfigSourceRequest, ...grpc.CallOption) *gen.GetConfigSourceResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.Runner)
+			r0 = ret.Get(0).(*gen.GetConfigSourceResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetRunnerRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetConfigSourceRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1008,8 +1128,8 @@ func (_m *WaypointClient) GetRunner(ctx context.Context, in *gen.GetRunnerReques
 	return r0, r1
 }
 
-// GetServerConfig provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) GetServerConfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*gen.GetServerConfigResponse, error) {
+// GetDeployment provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetDeployment(ctx context.Context, in *gen.GetDeploymentRequest, opts ...grpc.CallOption) (*gen.Deployment, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1019,17 +1139,17 @@ func (_m *WaypointClient) GetServerConfig(ctx context.Context, in *emptypb.Empty
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.GetServerConfigResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) *gen.GetServerConfigResponse); ok {
+	var r0 *gen.Deployment
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetDeploymentRequest, ...grpc.CallOption) *gen.Deployment); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.GetServerConfigResponse)
+			r0 = ret.Get(0).(*gen.Deployment)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetDeploymentRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1038,8 +1158,8 @@ func (_m *WaypointClient) GetServerConfig(ctx context.Context, in *emptypb.Empty
 	return r0, r1
 }
 
-// GetStatusReport provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) GetStatusReport(ctx context.Context, in *gen.GetStatusReportRequest, opts ...grpc.CallOption) (*gen.StatusReport, error) {
+// GetDeploymentDiff provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetDeploymentDiff(ctx context.Context, in *gen.GetDeploymentDiffRequest, opts ...grpc.CallOption) (*gen.GetDeploymentDiffResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1049,17 +1169,17 @@ func (_m *WaypointClient) GetStatusReport(ctx context.Context, in *gen.GetStatus
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.StatusReport
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetStatusReportRequest, ...grpc.CallOption) *gen.StatusReport); ok {
+	var r0 *gen.GetDeploymentDiffResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetDeploymentDiffRequest, ...grpc.CallOption) *gen.GetDeploymentDiffResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.StatusReport)
+			r0 = ret.Get(0).(*gen.GetDeploymentDiffResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetStatusReportRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetDeploymentDiffRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1068,8 +1188,8 @@ func (_m *WaypointClient) GetStatusReport(ctx context.Context, in *gen.GetStatus
 	return r0, r1
 }
 
-// GetUser provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) GetUser(ctx context.Context, in *gen.GetUserRequest, opts ...grpc.CallOption) (*gen.GetUserResponse, error) {
+// GetExecSession provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetExecSession(ctx context.Context, in *gen.GetExecSessionRequest, opts ...grpc.CallOption) (*gen.ExecSession, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1079,17 +1199,17 @@ func (_m *WaypointClient) GetUser(ctx context.Context, in *gen.GetUserRequest, o
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.GetUserResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetUserRequest, ...grpc.CallOption) *gen.GetUserResponse); ok {
+	var r0 *gen.ExecSession
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetExecSessionRequest, ...grpc.CallOption) *gen.ExecSession); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.GetUserResponse)
+			r0 = ret.Get(0).(*gen.ExecSession)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetUserRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetExecSessionRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1098,8 +1218,8 @@ func (_m *WaypointClient) GetUser(ctx context.Context, in *gen.GetUserRequest, o
 	return r0, r1
 }
 
-// GetVersionInfo provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) GetVersionInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*gen.GetVersionInfoResponse, error) {
+// GetJob provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetJob(ctx context.Context, in *gen.GetJobRequest, opts ...grpc.CallOption) (*gen.Job, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1109,17 +1229,17 @@ func (_m *WaypointClient) GetVersionInfo(ctx context.Context, in *emptypb.Empty,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.GetVersionInfoResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) *gen.GetVersionInfoResponse); ok {
+	var r0 *gen.Job
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetJobRequest, ...grpc.CallOption) *gen.Job); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.GetVersionInfoResponse)
+			r0 = ret.Get(0).(*gen.Job)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetJobRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1128,8 +1248,8 @@ func (_m *WaypointClient) GetVersionInfo(ctx context.Context, in *emptypb.Empty,
 	return r0, r1
 }
 
-// GetWorkspace provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) GetWorkspace(ctx context.Context, in *gen.GetWorkspaceRequest, opts ...grpc.CallOption) (*gen.GetWorkspaceResponse, error) {
+// GetJobLogs provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetJobLogs(ctx context.Context, in *gen.GetJobLogsRequest, opts ...grpc.CallOption) (gen.Waypoint_GetJobLogsClient, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1139,17 +1259,17 @@ func (_m *WaypointClient) GetWorkspace(ctx context.Context, in *gen.GetWorkspace
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.GetWorkspaceResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetWorkspaceRequest, ...grpc.CallOption) *gen.GetWorkspaceResponse); ok {
+	var r0 gen.Waypoint_GetJobLogsClient
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetJobLogsRequest, ...grpc.CallOption) gen.Waypoint_GetJobLogsClient); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.GetWorkspaceResponse)
+			r0 = ret.Get(0).(gen.Waypoint_GetJobLogsClient)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetWorkspaceRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetJobLogsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1158,8 +1278,8 @@ func (_m *WaypointClient) GetWorkspace(ctx context.Context, in *gen.GetWorkspace
 	return r0, r1
 }
 
-// ListAuthMethods provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) ListAuthMethods(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*gen.ListAuthMethodsResponse, error) {
+// GetJobSchedule provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetJobSchedule(ctx context.Context, in *gen.GetJobScheduleRequest, opts ...grpc.CallOption) (*gen.JobSchedule, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1169,17 +1289,17 @@ func (_m *WaypointClient) ListAuthMethods(ctx context.Context, in *emptypb.Empty
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.ListAuthMethodsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) *gen.ListAuthMethodsResponse); ok {
+	var r0 *gen.JobSchedule
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetJobScheduleRequest, ...grpc.CallOption) *gen.JobSchedule); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ListAuthMethodsResponse)
+			r0 = ret.Get(0).(*gen.JobSchedule)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetJobScheduleRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1188,8 +1308,8 @@ func (_m *WaypointClient) ListAuthMethods(ctx context.Context, in *emptypb.Empty
 	return r0, r1
 }
 
-// ListBuilds provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) ListBuilds(ctx context.Context, in *gen.ListBuildsRequest, opts ...grpc.CallOption) (*gen.ListBuildsResponse, error) {
+// GetJobStream provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetJobStream(ctx context.Context, in *gen.GetJobStreamRequest, opts ...grpc.CallOption) (gen.Waypoint_GetJobStreamClient, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1199,17 +1319,17 @@ func (_m *WaypointClient) ListBuilds(ctx context.Context, in *gen.ListBuildsRequ
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.ListBuildsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListBuildsRequest, ...grpc.CallOption) *gen.ListBuildsResponse); ok {
+	var r0 gen.Waypoint_GetJobStreamClient
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetJobStreamRequest, ...grpc.CallOption) gen.Waypoint_GetJobStreamClient); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ListBuildsResponse)
+			r0 = ret.Get(0).(gen.Waypoint_GetJobStreamClient)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListBuildsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetJobStreamRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1218,8 +1338,8 @@ func (_m *WaypointClient) ListBuilds(ctx context.Context, in *gen.ListBuildsRequ
 	return r0, r1
 }
 
-// ListDeployments provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) ListDeployments(ctx context.Context, in *gen.ListDeploymentsRequest, opts ...grpc.CallOption) (*gen.ListDeploymentsResponse, error) {
+// GetLatestBuild provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetLatestBuild(ctx context.Context, in *gen.GetLatestBuildRequest, opts ...grpc.CallOption) (*gen.Build, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1229,17 +1349,17 @@ func (_m *WaypointClient) ListDeployments(ctx context.Context, in *gen.ListDeplo
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.ListDeploymentsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListDeploymentsRequest, ...grpc.CallOption) *gen.ListDeploymentsResponse); ok {
+	var r0 *gen.Build
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetLatestBuildRequest, ...grpc.CallOption) *gen.Build); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ListDeploymentsResponse)
+			r0 = ret.Get(0).(*gen.Build)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListDeploymentsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetLatestBuildRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1248,8 +1368,8 @@ func (_m *WaypointClient) ListDeployments(ctx context.Context, in *gen.ListDeplo
 	return r0, r1
 }
 
-// ListHostnames provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) ListHostnames(ctx context.Context, in *gen.ListHostnamesRequest, opts ...grpc.CallOption) (*gen.ListHostnamesResponse, error) {
+// GetLatestPushedArtifact provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetLatestPushedArtifact(ctx context.Context, in *gen.GetLatestPushedArtifactRequest, opts ...grpc.CallOption) (*gen.PushedArtifact, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1259,17 +1379,17 @@ func (_m *WaypointClient) ListHostnames(ctx context.Context, in *gen.ListHostnam
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.ListHostnamesResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListHostnamesRequest, ...grpc.CallOption) *gen.ListHostnamesResponse); ok {
+	var r0 *gen.PushedArtifact
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetLatestPushedArtifactRequest, ...grpc.CallOption) *gen.PushedArtifact); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ListHostnamesResponse)
+			r0 = ret.Get(0).(*gen.PushedArtifact)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListHostnamesRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetLatestPushedArtifactRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1278,8 +1398,8 @@ func (_m *WaypointClient) ListHostnames(ctx context.Context, in *gen.ListHostnam
 	return r0, r1
 }
 
-// ListInstances provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) ListInstances(ctx context.Context, in *gen.ListInstancesRequest, opts ...grpc.CallOption) (*gen.ListInstancesResponse, error) {
+// GetLatestRelease provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetLatestRelease(ctx context.Context, in *gen.GetLatestReleaseRequest, opts ...grpc.CallOption) (*gen.Release, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1289,17 +1409,17 @@ func (_m *WaypointClient) ListInstances(ctx context.Context, in *gen.ListInstanc
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.ListInstancesResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListInstancesRequest, ...grpc.CallOption) *gen.ListInstancesResponse); ok {
+	var r0 *gen.Release
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetLatestReleaseRequest, ...grpc.CallOption) *gen.Release); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ListInstancesResponse)
+			r0 = ret.Get(0).(*gen.Release)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListInstancesRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetLatestReleaseRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1308,8 +1428,8 @@ func (_m *WaypointClient) ListInstances(ctx context.Context, in *gen.ListInstanc
 	return r0, r1
 }
 
-// ListOIDCAuthMethods provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) ListOIDCAuthMethods(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*gen.ListOIDCAuthMethodsResponse, error) {
+// GetLatestStatusReport provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetLatestStatusReport(ctx context.Context, in *gen.GetLatestStatusReportRequest, opts ...grpc.CallOption) (*gen.StatusReport, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1319,17 +1439,17 @@ func (_m *WaypointClient) ListOIDCAuthMethods(ctx context.Context, in *emptypb.E
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.ListOIDCAuthMethodsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) *gen.ListOIDCAuthMethodsResponse); ok {
+	var r0 *gen.StatusReport
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetLatestStatusReportRequest, ...grpc.CallOption) *gen.StatusReport); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ListOIDCAuthMethodsResponse)
+			r0 = ret.Get(0).(*gen.StatusReport)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetLatestStatusReportRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1338,8 +1458,8 @@ func (_m *WaypointClient) ListOIDCAuthMethods(ctx context.Context, in *emptypb.E
 	return r0, r1
 }
 
-// ListProjects provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) ListProjects(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*gen.ListProjectsResponse, error) {
+// GetLogStream provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetLogStream(ctx context.Context, in *gen.GetLogStreamRequest, opts ...grpc.CallOption) (gen.Waypoint_GetLogStreamClient, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1349,17 +1469,17 @@ func (_m *WaypointClient) ListProjects(ctx context.Context, in *emptypb.Empty, o
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.ListProjectsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) *gen.ListProjectsResponse); ok {
+	var r0 gen.Waypoint_GetLogStreamClient
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetLogStreamRequest, ...grpc.CallOption) gen.Waypoint_GetLogStreamClient); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ListProjectsResponse)
+			r0 = ret.Get(0).(gen.Waypoint_GetLogStreamClient)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetLogStreamRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1368,8 +1488,8 @@ func (_m *WaypointClient) ListProjects(ctx context.Context, in *emptypb.Empty, o
 	return r0, r1
 }
 
-// ListPushedArtifacts provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) ListPushedArtifacts(ctx context.Context, in *gen.ListPushedArtifactsRequest, opts ...grpc.CallOption) (*gen.ListPushedArtifactsResponse, error) {
+// GetNotifier provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetNotifier(ctx context.Context, in *gen.GetNotifierRequest, opts ...grpc.CallOption) (*gen.Notifier, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1379,17 +1499,17 @@ func (_m *WaypointClient) ListPushedArtifacts(ctx context.Context, in *gen.ListP
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.ListPushedArtifactsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListPushedArtifactsRequest, ...grpc.CallOption) *gen.ListPushedArtifactsResponse); ok {
+	var r0 *gen.Notifier
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetNotifierRequest, ...grpc.CallOption) *gen.Notifier); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ListPushedArtifactsResponse)
+			r0 = ret.Get(0).(*gen.Notifier)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListPushedArtifactsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetNotifierRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1398,8 +1518,8 @@ func (_m *WaypointClient) ListPushedArtifacts(ctx context.Context, in *gen.ListP
 	return r0, r1
 }
 
-// ListReleases provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) ListReleases(ctx context.Context, in *gen.ListReleasesRequest, opts ...grpc.CallOption) (*gen.ListReleasesResponse, error) {
+// GetOIDCAuthURL provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetOIDCAuthURL(ctx context.Context, in *gen.GetOIDCAuthURLRequest, opts ...grpc.CallOption) (*gen.GetOIDCAuthURLResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1409,17 +1529,17 @@ func (_m *WaypointClient) ListReleases(ctx context.Context, in *gen.ListReleases
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.ListReleasesResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListReleasesRequest, ...grpc.CallOption) *gen.ListReleasesResponse); ok {
+	var r0 *gen.GetOIDCAuthURLResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetOIDCAuthURLRequest, ...grpc.CallOption) *gen.GetOIDCAuthURLResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ListReleasesResponse)
+			r0 = ret.Get(0).(*gen.GetOIDCAuthURLResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListReleasesRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetOIDCAuthURLRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1428,8 +1548,8 @@ func (_m *WaypointClient) ListReleases(ctx context.Context, in *gen.ListReleases
 	return r0, r1
 }
 
-// ListStatusReports provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) ListStatusReports(ctx context.Context, in *gen.ListStatusReportsRequest, opts ...grpc.CallOption) (*gen.ListStatusReportsResponse, error) {
+// GetOnDemandRunnerConfig provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetOnDemandRunnerConfig(ctx context.Context, in *gen.GetOnDemandRunnerConfigRequest, opts ...grpc.CallOption) (*gen.GetOnDemandRunnerConfigResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1439,17 +1559,17 @@ func (_m *WaypointClient) ListStatusReports(ctx context.Context, in *gen.ListSta
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.ListStatusReportsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListStatusReportsRequest, ...grpc.CallOption) *gen.ListStatusReportsResponse); ok {
+	var r0 *gen.GetOnDemandRunnerConfigResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetOnDemandRunnerConfigRequest, ...grpc.CallOption) *gen.GetOnDemandRunnerConfigResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ListStatusReportsResponse)
+			r0 = ret.Get(0).(*gen.GetOnDemandRunnerConfigResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListStatusReportsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetOnDemandRunnerConfigRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1458,8 +1578,8 @@ func (_m *WaypointClient) ListStatusReports(ctx context.Context, in *gen.ListSta
 	return r0, r1
 }
 
-// ListUsers provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) ListUsers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*gen.ListUsersResponse, error) {
+// GetPipelineRun provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetPipelineRun(ctx context.Context, in *gen.GetPipelineRunRequest, opts ...grpc.CallOption) (*gen.PipelineRun, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1469,17 +1589,17 @@ func (_m *WaypointClient) ListUsers(ctx context.Context, in *emptypb.Empty, opts
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.ListUsersResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) *gen.ListUsersResponse); ok {
+	var r0 *gen.PipelineRun
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetPipelineRunRequest, ...grpc.CallOption) *gen.PipelineRun); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ListUsersResponse)
+			r0 = ret.Get(0).(*gen.PipelineRun)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetPipelineRunRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1488,8 +1608,8 @@ func (_m *WaypointClient) ListUsers(ctx context.Context, in *emptypb.Empty, opts
 	return r0, r1
 }
 
-// ListWorkspaces provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) ListWorkspaces(ctx context.Context, in *gen.ListWorkspacesRequest, opts ...grpc.CallOption) (*gen.ListWorkspacesResponse, error) {
+// GetProject provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetProject(ctx context.Context, in *gen.GetProjectRequest, opts ...grpc.CallOption) (*gen.GetProjectResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1499,17 +1619,17 @@ func (_m *WaypointClient) ListWorkspaces(ctx context.Context, in *gen.ListWorksp
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.ListWorkspacesResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListWorkspacesRequest, ...grpc.CallOption) *gen.ListWorkspacesResponse); ok {
+	var r0 *gen.GetProjectResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetProjectRequest, ...grpc.CallOption) *gen.GetProjectResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ListWorkspacesResponse)
+			r0 = ret.Get(0).(*gen.GetProjectResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListWorkspacesRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetProjectRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1518,8 +1638,8 @@ func (_m *WaypointClient) ListWorkspaces(ctx context.Context, in *gen.ListWorksp
 	return r0, r1
 }
 
-// QueueJob provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) QueueJob(ctx context.Context, in *gen.QueueJobRequest, opts ...grpc.CallOption) (*gen.QueueJobResponse, error) {
+// GetProjectStatusSummary provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetProjectStatusSummary(ctx context.Context, in *gen.GetProjectStatusSummaryRequest, opts ...grpc.CallOption) (*gen.GetProjectStatusSummaryResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1529,8 +1649,1178 @@ func (_m *WaypointClient) QueueJob(ctx context.Context, in *gen.QueueJobRequest,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.QueueJobResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.QueueJobRequest, ...grpc.CallOption) *gen.QueueJobResponse); ok {
+	var r0 *gen.GetProjectStatusSummaryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetProjectStatusSummaryRequest, ...grpc.CallOption) *gen.GetProjectStatusSummaryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetProjectStatusSummaryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetProjectStatusSummaryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProjectTemplate provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetProjectTemplate(ctx context.Context, in *gen.GetProjectTemplateRequest, opts ...grpc.CallOption) (*gen.GetProjectTemplateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.GetProjectTemplateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetProjectTemplateRequest, ...grpc.CallOption) *gen.GetProjectTemplateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetProjectTemplateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetProjectTemplateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPushedArtifact provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetPushedArtifact(ctx context.Context, in *gen.GetPushedArtifactRequest, opts ...grpc.CallOption) (*gen.PushedArtifact, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.PushedArtifact
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetPushedArtifactRequest, ...grpc.CallOption) *gen.PushedArtifact); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.PushedArtifact)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetPushedArtifactRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRelease provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetRelease(ctx context.Context, in *gen.GetReleaseRequest, opts ...grpc.CallOption) (*gen.Release, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.Release
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetReleaseRequest, ...grpc.CallOption) *gen.Release); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.Release)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetReleaseRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRunner provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetRunner(ctx context.Context, in *gen.GetRunnerRequest, opts ...grpc.CallOption) (*gen.Runner, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.Runner
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetRunnerRequest, ...grpc.CallOption) *gen.Runner); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.Runner)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetRunnerRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetServerConfig provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetServerConfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*gen.GetServerConfigResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.GetServerConfigResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) *gen.GetServerConfigResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetServerConfigResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetServerStatus provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetServerStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*gen.GetServerStatusResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.GetServerStatusResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) *gen.GetServerStatusResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetServerStatusResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSnapshotStatus provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetSnapshotStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*gen.GetSnapshotStatusResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.GetSnapshotStatusResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) *gen.GetSnapshotStatusResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetSnapshotStatusResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStatusReport provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetStatusReport(ctx context.Context, in *gen.GetStatusReportRequest, opts ...grpc.CallOption) (*gen.StatusReport, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.StatusReport
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetStatusReportRequest, ...grpc.CallOption) *gen.StatusReport); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.StatusReport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetStatusReportRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUser provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetUser(ctx context.Context, in *gen.GetUserRequest, opts ...grpc.CallOption) (*gen.GetUserResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.GetUserResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetUserRequest, ...grpc.CallOption) *gen.GetUserResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetUserResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetUserRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVersionInfo provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetVersionInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*gen.GetVersionInfoResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.GetVersionInfoResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) *gen.GetVersionInfoResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetVersionInfoResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWorkspace provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GetWorkspace(ctx context.Context, in *gen.GetWorkspaceRequest, opts ...grpc.CallOption) (*gen.GetWorkspaceResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.GetWorkspaceResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetWorkspaceRequest, ...grpc.CallOption) *gen.GetWorkspaceResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetWorkspaceResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetWorkspaceRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GrantUserRole provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) GrantUserRole(ctx context.Context, in *gen.UserRoleRequest, opts ...grpc.CallOption) (*gen.UpdateUserResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.UpdateUserResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UserRoleRequest, ...grpc.CallOption) *gen.UpdateUserResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.UpdateUserResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UserRoleRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAppEvents provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListAppEvents(ctx context.Context, in *gen.ListAppEventsRequest, opts ...grpc.CallOption) (*gen.ListAppEventsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListAppEventsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListAppEventsRequest, ...grpc.CallOption) *gen.ListAppEventsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListAppEventsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListAppEventsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAuditEvents provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListAuditEvents(ctx context.Context, in *gen.ListAuditEventsRequest, opts ...grpc.CallOption) (*gen.ListAuditEventsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListAuditEventsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListAuditEventsRequest, ...grpc.CallOption) *gen.ListAuditEventsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListAuditEventsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListAuditEventsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAuthMethods provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListAuthMethods(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*gen.ListAuthMethodsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListAuthMethodsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) *gen.ListAuthMethodsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListAuthMethodsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBuilds provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListBuilds(ctx context.Context, in *gen.ListBuildsRequest, opts ...grpc.CallOption) (*gen.ListBuildsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListBuildsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListBuildsRequest, ...grpc.CallOption) *gen.ListBuildsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListBuildsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListBuildsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDeployments provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListDeployments(ctx context.Context, in *gen.ListDeploymentsRequest, opts ...grpc.CallOption) (*gen.ListDeploymentsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListDeploymentsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListDeploymentsRequest, ...grpc.CallOption) *gen.ListDeploymentsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListDeploymentsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListDeploymentsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExecSessions provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListExecSessions(ctx context.Context, in *gen.ListExecSessionsRequest, opts ...grpc.CallOption) (*gen.ListExecSessionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListExecSessionsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListExecSessionsRequest, ...grpc.CallOption) *gen.ListExecSessionsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListExecSessionsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListExecSessionsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListHostnames provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListHostnames(ctx context.Context, in *gen.ListHostnamesRequest, opts ...grpc.CallOption) (*gen.ListHostnamesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListHostnamesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListHostnamesRequest, ...grpc.CallOption) *gen.ListHostnamesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListHostnamesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListHostnamesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListInstances provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListInstances(ctx context.Context, in *gen.ListInstancesRequest, opts ...grpc.CallOption) (*gen.ListInstancesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListInstancesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListInstancesRequest, ...grpc.CallOption) *gen.ListInstancesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListInstancesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListInstancesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListJobSchedules provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListJobSchedules(ctx context.Context, in *gen.ListJobSchedulesRequest, opts ...grpc.CallOption) (*gen.ListJobSchedulesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListJobSchedulesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListJobSchedulesRequest, ...grpc.CallOption) *gen.ListJobSchedulesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListJobSchedulesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListJobSchedulesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListNotifiers provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListNotifiers(ctx context.Context, in *gen.ListNotifiersRequest, opts ...grpc.CallOption) (*gen.ListNotifiersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListNotifiersResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListNotifiersRequest, ...grpc.CallOption) *gen.ListNotifiersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListNotifiersResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListNotifiersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOIDCAuthMethods provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListOIDCAuthMethods(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*gen.ListOIDCAuthMethodsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListOIDCAuthMethodsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) *gen.ListOIDCAuthMethodsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListOIDCAuthMethodsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOnDemandRunnerConfigs provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListOnDemandRunnerConfigs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*gen.ListOnDemandRunnerConfigsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListOnDemandRunnerConfigsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) *gen.ListOnDemandRunnerConfigsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListOnDemandRunnerConfigsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPipelineRuns provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListPipelineRuns(ctx context.Context, in *gen.ListPipelineRunsRequest, opts ...grpc.CallOption) (*gen.ListPipelineRunsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListPipelineRunsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListPipelineRunsRequest, ...grpc.CallOption) *gen.ListPipelineRunsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListPipelineRunsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListPipelineRunsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPipelines provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListPipelines(ctx context.Context, in *gen.ListPipelinesRequest, opts ...grpc.CallOption) (*gen.ListPipelinesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListPipelinesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListPipelinesRequest, ...grpc.CallOption) *gen.ListPipelinesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListPipelinesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListPipelinesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPlugins provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListPlugins(ctx context.Context, in *gen.ListPluginsRequest, opts ...grpc.CallOption) (*gen.ListPluginsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListPluginsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListPluginsRequest, ...grpc.CallOption) *gen.ListPluginsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListPluginsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListPluginsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPreviews provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListPreviews(ctx context.Context, in *gen.ListPreviewsRequest, opts ...grpc.CallOption) (*gen.ListPreviewsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListPreviewsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListPreviewsRequest, ...grpc.CallOption) *gen.ListPreviewsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListPreviewsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListPreviewsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListProjectTemplates provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListProjectTemplates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*gen.ListProjectTemplatesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListProjectTemplatesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) *gen.ListProjectTemplatesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListProjectTemplatesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListProjects provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListProjects(ctx context.Context, in *gen.ListProjectsRequest, opts ...grpc.CallOption) (*gen.ListProjectsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListProjectsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListProjectsRequest, ...grpc.CallOption) *gen.ListProjectsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListProjectsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListProjectsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPushedArtifacts provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListPushedArtifacts(ctx context.Context, in *gen.ListPushedArtifactsRequest, opts ...grpc.CallOption) (*gen.ListPushedArtifactsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListPushedArtifactsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListPushedArtifactsRequest, ...grpc.CallOption) *gen.ListPushedArtifactsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListPushedArtifactsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListPushedArtifactsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListReleases provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListReleases(ctx context.Context, in *gen.ListReleasesRequest, opts ...grpc.CallOption) (*gen.ListReleasesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListReleasesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListReleasesRequest, ...grpc.CallOption) *gen.ListReleasesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListReleasesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListReleasesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRunners provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListRunners(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*gen.ListRunnersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListRunnersResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) *gen.ListRunnersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListRunnersResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListServiceAccounts provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListServiceAccounts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*gen.ListServiceAccountsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListServiceAccountsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) *gen.ListServiceAccountsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListServiceAccountsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStatusReports provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListStatusReports(ctx context.Context, in *gen.ListStatusReportsRequest, opts ...grpc.CallOption) (*gen.ListStatusReportsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListStatusReportsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListStatusReportsRequest, ...grpc.CallOption) *gen.ListStatusReportsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListStatusReportsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListStatusReportsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUsers provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListUsers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*gen.ListUsersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListUsersResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) *gen.ListUsersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListUsersResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListWorkspaces provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ListWorkspaces(ctx context.Context, in *gen.ListWorkspacesRequest, opts ...grpc.CallOption) (*gen.ListWorkspacesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ListWorkspacesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListWorkspacesRequest, ...grpc.CallOption) *gen.ListWorkspacesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListWorkspacesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListWorkspacesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PruneState provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) PruneState(ctx context.Context, in *gen.PruneStateRequest, opts ...grpc.CallOption) (*gen.PruneStateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.PruneStateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.PruneStateRequest, ...grpc.CallOption) *gen.PruneStateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.PruneStateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.PruneStateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueueJob provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) QueueJob(ctx context.Context, in *gen.QueueJobRequest, opts ...grpc.CallOption) (*gen.QueueJobResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.QueueJobResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.QueueJobRequest, ...grpc.CallOption) *gen.QueueJobResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -1539,7 +2829,547 @@ func (_m *WaypointClient) QueueJob(ctx context.Context, in *gen.QueueJobRequest,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.QueueJobRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.QueueJobRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshToken provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) RefreshToken(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*gen.NewTokenResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.NewTokenResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) *gen.NewTokenResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.NewTokenResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RejectRunner provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) RejectRunner(ctx context.Context, in *gen.RejectRunnerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RejectRunnerRequest, ...grpc.CallOption) *emptypb.Empty); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*emptypb.Empty)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RejectRunnerRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreSnapshot provides a mock function with given fields: ctx, opts
+func (_m *WaypointClient) RestoreSnapshot(ctx context.Context, opts ...grpc.CallOption) (gen.Waypoint_RestoreSnapshotClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 gen.Waypoint_RestoreSnapshotClient
+	if rf, ok := ret.Get(0).(func(context.Context, ...grpc.CallOption) gen.Waypoint_RestoreSnapshotClient); ok {
+		r0 = rf(ctx, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gen.Waypoint_RestoreSnapshotClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeToken provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) RevokeToken(ctx context.Context, in *gen.RevokeTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RevokeTokenRequest, ...grpc.CallOption) *emptypb.Empty); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*emptypb.Empty)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RevokeTokenRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeUserRole provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) RevokeUserRole(ctx context.Context, in *gen.UserRoleRequest, opts ...grpc.CallOption) (*gen.UpdateUserResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.UpdateUserResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UserRoleRequest, ...grpc.CallOption) *gen.UpdateUserResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.UpdateUserResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UserRoleRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RollbackConfig provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) RollbackConfig(ctx context.Context, in *gen.RollbackConfigRequest, opts ...grpc.CallOption) (*gen.RollbackConfigResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.RollbackConfigResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RollbackConfigRequest, ...grpc.CallOption) *gen.RollbackConfigResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RollbackConfigResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RollbackConfigRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RotateConfigEncryptionKey provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) RotateConfigEncryptionKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*gen.RotateConfigEncryptionKeyResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.RotateConfigEncryptionKeyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) *gen.RotateConfigEncryptionKeyResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RotateConfigEncryptionKeyResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RunPipeline provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) RunPipeline(ctx context.Context, in *gen.RunPipelineRequest, opts ...grpc.CallOption) (*gen.RunPipelineResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.RunPipelineResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RunPipelineRequest, ...grpc.CallOption) *gen.RunPipelineResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RunPipelineResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RunPipelineRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RunnerConfig provides a mock function with given fields: ctx, opts
+func (_m *WaypointClient) RunnerConfig(ctx context.Context, opts ...grpc.CallOption) (gen.Waypoint_RunnerConfigClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 gen.Waypoint_RunnerConfigClient
+	if rf, ok := ret.Get(0).(func(context.Context, ...grpc.CallOption) gen.Waypoint_RunnerConfigClient); ok {
+		r0 = rf(ctx, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gen.Waypoint_RunnerConfigClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RunnerGetDeploymentConfig provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) RunnerGetDeploymentConfig(ctx context.Context, in *gen.RunnerGetDeploymentConfigRequest, opts ...grpc.CallOption) (*gen.RunnerGetDeploymentConfigResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.RunnerGetDeploymentConfigResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RunnerGetDeploymentConfigRequest, ...grpc.CallOption) *gen.RunnerGetDeploymentConfigResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RunnerGetDeploymentConfigResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RunnerGetDeploymentConfigRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RunnerJobStream provides a mock function with given fields: ctx, opts
+func (_m *WaypointClient) RunnerJobStream(ctx context.Context, opts ...grpc.CallOption) (gen.Waypoint_RunnerJobStreamClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 gen.Waypoint_RunnerJobStreamClient
+	if rf, ok := ret.Get(0).(func(context.Context, ...grpc.CallOption) gen.Waypoint_RunnerJobStreamClient); ok {
+		r0 = rf(ctx, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gen.Waypoint_RunnerJobStreamClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchLogs provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) SearchLogs(ctx context.Context, in *gen.SearchLogsRequest, opts ...grpc.CallOption) (*gen.SearchLogsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.SearchLogsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.SearchLogsRequest, ...grpc.CallOption) *gen.SearchLogsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.SearchLogsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.SearchLogsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetConfig provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) SetConfig(ctx context.Context, in *gen.ConfigSetRequest, opts ...grpc.CallOption) (*gen.ConfigSetResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.ConfigSetResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ConfigSetRequest, ...grpc.CallOption) *gen.ConfigSetResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ConfigSetResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ConfigSetRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetConfigSource provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) SetConfigSource(ctx context.Context, in *gen.SetConfigSourceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.SetConfigSourceRequest, ...grpc.CallOption) *emptypb.Empty); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*emptypb.Empty)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.SetConfigSourceRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetServerConfig provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) SetServerConfig(ctx context.Context, in *gen.SetServerConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.SetServerConfigRequest, ...grpc.CallOption) *emptypb.Empty); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*emptypb.Empty)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.SetServerConfigRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartExecStream provides a mock function with given fields: ctx, opts
+func (_m *WaypointClient) StartExecStream(ctx context.Context, opts ...grpc.CallOption) (gen.Waypoint_StartExecStreamClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 gen.Waypoint_StartExecStreamClient
+	if rf, ok := ret.Get(0).(func(context.Context, ...grpc.CallOption) gen.Waypoint_StartExecStreamClient); ok {
+		r0 = rf(ctx, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gen.Waypoint_StartExecStreamClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TestAuthMethod provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) TestAuthMethod(ctx context.Context, in *gen.TestAuthMethodRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.TestAuthMethodRequest, ...grpc.CallOption) *emptypb.Empty); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*emptypb.Empty)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.TestAuthMethodRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateUser provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) UpdateUser(ctx context.Context, in *gen.UpdateUserRequest, opts ...grpc.CallOption) (*gen.UpdateUserResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *gen.UpdateUserResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpdateUserRequest, ...grpc.CallOption) *gen.UpdateUserResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.UpdateUserResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpdateUserRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1548,8 +3378,8 @@ func (_m *WaypointClient) QueueJob(ctx context.Context, in *gen.QueueJobRequest,
 	return r0, r1
 }
 
-// RestoreSnapshot provides a mock function with given fields: ctx, opts
-func (_m *WaypointClient) RestoreSnapshot(ctx context.Context, opts ...grpc.CallOption) (gen.Waypoint_RestoreSnapshotClient, error) {
+// UploadBuildCache provides a mock function with given fields: ctx, opts
+func (_m *WaypointClient) UploadBuildCache(ctx context.Context, opts ...grpc.CallOption) (gen.Waypoint_UploadBuildCacheClient, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1559,12 +3389,12 @@ func (_m *WaypointClient) RestoreSnapshot(ctx context.Context, opts ...grpc.Call
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 gen.Waypoint_RestoreSnapshotClient
-	if rf, ok := ret.Get(0).(func(context.Context, ...grpc.CallOption) gen.Waypoint_RestoreSnapshotClient); ok {
+	var r0 gen.Waypoint_UploadBuildCacheClient
+	if rf, ok := ret.Get(0).(func(context.Context, ...grpc.CallOption) gen.Waypoint_UploadBuildCacheClient); ok {
 		r0 = rf(ctx, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(gen.Waypoint_RestoreSnapshotClient)
+			r0 = ret.Get(0).(gen.Waypoint_UploadBuildCacheClient)
 		}
 	}
 
@@ -1578,8 +3408,8 @@ func (_m *WaypointClient) RestoreSnapshot(ctx context.Context, opts ...grpc.Call
 	return r0, r1
 }
 
-// RunnerConfig provides a mock function with given fields: ctx, opts
-func (_m *WaypointClient) RunnerConfig(ctx context.Context, opts ...grpc.CallOption) (gen.Waypoint_RunnerConfigClient, error) {
+// UploadPlugin provides a mock function with given fields: ctx, opts
+func (_m *WaypointClient) UploadPlugin(ctx context.Context, opts ...grpc.CallOption) (gen.Waypoint_UploadPluginClient, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1589,12 +3419,12 @@ func (_m *WaypointClient) RunnerConfig(ctx context.Context, opts ...grpc.CallOpt
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 gen.Waypoint_RunnerConfigClient
-	if rf, ok := ret.Get(0).(func(context.Context, ...grpc.CallOption) gen.Waypoint_RunnerConfigClient); ok {
+	var r0 gen.Waypoint_UploadPluginClient
+	if rf, ok := ret.Get(0).(func(context.Context, ...grpc.CallOption) gen.Waypoint_UploadPluginClient); ok {
 		r0 = rf(ctx, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(gen.Waypoint_RunnerConfigClient)
+			r0 = ret.Get(0).(gen.Waypoint_UploadPluginClient)
 		}
 	}
 
@@ -1608,8 +3438,8 @@ func (_m *WaypointClient) RunnerConfig(ctx context.Context, opts ...grpc.CallOpt
 	return r0, r1
 }
 
-// RunnerGetDeploymentConfig provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) RunnerGetDeploymentConfig(ctx context.Context, in *gen.RunnerGetDeploymentConfigRequest, opts ...grpc.CallOption) (*gen.RunnerGetDeploymentConfigResponse, error) {
+// UpsertApplication provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) UpsertApplication(ctx context.Context, in *gen.UpsertApplicationRequest, opts ...grpc.CallOption) (*gen.UpsertApplicationResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1619,17 +3449,17 @@ func (_m *WaypointClient) RunnerGetDeploymentConfig(ctx context.Context, in *gen
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.RunnerGetDeploymentConfigResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.RunnerGetDeploymentConfigRequest, ...grpc.CallOption) *gen.RunnerGetDeploymentConfigResponse); ok {
+	var r0 *gen.UpsertApplicationResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertApplicationRequest, ...grpc.CallOption) *gen.UpsertApplicationResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.RunnerGetDeploymentConfigResponse)
+			r0 = ret.Get(0).(*gen.UpsertApplicationResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.RunnerGetDeploymentConfigRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertApplicationRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1638,29 +3468,29 @@ func (_m *WaypointClient) RunnerGetDeploymentConfig(ctx context.Context, in *gen
 	return r0, r1
 }
 
-// RunnerJobStream provides a mock function with given fields: ctx, opts
-func (_m *WaypointClient) RunnerJobStream(ctx context.Context, opts ...grpc.CallOption) (gen.Waypoint_RunnerJobStreamClient, error) {
+// UpsertAuthMethod provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) UpsertAuthMethod(ctx context.Context, in *gen.UpsertAuthMethodRequest, opts ...grpc.CallOption) (*gen.UpsertAuthMethodResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, ctx)
+	_ca = append(_ca, ctx, in)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 gen.Waypoint_RunnerJobStreamClient
-	if rf, ok := ret.Get(0).(func(context.Context, ...grpc.CallOption) gen.Waypoint_RunnerJobStreamClient); ok {
-		r0 = rf(ctx, opts...)
+	var r0 *gen.UpsertAuthMethodResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertAuthMethodRequest, ...grpc.CallOption) *gen.UpsertAuthMethodResponse); ok {
+		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(gen.Waypoint_RunnerJobStreamClient)
+			r0 = ret.Get(0).(*gen.UpsertAuthMethodResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, ...grpc.CallOption) error); ok {
-		r1 = rf(ctx, opts...)
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertAuthMethodRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1668,8 +3498,8 @@ func (_m *WaypointClient) RunnerJobStream(ctx context.Context, opts ...grpc.Call
 	return r0, r1
 }
 
-// SetConfig provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) SetConfig(ctx context.Context, in *gen.ConfigSetRequest, opts ...grpc.CallOption) (*gen.ConfigSetResponse, error) {
+// UpsertBuild provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) UpsertBuild(ctx context.Context, in *gen.UpsertBuildRequest, opts ...grpc.CallOption) (*gen.UpsertBuildResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1679,17 +3509,17 @@ func (_m *WaypointClient) SetConfig(ctx context.Context, in *gen.ConfigSetReques
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.ConfigSetResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.ConfigSetRequest, ...grpc.CallOption) *gen.ConfigSetResponse); ok {
+	var r0 *gen.UpsertBuildResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertBuildRequest, ...grpc.CallOption) *gen.UpsertBuildResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ConfigSetResponse)
+			r0 = ret.Get(0).(*gen.UpsertBuildResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.ConfigSetRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertBuildRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1698,8 +3528,8 @@ func (_m *WaypointClient) SetConfig(ctx context.Context, in *gen.ConfigSetReques
 	return r0, r1
 }
 
-// SetConfigSource provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) SetConfigSource(ctx context.Context, in *gen.SetConfigSourceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+// UpsertDeployment provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) UpsertDeployment(ctx context.Context, in *gen.UpsertDeploymentRequest, opts ...grpc.CallOption) (*gen.UpsertDeploymentResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1709,17 +3539,17 @@ func (_m *WaypointClient) SetConfigSource(ctx context.Context, in *gen.SetConfig
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *emptypb.Empty
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.SetConfigSourceRequest, ...grpc.CallOption) *emptypb.Empty); ok {
+	var r0 *gen.UpsertDeploymentResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertDeploymentRequest, ...grpc.CallOption) *gen.UpsertDeploymentResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*emptypb.Empty)
+			r0 = ret.Get(0).(*gen.UpsertDeploymentResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.SetConfigSourceRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertDeploymentRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1728,8 +3558,8 @@ func (_m *WaypointClient) SetConfigSource(ctx context.Context, in *gen.SetConfig
 	return r0, r1
 }
 
-// SetServerConfig provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) SetServerConfig(ctx context.Context, in *gen.SetServerConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+// UpsertJobSchedule provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) UpsertJobSchedule(ctx context.Context, in *gen.UpsertJobScheduleRequest, opts ...grpc.CallOption) (*gen.UpsertJobScheduleResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1739,17 +3569,17 @@ func (_m *WaypointClient) SetServerConfig(ctx context.Context, in *gen.SetServer
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *emptypb.Empty
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.SetServerConfigRequest, ...grpc.CallOption) *emptypb.Empty); ok {
+	var r0 *gen.UpsertJobScheduleResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertJobScheduleRequest, ...grpc.CallOption) *gen.UpsertJobScheduleResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*emptypb.Empty)
+			r0 = ret.Get(0).(*gen.UpsertJobScheduleResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.SetServerConfigRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertJobScheduleRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1758,29 +3588,29 @@ func (_m *WaypointClient) SetServerConfig(ctx context.Context, in *gen.SetServer
 	return r0, r1
 }
 
-// StartExecStream provides a mock function with given fields: ctx, opts
-func (_m *WaypointClient) StartExecStream(ctx context.Context, opts ...grpc.CallOption) (gen.Waypoint_StartExecStreamClient, error) {
+// UpsertNotifier provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) UpsertNotifier(ctx context.Context, in *gen.UpsertNotifierRequest, opts ...grpc.CallOption) (*gen.UpsertNotifierResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, ctx)
+	_ca = append(_ca, ctx, in)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 gen.Waypoint_StartExecStreamClient
-	if rf, ok := ret.Get(0).(func(context.Context, ...grpc.CallOption) gen.Waypoint_StartExecStreamClient); ok {
-		r0 = rf(ctx, opts...)
+	var r0 *gen.UpsertNotifierResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertNotifierRequest, ...grpc.CallOption) *gen.UpsertNotifierResponse); ok {
+		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(gen.Waypoint_StartExecStreamClient)
+			r0 = ret.Get(0).(*gen.UpsertNotifierResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, ...grpc.CallOption) error); ok {
-		r1 = rf(ctx, opts...)
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertNotifierRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1788,8 +3618,8 @@ func (_m *WaypointClient) StartExecStream(ctx context.Context, opts ...grpc.Call
 	return r0, r1
 }
 
-// UpdateUser provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) UpdateUser(ctx context.Context, in *gen.UpdateUserRequest, opts ...grpc.CallOption) (*gen.UpdateUserResponse, error) {
+// UpsertOnDemandRunnerConfig provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) UpsertOnDemandRunnerConfig(ctx context.Context, in *gen.UpsertOnDemandRunnerConfigRequest, opts ...grpc.CallOption) (*gen.UpsertOnDemandRunnerConfigResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1799,17 +3629,17 @@ func (_m *WaypointClient) UpdateUser(ctx context.Context, in *gen.UpdateUserRequ
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.UpdateUserResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpdateUserRequest, ...grpc.CallOption) *gen.UpdateUserResponse); ok {
+	var r0 *gen.UpsertOnDemandRunnerConfigResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertOnDemandRunnerConfigRequest, ...grpc.CallOption) *gen.UpsertOnDemandRunnerConfigResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.UpdateUserResponse)
+			r0 = ret.Get(0).(*gen.UpsertOnDemandRunnerConfigResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpdateUserRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertOnDemandRunnerConfigRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1818,8 +3648,8 @@ func (_m *WaypointClient) UpdateUser(ctx context.Context, in *gen.UpdateUserRequ
 	return r0, r1
 }
 
-// UpsertApplication provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) UpsertApplication(ctx context.Context, in *gen.UpsertApplicationRequest, opts ...grpc.CallOption) (*gen.UpsertApplicationResponse, error) {
+// UpsertPipeline provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) UpsertPipeline(ctx context.Context, in *gen.UpsertPipelineRequest, opts ...grpc.CallOption) (*gen.UpsertPipelineResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1829,17 +3659,17 @@ func (_m *WaypointClient) UpsertApplication(ctx context.Context, in *gen.UpsertA
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.UpsertApplicationResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertApplicationRequest, ...grpc.CallOption) *gen.UpsertApplicationResponse); ok {
+	var r0 *gen.UpsertPipelineResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertPipelineRequest, ...grpc.CallOption) *gen.UpsertPipelineResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.UpsertApplicationResponse)
+			r0 = ret.Get(0).(*gen.UpsertPipelineResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertApplicationRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertPipelineRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1848,8 +3678,8 @@ func (_m *WaypointClient) UpsertApplication(ctx context.Context, in *gen.UpsertA
 	return r0, r1
 }
 
-// UpsertAuthMethod provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) UpsertAuthMethod(ctx context.Context, in *gen.UpsertAuthMethodRequest, opts ...grpc.CallOption) (*gen.UpsertAuthMethodResponse, error) {
+// UpsertProject provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) UpsertProject(ctx context.Context, in *gen.UpsertProjectRequest, opts ...grpc.CallOption) (*gen.UpsertProjectResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1859,17 +3689,17 @@ func (_m *WaypointClient) UpsertAuthMethod(ctx context.Context, in *gen.UpsertAu
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.UpsertAuthMethodResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertAuthMethodRequest, ...grpc.CallOption) *gen.UpsertAuthMethodResponse); ok {
+	var r0 *gen.UpsertProjectResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertProjectRequest, ...grpc.CallOption) *gen.UpsertProjectResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.UpsertAuthMethodResponse)
+			r0 = ret.Get(0).(*gen.UpsertProjectResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertAuthMethodRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertProjectRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1878,8 +3708,8 @@ func (_m *WaypointClient) UpsertAuthMethod(ctx context.Context, in *gen.UpsertAu
 	return r0, r1
 }
 
-// UpsertBuild provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) UpsertBuild(ctx context.Context, in *gen.UpsertBuildRequest, opts ...grpc.CallOption) (*gen.UpsertBuildResponse, error) {
+// UpsertProjectTemplate provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) UpsertProjectTemplate(ctx context.Context, in *gen.UpsertProjectTemplateRequest, opts ...grpc.CallOption) (*gen.UpsertProjectTemplateResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1889,17 +3719,17 @@ func (_m *WaypointClient) UpsertBuild(ctx context.Context, in *gen.UpsertBuildRe
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.UpsertBuildResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertBuildRequest, ...grpc.CallOption) *gen.UpsertBuildResponse); ok {
+	var r0 *gen.UpsertProjectTemplateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertProjectTemplateRequest, ...grpc.CallOption) *gen.UpsertProjectTemplateResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.UpsertBuildResponse)
+			r0 = ret.Get(0).(*gen.UpsertProjectTemplateResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertBuildRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertProjectTemplateRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1908,8 +3738,8 @@ func (_m *WaypointClient) UpsertBuild(ctx context.Context, in *gen.UpsertBuildRe
 	return r0, r1
 }
 
-// UpsertDeployment provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) UpsertDeployment(ctx context.Context, in *gen.UpsertDeploymentRequest, opts ...grpc.CallOption) (*gen.UpsertDeploymentResponse, error) {
+// UpsertPushedArtifact provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) UpsertPushedArtifact(ctx context.Context, in *gen.UpsertPushedArtifactRequest, opts ...grpc.CallOption) (*gen.UpsertPushedArtifactResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1919,17 +3749,17 @@ func (_m *WaypointClient) UpsertDeployment(ctx context.Context, in *gen.UpsertDe
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.UpsertDeploymentResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertDeploymentRequest, ...grpc.CallOption) *gen.UpsertDeploymentResponse); ok {
+	var r0 *gen.UpsertPushedArtifactResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertPushedArtifactRequest, ...grpc.CallOption) *gen.UpsertPushedArtifactResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.UpsertDeploymentResponse)
+			r0 = ret.Get(0).(*gen.UpsertPushedArtifactResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertDeploymentRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertPushedArtifactRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1938,8 +3768,8 @@ func (_m *WaypointClient) UpsertDeployment(ctx context.Context, in *gen.UpsertDe
 	return r0, r1
 }
 
-// UpsertProject provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) UpsertProject(ctx context.Context, in *gen.UpsertProjectRequest, opts ...grpc.CallOption) (*gen.UpsertProjectResponse, error) {
+// UpsertRelease provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) UpsertRelease(ctx context.Context, in *gen.UpsertReleaseRequest, opts ...grpc.CallOption) (*gen.UpsertReleaseResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1949,17 +3779,17 @@ func (_m *WaypointClient) UpsertProject(ctx context.Context, in *gen.UpsertProje
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.UpsertProjectResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertProjectRequest, ...grpc.CallOption) *gen.UpsertProjectResponse); ok {
+	var r0 *gen.UpsertReleaseResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertReleaseRequest, ...grpc.CallOption) *gen.UpsertReleaseResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.UpsertProjectResponse)
+			r0 = ret.Get(0).(*gen.UpsertReleaseResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertProjectRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertReleaseRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1968,8 +3798,8 @@ func (_m *WaypointClient) UpsertProject(ctx context.Context, in *gen.UpsertProje
 	return r0, r1
 }
 
-// UpsertPushedArtifact provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) UpsertPushedArtifact(ctx context.Context, in *gen.UpsertPushedArtifactRequest, opts ...grpc.CallOption) (*gen.UpsertPushedArtifactResponse, error) {
+// UpsertStatusReport provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) UpsertStatusReport(ctx context.Context, in *gen.UpsertStatusReportRequest, opts ...grpc.CallOption) (*gen.UpsertStatusReportResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1979,17 +3809,17 @@ func (_m *WaypointClient) UpsertPushedArtifact(ctx context.Context, in *gen.Upse
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.UpsertPushedArtifactResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertPushedArtifactRequest, ...grpc.CallOption) *gen.UpsertPushedArtifactResponse); ok {
+	var r0 *gen.UpsertStatusReportResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertStatusReportRequest, ...grpc.CallOption) *gen.UpsertStatusReportResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.UpsertPushedArtifactResponse)
+			r0 = ret.Get(0).(*gen.UpsertStatusReportResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertPushedArtifactRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertStatusReportRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1998,8 +3828,8 @@ func (_m *WaypointClient) UpsertPushedArtifact(ctx context.Context, in *gen.Upse
 	return r0, r1
 }
 
-// UpsertRelease provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) UpsertRelease(ctx context.Context, in *gen.UpsertReleaseRequest, opts ...grpc.CallOption) (*gen.UpsertReleaseResponse, error) {
+// UpsertWorkspace provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) UpsertWorkspace(ctx context.Context, in *gen.UpsertWorkspaceRequest, opts ...grpc.CallOption) (*gen.UpsertWorkspaceResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2009,17 +3839,17 @@ func (_m *WaypointClient) UpsertRelease(ctx context.Context, in *gen.UpsertRelea
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.UpsertReleaseResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertReleaseRequest, ...grpc.CallOption) *gen.UpsertReleaseResponse); ok {
+	var r0 *gen.UpsertWorkspaceResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertWorkspaceRequest, ...grpc.CallOption) *gen.UpsertWorkspaceResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.UpsertReleaseResponse)
+			r0 = ret.Get(0).(*gen.UpsertWorkspaceResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertReleaseRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertWorkspaceRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2028,8 +3858,8 @@ func (_m *WaypointClient) UpsertRelease(ctx context.Context, in *gen.UpsertRelea
 	return r0, r1
 }
 
-// UpsertStatusReport provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) UpsertStatusReport(ctx context.Context, in *gen.UpsertStatusReportRequest, opts ...grpc.CallOption) (*gen.UpsertStatusReportResponse, error) {
+// ValidateJob provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ValidateJob(ctx context.Context, in *gen.ValidateJobRequest, opts ...grpc.CallOption) (*gen.ValidateJobResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2039,17 +3869,17 @@ func (_m *WaypointClient) UpsertStatusReport(ctx context.Context, in *gen.Upsert
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.UpsertStatusReportResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertStatusReportRequest, ...grpc.CallOption) *gen.UpsertStatusReportResponse); ok {
+	var r0 *gen.ValidateJobResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ValidateJobRequest, ...grpc.CallOption) *gen.ValidateJobResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.UpsertStatusReportResponse)
+			r0 = ret.Get(0).(*gen.ValidateJobResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertStatusReportRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ValidateJobRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2058,8 +3888,8 @@ func (_m *WaypointClient) UpsertStatusReport(ctx context.Context, in *gen.Upsert
 	return r0, r1
 }
 
-// ValidateJob provides a mock function with given fields: ctx, in, opts
-func (_m *WaypointClient) ValidateJob(ctx context.Context, in *gen.ValidateJobRequest, opts ...grpc.CallOption) (*gen.ValidateJobResponse, error) {
+// ValidateWaypointHcl provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) ValidateWaypointHcl(ctx context.Context, in *gen.ValidateWaypointHclRequest, opts ...grpc.CallOption) (*gen.ValidateWaypointHclResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2069,17 +3899,107 @@ func (_m *WaypointClient) ValidateJob(ctx context.Context, in *gen.ValidateJobRe
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *gen.ValidateJobResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.ValidateJobRequest, ...grpc.CallOption) *gen.ValidateJobResponse); ok {
+	var r0 *gen.ValidateWaypointHclResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ValidateWaypointHclRequest, ...grpc.CallOption) *gen.ValidateWaypointHclResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ValidateJobResponse)
+			r0 = ret.Get(0).(*gen.ValidateWaypointHclResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.ValidateJobRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ValidateWaypointHclRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WatchJobs provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) WatchJobs(ctx context.Context, in *gen.ListJobsRequest, opts ...grpc.CallOption) (gen.Waypoint_WatchJobsClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 gen.Waypoint_WatchJobsClient
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListJobsRequest, ...grpc.CallOption) gen.Waypoint_WatchJobsClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gen.Waypoint_WatchJobsClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListJobsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WatchProjects provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) WatchProjects(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (gen.Waypoint_WatchProjectsClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 gen.Waypoint_WatchProjectsClient
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) gen.Waypoint_WatchProjectsClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gen.Waypoint_WatchProjectsClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WatchStatusReports provides a mock function with given fields: ctx, in, opts
+func (_m *WaypointClient) WatchStatusReports(ctx context.Context, in *gen.ListStatusReportsRequest, opts ...grpc.CallOption) (gen.Waypoint_WatchStatusReportsClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 gen.Waypoint_WatchStatusReportsClient
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListStatusReportsRequest, ...grpc.CallOption) gen.Waypoint_WatchStatusReportsClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gen.Waypoint_WatchStatusReportsClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListStatusReportsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -16,6 +16,52 @@ type WaypointServer struct {
 	mock.Mock
 }
 
+// AdoptRunner provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) AdoptRunner(_a0 context.Context, _a1 *gen.AdoptRunnerRequest) (*emptypb.Empty, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AdoptRunnerRequest) *emptypb.Empty); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*emptypb.Empty)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.AdoptRunnerRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovePipelineRun provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ApprovePipelineRun(_a0 context.Context, _a1 *gen.ApprovePipelineRunRequest) (*gen.PipelineRun, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.PipelineRun
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ApprovePipelineRunRequest) *gen.PipelineRun); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.PipelineRun)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ApprovePipelineRunRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BootstrapToken provides a mock function with given fields: _a0, _a1
 func (_m *WaypointServer) BootstrapToken(_a0 context.Context, _a1 *emptypb.Empty) (*gen.NewTokenResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -85,6 +131,29 @@ func (_m *WaypointServer) CompleteOIDCAuth(_a0 context.Context, _a1 *gen.Complet
 	return r0, r1
 }
 
+// CompletePATAuth provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) CompletePATAuth(_a0 context.Context, _a1 *gen.CompletePATAuthRequest) (*gen.CompletePATAuthResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.CompletePATAuthResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.CompletePATAuthRequest) *gen.CompletePATAuthResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.CompletePATAuthResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.CompletePATAuthRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ConvertInviteToken provides a mock function with given fields: _a0, _a1
 func (_m *WaypointServer) ConvertInviteToken(_a0 context.Context, _a1 *gen.ConvertInviteTokenRequest) (*gen.NewTokenResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -131,6 +200,29 @@ func (_m *WaypointServer) CreateHostname(_a0 context.Context, _a1 *gen.CreateHos
 	return r0, r1
 }
 
+// CreateServiceAccount provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) CreateServiceAccount(_a0 context.Context, _a1 *gen.CreateServiceAccountRequest) (*gen.CreateServiceAccountResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.CreateServiceAccountResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.CreateServiceAccountRequest) *gen.CreateServiceAccountResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.CreateServiceAccountResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.CreateServiceAccountRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateSnapshot provides a mock function with given fields: _a0, _a1
 func (_m *WaypointServer) CreateSnapshot(_a0 *emptypb.Empty, _a1 gen.Waypoint_CreateSnapshotServer) error {
 	ret := _m.Called(_a0, _a1)
@@ -214,12 +306,12 @@ func (_m *WaypointServer) DeleteHostname(_a0 context.Context, _a1 *gen.DeleteHos
 	return r0, r1
 }
 
-// DeleteUser provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) DeleteUser(_a0 context.Context, _a1 *gen.DeleteUserRequest) (*emptypb.Empty, error) {
+// DeleteJobSchedule provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) DeleteJobSchedule(_a0 context.Context, _a1 *gen.DeleteJobScheduleRequest) (*emptypb.Empty, error) {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 *emptypb.Empty
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteUserRequest) *emptypb.Empty); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteJobScheduleRequest) *emptypb.Empty); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
@@ -228,7 +320,7 @@ func (_m *WaypointServer) DeleteUser(_a0 context.Context, _a1 *gen.DeleteUserReq
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.DeleteUserRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DeleteJobScheduleRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -237,63 +329,90 @@ func (_m *WaypointServer) DeleteUser(_a0 context.Context, _a1 *gen.DeleteUserReq
 	return r0, r1
 }
 
-// EntrypointConfig provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) EntrypointConfig(_a0 *gen.EntrypointConfigRequest, _a1 gen.Waypoint_EntrypointConfigServer) error {
+// DeleteNotifier provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) DeleteNotifier(_a0 context.Context, _a1 *gen.DeleteNotifierRequest) (*emptypb.Empty, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*gen.EntrypointConfigRequest, gen.Waypoint_EntrypointConfigServer) error); ok {
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteNotifierRequest) *emptypb.Empty); ok {
 		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*emptypb.Empty)
+		}
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DeleteNotifierRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// EntrypointExecStream provides a mock function with given fields: _a0
-func (_m *WaypointServer) EntrypointExecStream(_a0 gen.Waypoint_EntrypointExecStreamServer) error {
-	ret := _m.Called(_a0)
+// DeleteOnDemandRunnerConfig provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) DeleteOnDemandRunnerConfig(_a0 context.Context, _a1 *gen.DeleteOnDemandRunnerConfigRequest) (*emptypb.Empty, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(gen.Waypoint_EntrypointExecStreamServer) error); ok {
-		r0 = rf(_a0)
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteOnDemandRunnerConfigRequest) *emptypb.Empty); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*emptypb.Empty)
+		}
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DeleteOnDemandRunnerConfigRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// EntrypointLogStream provides a mock function with given fields: _a0
-func (_m *WaypointServer) EntrypointLogStream(_a0 gen.Waypoint_EntrypointLogStreamServer) error {
-	ret := _m.Called(_a0)
+// DeletePlugin provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) DeletePlugin(_a0 context.Context, _a1 *gen.DeletePluginRequest) (*emptypb.Empty, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(gen.Waypoint_EntrypointLogStreamServer) error); ok {
-		r0 = rf(_a0)
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeletePluginRequest) *emptypb.Empty); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*emptypb.Empty)
+		}
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DeletePluginRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// FindExecInstance provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) FindExecInstance(_a0 context.Context, _a1 *gen.FindExecInstanceRequest) (*gen.FindExecInstanceResponse, error) {
+// DeleteProject provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) DeleteProject(_a0 context.Context, _a1 *gen.DeleteProjectRequest) (*emptypb.Empty, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.FindExecInstanceResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.FindExecInstanceRequest) *gen.FindExecInstanceResponse); ok {
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteProjectRequest) *emptypb.Empty); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.FindExecInstanceResponse)
+			r0 = ret.Get(0).(*emptypb.Empty)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.FindExecInstanceRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DeleteProjectRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -302,21 +421,21 @@ func (_m *WaypointServer) FindExecInstance(_a0 context.Context, _a1 *gen.FindExe
 	return r0, r1
 }
 
-// GenerateInviteToken provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) GenerateInviteToken(_a0 context.Context, _a1 *gen.InviteTokenRequest) (*gen.NewTokenResponse, error) {
+// DeleteProjectTemplate provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) DeleteProjectTemplate(_a0 context.Context, _a1 *gen.DeleteProjectTemplateRequest) (*emptypb.Empty, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.NewTokenResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.InviteTokenRequest) *gen.NewTokenResponse); ok {
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteProjectTemplateRequest) *emptypb.Empty); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.NewTokenResponse)
+			r0 = ret.Get(0).(*emptypb.Empty)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.InviteTokenRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DeleteProjectTemplateRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -325,21 +444,21 @@ func (_m *WaypointServer) GenerateInviteToken(_a0 context.Context, _a1 *gen.Invi
 	return r0, r1
 }
 
-// GenerateLoginToken provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) GenerateLoginToken(_a0 context.Context, _a1 *gen.LoginTokenRequest) (*gen.NewTokenResponse, error) {
+// DeleteServiceAccount provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) DeleteServiceAccount(_a0 context.Context, _a1 *gen.DeleteServiceAccountRequest) (*emptypb.Empty, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.NewTokenResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.LoginTokenRequest) *gen.NewTokenResponse); ok {
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteServiceAccountRequest) *emptypb.Empty); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.NewTokenResponse)
+			r0 = ret.Get(0).(*emptypb.Empty)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.LoginTokenRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DeleteServiceAccountRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -348,21 +467,21 @@ func (_m *WaypointServer) GenerateLoginToken(_a0 context.Context, _a1 *gen.Login
 	return r0, r1
 }
 
-// GetAuthMethod provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) GetAuthMethod(_a0 context.Context, _a1 *gen.GetAuthMethodRequest) (*gen.GetAuthMethodResponse, error) {
+// DeleteUser provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) DeleteUser(_a0 context.Context, _a1 *gen.DeleteUserRequest) (*emptypb.Empty, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.GetAuthMethodResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetAuthMethodRequest) *gen.GetAuthMethodResponse); ok {
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteUserRequest) *emptypb.Empty); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.GetAuthMethodResponse)
+			r0 = ret.Get(0).(*emptypb.Empty)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetAuthMethodRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DeleteUserRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -371,21 +490,21 @@ func (_m *WaypointServer) GetAuthMethod(_a0 context.Context, _a1 *gen.GetAuthMet
 	return r0, r1
 }
 
-// GetBuild provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) GetBuild(_a0 context.Context, _a1 *gen.GetBuildRequest) (*gen.Build, error) {
+// DeleteWorkspace provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) DeleteWorkspace(_a0 context.Context, _a1 *gen.DeleteWorkspaceRequest) (*emptypb.Empty, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.Build
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetBuildRequest) *gen.Build); ok {
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteWorkspaceRequest) *emptypb.Empty); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.Build)
+			r0 = ret.Get(0).(*emptypb.Empty)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetBuildRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DeleteWorkspaceRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -394,21 +513,77 @@ func (_m *WaypointServer) GetBuild(_a0 context.Context, _a1 *gen.GetBuildRequest
 	return r0, r1
 }
 
-// GetConfig provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) GetConfig(_a0 context.Context, _a1 *gen.ConfigGetRequest) (*gen.ConfigGetResponse, error) {
+// DownloadBuildCache provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) DownloadBuildCache(_a0 *gen.DownloadBuildCacheRequest, _a1 gen.Waypoint_DownloadBuildCacheServer) error {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.ConfigGetResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.ConfigGetRequest) *gen.ConfigGetResponse); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gen.DownloadBuildCacheRequest, gen.Waypoint_DownloadBuildCacheServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DownloadPlugin provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) DownloadPlugin(_a0 *gen.DownloadPluginRequest, _a1 gen.Waypoint_DownloadPluginServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gen.DownloadPluginRequest, gen.Waypoint_DownloadPluginServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EntrypointConfig provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) EntrypointConfig(_a0 *gen.EntrypointConfigRequest, _a1 gen.Waypoint_EntrypointConfigServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gen.EntrypointConfigRequest, gen.Waypoint_EntrypointConfigServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EntrypointExecStream provides a mock function with given fields: _a0
+func (_m *WaypointServer) EntrypointExecStream(_a0 gen.Waypoint_EntrypointExecStreamServer) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(gen.Waypoint_EntrypointExecStreamServer) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EntrypointHealthReport provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) EntrypointHealthReport(_a0 context.Context, _a1 *gen.EntrypointHealthReportRequest) (*emptypb.Empty, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.EntrypointHealthReportRequest) *emptypb.Empty); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ConfigGetResponse)
+			r0 = ret.Get(0).(*emptypb.Empty)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.ConfigGetRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.EntrypointHealthReportRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -417,21 +592,997 @@ func (_m *WaypointServer) GetConfig(_a0 context.Context, _a1 *gen.ConfigGetReque
 	return r0, r1
 }
 
-// GetConfigSource provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) GetConfigSource(_a0 context.Context, _a1 *gen.GetConfigSourceRequest) (*gen.GetConfigSourceResponse, error) {
+// EntrypointLogStream provides a mock function with given fields: _a0
+func (_m *WaypointServer) EntrypointLogStream(_a0 gen.Waypoint_EntrypointLogStreamServer) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(gen.Waypoint_EntrypointLogStreamServer) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindExecInstance provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) FindExecInstance(_a0 context.Context, _a1 *gen.FindExecInstanceRequest) (*gen.FindExecInstanceResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.GetConfigSourceResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetConfigSourceRequest) *gen.GetConfigSourceResponse); ok {
+	var r0 *gen.FindExecInstanceResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.FindExecInstanceRequest) *gen.FindExecInstanceResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.FindExecInstanceResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.FindExecInstanceRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GenerateInviteToken provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GenerateInviteToken(_a0 context.Context, _a1 *gen.InviteTokenRequest) (*gen.NewTokenResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.NewTokenResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.InviteTokenRequest) *gen.NewTokenResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.NewTokenResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.InviteTokenRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GenerateLoginToken provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GenerateLoginToken(_a0 context.Context, _a1 *gen.LoginTokenRequest) (*gen.NewTokenResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.NewTokenResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.LoginTokenRequest) *gen.NewTokenResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.NewTokenResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.LoginTokenRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GenerateServiceAccountToken provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GenerateServiceAccountToken(_a0 context.Context, _a1 *gen.ServiceAccountTokenRequest) (*gen.NewTokenResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.NewTokenResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ServiceAccountTokenRequest) *gen.NewTokenResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.NewTokenResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ServiceAccountTokenRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAuthMethod provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetAuthMethod(_a0 context.Context, _a1 *gen.GetAuthMethodRequest) (*gen.GetAuthMethodResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.GetAuthMethodResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetAuthMethodRequest) *gen.GetAuthMethodResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetAuthMethodResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetAuthMethodRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBuild provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetBuild(_a0 context.Context, _a1 *gen.GetBuildRequest) (*gen.Build, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.Build
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetBuildRequest) *gen.Build); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.Build)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetBuildRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConfig provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetConfig(_a0 context.Context, _a1 *gen.ConfigGetRequest) (*gen.ConfigGetResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.ConfigGetResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ConfigGetRequest) *gen.ConfigGetResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ConfigGetResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ConfigGetRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConfigHistory provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetConfigHistory(_a0 context.Context, _a1 *gen.GetConfigHistoryRequest) (*gen.GetConfigHistoryResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.GetConfigHistoryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetConfigHistoryRequest) *gen.GetConfigHistoryResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetConfigHistoryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetConfigHistoryRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConfigSource provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetConfigSource(_a0 context.Context, _a1 *gen.GetConfigSourceRequest) (*gen.GetConfigSourceResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.GetConfigSourceResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetConfigSourceRequest) *gen.GetConfigSourceResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetConfigSourceResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetConfigSourceRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDeployment provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetDeployment(_a0 context.Context, _a1 *gen.GetDeploymentRequest) (*gen.Deployment, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.Deployment
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetDeploymentRequest) *gen.Deployment); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.Deployment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetDeploymentRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDeploymentDiff provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetDeploymentDiff(_a0 context.Context, _a1 *gen.GetDeploymentDiffRequest) (*gen.GetDeploymentDiffResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.GetDeploymentDiffResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetDeploymentDiffRequest) *gen.GetDeploymentDiffResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetDeploymentDiffResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetDeploymentDiffRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetExecSession provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetExecSession(_a0 context.Context, _a1 *gen.GetExecSessionRequest) (*gen.ExecSession, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.ExecSession
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetExecSessionRequest) *gen.ExecSession); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ExecSession)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetExecSessionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetJob provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetJob(_a0 context.Context, _a1 *gen.GetJobRequest) (*gen.Job, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.Job
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetJobRequest) *gen.Job); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.Job)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetJobRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetJobLogs provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetJobLogs(_a0 *gen.GetJobLogsRequest, _a1 gen.Waypoint_GetJobLogsServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gen.GetJobLogsRequest, gen.Waypoint_GetJobLogsServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetJobSchedule provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetJobSchedule(_a0 context.Context, _a1 *gen.GetJobScheduleRequest) (*gen.JobSchedule, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.JobSchedule
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetJobScheduleRequest) *gen.JobSchedule); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.JobSchedule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetJobScheduleRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetJobStream provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetJobStream(_a0 *gen.GetJobStreamRequest, _a1 gen.Waypoint_GetJobStreamServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gen.GetJobStreamRequest, gen.Waypoint_GetJobStreamServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetLatestBuild provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetLatestBuild(_a0 context.Context, _a1 *gen.GetLatestBuildRequest) (*gen.Build, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.Build
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetLatestBuildRequest) *gen.Build); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.Build)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetLatestBuildRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLatestPushedArtifact provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetLatestPushedArtifact(_a0 context.Context, _a1 *gen.GetLatestPushedArtifactRequest) (*gen.PushedArtifact, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.PushedArtifact
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetLatestPushedArtifactRequest) *gen.PushedArtifact); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.PushedArtifact)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetLatestPushedArtifactRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLatestRelease provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetLatestRelease(_a0 context.Context, _a1 *gen.GetLatestReleaseRequest) (*gen.Release, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.Release
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetLatestReleaseRequest) *gen.Release); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.Release)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetLatestReleaseRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLatestStatusReport provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetLatestStatusReport(_a0 context.Context, _a1 *gen.GetLatestStatusReportRequest) (*gen.StatusReport, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.StatusReport
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetLatestStatusReportRequest) *gen.StatusReport); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.StatusReport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetLatestStatusReportRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLogStream provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetLogStream(_a0 *gen.GetLogStreamRequest, _a1 gen.Waypoint_GetLogStreamServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gen.GetLogStreamRequest, gen.Waypoint_GetLogStreamServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetNotifier provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetNotifier(_a0 context.Context, _a1 *gen.GetNotifierRequest) (*gen.Notifier, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.Notifier
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetNotifierRequest) *gen.Notifier); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.Notifier)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetNotifierRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOIDCAuthURL provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetOIDCAuthURL(_a0 context.Context, _a1 *gen.GetOIDCAuthURLRequest) (*gen.GetOIDCAuthURLResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.GetOIDCAuthURLResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetOIDCAuthURLRequest) *gen.GetOIDCAuthURLResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetOIDCAuthURLResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetOIDCAuthURLRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOnDemandRunnerConfig provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetOnDemandRunnerConfig(_a0 context.Context, _a1 *gen.GetOnDemandRunnerConfigRequest) (*gen.GetOnDemandRunnerConfigResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.GetOnDemandRunnerConfigResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetOnDemandRunnerConfigRequest) *gen.GetOnDemandRunnerConfigResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetOnDemandRunnerConfigResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetOnDemandRunnerConfigRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPipelineRun provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetPipelineRun(_a0 context.Context, _a1 *gen.GetPipelineRunRequest) (*gen.PipelineRun, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.PipelineRun
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetPipelineRunRequest) *gen.PipelineRun); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.PipelineRun)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetPipelineRunRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProject provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetProject(_a0 context.Context, _a1 *gen.GetProjectRequest) (*gen.GetProjectResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.GetProjectResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetProjectRequest) *gen.GetProjectResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetProjectResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetProjectRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProjectStatusSummary provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetProjectStatusSummary(_a0 context.Context, _a1 *gen.GetProjectStatusSummaryRequest) (*gen.GetProjectStatusSummaryResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.GetProjectStatusSummaryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetProjectStatusSummaryRequest) *gen.GetProjectStatusSummaryResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetProjectStatusSummaryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetProjectStatusSummaryRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProjectTemplate provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetProjectTemplate(_a0 context.Context, _a1 *gen.GetProjectTemplateRequest) (*gen.GetProjectTemplateResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.GetProjectTemplateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetProjectTemplateRequest) *gen.GetProjectTemplateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetProjectTemplateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetProjectTemplateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPushedArtifact provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetPushedArtifact(_a0 context.Context, _a1 *gen.GetPushedArtifactRequest) (*gen.PushedArtifact, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.PushedArtifact
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetPushedArtifactRequest) *gen.PushedArtifact); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.PushedArtifact)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetPushedArtifactRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRelease provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetRelease(_a0 context.Context, _a1 *gen.GetReleaseRequest) (*gen.Release, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.Release
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetReleaseRequest) *gen.Release); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.Release)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetReleaseRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRunner provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetRunner(_a0 context.Context, _a1 *gen.GetRunnerRequest) (*gen.Runner, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.Runner
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetRunnerRequest) *gen.Runner); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.Runner)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetRunnerRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetServerConfig provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetServerConfig(_a0 context.Context, _a1 *emptypb.Empty) (*gen.GetServerConfigResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.GetServerConfigResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty) *gen.GetServerConfigResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetServerConfigResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetServerStatus provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetServerStatus(_a0 context.Context, _a1 *emptypb.Empty) (*gen.GetServerStatusResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.GetServerStatusResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty) *gen.GetServerStatusResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetServerStatusResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSnapshotStatus provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetSnapshotStatus(_a0 context.Context, _a1 *emptypb.Empty) (*gen.GetSnapshotStatusResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.GetSnapshotStatusResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty) *gen.GetSnapshotStatusResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetSnapshotStatusResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStatusReport provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetStatusReport(_a0 context.Context, _a1 *gen.GetStatusReportRequest) (*gen.StatusReport, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.StatusReport
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetStatusReportRequest) *gen.StatusReport); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.StatusReport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetStatusReportRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUser provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetUser(_a0 context.Context, _a1 *gen.GetUserRequest) (*gen.GetUserResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.GetUserResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetUserRequest) *gen.GetUserResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetUserResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetUserRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVersionInfo provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetVersionInfo(_a0 context.Context, _a1 *emptypb.Empty) (*gen.GetVersionInfoResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.GetVersionInfoResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty) *gen.GetVersionInfoResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetVersionInfoResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWorkspace provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GetWorkspace(_a0 context.Context, _a1 *gen.GetWorkspaceRequest) (*gen.GetWorkspaceResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.GetWorkspaceResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetWorkspaceRequest) *gen.GetWorkspaceResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetWorkspaceResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetWorkspaceRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GrantUserRole provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) GrantUserRole(_a0 context.Context, _a1 *gen.UserRoleRequest) (*gen.UpdateUserResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.UpdateUserResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UserRoleRequest) *gen.UpdateUserResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.UpdateUserResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UserRoleRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAppEvents provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListAppEvents(_a0 context.Context, _a1 *gen.ListAppEventsRequest) (*gen.ListAppEventsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.ListAppEventsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListAppEventsRequest) *gen.ListAppEventsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListAppEventsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListAppEventsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAuditEvents provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListAuditEvents(_a0 context.Context, _a1 *gen.ListAuditEventsRequest) (*gen.ListAuditEventsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.ListAuditEventsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListAuditEventsRequest) *gen.ListAuditEventsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListAuditEventsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListAuditEventsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAuthMethods provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListAuthMethods(_a0 context.Context, _a1 *emptypb.Empty) (*gen.ListAuthMethodsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.ListAuthMethodsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty) *gen.ListAuthMethodsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListAuthMethodsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBuilds provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListBuilds(_a0 context.Context, _a1 *gen.ListBuildsRequest) (*gen.ListBuildsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.ListBuildsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListBuildsRequest) *gen.ListBuildsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListBuildsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListBuildsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDeployments provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListDeployments(_a0 context.Context, _a1 *gen.ListDeploymentsRequest) (*gen.ListDeploymentsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.ListDeploymentsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListDeploymentsRequest) *gen.ListDeploymentsResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.GetConfigSourceResponse)
+			r0 = ret.Get(0).(*gen.ListDeploymentsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetConfigSourceRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListDeploymentsRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -440,21 +1591,21 @@ func (_m *WaypointServer) GetConfigSource(_a0 context.Context, _a1 *gen.GetConfi
 	return r0, r1
 }
 
-// GetDeployment provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) GetDeployment(_a0 context.Context, _a1 *gen.GetDeploymentRequest) (*gen.Deployment, error) {
+// ListExecSessions provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListExecSessions(_a0 context.Context, _a1 *gen.ListExecSessionsRequest) (*gen.ListExecSessionsResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.Deployment
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetDeploymentRequest) *gen.Deployment); ok {
+	var r0 *gen.ListExecSessionsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListExecSessionsRequest) *gen.ListExecSessionsResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.Deployment)
+			r0 = ret.Get(0).(*gen.ListExecSessionsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetDeploymentRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListExecSessionsRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -463,21 +1614,21 @@ func (_m *WaypointServer) GetDeployment(_a0 context.Context, _a1 *gen.GetDeploym
 	return r0, r1
 }
 
-// GetJob provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) GetJob(_a0 context.Context, _a1 *gen.GetJobRequest) (*gen.Job, error) {
+// ListHostnames provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListHostnames(_a0 context.Context, _a1 *gen.ListHostnamesRequest) (*gen.ListHostnamesResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.Job
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetJobRequest) *gen.Job); ok {
+	var r0 *gen.ListHostnamesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListHostnamesRequest) *gen.ListHostnamesResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.Job)
+			r0 = ret.Get(0).(*gen.ListHostnamesResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetJobRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListHostnamesRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -486,35 +1637,21 @@ func (_m *WaypointServer) GetJob(_a0 context.Context, _a1 *gen.GetJobRequest) (*
 	return r0, r1
 }
 
-// GetJobStream provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) GetJobStream(_a0 *gen.GetJobStreamRequest, _a1 gen.Waypoint_GetJobStreamServer) error {
-	ret := _m.Called(_a0, _a1)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*gen.GetJobStreamRequest, gen.Waypoint_GetJobStreamServer) error); ok {
-		r0 = rf(_a0, _a1)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// GetLatestBuild provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) GetLatestBuild(_a0 context.Context, _a1 *gen.GetLatestBuildRequest) (*gen.Build, error) {
+// ListInstances provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListInstances(_a0 context.Context, _a1 *gen.ListInstancesRequest) (*gen.ListInstancesResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.Build
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetLatestBuildRequest) *gen.Build); ok {
+	var r0 *gen.ListInstancesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListInstancesRequest) *gen.ListInstancesResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.Build)
+			r0 = ret.Get(0).(*gen.ListInstancesResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetLatestBuildRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListInstancesRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -523,21 +1660,21 @@ func (_m *WaypointServer) GetLatestBuild(_a0 context.Context, _a1 *gen.GetLatest
 	return r0, r1
 }
 
-// GetLatestPushedArtifact provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) GetLatestPushedArtifact(_a0 context.Context, _a1 *gen.GetLatestPushedArtifactRequest) (*gen.PushedArtifact, error) {
+// ListJobSchedules provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListJobSchedules(_a0 context.Context, _a1 *gen.ListJobSchedulesRequest) (*gen.ListJobSchedulesResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.PushedArtifact
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetLatestPushedArtifactRequest) *gen.PushedArtifact); ok {
+	var r0 *gen.ListJobSchedulesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListJobSchedulesRequest) *gen.ListJobSchedulesResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.PushedArtifact)
+			r0 = ret.Get(0).(*gen.ListJobSchedulesResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetLatestPushedArtifactRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListJobSchedulesRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -546,21 +1683,21 @@ func (_m *WaypointServer) GetLatestPushedArtifact(_a0 context.Context, _a1 *gen.
 	return r0, r1
 }
 
-// GetLatestRelease provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) GetLatestRelease(_a0 context.Context, _a1 *gen.GetLatestReleaseRequest) (*gen.Release, error) {
+// ListNotifiers provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListNotifiers(_a0 context.Context, _a1 *gen.ListNotifiersRequest) (*gen.ListNotifiersResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.Release
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetLatestReleaseRequest) *gen.Release); ok {
+	var r0 *gen.ListNotifiersResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListNotifiersRequest) *gen.ListNotifiersResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.Release)
+			r0 = ret.Get(0).(*gen.ListNotifiersResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetLatestReleaseRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListNotifiersRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -569,21 +1706,21 @@ func (_m *WaypointServer) GetLatestRelease(_a0 context.Context, _a1 *gen.GetLate
 	return r0, r1
 }
 
-// GetLatestStatusReport provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) GetLatestStatusReport(_a0 context.Context, _a1 *gen.GetLatestStatusReportRequest) (*gen.StatusReport, error) {
+// ListOIDCAuthMethods provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListOIDCAuthMethods(_a0 context.Context, _a1 *emptypb.Empty) (*gen.ListOIDCAuthMethodsResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.StatusReport
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetLatestStatusReportRequest) *gen.StatusReport); ok {
+	var r0 *gen.ListOIDCAuthMethodsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty) *gen.ListOIDCAuthMethodsResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.StatusReport)
+			r0 = ret.Get(0).(*gen.ListOIDCAuthMethodsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetLatestStatusReportRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -592,35 +1729,21 @@ func (_m *WaypointServer) GetLatestStatusReport(_a0 context.Context, _a1 *gen.Ge
 	return r0, r1
 }
 
-// GetLogStream provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) GetLogStream(_a0 *gen.GetLogStreamRequest, _a1 gen.Waypoint_GetLogStreamServer) error {
-	ret := _m.Called(_a0, _a1)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*gen.GetLogStreamRequest, gen.Waypoint_GetLogStreamServer) error); ok {
-		r0 = rf(_a0, _a1)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// GetOIDCAuthURL provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) GetOIDCAuthURL(_a0 context.Context, _a1 *gen.GetOIDCAuthURLRequest) (*gen.GetOIDCAuthURLResponse, error) {
+// ListOnDemandRunnerConfigs provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListOnDemandRunnerConfigs(_a0 context.Context, _a1 *emptypb.Empty) (*gen.ListOnDemandRunnerConfigsResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.GetOIDCAuthURLResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetOIDCAuthURLRequest) *gen.GetOIDCAuthURLResponse); ok {
+	var r0 *gen.ListOnDemandRunnerConfigsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty) *gen.ListOnDemandRunnerConfigsResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.GetOIDCAuthURLResponse)
+			r0 = ret.Get(0).(*gen.ListOnDemandRunnerConfigsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetOIDCAuthURLRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -629,21 +1752,21 @@ func (_m *WaypointServer) GetOIDCAuthURL(_a0 context.Context, _a1 *gen.GetOIDCAu
 	return r0, r1
 }
 
-// GetProject provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) GetProject(_a0 context.Context, _a1 *gen.GetProjectRequest) (*gen.GetProjectResponse, error) {
+// ListPipelineRuns provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListPipelineRuns(_a0 context.Context, _a1 *gen.ListPipelineRunsRequest) (*gen.ListPipelineRunsResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.GetProjectResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetProjectRequest) *gen.GetProjectResponse); ok {
+	var r0 *gen.ListPipelineRunsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListPipelineRunsRequest) *gen.ListPipelineRunsResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.GetProjectResponse)
+			r0 = ret.Get(0).(*gen.ListPipelineRunsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetProjectRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListPipelineRunsRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -652,21 +1775,21 @@ func (_m *WaypointServer) GetProject(_a0 context.Context, _a1 *gen.GetProjectReq
 	return r0, r1
 }
 
-// GetPushedArtifact provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) GetPushedArtifact(_a0 context.Context, _a1 *gen.GetPushedArtifactRequest) (*gen.PushedArtifact, error) {
+// ListPipelines provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListPipelines(_a0 context.Context, _a1 *gen.ListPipelinesRequest) (*gen.ListPipelinesResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.PushedArtifact
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetPushedArtifactRequest) *gen.PushedArtifact); ok {
+	var r0 *gen.ListPipelinesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListPipelinesRequest) *gen.ListPipelinesResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.PushedArtifact)
+			r0 = ret.Get(0).(*gen.ListPipelinesResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetPushedArtifactRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListPipelinesRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -675,21 +1798,21 @@ func (_m *WaypointServer) GetPushedArtifact(_a0 context.Context, _a1 *gen.GetPus
 	return r0, r1
 }
 
-// GetRelease provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) GetRelease(_a0 context.Context, _a1 *gen.GetReleaseRequest) (*gen.Release, error) {
+// ListPlugins provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListPlugins(_a0 context.Context, _a1 *gen.ListPluginsRequest) (*gen.ListPluginsResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.Release
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetReleaseRequest) *gen.Release); ok {
+	var r0 *gen.ListPluginsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListPluginsRequest) *gen.ListPluginsResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.Release)
+			r0 = ret.Get(0).(*gen.ListPluginsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetReleaseRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListPluginsRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -698,21 +1821,21 @@ func (_m *WaypointServer) GetRelease(_a0 context.Context, _a1 *gen.GetReleaseReq
 	return r0, r1
 }
 
-// GetRunner provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) GetRunner(_a0 context.Context, _a1 *gen.GetRunnerRequest) (*gen.Runner, error) {
+// ListPreviews provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListPreviews(_a0 context.Context, _a1 *gen.ListPreviewsRequest) (*gen.ListPreviewsResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.Runner
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetRunnerRequest) *gen.Runner); ok {
+	var r0 *gen.ListPreviewsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListPreviewsRequest) *gen.ListPreviewsResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.Runner)
+			r0 = ret.Get(0).(*gen.ListPreviewsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetRunnerRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListPreviewsRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -721,16 +1844,16 @@ func (_m *WaypointServer) GetRunner(_a0 context.Context, _a1 *gen.GetRunnerReque
 	return r0, r1
 }
 
-// GetServerConfig provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) GetServerConfig(_a0 context.Context, _a1 *emptypb.Empty) (*gen.GetServerConfigResponse, error) {
+// ListProjectTemplates provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListProjectTemplates(_a0 context.Context, _a1 *emptypb.Empty) (*gen.ListProjectTemplatesResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.GetServerConfigResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty) *gen.GetServerConfigResponse); ok {
+	var r0 *gen.ListProjectTemplatesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty) *gen.ListProjectTemplatesResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.GetServerConfigResponse)
+			r0 = ret.Get(0).(*gen.ListProjectTemplatesResponse)
 		}
 	}
 
@@ -744,21 +1867,21 @@ func (_m *WaypointServer) GetServerConfig(_a0 context.Context, _a1 *emptypb.Empt
 	return r0, r1
 }
 
-// GetStatusReport provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) GetStatusReport(_a0 context.Context, _a1 *gen.GetStatusReportRequest) (*gen.StatusReport, error) {
+// ListProjects provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListProjects(_a0 context.Context, _a1 *gen.ListProjectsRequest) (*gen.ListProjectsResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.StatusReport
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetStatusReportRequest) *gen.StatusReport); ok {
+	var r0 *gen.ListProjectsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListProjectsRequest) *gen.ListProjectsResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.StatusReport)
+			r0 = ret.Get(0).(*gen.ListProjectsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetStatusReportRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListProjectsRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -767,21 +1890,21 @@ func (_m *WaypointServer) GetStatusReport(_a0 context.Context, _a1 *gen.GetStatu
 	return r0, r1
 }
 
-// GetUser provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) GetUser(_a0 context.Context, _a1 *gen.GetUserRequest) (*gen.GetUserResponse, error) {
+// ListPushedArtifacts provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListPushedArtifacts(_a0 context.Context, _a1 *gen.ListPushedArtifactsRequest) (*gen.ListPushedArtifactsResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.GetUserResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetUserRequest) *gen.GetUserResponse); ok {
+	var r0 *gen.ListPushedArtifactsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListPushedArtifactsRequest) *gen.ListPushedArtifactsResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.GetUserResponse)
+			r0 = ret.Get(0).(*gen.ListPushedArtifactsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetUserRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListPushedArtifactsRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -790,21 +1913,21 @@ func (_m *WaypointServer) GetUser(_a0 context.Context, _a1 *gen.GetUserRequest)
 	return r0, r1
 }
 
-// GetVersionInfo provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) GetVersionInfo(_a0 context.Context, _a1 *emptypb.Empty) (*gen.GetVersionInfoResponse, error) {
+// ListReleases provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListReleases(_a0 context.Context, _a1 *gen.ListReleasesRequest) (*gen.ListReleasesResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.GetVersionInfoResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty) *gen.GetVersionInfoResponse); ok {
+	var r0 *gen.ListReleasesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListReleasesRequest) *gen.ListReleasesResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.GetVersionInfoResponse)
+			r0 = ret.Get(0).(*gen.ListReleasesResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListReleasesRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -813,21 +1936,21 @@ func (_m *WaypointServer) GetVersionInfo(_a0 context.Context, _a1 *emptypb.Empty
 	return r0, r1
 }
 
-// GetWorkspace provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) GetWorkspace(_a0 context.Context, _a1 *gen.GetWorkspaceRequest) (*gen.GetWorkspaceResponse, error) {
+// ListRunners provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListRunners(_a0 context.Context, _a1 *emptypb.Empty) (*gen.ListRunnersResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.GetWorkspaceResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetWorkspaceRequest) *gen.GetWorkspaceResponse); ok {
+	var r0 *gen.ListRunnersResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty) *gen.ListRunnersResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.GetWorkspaceResponse)
+			r0 = ret.Get(0).(*gen.ListRunnersResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetWorkspaceRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -836,16 +1959,16 @@ func (_m *WaypointServer) GetWorkspace(_a0 context.Context, _a1 *gen.GetWorkspac
 	return r0, r1
 }
 
-// ListAuthMethods provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) ListAuthMethods(_a0 context.Context, _a1 *emptypb.Empty) (*gen.ListAuthMethodsResponse, error) {
+// ListServiceAccounts provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListServiceAccounts(_a0 context.Context, _a1 *emptypb.Empty) (*gen.ListServiceAccountsResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.ListAuthMethodsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty) *gen.ListAuthMethodsResponse); ok {
+	var r0 *gen.ListServiceAccountsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty) *gen.ListServiceAccountsResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ListAuthMethodsResponse)
+			r0 = ret.Get(0).(*gen.ListServiceAccountsResponse)
 		}
 	}
 
@@ -859,21 +1982,21 @@ func (_m *WaypointServer) ListAuthMethods(_a0 context.Context, _a1 *emptypb.Empt
 	return r0, r1
 }
 
-// ListBuilds provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) ListBuilds(_a0 context.Context, _a1 *gen.ListBuildsRequest) (*gen.ListBuildsResponse, error) {
+// ListStatusReports provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListStatusReports(_a0 context.Context, _a1 *gen.ListStatusReportsRequest) (*gen.ListStatusReportsResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.ListBuildsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListBuildsRequest) *gen.ListBuildsResponse); ok {
+	var r0 *gen.ListStatusReportsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListStatusReportsRequest) *gen.ListStatusReportsResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ListBuildsResponse)
+			r0 = ret.Get(0).(*gen.ListStatusReportsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListBuildsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListStatusReportsRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -882,21 +2005,21 @@ func (_m *WaypointServer) ListBuilds(_a0 context.Context, _a1 *gen.ListBuildsReq
 	return r0, r1
 }
 
-// ListDeployments provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) ListDeployments(_a0 context.Context, _a1 *gen.ListDeploymentsRequest) (*gen.ListDeploymentsResponse, error) {
+// ListUsers provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListUsers(_a0 context.Context, _a1 *emptypb.Empty) (*gen.ListUsersResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.ListDeploymentsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListDeploymentsRequest) *gen.ListDeploymentsResponse); ok {
+	var r0 *gen.ListUsersResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty) *gen.ListUsersResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ListDeploymentsResponse)
+			r0 = ret.Get(0).(*gen.ListUsersResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListDeploymentsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -905,21 +2028,21 @@ func (_m *WaypointServer) ListDeployments(_a0 context.Context, _a1 *gen.ListDepl
 	return r0, r1
 }
 
-// ListHostnames provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) ListHostnames(_a0 context.Context, _a1 *gen.ListHostnamesRequest) (*gen.ListHostnamesResponse, error) {
+// ListWorkspaces provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ListWorkspaces(_a0 context.Context, _a1 *gen.ListWorkspacesRequest) (*gen.ListWorkspacesResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.ListHostnamesResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListHostnamesRequest) *gen.ListHostnamesResponse); ok {
+	var r0 *gen.ListWorkspacesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListWorkspacesRequest) *gen.ListWorkspacesResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ListHostnamesResponse)
+			r0 = ret.Get(0).(*gen.ListWorkspacesResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListHostnamesRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListWorkspacesRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -928,21 +2051,21 @@ func (_m *WaypointServer) ListHostnames(_a0 context.Context, _a1 *gen.ListHostna
 	return r0, r1
 }
 
-// ListInstances provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) ListInstances(_a0 context.Context, _a1 *gen.ListInstancesRequest) (*gen.ListInstancesResponse, error) {
+// PruneState provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) PruneState(_a0 context.Context, _a1 *gen.PruneStateRequest) (*gen.PruneStateResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.ListInstancesResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListInstancesRequest) *gen.ListInstancesResponse); ok {
+	var r0 *gen.PruneStateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.PruneStateRequest) *gen.PruneStateResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ListInstancesResponse)
+			r0 = ret.Get(0).(*gen.PruneStateResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListInstancesRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.PruneStateRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -951,21 +2074,21 @@ func (_m *WaypointServer) ListInstances(_a0 context.Context, _a1 *gen.ListInstan
 	return r0, r1
 }
 
-// ListOIDCAuthMethods provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) ListOIDCAuthMethods(_a0 context.Context, _a1 *emptypb.Empty) (*gen.ListOIDCAuthMethodsResponse, error) {
+// QueueJob provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) QueueJob(_a0 context.Context, _a1 *gen.QueueJobRequest) (*gen.QueueJobResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.ListOIDCAuthMethodsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty) *gen.ListOIDCAuthMethodsResponse); ok {
+	var r0 *gen.QueueJobResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.QueueJobRequest) *gen.QueueJobResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ListOIDCAuthMethodsResponse)
+			r0 = ret.Get(0).(*gen.QueueJobResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.QueueJobRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -974,16 +2097,16 @@ func (_m *WaypointServer) ListOIDCAuthMethods(_a0 context.Context, _a1 *emptypb.
 	return r0, r1
 }
 
-// ListProjects provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) ListProjects(_a0 context.Context, _a1 *emptypb.Empty) (*gen.ListProjectsResponse, error) {
+// RefreshToken provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) RefreshToken(_a0 context.Context, _a1 *emptypb.Empty) (*gen.NewTokenResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.ListProjectsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty) *gen.ListProjectsResponse); ok {
+	var r0 *gen.NewTokenResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty) *gen.NewTokenResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ListProjectsResponse)
+			r0 = ret.Get(0).(*gen.NewTokenResponse)
 		}
 	}
 
@@ -997,21 +2120,21 @@ func (_m *WaypointServer) ListProjects(_a0 context.Context, _a1 *emptypb.Empty)
 	return r0, r1
 }
 
-// ListPushedArtifacts provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) ListPushedArtifacts(_a0 context.Context, _a1 *gen.ListPushedArtifactsRequest) (*gen.ListPushedArtifactsResponse, error) {
+// RejectRunner provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) RejectRunner(_a0 context.Context, _a1 *gen.RejectRunnerRequest) (*emptypb.Empty, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.ListPushedArtifactsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListPushedArtifactsRequest) *gen.ListPushedArtifactsResponse); ok {
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RejectRunnerRequest) *emptypb.Empty); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ListPushedArtifactsResponse)
+			r0 = ret.Get(0).(*emptypb.Empty)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListPushedArtifactsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RejectRunnerRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1020,21 +2143,35 @@ func (_m *WaypointServer) ListPushedArtifacts(_a0 context.Context, _a1 *gen.List
 	return r0, r1
 }
 
-// ListReleases provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) ListReleases(_a0 context.Context, _a1 *gen.ListReleasesRequest) (*gen.ListReleasesResponse, error) {
-	ret := _m.Called(_a0, _a1)
+// RestoreSnapshot provides a mock function with given fields: _a0
+func (_m *WaypointServer) RestoreSnapshot(_a0 gen.Waypoint_RestoreSnapshotServer) error {
+	ret := _m.Called(_a0)
 
-	var r0 *gen.ListReleasesResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListReleasesRequest) *gen.ListReleasesResponse); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(gen.Waypoint_RestoreSnapshotServer) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeToken provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) RevokeToken(_a0 context.Context, _a1 *gen.RevokeTokenRequest) (*emptypb.Empty, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RevokeTokenRequest) *emptypb.Empty); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ListReleasesResponse)
+			r0 = ret.Get(0).(*emptypb.Empty)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListReleasesRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RevokeTokenRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1043,21 +2180,21 @@ func (_m *WaypointServer) ListReleases(_a0 context.Context, _a1 *gen.ListRelease
 	return r0, r1
 }
 
-// ListStatusReports provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) ListStatusReports(_a0 context.Context, _a1 *gen.ListStatusReportsRequest) (*gen.ListStatusReportsResponse, error) {
+// RevokeUserRole provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) RevokeUserRole(_a0 context.Context, _a1 *gen.UserRoleRequest) (*gen.UpdateUserResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.ListStatusReportsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListStatusReportsRequest) *gen.ListStatusReportsResponse); ok {
+	var r0 *gen.UpdateUserResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UserRoleRequest) *gen.UpdateUserResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ListStatusReportsResponse)
+			r0 = ret.Get(0).(*gen.UpdateUserResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListStatusReportsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UserRoleRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1066,21 +2203,21 @@ func (_m *WaypointServer) ListStatusReports(_a0 context.Context, _a1 *gen.ListSt
 	return r0, r1
 }
 
-// ListUsers provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) ListUsers(_a0 context.Context, _a1 *emptypb.Empty) (*gen.ListUsersResponse, error) {
+// RollbackConfig provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) RollbackConfig(_a0 context.Context, _a1 *gen.RollbackConfigRequest) (*gen.RollbackConfigResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.ListUsersResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty) *gen.ListUsersResponse); ok {
+	var r0 *gen.RollbackConfigResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RollbackConfigRequest) *gen.RollbackConfigResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ListUsersResponse)
+			r0 = ret.Get(0).(*gen.RollbackConfigResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RollbackConfigRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1089,21 +2226,21 @@ func (_m *WaypointServer) ListUsers(_a0 context.Context, _a1 *emptypb.Empty) (*g
 	return r0, r1
 }
 
-// ListWorkspaces provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) ListWorkspaces(_a0 context.Context, _a1 *gen.ListWorkspacesRequest) (*gen.ListWorkspacesResponse, error) {
+// RotateConfigEncryptionKey provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) RotateConfigEncryptionKey(_a0 context.Context, _a1 *emptypb.Empty) (*gen.RotateConfigEncryptionKeyResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.ListWorkspacesResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListWorkspacesRequest) *gen.ListWorkspacesResponse); ok {
+	var r0 *gen.RotateConfigEncryptionKeyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *emptypb.Empty) *gen.RotateConfigEncryptionKeyResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.ListWorkspacesResponse)
+			r0 = ret.Get(0).(*gen.RotateConfigEncryptionKeyResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListWorkspacesRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *emptypb.Empty) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1112,21 +2249,21 @@ func (_m *WaypointServer) ListWorkspaces(_a0 context.Context, _a1 *gen.ListWorks
 	return r0, r1
 }
 
-// QueueJob provides a mock function with given fields: _a0, _a1
-func (_m *WaypointServer) QueueJob(_a0 context.Context, _a1 *gen.QueueJobRequest) (*gen.QueueJobResponse, error) {
+// RunPipeline provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) RunPipeline(_a0 context.Context, _a1 *gen.RunPipelineRequest) (*gen.RunPipelineResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *gen.QueueJobResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *gen.QueueJobRequest) *gen.QueueJobResponse); ok {
+	var r0 *gen.RunPipelineResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RunPipelineRequest) *gen.RunPipelineResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.QueueJobResponse)
+			r0 = ret.Get(0).(*gen.RunPipelineResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gen.QueueJobRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RunPipelineRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1135,20 +2272,6 @@ func (_m *WaypointServer) QueueJob(_a0 context.Context, _a1 *gen.QueueJobRequest
 	return r0, r1
 }
 
-// RestoreSnapshot provides a mock function with given fields: _a0
-func (_m *WaypointServer) RestoreSnapshot(_a0 gen.Waypoint_RestoreSnapshotServer) error {
-	ret := _m.Called(_a0)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(gen.Waypoint_RestoreSnapshotServer) error); ok {
-		r0 = rf(_a0)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
 // RunnerConfig provides a mock function with given fields: _a0
 func (_m *WaypointServer) RunnerConfig(_a0 gen.Waypoint_RunnerConfigServer) error {
 	ret := _m.Called(_a0)
@@ -1200,6 +2323,29 @@ func (_m *WaypointServer) RunnerJobStream(_a0 gen.Waypoint_RunnerJobStreamServer
 	return r0
 }
 
+// SearchLogs provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) SearchLogs(_a0 context.Context, _a1 *gen.SearchLogsRequest) (*gen.SearchLogsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.SearchLogsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.SearchLogsRequest) *gen.SearchLogsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.SearchLogsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.SearchLogsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetConfig provides a mock function with given fields: _a0, _a1
 func (_m *WaypointServer) SetConfig(_a0 context.Context, _a1 *gen.ConfigSetRequest) (*gen.ConfigSetResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1283,6 +2429,29 @@ func (_m *WaypointServer) StartExecStream(_a0 gen.Waypoint_StartExecStreamServer
 	return r0
 }
 
+// TestAuthMethod provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) TestAuthMethod(_a0 context.Context, _a1 *gen.TestAuthMethodRequest) (*emptypb.Empty, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.TestAuthMethodRequest) *emptypb.Empty); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*emptypb.Empty)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.TestAuthMethodRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateUser provides a mock function with given fields: _a0, _a1
 func (_m *WaypointServer) UpdateUser(_a0 context.Context, _a1 *gen.UpdateUserRequest) (*gen.UpdateUserResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1306,6 +2475,34 @@ func (_m *WaypointServer) UpdateUser(_a0 context.Context, _a1 *gen.UpdateUserReq
 	return r0, r1
 }
 
+// UploadBuildCache provides a mock function with given fields: _a0
+func (_m *WaypointServer) UploadBuildCache(_a0 gen.Waypoint_UploadBuildCacheServer) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(gen.Waypoint_UploadBuildCacheServer) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UploadPlugin provides a mock function with given fields: _a0
+func (_m *WaypointServer) UploadPlugin(_a0 gen.Waypoint_UploadPluginServer) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(gen.Waypoint_UploadPluginServer) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpsertApplication provides a mock function with given fields: _a0, _a1
 func (_m *WaypointServer) UpsertApplication(_a0 context.Context, _a1 *gen.UpsertApplicationRequest) (*gen.UpsertApplicationResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1398,6 +2595,98 @@ func (_m *WaypointServer) UpsertDeployment(_a0 context.Context, _a1 *gen.UpsertD
 	return r0, r1
 }
 
+// UpsertJobSchedule provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) UpsertJobSchedule(_a0 context.Context, _a1 *gen.UpsertJobScheduleRequest) (*gen.UpsertJobScheduleResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.UpsertJobScheduleResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertJobScheduleRequest) *gen.UpsertJobScheduleResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.UpsertJobScheduleResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertJobScheduleRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpsertNotifier provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) UpsertNotifier(_a0 context.Context, _a1 *gen.UpsertNotifierRequest) (*gen.UpsertNotifierResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.UpsertNotifierResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertNotifierRequest) *gen.UpsertNotifierResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.UpsertNotifierResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertNotifierRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpsertOnDemandRunnerConfig provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) UpsertOnDemandRunnerConfig(_a0 context.Context, _a1 *gen.UpsertOnDemandRunnerConfigRequest) (*gen.UpsertOnDemandRunnerConfigResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.UpsertOnDemandRunnerConfigResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertOnDemandRunnerConfigRequest) *gen.UpsertOnDemandRunnerConfigResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.UpsertOnDemandRunnerConfigResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertOnDemandRunnerConfigRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpsertPipeline provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) UpsertPipeline(_a0 context.Context, _a1 *gen.UpsertPipelineRequest) (*gen.UpsertPipelineResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.UpsertPipelineResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertPipelineRequest) *gen.UpsertPipelineResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.UpsertPipelineResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertPipelineRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpsertProject provides a mock function with given fields: _a0, _a1
 func (_m *WaypointServer) UpsertProject(_a0 context.Context, _a1 *gen.UpsertProjectRequest) (*gen.UpsertProjectResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1421,6 +2710,29 @@ func (_m *WaypointServer) UpsertProject(_a0 context.Context, _a1 *gen.UpsertProj
 	return r0, r1
 }
 
+// UpsertProjectTemplate provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) UpsertProjectTemplate(_a0 context.Context, _a1 *gen.UpsertProjectTemplateRequest) (*gen.UpsertProjectTemplateResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.UpsertProjectTemplateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertProjectTemplateRequest) *gen.UpsertProjectTemplateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.UpsertProjectTemplateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertProjectTemplateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpsertPushedArtifact provides a mock function with given fields: _a0, _a1
 func (_m *WaypointServer) UpsertPushedArtifact(_a0 context.Context, _a1 *gen.UpsertPushedArtifactRequest) (*gen.UpsertPushedArtifactResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1490,6 +2802,29 @@ func (_m *WaypointServer) UpsertStatusReport(_a0 context.Context, _a1 *gen.Upser
 	return r0, r1
 }
 
+// UpsertWorkspace provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) UpsertWorkspace(_a0 context.Context, _a1 *gen.UpsertWorkspaceRequest) (*gen.UpsertWorkspaceResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.UpsertWorkspaceResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpsertWorkspaceRequest) *gen.UpsertWorkspaceResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.UpsertWorkspaceResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpsertWorkspaceRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ValidateJob provides a mock function with given fields: _a0, _a1
 func (_m *WaypointServer) ValidateJob(_a0 context.Context, _a1 *gen.ValidateJobRequest) (*gen.ValidateJobResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1513,6 +2848,71 @@ func (_m *WaypointServer) ValidateJob(_a0 context.Context, _a1 *gen.ValidateJobR
 	return r0, r1
 }
 
+// ValidateWaypointHcl provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) ValidateWaypointHcl(_a0 context.Context, _a1 *gen.ValidateWaypointHclRequest) (*gen.ValidateWaypointHclResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *gen.ValidateWaypointHclResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ValidateWaypointHclRequest) *gen.ValidateWaypointHclResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ValidateWaypointHclResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ValidateWaypointHclRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WatchJobs provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) WatchJobs(_a0 *gen.ListJobsRequest, _a1 gen.Waypoint_WatchJobsServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gen.ListJobsRequest, gen.Waypoint_WatchJobsServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WatchProjects provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) WatchProjects(_a0 *emptypb.Empty, _a1 gen.Waypoint_WatchProjectsServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*emptypb.Empty, gen.Waypoint_WatchProjectsServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WatchStatusReports provides a mock function with given fields: _a0, _a1
+func (_m *WaypointServer) WatchStatusReports(_a0 *gen.ListStatusReportsRequest, _a1 gen.Waypoint_WatchStatusReportsServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gen.ListStatusReportsRequest, gen.Waypoint_WatchStatusReportsServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // WaypointHclFmt provides a mock function with given fields: _a0, _a1
 func (_m *WaypointServer) WaypointHclFmt(_a0 context.Context, _a1 *gen.WaypointHclFmtRequest) (*gen.WaypointHclFmtResponse, error) {
 	ret := _m.Called(_a0, _a1)
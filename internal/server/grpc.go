@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc/reflection"
 
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/telemetry"
 )
 
 type grpcServer struct {
@@ -28,7 +29,9 @@ func newGrpcServer(opts *options) (*grpcServer, error) {
 		return nil, err
 	}
 
-	var so []grpc.ServerOption
+	// The tracing interceptors are first so that the spans of calls
+	// include the time of every other interceptor.
+	so := telemetry.ServerOptions()
 	so = append(so,
 		grpc.ChainUnaryInterceptor(
 			// Insert our logger and also log req/resp
@@ -55,6 +58,15 @@ func newGrpcServer(opts *options) (*grpcServer, error) {
 			}),
 	)
 
+	// The metrics interceptors are before auth so that the latency
+	// includes authentication and rejected calls are counted.
+	if opts.metrics != nil {
+		so = append(so,
+			grpc.ChainUnaryInterceptor(metricsUnaryInterceptor(opts.metrics)),
+			grpc.ChainStreamInterceptor(metricsStreamInterceptor(opts.metrics)),
+		)
+	}
+
 	if opts.AuthChecker != nil {
 		so = append(so,
 			grpc.ChainUnaryInterceptor(authUnaryInterceptor(opts.AuthChecker)),
@@ -62,6 +74,20 @@ func newGrpcServer(opts *options) (*grpcServer, error) {
 		)
 	}
 
+	// The rate limit interceptor must be after auth so that it can limit
+	// per user.
+	if rl, ok := opts.Service.(RateLimiter); ok {
+		so = append(so,
+			grpc.ChainUnaryInterceptor(rateLimitUnaryInterceptor(rl)),
+			grpc.ChainStreamInterceptor(rateLimitStreamInterceptor(rl)),
+		)
+	}
+
+	// The audit interceptor must be after auth so that it knows the user.
+	if al, ok := opts.Service.(AuditLogger); ok {
+		so = append(so, grpc.ChainUnaryInterceptor(auditUnaryInterceptor(al)))
+	}
+
 	s := grpc.NewServer(so...)
 
 	return &grpcServer{
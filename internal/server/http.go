@@ -12,6 +12,7 @@ import (
 	assetfs "github.com/elazarl/go-bindata-assetfs"
 	"github.com/hashicorp/waypoint/internal/server/gen"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 type httpServer struct {
@@ -44,11 +45,23 @@ func newHttpServer(grpcServer *grpc.Server, opts *options) *httpServer {
 		Fallback:  "index.html",
 	})
 
+	var metricsHandler http.Handler
+	if opts.metrics != nil {
+		metricsHandler = promhttp.HandlerFor(opts.metrics.registry, promhttp.HandlerOpts{
+			ErrorLog: log.StandardLogger(&hclog.StandardLoggerOptions{InferLevels: true}),
+		})
+	}
+
 	// If the path has a grpc prefix we assume it's a GRPC gateway request,
-	// otherwise fall back to serving the UI from the filesystem
+	// webhooks go to the webhook handler, metrics go to the Prometheus
+	// handler, otherwise fall back to serving the UI from the filesystem
 	rootHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if strings.HasPrefix(r.URL.Path, "/grpc") {
 			grpcWrapped.ServeHTTP(w, r)
+		} else if strings.HasPrefix(r.URL.Path, WebhookPath) && opts.WebhookHandler != nil {
+			opts.WebhookHandler.ServeWebhook(w, r)
+		} else if r.URL.Path == MetricsPath && metricsHandler != nil {
+			metricsHandler.ServeHTTP(w, r)
 		} else if opts.BrowserUIEnabled {
 			uifs.ServeHTTP(w, r)
 		}
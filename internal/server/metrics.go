@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// MetricsPath is the path of the Prometheus metrics endpoint on the HTTP
+// server.
+const MetricsPath = "/metrics"
+
+// metrics are the metrics of the gRPC server. The service can add its own
+// metrics by implementing prometheus.Collector.
+type metrics struct {
+	registry *prometheus.Registry
+
+	// rpcDuration is the latency of unary calls by method and status code.
+	rpcDuration *prometheus.HistogramVec
+
+	// rpcStreams is the number of open streams by method.
+	rpcStreams *prometheus.GaugeVec
+}
+
+// newMetrics returns the metrics of the server registered with a new
+// registry. If the service implements prometheus.Collector, its metrics
+// are registered too.
+func newMetrics(opts *options) (*metrics, error) {
+	m := &metrics{
+		registry: prometheus.NewRegistry(),
+
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "waypoint",
+			Subsystem: "rpc",
+			Name:      "duration_seconds",
+			Help:      "Latency of unary gRPC calls.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "code"}),
+
+		rpcStreams: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "waypoint",
+			Subsystem: "rpc",
+			Name:      "streams_open",
+			Help:      "Number of open gRPC streams.",
+		}, []string{"method"}),
+	}
+
+	collectors := []prometheus.Collector{
+		m.rpcDuration,
+		m.rpcStreams,
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+	}
+	if c, ok := opts.Service.(prometheus.Collector); ok {
+		collectors = append(collectors, c)
+	}
+
+	for _, c := range collectors {
+		if err := m.registry.Register(c); err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// metricsUnaryInterceptor returns a gRPC unary interceptor that records
+// the latency of every call.
+func metricsUnaryInterceptor(m *metrics) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		if strings.HasPrefix(info.FullMethod, "/grpc.reflection.v1alpha.ServerReflection/") {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		m.rpcDuration.WithLabelValues(
+			filepath.Base(info.FullMethod),
+			status.Code(err).String(),
+		).Observe(time.Since(start).Seconds())
+
+		return resp, err
+	}
+}
+
+// metricsStreamInterceptor returns a gRPC stream interceptor that tracks
+// the number of open streams. Streams such as the runner and entrypoint
+// streams stay open for a long time so we don't record their latency.
+func metricsStreamInterceptor(m *metrics) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		if strings.HasPrefix(info.FullMethod, "/grpc.reflection.v1alpha.ServerReflection/") {
+			return handler(srv, ss)
+		}
+
+		gauge := m.rpcStreams.WithLabelValues(filepath.Base(info.FullMethod))
+		gauge.Inc()
+		defer gauge.Dec()
+
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMetricsUnaryInterceptor(t *testing.T) {
+	require := require.New(t)
+
+	m, err := newMetrics(&options{})
+	require.NoError(err)
+	f := metricsUnaryInterceptor(m)
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Errorf(codes.NotFound, "nope")
+	}
+
+	_, err = f(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/foo/GetProject"}, handler)
+	require.Error(err)
+
+	families, err := m.registry.Gather()
+	require.NoError(err)
+
+	var found bool
+	for _, fam := range families {
+		if fam.GetName() != "waypoint_rpc_duration_seconds" {
+			continue
+		}
+
+		require.Len(fam.Metric, 1)
+		labels := map[string]string{}
+		for _, l := range fam.Metric[0].Label {
+			labels[l.GetName()] = l.GetValue()
+		}
+		require.Equal("GetProject", labels["method"])
+		require.Equal("NotFound", labels["code"])
+		require.Equal(uint64(1), fam.Metric[0].Histogram.GetSampleCount())
+		found = true
+	}
+	require.True(found)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"go/token"
+	"strings"
 
 	"github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
@@ -59,6 +60,11 @@ func ValidateAuthMethodRules(v *pb.AuthMethod) []*validation.FieldRules {
 				v := v.Method.(*pb.AuthMethod_Oidc)
 				return validateAuthMethodOIDCRules(v)
 			}),
+		validationext.StructOneof(&v.Method, (*pb.AuthMethod_Pat)(nil),
+			func() []*validation.FieldRules {
+				v := v.Method.(*pb.AuthMethod_Pat)
+				return validateAuthMethodPATRules(v)
+			}),
 	}
 }
 
@@ -70,6 +76,17 @@ func validateAuthMethodOIDCRules(v *pb.AuthMethod_Oidc) []*validation.FieldRules
 		validation.Field(&v.Oidc.DiscoveryUrl, validation.Required, is.URL),
 		validation.Field(&v.Oidc.ClaimMappings, validation.By(isClaimMapping)),
 		validation.Field(&v.Oidc.ListClaimMappings, validation.By(isClaimMapping)),
+		validation.Field(&v.Oidc.RoleMappings, validation.Each(validation.By(isRoleMapping))),
+	}
+}
+
+// validateAuthMethodPATRules
+func validateAuthMethodPATRules(v *pb.AuthMethod_Pat) []*validation.FieldRules {
+	return []*validation.FieldRules{
+		validation.Field(&v.Pat.Provider, validation.Required),
+		validation.Field(&v.Pat.ApiUrl, is.URL),
+		validation.Field(&v.Pat.Orgs, validation.Each(validation.Required)),
+		validation.Field(&v.Pat.Teams, validation.Each(validation.By(isTeam))),
 	}
 }
 
@@ -83,6 +100,16 @@ func ValidateUpsertAuthMethodRequest(v *pb.UpsertAuthMethodRequest) error {
 	))
 }
 
+// ValidateTestAuthMethodRequest
+func ValidateTestAuthMethodRequest(v *pb.TestAuthMethodRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.AuthMethod, validation.Required),
+		validationext.StructField(&v.AuthMethod, func() []*validation.FieldRules {
+			return ValidateAuthMethodRules(v.AuthMethod)
+		}),
+	))
+}
+
 // ValidateDeleteAuthMethodRequest
 func ValidateDeleteAuthMethodRequest(v *pb.DeleteAuthMethodRequest) error {
 	return validationext.Error(validation.ValidateStruct(v,
@@ -90,6 +117,14 @@ func ValidateDeleteAuthMethodRequest(v *pb.DeleteAuthMethodRequest) error {
 	))
 }
 
+// ValidateCompletePATAuthRequest
+func ValidateCompletePATAuthRequest(v *pb.CompletePATAuthRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.AuthMethod, validation.Required),
+		validation.Field(&v.Token, validation.Required),
+	))
+}
+
 // ValidateGetOIDCAuthURLRequest
 func ValidateGetOIDCAuthURLRequest(v *pb.GetOIDCAuthURLRequest) error {
 	return validationext.Error(validation.ValidateStruct(v,
@@ -131,6 +166,23 @@ func isBExpr(v interface{}) error {
 	return nil
 }
 
+func isRoleMapping(v interface{}) error {
+	m := v.(*pb.AuthMethod_OIDC_RoleMapping)
+	return validation.ValidateStruct(m,
+		validation.Field(&m.Selector, validation.Required, validation.By(isBExpr)),
+		validation.Field(&m.Role, validation.Required, validation.In(RoleAdmin, RoleOperator, RoleReadOnly)),
+	)
+}
+
+func isTeam(v interface{}) error {
+	parts := strings.SplitN(v.(string), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return errors.New("team must be in the form 'org/team'")
+	}
+
+	return nil
+}
+
 func isClaimMapping(v interface{}) error {
 	m := v.(map[string]string)
 	for m, v := range m {
@@ -0,0 +1,120 @@
+package ptypes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestValidateAuthMethod(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Modify func(*pb.AuthMethod)
+		Error  string
+	}{
+		{
+			"valid",
+			nil,
+			"",
+		},
+
+		{
+			"valid role mapping",
+			func(v *pb.AuthMethod) {
+				v.Method.(*pb.AuthMethod_Oidc).Oidc.RoleMappings = []*pb.AuthMethod_OIDC_RoleMapping{
+					{Selector: "admins in list.groups", Role: RoleAdmin},
+				}
+			},
+			"",
+		},
+
+		{
+			"role mapping with unknown role",
+			func(v *pb.AuthMethod) {
+				v.Method.(*pb.AuthMethod_Oidc).Oidc.RoleMappings = []*pb.AuthMethod_OIDC_RoleMapping{
+					{Selector: "admins in list.groups", Role: "nope"},
+				}
+			},
+			"role: must be a valid value",
+		},
+
+		{
+			"role mapping with invalid selector",
+			func(v *pb.AuthMethod) {
+				v.Method.(*pb.AuthMethod_Oidc).Oidc.RoleMappings = []*pb.AuthMethod_OIDC_RoleMapping{
+					{Selector: "(", Role: RoleAdmin},
+				}
+			},
+			"invalid selector",
+		},
+
+		{
+			"role mapping without selector",
+			func(v *pb.AuthMethod) {
+				v.Method.(*pb.AuthMethod_Oidc).Oidc.RoleMappings = []*pb.AuthMethod_OIDC_RoleMapping{
+					{Role: RoleAdmin},
+				}
+			},
+			"selector: cannot be blank",
+		},
+
+		{
+			"valid PAT",
+			func(v *pb.AuthMethod) {
+				v.Method = &pb.AuthMethod_Pat{
+					Pat: &pb.AuthMethod_PAT{
+						Provider: pb.AuthMethod_PAT_GITHUB,
+						Orgs:     []string{"acme"},
+						Teams:    []string{"acme/ops"},
+					},
+				}
+			},
+			"",
+		},
+
+		{
+			"PAT without provider",
+			func(v *pb.AuthMethod) {
+				v.Method = &pb.AuthMethod_Pat{
+					Pat: &pb.AuthMethod_PAT{},
+				}
+			},
+			"provider: cannot be blank",
+		},
+
+		{
+			"PAT with invalid team",
+			func(v *pb.AuthMethod) {
+				v.Method = &pb.AuthMethod_Pat{
+					Pat: &pb.AuthMethod_PAT{
+						Provider: pb.AuthMethod_PAT_GITLAB,
+						Teams:    []string{"ops"},
+					},
+				}
+			},
+			"team must be in the form",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			value := TestAuthMethod(t, nil)
+			if f := tt.Modify; f != nil {
+				f(value)
+			}
+
+			err := ValidateAuthMethod(value)
+			if tt.Error == "" {
+				require.NoError(err)
+				return
+			}
+
+			require.Error(err)
+			require.Contains(err.Error(), tt.Error)
+		})
+	}
+}
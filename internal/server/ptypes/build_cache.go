@@ -0,0 +1,30 @@
+package ptypes
+
+import (
+	"github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/hashicorp/waypoint/internal/pkg/validationext"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// ValidateUploadBuildCacheOpen
+func ValidateUploadBuildCacheOpen(v *pb.UploadBuildCacheRequest_Open) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Application, validation.Required),
+		validationext.StructField(&v.Application, func() []*validation.FieldRules {
+			return ValidateRefApplicationRules(v.Application)
+		}),
+		validation.Field(&v.Key, validation.Required),
+	))
+}
+
+// ValidateDownloadBuildCacheRequest
+func ValidateDownloadBuildCacheRequest(v *pb.DownloadBuildCacheRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Application, validation.Required),
+		validationext.StructField(&v.Application, func() []*validation.FieldRules {
+			return ValidateRefApplicationRules(v.Application)
+		}),
+		validation.Field(&v.Key, validation.Required),
+	))
+}
@@ -3,6 +3,9 @@ package ptypes
 import (
 	"strconv"
 
+	"github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/hashicorp/waypoint/internal/pkg/validationext"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 )
 
@@ -22,3 +25,11 @@ func (v *Deployment) URLFragment() string {
 
 	return "v" + strconv.FormatUint(seq, 10)
 }
+
+// ValidateGetDeploymentDiffRequest
+func ValidateGetDeploymentDiffRequest(v *pb.GetDeploymentDiffRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.From, validation.Required),
+		validation.Field(&v.To, validation.Required),
+	))
+}
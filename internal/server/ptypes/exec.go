@@ -32,6 +32,25 @@ func TestExecStreamRequestStart(t testing.T, src *pb.ExecStreamRequest_Start) *p
 func ValidateExecStreamRequestStart(v *pb.ExecStreamRequest_Start) error {
 	return validationext.Error(validation.ValidateStruct(v,
 		validation.Field(&v.Target, validation.Required),
-		validation.Field(&v.Args, validation.Required),
+		validation.Field(&v.Args, validation.When(
+			v.PortForward == nil && v.FileCopy == nil, validation.Required)),
+		validationext.StructField(&v.PortForward, func() []*validation.FieldRules {
+			return []*validation.FieldRules{
+				validation.Field(&v.PortForward.Port, validation.Required, validation.Max(int32(65535))),
+			}
+		}),
+		validation.Field(&v.FileCopy, validation.When(v.PortForward != nil, validation.Nil)),
+		validationext.StructField(&v.FileCopy, func() []*validation.FieldRules {
+			return []*validation.FieldRules{
+				validation.Field(&v.FileCopy.Path, validation.Required),
+			}
+		}),
+	))
+}
+
+// ValidateGetExecSessionRequest
+func ValidateGetExecSessionRequest(v *pb.GetExecSessionRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.SessionId, validation.Required),
 	))
 }
@@ -27,6 +27,49 @@ func TestValidateExecStreamRequestStart(t *testing.T) {
 			},
 			"args: cannot be blank",
 		},
+
+		{
+			"port forward without args",
+			func(v *pb.ExecStreamRequest_Start) {
+				v.Args = nil
+				v.PortForward = &pb.ExecStreamRequest_PortForward{Port: 8080}
+			},
+			"",
+		},
+
+		{
+			"port forward port out of range",
+			func(v *pb.ExecStreamRequest_Start) {
+				v.PortForward = &pb.ExecStreamRequest_PortForward{Port: 70000}
+			},
+			"port",
+		},
+
+		{
+			"file copy without args",
+			func(v *pb.ExecStreamRequest_Start) {
+				v.Args = nil
+				v.FileCopy = &pb.ExecStreamRequest_FileCopy{Path: "/tmp/heap.dump"}
+			},
+			"",
+		},
+
+		{
+			"file copy requires a path",
+			func(v *pb.ExecStreamRequest_Start) {
+				v.FileCopy = &pb.ExecStreamRequest_FileCopy{Upload: true}
+			},
+			"path: cannot be blank",
+		},
+
+		{
+			"file copy and port forward",
+			func(v *pb.ExecStreamRequest_Start) {
+				v.PortForward = &pb.ExecStreamRequest_PortForward{Port: 8080}
+				v.FileCopy = &pb.ExecStreamRequest_FileCopy{Path: "/tmp/heap.dump"}
+			},
+			"file_copy: must be blank",
+		},
 	}
 
 	for _, tt := range cases {
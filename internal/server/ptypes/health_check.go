@@ -0,0 +1,23 @@
+package ptypes
+
+import (
+	"github.com/golang/protobuf/proto"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// HealthChecksEqual returns true if both lists have the same checks in the
+// same order.
+func HealthChecksEqual(a, b []*pb.HealthCheck) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,37 @@
+package ptypes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestHealthChecksEqual(t *testing.T) {
+	web := &pb.HealthCheck{Type: pb.HealthCheck_HTTP, Path: "/health"}
+	cmd := &pb.HealthCheck{Type: pb.HealthCheck_COMMAND, Command: []string{"true"}}
+
+	cases := []struct {
+		Name  string
+		A, B  []*pb.HealthCheck
+		Equal bool
+	}{
+		{"both empty", nil, []*pb.HealthCheck{}, true},
+		{"same checks", []*pb.HealthCheck{web, cmd}, []*pb.HealthCheck{
+			{Type: pb.HealthCheck_HTTP, Path: "/health"},
+			{Type: pb.HealthCheck_COMMAND, Command: []string{"true"}},
+		}, true},
+		{"different order", []*pb.HealthCheck{web, cmd}, []*pb.HealthCheck{cmd, web}, false},
+		{"different length", []*pb.HealthCheck{web}, []*pb.HealthCheck{web, cmd}, false},
+		{"different field", []*pb.HealthCheck{web}, []*pb.HealthCheck{
+			{Type: pb.HealthCheck_HTTP, Path: "/ready"},
+		}, false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require.Equal(t, tt.Equal, HealthChecksEqual(tt.A, tt.B))
+		})
+	}
+}
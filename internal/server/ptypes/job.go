@@ -57,12 +57,20 @@ func ValidateJob(job *pb.Job) error {
 		validation.Field(&job.Workspace, validation.Required),
 		validation.Field(&job.TargetRunner, validation.Required),
 		validation.Field(&job.Operation, validation.Required),
+		validation.Field(&job.Timeout, validationext.IsDuration),
 		validationext.StructField(&job.DataSource, func() []*validation.FieldRules {
 			return ValidateJobDataSourceRules(job.DataSource)
 		}),
 	))
 }
 
+// ValidateGetJobLogsRequest
+func ValidateGetJobLogsRequest(v *pb.GetJobLogsRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.JobId, validation.Required),
+	))
+}
+
 // ValidateJobDataSourceRules
 func ValidateJobDataSourceRules(v *pb.Job_DataSource) []*validation.FieldRules {
 	return []*validation.FieldRules{
@@ -0,0 +1,99 @@
+package ptypes
+
+import (
+	"fmt"
+
+	"github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hashicorp/cronexpr"
+	"github.com/imdario/mergo"
+	"github.com/mitchellh/go-testing-interface"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/pkg/validationext"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// TestJobSchedule returns a valid job schedule for tests.
+func TestJobSchedule(t testing.T, src *pb.JobSchedule) *pb.JobSchedule {
+	t.Helper()
+
+	if src == nil {
+		src = &pb.JobSchedule{}
+	}
+
+	require.NoError(t, mergo.Merge(src, &pb.JobSchedule{
+		Project: &pb.Ref_Project{Project: "p_test"},
+		Name:    "nightly",
+		Application: &pb.Ref_Application{
+			Project:     "p_test",
+			Application: "a_test",
+		},
+		Workspace: &pb.Ref_Workspace{Workspace: "default"},
+		Op:        pb.JobSchedule_UP,
+		Cron:      "0 2 * * *",
+	}))
+
+	return src
+}
+
+// ValidateJobSchedule validates the job schedule structure.
+func ValidateJobSchedule(v *pb.JobSchedule) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Project, validation.Required),
+		validation.Field(&v.Name, validation.Required),
+		validation.Field(&v.Application, validation.Required),
+		validationext.StructField(&v.Application, func() []*validation.FieldRules {
+			return []*validation.FieldRules{
+				validation.Field(&v.Application.Application, validation.Required),
+				validation.Field(&v.Application.Project,
+					validation.In(v.Project.GetProject()).Error("must match the project of the schedule")),
+			}
+		}),
+		validation.Field(&v.Workspace, validation.Required),
+		validation.Field(&v.Op, validation.Required),
+		validation.Field(&v.Cron, validation.Required, validation.By(isCron)),
+	))
+}
+
+// ValidateUpsertJobScheduleRequest
+func ValidateUpsertJobScheduleRequest(v *pb.UpsertJobScheduleRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Schedule, validation.Required),
+	))
+}
+
+// ValidateGetJobScheduleRequest
+func ValidateGetJobScheduleRequest(v *pb.GetJobScheduleRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Project, validation.Required),
+		validation.Field(&v.Name, validation.Required),
+	))
+}
+
+// ValidateListJobSchedulesRequest
+func ValidateListJobSchedulesRequest(v *pb.ListJobSchedulesRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Project, validation.Required),
+	))
+}
+
+// ValidateDeleteJobScheduleRequest
+func ValidateDeleteJobScheduleRequest(v *pb.DeleteJobScheduleRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Project, validation.Required),
+		validation.Field(&v.Name, validation.Required),
+	))
+}
+
+func isCron(v interface{}) error {
+	str := v.(string)
+	if str == "" {
+		return nil
+	}
+
+	if _, err := cronexpr.Parse(str); err != nil {
+		return fmt.Errorf("invalid cron expression: %s", err)
+	}
+
+	return nil
+}
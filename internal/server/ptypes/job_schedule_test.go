@@ -0,0 +1,61 @@
+package ptypes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestValidateJobSchedule(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Modify func(*pb.JobSchedule)
+		Error  string
+	}{
+		{
+			"valid",
+			nil,
+			"",
+		},
+
+		{
+			"no op",
+			func(v *pb.JobSchedule) { v.Op = pb.JobSchedule_UNKNOWN },
+			"op: cannot be blank",
+		},
+
+		{
+			"invalid cron",
+			func(v *pb.JobSchedule) { v.Cron = "every night" },
+			"invalid cron expression",
+		},
+
+		{
+			"application in another project",
+			func(v *pb.JobSchedule) { v.Application.Project = "other" },
+			"must match the project",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			value := TestJobSchedule(t, nil)
+			if f := tt.Modify; f != nil {
+				f(value)
+			}
+
+			err := ValidateJobSchedule(value)
+			if tt.Error == "" {
+				require.NoError(err)
+				return
+			}
+
+			require.Error(err)
+			require.Contains(err.Error(), tt.Error)
+		})
+	}
+}
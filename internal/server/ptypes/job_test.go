@@ -20,6 +20,12 @@ func TestValidateJob(t *testing.T) {
 			"",
 		},
 
+		{
+			"timeout is invalid",
+			func(j *pb.Job) { j.Timeout = "forever" },
+			"invalid duration",
+		},
+
 		{
 			"id is set",
 			func(j *pb.Job) { j.Id = "nope" },
@@ -0,0 +1,27 @@
+package ptypes
+
+import (
+	"github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/hashicorp/waypoint/internal/pkg/validationext"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// ValidateSearchLogsRequest
+func ValidateSearchLogsRequest(v *pb.SearchLogsRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Application, validation.Required),
+		validationext.StructField(&v.Application, func() []*validation.FieldRules {
+			return []*validation.FieldRules{
+				validation.Field(&v.Application.Application, validation.Required),
+				validationext.StructField(&v.Application.Application, func() []*validation.FieldRules {
+					return ValidateRefApplicationRules(v.Application.Application)
+				}),
+				validation.Field(&v.Application.Workspace, validation.Required),
+				validationext.StructField(&v.Application.Workspace, func() []*validation.FieldRules {
+					return ValidateRefWorkspaceRules(v.Application.Workspace)
+				}),
+			}
+		}),
+	))
+}
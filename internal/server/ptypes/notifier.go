@@ -0,0 +1,78 @@
+package ptypes
+
+import (
+	"github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/imdario/mergo"
+	"github.com/mitchellh/go-testing-interface"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/pkg/validationext"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// TestNotifier returns a valid notifier for tests.
+func TestNotifier(t testing.T, src *pb.Notifier) *pb.Notifier {
+	t.Helper()
+
+	if src == nil {
+		src = &pb.Notifier{}
+	}
+
+	require.NoError(t, mergo.Merge(src, &pb.Notifier{
+		Project: &pb.Ref_Project{Project: "p_test"},
+		Name:    "team",
+		Type:    pb.Notifier_WEBHOOK,
+		Url:     "https://example.com/hook",
+	}))
+
+	return src
+}
+
+// ValidateNotifier validates the notifier structure.
+func ValidateNotifier(v *pb.Notifier) error {
+	email := v.Type == pb.Notifier_EMAIL
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Project, validation.Required),
+		validation.Field(&v.Name, validation.Required),
+		validation.Field(&v.Type, validation.Required),
+		validation.Field(&v.Url,
+			validation.When(!email, validation.Required, is.URL),
+			validation.When(email, validation.Empty)),
+		validation.Field(&v.EmailTo,
+			validation.When(email, validation.Required),
+			validation.When(!email, validation.Empty),
+			validation.Each(is.EmailFormat)),
+		validation.Field(&v.Events, validation.Each(validation.Required)),
+	))
+}
+
+// ValidateUpsertNotifierRequest
+func ValidateUpsertNotifierRequest(v *pb.UpsertNotifierRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Notifier, validation.Required),
+	))
+}
+
+// ValidateGetNotifierRequest
+func ValidateGetNotifierRequest(v *pb.GetNotifierRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Project, validation.Required),
+		validation.Field(&v.Name, validation.Required),
+	))
+}
+
+// ValidateListNotifiersRequest
+func ValidateListNotifiersRequest(v *pb.ListNotifiersRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Project, validation.Required),
+	))
+}
+
+// ValidateDeleteNotifierRequest
+func ValidateDeleteNotifierRequest(v *pb.DeleteNotifierRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Project, validation.Required),
+		validation.Field(&v.Name, validation.Required),
+	))
+}
@@ -0,0 +1,80 @@
+package ptypes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestValidateNotifier(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Modify func(*pb.Notifier)
+		Error  string
+	}{
+		{
+			"valid",
+			nil,
+			"",
+		},
+
+		{
+			"no type",
+			func(v *pb.Notifier) { v.Type = pb.Notifier_UNKNOWN },
+			"type: cannot be blank",
+		},
+
+		{
+			"invalid url",
+			func(v *pb.Notifier) { v.Url = "not a url" },
+			"url: must be a valid URL",
+		},
+
+		{
+			"email without recipients",
+			func(v *pb.Notifier) {
+				v.Type = pb.Notifier_EMAIL
+				v.Url = ""
+			},
+			"email_to: cannot be blank",
+		},
+
+		{
+			"email",
+			func(v *pb.Notifier) {
+				v.Type = pb.Notifier_EMAIL
+				v.Url = ""
+				v.EmailTo = []string{"ops@example.com"}
+			},
+			"",
+		},
+
+		{
+			"recipients for a webhook",
+			func(v *pb.Notifier) { v.EmailTo = []string{"ops@example.com"} },
+			"email_to: must be blank",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			value := TestNotifier(t, nil)
+			if f := tt.Modify; f != nil {
+				f(value)
+			}
+
+			err := ValidateNotifier(value)
+			if tt.Error == "" {
+				require.NoError(err)
+				return
+			}
+
+			require.Error(err)
+			require.Contains(err.Error(), tt.Error)
+		})
+	}
+}
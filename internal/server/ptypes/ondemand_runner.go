@@ -0,0 +1,68 @@
+package ptypes
+
+import (
+	"github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/imdario/mergo"
+	"github.com/mitchellh/go-testing-interface"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/pkg/validationext"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// TestOnDemandRunnerConfig returns a valid on-demand runner configuration
+// for tests.
+func TestOnDemandRunnerConfig(t testing.T, src *pb.OnDemandRunnerConfig) *pb.OnDemandRunnerConfig {
+	t.Helper()
+
+	if src == nil {
+		src = &pb.OnDemandRunnerConfig{}
+	}
+
+	require.NoError(t, mergo.Merge(src, &pb.OnDemandRunnerConfig{
+		Name:       "test",
+		PluginType: "docker",
+	}))
+
+	return src
+}
+
+// ValidateOnDemandRunnerConfig validates the on-demand runner
+// configuration structure.
+func ValidateOnDemandRunnerConfig(v *pb.OnDemandRunnerConfig) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		ValidateOnDemandRunnerConfigRules(v)...,
+	))
+}
+
+// ValidateOnDemandRunnerConfigRules
+func ValidateOnDemandRunnerConfigRules(v *pb.OnDemandRunnerConfig) []*validation.FieldRules {
+	return []*validation.FieldRules{
+		validation.Field(&v.Name, validation.Required),
+		validation.Field(&v.PluginType, validation.Required),
+	}
+}
+
+// ValidateUpsertOnDemandRunnerConfigRequest
+func ValidateUpsertOnDemandRunnerConfigRequest(v *pb.UpsertOnDemandRunnerConfigRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Config, validation.Required),
+		validationext.StructField(&v.Config, func() []*validation.FieldRules {
+			return ValidateOnDemandRunnerConfigRules(v.Config)
+		}),
+	))
+}
+
+// ValidateGetOnDemandRunnerConfigRequest
+func ValidateGetOnDemandRunnerConfigRequest(v *pb.GetOnDemandRunnerConfigRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Config, validation.Required),
+	))
+}
+
+// ValidateDeleteOnDemandRunnerConfigRequest
+func ValidateDeleteOnDemandRunnerConfigRequest(v *pb.DeleteOnDemandRunnerConfigRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Config, validation.Required),
+	))
+}
@@ -0,0 +1,168 @@
+package ptypes
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/imdario/mergo"
+	"github.com/mitchellh/go-testing-interface"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/pkg/validationext"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// TestPipeline returns a valid pipeline for tests.
+func TestPipeline(t testing.T, src *pb.Pipeline) *pb.Pipeline {
+	t.Helper()
+
+	if src == nil {
+		src = &pb.Pipeline{}
+	}
+
+	require.NoError(t, mergo.Merge(src, &pb.Pipeline{
+		Project: &pb.Ref_Project{Project: "p_test"},
+		Name:    "test",
+		Steps: []*pb.Pipeline_Step{
+			{
+				Name: "build",
+				Application: &pb.Ref_Application{
+					Project:     "p_test",
+					Application: "a_test",
+				},
+				Op: pb.Pipeline_BUILD,
+			},
+		},
+	}))
+
+	return src
+}
+
+// ValidatePipeline validates the pipeline structure.
+func ValidatePipeline(v *pb.Pipeline) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Project, validation.Required),
+		validation.Field(&v.Name, validation.Required),
+		validation.Field(&v.Steps, validation.Required, validation.By(isPipelineSteps)),
+	))
+}
+
+// ValidateUpsertPipelineRequest
+func ValidateUpsertPipelineRequest(v *pb.UpsertPipelineRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Pipeline, validation.Required),
+	))
+}
+
+// ValidateListPipelinesRequest
+func ValidateListPipelinesRequest(v *pb.ListPipelinesRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Project, validation.Required),
+	))
+}
+
+// ValidateRunPipelineRequest
+func ValidateRunPipelineRequest(v *pb.RunPipelineRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Project, validation.Required),
+		validation.Field(&v.Name, validation.Required),
+		validation.Field(&v.JobTemplate, validation.Required),
+		validationext.StructField(&v.JobTemplate, func() []*validation.FieldRules {
+			return []*validation.FieldRules{
+				validation.Field(&v.JobTemplate.Id, validation.By(isEmpty)),
+				validation.Field(&v.JobTemplate.Workspace, validation.Required),
+				validation.Field(&v.JobTemplate.TargetRunner, validation.Required),
+			}
+		}),
+	))
+}
+
+// ValidateGetPipelineRunRequest
+func ValidateGetPipelineRunRequest(v *pb.GetPipelineRunRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Id, validation.Required),
+	))
+}
+
+// ValidateListPipelineRunsRequest
+func ValidateListPipelineRunsRequest(v *pb.ListPipelineRunsRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Project, validation.Required),
+		validation.Field(&v.Name, validation.Required),
+	))
+}
+
+// ValidateApprovePipelineRunRequest
+func ValidateApprovePipelineRunRequest(v *pb.ApprovePipelineRunRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.RunId, validation.Required),
+	))
+}
+
+// isPipelineSteps validates that the steps of a pipeline are complete,
+// have unique names, and only depend on other steps without any loops.
+func isPipelineSteps(v interface{}) error {
+	steps := v.([]*pb.Pipeline_Step)
+
+	byName := map[string]*pb.Pipeline_Step{}
+	for _, step := range steps {
+		if step.Name == "" {
+			return errors.New("step name is required")
+		}
+		if _, ok := byName[step.Name]; ok {
+			return fmt.Errorf("step %q is defined more than once", step.Name)
+		}
+		if step.Op != pb.Pipeline_APPROVAL &&
+			(step.Application == nil || step.Application.Application == "") {
+			return fmt.Errorf("step %q: application is required", step.Name)
+		}
+		if step.Op == pb.Pipeline_UNKNOWN {
+			return fmt.Errorf("step %q: op is required", step.Name)
+		}
+		if step.Op == pb.Pipeline_EXEC && len(step.Command) == 0 {
+			return fmt.Errorf("step %q: command is required for EXEC steps", step.Name)
+		}
+
+		byName[step.Name] = step
+	}
+
+	for _, step := range steps {
+		for _, dep := range step.DependsOn {
+			if _, ok := byName[dep]; !ok {
+				return fmt.Errorf("step %q: depends on unknown step %q", step.Name, dep)
+			}
+		}
+	}
+
+	// Detect loops by repeatedly removing the steps whose dependencies
+	// have all been removed. Anything left over is part of a loop.
+	done := map[string]bool{}
+	for len(done) < len(steps) {
+		progress := false
+		for _, step := range steps {
+			if done[step.Name] {
+				continue
+			}
+
+			ready := true
+			for _, dep := range step.DependsOn {
+				if !done[dep] {
+					ready = false
+					break
+				}
+			}
+
+			if ready {
+				done[step.Name] = true
+				progress = true
+			}
+		}
+
+		if !progress {
+			return errors.New("step dependencies have a loop")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package ptypes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestValidatePipeline(t *testing.T) {
+	step := func(name string, deps ...string) *pb.Pipeline_Step {
+		return &pb.Pipeline_Step{
+			Name: name,
+			Application: &pb.Ref_Application{
+				Project:     "p_test",
+				Application: "a_test",
+			},
+			Op:        pb.Pipeline_BUILD,
+			DependsOn: deps,
+		}
+	}
+
+	cases := []struct {
+		Name   string
+		Modify func(*pb.Pipeline)
+		Error  string
+	}{
+		{
+			"valid",
+			nil,
+			"",
+		},
+
+		{
+			"no steps",
+			func(v *pb.Pipeline) { v.Steps = []*pb.Pipeline_Step{} },
+			"steps: cannot be blank",
+		},
+
+		{
+			"dependencies",
+			func(v *pb.Pipeline) {
+				v.Steps = []*pb.Pipeline_Step{step("a"), step("b", "a"), step("c", "a", "b")}
+			},
+			"",
+		},
+
+		{
+			"duplicate step",
+			func(v *pb.Pipeline) {
+				v.Steps = []*pb.Pipeline_Step{step("a"), step("a")}
+			},
+			"defined more than once",
+		},
+
+		{
+			"unknown dependency",
+			func(v *pb.Pipeline) {
+				v.Steps = []*pb.Pipeline_Step{step("a", "b")}
+			},
+			"unknown step \"b\"",
+		},
+
+		{
+			"dependency loop",
+			func(v *pb.Pipeline) {
+				v.Steps = []*pb.Pipeline_Step{step("a", "c"), step("b", "a"), step("c", "b")}
+			},
+			"loop",
+		},
+
+		{
+			"approval without application",
+			func(v *pb.Pipeline) {
+				v.Steps[0].Op = pb.Pipeline_APPROVAL
+				v.Steps[0].Application = nil
+			},
+			"",
+		},
+
+		{
+			"build without application",
+			func(v *pb.Pipeline) {
+				v.Steps[0].Application = nil
+			},
+			"application is required",
+		},
+
+		{
+			"exec without command",
+			func(v *pb.Pipeline) {
+				v.Steps[0].Op = pb.Pipeline_EXEC
+			},
+			"command is required",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			value := TestPipeline(t, nil)
+			if f := tt.Modify; f != nil {
+				f(value)
+			}
+
+			err := ValidatePipeline(value)
+			if tt.Error == "" {
+				require.NoError(err)
+				return
+			}
+
+			require.Error(err)
+			require.Contains(err.Error(), tt.Error)
+		})
+	}
+}
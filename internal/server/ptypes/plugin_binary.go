@@ -0,0 +1,51 @@
+package ptypes
+
+import (
+	"regexp"
+
+	"github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/hashicorp/waypoint/internal/pkg/validationext"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// PluginNameRegexp is the format of plugin names. The name is part of the
+// binary name and of paths so it can't contain path separators or "..".
+var PluginNameRegexp = regexp.MustCompile(`^[a-zA-Z\d][a-zA-Z\d_-]*(\.[a-zA-Z\d_-]+)*$`)
+
+// pluginChecksumRegexp is the format of a hex-encoded SHA256 checksum.
+var pluginChecksumRegexp = regexp.MustCompile(`^[a-f\d]{64}$`)
+
+// ValidateUploadPluginOpen
+func ValidateUploadPluginOpen(v *pb.UploadPluginRequest_Open) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		append(pluginBinaryRefRules(&v.Name, &v.Version, &v.Os, &v.Arch),
+			validation.Field(&v.Checksum, validation.Match(pluginChecksumRegexp)),
+		)...,
+	))
+}
+
+// ValidateDownloadPluginRequest
+func ValidateDownloadPluginRequest(v *pb.DownloadPluginRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		pluginBinaryRefRules(&v.Name, &v.Version, &v.Os, &v.Arch)...,
+	))
+}
+
+// ValidateDeletePluginRequest
+func ValidateDeletePluginRequest(v *pb.DeletePluginRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		pluginBinaryRefRules(&v.Name, &v.Version, &v.Os, &v.Arch)...,
+	))
+}
+
+// pluginBinaryRefRules are the rules for the fields that identify a
+// plugin binary.
+func pluginBinaryRefRules(name, version, os, arch *string) []*validation.FieldRules {
+	return []*validation.FieldRules{
+		validation.Field(name, validation.Required, validation.Match(PluginNameRegexp)),
+		validation.Field(version, validation.Required, validationext.IsVersion),
+		validation.Field(os, validation.Required),
+		validation.Field(arch, validation.Required),
+	}
+}
@@ -0,0 +1,37 @@
+package ptypes
+
+import (
+	"github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/imdario/mergo"
+	"github.com/mitchellh/go-testing-interface"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/pkg/validationext"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// TestPreview returns a valid preview for tests.
+func TestPreview(t testing.T, src *pb.Preview) *pb.Preview {
+	t.Helper()
+
+	if src == nil {
+		src = &pb.Preview{}
+	}
+
+	require.NoError(t, mergo.Merge(src, &pb.Preview{
+		Project:   &pb.Ref_Project{Project: "p_test"},
+		Number:    1,
+		Workspace: &pb.Ref_Workspace{Workspace: "pr-1"},
+		Commit:    "abc123",
+		State:     pb.Preview_DEPLOYING,
+	}))
+
+	return src
+}
+
+// ValidateListPreviewsRequest
+func ValidateListPreviewsRequest(v *pb.ListPreviewsRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Project, validation.Required),
+	))
+}
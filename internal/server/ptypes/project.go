@@ -46,6 +46,36 @@ func (p *Project) App(n string) int {
 	return -1
 }
 
+// AppLabels returns the labels of the app with the given name merged over
+// the labels of the project. If the app isn't found, this returns the
+// labels of the project.
+func (p *Project) AppLabels(n string) map[string]string {
+	result := map[string]string{}
+	for k, v := range p.Labels {
+		result[k] = v
+	}
+
+	if idx := p.App(n); idx >= 0 {
+		for k, v := range p.Applications[idx].Labels {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// LabelsMatch returns true if labels has every label in selector with the
+// same value. An empty selector matches everything.
+func LabelsMatch(selector, labels map[string]string) bool {
+	for k, v := range selector {
+		if actual, ok := labels[k]; !ok || actual != v {
+			return false
+		}
+	}
+
+	return true
+}
+
 // ValidateProject validates the project structure.
 func ValidateProject(p *pb.Project) error {
 	return validationext.Error(validation.ValidateStruct(p,
@@ -68,6 +98,21 @@ func ValidateProjectRules(p *pb.Project) []*validation.FieldRules {
 				validation.Field(&p.DataSourcePoll.Interval, validationext.IsDuration),
 			}
 		}),
+
+		validationext.StructField(&p.JobTimeouts, func() []*validation.FieldRules {
+			return []*validation.FieldRules{
+				validation.Field(&p.JobTimeouts.Build, validationext.IsDuration),
+				validation.Field(&p.JobTimeouts.Deploy, validationext.IsDuration),
+				validation.Field(&p.JobTimeouts.Release, validationext.IsDuration),
+			}
+		}),
+
+		validationext.StructField(&p.Webhook, func() []*validation.FieldRules {
+			return []*validation.FieldRules{
+				validation.Field(&p.Webhook.Secret,
+					validation.When(p.Webhook.Enabled, validation.Required)),
+			}
+		}),
 	}
 }
 
@@ -0,0 +1,74 @@
+package ptypes
+
+import (
+	"text/template"
+
+	"github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/imdario/mergo"
+	"github.com/mitchellh/go-testing-interface"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/pkg/validationext"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// TestProjectTemplate returns a valid project template for tests.
+func TestProjectTemplate(t testing.T, src *pb.ProjectTemplate) *pb.ProjectTemplate {
+	t.Helper()
+
+	if src == nil {
+		src = &pb.ProjectTemplate{}
+	}
+
+	require.NoError(t, mergo.Merge(src, &pb.ProjectTemplate{
+		Name:        "test",
+		WaypointHcl: []byte(`project = "{{.Project}}"`),
+	}))
+
+	return src
+}
+
+// ValidateProjectTemplate validates the project template structure.
+func ValidateProjectTemplate(v *pb.ProjectTemplate) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		ValidateProjectTemplateRules(v)...,
+	))
+}
+
+// ValidateProjectTemplateRules
+func ValidateProjectTemplateRules(v *pb.ProjectTemplate) []*validation.FieldRules {
+	return []*validation.FieldRules{
+		validation.Field(&v.Name, validation.Required),
+		validation.Field(&v.WaypointHcl, validation.Required, validation.By(isTextTemplate)),
+	}
+}
+
+// ValidateUpsertProjectTemplateRequest
+func ValidateUpsertProjectTemplateRequest(v *pb.UpsertProjectTemplateRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Template, validation.Required),
+		validationext.StructField(&v.Template, func() []*validation.FieldRules {
+			return ValidateProjectTemplateRules(v.Template)
+		}),
+	))
+}
+
+// ValidateGetProjectTemplateRequest
+func ValidateGetProjectTemplateRequest(v *pb.GetProjectTemplateRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Template, validation.Required),
+	))
+}
+
+// ValidateDeleteProjectTemplateRequest
+func ValidateDeleteProjectTemplateRequest(v *pb.DeleteProjectTemplateRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Template, validation.Required),
+	))
+}
+
+// isTextTemplate validates that the value is a valid text/template.
+func isTextTemplate(v interface{}) error {
+	_, err := template.New("waypoint.hcl").Parse(string(v.([]byte)))
+	return err
+}
@@ -47,6 +47,14 @@ func TestValidateProject(t *testing.T) {
 			"invalid duration",
 		},
 
+		{
+			"job timeout is invalid",
+			func(v *pb.Project) {
+				v.JobTimeouts = &pb.Project_JobTimeouts{Deploy: "forever"}
+			},
+			"invalid duration",
+		},
+
 		{
 			"polling interval is valid",
 			func(v *pb.Project) {
@@ -58,6 +66,22 @@ func TestValidateProject(t *testing.T) {
 			"",
 		},
 
+		{
+			"webhook enabled without a secret",
+			func(v *pb.Project) {
+				v.Webhook = &pb.Project_Webhook{Enabled: true}
+			},
+			"secret: cannot be blank",
+		},
+
+		{
+			"webhook disabled without a secret",
+			func(v *pb.Project) {
+				v.Webhook = &pb.Project_Webhook{Enabled: false}
+			},
+			"",
+		},
+
 		{
 			"data source git with no URL",
 			func(v *pb.Project) {
@@ -111,3 +135,44 @@ func TestValidateProject(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectAppLabels(t *testing.T) {
+	require := require.New(t)
+
+	p := &Project{Project: TestProject(t, &pb.Project{
+		Labels: map[string]string{"team": "payments", "tier": "backend"},
+		Applications: []*pb.Application{
+			{Name: "web", Labels: map[string]string{"tier": "frontend"}},
+			{Name: "api"},
+		},
+	})}
+
+	require.Equal(map[string]string{"team": "payments", "tier": "frontend"}, p.AppLabels("WEB"))
+	require.Equal(map[string]string{"team": "payments", "tier": "backend"}, p.AppLabels("api"))
+	require.Equal(map[string]string{"team": "payments", "tier": "backend"}, p.AppLabels("nope"))
+
+	// The project labels aren't modified
+	require.Equal("backend", p.Labels["tier"])
+}
+
+func TestLabelsMatch(t *testing.T) {
+	labels := map[string]string{"team": "payments", "tier": "frontend"}
+
+	cases := []struct {
+		Name     string
+		Selector map[string]string
+		Result   bool
+	}{
+		{"empty", nil, true},
+		{"match", map[string]string{"team": "payments"}, true},
+		{"match all", map[string]string{"team": "payments", "tier": "frontend"}, true},
+		{"value mismatch", map[string]string{"team": "search"}, false},
+		{"missing", map[string]string{"env": "prod"}, false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require.Equal(t, tt.Result, LabelsMatch(tt.Selector, labels))
+		})
+	}
+}
@@ -12,3 +12,11 @@ func ValidateRefWorkspaceRules(v *pb.Ref_Workspace) []*validation.FieldRules {
 		validation.Field(&v.Workspace, validation.Required),
 	}
 }
+
+// ValidateRefApplicationRules
+func ValidateRefApplicationRules(v *pb.Ref_Application) []*validation.FieldRules {
+	return []*validation.FieldRules{
+		validation.Field(&v.Application, validation.Required),
+		validation.Field(&v.Project, validation.Required),
+	}
+}
@@ -1,10 +1,12 @@
 package ptypes
 
 import (
+	"github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/imdario/mergo"
 	"github.com/mitchellh/go-testing-interface"
 	"github.com/stretchr/testify/require"
 
+	"github.com/hashicorp/waypoint/internal/pkg/validationext"
 	"github.com/hashicorp/waypoint/internal/server"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 )
@@ -25,3 +27,17 @@ func TestRunner(t testing.T, src *pb.Runner) *pb.Runner {
 
 	return src
 }
+
+// ValidateAdoptRunnerRequest
+func ValidateAdoptRunnerRequest(v *pb.AdoptRunnerRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.RunnerId, validation.Required),
+	))
+}
+
+// ValidateRejectRunnerRequest
+func ValidateRejectRunnerRequest(v *pb.RejectRunnerRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.RunnerId, validation.Required),
+	))
+}
@@ -0,0 +1,161 @@
+package ptypes
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// secretRefSchemes are the config sourcers that config values can
+// reference with a secret reference, by the scheme of the reference.
+var secretRefSchemes = map[string]*secretRefScheme{
+	"vault": {
+		From:    "vault",
+		PathKey: "path",
+		KeyKey:  "key",
+
+		// The data of KV version 2 secrets is nested under "data", so we
+		// prefix the key for those paths so that references look the same
+		// for both versions.
+		Key: func(path, key string) string {
+			if strings.Contains(path, "/data/") {
+				return "data/" + key
+			}
+
+			return key
+		},
+	},
+
+	"aws-secretsmanager": {
+		From:    "aws-secretsmanager",
+		PathKey: "secret_id",
+		KeyKey:  "key",
+	},
+}
+
+// secretRefScheme is how a secret reference maps to the config of a
+// config sourcer.
+type secretRefScheme struct {
+	// From is the name of the config sourcer.
+	From string
+
+	// PathKey and KeyKey are the keys of the config that the path and
+	// key of the reference are set to.
+	PathKey string
+	KeyKey  string
+
+	// Key, if set, converts the key of the reference to the key that
+	// the config sourcer expects.
+	Key func(path, key string) string
+}
+
+// IsSecretRef returns true if the value looks like a secret reference,
+// i.e. it starts with one of the supported schemes followed by a colon.
+func IsSecretRef(v string) bool {
+	idx := strings.IndexByte(v, ':')
+	if idx <= 0 {
+		return false
+	}
+
+	_, ok := secretRefSchemes[v[:idx]]
+	return ok
+}
+
+// ParseSecretRef parses a secret reference such as
+// "vault:secret/data/app#token" or "aws-secretsmanager:prod/db#password"
+// into the dynamic value that reads it. The key after "#" is optional
+// for secrets that are a single value.
+//
+// Secret references let config variables point at secrets in external
+// systems so that the secret value is never stored by the server. The
+// value is read by the entrypoint or runner when the config is used.
+func ParseSecretRef(v string) (*pb.ConfigVar_DynamicVal, error) {
+	idx := strings.IndexByte(v, ':')
+	if idx <= 0 {
+		return nil, fmt.Errorf(
+			"secret reference %q must be in the form SCHEME:PATH#KEY, supported schemes: %s",
+			v, strings.Join(secretRefSchemeNames(), ", "))
+	}
+
+	scheme, ok := secretRefSchemes[v[:idx]]
+	if !ok {
+		return nil, fmt.Errorf(
+			"secret reference %q has an unknown scheme %q, supported schemes: %s",
+			v, v[:idx], strings.Join(secretRefSchemeNames(), ", "))
+	}
+
+	path, key := v[idx+1:], ""
+	if i := strings.LastIndexByte(path, '#'); i >= 0 {
+		path, key = path[:i], path[i+1:]
+	}
+	if path == "" {
+		return nil, fmt.Errorf("secret reference %q has no path", v)
+	}
+
+	config := map[string]string{scheme.PathKey: path}
+	if key != "" {
+		if scheme.Key != nil {
+			key = scheme.Key(path, key)
+		}
+
+		config[scheme.KeyKey] = key
+	}
+
+	return &pb.ConfigVar_DynamicVal{
+		From:   scheme.From,
+		Config: config,
+	}, nil
+}
+
+// SecretRefString returns the secret reference for the dynamic value, or
+// an empty string if the value can't be written as a secret reference.
+// This is the inverse of ParseSecretRef.
+func SecretRefString(v *pb.ConfigVar_DynamicVal) string {
+	for name, scheme := range secretRefSchemes {
+		if v.From != scheme.From {
+			continue
+		}
+
+		path := v.Config[scheme.PathKey]
+		if path == "" {
+			return ""
+		}
+
+		// References only set the path and key, so other config can't
+		// be written as a reference.
+		for k := range v.Config {
+			if k != scheme.PathKey && k != scheme.KeyKey {
+				return ""
+			}
+		}
+
+		key, ok := v.Config[scheme.KeyKey]
+		if !ok {
+			return name + ":" + path
+		}
+
+		if scheme.Key != nil && scheme.Key(path, "") != "" {
+			prefix := scheme.Key(path, "")
+			if !strings.HasPrefix(key, prefix) {
+				return ""
+			}
+			key = key[len(prefix):]
+		}
+
+		return name + ":" + path + "#" + key
+	}
+
+	return ""
+}
+
+func secretRefSchemeNames() []string {
+	var result []string
+	for name := range secretRefSchemes {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+
+	return result
+}
@@ -0,0 +1,118 @@
+package ptypes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestParseSecretRef(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    string
+		IsRef    bool
+		Expected *pb.ConfigVar_DynamicVal
+		Error    string
+	}{
+		{
+			"vault kv v2",
+			"vault:secret/data/app#token",
+			true,
+			&pb.ConfigVar_DynamicVal{
+				From: "vault",
+				Config: map[string]string{
+					"path": "secret/data/app",
+					"key":  "data/token",
+				},
+			},
+			"",
+		},
+
+		{
+			"vault kv v1",
+			"vault:kv/app#token",
+			true,
+			&pb.ConfigVar_DynamicVal{
+				From: "vault",
+				Config: map[string]string{
+					"path": "kv/app",
+					"key":  "token",
+				},
+			},
+			"",
+		},
+
+		{
+			"secrets manager without key",
+			"aws-secretsmanager:prod/db",
+			true,
+			&pb.ConfigVar_DynamicVal{
+				From: "aws-secretsmanager",
+				Config: map[string]string{
+					"secret_id": "prod/db",
+				},
+			},
+			"",
+		},
+
+		{
+			"unknown scheme",
+			"consul:foo#bar",
+			false,
+			nil,
+			"unknown scheme",
+		},
+
+		{
+			"no scheme",
+			"foo",
+			false,
+			nil,
+			"must be in the form",
+		},
+
+		{
+			"no path",
+			"vault:#token",
+			true,
+			nil,
+			"no path",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			require.Equal(tt.IsRef, IsSecretRef(tt.Input))
+
+			v, err := ParseSecretRef(tt.Input)
+			if tt.Error != "" {
+				require.Error(err)
+				require.Contains(err.Error(), tt.Error)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.Expected, v)
+
+			// Round trip
+			require.Equal(tt.Input, SecretRefString(v))
+		})
+	}
+}
+
+func TestSecretRefString_notRef(t *testing.T) {
+	require := require.New(t)
+
+	require.Empty(SecretRefString(&pb.ConfigVar_DynamicVal{
+		From:   "kubernetes",
+		Config: map[string]string{"name": "foo"},
+	}))
+
+	require.Empty(SecretRefString(&pb.ConfigVar_DynamicVal{
+		From:   "vault",
+		Config: map[string]string{"path": "secret/data/app", "key": "token"},
+	}))
+}
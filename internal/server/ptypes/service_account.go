@@ -0,0 +1,71 @@
+package ptypes
+
+import (
+	"github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/imdario/mergo"
+	"github.com/mitchellh/go-testing-interface"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/pkg/validationext"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// TestServiceAccount returns a valid service account for tests.
+func TestServiceAccount(t testing.T, src *pb.ServiceAccount) *pb.ServiceAccount {
+	t.Helper()
+
+	if src == nil {
+		src = &pb.ServiceAccount{}
+	}
+
+	require.NoError(t, mergo.Merge(src, &pb.ServiceAccount{
+		Name:  "test",
+		Roles: []string{RoleOperator},
+	}))
+
+	return src
+}
+
+// ValidateServiceAccount validates the service account structure.
+func ValidateServiceAccount(v *pb.ServiceAccount) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		ValidateServiceAccountRules(v)...,
+	))
+}
+
+// ValidateServiceAccountRules
+func ValidateServiceAccountRules(v *pb.ServiceAccount) []*validation.FieldRules {
+	return []*validation.FieldRules{
+		validation.Field(&v.Name,
+			validation.Required,
+			validation.Match(UsernameRegexp),
+			validation.Length(1, 38)),
+		validation.Field(&v.Roles,
+			validation.Required,
+			validation.Each(validation.In(RoleAdmin, RoleOperator, RoleReadOnly))),
+	}
+}
+
+// ValidateCreateServiceAccountRequest
+func ValidateCreateServiceAccountRequest(v *pb.CreateServiceAccountRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.ServiceAccount, validation.Required),
+		validationext.StructField(&v.ServiceAccount, func() []*validation.FieldRules {
+			return ValidateServiceAccountRules(v.ServiceAccount)
+		}),
+	))
+}
+
+// ValidateDeleteServiceAccountRequest
+func ValidateDeleteServiceAccountRequest(v *pb.DeleteServiceAccountRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.ServiceAccount, validation.Required),
+	))
+}
+
+// ValidateServiceAccountTokenRequest
+func ValidateServiceAccountTokenRequest(v *pb.ServiceAccountTokenRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.ServiceAccount, validation.Required),
+	))
+}
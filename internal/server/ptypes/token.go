@@ -0,0 +1,15 @@
+package ptypes
+
+import (
+	"github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/hashicorp/waypoint/internal/pkg/validationext"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// ValidateRevokeTokenRequest
+func ValidateRevokeTokenRequest(v *pb.RevokeTokenRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Target, validation.Required),
+	))
+}
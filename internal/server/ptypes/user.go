@@ -12,6 +12,22 @@ import (
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 )
 
+const (
+	// RoleAdmin can call every API, including managing users, auth
+	// methods, server configuration, and snapshots.
+	RoleAdmin = "admin"
+
+	// RoleOperator can call every API except for the admin APIs. This
+	// is the role for users that build, deploy, and release applications.
+	RoleOperator = "operator"
+
+	// RoleReadOnly can only call APIs that don't mutate any state.
+	RoleReadOnly = "read-only"
+)
+
+// Roles are all of the valid user roles.
+var Roles = []string{RoleAdmin, RoleOperator, RoleReadOnly}
+
 // UsernameRegexp is the valid username regular expression. This is
 // somewhat arbitrary but exactly matches the GitHub username requirements.
 // We can always loosen this later.
@@ -61,6 +77,16 @@ func ValidateUpdateUserRequest(v *pb.UpdateUserRequest) error {
 	))
 }
 
+// ValidateUserRoleRequest
+func ValidateUserRoleRequest(v *pb.UserRoleRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.User, validation.Required),
+		validation.Field(&v.Role,
+			validation.Required,
+			validation.In(RoleAdmin, RoleOperator, RoleReadOnly)),
+	))
+}
+
 // ValidateDeleteUserRequest
 func ValidateDeleteUserRequest(v *pb.DeleteUserRequest) error {
 	return validationext.Error(validation.ValidateStruct(v,
@@ -35,3 +35,25 @@ func ValidateGetWorkspaceRequest(v *pb.GetWorkspaceRequest) error {
 		}),
 	))
 }
+
+// ValidateUpsertWorkspaceRequest
+func ValidateUpsertWorkspaceRequest(v *pb.UpsertWorkspaceRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Workspace, validation.Required),
+		validationext.StructField(&v.Workspace, func() []*validation.FieldRules {
+			return []*validation.FieldRules{
+				validation.Field(&v.Workspace.Name, validation.Required),
+			}
+		}),
+	))
+}
+
+// ValidateDeleteWorkspaceRequest
+func ValidateDeleteWorkspaceRequest(v *pb.DeleteWorkspaceRequest) error {
+	return validationext.Error(validation.ValidateStruct(v,
+		validation.Field(&v.Workspace, validation.Required),
+		validationext.StructField(&v.Workspace, func() []*validation.FieldRules {
+			return ValidateRefWorkspaceRules(v.Workspace)
+		}),
+	))
+}
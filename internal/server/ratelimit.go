@@ -0,0 +1,55 @@
+package server
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/grpc"
+)
+
+// RateLimiter can optionally be implemented by the service to limit the
+// rate of API calls. It is called after authentication so that it can
+// limit calls per user.
+type RateLimiter interface {
+	// RateLimitRequest is called before the request is handled. If this
+	// returns an error, the request fails with that error. Streams are
+	// only checked when they're opened.
+	RateLimitRequest(ctx context.Context, endpoint string) error
+}
+
+// rateLimitUnaryInterceptor returns a gRPC unary interceptor that checks
+// the RateLimiter before calling the handler.
+func rateLimitUnaryInterceptor(limiter RateLimiter) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, "/grpc.reflection.v1alpha.ServerReflection/") {
+			if err := limiter.RateLimitRequest(ctx, filepath.Base(info.FullMethod)); err != nil {
+				return nil, err
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// rateLimitStreamInterceptor returns a gRPC stream interceptor that checks
+// the RateLimiter before calling the handler.
+func rateLimitStreamInterceptor(limiter RateLimiter) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		if !strings.HasPrefix(info.FullMethod, "/grpc.reflection.v1alpha.ServerReflection/") {
+			if err := limiter.RateLimitRequest(ss.Context(), filepath.Base(info.FullMethod)); err != nil {
+				return err
+			}
+		}
+
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type trivialRateLimiter struct {
+	endpoints []string
+}
+
+func (t *trivialRateLimiter) RateLimitRequest(ctx context.Context, endpoint string) error {
+	t.endpoints = append(t.endpoints, endpoint)
+	if endpoint == "ListProjects" {
+		return status.Errorf(codes.ResourceExhausted, "slow down")
+	}
+
+	return nil
+}
+
+func TestRateLimitUnaryInterceptor(t *testing.T) {
+	require := require.New(t)
+
+	var rl trivialRateLimiter
+	f := rateLimitUnaryInterceptor(&rl)
+
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return "hello", nil
+	}
+
+	// Allowed
+	resp, err := f(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/foo/GetProject"}, handler)
+	require.NoError(err)
+	require.Equal("hello", resp)
+	require.True(called)
+
+	// Limited requests don't call the handler
+	called = false
+	_, err = f(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/foo/ListProjects"}, handler)
+	require.Error(err)
+	require.Equal(codes.ResourceExhausted, status.Code(err))
+	require.False(called)
+	require.Equal([]string{"GetProject", "ListProjects"}, rl.endpoints)
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"net"
+	"net/http"
 	"sync"
 
 	"github.com/hashicorp/go-hclog"
@@ -31,6 +32,14 @@ func Run(opts ...Option) error {
 	}
 	log := cfg.Logger
 
+	if cfg.MetricsEnabled {
+		m, err := newMetrics(&cfg)
+		if err != nil {
+			return err
+		}
+		cfg.metrics = m
+	}
+
 	grpcServer, err := newGrpcServer(&cfg)
 	if err != nil {
 		return err
@@ -119,8 +128,31 @@ type options struct {
 
 	// BrowserUIEnabled determines if the browser UI should be mounted
 	BrowserUIEnabled bool
+
+	// WebhookHandler, if set, handles the requests to the webhook
+	// endpoints of the HTTP server.
+	WebhookHandler WebhookHandler
+
+	// MetricsEnabled determines if the Prometheus metrics endpoint should
+	// be mounted on the HTTP server.
+	MetricsEnabled bool
+
+	// metrics is set by Run if MetricsEnabled is true.
+	metrics *metrics
 }
 
+// WebhookHandler is implemented by services that accept webhooks from
+// external systems such as Git providers. Webhook requests aren't
+// authenticated with a token so the handler must verify them itself.
+type WebhookHandler interface {
+	// ServeWebhook handles a request to a path starting with WebhookPath.
+	ServeWebhook(w http.ResponseWriter, r *http.Request)
+}
+
+// WebhookPath is the path prefix of the webhook endpoints on the HTTP
+// server.
+const WebhookPath = "/v1/webhook/"
+
 // WithContext sets the context for the server. When this context is cancelled,
 // the server will be shut down.
 func WithContext(ctx context.Context) Option {
@@ -156,7 +188,18 @@ func WithAuthentication(ac AuthChecker) Option {
 	return func(opts *options) { opts.AuthChecker = ac }
 }
 
+// WithWebhooks configures the server to pass webhook requests to h.
+func WithWebhooks(h WebhookHandler) Option {
+	return func(opts *options) { opts.WebhookHandler = h }
+}
+
 // WithBrowserUI configures the server to enable the browser UI.
 func WithBrowserUI(enabled bool) Option {
 	return func(opts *options) { opts.BrowserUIEnabled = enabled }
 }
+
+// WithMetrics configures the server to record metrics and serve them
+// at MetricsPath on the HTTP server.
+func WithMetrics(enabled bool) Option {
+	return func(opts *options) { opts.MetricsEnabled = enabled }
+}
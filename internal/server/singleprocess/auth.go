@@ -20,6 +20,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
 	"github.com/hashicorp/waypoint/internal/server/singleprocess/state"
 )
 
@@ -62,17 +63,33 @@ var (
 		"ListOIDCAuthMethods": {},
 		"GetOIDCAuthURL":      {},
 		"CompleteOIDCAuth":    {},
+		"CompletePATAuth":     {},
 	}
 )
 
 type userKey struct{}
 
+type tokenKey struct{}
+
 // userWithContext inserts the user value u into the context. This can
 // be extracted with userFromContext.
 func userWithContext(ctx context.Context, u *pb.User) context.Context {
 	return context.WithValue(ctx, userKey{}, u)
 }
 
+// tokenWithContext inserts the login token used for the request into the
+// context. This can be extracted with tokenFromContext.
+func tokenWithContext(ctx context.Context, t *pb.Token) context.Context {
+	return context.WithValue(ctx, tokenKey{}, t)
+}
+
+// tokenFromContext returns the login token used for the request. This will
+// return nil if the request isn't authenticated with a token.
+func tokenFromContext(ctx context.Context) *pb.Token {
+	value, _ := ctx.Value(tokenKey{}).(*pb.Token)
+	return value
+}
+
 // userFromContext returns the authenticated user in the request context.
 // This will return nil if the user is not authenticated.
 func (s *service) userFromContext(ctx context.Context) *pb.User {
@@ -113,15 +130,53 @@ func (s *service) Authenticate(
 		return nil, ErrInvalidToken
 	}
 
+	if err := s.checkRevoked(body); err != nil {
+		return nil, err
+	}
+
 	// If this is an entrypoint token then we can only access entrypoint APIs.
 	if login.Login.Entrypoint != nil && !strings.HasPrefix(endpoint, "Entrypoint") {
 		return nil, status.Errorf(codes.Unauthenticated, "Unauthorized endpoint")
 	}
 
-	// Look up the user that this token is for.
+	// Look up the user or service account that this token is for.
+	var user *pb.User
+	if id := login.Login.ServiceAccountId; id != "" {
+		user, err = s.serviceAccountPrincipal(id, endpoint)
+	} else {
+		user, err = s.tokenUser(body, login.Login)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Roles in the token, i.e. from auth method role mappings, replace
+	// the roles of the user for this request.
+	if roles := login.Login.Roles; len(roles) > 0 {
+		user = proto.Clone(user).(*pb.User)
+		user.Roles = roles
+	}
+
+	// Entrypoint tokens are already limited to the entrypoint APIs above
+	// so only check the roles of other tokens.
+	if login.Login.Entrypoint == nil {
+		if err := s.authorize(user, endpoint, effects); err != nil {
+			return nil, err
+		}
+	}
+
+	// The token is used by AuthorizeRequest for scoped tokens and to
+	// issue new tokens with the same limits.
+	ctx = tokenWithContext(ctx, body)
+
+	return userWithContext(ctx, user), nil
+}
+
+// tokenUser returns the user of a login token.
+func (s *service) tokenUser(body *pb.Token, login *pb.Token_Login) (*pb.User, error) {
 	user, err := s.state.UserGet(&pb.Ref_User{
 		Ref: &pb.Ref_User_Id{
-			Id: &pb.Ref_UserId{Id: login.Login.UserId},
+			Id: &pb.Ref_UserId{Id: login.UserId},
 		},
 	})
 	if status.Code(err) == codes.NotFound {
@@ -129,7 +184,7 @@ func (s *service) Authenticate(
 		// first time, we need to create the initial Waypoint user. This is
 		// purely a backwards compatibility case that we should drop at some
 		// point.
-		if !body.UnusedLogin || login.Login.UserId != DefaultUserId {
+		if !body.UnusedLogin || login.UserId != DefaultUserId {
 			return nil, status.Errorf(codes.Unauthenticated,
 				"Pre-Waypoint 0.5 token must be for the default user. This should always "+
 					"be the case so the token is likely corrupt.")
@@ -149,7 +204,7 @@ func (s *service) Authenticate(
 		// Look up the user again
 		user, err = s.state.UserGet(&pb.Ref_User{
 			Ref: &pb.Ref_User_Id{
-				Id: &pb.Ref_UserId{Id: login.Login.UserId},
+				Id: &pb.Ref_UserId{Id: login.UserId},
 			},
 		})
 	}
@@ -157,7 +212,20 @@ func (s *service) Authenticate(
 		return nil, err
 	}
 
-	return userWithContext(ctx, user), nil
+	return user, nil
+}
+
+// checkRevoked returns an error if the token was revoked.
+func (s *service) checkRevoked(body *pb.Token) error {
+	revoked, err := s.state.TokenRevoked(body)
+	if err != nil {
+		return err
+	}
+	if revoked {
+		return errors.Wrapf(ErrInvalidToken, "token has been revoked")
+	}
+
+	return nil
 }
 
 // decodeToken parses the string and validates it as a valid token. If the token
@@ -308,8 +376,11 @@ func (s *service) GenerateLoginToken(
 		}
 	}
 
+	// A token for ourself keeps the roles of our token so that a token
+	// can't be used to get more access than it has.
 	login := &pb.Token_Login{
 		UserId: currentUser.Id,
+		Roles:  tokenFromContext(ctx).GetLogin().GetRoles(),
 	}
 
 	// If we're authing as another user, we have to get that user
@@ -319,7 +390,29 @@ func (s *service) GenerateLoginToken(
 			return nil, err
 		}
 
-		login.UserId = user.Id
+		if err := requireAdminForOtherUser(currentUser, user.Id); err != nil {
+			return nil, err
+		}
+
+		if user.Id != currentUser.Id {
+			login.UserId = user.Id
+			login.Roles = nil
+		}
+	}
+
+	// Only keep the scope if it actually limits the token.
+	if req.Scope != nil && (req.Scope.Project != "" || req.Scope.Workspace != "") {
+		login.Scope = req.Scope
+	}
+
+	// Tokens that pre-authorize runners bypass runner adoption.
+	if req.RunnerAdoption != nil {
+		if !userHasRole(currentUser, serverptypes.RoleAdmin) {
+			return nil, status.Errorf(codes.PermissionDenied,
+				"the %q role is required to pre-authorize runners", serverptypes.RoleAdmin)
+		}
+
+		login.RunnerAdoption = req.RunnerAdoption
 	}
 
 	token, err := s.newToken(dur, DefaultKeyId, nil, &pb.Token{
@@ -427,9 +520,16 @@ func (s *service) ConvertInviteToken(ctx context.Context, req *pb.ConvertInviteT
 	}
 	invite := kind.Invite
 
+	if err := s.checkRevoked(body); err != nil {
+		return nil, err
+	}
+
 	// If we have a signup invite, then create a new user.
 	if signup := invite.Signup; signup != nil {
-		user := &pb.User{Username: signup.InitialUsername}
+		user := &pb.User{
+			Username: signup.InitialUsername,
+			Roles:    []string{serverptypes.RoleOperator},
+		}
 		if err := s.state.UserPut(user); err != nil {
 			return nil, err
 		}
@@ -451,6 +551,86 @@ func (s *service) ConvertInviteToken(ctx context.Context, req *pb.ConvertInviteT
 	return &pb.NewTokenResponse{Token: token}, nil
 }
 
+// RevokeToken revokes a single token by ID or every token of a user.
+func (s *service) RevokeToken(
+	ctx context.Context, req *pb.RevokeTokenRequest,
+) (*empty.Empty, error) {
+	if err := serverptypes.ValidateRevokeTokenRequest(req); err != nil {
+		return nil, err
+	}
+
+	currentUser := s.userFromContext(ctx)
+
+	var revocation pb.TokenRevocation
+	switch t := req.Target.(type) {
+	case *pb.RevokeTokenRequest_TokenId:
+		// Token IDs are unguessable so anyone who knows the ID of a token
+		// may revoke it.
+		id, err := base58.Decode(t.TokenId)
+		if err != nil || len(id) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid token ID")
+		}
+
+		revocation.Target = &pb.TokenRevocation_AccessorId{AccessorId: id}
+
+	case *pb.RevokeTokenRequest_User:
+		user, err := s.state.UserGet(t.User)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := requireAdminForOtherUser(currentUser, user.Id); err != nil {
+			return nil, err
+		}
+
+		revocation.Target = &pb.TokenRevocation_UserId{UserId: user.Id}
+	}
+
+	if err := s.state.TokenRevocationPut(&revocation); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// RefreshToken issues a new login token to replace the token used for the
+// request. The new token is valid for as long as the current token was
+// when it was issued.
+func (s *service) RefreshToken(
+	ctx context.Context, req *empty.Empty,
+) (*pb.NewTokenResponse, error) {
+	current := tokenFromContext(ctx)
+	login := current.GetLogin()
+	if login == nil {
+		return nil, status.Errorf(codes.Unauthenticated,
+			"RefreshToken requires a login token")
+	}
+
+	if current.ValidUntil == nil || current.IssuedTime == nil {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"the token doesn't expire and can't be refreshed")
+	}
+
+	issued, err := ptypes.Timestamp(current.IssuedTime)
+	if err != nil {
+		return nil, err
+	}
+
+	validUntil, err := ptypes.Timestamp(current.ValidUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := s.newToken(validUntil.Sub(issued), DefaultKeyId, nil, &pb.Token{
+		Kind: &pb.Token_Login_{Login: proto.Clone(login).(*pb.Token_Login)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.NewTokenResponse{Token: token}, nil
+}
+
 // BootstrapToken RPC call.
 func (s *service) BootstrapToken(ctx context.Context, req *empty.Empty) (*pb.NewTokenResponse, error) {
 	if !s.state.HMACKeyEmpty() {
@@ -493,6 +673,7 @@ func (s *service) bootstrapUser() (*pb.User, error) {
 	user := &pb.User{
 		Id:       DefaultUserId,
 		Username: DefaultUser,
+		Roles:    []string{serverptypes.RoleAdmin},
 	}
 
 	return user, s.state.UserPut(user)
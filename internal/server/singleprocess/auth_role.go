@@ -0,0 +1,113 @@
+package singleprocess
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+var (
+	// adminEndpoints are the endpoints that only users with the admin role
+	// may call.
+	adminEndpoints = map[string]struct{}{
+		"DeleteUser":                  {},
+		"GrantUserRole":               {},
+		"RevokeUserRole":              {},
+		"UpsertAuthMethod":            {},
+		"TestAuthMethod":              {},
+		"DeleteAuthMethod":            {},
+		"UpsertProjectTemplate":       {},
+		"DeleteProjectTemplate":       {},
+		"UpsertOnDemandRunnerConfig":  {},
+		"DeleteOnDemandRunnerConfig":  {},
+		"UploadPlugin":                {},
+		"DeletePlugin":                {},
+		"AdoptRunner":                 {},
+		"RejectRunner":                {},
+		"CreateServiceAccount":        {},
+		"ListServiceAccounts":         {},
+		"DeleteServiceAccount":        {},
+		"GenerateServiceAccountToken": {},
+		"SetServerConfig":             {},
+		"CreateSnapshot":              {},
+		"RestoreSnapshot":             {},
+		"GetSnapshotStatus":           {},
+		"PruneState":                  {},
+		"RotateConfigEncryptionKey":   {},
+		"GenerateInviteToken":         {},
+		"ListAuditEvents":             {},
+		"ListExecSessions":            {},
+		"GetExecSession":              {},
+	}
+
+	// selfServiceEndpoints are endpoints that every user may call because
+	// they only act on the calling user by default. The endpoints check
+	// that the user is an admin if they are used to act on another user.
+	selfServiceEndpoints = map[string]struct{}{
+		"UpdateUser":         {},
+		"GenerateLoginToken": {},
+		"RevokeToken":        {},
+		"RefreshToken":       {},
+	}
+)
+
+// authorize checks that the user has a role that permits calling the
+// endpoint with the given effects.
+func (s *service) authorize(user *pb.User, endpoint string, effects []string) error {
+	if userHasRole(user, serverptypes.RoleAdmin) {
+		return nil
+	}
+
+	if _, ok := selfServiceEndpoints[endpoint]; ok {
+		return nil
+	}
+
+	if _, ok := adminEndpoints[endpoint]; ok {
+		return status.Errorf(codes.PermissionDenied,
+			"%s requires the %q role", endpoint, serverptypes.RoleAdmin)
+	}
+
+	if userHasRole(user, serverptypes.RoleOperator) {
+		return nil
+	}
+
+	if userHasRole(user, serverptypes.RoleReadOnly) {
+		for _, effect := range effects {
+			if effect == "readonly" {
+				return nil
+			}
+		}
+	}
+
+	return status.Errorf(codes.PermissionDenied,
+		"%s requires the %q role", endpoint, serverptypes.RoleOperator)
+}
+
+// userHasRole returns true if the user has been granted the role. Users
+// with no roles were created before roles existed and have every role.
+func userHasRole(user *pb.User, role string) bool {
+	if len(user.Roles) == 0 {
+		return true
+	}
+
+	for _, r := range user.Roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// requireAdminForOtherUser returns an error if the current user isn't the
+// target user and isn't an admin.
+func requireAdminForOtherUser(current *pb.User, targetId string) error {
+	if current.Id == targetId || userHasRole(current, serverptypes.RoleAdmin) {
+		return nil
+	}
+
+	return status.Errorf(codes.PermissionDenied,
+		"the %q role is required to act on other users", serverptypes.RoleAdmin)
+}
@@ -0,0 +1,208 @@
+package singleprocess
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// scopeExemptEndpoints are the endpoints that scoped tokens may call even
+// though they don't target a project or workspace, because they only act
+// on the token itself and keep its scope.
+var scopeExemptEndpoints = map[string]struct{}{
+	"RefreshToken": {},
+}
+
+// scopeFromContext returns the scope of the login token used for the
+// request. This will return nil if the token isn't scoped.
+func scopeFromContext(ctx context.Context) *pb.Token_Scope {
+	return tokenFromContext(ctx).GetLogin().GetScope()
+}
+
+// requestRefs are the projects and workspaces that a request targets.
+type requestRefs struct {
+	Projects   map[string]struct{}
+	Workspaces map[string]struct{}
+}
+
+// AuthorizeRequest implements the server.RequestAuthorizer interface.
+//
+// This rejects requests made with a scoped token that target a project or
+// workspace outside of the scope of the token. Requests that can't be
+// attributed to the project and workspace of the scope are rejected too,
+// since we can't tell what they may access.
+//
+// For tokens limited to a workspace, unset workspace refs in the request
+// are set to the workspace of the scope. Otherwise a request without a
+// workspace, such as listing the builds of an application, would target
+// every workspace.
+func (s *service) AuthorizeRequest(ctx context.Context, endpoint string, req interface{}) error {
+	scope := scopeFromContext(ctx)
+	if scope == nil {
+		return nil
+	}
+
+	if _, ok := scopeExemptEndpoints[endpoint]; ok {
+		return nil
+	}
+
+	refs := &requestRefs{
+		Projects:   map[string]struct{}{},
+		Workspaces: map[string]struct{}{},
+	}
+	if m, ok := req.(proto.Message); ok {
+		if scope.Workspace != "" {
+			injectWorkspace(m.ProtoReflect(), scope.Workspace)
+		}
+
+		s.collectRefs(refs, m.ProtoReflect())
+	}
+
+	if scope.Project != "" {
+		for p := range refs.Projects {
+			if p != scope.Project {
+				return status.Errorf(codes.PermissionDenied,
+					"token is limited to project %q", scope.Project)
+			}
+		}
+	}
+
+	if scope.Workspace != "" {
+		for ws := range refs.Workspaces {
+			if ws != scope.Workspace {
+				return status.Errorf(codes.PermissionDenied,
+					"token is limited to workspace %q", scope.Workspace)
+			}
+		}
+	}
+
+	// At this point every target is within the scope, but we require that
+	// the request targets every part of the scope so that it can't access
+	// everything. A request that targets the project but no workspace could
+	// access every workspace of the project, for example.
+	if scope.Project == "" && scope.Workspace == "" ||
+		scope.Project != "" && len(refs.Projects) == 0 ||
+		scope.Workspace != "" && len(refs.Workspaces) == 0 {
+		return status.Errorf(codes.PermissionDenied,
+			"%s isn't limited to the project and workspace of the scoped token "+
+				"and can't be called with it",
+			endpoint)
+	}
+
+	return nil
+}
+
+// workspaceRefName is the full name of the Ref.Workspace message.
+var workspaceRefName = (&pb.Ref_Workspace{}).ProtoReflect().Descriptor().FullName()
+
+// injectWorkspace sets every unset workspace ref of the message to the
+// given workspace. Empty workspace refs count as unset. This recurses into
+// the messages that are set but doesn't create new ones, so only workspace
+// fields of the parts of the request that are used are set.
+func injectWorkspace(m protoreflect.Message, ws string) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Kind() != protoreflect.MessageKind || fd.IsMap() {
+			continue
+		}
+
+		if fd.IsList() {
+			list := m.Get(fd).List()
+			for i := 0; i < list.Len(); i++ {
+				injectWorkspace(list.Get(i).Message(), ws)
+			}
+
+			continue
+		}
+
+		// Setting an unset oneof field would clear the field that is set.
+		if fd.ContainingOneof() != nil && !m.Has(fd) {
+			continue
+		}
+
+		if fd.Message().FullName() == workspaceRefName {
+			ref := m.Mutable(fd).Message().Interface().(*pb.Ref_Workspace)
+			if ref.Workspace == "" {
+				ref.Workspace = ws
+			}
+
+			continue
+		}
+
+		if m.Has(fd) {
+			injectWorkspace(m.Get(fd).Message(), ws)
+		}
+	}
+}
+
+// collectRefs walks the message and records the projects and workspaces
+// it references. Jobs and deployments referenced by ID are looked up so
+// that their targets are recorded too.
+func (s *service) collectRefs(refs *requestRefs, m protoreflect.Message) {
+	switch v := m.Interface().(type) {
+	case *pb.Ref_Application:
+		refs.addProject(v.Project)
+	case *pb.Ref_Project:
+		refs.addProject(v.Project)
+	case *pb.Ref_Workspace:
+		refs.addWorkspace(v.Workspace)
+	case *pb.Project:
+		refs.addProject(v.Name)
+	}
+
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList() && fd.Kind() == protoreflect.MessageKind:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				s.collectRefs(refs, list.Get(i).Message())
+			}
+
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					s.collectRefs(refs, v.Message())
+					return true
+				})
+			}
+
+		case fd.Kind() == protoreflect.MessageKind:
+			s.collectRefs(refs, v.Message())
+
+		case fd.Kind() == protoreflect.StringKind && fd.Name() == "job_id":
+			job, err := s.state.JobById(v.String(), nil)
+			if err == nil && job != nil {
+				s.collectRefs(refs, job.Job.ProtoReflect())
+			}
+
+		case fd.Kind() == protoreflect.StringKind && fd.Name() == "deployment_id":
+			deployment, err := s.state.DeploymentGet(&pb.Ref_Operation{
+				Target: &pb.Ref_Operation_Id{Id: v.String()},
+			})
+			if err == nil && deployment != nil {
+				refs.addProject(deployment.Application.GetProject())
+				refs.addWorkspace(deployment.Workspace.GetWorkspace())
+			}
+		}
+
+		return true
+	})
+}
+
+func (r *requestRefs) addProject(v string) {
+	if v != "" {
+		r.Projects[v] = struct{}{}
+	}
+}
+
+func (r *requestRefs) addWorkspace(v string) {
+	if v != "" {
+		r.Workspaces[v] = struct{}{}
+	}
+}
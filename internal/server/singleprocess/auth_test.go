@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/mr-tron/base58"
 	"github.com/stretchr/testify/assert"
@@ -15,6 +17,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
 )
 
 func TestServiceAuth(t *testing.T) {
@@ -312,3 +315,404 @@ func TestServiceAuth_userSuperuserForced(t *testing.T) {
 	require.NotNil(t, user)
 	require.Equal(t, DefaultUserId, user.Id)
 }
+
+func TestServiceAuth_roles(t *testing.T) {
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(t, err)
+	s := impl.(*service)
+	ctx := context.Background()
+
+	// "Log in" a default user
+	ctx = userWithContext(ctx, &pb.User{Id: DefaultUserId})
+
+	// Bootstrap
+	_, err = impl.BootstrapToken(ctx, &empty.Empty{})
+	require.NoError(t, err)
+
+	// Create a user with the given role and return a token for them.
+	tokenForRole := func(t *testing.T, username, role string) string {
+		require := require.New(t)
+
+		user := &pb.User{Username: username, Roles: []string{role}}
+		require.NoError(s.state.UserPut(user))
+
+		resp, err := s.GenerateLoginToken(ctx, &pb.LoginTokenRequest{
+			User: &pb.Ref_User{
+				Ref: &pb.Ref_User_Id{Id: &pb.Ref_UserId{Id: user.Id}},
+			},
+		})
+		require.NoError(err)
+		return resp.Token
+	}
+
+	readonly := []string{"readonly"}
+	mutable := []string{"mutable"}
+
+	t.Run("admin", func(t *testing.T) {
+		token := tokenForRole(t, "admin", "admin")
+
+		_, err := s.Authenticate(context.Background(), token, "UpsertAuthMethod", mutable)
+		require.NoError(t, err)
+	})
+
+	t.Run("operator", func(t *testing.T) {
+		token := tokenForRole(t, "operator", "operator")
+
+		_, err := s.Authenticate(context.Background(), token, "QueueJob", mutable)
+		require.NoError(t, err)
+
+		_, err = s.Authenticate(context.Background(), token, "UpsertAuthMethod", mutable)
+		require.Error(t, err)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// Self-service endpoints are allowed
+		_, err = s.Authenticate(context.Background(), token, "UpdateUser", mutable)
+		require.NoError(t, err)
+	})
+
+	t.Run("read-only", func(t *testing.T) {
+		token := tokenForRole(t, "reader", "read-only")
+
+		_, err := s.Authenticate(context.Background(), token, "ListBuilds", readonly)
+		require.NoError(t, err)
+
+		_, err = s.Authenticate(context.Background(), token, "QueueJob", mutable)
+		require.Error(t, err)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("no roles is treated as admin", func(t *testing.T) {
+		require := require.New(t)
+
+		user := &pb.User{Username: "legacy"}
+		require.NoError(s.state.UserPut(user))
+
+		resp, err := s.GenerateLoginToken(ctx, &pb.LoginTokenRequest{
+			User: &pb.Ref_User{
+				Ref: &pb.Ref_User_Id{Id: &pb.Ref_UserId{Id: user.Id}},
+			},
+		})
+		require.NoError(err)
+
+		_, err = s.Authenticate(context.Background(), resp.Token, "UpsertAuthMethod", mutable)
+		require.NoError(err)
+	})
+
+	t.Run("login token for another user requires admin", func(t *testing.T) {
+		require := require.New(t)
+
+		user := &pb.User{Username: "limited", Roles: []string{"operator"}}
+		require.NoError(s.state.UserPut(user))
+
+		_, err := s.GenerateLoginToken(userWithContext(context.Background(), user), &pb.LoginTokenRequest{
+			User: &pb.Ref_User{
+				Ref: &pb.Ref_User_Id{Id: &pb.Ref_UserId{Id: DefaultUserId}},
+			},
+		})
+		require.Error(err)
+		require.Equal(codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("token roles replace user roles", func(t *testing.T) {
+		require := require.New(t)
+
+		user := &pb.User{Username: "mapped", Roles: []string{"admin"}}
+		require.NoError(s.state.UserPut(user))
+
+		token, err := s.newToken(0, DefaultKeyId, nil, &pb.Token{
+			Kind: &pb.Token_Login_{Login: &pb.Token_Login{
+				UserId: user.Id,
+				Roles:  []string{"read-only"},
+			}},
+		})
+		require.NoError(err)
+
+		_, err = s.Authenticate(context.Background(), token, "QueueJob", mutable)
+		require.Error(err)
+		require.Equal(codes.PermissionDenied, status.Code(err))
+
+		// Tokens generated with the token keep its roles
+		tokenCtx, err := s.Authenticate(context.Background(), token, "GenerateLoginToken", mutable)
+		require.NoError(err)
+		resp, err := s.GenerateLoginToken(tokenCtx, &pb.LoginTokenRequest{})
+		require.NoError(err)
+
+		_, err = s.Authenticate(context.Background(), resp.Token, "QueueJob", mutable)
+		require.Error(err)
+		require.Equal(codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestServiceAuth_scope(t *testing.T) {
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(t, err)
+	s := impl.(*service)
+	ctx := context.Background()
+
+	// "Log in" a default user
+	ctx = userWithContext(ctx, &pb.User{Id: DefaultUserId})
+
+	// Bootstrap
+	_, err = impl.BootstrapToken(ctx, &empty.Empty{})
+	require.NoError(t, err)
+
+	// Authenticate with a scoped token and authorize the request.
+	call := func(t *testing.T, scope *pb.Token_Scope, req interface{}) error {
+		resp, err := s.GenerateLoginToken(ctx, &pb.LoginTokenRequest{Scope: scope})
+		require.NoError(t, err)
+
+		reqCtx, err := s.Authenticate(context.Background(), resp.Token, "test", nil)
+		require.NoError(t, err)
+
+		return s.AuthorizeRequest(reqCtx, "test", req)
+	}
+
+	app := func(project, ws string) *pb.ListBuildsRequest {
+		return &pb.ListBuildsRequest{
+			Application: &pb.Ref_Application{Project: project, Application: "web"},
+			Workspace:   &pb.Ref_Workspace{Workspace: ws},
+		}
+	}
+
+	t.Run("unscoped token", func(t *testing.T) {
+		require.NoError(t, call(t, nil, &empty.Empty{}))
+	})
+
+	t.Run("project scope", func(t *testing.T) {
+		require := require.New(t)
+		scope := &pb.Token_Scope{Project: "foo"}
+
+		require.NoError(call(t, scope, app("foo", "prod")))
+
+		err := call(t, scope, app("bar", "prod"))
+		require.Error(err)
+		require.Equal(codes.PermissionDenied, status.Code(err))
+
+		// Requests that don't target a project are rejected
+		err = call(t, scope, &empty.Empty{})
+		require.Error(err)
+		require.Equal(codes.PermissionDenied, status.Code(err))
+
+		// Scoped tokens can't create new tokens
+		err = call(t, scope, &pb.LoginTokenRequest{})
+		require.Error(err)
+	})
+
+	t.Run("project and workspace scope", func(t *testing.T) {
+		require := require.New(t)
+		scope := &pb.Token_Scope{Project: "foo", Workspace: "prod"}
+
+		require.NoError(call(t, scope, app("foo", "prod")))
+
+		err := call(t, scope, app("foo", "dev"))
+		require.Error(err)
+		require.Equal(codes.PermissionDenied, status.Code(err))
+
+		// Requests that can't be limited to the workspace are rejected
+		err = call(t, scope, &pb.GetProjectRequest{
+			Project: &pb.Ref_Project{Project: "foo"},
+		})
+		require.Error(err)
+		require.Equal(codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("workspace is set on requests without one", func(t *testing.T) {
+		require := require.New(t)
+		scope := &pb.Token_Scope{Project: "foo", Workspace: "prod"}
+
+		req := &pb.ListBuildsRequest{
+			Application: &pb.Ref_Application{Project: "foo", Application: "web"},
+		}
+		require.NoError(call(t, scope, req))
+		require.Equal("prod", req.Workspace.GetWorkspace())
+
+		// Empty workspace refs are set too
+		req = app("foo", "")
+		require.NoError(call(t, scope, req))
+		require.Equal("prod", req.Workspace.GetWorkspace())
+	})
+
+	t.Run("workspace scope", func(t *testing.T) {
+		require := require.New(t)
+		scope := &pb.Token_Scope{Workspace: "prod"}
+
+		require.NoError(call(t, scope, app("bar", "prod")))
+
+		err := call(t, scope, app("bar", "dev"))
+		require.Error(err)
+		require.Equal(codes.PermissionDenied, status.Code(err))
+
+		err = call(t, scope, &empty.Empty{})
+		require.Error(err)
+		require.Equal(codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("job by ID", func(t *testing.T) {
+		require := require.New(t)
+		scope := &pb.Token_Scope{Project: "foo"}
+
+		job := serverptypes.TestJobNew(t, &pb.Job{
+			Id:          "A",
+			Application: &pb.Ref_Application{Project: "bar", Application: "web"},
+		})
+		require.NoError(s.state.JobCreate(job))
+
+		err := call(t, scope, &pb.GetJobRequest{JobId: job.Id})
+		require.Error(err)
+		require.Equal(codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestServiceRevokeToken(t *testing.T) {
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(t, err)
+	s := impl.(*service)
+	ctx := context.Background()
+
+	// "Log in" a default user
+	ctx = userWithContext(ctx, &pb.User{Id: DefaultUserId})
+
+	// Bootstrap
+	_, err = impl.BootstrapToken(ctx, &empty.Empty{})
+	require.NoError(t, err)
+
+	newToken := func(t *testing.T) string {
+		resp, err := s.GenerateLoginToken(ctx, &pb.LoginTokenRequest{})
+		require.NoError(t, err)
+
+		_, err = s.Authenticate(context.Background(), resp.Token, "test", nil)
+		require.NoError(t, err)
+		return resp.Token
+	}
+
+	t.Run("by token ID", func(t *testing.T) {
+		require := require.New(t)
+
+		token := newToken(t)
+		other := newToken(t)
+
+		_, body, err := s.decodeToken(token)
+		require.NoError(err)
+
+		_, err = s.RevokeToken(ctx, &pb.RevokeTokenRequest{
+			Target: &pb.RevokeTokenRequest_TokenId{
+				TokenId: base58.Encode(body.AccessorId),
+			},
+		})
+		require.NoError(err)
+
+		_, err = s.Authenticate(context.Background(), token, "test", nil)
+		require.Error(err)
+		require.Contains(err.Error(), "revoked")
+
+		_, err = s.Authenticate(context.Background(), other, "test", nil)
+		require.NoError(err)
+	})
+
+	t.Run("by user", func(t *testing.T) {
+		require := require.New(t)
+
+		token := newToken(t)
+
+		_, err := s.RevokeToken(ctx, &pb.RevokeTokenRequest{
+			Target: &pb.RevokeTokenRequest_User{
+				User: &pb.Ref_User{
+					Ref: &pb.Ref_User_Id{Id: &pb.Ref_UserId{Id: DefaultUserId}},
+				},
+			},
+		})
+		require.NoError(err)
+
+		_, err = s.Authenticate(context.Background(), token, "test", nil)
+		require.Error(err)
+
+		// New tokens are still valid
+		newToken(t)
+	})
+
+	t.Run("other users require admin", func(t *testing.T) {
+		require := require.New(t)
+
+		user := &pb.User{Username: "revoker", Roles: []string{"operator"}}
+		require.NoError(s.state.UserPut(user))
+
+		_, err := s.RevokeToken(userWithContext(context.Background(), user), &pb.RevokeTokenRequest{
+			Target: &pb.RevokeTokenRequest_User{
+				User: &pb.Ref_User{
+					Ref: &pb.Ref_User_Id{Id: &pb.Ref_UserId{Id: DefaultUserId}},
+				},
+			},
+		})
+		require.Error(err)
+		require.Equal(codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("no target", func(t *testing.T) {
+		_, err := s.RevokeToken(ctx, &pb.RevokeTokenRequest{})
+		require.Error(t, err)
+	})
+}
+
+func TestServiceRefreshToken(t *testing.T) {
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(t, err)
+	s := impl.(*service)
+	ctx := context.Background()
+
+	// "Log in" a default user
+	ctx = userWithContext(ctx, &pb.User{Id: DefaultUserId})
+
+	// Bootstrap
+	_, err = impl.BootstrapToken(ctx, &empty.Empty{})
+	require.NoError(t, err)
+
+	t.Run("refreshes with the same lifetime and scope", func(t *testing.T) {
+		require := require.New(t)
+
+		scope := &pb.Token_Scope{Project: "foo"}
+		resp, err := s.GenerateLoginToken(ctx, &pb.LoginTokenRequest{
+			Duration: "1h",
+			Scope:    scope,
+		})
+		require.NoError(err)
+
+		tokenCtx, err := s.Authenticate(context.Background(), resp.Token, "RefreshToken", nil)
+		require.NoError(err)
+		require.NoError(s.AuthorizeRequest(tokenCtx, "RefreshToken", &empty.Empty{}))
+
+		refreshed, err := s.RefreshToken(tokenCtx, &empty.Empty{})
+		require.NoError(err)
+		require.NotEqual(resp.Token, refreshed.Token)
+
+		_, body, err := s.decodeToken(refreshed.Token)
+		require.NoError(err)
+
+		issued, err := ptypes.Timestamp(body.IssuedTime)
+		require.NoError(err)
+		validUntil, err := ptypes.Timestamp(body.ValidUntil)
+		require.NoError(err)
+		require.InDelta(time.Hour, validUntil.Sub(issued), float64(time.Second))
+
+		login := body.Kind.(*pb.Token_Login_).Login
+		require.Equal(DefaultUserId, login.UserId)
+		require.True(proto.Equal(scope, login.Scope))
+	})
+
+	t.Run("tokens that don't expire", func(t *testing.T) {
+		require := require.New(t)
+
+		resp, err := s.GenerateLoginToken(ctx, &pb.LoginTokenRequest{})
+		require.NoError(err)
+
+		tokenCtx, err := s.Authenticate(context.Background(), resp.Token, "RefreshToken", nil)
+		require.NoError(err)
+
+		_, err = s.RefreshToken(tokenCtx, &empty.Empty{})
+		require.Error(err)
+		require.Equal(codes.FailedPrecondition, status.Code(err))
+	})
+}
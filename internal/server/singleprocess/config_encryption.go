@@ -0,0 +1,140 @@
+package singleprocess
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/hashicorp/go-hclog"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/server/singleprocess/state"
+	"github.com/hashicorp/waypoint/internal/serverconfig"
+)
+
+func (s *service) RotateConfigEncryptionKey(
+	ctx context.Context,
+	req *empty.Empty,
+) (*pb.RotateConfigEncryptionKeyResponse, error) {
+	id, count, err := s.state.ConfigEncryptionRotate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RotateConfigEncryptionKeyResponse{
+		KeyId:       id,
+		Reencrypted: uint32(count),
+	}, nil
+}
+
+// initConfigEncryption enables the encryption of config values at rest
+// if a root key is configured. Plaintext values from before encryption
+// was enabled are encrypted.
+func initConfigEncryption(
+	ctx context.Context,
+	log hclog.Logger,
+	st state.Interface,
+	cfg *serverconfig.ConfigEncryption,
+) error {
+	wrapper, err := newConfigKeyWrapper(cfg.KeyFile, cfg.KMSKeyId, cfg.KMSRegion)
+	if err != nil {
+		return err
+	}
+
+	var previous []state.KeyWrapper
+	if cfg.PreviousKeyFile != "" || cfg.PreviousKMSKeyId != "" {
+		w, err := newConfigKeyWrapper(cfg.PreviousKeyFile, cfg.PreviousKMSKeyId, cfg.KMSRegion)
+		if err != nil {
+			return err
+		}
+
+		previous = append(previous, w)
+	}
+
+	count, err := st.ConfigEncryptionEnable(ctx, wrapper, previous...)
+	if err != nil {
+		return err
+	}
+
+	log.Info("config encryption enabled", "encrypted", count)
+	return nil
+}
+
+// newConfigKeyWrapper returns the key wrapper for the key file or KMS key.
+// Exactly one of them must be set.
+func newConfigKeyWrapper(keyFile, kmsKeyId, kmsRegion string) (state.KeyWrapper, error) {
+	switch {
+	case keyFile != "" && kmsKeyId != "":
+		return nil, fmt.Errorf(
+			"only one of the config encryption key file and KMS key may be set")
+
+	case keyFile != "":
+		data, err := ioutil.ReadFile(keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading config encryption key file: %w", err)
+		}
+
+		// The key may be base64 encoded so that it can be generated with
+		// "openssl rand -base64 32".
+		data = bytes.TrimSpace(data)
+		if len(data) != 32 {
+			decoded, err := base64.StdEncoding.DecodeString(string(data))
+			if err == nil {
+				data = decoded
+			}
+		}
+
+		return state.NewLocalKeyWrapper(data)
+
+	case kmsKeyId != "":
+		sess, err := newAWSSession(kmsRegion)
+		if err != nil {
+			return nil, err
+		}
+
+		return &kmsKeyWrapper{client: kms.New(sess), keyId: kmsKeyId}, nil
+
+	default:
+		return nil, fmt.Errorf(
+			"a config encryption key file or KMS key must be set")
+	}
+}
+
+// kmsKeyWrapper wraps keys with an AWS KMS key. The wrapped keys are only
+// a few bytes, so they're encrypted by KMS directly.
+type kmsKeyWrapper struct {
+	client *kms.KMS
+	keyId  string
+}
+
+func (w *kmsKeyWrapper) WrapKey(ctx context.Context, key []byte) ([]byte, error) {
+	resp, err := w.client.EncryptWithContext(ctx, &kms.EncryptInput{
+		KeyId:     aws.String(w.keyId),
+		Plaintext: key,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.CiphertextBlob, nil
+}
+
+func (w *kmsKeyWrapper) UnwrapKey(ctx context.Context, wrapped []byte) ([]byte, error) {
+	// The key ID is set so that a key wrapped with another KMS key, such
+	// as before a rotation of the root key, fails rather than being
+	// decrypted with whatever key it was wrapped with.
+	resp, err := w.client.DecryptWithContext(ctx, &kms.DecryptInput{
+		KeyId:          aws.String(w.keyId),
+		CiphertextBlob: wrapped,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Plaintext, nil
+}
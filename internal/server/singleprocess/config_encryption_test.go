@@ -0,0 +1,123 @@
+package singleprocess
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/serverconfig"
+)
+
+func TestServiceRotateConfigEncryptionKey(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not enabled", func(t *testing.T) {
+		require := require.New(t)
+
+		impl, err := New(WithDB(testDB(t)))
+		require.NoError(err)
+
+		_, err = impl.RotateConfigEncryptionKey(ctx, &empty.Empty{})
+		require.Error(err)
+		require.Equal(codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("rotate", func(t *testing.T) {
+		require := require.New(t)
+
+		impl, err := New(
+			WithDB(testDB(t)),
+			WithConfig(&serverconfig.Config{
+				ConfigEncryption: &serverconfig.ConfigEncryption{
+					KeyFile: testConfigKeyFile(t),
+				},
+			}),
+		)
+		require.NoError(err)
+
+		_, err = impl.SetConfig(ctx, &pb.ConfigSetRequest{
+			Variables: []*pb.ConfigVar{
+				{
+					Scope: &pb.ConfigVar_Project{
+						Project: &pb.Ref_Project{Project: "foo"},
+					},
+
+					Name:  "DATABASE_PASSWORD",
+					Value: &pb.ConfigVar_Static{Static: "hunter2"},
+				},
+			},
+		})
+		require.NoError(err)
+
+		resp, err := impl.RotateConfigEncryptionKey(ctx, &empty.Empty{})
+		require.NoError(err)
+		require.NotEmpty(resp.KeyId)
+		require.Equal(uint32(1), resp.Reencrypted)
+
+		// The value is still readable
+		getResp, err := impl.GetConfig(ctx, &pb.ConfigGetRequest{
+			Scope: &pb.ConfigGetRequest_Project{
+				Project: &pb.Ref_Project{Project: "foo"},
+			},
+		})
+		require.NoError(err)
+		require.Len(getResp.Variables, 1)
+		require.Equal("hunter2", getResp.Variables[0].Value.(*pb.ConfigVar_Static).Static)
+	})
+}
+
+func TestNewConfigKeyWrapper(t *testing.T) {
+	require := require.New(t)
+
+	// Neither or both set
+	_, err := newConfigKeyWrapper("", "", "")
+	require.Error(err)
+	_, err = newConfigKeyWrapper("foo", "bar", "")
+	require.Error(err)
+
+	// Base64 encoded key
+	w, err := newConfigKeyWrapper(testConfigKeyFile(t), "", "")
+	require.NoError(err)
+	wrapped, err := w.WrapKey(context.Background(), []byte("hello"))
+	require.NoError(err)
+	unwrapped, err := w.UnwrapKey(context.Background(), wrapped)
+	require.NoError(err)
+	require.Equal([]byte("hello"), unwrapped)
+
+	// Key of the wrong length
+	td, err := ioutil.TempDir("", "waypoint")
+	require.NoError(err)
+	defer os.RemoveAll(td)
+	path := filepath.Join(td, "short.key")
+	require.NoError(ioutil.WriteFile(path, []byte("short"), 0600))
+	_, err = newConfigKeyWrapper(path, "", "")
+	require.Error(err)
+}
+
+// testConfigKeyFile writes a random base64 encoded key to a file and
+// returns its path.
+func testConfigKeyFile(t *testing.T) string {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+
+	td, err := ioutil.TempDir("", "waypoint")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(td) })
+
+	path := filepath.Join(td, "config.key")
+	require.NoError(t, ioutil.WriteFile(path,
+		[]byte(base64.StdEncoding.EncodeToString(key)+"\n"), 0600))
+
+	return path
+}
@@ -0,0 +1,237 @@
+package singleprocess
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/hashicorp/cronexpr"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-memdb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// runJobScheduler queues the jobs of job schedules. This sleeps until the
+// next time of the earliest schedule, queues its job, and records the
+// result and the next time on the schedule.
+// This blocks and is expected to be run in a goroutine.
+//
+// This function should only ever be invoked one at a time. Running multiple
+// copies can result in duplicate jobs for schedules.
+func (s *service) runJobScheduler(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	log hclog.Logger,
+) {
+	defer wg.Done()
+
+	log.Info("starting")
+	defer log.Info("exiting")
+
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		ws := memdb.NewWatchSet()
+		schedule, next, err := s.state.JobSchedulePeek(ws)
+		if err != nil {
+			// This should never happen. We log it and sleep so that we
+			// don't spin since the error will probably keep happening.
+			log.Error("BUG (please report): error peeking job schedules, sleeping 1 minute", "err", err)
+
+			select {
+			case <-time.After(1 * time.Minute):
+			case <-ctx.Done():
+			}
+
+			continue
+		}
+
+		// Wait until the schedules change or the next schedule is due.
+		loopCtx, loopCtxCancel := context.WithCancel(ctx)
+		if schedule != nil {
+			log.Trace("next job schedule", "name", schedule.Name, "time", next)
+			loopCtxCancel()
+			loopCtx, loopCtxCancel = context.WithDeadline(ctx, next)
+		}
+		err = ws.WatchCtx(loopCtx)
+		loopCtxCancel()
+
+		if err == nil {
+			log.Trace("job schedules changed")
+			continue
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if schedule == nil {
+			continue
+		}
+
+		if err := s.jobScheduleRun(ctx, log, schedule.Project, schedule.Name); err != nil {
+			// The schedule couldn't be updated so it is still due. Sleep
+			// so that we don't spin on it.
+			log.Warn("error running job schedule", "name", schedule.Name, "err", err)
+
+			select {
+			case <-time.After(1 * time.Second):
+			case <-ctx.Done():
+			}
+		}
+	}
+}
+
+// jobScheduleRun queues the job of a schedule that is due and stores the
+// result and next time of the schedule. Errors queueing the job are stored
+// on the schedule and aren't returned.
+func (s *service) jobScheduleRun(
+	ctx context.Context,
+	log hclog.Logger,
+	ref *pb.Ref_Project,
+	name string,
+) error {
+	s.jobScheduleMu.Lock()
+	defer s.jobScheduleMu.Unlock()
+
+	// Get the latest schedule since it may have changed or been deleted.
+	schedule, err := s.state.JobScheduleGet(ref, name)
+	if status.Code(err) == codes.NotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if schedule.NextTime == nil {
+		return nil
+	}
+	if t, err := ptypes.Timestamp(schedule.NextTime); err != nil || t.After(now) {
+		return err
+	}
+
+	log = log.With("project", ref.Project, "name", name)
+	schedule.LastTime, err = ptypes.TimestampProto(now)
+	if err != nil {
+		return err
+	}
+	schedule.LastJobId = ""
+	schedule.LastError = ""
+
+	req, err := s.jobScheduleJob(log, schedule)
+	if err == nil {
+		var resps []*pb.QueueJobResponse
+		resps, err = s.queueJobMulti(ctx, []*pb.QueueJobRequest{req})
+		if err == nil {
+			schedule.LastJobId = resps[0].JobId
+		}
+	}
+	if err != nil {
+		log.Warn("error queueing scheduled job", "err", err)
+		schedule.LastError = err.Error()
+	} else {
+		log.Debug("queued scheduled job", "job_id", schedule.LastJobId)
+	}
+
+	schedule.NextTime, err = jobScheduleNext(schedule.Cron, now)
+	if err != nil {
+		return err
+	}
+
+	return s.state.JobSchedulePut(schedule)
+}
+
+// jobScheduleJob returns the job to queue for a schedule. The job uses
+// the data source of the project and runs on any runner.
+func (s *service) jobScheduleJob(
+	log hclog.Logger,
+	schedule *pb.JobSchedule,
+) (*pb.QueueJobRequest, error) {
+	job := &pb.Job{
+		// SingletonId so that only one job of the schedule is ever queued
+		// if the runners fall behind.
+		SingletonId: fmt.Sprintf("schedule/%s/%s",
+			schedule.Project.Project, schedule.Name),
+
+		Application: schedule.Application,
+		Workspace:   schedule.Workspace,
+
+		TargetRunner: &pb.Ref_Runner{
+			Target: &pb.Ref_Runner_Any{
+				Any: &pb.Ref_RunnerAny{},
+			},
+		},
+
+		// Scheduled jobs are in the background so interactive jobs go first.
+		Priority: pb.Job_LOW,
+	}
+
+	switch schedule.Op {
+	case pb.JobSchedule_UP:
+		job.Operation = &pb.Job_Up{
+			Up: &pb.Job_UpOp{
+				Release: &pb.Job_ReleaseOp{
+					Prune: true,
+				},
+			},
+		}
+
+	case pb.JobSchedule_BUILD:
+		job.Operation = &pb.Job_Build{
+			Build: &pb.Job_BuildOp{},
+		}
+
+	case pb.JobSchedule_STATUS_REPORT:
+		// Status reports are built the same way as status report polling.
+		app, err := s.state.AppGet(schedule.Application)
+		if err != nil {
+			return nil, err
+		}
+
+		poll := &applicationPoll{
+			state:     s.state,
+			workspace: schedule.Workspace.Workspace,
+		}
+		req, err := poll.buildPollJob(log, app)
+		if err != nil {
+			return nil, err
+		}
+		if req == nil {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"no deployment or release to generate a status report for")
+		}
+
+		return req, nil
+
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"unknown job schedule operation: %s", schedule.Op.String())
+	}
+
+	return &pb.QueueJobRequest{Job: job}, nil
+}
+
+// jobScheduleNext returns the first time after t that the cron expression
+// matches. The expression is evaluated in UTC. This returns nil if the
+// expression never matches again.
+func jobScheduleNext(cron string, t time.Time) (*timestamp.Timestamp, error) {
+	expr, err := cronexpr.Parse(cron)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid cron expression: %s", err)
+	}
+
+	next := expr.Next(t.UTC())
+	if next.IsZero() {
+		return nil, nil
+	}
+
+	return ptypes.TimestampProto(next)
+}
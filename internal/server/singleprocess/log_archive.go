@@ -0,0 +1,483 @@
+package singleprocess
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-hclog"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/server/singleprocess/state"
+	"github.com/hashicorp/waypoint/internal/serverconfig"
+)
+
+const (
+	// logArchiveNameSuffix is the suffix of the chunks in the log archive.
+	// Only files with this suffix are read so that other files in the
+	// destination are ignored.
+	logArchiveNameSuffix = ".logs"
+
+	// logArchiveTimeFormat is the format of the times in chunk names. It
+	// sorts in time order.
+	logArchiveTimeFormat = "20060102T150405.000000000Z"
+
+	// defaultLogArchiveFlushInterval is how often new lines are written
+	// if the interval isn't configured.
+	defaultLogArchiveFlushInterval = time.Minute
+
+	// defaultSearchLogsLimit is the default maximum number of lines
+	// returned by SearchLogs.
+	defaultSearchLogsLimit = 1000
+)
+
+// logArchive persists the logs of instances so that they can be searched
+// after the instances are gone or the server restarts.
+//
+// Lines are buffered per instance and written every interval as a
+// "chunk": a LogBatch of a single instance stored under the directory of
+// its application. The name of a chunk has the times of its first and
+// last lines so that searches only read the chunks in their time range.
+type logArchive struct {
+	target   logArchiveTarget
+	interval time.Duration
+	log      hclog.Logger
+
+	mu      sync.Mutex
+	pending map[string]*logArchivePending
+}
+
+// logArchivePending are the lines of an instance that haven't been
+// written yet.
+type logArchivePending struct {
+	// dir is the directory of the application of the instance.
+	dir   string
+	batch *pb.LogBatch
+}
+
+func newLogArchive(cfg *serverconfig.LogArchive, log hclog.Logger) (*logArchive, error) {
+	var target logArchiveTarget
+	var err error
+	switch {
+	case cfg.Path != "" && cfg.S3Bucket != "":
+		return nil, fmt.Errorf(
+			"only one of the log archive path and S3 bucket may be set")
+
+	case cfg.Path != "":
+		target, err = newLogArchiveDirTarget(cfg.Path)
+
+	case cfg.S3Bucket != "":
+		target, err = newLogArchiveS3Target(cfg)
+
+	default:
+		return nil, fmt.Errorf(
+			"the log archive requires a path or S3 bucket")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	interval := cfg.FlushInterval
+	if interval <= 0 {
+		interval = defaultLogArchiveFlushInterval
+	}
+
+	return &logArchive{
+		target:   target,
+		interval: interval,
+		log:      log,
+		pending:  map[string]*logArchivePending{},
+	}, nil
+}
+
+// run writes the pending lines every interval until the context is
+// cancelled. The remaining lines are written before exiting.
+func (a *logArchive) run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	a.log.Info("starting", "destination", a.target.String(), "interval", a.interval)
+	defer a.log.Info("exiting")
+
+	tk := time.NewTicker(a.interval)
+	defer tk.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// Our context is done so we use a new one to write the lines
+			// we still have.
+			if err := a.flush(context.Background()); err != nil {
+				a.log.Error("error writing logs", "error", err)
+			}
+
+			return
+
+		case <-tk.C:
+			if err := a.flush(ctx); err != nil {
+				a.log.Error("error writing logs", "error", err)
+			}
+		}
+	}
+}
+
+// Append adds the lines of the instance to the archive. The lines are
+// written on the next flush.
+func (a *logArchive) Append(inst *state.Instance, lines []*pb.LogBatch_Entry) {
+	if len(lines) == 0 {
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	p, ok := a.pending[inst.Id]
+	if !ok {
+		p = &logArchivePending{
+			dir: logArchiveDir(inst.Project, inst.Workspace, inst.Application),
+			batch: &pb.LogBatch{
+				DeploymentId: inst.DeploymentId,
+				InstanceId:   inst.Id,
+			},
+		}
+		a.pending[inst.Id] = p
+	}
+
+	p.batch.Lines = append(p.batch.Lines, lines...)
+}
+
+// flush writes the pending lines of every instance.
+func (a *logArchive) flush(ctx context.Context) error {
+	a.mu.Lock()
+	pending := a.pending
+	a.pending = map[string]*logArchivePending{}
+	a.mu.Unlock()
+
+	var lastErr error
+	for _, p := range pending {
+		// We keep going on errors so that one failure doesn't lose the
+		// lines of every instance. The lines of a failed chunk are lost.
+		data, err := proto.Marshal(p.batch)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		name := path.Join(p.dir, logArchiveChunkName(p.batch))
+		if err := a.target.Write(ctx, name, data); err != nil {
+			lastErr = err
+			continue
+		}
+
+		a.log.Trace("wrote logs", "name", name, "lines", len(p.batch.Lines))
+	}
+
+	return lastErr
+}
+
+// Search returns the archived lines of an application within the time
+// range. Zero times leave the range open. If there are more than limit
+// lines, only the newest limit lines are returned.
+func (a *logArchive) Search(
+	ctx context.Context,
+	ref *pb.GetLogStreamRequest_Application,
+	since, until time.Time,
+	limit int,
+) (*pb.SearchLogsResponse, error) {
+	dir := logArchiveDir(
+		ref.Application.Project,
+		ref.Workspace.Workspace,
+		ref.Application.Application,
+	)
+
+	// Get the batches of the chunks in the time range and the lines that
+	// aren't written yet.
+	var batches []*pb.LogBatch
+	names, err := a.target.List(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		first, last, ok := logArchiveChunkTimes(name)
+		if !ok {
+			continue
+		}
+		if (!since.IsZero() && last.Before(since)) || (!until.IsZero() && first.After(until)) {
+			continue
+		}
+
+		data, err := a.target.Read(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+
+		var batch pb.LogBatch
+		if err := proto.Unmarshal(data, &batch); err != nil {
+			return nil, err
+		}
+
+		batches = append(batches, &batch)
+	}
+
+	a.mu.Lock()
+	for _, p := range a.pending {
+		if p.dir == dir {
+			batches = append(batches, proto.Clone(p.batch).(*pb.LogBatch))
+		}
+	}
+	a.mu.Unlock()
+
+	// Flatten the lines in the time range so that we can sort them and
+	// keep the newest.
+	type line struct {
+		batch *pb.LogBatch
+		entry *pb.LogBatch_Entry
+		time  time.Time
+	}
+	var lines []line
+	for _, batch := range batches {
+		for _, entry := range batch.Lines {
+			t, err := ptypes.Timestamp(entry.Timestamp)
+			if err != nil {
+				continue
+			}
+			if (!since.IsZero() && t.Before(since)) || (!until.IsZero() && t.After(until)) {
+				continue
+			}
+
+			lines = append(lines, line{batch: batch, entry: entry, time: t})
+		}
+	}
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].time.Before(lines[j].time)
+	})
+
+	var result pb.SearchLogsResponse
+	if limit > 0 && len(lines) > limit {
+		lines = lines[len(lines)-limit:]
+		result.Truncated = true
+	}
+
+	// Group the lines by instance again. The batches are in the order of
+	// their first line.
+	byInstance := map[string]*pb.LogBatch{}
+	for _, l := range lines {
+		batch, ok := byInstance[l.batch.InstanceId]
+		if !ok {
+			batch = &pb.LogBatch{
+				DeploymentId: l.batch.DeploymentId,
+				InstanceId:   l.batch.InstanceId,
+			}
+			byInstance[l.batch.InstanceId] = batch
+			result.Batches = append(result.Batches, batch)
+		}
+
+		batch.Lines = append(batch.Lines, l.entry)
+	}
+
+	return &result, nil
+}
+
+// logArchiveDir returns the directory of the chunks of an application.
+func logArchiveDir(project, workspace, app string) string {
+	return path.Join(project, workspace, app)
+}
+
+// logArchiveChunkName returns the name of the chunk of the batch within
+// the directory of its application.
+func logArchiveChunkName(batch *pb.LogBatch) string {
+	first, _ := ptypes.Timestamp(batch.Lines[0].Timestamp)
+	last, _ := ptypes.Timestamp(batch.Lines[len(batch.Lines)-1].Timestamp)
+	return fmt.Sprintf("%s_%s_%s%s",
+		first.UTC().Format(logArchiveTimeFormat),
+		last.UTC().Format(logArchiveTimeFormat),
+		batch.InstanceId,
+		logArchiveNameSuffix,
+	)
+}
+
+// logArchiveChunkTimes returns the times of the first and last lines of
+// the chunk with the given name. This returns false if the name isn't
+// the name of a chunk.
+func logArchiveChunkTimes(name string) (time.Time, time.Time, bool) {
+	base := path.Base(name)
+	if !strings.HasSuffix(base, logArchiveNameSuffix) {
+		return time.Time{}, time.Time{}, false
+	}
+
+	parts := strings.SplitN(base, "_", 3)
+	if len(parts) != 3 {
+		return time.Time{}, time.Time{}, false
+	}
+
+	first, err := time.Parse(logArchiveTimeFormat, parts[0])
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	last, err := time.Parse(logArchiveTimeFormat, parts[1])
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	return first, last, true
+}
+
+// logArchiveTarget is a destination that log chunks are written to.
+// Names are slash-separated paths relative to the root of the target.
+type logArchiveTarget interface {
+	// Write writes a chunk with the given name.
+	Write(ctx context.Context, name string, data []byte) error
+
+	// List returns the names of all files under the directory dir.
+	List(ctx context.Context, dir string) ([]string, error)
+
+	// Read returns the contents of the chunk with the given name.
+	Read(ctx context.Context, name string) ([]byte, error)
+
+	// String returns the destination for humans.
+	String() string
+}
+
+// logArchiveDirTarget writes log chunks to a local directory.
+type logArchiveDirTarget struct {
+	dir string
+}
+
+func newLogArchiveDirTarget(dir string) (*logArchiveDirTarget, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	return &logArchiveDirTarget{dir: dir}, nil
+}
+
+func (t *logArchiveDirTarget) Write(ctx context.Context, name string, data []byte) error {
+	full := filepath.Join(t.dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(full), 0700); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first and rename it once it is complete
+	// so that a partial chunk is never read.
+	f, err := ioutil.TempFile(filepath.Dir(full), filepath.Base(full)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), full)
+}
+
+func (t *logArchiveDirTarget) List(ctx context.Context, dir string) ([]string, error) {
+	entries, err := ioutil.ReadDir(filepath.Join(t.dir, filepath.FromSlash(dir)))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+	for _, e := range entries {
+		if e.Mode().IsRegular() {
+			result = append(result, path.Join(dir, e.Name()))
+		}
+	}
+
+	return result, nil
+}
+
+func (t *logArchiveDirTarget) Read(ctx context.Context, name string) ([]byte, error) {
+	return ioutil.ReadFile(filepath.Join(t.dir, filepath.FromSlash(name)))
+}
+
+func (t *logArchiveDirTarget) String() string {
+	return t.dir
+}
+
+// logArchiveS3Target writes log chunks to an S3 bucket.
+type logArchiveS3Target struct {
+	bucket string
+	prefix string
+	sess   *session.Session
+}
+
+func newLogArchiveS3Target(cfg *serverconfig.LogArchive) (*logArchiveS3Target, error) {
+	sess, err := newAWSSession(cfg.S3Region)
+	if err != nil {
+		return nil, err
+	}
+
+	return &logArchiveS3Target{
+		bucket: cfg.S3Bucket,
+		prefix: cfg.S3Prefix,
+		sess:   sess,
+	}, nil
+}
+
+func (t *logArchiveS3Target) Write(ctx context.Context, name string, data []byte) error {
+	_, err := s3manager.NewUploader(t.sess).UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(t.bucket),
+		Key:    aws.String(path.Join(t.prefix, name)),
+		Body:   bytes.NewReader(data),
+	})
+
+	return err
+}
+
+func (t *logArchiveS3Target) List(ctx context.Context, dir string) ([]string, error) {
+	prefix := path.Join(t.prefix, dir) + "/"
+
+	var result []string
+	err := s3.New(t.sess).ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket:    aws.String(t.bucket),
+		Prefix:    aws.String(prefix),
+		Delimiter: aws.String("/"),
+	}, func(page *s3.ListObjectsV2Output, last bool) bool {
+		for _, obj := range page.Contents {
+			result = append(result, path.Join(dir, path.Base(aws.StringValue(obj.Key))))
+		}
+
+		return true
+	})
+
+	return result, err
+}
+
+func (t *logArchiveS3Target) Read(ctx context.Context, name string) ([]byte, error) {
+	out, err := s3.New(t.sess).GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(t.bucket),
+		Key:    aws.String(path.Join(t.prefix, name)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer out.Body.Close()
+
+	return ioutil.ReadAll(out.Body)
+}
+
+func (t *logArchiveS3Target) String() string {
+	return "s3://" + path.Join(t.bucket, t.prefix)
+}
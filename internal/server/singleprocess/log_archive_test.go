@@ -0,0 +1,121 @@
+package singleprocess
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/internal/server"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/server/singleprocess/state"
+	"github.com/hashicorp/waypoint/internal/serverconfig"
+)
+
+func TestLogArchive(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "waypoint-test")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+
+	a, err := newLogArchive(&serverconfig.LogArchive{Path: dir}, hclog.L())
+	require.NoError(err)
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	entry := func(line string, offset time.Duration) *pb.LogBatch_Entry {
+		ts, err := ptypes.TimestampProto(start.Add(offset))
+		require.NoError(err)
+		return &pb.LogBatch_Entry{Line: line, Timestamp: ts}
+	}
+
+	instA := &state.Instance{Id: "A", DeploymentId: "D", Project: "p", Application: "web", Workspace: "default"}
+	instB := &state.Instance{Id: "B", DeploymentId: "D", Project: "p", Application: "web", Workspace: "default"}
+	instOther := &state.Instance{Id: "C", DeploymentId: "E", Project: "p", Application: "api", Workspace: "default"}
+
+	// Write a chunk for each instance and keep one line pending
+	a.Append(instA, []*pb.LogBatch_Entry{entry("a1", 0), entry("a2", 2*time.Minute)})
+	a.Append(instB, []*pb.LogBatch_Entry{entry("b1", time.Minute)})
+	a.Append(instOther, []*pb.LogBatch_Entry{entry("c1", time.Minute)})
+	require.NoError(a.flush(ctx))
+	a.Append(instA, []*pb.LogBatch_Entry{entry("a3", time.Hour)})
+
+	ref := &pb.GetLogStreamRequest_Application{
+		Application: &pb.Ref_Application{Project: "p", Application: "web"},
+		Workspace:   &pb.Ref_Workspace{Workspace: "default"},
+	}
+	lines := func(resp *pb.SearchLogsResponse) []string {
+		var result []string
+		for _, batch := range resp.Batches {
+			for _, entry := range batch.Lines {
+				result = append(result, batch.InstanceId+":"+entry.Line)
+			}
+		}
+		return result
+	}
+
+	// Everything, including the pending line
+	resp, err := a.Search(ctx, ref, time.Time{}, time.Time{}, 0)
+	require.NoError(err)
+	require.Equal([]string{"A:a1", "A:a2", "A:a3", "B:b1"}, lines(resp))
+	require.False(resp.Truncated)
+
+	// Time range
+	resp, err = a.Search(ctx, ref, start.Add(time.Minute), start.Add(10*time.Minute), 0)
+	require.NoError(err)
+	require.Equal([]string{"B:b1", "A:a2"}, lines(resp))
+
+	// Limit keeps the newest lines
+	resp, err = a.Search(ctx, ref, time.Time{}, time.Time{}, 2)
+	require.NoError(err)
+	require.Equal([]string{"A:a2", "A:a3"}, lines(resp))
+	require.True(resp.Truncated)
+
+	// The archive survives a restart once flushed
+	require.NoError(a.flush(ctx))
+	a, err = newLogArchive(&serverconfig.LogArchive{Path: dir}, hclog.L())
+	require.NoError(err)
+	resp, err = a.Search(ctx, ref, start.Add(30*time.Minute), time.Time{}, 0)
+	require.NoError(err)
+	require.Equal([]string{"A:a3"}, lines(resp))
+}
+
+func TestServiceSearchLogs(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("without an archive", func(t *testing.T) {
+		require := require.New(t)
+
+		impl, err := New(WithDB(testDB(t)))
+		require.NoError(err)
+		client := server.TestServer(t, impl)
+
+		_, err = client.SearchLogs(ctx, &pb.SearchLogsRequest{
+			Application: &pb.GetLogStreamRequest_Application{
+				Application: &pb.Ref_Application{Project: "p", Application: "web"},
+				Workspace:   &pb.Ref_Workspace{Workspace: "default"},
+			},
+		})
+		require.Error(err)
+		require.Equal(codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("requires an application", func(t *testing.T) {
+		require := require.New(t)
+
+		impl, err := New(WithDB(testDB(t)))
+		require.NoError(err)
+		client := server.TestServer(t, impl)
+
+		_, err = client.SearchLogs(ctx, &pb.SearchLogsRequest{})
+		require.Error(err)
+	})
+}
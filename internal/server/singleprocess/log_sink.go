@@ -0,0 +1,410 @@
+package singleprocess
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-hclog"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/server/singleprocess/state"
+	"github.com/hashicorp/waypoint/internal/serverconfig"
+)
+
+// logSinkQueueSize is the number of batches queued for each log sink. If
+// a sink falls further behind, new batches are dropped for it.
+const logSinkQueueSize = 1024
+
+// logSink is an external destination that the logs of instances are
+// forwarded to.
+type logSink interface {
+	// Write writes lines of the instance.
+	Write(ctx context.Context, inst *state.Instance, lines []*pb.LogBatch_Entry) error
+
+	// String returns the destination for humans.
+	String() string
+}
+
+func newLogSink(cfg *serverconfig.LogSink) (logSink, error) {
+	if cfg.Address == "" {
+		return nil, fmt.Errorf("log sink %q requires an address", cfg.Type)
+	}
+
+	switch cfg.Type {
+	case "file":
+		return &logSinkFile{path: cfg.Address}, nil
+
+	case "syslog":
+		return newLogSinkSyslog(cfg.Address)
+
+	case "loki":
+		return &logSinkLoki{
+			url:    strings.TrimSuffix(cfg.Address, "/") + "/loki/api/v1/push",
+			client: &http.Client{Timeout: 30 * time.Second},
+		}, nil
+
+	case "cloudwatch":
+		return newLogSinkCloudWatch(cfg.Address)
+
+	default:
+		return nil, fmt.Errorf(
+			"unknown log sink type %q, must be one of file, syslog, loki or cloudwatch",
+			cfg.Type)
+	}
+}
+
+// logForwarder forwards the logs of instances to the log sinks. Every sink
+// has its own queue so that a slow or failing sink never blocks the
+// entrypoint log streams or the other sinks.
+type logForwarder struct {
+	sinks  []logSink
+	queues []chan *logForwardBatch
+	log    hclog.Logger
+}
+
+type logForwardBatch struct {
+	inst  *state.Instance
+	lines []*pb.LogBatch_Entry
+}
+
+func newLogForwarder(cfgs []*serverconfig.LogSink, log hclog.Logger) (*logForwarder, error) {
+	f := &logForwarder{log: log}
+	for _, cfg := range cfgs {
+		sink, err := newLogSink(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		f.sinks = append(f.sinks, sink)
+		f.queues = append(f.queues, make(chan *logForwardBatch, logSinkQueueSize))
+	}
+
+	return f, nil
+}
+
+// run forwards the queued lines to the sinks until the context is
+// cancelled.
+func (f *logForwarder) run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	var sinkWg sync.WaitGroup
+	for i, sink := range f.sinks {
+		sinkWg.Add(1)
+		go func(sink logSink, queue <-chan *logForwardBatch) {
+			defer sinkWg.Done()
+
+			log := f.log.With("sink", sink.String())
+			log.Info("starting")
+			defer log.Info("exiting")
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+
+				case batch := <-queue:
+					if err := sink.Write(ctx, batch.inst, batch.lines); err != nil {
+						log.Warn("error forwarding logs", "error", err,
+							"instance_id", batch.inst.Id)
+					}
+				}
+			}
+		}(sink, f.queues[i])
+	}
+
+	sinkWg.Wait()
+}
+
+// Append queues the lines of the instance for every sink.
+func (f *logForwarder) Append(inst *state.Instance, lines []*pb.LogBatch_Entry) {
+	if len(lines) == 0 {
+		return
+	}
+
+	batch := &logForwardBatch{inst: inst, lines: lines}
+	for i, queue := range f.queues {
+		select {
+		case queue <- batch:
+		default:
+			f.log.Warn("log sink is behind, dropping logs",
+				"sink", f.sinks[i].String(), "instance_id", inst.Id, "lines", len(lines))
+		}
+	}
+}
+
+// logSinkRecord is a line with the metadata of its instance. This is the
+// format of the lines written by the file sink.
+type logSinkRecord struct {
+	Time         time.Time `json:"time"`
+	Project      string    `json:"project"`
+	Application  string    `json:"application"`
+	Workspace    string    `json:"workspace"`
+	DeploymentId string    `json:"deployment_id"`
+	InstanceId   string    `json:"instance_id"`
+	Source       string    `json:"source"`
+	Line         string    `json:"line"`
+}
+
+func newLogSinkRecord(inst *state.Instance, entry *pb.LogBatch_Entry) *logSinkRecord {
+	ts, _ := ptypes.Timestamp(entry.Timestamp)
+	return &logSinkRecord{
+		Time:         ts,
+		Project:      inst.Project,
+		Application:  inst.Application,
+		Workspace:    inst.Workspace,
+		DeploymentId: inst.DeploymentId,
+		InstanceId:   inst.Id,
+		Source:       strings.ToLower(entry.Source.String()),
+		Line:         entry.Line,
+	}
+}
+
+// logSinkFile appends lines as JSON objects to a file.
+type logSinkFile struct {
+	path string
+}
+
+func (s *logSinkFile) Write(ctx context.Context, inst *state.Instance, lines []*pb.LogBatch_Entry) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, entry := range lines {
+		if err := enc.Encode(newLogSinkRecord(inst, entry)); err != nil {
+			return err
+		}
+	}
+
+	// We open the file for every write so that external log rotation
+	// works without signaling the server.
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func (s *logSinkFile) String() string {
+	return "file:" + s.path
+}
+
+// logSinkSyslog sends lines as RFC 5424 messages to a syslog server. The
+// app name of the messages is the application and the process ID is the
+// instance ID.
+type logSinkSyslog struct {
+	network string
+	addr    string
+
+	conn net.Conn
+}
+
+func newLogSinkSyslog(addr string) (*logSinkSyslog, error) {
+	u, err := url.Parse(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	switch u.Scheme {
+	case "udp", "tcp":
+		return &logSinkSyslog{network: u.Scheme, addr: u.Host}, nil
+
+	case "unix", "unixgram":
+		return &logSinkSyslog{network: u.Scheme, addr: u.Path}, nil
+
+	default:
+		return nil, fmt.Errorf(
+			"syslog address must be a udp://, tcp://, unix:// or unixgram:// URL")
+	}
+}
+
+// syslogPriority is the priority of the messages: the local0 facility
+// with the informational severity.
+const syslogPriority = 16*8 + 6
+
+func (s *logSinkSyslog) Write(ctx context.Context, inst *state.Instance, lines []*pb.LogBatch_Entry) error {
+	if s.conn == nil {
+		var d net.Dialer
+		conn, err := d.DialContext(ctx, s.network, s.addr)
+		if err != nil {
+			return err
+		}
+
+		s.conn = conn
+	}
+
+	for _, entry := range lines {
+		ts, _ := ptypes.Timestamp(entry.Timestamp)
+		msg := fmt.Sprintf("<%d>1 %s %s %s %s %s - %s\n",
+			syslogPriority,
+			ts.UTC().Format(time.RFC3339Nano),
+			inst.Project,
+			inst.Application,
+			inst.Id,
+			strings.ToLower(entry.Source.String()),
+			entry.Line,
+		)
+
+		if _, err := s.conn.Write([]byte(msg)); err != nil {
+			// Reconnect on the next write.
+			s.conn.Close()
+			s.conn = nil
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *logSinkSyslog) String() string {
+	return "syslog:" + s.network + "://" + s.addr
+}
+
+// logSinkLoki pushes lines to the Loki push API. Every instance is a
+// stream labeled with its project, application, workspace and instance.
+type logSinkLoki struct {
+	url    string
+	client *http.Client
+}
+
+func (s *logSinkLoki) Write(ctx context.Context, inst *state.Instance, lines []*pb.LogBatch_Entry) error {
+	type stream struct {
+		Stream map[string]string `json:"stream"`
+		Values [][2]string       `json:"values"`
+	}
+
+	st := stream{
+		Stream: map[string]string{
+			"project":     inst.Project,
+			"application": inst.Application,
+			"workspace":   inst.Workspace,
+			"instance_id": inst.Id,
+		},
+	}
+	for _, entry := range lines {
+		ts, _ := ptypes.Timestamp(entry.Timestamp)
+		st.Values = append(st.Values, [2]string{
+			strconv.FormatInt(ts.UnixNano(), 10),
+			entry.Line,
+		})
+	}
+
+	body, err := json.Marshal(map[string][]stream{"streams": {st}})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("loki returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (s *logSinkLoki) String() string {
+	return "loki:" + s.url
+}
+
+// logSinkCloudWatch puts lines to a CloudWatch Logs log group. Every
+// instance has its own log stream named after the application and
+// instance, which is created on the first write.
+type logSinkCloudWatch struct {
+	group   string
+	svc     *cloudwatchlogs.CloudWatchLogs
+	streams map[string]struct{}
+}
+
+func newLogSinkCloudWatch(group string) (*logSinkCloudWatch, error) {
+	sess, err := newAWSSession("")
+	if err != nil {
+		return nil, err
+	}
+
+	return &logSinkCloudWatch{
+		group:   group,
+		svc:     cloudwatchlogs.New(sess),
+		streams: map[string]struct{}{},
+	}, nil
+}
+
+func (s *logSinkCloudWatch) Write(ctx context.Context, inst *state.Instance, lines []*pb.LogBatch_Entry) error {
+	name := inst.Application + "/" + inst.Id
+	if _, ok := s.streams[name]; !ok {
+		_, err := s.svc.CreateLogStreamWithContext(ctx, &cloudwatchlogs.CreateLogStreamInput{
+			LogGroupName:  aws.String(s.group),
+			LogStreamName: aws.String(name),
+		})
+		if aerr, ok := err.(awserr.Error); ok &&
+			aerr.Code() == cloudwatchlogs.ErrCodeResourceAlreadyExistsException {
+			err = nil
+		}
+		if err != nil {
+			return err
+		}
+
+		s.streams[name] = struct{}{}
+	}
+
+	// CloudWatch requires the events of a put to be in time order and
+	// doesn't accept empty messages.
+	events := make([]*cloudwatchlogs.InputLogEvent, 0, len(lines))
+	for _, entry := range lines {
+		if entry.Line == "" {
+			continue
+		}
+
+		ts, _ := ptypes.Timestamp(entry.Timestamp)
+		events = append(events, &cloudwatchlogs.InputLogEvent{
+			Message:   aws.String(entry.Line),
+			Timestamp: aws.Int64(ts.UnixNano() / int64(time.Millisecond)),
+		})
+	}
+	if len(events) == 0 {
+		return nil
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return *events[i].Timestamp < *events[j].Timestamp
+	})
+
+	_, err := s.svc.PutLogEventsWithContext(ctx, &cloudwatchlogs.PutLogEventsInput{
+		LogGroupName:  aws.String(s.group),
+		LogStreamName: aws.String(name),
+		LogEvents:     events,
+	})
+
+	return err
+}
+
+func (s *logSinkCloudWatch) String() string {
+	return "cloudwatch:" + s.group
+}
@@ -0,0 +1,129 @@
+package singleprocess
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/server/singleprocess/state"
+	"github.com/hashicorp/waypoint/internal/serverconfig"
+)
+
+func TestLogSink(t *testing.T) {
+	ctx := context.Background()
+
+	inst := &state.Instance{
+		Id:           "I",
+		DeploymentId: "D",
+		Project:      "p",
+		Application:  "web",
+		Workspace:    "default",
+	}
+	ts, err := ptypes.TimestampProto(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	lines := []*pb.LogBatch_Entry{
+		{Line: "hello", Timestamp: ts},
+		{Line: "world", Timestamp: ts, Source: pb.LogBatch_Entry_ENTRYPOINT},
+	}
+
+	t.Run("file", func(t *testing.T) {
+		require := require.New(t)
+
+		dir, err := ioutil.TempDir("", "waypoint-test")
+		require.NoError(err)
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "app.log")
+
+		sink, err := newLogSink(&serverconfig.LogSink{Type: "file", Address: path})
+		require.NoError(err)
+		require.NoError(sink.Write(ctx, inst, lines))
+		require.NoError(sink.Write(ctx, inst, lines[:1]))
+
+		data, err := ioutil.ReadFile(path)
+		require.NoError(err)
+		records := strings.Split(strings.TrimSpace(string(data)), "\n")
+		require.Len(records, 3)
+
+		var rec logSinkRecord
+		require.NoError(json.Unmarshal([]byte(records[1]), &rec))
+		require.Equal("web", rec.Application)
+		require.Equal("I", rec.InstanceId)
+		require.Equal("entrypoint", rec.Source)
+		require.Equal("world", rec.Line)
+	})
+
+	t.Run("syslog", func(t *testing.T) {
+		require := require.New(t)
+
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(err)
+		defer conn.Close()
+
+		sink, err := newLogSink(&serverconfig.LogSink{
+			Type:    "syslog",
+			Address: "udp://" + conn.LocalAddr().String(),
+		})
+		require.NoError(err)
+		require.NoError(sink.Write(ctx, inst, lines[:1]))
+
+		buf := make([]byte, 1024)
+		require.NoError(conn.SetReadDeadline(time.Now().Add(5 * time.Second)))
+		n, _, err := conn.ReadFrom(buf)
+		require.NoError(err)
+		require.Equal(
+			"<134>1 2021-01-01T00:00:00Z p web I app - hello\n",
+			string(buf[:n]))
+	})
+
+	t.Run("loki", func(t *testing.T) {
+		require := require.New(t)
+
+		var mu sync.Mutex
+		var body map[string][]struct {
+			Stream map[string]string `json:"stream"`
+			Values [][2]string       `json:"values"`
+		}
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			require.Equal("/loki/api/v1/push", r.URL.Path)
+			require.NoError(json.NewDecoder(r.Body).Decode(&body))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer srv.Close()
+
+		sink, err := newLogSink(&serverconfig.LogSink{Type: "loki", Address: srv.URL})
+		require.NoError(err)
+		require.NoError(sink.Write(ctx, inst, lines))
+
+		mu.Lock()
+		defer mu.Unlock()
+		require.Len(body["streams"], 1)
+		stream := body["streams"][0]
+		require.Equal("web", stream.Stream["application"])
+		require.Equal("I", stream.Stream["instance_id"])
+		require.Len(stream.Values, 2)
+		require.Equal("hello", stream.Values[0][1])
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		_, err := newLogForwarder([]*serverconfig.LogSink{
+			{Type: "nope", Address: "foo"},
+		}, hclog.L())
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,153 @@
+package singleprocess
+
+import (
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/prometheus/client_golang/prometheus"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/server/singleprocess/state"
+)
+
+var (
+	metricJobs = prometheus.NewDesc(
+		"waypoint_jobs",
+		"Number of jobs that are queued, waiting to be acked, or running.",
+		[]string{"state"}, nil,
+	)
+
+	metricRunnersConnected = prometheus.NewDesc(
+		"waypoint_runners_connected",
+		"Number of runners that are connected to the server.",
+		nil, nil,
+	)
+
+	metricEntrypointsConnected = prometheus.NewDesc(
+		"waypoint_entrypoints_connected",
+		"Number of entrypoints that are connected to the server.",
+		nil, nil,
+	)
+
+	metricStateSize = prometheus.NewDesc(
+		"waypoint_state_db_size_bytes",
+		"Size of the state database on disk.",
+		nil, nil,
+	)
+)
+
+// newJobDurationMetric returns the histogram of the durations of jobs by
+// operation and result, from when a runner acks the job until it completes.
+func newJobDurationMetric() *prometheus.HistogramVec {
+	return prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "waypoint",
+		Subsystem: "job",
+		Name:      "duration_seconds",
+		Help:      "Duration of jobs from ack to completion.",
+		Buckets:   []float64{1, 5, 15, 30, 60, 120, 300, 600, 1200, 1800, 3600},
+	}, []string{"operation", "result"})
+}
+
+// Describe implements prometheus.Collector.
+func (s *service) Describe(ch chan<- *prometheus.Desc) {
+	ch <- metricJobs
+	ch <- metricRunnersConnected
+	ch <- metricEntrypointsConnected
+	ch <- metricStateSize
+	s.jobDuration.Describe(ch)
+}
+
+// Collect implements prometheus.Collector. The gauges are read from the
+// state when the metrics are scraped. Values that can't be read are
+// skipped for this scrape.
+func (s *service) Collect(ch chan<- prometheus.Metric) {
+	for _, st := range []pb.Job_State{pb.Job_QUEUED, pb.Job_WAITING, pb.Job_RUNNING} {
+		count, err := s.state.JobCountByState(st)
+		if err != nil {
+			continue
+		}
+
+		ch <- prometheus.MustNewConstMetric(metricJobs, prometheus.GaugeValue,
+			float64(count), strings.ToLower(st.String()))
+	}
+
+	if runners, err := s.state.RunnerList(); err == nil {
+		ch <- prometheus.MustNewConstMetric(metricRunnersConnected,
+			prometheus.GaugeValue, float64(len(runners)))
+	}
+
+	if count, err := s.state.InstanceCount(); err == nil {
+		ch <- prometheus.MustNewConstMetric(metricEntrypointsConnected,
+			prometheus.GaugeValue, float64(count))
+	}
+
+	if size, err := s.state.DBSize(); err == nil {
+		ch <- prometheus.MustNewConstMetric(metricStateSize,
+			prometheus.GaugeValue, float64(size))
+	}
+
+	s.jobDuration.Collect(ch)
+}
+
+// observeJobDuration records the duration of the job once it completes.
+// Jobs that were never acked have no duration and are ignored.
+func (s *service) observeJobDuration(job *state.Job, result string) {
+	if job.AckTime == nil {
+		return
+	}
+
+	ackTime, err := ptypes.Timestamp(job.AckTime)
+	if err != nil {
+		return
+	}
+
+	s.jobDuration.WithLabelValues(jobOperationLabel(job.Job), result).
+		Observe(time.Since(ackTime).Seconds())
+}
+
+// jobOperationLabel returns the operation of the job for metric labels.
+func jobOperationLabel(job *pb.Job) string {
+	switch job.Operation.(type) {
+	case *pb.Job_Noop_:
+		return "noop"
+	case *pb.Job_Build:
+		return "build"
+	case *pb.Job_Push:
+		return "push"
+	case *pb.Job_Deploy:
+		return "deploy"
+	case *pb.Job_Destroy:
+		return "destroy"
+	case *pb.Job_Release:
+		return "release"
+	case *pb.Job_Validate:
+		return "validate"
+	case *pb.Job_Auth:
+		return "auth"
+	case *pb.Job_Docs:
+		return "docs"
+	case *pb.Job_ConfigSync:
+		return "config_sync"
+	case *pb.Job_Exec:
+		return "exec"
+	case *pb.Job_Up:
+		return "up"
+	case *pb.Job_Logs:
+		return "logs"
+	case *pb.Job_QueueProject:
+		return "queue_project"
+	case *pb.Job_Poll:
+		return "poll"
+	case *pb.Job_StatusReport:
+		return "status_report"
+	case *pb.Job_StartTask:
+		return "start_task"
+	case *pb.Job_StopTask:
+		return "stop_task"
+	case *pb.Job_RunCommand:
+		return "run_command"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,52 @@
+package singleprocess
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/server"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestServiceMetrics(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	reg := prometheus.NewRegistry()
+	require.NoError(reg.Register(impl.(prometheus.Collector)))
+
+	// Queue a job
+	TestApp(t, client, serverptypes.TestJobNew(t, nil).Application)
+	_, err = client.QueueJob(ctx, &pb.QueueJobRequest{Job: serverptypes.TestJobNew(t, nil)})
+	require.NoError(err)
+
+	families, err := reg.Gather()
+	require.NoError(err)
+
+	values := map[string]float64{}
+	for _, fam := range families {
+		for _, m := range fam.Metric {
+			name := fam.GetName()
+			for _, l := range m.Label {
+				name += "/" + l.GetValue()
+			}
+			if g := m.Gauge; g != nil {
+				values[name] = g.GetValue()
+			}
+		}
+	}
+
+	require.Equal(float64(1), values["waypoint_jobs/queued"])
+	require.Equal(float64(0), values["waypoint_jobs/running"])
+	require.Contains(values, "waypoint_runners_connected")
+	require.Contains(values, "waypoint_entrypoints_connected")
+	require.NotZero(values["waypoint_state_db_size_bytes"])
+}
@@ -0,0 +1,267 @@
+package singleprocess
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/serverconfig"
+)
+
+// notification is an event sent to the notifiers of a project.
+type notification struct {
+	Project     string
+	Application string
+	Workspace   string
+	Event       pb.Notifier_Event
+	Message     string
+	Time        time.Time
+}
+
+// notify queues a notification for the notifiers of the project of app.
+// This never blocks. If too many notifications are queued, the
+// notification is dropped.
+func (s *service) notify(
+	log hclog.Logger,
+	app *pb.Ref_Application,
+	ws *pb.Ref_Workspace,
+	event pb.Notifier_Event,
+	format string,
+	args ...interface{},
+) {
+	if s.notifyCh == nil || app == nil {
+		return
+	}
+
+	n := &notification{
+		Project:     app.Project,
+		Application: app.Application,
+		Workspace:   ws.GetWorkspace(),
+		Event:       event,
+		Message:     fmt.Sprintf(format, args...),
+		Time:        time.Now(),
+	}
+
+	select {
+	case s.notifyCh <- n:
+	default:
+		log.Warn("too many queued notifications, dropping notification",
+			"project", n.Project, "event", event.String())
+	}
+}
+
+// notifyJobFailed sends a notification that the job failed.
+func (s *service) notifyJobFailed(log hclog.Logger, job *pb.Job, err error) {
+	// Polls and other jobs that the server queues in the background fail
+	// routinely for projects without a usable data source, so we only
+	// notify about the operations of apps.
+	var op string
+	switch job.Operation.(type) {
+	case *pb.Job_Build:
+		op = "build"
+	case *pb.Job_Push:
+		op = "push"
+	case *pb.Job_Deploy:
+		op = "deploy"
+	case *pb.Job_Destroy:
+		op = "destroy"
+	case *pb.Job_Release:
+		op = "release"
+	case *pb.Job_Up:
+		op = "up"
+	case *pb.Job_StatusReport:
+		op = "status report"
+	default:
+		return
+	}
+
+	s.notify(log, job.Application, job.Workspace, pb.Notifier_JOB_FAILED,
+		"%s job %s failed: %s", op, job.Id, status.Convert(err).Message())
+}
+
+// runNotifier sends the queued notifications to the notifiers of their
+// project until ctx is cancelled.
+func (s *service) runNotifier(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	log hclog.Logger,
+) {
+	defer wg.Done()
+
+	log.Info("starting")
+	defer log.Info("exiting")
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case n := <-s.notifyCh:
+			notifiers, err := s.state.NotifierList(&pb.Ref_Project{Project: n.Project})
+			if err != nil {
+				log.Warn("error listing notifiers", "project", n.Project, "err", err)
+				continue
+			}
+
+			for _, notifier := range notifiers {
+				if !notifierWants(notifier, n.Event) {
+					continue
+				}
+
+				err := sendNotification(ctx, client, s.smtp, notifier, n)
+				if err != nil {
+					log.Warn("error sending notification",
+						"project", n.Project, "notifier", notifier.Name, "err", err)
+				}
+
+				if err := s.notifierSetLastError(notifier, err); err != nil {
+					log.Warn("error updating notifier",
+						"project", n.Project, "notifier", notifier.Name, "err", err)
+				}
+			}
+		}
+	}
+}
+
+// notifierSetLastError records the result of the last notification sent
+// to the notifier.
+func (s *service) notifierSetLastError(notifier *pb.Notifier, sendErr error) error {
+	s.notifierMu.Lock()
+	defer s.notifierMu.Unlock()
+
+	// Reload the notifier in case it changed while sending.
+	current, err := s.state.NotifierGet(notifier.Project, notifier.Name)
+	if status.Code(err) == codes.NotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	lastError := ""
+	if sendErr != nil {
+		lastError = sendErr.Error()
+	}
+	if current.LastError == lastError {
+		return nil
+	}
+
+	current.LastError = lastError
+	return s.state.NotifierPut(current)
+}
+
+// notifierWants returns true if the notifier sends the event.
+func notifierWants(notifier *pb.Notifier, event pb.Notifier_Event) bool {
+	if len(notifier.Events) == 0 {
+		return true
+	}
+
+	for _, e := range notifier.Events {
+		if e == event {
+			return true
+		}
+	}
+
+	return false
+}
+
+// notifierEventString returns the name of an event as it is shown to
+// users, such as "health-down".
+func notifierEventString(event pb.Notifier_Event) string {
+	return strings.ReplaceAll(strings.ToLower(event.String()), "_", "-")
+}
+
+// sendNotification sends n to the notifier.
+func sendNotification(
+	ctx context.Context,
+	client *http.Client,
+	smtpConfig *serverconfig.SMTP,
+	notifier *pb.Notifier,
+	n *notification,
+) error {
+	subject := fmt.Sprintf("%s/%s (%s): %s",
+		n.Project, n.Application, n.Workspace, n.Message)
+
+	switch notifier.Type {
+	case pb.Notifier_SLACK:
+		return postJSON(ctx, client, notifier.Url, map[string]string{
+			"text": subject,
+		})
+
+	case pb.Notifier_WEBHOOK:
+		return postJSON(ctx, client, notifier.Url, map[string]string{
+			"project":     n.Project,
+			"application": n.Application,
+			"workspace":   n.Workspace,
+			"event":       notifierEventString(n.Event),
+			"message":     n.Message,
+			"time":        n.Time.UTC().Format(time.RFC3339),
+		})
+
+	case pb.Notifier_EMAIL:
+		if smtpConfig == nil || smtpConfig.Addr == "" {
+			return fmt.Errorf("the server has no SMTP server configured for email notifications")
+		}
+
+		var auth smtp.Auth
+		if smtpConfig.Username != "" {
+			host := smtpConfig.Addr
+			if idx := strings.LastIndexByte(host, ':'); idx >= 0 {
+				host = host[:idx]
+			}
+
+			auth = smtp.PlainAuth("", smtpConfig.Username, smtpConfig.Password, host)
+		}
+
+		var msg bytes.Buffer
+		fmt.Fprintf(&msg, "From: %s\r\n", smtpConfig.From)
+		fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(notifier.EmailTo, ", "))
+		fmt.Fprintf(&msg, "Subject: Waypoint: %s\r\n", subject)
+		fmt.Fprintf(&msg, "\r\n%s\r\n\r\nProject: %s\r\nApplication: %s\r\nWorkspace: %s\r\nEvent: %s\r\nTime: %s\r\n",
+			n.Message, n.Project, n.Application, n.Workspace,
+			notifierEventString(n.Event), n.Time.UTC().Format(time.RFC1123))
+
+		return smtp.SendMail(smtpConfig.Addr, auth, smtpConfig.From, notifier.EmailTo, msg.Bytes())
+
+	default:
+		return fmt.Errorf("unknown notifier type: %s", notifier.Type)
+	}
+}
+
+// postJSON POSTs the JSON encoding of v to url.
+func postJSON(ctx context.Context, client *http.Client, url string, v interface{}) error {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("%s returned status %d", url, resp.StatusCode)
+	}
+
+	return nil
+}
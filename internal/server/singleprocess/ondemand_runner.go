@@ -0,0 +1,312 @@
+package singleprocess
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-memdb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/internal/server"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+	"github.com/hashicorp/waypoint/internal/serverclient"
+)
+
+const (
+	// defaultOnDemandRunnerImage is the image of on-demand runners if
+	// their configuration doesn't set one.
+	defaultOnDemandRunnerImage = "hashicorp/waypoint:latest"
+
+	// onDemandRunnerTokenTTL is how long the token that an on-demand
+	// runner connects to the server with is valid for.
+	onDemandRunnerTokenTTL = 24 * time.Hour
+)
+
+// onDemandRunnerJobs returns the jobs to queue for the job. If the project
+// of the job uses an on-demand runner, the job is changed to target a new
+// runner by ID and this returns the job that launches that runner followed
+// by the job. Otherwise this only returns the job.
+func (s *service) onDemandRunnerJobs(ctx context.Context, job *pb.Job) ([]*pb.Job, error) {
+	// Jobs that launch or stop runners run on the existing runners, and
+	// jobs that target a runner by ID already have a runner.
+	switch job.Operation.(type) {
+	case *pb.Job_StartTask, *pb.Job_StopTask:
+		return []*pb.Job{job}, nil
+	}
+	if _, ok := job.TargetRunner.Target.(*pb.Ref_Runner_Id); ok {
+		return []*pb.Job{job}, nil
+	}
+
+	project, err := s.state.ProjectGet(&pb.Ref_Project{Project: job.Application.Project})
+	if err != nil {
+		return nil, err
+	}
+	if project.OndemandRunner == nil {
+		return []*pb.Job{job}, nil
+	}
+
+	config, err := s.state.OnDemandRunnerConfigGet(project.OndemandRunner)
+	if status.Code(err) == codes.NotFound {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"Project %q uses the on-demand runner configuration %q which doesn't exist.",
+			project.Name, project.OndemandRunner.Name,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	runnerId, err := server.Id()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "uuid generation failed: %s", err)
+	}
+
+	info, err := s.onDemandRunnerLaunchInfo(config, runnerId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The runner is launched by the runners that the job would have
+	// targeted, unless the configuration targets other runners.
+	target := job.TargetRunner
+	if len(config.TargetLabels) > 0 {
+		target = &pb.Ref_Runner{
+			Target: &pb.Ref_Runner_Labels{
+				Labels: &pb.Ref_RunnerLabels{
+					Labels: config.TargetLabels,
+				},
+			},
+		}
+	}
+
+	startId, err := server.Id()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "uuid generation failed: %s", err)
+	}
+
+	start := &pb.Job{
+		Id:           startId,
+		Application:  job.Application,
+		Workspace:    job.Workspace,
+		TargetRunner: target,
+		DataSource:   job.DataSource,
+		Priority:     job.Priority,
+		Operation: &pb.Job_StartTask{
+			StartTask: &pb.Job_StartTaskLaunchOp{
+				Info: info,
+				Params: &pb.Job_TaskPluginParams{
+					PluginType: config.PluginType,
+					HclConfig:  config.PluginConfig,
+					HclFormat:  config.ConfigFormat,
+				},
+			},
+		},
+	}
+
+	job.TargetRunner = &pb.Ref_Runner{
+		Target: &pb.Ref_Runner_Id{
+			Id: &pb.Ref_RunnerId{
+				Id: runnerId,
+			},
+		},
+	}
+	job.OnDemandRunner = &pb.Job_OnDemandRunner{
+		Config:     &pb.Ref_OnDemandRunnerConfig{Name: config.Name},
+		RunnerId:   runnerId,
+		StartJobId: start.Id,
+	}
+
+	return []*pb.Job{start, job}, nil
+}
+
+// onDemandRunnerLaunchInfo returns the task to launch for an on-demand
+// runner with the given ID. The runner connects to the server using the
+// advertise address of the server and a new token.
+func (s *service) onDemandRunnerLaunchInfo(
+	config *pb.OnDemandRunnerConfig,
+	runnerId string,
+) (*pb.TaskLaunchInfo, error) {
+	cfg, err := s.state.ServerConfigGet()
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil || len(cfg.AdvertiseAddrs) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"The server has no advertise address configured, which on-demand "+
+				"runners require to connect to the server.")
+	}
+	addr := cfg.AdvertiseAddrs[0]
+
+	token, err := s.newToken(onDemandRunnerTokenTTL, DefaultKeyId, nil, &pb.Token{
+		Kind: &pb.Token_Login_{
+			Login: &pb.Token_Login{
+				UserId: DefaultUserId,
+				Roles:  []string{serverptypes.RoleOperator},
+
+				// The server launched the runner so it doesn't have to
+				// be adopted.
+				RunnerAdoption: &pb.Token_RunnerAdoption{},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	env := map[string]string{}
+	for k, v := range config.EnvironmentVariables {
+		env[k] = v
+	}
+	env[serverclient.EnvServerAddr] = addr.Addr
+	env[serverclient.EnvServerTls] = strconv.FormatBool(addr.Tls)
+	env[serverclient.EnvServerTlsSkipVerify] = strconv.FormatBool(addr.TlsSkipVerify)
+	env[serverclient.EnvServerToken] = token
+
+	image := config.OciUrl
+	if image == "" {
+		image = defaultOnDemandRunnerImage
+	}
+
+	return &pb.TaskLaunchInfo{
+		OciUrl:               image,
+		EnvironmentVariables: env,
+		Arguments:            []string{"runner", "agent", "-id=" + runnerId, "-ondemand"},
+	}, nil
+}
+
+// runOnDemandRunnerWatcher stops the on-demand runners of jobs once the
+// jobs complete, and cancels the jobs whose runner failed to launch.
+// This blocks and is expected to be run in a goroutine.
+func (s *service) runOnDemandRunnerWatcher(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	log hclog.Logger,
+) {
+	defer wg.Done()
+
+	log.Info("starting")
+	defer log.Info("exiting")
+
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		ws := memdb.NewWatchSet()
+		jobs, err := s.state.JobListOnDemandRunner(ws)
+		if err != nil {
+			// This should never happen. We log it and sleep so that we
+			// don't spin since the error will probably keep happening.
+			log.Error("BUG (please report): error listing jobs, sleeping 1 minute", "err", err)
+
+			select {
+			case <-time.After(1 * time.Minute):
+			case <-ctx.Done():
+			}
+
+			continue
+		}
+
+		for _, job := range jobs {
+			jlog := log.With("job_id", job.Id, "runner_id", job.OnDemandRunner.RunnerId)
+			if err := s.onDemandRunnerAdvance(jlog, job, ws); err != nil {
+				jlog.Warn("error updating on-demand runner", "err", err)
+			}
+		}
+
+		log.Trace("waiting on job changes")
+		if err := ws.WatchCtx(ctx); err != nil {
+			return
+		}
+	}
+}
+
+// onDemandRunnerAdvance queues the job that stops the on-demand runner of
+// the job once the job completes. If the runner failed to launch, the job
+// is cancelled instead since no runner would ever be assigned it. The jobs
+// that are still running are added to ws.
+func (s *service) onDemandRunnerAdvance(
+	log hclog.Logger,
+	job *pb.Job,
+	ws memdb.WatchSet,
+) error {
+	odr := proto.Clone(job.OnDemandRunner).(*pb.Job_OnDemandRunner)
+
+	current, err := s.state.JobById(job.Id, ws)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return nil
+	}
+	completed := current.State == pb.Job_SUCCESS || current.State == pb.Job_ERROR
+
+	start, err := s.state.JobById(odr.StartJobId, ws)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case start == nil:
+		// The job that launched the runner was pruned, so we don't know
+		// how to stop the runner anymore.
+		log.Warn("job that launched the on-demand runner not found")
+
+	case start.State == pb.Job_ERROR:
+		if !completed {
+			log.Warn("on-demand runner failed to launch, cancelling job")
+			if err := s.state.JobCancel(job.Id, false); err != nil {
+				return err
+			}
+		}
+
+	case start.State != pb.Job_SUCCESS:
+		// The runner is still launching. If the job already completed,
+		// for example because it was cancelled, the runner isn't needed.
+		if completed {
+			log.Debug("job completed before its on-demand runner launched")
+			return s.state.JobCancel(start.Id, false)
+		}
+
+		return nil
+
+	case !completed:
+		return nil
+
+	default:
+		stopId, err := server.Id()
+		if err != nil {
+			return status.Errorf(codes.Internal, "uuid generation failed: %s", err)
+		}
+
+		// The runner is stopped with the same plugin it was launched with.
+		stop := &pb.Job{
+			Id:           stopId,
+			Application:  start.Application,
+			Workspace:    start.Workspace,
+			TargetRunner: start.TargetRunner,
+			DataSource:   start.DataSource,
+			Operation: &pb.Job_StopTask{
+				StopTask: &pb.Job_StopTaskLaunchOp{
+					State:  start.Result.GetStartTask().GetState(),
+					Params: start.GetStartTask().GetParams(),
+				},
+			},
+		}
+		if err := s.state.JobCreate(stop); err != nil {
+			return err
+		}
+
+		log.Debug("queued job to stop on-demand runner", "stop_job_id", stop.Id)
+		odr.StopJobId = stop.Id
+	}
+
+	odr.Complete = true
+	return s.state.JobUpdateOnDemandRunner(job.Id, odr)
+}
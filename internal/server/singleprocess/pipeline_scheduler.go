@@ -0,0 +1,307 @@
+package singleprocess
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-memdb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// runPipelineScheduler advances the active pipeline runs. Whenever a run
+// or the job of one of its running steps changes, this updates the state
+// of the run and queues the jobs for the steps that are ready to start.
+// This blocks and is expected to be run in a goroutine.
+//
+// This function should only ever be invoked one at a time. Running multiple
+// copies can result in duplicate jobs for steps.
+func (s *service) runPipelineScheduler(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	log hclog.Logger,
+) {
+	defer wg.Done()
+
+	log.Info("starting")
+	defer log.Info("exiting")
+
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		ws := memdb.NewWatchSet()
+		runs, err := s.state.PipelineRunListActive(ws)
+		if err != nil {
+			// This should never happen. We log it and sleep so that we
+			// don't spin since the error will probably keep happening.
+			log.Error("BUG (please report): error listing pipeline runs, sleeping 1 minute", "err", err)
+
+			select {
+			case <-time.After(1 * time.Minute):
+			case <-ctx.Done():
+			}
+
+			continue
+		}
+
+		for _, run := range runs {
+			if err := s.pipelineRunAdvance(ctx, log, run.Id, ws); err != nil {
+				log.Warn("error advancing pipeline run", "run_id", run.Id, "err", err)
+			}
+		}
+
+		log.Trace("waiting on pipeline run changes")
+		if err := ws.WatchCtx(ctx); err != nil {
+			return
+		}
+	}
+}
+
+// pipelineRunAdvance updates the steps of the run from their jobs, queues
+// the jobs for the steps that are ready, and stores the run if anything
+// changed. The jobs of running steps are added to ws.
+func (s *service) pipelineRunAdvance(
+	ctx context.Context,
+	log hclog.Logger,
+	id string,
+	ws memdb.WatchSet,
+) error {
+	s.pipelineRunMu.Lock()
+	defer s.pipelineRunMu.Unlock()
+
+	run, err := s.state.PipelineRunGet(id)
+	if err != nil {
+		return err
+	}
+
+	log = log.With("run_id", run.Id)
+	original := proto.Clone(run)
+
+	for _, step := range run.Steps {
+		if step.State != pb.PipelineRun_RUNNING {
+			continue
+		}
+
+		job, err := s.state.JobById(step.JobId, ws)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case job == nil:
+			step.State = pb.PipelineRun_ERROR
+			step.Error = status.Newf(codes.NotFound,
+				"job %s for the step was not found", step.JobId).Proto()
+
+		case job.State == pb.Job_SUCCESS:
+			step.State = pb.PipelineRun_SUCCESS
+
+		case job.State == pb.Job_ERROR:
+			step.State = pb.PipelineRun_ERROR
+			step.Error = job.Error
+		}
+	}
+
+	// Build the jobs for the steps that are ready. If we can't build the
+	// job for a step, such as a deploy with nothing built, the step fails.
+	// Approval steps don't have a job, they wait to be approved instead.
+	var reqs []*pb.QueueJobRequest
+	var queued []*pb.PipelineRun_Step
+	for _, idx := range pipelineRunNext(run) {
+		step := run.Steps[idx]
+		if run.Pipeline.Steps[idx].Op == pb.Pipeline_APPROVAL {
+			log.Debug("step is waiting for approval", "step", step.Name)
+			step.State = pb.PipelineRun_WAITING_APPROVAL
+			continue
+		}
+
+		job, err := s.pipelineStepJob(run, run.Pipeline.Steps[idx])
+		if err != nil {
+			step.State = pb.PipelineRun_ERROR
+			step.Error = status.Convert(err).Proto()
+			continue
+		}
+
+		reqs = append(reqs, &pb.QueueJobRequest{Job: job})
+		queued = append(queued, step)
+	}
+
+	if len(reqs) > 0 {
+		resps, err := s.queueJobMulti(ctx, reqs)
+		for i, step := range queued {
+			if err != nil {
+				step.State = pb.PipelineRun_ERROR
+				step.Error = status.Convert(err).Proto()
+				continue
+			}
+
+			log.Debug("queued job for step", "step", step.Name, "job_id", resps[i].JobId)
+			step.State = pb.PipelineRun_RUNNING
+			step.JobId = resps[i].JobId
+		}
+	}
+
+	// Steps may have failed, started, or begun waiting for approval above
+	// which can change the state of the run. Nothing new is ready at this
+	// point since those steps must succeed before anything else can start.
+	pipelineRunNext(run)
+
+	if proto.Equal(original, run) {
+		return nil
+	}
+
+	log.Debug("updating pipeline run", "state", run.State.String())
+	return s.state.PipelineRunUpdate(run)
+}
+
+// pipelineStepJob returns the job to queue for a step of the run.
+func (s *service) pipelineStepJob(
+	run *pb.PipelineRun,
+	step *pb.Pipeline_Step,
+) (*pb.Job, error) {
+	job := proto.Clone(run.JobTemplate).(*pb.Job)
+	job.Application = step.Application
+	job.Workspace = run.Workspace
+
+	switch step.Op {
+	case pb.Pipeline_BUILD:
+		job.Operation = &pb.Job_Build{
+			Build: &pb.Job_BuildOp{},
+		}
+
+	case pb.Pipeline_DEPLOY:
+		artifact, err := s.state.ArtifactLatest(step.Application, run.Workspace)
+		if err != nil {
+			return nil, err
+		}
+
+		job.Operation = &pb.Job_Deploy{
+			Deploy: &pb.Job_DeployOp{
+				Artifact: artifact,
+			},
+		}
+
+	case pb.Pipeline_RELEASE:
+		deployment, err := s.state.DeploymentLatest(step.Application, run.Workspace)
+		if err != nil {
+			return nil, err
+		}
+
+		job.Operation = &pb.Job_Release{
+			Release: &pb.Job_ReleaseOp{
+				Deployment: deployment,
+				Prune:      true,
+			},
+		}
+
+	case pb.Pipeline_UP:
+		job.Operation = &pb.Job_Up{
+			Up: &pb.Job_UpOp{
+				Release: &pb.Job_ReleaseOp{
+					Prune: true,
+				},
+			},
+		}
+
+	case pb.Pipeline_EXEC:
+		job.Operation = &pb.Job_RunCommand{
+			RunCommand: &pb.Job_RunCommandOp{
+				Command: step.Command,
+			},
+		}
+
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"unknown pipeline step operation: %s", step.Op.String())
+	}
+
+	return job, nil
+}
+
+// pipelineRunNext updates the state of the run from the state of its
+// steps and returns the indexes of the pending steps that are ready to
+// start. Once any step fails, no more steps are started and all the
+// pending steps and steps waiting for approval are skipped. The run
+// completes when no steps are left running.
+func pipelineRunNext(run *pb.PipelineRun) []int {
+	states := map[string]pb.PipelineRun_State{}
+	var failed, running, waiting bool
+	var succeeded int
+	for _, step := range run.Steps {
+		states[step.Name] = step.State
+
+		switch step.State {
+		case pb.PipelineRun_ERROR:
+			failed = true
+		case pb.PipelineRun_RUNNING:
+			running = true
+		case pb.PipelineRun_WAITING_APPROVAL:
+			waiting = true
+		case pb.PipelineRun_SUCCESS:
+			succeeded++
+		}
+	}
+
+	if failed {
+		for _, step := range run.Steps {
+			if step.State == pb.PipelineRun_PENDING ||
+				step.State == pb.PipelineRun_WAITING_APPROVAL {
+				step.State = pb.PipelineRun_SKIPPED
+			}
+		}
+
+		if !running {
+			pipelineRunComplete(run, pb.PipelineRun_ERROR)
+		}
+
+		return nil
+	}
+
+	if succeeded == len(run.Steps) {
+		pipelineRunComplete(run, pb.PipelineRun_SUCCESS)
+		return nil
+	}
+
+	var result []int
+	for i, step := range run.Pipeline.Steps {
+		if run.Steps[i].State != pb.PipelineRun_PENDING {
+			continue
+		}
+
+		ready := true
+		for _, dep := range step.DependsOn {
+			if states[dep] != pb.PipelineRun_SUCCESS {
+				ready = false
+				break
+			}
+		}
+
+		if ready {
+			result = append(result, i)
+		}
+	}
+
+	switch {
+	case running || len(result) > 0:
+		run.State = pb.PipelineRun_RUNNING
+	case waiting:
+		run.State = pb.PipelineRun_WAITING_APPROVAL
+	}
+
+	return result
+}
+
+func pipelineRunComplete(run *pb.PipelineRun, state pb.PipelineRun_State) {
+	run.State = state
+	if run.CompleteTime == nil {
+		run.CompleteTime = ptypes.TimestampNow()
+	}
+}
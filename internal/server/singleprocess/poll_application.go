@@ -20,7 +20,7 @@ import (
 type applicationPoll struct {
 	// state is the state management interface that provides functions for
 	// safely mutating server state.
-	state *state.State
+	state state.Interface
 
 	// the workspace to check for polling applications and running their status on
 	// Currently, the application poll handler is only expected to work for default
@@ -174,6 +174,9 @@ func (a *applicationPoll) buildPollJob(
 			// Generate a status report
 			Operation: statusReportJob,
 
+			// Polling is in the background so interactive jobs go first.
+			Priority: pb.Job_LOW,
+
 			// Any runner is fine for polling.
 			TargetRunner: &pb.Ref_Runner{
 				Target: &pb.Ref_Runner_Any{
@@ -20,7 +20,7 @@ import (
 type projectPoll struct {
 	// state is the state management interface that provides functions for
 	// safely mutating server state.
-	state *state.State
+	state state.Interface
 }
 
 // Peek returns the latest project to poll on
@@ -78,6 +78,9 @@ func (pp *projectPoll) PollJob(
 				Poll: &pb.Job_PollOp{},
 			},
 
+			// Polling is in the background so interactive jobs go first.
+			Priority: pb.Job_LOW,
+
 			// Any runner is fine for polling.
 			TargetRunner: &pb.Ref_Runner{
 				Target: &pb.Ref_Runner_Any{
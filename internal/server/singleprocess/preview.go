@@ -0,0 +1,311 @@
+package singleprocess
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-memdb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// previewQueue queues the jobs to deploy or destroy the preview of a pull
+// request for the project and stores the preview. This returns nil if the
+// pull request closed and never had a preview.
+func (s *service) previewQueue(
+	ctx context.Context,
+	log hclog.Logger,
+	project *pb.Project,
+	pr *webhookPullRequest,
+) (*pb.Preview, error) {
+	s.previewMu.Lock()
+	defer s.previewMu.Unlock()
+
+	ref := &pb.Ref_Project{Project: project.Name}
+	preview, err := s.state.PreviewGet(ref, pr.Number)
+	if status.Code(err) == codes.NotFound {
+		if pr.Closed {
+			return nil, nil
+		}
+
+		err = nil
+		preview = &pb.Preview{
+			Project: ref,
+			Number:  pr.Number,
+			Workspace: &pb.Ref_Workspace{
+				Workspace: fmt.Sprintf("pr-%d", pr.Number),
+			},
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	template := &pb.Job{Workspace: preview.Workspace}
+	if pr.Closed {
+		preview.State = pb.Preview_DESTROYING
+		template.Operation = &pb.Job_Destroy{
+			Destroy: &pb.Job_DestroyOp{
+				Target: &pb.Job_DestroyOp_Workspace{
+					Workspace: &empty.Empty{},
+				},
+			},
+		}
+	} else {
+		preview.State = pb.Preview_DEPLOYING
+		preview.Commit = pr.Target.Commit
+		preview.Url = ""
+		template.Operation = &pb.Job_Up{
+			Up: &pb.Job_UpOp{
+				Release: &pb.Job_ReleaseOp{
+					Prune: true,
+				},
+			},
+		}
+	}
+
+	resps, err := s.queueJobMulti(ctx, []*pb.QueueJobRequest{
+		webhookJob(project, pr.Target.Commit,
+			fmt.Sprintf("preview/%s/%d", project.Name, pr.Number),
+			template,
+		),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("queued job for preview",
+		"project", project.Name, "job_id", resps[0].JobId, "state", preview.State.String())
+	preview.JobId = resps[0].JobId
+	preview.Error = nil
+	preview.CommentUrl = pr.CommentURL
+	preview.UpdateTime = ptypes.TimestampNow()
+	if err := s.state.PreviewPut(preview); err != nil {
+		return nil, err
+	}
+
+	return preview, nil
+}
+
+// runPreviewWatcher completes the previews that are deploying or being
+// destroyed once their jobs finish, and comments the result on the pull
+// request if the project has a provider token.
+// This blocks and is expected to be run in a goroutine.
+func (s *service) runPreviewWatcher(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	log hclog.Logger,
+) {
+	defer wg.Done()
+
+	log.Info("starting")
+	defer log.Info("exiting")
+
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		ws := memdb.NewWatchSet()
+		previews, err := s.state.PreviewListActive(ws)
+		if err != nil {
+			// This should never happen. We log it and sleep so that we
+			// don't spin since the error will probably keep happening.
+			log.Error("BUG (please report): error listing previews, sleeping 1 minute", "err", err)
+
+			select {
+			case <-time.After(1 * time.Minute):
+			case <-ctx.Done():
+			}
+
+			continue
+		}
+
+		for _, preview := range previews {
+			plog := log.With("project", preview.Project.Project, "number", preview.Number)
+			done, err := s.previewAdvance(plog, preview.Project, preview.Number, ws)
+			if err != nil {
+				plog.Warn("error updating preview", "err", err)
+				continue
+			}
+			if done == nil {
+				continue
+			}
+
+			if err := s.previewComment(ctx, done); err != nil {
+				plog.Warn("error commenting on pull request", "err", err)
+			}
+		}
+
+		log.Trace("waiting on preview changes")
+		if err := ws.WatchCtx(ctx); err != nil {
+			return
+		}
+	}
+}
+
+// previewAdvance completes the preview if its jobs have finished. The
+// jobs that are still running are added to ws. This returns the preview
+// if it was completed.
+func (s *service) previewAdvance(
+	log hclog.Logger,
+	ref *pb.Ref_Project,
+	number uint64,
+	ws memdb.WatchSet,
+) (*pb.Preview, error) {
+	s.previewMu.Lock()
+	defer s.previewMu.Unlock()
+
+	// Get the latest preview since a webhook may have queued new jobs.
+	preview, err := s.state.PreviewGet(ref, number)
+	if err != nil {
+		return nil, err
+	}
+	if preview.State != pb.Preview_DEPLOYING && preview.State != pb.Preview_DESTROYING {
+		return nil, nil
+	}
+
+	// The job queues a job for each app. We wait for it and then for each
+	// of the jobs it queued.
+	jobIds := []string{preview.JobId}
+	var url string
+	var jobErr *pb.Job
+	for i := 0; i < len(jobIds); i++ {
+		job, err := s.state.JobById(jobIds[i], ws)
+		if err != nil {
+			return nil, err
+		}
+		if job == nil {
+			return nil, status.Errorf(codes.NotFound, "job %s for the preview was not found", jobIds[i])
+		}
+
+		switch job.State {
+		case pb.Job_SUCCESS:
+		case pb.Job_ERROR:
+			if jobErr == nil {
+				jobErr = job.Job
+			}
+			continue
+		default:
+			// Still running
+			return nil, nil
+		}
+
+		if result := job.Result.GetQueueProject(); result != nil {
+			for _, app := range result.Applications {
+				jobIds = append(jobIds, app.JobId)
+			}
+		}
+
+		// The first URL of the apps is the URL of the preview.
+		if up := job.Result.GetUp(); up != nil && url == "" {
+			for _, v := range []string{up.AppUrl, up.ReleaseUrl, up.DeployUrl} {
+				if v != "" {
+					url = v
+					break
+				}
+			}
+		}
+	}
+
+	switch {
+	case jobErr != nil:
+		preview.State = pb.Preview_ERROR
+		preview.Error = jobErr.Error
+		if preview.Error == nil {
+			preview.Error = status.Newf(codes.Unknown, "job %s failed", jobErr.Id).Proto()
+		}
+
+	case preview.State == pb.Preview_DEPLOYING:
+		preview.State = pb.Preview_READY
+		preview.Url = url
+
+	default:
+		preview.State = pb.Preview_DESTROYED
+		preview.Url = ""
+	}
+
+	log.Debug("preview complete", "state", preview.State.String())
+	preview.UpdateTime = ptypes.TimestampNow()
+	if err := s.state.PreviewPut(preview); err != nil {
+		return nil, err
+	}
+
+	return proto.Clone(preview).(*pb.Preview), nil
+}
+
+// previewComment comments the state of the preview on its pull request.
+// This does nothing if the project doesn't have a provider token.
+func (s *service) previewComment(ctx context.Context, preview *pb.Preview) error {
+	if preview.CommentUrl == "" {
+		return nil
+	}
+
+	project, err := s.state.ProjectGet(preview.Project)
+	if err != nil {
+		return err
+	}
+	if project.Webhook == nil || project.Webhook.ProviderToken == "" {
+		return nil
+	}
+
+	var msg string
+	switch preview.State {
+	case pb.Preview_READY:
+		msg = fmt.Sprintf("Waypoint deployed a preview of %s to the workspace `%s`.",
+			preview.Commit, preview.Workspace.Workspace)
+		if preview.Url != "" {
+			msg += fmt.Sprintf("\n\nPreview: %s", preview.Url)
+		}
+
+	case pb.Preview_ERROR:
+		msg = fmt.Sprintf("Waypoint failed to update the preview in the workspace `%s`: %s",
+			preview.Workspace.Workspace, preview.Error.GetMessage())
+
+	case pb.Preview_DESTROYED:
+		msg = fmt.Sprintf("Waypoint destroyed the preview in the workspace `%s`.",
+			preview.Workspace.Workspace)
+
+	default:
+		return nil
+	}
+
+	body, err := json.Marshal(map[string]string{"body": msg})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, "POST", preview.CommentUrl, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	// GitHub and GitLab both accept API tokens as bearer tokens.
+	req.Header.Set("Authorization", "Bearer "+project.Webhook.ProviderToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status commenting on pull request: %s", resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package singleprocess
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/server"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestServicePreview(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Record the comments on the pull request
+	comments := make(chan string, 10)
+	commentSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal("Bearer token", r.Header.Get("Authorization"))
+
+		var body struct{ Body string }
+		require.NoError(json.NewDecoder(r.Body).Decode(&body))
+		comments <- body.Body
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer commentSrv.Close()
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+	st := testServiceImpl(impl).state
+
+	// Create a project with previews
+	_, err = client.UpsertProject(ctx, &pb.UpsertProjectRequest{
+		Project: serverptypes.TestProject(t, &pb.Project{
+			Name: "Example",
+			DataSource: &pb.Job_DataSource{
+				Source: &pb.Job_DataSource_Git{
+					Git: &pb.Job_Git{
+						Url: "https://github.com/hashicorp/example.git",
+					},
+				},
+			},
+			Webhook: &pb.Project_Webhook{
+				Enabled:       true,
+				Secret:        "hunter2",
+				Previews:      true,
+				ProviderToken: "token",
+			},
+		}),
+	})
+	require.NoError(err)
+
+	// send sends a pull request webhook with the given action.
+	handler := impl.(server.WebhookHandler)
+	send := func(action string) {
+		body := []byte(fmt.Sprintf(`{
+  "action": %q,
+  "number": 7,
+  "pull_request": {
+    "comments_url": %q,
+    "head": {"sha": "abc123"},
+    "base": {"ref": "main"}
+  },
+  "repository": {
+    "clone_url": "https://github.com/hashicorp/example.git",
+    "default_branch": "main"
+  }
+}`, action, commentSrv.URL))
+
+		mac := hmac.New(sha256.New, []byte("hunter2"))
+		mac.Write(body)
+
+		r := httptest.NewRequest("POST", server.WebhookPath+"github", bytes.NewReader(body))
+		r.Header.Set("X-GitHub-Event", "pull_request")
+		r.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+
+		w := httptest.NewRecorder()
+		handler.ServeWebhook(w, r)
+		require.Equal(http.StatusAccepted, w.Code)
+	}
+
+	// completeJob runs the next job as if it were a runner.
+	completeJob := func() *pb.Job {
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+
+		job, err := st.JobAssignForRunner(ctx, &pb.Runner{Id: "R_A"})
+		require.NoError(err)
+		_, err = st.JobAck(job.Id, true)
+		require.NoError(err)
+		require.NoError(st.JobComplete(job.Id, &pb.Job_Result{
+			QueueProject: &pb.Job_QueueProjectResult{},
+		}, nil))
+		return job.Job
+	}
+
+	// waitPreview waits for the preview to reach the given state.
+	waitPreview := func(state pb.Preview_State) *pb.Preview {
+		var preview *pb.Preview
+		require.Eventually(func() bool {
+			resp, err := client.ListPreviews(ctx, &pb.ListPreviewsRequest{
+				Project: &pb.Ref_Project{Project: "Example"},
+			})
+			require.NoError(err)
+			require.Len(resp.Previews, 1)
+			preview = resp.Previews[0]
+			return preview.State == state
+		}, 5*time.Second, 10*time.Millisecond)
+		return preview
+	}
+
+	// Opening the pull request deploys to its workspace
+	send("opened")
+	preview := waitPreview(pb.Preview_DEPLOYING)
+	require.Equal("pr-7", preview.Workspace.Workspace)
+	job := completeJob()
+	require.Equal(preview.JobId, job.Id)
+	require.Equal("pr-7", job.Workspace.Workspace)
+	require.Equal("abc123", job.DataSourceOverrides["ref"])
+	template := job.Operation.(*pb.Job_QueueProject).QueueProject.JobTemplate
+	require.IsType((*pb.Job_Up)(nil), template.Operation)
+
+	waitPreview(pb.Preview_READY)
+	require.Contains(<-comments, "deployed a preview of abc123")
+
+	// Closing the pull request destroys the workspace
+	send("closed")
+	waitPreview(pb.Preview_DESTROYING)
+	job = completeJob()
+	template = job.Operation.(*pb.Job_QueueProject).QueueProject.JobTemplate
+	require.IsType((*pb.Job_Destroy)(nil), template.Operation)
+
+	waitPreview(pb.Preview_DESTROYED)
+	require.Contains(<-comments, "destroyed the preview")
+}
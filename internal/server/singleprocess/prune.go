@@ -6,8 +6,57 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/server/singleprocess/state"
 )
 
+func (s *service) PruneState(
+	ctx context.Context,
+	req *pb.PruneStateRequest,
+) (*pb.PruneStateResponse, error) {
+	if req.KeepLast < 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"keep_last must not be negative")
+	}
+
+	policy := &state.RetentionPolicy{KeepLast: int(req.KeepLast)}
+	if req.MaxAge != "" {
+		d, err := time.ParseDuration(req.MaxAge)
+		if err != nil || d < 0 {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"max_age must be a positive duration such as \"720h\"")
+		}
+
+		policy.MaxAge = d
+	}
+
+	// Use the configured policy if none was given
+	if policy.KeepLast == 0 && policy.MaxAge == 0 {
+		if s.retention == nil {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"the server has no retention policy configured, so keep_last "+
+					"or max_age must be set")
+		}
+
+		policy = s.retention
+	}
+
+	deleted, err := s.state.PruneRetention(policy, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.PruneStateResponse{Deleted: map[string]int32{}}
+	for k, v := range deleted {
+		resp.Deleted[k] = int32(v)
+	}
+
+	return resp, nil
+}
+
 func (s *service) runPrune(
 	ctx context.Context,
 	wg *sync.WaitGroup,
@@ -30,6 +79,16 @@ func (s *service) runPrune(
 			if err != nil {
 				funclog.Error("error pruning data", "error", err)
 			}
+
+			// If we have a retention policy, delete the old records too.
+			if s.retention != nil {
+				deleted, err := s.state.PruneRetention(s.retention, false)
+				if err != nil {
+					funclog.Error("error pruning data by retention policy", "error", err)
+				} else {
+					funclog.Debug("pruned data by retention policy", "deleted", deleted)
+				}
+			}
 		}
 	}
 }
@@ -0,0 +1,54 @@
+package singleprocess
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestServicePruneState(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	s := impl.(*service)
+
+	// Create some builds
+	for _, id := range []string{"A", "B", "C"} {
+		require.NoError(s.state.BuildPut(false, serverptypes.TestValidBuild(t, &pb.Build{
+			Id: id,
+		})))
+	}
+
+	// No policy given and none configured
+	_, err = impl.PruneState(ctx, &pb.PruneStateRequest{})
+	require.Error(err)
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+
+	// Invalid max age
+	_, err = impl.PruneState(ctx, &pb.PruneStateRequest{MaxAge: "nope"})
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	// Dry run
+	resp, err := impl.PruneState(ctx, &pb.PruneStateRequest{KeepLast: 1, DryRun: true})
+	require.NoError(err)
+	require.Equal(int32(2), resp.Deleted["build"])
+
+	// Prune
+	resp, err = impl.PruneState(ctx, &pb.PruneStateRequest{KeepLast: 1})
+	require.NoError(err)
+	require.Equal(int32(2), resp.Deleted["build"])
+
+	// Nothing left to prune
+	resp, err = impl.PruneState(ctx, &pb.PruneStateRequest{KeepLast: 1})
+	require.NoError(err)
+	require.Equal(int32(0), resp.Deleted["build"])
+}
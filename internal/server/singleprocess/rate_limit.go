@@ -0,0 +1,117 @@
+package singleprocess
+
+import (
+	"context"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// rateLimitCleanupInterval is how often buckets that have refilled are
+// removed so that the limiter doesn't grow with every user ever seen.
+const rateLimitCleanupInterval = 5 * time.Minute
+
+// RateLimitRequest implements the server.RateLimiter interface. Requests
+// are limited per user or service account of the login token. Requests
+// without a token, with entrypoint tokens, and runner and entrypoint APIs
+// are never limited since they aren't made by users.
+func (s *service) RateLimitRequest(ctx context.Context, endpoint string) error {
+	if s.rateLimiter == nil {
+		return nil
+	}
+
+	if strings.HasPrefix(endpoint, "Runner") || strings.HasPrefix(endpoint, "Entrypoint") {
+		return nil
+	}
+
+	login := tokenFromContext(ctx).GetLogin()
+	if login == nil || login.Entrypoint != nil {
+		return nil
+	}
+
+	key := login.UserId
+	if id := login.ServiceAccountId; id != "" {
+		key = id
+	}
+
+	if wait := s.rateLimiter.take(key); wait > 0 {
+		return status.Errorf(codes.ResourceExhausted,
+			"API rate limit exceeded, retry in %s", wait.Round(time.Millisecond))
+	}
+
+	return nil
+}
+
+// rateLimiter is a token bucket rate limiter with a bucket per key.
+type rateLimiter struct {
+	mu      sync.Mutex
+	rate    float64 // tokens added per second
+	burst   float64 // bucket size
+	buckets map[string]*rateLimitBucket
+
+	lastCleanup time.Time
+
+	// now returns the current time. This can be replaced in tests.
+	now func() time.Time
+}
+
+type rateLimitBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter returns a rate limiter that allows rate requests per
+// second per key, with bursts of up to burst requests. A burst of less
+// than one is treated as one.
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		rate:    rate,
+		burst:   math.Max(float64(burst), 1),
+		buckets: map[string]*rateLimitBucket{},
+		now:     time.Now,
+	}
+}
+
+// take takes a token from the bucket of the key. If the bucket is empty,
+// this returns the duration until a token is available and takes nothing.
+func (l *rateLimiter) take(key string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if now.Sub(l.lastCleanup) > rateLimitCleanupInterval {
+		l.cleanup(now)
+		l.lastCleanup = now
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &rateLimitBucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+
+	// Refill for the time since the last request
+	b.tokens = math.Min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+	b.last = now
+
+	if b.tokens < 1 {
+		return time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+	}
+
+	b.tokens--
+	return 0
+}
+
+// cleanup removes the buckets that would be full by now, since a new
+// bucket is the same as a full one. This must be called with the lock held.
+func (l *rateLimiter) cleanup(now time.Time) {
+	for k, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, k)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package singleprocess
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestRateLimiter(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Now()
+	l := newRateLimiter(2, 3)
+	l.now = func() time.Time { return now }
+
+	// We can burst
+	for i := 0; i < 3; i++ {
+		require.Zero(l.take("a"))
+	}
+
+	// Then we're limited until a token is added
+	require.Equal(500*time.Millisecond, l.take("a"))
+
+	// Other keys have their own bucket
+	require.Zero(l.take("b"))
+
+	// After a token is added we can make one more request
+	now = now.Add(500 * time.Millisecond)
+	require.Zero(l.take("a"))
+	require.NotZero(l.take("a"))
+
+	// Refilled buckets are cleaned up
+	now = now.Add(rateLimitCleanupInterval + time.Second)
+	require.Zero(l.take("c"))
+	require.Len(l.buckets, 1)
+}
+
+func TestServiceRateLimitRequest(t *testing.T) {
+	require := require.New(t)
+
+	s := &service{rateLimiter: newRateLimiter(1, 1)}
+	ctx := tokenWithContext(context.Background(), &pb.Token{
+		Kind: &pb.Token_Login_{
+			Login: &pb.Token_Login{UserId: "alice"},
+		},
+	})
+
+	require.NoError(s.RateLimitRequest(ctx, "ListProjects"))
+
+	err := s.RateLimitRequest(ctx, "ListProjects")
+	require.Error(err)
+	require.Equal(codes.ResourceExhausted, status.Code(err))
+
+	// Runner APIs and unauthenticated requests aren't limited
+	require.NoError(s.RateLimitRequest(ctx, "RunnerJobStream"))
+	require.NoError(s.RateLimitRequest(context.Background(), "ListProjects"))
+
+	// No rate limit
+	s.rateLimiter = nil
+	require.NoError(s.RateLimitRequest(ctx, "ListProjects"))
+}
@@ -2,13 +2,18 @@ package singleprocess
 
 import (
 	"context"
+	"database/sql"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 	wphznpb "github.com/hashicorp/waypoint-hzn/pkg/pb"
+	"github.com/prometheus/client_golang/prometheus"
 	bolt "go.etcd.io/bbolt"
 
 	wpoidc "github.com/hashicorp/waypoint/internal/auth/oidc"
+	configpkg "github.com/hashicorp/waypoint/internal/config"
+	"github.com/hashicorp/waypoint/internal/plugin"
 	"github.com/hashicorp/waypoint/internal/server"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 	"github.com/hashicorp/waypoint/internal/server/singleprocess/state"
@@ -19,7 +24,7 @@ import (
 type service struct {
 	// state is the state management interface that provides functions for
 	// safely mutating server state.
-	state *state.State
+	state state.Interface
 
 	// id is our unique server ID.
 	id string
@@ -53,6 +58,82 @@ type service struct {
 
 	// oidcCache is the cache for OIDC providers.
 	oidcCache *wpoidc.ProviderCache
+
+	// rateLimiter limits the API requests per user. This is nil if there
+	// is no rate limit.
+	rateLimiter *rateLimiter
+
+	// snapshotter writes automatic snapshots. This is nil if automatic
+	// snapshots aren't configured.
+	snapshotter *snapshotter
+
+	// logArchive persists the logs of instances. This is nil if the log
+	// archive isn't configured.
+	logArchive *logArchive
+
+	// logForwarder forwards the logs of instances to the log sinks. This
+	// is nil if no log sinks are configured.
+	logForwarder *logForwarder
+
+	// retention is the configured retention policy for old records. This
+	// is nil if there is none.
+	retention *state.RetentionPolicy
+
+	// requireRunnerAdoption is true if new runners must be adopted before
+	// they're assigned jobs.
+	requireRunnerAdoption bool
+
+	// requireRemoteJobs is true if jobs that use the local data source
+	// are rejected for every project.
+	requireRemoteJobs bool
+
+	// execTranscripts is true if the input and output of exec sessions
+	// are recorded.
+	execTranscripts bool
+
+	// jobTimeouts are the default timeouts of jobs by operation.
+	jobTimeouts serverconfig.JobTimeouts
+
+	// statusReportInterval is how often status reports are generated for
+	// every deployed app. This is zero if automatic status reports are
+	// disabled.
+	statusReportInterval time.Duration
+
+	// pipelineRunMu is held while updating a pipeline run so that the
+	// pipeline scheduler and approvals don't overwrite each other.
+	pipelineRunMu sync.Mutex
+
+	// jobScheduleMu is held while updating a job schedule so that the
+	// job scheduler and API changes don't overwrite each other.
+	jobScheduleMu sync.Mutex
+
+	// previewMu is held while updating a preview so that webhooks and the
+	// preview watcher don't overwrite each other.
+	previewMu sync.Mutex
+
+	// notifyCh receives the notifications to send to the notifiers of
+	// projects. smtp is the mail server for email notifications, which is
+	// nil if none is configured.
+	notifyCh chan *notification
+	smtp     *serverconfig.SMTP
+
+	// notifierMu is held while updating a notifier so that the API and
+	// the notifier don't overwrite each other.
+	notifierMu sync.Mutex
+
+	// jobStreams counts the open job streams of every job, protected by
+	// jobStreamsMu. The jobs of a runner whose config stream closed keep
+	// running while their job stream is open.
+	jobStreamsMu sync.Mutex
+	jobStreams   map[string]int
+
+	// jobDuration records the durations of completed jobs for the
+	// metrics endpoint.
+	jobDuration *prometheus.HistogramVec
+
+	// pluginSchemas returns the schemas of plugins to validate
+	// waypoint.hcl files with.
+	pluginSchemas configpkg.PluginSchemaFunc
 }
 
 // New returns a Waypoint server implementation that uses BotlDB plus
@@ -60,6 +141,7 @@ type service struct {
 func New(opts ...Option) (pb.WaypointServer, error) {
 	var s service
 	s.oidcCache = wpoidc.NewProviderCache()
+	s.jobDuration = newJobDurationMetric()
 
 	var cfg config
 	for _, opt := range opts {
@@ -73,8 +155,20 @@ func New(opts ...Option) (pb.WaypointServer, error) {
 		log = hclog.L()
 	}
 
+	if s.pluginSchemas == nil {
+		s.pluginSchemas = plugin.NewSchemas(log.Named("plugin_schemas")).Get
+	}
+
 	// Initialize our state
-	st, err := state.New(log, cfg.db)
+	var st *state.State
+	var err error
+	if cfg.postgres != nil && cfg.postgresStandby != nil {
+		st, err = state.NewPostgresStandby(cfg.postgresStandby, log, cfg.postgres)
+	} else if cfg.postgres != nil {
+		st, err = state.NewPostgres(log, cfg.postgres)
+	} else {
+		st, err = state.New(log, cfg.db)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -97,6 +191,20 @@ func New(opts ...Option) (pb.WaypointServer, error) {
 	}
 	s.id = id
 
+	// Encrypt config values at rest if a root key is configured. This must
+	// happen before anything reads config.
+	if scfg := cfg.serverConfig; scfg != nil && scfg.ConfigEncryption != nil &&
+		(scfg.ConfigEncryption.KeyFile != "" || scfg.ConfigEncryption.KMSKeyId != "") {
+		if err := initConfigEncryption(
+			context.Background(),
+			log.Named("config_encryption"),
+			st,
+			scfg.ConfigEncryption,
+		); err != nil {
+			return nil, err
+		}
+	}
+
 	// Setup our URL service config if it is enabled.
 	if scfg := cfg.serverConfig; scfg != nil && scfg.URL != nil {
 		// Set our config
@@ -143,6 +251,57 @@ func New(opts ...Option) (pb.WaypointServer, error) {
 		}
 	}
 
+	// Setup our per-user rate limit if one is configured
+	if scfg := cfg.serverConfig; scfg != nil && scfg.RateLimit != nil && scfg.RateLimit.RequestsPerSecond > 0 {
+		s.rateLimiter = newRateLimiter(scfg.RateLimit.RequestsPerSecond, scfg.RateLimit.Burst)
+	}
+
+	// Setup our automatic snapshots if they are configured
+	if scfg := cfg.serverConfig; scfg != nil && scfg.Snapshot != nil && scfg.Snapshot.Interval > 0 {
+		s.snapshotter, err = newSnapshotter(scfg.Snapshot, log.Named("snapshot"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if scfg := cfg.serverConfig; scfg != nil && scfg.LogArchive != nil &&
+		(scfg.LogArchive.Path != "" || scfg.LogArchive.S3Bucket != "") {
+		s.logArchive, err = newLogArchive(scfg.LogArchive, log.Named("log_archive"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if scfg := cfg.serverConfig; scfg != nil && len(scfg.LogSinks) > 0 {
+		s.logForwarder, err = newLogForwarder(scfg.LogSinks, log.Named("log_forwarder"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if scfg := cfg.serverConfig; scfg != nil {
+		s.requireRunnerAdoption = scfg.RequireRunnerAdoption
+		s.requireRemoteJobs = scfg.RequireRemoteJobs
+		s.execTranscripts = scfg.ExecTranscripts
+		if scfg.JobTimeouts != nil {
+			s.jobTimeouts = *scfg.JobTimeouts
+		}
+		s.statusReportInterval = scfg.StatusReportInterval
+		if scfg.SMTP != nil && scfg.SMTP.Addr != "" {
+			s.smtp = scfg.SMTP
+		}
+	}
+
+	// Setup our retention policy if one is configured
+
+	if scfg := cfg.serverConfig; scfg != nil && scfg.Retention != nil &&
+		(scfg.Retention.KeepLast > 0 || scfg.Retention.MaxAge > 0) {
+		s.retention = &state.RetentionPolicy{
+			KeepLast: scfg.Retention.KeepLast,
+			MaxAge:   scfg.Retention.MaxAge,
+		}
+	}
+
 	// Setup the background context that is used for internal tasks
 	s.bgCtx, s.bgCtxCancel = context.WithCancel(context.Background())
 
@@ -164,17 +323,74 @@ func New(opts ...Option) (pb.WaypointServer, error) {
 		)
 	}
 
+	// Start generating status reports for every deployed app if it's
+	// configured.
+	if s.statusReportInterval > 0 {
+		s.bgWg.Add(1)
+		go s.runStatusReportPoller(s.bgCtx, &s.bgWg, s.statusReportInterval,
+			log.Named("status_report_poller"))
+	}
+
+	// Start sending notifications to the notifiers of projects.
+	s.notifyCh = make(chan *notification, 100)
+	s.bgWg.Add(1)
+	go s.runNotifier(s.bgCtx, &s.bgWg, log.Named("notifier"))
+
 	// Start out state pruning background goroutine. This calls
 	// Prune on the state every 10 minutes.
 	s.bgWg.Add(1)
 	go s.runPrune(s.bgCtx, &s.bgWg, log.Named("prune"))
 
+	// Start the pipeline scheduler which queues the jobs for the steps
+	// of pipeline runs.
+	s.bgWg.Add(1)
+	go s.runPipelineScheduler(s.bgCtx, &s.bgWg, log.Named("pipeline_scheduler"))
+
+	// Start the job scheduler which queues the jobs of job schedules.
+	s.bgWg.Add(1)
+	go s.runJobScheduler(s.bgCtx, &s.bgWg, log.Named("job_scheduler"))
+
+	// Start the preview watcher which completes previews once their
+	// jobs finish.
+	s.bgWg.Add(1)
+	go s.runPreviewWatcher(s.bgCtx, &s.bgWg, log.Named("preview_watcher"))
+
+	// Start the on-demand runner watcher which stops the runners that
+	// were launched for jobs once the jobs complete.
+	s.bgWg.Add(1)
+	go s.runOnDemandRunnerWatcher(s.bgCtx, &s.bgWg, log.Named("ondemand_runner_watcher"))
+
+	// Start our automatic snapshots if they are configured.
+	if s.snapshotter != nil {
+		s.bgWg.Add(1)
+		go s.snapshotter.run(s.bgCtx, &s.bgWg, s.state)
+	}
+
+	// Start writing the log archive if it is configured.
+	if s.logArchive != nil {
+		s.bgWg.Add(1)
+		go s.logArchive.run(s.bgCtx, &s.bgWg)
+	}
+
+	// Start forwarding logs if there are log sinks.
+	if s.logForwarder != nil {
+		s.bgWg.Add(1)
+		go s.logForwarder.run(s.bgCtx, &s.bgWg)
+	}
+
 	return &s, nil
 }
 
 // Close shuts down any background processes and resources that may
 // be used by the service. This should be called after the service
 // is no longer responding to requests.
+// LockLost returns a channel that is closed if the server lost the lock of
+// its PostgreSQL database schema. The server must stop then, since a standby
+// server may have taken over.
+func (s *service) LockLost() <-chan struct{} {
+	return s.state.LockLost()
+}
+
 func (s *service) Close() error {
 	s.bgCtxCancel()
 	s.bgWg.Wait()
@@ -184,10 +400,15 @@ func (s *service) Close() error {
 
 type config struct {
 	db           *bolt.DB
+	postgres     *sql.DB
 	serverConfig *serverconfig.Config
 	log          hclog.Logger
 	superuser    bool
 
+	// postgresStandby is non-nil if the server waits as a standby for the
+	// PostgreSQL schema. Cancelling it stops waiting.
+	postgresStandby context.Context
+
 	acceptUrlTerms bool
 }
 
@@ -201,6 +422,26 @@ func WithDB(db *bolt.DB) Option {
 	}
 }
 
+// WithPostgres sets the PostgreSQL database for use with the server
+// instead of a Bolt DB. The database must be opened with the
+// state.PostgresDriver.
+func WithPostgres(db *sql.DB) Option {
+	return func(s *service, cfg *config) error {
+		cfg.postgres = db
+		return nil
+	}
+}
+
+// WithPostgresStandby makes the server wait as a standby if another server
+// is using the PostgreSQL database set with WithPostgres, and take over
+// when that server stops. New blocks until then or until ctx is cancelled.
+func WithPostgresStandby(ctx context.Context) Option {
+	return func(s *service, cfg *config) error {
+		cfg.postgresStandby = ctx
+		return nil
+	}
+}
+
 // WithConfig sets the server config in use with this server.
 func WithConfig(scfg *serverconfig.Config) Option {
 	return func(s *service, cfg *config) error {
@@ -227,6 +468,16 @@ func WithSuperuser() Option {
 	}
 }
 
+// WithPluginSchemas sets the function that returns the schemas of plugins
+// used to validate waypoint.hcl files. This defaults to launching the
+// builtin plugins.
+func WithPluginSchemas(f configpkg.PluginSchemaFunc) Option {
+	return func(s *service, cfg *config) error {
+		s.pluginSchemas = f
+		return nil
+	}
+}
+
 func WithAcceptURLTerms(accept bool) Option {
 	return func(s *service, cfg *config) error {
 		cfg.acceptUrlTerms = true
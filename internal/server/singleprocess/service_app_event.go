@@ -0,0 +1,68 @@
+package singleprocess
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/server/singleprocess/state"
+)
+
+func (s *service) ListAppEvents(
+	ctx context.Context,
+	req *pb.ListAppEventsRequest,
+) (*pb.ListAppEventsResponse, error) {
+	if req.Application == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "application is required")
+	}
+
+	page := &state.Page{Size: int(req.PageSize), Token: req.PageToken}
+	result, err := s.state.AppEventList(req.Application, req.Workspace, req.Types, page)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListAppEventsResponse{
+		Events:        result,
+		NextPageToken: page.NextToken,
+	}, nil
+}
+
+// appEvent records an event in the timeline of an application. Failing to
+// record an event shouldn't fail the operation that it's about, so errors
+// are only logged.
+func (s *service) appEvent(
+	log hclog.Logger,
+	app *pb.Ref_Application,
+	ws *pb.Ref_Workspace,
+	typ pb.AppEvent_Type,
+	opId string,
+	opSeq uint64,
+	format string, args ...interface{},
+) {
+	if app == nil {
+		return
+	}
+
+	event := &pb.AppEvent{
+		Application:       app,
+		Workspace:         ws,
+		Time:              ptypes.TimestampNow(),
+		Type:              typ,
+		OperationId:       opId,
+		OperationSequence: opSeq,
+		Message:           fmt.Sprintf(format, args...),
+	}
+
+	if err := s.state.AppEventPut(event); err != nil {
+		log.Warn("failed to record app event",
+			"type", typ.String(),
+			"err", err,
+		)
+	}
+}
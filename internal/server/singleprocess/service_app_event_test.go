@@ -0,0 +1,85 @@
+package singleprocess
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/internal/server"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestServiceAppEvents(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	ref := &pb.Ref_Application{Project: "p_test", Application: "a_test"}
+	TestApp(t, client, ref)
+
+	// Starting a build records an event
+	_, err = client.UpsertBuild(ctx, &pb.UpsertBuildRequest{
+		Build: serverptypes.TestValidBuild(t, &pb.Build{
+			Application: ref,
+			Status:      server.NewStatus(pb.Status_RUNNING),
+		}),
+	})
+	require.NoError(err)
+
+	// A running deployment doesn't record an event
+	resp, err := client.UpsertDeployment(ctx, &pb.UpsertDeploymentRequest{
+		Deployment: serverptypes.TestValidDeployment(t, &pb.Deployment{
+			Application: ref,
+			Status:      server.NewStatus(pb.Status_RUNNING),
+		}),
+	})
+	require.NoError(err)
+
+	// Completing it does, but only once
+	dep := resp.Deployment
+	dep.Status = server.NewStatus(pb.Status_SUCCESS)
+	for i := 0; i < 2; i++ {
+		_, err = client.UpsertDeployment(ctx, &pb.UpsertDeploymentRequest{Deployment: dep})
+		require.NoError(err)
+	}
+
+	events, err := client.ListAppEvents(ctx, &pb.ListAppEventsRequest{
+		Application: ref,
+	})
+	require.NoError(err)
+	require.Len(events.Events, 2)
+	require.Equal(pb.AppEvent_DEPLOYMENT_CREATED, events.Events[0].Type)
+	require.Equal(dep.Id, events.Events[0].OperationId)
+	require.Equal(pb.AppEvent_BUILD_STARTED, events.Events[1].Type)
+
+	// Pages
+	events, err = client.ListAppEvents(ctx, &pb.ListAppEventsRequest{
+		Application: ref,
+		PageSize:    1,
+	})
+	require.NoError(err)
+	require.Len(events.Events, 1)
+	require.NotEmpty(events.NextPageToken)
+
+	events, err = client.ListAppEvents(ctx, &pb.ListAppEventsRequest{
+		Application: ref,
+		PageSize:    1,
+		PageToken:   events.NextPageToken,
+	})
+	require.NoError(err)
+	require.Len(events.Events, 1)
+	require.Equal(pb.AppEvent_BUILD_STARTED, events.Events[0].Type)
+
+	// Application is required
+	_, err = client.ListAppEvents(ctx, &pb.ListAppEventsRequest{})
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
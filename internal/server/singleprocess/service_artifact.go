@@ -3,11 +3,13 @@ package singleprocess
 import (
 	"context"
 
+	"github.com/hashicorp/go-hclog"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/hashicorp/waypoint/internal/server"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/server/ptypes"
 	"github.com/hashicorp/waypoint/internal/server/singleprocess/state"
 )
 
@@ -30,10 +32,30 @@ func (s *service) UpsertPushedArtifact(
 		result.Id = id
 	}
 
+	// Check if the push just completed so we can record an event about it.
+	pushed := result.Status.GetState() == pb.Status_SUCCESS
+	if pushed && !insert {
+		existing, err := s.state.ArtifactGet(&pb.Ref_Operation{
+			Target: &pb.Ref_Operation_Id{Id: result.Id},
+		})
+		if err != nil && status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+		if existing.GetStatus().GetState() == pb.Status_SUCCESS {
+			pushed = false
+		}
+	}
+
 	if err := s.state.ArtifactPut(!insert, result); err != nil {
 		return nil, err
 	}
 
+	if pushed {
+		s.appEvent(hclog.FromContext(ctx), result.Application, result.Workspace,
+			pb.AppEvent_ARTIFACT_PUSHED, result.Id, result.Sequence,
+			"artifact v%d pushed", result.Sequence)
+	}
+
 	return &pb.UpsertPushedArtifactResponse{Artifact: result}, nil
 }
 
@@ -50,6 +72,16 @@ func (s *service) ListPushedArtifacts(
 		return nil, err
 	}
 
+	if len(req.Labels) > 0 {
+		filtered := result[:0]
+		for _, a := range result {
+			if ptypes.LabelsMatch(req.Labels, a.Labels) {
+				filtered = append(filtered, a)
+			}
+		}
+		result = filtered
+	}
+
 	if req.IncludeBuild {
 		for _, a := range result {
 			b, err := s.state.BuildGet(&pb.Ref_Operation{
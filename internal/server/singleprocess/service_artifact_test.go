@@ -110,4 +110,35 @@ func TestServiceArtifact_List(t *testing.T) {
 		require.NotNil(listresp.Artifacts[0].Build)
 	})
 
+	t.Run("list with labels", func(t *testing.T) {
+		require := require.New(t)
+
+		resp, err := client.UpsertPushedArtifact(ctx, &pb.UpsertPushedArtifactRequest{
+			Artifact: serverptypes.TestValidArtifact(t, &pb.PushedArtifact{
+				Labels: map[string]string{"env": "prod"},
+				Artifact: &pb.Artifact{
+					Digest: "sha256:abcd",
+					Size:   1024,
+				},
+			}),
+		})
+		require.NoError(err)
+		artifact := resp.Artifact
+
+		listresp, err := client.ListPushedArtifacts(ctx, &Req{
+			Application: artifact.Application,
+			Labels:      map[string]string{"env": "prod"},
+		})
+		require.NoError(err)
+		require.Len(listresp.Artifacts, 1)
+		require.Equal(artifact.Id, listresp.Artifacts[0].Id)
+		require.Equal("sha256:abcd", listresp.Artifacts[0].Artifact.Digest)
+
+		listresp, err = client.ListPushedArtifacts(ctx, &Req{
+			Application: artifact.Application,
+			Labels:      map[string]string{"env": "staging"},
+		})
+		require.NoError(err)
+		require.Empty(listresp.Artifacts)
+	})
 }
@@ -0,0 +1,157 @@
+package singleprocess
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// auditEventsDefaultLimit is the number of audit events listed if the
+// request has no limit.
+const auditEventsDefaultLimit = 100
+
+// AuditRequest implements the server.AuditLogger interface.
+func (s *service) AuditRequest(ctx context.Context, endpoint string, req interface{}, err error) {
+	event := &pb.AuditEvent{
+		Time:     ptypes.TimestampNow(),
+		Endpoint: endpoint,
+	}
+
+	if user := s.userFromContext(ctx); user != nil {
+		event.UserId = user.Id
+		event.Username = user.Username
+	}
+
+	if m, ok := req.(proto.Message); ok {
+		event.Summary = auditSummary(m.ProtoReflect())
+	}
+
+	if err != nil {
+		event.Error = status.Convert(err).Proto()
+	}
+
+	// Failing to record an event shouldn't fail the request, which has
+	// already been handled at this point.
+	if err := s.state.AuditEventPut(event); err != nil {
+		hclog.FromContext(ctx).Warn("failed to record audit event",
+			"endpoint", endpoint,
+			"err", err,
+		)
+	}
+}
+
+func (s *service) ListAuditEvents(
+	ctx context.Context,
+	req *pb.ListAuditEventsRequest,
+) (*pb.ListAuditEventsResponse, error) {
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = auditEventsDefaultLimit
+	}
+
+	events, err := s.state.AuditEventList(func(e *pb.AuditEvent) bool {
+		if req.Endpoint != "" && req.Endpoint != e.Endpoint {
+			return false
+		}
+
+		// We match the user by the values recorded in the event so that
+		// events of deleted users can still be listed.
+		switch ref := req.User.GetRef().(type) {
+		case *pb.Ref_User_Id:
+			return ref.Id.Id == e.UserId
+		case *pb.Ref_User_Username:
+			return ref.Username.Username == e.Username
+		}
+
+		return true
+	}, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListAuditEventsResponse{Events: events}, nil
+}
+
+// auditSummary returns a summary of the targets of a request. This only
+// includes references, names of resources being upserted, and IDs so that
+// no secrets in the request are recorded.
+func auditSummary(m protoreflect.Message) string {
+	var parts []string
+	seen := map[string]struct{}{}
+	add := func(k, v string) {
+		if v == "" {
+			return
+		}
+
+		part := k + "=" + v
+		if _, ok := seen[part]; !ok {
+			seen[part] = struct{}{}
+			parts = append(parts, part)
+		}
+	}
+
+	var walk func(m protoreflect.Message)
+	walk = func(m protoreflect.Message) {
+		switch v := m.Interface().(type) {
+		case *pb.Ref_Application:
+			add("project", v.Project)
+			add("app", v.Application)
+			return
+		case *pb.Ref_Project:
+			add("project", v.Project)
+			return
+		case *pb.Ref_Workspace:
+			add("workspace", v.Workspace)
+			return
+		case *pb.Ref_User:
+			add("user", v.GetUsername().GetUsername())
+			add("user_id", v.GetId().GetId())
+			return
+		case *pb.Ref_AuthMethod:
+			add("auth_method", v.Name)
+			return
+		case *pb.Project:
+			add("project", v.Name)
+		case *pb.AuthMethod:
+			add("auth_method", v.Name)
+		}
+
+		// Walk the fields in order so that the summary is stable.
+		fields := m.Descriptor().Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			if !m.Has(fd) {
+				continue
+			}
+
+			v := m.Get(fd)
+			switch {
+			case fd.IsMap():
+				// Maps are config values, labels, etc. which we skip.
+
+			case fd.IsList() && fd.Kind() == protoreflect.MessageKind:
+				list := v.List()
+				for i := 0; i < list.Len(); i++ {
+					walk(list.Get(i).Message())
+				}
+
+			case fd.Kind() == protoreflect.MessageKind:
+				walk(v.Message())
+
+			case !fd.IsList() && fd.Kind() == protoreflect.StringKind &&
+				strings.HasSuffix(string(fd.Name()), "_id"):
+				add(string(fd.Name()), v.String())
+			}
+		}
+	}
+	walk(m)
+
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,87 @@
+package singleprocess
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/hashicorp/waypoint/internal/server"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestServiceAuditEvents(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	// A mutating call is recorded
+	_, err = client.UpsertProject(ctx, &pb.UpsertProjectRequest{
+		Project: serverptypes.TestProject(t, &pb.Project{Name: "example"}),
+	})
+	require.NoError(err)
+
+	// A readonly call is not
+	_, err = client.GetProject(ctx, &pb.GetProjectRequest{
+		Project: &pb.Ref_Project{Project: "example"},
+	})
+	require.NoError(err)
+
+	// A failed call is recorded with its error
+	_, err = client.DeleteAuthMethod(ctx, &pb.DeleteAuthMethodRequest{})
+	require.Error(err)
+
+	// Events are newest first. The first event is bootstrapping the
+	// test server, which is unauthenticated.
+	resp, err := client.ListAuditEvents(ctx, &pb.ListAuditEventsRequest{})
+	require.NoError(err)
+	require.Len(resp.Events, 3)
+	require.Equal("BootstrapToken", resp.Events[2].Endpoint)
+	require.Empty(resp.Events[2].UserId)
+
+	failed := resp.Events[0]
+	require.Equal("DeleteAuthMethod", failed.Endpoint)
+	require.NotNil(failed.Error)
+	require.Equal(int32(codes.InvalidArgument), failed.Error.Code)
+
+	upsert := resp.Events[1]
+	require.Equal("UpsertProject", upsert.Endpoint)
+	require.Equal("project=example", upsert.Summary)
+	require.Nil(upsert.Error)
+	require.NotEmpty(upsert.UserId)
+	require.NotNil(upsert.Time)
+
+	// Filter by endpoint
+	resp, err = client.ListAuditEvents(ctx, &pb.ListAuditEventsRequest{
+		Endpoint: "UpsertProject",
+	})
+	require.NoError(err)
+	require.Len(resp.Events, 1)
+
+	// Filter by user
+	resp, err = client.ListAuditEvents(ctx, &pb.ListAuditEventsRequest{
+		User: &pb.Ref_User{
+			Ref: &pb.Ref_User_Username{Username: &pb.Ref_UserUsername{Username: "nope"}},
+		},
+	})
+	require.NoError(err)
+	require.Empty(resp.Events)
+}
+
+func TestAuditSummary(t *testing.T) {
+	req := &pb.QueueJobRequest{
+		Job: &pb.Job{
+			Application: &pb.Ref_Application{Project: "foo", Application: "web"},
+			Workspace:   &pb.Ref_Workspace{Workspace: "prod"},
+			Labels:      map[string]string{"secret": "value"},
+		},
+	}
+
+	require.Equal(t, "project=foo app=web workspace=prod", auditSummary(req.ProtoReflect()))
+}
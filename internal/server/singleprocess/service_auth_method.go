@@ -4,7 +4,12 @@ import (
 	"context"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/hashicorp/cap/oidc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	wpoidc "github.com/hashicorp/waypoint/internal/auth/oidc"
+	"github.com/hashicorp/waypoint/internal/auth/pat"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
 )
@@ -46,6 +51,48 @@ func (s *service) UpsertAuthMethod(
 	}, nil
 }
 
+func (s *service) TestAuthMethod(
+	ctx context.Context,
+	req *pb.TestAuthMethodRequest,
+) (*empty.Empty, error) {
+	if err := serverptypes.ValidateTestAuthMethodRequest(req); err != nil {
+		return nil, err
+	}
+
+	switch method := req.AuthMethod.Method.(type) {
+	case *pb.AuthMethod_Oidc:
+		// We need our server config for the redirect URIs.
+		sc, err := s.state.ServerConfigGet()
+		if err != nil {
+			return nil, err
+		}
+
+		cfg, err := wpoidc.ProviderConfig(method.Oidc, sc)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid OIDC configuration: %s", err)
+		}
+
+		// Creating the provider performs discovery. We don't use the
+		// provider cache since this auth method isn't saved.
+		provider, err := oidc.NewProvider(cfg)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"OIDC discovery failed for %q, make sure the issuer URL is "+
+					"reachable from the server and that any custom CA is set "+
+					"with the issuer CA: %s", method.Oidc.DiscoveryUrl, err)
+		}
+		provider.Done()
+
+	case *pb.AuthMethod_Pat:
+		if err := pat.Check(ctx, method.Pat); err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+	}
+
+	return &empty.Empty{}, nil
+}
+
 func (s *service) DeleteAuthMethod(
 	ctx context.Context,
 	req *pb.DeleteAuthMethodRequest,
@@ -5,7 +5,10 @@ import (
 	"testing"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/hashicorp/cap/oidc"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/hashicorp/waypoint/internal/server"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
@@ -56,3 +59,58 @@ func TestListOIDCAuthMethods(t *testing.T) {
 		}
 	})
 }
+
+func TestTestAuthMethod(t *testing.T) {
+	ctx := context.Background()
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(t, err)
+	client := server.TestServer(t, impl)
+
+	t.Run("OIDC", func(t *testing.T) {
+		require := require.New(t)
+
+		// Create our OIDC test provider
+		oidcTP := oidc.StartTestProvider(t)
+		_, _, tpAlg, _ := oidcTP.SigningKeys()
+
+		am := serverptypes.TestAuthMethod(t, &pb.AuthMethod{
+			Method: &pb.AuthMethod_Oidc{
+				Oidc: &pb.AuthMethod_OIDC{
+					ClientId:       "alice",
+					ClientSecret:   "big-secret",
+					DiscoveryUrl:   oidcTP.Addr(),
+					DiscoveryCaPem: []string{oidcTP.CACert()},
+					SigningAlgs:    []string{string(tpAlg)},
+				},
+			},
+		})
+
+		_, err := client.TestAuthMethod(ctx, &pb.TestAuthMethodRequest{AuthMethod: am})
+		require.NoError(err)
+
+		// Without the CA, discovery fails
+		am.Method.(*pb.AuthMethod_Oidc).Oidc.DiscoveryCaPem = nil
+		_, err = client.TestAuthMethod(ctx, &pb.TestAuthMethodRequest{AuthMethod: am})
+		require.Error(err)
+		require.Equal(codes.FailedPrecondition, status.Code(err))
+
+		// Nothing was saved
+		resp, err := client.ListAuthMethods(ctx, &empty.Empty{})
+		require.NoError(err)
+		require.Empty(resp.AuthMethods)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		require := require.New(t)
+
+		_, err := client.TestAuthMethod(ctx, &pb.TestAuthMethodRequest{
+			AuthMethod: serverptypes.TestAuthMethod(t, &pb.AuthMethod{
+				Name: "token",
+			}),
+		})
+		require.Error(err)
+		require.Equal(codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -214,14 +214,17 @@ func (s *service) CompleteOIDCAuth(
 		}
 	}
 
-	// Verify this user is allowed to auth at all.
-	if am.AccessSelector != "" {
-		// Get our data
-		selectorData, err := wpoidc.SelectorData(amMethod.Oidc, jsonClaims, userClaims)
+	// Get the data for selectors if we have any to evaluate.
+	var selectorData map[string]interface{}
+	if am.AccessSelector != "" || len(amMethod.Oidc.RoleMappings) > 0 {
+		selectorData, err = wpoidc.SelectorData(amMethod.Oidc, jsonClaims, userClaims)
 		if err != nil {
 			return nil, err
 		}
+	}
 
+	// Verify this user is allowed to auth at all.
+	if am.AccessSelector != "" {
 		eval, err := bexpr.CreateEvaluator(am.AccessSelector)
 		if err != nil {
 			// This shouldn't happen since we validate on auth method create.
@@ -246,6 +249,15 @@ func (s *service) CompleteOIDCAuth(
 		}
 	}
 
+	// Determine the roles granted by the role mappings.
+	roles, err := oidcRoles(amMethod.Oidc, selectorData)
+	if err != nil {
+		return nil, err
+	}
+	if len(roles) > 0 {
+		log.Debug("OIDC role mappings matched", "roles", roles)
+	}
+
 	// Look up a user by sub.
 	user, err := s.oidcInitUser(log, &idClaimVals)
 	if err != nil {
@@ -255,7 +267,7 @@ func (s *service) CompleteOIDCAuth(
 	// Generate a token for this user
 	token, err := s.newToken(oidcAuthExpiry, DefaultKeyId, nil, &pb.Token{
 		Kind: &pb.Token_Login_{
-			Login: &pb.Token_Login{UserId: user.Id},
+			Login: &pb.Token_Login{UserId: user.Id, Roles: roles},
 		},
 	})
 	if err != nil {
@@ -270,6 +282,37 @@ func (s *service) CompleteOIDCAuth(
 	}, nil
 }
 
+// oidcRoles returns the roles granted by the role mappings of the auth
+// method that match the selector data. The roles are returned in the order
+// of the mappings without duplicates.
+func oidcRoles(am *pb.AuthMethod_OIDC, data map[string]interface{}) ([]string, error) {
+	var result []string
+	seen := map[string]struct{}{}
+	for _, m := range am.RoleMappings {
+		if _, ok := seen[m.Role]; ok {
+			continue
+		}
+
+		eval, err := bexpr.CreateEvaluator(m.Selector)
+		if err != nil {
+			// This shouldn't happen since we validate on auth method create.
+			return nil, err
+		}
+
+		match, err := eval.Evaluate(data)
+		if err != nil {
+			return nil, err
+		}
+
+		if match {
+			seen[m.Role] = struct{}{}
+			result = append(result, m.Role)
+		}
+	}
+
+	return result, nil
+}
+
 // oidcInitUser finds or creates the user for the given OIDC information.
 func (s *service) oidcInitUser(log hclog.Logger, claims *idClaims) (*pb.User, error) {
 	// This method attempts to find, link, or create a new user to the
@@ -325,6 +368,7 @@ func (s *service) oidcInitUser(log hclog.Logger, claims *idClaims) (*pb.User, er
 		user = &pb.User{
 			Username: username,
 			Email:    email,
+			Roles:    []string{serverptypes.RoleOperator},
 		}
 	}
 
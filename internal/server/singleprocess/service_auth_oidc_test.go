@@ -237,3 +237,91 @@ func TestOIDCAuth_accessSelector(t *testing.T) {
 		})
 	}
 }
+
+func TestOIDCAuth_roleMappings(t *testing.T) {
+	ctx := context.Background()
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(t, err)
+	client := server.TestServer(t, impl)
+
+	// Create our OIDC test provider
+	oidcTP := oidc.StartTestProvider(t)
+	oidcTP.SetClientCreds("alice", "big-secret")
+	_, _, tpAlg, _ := oidcTP.SigningKeys()
+
+	// Create our auth method that maps groups to roles
+	_, err = client.UpsertAuthMethod(ctx, &pb.UpsertAuthMethodRequest{
+		AuthMethod: serverptypes.TestAuthMethod(t, &pb.AuthMethod{
+			Name: "TEST",
+			Method: &pb.AuthMethod_Oidc{
+				Oidc: &pb.AuthMethod_OIDC{
+					ClientId:            "alice",
+					ClientSecret:        "big-secret",
+					DiscoveryUrl:        oidcTP.Addr(),
+					DiscoveryCaPem:      []string{oidcTP.CACert()},
+					SigningAlgs:         []string{string(tpAlg)},
+					AllowedRedirectUris: []string{"https://example.com"},
+					ListClaimMappings:   map[string]string{"groups": "g"},
+					RoleMappings: []*pb.AuthMethod_OIDC_RoleMapping{
+						{Selector: "admins in list.g", Role: "admin"},
+						{Selector: "ops in list.g", Role: "operator"},
+						{Selector: "admins in list.g", Role: "admin"},
+					},
+				},
+			},
+		}),
+	})
+	require.NoError(t, err)
+
+	cases := []struct {
+		Name   string
+		Groups []string
+		Roles  []string
+	}{
+		{
+			"single role",
+			[]string{"ops"},
+			[]string{"operator"},
+		},
+
+		{
+			"multiple roles",
+			[]string{"ops", "admins"},
+			[]string{"admin", "operator"},
+		},
+
+		{
+			"no match",
+			[]string{"nope"},
+			nil,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			oidcTP.SetCustomClaims(map[string]interface{}{"groups": tt.Groups})
+			oidcTP.SetExpectedState("state")
+			oidcTP.SetExpectedAuthCode("hello")
+			oidcTP.SetExpectedAuthNonce("nonce")
+
+			respAuth, err := client.CompleteOIDCAuth(ctx, &pb.CompleteOIDCAuthRequest{
+				AuthMethod:  &pb.Ref_AuthMethod{Name: "TEST"},
+				RedirectUri: "https://example.com",
+				State:       "state",
+				Code:        "hello",
+				Nonce:       "nonce",
+			})
+			require.NoError(err)
+
+			_, body, err := testServiceImpl(impl).decodeToken(respAuth.Token)
+			require.NoError(err)
+			login, ok := body.Kind.(*pb.Token_Login_)
+			require.True(ok)
+			require.Equal(tt.Roles, login.Login.Roles)
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package singleprocess
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/internal/auth/pat"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+// patAuthExpiry is the duration that a PAT-based login is valid for. This
+// matches OIDC logins.
+const patAuthExpiry = oidcAuthExpiry
+
+func (s *service) CompletePATAuth(
+	ctx context.Context,
+	req *pb.CompletePATAuthRequest,
+) (*pb.CompletePATAuthResponse, error) {
+	log := hclog.FromContext(ctx)
+
+	if err := serverptypes.ValidateCompletePATAuthRequest(req); err != nil {
+		return nil, err
+	}
+
+	// Get the auth method
+	am, err := s.state.AuthMethodGet(req.AuthMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	// The auth method must be PAT
+	amMethod, ok := am.Method.(*pb.AuthMethod_Pat)
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"auth method is not PAT")
+	}
+
+	// Look up the owner of the token with the provider.
+	id, err := pat.Lookup(ctx, amMethod.Pat, req.Token)
+	if err != nil {
+		if err == pat.ErrInvalidToken {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		return nil, err
+	}
+
+	// From this point forward, log all data
+	log = log.With(
+		"provider", amMethod.Pat.Provider.String(),
+		"provider_id", id.ID,
+		"provider_username", id.Username,
+	)
+
+	// Verify this user is allowed to auth at all.
+	allowed := pat.Allowed(amMethod.Pat, id)
+	if allowed && am.AccessSelector != "" {
+		eval, err := bexpr.CreateEvaluator(am.AccessSelector)
+		if err != nil {
+			// This shouldn't happen since we validate on auth method create.
+			return nil, err
+		}
+
+		allowed, err = eval.Evaluate(pat.SelectorData(id))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !allowed {
+		// Warn so an operator can detect
+		log.Warn("rejected PAT login based on membership or access selector",
+			"orgs", id.Orgs,
+			"teams", id.Teams,
+			"selector", am.AccessSelector,
+		)
+
+		return nil, status.Errorf(codes.PermissionDenied,
+			"Your account was denied access. Please contact your Waypoint "+
+				"server administrator for more information.")
+	}
+
+	user, err := s.patInitUser(log, pat.APIURL(amMethod.Pat), id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate a token for this user
+	token, err := s.newToken(patAuthExpiry, DefaultKeyId, nil, &pb.Token{
+		Kind: &pb.Token_Login_{
+			Login: &pb.Token_Login{UserId: user.Id},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CompletePATAuthResponse{
+		Token: token,
+		User:  user,
+	}, nil
+}
+
+// patInitUser finds or creates the user for the given provider identity.
+func (s *service) patInitUser(
+	log hclog.Logger,
+	apiURL string,
+	id *pat.Identity,
+) (*pb.User, error) {
+	// Unlike OIDC, we never link an existing user by email since the
+	// email of a provider profile isn't necessarily verified. The user
+	// is either found by exact account link or a new user is created.
+	user, err := s.state.UserGetPAT(apiURL, id.ID)
+	if err == nil {
+		return user, nil
+	}
+	if status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+
+	// Use their provider username if it is valid and available so users
+	// are easy to recognize, and fall back to a random username like OIDC.
+	// GitLab usernames can contain characters that Waypoint doesn't allow.
+	username := id.Username
+	if !serverptypes.UsernameRegexp.MatchString(username) || len(username) > 38 {
+		username = ""
+	}
+	if username != "" {
+		_, err := s.state.UserGet(&pb.Ref_User{
+			Ref: &pb.Ref_User_Username{
+				Username: &pb.Ref_UserUsername{Username: username},
+			},
+		})
+		if status.Code(err) != codes.NotFound {
+			username = ""
+		}
+	}
+	if username == "" {
+		username = fmt.Sprintf("user_%d", time.Now().Unix())
+	}
+
+	user = &pb.User{
+		Username: username,
+		Roles:    []string{serverptypes.RoleOperator},
+		Links: []*pb.User_Link{
+			{
+				Method: &pb.User_Link_Pat{
+					Pat: &pb.User_Link_PAT{
+						ApiUrl: apiURL,
+						Id:     id.ID,
+					},
+				},
+			},
+		},
+	}
+	if err := s.state.UserPut(user); err != nil {
+		return nil, err
+	}
+
+	log.Info("new PAT user linked",
+		"user_id", user.Id,
+		"username", user.Username,
+	)
+
+	return user, nil
+}
@@ -0,0 +1,87 @@
+package singleprocess
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/internal/pkg/githubapi"
+	"github.com/hashicorp/waypoint/internal/server"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestPATAuth(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	// Create a fake GitHub API. "alice" is in the "acme" org and "bob"
+	// isn't in any org.
+	ghURL := githubapi.TestServer(t, &githubapi.TestAPI{
+		Users: map[string]*githubapi.TestUser{
+			"alice-token": {ID: 1, Login: "alice", Orgs: []string{"acme"}},
+			"bob-token":   {ID: 2, Login: "bob"},
+		},
+	})
+
+	// Create
+	{
+		resp, err := client.UpsertAuthMethod(ctx, &pb.UpsertAuthMethodRequest{
+			AuthMethod: serverptypes.TestAuthMethod(t, &pb.AuthMethod{
+				Name: "TEST",
+				Method: &pb.AuthMethod_Pat{
+					Pat: &pb.AuthMethod_PAT{
+						Provider: pb.AuthMethod_PAT_GITHUB,
+						ApiUrl:   ghURL,
+						Orgs:     []string{"acme"},
+					},
+				},
+			}),
+		})
+		require.NoError(err)
+		require.NotNil(resp)
+	}
+
+	// Complete our auth
+	respAuth, err := client.CompletePATAuth(ctx, &pb.CompletePATAuthRequest{
+		AuthMethod: &pb.Ref_AuthMethod{Name: "TEST"},
+		Token:      "alice-token",
+	})
+	require.NoError(err)
+	require.NotEmpty(respAuth.Token)
+	user := respAuth.User
+	require.NotNil(user)
+	require.Equal("alice", user.Username)
+
+	// Auth again and we should get the same user
+	respAuth, err = client.CompletePATAuth(ctx, &pb.CompletePATAuthRequest{
+		AuthMethod: &pb.Ref_AuthMethod{Name: "TEST"},
+		Token:      "alice-token",
+	})
+	require.NoError(err)
+	require.Equal(user.Id, respAuth.User.Id)
+
+	// Not a member of an allowed org
+	_, err = client.CompletePATAuth(ctx, &pb.CompletePATAuthRequest{
+		AuthMethod: &pb.Ref_AuthMethod{Name: "TEST"},
+		Token:      "bob-token",
+	})
+	require.Error(err)
+	require.Equal(codes.PermissionDenied, status.Code(err))
+
+	// Invalid token
+	_, err = client.CompletePATAuth(ctx, &pb.CompletePATAuthRequest{
+		AuthMethod: &pb.Ref_AuthMethod{Name: "TEST"},
+		Token:      "nope",
+	})
+	require.Error(err)
+	require.Equal(codes.Unauthenticated, status.Code(err))
+}
@@ -3,6 +3,7 @@ package singleprocess
 import (
 	"context"
 
+	"github.com/hashicorp/go-hclog"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -34,6 +35,12 @@ func (s *service) UpsertBuild(
 		return nil, err
 	}
 
+	if insert {
+		s.appEvent(hclog.FromContext(ctx), result.Application, result.Workspace,
+			pb.AppEvent_BUILD_STARTED, result.Id, result.Sequence,
+			"build v%d started", result.Sequence)
+	}
+
 	return &pb.UpsertBuildResponse{Build: result}, nil
 }
 
@@ -41,15 +48,22 @@ func (s *service) ListBuilds(
 	ctx context.Context,
 	req *pb.ListBuildsRequest,
 ) (*pb.ListBuildsResponse, error) {
+	page := &state.Page{Size: int(req.PageSize), Token: req.PageToken}
 	result, err := s.state.BuildList(req.Application,
 		state.ListWithWorkspace(req.Workspace),
+		state.ListWithStatusFilter(req.Status...),
+		state.ListWithFilter(req.Filter),
 		state.ListWithOrder(req.Order),
+		state.ListWithPage(page),
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.ListBuildsResponse{Builds: result}, nil
+	return &pb.ListBuildsResponse{
+		Builds:        result,
+		NextPageToken: page.NextToken,
+	}, nil
 }
 
 func (s *service) GetLatestBuild(
@@ -0,0 +1,150 @@
+package singleprocess
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/server/ptypes"
+	"github.com/hashicorp/waypoint/internal/server/singleprocess/state"
+)
+
+// maxBuildCacheSize is the maximum size of the data for a single build
+// cache key. The data is written in a single database transaction so this
+// also bounds the memory used by an upload.
+const maxBuildCacheSize = 1024 * 1024 * 1024 // 1 GB
+
+func (s *service) UploadBuildCache(
+	srv pb.Waypoint_UploadBuildCacheServer,
+) error {
+	log := hclog.FromContext(srv.Context())
+
+	// Read our first event which must be an Open event.
+	log.Trace("waiting for Open message")
+	req, err := srv.Recv()
+	if err != nil {
+		return err
+	}
+	open, ok := req.Event.(*pb.UploadBuildCacheRequest_Open_)
+	if !ok {
+		return status.Errorf(codes.FailedPrecondition,
+			"first message must be Open type")
+	}
+	if err := ptypes.ValidateUploadBuildCacheOpen(open.Open); err != nil {
+		return err
+	}
+
+	// We buffer the data to a temporary file so that we know the digest
+	// before we store it. This lets us skip storing data we already have.
+	f, err := ioutil.TempFile("", "waypoint-build-cache")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	h := sha256.New()
+	bw := bufio.NewWriterSize(io.MultiWriter(f, h), 1024*1024)
+	var size uint64
+	for {
+		req, err := srv.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		chunk, ok := req.Event.(*pb.UploadBuildCacheRequest_Chunk)
+		if !ok {
+			return status.Errorf(codes.FailedPrecondition,
+				"all messages after Open must be data chunks")
+		}
+
+		size += uint64(len(chunk.Chunk))
+		if size > maxBuildCacheSize {
+			return status.Errorf(codes.ResourceExhausted,
+				"build cache data exceeds the maximum size of %d bytes", maxBuildCacheSize)
+		}
+
+		if _, err := bw.Write(chunk.Chunk); err != nil {
+			return status.Errorf(codes.Aborted,
+				"error writing request data: %s", err)
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	entry := &pb.BuildCacheEntry{
+		Application: open.Open.Application,
+		Key:         open.Open.Key,
+		Digest:      state.BuildCacheDigest(h.Sum(nil)),
+		Size:        size,
+	}
+	if err := s.state.BuildCachePut(entry, bufio.NewReader(f)); err != nil {
+		return err
+	}
+
+	log.Debug("stored build cache data",
+		"key", entry.Key, "digest", entry.Digest, "size", entry.Size)
+	return srv.SendAndClose(&pb.UploadBuildCacheResponse{Entry: entry})
+}
+
+func (s *service) DownloadBuildCache(
+	req *pb.DownloadBuildCacheRequest,
+	srv pb.Waypoint_DownloadBuildCacheServer,
+) error {
+	if err := ptypes.ValidateDownloadBuildCacheRequest(req); err != nil {
+		return err
+	}
+
+	entry, err := s.state.BuildCacheGet(req.Application, req.Key)
+	if err != nil {
+		return err
+	}
+
+	if err := srv.Send(&pb.DownloadBuildCacheResponse{
+		Event: &pb.DownloadBuildCacheResponse_Open_{
+			Open: &pb.DownloadBuildCacheResponse_Open{
+				Entry: entry,
+			},
+		},
+	}); err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriterSize(&buildCacheWriter{srv: srv}, 1024*1024)
+	if err := s.state.BuildCacheRead(entry.Digest, bw); err != nil {
+		return err
+	}
+
+	return bw.Flush()
+}
+
+type buildCacheWriter struct {
+	srv pb.Waypoint_DownloadBuildCacheServer
+}
+
+func (w *buildCacheWriter) Write(p []byte) (int, error) {
+	// Ignore empty data.
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	return len(p), w.srv.Send(&pb.DownloadBuildCacheResponse{
+		Event: &pb.DownloadBuildCacheResponse_Chunk{
+			Chunk: p,
+		},
+	})
+}
@@ -0,0 +1,113 @@
+package singleprocess
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/internal/server"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestServiceBuildCache(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	app := &pb.Ref_Application{Project: "p", Application: "a"}
+	data := bytes.Repeat([]byte("layer"), 1024*1024)
+
+	// Upload in a few chunks
+	upload, err := client.UploadBuildCache(ctx)
+	require.NoError(err)
+	require.NoError(upload.Send(&pb.UploadBuildCacheRequest{
+		Event: &pb.UploadBuildCacheRequest_Open_{
+			Open: &pb.UploadBuildCacheRequest_Open{
+				Application: app,
+				Key:         "docker",
+			},
+		},
+	}))
+	for buf := data; len(buf) > 0; {
+		n := 1024 * 1024
+		if n > len(buf) {
+			n = len(buf)
+		}
+
+		require.NoError(upload.Send(&pb.UploadBuildCacheRequest{
+			Event: &pb.UploadBuildCacheRequest_Chunk{Chunk: buf[:n]},
+		}))
+		buf = buf[n:]
+	}
+	uploadResp, err := upload.CloseAndRecv()
+	require.NoError(err)
+	require.Equal(uint64(len(data)), uploadResp.Entry.Size)
+	require.NotEmpty(uploadResp.Entry.Digest)
+
+	// Download
+	download, err := client.DownloadBuildCache(ctx, &pb.DownloadBuildCacheRequest{
+		Application: app,
+		Key:         "docker",
+	})
+	require.NoError(err)
+
+	resp, err := download.Recv()
+	require.NoError(err)
+	require.IsType((*pb.DownloadBuildCacheResponse_Open_)(nil), resp.Event)
+	open := resp.Event.(*pb.DownloadBuildCacheResponse_Open_).Open
+	require.Equal(uploadResp.Entry.Digest, open.Entry.Digest)
+
+	var buf bytes.Buffer
+	for {
+		resp, err := download.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(err)
+		require.IsType((*pb.DownloadBuildCacheResponse_Chunk)(nil), resp.Event)
+		buf.Write(resp.Event.(*pb.DownloadBuildCacheResponse_Chunk).Chunk)
+	}
+	require.Equal(data, buf.Bytes())
+
+	// Download a missing key
+	download, err = client.DownloadBuildCache(ctx, &pb.DownloadBuildCacheRequest{
+		Application: app,
+		Key:         "nope",
+	})
+	require.NoError(err)
+	_, err = download.Recv()
+	require.Error(err)
+	require.Equal(codes.NotFound, status.Code(err))
+}
+
+func TestServiceUploadBuildCache_badOpen(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	stream, err := client.UploadBuildCache(ctx)
+	require.NoError(err)
+	require.NoError(stream.Send(&pb.UploadBuildCacheRequest{
+		Event: &pb.UploadBuildCacheRequest_Chunk{
+			Chunk: []byte("Hello"),
+		},
+	}))
+
+	resp, err := stream.CloseAndRecv()
+	require.Error(err)
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+	require.Nil(resp)
+}
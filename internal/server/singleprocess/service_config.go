@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 )
@@ -12,13 +14,65 @@ func (s *service) SetConfig(
 	ctx context.Context,
 	req *pb.ConfigSetRequest,
 ) (*pb.ConfigSetResponse, error) {
-	if err := s.state.ConfigSet(req.Variables...); err != nil {
+	if err := s.state.ConfigSetBy(s.userFromContext(ctx), req.Variables...); err != nil {
 		return nil, err
 	}
 
 	return &pb.ConfigSetResponse{}, nil
 }
 
+func (s *service) GetConfigHistory(
+	ctx context.Context,
+	req *pb.GetConfigHistoryRequest,
+) (*pb.GetConfigHistoryResponse, error) {
+	if err := validateConfigVarRef(req.Variable); err != nil {
+		return nil, err
+	}
+
+	versions, err := s.state.ConfigHistory(req.Variable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetConfigHistoryResponse{Versions: versions}, nil
+}
+
+func (s *service) RollbackConfig(
+	ctx context.Context,
+	req *pb.RollbackConfigRequest,
+) (*pb.RollbackConfigResponse, error) {
+	if err := validateConfigVarRef(req.Variable); err != nil {
+		return nil, err
+	}
+	if req.Version == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "version is required")
+	}
+
+	version, err := s.state.ConfigRollback(s.userFromContext(ctx), req.Variable, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RollbackConfigResponse{Version: version}, nil
+}
+
+// validateConfigVarRef validates a config var that identifies a variable
+// by its scope, name and workspace.
+func validateConfigVarRef(v *pb.ConfigVar) error {
+	switch {
+	case v == nil:
+		return status.Errorf(codes.InvalidArgument, "variable is required")
+
+	case v.Scope == nil:
+		return status.Errorf(codes.InvalidArgument, "variable scope is required")
+
+	case v.Name == "":
+		return status.Errorf(codes.InvalidArgument, "variable name is required")
+	}
+
+	return nil
+}
+
 func (s *service) GetConfig(
 	ctx context.Context,
 	req *pb.ConfigGetRequest,
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/waypoint/internal/server"
@@ -67,6 +69,68 @@ func TestServiceConfig(t *testing.T) {
 	})
 }
 
+func TestServiceConfigHistory(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	configVar := func(value string) *pb.ConfigVar {
+		return &pb.ConfigVar{
+			Scope: &pb.ConfigVar_Project{
+				Project: &pb.Ref_Project{Project: "foo"},
+			},
+
+			Name:  "PORT",
+			Value: &pb.ConfigVar_Static{Static: value},
+		}
+	}
+
+	for _, v := range []string{"8080", "9090"} {
+		_, err := client.SetConfig(ctx, &pb.ConfigSetRequest{
+			Variables: []*pb.ConfigVar{configVar(v)},
+		})
+		require.NoError(err)
+	}
+
+	// Invalid requests
+	_, err = client.GetConfigHistory(ctx, &pb.GetConfigHistoryRequest{})
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = client.RollbackConfig(ctx, &pb.RollbackConfigRequest{Variable: configVar("")})
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	// History
+	resp, err := client.GetConfigHistory(ctx, &pb.GetConfigHistoryRequest{
+		Variable: configVar(""),
+	})
+	require.NoError(err)
+	require.Len(resp.Versions, 2)
+	require.Equal(uint64(2), resp.Versions[0].Version)
+
+	// Rollback
+	rollbackResp, err := client.RollbackConfig(ctx, &pb.RollbackConfigRequest{
+		Variable: configVar(""),
+		Version:  1,
+	})
+	require.NoError(err)
+	require.Equal(uint64(3), rollbackResp.Version.Version)
+	require.Equal(uint64(1), rollbackResp.Version.RollbackVersion)
+
+	getResp, err := client.GetConfig(ctx, &pb.ConfigGetRequest{
+		Scope: &pb.ConfigGetRequest_Project{
+			Project: &pb.Ref_Project{Project: "foo"},
+		},
+	})
+	require.NoError(err)
+	require.Len(getResp.Variables, 1)
+	require.Equal("8080", getResp.Variables[0].Value.(*pb.ConfigVar_Static).Static)
+}
+
 func TestServiceConfigSource(t *testing.T) {
 	ctx := context.Background()
 
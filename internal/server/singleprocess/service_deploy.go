@@ -33,12 +33,50 @@ func (s *service) UpsertDeployment(
 
 		// Specify the id
 		result.Id = id
+
+		// If the job that created this is a promotion, record where the
+		// deployment was promoted from.
+		if result.JobId != "" && result.Promotion == nil {
+			job, err := s.state.JobById(result.JobId, nil)
+			if err != nil {
+				return nil, err
+			}
+			if job != nil {
+				if op := job.GetDeploy(); op != nil {
+					result.Promotion = op.Promotion
+				}
+			}
+		}
+	}
+
+	// Check if the deployment just completed so we can notify and record
+	// an event about it.
+	completed := result.Status.GetState() == pb.Status_SUCCESS
+	if completed && !insert {
+		existing, err := s.state.DeploymentGet(&pb.Ref_Operation{
+			Target: &pb.Ref_Operation_Id{Id: result.Id},
+		})
+		if err != nil && status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+		if existing.GetStatus().GetState() == pb.Status_SUCCESS {
+			completed = false
+		}
 	}
 
 	if err := s.state.DeploymentPut(!insert, result); err != nil {
 		return nil, err
 	}
 
+	if completed {
+		log := hclog.FromContext(ctx)
+		s.notify(log, result.Application, result.Workspace,
+			pb.Notifier_DEPLOY_COMPLETE, "deployment v%d completed", result.Sequence)
+		s.appEvent(log, result.Application, result.Workspace,
+			pb.AppEvent_DEPLOYMENT_CREATED, result.Id, result.Sequence,
+			"deployment v%d created", result.Sequence)
+	}
+
 	// This requires: (1) URL service is enabled (2) auto hostname isn't
 	// explicitly set to false in the request and (3) either the server
 	// default is true or we explicitly ask for it.
@@ -79,11 +117,14 @@ func (s *service) ListDeployments(
 	ctx context.Context,
 	req *pb.ListDeploymentsRequest,
 ) (*pb.ListDeploymentsResponse, error) {
+	page := &state.Page{Size: int(req.PageSize), Token: req.PageToken}
 	result, err := s.state.DeploymentList(req.Application,
 		state.ListWithStatusFilter(req.Status...),
 		state.ListWithOrder(req.Order),
 		state.ListWithWorkspace(req.Workspace),
 		state.ListWithPhysicalState(req.PhysicalState),
+		state.ListWithFilter(req.Filter),
+		state.ListWithPage(page),
 	)
 	if err != nil {
 		return nil, err
@@ -99,7 +140,10 @@ func (s *service) ListDeployments(
 		}
 	}
 
-	return &pb.ListDeploymentsResponse{Deployments: result}, nil
+	return &pb.ListDeploymentsResponse{
+		Deployments:   result,
+		NextPageToken: page.NextToken,
+	}, nil
 }
 
 func setDeploymentUrlIfNeeded(d *pb.Deployment) {
@@ -0,0 +1,165 @@
+package singleprocess
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func (s *service) GetDeploymentDiff(
+	ctx context.Context,
+	req *pb.GetDeploymentDiffRequest,
+) (*pb.GetDeploymentDiffResponse, error) {
+	if err := ptypes.ValidateGetDeploymentDiffRequest(req); err != nil {
+		return nil, err
+	}
+
+	from, err := s.GetDeployment(ctx, &pb.GetDeploymentRequest{
+		Ref:         req.From,
+		LoadDetails: pb.Deployment_ARTIFACT,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := s.GetDeployment(ctx, &pb.GetDeploymentRequest{
+		Ref:         req.To,
+		LoadDetails: pb.Deployment_ARTIFACT,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	changes := diffValues(pb.GetDeploymentDiffResponse_ARTIFACT,
+		artifactDiffValues(from.Preload.Artifact),
+		artifactDiffValues(to.Preload.Artifact),
+	)
+
+	// Deployments created before snapshots were recorded can only be
+	// compared by their artifacts.
+	if from.Snapshot != nil && to.Snapshot != nil {
+		changes = append(changes, diffValues(pb.GetDeploymentDiffResponse_CONFIG,
+			configDiffValues(from.Snapshot.ConfigVars),
+			configDiffValues(to.Snapshot.ConfigVars),
+		)...)
+
+		changes = append(changes, diffValues(pb.GetDeploymentDiffResponse_VARIABLE,
+			from.Snapshot.Variables,
+			to.Snapshot.Variables,
+		)...)
+
+		if !bytes.Equal(from.Snapshot.WaypointHcl, to.Snapshot.WaypointHcl) {
+			changes = append(changes, &pb.GetDeploymentDiffResponse_Change{
+				Section: pb.GetDeploymentDiffResponse_WAYPOINT_HCL,
+				Name:    "waypoint.hcl",
+				From:    string(from.Snapshot.WaypointHcl),
+				To:      string(to.Snapshot.WaypointHcl),
+			})
+		}
+	}
+
+	return &pb.GetDeploymentDiffResponse{
+		From:    from,
+		To:      to,
+		Changes: changes,
+	}, nil
+}
+
+// diffValues returns the changes between two sets of named values, sorted
+// by name.
+func diffValues(
+	section pb.GetDeploymentDiffResponse_Section,
+	from, to map[string]string,
+) []*pb.GetDeploymentDiffResponse_Change {
+	var names []string
+	for k := range from {
+		names = append(names, k)
+	}
+	for k := range to {
+		if _, ok := from[k]; !ok {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+
+	var result []*pb.GetDeploymentDiffResponse_Change
+	for _, name := range names {
+		fromV, fromOk := from[name]
+		toV, toOk := to[name]
+		if fromOk == toOk && fromV == toV {
+			continue
+		}
+
+		result = append(result, &pb.GetDeploymentDiffResponse_Change{
+			Section: section,
+			Name:    name,
+			From:    fromV,
+			To:      toV,
+		})
+	}
+
+	return result
+}
+
+// artifactDiffValues returns the values of an artifact that are compared.
+// Template data is flattened so that each field is compared separately.
+func artifactDiffValues(a *pb.PushedArtifact) map[string]string {
+	result := map[string]string{}
+	if a == nil {
+		return result
+	}
+
+	result["id"] = a.Id
+	result["sequence"] = fmt.Sprintf("v%d", a.Sequence)
+	if a.Component != nil {
+		result["component"] = a.Component.Name
+	}
+	for k, v := range a.Labels {
+		result["label."+k] = v
+	}
+
+	if len(a.TemplateData) > 0 {
+		var data map[string]interface{}
+		if err := json.Unmarshal(a.TemplateData, &data); err == nil {
+			for k, v := range data {
+				result["template."+k] = fmt.Sprint(v)
+			}
+		}
+	}
+
+	return result
+}
+
+// configDiffValues returns the config variables formatted as strings. The
+// config of dynamic values is included since changing it changes the value.
+func configDiffValues(vars []*pb.ConfigVar) map[string]string {
+	result := map[string]string{}
+	for _, cv := range vars {
+		var value string
+		switch v := cv.Value.(type) {
+		case *pb.ConfigVar_Static:
+			value = v.Static
+
+		case *pb.ConfigVar_Dynamic:
+			var config []string
+			for k, v := range v.Dynamic.Config {
+				config = append(config, k+"="+v)
+			}
+			sort.Strings(config)
+			value = fmt.Sprintf("<dynamic via %s: %s>", v.Dynamic.From, strings.Join(config, ", "))
+
+		default:
+			continue
+		}
+
+		result[cv.Name] = value
+	}
+
+	return result
+}
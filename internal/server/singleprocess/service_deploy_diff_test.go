@@ -0,0 +1,84 @@
+package singleprocess
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/server"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestServiceGetDeploymentDiff(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	// Create two artifacts and a deployment of each
+	var deployIds []string
+	for i, tag := range []string{"v1", "v2"} {
+		artifactResp, err := client.UpsertPushedArtifact(ctx, &pb.UpsertPushedArtifactRequest{
+			Artifact: serverptypes.TestValidArtifact(t, &pb.PushedArtifact{
+				TemplateData: []byte(`{"Image":"web","Tag":"` + tag + `"}`),
+			}),
+		})
+		require.NoError(err)
+
+		snapshot := &pb.Deployment_Snapshot{
+			WaypointHcl: []byte(`project = "p_test"`),
+			ConfigVars: []*pb.ConfigVar{
+				{
+					Name:  "PORT",
+					Value: &pb.ConfigVar_Static{Static: "8080"},
+				},
+			},
+			Variables: map[string]string{"replicas": "2"},
+		}
+		if i == 1 {
+			snapshot.ConfigVars[0].Value = &pb.ConfigVar_Static{Static: "9090"}
+			snapshot.Variables["region"] = "us-east-1"
+		}
+
+		deployResp, err := client.UpsertDeployment(ctx, &pb.UpsertDeploymentRequest{
+			Deployment: serverptypes.TestValidDeployment(t, &pb.Deployment{
+				ArtifactId: artifactResp.Artifact.Id,
+				Snapshot:   snapshot,
+			}),
+		})
+		require.NoError(err)
+		deployIds = append(deployIds, deployResp.Deployment.Id)
+	}
+
+	resp, err := client.GetDeploymentDiff(ctx, &pb.GetDeploymentDiffRequest{
+		From: &pb.Ref_Operation{Target: &pb.Ref_Operation_Id{Id: deployIds[0]}},
+		To:   &pb.Ref_Operation{Target: &pb.Ref_Operation_Id{Id: deployIds[1]}},
+	})
+	require.NoError(err)
+	require.Equal(deployIds[0], resp.From.Id)
+	require.Equal(deployIds[1], resp.To.Id)
+
+	changes := map[string]*pb.GetDeploymentDiffResponse_Change{}
+	for _, c := range resp.Changes {
+		changes[c.Section.String()+"/"+c.Name] = c
+	}
+
+	require.Contains(changes, "ARTIFACT/id")
+	require.Equal("v1", changes["ARTIFACT/template.Tag"].From)
+	require.Equal("v2", changes["ARTIFACT/template.Tag"].To)
+	require.NotContains(changes, "ARTIFACT/template.Image")
+
+	require.Equal("8080", changes["CONFIG/PORT"].From)
+	require.Equal("9090", changes["CONFIG/PORT"].To)
+
+	require.Empty(changes["VARIABLE/region"].From)
+	require.Equal("us-east-1", changes["VARIABLE/region"].To)
+	require.NotContains(changes, "VARIABLE/replicas")
+
+	require.NotContains(changes, "WAYPOINT_HCL/waypoint.hcl")
+}
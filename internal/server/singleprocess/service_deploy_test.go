@@ -48,6 +48,49 @@ func TestServiceDeployment(t *testing.T) {
 		require.Equal(pb.Status_RUNNING, result.Status.State)
 	})
 
+	t.Run("create from a promotion job", func(t *testing.T) {
+		require := require.New(t)
+
+		promotion := &pb.Deployment_Promotion{
+			FromWorkspace: &pb.Ref_Workspace{Workspace: "staging"},
+			DeploymentId:  "d_staging",
+		}
+
+		// Queue the job that promotes the deployment
+		queueResp, err := client.QueueJob(ctx, &pb.QueueJobRequest{
+			Job: serverptypes.TestJobNew(t, &pb.Job{
+				Operation: &pb.Job_Deploy{
+					Deploy: &pb.Job_DeployOp{
+						Artifact:  serverptypes.TestValidArtifact(t, nil),
+						Promotion: promotion,
+					},
+				},
+			}),
+		})
+		require.NoError(err)
+
+		// Create the deployment for the job
+		resp, err := client.UpsertDeployment(ctx, &Req{
+			Deployment: serverptypes.TestValidDeployment(t, &pb.Deployment{
+				JobId: queueResp.JobId,
+			}),
+		})
+		require.NoError(err)
+		require.NotNil(resp.Deployment.Promotion)
+		require.Equal("staging", resp.Deployment.Promotion.FromWorkspace.Workspace)
+		require.Equal("d_staging", resp.Deployment.Promotion.DeploymentId)
+
+		// The lineage is stored
+		getResp, err := client.GetDeployment(ctx, &pb.GetDeploymentRequest{
+			Ref: &pb.Ref_Operation{
+				Target: &pb.Ref_Operation_Id{Id: resp.Deployment.Id},
+			},
+		})
+		require.NoError(err)
+		require.NotNil(getResp.Promotion)
+		require.Equal("d_staging", getResp.Promotion.DeploymentId)
+	})
+
 	t.Run("update non-existent", func(t *testing.T) {
 		require := require.New(t)
 
@@ -94,9 +94,11 @@ func (s *service) EntrypointConfig(
 		config := &pb.EntrypointConfig{}
 		for _, exec := range execs {
 			config.Exec = append(config.Exec, &pb.EntrypointConfig_Exec{
-				Index: exec.Id,
-				Args:  exec.Args,
-				Pty:   exec.Pty,
+				Index:       exec.Id,
+				Args:        exec.Args,
+				Pty:         exec.Pty,
+				PortForward: exec.PortForward,
+				FileCopy:    exec.FileCopy,
 			})
 		}
 
@@ -106,11 +108,13 @@ func (s *service) EntrypointConfig(
 			Labels:    deployment.Labels,
 		}
 
-		// Get the config vars in use
+		// Get the config vars in use, including any overrides for the
+		// workspace of the deployment.
 		vars, err := s.state.ConfigGetWatch(&pb.ConfigGetRequest{
 			Scope: &pb.ConfigGetRequest_Application{
 				Application: deployment.Application,
 			},
+			Workspace: deployment.Workspace,
 		}, ws)
 		if err != nil {
 			return err
@@ -124,6 +128,14 @@ func (s *service) EntrypointConfig(
 			return err
 		}
 
+		// Send down the health checks the entrypoint should run. These
+		// aren't watched, they're updated on the next deploy.
+		app, err := s.state.AppGet(deployment.Application)
+		if err != nil {
+			return err
+		}
+		config.HealthChecks = app.HealthChecks
+
 		// Get the config sources we need for our vars. We only do this if
 		// at least one var has a dynamic value.
 		if varContainsDynamic(vars) {
@@ -204,6 +216,7 @@ func (s *service) EntrypointLogStream(
 	log := hclog.FromContext(server.Context())
 
 	var buf *logbuffer.Buffer
+	var instance *state.Instance
 	for {
 		// Read the next log entry
 		batch, err := server.Recv()
@@ -216,7 +229,7 @@ func (s *service) EntrypointLogStream(
 			log = log.With("instance_id", batch.InstanceId)
 
 			// Read our instance record
-			instance, err := s.state.InstanceById(batch.InstanceId)
+			instance, err = s.state.InstanceById(batch.InstanceId)
 			if err != nil {
 				if status.Code(err) == codes.NotFound {
 					// See if we have a instance logs entry to use instead.
@@ -258,6 +271,18 @@ func (s *service) EntrypointLogStream(
 
 		// Write our log data to the circular buffer
 		buf.Write(entries...)
+
+		// Archive the lines of instances if we have a log archive. The
+		// lines of logs plugins aren't archived since they're already
+		// stored by the platform.
+		if s.logArchive != nil && instance != nil {
+			s.logArchive.Append(instance, batch.Lines)
+		}
+
+		// Forward the lines of instances to the log sinks.
+		if s.logForwarder != nil && instance != nil {
+			s.logForwarder.Append(instance, batch.Lines)
+		}
 	}
 }
 
@@ -484,6 +509,23 @@ func (s *service) handleClientExecRequest(
 	return nil
 }
 
+func (s *service) EntrypointHealthReport(
+	ctx context.Context,
+	req *pb.EntrypointHealthReportRequest,
+) (*empty.Empty, error) {
+	if req.InstanceId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "instance_id is required")
+	}
+
+	// We only keep the latest results, they're added to the status reports
+	// of the deployment when they're generated.
+	if err := s.state.InstanceHealthChecksSet(req.InstanceId, req.Results); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
 // varContainsDynamic returns true if there are any dynamic values in the list.
 func varContainsDynamic(vars []*pb.ConfigVar) bool {
 	for _, v := range vars {
@@ -286,6 +286,80 @@ func TestServiceEntrypointConfig(t *testing.T) {
 	})
 }
 
+func TestServiceEntrypointHealthReport(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	// Create a deployment
+	resp, err := client.UpsertDeployment(ctx, &pb.UpsertDeploymentRequest{
+		Deployment: serverptypes.TestValidDeployment(t, nil),
+	})
+	require.NoError(err)
+	dep := resp.Deployment
+
+	// Register an instance
+	instanceId, err := server.Id()
+	require.NoError(err)
+	stream, err := client.EntrypointConfig(ctx, &pb.EntrypointConfigRequest{
+		InstanceId:   instanceId,
+		DeploymentId: dep.Id,
+	})
+	require.NoError(err)
+	_, err = stream.Recv()
+	require.NoError(err)
+
+	// An instance ID is required
+	_, err = client.EntrypointHealthReport(ctx, &pb.EntrypointHealthReportRequest{})
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	// Unknown instances are rejected
+	_, err = client.EntrypointHealthReport(ctx, &pb.EntrypointHealthReportRequest{
+		InstanceId: "nope",
+	})
+	require.Error(err)
+	require.Equal(codes.NotFound, status.Code(err))
+
+	// Report our results
+	_, err = client.EntrypointHealthReport(ctx, &pb.EntrypointHealthReportRequest{
+		InstanceId: instanceId,
+		Results: []*pb.HealthCheckResult{
+			{Name: "web", HealthStatus: "READY"},
+			{Name: "db", HealthStatus: "DOWN", HealthMessage: "connection refused"},
+		},
+	})
+	require.NoError(err)
+
+	// A completed status report of the deployment includes the results
+	report := serverptypes.TestValidStatusReport(t, &pb.StatusReport{
+		TargetId: &pb.StatusReport_DeploymentId{DeploymentId: dep.Id},
+		Status:   server.NewStatus(pb.Status_SUCCESS),
+		Health:   &pb.StatusReport_Health{HealthStatus: "READY"},
+	})
+	srResp, err := client.UpsertStatusReport(ctx, &pb.UpsertStatusReportRequest{
+		StatusReport: report,
+	})
+	require.NoError(err)
+	result := srResp.StatusReport
+	require.Len(result.Resources, 2)
+	require.Equal("health-check", result.Resources[0].Type)
+	require.Len(result.ResourcesHealth, 3)
+	require.Equal("PARTIAL", result.Health.HealthStatus)
+
+	// Upserting again doesn't duplicate the results
+	srResp, err = client.UpsertStatusReport(ctx, &pb.UpsertStatusReportRequest{
+		StatusReport: result,
+	})
+	require.NoError(err)
+	require.Len(srResp.StatusReport.Resources, 2)
+	require.Len(srResp.StatusReport.ResourcesHealth, 3)
+}
+
 func TestServiceEntrypointExecStream_badOpen(t *testing.T) {
 	ctx := context.Background()
 	require := require.New(t)
@@ -45,15 +45,33 @@ func (s *service) StartExecStream(
 	execRec := &state.InstanceExec{
 		Args:              start.Start.Args,
 		Pty:               start.Start.Pty,
+		PortForward:       start.Start.PortForward,
+		FileCopy:          start.Start.FileCopy,
 		ClientEventCh:     clientEventCh,
 		EntrypointEventCh: eventCh,
 		Context:           srv.Context(),
 	}
 
+	// Port forwarding and file copies are implemented by the entrypoint, so
+	// they aren't supported by virtual instances or exec plugins.
+	entrypointOnly := start.Start.PortForward != nil || start.Start.FileCopy != nil
+
 	// Register the exec session
 	switch t := start.Start.Target.(type) {
 	case *pb.ExecStreamRequest_Start_InstanceId:
 		log = log.With("instance_id", t.InstanceId)
+
+		if entrypointOnly {
+			inst, err := s.state.InstanceById(t.InstanceId)
+			if err != nil {
+				return err
+			}
+			if inst.Type == pb.Instance_VIRTUAL {
+				return status.Errorf(codes.FailedPrecondition,
+					"port forwarding and file copies aren't supported for virtual instances")
+			}
+		}
+
 		err = s.state.InstanceExecCreateByTargetedInstance(t.InstanceId, execRec)
 		if err != nil {
 			return err
@@ -73,6 +91,18 @@ func (s *service) StartExecStream(
 		// We need to spawn a job that will in turn spawn a virtual CEB
 		// that will connect back and create an instance exec record for us
 		// to use.
+		// Without the entrypoint no instances will connect for the exec.
+		if deployment.EntrypointDisabled && (!deployment.HasExecPlugin || entrypointOnly) {
+			return status.Errorf(codes.FailedPrecondition,
+				"the deployment runs without the entrypoint and its platform "+
+					"doesn't support this")
+		}
+
+		if deployment.HasExecPlugin && entrypointOnly {
+			return status.Errorf(codes.FailedPrecondition,
+				"port forwarding and file copies aren't supported for deployments with an exec plugin")
+		}
+
 		if deployment.HasExecPlugin {
 			instId, err := server.Id()
 			if err != nil {
@@ -185,6 +215,14 @@ func (s *service) StartExecStream(
 	// Make sure we always deregister it
 	defer s.state.InstanceExecDelete(execRec.Id)
 
+	// Record the session. If we can't record it, we don't allow the exec
+	// since the record of who accessed instances is required for auditing.
+	rec, err := s.recordExecSession(srv.Context(), log, start.Start, execRec)
+	if err != nil {
+		return err
+	}
+	defer rec.end()
+
 	// Always send the open message. In the future we'll send some metadata here.
 	if err := srv.Send(&pb.ExecStreamResponse{
 		Event: &pb.ExecStreamResponse_Open_{
@@ -213,6 +251,10 @@ func (s *service) StartExecStream(
 				return
 			}
 
+			if input, ok := resp.Event.(*pb.ExecStreamRequest_Input_); ok {
+				rec.write(pb.ExecSession_TranscriptEntry_STDIN, input.Input.Data)
+			}
+
 			clientEventCh <- resp
 		}
 	}()
@@ -237,7 +279,7 @@ func (s *service) StartExecStream(
 				return nil
 			}
 
-			exit, err := s.handleEntrypointExecRequest(log, srv, entryReq)
+			exit, err := s.handleEntrypointExecRequest(log, srv, rec, entryReq)
 			if exit || err != nil {
 				return err
 			}
@@ -248,6 +290,7 @@ func (s *service) StartExecStream(
 func (s *service) handleEntrypointExecRequest(
 	log hclog.Logger,
 	srv pb.Waypoint_StartExecStreamServer,
+	rec *execSessionRecorder,
 	entryReq *pb.EntrypointExecRequest,
 ) (bool, error) {
 	log.Trace("event received from entrypoint", "event", entryReq.Event)
@@ -255,6 +298,12 @@ func (s *service) handleEntrypointExecRequest(
 	exit := false
 	switch event := entryReq.Event.(type) {
 	case *pb.EntrypointExecRequest_Output_:
+		channel := pb.ExecSession_TranscriptEntry_STDOUT
+		if event.Output.Channel == pb.EntrypointExecRequest_Output_STDERR {
+			channel = pb.ExecSession_TranscriptEntry_STDERR
+		}
+		rec.write(channel, event.Output.Data)
+
 		send = &pb.ExecStreamResponse{
 			Event: &pb.ExecStreamResponse_Output_{
 				Output: &pb.ExecStreamResponse_Output{
@@ -266,6 +315,7 @@ func (s *service) handleEntrypointExecRequest(
 
 	case *pb.EntrypointExecRequest_Exit_:
 		exit = true
+		rec.exit(event.Exit.Code)
 		send = &pb.ExecStreamResponse{
 			Event: &pb.ExecStreamResponse_Exit_{
 				Exit: &pb.ExecStreamResponse_Exit{
@@ -276,6 +326,7 @@ func (s *service) handleEntrypointExecRequest(
 	case *pb.EntrypointExecRequest_Error_:
 		log.Warn("error observed processing entrypoint exec stream", "error", event.Error.Error)
 		exit = true
+		rec.exit(1)
 		send = &pb.ExecStreamResponse{
 			Event: &pb.ExecStreamResponse_Exit_{
 				Exit: &pb.ExecStreamResponse_Exit{
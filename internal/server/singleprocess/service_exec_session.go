@@ -0,0 +1,179 @@
+package singleprocess
+
+import (
+	"context"
+	"sync"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-hclog"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+	"github.com/hashicorp/waypoint/internal/server/singleprocess/state"
+)
+
+// execSessionsDefaultLimit is the number of exec sessions listed if the
+// request has no limit.
+const execSessionsDefaultLimit = 100
+
+// execTranscriptMax is the maximum number of bytes of input and output
+// recorded in the transcript of an exec session. Later input and output
+// isn't recorded so that a long running session can't fill the database.
+const execTranscriptMax = 1 << 20
+
+func (s *service) ListExecSessions(
+	ctx context.Context,
+	req *pb.ListExecSessionsRequest,
+) (*pb.ListExecSessionsResponse, error) {
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = execSessionsDefaultLimit
+	}
+
+	sessions, err := s.state.ExecSessionList(func(e *pb.ExecSession) bool {
+		if ref := req.Application; ref != nil {
+			if ref.Project != e.Application.GetProject() ||
+				(ref.Application != "" && ref.Application != e.Application.GetApplication()) {
+				return false
+			}
+		}
+
+		if ref := req.Workspace; ref != nil && ref.Workspace != e.Workspace.GetWorkspace() {
+			return false
+		}
+
+		// Like audit events, we match the user by the values recorded in
+		// the session so that sessions of deleted users can be listed.
+		switch ref := req.User.GetRef().(type) {
+		case *pb.Ref_User_Id:
+			return ref.Id.Id == e.UserId
+		case *pb.Ref_User_Username:
+			return ref.Username.Username == e.Username
+		}
+
+		return true
+	}, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListExecSessionsResponse{Sessions: sessions}, nil
+}
+
+func (s *service) GetExecSession(
+	ctx context.Context,
+	req *pb.GetExecSessionRequest,
+) (*pb.ExecSession, error) {
+	if err := serverptypes.ValidateGetExecSessionRequest(req); err != nil {
+		return nil, err
+	}
+
+	return s.state.ExecSessionGet(req.SessionId)
+}
+
+// execSessionRecorder records an exec session in the state. It is safe to
+// use concurrently since the input and output of the session are recorded
+// from different goroutines.
+type execSessionRecorder struct {
+	state state.Interface
+	log   hclog.Logger
+
+	mu      sync.Mutex
+	session *pb.ExecSession
+
+	// transcript is true if the input and output are recorded. They
+	// aren't for port forwarding and file copy sessions since they're raw
+	// data rather than a terminal. size is the number of bytes recorded.
+	transcript bool
+	size       int
+}
+
+// recordExecSession records the start of the exec session in the instance
+// of the exec record. The exec must already be registered so that its
+// instance is known. The session must be ended with end.
+func (s *service) recordExecSession(
+	ctx context.Context,
+	log hclog.Logger,
+	start *pb.ExecStreamRequest_Start,
+	execRec *state.InstanceExec,
+) (*execSessionRecorder, error) {
+	session := &pb.ExecSession{
+		InstanceId:      execRec.InstanceId,
+		Args:            start.Args,
+		Pty:             start.Pty.GetEnable(),
+		PortForwardPort: start.PortForward.GetPort(),
+		FileCopy:        start.FileCopy,
+		StartTime:       ptypes.TimestampNow(),
+		ExitCode:        -1,
+	}
+
+	if user := s.userFromContext(ctx); user != nil {
+		session.UserId = user.Id
+		session.Username = user.Username
+	}
+
+	// The instance is set when the exec is registered, so it should
+	// exist, but it may have exited right after.
+	if inst, err := s.state.InstanceById(execRec.InstanceId); err == nil {
+		inst := inst.Proto()
+		session.Application = inst.Application
+		session.Workspace = inst.Workspace
+		session.DeploymentId = inst.DeploymentId
+	}
+
+	if err := s.state.ExecSessionPut(session); err != nil {
+		return nil, err
+	}
+
+	return &execSessionRecorder{
+		state:      s.state,
+		log:        log.With("exec_session_id", session.Id),
+		session:    session,
+		transcript: s.execTranscripts && start.PortForward == nil && start.FileCopy == nil,
+	}, nil
+}
+
+// write records input or output of the session in the transcript.
+func (r *execSessionRecorder) write(
+	channel pb.ExecSession_TranscriptEntry_Channel,
+	data []byte,
+) {
+	if !r.transcript || len(data) == 0 {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.size+len(data) > execTranscriptMax {
+		r.session.TranscriptTruncated = true
+		return
+	}
+	r.size += len(data)
+
+	r.session.Transcript = append(r.session.Transcript, &pb.ExecSession_TranscriptEntry{
+		Time:    ptypes.TimestampNow(),
+		Channel: channel,
+		Data:    data,
+	})
+}
+
+// exit records the exit code of the command.
+func (r *execSessionRecorder) exit(code int32) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.session.ExitCode = code
+}
+
+// end records the end of the session along with its transcript.
+func (r *execSessionRecorder) end() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.session.EndTime = ptypes.TimestampNow()
+
+	// The exec has already ended, so all we can do is log an error.
+	if err := r.state.ExecSessionPut(r.session); err != nil {
+		r.log.Warn("failed to record the end of the exec session", "err", err)
+	}
+}
@@ -0,0 +1,121 @@
+package singleprocess
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/internal/server"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/serverconfig"
+)
+
+func TestServiceExecSession(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)), WithConfig(&serverconfig.Config{
+		ExecTranscripts: true,
+	}))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	// Create an instance
+	instanceId, deploymentId, closer := TestEntrypoint(t, client)
+	defer closer()
+
+	// Start stream
+	stream, err := client.StartExecStream(ctx)
+	require.NoError(err)
+	require.NoError(stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Start_{
+			Start: &pb.ExecStreamRequest_Start{
+				Target: &pb.ExecStreamRequest_Start_DeploymentId{
+					DeploymentId: deploymentId,
+				},
+				Args: []string{"sh"},
+			},
+		},
+	}))
+	defer stream.CloseSend()
+
+	// Should open
+	resp, err := stream.Recv()
+	require.NoError(err)
+	_, ok := resp.Event.(*pb.ExecStreamResponse_Open_)
+	require.True(ok, "should be an open")
+	exec := testGetInstanceExec(t, impl, instanceId)
+
+	// The running session is listed
+	list, err := client.ListExecSessions(ctx, &pb.ListExecSessionsRequest{})
+	require.NoError(err)
+	require.Len(list.Sessions, 1)
+	require.Equal(instanceId, list.Sessions[0].InstanceId)
+	require.Equal(deploymentId, list.Sessions[0].DeploymentId)
+	require.Equal([]string{"sh"}, list.Sessions[0].Args)
+	require.Nil(list.Sessions[0].EndTime)
+
+	// Send input and output
+	require.NoError(stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Input_{
+			Input: &pb.ExecStreamRequest_Input{Data: []byte("ls\n")},
+		},
+	}))
+	<-exec.ClientEventCh
+	exec.EntrypointEventCh <- &pb.EntrypointExecRequest{
+		Event: &pb.EntrypointExecRequest_Output_{
+			Output: &pb.EntrypointExecRequest_Output{
+				Channel: pb.EntrypointExecRequest_Output_STDERR,
+				Data:    []byte("nope\n"),
+			},
+		},
+	}
+	exec.EntrypointEventCh <- &pb.EntrypointExecRequest{
+		Event: &pb.EntrypointExecRequest_Exit_{
+			Exit: &pb.EntrypointExecRequest_Exit{Code: 3},
+		},
+	}
+
+	// Read until the stream ends
+	for {
+		_, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(err)
+	}
+
+	// The ended session is recorded with its transcript
+	list, err = client.ListExecSessions(ctx, &pb.ListExecSessionsRequest{
+		Application: &pb.Ref_Application{Project: "nope"},
+	})
+	require.NoError(err)
+	require.Empty(list.Sessions)
+
+	list, err = client.ListExecSessions(ctx, &pb.ListExecSessionsRequest{})
+	require.NoError(err)
+	require.Len(list.Sessions, 1)
+	require.Empty(list.Sessions[0].Transcript)
+
+	session, err := client.GetExecSession(ctx, &pb.GetExecSessionRequest{
+		SessionId: list.Sessions[0].Id,
+	})
+	require.NoError(err)
+	require.NotNil(session.EndTime)
+	require.Equal(int32(3), session.ExitCode)
+	require.Len(session.Transcript, 2)
+	require.Equal(pb.ExecSession_TranscriptEntry_STDIN, session.Transcript[0].Channel)
+	require.Equal("ls\n", string(session.Transcript[0].Data))
+	require.Equal(pb.ExecSession_TranscriptEntry_STDERR, session.Transcript[1].Channel)
+	require.Equal("nope\n", string(session.Transcript[1].Data))
+
+	// Unknown session
+	_, err = client.GetExecSession(ctx, &pb.GetExecSessionRequest{SessionId: "nope"})
+	require.Error(err)
+	require.Equal(codes.NotFound, status.Code(err))
+}
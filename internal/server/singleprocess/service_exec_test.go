@@ -497,3 +497,94 @@ func TestService_waitOnJobStarted(t *testing.T) {
 
 	require.Equal(pb.Job_ERROR, js)
 }
+
+func TestServiceStartExecStream_portForward(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	// Create an instance
+	instanceId, deploymentId, closer := TestEntrypoint(t, client)
+	defer closer()
+
+	// Start a port forward
+	stream, err := client.StartExecStream(ctx)
+	require.NoError(err)
+	require.NoError(stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Start_{
+			Start: &pb.ExecStreamRequest_Start{
+				Target: &pb.ExecStreamRequest_Start_DeploymentId{
+					DeploymentId: deploymentId,
+				},
+				PortForward: &pb.ExecStreamRequest_PortForward{
+					Port: 8080,
+				},
+			},
+		},
+	}))
+	defer stream.CloseSend()
+
+	// Should open
+	resp, err := stream.Recv()
+	require.NoError(err)
+	_, ok := resp.Event.(*pb.ExecStreamResponse_Open_)
+	require.True(ok, "should be an open")
+
+	// The exec record has the port
+	exec := testGetInstanceExec(t, impl, instanceId)
+	require.Empty(exec.Args)
+	require.NotNil(exec.PortForward)
+	require.Equal(int32(8080), exec.PortForward.Port)
+
+	// The session is recorded with the port
+	list, err := client.ListExecSessions(ctx, &pb.ListExecSessionsRequest{})
+	require.NoError(err)
+	require.Len(list.Sessions, 1)
+	require.Equal(int32(8080), list.Sessions[0].PortForwardPort)
+}
+
+func TestServiceStartExecStream_entrypointDisabled(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	// Create a deployment without the entrypoint or an exec plugin
+	resp, err := client.UpsertDeployment(ctx, &pb.UpsertDeploymentRequest{
+		Deployment: serverptypes.TestValidDeployment(t, &pb.Deployment{
+			Component: &pb.Component{
+				Name: "testapp",
+			},
+
+			EntrypointDisabled: true,
+		}),
+	})
+	require.NoError(err)
+
+	// Start exec
+	stream, err := client.StartExecStream(ctx)
+	require.NoError(err)
+	require.NoError(stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Start_{
+			Start: &pb.ExecStreamRequest_Start{
+				Target: &pb.ExecStreamRequest_Start_DeploymentId{
+					DeploymentId: resp.Deployment.Id,
+				},
+				Args: []string{"foo", "bar"},
+			},
+		},
+	}))
+	defer stream.CloseSend()
+
+	// Should fail rather than wait for an instance
+	_, err = stream.Recv()
+	require.Error(err)
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+}
@@ -2,6 +2,7 @@ package singleprocess
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-memdb"
@@ -16,6 +17,18 @@ func (s *service) ListInstances(
 	ctx context.Context,
 	req *pb.ListInstancesRequest,
 ) (*pb.ListInstancesResponse, error) {
+	// Deployments without the entrypoint have no instances that connect
+	// to the server, so their instances come from the platform instead.
+	if scope, ok := req.Scope.(*pb.ListInstancesRequest_DeploymentId); ok {
+		instances, ok, err := s.platformInstances(scope.DeploymentId)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return &pb.ListInstancesResponse{Instances: instances}, nil
+		}
+	}
+
 	var result []*state.Instance
 	var err error
 	switch scope := req.Scope.(type) {
@@ -77,6 +90,69 @@ func (s *service) ListInstances(
 	return &pb.ListInstancesResponse{Instances: final}, nil
 }
 
+// platformInstances returns the instances of a deployment without the
+// entrypoint. These are tracked by the platform plugin and come from the
+// resources of the latest status report of the deployment. Resources named
+// "parent/child" are part of another resource, such as the containers of a
+// pod, and aren't instances. The bool is false if the deployment uses the
+// entrypoint.
+func (s *service) platformInstances(deploymentId string) ([]*pb.Instance, bool, error) {
+	dep, err := s.state.DeploymentGet(&pb.Ref_Operation{
+		Target: &pb.Ref_Operation_Id{Id: deploymentId},
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if !dep.EntrypointDisabled {
+		return nil, false, nil
+	}
+
+	// Reports are listed newest first.
+	reports, err := s.state.StatusReportList(dep.Application,
+		state.ListWithWorkspace(dep.Workspace),
+		state.ListWithStatusFilter(&pb.StatusFilter{
+			Filters: []*pb.StatusFilter_Filter{{
+				Filter: &pb.StatusFilter_Filter_State{State: pb.Status_SUCCESS},
+			}},
+		}),
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var report *pb.StatusReport
+	for _, r := range reports {
+		if r.GetDeploymentId() == dep.Id {
+			report = r
+			break
+		}
+	}
+	if report == nil {
+		return nil, true, nil
+	}
+
+	var result []*pb.Instance
+	for _, r := range report.ResourcesHealth {
+		if r.Name == "" || strings.Contains(r.Name, "/") ||
+			strings.HasPrefix(r.Id, healthCheckResourceType+"/") {
+			continue
+		}
+
+		result = append(result, &pb.Instance{
+			Id:           r.Name,
+			DeploymentId: dep.Id,
+			Application:  dep.Application,
+			Workspace:    dep.Workspace,
+			Type:         pb.Instance_LONG_RUNNING,
+		})
+	}
+
+	return result, true, nil
+}
+
 // FindExecInstance considers the instances available for the given deployment
 // and finds an instance to use for exec. Returns codes.ResourceExhausted if
 // there are no instances available for exec.
@@ -70,6 +70,83 @@ func TestListInstances(t *testing.T) {
 		}
 	})
 
+	t.Run("deployment ID without the entrypoint", func(t *testing.T) {
+		require := require.New(t)
+
+		// Create our server
+		impl, err := New(WithDB(testDB(t)))
+		require.NoError(err)
+		client := server.TestServer(t, impl)
+
+		// Create a deployment
+		resp, err := client.UpsertDeployment(ctx, &pb.UpsertDeploymentRequest{
+			Deployment: serverptypes.TestValidDeployment(t, &pb.Deployment{
+				Component: &pb.Component{
+					Name: "testapp",
+				},
+				EntrypointDisabled: true,
+			}),
+		})
+		require.NoError(err)
+		dep := resp.Deployment
+
+		list := func() []*pb.Instance {
+			resp, err := client.ListInstances(ctx, &pb.ListInstancesRequest{
+				Scope: &pb.ListInstancesRequest_DeploymentId{
+					DeploymentId: dep.Id,
+				},
+				WaitTimeout: "1m",
+			})
+			require.NoError(err)
+			return resp.Instances
+		}
+
+		// Without a status report there are no instances, and we don't
+		// wait for any to connect.
+		require.Len(list(), 0)
+
+		// Reports of other deployments and reports that didn't complete
+		// are ignored.
+		for _, report := range []*pb.StatusReport{
+			{
+				TargetId: &pb.StatusReport_DeploymentId{DeploymentId: "other"},
+				Status:   server.NewStatus(pb.Status_SUCCESS),
+			},
+			{
+				TargetId: &pb.StatusReport_DeploymentId{DeploymentId: dep.Id},
+				Status:   server.NewStatus(pb.Status_RUNNING),
+			},
+		} {
+			_, err := client.UpsertStatusReport(ctx, &pb.UpsertStatusReportRequest{
+				StatusReport: serverptypes.TestValidStatusReport(t, report),
+			})
+			require.NoError(err)
+		}
+		require.Len(list(), 0)
+
+		// The instances are the resources of the report, not their parts
+		_, err = client.UpsertStatusReport(ctx, &pb.UpsertStatusReportRequest{
+			StatusReport: serverptypes.TestValidStatusReport(t, &pb.StatusReport{
+				TargetId: &pb.StatusReport_DeploymentId{DeploymentId: dep.Id},
+				Status:   server.NewStatus(pb.Status_SUCCESS),
+				ResourcesHealth: []*pb.StatusReport_Health{
+					{Name: "pod-a", HealthStatus: "READY"},
+					{Name: "pod-a/app", HealthStatus: "READY"},
+					{Name: "pod-b", HealthStatus: "DOWN"},
+				},
+			}),
+		})
+		require.NoError(err)
+
+		instances := list()
+		require.Len(instances, 2)
+		require.Equal("pod-a", instances[0].Id)
+		require.Equal("pod-b", instances[1].Id)
+		for _, inst := range instances {
+			require.Equal(dep.Id, inst.DeploymentId)
+		}
+	})
+
 	t.Run("deployment ID with wait timeout defined", func(t *testing.T) {
 		require := require.New(t)
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/hashicorp/go-hclog"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/waypoint/internal/server/logbuffer"
 	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
 	"github.com/hashicorp/waypoint/internal/server/singleprocess/state"
+	"github.com/hashicorp/waypoint/internal/telemetry"
 )
 
 // TODO: test
@@ -44,11 +46,77 @@ func (s *service) XListJobs(
 		return nil, err
 	}
 
+	result := jobs[:0]
+	for _, job := range jobs {
+		if jobMatchesListRequest(job, req) {
+			result = append(result, job)
+		}
+	}
+
 	return &pb.ListJobsResponse{
-		Jobs: jobs,
+		Jobs: result,
 	}, nil
 }
 
+func (s *service) WatchJobs(
+	req *pb.ListJobsRequest,
+	srv pb.Waypoint_WatchJobsServer,
+) error {
+	return watchList(srv.Context(), func(ws memdb.WatchSet) (proto.Message, error) {
+		jobs, err := s.state.JobListWatch(ws)
+		if err != nil {
+			return nil, err
+		}
+
+		result := jobs[:0]
+		for _, job := range jobs {
+			if jobMatchesListRequest(job, req) {
+				result = append(result, job)
+			}
+		}
+
+		return &pb.ListJobsResponse{Jobs: result}, nil
+	}, func(resp proto.Message) error {
+		return srv.Send(resp.(*pb.ListJobsResponse))
+	})
+}
+
+// jobMatchesListRequest returns true if the job matches the filters
+// of the ListJobs request.
+func jobMatchesListRequest(job *pb.Job, req *pb.ListJobsRequest) bool {
+	if ref := req.Application; ref != nil {
+		if job.Application == nil {
+			return false
+		}
+
+		if ref.Project != "" && ref.Project != job.Application.Project {
+			return false
+		}
+
+		if ref.Application != "" && ref.Application != job.Application.Application {
+			return false
+		}
+	}
+
+	if ref := req.Workspace; ref != nil && ref.Workspace != "" {
+		if job.Workspace == nil || job.Workspace.Workspace != ref.Workspace {
+			return false
+		}
+	}
+
+	if len(req.JobState) > 0 {
+		for _, state := range req.JobState {
+			if job.State == state {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return true
+}
+
 func (s *service) CancelJob(
 	ctx context.Context,
 	req *pb.CancelJobRequest,
@@ -69,17 +137,25 @@ func (s *service) queueJobMulti(
 	req []*pb.QueueJobRequest,
 ) ([]*pb.QueueJobResponse, error) {
 	jobs := make([]*pb.Job, len(req))
+	var queue []*pb.Job
 	for i, single := range req {
 		job, err := s.queueJobReqToJob(ctx, single)
 		if err != nil {
 			return nil, err
 		}
 
+		// Launch an on-demand runner for the job if the project uses them.
+		odrJobs, err := s.onDemandRunnerJobs(ctx, job)
+		if err != nil {
+			return nil, err
+		}
+
 		jobs[i] = job
+		queue = append(queue, odrJobs...)
 	}
 
 	// Queue the jobs
-	if err := s.state.JobCreate(jobs...); err != nil {
+	if err := s.state.JobCreate(queue...); err != nil {
 		return nil, err
 	}
 
@@ -124,6 +200,17 @@ func (s *service) queueJobReqToJob(
 		)
 	}
 
+	// Projects that are remote only reject local jobs so that every
+	// operation runs on a remote runner.
+	if _, ok := job.DataSource.GetSource().(*pb.Job_DataSource_Local); ok &&
+		(project.RemoteOnly || s.requireRemoteJobs) {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"Project %q only allows remote operations. Run the command again "+
+				"with the \"-remote\" flag to execute it on a remote runner.",
+			job.Application.Project,
+		)
+	}
+
 	if job.DataSource == nil {
 		if project.DataSource == nil {
 			return nil, status.Errorf(codes.FailedPrecondition,
@@ -138,6 +225,24 @@ func (s *service) queueJobReqToJob(
 		job.DataSource = project.DataSource
 	}
 
+	// If the project requires runner labels, jobs that target any runner
+	// only target the runners with those labels.
+	if _, ok := job.TargetRunner.Target.(*pb.Ref_Runner_Any); ok && len(project.RunnerLabels) > 0 {
+		job.TargetRunner = &pb.Ref_Runner{
+			Target: &pb.Ref_Runner_Labels{
+				Labels: &pb.Ref_RunnerLabels{
+					Labels: project.RunnerLabels,
+				},
+			},
+		}
+	}
+
+	// Jobs without a timeout use the timeout of the project or the server
+	// default for their operation.
+	if job.Timeout == "" {
+		job.Timeout = s.jobTimeout(project, job)
+	}
+
 	// Get the next id
 	id, err := server.Id()
 	if err != nil {
@@ -145,6 +250,10 @@ func (s *service) queueJobReqToJob(
 	}
 	job.Id = id
 
+	// Store the trace context of the caller so that the runner continues
+	// the trace when it executes the job.
+	job.TraceContext = telemetry.Inject(ctx)
+
 	// Validate expiry if we have one
 	job.ExpireTime = nil
 	if req.ExpiresIn != "" {
@@ -172,8 +281,14 @@ func (s *service) QueueJob(
 		return nil, err
 	}
 
+	// Launch an on-demand runner for the job if the project uses them.
+	jobs, err := s.onDemandRunnerJobs(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+
 	// Queue the job
-	if err := s.state.JobCreate(job); err != nil {
+	if err := s.state.JobCreate(jobs...); err != nil {
 		return nil, err
 	}
 
@@ -323,8 +438,10 @@ func (s *service) GetJobStream(
 
 			// If we haven't initialized output streaming and the output buffer
 			// is now non-nil, initialize that. This will send any buffered
-			// data down.
-			if eventsCh == nil && job.OutputBuffer != nil {
+			// data down. If the job already completed, we send the stored
+			// output instead below since the buffer may not have all of it.
+			completed := job.State == pb.Job_SUCCESS || job.State == pb.Job_ERROR
+			if eventsCh == nil && job.OutputBuffer != nil && !completed {
 				eventsCh, err = s.getJobStreamOutputInit(ctx, job, server)
 				if err != nil {
 					return err
@@ -335,6 +452,13 @@ func (s *service) GetJobStream(
 			case pb.Job_SUCCESS, pb.Job_ERROR:
 				// TODO(mitchellh): we should drain the output buffer
 
+				// If we never streamed the output, send the stored output.
+				if eventsCh == nil {
+					if err := s.getJobStreamOutputStored(job, server); err != nil {
+						return err
+					}
+				}
+
 				// Job is done. For success, error will be nil, so this
 				// populates the event with the proper values.
 				return server.Send(&pb.GetJobStreamResponse{
@@ -361,6 +485,53 @@ func (s *service) GetJobStream(
 	}
 }
 
+func (s *service) GetJobLogs(
+	req *pb.GetJobLogsRequest,
+	server pb.Waypoint_GetJobLogsServer,
+) error {
+	if err := serverptypes.ValidateGetJobLogsRequest(req); err != nil {
+		return err
+	}
+
+	job, err := s.state.JobById(req.JobId, nil)
+	if err != nil {
+		return err
+	}
+	if job == nil {
+		return status.Errorf(codes.NotFound, "job not found for ID: %s", req.JobId)
+	}
+
+	events, err := s.state.JobLogList(job.Id)
+	if err != nil {
+		return err
+	}
+
+	return jobLogBatches(events, func(batch []*pb.GetJobStreamResponse_Terminal_Event) error {
+		return server.Send(&pb.GetJobLogsResponse{Events: batch})
+	})
+}
+
+// jobLogBatches calls f with the events in batches of the same size
+// as the output buffer is read in.
+func jobLogBatches(
+	events []*pb.GetJobStreamResponse_Terminal_Event,
+	f func([]*pb.GetJobStreamResponse_Terminal_Event) error,
+) error {
+	for len(events) > 0 {
+		n := len(events)
+		if n > 64 {
+			n = 64
+		}
+
+		if err := f(events[:n]); err != nil {
+			return err
+		}
+		events = events[n:]
+	}
+
+	return nil
+}
+
 func (s *service) readJobLogBatch(r *logbuffer.Reader, block bool) []*pb.GetJobStreamResponse_Terminal_Event {
 	entries := r.Read(64, block)
 	if entries == nil {
@@ -375,6 +546,28 @@ func (s *service) readJobLogBatch(r *logbuffer.Reader, block bool) []*pb.GetJobS
 	return events
 }
 
+// getJobStreamOutputStored sends the stored output of a completed job.
+func (s *service) getJobStreamOutputStored(
+	job *state.Job,
+	server pb.Waypoint_GetJobStreamServer,
+) error {
+	events, err := s.state.JobLogList(job.Id)
+	if err != nil {
+		return err
+	}
+
+	return jobLogBatches(events, func(batch []*pb.GetJobStreamResponse_Terminal_Event) error {
+		return server.Send(&pb.GetJobStreamResponse{
+			Event: &pb.GetJobStreamResponse_Terminal_{
+				Terminal: &pb.GetJobStreamResponse_Terminal{
+					Events:   batch,
+					Buffered: true,
+				},
+			},
+		})
+	})
+}
+
 func (s *service) getJobStreamOutputInit(
 	ctx context.Context,
 	job *state.Job,
@@ -420,3 +613,29 @@ func (s *service) getJobStreamOutputInit(
 
 	return eventsCh, nil
 }
+
+// jobTimeout returns the timeout for the job from the project, or the
+// server default if the project doesn't set one. This returns an empty
+// string if the job has no timeout.
+func (s *service) jobTimeout(project *pb.Project, job *pb.Job) string {
+	var projectTimeout string
+	var serverTimeout time.Duration
+	jt := project.JobTimeouts
+	switch job.Operation.(type) {
+	case *pb.Job_Build:
+		projectTimeout, serverTimeout = jt.GetBuild(), s.jobTimeouts.Build
+	case *pb.Job_Deploy:
+		projectTimeout, serverTimeout = jt.GetDeploy(), s.jobTimeouts.Deploy
+	case *pb.Job_Release:
+		projectTimeout, serverTimeout = jt.GetRelease(), s.jobTimeouts.Release
+	}
+
+	if projectTimeout != "" {
+		return projectTimeout
+	}
+	if serverTimeout > 0 {
+		return serverTimeout.String()
+	}
+
+	return ""
+}
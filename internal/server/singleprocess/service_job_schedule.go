@@ -0,0 +1,113 @@
+package singleprocess
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func (s *service) UpsertJobSchedule(
+	ctx context.Context,
+	req *pb.UpsertJobScheduleRequest,
+) (*pb.UpsertJobScheduleResponse, error) {
+	if err := serverptypes.ValidateUpsertJobScheduleRequest(req); err != nil {
+		return nil, err
+	}
+	if err := serverptypes.ValidateJobSchedule(req.Schedule); err != nil {
+		return nil, err
+	}
+
+	// Verify the project exists so we don't schedule jobs that can
+	// never be queued.
+	if _, err := s.state.ProjectGet(req.Schedule.Project); err != nil {
+		return nil, err
+	}
+
+	s.jobScheduleMu.Lock()
+	defer s.jobScheduleMu.Unlock()
+
+	schedule := req.Schedule
+	schedule.Application.Project = schedule.Project.Project
+
+	// The last run of the schedule is only set by the server so we keep
+	// it from the existing schedule, if there is one.
+	schedule.LastTime = nil
+	schedule.LastJobId = ""
+	schedule.LastError = ""
+	existing, err := s.state.JobScheduleGet(schedule.Project, schedule.Name)
+	if err != nil && status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+	if existing != nil {
+		schedule.LastTime = existing.LastTime
+		schedule.LastJobId = existing.LastJobId
+		schedule.LastError = existing.LastError
+	}
+
+	schedule.NextTime, err = jobScheduleNext(schedule.Cron, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.state.JobSchedulePut(schedule); err != nil {
+		return nil, err
+	}
+
+	return &pb.UpsertJobScheduleResponse{Schedule: schedule}, nil
+}
+
+func (s *service) GetJobSchedule(
+	ctx context.Context,
+	req *pb.GetJobScheduleRequest,
+) (*pb.JobSchedule, error) {
+	if err := serverptypes.ValidateGetJobScheduleRequest(req); err != nil {
+		return nil, err
+	}
+
+	return s.state.JobScheduleGet(req.Project, req.Name)
+}
+
+func (s *service) ListJobSchedules(
+	ctx context.Context,
+	req *pb.ListJobSchedulesRequest,
+) (*pb.ListJobSchedulesResponse, error) {
+	if err := serverptypes.ValidateListJobSchedulesRequest(req); err != nil {
+		return nil, err
+	}
+
+	result, err := s.state.JobScheduleList(req.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListJobSchedulesResponse{Schedules: result}, nil
+}
+
+func (s *service) DeleteJobSchedule(
+	ctx context.Context,
+	req *pb.DeleteJobScheduleRequest,
+) (*empty.Empty, error) {
+	if err := serverptypes.ValidateDeleteJobScheduleRequest(req); err != nil {
+		return nil, err
+	}
+
+	s.jobScheduleMu.Lock()
+	defer s.jobScheduleMu.Unlock()
+
+	// Return NotFound so that typos in the name aren't silently ignored.
+	if _, err := s.state.JobScheduleGet(req.Project, req.Name); err != nil {
+		return nil, err
+	}
+
+	if err := s.state.JobScheduleDelete(req.Project, req.Name); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,124 @@
+package singleprocess
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/internal/server"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestServiceJobSchedule(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	// The project must exist
+	v := serverptypes.TestJobSchedule(t, nil)
+	_, err = client.UpsertJobSchedule(ctx, &pb.UpsertJobScheduleRequest{Schedule: v})
+	require.Error(err)
+	require.Equal(codes.NotFound, status.Code(err))
+
+	TestApp(t, client, v.Application)
+	resp, err := client.UpsertJobSchedule(ctx, &pb.UpsertJobScheduleRequest{Schedule: v})
+	require.NoError(err)
+	require.NotNil(resp.Schedule.NextTime)
+
+	result, err := client.GetJobSchedule(ctx, &pb.GetJobScheduleRequest{
+		Project: v.Project,
+		Name:    v.Name,
+	})
+	require.NoError(err)
+	require.Equal(v.Cron, result.Cron)
+
+	list, err := client.ListJobSchedules(ctx, &pb.ListJobSchedulesRequest{Project: v.Project})
+	require.NoError(err)
+	require.Len(list.Schedules, 1)
+
+	// Invalid schedules are rejected
+	v.Cron = "nope"
+	_, err = client.UpsertJobSchedule(ctx, &pb.UpsertJobScheduleRequest{Schedule: v})
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	// Delete
+	_, err = client.DeleteJobSchedule(ctx, &pb.DeleteJobScheduleRequest{
+		Project: v.Project,
+		Name:    v.Name,
+	})
+	require.NoError(err)
+	_, err = client.DeleteJobSchedule(ctx, &pb.DeleteJobScheduleRequest{
+		Project: v.Project,
+		Name:    v.Name,
+	})
+	require.Error(err)
+	require.Equal(codes.NotFound, status.Code(err))
+}
+
+func TestServiceJobSchedule_scheduler(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	// Every second
+	v := serverptypes.TestJobSchedule(t, &pb.JobSchedule{Cron: "* * * * * * *"})
+	TestApp(t, client, v.Application)
+	_, err = client.UpsertJobSchedule(ctx, &pb.UpsertJobScheduleRequest{Schedule: v})
+	require.NoError(err)
+
+	// The project has no data source so the job can't be queued
+	require.Eventually(func() bool {
+		v, err = client.GetJobSchedule(ctx, &pb.GetJobScheduleRequest{
+			Project: v.Project,
+			Name:    v.Name,
+		})
+		require.NoError(err)
+		return v.LastTime != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Contains(v.LastError, "data source")
+	require.Empty(v.LastJobId)
+	require.NotNil(v.NextTime)
+
+	// Add a data source and the job is queued
+	_, err = client.UpsertProject(ctx, &pb.UpsertProjectRequest{
+		Project: &pb.Project{
+			Name: v.Project.Project,
+			DataSource: &pb.Job_DataSource{
+				Source: &pb.Job_DataSource_Git{
+					Git: &pb.Job_Git{Url: "example.com"},
+				},
+			},
+		},
+	})
+	require.NoError(err)
+
+	require.Eventually(func() bool {
+		v, err = client.GetJobSchedule(ctx, &pb.GetJobScheduleRequest{
+			Project: v.Project,
+			Name:    v.Name,
+		})
+		require.NoError(err)
+		return v.LastJobId != ""
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Empty(v.LastError)
+
+	job, err := client.GetJob(ctx, &pb.GetJobRequest{JobId: v.LastJobId})
+	require.NoError(err)
+	require.IsType((*pb.Job_Up)(nil), job.Operation)
+	require.Equal(v.Application.Application, job.Application.Application)
+	require.NotNil(job.DataSource)
+}
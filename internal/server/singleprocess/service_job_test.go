@@ -9,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/hashicorp/waypoint/internal/server"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
@@ -65,6 +66,82 @@ func TestServiceQueueJob(t *testing.T) {
 			return job.State == pb.Job_ERROR && job.CancelTime != nil
 		}, 2*time.Second, 10*time.Millisecond)
 	})
+
+	t.Run("project runner labels", func(t *testing.T) {
+		require := require.New(t)
+
+		// Require labels for the project
+		ref := &pb.Ref_Project{Project: serverptypes.TestJobNew(t, nil).Application.Project}
+		projResp, err := client.GetProject(ctx, &pb.GetProjectRequest{Project: ref})
+		require.NoError(err)
+		project := projResp.Project
+		project.RunnerLabels = map[string]string{"env": "prod"}
+		_, err = client.UpsertProject(ctx, &pb.UpsertProjectRequest{Project: project})
+		require.NoError(err)
+
+		// Jobs that target any runner target the labels
+		resp, err := client.QueueJob(ctx, &Req{
+			Job: serverptypes.TestJobNew(t, nil),
+		})
+		require.NoError(err)
+		job, err := testServiceImpl(impl).state.JobById(resp.JobId, nil)
+		require.NoError(err)
+		target := job.TargetRunner.Target.(*pb.Ref_Runner_Labels)
+		require.Equal(project.RunnerLabels, target.Labels.Labels)
+
+		// Jobs that target a runner by ID are unchanged
+		resp, err = client.QueueJob(ctx, &Req{
+			Job: serverptypes.TestJobNew(t, &pb.Job{
+				TargetRunner: &pb.Ref_Runner{
+					Target: &pb.Ref_Runner_Id{
+						Id: &pb.Ref_RunnerId{Id: "R_A"},
+					},
+				},
+			}),
+		})
+		require.NoError(err)
+		job, err = testServiceImpl(impl).state.JobById(resp.JobId, nil)
+		require.NoError(err)
+		require.IsType((*pb.Ref_Runner_Id)(nil), job.TargetRunner.Target)
+	})
+
+	t.Run("remote only project", func(t *testing.T) {
+		require := require.New(t)
+
+		// Make the project remote only
+		ref := &pb.Ref_Project{Project: serverptypes.TestJobNew(t, nil).Application.Project}
+		projResp, err := client.GetProject(ctx, &pb.GetProjectRequest{Project: ref})
+		require.NoError(err)
+		project := projResp.Project
+		project.RemoteOnly = true
+		_, err = client.UpsertProject(ctx, &pb.UpsertProjectRequest{Project: project})
+		require.NoError(err)
+		defer func() {
+			project.RemoteOnly = false
+			_, err := client.UpsertProject(ctx, &pb.UpsertProjectRequest{Project: project})
+			require.NoError(err)
+		}()
+
+		// Local jobs are rejected
+		_, err = client.QueueJob(ctx, &Req{
+			Job: serverptypes.TestJobNew(t, nil),
+		})
+		require.Error(err)
+		require.Equal(codes.FailedPrecondition, status.Code(err))
+		require.Contains(err.Error(), "-remote")
+
+		// Remote jobs are allowed
+		_, err = client.QueueJob(ctx, &Req{
+			Job: serverptypes.TestJobNew(t, &pb.Job{
+				DataSource: &pb.Job_DataSource{
+					Source: &pb.Job_DataSource_Git{
+						Git: &pb.Job_Git{Url: "https://github.com/hashicorp/waypoint.git"},
+					},
+				},
+			}),
+		})
+		require.NoError(err)
+	})
 }
 
 func TestServiceValidateJob(t *testing.T) {
@@ -257,6 +334,28 @@ func TestServiceGetJobStream_complete(t *testing.T) {
 		require.NotNil(event)
 		require.Nil(event.Complete.Error)
 	}
+
+	// The output is stored
+	{
+		logs, err := client.GetJobLogs(ctx, &pb.GetJobLogsRequest{JobId: queueResp.JobId})
+		require.NoError(err)
+		resp, err := logs.Recv()
+		require.NoError(err)
+		require.Len(resp.Events, 2)
+		require.Equal("hello", resp.Events[0].GetLine().Msg)
+		_, err = logs.Recv()
+		require.Equal(io.EOF, err)
+	}
+
+	// A stream of the completed job sends the stored output
+	{
+		stream, err := client.GetJobStream(ctx, &pb.GetJobStreamRequest{JobId: queueResp.JobId})
+		require.NoError(err)
+		resp := jobStreamRecv(t, stream, (*pb.GetJobStreamResponse_Terminal_)(nil))
+		event := resp.Event.(*pb.GetJobStreamResponse_Terminal_)
+		require.True(event.Terminal.Buffered)
+		require.Len(event.Terminal.Events, 2)
+	}
 }
 
 func TestServiceGetJobStream_bufferedData(t *testing.T) {
@@ -423,3 +522,86 @@ func jobStreamRecv(
 		t.Logf("received event, but not the type we wanted: %T", resp.Event)
 	}
 }
+
+func TestServiceListJobs(t *testing.T) {
+	ctx := context.Background()
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(t, err)
+	client := server.TestServer(t, impl)
+
+	// Initialize our apps
+	TestApp(t, client, serverptypes.TestJobNew(t, nil).Application)
+	TestApp(t, client, &pb.Ref_Application{Project: "p_test", Application: "b_test"})
+
+	// Queue a job for each app
+	for _, app := range []string{"a_test", "b_test"} {
+		_, err := client.QueueJob(ctx, &pb.QueueJobRequest{
+			Job: serverptypes.TestJobNew(t, &pb.Job{
+				Application: &pb.Ref_Application{
+					Project:     "p_test",
+					Application: app,
+				},
+			}),
+		})
+		require.NoError(t, err)
+	}
+
+	type Req = pb.ListJobsRequest
+
+	t.Run("all jobs", func(t *testing.T) {
+		require := require.New(t)
+
+		resp, err := client.XListJobs(ctx, &Req{})
+		require.NoError(err)
+		require.Len(resp.Jobs, 2)
+	})
+
+	t.Run("by application", func(t *testing.T) {
+		require := require.New(t)
+
+		resp, err := client.XListJobs(ctx, &Req{
+			Application: &pb.Ref_Application{Project: "p_test", Application: "b_test"},
+		})
+		require.NoError(err)
+		require.Len(resp.Jobs, 1)
+		require.Equal("b_test", resp.Jobs[0].Application.Application)
+	})
+
+	t.Run("by project", func(t *testing.T) {
+		require := require.New(t)
+
+		resp, err := client.XListJobs(ctx, &Req{
+			Application: &pb.Ref_Application{Project: "p_test"},
+		})
+		require.NoError(err)
+		require.Len(resp.Jobs, 2)
+	})
+
+	t.Run("by workspace", func(t *testing.T) {
+		require := require.New(t)
+
+		resp, err := client.XListJobs(ctx, &Req{
+			Workspace: &pb.Ref_Workspace{Workspace: "nope"},
+		})
+		require.NoError(err)
+		require.Empty(resp.Jobs)
+	})
+
+	t.Run("by state", func(t *testing.T) {
+		require := require.New(t)
+
+		resp, err := client.XListJobs(ctx, &Req{
+			JobState: []pb.Job_State{pb.Job_QUEUED},
+		})
+		require.NoError(err)
+		require.Len(resp.Jobs, 2)
+
+		resp, err = client.XListJobs(ctx, &Req{
+			JobState: []pb.Job_State{pb.Job_RUNNING},
+		})
+		require.NoError(err)
+		require.Empty(resp.Jobs)
+	})
+}
@@ -3,7 +3,9 @@ package singleprocess
 import (
 	"context"
 	"sync"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-memdb"
 	"google.golang.org/grpc/codes"
@@ -13,6 +15,7 @@ import (
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 	"github.com/hashicorp/waypoint/internal/server/grpcmetadata"
 	"github.com/hashicorp/waypoint/internal/server/logbuffer"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
 	"github.com/hashicorp/waypoint/internal/server/singleprocess/state"
 )
 
@@ -136,6 +139,34 @@ type streamRec struct {
 	JobId          string
 }
 
+// deploymentUsesLogsPlugin returns true if the logs of the deployment are
+// read with its logs plugin rather than from its instances. HasLogsPlugin is
+// set when we create the Deployment value by detecting if the plugin had a
+// LogsFunc defined. Since platforms with a logs plugin may also run the
+// entrypoint, the plugin is only used if the deployment has no entrypoint
+// or no entrypoint instances are connected.
+func (s *service) deploymentUsesLogsPlugin(d *pb.Deployment, ws memdb.WatchSet) (bool, error) {
+	if !d.HasLogsPlugin {
+		return false, nil
+	}
+	if d.EntrypointDisabled {
+		return true, nil
+	}
+
+	instances, err := s.state.InstancesByDeployment(d.Id, ws)
+	if err != nil {
+		return false, err
+	}
+	for _, i := range instances {
+		// Virtual instances are run by plugins, including logs plugins.
+		if i.Type != pb.Instance_VIRTUAL {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
 func (s *service) GetLogStream(
 	req *pb.GetLogStreamRequest,
 	srv pb.Waypoint_GetLogStreamServer,
@@ -165,9 +196,19 @@ func (s *service) GetLogStream(
 
 		log = log.With("deployment_id", scope.DeploymentId)
 
-		// This flag is set when we create the Deployment value by detecting if the plugin
-		// had a LogsFunc defined.
-		if deployment.HasLogsPlugin {
+		usePlugin, err := s.deploymentUsesLogsPlugin(deployment, nil)
+		if err != nil {
+			return err
+		}
+
+		// Without the entrypoint no instances will connect with logs.
+		if !usePlugin && deployment.EntrypointDisabled {
+			return status.Errorf(codes.FailedPrecondition,
+				"the deployment runs without the entrypoint and its platform "+
+					"doesn't support reading logs")
+		}
+
+		if usePlugin {
 			log.Debug("deployment supports log plugin. spawning log plugin")
 			inst, jobId, err := s.spawnLogPlugin(srv.Context(), log, deployment)
 			if err != nil {
@@ -273,9 +314,14 @@ func (s *service) GetLogStream(
 
 			var streams []*streamRec
 			for _, dep := range deployments {
+				usePlugin, err := s.deploymentUsesLogsPlugin(dep, ws)
+				if err != nil {
+					return nil, err
+				}
+
 				// If this deployment uses a logs plugin, either used the previously spawn
 				// instance or spawn a new instance by invoking the logs pluign.
-				if dep.HasLogsPlugin {
+				if usePlugin {
 					if inst, ok := deploymentToInstance[dep.Id]; ok {
 						streams = append(streams, inst)
 					} else {
@@ -329,6 +375,43 @@ func (s *service) GetLogStream(
 	return s.sendInstanceLogs(srv.Context(), log, srv, instanceFunc, req.LimitBacklog)
 }
 
+func (s *service) SearchLogs(
+	ctx context.Context,
+	req *pb.SearchLogsRequest,
+) (*pb.SearchLogsResponse, error) {
+	if err := serverptypes.ValidateSearchLogsRequest(req); err != nil {
+		return nil, err
+	}
+
+	if s.logArchive == nil {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"the server has no log archive configured")
+	}
+
+	var since, until time.Time
+	if req.Since != nil {
+		t, err := ptypes.Timestamp(req.Since)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid since: %s", err)
+		}
+		since = t
+	}
+	if req.Until != nil {
+		t, err := ptypes.Timestamp(req.Until)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid until: %s", err)
+		}
+		until = t
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultSearchLogsLimit
+	}
+
+	return s.logArchive.Search(ctx, req.Application, since, until, limit)
+}
+
 // Used to reduce the functional surface area of sendInstanceLogs. This is
 // implemented by the GetLogStream service stream.
 type batchSender interface {
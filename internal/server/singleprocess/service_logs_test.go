@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/hashicorp/go-hclog"
@@ -532,3 +534,76 @@ func TestServiceGetLogStream_byApp(t *testing.T) {
 	require.NotEmpty(batch.Lines)
 	require.Len(batch.Lines, 25)
 }
+
+func TestServiceGetLogStream_entrypointDisabled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(t, err)
+	client := server.TestServer(t, impl)
+
+	// Create a deployment without the entrypoint or a logs plugin
+	resp, err := client.UpsertDeployment(ctx, &pb.UpsertDeploymentRequest{
+		Deployment: serverptypes.TestValidDeployment(t, &pb.Deployment{
+			Component: &pb.Component{
+				Name: "testapp",
+			},
+			EntrypointDisabled: true,
+		}),
+	})
+	require.NoError(t, err)
+
+	// Should fail rather than wait for instances
+	logRecvClient, err := client.GetLogStream(ctx, &pb.GetLogStreamRequest{
+		Scope: &pb.GetLogStreamRequest_DeploymentId{
+			DeploymentId: resp.Deployment.Id,
+		},
+	})
+	require.NoError(t, err)
+	_, err = logRecvClient.Recv()
+	require.Error(t, err)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestServiceDeploymentUsesLogsPlugin(t *testing.T) {
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+	s := impl.(*service)
+
+	// Without a logs plugin we always use the instances
+	_, deploymentId, closer := TestEntrypoint(t, client)
+	defer closer()
+	dep := &pb.Deployment{Id: deploymentId}
+	ok, err := s.deploymentUsesLogsPlugin(dep, nil)
+	require.NoError(err)
+	require.False(ok)
+
+	// With a logs plugin we prefer connected entrypoint instances
+	dep.HasLogsPlugin = true
+	ok, err = s.deploymentUsesLogsPlugin(dep, nil)
+	require.NoError(err)
+	require.False(ok)
+
+	// ...and use the plugin if there are none
+	ok, err = s.deploymentUsesLogsPlugin(&pb.Deployment{
+		Id:            "nope",
+		HasLogsPlugin: true,
+	}, nil)
+	require.NoError(err)
+	require.True(ok)
+
+	// Without the entrypoint we always use the plugin
+	ok, err = s.deploymentUsesLogsPlugin(&pb.Deployment{
+		Id:                 deploymentId,
+		HasLogsPlugin:      true,
+		EntrypointDisabled: true,
+	}, nil)
+	require.NoError(err)
+	require.True(ok)
+}
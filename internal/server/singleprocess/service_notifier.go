@@ -0,0 +1,101 @@
+package singleprocess
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func (s *service) UpsertNotifier(
+	ctx context.Context,
+	req *pb.UpsertNotifierRequest,
+) (*pb.UpsertNotifierResponse, error) {
+	if err := serverptypes.ValidateUpsertNotifierRequest(req); err != nil {
+		return nil, err
+	}
+	if err := serverptypes.ValidateNotifier(req.Notifier); err != nil {
+		return nil, err
+	}
+
+	// Verify the project exists so that typos in the project name don't
+	// create notifiers that never send anything.
+	if _, err := s.state.ProjectGet(req.Notifier.Project); err != nil {
+		return nil, err
+	}
+
+	s.notifierMu.Lock()
+	defer s.notifierMu.Unlock()
+
+	// The last error is only set by the server so we keep it from the
+	// existing notifier, if there is one.
+	notifier := req.Notifier
+	notifier.LastError = ""
+	existing, err := s.state.NotifierGet(notifier.Project, notifier.Name)
+	if err != nil && status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+	if existing != nil {
+		notifier.LastError = existing.LastError
+	}
+
+	if err := s.state.NotifierPut(notifier); err != nil {
+		return nil, err
+	}
+
+	return &pb.UpsertNotifierResponse{Notifier: notifier}, nil
+}
+
+func (s *service) GetNotifier(
+	ctx context.Context,
+	req *pb.GetNotifierRequest,
+) (*pb.Notifier, error) {
+	if err := serverptypes.ValidateGetNotifierRequest(req); err != nil {
+		return nil, err
+	}
+
+	return s.state.NotifierGet(req.Project, req.Name)
+}
+
+func (s *service) ListNotifiers(
+	ctx context.Context,
+	req *pb.ListNotifiersRequest,
+) (*pb.ListNotifiersResponse, error) {
+	if err := serverptypes.ValidateListNotifiersRequest(req); err != nil {
+		return nil, err
+	}
+
+	result, err := s.state.NotifierList(req.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListNotifiersResponse{Notifiers: result}, nil
+}
+
+func (s *service) DeleteNotifier(
+	ctx context.Context,
+	req *pb.DeleteNotifierRequest,
+) (*empty.Empty, error) {
+	if err := serverptypes.ValidateDeleteNotifierRequest(req); err != nil {
+		return nil, err
+	}
+
+	s.notifierMu.Lock()
+	defer s.notifierMu.Unlock()
+
+	// Return NotFound so that typos in the name aren't silently ignored.
+	if _, err := s.state.NotifierGet(req.Project, req.Name); err != nil {
+		return nil, err
+	}
+
+	if err := s.state.NotifierDelete(req.Project, req.Name); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,145 @@
+package singleprocess
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/internal/server"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestServiceNotifier(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	// The project must exist
+	v := serverptypes.TestNotifier(t, nil)
+	_, err = client.UpsertNotifier(ctx, &pb.UpsertNotifierRequest{Notifier: v})
+	require.Error(err)
+	require.Equal(codes.NotFound, status.Code(err))
+
+	TestApp(t, client, &pb.Ref_Application{Project: v.Project.Project, Application: "a_test"})
+	_, err = client.UpsertNotifier(ctx, &pb.UpsertNotifierRequest{Notifier: v})
+	require.NoError(err)
+
+	result, err := client.GetNotifier(ctx, &pb.GetNotifierRequest{
+		Project: v.Project,
+		Name:    v.Name,
+	})
+	require.NoError(err)
+	require.Equal(v.Url, result.Url)
+
+	list, err := client.ListNotifiers(ctx, &pb.ListNotifiersRequest{Project: v.Project})
+	require.NoError(err)
+	require.Len(list.Notifiers, 1)
+
+	// Invalid notifiers are rejected
+	v.Url = ""
+	_, err = client.UpsertNotifier(ctx, &pb.UpsertNotifierRequest{Notifier: v})
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	// Delete
+	_, err = client.DeleteNotifier(ctx, &pb.DeleteNotifierRequest{
+		Project: v.Project,
+		Name:    v.Name,
+	})
+	require.NoError(err)
+	_, err = client.DeleteNotifier(ctx, &pb.DeleteNotifierRequest{
+		Project: v.Project,
+		Name:    v.Name,
+	})
+	require.Error(err)
+	require.Equal(codes.NotFound, status.Code(err))
+}
+
+func TestServiceNotifier_webhook(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Receive the notifications
+	received := make(chan map[string]string, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err == nil {
+			received <- body
+		}
+	}))
+	defer srv.Close()
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	ref := &pb.Ref_Application{Project: "p_test", Application: "a_test"}
+	TestApp(t, client, ref)
+	_, err = client.UpsertNotifier(ctx, &pb.UpsertNotifierRequest{
+		Notifier: serverptypes.TestNotifier(t, &pb.Notifier{
+			Url:    srv.URL,
+			Events: []pb.Notifier_Event{pb.Notifier_DEPLOY_COMPLETE},
+		}),
+	})
+	require.NoError(err)
+
+	// A running deployment doesn't notify
+	resp, err := client.UpsertDeployment(ctx, &pb.UpsertDeploymentRequest{
+		Deployment: serverptypes.TestValidDeployment(t, &pb.Deployment{
+			Application: ref,
+			Status:      server.NewStatus(pb.Status_RUNNING),
+		}),
+	})
+	require.NoError(err)
+
+	// Completing it does
+	dep := resp.Deployment
+	dep.Status = server.NewStatus(pb.Status_SUCCESS)
+	_, err = client.UpsertDeployment(ctx, &pb.UpsertDeploymentRequest{Deployment: dep})
+	require.NoError(err)
+
+	select {
+	case body := <-received:
+		require.Equal("deploy-complete", body["event"])
+		require.Equal("a_test", body["application"])
+		require.Equal("default", body["workspace"])
+
+	case <-time.After(5 * time.Second):
+		t.Fatal("notification not received")
+	}
+
+	// Updating a completed deployment doesn't notify again
+	_, err = client.UpsertDeployment(ctx, &pb.UpsertDeploymentRequest{Deployment: dep})
+	require.NoError(err)
+
+	select {
+	case <-received:
+		t.Fatal("unexpected notification")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestNotifierWants(t *testing.T) {
+	require := require.New(t)
+
+	require.True(notifierWants(&pb.Notifier{}, pb.Notifier_JOB_FAILED))
+	require.True(notifierWants(&pb.Notifier{
+		Events: []pb.Notifier_Event{pb.Notifier_JOB_FAILED},
+	}, pb.Notifier_JOB_FAILED))
+	require.False(notifierWants(&pb.Notifier{
+		Events: []pb.Notifier_Event{pb.Notifier_HEALTH_DOWN},
+	}, pb.Notifier_JOB_FAILED))
+}
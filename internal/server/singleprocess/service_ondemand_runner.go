@@ -0,0 +1,73 @@
+package singleprocess
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func (s *service) UpsertOnDemandRunnerConfig(
+	ctx context.Context,
+	req *pb.UpsertOnDemandRunnerConfigRequest,
+) (*pb.UpsertOnDemandRunnerConfigResponse, error) {
+	if err := serverptypes.ValidateUpsertOnDemandRunnerConfigRequest(req); err != nil {
+		return nil, err
+	}
+
+	if err := s.state.OnDemandRunnerConfigPut(req.Config); err != nil {
+		return nil, err
+	}
+
+	return &pb.UpsertOnDemandRunnerConfigResponse{Config: req.Config}, nil
+}
+
+func (s *service) GetOnDemandRunnerConfig(
+	ctx context.Context,
+	req *pb.GetOnDemandRunnerConfigRequest,
+) (*pb.GetOnDemandRunnerConfigResponse, error) {
+	if err := serverptypes.ValidateGetOnDemandRunnerConfigRequest(req); err != nil {
+		return nil, err
+	}
+
+	value, err := s.state.OnDemandRunnerConfigGet(req.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetOnDemandRunnerConfigResponse{Config: value}, nil
+}
+
+func (s *service) ListOnDemandRunnerConfigs(
+	ctx context.Context,
+	req *empty.Empty,
+) (*pb.ListOnDemandRunnerConfigsResponse, error) {
+	values, err := s.state.OnDemandRunnerConfigList()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListOnDemandRunnerConfigsResponse{Configs: values}, nil
+}
+
+func (s *service) DeleteOnDemandRunnerConfig(
+	ctx context.Context,
+	req *pb.DeleteOnDemandRunnerConfigRequest,
+) (*empty.Empty, error) {
+	if err := serverptypes.ValidateDeleteOnDemandRunnerConfigRequest(req); err != nil {
+		return nil, err
+	}
+
+	// Validate that the configuration exists
+	if _, err := s.state.OnDemandRunnerConfigGet(req.Config); err != nil {
+		return nil, err
+	}
+
+	if err := s.state.OnDemandRunnerConfigDelete(req.Config); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,238 @@
+package singleprocess
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/internal/server"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+	"github.com/hashicorp/waypoint/internal/serverclient"
+)
+
+func TestServiceOnDemandRunnerConfig(t *testing.T) {
+	ctx := context.Background()
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(t, err)
+	client := server.TestServer(t, impl)
+
+	t.Run("upsert, get, list, and delete", func(t *testing.T) {
+		require := require.New(t)
+
+		// Create
+		resp, err := client.UpsertOnDemandRunnerConfig(ctx, &pb.UpsertOnDemandRunnerConfigRequest{
+			Config: serverptypes.TestOnDemandRunnerConfig(t, &pb.OnDemandRunnerConfig{
+				Name:                 "docker",
+				EnvironmentVariables: map[string]string{"FOO": "bar"},
+			}),
+		})
+		require.NoError(err)
+		require.Equal("docker", resp.Config.Name)
+
+		// Get
+		{
+			resp, err := client.GetOnDemandRunnerConfig(ctx, &pb.GetOnDemandRunnerConfigRequest{
+				Config: &pb.Ref_OnDemandRunnerConfig{Name: "docker"},
+			})
+			require.NoError(err)
+			require.Equal("bar", resp.Config.EnvironmentVariables["FOO"])
+		}
+
+		// List
+		{
+			resp, err := client.ListOnDemandRunnerConfigs(ctx, &empty.Empty{})
+			require.NoError(err)
+			require.Len(resp.Configs, 1)
+		}
+
+		// Delete
+		{
+			_, err := client.DeleteOnDemandRunnerConfig(ctx, &pb.DeleteOnDemandRunnerConfigRequest{
+				Config: &pb.Ref_OnDemandRunnerConfig{Name: "docker"},
+			})
+			require.NoError(err)
+		}
+
+		// Get again
+		{
+			_, err := client.GetOnDemandRunnerConfig(ctx, &pb.GetOnDemandRunnerConfigRequest{
+				Config: &pb.Ref_OnDemandRunnerConfig{Name: "docker"},
+			})
+			require.Error(err)
+			require.Equal(codes.NotFound, status.Code(err))
+		}
+	})
+
+	t.Run("plugin type is required", func(t *testing.T) {
+		require := require.New(t)
+
+		_, err := client.UpsertOnDemandRunnerConfig(ctx, &pb.UpsertOnDemandRunnerConfigRequest{
+			Config: &pb.OnDemandRunnerConfig{Name: "docker"},
+		})
+		require.Error(err)
+		require.Equal(codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestServiceOnDemandRunner(t *testing.T) {
+	ctx := context.Background()
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(t, err)
+	client := server.TestServer(t, impl)
+	s := testServiceImpl(impl)
+
+	// Initialize our app
+	TestApp(t, client, serverptypes.TestJobNew(t, nil).Application)
+
+	// On-demand runners connect to the advertise address
+	_, err = client.SetServerConfig(ctx, &pb.SetServerConfigRequest{
+		Config: serverptypes.TestServerConfig(t, nil),
+	})
+	require.NoError(t, err)
+
+	_, err = client.UpsertOnDemandRunnerConfig(ctx, &pb.UpsertOnDemandRunnerConfigRequest{
+		Config: serverptypes.TestOnDemandRunnerConfig(t, &pb.OnDemandRunnerConfig{
+			Name:                 "docker",
+			EnvironmentVariables: map[string]string{"FOO": "bar"},
+		}),
+	})
+	require.NoError(t, err)
+
+	// Use the configuration for the project
+	ref := &pb.Ref_Project{Project: serverptypes.TestJobNew(t, nil).Application.Project}
+	projResp, err := client.GetProject(ctx, &pb.GetProjectRequest{Project: ref})
+	require.NoError(t, err)
+	project := projResp.Project
+	project.OndemandRunner = &pb.Ref_OnDemandRunnerConfig{Name: "docker"}
+	_, err = client.UpsertProject(ctx, &pb.UpsertProjectRequest{Project: project})
+	require.NoError(t, err)
+
+	// queue queues a job and returns it with the job that launches its runner.
+	queue := func(t *testing.T) (*pb.Job, *pb.Job) {
+		require := require.New(t)
+
+		resp, err := client.QueueJob(ctx, &pb.QueueJobRequest{
+			Job: serverptypes.TestJobNew(t, nil),
+		})
+		require.NoError(err)
+
+		job, err := s.state.JobById(resp.JobId, nil)
+		require.NoError(err)
+		require.NotNil(job.OnDemandRunner)
+
+		start, err := s.state.JobById(job.OnDemandRunner.StartJobId, nil)
+		require.NoError(err)
+		require.NotNil(start)
+
+		return job.Job, start.Job
+	}
+
+	t.Run("launch and stop", func(t *testing.T) {
+		require := require.New(t)
+
+		job, start := queue(t)
+		runnerId := job.OnDemandRunner.RunnerId
+
+		// The job targets the launched runner
+		target := job.TargetRunner.Target.(*pb.Ref_Runner_Id)
+		require.Equal(runnerId, target.Id.Id)
+
+		// The runner is launched with what it needs to connect
+		info := start.GetStartTask().Info
+		require.Equal(defaultOnDemandRunnerImage, info.OciUrl)
+		require.Equal("bar", info.EnvironmentVariables["FOO"])
+		require.NotEmpty(info.EnvironmentVariables[serverclient.EnvServerAddr])
+		require.NotEmpty(info.EnvironmentVariables[serverclient.EnvServerToken])
+		require.Contains(info.Arguments, "-id="+runnerId)
+		require.Equal("docker", start.GetStartTask().Params.PluginType)
+
+		// A registered runner launches the runner
+		state, err := ptypes.MarshalAny(&empty.Empty{})
+		require.NoError(err)
+		assigned, err := s.state.JobAssignForRunner(ctx, &pb.Runner{Id: "R_A"})
+		require.NoError(err)
+		require.Equal(start.Id, assigned.Id)
+		_, err = s.state.JobAck(start.Id, true)
+		require.NoError(err)
+		require.NoError(s.state.JobComplete(start.Id, &pb.Job_Result{
+			StartTask: &pb.Job_StartTaskResult{State: state},
+		}, nil))
+
+		// The launched runner runs the job
+		assigned, err = s.state.JobAssignForRunner(ctx, &pb.Runner{Id: runnerId, ByIdOnly: true})
+		require.NoError(err)
+		require.Equal(job.Id, assigned.Id)
+		_, err = s.state.JobAck(job.Id, true)
+		require.NoError(err)
+		require.NoError(s.state.JobComplete(job.Id, nil, nil))
+
+		// The runner is stopped
+		var stopId string
+		require.Eventually(func() bool {
+			job, err := s.state.JobById(job.Id, nil)
+			require.NoError(err)
+			stopId = job.OnDemandRunner.StopJobId
+			return job.OnDemandRunner.Complete
+		}, 2*time.Second, 10*time.Millisecond)
+
+		stop, err := s.state.JobById(stopId, nil)
+		require.NoError(err)
+		require.NotNil(stop)
+		require.True(proto.Equal(state, stop.GetStopTask().State))
+
+		// Cancel the stop job so that it isn't assigned in other tests
+		require.NoError(s.state.JobCancel(stopId, false))
+	})
+
+	t.Run("launch failure cancels the job", func(t *testing.T) {
+		require := require.New(t)
+
+		job, start := queue(t)
+
+		// The runner fails to launch
+		assigned, err := s.state.JobAssignForRunner(ctx, &pb.Runner{Id: "R_A"})
+		require.NoError(err)
+		require.Equal(start.Id, assigned.Id)
+		_, err = s.state.JobAck(start.Id, true)
+		require.NoError(err)
+		require.NoError(s.state.JobComplete(start.Id, nil, errors.New("no docker")))
+
+		// The job is cancelled and no runner is stopped
+		require.Eventually(func() bool {
+			job, err := s.state.JobById(job.Id, nil)
+			require.NoError(err)
+			return job.State == pb.Job_ERROR && job.OnDemandRunner.Complete
+		}, 2*time.Second, 10*time.Millisecond)
+
+		current, err := s.state.JobById(job.Id, nil)
+		require.NoError(err)
+		require.Empty(current.OnDemandRunner.StopJobId)
+	})
+
+	t.Run("missing configuration", func(t *testing.T) {
+		require := require.New(t)
+
+		project.OndemandRunner = &pb.Ref_OnDemandRunnerConfig{Name: "nope"}
+		_, err := client.UpsertProject(ctx, &pb.UpsertProjectRequest{Project: project})
+		require.NoError(err)
+
+		_, err = client.QueueJob(ctx, &pb.QueueJobRequest{
+			Job: serverptypes.TestJobNew(t, nil),
+		})
+		require.Error(err)
+		require.Equal(codes.FailedPrecondition, status.Code(err))
+	})
+}
@@ -0,0 +1,194 @@
+package singleprocess
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func (s *service) UpsertPipeline(
+	ctx context.Context,
+	req *pb.UpsertPipelineRequest,
+) (*pb.UpsertPipelineResponse, error) {
+	if err := serverptypes.ValidateUpsertPipelineRequest(req); err != nil {
+		return nil, err
+	}
+	if err := serverptypes.ValidatePipeline(req.Pipeline); err != nil {
+		return nil, err
+	}
+
+	if err := s.state.PipelinePut(req.Pipeline); err != nil {
+		return nil, err
+	}
+
+	result, err := s.state.PipelineGet(req.Pipeline.Project, req.Pipeline.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpsertPipelineResponse{Pipeline: result}, nil
+}
+
+func (s *service) ListPipelines(
+	ctx context.Context,
+	req *pb.ListPipelinesRequest,
+) (*pb.ListPipelinesResponse, error) {
+	if err := serverptypes.ValidateListPipelinesRequest(req); err != nil {
+		return nil, err
+	}
+
+	result, err := s.state.PipelineList(req.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListPipelinesResponse{Pipelines: result}, nil
+}
+
+func (s *service) RunPipeline(
+	ctx context.Context,
+	req *pb.RunPipelineRequest,
+) (*pb.RunPipelineResponse, error) {
+	if err := serverptypes.ValidateRunPipelineRequest(req); err != nil {
+		return nil, err
+	}
+
+	pipeline, err := s.state.PipelineGet(req.Project, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// The application and operation are set for each step by the
+	// scheduler, so clear them so they never leak into a step's job.
+	template := proto.Clone(req.JobTemplate).(*pb.Job)
+	template.Application = nil
+	template.Operation = nil
+
+	run := &pb.PipelineRun{
+		Pipeline:    pipeline,
+		Workspace:   template.Workspace,
+		State:       pb.PipelineRun_PENDING,
+		JobTemplate: template,
+	}
+	for _, step := range pipeline.Steps {
+		run.Steps = append(run.Steps, &pb.PipelineRun_Step{
+			Name:  step.Name,
+			State: pb.PipelineRun_PENDING,
+		})
+	}
+
+	// Creating the run triggers the pipeline scheduler which queues
+	// the jobs for the first steps.
+	if err := s.state.PipelineRunCreate(run); err != nil {
+		return nil, err
+	}
+
+	return &pb.RunPipelineResponse{Run: run}, nil
+}
+
+func (s *service) GetPipelineRun(
+	ctx context.Context,
+	req *pb.GetPipelineRunRequest,
+) (*pb.PipelineRun, error) {
+	if err := serverptypes.ValidateGetPipelineRunRequest(req); err != nil {
+		return nil, err
+	}
+
+	return s.state.PipelineRunGet(req.Id)
+}
+
+func (s *service) ListPipelineRuns(
+	ctx context.Context,
+	req *pb.ListPipelineRunsRequest,
+) (*pb.ListPipelineRunsResponse, error) {
+	if err := serverptypes.ValidateListPipelineRunsRequest(req); err != nil {
+		return nil, err
+	}
+
+	result, err := s.state.PipelineRunList(req.Project, req.Name, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListPipelineRunsResponse{Runs: result}, nil
+}
+
+func (s *service) ApprovePipelineRun(
+	ctx context.Context,
+	req *pb.ApprovePipelineRunRequest,
+) (*pb.PipelineRun, error) {
+	if err := serverptypes.ValidateApprovePipelineRunRequest(req); err != nil {
+		return nil, err
+	}
+
+	// The approver is recorded on the step so we require a user.
+	user := s.userFromContext(ctx)
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied,
+			"approving a pipeline run requires an authenticated user")
+	}
+
+	// Hold the lock so the scheduler doesn't overwrite the approval.
+	s.pipelineRunMu.Lock()
+	defer s.pipelineRunMu.Unlock()
+
+	run, err := s.state.PipelineRunGet(req.RunId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the step to approve. If no step is given, there must be
+	// exactly one step waiting for approval.
+	var step *pb.PipelineRun_Step
+	for _, v := range run.Steps {
+		if req.Step != "" {
+			if v.Name == req.Step {
+				step = v
+				break
+			}
+
+			continue
+		}
+
+		if v.State != pb.PipelineRun_WAITING_APPROVAL {
+			continue
+		}
+		if step != nil {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"more than one step is waiting for approval, a step must be specified")
+		}
+
+		step = v
+	}
+	switch {
+	case step == nil && req.Step != "":
+		return nil, status.Errorf(codes.NotFound,
+			"pipeline run has no step named %q", req.Step)
+
+	case step == nil:
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"pipeline run has no steps waiting for approval")
+
+	case step.State != pb.PipelineRun_WAITING_APPROVAL:
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"step %q is not waiting for approval", step.Name)
+	}
+
+	step.State = pb.PipelineRun_SUCCESS
+	step.ApprovedBy = user.Username
+	step.ApproveTime = ptypes.TimestampNow()
+
+	// Updating the run triggers the pipeline scheduler which starts
+	// the steps that depend on the approval.
+	if err := s.state.PipelineRunUpdate(run); err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
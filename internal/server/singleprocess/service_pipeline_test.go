@@ -0,0 +1,352 @@
+package singleprocess
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/internal/server"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestServicePipeline(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	p := serverptypes.TestPipeline(t, nil)
+	resp, err := client.UpsertPipeline(ctx, &pb.UpsertPipelineRequest{Pipeline: p})
+	require.NoError(err)
+	require.Equal(p.Name, resp.Pipeline.Name)
+
+	list, err := client.ListPipelines(ctx, &pb.ListPipelinesRequest{Project: p.Project})
+	require.NoError(err)
+	require.Len(list.Pipelines, 1)
+
+	// Invalid pipelines are rejected
+	p.Steps[0].DependsOn = []string{"nope"}
+	_, err = client.UpsertPipeline(ctx, &pb.UpsertPipelineRequest{Pipeline: p})
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func TestServiceRunPipeline(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+	st := impl.(*service).state
+
+	p := serverptypes.TestPipeline(t, nil)
+	TestApp(t, client, p.Steps[0].Application)
+	p.Steps = append(p.Steps, &pb.Pipeline_Step{
+		Name:        "test",
+		Application: p.Steps[0].Application,
+		Op:          pb.Pipeline_EXEC,
+		DependsOn:   []string{"build"},
+		Command:     []string{"make", "test"},
+	}, &pb.Pipeline_Step{
+		Name:        "deploy",
+		Application: p.Steps[0].Application,
+		Op:          pb.Pipeline_DEPLOY,
+		DependsOn:   []string{"test"},
+	})
+	_, err = client.UpsertPipeline(ctx, &pb.UpsertPipelineRequest{Pipeline: p})
+	require.NoError(err)
+
+	template := serverptypes.TestJobNew(t, nil)
+	template.Application = nil
+	template.Operation = nil
+	runResp, err := client.RunPipeline(ctx, &pb.RunPipelineRequest{
+		Project:     p.Project,
+		Name:        p.Name,
+		JobTemplate: template,
+	})
+	require.NoError(err)
+	require.Equal(uint64(1), runResp.Run.Sequence)
+
+	// waitStep waits for the step at idx to reach the given state.
+	waitStep := func(idx int, state pb.PipelineRun_State) *pb.PipelineRun {
+		var run *pb.PipelineRun
+		require.Eventually(func() bool {
+			run, err = client.GetPipelineRun(ctx, &pb.GetPipelineRunRequest{Id: runResp.Run.Id})
+			require.NoError(err)
+			return run.Steps[idx].State == state
+		}, 5*time.Second, 10*time.Millisecond)
+		return run
+	}
+
+	// completeJob runs the next job as if it were a runner.
+	completeJob := func(cerr error) *pb.Job {
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+
+		job, err := st.JobAssignForRunner(ctx, &pb.Runner{Id: "R_A"})
+		require.NoError(err)
+		_, err = st.JobAck(job.Id, true)
+		require.NoError(err)
+		require.NoError(st.JobComplete(job.Id, nil, cerr))
+		return job.Job
+	}
+
+	// The first step starts right away
+	run := waitStep(0, pb.PipelineRun_RUNNING)
+	require.Equal(pb.PipelineRun_RUNNING, run.State)
+	require.Equal(pb.PipelineRun_PENDING, run.Steps[1].State)
+	job := completeJob(nil)
+	require.Equal(run.Steps[0].JobId, job.Id)
+	require.IsType((*pb.Job_Build)(nil), job.Operation)
+
+	// The exec step starts once the build succeeds
+	run = waitStep(1, pb.PipelineRun_RUNNING)
+	require.Equal(pb.PipelineRun_SUCCESS, run.Steps[0].State)
+	job = completeJob(errors.New("tests failed"))
+	require.IsType((*pb.Job_RunCommand)(nil), job.Operation)
+	require.Equal([]string{"make", "test"},
+		job.Operation.(*pb.Job_RunCommand).RunCommand.Command)
+
+	// The failure skips the rest of the pipeline
+	run = waitStep(1, pb.PipelineRun_ERROR)
+	require.Equal(pb.PipelineRun_SKIPPED, run.Steps[2].State)
+	require.Equal(pb.PipelineRun_ERROR, run.State)
+	require.NotNil(run.CompleteTime)
+	require.Contains(run.Steps[1].Error.Message, "tests failed")
+
+	runs, err := client.ListPipelineRuns(ctx, &pb.ListPipelineRunsRequest{
+		Project: p.Project,
+		Name:    p.Name,
+	})
+	require.NoError(err)
+	require.Len(runs.Runs, 1)
+}
+
+func TestServiceApprovePipelineRun(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)), WithSuperuser())
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+	st := impl.(*service).state
+
+	p := serverptypes.TestPipeline(t, nil)
+	TestApp(t, client, p.Steps[0].Application)
+	p.Steps = []*pb.Pipeline_Step{
+		{
+			Name: "approve",
+			Op:   pb.Pipeline_APPROVAL,
+		},
+		{
+			Name:        "build",
+			Application: p.Steps[0].Application,
+			Op:          pb.Pipeline_BUILD,
+			DependsOn:   []string{"approve"},
+		},
+	}
+	_, err = client.UpsertPipeline(ctx, &pb.UpsertPipelineRequest{Pipeline: p})
+	require.NoError(err)
+
+	template := serverptypes.TestJobNew(t, nil)
+	template.Application = nil
+	template.Operation = nil
+	runResp, err := client.RunPipeline(ctx, &pb.RunPipelineRequest{
+		Project:     p.Project,
+		Name:        p.Name,
+		JobTemplate: template,
+	})
+	require.NoError(err)
+	id := runResp.Run.Id
+
+	// The run waits for approval without queueing a job
+	var run *pb.PipelineRun
+	require.Eventually(func() bool {
+		run, err = client.GetPipelineRun(ctx, &pb.GetPipelineRunRequest{Id: id})
+		require.NoError(err)
+		return run.State == pb.PipelineRun_WAITING_APPROVAL
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(pb.PipelineRun_WAITING_APPROVAL, run.Steps[0].State)
+	require.Empty(run.Steps[0].JobId)
+	require.Equal(pb.PipelineRun_PENDING, run.Steps[1].State)
+
+	// Approving an unknown step or one that isn't waiting fails
+	_, err = client.ApprovePipelineRun(ctx, &pb.ApprovePipelineRunRequest{
+		RunId: id,
+		Step:  "nope",
+	})
+	require.Error(err)
+	require.Equal(codes.NotFound, status.Code(err))
+	_, err = client.ApprovePipelineRun(ctx, &pb.ApprovePipelineRunRequest{
+		RunId: id,
+		Step:  "build",
+	})
+	require.Error(err)
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+
+	// Approve
+	run, err = client.ApprovePipelineRun(ctx, &pb.ApprovePipelineRunRequest{RunId: id})
+	require.NoError(err)
+	require.Equal(pb.PipelineRun_SUCCESS, run.Steps[0].State)
+	require.Equal(DefaultUser, run.Steps[0].ApprovedBy)
+	require.NotNil(run.Steps[0].ApproveTime)
+
+	// The next step starts once approved
+	require.Eventually(func() bool {
+		run, err = client.GetPipelineRun(ctx, &pb.GetPipelineRunRequest{Id: id})
+		require.NoError(err)
+		return run.Steps[1].State == pb.PipelineRun_RUNNING
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(pb.PipelineRun_RUNNING, run.State)
+
+	{
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+
+		job, err := st.JobAssignForRunner(ctx, &pb.Runner{Id: "R_A"})
+		require.NoError(err)
+		require.Equal(run.Steps[1].JobId, job.Id)
+	}
+
+	// Nothing is left to approve
+	_, err = client.ApprovePipelineRun(ctx, &pb.ApprovePipelineRunRequest{RunId: id})
+	require.Error(err)
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+}
+
+func TestPipelineRunNext(t *testing.T) {
+	pipeline := &pb.Pipeline{
+		Steps: []*pb.Pipeline_Step{
+			{Name: "a"},
+			{Name: "b"},
+			{Name: "c", DependsOn: []string{"a", "b"}},
+		},
+	}
+
+	run := func(states ...pb.PipelineRun_State) *pb.PipelineRun {
+		r := &pb.PipelineRun{Pipeline: pipeline, State: pb.PipelineRun_PENDING}
+		for i, s := range states {
+			r.Steps = append(r.Steps, &pb.PipelineRun_Step{
+				Name:  pipeline.Steps[i].Name,
+				State: s,
+			})
+		}
+		return r
+	}
+
+	const (
+		pending = pb.PipelineRun_PENDING
+		running = pb.PipelineRun_RUNNING
+		success = pb.PipelineRun_SUCCESS
+		failed  = pb.PipelineRun_ERROR
+		skipped = pb.PipelineRun_SKIPPED
+		waiting = pb.PipelineRun_WAITING_APPROVAL
+	)
+
+	cases := []struct {
+		Name     string
+		Run      *pb.PipelineRun
+		Ready    []int
+		State    pb.PipelineRun_State
+		Expected []pb.PipelineRun_State
+	}{
+		{
+			"start",
+			run(pending, pending, pending),
+			[]int{0, 1},
+			running,
+			[]pb.PipelineRun_State{pending, pending, pending},
+		},
+
+		{
+			"waiting on dependencies",
+			run(success, running, pending),
+			nil,
+			running,
+			[]pb.PipelineRun_State{success, running, pending},
+		},
+
+		{
+			"dependencies done",
+			run(success, success, pending),
+			[]int{2},
+			running,
+			[]pb.PipelineRun_State{success, success, pending},
+		},
+
+		{
+			"failure waits for running steps",
+			run(failed, running, pending),
+			nil,
+			pending,
+			[]pb.PipelineRun_State{failed, running, skipped},
+		},
+
+		{
+			"failure",
+			run(failed, success, pending),
+			nil,
+			failed,
+			[]pb.PipelineRun_State{failed, success, skipped},
+		},
+
+		{
+			"waiting for approval",
+			run(success, waiting, pending),
+			nil,
+			waiting,
+			[]pb.PipelineRun_State{success, waiting, pending},
+		},
+
+		{
+			"running while waiting for approval",
+			run(running, waiting, pending),
+			nil,
+			running,
+			[]pb.PipelineRun_State{running, waiting, pending},
+		},
+
+		{
+			"failure skips approval",
+			run(failed, waiting, pending),
+			nil,
+			failed,
+			[]pb.PipelineRun_State{failed, skipped, skipped},
+		},
+
+		{
+			"success",
+			run(success, success, success),
+			nil,
+			success,
+			[]pb.PipelineRun_State{success, success, success},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			require.Equal(tt.Ready, pipelineRunNext(tt.Run))
+			require.Equal(tt.State, tt.Run.State)
+
+			var actual []pb.PipelineRun_State
+			for _, step := range tt.Run.Steps {
+				actual = append(actual, step.State)
+			}
+			require.Equal(tt.Expected, actual)
+		})
+	}
+}
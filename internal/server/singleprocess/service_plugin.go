@@ -0,0 +1,211 @@
+package singleprocess
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/hashicorp/go-hclog"
+	goversion "github.com/hashicorp/go-version"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+// maxPluginSize is the maximum size of a plugin binary. The binary is
+// written in a single database transaction so this also bounds the memory
+// used by an upload.
+const maxPluginSize = 512 * 1024 * 1024 // 512 MB
+
+func (s *service) UploadPlugin(
+	srv pb.Waypoint_UploadPluginServer,
+) error {
+	log := hclog.FromContext(srv.Context())
+
+	// Read our first event which must be an Open event.
+	log.Trace("waiting for Open message")
+	req, err := srv.Recv()
+	if err != nil {
+		return err
+	}
+	open, ok := req.Event.(*pb.UploadPluginRequest_Open_)
+	if !ok {
+		return status.Errorf(codes.FailedPrecondition,
+			"first message must be Open type")
+	}
+	open.Open.Checksum = strings.ToLower(open.Open.Checksum)
+	if err := ptypes.ValidateUploadPluginOpen(open.Open); err != nil {
+		return err
+	}
+
+	// We buffer the binary to a temporary file so that we know the checksum
+	// before we store it.
+	f, err := ioutil.TempFile("", "waypoint-plugin")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	h := sha256.New()
+	bw := bufio.NewWriterSize(io.MultiWriter(f, h), 1024*1024)
+	var size uint64
+	for {
+		req, err := srv.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		chunk, ok := req.Event.(*pb.UploadPluginRequest_Chunk)
+		if !ok {
+			return status.Errorf(codes.FailedPrecondition,
+				"all messages after Open must be data chunks")
+		}
+
+		size += uint64(len(chunk.Chunk))
+		if size > maxPluginSize {
+			return status.Errorf(codes.ResourceExhausted,
+				"plugin binary exceeds the maximum size of %d bytes", maxPluginSize)
+		}
+
+		if _, err := bw.Write(chunk.Chunk); err != nil {
+			return status.Errorf(codes.Aborted,
+				"error writing request data: %s", err)
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	checksum := hex.EncodeToString(h.Sum(nil))
+	if v := open.Open.Checksum; v != "" && v != checksum {
+		return status.Errorf(codes.InvalidArgument,
+			"plugin binary checksum mismatch, expected %s, got %s", v, checksum)
+	}
+
+	binary := &pb.PluginBinary{
+		Name:     open.Open.Name,
+		Version:  pluginVersion(open.Open.Version),
+		Os:       open.Open.Os,
+		Arch:     open.Open.Arch,
+		Checksum: checksum,
+		Size:     size,
+	}
+	if size == 0 {
+		return status.Errorf(codes.InvalidArgument, "plugin binary is empty")
+	}
+	if err := s.state.PluginBinaryPut(binary, bufio.NewReader(f)); err != nil {
+		return err
+	}
+
+	log.Info("stored plugin binary",
+		"name", binary.Name,
+		"version", binary.Version,
+		"os", binary.Os,
+		"arch", binary.Arch,
+		"checksum", binary.Checksum)
+	return srv.SendAndClose(&pb.UploadPluginResponse{Plugin: binary})
+}
+
+func (s *service) ListPlugins(
+	ctx context.Context,
+	req *pb.ListPluginsRequest,
+) (*pb.ListPluginsResponse, error) {
+	result, err := s.state.PluginBinaryList(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListPluginsResponse{Plugins: result}, nil
+}
+
+func (s *service) DownloadPlugin(
+	req *pb.DownloadPluginRequest,
+	srv pb.Waypoint_DownloadPluginServer,
+) error {
+	if err := ptypes.ValidateDownloadPluginRequest(req); err != nil {
+		return err
+	}
+
+	binary, err := s.state.PluginBinaryGet(
+		req.Name, pluginVersion(req.Version), req.Os, req.Arch)
+	if err != nil {
+		return err
+	}
+
+	if err := srv.Send(&pb.DownloadPluginResponse{
+		Event: &pb.DownloadPluginResponse_Open_{
+			Open: &pb.DownloadPluginResponse_Open{
+				Plugin: binary,
+			},
+		},
+	}); err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriterSize(&pluginWriter{srv: srv}, 1024*1024)
+	if err := s.state.PluginBinaryRead(binary.Checksum, bw); err != nil {
+		return err
+	}
+
+	return bw.Flush()
+}
+
+func (s *service) DeletePlugin(
+	ctx context.Context,
+	req *pb.DeletePluginRequest,
+) (*empty.Empty, error) {
+	if err := ptypes.ValidateDeletePluginRequest(req); err != nil {
+		return nil, err
+	}
+
+	if err := s.state.PluginBinaryDelete(
+		req.Name, pluginVersion(req.Version), req.Os, req.Arch); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// pluginVersion returns the canonical form of a version, such as "0.4.0"
+// for "0.4", so the same version is always stored under the same ID. The
+// version must already be validated.
+func pluginVersion(v string) string {
+	version, err := goversion.NewVersion(v)
+	if err != nil {
+		return v
+	}
+
+	return version.String()
+}
+
+type pluginWriter struct {
+	srv pb.Waypoint_DownloadPluginServer
+}
+
+func (w *pluginWriter) Write(p []byte) (int, error) {
+	// Ignore empty data.
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	return len(p), w.srv.Send(&pb.DownloadPluginResponse{
+		Event: &pb.DownloadPluginResponse_Chunk{
+			Chunk: p,
+		},
+	})
+}
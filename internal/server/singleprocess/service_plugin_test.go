@@ -0,0 +1,155 @@
+package singleprocess
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/internal/server"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestServicePlugin(t *testing.T) {
+	ctx := context.Background()
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(t, err)
+	client := server.TestServer(t, impl)
+
+	upload := func(t *testing.T, open *pb.UploadPluginRequest_Open, data []byte) (*pb.PluginBinary, error) {
+		stream, err := client.UploadPlugin(ctx)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&pb.UploadPluginRequest{
+			Event: &pb.UploadPluginRequest_Open_{Open: open},
+		}))
+
+		// If the server rejected the Open message then sending returns
+		// EOF and the error is returned by CloseAndRecv.
+		err = stream.Send(&pb.UploadPluginRequest{
+			Event: &pb.UploadPluginRequest_Chunk{Chunk: data},
+		})
+		if err != io.EOF {
+			require.NoError(t, err)
+		}
+
+		resp, err := stream.CloseAndRecv()
+		if err != nil {
+			return nil, err
+		}
+
+		return resp.Plugin, nil
+	}
+
+	data := bytes.Repeat([]byte("plugin"), 1024*1024)
+	sum := sha256.Sum256(data)
+	checksum := hex.EncodeToString(sum[:])
+
+	t.Run("upload and download", func(t *testing.T) {
+		require := require.New(t)
+
+		binary, err := upload(t, &pb.UploadPluginRequest_Open{
+			Name:     "kubernetes",
+			Version:  "0.4",
+			Os:       "linux",
+			Arch:     "amd64",
+			Checksum: checksum,
+		}, data)
+		require.NoError(err)
+		require.Equal("0.4.0", binary.Version)
+		require.Equal(checksum, binary.Checksum)
+		require.Equal(uint64(len(data)), binary.Size)
+
+		list, err := client.ListPlugins(ctx, &pb.ListPluginsRequest{Name: "kubernetes"})
+		require.NoError(err)
+		require.Len(list.Plugins, 1)
+		require.Equal("0.4.0", list.Plugins[0].Version)
+
+		download, err := client.DownloadPlugin(ctx, &pb.DownloadPluginRequest{
+			Name:    "kubernetes",
+			Version: "0.4.0",
+			Os:      "linux",
+			Arch:    "amd64",
+		})
+		require.NoError(err)
+
+		resp, err := download.Recv()
+		require.NoError(err)
+		require.IsType((*pb.DownloadPluginResponse_Open_)(nil), resp.Event)
+		require.Equal(checksum, resp.Event.(*pb.DownloadPluginResponse_Open_).Open.Plugin.Checksum)
+
+		var buf bytes.Buffer
+		for {
+			resp, err := download.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(err)
+			buf.Write(resp.Event.(*pb.DownloadPluginResponse_Chunk).Chunk)
+		}
+		require.Equal(data, buf.Bytes())
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		require := require.New(t)
+
+		_, err := upload(t, &pb.UploadPluginRequest_Open{
+			Name:     "nomad",
+			Version:  "1.0.0",
+			Os:       "linux",
+			Arch:     "amd64",
+			Checksum: checksum,
+		}, []byte("other"))
+		require.Error(err)
+		require.Equal(codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("versions can't be replaced", func(t *testing.T) {
+		require := require.New(t)
+
+		_, err := upload(t, &pb.UploadPluginRequest_Open{
+			Name:    "kubernetes",
+			Version: "0.4.0",
+			Os:      "linux",
+			Arch:    "amd64",
+		}, []byte("other"))
+		require.Error(err)
+		require.Equal(codes.AlreadyExists, status.Code(err))
+	})
+
+	t.Run("invalid version", func(t *testing.T) {
+		require := require.New(t)
+
+		_, err := upload(t, &pb.UploadPluginRequest_Open{
+			Name:    "kubernetes",
+			Version: "latest",
+			Os:      "linux",
+			Arch:    "amd64",
+		}, data)
+		require.Error(err)
+		require.Equal(codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require := require.New(t)
+
+		_, err := client.DeletePlugin(ctx, &pb.DeletePluginRequest{
+			Name:    "kubernetes",
+			Version: "0.4",
+			Os:      "linux",
+			Arch:    "amd64",
+		})
+		require.NoError(err)
+
+		list, err := client.ListPlugins(ctx, &pb.ListPluginsRequest{})
+		require.NoError(err)
+		require.Empty(list.Plugins)
+	})
+}
@@ -0,0 +1,24 @@
+package singleprocess
+
+import (
+	"context"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func (s *service) ListPreviews(
+	ctx context.Context,
+	req *pb.ListPreviewsRequest,
+) (*pb.ListPreviewsResponse, error) {
+	if err := serverptypes.ValidateListPreviewsRequest(req); err != nil {
+		return nil, err
+	}
+
+	result, err := s.state.PreviewList(req.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListPreviewsResponse{Previews: result}, nil
+}
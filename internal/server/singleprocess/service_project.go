@@ -3,10 +3,15 @@ package singleprocess
 import (
 	"context"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/hashicorp/go-memdb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+	"github.com/hashicorp/waypoint/internal/server/singleprocess/state"
 )
 
 // TODO: test
@@ -48,17 +53,89 @@ func (s *service) GetProject(
 	}, nil
 }
 
+func (s *service) DeleteProject(
+	ctx context.Context,
+	req *pb.DeleteProjectRequest,
+) (*empty.Empty, error) {
+	if req.Project == nil || req.Project.Project == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "project must be set")
+	}
+
+	// Get the project so that a project that doesn't exist is an error.
+	p, err := s.state.ProjectGet(req.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.Force {
+		var deployments, releases int
+		for _, app := range p.Applications {
+			ref := &pb.Ref_Application{
+				Project:     p.Name,
+				Application: app.Name,
+			}
+
+			ds, err := s.state.DeploymentList(ref,
+				state.ListWithPhysicalState(pb.Operation_CREATED))
+			if err != nil {
+				return nil, err
+			}
+			deployments += len(ds)
+
+			rs, err := s.state.ReleaseList(ref,
+				state.ListWithPhysicalState(pb.Operation_CREATED))
+			if err != nil {
+				return nil, err
+			}
+			releases += len(rs)
+		}
+
+		if deployments > 0 || releases > 0 {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"project %q has %d deployment(s) and %d release(s) whose resources "+
+					"haven't been destroyed. Destroy them first or force the delete.",
+				p.Name, deployments, releases)
+		}
+	}
+
+	if err := s.state.ProjectDelete(req.Project); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
 // TODO: test
 func (s *service) ListProjects(
 	ctx context.Context,
-	req *empty.Empty,
+	req *pb.ListProjectsRequest,
 ) (*pb.ListProjectsResponse, error) {
-	result, err := s.state.ProjectList()
+	page := &state.Page{Size: int(req.PageSize), Token: req.PageToken}
+	result, err := s.state.ProjectListPage(page, req.Labels)
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.ListProjectsResponse{Projects: result}, nil
+	return &pb.ListProjectsResponse{
+		Projects:      result,
+		NextPageToken: page.NextToken,
+	}, nil
+}
+
+func (s *service) WatchProjects(
+	req *empty.Empty,
+	srv pb.Waypoint_WatchProjectsServer,
+) error {
+	return watchList(srv.Context(), func(ws memdb.WatchSet) (proto.Message, error) {
+		result, err := s.state.ProjectListWatch(ws)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.ListProjectsResponse{Projects: result}, nil
+	}, func(resp proto.Message) error {
+		return srv.Send(resp.(*pb.ListProjectsResponse))
+	})
 }
 
 // TODO: test
@@ -86,6 +163,8 @@ func (s *service) UpsertApplication(
 	}
 
 	app.FileChangeSignal = req.FileChangeSignal
+	app.Labels = req.Labels
+	app.HealthChecks = req.HealthChecks
 
 	app, err = s.state.AppPut(app)
 	if err != nil {
@@ -0,0 +1,73 @@
+package singleprocess
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func (s *service) UpsertProjectTemplate(
+	ctx context.Context,
+	req *pb.UpsertProjectTemplateRequest,
+) (*pb.UpsertProjectTemplateResponse, error) {
+	if err := serverptypes.ValidateUpsertProjectTemplateRequest(req); err != nil {
+		return nil, err
+	}
+
+	if err := s.state.ProjectTemplatePut(req.Template); err != nil {
+		return nil, err
+	}
+
+	return &pb.UpsertProjectTemplateResponse{Template: req.Template}, nil
+}
+
+func (s *service) GetProjectTemplate(
+	ctx context.Context,
+	req *pb.GetProjectTemplateRequest,
+) (*pb.GetProjectTemplateResponse, error) {
+	if err := serverptypes.ValidateGetProjectTemplateRequest(req); err != nil {
+		return nil, err
+	}
+
+	value, err := s.state.ProjectTemplateGet(req.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetProjectTemplateResponse{Template: value}, nil
+}
+
+func (s *service) ListProjectTemplates(
+	ctx context.Context,
+	req *empty.Empty,
+) (*pb.ListProjectTemplatesResponse, error) {
+	values, err := s.state.ProjectTemplateList()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListProjectTemplatesResponse{Templates: values}, nil
+}
+
+func (s *service) DeleteProjectTemplate(
+	ctx context.Context,
+	req *pb.DeleteProjectTemplateRequest,
+) (*empty.Empty, error) {
+	if err := serverptypes.ValidateDeleteProjectTemplateRequest(req); err != nil {
+		return nil, err
+	}
+
+	// Validate that the template exists
+	if _, err := s.state.ProjectTemplateGet(req.Template); err != nil {
+		return nil, err
+	}
+
+	if err := s.state.ProjectTemplateDelete(req.Template); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,93 @@
+package singleprocess
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/internal/server"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestServiceProjectTemplate(t *testing.T) {
+	ctx := context.Background()
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(t, err)
+	client := server.TestServer(t, impl)
+
+	t.Run("upsert, get, list, and delete", func(t *testing.T) {
+		require := require.New(t)
+
+		// Create
+		resp, err := client.UpsertProjectTemplate(ctx, &pb.UpsertProjectTemplateRequest{
+			Template: serverptypes.TestProjectTemplate(t, &pb.ProjectTemplate{
+				Name:       "web",
+				ConfigVars: map[string]string{"PORT": "8080"},
+			}),
+		})
+		require.NoError(err)
+		require.Equal("web", resp.Template.Name)
+
+		// Get
+		{
+			resp, err := client.GetProjectTemplate(ctx, &pb.GetProjectTemplateRequest{
+				Template: &pb.Ref_ProjectTemplate{Name: "web"},
+			})
+			require.NoError(err)
+			require.Equal("8080", resp.Template.ConfigVars["PORT"])
+		}
+
+		// List
+		{
+			resp, err := client.ListProjectTemplates(ctx, &empty.Empty{})
+			require.NoError(err)
+			require.Len(resp.Templates, 1)
+		}
+
+		// Delete
+		{
+			_, err := client.DeleteProjectTemplate(ctx, &pb.DeleteProjectTemplateRequest{
+				Template: &pb.Ref_ProjectTemplate{Name: "web"},
+			})
+			require.NoError(err)
+		}
+
+		// Get again
+		{
+			_, err := client.GetProjectTemplate(ctx, &pb.GetProjectTemplateRequest{
+				Template: &pb.Ref_ProjectTemplate{Name: "web"},
+			})
+			require.Error(err)
+			require.Equal(codes.NotFound, status.Code(err))
+		}
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		require := require.New(t)
+
+		_, err := client.UpsertProjectTemplate(ctx, &pb.UpsertProjectTemplateRequest{
+			Template: serverptypes.TestProjectTemplate(t, &pb.ProjectTemplate{
+				WaypointHcl: []byte(`project = "{{.Project"`),
+			}),
+		})
+		require.Error(err)
+		require.Equal(codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("delete non-existent", func(t *testing.T) {
+		require := require.New(t)
+
+		_, err := client.DeleteProjectTemplate(ctx, &pb.DeleteProjectTemplateRequest{
+			Template: &pb.Ref_ProjectTemplate{Name: "nope"},
+		})
+		require.Error(err)
+		require.Equal(codes.NotFound, status.Code(err))
+	})
+}
@@ -0,0 +1,136 @@
+package singleprocess
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/internal/server"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestServiceWatchProjects(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	upsert := func(name string) {
+		_, err := client.UpsertProject(ctx, &pb.UpsertProjectRequest{
+			Project: serverptypes.TestProject(t, &pb.Project{Name: name}),
+		})
+		require.NoError(err)
+	}
+
+	upsert("a")
+
+	stream, err := client.WatchProjects(ctx, &empty.Empty{})
+	require.NoError(err)
+
+	// The current list is sent first
+	resp, err := stream.Recv()
+	require.NoError(err)
+	require.Len(resp.Projects, 1)
+
+	// A new project sends the list again
+	upsert("b")
+	resp, err = stream.Recv()
+	require.NoError(err)
+	require.Len(resp.Projects, 2)
+}
+
+func TestServiceDeleteProject(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	// The test deployment is for project "p_test" and app "a_test"
+	_, err = client.UpsertProject(ctx, &pb.UpsertProjectRequest{
+		Project: serverptypes.TestProject(t, &pb.Project{
+			Name: "p_test",
+			Applications: []*pb.Application{
+				{Name: "a_test", Project: &pb.Ref_Project{Project: "p_test"}},
+			},
+		}),
+	})
+	require.NoError(err)
+
+	dep, err := client.UpsertDeployment(ctx, &pb.UpsertDeploymentRequest{
+		Deployment: serverptypes.TestValidDeployment(t, &pb.Deployment{
+			State: pb.Operation_CREATED,
+		}),
+	})
+	require.NoError(err)
+
+	ref := &pb.Ref_Project{Project: "p_test"}
+
+	// Can't delete with a live deployment
+	_, err = client.DeleteProject(ctx, &pb.DeleteProjectRequest{Project: ref})
+	require.Error(err)
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+
+	// Once the deployment is destroyed, we can
+	dep.Deployment.State = pb.Operation_DESTROYED
+	_, err = client.UpsertDeployment(ctx, &pb.UpsertDeploymentRequest{
+		Deployment: dep.Deployment,
+	})
+	require.NoError(err)
+
+	_, err = client.DeleteProject(ctx, &pb.DeleteProjectRequest{Project: ref})
+	require.NoError(err)
+
+	_, err = client.GetProject(ctx, &pb.GetProjectRequest{Project: ref})
+	require.Error(err)
+	require.Equal(codes.NotFound, status.Code(err))
+
+	// Deleting a project that doesn't exist is an error
+	_, err = client.DeleteProject(ctx, &pb.DeleteProjectRequest{Project: ref})
+	require.Error(err)
+	require.Equal(codes.NotFound, status.Code(err))
+}
+
+func TestServiceDeleteProject_force(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	_, err = client.UpsertProject(ctx, &pb.UpsertProjectRequest{
+		Project: serverptypes.TestProject(t, &pb.Project{
+			Name: "p_test",
+			Applications: []*pb.Application{
+				{Name: "a_test", Project: &pb.Ref_Project{Project: "p_test"}},
+			},
+		}),
+	})
+	require.NoError(err)
+
+	_, err = client.UpsertDeployment(ctx, &pb.UpsertDeploymentRequest{
+		Deployment: serverptypes.TestValidDeployment(t, &pb.Deployment{
+			State: pb.Operation_CREATED,
+		}),
+	})
+	require.NoError(err)
+
+	_, err = client.DeleteProject(ctx, &pb.DeleteProjectRequest{
+		Project: &pb.Ref_Project{Project: "p_test"},
+		Force:   true,
+	})
+	require.NoError(err)
+}
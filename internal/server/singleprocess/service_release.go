@@ -2,7 +2,9 @@ package singleprocess
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/hashicorp/go-hclog"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -30,10 +32,35 @@ func (s *service) UpsertRelease(
 		result.Id = id
 	}
 
+	// Check if the release just completed so we can record an event about
+	// it.
+	released := result.Status.GetState() == pb.Status_SUCCESS
+	if released && !insert {
+		existing, err := s.state.ReleaseGet(&pb.Ref_Operation{
+			Target: &pb.Ref_Operation_Id{Id: result.Id},
+		})
+		if err != nil && status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+		if existing.GetStatus().GetState() == pb.Status_SUCCESS {
+			released = false
+		}
+	}
+
 	if err := s.state.ReleasePut(!insert, result); err != nil {
 		return nil, err
 	}
 
+	if released {
+		msg := fmt.Sprintf("release v%d switched to deployment %s", result.Sequence, result.DeploymentId)
+		if result.Url != "" {
+			msg += " at " + result.Url
+		}
+
+		s.appEvent(hclog.FromContext(ctx), result.Application, result.Workspace,
+			pb.AppEvent_RELEASE_SWITCHED, result.Id, result.Sequence, "%s", msg)
+	}
+
 	return &pb.UpsertReleaseResponse{Release: result}, nil
 }
 
@@ -47,6 +74,7 @@ func (s *service) ListReleases(
 		state.ListWithOrder(req.Order),
 		state.ListWithWorkspace(req.Workspace),
 		state.ListWithPhysicalState(req.PhysicalState),
+		state.ListWithFilter(req.Filter),
 	)
 	if err != nil {
 		return nil, err
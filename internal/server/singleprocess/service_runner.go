@@ -3,7 +3,10 @@ package singleprocess
 import (
 	"context"
 	"io"
+	"sort"
+	"time"
 
+	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-memdb"
 	"google.golang.org/grpc/codes"
@@ -11,15 +14,168 @@ import (
 
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 	"github.com/hashicorp/waypoint/internal/server/logbuffer"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
 	"github.com/hashicorp/waypoint/internal/server/singleprocess/state"
 )
 
+// runnerHeartbeatTimeout is how long the server waits for a heartbeat
+// from a runner that sent one before it disconnects the runner. Runners
+// send heartbeats much more often than this.
+const runnerHeartbeatTimeout = 1 * time.Minute
+
 // TODO: test
 func (s *service) GetRunner(
 	ctx context.Context,
 	req *pb.GetRunnerRequest,
 ) (*pb.Runner, error) {
-	return s.state.RunnerById(req.RunnerId)
+	return s.state.RunnerById(req.RunnerId, nil)
+}
+
+func (s *service) AdoptRunner(
+	ctx context.Context,
+	req *pb.AdoptRunnerRequest,
+) (*empty.Empty, error) {
+	if err := serverptypes.ValidateAdoptRunnerRequest(req); err != nil {
+		return nil, err
+	}
+
+	if err := s.state.RunnerAdopt(req.RunnerId); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *service) RejectRunner(
+	ctx context.Context,
+	req *pb.RejectRunnerRequest,
+) (*empty.Empty, error) {
+	if err := serverptypes.ValidateRejectRunnerRequest(req); err != nil {
+		return nil, err
+	}
+
+	if err := s.state.RunnerReject(req.RunnerId); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *service) ListRunners(
+	ctx context.Context,
+	req *empty.Empty,
+) (*pb.ListRunnersResponse, error) {
+	runners, err := s.state.RunnerList()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(runners, func(i, j int) bool {
+		return runners[i].Id < runners[j].Id
+	})
+
+	var result pb.ListRunnersResponse
+	for _, r := range runners {
+		ids, err := s.state.JobIdsByRunner(r.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Runners = append(result.Runners, &pb.ListRunnersResponse_Runner{
+			Runner: r,
+			JobIds: ids,
+		})
+	}
+
+	return &result, nil
+}
+
+// runnerFailJobs fails the jobs that are assigned to the runner with the
+// given ID. This is called once the runner disconnects so that its jobs
+// don't wait for the job heartbeat to time out. Jobs that the runner never
+// acked are queued again instead.
+//
+// The config stream of a runner may close while its job streams stay
+// open, such as when the runner reconnects it. Jobs with an open job
+// stream are left alone, and are failed once their job stream closes
+// instead.
+func (s *service) runnerFailJobs(log hclog.Logger, id string) {
+	ids, err := s.state.JobIdsByRunner(id)
+	if err != nil {
+		log.Error("failed to list jobs of runner", "err", err)
+		return
+	}
+
+	for _, jobId := range ids {
+		if s.jobStreamOpen(jobId) {
+			log.Debug("job stream of disconnected runner is open, not failing job",
+				"job_id", jobId)
+			continue
+		}
+
+		s.runnerFailJob(log, id, jobId)
+	}
+}
+
+// runnerFailJob fails a job assigned to the runner with the given ID that
+// disconnected, or queues it again if the runner never acked it.
+func (s *service) runnerFailJob(log hclog.Logger, id, jobId string) {
+	job, err := s.state.JobById(jobId, nil)
+	if err != nil || job == nil {
+		return
+	}
+
+	switch job.State {
+	case pb.Job_WAITING:
+		log.Info("runner disconnected before acking job, requeueing it", "job_id", jobId)
+		_, err = s.state.JobAck(jobId, false)
+
+	case pb.Job_RUNNING:
+		log.Warn("runner disconnected while running job, failing it", "job_id", jobId)
+		jobErr := status.Errorf(codes.Unavailable,
+			"runner %q disconnected while running the job", id)
+		err = s.state.JobComplete(jobId, nil, jobErr)
+		if err == nil {
+			s.observeJobDuration(job, "error")
+			s.notifyJobFailed(log, job.Job, jobErr)
+		}
+	}
+	if err != nil {
+		log.Warn("error updating job of disconnected runner", "job_id", jobId, "err", err)
+	}
+}
+
+// jobStreamAdd records that a job stream for the job opened. The returned
+// function records that it closed.
+func (s *service) jobStreamAdd(jobId string) func() {
+	s.jobStreamsMu.Lock()
+	defer s.jobStreamsMu.Unlock()
+	if s.jobStreams == nil {
+		s.jobStreams = map[string]int{}
+	}
+	s.jobStreams[jobId]++
+
+	return func() {
+		s.jobStreamsMu.Lock()
+		defer s.jobStreamsMu.Unlock()
+		s.jobStreams[jobId]--
+		if s.jobStreams[jobId] <= 0 {
+			delete(s.jobStreams, jobId)
+		}
+	}
+}
+
+// jobStreamOpen returns true if a job stream for the job is open.
+func (s *service) jobStreamOpen(jobId string) bool {
+	s.jobStreamsMu.Lock()
+	defer s.jobStreamsMu.Unlock()
+	return s.jobStreams[jobId] > 0
+}
+
+// runnerPreadopted returns true if the token pre-authorizes the runner, so
+// that it doesn't have to be adopted.
+func runnerPreadopted(token *pb.Token, r *pb.Runner) bool {
+	adoption := token.GetLogin().GetRunnerAdoption()
+	return adoption != nil && serverptypes.LabelsMatch(adoption.Labels, r.Labels)
 }
 
 func (s *service) RunnerGetDeploymentConfig(
@@ -76,6 +232,13 @@ func (s *service) RunnerConfig(
 	}
 	record := req.Open.Runner
 
+	// Runners don't have to be adopted unless the server requires it. The
+	// token may pre-authorize the runner in that case.
+	record.AdoptionState = pb.Runner_PENDING
+	if !s.requireRunnerAdoption || runnerPreadopted(tokenFromContext(ctx), record) {
+		record.AdoptionState = pb.Runner_PREADOPTED
+	}
+
 	// Create our record
 	log = log.With("runner_id", record.Id)
 	log.Trace("registering runner")
@@ -91,15 +254,27 @@ func (s *service) RunnerConfig(
 		if err := s.state.RunnerDelete(record.Id); err != nil {
 			log.Error("failed to delete runner data. This should not happen.", "err", err)
 		}
+
+		// The runner can't complete its jobs anymore.
+		s.runnerFailJobs(log, record.Id)
 	}()
 
 	// Start a goroutine that listens on the recvmsg so we can detect
-	// when the client exited.
+	// when the client exited. Once the runner sends a heartbeat, we also
+	// disconnect it if the heartbeats stop. Runners that don't send
+	// heartbeats are only disconnected when the stream closes.
 	go func() {
 		defer cancel()
 
+		var timer *time.Timer
+		defer func() {
+			if timer != nil {
+				timer.Stop()
+			}
+		}()
+
 		for {
-			_, err := srv.Recv()
+			event, err := srv.Recv()
 			if err != nil {
 				if err != io.EOF {
 					log.Warn("unknown error from recvmsg", "err", err)
@@ -107,6 +282,23 @@ func (s *service) RunnerConfig(
 
 				return
 			}
+
+			if _, ok := event.Event.(*pb.RunnerConfigRequest_Heartbeat_); !ok {
+				continue
+			}
+
+			if err := s.state.RunnerHeartbeat(record.Id); err != nil {
+				log.Warn("error updating runner heartbeat", "err", err)
+			}
+
+			if timer == nil {
+				timer = time.AfterFunc(runnerHeartbeatTimeout, func() {
+					log.Warn("runner heartbeat timed out, disconnecting runner")
+					cancel()
+				})
+			} else {
+				timer.Reset(runnerHeartbeatTimeout)
+			}
 		}
 	}()
 
@@ -114,6 +306,27 @@ func (s *service) RunnerConfig(
 	for {
 		ws := memdb.NewWatchSet()
 
+		// The runner waits for its first config before it requests jobs,
+		// so we don't send any until the runner is adopted.
+		runner, err := s.state.RunnerById(record.Id, ws)
+		if err != nil {
+			return err
+		}
+		switch runner.AdoptionState {
+		case pb.Runner_REJECTED:
+			log.Info("rejected runner attempted to register")
+			return status.Errorf(codes.PermissionDenied,
+				"runner %q was rejected by the server", record.Id)
+
+		case pb.Runner_PENDING:
+			log.Info("runner is waiting to be adopted")
+			if err := ws.WatchCtx(ctx); err != nil {
+				return err
+			}
+
+			continue
+		}
+
 		// Build our config
 		config := &pb.RunnerConfig{}
 
@@ -167,17 +380,36 @@ func (s *service) RunnerJobStream(
 	log = log.With("runner_id", reqEvent.Request.RunnerId)
 
 	// Get the runner to validate it is registered
-	runner, err := s.state.RunnerById(reqEvent.Request.RunnerId)
+	runner, err := s.state.RunnerById(reqEvent.Request.RunnerId, nil)
 	if err != nil {
 		return err
 	}
 
+	// Runners that aren't adopted are never assigned jobs.
+	if runner.AdoptionState != pb.Runner_ADOPTED &&
+		runner.AdoptionState != pb.Runner_PREADOPTED {
+		return status.Errorf(codes.PermissionDenied,
+			"runner %q must be adopted before it is assigned jobs", runner.Id)
+	}
+
 	// Get a job assignment for this runner
 	job, err := s.state.JobAssignForRunner(ctx, runner)
 	if err != nil {
 		return err
 	}
 
+	// Track that the job stream is open. If the config stream of the
+	// runner closed while this stream was open, the runner's jobs were
+	// left to us, so we fail the job if it didn't complete.
+	jobId := job.Id
+	jobStreamDone := s.jobStreamAdd(jobId)
+	defer func() {
+		jobStreamDone()
+		if _, err := s.state.RunnerById(runner.Id, nil); status.Code(err) == codes.NotFound {
+			s.runnerFailJob(log, runner.Id, jobId)
+		}
+	}()
+
 	// Send the job assignment.
 	//
 	// If this has an error, we continue to accumulate the error until
@@ -388,10 +620,22 @@ func (s *service) handleJobStreamRequest(
 	log.Trace("event received", "event", req.Event)
 	switch event := req.Event.(type) {
 	case *pb.RunnerJobStreamRequest_Complete_:
-		return s.state.JobComplete(job.Id, event.Complete.Result, nil)
+		if err := s.state.JobComplete(job.Id, event.Complete.Result, nil); err != nil {
+			return err
+		}
+
+		s.observeJobDuration(job, "success")
+		return nil
 
 	case *pb.RunnerJobStreamRequest_Error_:
-		return s.state.JobComplete(job.Id, nil, status.FromProto(event.Error.Error).Err())
+		jobErr := status.FromProto(event.Error.Error).Err()
+		if err := s.state.JobComplete(job.Id, nil, jobErr); err != nil {
+			return err
+		}
+
+		s.observeJobDuration(job, "error")
+		s.notifyJobFailed(log, job.Job, jobErr)
+		return nil
 
 	case *pb.RunnerJobStreamRequest_Heartbeat_:
 		return s.state.JobHeartbeat(job.Id)
@@ -406,6 +650,13 @@ func (s *service) handleJobStreamRequest(
 		}, job.Workspace, event.Download.DataSourceRef)
 
 	case *pb.RunnerJobStreamRequest_Terminal:
+		// Store the output so that it can be read after the job completes,
+		// even after a server restart. We don't fail the job if this fails
+		// since the output is still streamed to anyone watching the job.
+		if err := s.state.JobLogAppend(job.Id, event.Terminal.Events); err != nil {
+			log.Warn("error storing job output", "err", err)
+		}
+
 		// This shouldn't happen but we want to protect against it to prevent
 		// a panic.
 		if job.OutputBuffer == nil {
@@ -4,7 +4,9 @@ import (
 	"context"
 	"io"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -12,6 +14,7 @@ import (
 	"github.com/hashicorp/waypoint/internal/server"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+	"github.com/hashicorp/waypoint/internal/serverconfig"
 )
 
 // Complete happy path job stream
@@ -316,3 +319,268 @@ func TestServiceRunnerGetDeploymentConfig(t *testing.T) {
 		require.NotEmpty(resp.ServerAddr)
 	})
 }
+
+func TestServiceRunnerConfig_adoption(t *testing.T) {
+	ctx := context.Background()
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)), WithConfig(&serverconfig.Config{
+		RequireRunnerAdoption: true,
+	}))
+	require.NoError(t, err)
+	client := server.TestServer(t, impl)
+
+	// open registers a runner and returns a channel that receives the
+	// result of waiting for its first config.
+	open := func(t *testing.T, id string) <-chan error {
+		stream, err := client.RunnerConfig(ctx)
+		require.NoError(t, err)
+		t.Cleanup(func() { stream.CloseSend() })
+
+		require.NoError(t, stream.Send(&pb.RunnerConfigRequest{
+			Event: &pb.RunnerConfigRequest_Open_{
+				Open: &pb.RunnerConfigRequest_Open{
+					Runner: &pb.Runner{Id: id},
+				},
+			},
+		}))
+
+		errCh := make(chan error, 1)
+		go func() {
+			_, err := stream.Recv()
+			errCh <- err
+		}()
+
+		return errCh
+	}
+
+	t.Run("pending until adopted", func(t *testing.T) {
+		require := require.New(t)
+
+		errCh := open(t, "A")
+
+		// The runner doesn't get its config
+		select {
+		case err := <-errCh:
+			t.Fatalf("runner got config before adoption: %v", err)
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		runner, err := client.GetRunner(ctx, &pb.GetRunnerRequest{RunnerId: "A"})
+		require.NoError(err)
+		require.Equal(pb.Runner_PENDING, runner.AdoptionState)
+
+		// The runner can't request jobs
+		stream, err := client.RunnerJobStream(ctx)
+		require.NoError(err)
+		require.NoError(stream.Send(&pb.RunnerJobStreamRequest{
+			Event: &pb.RunnerJobStreamRequest_Request_{
+				Request: &pb.RunnerJobStreamRequest_Request{
+					RunnerId: "A",
+				},
+			},
+		}))
+		_, err = stream.Recv()
+		require.Error(err)
+		require.Equal(codes.PermissionDenied, status.Code(err))
+
+		// Adopt
+		_, err = client.AdoptRunner(ctx, &pb.AdoptRunnerRequest{RunnerId: "A"})
+		require.NoError(err)
+
+		select {
+		case err := <-errCh:
+			require.NoError(err)
+		case <-time.After(2 * time.Second):
+			t.Fatal("runner didn't get config after adoption")
+		}
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		require := require.New(t)
+
+		_, err := client.RejectRunner(ctx, &pb.RejectRunnerRequest{RunnerId: "B"})
+		require.NoError(err)
+
+		select {
+		case err := <-open(t, "B"):
+			require.Error(err)
+			require.Equal(codes.PermissionDenied, status.Code(err))
+		case <-time.After(2 * time.Second):
+			t.Fatal("rejected runner wasn't disconnected")
+		}
+	})
+
+	t.Run("runner ID is required", func(t *testing.T) {
+		require := require.New(t)
+
+		_, err := client.AdoptRunner(ctx, &pb.AdoptRunnerRequest{})
+		require.Error(err)
+		require.Equal(codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestRunnerPreadopted(t *testing.T) {
+	runner := &pb.Runner{Labels: map[string]string{"env": "prod"}}
+	token := func(adoption *pb.Token_RunnerAdoption) *pb.Token {
+		return &pb.Token{
+			Kind: &pb.Token_Login_{
+				Login: &pb.Token_Login{RunnerAdoption: adoption},
+			},
+		}
+	}
+
+	require.False(t, runnerPreadopted(nil, runner))
+	require.False(t, runnerPreadopted(token(nil), runner))
+	require.True(t, runnerPreadopted(token(&pb.Token_RunnerAdoption{}), runner))
+	require.True(t, runnerPreadopted(token(&pb.Token_RunnerAdoption{
+		Labels: map[string]string{"env": "prod"},
+	}), runner))
+	require.False(t, runnerPreadopted(token(&pb.Token_RunnerAdoption{
+		Labels: map[string]string{"env": "dev"},
+	}), runner))
+}
+
+func TestServiceListRunners(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+	s := testServiceImpl(impl)
+
+	// Initialize our app
+	TestApp(t, client, serverptypes.TestJobNew(t, nil).Application)
+
+	// Register our runner
+	id, closer := TestRunner(t, client, &pb.Runner{
+		Version: "v1.2.3",
+		Labels:  map[string]string{"env": "prod"},
+	})
+
+	// Queue a job and run it on the runner
+	queueResp, err := client.QueueJob(ctx, &pb.QueueJobRequest{Job: serverptypes.TestJobNew(t, nil)})
+	require.NoError(err)
+	runner, err := s.state.RunnerById(id, nil)
+	require.NoError(err)
+	job, err := s.state.JobAssignForRunner(ctx, runner)
+	require.NoError(err)
+	require.Equal(queueResp.JobId, job.Id)
+	_, err = s.state.JobAck(job.Id, true)
+	require.NoError(err)
+
+	// List
+	resp, err := client.ListRunners(ctx, &empty.Empty{})
+	require.NoError(err)
+	require.Len(resp.Runners, 1)
+	require.Equal(id, resp.Runners[0].Runner.Id)
+	require.Equal("v1.2.3", resp.Runners[0].Runner.Version)
+	require.NotNil(resp.Runners[0].Runner.LastSeen)
+	require.Equal([]string{job.Id}, resp.Runners[0].JobIds)
+
+	// The runner disconnects
+	closer()
+
+	// The runner is removed and the job fails
+	require.Eventually(func() bool {
+		job, err := s.state.JobById(job.Id, nil)
+		require.NoError(err)
+		return job.State == pb.Job_ERROR
+	}, 2*time.Second, 10*time.Millisecond)
+
+	resp, err = client.ListRunners(ctx, &empty.Empty{})
+	require.NoError(err)
+	require.Empty(resp.Runners)
+}
+
+// The jobs of a runner whose config stream closed keep running while their
+// job stream is open.
+func TestServiceRunnerJobStream_configStreamClosed(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Complete bool
+		Expected pb.Job_State
+	}{
+		{"job completes", true, pb.Job_SUCCESS},
+		{"job stream closes", false, pb.Job_ERROR},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			// Create our server
+			impl, err := New(WithDB(testDB(t)))
+			require.NoError(err)
+			client := server.TestServer(t, impl)
+			s := testServiceImpl(impl)
+
+			// Initialize our app
+			TestApp(t, client, serverptypes.TestJobNew(t, nil).Application)
+
+			// Create a job
+			queueResp, err := client.QueueJob(context.Background(), &pb.QueueJobRequest{
+				Job: serverptypes.TestJobNew(t, nil),
+			})
+			require.NoError(err)
+
+			// Register our runner and start running the job
+			id, closer := TestRunner(t, client, nil)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			stream, err := client.RunnerJobStream(ctx)
+			require.NoError(err)
+			require.NoError(stream.Send(&pb.RunnerJobStreamRequest{
+				Event: &pb.RunnerJobStreamRequest_Request_{
+					Request: &pb.RunnerJobStreamRequest_Request{
+						RunnerId: id,
+					},
+				},
+			}))
+			_, err = stream.Recv()
+			require.NoError(err)
+			require.NoError(stream.Send(&pb.RunnerJobStreamRequest{
+				Event: &pb.RunnerJobStreamRequest_Ack_{
+					Ack: &pb.RunnerJobStreamRequest_Ack{},
+				},
+			}))
+			require.Eventually(func() bool {
+				job, err := s.state.JobById(queueResp.JobId, nil)
+				require.NoError(err)
+				return job.State == pb.Job_RUNNING
+			}, 2*time.Second, 10*time.Millisecond)
+
+			// The config stream closes and the runner is removed
+			closer()
+			require.Eventually(func() bool {
+				_, err := s.state.RunnerById(id, nil)
+				return status.Code(err) == codes.NotFound
+			}, 2*time.Second, 10*time.Millisecond)
+
+			// The job keeps running
+			job, err := s.state.JobById(queueResp.JobId, nil)
+			require.NoError(err)
+			require.Equal(pb.Job_RUNNING, job.State)
+
+			if tt.Complete {
+				require.NoError(stream.Send(&pb.RunnerJobStreamRequest{
+					Event: &pb.RunnerJobStreamRequest_Complete_{
+						Complete: &pb.RunnerJobStreamRequest_Complete{},
+					},
+				}))
+				_, err = stream.Recv()
+				require.Equal(io.EOF, err)
+			} else {
+				cancel()
+			}
+
+			require.Eventually(func() bool {
+				job, err := s.state.JobById(queueResp.JobId, nil)
+				require.NoError(err)
+				return job.State == tt.Expected
+			}, 2*time.Second, 10*time.Millisecond)
+		})
+	}
+}
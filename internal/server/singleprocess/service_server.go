@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/hashicorp/waypoint/internal/protocolversion"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
 )
@@ -37,3 +38,51 @@ func (s *service) GetServerConfig(
 
 	return &pb.GetServerConfigResponse{Config: cfg}, nil
 }
+
+func (s *service) GetServerStatus(
+	ctx context.Context,
+	req *empty.Empty,
+) (*pb.GetServerStatusResponse, error) {
+	size, err := s.state.DBSize()
+	if err != nil {
+		return nil, err
+	}
+
+	runners, err := s.state.RunnerList()
+	if err != nil {
+		return nil, err
+	}
+
+	// Jobs that are assigned but not yet accepted by a runner are still
+	// waiting in the queue as far as operators are concerned.
+	var queued int
+	for _, state := range []pb.Job_State{pb.Job_QUEUED, pb.Job_WAITING} {
+		count, err := s.state.JobCountByState(state)
+		if err != nil {
+			return nil, err
+		}
+
+		queued += count
+	}
+
+	running, err := s.state.JobCountByState(pb.Job_RUNNING)
+	if err != nil {
+		return nil, err
+	}
+
+	urlService := &pb.GetServerStatusResponse_URLService{}
+	if s.urlConfig != nil && s.urlConfig.Enabled {
+		urlService.Enabled = true
+		urlService.Connected = s.urlClient() != nil
+		urlService.ApiAddress = s.urlConfig.APIAddress
+	}
+
+	return &pb.GetServerStatusResponse{
+		Version:         protocolversion.Current(),
+		DbSizeBytes:     size,
+		RunnerCount:     uint32(len(runners)),
+		QueuedJobCount:  uint32(queued),
+		RunningJobCount: uint32(running),
+		UrlService:      urlService,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package singleprocess
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/server"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestServiceGetServerStatus(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	// Initialize our app and queue a job
+	TestApp(t, client, serverptypes.TestJobNew(t, nil).Application)
+	_, err = client.QueueJob(ctx, &pb.QueueJobRequest{
+		Job: serverptypes.TestJobNew(t, nil),
+	})
+	require.NoError(err)
+
+	resp, err := client.GetServerStatus(ctx, &empty.Empty{})
+	require.NoError(err)
+	require.NotNil(resp.Version)
+	require.True(resp.DbSizeBytes > 0)
+	require.Equal(uint32(1), resp.QueuedJobCount)
+	require.Equal(uint32(0), resp.RunningJobCount)
+	require.False(resp.UrlService.Enabled)
+	require.False(resp.UrlService.Connected)
+}
@@ -0,0 +1,147 @@
+package singleprocess
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+// serviceAccountUsernamePrefix is the prefix of the username of service
+// accounts when they make requests. Usernames can't contain a slash so
+// this never matches a user.
+const serviceAccountUsernamePrefix = "service-account/"
+
+// serviceAccountDeniedEndpoints are the endpoints that act on the calling
+// user and don't apply to service accounts.
+var serviceAccountDeniedEndpoints = map[string]struct{}{
+	"GenerateLoginToken":  {},
+	"GenerateInviteToken": {},
+	"UpdateUser":          {},
+}
+
+func (s *service) CreateServiceAccount(
+	ctx context.Context,
+	req *pb.CreateServiceAccountRequest,
+) (*pb.CreateServiceAccountResponse, error) {
+	if err := serverptypes.ValidateCreateServiceAccountRequest(req); err != nil {
+		return nil, err
+	}
+
+	// Only copy the fields that can be set so that the ID is always
+	// generated by the server.
+	sa := &pb.ServiceAccount{
+		Name:        req.ServiceAccount.Name,
+		Description: req.ServiceAccount.Description,
+		Roles:       req.ServiceAccount.Roles,
+	}
+	if err := s.state.ServiceAccountCreate(sa); err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateServiceAccountResponse{ServiceAccount: sa}, nil
+}
+
+func (s *service) ListServiceAccounts(
+	ctx context.Context,
+	req *empty.Empty,
+) (*pb.ListServiceAccountsResponse, error) {
+	values, err := s.state.ServiceAccountList()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListServiceAccountsResponse{ServiceAccounts: values}, nil
+}
+
+func (s *service) DeleteServiceAccount(
+	ctx context.Context,
+	req *pb.DeleteServiceAccountRequest,
+) (*empty.Empty, error) {
+	if err := serverptypes.ValidateDeleteServiceAccountRequest(req); err != nil {
+		return nil, err
+	}
+
+	// Validate that the service account exists
+	if _, err := s.state.ServiceAccountGet(req.ServiceAccount); err != nil {
+		return nil, err
+	}
+
+	// Tokens reference the service account by ID so they're invalid as
+	// soon as it is deleted.
+	if err := s.state.ServiceAccountDelete(req.ServiceAccount); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *service) GenerateServiceAccountToken(
+	ctx context.Context,
+	req *pb.ServiceAccountTokenRequest,
+) (*pb.NewTokenResponse, error) {
+	if err := serverptypes.ValidateServiceAccountTokenRequest(req); err != nil {
+		return nil, err
+	}
+
+	sa, err := s.state.ServiceAccountGet(req.ServiceAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	// If we have a duration set, set the expiry
+	var dur time.Duration
+	if d := req.Duration; d != "" {
+		dur, err = time.ParseDuration(d)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	login := &pb.Token_Login{ServiceAccountId: sa.Id}
+
+	// Only keep the scope if it actually limits the token.
+	if req.Scope != nil && (req.Scope.Project != "" || req.Scope.Workspace != "") {
+		login.Scope = req.Scope
+	}
+
+	token, err := s.newToken(dur, DefaultKeyId, nil, &pb.Token{
+		Kind: &pb.Token_Login_{Login: login},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.NewTokenResponse{Token: token}, nil
+}
+
+// serviceAccountPrincipal returns the user that represents the service
+// account with the given ID in requests. The user has the ID and roles of
+// the service account, and its name with serviceAccountUsernamePrefix.
+func (s *service) serviceAccountPrincipal(id, endpoint string) (*pb.User, error) {
+	sa, err := s.state.ServiceAccountGetId(id)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.Unauthenticated,
+				"the service account of this token was deleted")
+		}
+
+		return nil, err
+	}
+
+	if _, ok := serviceAccountDeniedEndpoints[endpoint]; ok {
+		return nil, status.Errorf(codes.PermissionDenied,
+			"service accounts can't call %s", endpoint)
+	}
+
+	return &pb.User{
+		Id:       sa.Id,
+		Username: serviceAccountUsernamePrefix + sa.Name,
+		Roles:    sa.Roles,
+	}, nil
+}
@@ -0,0 +1,118 @@
+package singleprocess
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestServiceServiceAccount(t *testing.T) {
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	s := impl.(*service)
+	ctx := context.Background()
+
+	// "Log in" a default user
+	ctx = userWithContext(ctx, &pb.User{Id: DefaultUserId})
+
+	// Bootstrap
+	_, err = impl.BootstrapToken(ctx, &empty.Empty{})
+	require.NoError(err)
+
+	readonly := []string{"readonly"}
+	mutable := []string{"mutable"}
+
+	// Create
+	resp, err := s.CreateServiceAccount(ctx, &pb.CreateServiceAccountRequest{
+		ServiceAccount: serverptypes.TestServiceAccount(t, &pb.ServiceAccount{
+			Name:  "ci",
+			Roles: []string{"read-only"},
+		}),
+	})
+	require.NoError(err)
+	sa := resp.ServiceAccount
+	require.NotEmpty(sa.Id)
+
+	// Create with the same name
+	_, err = s.CreateServiceAccount(ctx, &pb.CreateServiceAccountRequest{
+		ServiceAccount: serverptypes.TestServiceAccount(t, &pb.ServiceAccount{
+			Name: "ci",
+		}),
+	})
+	require.Error(err)
+	require.Equal(codes.AlreadyExists, status.Code(err))
+
+	// List
+	{
+		resp, err := s.ListServiceAccounts(ctx, &empty.Empty{})
+		require.NoError(err)
+		require.Len(resp.ServiceAccounts, 1)
+	}
+
+	// Generate a token
+	tokenResp, err := s.GenerateServiceAccountToken(ctx, &pb.ServiceAccountTokenRequest{
+		ServiceAccount: &pb.Ref_ServiceAccount{Name: "ci"},
+	})
+	require.NoError(err)
+	token := tokenResp.Token
+
+	// The token authenticates as the service account with its roles
+	{
+		reqCtx, err := s.Authenticate(context.Background(), token, "ListBuilds", readonly)
+		require.NoError(err)
+
+		user := s.userFromContext(reqCtx)
+		require.NotNil(user)
+		require.Equal(sa.Id, user.Id)
+		require.Equal("service-account/ci", user.Username)
+
+		_, err = s.Authenticate(context.Background(), token, "QueueJob", mutable)
+		require.Error(err)
+		require.Equal(codes.PermissionDenied, status.Code(err))
+	}
+
+	// Service accounts can't create login tokens
+	{
+		_, err := s.Authenticate(context.Background(), token, "GenerateLoginToken", mutable)
+		require.Error(err)
+		require.Equal(codes.PermissionDenied, status.Code(err))
+	}
+
+	// Delete
+	{
+		_, err := s.DeleteServiceAccount(ctx, &pb.DeleteServiceAccountRequest{
+			ServiceAccount: &pb.Ref_ServiceAccount{Name: "ci"},
+		})
+		require.NoError(err)
+
+		_, err = s.DeleteServiceAccount(ctx, &pb.DeleteServiceAccountRequest{
+			ServiceAccount: &pb.Ref_ServiceAccount{Name: "ci"},
+		})
+		require.Error(err)
+		require.Equal(codes.NotFound, status.Code(err))
+	}
+
+	// The token no longer works, even if the name is reused
+	{
+		_, err := s.CreateServiceAccount(ctx, &pb.CreateServiceAccountRequest{
+			ServiceAccount: serverptypes.TestServiceAccount(t, &pb.ServiceAccount{
+				Name: "ci",
+			}),
+		})
+		require.NoError(err)
+
+		_, err = s.Authenticate(context.Background(), token, "ListBuilds", readonly)
+		require.Error(err)
+		require.Equal(codes.Unauthenticated, status.Code(err))
+	}
+}
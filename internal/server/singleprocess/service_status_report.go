@@ -2,12 +2,19 @@ package singleprocess
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-memdb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/hashicorp/waypoint/internal/server"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
 	"github.com/hashicorp/waypoint/internal/server/singleprocess/state"
 )
 
@@ -30,27 +37,103 @@ func (s *service) UpsertStatusReport(
 		result.Id = id
 	}
 
+	// Completed reports of deployments include the latest results of the
+	// health checks that the entrypoints run.
+	if result.Status != nil && result.Status.State == pb.Status_SUCCESS {
+		if err := s.statusReportHealthChecks(result); err != nil {
+			return nil, err
+		}
+	}
+
+	// Get the previous report before we store this one so that we can
+	// notify and record events about changes in health.
+	var previous *pb.StatusReport
+	if result.Status != nil && result.Status.State == pb.Status_SUCCESS {
+		var err error
+		previous, err = s.state.StatusReportLatest(result.Application, result.Workspace)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+	}
+
 	if err := s.state.StatusReportPut(!insert, result); err != nil {
 		return nil, err
 	}
 
+	if previous != nil && previous.Id != result.Id {
+		log := hclog.FromContext(ctx)
+		s.notifyHealthChange(log, previous, result)
+
+		from := previous.GetHealth().GetHealthStatus()
+		to := result.GetHealth().GetHealthStatus()
+		if from != to {
+			s.appEvent(log, result.Application, result.Workspace,
+				pb.AppEvent_HEALTH_CHANGED, result.Id, 0,
+				"health changed from %s to %s", from, to)
+		}
+	}
+
 	return &pb.UpsertStatusReportResponse{StatusReport: result}, nil
 }
 
+// notifyHealthChange sends a notification if the health of the report
+// changed from the previous report between READY and DOWN or PARTIAL.
+func (s *service) notifyHealthChange(log hclog.Logger, previous, report *pb.StatusReport) {
+	from := previous.GetHealth().GetHealthStatus()
+	to := report.GetHealth().GetHealthStatus()
+	down := func(v string) bool { return v == "DOWN" || v == "PARTIAL" }
+
+	switch {
+	case from == "READY" && down(to):
+		s.notify(log, report.Application, report.Workspace, pb.Notifier_HEALTH_DOWN,
+			"health changed from %s to %s: %s", from, to, report.Health.HealthMessage)
+
+	case down(from) && to == "READY":
+		s.notify(log, report.Application, report.Workspace, pb.Notifier_HEALTH_READY,
+			"health changed from %s to %s", from, to)
+	}
+}
+
 func (s *service) ListStatusReports(
 	ctx context.Context,
 	req *pb.ListStatusReportsRequest,
 ) (*pb.ListStatusReportsResponse, error) {
+	page := &state.Page{Size: int(req.PageSize), Token: req.PageToken}
 	result, err := s.state.StatusReportList(req.Application,
 		state.ListWithStatusFilter(req.Status...),
 		state.ListWithOrder(req.Order),
 		state.ListWithWorkspace(req.Workspace),
+		state.ListWithPage(page),
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.ListStatusReportsResponse{StatusReports: result}, nil
+	return &pb.ListStatusReportsResponse{
+		StatusReports: result,
+		NextPageToken: page.NextToken,
+	}, nil
+}
+
+func (s *service) WatchStatusReports(
+	req *pb.ListStatusReportsRequest,
+	srv pb.Waypoint_WatchStatusReportsServer,
+) error {
+	return watchList(srv.Context(), func(ws memdb.WatchSet) (proto.Message, error) {
+		result, err := s.state.StatusReportList(req.Application,
+			state.ListWithStatusFilter(req.Status...),
+			state.ListWithOrder(req.Order),
+			state.ListWithWorkspace(req.Workspace),
+			state.ListWithWatchSet(ws),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.ListStatusReportsResponse{StatusReports: result}, nil
+	}, func(resp proto.Message) error {
+		return srv.Send(resp.(*pb.ListStatusReportsResponse))
+	})
 }
 
 func (s *service) GetLatestStatusReport(
@@ -77,3 +160,247 @@ func (s *service) GetStatusReport(
 
 	return r, nil
 }
+
+// GetProjectStatusSummary returns the latest status of every application
+// in one or all projects.
+func (s *service) GetProjectStatusSummary(
+	ctx context.Context,
+	req *pb.GetProjectStatusSummaryRequest,
+) (*pb.GetProjectStatusSummaryResponse, error) {
+	refs := []*pb.Ref_Project{req.Project}
+	if req.Project == nil {
+		var err error
+		refs, err = s.state.ProjectList()
+		if err != nil {
+			return nil, err
+		}
+
+		sort.Slice(refs, func(i, j int) bool {
+			return refs[i].Project < refs[j].Project
+		})
+	}
+
+	var result []*pb.ProjectStatusSummary
+	for _, ref := range refs {
+		summary, err := s.projectStatusSummary(ref, req.Workspace, req.Labels)
+		if err != nil {
+			return nil, err
+		}
+
+		// With a label selector, projects without matching apps are
+		// left out.
+		if len(req.Labels) > 0 && len(summary.Applications) == 0 {
+			continue
+		}
+
+		result = append(result, summary)
+	}
+
+	return &pb.GetProjectStatusSummaryResponse{Projects: result}, nil
+}
+
+// projectStatusSummary builds the status summary for a single project.
+// If ws is nil, every workspace the project is in is included. Only apps
+// whose labels match the labels selector are included.
+func (s *service) projectStatusSummary(
+	ref *pb.Ref_Project,
+	ws *pb.Ref_Workspace,
+	labels map[string]string,
+) (*pb.ProjectStatusSummary, error) {
+	project, err := s.state.ProjectGet(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	// Determine the apps to summarize in each workspace. If a workspace
+	// was given or the project isn't in any workspace yet, we summarize
+	// every app in the project in the given (or default) workspace.
+	targets := map[string][]string{}
+	if ws == nil {
+		workspaces, err := s.state.ProjectListWorkspaces(ref)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, w := range workspaces {
+			for _, app := range w.Applications {
+				targets[w.Workspace.Workspace] = append(
+					targets[w.Workspace.Workspace], app.Application.Application)
+			}
+		}
+	}
+	if len(targets) == 0 {
+		name := "default"
+		if ws != nil {
+			name = ws.Workspace
+		}
+
+		for _, app := range project.Applications {
+			targets[name] = append(targets[name], app.Name)
+		}
+	}
+
+	var names []string
+	for name := range targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	p := &serverptypes.Project{Project: project}
+	result := &pb.ProjectStatusSummary{Project: ref}
+	for _, name := range names {
+		apps := targets[name]
+		sort.Strings(apps)
+
+		for _, app := range apps {
+			if !serverptypes.LabelsMatch(labels, p.AppLabels(app)) {
+				continue
+			}
+
+			summary, err := s.appStatusSummary(&pb.Ref_Application{
+				Project:     ref.Project,
+				Application: app,
+			}, &pb.Ref_Workspace{Workspace: name})
+			if err != nil {
+				return nil, err
+			}
+
+			result.Applications = append(result.Applications, summary)
+		}
+	}
+
+	return result, nil
+}
+
+// appStatusSummary gathers the latest values for a single app in a
+// single workspace. Values that don't exist are left unset.
+func (s *service) appStatusSummary(
+	ref *pb.Ref_Application,
+	ws *pb.Ref_Workspace,
+) (*pb.ProjectStatusSummary_Application, error) {
+	result := &pb.ProjectStatusSummary_Application{
+		Application: ref,
+		Workspace:   ws,
+	}
+
+	var err error
+	result.LatestStatusReport, err = s.state.StatusReportLatest(ref, ws)
+	if err != nil && status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+
+	result.LatestBuild, err = s.state.BuildLatest(ref, ws)
+	if err != nil && status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+
+	deployments, err := s.state.DeploymentList(ref,
+		state.ListWithWorkspace(ws),
+		state.ListWithPhysicalState(pb.Operation_CREATED),
+		state.ListWithOrder(&pb.OperationOrder{
+			Order: pb.OperationOrder_COMPLETE_TIME,
+			Desc:  true,
+			Limit: 1,
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(deployments) > 0 {
+		result.LatestDeployment = deployments[0]
+	}
+
+	result.LatestRelease, err = s.state.ReleaseLatest(ref, ws)
+	if err != nil && status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// healthCheckResourceType is the resource type of the health check results
+// added to status reports.
+const healthCheckResourceType = "health-check"
+
+// statusReportHealthChecks adds the latest health check results of the
+// instances of the deployment as resources to the report. Failing checks
+// lower the overall health of the report.
+func (s *service) statusReportHealthChecks(report *pb.StatusReport) error {
+	id := report.GetDeploymentId()
+	if id == "" {
+		return nil
+	}
+
+	instances, err := s.state.InstancesByDeployment(id, nil)
+	if err != nil {
+		return err
+	}
+
+	// Remove any results we added previously so that upserting a report
+	// again doesn't duplicate them.
+	resources := report.Resources[:0]
+	for _, r := range report.Resources {
+		if r.Type != healthCheckResourceType {
+			resources = append(resources, r)
+		}
+	}
+	report.Resources = resources
+
+	resourcesHealth := report.ResourcesHealth[:0]
+	for _, h := range report.ResourcesHealth {
+		if !strings.HasPrefix(h.Id, healthCheckResourceType+"/") {
+			resourcesHealth = append(resourcesHealth, h)
+		}
+	}
+	report.ResourcesHealth = resourcesHealth
+
+	// Sort so the resources are in a stable order.
+	sort.Slice(instances, func(i, j int) bool {
+		return instances[i].Id < instances[j].Id
+	})
+
+	var total, down int
+	for _, inst := range instances {
+		for _, result := range inst.HealthChecks {
+			total++
+			if result.HealthStatus != "READY" {
+				down++
+			}
+
+			health := &pb.StatusReport_Health{
+				HealthStatus:  result.HealthStatus,
+				HealthMessage: result.HealthMessage,
+				Name:          fmt.Sprintf("%s (%s)", result.Name, inst.Id),
+				Id:            fmt.Sprintf("%s/%s/%s", healthCheckResourceType, inst.Id, result.Name),
+			}
+
+			report.ResourcesHealth = append(report.ResourcesHealth, health)
+			report.Resources = append(report.Resources, &pb.StatusReport_Resource{
+				Id:            health.Id,
+				Name:          health.Name,
+				Platform:      "waypoint",
+				Type:          healthCheckResourceType,
+				Health:        health,
+				HealthMessage: result.HealthMessage,
+			})
+		}
+	}
+
+	if down == 0 {
+		return nil
+	}
+
+	if report.Health == nil {
+		report.Health = &pb.StatusReport_Health{}
+	}
+
+	if down == total {
+		report.Health.HealthStatus = "DOWN"
+	} else if report.Health.HealthStatus != "DOWN" {
+		report.Health.HealthStatus = "PARTIAL"
+	}
+	report.Health.HealthMessage = fmt.Sprintf(
+		"%d of %d health checks are failing", down, total)
+
+	return nil
+}
@@ -2,6 +2,7 @@ package singleprocess
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -138,4 +139,184 @@ func TestServiceStatusReport_ListStatusReports(t *testing.T) {
 		require.NotEmpty(sr)
 		require.Equal(sr.StatusReports[0].Id, resp.StatusReport.Id)
 	})
+
+	t.Run("paginate", func(t *testing.T) {
+		require := require.New(t)
+
+		// Create a few more reports so we have multiple pages
+		for i := 0; i < 2; i++ {
+			_, err := client.UpsertStatusReport(ctx, &pb.UpsertStatusReportRequest{
+				StatusReport: serverptypes.TestValidStatusReport(t, nil),
+			})
+			require.NoError(err)
+		}
+
+		var ids []string
+		var token string
+		for {
+			sr, err := client.ListStatusReports(ctx, &Req{
+				Application: resp.StatusReport.Application,
+				PageSize:    2,
+				PageToken:   token,
+			})
+			require.NoError(err)
+			require.True(len(sr.StatusReports) <= 2)
+			for _, r := range sr.StatusReports {
+				ids = append(ids, r.Id)
+			}
+
+			token = sr.NextPageToken
+			if token == "" {
+				break
+			}
+		}
+
+		require.Len(ids, 3)
+		require.Contains(ids, resp.StatusReport.Id)
+	})
+
+	t.Run("invalid page token", func(t *testing.T) {
+		require := require.New(t)
+
+		_, err := client.ListStatusReports(ctx, &Req{
+			Application: resp.StatusReport.Application,
+			PageToken:   "nope",
+		})
+		require.Error(err)
+		require.Equal(codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestServiceStatusReport_WatchStatusReports(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	report := serverptypes.TestValidStatusReport(t, nil)
+	stream, err := client.WatchStatusReports(ctx, &pb.ListStatusReportsRequest{
+		Application: report.Application,
+	})
+	require.NoError(err)
+
+	// The current, empty, list is sent first
+	resp, err := stream.Recv()
+	require.NoError(err)
+	require.Empty(resp.StatusReports)
+
+	// A new report sends the list again
+	_, err = client.UpsertStatusReport(ctx, &pb.UpsertStatusReportRequest{
+		StatusReport: report,
+	})
+	require.NoError(err)
+
+	resp, err = stream.Recv()
+	require.NoError(err)
+	require.Len(resp.StatusReports, 1)
+}
+
+func TestServiceStatusReport_GetProjectStatusSummary(t *testing.T) {
+	ctx := context.Background()
+
+	// Create our server
+	db := testDB(t)
+	impl, err := New(WithDB(db))
+	require.NoError(t, err)
+	client := server.TestServer(t, impl)
+
+	// Create a project with two apps
+	_, err = client.UpsertProject(ctx, &pb.UpsertProjectRequest{
+		Project: serverptypes.TestProject(t, &pb.Project{
+			Name:   "p_test",
+			Labels: map[string]string{"team": "payments"},
+		}),
+	})
+	require.NoError(t, err)
+	for _, name := range []string{"b_test", "a_test"} {
+		_, err = client.UpsertApplication(ctx, &pb.UpsertApplicationRequest{
+			Project: &pb.Ref_Project{Project: "p_test"},
+			Name:    name,
+			Labels:  map[string]string{"tier": strings.TrimSuffix(name, "_test")},
+		})
+		require.NoError(t, err)
+	}
+
+	// Create a completed status report for one of the apps
+	st := server.NewStatus(pb.Status_RUNNING)
+	server.StatusSetSuccess(st)
+	reportResp, err := client.UpsertStatusReport(ctx, &pb.UpsertStatusReportRequest{
+		StatusReport: serverptypes.TestValidStatusReport(t, &pb.StatusReport{
+			Status: st,
+			Health: &pb.StatusReport_Health{HealthStatus: "READY"},
+		}),
+	})
+	require.NoError(t, err)
+
+	type Req = pb.GetProjectStatusSummaryRequest
+
+	t.Run("single project in a workspace", func(t *testing.T) {
+		require := require.New(t)
+
+		resp, err := client.GetProjectStatusSummary(ctx, &Req{
+			Project:   &pb.Ref_Project{Project: "p_test"},
+			Workspace: &pb.Ref_Workspace{Workspace: "default"},
+		})
+		require.NoError(err)
+		require.Len(resp.Projects, 1)
+
+		apps := resp.Projects[0].Applications
+		require.Len(apps, 2)
+		require.Equal("a_test", apps[0].Application.Application)
+		require.Equal("default", apps[0].Workspace.Workspace)
+		require.NotNil(apps[0].LatestStatusReport)
+		require.Equal(reportResp.StatusReport.Id, apps[0].LatestStatusReport.Id)
+		require.Nil(apps[0].LatestDeployment)
+
+		require.Equal("b_test", apps[1].Application.Application)
+		require.Nil(apps[1].LatestStatusReport)
+	})
+
+	t.Run("all projects", func(t *testing.T) {
+		require := require.New(t)
+
+		resp, err := client.GetProjectStatusSummary(ctx, &Req{})
+		require.NoError(err)
+		require.Len(resp.Projects, 1)
+		require.Equal("p_test", resp.Projects[0].Project.Project)
+		require.NotEmpty(resp.Projects[0].Applications)
+	})
+
+	t.Run("label selector", func(t *testing.T) {
+		require := require.New(t)
+
+		// App labels
+		resp, err := client.GetProjectStatusSummary(ctx, &Req{
+			Labels: map[string]string{"team": "payments", "tier": "b"},
+		})
+		require.NoError(err)
+		require.Len(resp.Projects, 1)
+		require.Len(resp.Projects[0].Applications, 1)
+		require.Equal("b_test", resp.Projects[0].Applications[0].Application.Application)
+
+		// No matching apps leaves the project out
+		resp, err = client.GetProjectStatusSummary(ctx, &Req{
+			Labels: map[string]string{"team": "search"},
+		})
+		require.NoError(err)
+		require.Empty(resp.Projects)
+	})
+
+	t.Run("non-existent project", func(t *testing.T) {
+		require := require.New(t)
+
+		_, err := client.GetProjectStatusSummary(ctx, &Req{
+			Project: &pb.Ref_Project{Project: "nope"},
+		})
+		require.Error(err)
+		require.Equal(codes.NotFound, status.Code(err))
+	})
 }
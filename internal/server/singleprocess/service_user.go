@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
@@ -38,6 +40,10 @@ func (s *service) UpdateUser(
 		return nil, err
 	}
 
+	if err := requireAdminForOtherUser(s.userFromContext(ctx), req.User.Id); err != nil {
+		return nil, err
+	}
+
 	// Get the user so that we don't overwrite fields that shouldn't be.
 	user, err := s.state.UserGet(&pb.Ref_User{
 		Ref: &pb.Ref_User_Id{
@@ -77,6 +83,97 @@ func (s *service) DeleteUser(
 	return &empty.Empty{}, nil
 }
 
+func (s *service) GrantUserRole(
+	ctx context.Context,
+	req *pb.UserRoleRequest,
+) (*pb.UpdateUserResponse, error) {
+	if err := serverptypes.ValidateUserRoleRequest(req); err != nil {
+		return nil, err
+	}
+
+	user, err := s.state.UserGet(req.User)
+	if err != nil {
+		return nil, err
+	}
+
+	// Users with no roles are treated as admins, so granting them a role
+	// would reduce their access unless they're also made an admin.
+	if len(user.Roles) == 0 {
+		user.Roles = []string{serverptypes.RoleAdmin}
+	}
+
+	if !userHasRole(user, req.Role) {
+		user.Roles = append(user.Roles, req.Role)
+	}
+
+	if err := s.state.UserPut(user); err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateUserResponse{User: user}, nil
+}
+
+func (s *service) RevokeUserRole(
+	ctx context.Context,
+	req *pb.UserRoleRequest,
+) (*pb.UpdateUserResponse, error) {
+	if err := serverptypes.ValidateUserRoleRequest(req); err != nil {
+		return nil, err
+	}
+
+	user, err := s.state.UserGet(req.User)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make the implicit admin role of users with no roles explicit so
+	// that it can be revoked.
+	if len(user.Roles) == 0 {
+		user.Roles = []string{serverptypes.RoleAdmin}
+	}
+
+	var roles []string
+	for _, r := range user.Roles {
+		if r != req.Role {
+			roles = append(roles, r)
+		}
+	}
+	if len(roles) == len(user.Roles) {
+		return &pb.UpdateUserResponse{User: user}, nil
+	}
+	if len(roles) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"the last role of a user can't be revoked, grant another role first")
+	}
+
+	// Don't allow removing the last admin, since nobody would be able to
+	// manage users or grant the role again.
+	if req.Role == serverptypes.RoleAdmin {
+		users, err := s.state.UserList()
+		if err != nil {
+			return nil, err
+		}
+
+		var admins int
+		for _, u := range users {
+			if userHasRole(u, serverptypes.RoleAdmin) {
+				admins++
+			}
+		}
+		if admins <= 1 {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"the %q role can't be revoked from the last admin", serverptypes.RoleAdmin)
+		}
+	}
+
+	user.Roles = roles
+	if err := s.state.UserPut(user); err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateUserResponse{User: user}, nil
+}
+
 func (s *service) ListUsers(
 	ctx context.Context,
 	req *empty.Empty,
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/hashicorp/waypoint/internal/server"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
@@ -69,3 +71,68 @@ func TestServiceUpdateUser(t *testing.T) {
 		require.Equal("tubes", resp.User.Username)
 	})
 }
+
+func TestServiceUserRole(t *testing.T) {
+	ctx := context.Background()
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(t, err)
+	client := server.TestServer(t, impl)
+
+	// Get ourself
+	userResp, err := client.GetUser(ctx, &pb.GetUserRequest{})
+	require.NoError(t, err)
+	self := userResp.User
+	require.Equal(t, []string{"admin"}, self.Roles)
+
+	ref := &pb.Ref_User{
+		Ref: &pb.Ref_User_Id{Id: &pb.Ref_UserId{Id: self.Id}},
+	}
+
+	type Req = pb.UserRoleRequest
+
+	t.Run("invalid role", func(t *testing.T) {
+		_, err := client.GrantUserRole(ctx, &Req{User: ref, Role: "nope"})
+		require.Error(t, err)
+	})
+
+	t.Run("can't revoke the last admin", func(t *testing.T) {
+		require := require.New(t)
+
+		_, err := client.GrantUserRole(ctx, &Req{User: ref, Role: "operator"})
+		require.NoError(err)
+
+		_, err = client.RevokeUserRole(ctx, &Req{User: ref, Role: "admin"})
+		require.Error(err)
+		require.Equal(codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("grant and revoke", func(t *testing.T) {
+		require := require.New(t)
+
+		user := &pb.User{Username: "alice", Roles: []string{"read-only"}}
+		require.NoError(testServiceImpl(impl).state.UserPut(user))
+		ref := &pb.Ref_User{
+			Ref: &pb.Ref_User_Id{Id: &pb.Ref_UserId{Id: user.Id}},
+		}
+
+		resp, err := client.GrantUserRole(ctx, &Req{User: ref, Role: "operator"})
+		require.NoError(err)
+		require.Equal([]string{"read-only", "operator"}, resp.User.Roles)
+
+		// Granting again is a no-op
+		resp, err = client.GrantUserRole(ctx, &Req{User: ref, Role: "operator"})
+		require.NoError(err)
+		require.Equal([]string{"read-only", "operator"}, resp.User.Roles)
+
+		resp, err = client.RevokeUserRole(ctx, &Req{User: ref, Role: "read-only"})
+		require.NoError(err)
+		require.Equal([]string{"operator"}, resp.User.Roles)
+
+		// The last role can't be revoked
+		_, err = client.RevokeUserRole(ctx, &Req{User: ref, Role: "operator"})
+		require.Error(err)
+		require.Equal(codes.FailedPrecondition, status.Code(err))
+	})
+}
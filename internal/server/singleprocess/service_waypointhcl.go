@@ -2,6 +2,11 @@ package singleprocess
 
 import (
 	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/hcl/v2"
 
 	configpkg "github.com/hashicorp/waypoint/internal/config"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
@@ -18,3 +23,85 @@ func (s *service) WaypointHclFmt(
 
 	return &pb.WaypointHclFmtResponse{WaypointHcl: result}, nil
 }
+
+func (s *service) ValidateWaypointHcl(
+	ctx context.Context,
+	req *pb.ValidateWaypointHclRequest,
+) (*pb.ValidateWaypointHclResponse, error) {
+	// We don't have the files of the project, so we load the config in
+	// an empty directory. The format is determined by the file name.
+	td, err := ioutil.TempDir("", "waypoint-validate")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(td)
+
+	filename := configpkg.Filename
+	if req.Format == pb.Project_JSON {
+		filename += ".json"
+	}
+
+	cfg, err := configpkg.Load(filepath.Join(td, filename), &configpkg.LoadOptions{
+		Pwd:       td,
+		Workspace: req.Workspace.GetWorkspace(),
+		Source:    req.WaypointHcl,
+	})
+	if err != nil {
+		diags, ok := err.(hcl.Diagnostics)
+		if !ok {
+			diags = hcl.Diagnostics{{
+				Severity: hcl.DiagError,
+				Summary:  err.Error(),
+			}}
+		}
+
+		return &pb.ValidateWaypointHclResponse{
+			Diagnostics: hclDiagnosticsProto(diags),
+		}, nil
+	}
+
+	diags := cfg.ValidateFull(&configpkg.ValidateOptions{
+		Schemas: s.pluginSchemas,
+		NoFiles: true,
+	})
+
+	return &pb.ValidateWaypointHclResponse{
+		Diagnostics: hclDiagnosticsProto(diags),
+	}, nil
+}
+
+// hclDiagnosticsProto converts HCL diagnostics to their proto form. File
+// names are relative since the file was loaded from a temporary directory.
+func hclDiagnosticsProto(diags hcl.Diagnostics) []*pb.ValidateWaypointHclResponse_Diagnostic {
+	result := make([]*pb.ValidateWaypointHclResponse_Diagnostic, 0, len(diags))
+	for _, diag := range diags {
+		d := &pb.ValidateWaypointHclResponse_Diagnostic{
+			Severity: pb.ValidateWaypointHclResponse_ERROR,
+			Summary:  diag.Summary,
+			Detail:   diag.Detail,
+		}
+		if diag.Severity == hcl.DiagWarning {
+			d.Severity = pb.ValidateWaypointHclResponse_WARNING
+		}
+
+		if r := diag.Subject; r != nil {
+			d.Range = &pb.Variable_HclRange{
+				Filename: filepath.Base(r.Filename),
+				Start: &pb.Variable_HclPos{
+					Line:   int32(r.Start.Line),
+					Column: int32(r.Start.Column),
+					Byte:   int32(r.Start.Byte),
+				},
+				End: &pb.Variable_HclPos{
+					Line:   int32(r.End.Line),
+					Column: int32(r.End.Column),
+					Byte:   int32(r.End.Byte),
+				},
+			}
+		}
+
+		result = append(result, d)
+	}
+
+	return result
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	"github.com/hashicorp/waypoint-plugin-sdk/docs"
 	"github.com/hashicorp/waypoint/internal/server"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 )
@@ -40,3 +42,91 @@ project = "foo"
 		require.Equal(string(resp.WaypointHcl), output)
 	})
 }
+
+func TestServiceValidateWaypointHcl(t *testing.T) {
+	ctx := context.Background()
+
+	type testBuildConfig struct {
+		Image string `hcl:"image"`
+	}
+
+	// Create our server with a fake plugin so we don't launch plugins
+	impl, err := New(
+		WithDB(testDB(t)),
+		WithPluginSchemas(func(typ component.Type, name string) (*docs.Documentation, error) {
+			if typ != component.BuilderType || name != "test" {
+				return nil, nil
+			}
+
+			return docs.New(docs.FromConfig(&testBuildConfig{}))
+		}),
+	)
+	require.NoError(t, err)
+	client := server.TestServer(t, impl)
+
+	t.Run("valid", func(t *testing.T) {
+		require := require.New(t)
+
+		resp, err := client.ValidateWaypointHcl(ctx, &pb.ValidateWaypointHclRequest{
+			WaypointHcl: []byte(`
+project = "foo"
+
+app "web" {
+  build {
+    use "test" {
+      image = "foo"
+    }
+  }
+
+  deploy {
+    use "other" {}
+  }
+}
+`),
+		})
+		require.NoError(err)
+		require.Empty(resp.Diagnostics)
+	})
+
+	t.Run("unknown plugin field", func(t *testing.T) {
+		require := require.New(t)
+
+		resp, err := client.ValidateWaypointHcl(ctx, &pb.ValidateWaypointHclRequest{
+			WaypointHcl: []byte(`
+project = "foo"
+
+app "web" {
+  build {
+    use "test" {
+      imag = "foo"
+    }
+  }
+
+  deploy {
+    use "other" {}
+  }
+}
+`),
+		})
+		require.NoError(err)
+		require.Len(resp.Diagnostics, 1)
+
+		diag := resp.Diagnostics[0]
+		require.Equal(pb.ValidateWaypointHclResponse_ERROR, diag.Severity)
+		require.Equal("Unsupported argument", diag.Summary)
+		require.Equal("waypoint.hcl", diag.Range.Filename)
+		require.Equal(int32(7), diag.Range.Start.Line)
+	})
+
+	t.Run("syntax error", func(t *testing.T) {
+		require := require.New(t)
+
+		resp, err := client.ValidateWaypointHcl(ctx, &pb.ValidateWaypointHclRequest{
+			WaypointHcl: []byte("project = \n"),
+		})
+		require.NoError(err)
+		require.NotEmpty(resp.Diagnostics)
+		require.Equal(pb.ValidateWaypointHclResponse_ERROR, resp.Diagnostics[0].Severity)
+		require.NotNil(resp.Diagnostics[0].Range)
+	})
+}
@@ -2,7 +2,9 @@ package singleprocess
 
 import (
 	"context"
+	"strings"
 
+	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -59,3 +61,45 @@ func (s *service) ListWorkspaces(
 
 	return &pb.ListWorkspacesResponse{Workspaces: result}, nil
 }
+
+func (s *service) UpsertWorkspace(
+	ctx context.Context,
+	req *pb.UpsertWorkspaceRequest,
+) (*pb.UpsertWorkspaceResponse, error) {
+	if err := ptypes.ValidateUpsertWorkspaceRequest(req); err != nil {
+		return nil, err
+	}
+
+	if err := s.state.WorkspacePut(req.Workspace); err != nil {
+		return nil, err
+	}
+
+	result, err := s.state.WorkspaceGet(req.Workspace.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpsertWorkspaceResponse{Workspace: result}, nil
+}
+
+func (s *service) DeleteWorkspace(
+	ctx context.Context,
+	req *pb.DeleteWorkspaceRequest,
+) (*empty.Empty, error) {
+	if err := ptypes.ValidateDeleteWorkspaceRequest(req); err != nil {
+		return nil, err
+	}
+
+	// The default workspace is used whenever no workspace is specified
+	// so we never allow it to be deleted.
+	if strings.EqualFold(req.Workspace.Workspace, "default") {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"the default workspace can't be deleted")
+	}
+
+	if err := s.state.WorkspaceDelete(req.Workspace.Workspace); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,93 @@
+package singleprocess
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/internal/server"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestServiceWorkspace(t *testing.T) {
+	ctx := context.Background()
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(t, err)
+	client := server.TestServer(t, impl)
+
+	t.Run("upsert, list, and delete", func(t *testing.T) {
+		require := require.New(t)
+
+		// Create
+		resp, err := client.UpsertWorkspace(ctx, &pb.UpsertWorkspaceRequest{
+			Workspace: &pb.Workspace{
+				Name:        "staging",
+				Description: "pre-production",
+			},
+		})
+		require.NoError(err)
+		require.Equal("staging", resp.Workspace.Name)
+		require.Equal("pre-production", resp.Workspace.Description)
+
+		// List
+		listResp, err := client.ListWorkspaces(ctx, &pb.ListWorkspacesRequest{})
+		require.NoError(err)
+		require.Len(listResp.Workspaces, 1)
+		require.Equal("pre-production", listResp.Workspaces[0].Description)
+
+		// Delete
+		_, err = client.DeleteWorkspace(ctx, &pb.DeleteWorkspaceRequest{
+			Workspace: &pb.Ref_Workspace{Workspace: "staging"},
+		})
+		require.NoError(err)
+
+		_, err = client.GetWorkspace(ctx, &pb.GetWorkspaceRequest{
+			Workspace: &pb.Ref_Workspace{Workspace: "staging"},
+		})
+		require.Error(err)
+		require.Equal(codes.NotFound, status.Code(err))
+	})
+
+	t.Run("upsert without a name", func(t *testing.T) {
+		require := require.New(t)
+
+		_, err := client.UpsertWorkspace(ctx, &pb.UpsertWorkspaceRequest{
+			Workspace: &pb.Workspace{Description: "nope"},
+		})
+		require.Error(err)
+		require.Equal(codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("delete in use", func(t *testing.T) {
+		require := require.New(t)
+
+		_, err := client.UpsertBuild(ctx, &pb.UpsertBuildRequest{
+			Build: serverptypes.TestValidBuild(t, &pb.Build{
+				Workspace: &pb.Ref_Workspace{Workspace: "prod"},
+			}),
+		})
+		require.NoError(err)
+
+		_, err = client.DeleteWorkspace(ctx, &pb.DeleteWorkspaceRequest{
+			Workspace: &pb.Ref_Workspace{Workspace: "prod"},
+		})
+		require.Error(err)
+		require.Equal(codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("delete default", func(t *testing.T) {
+		require := require.New(t)
+
+		_, err := client.DeleteWorkspace(ctx, &pb.DeleteWorkspaceRequest{
+			Workspace: &pb.Ref_Workspace{Workspace: "default"},
+		})
+		require.Error(err)
+		require.Equal(codes.FailedPrecondition, status.Code(err))
+	})
+}
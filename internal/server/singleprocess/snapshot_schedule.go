@@ -0,0 +1,362 @@
+package singleprocess
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/hashicorp/go-hclog"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/server/singleprocess/state"
+	"github.com/hashicorp/waypoint/internal/serverconfig"
+)
+
+const (
+	// snapshotNamePrefix and snapshotNameSuffix surround the time in the
+	// names of automatic snapshots. Only files with these are pruned so
+	// that other files in the destination are never deleted.
+	snapshotNamePrefix = "waypoint-snapshot-"
+	snapshotNameSuffix = ".snap"
+
+	// snapshotTimeFormat is the format of the time in snapshot names. It
+	// sorts in time order.
+	snapshotTimeFormat = "20060102T150405Z"
+)
+
+func (s *service) GetSnapshotStatus(
+	ctx context.Context,
+	req *empty.Empty,
+) (*pb.GetSnapshotStatusResponse, error) {
+	if s.snapshotter == nil {
+		return &pb.GetSnapshotStatusResponse{}, nil
+	}
+
+	return s.snapshotter.Status(), nil
+}
+
+// snapshotter writes snapshots of the state on an interval and deletes
+// the oldest snapshots beyond the retain count.
+type snapshotter struct {
+	target   snapshotTarget
+	interval time.Duration
+	retain   int
+	log      hclog.Logger
+
+	// now returns the current time. This is only changed for tests.
+	now func() time.Time
+
+	mu     sync.Mutex
+	status *pb.GetSnapshotStatusResponse
+}
+
+func newSnapshotter(cfg *serverconfig.Snapshot, log hclog.Logger) (*snapshotter, error) {
+	var target snapshotTarget
+	var err error
+	switch {
+	case cfg.Path != "" && cfg.S3Bucket != "":
+		return nil, fmt.Errorf(
+			"only one of the snapshot path and S3 bucket may be set")
+
+	case cfg.Path != "":
+		target, err = newSnapshotDirTarget(cfg.Path)
+
+	case cfg.S3Bucket != "":
+		target, err = newSnapshotS3Target(cfg)
+
+	default:
+		return nil, fmt.Errorf(
+			"automatic snapshots require a snapshot path or S3 bucket")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &snapshotter{
+		target:   target,
+		interval: cfg.Interval,
+		retain:   cfg.Retain,
+		log:      log,
+		now:      time.Now,
+		status: &pb.GetSnapshotStatusResponse{
+			Enabled:     true,
+			Destination: target.String(),
+			Interval:    cfg.Interval.String(),
+			Retain:      int32(cfg.Retain),
+		},
+	}, nil
+}
+
+// run writes a snapshot every interval until the context is cancelled.
+func (s *snapshotter) run(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	st state.Interface,
+) {
+	defer wg.Done()
+
+	s.log.Info("starting", "destination", s.target.String(), "interval", s.interval)
+	defer s.log.Info("exiting")
+
+	tk := time.NewTicker(s.interval)
+	defer tk.Stop()
+
+	for {
+		s.setNext(s.now().Add(s.interval))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-tk.C:
+			if err := s.snapshot(ctx, st); err != nil {
+				s.log.Error("error writing snapshot", "error", err)
+			}
+		}
+	}
+}
+
+// snapshot writes a single snapshot and then prunes old snapshots.
+func (s *snapshotter) snapshot(ctx context.Context, st state.Interface) error {
+	name := snapshotNamePrefix + s.now().UTC().Format(snapshotTimeFormat) + snapshotNameSuffix
+
+	err := s.target.Write(ctx, name, st.CreateSnapshot)
+	if err == nil {
+		s.log.Info("wrote snapshot", "name", name)
+		err = s.prune(ctx)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err != nil {
+		s.status.LastError = err.Error()
+		return err
+	}
+
+	s.status.LastError = ""
+	s.status.LastSnapshot = name
+	s.status.LastSuccessTime, _ = ptypes.TimestampProto(s.now())
+	return nil
+}
+
+// prune deletes the oldest snapshots beyond the retain count. A retain
+// count of zero keeps every snapshot.
+func (s *snapshotter) prune(ctx context.Context) error {
+	if s.retain <= 0 {
+		return nil
+	}
+
+	names, err := s.target.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	var snapshots []string
+	for _, name := range names {
+		if strings.HasPrefix(name, snapshotNamePrefix) && strings.HasSuffix(name, snapshotNameSuffix) {
+			snapshots = append(snapshots, name)
+		}
+	}
+	if len(snapshots) <= s.retain {
+		return nil
+	}
+
+	sort.Strings(snapshots)
+	for _, name := range snapshots[:len(snapshots)-s.retain] {
+		if err := s.target.Delete(ctx, name); err != nil {
+			return err
+		}
+
+		s.log.Debug("deleted old snapshot", "name", name)
+	}
+
+	return nil
+}
+
+func (s *snapshotter) setNext(t time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status.NextTime, _ = ptypes.TimestampProto(t)
+}
+
+// Status returns a copy of the current status.
+func (s *snapshotter) Status() *pb.GetSnapshotStatusResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return proto.Clone(s.status).(*pb.GetSnapshotStatusResponse)
+}
+
+// snapshotTarget is a destination that snapshots are written to.
+type snapshotTarget interface {
+	// Write writes a snapshot with the given name. The data is written by
+	// calling fn. The snapshot must not be visible to List if this fails.
+	Write(ctx context.Context, name string, fn func(io.Writer) error) error
+
+	// List returns the names of all files in the destination.
+	List(ctx context.Context) ([]string, error)
+
+	// Delete deletes the snapshot with the given name.
+	Delete(ctx context.Context, name string) error
+
+	// String returns the destination for humans.
+	String() string
+}
+
+// snapshotDirTarget writes snapshots to a local directory.
+type snapshotDirTarget struct {
+	dir string
+}
+
+func newSnapshotDirTarget(dir string) (*snapshotDirTarget, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	return &snapshotDirTarget{dir: dir}, nil
+}
+
+func (t *snapshotDirTarget) Write(ctx context.Context, name string, fn func(io.Writer) error) error {
+	// Write to a temporary file first and rename it once it is complete
+	// so that a partial snapshot never looks like a valid one.
+	f, err := ioutil.TempFile(t.dir, name+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if err := fn(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), filepath.Join(t.dir, name))
+}
+
+func (t *snapshotDirTarget) List(ctx context.Context) ([]string, error) {
+	entries, err := ioutil.ReadDir(t.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+	for _, e := range entries {
+		if e.Mode().IsRegular() {
+			result = append(result, e.Name())
+		}
+	}
+
+	return result, nil
+}
+
+func (t *snapshotDirTarget) Delete(ctx context.Context, name string) error {
+	return os.Remove(filepath.Join(t.dir, name))
+}
+
+func (t *snapshotDirTarget) String() string {
+	return t.dir
+}
+
+// snapshotS3Target writes snapshots to an S3 bucket.
+type snapshotS3Target struct {
+	bucket string
+	prefix string
+	sess   *session.Session
+}
+
+func newSnapshotS3Target(cfg *serverconfig.Snapshot) (*snapshotS3Target, error) {
+	sess, err := newAWSSession(cfg.S3Region)
+	if err != nil {
+		return nil, err
+	}
+
+	return &snapshotS3Target{
+		bucket: cfg.S3Bucket,
+		prefix: cfg.S3Prefix,
+		sess:   sess,
+	}, nil
+}
+
+func (t *snapshotS3Target) Write(ctx context.Context, name string, fn func(io.Writer) error) error {
+	// Stream the snapshot to the uploader so that it isn't buffered in
+	// memory. S3 only makes the object visible once the upload completes.
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(fn(pw))
+	}()
+
+	_, err := s3manager.NewUploader(t.sess).UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(t.bucket),
+		Key:    aws.String(path.Join(t.prefix, name)),
+		Body:   pr,
+	})
+
+	// Unblock the writer if the upload failed before reading everything.
+	pr.CloseWithError(err)
+	return err
+}
+
+func (t *snapshotS3Target) List(ctx context.Context) ([]string, error) {
+	prefix := path.Join(t.prefix, snapshotNamePrefix)
+
+	var result []string
+	err := s3.New(t.sess).ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(t.bucket),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, last bool) bool {
+		for _, obj := range page.Contents {
+			result = append(result, path.Base(aws.StringValue(obj.Key)))
+		}
+
+		return true
+	})
+
+	return result, err
+}
+
+func (t *snapshotS3Target) Delete(ctx context.Context, name string) error {
+	_, err := s3.New(t.sess).DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(t.bucket),
+		Key:    aws.String(path.Join(t.prefix, name)),
+	})
+
+	return err
+}
+
+func (t *snapshotS3Target) String() string {
+	return "s3://" + path.Join(t.bucket, t.prefix)
+}
+
+// newAWSSession returns an AWS session with the credentials from the
+// environment. If region is empty, the region is also from the environment.
+func newAWSSession(region string) (*session.Session, error) {
+	config := aws.NewConfig()
+	if region != "" {
+		config = config.WithRegion(region)
+	}
+
+	return session.NewSessionWithOptions(session.Options{
+		Config:            *config,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+}
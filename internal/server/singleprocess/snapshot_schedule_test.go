@@ -0,0 +1,123 @@
+package singleprocess
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/serverconfig"
+)
+
+func TestSnapshotter(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	s := impl.(*service)
+
+	dir, err := ioutil.TempDir("", "waypoint-test")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+
+	// A file that isn't a snapshot is never pruned
+	other := filepath.Join(dir, "other")
+	require.NoError(ioutil.WriteFile(other, []byte("hello"), 0600))
+
+	sn, err := newSnapshotter(&serverconfig.Snapshot{
+		Interval: time.Hour,
+		Retain:   2,
+		Path:     dir,
+	}, hclog.L())
+	require.NoError(err)
+
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	sn.now = func() time.Time { return now }
+
+	// Write three snapshots
+	for i := 0; i < 3; i++ {
+		require.NoError(sn.snapshot(ctx, s.state))
+		now = now.Add(time.Hour)
+	}
+
+	// Only the newest two are kept
+	names, err := sn.target.List(ctx)
+	require.NoError(err)
+	require.ElementsMatch([]string{
+		"other",
+		"waypoint-snapshot-20210101T010000Z.snap",
+		"waypoint-snapshot-20210101T020000Z.snap",
+	}, names)
+
+	// The snapshots have data
+	fi, err := os.Stat(filepath.Join(dir, "waypoint-snapshot-20210101T020000Z.snap"))
+	require.NoError(err)
+	require.NotZero(fi.Size())
+
+	// Status
+	status := sn.Status()
+	require.True(status.Enabled)
+	require.Equal(dir, status.Destination)
+	require.Equal("waypoint-snapshot-20210101T020000Z.snap", status.LastSnapshot)
+	require.Empty(status.LastError)
+	require.NotNil(status.LastSuccessTime)
+}
+
+func TestSnapshotter_config(t *testing.T) {
+	_, err := newSnapshotter(&serverconfig.Snapshot{
+		Interval: time.Hour,
+	}, hclog.L())
+	require.Error(t, err)
+
+	_, err = newSnapshotter(&serverconfig.Snapshot{
+		Interval: time.Hour,
+		Path:     "/tmp",
+		S3Bucket: "bucket",
+	}, hclog.L())
+	require.Error(t, err)
+}
+
+func TestServiceGetSnapshotStatus(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	// Not configured
+	{
+		impl, err := New(WithDB(testDB(t)))
+		require.NoError(err)
+
+		resp, err := impl.GetSnapshotStatus(ctx, &empty.Empty{})
+		require.NoError(err)
+		require.False(resp.Enabled)
+	}
+
+	// Configured
+	{
+		dir, err := ioutil.TempDir("", "waypoint-test")
+		require.NoError(err)
+		defer os.RemoveAll(dir)
+
+		impl, err := New(WithDB(testDB(t)), WithConfig(&serverconfig.Config{
+			Snapshot: &serverconfig.Snapshot{
+				Interval: time.Hour,
+				Retain:   5,
+				Path:     dir,
+			},
+		}))
+		require.NoError(err)
+		defer testServiceImpl(impl).Close()
+
+		resp, err := impl.GetSnapshotStatus(ctx, &empty.Empty{})
+		require.NoError(err)
+		require.True(resp.Enabled)
+		require.Equal("1h0m0s", resp.Interval)
+		require.Equal(int32(5), resp.Retain)
+	}
+}
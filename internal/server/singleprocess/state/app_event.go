@@ -0,0 +1,158 @@
+package state
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+var appEventBucket = []byte("app_events")
+
+// appEventMax is the maximum number of events that are kept for each
+// application. When more events are recorded, the oldest events of the
+// application are deleted.
+var appEventMax uint64 = 1000
+
+func init() {
+	dbBuckets = append(dbBuckets, appEventBucket)
+}
+
+// AppEventPut records an event of an application. The ID of the event is
+// set to a new ULID. Each application has its own bucket of events keyed
+// by a sequence number so that the events of one application can be
+// listed in order without reading the events of other applications.
+func (s *State) AppEventPut(e *pb.AppEvent) error {
+	if e.Application == nil {
+		return fmt.Errorf("application is required")
+	}
+
+	id, err := ulid()
+	if err != nil {
+		return err
+	}
+	e.Id = id
+
+	return s.db.Update(func(dbTxn dbTx) error {
+		b, err := dbTxn.Bucket(appEventBucket).CreateBucketIfNotExists(appEventId(e.Application))
+		if err != nil {
+			return err
+		}
+
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		if err := dbPut(b, appEventKey(seq), e); err != nil {
+			return err
+		}
+
+		// Sequence numbers are never reused, so every event with a
+		// sequence at or below this is over our maximum.
+		if seq <= appEventMax {
+			return nil
+		}
+		oldest := appEventKey(seq - appEventMax)
+
+		c := b.Cursor()
+		for k, _ := c.First(); k != nil && bytes.Compare(k, oldest) <= 0; k, _ = c.First() {
+			if err := c.Delete(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// AppEventList returns the events of an application newest first. If ws
+// is set, only events of that workspace are returned. If types is
+// non-empty, only events of those types are returned.
+func (s *State) AppEventList(
+	ref *pb.Ref_Application,
+	ws *pb.Ref_Workspace,
+	types []pb.AppEvent_Type,
+	p *Page,
+) ([]*pb.AppEvent, error) {
+	after, err := p.after()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*pb.AppEvent
+	err = s.db.View(func(dbTxn dbTx) error {
+		b := dbTxn.Bucket(appEventBucket).Bucket(appEventId(ref))
+		if b == nil {
+			return nil
+		}
+
+		c := b.Cursor()
+		k, v := c.Last()
+		if after != "" {
+			// Seek returns the first key at or after the token, so we
+			// step back until we're before it.
+			k, v = c.Seek([]byte(after))
+			if k == nil {
+				k, v = c.Last()
+			}
+			for k != nil && bytes.Compare(k, []byte(after)) >= 0 {
+				k, v = c.Prev()
+			}
+		}
+
+		for ; k != nil; k, v = c.Prev() {
+			var e pb.AppEvent
+			if err := proto.Unmarshal(v, &e); err != nil {
+				return err
+			}
+
+			if ws != nil && ws.Workspace != e.Workspace.GetWorkspace() {
+				continue
+			}
+			if !appEventTypeMatch(types, e.Type) {
+				continue
+			}
+
+			result = append(result, &e)
+			if p.full(len(result)) {
+				p.setNext(string(k))
+				return nil
+			}
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// appEventTypeMatch returns true if t is one of types or types is empty.
+func appEventTypeMatch(types []pb.AppEvent_Type, t pb.AppEvent_Type) bool {
+	if len(types) == 0 {
+		return true
+	}
+
+	for _, v := range types {
+		if v == t {
+			return true
+		}
+	}
+
+	return false
+}
+
+// appEventId returns the name of the bucket of events of an application.
+func appEventId(ref *pb.Ref_Application) []byte {
+	return []byte(strings.ToLower(fmt.Sprintf("%s/%s", ref.Project, ref.Application)))
+}
+
+// appEventKey returns the key of the event with the given sequence.
+func appEventKey(seq uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+	return key
+}
@@ -0,0 +1,125 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestAppEvent(t *testing.T) {
+	app := &pb.Ref_Application{Project: "p", Application: "a"}
+
+	t.Run("Put and List", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		for _, typ := range []pb.AppEvent_Type{
+			pb.AppEvent_BUILD_STARTED,
+			pb.AppEvent_DEPLOYMENT_CREATED,
+			pb.AppEvent_RELEASE_SWITCHED,
+		} {
+			require.NoError(s.AppEventPut(&pb.AppEvent{
+				Application: app,
+				Workspace:   &pb.Ref_Workspace{Workspace: "default"},
+				Type:        typ,
+			}))
+		}
+
+		// Another app and workspace
+		require.NoError(s.AppEventPut(&pb.AppEvent{
+			Application: &pb.Ref_Application{Project: "p", Application: "b"},
+			Type:        pb.AppEvent_BUILD_STARTED,
+		}))
+		require.NoError(s.AppEventPut(&pb.AppEvent{
+			Application: app,
+			Workspace:   &pb.Ref_Workspace{Workspace: "prod"},
+			Type:        pb.AppEvent_BUILD_STARTED,
+		}))
+
+		// Newest first
+		result, err := s.AppEventList(app, nil, nil, nil)
+		require.NoError(err)
+		require.Len(result, 4)
+		require.Equal("prod", result[0].Workspace.Workspace)
+		require.Equal(pb.AppEvent_RELEASE_SWITCHED, result[1].Type)
+		require.NotEmpty(result[0].Id)
+
+		// Workspace
+		result, err = s.AppEventList(app, &pb.Ref_Workspace{Workspace: "default"}, nil, nil)
+		require.NoError(err)
+		require.Len(result, 3)
+
+		// Types
+		result, err = s.AppEventList(app, nil, []pb.AppEvent_Type{pb.AppEvent_BUILD_STARTED}, nil)
+		require.NoError(err)
+		require.Len(result, 2)
+
+		// Unknown app
+		result, err = s.AppEventList(&pb.Ref_Application{Project: "p", Application: "c"}, nil, nil, nil)
+		require.NoError(err)
+		require.Empty(result)
+	})
+
+	t.Run("pages", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		for i := 0; i < 5; i++ {
+			require.NoError(s.AppEventPut(&pb.AppEvent{
+				Application:       app,
+				OperationSequence: uint64(i),
+			}))
+		}
+
+		var seqs []uint64
+		page := &Page{Size: 2}
+		for {
+			result, err := s.AppEventList(app, nil, nil, page)
+			require.NoError(err)
+			for _, e := range result {
+				seqs = append(seqs, e.OperationSequence)
+			}
+
+			if page.NextToken == "" {
+				break
+			}
+			page = &Page{Size: 2, Token: page.NextToken}
+		}
+
+		require.Equal([]uint64{4, 3, 2, 1, 0}, seqs)
+
+		// Invalid token
+		_, err := s.AppEventList(app, nil, nil, &Page{Token: "!"})
+		require.Error(err)
+	})
+
+	t.Run("prunes the oldest events", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		old := appEventMax
+		defer func() { appEventMax = old }()
+		appEventMax = 2
+
+		for i := 0; i < 3; i++ {
+			require.NoError(s.AppEventPut(&pb.AppEvent{
+				Application:       app,
+				OperationSequence: uint64(i),
+			}))
+		}
+
+		result, err := s.AppEventList(app, nil, nil, nil)
+		require.NoError(err)
+		require.Len(result, 2)
+		require.Equal(uint64(2), result[0].OperationSequence)
+		require.Equal(uint64(1), result[1].OperationSequence)
+	})
+}
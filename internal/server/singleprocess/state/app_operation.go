@@ -13,11 +13,11 @@ import (
 	"github.com/hashicorp/go-memdb"
 	"github.com/mitchellh/go-testing-interface"
 	"github.com/stretchr/testify/require"
-	bolt "go.etcd.io/bbolt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
 )
 
 // appOperation is an abstraction on any "operation" that may happen to
@@ -112,7 +112,7 @@ func (op *appOperation) Put(s *State, update bool, value proto.Message) error {
 	memTxn := s.inmem.Txn(true)
 	defer memTxn.Abort()
 
-	err := s.db.Update(func(dbTxn *bolt.Tx) error {
+	err := s.db.Update(func(dbTxn dbTx) error {
 		return op.dbPut(s, dbTxn, memTxn, update, value)
 	})
 	if err == nil {
@@ -128,7 +128,7 @@ func (op *appOperation) Get(s *State, ref *pb.Ref_Operation) (interface{}, error
 	defer memTxn.Abort()
 
 	result := op.newStruct()
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx dbTx) error {
 		var id string
 		switch t := ref.Target.(type) {
 		case *pb.Ref_Operation_Id:
@@ -174,7 +174,7 @@ func (op *appOperation) Get(s *State, ref *pb.Ref_Operation) (interface{}, error
 
 func (op *appOperation) getIdForSeq(
 	s *State,
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	ref *pb.Ref_OperationSeq,
 ) (string, error) {
@@ -230,9 +230,20 @@ func (op *appOperation) List(s *State, opts *listOperationsOptions) ([]interface
 		return nil, err
 	}
 
+	// If we're paging, skip everything up to and including the last
+	// result of the previous page.
+	after, err := opts.Page.after()
+	if err != nil {
+		return nil, err
+	}
+	if opts.Page != nil {
+		opts.Page.NextToken = ""
+	}
+
 	var result []interface{}
+	var lastId string
 
-	s.db.View(func(tx *bolt.Tx) error {
+	err = s.db.View(func(tx dbTx) error {
 		for {
 			current := iter.Next()
 			if current == nil {
@@ -244,6 +255,14 @@ func (op *appOperation) List(s *State, opts *listOperationsOptions) ([]interface
 				return nil
 			}
 
+			if after != "" {
+				if record.Id == after {
+					after = ""
+				}
+
+				continue
+			}
+
 			// If our workspace doesn't match then continue to the next result.
 			if opts.Workspace != nil && record.Workspace != opts.Workspace.Workspace {
 				continue
@@ -273,7 +292,22 @@ func (op *appOperation) List(s *State, opts *listOperationsOptions) ([]interface
 				}
 			}
 
+			if opts.Filter != nil {
+				labels, _ := op.valueField(value, "Labels").(map[string]string)
+				status, _ := op.valueField(value, "Status").(*pb.Status)
+				if !operationFilterMatch(opts.Filter, labels, status) {
+					continue
+				}
+			}
+
+			// If the page is full, this result starts the next page.
+			if opts.Page.full(len(result)) {
+				opts.Page.setNext(lastId)
+				return nil
+			}
+
 			result = append(result, value)
+			lastId = record.Id
 
 			// If we have a limit, check that now
 			if o := opts.Order; o != nil && o.Limit > 0 && len(result) >= int(o.Limit) {
@@ -281,6 +315,16 @@ func (op *appOperation) List(s *State, opts *listOperationsOptions) ([]interface
 			}
 		}
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	// If we never found the last result of the previous page, then it
+	// was deleted and we can't tell where the next page starts.
+	if after != "" {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"page token is no longer valid, list from the first page again")
+	}
 
 	// We can derive a watchch by doing a get on the "first" prefix, discarding the result, and
 	// just use the watchch.
@@ -363,7 +407,7 @@ func (op *appOperation) Latest(
 
 // dbGet reads the value from the database.
 func (op *appOperation) dbGet(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	id []byte,
 	result proto.Message,
 ) error {
@@ -384,7 +428,7 @@ func (op *appOperation) dbGet(
 // It expects to hold a write transaction to both bolt and memdb.
 func (op *appOperation) dbPut(
 	s *State,
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	update bool,
 	value proto.Message,
@@ -543,7 +587,7 @@ func (op *appOperation) appSeq(ref *pb.Ref_Application) *uint64 {
 
 // indexInit initializes the index table in memdb from all the records
 // persisted on disk.
-func (op *appOperation) indexInit(s *State, dbTxn *bolt.Tx, memTxn *memdb.Txn) error {
+func (op *appOperation) indexInit(s *State, dbTxn dbTx, memTxn *memdb.Txn) error {
 	bucket := dbTxn.Bucket(op.Bucket)
 	c := bucket.Cursor()
 
@@ -858,6 +902,8 @@ type listOperationsOptions struct {
 	Order         *pb.OperationOrder
 	PhysicalState pb.Operation_PhysicalState
 	WatchSet      memdb.WatchSet
+	Page          *Page
+	Filter        *pb.OperationFilter
 }
 
 func buildListOperationsOptions(ref *pb.Ref_Application, opts ...ListOperationOption) *listOperationsOptions {
@@ -901,6 +947,21 @@ func ListWithWorkspace(f *pb.Ref_Workspace) ListOperationOption {
 	}
 }
 
+// ListWithPage returns a single page of results. The token for the next
+// page is set on p.
+func ListWithPage(p *Page) ListOperationOption {
+	return func(opts *listOperationsOptions) {
+		opts.Page = p
+	}
+}
+
+// ListWithFilter filters the results by labels and start time.
+func ListWithFilter(f *pb.OperationFilter) ListOperationOption {
+	return func(opts *listOperationsOptions) {
+		opts.Filter = f
+	}
+}
+
 // statusFilterMatch is a helper that compares a pb.Status to a set of
 // StatusFilters. This returns true if the filters match.
 func statusFilterMatch(
@@ -940,6 +1001,44 @@ func statusFilterMatchSingle(
 	}
 }
 
+// operationFilterMatch returns true if an operation with the given labels
+// and status matches all the fields set on the filter.
+func operationFilterMatch(
+	filter *pb.OperationFilter,
+	labels map[string]string,
+	status *pb.Status,
+) bool {
+	if !serverptypes.LabelsMatch(filter.Labels, labels) {
+		return false
+	}
+
+	if filter.StartedAfter == nil && filter.StartedBefore == nil {
+		return true
+	}
+
+	// Operations that haven't started never match a time range.
+	start, err := ptypes.Timestamp(status.GetStartTime())
+	if err != nil {
+		return false
+	}
+
+	if filter.StartedAfter != nil {
+		after, err := ptypes.Timestamp(filter.StartedAfter)
+		if err == nil && start.Before(after) {
+			return false
+		}
+	}
+
+	if filter.StartedBefore != nil {
+		before, err := ptypes.Timestamp(filter.StartedBefore)
+		if err == nil && !start.Before(before) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // ListWithWatchSet registers watches for the listing, allowing the watcher
 // to detect if new items are added.
 func ListWithWatchSet(ws memdb.WatchSet) ListOperationOption {
@@ -9,6 +9,8 @@ import (
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/hashicorp/go-memdb"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
@@ -387,6 +389,159 @@ func TestAppOperation(t *testing.T) {
 		require.Len(results, 1)
 	})
 
+	t.Run("list with paging", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		// Create builds "A" to "E" where "A" is the newest.
+		ids := []string{"A", "B", "C", "D", "E"}
+		for i, id := range ids {
+			pt, err := ptypes.TimestampProto(time.Now().Add(-time.Duration(i) * time.Hour))
+			require.NoError(err)
+
+			require.NoError(op.Put(s, false, serverptypes.TestValidBuild(t, &pb.Build{
+				Id: id,
+				Status: &pb.Status{
+					State:     pb.Status_SUCCESS,
+					StartTime: pt,
+				},
+			})))
+		}
+
+		build := serverptypes.TestValidBuild(t, nil)
+		page := &Page{Size: 2}
+		var pages [][]string
+		for {
+			results, err := op.List(s, &listOperationsOptions{
+				Application: build.Application,
+				Page:        page,
+			})
+			require.NoError(err)
+
+			var pageIds []string
+			for _, result := range results {
+				pageIds = append(pageIds, result.(*pb.Build).Id)
+			}
+			pages = append(pages, pageIds)
+
+			if page.NextToken == "" {
+				break
+			}
+			page.Token = page.NextToken
+		}
+
+		require.Equal([][]string{{"A", "B"}, {"C", "D"}, {"E"}}, pages)
+
+		// A token for a record that no longer exists is invalid
+		page = &Page{Size: 2}
+		_, err := op.List(s, &listOperationsOptions{
+			Application: build.Application,
+			Page:        page,
+		})
+		require.NoError(err)
+		require.NotEmpty(page.NextToken)
+
+		memTxn := s.inmem.Txn(true)
+		raw, err := memTxn.First(op.memTableName(), opIdIndexName, "B")
+		require.NoError(err)
+		require.NoError(memTxn.Delete(op.memTableName(), raw))
+		memTxn.Commit()
+
+		page.Token = page.NextToken
+		_, err = op.List(s, &listOperationsOptions{
+			Application: build.Application,
+			Page:        page,
+		})
+		require.Error(err)
+		require.Equal(codes.InvalidArgument, status.Code(err))
+
+		// A malformed token is invalid
+		_, err = op.List(s, &listOperationsOptions{
+			Application: build.Application,
+			Page:        &Page{Token: "!"},
+		})
+		require.Error(err)
+		require.Equal(codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("list with operation filter", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		now := time.Now()
+		for i, v := range []struct {
+			Id     string
+			Labels map[string]string
+		}{
+			{"A", map[string]string{"env": "prod", "team": "web"}},
+			{"B", map[string]string{"env": "prod"}},
+			{"C", map[string]string{"env": "dev", "team": "web"}},
+			{"D", nil},
+		} {
+			pt, err := ptypes.TimestampProto(now.Add(-time.Duration(i) * time.Hour))
+			require.NoError(err)
+
+			require.NoError(op.Put(s, false, serverptypes.TestValidBuild(t, &pb.Build{
+				Id:     v.Id,
+				Labels: v.Labels,
+				Status: &pb.Status{
+					State:     pb.Status_SUCCESS,
+					StartTime: pt,
+				},
+			})))
+		}
+
+		list := func(f *pb.OperationFilter) []string {
+			build := serverptypes.TestValidBuild(t, nil)
+			results, err := op.List(s, &listOperationsOptions{
+				Application: build.Application,
+				Filter:      f,
+			})
+			require.NoError(err)
+
+			var ids []string
+			for _, result := range results {
+				ids = append(ids, result.(*pb.Build).Id)
+			}
+			sort.Strings(ids)
+			return ids
+		}
+
+		// Labels
+		require.Equal([]string{"A", "B"}, list(&pb.OperationFilter{
+			Labels: map[string]string{"env": "prod"},
+		}))
+		require.Equal([]string{"A"}, list(&pb.OperationFilter{
+			Labels: map[string]string{"env": "prod", "team": "web"},
+		}))
+		require.Empty(list(&pb.OperationFilter{
+			Labels: map[string]string{"env": "staging"},
+		}))
+
+		// Time range
+		after, err := ptypes.TimestampProto(now.Add(-150 * time.Minute))
+		require.NoError(err)
+		before, err := ptypes.TimestampProto(now.Add(-30 * time.Minute))
+		require.NoError(err)
+		require.Equal([]string{"A", "B", "C"}, list(&pb.OperationFilter{
+			StartedAfter: after,
+		}))
+		require.Equal([]string{"B", "C"}, list(&pb.OperationFilter{
+			StartedAfter:  after,
+			StartedBefore: before,
+		}))
+
+		// Both
+		require.Equal([]string{"C"}, list(&pb.OperationFilter{
+			Labels:        map[string]string{"team": "web"},
+			StartedBefore: before,
+		}))
+	})
+
 	t.Run("list with memwatch", func(t *testing.T) {
 		require := require.New(t)
 
@@ -4,7 +4,6 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-memdb"
-	bolt "go.etcd.io/bbolt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -17,7 +16,7 @@ func (s *State) AppPut(app *pb.Application) (*pb.Application, error) {
 	memTxn := s.inmem.Txn(true)
 	defer memTxn.Abort()
 
-	err := s.db.Update(func(dbTxn *bolt.Tx) error {
+	err := s.db.Update(func(dbTxn dbTx) error {
 		return s.appPut(dbTxn, memTxn, app)
 	})
 	if err == nil {
@@ -33,7 +32,7 @@ func (s *State) AppDelete(ref *pb.Ref_Application) error {
 	memTxn := s.inmem.Txn(true)
 	defer memTxn.Abort()
 
-	err := s.db.Update(func(dbTxn *bolt.Tx) error {
+	err := s.db.Update(func(dbTxn dbTx) error {
 		return s.appDelete(dbTxn, memTxn, ref)
 	})
 	if err == nil {
@@ -49,7 +48,7 @@ func (s *State) AppGet(ref *pb.Ref_Application) (*pb.Application, error) {
 	defer memTxn.Abort()
 
 	var result *pb.Application
-	err := s.db.View(func(dbTxn *bolt.Tx) error {
+	err := s.db.View(func(dbTxn dbTx) error {
 		var err error
 		result, err = s.appGet(dbTxn, memTxn, ref)
 		return err
@@ -71,7 +70,7 @@ func (s *State) ApplicationPollPeek(
 
 	var result *pb.Project
 	var pollTime time.Time
-	err := s.db.View(func(dbTxn *bolt.Tx) error {
+	err := s.db.View(func(dbTxn dbTx) error {
 		var err error
 		result, pollTime, err = s.appPollPeek(dbTxn, memTxn, ws)
 		return err
@@ -89,7 +88,7 @@ func (s *State) ApplicationPollComplete(
 	memTxn := s.inmem.Txn(true)
 	defer memTxn.Abort()
 
-	err := s.db.View(func(dbTxn *bolt.Tx) error {
+	err := s.db.View(func(dbTxn dbTx) error {
 		err := s.appPollComplete(dbTxn, memTxn, project, t)
 		return err
 	})
@@ -118,7 +117,7 @@ func (s *State) GetFileChangeSignal(scope *pb.Ref_Application) (string, error) {
 }
 
 func (s *State) appPut(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	value *pb.Application,
 ) error {
@@ -144,7 +143,7 @@ func (s *State) appPut(
 }
 
 func (s *State) appDelete(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	ref *pb.Ref_Application,
 ) error {
@@ -173,7 +172,7 @@ func (s *State) appDelete(
 }
 
 func (s *State) appGet(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	ref *pb.Ref_Application,
 ) (*pb.Application, error) {
@@ -206,7 +205,7 @@ func (s *State) appDefaultForRef(ref *pb.Ref_Application) *pb.Application {
 }
 
 func (s *State) appPollPeek(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	ws memdb.WatchSet,
 ) (*pb.Project, time.Time, error) {
@@ -247,7 +246,7 @@ func (s *State) appPollPeek(
 	}
 
 	var result *pb.Project
-	err = s.db.View(func(dbTxn *bolt.Tx) error {
+	err = s.db.View(func(dbTxn dbTx) error {
 		var err error
 		result, err = s.projectGet(dbTxn, memTxn, &pb.Ref_Project{
 			Project: rec.Id,
@@ -260,7 +259,7 @@ func (s *State) appPollPeek(
 }
 
 func (s *State) appPollComplete(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	project *pb.Project,
 	t time.Time,
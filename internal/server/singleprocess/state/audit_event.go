@@ -0,0 +1,100 @@
+package state
+
+import (
+	"encoding/binary"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/go-memdb"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+var auditEventBucket = []byte("audit_events")
+
+// auditEventMax is the maximum number of audit events that are kept. When
+// more events are recorded, the oldest events are deleted.
+var auditEventMax = 10000
+
+func init() {
+	dbBuckets = append(dbBuckets, auditEventBucket)
+	dbIndexers = append(dbIndexers, (*State).auditEventIndexInit)
+}
+
+// AuditEventPut records an audit event. The ID of the event is set to a
+// new ULID. Events are stored by a sequence number rather than the ID so
+// that events recorded in the same millisecond keep their order.
+func (s *State) AuditEventPut(e *pb.AuditEvent) error {
+	id, err := ulid()
+	if err != nil {
+		return err
+	}
+	e.Id = id
+
+	// The count is only modified in write transactions, which bolt
+	// serializes, so it doesn't need its own lock.
+	return s.db.Update(func(dbTxn dbTx) error {
+		b := dbTxn.Bucket(auditEventBucket)
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, seq)
+		if err := dbPut(b, key, e); err != nil {
+			return err
+		}
+		count := s.auditEvents + 1
+
+		// Delete the oldest events to stay within our maximum.
+		c := b.Cursor()
+		for count > auditEventMax {
+			if k, _ := c.First(); k == nil {
+				break
+			}
+			if err := c.Delete(); err != nil {
+				return err
+			}
+			count--
+		}
+
+		s.auditEvents = count
+		return nil
+	})
+}
+
+// AuditEventList returns the audit events newest first for which the
+// filter returns true, up to the limit. If filter is nil, every event
+// matches. If limit is zero or less, every matching event is returned.
+func (s *State) AuditEventList(filter func(*pb.AuditEvent) bool, limit int) ([]*pb.AuditEvent, error) {
+	var result []*pb.AuditEvent
+	err := s.db.View(func(dbTxn dbTx) error {
+		c := dbTxn.Bucket(auditEventBucket).Cursor()
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			var e pb.AuditEvent
+			if err := proto.Unmarshal(v, &e); err != nil {
+				return err
+			}
+
+			if filter != nil && !filter(&e) {
+				continue
+			}
+
+			result = append(result, &e)
+			if limit > 0 && len(result) >= limit {
+				return nil
+			}
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// auditEventIndexInit counts the persisted audit events. Audit events
+// aren't indexed in memory since they're only listed in order.
+func (s *State) auditEventIndexInit(dbTxn dbTx, memTxn *memdb.Txn) error {
+	s.auditEvents = dbTxn.Bucket(auditEventBucket).KeyN()
+	return nil
+}
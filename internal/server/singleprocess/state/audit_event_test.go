@@ -0,0 +1,63 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestAuditEvent(t *testing.T) {
+	t.Run("Put and List", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		for _, endpoint := range []string{"A", "B", "A"} {
+			require.NoError(s.AuditEventPut(&pb.AuditEvent{Endpoint: endpoint}))
+		}
+
+		// Newest first
+		result, err := s.AuditEventList(nil, 0)
+		require.NoError(err)
+		require.Len(result, 3)
+		require.Equal("A", result[0].Endpoint)
+		require.Equal("B", result[1].Endpoint)
+		require.NotEmpty(result[0].Id)
+
+		// Limit
+		result, err = s.AuditEventList(nil, 1)
+		require.NoError(err)
+		require.Len(result, 1)
+
+		// Filter
+		result, err = s.AuditEventList(func(e *pb.AuditEvent) bool {
+			return e.Endpoint == "A"
+		}, 0)
+		require.NoError(err)
+		require.Len(result, 2)
+	})
+
+	t.Run("prunes the oldest events", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		old := auditEventMax
+		defer func() { auditEventMax = old }()
+		auditEventMax = 2
+
+		for _, endpoint := range []string{"A", "B", "C"} {
+			require.NoError(s.AuditEventPut(&pb.AuditEvent{Endpoint: endpoint}))
+		}
+
+		result, err := s.AuditEventList(nil, 0)
+		require.NoError(err)
+		require.Len(result, 2)
+		require.Equal("C", result[0].Endpoint)
+		require.Equal("B", result[1].Endpoint)
+	})
+}
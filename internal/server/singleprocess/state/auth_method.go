@@ -5,7 +5,6 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hashicorp/go-memdb"
-	bolt "go.etcd.io/bbolt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -25,7 +24,7 @@ func (s *State) AuthMethodPut(v *pb.AuthMethod) error {
 	memTxn := s.inmem.Txn(true)
 	defer memTxn.Abort()
 
-	err := s.db.Update(func(dbTxn *bolt.Tx) error {
+	err := s.db.Update(func(dbTxn dbTx) error {
 		return s.authMethodPut(dbTxn, memTxn, v)
 	})
 	if err == nil {
@@ -41,7 +40,7 @@ func (s *State) AuthMethodGet(ref *pb.Ref_AuthMethod) (*pb.AuthMethod, error) {
 	defer memTxn.Abort()
 
 	var result *pb.AuthMethod
-	err := s.db.View(func(dbTxn *bolt.Tx) error {
+	err := s.db.View(func(dbTxn dbTx) error {
 		var err error
 		result, err = s.authMethodGet(dbTxn, memTxn, ref)
 		return err
@@ -55,7 +54,7 @@ func (s *State) AuthMethodDelete(ref *pb.Ref_AuthMethod) error {
 	memTxn := s.inmem.Txn(true)
 	defer memTxn.Abort()
 
-	err := s.db.Update(func(dbTxn *bolt.Tx) error {
+	err := s.db.Update(func(dbTxn dbTx) error {
 		return s.authMethodDelete(dbTxn, memTxn, ref)
 	})
 	if err == nil {
@@ -84,7 +83,7 @@ func (s *State) AuthMethodList() ([]*pb.AuthMethod, error) {
 		idx := next.(*authMethodIndex)
 
 		var v *pb.AuthMethod
-		err = s.db.View(func(dbTxn *bolt.Tx) error {
+		err = s.db.View(func(dbTxn dbTx) error {
 			v, err = s.authMethodGet(dbTxn, memTxn, &pb.Ref_AuthMethod{Name: idx.Id})
 			return err
 		})
@@ -96,7 +95,7 @@ func (s *State) AuthMethodList() ([]*pb.AuthMethod, error) {
 }
 
 func (s *State) authMethodPut(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	value *pb.AuthMethod,
 ) error {
@@ -113,7 +112,7 @@ func (s *State) authMethodPut(
 }
 
 func (s *State) authMethodGet(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	ref *pb.Ref_AuthMethod,
 ) (*pb.AuthMethod, error) {
@@ -123,7 +122,7 @@ func (s *State) authMethodGet(
 }
 
 func (s *State) authMethodDelete(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	ref *pb.Ref_AuthMethod,
 ) error {
@@ -163,7 +162,7 @@ func (s *State) authMethodIndexSet(txn *memdb.Txn, id []byte, value *pb.AuthMeth
 }
 
 // authMethodIndexInit initializes the authMethod index from persisted data.
-func (s *State) authMethodIndexInit(dbTxn *bolt.Tx, memTxn *memdb.Txn) error {
+func (s *State) authMethodIndexInit(dbTxn dbTx, memTxn *memdb.Txn) error {
 	bucket := dbTxn.Bucket(authMethodBucket)
 	return bucket.ForEach(func(k, v []byte) error {
 		var value pb.AuthMethod
@@ -0,0 +1,91 @@
+package state
+
+// backend is the persisted storage of the state. The state is stored as
+// buckets of keys and values, modeled after BoltDB which was the first
+// backend. Every backend must implement the semantics that BoltDB has
+// since the state relies on them:
+//
+//   - Keys in a bucket are ordered bytewise.
+//   - View transactions see a consistent snapshot of the data.
+//   - Update transactions are serialized. Only one may run at a time.
+//   - An Update transaction is rolled back if its function returns an
+//     error, and committed otherwise.
+type backend interface {
+	// View runs fn in a read-only transaction.
+	View(fn func(dbTx) error) error
+
+	// Update runs fn in a read-write transaction.
+	Update(fn func(dbTx) error) error
+
+	// Size returns the size of the stored data in bytes.
+	Size() (int64, error)
+
+	// Close closes the backend.
+	Close() error
+}
+
+// dbTx is a transaction of a backend.
+type dbTx interface {
+	// Bucket returns the top-level bucket with the given name, or nil if
+	// it doesn't exist.
+	Bucket(name []byte) dbBucket
+
+	// CreateBucketIfNotExists returns the top-level bucket with the given
+	// name, creating it if it doesn't exist.
+	CreateBucketIfNotExists(name []byte) (dbBucket, error)
+
+	// ForEach calls fn for every top-level bucket.
+	ForEach(fn func(name []byte, b dbBucket) error) error
+}
+
+// dbBucket is a bucket of keys and values. Buckets may be nested.
+type dbBucket interface {
+	// Get returns the value of the key, or nil if it isn't set. The value
+	// is only valid for the life of the transaction.
+	Get(key []byte) []byte
+
+	// Put sets the value of the key.
+	Put(key, value []byte) error
+
+	// Delete deletes the key. Deleting a key that isn't set isn't an error.
+	Delete(key []byte) error
+
+	// Cursor returns a cursor to iterate over the keys in order.
+	Cursor() dbCursor
+
+	// ForEach calls fn for every key in order. Nested buckets are listed
+	// with a nil value.
+	ForEach(fn func(k, v []byte) error) error
+
+	// KeyN returns the number of keys in the bucket.
+	KeyN() int
+
+	// Bucket returns the nested bucket with the given name, or nil if it
+	// doesn't exist.
+	Bucket(name []byte) dbBucket
+
+	// CreateBucketIfNotExists returns the nested bucket with the given
+	// name, creating it if it doesn't exist.
+	CreateBucketIfNotExists(name []byte) (dbBucket, error)
+
+	// NextSequence returns a monotonically increasing integer for the
+	// bucket, starting at 1.
+	NextSequence() (uint64, error)
+}
+
+// dbCursor iterates over the keys of a bucket in order. The methods that
+// move the cursor return a nil key once the cursor moves past the first
+// or last key.
+type dbCursor interface {
+	First() (key, value []byte)
+	Last() (key, value []byte)
+	Next() (key, value []byte)
+	Prev() (key, value []byte)
+
+	// Seek moves the cursor to the given key, or to the next key if it
+	// isn't set.
+	Seek(seek []byte) (key, value []byte)
+
+	// Delete deletes the key the cursor is at.
+	Delete() error
+}
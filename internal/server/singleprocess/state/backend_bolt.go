@@ -0,0 +1,101 @@
+package state
+
+import (
+	bolt "go.etcd.io/bbolt"
+)
+
+// boltBackend is the backend that stores the state in a BoltDB file.
+type boltBackend struct {
+	db *bolt.DB
+}
+
+func (b *boltBackend) View(fn func(dbTx) error) error {
+	return b.db.View(func(tx *bolt.Tx) error {
+		return fn(boltTx{tx})
+	})
+}
+
+func (b *boltBackend) Update(fn func(dbTx) error) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return fn(boltTx{tx})
+	})
+}
+
+func (b *boltBackend) Size() (int64, error) {
+	var size int64
+	err := b.db.View(func(tx *bolt.Tx) error {
+		size = tx.Size()
+		return nil
+	})
+
+	return size, err
+}
+
+func (b *boltBackend) Close() error {
+	return b.db.Close()
+}
+
+type boltTx struct {
+	tx *bolt.Tx
+}
+
+func (t boltTx) Bucket(name []byte) dbBucket {
+	return wrapBoltBucket(t.tx.Bucket(name))
+}
+
+func (t boltTx) CreateBucketIfNotExists(name []byte) (dbBucket, error) {
+	b, err := t.tx.CreateBucketIfNotExists(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapBoltBucket(b), nil
+}
+
+func (t boltTx) ForEach(fn func(name []byte, b dbBucket) error) error {
+	return t.tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+		return fn(name, wrapBoltBucket(b))
+	})
+}
+
+type boltBucket struct {
+	b *bolt.Bucket
+}
+
+// wrapBoltBucket wraps b, returning a nil interface if b is nil so that
+// callers can check if a bucket exists.
+func wrapBoltBucket(b *bolt.Bucket) dbBucket {
+	if b == nil {
+		return nil
+	}
+
+	return boltBucket{b}
+}
+
+func (b boltBucket) Get(key []byte) []byte         { return b.b.Get(key) }
+func (b boltBucket) Put(key, value []byte) error   { return b.b.Put(key, value) }
+func (b boltBucket) Delete(key []byte) error       { return b.b.Delete(key) }
+func (b boltBucket) Cursor() dbCursor              { return b.b.Cursor() }
+func (b boltBucket) KeyN() int                     { return b.b.Stats().KeyN }
+func (b boltBucket) NextSequence() (uint64, error) { return b.b.NextSequence() }
+func (b boltBucket) Bucket(name []byte) dbBucket   { return wrapBoltBucket(b.b.Bucket(name)) }
+
+func (b boltBucket) ForEach(fn func(k, v []byte) error) error {
+	return b.b.ForEach(fn)
+}
+
+func (b boltBucket) CreateBucketIfNotExists(name []byte) (dbBucket, error) {
+	child, err := b.b.CreateBucketIfNotExists(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapBoltBucket(child), nil
+}
+
+var (
+	_ backend  = (*boltBackend)(nil)
+	_ dbTx     = boltTx{}
+	_ dbBucket = boltBucket{}
+	_ dbCursor = (*bolt.Cursor)(nil)
+)
@@ -0,0 +1,789 @@
+package state
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+
+	// Register the "postgres" driver for database/sql.
+	_ "github.com/lib/pq"
+)
+
+// PostgresDriver is the database/sql driver name to open a database for
+// NewPostgres with.
+const PostgresDriver = "postgres"
+
+// The keys of the PostgreSQL advisory locks that we take. The values are
+// arbitrary but must not change between versions since servers of different
+// versions must agree on them. The locks are taken with the hash of the
+// current schema as the second key, so servers can share a database by
+// using different schemas, set with the search_path parameter of the URL.
+const (
+	// postgresLockServer is held by the active server of the schema for
+	// as long as it runs, since the in-memory indexes don't support
+	// multiple servers sharing data. Standby servers wait for it.
+	postgresLockServer int32 = 0x77617901
+
+	// postgresLockMigrate is held while the schema is migrated.
+	postgresLockMigrate int32 = 0x77617902
+
+	// postgresLockUpdate is held by every Update transaction to serialize
+	// them like BoltDB does.
+	postgresLockUpdate int32 = 0x77617903
+)
+
+// postgresCursorBatch is the number of keys a cursor reads ahead.
+const postgresCursorBatch = 100
+
+// postgresLockCheckInterval is how often the active server checks that it
+// still holds postgresLockServer.
+const postgresLockCheckInterval = 5 * time.Second
+
+// postgresKeepalives makes PostgreSQL notice that the connection of a
+// server that stopped responding is broken within about 30 seconds rather
+// than hours, so that the server's locks are released and a standby server
+// can take over. These are ignored for Unix socket connections.
+const postgresKeepalives = `SET tcp_keepalives_idle = 15;
+	SET tcp_keepalives_interval = 5;
+	SET tcp_keepalives_count = 3`
+
+// postgresLockHeldQuery returns whether the session with the process ID $1
+// holds postgresLockServer ($2) for the current schema. Advisory locks with
+// two keys are listed in pg_locks with the keys as classid and objid.
+const postgresLockHeldQuery = `SELECT EXISTS (SELECT 1 FROM pg_locks
+	WHERE locktype = 'advisory' AND granted AND pid = $1
+	AND classid = $2::int4::oid
+	AND objid = hashtext(current_schema())::oid
+	AND objsubid = 2)`
+
+// postgresMigrations are the migrations of the schema, applied in order. The
+// schema version of a database is the number of migrations applied to it.
+// Released migrations must never be changed, new ones are appended.
+//
+// Buckets are stored in waypoint_buckets. Top-level buckets have a
+// parent_id of 0. The keys and values of the buckets are stored in
+// waypoint_items.
+var postgresMigrations = []string{
+	// 1
+	`CREATE TABLE waypoint_buckets (
+		id        BIGSERIAL PRIMARY KEY,
+		parent_id BIGINT NOT NULL,
+		name      BYTEA NOT NULL,
+		sequence  BIGINT NOT NULL DEFAULT 0,
+		UNIQUE (parent_id, name)
+	);
+
+	CREATE TABLE waypoint_items (
+		bucket_id BIGINT NOT NULL REFERENCES waypoint_buckets (id) ON DELETE CASCADE,
+		key       BYTEA NOT NULL,
+		value     BYTEA NOT NULL,
+		PRIMARY KEY (bucket_id, key)
+	);`,
+}
+
+var (
+	errPostgresTxReadOnly = errors.New("transaction is read-only")
+	errPostgresLockLost   = errors.New(
+		"this server lost the lock of the database schema, another server may " +
+			"have taken over")
+)
+
+// NewPostgres initializes a new State store that persists data to the
+// PostgreSQL database db, opened with the PostgresDriver. The schema of the
+// database is migrated to the latest version if necessary. Only one server
+// may use a schema of a database at a time, so this fails if another server
+// is using it.
+func NewPostgres(log hclog.Logger, db *sql.DB) (*State, error) {
+	return newPostgres(context.Background(), log, db, false)
+}
+
+// NewPostgresStandby is like NewPostgres, but if another server is using
+// the schema this waits as a standby until that server stops and then takes
+// over. This lets multiple servers share a schema for high availability.
+// Cancelling ctx stops waiting.
+//
+// The in-memory indexes are built once this server takes over, so they
+// include everything the previous server wrote.
+func NewPostgresStandby(ctx context.Context, log hclog.Logger, db *sql.DB) (*State, error) {
+	return newPostgres(ctx, log, db, true)
+}
+
+func newPostgres(ctx context.Context, log hclog.Logger, db *sql.DB, wait bool) (*State, error) {
+	// Hold a connection for the life of the server that locks the database
+	// for us. This is the equivalent of the file lock of BoltDB.
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := conn.ExecContext(ctx, postgresKeepalives); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	if wait {
+		log.Info("waiting to become the active server of the database schema")
+		if _, err := conn.ExecContext(ctx,
+			`SELECT pg_advisory_lock($1, hashtext(current_schema()))`, postgresLockServer); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		log.Info("became the active server of the database schema")
+	} else {
+		var locked bool
+		err = conn.QueryRowContext(ctx,
+			`SELECT pg_try_advisory_lock($1, hashtext(current_schema()))`, postgresLockServer).Scan(&locked)
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+		if !locked {
+			conn.Close()
+			return nil, fmt.Errorf(
+				"the database schema is in use by another server. Only one server " +
+					"may use a schema at a time unless servers are run as standbys.")
+		}
+	}
+
+	var pid int
+	if err := conn.QueryRowContext(ctx, `SELECT pg_backend_pid()`).Scan(&pid); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	b := &postgresBackend{
+		db:      db,
+		conn:    conn,
+		lockPid: pid,
+		lostCh:  make(chan struct{}),
+		closeCh: make(chan struct{}),
+	}
+	if err := postgresMigrate(ctx, log, db); err != nil {
+		b.Close()
+		return nil, err
+	}
+
+	s, err := newState(log, b)
+	if err != nil {
+		b.Close()
+		return nil, err
+	}
+
+	go b.watchLock(log)
+	return s, nil
+}
+
+// postgresMigrate applies the migrations that weren't applied to db yet.
+func postgresMigrate(ctx context.Context, log hclog.Logger, db *sql.DB) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx,
+		`SELECT pg_advisory_xact_lock($1, hashtext(current_schema()))`, postgresLockMigrate); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS waypoint_schema_migrations (
+		version    INTEGER PRIMARY KEY,
+		applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`); err != nil {
+		return err
+	}
+
+	var current int
+	if err := tx.QueryRowContext(ctx,
+		`SELECT COALESCE(MAX(version), 0) FROM waypoint_schema_migrations`,
+	).Scan(&current); err != nil {
+		return err
+	}
+
+	if current > len(postgresMigrations) {
+		return fmt.Errorf(
+			"the database schema version %d is newer than the version this server "+
+				"supports (%d). Please upgrade your server.",
+			current, len(postgresMigrations))
+	}
+
+	for i := current; i < len(postgresMigrations); i++ {
+		version := i + 1
+		log.Info("migrating database schema", "version", version)
+
+		if _, err := tx.ExecContext(ctx, postgresMigrations[i]); err != nil {
+			return fmt.Errorf("error migrating database schema to version %d: %w",
+				version, err)
+		}
+
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO waypoint_schema_migrations (version) VALUES ($1)`,
+			version); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// postgresBackend is the backend that stores the state in a PostgreSQL
+// database.
+type postgresBackend struct {
+	db *sql.DB
+
+	// conn holds the postgresLockServer lock. lockPid is the process ID of
+	// its session.
+	conn    *sql.Conn
+	lockPid int
+
+	// lostCh is closed when the lock is lost. closeCh is closed when the
+	// backend is closed.
+	lostCh    chan struct{}
+	lostOnce  sync.Once
+	closeCh   chan struct{}
+	closeOnce sync.Once
+}
+
+// Lost returns a channel that is closed if the server loses
+// postgresLockServer, such as because its connection broke. A standby
+// server may take over once the lock is released, so the server must stop.
+func (b *postgresBackend) Lost() <-chan struct{} {
+	return b.lostCh
+}
+
+func (b *postgresBackend) setLost() {
+	b.lostOnce.Do(func() { close(b.lostCh) })
+}
+
+// watchLock periodically checks that the connection holding the lock still
+// holds it until the backend is closed.
+func (b *postgresBackend) watchLock(log hclog.Logger) {
+	t := time.NewTicker(postgresLockCheckInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-b.closeCh:
+			return
+		case <-t.C:
+		}
+
+		// The query may not return at all if the network is partitioned,
+		// so we time out separately from the context.
+		errCh := make(chan error, 1)
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), postgresLockCheckInterval)
+			defer cancel()
+
+			var held bool
+			err := b.conn.QueryRowContext(ctx, postgresLockHeldQuery,
+				b.lockPid, postgresLockServer).Scan(&held)
+			if err == nil && !held {
+				err = errPostgresLockLost
+			}
+			errCh <- err
+		}()
+
+		var err error
+		select {
+		case err = <-errCh:
+		case <-time.After(postgresLockCheckInterval):
+			err = errors.New("timed out checking the lock")
+		case <-b.closeCh:
+			return
+		}
+		if err != nil {
+			log.Error("lost the lock of the database schema", "err", err)
+			b.setLost()
+			return
+		}
+	}
+}
+
+func (b *postgresBackend) View(fn func(dbTx) error) error {
+	return b.run(&sql.TxOptions{
+		Isolation: sql.LevelRepeatableRead,
+		ReadOnly:  true,
+	}, fn)
+}
+
+func (b *postgresBackend) Update(fn func(dbTx) error) error {
+	return b.run(&sql.TxOptions{Isolation: sql.LevelReadCommitted}, fn)
+}
+
+func (b *postgresBackend) run(opts *sql.TxOptions, fn func(dbTx) error) error {
+	ctx := context.Background()
+	tx, err := b.db.BeginTx(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	writable := !opts.ReadOnly
+	if writable {
+		if _, err := tx.ExecContext(ctx,
+			`SELECT pg_advisory_xact_lock($1, hashtext(current_schema()))`, postgresLockUpdate); err != nil {
+			return err
+		}
+
+		// Only the server holding the lock may write. If we lost it, a
+		// standby server may have taken over with its own indexes.
+		var held bool
+		if err := tx.QueryRowContext(ctx, postgresLockHeldQuery,
+			b.lockPid, postgresLockServer).Scan(&held); err != nil {
+			return err
+		}
+		if !held {
+			b.setLost()
+			return errPostgresLockLost
+		}
+	}
+
+	ptx := &postgresTx{ctx: ctx, tx: tx, writable: writable}
+	err = fn(ptx)
+
+	// An error of a method that can't return one is the cause of any other
+	// error, so it takes precedence.
+	if ptx.err != nil {
+		return ptx.err
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (b *postgresBackend) Size() (int64, error) {
+	var size int64
+	err := b.db.QueryRow(`SELECT
+		pg_total_relation_size('waypoint_buckets') +
+		pg_total_relation_size('waypoint_items')`).Scan(&size)
+	return size, err
+}
+
+func (b *postgresBackend) Close() error {
+	b.closeOnce.Do(func() { close(b.closeCh) })
+
+	// Unlock explicitly since closing the connection only returns it to
+	// the pool, keeping the session and the lock alive.
+	_, err := b.conn.ExecContext(context.Background(),
+		`SELECT pg_advisory_unlock($1, hashtext(current_schema()))`, postgresLockServer)
+	if cerr := b.conn.Close(); err == nil {
+		err = cerr
+	}
+	if cerr := b.db.Close(); err == nil {
+		err = cerr
+	}
+
+	return err
+}
+
+// postgresTx is a transaction of the PostgreSQL backend.
+type postgresTx struct {
+	ctx      context.Context
+	tx       *sql.Tx
+	writable bool
+
+	// err is the first error of a method that can't return errors, such
+	// as Get. Once set, every method does nothing and the transaction
+	// fails with this error.
+	err error
+
+	// gen is incremented on every write so that cursors know to discard
+	// the keys they read ahead.
+	gen uint64
+}
+
+func (t *postgresTx) setErr(err error) {
+	if t.err == nil {
+		t.err = err
+	}
+}
+
+func (t *postgresTx) Bucket(name []byte) dbBucket {
+	return t.bucket(0, name)
+}
+
+func (t *postgresTx) CreateBucketIfNotExists(name []byte) (dbBucket, error) {
+	return t.createBucket(0, name)
+}
+
+func (t *postgresTx) ForEach(fn func(name []byte, b dbBucket) error) error {
+	if t.err != nil {
+		return t.err
+	}
+
+	rows, err := t.tx.QueryContext(t.ctx, `SELECT id, name FROM waypoint_buckets
+		WHERE parent_id = 0 ORDER BY name`)
+	if err != nil {
+		return err
+	}
+
+	// Read all buckets first since fn can't query while rows is open.
+	var buckets []*postgresBucket
+	var names [][]byte
+	for rows.Next() {
+		b := &postgresBucket{tx: t}
+		var name []byte
+		if err := rows.Scan(&b.id, &name); err != nil {
+			rows.Close()
+			return err
+		}
+
+		buckets = append(buckets, b)
+		names = append(names, name)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for i, b := range buckets {
+		if err := fn(names[i], b); err != nil {
+			return err
+		}
+	}
+
+	return t.err
+}
+
+// bucket returns the bucket of the parent with the given name.
+func (t *postgresTx) bucket(parent int64, name []byte) dbBucket {
+	if t.err != nil {
+		// Callers expect the buckets that dbInit creates to exist, so
+		// return a bucket that does nothing rather than nil.
+		return &postgresBucket{tx: t}
+	}
+
+	b := &postgresBucket{tx: t}
+	err := t.tx.QueryRowContext(t.ctx, `SELECT id FROM waypoint_buckets
+		WHERE parent_id = $1 AND name = $2`, parent, name).Scan(&b.id)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		t.setErr(err)
+	}
+
+	return b
+}
+
+// createBucket returns the bucket of the parent with the given name,
+// creating it if it doesn't exist.
+func (t *postgresTx) createBucket(parent int64, name []byte) (dbBucket, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
+	if !t.writable {
+		return nil, errPostgresTxReadOnly
+	}
+	if len(name) == 0 {
+		return nil, errors.New("bucket name required")
+	}
+
+	// The no-op update makes RETURNING return the id of existing buckets.
+	b := &postgresBucket{tx: t}
+	err := t.tx.QueryRowContext(t.ctx, `INSERT INTO waypoint_buckets (parent_id, name)
+		VALUES ($1, $2)
+		ON CONFLICT (parent_id, name) DO UPDATE SET name = EXCLUDED.name
+		RETURNING id`, parent, name).Scan(&b.id)
+	if err != nil {
+		return nil, err
+	}
+	t.gen++
+
+	return b, nil
+}
+
+// postgresBucket is a bucket of the PostgreSQL backend.
+type postgresBucket struct {
+	tx *postgresTx
+	id int64
+}
+
+func (b *postgresBucket) Get(key []byte) []byte {
+	if b.tx.err != nil {
+		return nil
+	}
+
+	var value []byte
+	err := b.tx.tx.QueryRowContext(b.tx.ctx, `SELECT value FROM waypoint_items
+		WHERE bucket_id = $1 AND key = $2`, b.id, key).Scan(&value)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		b.tx.setErr(err)
+		return nil
+	}
+
+	// Like BoltDB, a key that is set never has a nil value.
+	if value == nil {
+		value = []byte{}
+	}
+
+	return value
+}
+
+func (b *postgresBucket) Put(key, value []byte) error {
+	if err := b.checkWrite(); err != nil {
+		return err
+	}
+	if len(key) == 0 {
+		return errors.New("key required")
+	}
+	if value == nil {
+		value = []byte{}
+	}
+
+	_, err := b.tx.tx.ExecContext(b.tx.ctx, `INSERT INTO waypoint_items (bucket_id, key, value)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (bucket_id, key) DO UPDATE SET value = EXCLUDED.value`,
+		b.id, key, value)
+	b.tx.gen++
+	return err
+}
+
+func (b *postgresBucket) Delete(key []byte) error {
+	if err := b.checkWrite(); err != nil {
+		return err
+	}
+
+	_, err := b.tx.tx.ExecContext(b.tx.ctx, `DELETE FROM waypoint_items
+		WHERE bucket_id = $1 AND key = $2`, b.id, key)
+	b.tx.gen++
+	return err
+}
+
+func (b *postgresBucket) Cursor() dbCursor {
+	return &postgresCursor{b: b}
+}
+
+func (b *postgresBucket) ForEach(fn func(k, v []byte) error) error {
+	c := b.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := fn(k, v); err != nil {
+			return err
+		}
+	}
+
+	return b.tx.err
+}
+
+func (b *postgresBucket) KeyN() int {
+	if b.tx.err != nil {
+		return 0
+	}
+
+	var n int
+	err := b.tx.tx.QueryRowContext(b.tx.ctx, `SELECT COUNT(*) FROM waypoint_items
+		WHERE bucket_id = $1`, b.id).Scan(&n)
+	if err != nil {
+		b.tx.setErr(err)
+	}
+
+	return n
+}
+
+func (b *postgresBucket) Bucket(name []byte) dbBucket {
+	return b.tx.bucket(b.id, name)
+}
+
+func (b *postgresBucket) CreateBucketIfNotExists(name []byte) (dbBucket, error) {
+	return b.tx.createBucket(b.id, name)
+}
+
+func (b *postgresBucket) NextSequence() (uint64, error) {
+	if err := b.checkWrite(); err != nil {
+		return 0, err
+	}
+
+	var seq uint64
+	err := b.tx.tx.QueryRowContext(b.tx.ctx, `UPDATE waypoint_buckets
+		SET sequence = sequence + 1 WHERE id = $1
+		RETURNING sequence`, b.id).Scan(&seq)
+	return seq, err
+}
+
+func (b *postgresBucket) checkWrite() error {
+	if b.tx.err != nil {
+		return b.tx.err
+	}
+	if !b.tx.writable {
+		return errPostgresTxReadOnly
+	}
+
+	return nil
+}
+
+// postgresEntry is a key of a bucket and its value. The value of a nested
+// bucket is nil.
+type postgresEntry struct {
+	key, value []byte
+}
+
+// postgresCursor is a cursor of the PostgreSQL backend. Every move queries
+// the entries following the current key, and keeps a batch of them to
+// serve the next moves in the same direction from.
+type postgresCursor struct {
+	b *postgresBucket
+
+	// key is the key the cursor is at, nil if it isn't at one.
+	key []byte
+
+	// ahead are the entries read ahead in the direction of desc. They are
+	// only valid while the generation of the transaction is gen.
+	ahead []postgresEntry
+	desc  bool
+	gen   uint64
+}
+
+func (c *postgresCursor) First() ([]byte, []byte) {
+	return c.fetch(">=", []byte{}, false)
+}
+
+func (c *postgresCursor) Last() ([]byte, []byte) {
+	return c.fetch("", nil, true)
+}
+
+func (c *postgresCursor) Next() ([]byte, []byte) {
+	if c.key == nil {
+		return nil, nil
+	}
+	if e, ok := c.pop(false); ok {
+		return e.key, e.value
+	}
+
+	return c.fetch(">", c.key, false)
+}
+
+func (c *postgresCursor) Prev() ([]byte, []byte) {
+	if c.key == nil {
+		return nil, nil
+	}
+	if e, ok := c.pop(true); ok {
+		return e.key, e.value
+	}
+
+	return c.fetch("<", c.key, true)
+}
+
+func (c *postgresCursor) Seek(seek []byte) ([]byte, []byte) {
+	if seek == nil {
+		seek = []byte{}
+	}
+
+	return c.fetch(">=", seek, false)
+}
+
+func (c *postgresCursor) Delete() error {
+	if err := c.b.checkWrite(); err != nil {
+		return err
+	}
+	if c.key == nil {
+		return errors.New("cursor isn't at a key")
+	}
+
+	_, err := c.b.tx.tx.ExecContext(c.b.tx.ctx, `DELETE FROM waypoint_items
+		WHERE bucket_id = $1 AND key = $2`, c.b.id, c.key)
+	c.b.tx.gen++
+
+	// Deleting the current key doesn't change the keys around it, so the
+	// keys read ahead are still valid for this cursor.
+	if c.gen == c.b.tx.gen-1 {
+		c.gen = c.b.tx.gen
+	}
+
+	return err
+}
+
+// pop moves the cursor to the next entry read ahead in the direction, if
+// there is one.
+func (c *postgresCursor) pop(desc bool) (postgresEntry, bool) {
+	if c.desc != desc || c.gen != c.b.tx.gen || len(c.ahead) == 0 {
+		return postgresEntry{}, false
+	}
+
+	e := c.ahead[0]
+	c.ahead = c.ahead[1:]
+	c.key = e.key
+	return e, true
+}
+
+// fetch moves the cursor to the first entry in the direction whose key
+// compares to bound with op. An empty op matches every key.
+func (c *postgresCursor) fetch(op string, bound []byte, desc bool) ([]byte, []byte) {
+	c.key = nil
+	c.ahead = nil
+	if c.b.tx.err != nil {
+		return nil, nil
+	}
+
+	order := "ASC"
+	if desc {
+		order = "DESC"
+	}
+
+	where := ""
+	args := []interface{}{c.b.id, postgresCursorBatch + 1}
+	if op != "" {
+		where = "WHERE key " + op + " $3"
+		args = append(args, bound)
+	}
+
+	// Nested buckets are listed with their name as key and a nil value
+	// like they are in BoltDB.
+	rows, err := c.b.tx.tx.QueryContext(c.b.tx.ctx, `SELECT key, value, bucket FROM (
+		SELECT key, value, false AS bucket FROM waypoint_items WHERE bucket_id = $1
+		UNION ALL
+		SELECT name, NULL, true FROM waypoint_buckets WHERE parent_id = $1
+	) AS entries `+where+`
+	ORDER BY key `+order+`
+	LIMIT $2`, args...)
+	if err != nil {
+		c.b.tx.setErr(err)
+		return nil, nil
+	}
+	defer rows.Close()
+
+	var entries []postgresEntry
+	for rows.Next() {
+		var e postgresEntry
+		var bucket bool
+		if err := rows.Scan(&e.key, &e.value, &bucket); err != nil {
+			c.b.tx.setErr(err)
+			return nil, nil
+		}
+		if !bucket && e.value == nil {
+			e.value = []byte{}
+		}
+
+		entries = append(entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		c.b.tx.setErr(err)
+		return nil, nil
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	c.key = entries[0].key
+	c.ahead = entries[1:]
+	c.desc = desc
+	c.gen = c.b.tx.gen
+	return entries[0].key, entries[0].value
+}
+
+var (
+	_ backend  = (*postgresBackend)(nil)
+	_ dbTx     = (*postgresTx)(nil)
+	_ dbBucket = (*postgresBucket)(nil)
+	_ dbCursor = (*postgresCursor)(nil)
+)
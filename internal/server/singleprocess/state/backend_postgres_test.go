@@ -0,0 +1,104 @@
+package state
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresStandby(t *testing.T) {
+	if os.Getenv(testPostgresURLEnv) == "" {
+		t.Skipf("%s must be set to test the PostgreSQL backend", testPostgresURLEnv)
+	}
+
+	t.Run("takes over when the active server closes", func(t *testing.T) {
+		require := require.New(t)
+
+		schema := testPostgresSchema(t)
+		active := testStatePostgres(t, testPostgresDB(t, schema))
+		require.NoError(active.ServerIdSet("active"))
+
+		// A second server can't use the schema without waiting
+		_, err := NewPostgres(hclog.L(), testPostgresDB(t, schema))
+		require.Error(err)
+
+		standbyCh := make(chan *State, 1)
+		errCh := make(chan error, 1)
+		go func() {
+			s, err := NewPostgresStandby(context.Background(), hclog.L(), testPostgresDB(t, schema))
+			if err != nil {
+				errCh <- err
+				return
+			}
+			standbyCh <- s
+		}()
+
+		select {
+		case <-standbyCh:
+			t.Fatal("standby shouldn't take over while the active server runs")
+		case err := <-errCh:
+			t.Fatal(err)
+		case <-time.After(time.Second):
+		}
+
+		require.NoError(active.Close())
+
+		select {
+		case s := <-standbyCh:
+			defer s.Close()
+
+			// The standby sees the data of the previous server
+			id, err := s.ServerIdGet()
+			require.NoError(err)
+			require.Equal("active", id)
+			require.NoError(s.ServerIdSet("standby"))
+		case err := <-errCh:
+			t.Fatal(err)
+		case <-time.After(10 * time.Second):
+			t.Fatal("standby didn't take over")
+		}
+	})
+
+	t.Run("stops waiting when cancelled", func(t *testing.T) {
+		require := require.New(t)
+
+		schema := testPostgresSchema(t)
+		testStatePostgres(t, testPostgresDB(t, schema))
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, err := NewPostgresStandby(ctx, hclog.L(), testPostgresDB(t, schema))
+		require.Error(err)
+	})
+
+	t.Run("lock lost", func(t *testing.T) {
+		require := require.New(t)
+
+		schema := testPostgresSchema(t)
+		s := testStatePostgres(t, testPostgresDB(t, schema))
+		require.NotNil(s.LockLost())
+
+		// Terminate the session holding the lock, as PostgreSQL does
+		// when the connection of a server breaks.
+		pg := s.db.(*postgresBackend)
+		_, err := testPostgresDB(t, schema).Exec(
+			`SELECT pg_terminate_backend($1)`, pg.lockPid)
+		require.NoError(err)
+
+		// Writes are fenced off even before the watcher checks the lock.
+		// Terminating the session is asynchronous so we retry.
+		require.Eventually(func() bool {
+			return s.ServerIdSet("lost") == errPostgresLockLost
+		}, 5*time.Second, 50*time.Millisecond)
+
+		select {
+		case <-s.LockLost():
+		default:
+			t.Fatal("lock loss should be reported")
+		}
+	})
+}
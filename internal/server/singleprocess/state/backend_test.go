@@ -0,0 +1,260 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// These tests check the semantics that the state relies on from a backend.
+// They run against the backend that TestState uses.
+func TestBackend(t *testing.T) {
+	bucketName := []byte("backend_test")
+
+	// keys returns n keys that sort in the order of their index.
+	keys := func(n int) [][]byte {
+		var result [][]byte
+		for i := 0; i < n; i++ {
+			result = append(result, []byte(fmt.Sprintf("k%04d", i)))
+		}
+
+		return result
+	}
+
+	t.Run("get, put and delete", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		require.NoError(s.db.Update(func(tx dbTx) error {
+			b, err := tx.CreateBucketIfNotExists(bucketName)
+			require.NoError(err)
+
+			require.Nil(b.Get([]byte("a")))
+			require.NoError(b.Put([]byte("a"), []byte("1")))
+			require.NoError(b.Put([]byte("b"), []byte{}))
+			require.NoError(b.Put([]byte("a"), []byte("2")))
+			require.NoError(b.Delete([]byte("missing")))
+			return nil
+		}))
+
+		require.NoError(s.db.View(func(tx dbTx) error {
+			b := tx.Bucket(bucketName)
+			require.NotNil(b)
+			require.Equal([]byte("2"), b.Get([]byte("a")))
+
+			// Empty values are distinct from unset keys
+			require.NotNil(b.Get([]byte("b")))
+			require.Empty(b.Get([]byte("b")))
+			require.Equal(2, b.KeyN())
+
+			require.Nil(tx.Bucket([]byte("missing")))
+			return nil
+		}))
+
+		require.NoError(s.db.Update(func(tx dbTx) error {
+			return tx.Bucket(bucketName).Delete([]byte("a"))
+		}))
+		require.NoError(s.db.View(func(tx dbTx) error {
+			require.Nil(tx.Bucket(bucketName).Get([]byte("a")))
+			return nil
+		}))
+	})
+
+	t.Run("rollback on error", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		errRollback := errors.New("rollback")
+		err := s.db.Update(func(tx dbTx) error {
+			b, err := tx.CreateBucketIfNotExists(bucketName)
+			require.NoError(err)
+			require.NoError(b.Put([]byte("a"), []byte("1")))
+			return errRollback
+		})
+		require.Equal(errRollback, err)
+
+		require.NoError(s.db.View(func(tx dbTx) error {
+			require.Nil(tx.Bucket(bucketName))
+			return nil
+		}))
+	})
+
+	t.Run("cursor", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		// More keys than the PostgreSQL cursor reads ahead
+		all := keys(postgresCursorBatch*2 + 5)
+		require.NoError(s.db.Update(func(tx dbTx) error {
+			b, err := tx.CreateBucketIfNotExists(bucketName)
+			require.NoError(err)
+
+			// Insert out of order
+			for i := len(all) - 1; i >= 0; i-- {
+				require.NoError(b.Put(all[i], all[i]))
+			}
+
+			return nil
+		}))
+
+		require.NoError(s.db.View(func(tx dbTx) error {
+			c := tx.Bucket(bucketName).Cursor()
+
+			var forward [][]byte
+			for k, v := c.First(); k != nil; k, v = c.Next() {
+				require.Equal(k, v)
+				forward = append(forward, k)
+			}
+			require.Equal(all, forward)
+
+			var backward [][]byte
+			for k, _ := c.Last(); k != nil; k, _ = c.Prev() {
+				backward = append([][]byte{k}, backward...)
+			}
+			require.Equal(all, backward)
+
+			// Seek to a key and between keys
+			k, _ := c.Seek(all[150])
+			require.Equal(all[150], k)
+			k, _ = c.Seek([]byte("k0150a"))
+			require.Equal(all[151], k)
+			k, _ = c.Next()
+			require.Equal(all[152], k)
+			k, _ = c.Prev()
+			require.Equal(all[151], k)
+			k, _ = c.Seek([]byte("l"))
+			require.Nil(k)
+
+			return nil
+		}))
+	})
+
+	t.Run("cursor delete", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		all := keys(postgresCursorBatch + 5)
+		require.NoError(s.db.Update(func(tx dbTx) error {
+			b, err := tx.CreateBucketIfNotExists(bucketName)
+			require.NoError(err)
+			for _, k := range all {
+				require.NoError(b.Put(k, k))
+			}
+
+			// Delete every other key by seeking to it, the pattern that
+			// deleting job logs uses.
+			c := b.Cursor()
+			for i := 0; i < len(all); i += 2 {
+				k, _ := c.Seek(all[i])
+				require.Equal(all[i], k)
+				require.NoError(c.Delete())
+			}
+
+			return nil
+		}))
+
+		require.NoError(s.db.View(func(tx dbTx) error {
+			b := tx.Bucket(bucketName)
+			require.Equal(len(all)/2, b.KeyN())
+			for i, k := range all {
+				if i%2 == 0 {
+					require.Nil(b.Get(k))
+				} else {
+					require.Equal(k, b.Get(k))
+				}
+			}
+
+			return nil
+		}))
+	})
+
+	t.Run("nested buckets", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		require.NoError(s.db.Update(func(tx dbTx) error {
+			b, err := tx.CreateBucketIfNotExists(bucketName)
+			require.NoError(err)
+			require.NoError(b.Put([]byte("a"), []byte("1")))
+
+			child, err := b.CreateBucketIfNotExists([]byte("b"))
+			require.NoError(err)
+			require.NoError(child.Put([]byte("a"), []byte("2")))
+
+			// Creating an existing bucket returns it
+			child, err = b.CreateBucketIfNotExists([]byte("b"))
+			require.NoError(err)
+			require.Equal([]byte("2"), child.Get([]byte("a")))
+
+			return nil
+		}))
+
+		require.NoError(s.db.View(func(tx dbTx) error {
+			b := tx.Bucket(bucketName)
+			require.Nil(b.Bucket([]byte("missing")))
+			require.Equal([]byte("2"), b.Bucket([]byte("b")).Get([]byte("a")))
+
+			// Nested buckets are listed with a nil value
+			var ks, vs [][]byte
+			require.NoError(b.ForEach(func(k, v []byte) error {
+				ks = append(ks, k)
+				vs = append(vs, v)
+				return nil
+			}))
+			require.Equal([][]byte{[]byte("a"), []byte("b")}, ks)
+			require.Equal([][]byte{[]byte("1"), nil}, vs)
+
+			// The top-level buckets include ours
+			found := false
+			require.NoError(tx.ForEach(func(name []byte, _ dbBucket) error {
+				found = found || string(name) == string(bucketName)
+				return nil
+			}))
+			require.True(found)
+
+			return nil
+		}))
+	})
+
+	t.Run("sequence", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		for i := uint64(1); i <= 3; i++ {
+			require.NoError(s.db.Update(func(tx dbTx) error {
+				b, err := tx.CreateBucketIfNotExists(bucketName)
+				require.NoError(err)
+
+				seq, err := b.NextSequence()
+				require.NoError(err)
+				require.Equal(i, seq)
+				return nil
+			}))
+		}
+	})
+
+	t.Run("view is read-only", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		require.Error(s.db.View(func(tx dbTx) error {
+			return tx.Bucket(sysBucket).Put([]byte("a"), []byte("1"))
+		}))
+	})
+}
@@ -0,0 +1,278 @@
+package state
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-memdb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+var (
+	buildCacheBucket     = []byte("build_cache")
+	buildCacheBlobBucket = []byte("build_cache_blobs")
+)
+
+// buildCacheChunkSize is the maximum size of a single value in the blob
+// bucket. Cache data is split into chunks of this size so that we never
+// have to hold a single huge value in memory.
+const buildCacheChunkSize = 1024 * 1024
+
+func init() {
+	dbBuckets = append(dbBuckets, buildCacheBucket, buildCacheBlobBucket)
+	dbIndexers = append(dbIndexers, (*State).buildCacheIndexInit)
+	schemas = append(schemas, buildCacheIndexSchema)
+}
+
+// BuildCacheDigest returns the digest of the data in the format that
+// is stored in a build cache entry.
+func BuildCacheDigest(sum []byte) string {
+	return "sha256:" + hex.EncodeToString(sum)
+}
+
+// BuildCachePut stores the data read from r under the application and key
+// of the entry. The digest and size of the entry must be set and must match
+// the data. If data with the same digest is already stored, r isn't read.
+// Data that is no longer referenced by any entry is deleted.
+func (s *State) BuildCachePut(e *pb.BuildCacheEntry, r io.Reader) error {
+	id := buildCacheId(e.Application, e.Key)
+	e.UpdateTime = ptypes.TimestampNow()
+
+	memTxn := s.inmem.Txn(true)
+	defer memTxn.Abort()
+
+	err := s.db.Update(func(dbTxn dbTx) error {
+		blobs := dbTxn.Bucket(buildCacheBlobBucket)
+		if blobs.Get(buildCacheChunkKey(e.Digest, 0)) == nil {
+			if err := buildCacheBlobWrite(blobs, e, r); err != nil {
+				return err
+			}
+		}
+
+		raw, err := memTxn.First(
+			buildCacheIndexTableName,
+			buildCacheIndexIdIndexName,
+			id,
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := dbPut(dbTxn.Bucket(buildCacheBucket), []byte(id), e); err != nil {
+			return err
+		}
+		if err := s.buildCacheIndexSet(memTxn, id, e); err != nil {
+			return err
+		}
+
+		// If this replaced data with a different digest, delete the old
+		// data unless another entry still references it.
+		if raw != nil {
+			if prev := raw.(*buildCacheIndexRecord).Entry.Digest; prev != e.Digest {
+				return buildCacheBlobGC(memTxn, blobs, prev)
+			}
+		}
+
+		return nil
+	})
+	if err == nil {
+		memTxn.Commit()
+	}
+
+	return err
+}
+
+// BuildCacheGet returns the entry for the key of an application. This
+// returns a NotFound error if there is no entry.
+func (s *State) BuildCacheGet(ref *pb.Ref_Application, key string) (*pb.BuildCacheEntry, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	raw, err := memTxn.First(
+		buildCacheIndexTableName,
+		buildCacheIndexIdIndexName,
+		buildCacheId(ref, key),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, status.Errorf(codes.NotFound,
+			"build cache entry not found for key: %s", key)
+	}
+
+	return raw.(*buildCacheIndexRecord).Entry, nil
+}
+
+// BuildCacheRead writes the data with the given digest to w.
+func (s *State) BuildCacheRead(digest string, w io.Writer) error {
+	return s.db.View(func(dbTxn dbTx) error {
+		prefix := []byte(digest + "/")
+		c := dbTxn.Bucket(buildCacheBlobBucket).Cursor()
+		k, v := c.Seek(prefix)
+		if k == nil || !bytes.HasPrefix(k, prefix) {
+			return status.Errorf(codes.NotFound,
+				"build cache data not found for digest: %s", digest)
+		}
+
+		for ; k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			if _, err := w.Write(v); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// buildCacheBlobWrite writes the data for the entry in chunks, verifying
+// the digest and size. An error aborts the transaction so no partial
+// data is ever stored.
+func buildCacheBlobWrite(b dbBucket, e *pb.BuildCacheEntry, r io.Reader) error {
+	h := sha256.New()
+	var size uint64
+	for idx := uint64(0); ; idx++ {
+		// Bolt requires values to stay valid for the life of the
+		// transaction so every chunk gets its own buffer.
+		buf := make([]byte, buildCacheChunkSize)
+		n, err := io.ReadFull(r, buf)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return status.Errorf(codes.Aborted, "error reading build cache data: %s", err)
+		}
+
+		// We always write the first chunk, even if it is empty, since
+		// that is how we check if data exists.
+		if n > 0 || idx == 0 {
+			h.Write(buf[:n])
+			size += uint64(n)
+			if err := b.Put(buildCacheChunkKey(e.Digest, idx), buf[:n]); err != nil {
+				return status.Errorf(codes.Aborted, "failed to write data: %s", err)
+			}
+		}
+
+		if err != nil {
+			break
+		}
+	}
+
+	if digest := BuildCacheDigest(h.Sum(nil)); digest != e.Digest || size != e.Size {
+		return status.Errorf(codes.InvalidArgument,
+			"build cache data doesn't match, expected %s (%d bytes), got %s (%d bytes)",
+			e.Digest, e.Size, digest, size)
+	}
+
+	return nil
+}
+
+// buildCacheBlobGC deletes the data for the digest if no entry references it.
+func buildCacheBlobGC(memTxn *memdb.Txn, b dbBucket, digest string) error {
+	raw, err := memTxn.First(
+		buildCacheIndexTableName,
+		buildCacheIndexDigestIndexName,
+		digest,
+	)
+	if err != nil {
+		return err
+	}
+	if raw != nil {
+		return nil
+	}
+
+	// Collect the keys first since deleting while iterating with a
+	// cursor skips keys.
+	prefix := []byte(digest + "/")
+	var keys [][]byte
+	c := b.Cursor()
+	for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+		keys = append(keys, k)
+	}
+	for _, k := range keys {
+		if err := b.Delete(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// buildCacheIndexSet writes an index record for a build cache entry.
+func (s *State) buildCacheIndexSet(txn *memdb.Txn, id string, value *pb.BuildCacheEntry) error {
+	return txn.Insert(buildCacheIndexTableName, &buildCacheIndexRecord{
+		Id:     id,
+		Digest: value.Digest,
+		Entry:  value,
+	})
+}
+
+// buildCacheIndexInit initializes the build cache index from persisted data.
+func (s *State) buildCacheIndexInit(dbTxn dbTx, memTxn *memdb.Txn) error {
+	bucket := dbTxn.Bucket(buildCacheBucket)
+	return bucket.ForEach(func(k, v []byte) error {
+		var value pb.BuildCacheEntry
+		if err := proto.Unmarshal(v, &value); err != nil {
+			return err
+		}
+
+		return s.buildCacheIndexSet(memTxn, string(k), &value)
+	})
+}
+
+// buildCacheId returns the ID used to store the entry for a key.
+func buildCacheId(ref *pb.Ref_Application, key string) string {
+	return strings.ToLower(fmt.Sprintf("%s/%s/%s", ref.Project, ref.Application, key))
+}
+
+// buildCacheChunkKey returns the key of a chunk of data. The index is
+// big-endian so that the chunks of a digest are iterated in order.
+func buildCacheChunkKey(digest string, idx uint64) []byte {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], idx)
+	return append([]byte(digest+"/"), buf[:]...)
+}
+
+func buildCacheIndexSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: buildCacheIndexTableName,
+		Indexes: map[string]*memdb.IndexSchema{
+			buildCacheIndexIdIndexName: {
+				Name:         buildCacheIndexIdIndexName,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "Id",
+				},
+			},
+
+			buildCacheIndexDigestIndexName: {
+				Name:         buildCacheIndexDigestIndexName,
+				AllowMissing: false,
+				Unique:       false,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "Digest",
+				},
+			},
+		},
+	}
+}
+
+const (
+	buildCacheIndexTableName       = "build-cache-index"
+	buildCacheIndexIdIndexName     = "id"
+	buildCacheIndexDigestIndexName = "digest"
+)
+
+type buildCacheIndexRecord struct {
+	Id     string
+	Digest string
+	Entry  *pb.BuildCacheEntry
+}
@@ -0,0 +1,114 @@
+package state
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestBuildCache(t *testing.T) {
+	app := &pb.Ref_Application{Project: "p", Application: "a"}
+
+	entry := func(key string, data []byte) *pb.BuildCacheEntry {
+		sum := sha256.Sum256(data)
+		return &pb.BuildCacheEntry{
+			Application: app,
+			Key:         key,
+			Digest:      BuildCacheDigest(sum[:]),
+			Size:        uint64(len(data)),
+		}
+	}
+
+	t.Run("put and read", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		// Larger than a chunk so we test reassembly
+		data := []byte(strings.Repeat("layer", buildCacheChunkSize))
+		require.NoError(s.BuildCachePut(entry("docker", data), bytes.NewReader(data)))
+
+		e, err := s.BuildCacheGet(app, "docker")
+		require.NoError(err)
+		require.Equal(uint64(len(data)), e.Size)
+		require.NotNil(e.UpdateTime)
+
+		var buf bytes.Buffer
+		require.NoError(s.BuildCacheRead(e.Digest, &buf))
+		require.Equal(data, buf.Bytes())
+	})
+
+	t.Run("get missing", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		_, err := s.BuildCacheGet(app, "docker")
+		require.Error(err)
+		require.Equal(codes.NotFound, status.Code(err))
+	})
+
+	t.Run("empty data", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		require.NoError(s.BuildCachePut(entry("docker", nil), bytes.NewReader(nil)))
+
+		e, err := s.BuildCacheGet(app, "docker")
+		require.NoError(err)
+
+		var buf bytes.Buffer
+		require.NoError(s.BuildCacheRead(e.Digest, &buf))
+		require.Empty(buf.Bytes())
+	})
+
+	t.Run("digest mismatch", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		e := entry("docker", []byte("hello"))
+		err := s.BuildCachePut(e, bytes.NewReader([]byte("goodbye")))
+		require.Error(err)
+		require.Equal(codes.InvalidArgument, status.Code(err))
+
+		_, err = s.BuildCacheGet(app, "docker")
+		require.Equal(codes.NotFound, status.Code(err))
+		require.Equal(codes.NotFound, status.Code(s.BuildCacheRead(e.Digest, &bytes.Buffer{})))
+	})
+
+	t.Run("shared data is kept until unreferenced", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		v1 := []byte("v1")
+		v2 := []byte("v2")
+		require.NoError(s.BuildCachePut(entry("a", v1), bytes.NewReader(v1)))
+		require.NoError(s.BuildCachePut(entry("b", v1), bytes.NewReader(v1)))
+
+		// Replacing one key keeps the data for the other
+		require.NoError(s.BuildCachePut(entry("a", v2), bytes.NewReader(v2)))
+		var buf bytes.Buffer
+		require.NoError(s.BuildCacheRead(entry("b", v1).Digest, &buf))
+		require.Equal(v1, buf.Bytes())
+
+		// Replacing the last reference deletes the data
+		require.NoError(s.BuildCachePut(entry("b", v2), bytes.NewReader(v2)))
+		err := s.BuildCacheRead(entry("b", v1).Digest, &bytes.Buffer{})
+		require.Equal(codes.NotFound, status.Code(err))
+	})
+}
@@ -2,11 +2,12 @@ package state
 
 import (
 	"fmt"
+	"path"
 	"sort"
+	"strings"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hashicorp/go-memdb"
-	bolt "go.etcd.io/bbolt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -24,12 +25,23 @@ func init() {
 
 // ConfigSet writes a configuration variable to the data store.
 func (s *State) ConfigSet(vs ...*pb.ConfigVar) error {
+	return s.ConfigSetBy(nil, vs...)
+}
+
+// ConfigSetBy is ConfigSet that records user as the author of the new
+// versions of the variables. The user may be nil.
+func (s *State) ConfigSetBy(user *pb.User, vs ...*pb.ConfigVar) error {
 	memTxn := s.inmem.Txn(true)
 	defer memTxn.Abort()
 
-	err := s.db.Update(func(dbTxn *bolt.Tx) error {
+	err := s.db.Update(func(dbTxn dbTx) error {
 		for _, v := range vs {
-			if err := s.configSet(dbTxn, memTxn, v); err != nil {
+			version := &pb.ConfigVarVersion{
+				UserId:   user.GetId(),
+				Username: user.GetUsername(),
+			}
+
+			if err := s.configSet(dbTxn, memTxn, v, version); err != nil {
 				return err
 			}
 		}
@@ -55,7 +67,7 @@ func (s *State) ConfigGetWatch(req *pb.ConfigGetRequest, ws memdb.WatchSet) ([]*
 	defer memTxn.Abort()
 
 	var result []*pb.ConfigVar
-	err := s.db.View(func(dbTxn *bolt.Tx) error {
+	err := s.db.View(func(dbTxn dbTx) error {
 		var err error
 		result, err = s.configGetMerged(dbTxn, memTxn, ws, req)
 		return err
@@ -65,9 +77,10 @@ func (s *State) ConfigGetWatch(req *pb.ConfigGetRequest, ws memdb.WatchSet) ([]*
 }
 
 func (s *State) configSet(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	value *pb.ConfigVar,
+	version *pb.ConfigVarVersion,
 ) error {
 	id := s.configVarId(value)
 
@@ -79,52 +92,113 @@ func (s *State) configSet(
 			return err
 		}
 	} else {
-		// If this is a runner, we don't support dynamic values currently.
+		// If this is a runner, we don't support dynamic values or
+		// workspaces currently.
 		if _, ok := value.Scope.(*pb.ConfigVar_Runner); ok {
 			if _, ok := value.Value.(*pb.ConfigVar_Static); !ok {
 				return status.Errorf(codes.FailedPrecondition,
 					"runner-scoped configuration must be static")
 			}
+
+			if configVarWorkspace(value) != "" {
+				return status.Errorf(codes.FailedPrecondition,
+					"runner-scoped configuration can't be scoped to a workspace")
+			}
+
+			// Runners write files relative to their working directory
+			// otherwise, which is rarely what was intended.
+			if value.NameIsPath && !path.IsAbs(value.Name) {
+				return status.Errorf(codes.FailedPrecondition,
+					"runner configuration files must have an absolute path")
+			}
 		}
 
-		if err := dbPut(b, id, value); err != nil {
+		stored, err := s.configEncrypt(value)
+		if err != nil {
+			return err
+		}
+
+		if err := dbPut(b, id, stored); err != nil {
 			return err
 		}
 	}
 
+	// Record the new version. The index is updated after this since this
+	// is the last step that can fail.
+	if err := s.configHistoryPut(dbTxn, id, value, version); err != nil {
+		return err
+	}
+
 	// Create our index value and write that.
 	return s.configIndexSet(memTxn, id, value)
 }
 
 func (s *State) configGetMerged(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	ws memdb.WatchSet,
 	req *pb.ConfigGetRequest,
 ) ([]*pb.ConfigVar, error) {
+	var workspace string
+	if req.Workspace != nil {
+		workspace = req.Workspace.Workspace
+	}
+
 	var mergeSet [][]*pb.ConfigVar
 	switch scope := req.Scope.(type) {
 	case *pb.ConfigGetRequest_Project:
-		// For project scope, we just return the project scoped values.
-		return s.configGetExact(dbTxn, memTxn, ws, scope.Project, req.Prefix)
+		// For project scope without a workspace, we just return the
+		// project scoped values.
+		if workspace == "" {
+			return s.configGetExact(dbTxn, memTxn, ws, scope.Project, "", req.Prefix)
+		}
 
-	case *pb.ConfigGetRequest_Application:
-		// Application scope, we have to get the project scope first
-		projectVars, err := s.configGetExact(dbTxn, memTxn, ws, &pb.Ref_Project{
-			Project: scope.Application.Project,
-		}, req.Prefix)
-		if err != nil {
-			return nil, err
+		// With a workspace, we return the values both in and out of the
+		// workspace. Like the project scoped values, these aren't merged
+		// since they may be for different apps.
+		var result []*pb.ConfigVar
+		for _, w := range []string{"", workspace} {
+			vars, err := s.configGetExact(dbTxn, memTxn, ws, scope.Project, w, req.Prefix)
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, vars...)
 		}
 
-		// Then the application scope
-		appVars, err := s.configGetExact(dbTxn, memTxn, ws, scope.Application, req.Prefix)
-		if err != nil {
-			return nil, err
+		sort.Sort(serversort.ConfigName(result))
+		return result, nil
+
+	case *pb.ConfigGetRequest_Application:
+		// Application scope, we get the project scope first and then the
+		// application scope. If we have a workspace, we repeat this for
+		// the workspace so that any workspace value overrides the others.
+		workspaces := []string{""}
+		if workspace != "" {
+			workspaces = append(workspaces, workspace)
 		}
 
-		// Build our merge set
-		mergeSet = append(mergeSet, projectVars, appVars)
+		for _, w := range workspaces {
+			projectVars, err := s.configGetExact(dbTxn, memTxn, ws, &pb.Ref_Project{
+				Project: scope.Application.Project,
+			}, w, req.Prefix)
+			if err != nil {
+				return nil, err
+			}
+
+			appVars, err := s.configGetExact(dbTxn, memTxn, ws, scope.Application, w, req.Prefix)
+			if err != nil {
+				return nil, err
+			}
+
+			// The project lookup also matches the variables of every app
+			// in the project. We only want the project-scoped ones, otherwise
+			// the values of other apps could override the values of this app.
+			projectVars = configVarsProjectScoped(projectVars)
+
+			// Build our merge set
+			mergeSet = append(mergeSet, projectVars, appVars)
+		}
 
 	case *pb.ConfigGetRequest_Runner:
 		var err error
@@ -158,12 +232,15 @@ func (s *State) configGetMerged(
 // configGetExact returns the list of config variables for a scope
 // exactly. By "exactly" we mean without any merging logic: if you request
 // app-scoped variables, you'll get app-scoped variables. If a project-scoped
-// variable matches, it will not be merged in.
+// variable matches, it will not be merged in. Likewise, only variables
+// in the given workspace are returned, where an empty workspace matches
+// variables that aren't scoped to a workspace.
 func (s *State) configGetExact(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	ws memdb.WatchSet,
 	ref interface{}, // should be one of the *pb.Ref_ values.
+	workspace string,
 	prefix string,
 ) ([]*pb.ConfigVar, error) {
 	// We have to get the correct iterator based on the scope. We check the
@@ -211,11 +288,18 @@ func (s *State) configGetExact(
 			break
 		}
 
-		var value pb.ConfigVar
 		record := current.(*configIndexRecord)
+		if !strings.EqualFold(record.Workspace, workspace) {
+			continue
+		}
+
+		var value pb.ConfigVar
 		if err := dbGet(b, []byte(record.Id), &value); err != nil {
 			return nil, err
 		}
+		if err := s.configDecrypt(&value); err != nil {
+			return nil, err
+		}
 
 		result = append(result, &value)
 	}
@@ -225,7 +309,7 @@ func (s *State) configGetExact(
 
 // configGetRunner gets the config vars for a runner.
 func (s *State) configGetRunner(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	ws memdb.WatchSet,
 	req *pb.Ref_RunnerId,
@@ -281,6 +365,9 @@ func (s *State) configGetRunner(
 		if err := dbGet(b, []byte(record.Id), &value); err != nil {
 			return nil, err
 		}
+		if err := s.configDecrypt(&value); err != nil {
+			return nil, err
+		}
 
 		result[idx] = append(result[idx], &value)
 	}
@@ -312,13 +399,19 @@ func (s *State) configIndexSet(txn *memdb.Txn, id []byte, value *pb.ConfigVar) e
 		Project:     project,
 		Application: application,
 		Name:        value.Name,
+		Workspace:   configVarWorkspace(value),
 		Runner:      runner != nil,
 		RunnerRef:   runner,
 	}
 
-	// If we have no value, we delete from the memdb index
+	// If we have no value, we delete from the memdb index. Unsetting a
+	// variable that isn't set isn't an error.
 	if isConfigVarDelete(value) {
-		return txn.Delete(configIndexTableName, record)
+		if err := txn.Delete(configIndexTableName, record); err != nil && err != memdb.ErrNotFound {
+			return err
+		}
+
+		return nil
 	}
 
 	// Insert the index
@@ -326,7 +419,7 @@ func (s *State) configIndexSet(txn *memdb.Txn, id []byte, value *pb.ConfigVar) e
 }
 
 // configIndexInit initializes the config index from persisted data.
-func (s *State) configIndexInit(dbTxn *bolt.Tx, memTxn *memdb.Txn) error {
+func (s *State) configIndexInit(dbTxn dbTx, memTxn *memdb.Txn) error {
 	bucket := dbTxn.Bucket(configBucket)
 	return bucket.ForEach(func(k, v []byte) error {
 		var value pb.ConfigVar
@@ -342,6 +435,15 @@ func (s *State) configIndexInit(dbTxn *bolt.Tx, memTxn *memdb.Txn) error {
 }
 
 func (s *State) configVarId(v *pb.ConfigVar) []byte {
+	// Workspace-scoped variables are prefixed so that they never collide
+	// with the same variable outside of the workspace.
+	if ws := configVarWorkspace(v); ws != "" {
+		return append([]byte("workspace/"+ws+"/"), s.configVarId(&pb.ConfigVar{
+			Scope: v.Scope,
+			Name:  v.Name,
+		})...)
+	}
+
 	switch scope := v.Scope.(type) {
 	case *pb.ConfigVar_Application:
 		return []byte(fmt.Sprintf("%s/%s/%s",
@@ -468,7 +570,8 @@ type configIndexRecord struct {
 	Project     string
 	Application string
 	Name        string
-	Runner      bool // true if this is a runner config
+	Workspace   string // empty if this isn't scoped to a workspace
+	Runner      bool   // true if this is a runner config
 	RunnerRef   *pb.Ref_Runner
 }
 
@@ -487,3 +590,25 @@ func isConfigVarDelete(value *pb.ConfigVar) bool {
 
 	return false
 }
+
+// configVarWorkspace returns the workspace the config var is scoped to,
+// or an empty string if it isn't scoped to a workspace.
+func configVarWorkspace(value *pb.ConfigVar) string {
+	if value.Workspace == nil {
+		return ""
+	}
+
+	return value.Workspace.Workspace
+}
+
+// configVarsProjectScoped returns only the project-scoped vars in vs.
+func configVarsProjectScoped(vs []*pb.ConfigVar) []*pb.ConfigVar {
+	result := vs[:0]
+	for _, v := range vs {
+		if _, ok := v.Scope.(*pb.ConfigVar_Project); ok {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,508 @@
+package state
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+var configKeyBucket = []byte("config_keys")
+
+func init() {
+	dbBuckets = append(dbBuckets, configKeyBucket)
+}
+
+// KeyWrapper encrypts and decrypts the keys that config values are
+// encrypted with. This is the root key of config encryption, such as a
+// local key or a key in a KMS, so that the keys stored in the database
+// are useless without it.
+type KeyWrapper interface {
+	WrapKey(ctx context.Context, key []byte) ([]byte, error)
+	UnwrapKey(ctx context.Context, wrapped []byte) ([]byte, error)
+}
+
+// configKeyring is the set of unwrapped keys that config values are
+// encrypted with.
+type configKeyring struct {
+	mu      sync.RWMutex
+	wrapper KeyWrapper
+	keys    map[string][]byte
+	active  string
+}
+
+// ConfigEncryptionEnable enables the encryption of config values at rest.
+// The keys stored in the database are unwrapped with the wrapper. If a key
+// can't be unwrapped, each of the previous wrappers is tried and the key
+// is wrapped again with the wrapper, which is how the root key is rotated.
+// If there are no keys yet, one is created.
+//
+// Every config value that is stored in plaintext is then encrypted, which
+// migrates existing databases. The number of values encrypted is returned.
+func (s *State) ConfigEncryptionEnable(
+	ctx context.Context,
+	wrapper KeyWrapper,
+	previous ...KeyWrapper,
+) (int, error) {
+	kr := &configKeyring{wrapper: wrapper, keys: map[string][]byte{}}
+
+	var count int
+	err := s.db.Update(func(dbTxn dbTx) error {
+		b := dbTxn.Bucket(configKeyBucket)
+
+		var stored []*pb.ConfigEncryptionKey
+		if err := b.ForEach(func(k, v []byte) error {
+			var key pb.ConfigEncryptionKey
+			if err := proto.Unmarshal(v, &key); err != nil {
+				return err
+			}
+
+			stored = append(stored, &key)
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		for _, key := range stored {
+			raw, rewrapped, err := configKeyUnwrap(ctx, key, wrapper, previous)
+			if err != nil {
+				return err
+			}
+			if rewrapped {
+				if err := dbPut(b, []byte(key.Id), key); err != nil {
+					return err
+				}
+			}
+
+			kr.keys[key.Id] = raw
+		}
+
+		// The newest key is the active key. Keys are ULIDs so they sort by
+		// time, but we use the create time in case the clock went back.
+		sort.Slice(stored, func(i, j int) bool {
+			ti, _ := ptypes.Timestamp(stored[i].CreateTime)
+			tj, _ := ptypes.Timestamp(stored[j].CreateTime)
+			return ti.Before(tj)
+		})
+		if len(stored) > 0 {
+			kr.active = stored[len(stored)-1].Id
+		} else {
+			if _, err := kr.create(ctx, b); err != nil {
+				return err
+			}
+		}
+
+		var err error
+		count, err = kr.reencrypt(dbTxn, false)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	s.configKeysMu.Lock()
+	defer s.configKeysMu.Unlock()
+	s.configKeys = kr
+
+	return count, nil
+}
+
+// ConfigEncryptionRotate creates a new key and re-encrypts every config
+// value with it. The old keys are kept so that snapshots taken before the
+// rotation can still be restored. It returns the ID of the new key and the
+// number of values that were re-encrypted.
+func (s *State) ConfigEncryptionRotate(ctx context.Context) (string, int, error) {
+	kr := s.configKeyring()
+	if kr == nil {
+		return "", 0, status.Errorf(codes.FailedPrecondition,
+			"config encryption is not enabled on the server")
+	}
+
+	// Config values are only written in write transactions, which bolt
+	// serializes, so no values can be written with the old key during the
+	// rotation.
+	kr.mu.RLock()
+	previous := kr.active
+	kr.mu.RUnlock()
+
+	var id string
+	var count int
+	err := s.db.Update(func(dbTxn dbTx) error {
+		var err error
+		id, err = kr.create(ctx, dbTxn.Bucket(configKeyBucket))
+		if err != nil {
+			return err
+		}
+
+		count, err = kr.reencrypt(dbTxn, true)
+		return err
+	})
+	if err != nil {
+		// The transaction was rolled back so the new key doesn't exist.
+		kr.mu.Lock()
+		defer kr.mu.Unlock()
+		if id != "" {
+			delete(kr.keys, id)
+		}
+		kr.active = previous
+
+		return "", 0, err
+	}
+
+	return id, count, nil
+}
+
+// configKeyring returns the keyring, or nil if config encryption is not
+// enabled.
+func (s *State) configKeyring() *configKeyring {
+	s.configKeysMu.RLock()
+	defer s.configKeysMu.RUnlock()
+	return s.configKeys
+}
+
+// configEncrypt returns the value to store for the config var. If config
+// encryption is enabled and the value is static, this is a copy of the
+// var with the value encrypted. Otherwise, the var is returned as is.
+func (s *State) configEncrypt(v *pb.ConfigVar) (*pb.ConfigVar, error) {
+	kr := s.configKeyring()
+	if kr == nil {
+		return v, nil
+	}
+
+	return kr.encrypt(v)
+}
+
+// configDecrypt decrypts the value of the config var in place if it is
+// encrypted.
+func (s *State) configDecrypt(v *pb.ConfigVar) error {
+	enc, ok := v.Value.(*pb.ConfigVar_Encrypted)
+	if !ok {
+		return nil
+	}
+
+	kr := s.configKeyring()
+	if kr == nil {
+		return status.Errorf(codes.FailedPrecondition,
+			"config value %q is encrypted but config encryption is not "+
+				"enabled on the server", v.Name)
+	}
+
+	plaintext, err := kr.decrypt(enc.Encrypted)
+	if err != nil {
+		return err
+	}
+
+	v.Value = &pb.ConfigVar_Static{Static: string(plaintext)}
+	return nil
+}
+
+// create creates a new key, stores it wrapped in the bucket, and makes it
+// the active key.
+func (kr *configKeyring) create(ctx context.Context, b dbBucket) (string, error) {
+	id, err := ulid()
+	if err != nil {
+		return "", err
+	}
+
+	raw := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, raw); err != nil {
+		return "", err
+	}
+
+	wrapped, err := kr.wrapper.WrapKey(ctx, raw)
+	if err != nil {
+		return "", err
+	}
+
+	if err := dbPut(b, []byte(id), &pb.ConfigEncryptionKey{
+		Id:         id,
+		WrappedKey: wrapped,
+		CreateTime: ptypes.TimestampNow(),
+	}); err != nil {
+		return "", err
+	}
+
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+	kr.keys[id] = raw
+	kr.active = id
+
+	return id, nil
+}
+
+// reencrypt encrypts the config values in the database with the active
+// key. If all is false, only plaintext values are encrypted. Otherwise,
+// values encrypted with other keys are re-encrypted too. This doesn't
+// change the in-memory indexes since only the stored value changes. The
+// previous values in the config history are encrypted too, but only the
+// current values are counted.
+func (kr *configKeyring) reencrypt(dbTxn dbTx, all bool) (int, error) {
+	count, err := kr.reencryptBucket(dbTxn.Bucket(configBucket), all, false)
+	if err != nil {
+		return 0, err
+	}
+
+	history := dbTxn.Bucket(configHistoryBucket)
+	var names [][]byte
+	if err := history.ForEach(func(k, v []byte) error {
+		// Only the nested buckets have a nil value.
+		if v == nil {
+			names = append(names, append([]byte(nil), k...))
+		}
+
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	for _, name := range names {
+		if _, err := kr.reencryptBucket(history.Bucket(name), all, true); err != nil {
+			return 0, err
+		}
+	}
+
+	return count, nil
+}
+
+// reencryptBucket re-encrypts the values of a bucket like reencrypt. The
+// bucket holds config vars, or versions of a config var if versions is
+// true.
+func (kr *configKeyring) reencryptBucket(b dbBucket, all, versions bool) (int, error) {
+	// Collect the values first since we can't modify the bucket while
+	// iterating over it.
+	updates := map[string]proto.Message{}
+	err := b.ForEach(func(k, v []byte) error {
+		var version pb.ConfigVarVersion
+		value := &pb.ConfigVar{}
+		if versions {
+			if err := proto.Unmarshal(v, &version); err != nil {
+				return err
+			}
+			value = version.Variable
+		} else if err := proto.Unmarshal(v, value); err != nil {
+			return err
+		}
+
+		encrypted, err := kr.reencryptVar(value, all)
+		if err != nil || encrypted == nil {
+			return err
+		}
+
+		if versions {
+			version.Variable = encrypted
+			updates[string(k)] = &version
+		} else {
+			updates[string(k)] = encrypted
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for k, v := range updates {
+		if err := dbPut(b, []byte(k), v); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(updates), nil
+}
+
+// reencryptVar returns the var encrypted with the active key, or nil if it
+// doesn't need to be encrypted again. See reencrypt for what all means.
+func (kr *configKeyring) reencryptVar(value *pb.ConfigVar, all bool) (*pb.ConfigVar, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch val := value.Value.(type) {
+	case *pb.ConfigVar_Static:
+		// Always encrypted.
+
+	case *pb.ConfigVar_Encrypted:
+		kr.mu.RLock()
+		active := kr.active
+		kr.mu.RUnlock()
+		if !all || val.Encrypted.KeyId == active {
+			return nil, nil
+		}
+
+		plaintext, err := kr.decrypt(val.Encrypted)
+		if err != nil {
+			return nil, err
+		}
+		value.Value = &pb.ConfigVar_Static{Static: string(plaintext)}
+
+	default:
+		return nil, nil
+	}
+
+	return kr.encrypt(value)
+}
+
+// encrypt returns a copy of the var with its static value encrypted with
+// the active key. Other values are returned as is.
+func (kr *configKeyring) encrypt(v *pb.ConfigVar) (*pb.ConfigVar, error) {
+	static, ok := v.Value.(*pb.ConfigVar_Static)
+	if !ok {
+		return v, nil
+	}
+
+	kr.mu.RLock()
+	id := kr.active
+	key := kr.keys[id]
+	kr.mu.RUnlock()
+
+	nonce, ciphertext, err := aesGCMSeal(key, []byte(static.Static))
+	if err != nil {
+		return nil, err
+	}
+
+	result := proto.Clone(v).(*pb.ConfigVar)
+	result.Value = &pb.ConfigVar_Encrypted{
+		Encrypted: &pb.ConfigVar_EncryptedVal{
+			KeyId:      id,
+			Nonce:      nonce,
+			Ciphertext: ciphertext,
+		},
+	}
+
+	return result, nil
+}
+
+// decrypt returns the plaintext of an encrypted value.
+func (kr *configKeyring) decrypt(v *pb.ConfigVar_EncryptedVal) ([]byte, error) {
+	kr.mu.RLock()
+	key, ok := kr.keys[v.KeyId]
+	kr.mu.RUnlock()
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"config encryption key %q not found", v.KeyId)
+	}
+
+	return aesGCMOpen(key, v.Nonce, v.Ciphertext)
+}
+
+// configKeyUnwrap unwraps the key with the wrapper or, if that fails, with
+// one of the previous wrappers. Keys that are unwrapped with a previous
+// wrapper are wrapped again with the wrapper, in which case rewrapped is
+// true and the caller must store the key again.
+func configKeyUnwrap(
+	ctx context.Context,
+	key *pb.ConfigEncryptionKey,
+	wrapper KeyWrapper,
+	previous []KeyWrapper,
+) (raw []byte, rewrapped bool, err error) {
+	raw, err = wrapper.UnwrapKey(ctx, key.WrappedKey)
+	if err == nil {
+		return raw, false, nil
+	}
+
+	for _, w := range previous {
+		raw, perr := w.UnwrapKey(ctx, key.WrappedKey)
+		if perr != nil {
+			continue
+		}
+
+		key.WrappedKey, err = wrapper.WrapKey(ctx, raw)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return raw, true, nil
+	}
+
+	return nil, false, status.Errorf(codes.FailedPrecondition,
+		"config encryption key %q can't be decrypted with the configured "+
+			"root key: %s", key.Id, err)
+}
+
+// aesGCMSeal encrypts the plaintext with AES-GCM and a random nonce.
+func aesGCMSeal(key, plaintext []byte) ([]byte, []byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, nil, err
+	}
+
+	return nonce, gcm.Seal(nil, nonce, plaintext, nil), nil
+}
+
+// aesGCMOpen decrypts ciphertext encrypted with aesGCMSeal.
+func aesGCMOpen(key, nonce, ciphertext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
+
+// localKeyWrapper wraps keys with a local AES-256 key.
+type localKeyWrapper struct {
+	key []byte
+}
+
+// NewLocalKeyWrapper returns a KeyWrapper that wraps keys with the given
+// AES-256 key, which must be 32 bytes.
+func NewLocalKeyWrapper(key []byte) (KeyWrapper, error) {
+	if len(key) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"config encryption key must be 32 bytes, got %d", len(key))
+	}
+
+	return &localKeyWrapper{key: key}, nil
+}
+
+func (w *localKeyWrapper) WrapKey(ctx context.Context, key []byte) ([]byte, error) {
+	nonce, ciphertext, err := aesGCMSeal(w.key, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(nonce, ciphertext...), nil
+}
+
+func (w *localKeyWrapper) UnwrapKey(ctx context.Context, wrapped []byte) ([]byte, error) {
+	block, err := aes.NewCipher(w.key)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(wrapped) < gcm.NonceSize() {
+		return nil, status.Errorf(codes.InvalidArgument, "wrapped key is too short")
+	}
+
+	return gcm.Open(nil, wrapped[:gcm.NonceSize()], wrapped[gcm.NonceSize():], nil)
+}
@@ -0,0 +1,204 @@
+package state
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestConfigEncryption(t *testing.T) {
+	ctx := context.Background()
+
+	configVar := func(name, value string) *pb.ConfigVar {
+		return &pb.ConfigVar{
+			Scope: &pb.ConfigVar_Project{
+				Project: &pb.Ref_Project{Project: "foo"},
+			},
+
+			Name:  name,
+			Value: &pb.ConfigVar_Static{Static: value},
+		}
+	}
+
+	get := func(t *testing.T, s *State) []*pb.ConfigVar {
+		vs, err := s.ConfigGet(&pb.ConfigGetRequest{
+			Scope: &pb.ConfigGetRequest_Project{
+				Project: &pb.Ref_Project{Project: "foo"},
+			},
+		})
+		require.NoError(t, err)
+		return vs
+	}
+
+	t.Run("set and get", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		n, err := s.ConfigEncryptionEnable(ctx, testKeyWrapper(t))
+		require.NoError(err)
+		require.Equal(0, n)
+
+		require.NoError(s.ConfigSet(configVar("foo", "bar")))
+
+		vs := get(t, s)
+		require.Len(vs, 1)
+		require.Equal("bar", vs[0].Value.(*pb.ConfigVar_Static).Static)
+
+		// The stored value must be encrypted
+		stored := testConfigStored(t, s)
+		require.Len(stored, 1)
+		enc, ok := stored[0].Value.(*pb.ConfigVar_Encrypted)
+		require.True(ok)
+		require.NotContains(string(enc.Encrypted.Ciphertext), "bar")
+	})
+
+	t.Run("migrates plaintext values", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		require.NoError(s.ConfigSet(configVar("foo", "bar"), configVar("baz", "qux")))
+		for _, v := range testConfigStored(t, s) {
+			require.IsType(&pb.ConfigVar_Static{}, v.Value)
+		}
+
+		n, err := s.ConfigEncryptionEnable(ctx, testKeyWrapper(t))
+		require.NoError(err)
+		require.Equal(2, n)
+
+		for _, v := range testConfigStored(t, s) {
+			require.IsType(&pb.ConfigVar_Encrypted{}, v.Value)
+		}
+
+		require.Len(get(t, s), 2)
+	})
+
+	t.Run("rotate", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		_, err := s.ConfigEncryptionEnable(ctx, testKeyWrapper(t))
+		require.NoError(err)
+		require.NoError(s.ConfigSet(configVar("foo", "bar")))
+		before := testConfigStored(t, s)[0].Value.(*pb.ConfigVar_Encrypted)
+
+		id, n, err := s.ConfigEncryptionRotate(ctx)
+		require.NoError(err)
+		require.Equal(1, n)
+		require.NotEqual(before.Encrypted.KeyId, id)
+
+		after := testConfigStored(t, s)[0].Value.(*pb.ConfigVar_Encrypted)
+		require.Equal(id, after.Encrypted.KeyId)
+
+		vs := get(t, s)
+		require.Len(vs, 1)
+		require.Equal("bar", vs[0].Value.(*pb.ConfigVar_Static).Static)
+	})
+
+	t.Run("rotate without encryption", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		_, _, err := s.ConfigEncryptionRotate(ctx)
+		require.Error(err)
+		require.Equal(codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("restart with a previous root key", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		oldWrapper := testKeyWrapper(t)
+		_, err := s.ConfigEncryptionEnable(ctx, oldWrapper)
+		require.NoError(err)
+		require.NoError(s.ConfigSet(configVar("foo", "bar")))
+
+		// A new root key without the previous one fails
+		newWrapper := testKeyWrapper(t)
+		s, err = TestStateRestart(t, s)
+		require.NoError(err)
+		_, err = s.ConfigEncryptionEnable(ctx, newWrapper)
+		require.Error(err)
+
+		// With the previous root key the keys are rewrapped
+		_, err = s.ConfigEncryptionEnable(ctx, newWrapper, oldWrapper)
+		require.NoError(err)
+		require.Len(get(t, s), 1)
+
+		// After which the previous root key is no longer needed
+		s, err = TestStateRestart(t, s)
+		require.NoError(err)
+		_, err = s.ConfigEncryptionEnable(ctx, newWrapper)
+		require.NoError(err)
+
+		vs := get(t, s)
+		require.Len(vs, 1)
+		require.Equal("bar", vs[0].Value.(*pb.ConfigVar_Static).Static)
+	})
+
+	t.Run("get encrypted value without encryption", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		_, err := s.ConfigEncryptionEnable(ctx, testKeyWrapper(t))
+		require.NoError(err)
+		require.NoError(s.ConfigSet(configVar("foo", "bar")))
+
+		s, err = TestStateRestart(t, s)
+		require.NoError(err)
+
+		_, err = s.ConfigGet(&pb.ConfigGetRequest{
+			Scope: &pb.ConfigGetRequest_Project{
+				Project: &pb.Ref_Project{Project: "foo"},
+			},
+		})
+		require.Error(err)
+		require.Equal(codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func testKeyWrapper(t *testing.T) KeyWrapper {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+
+	w, err := NewLocalKeyWrapper(key)
+	require.NoError(t, err)
+	return w
+}
+
+// testConfigStored returns the config vars as stored in the database.
+func testConfigStored(t *testing.T, s *State) []*pb.ConfigVar {
+	var result []*pb.ConfigVar
+	require.NoError(t, s.db.View(func(dbTxn dbTx) error {
+		return dbTxn.Bucket(configBucket).ForEach(func(k, v []byte) error {
+			var value pb.ConfigVar
+			if err := proto.Unmarshal(v, &value); err != nil {
+				return err
+			}
+
+			result = append(result, &value)
+			return nil
+		})
+	}))
+
+	return result
+}
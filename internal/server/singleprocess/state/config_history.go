@@ -0,0 +1,171 @@
+package state
+
+import (
+	"bytes"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+var configHistoryBucket = []byte("config_history")
+
+// configHistoryMax is the maximum number of versions that are kept for
+// each config variable. When more versions are recorded, the oldest
+// versions are deleted.
+var configHistoryMax uint64 = 50
+
+func init() {
+	dbBuckets = append(dbBuckets, configHistoryBucket)
+}
+
+// ConfigHistory returns the versions of the config variable, newest first.
+// The variable is identified by its scope, name and workspace.
+func (s *State) ConfigHistory(v *pb.ConfigVar) ([]*pb.ConfigVarVersion, error) {
+	var result []*pb.ConfigVarVersion
+	err := s.db.View(func(dbTxn dbTx) error {
+		b := dbTxn.Bucket(configHistoryBucket).Bucket(s.configVarId(v))
+		if b == nil {
+			return nil
+		}
+
+		c := b.Cursor()
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			var version pb.ConfigVarVersion
+			if err := proto.Unmarshal(v, &version); err != nil {
+				return err
+			}
+			if err := s.configDecrypt(version.Variable); err != nil {
+				return err
+			}
+
+			result = append(result, &version)
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// ConfigRollback sets the config variable back to the value it had at the
+// given version. This creates a new version with that value, authored by
+// user, which may be nil. The new version is returned. Like any other
+// change, watchers of the config, such as entrypoints, are notified.
+func (s *State) ConfigRollback(
+	user *pb.User,
+	v *pb.ConfigVar,
+	version uint64,
+) (*pb.ConfigVarVersion, error) {
+	id := s.configVarId(v)
+
+	memTxn := s.inmem.Txn(true)
+	defer memTxn.Abort()
+
+	var result *pb.ConfigVarVersion
+	err := s.db.Update(func(dbTxn dbTx) error {
+		b := dbTxn.Bucket(configHistoryBucket).Bucket(id)
+		if b == nil {
+			return status.Errorf(codes.NotFound,
+				"config variable %q has no history", v.Name)
+		}
+
+		var target pb.ConfigVarVersion
+		if err := dbGet(b, configHistoryKey(version), &target); err != nil {
+			return err
+		}
+		if err := s.configDecrypt(target.Variable); err != nil {
+			return err
+		}
+
+		result = &pb.ConfigVarVersion{
+			UserId:          user.GetId(),
+			Username:        user.GetUsername(),
+			RollbackVersion: version,
+		}
+
+		return s.configSet(dbTxn, memTxn, target.Variable, result)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	memTxn.Commit()
+	return result, nil
+}
+
+// configHistoryPut records a new version of the config variable with the
+// given ID. The version number, variable and time of the version are set.
+func (s *State) configHistoryPut(
+	dbTxn dbTx,
+	id []byte,
+	value *pb.ConfigVar,
+	version *pb.ConfigVarVersion,
+) error {
+	parent := dbTxn.Bucket(configHistoryBucket)
+
+	// Unsetting a variable that was never set isn't a change.
+	if isConfigVarDelete(value) && parent.Bucket(id) == nil {
+		return nil
+	}
+
+	b, err := parent.CreateBucketIfNotExists(id)
+	if err != nil {
+		return err
+	}
+
+	seq, err := b.NextSequence()
+	if err != nil {
+		return err
+	}
+
+	// Deletions are recorded as unset so that the history shows when the
+	// variable was deleted and a rollback to it deletes the variable.
+	if isConfigVarDelete(value) {
+		value = proto.Clone(value).(*pb.ConfigVar)
+		value.Value = &pb.ConfigVar_Unset{Unset: &empty.Empty{}}
+	}
+
+	stored, err := s.configEncrypt(value)
+	if err != nil {
+		return err
+	}
+
+	version.Version = seq
+	version.Variable = value
+	version.Time = ptypes.TimestampNow()
+
+	// We store a copy with the encrypted value but return the version
+	// with the value as it was set.
+	storedVersion := proto.Clone(version).(*pb.ConfigVarVersion)
+	storedVersion.Variable = stored
+	if err := dbPut(b, configHistoryKey(seq), storedVersion); err != nil {
+		return err
+	}
+
+	// Sequence numbers are never reused, so every version with a
+	// sequence at or below this is over our maximum.
+	if seq <= configHistoryMax {
+		return nil
+	}
+	oldest := configHistoryKey(seq - configHistoryMax)
+
+	c := b.Cursor()
+	for k, _ := c.First(); k != nil && bytes.Compare(k, oldest) <= 0; k, _ = c.First() {
+		if err := c.Delete(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// configHistoryKey returns the key of the given version.
+func configHistoryKey(version uint64) []byte {
+	// Versions are sequences like app events so we use the same keys.
+	return appEventKey(version)
+}
@@ -0,0 +1,176 @@
+package state
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestConfigHistory(t *testing.T) {
+	configVar := func(value string) *pb.ConfigVar {
+		return &pb.ConfigVar{
+			Scope: &pb.ConfigVar_Application{
+				Application: &pb.Ref_Application{
+					Project:     "foo",
+					Application: "bar",
+				},
+			},
+
+			Name:  "DATABASE_URL",
+			Value: &pb.ConfigVar_Static{Static: value},
+		}
+	}
+
+	get := func(t *testing.T, s *State) []*pb.ConfigVar {
+		vs, err := s.ConfigGet(&pb.ConfigGetRequest{
+			Scope: &pb.ConfigGetRequest_Application{
+				Application: &pb.Ref_Application{
+					Project:     "foo",
+					Application: "bar",
+				},
+			},
+		})
+		require.NoError(t, err)
+		return vs
+	}
+
+	t.Run("versions", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		user := &pb.User{Id: "U1", Username: "alice"}
+		require.NoError(s.ConfigSetBy(user, configVar("one")))
+		require.NoError(s.ConfigSet(configVar("two")))
+		require.NoError(s.ConfigSet(configVar("")))
+
+		versions, err := s.ConfigHistory(configVar(""))
+		require.NoError(err)
+		require.Len(versions, 3)
+
+		// Newest first
+		require.Equal(uint64(3), versions[0].Version)
+		require.IsType(&pb.ConfigVar_Unset{}, versions[0].Variable.Value)
+		require.Equal("two", versions[1].Variable.Value.(*pb.ConfigVar_Static).Static)
+		require.Empty(versions[1].Username)
+		require.Equal(uint64(1), versions[2].Version)
+		require.Equal("alice", versions[2].Username)
+		require.Equal("U1", versions[2].UserId)
+		require.NotNil(versions[2].Time)
+
+		// Other variables have no history
+		other := configVar("")
+		other.Name = "OTHER"
+		versions, err = s.ConfigHistory(other)
+		require.NoError(err)
+		require.Empty(versions)
+	})
+
+	t.Run("unset without a value", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		require.NoError(s.ConfigSet(configVar("")))
+
+		versions, err := s.ConfigHistory(configVar(""))
+		require.NoError(err)
+		require.Empty(versions)
+	})
+
+	t.Run("prunes old versions", func(t *testing.T) {
+		require := require.New(t)
+
+		old := configHistoryMax
+		defer func() { configHistoryMax = old }()
+		configHistoryMax = 2
+
+		s := TestState(t)
+		defer s.Close()
+
+		for _, v := range []string{"one", "two", "three"} {
+			require.NoError(s.ConfigSet(configVar(v)))
+		}
+
+		versions, err := s.ConfigHistory(configVar(""))
+		require.NoError(err)
+		require.Len(versions, 2)
+		require.Equal(uint64(3), versions[0].Version)
+		require.Equal(uint64(2), versions[1].Version)
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		require.NoError(s.ConfigSet(configVar("one")))
+		require.NoError(s.ConfigSet(configVar("two")))
+
+		version, err := s.ConfigRollback(&pb.User{Username: "bob"}, configVar(""), 1)
+		require.NoError(err)
+		require.Equal(uint64(3), version.Version)
+		require.Equal(uint64(1), version.RollbackVersion)
+		require.Equal("bob", version.Username)
+
+		vs := get(t, s)
+		require.Len(vs, 1)
+		require.Equal("one", vs[0].Value.(*pb.ConfigVar_Static).Static)
+
+		// Rolling back to a deletion deletes the variable
+		require.NoError(s.ConfigSet(configVar("")))
+		require.NoError(s.ConfigSet(configVar("five")))
+		_, err = s.ConfigRollback(nil, configVar(""), 4)
+		require.NoError(err)
+		require.Empty(get(t, s))
+
+		// Unknown version
+		_, err = s.ConfigRollback(nil, configVar(""), 42)
+		require.Error(err)
+		require.Equal(codes.NotFound, status.Code(err))
+	})
+
+	t.Run("rollback without history", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		_, err := s.ConfigRollback(nil, configVar(""), 1)
+		require.Error(err)
+		require.Equal(codes.NotFound, status.Code(err))
+	})
+
+	t.Run("encrypted", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		// Values from before encryption is enabled are encrypted too
+		require.NoError(s.ConfigSet(configVar("one")))
+		_, err := s.ConfigEncryptionEnable(context.Background(), testKeyWrapper(t))
+		require.NoError(err)
+		require.NoError(s.ConfigSet(configVar("two")))
+
+		versions, err := s.ConfigHistory(configVar(""))
+		require.NoError(err)
+		require.Len(versions, 2)
+		require.Equal("two", versions[0].Variable.Value.(*pb.ConfigVar_Static).Static)
+		require.Equal("one", versions[1].Variable.Value.(*pb.ConfigVar_Static).Static)
+
+		_, err = s.ConfigRollback(nil, configVar(""), 1)
+		require.NoError(err)
+		vs := get(t, s)
+		require.Len(vs, 1)
+		require.Equal("one", vs[0].Value.(*pb.ConfigVar_Static).Static)
+	})
+}
@@ -6,7 +6,6 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/hashicorp/go-memdb"
 	"github.com/mitchellh/hashstructure/v2"
-	bolt "go.etcd.io/bbolt"
 
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 )
@@ -24,7 +23,7 @@ func (s *State) ConfigSourceSet(vs ...*pb.ConfigSource) error {
 	memTxn := s.inmem.Txn(true)
 	defer memTxn.Abort()
 
-	err := s.db.Update(func(dbTxn *bolt.Tx) error {
+	err := s.db.Update(func(dbTxn dbTx) error {
 		for _, v := range vs {
 			if err := s.configSourceSet(dbTxn, memTxn, v); err != nil {
 				return err
@@ -53,7 +52,7 @@ func (s *State) ConfigSourceGetWatch(req *pb.GetConfigSourceRequest, ws memdb.Wa
 	defer memTxn.Abort()
 
 	var result []*pb.ConfigSource
-	err := s.db.View(func(dbTxn *bolt.Tx) error {
+	err := s.db.View(func(dbTxn dbTx) error {
 		var err error
 		result, err = s.configSourceGetMerged(dbTxn, memTxn, ws, req)
 		return err
@@ -63,7 +62,7 @@ func (s *State) ConfigSourceGetWatch(req *pb.GetConfigSourceRequest, ws memdb.Wa
 }
 
 func (s *State) configSourceSet(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	value *pb.ConfigSource,
 ) error {
@@ -97,7 +96,7 @@ func (s *State) configSourceSet(
 }
 
 func (s *State) configSourceGetMerged(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	ws memdb.WatchSet,
 	req *pb.GetConfigSourceRequest,
@@ -117,7 +116,7 @@ func (s *State) configSourceGetMerged(
 // configSourceGetExact returns the list of config sources for a scope
 // exactly. By "exactly" we mean without any merging logic.
 func (s *State) configSourceGetExact(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	ws memdb.WatchSet,
 	ref interface{}, // should be one of the *pb.Ref_ values.
@@ -183,7 +182,7 @@ func (s *State) configSourceIndexSet(txn *memdb.Txn, id []byte, value *pb.Config
 }
 
 // configSourceIndexInit initializes the config index from persisted data.
-func (s *State) configSourceIndexInit(dbTxn *bolt.Tx, memTxn *memdb.Txn) error {
+func (s *State) configSourceIndexInit(dbTxn dbTx, memTxn *memdb.Txn) error {
 	bucket := dbTxn.Bucket(configSourceBucket)
 	return bucket.ForEach(func(k, v []byte) error {
 		var value pb.ConfigSource
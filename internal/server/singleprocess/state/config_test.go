@@ -147,6 +147,131 @@ func TestConfig(t *testing.T) {
 		}
 	})
 
+	t.Run("merging with workspace", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		project := &pb.Ref_Project{Project: "foo"}
+		app := &pb.Ref_Application{Project: "foo", Application: "bar"}
+		prod := &pb.Ref_Workspace{Workspace: "prod"}
+
+		require.NoError(s.ConfigSet(
+			&pb.ConfigVar{
+				Scope: &pb.ConfigVar_Project{Project: project},
+				Name:  "global",
+				Value: &pb.ConfigVar_Static{Static: "value"},
+			},
+			&pb.ConfigVar{
+				Scope: &pb.ConfigVar_Project{Project: project},
+				Name:  "DATABASE_URL",
+				Value: &pb.ConfigVar_Static{Static: "project"},
+			},
+			&pb.ConfigVar{
+				Scope: &pb.ConfigVar_Application{Application: app},
+				Name:  "DATABASE_URL",
+				Value: &pb.ConfigVar_Static{Static: "app"},
+			},
+			&pb.ConfigVar{
+				Scope:     &pb.ConfigVar_Project{Project: project},
+				Workspace: prod,
+				Name:      "DATABASE_URL",
+				Value:     &pb.ConfigVar_Static{Static: "project-prod"},
+			},
+			&pb.ConfigVar{
+				Scope: &pb.ConfigVar_Application{Application: &pb.Ref_Application{
+					Project:     "foo",
+					Application: "other",
+				}},
+				Workspace: prod,
+				Name:      "global",
+				Value:     &pb.ConfigVar_Static{Static: "other-prod"},
+			},
+		))
+
+		{
+			// Without a workspace, no workspace values are used.
+			vs, err := s.ConfigGet(&pb.ConfigGetRequest{
+				Scope: &pb.ConfigGetRequest_Application{Application: app},
+			})
+			require.NoError(err)
+			require.Len(vs, 2)
+			require.Equal("DATABASE_URL", vs[0].Name)
+			require.Equal("app", vs[0].Value.(*pb.ConfigVar_Static).Static)
+			require.Equal("global", vs[1].Name)
+			require.Equal("value", vs[1].Value.(*pb.ConfigVar_Static).Static)
+		}
+
+		{
+			// The project workspace value overrides the app value, and the
+			// values of other apps aren't used.
+			vs, err := s.ConfigGet(&pb.ConfigGetRequest{
+				Scope:     &pb.ConfigGetRequest_Application{Application: app},
+				Workspace: prod,
+			})
+			require.NoError(err)
+			require.Len(vs, 2)
+			require.Equal("DATABASE_URL", vs[0].Name)
+			require.Equal("project-prod", vs[0].Value.(*pb.ConfigVar_Static).Static)
+			require.Equal("global", vs[1].Name)
+			require.Equal("value", vs[1].Value.(*pb.ConfigVar_Static).Static)
+		}
+
+		// Set an app value in the workspace
+		require.NoError(s.ConfigSet(&pb.ConfigVar{
+			Scope:     &pb.ConfigVar_Application{Application: app},
+			Workspace: prod,
+			Name:      "DATABASE_URL",
+			Value:     &pb.ConfigVar_Static{Static: "app-prod"},
+		}))
+
+		{
+			vs, err := s.ConfigGet(&pb.ConfigGetRequest{
+				Scope:     &pb.ConfigGetRequest_Application{Application: app},
+				Workspace: prod,
+			})
+			require.NoError(err)
+			require.Len(vs, 2)
+			require.Equal("app-prod", vs[0].Value.(*pb.ConfigVar_Static).Static)
+		}
+
+		{
+			// Other workspaces only get the values without a workspace.
+			vs, err := s.ConfigGet(&pb.ConfigGetRequest{
+				Scope:     &pb.ConfigGetRequest_Application{Application: app},
+				Workspace: &pb.Ref_Workspace{Workspace: "staging"},
+			})
+			require.NoError(err)
+			require.Len(vs, 2)
+			require.Equal("app", vs[0].Value.(*pb.ConfigVar_Static).Static)
+		}
+
+		{
+			// Deleting the workspace value leaves the others. Project scope
+			// returns every value in the project without merging.
+			require.NoError(s.ConfigSet(&pb.ConfigVar{
+				Scope:     &pb.ConfigVar_Project{Project: project},
+				Workspace: prod,
+				Name:      "DATABASE_URL",
+				Value:     &pb.ConfigVar_Unset{Unset: &empty.Empty{}},
+			}))
+
+			vs, err := s.ConfigGet(&pb.ConfigGetRequest{
+				Scope:     &pb.ConfigGetRequest_Project{Project: project},
+				Workspace: prod,
+			})
+			require.NoError(err)
+			require.Len(vs, 5)
+
+			vs, err = s.ConfigGet(&pb.ConfigGetRequest{
+				Scope: &pb.ConfigGetRequest_Project{Project: project},
+			})
+			require.NoError(err)
+			require.Len(vs, 3)
+		}
+	})
+
 	t.Run("delete", func(t *testing.T) {
 		require := require.New(t)
 
@@ -264,6 +389,39 @@ func TestConfig(t *testing.T) {
 		}
 	})
 
+	t.Run("unset a variable that was never set", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		require.NoError(s.ConfigSet(&pb.ConfigVar{
+			Scope: &pb.ConfigVar_Project{
+				Project: &pb.Ref_Project{
+					Project: "foo",
+				},
+			},
+
+			Name: "foo",
+			Value: &pb.ConfigVar_Unset{
+				Unset: &empty.Empty{},
+			},
+		}))
+
+		// Should not exist
+		{
+			vs, err := s.ConfigGet(&pb.ConfigGetRequest{
+				Scope: &pb.ConfigGetRequest_Project{
+					Project: &pb.Ref_Project{Project: "foo"},
+				},
+
+				Prefix: "foo",
+			})
+			require.NoError(err)
+			require.Len(vs, 0)
+		}
+	})
+
 	t.Run("delete with empty static value", func(t *testing.T) {
 		require := require.New(t)
 
@@ -530,6 +688,72 @@ func TestConfig(t *testing.T) {
 		require.Error(err)
 		require.Equal(codes.FailedPrecondition, status.Code(err))
 	})
+
+	t.Run("runner config files", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		scope := &pb.ConfigVar_Runner{
+			Runner: &pb.Ref_Runner{
+				Target: &pb.Ref_Runner_Any{
+					Any: &pb.Ref_RunnerAny{},
+				},
+			},
+		}
+
+		// Relative paths aren't allowed
+		err := s.ConfigSet(&pb.ConfigVar{
+			Scope:      scope,
+			Name:       "creds.json",
+			NameIsPath: true,
+			Value:      &pb.ConfigVar_Static{Static: "{}"},
+		})
+		require.Error(err)
+		require.Equal(codes.FailedPrecondition, status.Code(err))
+
+		// Absolute paths are
+		require.NoError(s.ConfigSet(&pb.ConfigVar{
+			Scope:      scope,
+			Name:       "/etc/creds.json",
+			NameIsPath: true,
+			Value:      &pb.ConfigVar_Static{Static: "{}"},
+		}))
+
+		vs, err := s.ConfigGet(&pb.ConfigGetRequest{
+			Scope: &pb.ConfigGetRequest_Runner{
+				Runner: &pb.Ref_RunnerId{Id: "R_A"},
+			},
+		})
+		require.NoError(err)
+		require.Len(vs, 1)
+		require.True(vs[0].NameIsPath)
+	})
+
+	t.Run("runner workspace config not allowed", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		err := s.ConfigSet(&pb.ConfigVar{
+			Scope: &pb.ConfigVar_Runner{
+				Runner: &pb.Ref_Runner{
+					Target: &pb.Ref_Runner_Any{
+						Any: &pb.Ref_RunnerAny{},
+					},
+				},
+			},
+			Workspace: &pb.Ref_Workspace{Workspace: "prod"},
+
+			Name:  "foo",
+			Value: &pb.ConfigVar_Static{Static: "bar"},
+		})
+
+		require.Error(err)
+		require.Equal(codes.FailedPrecondition, status.Code(err))
+	})
 }
 
 func TestConfigWatch(t *testing.T) {
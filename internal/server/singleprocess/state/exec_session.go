@@ -0,0 +1,158 @@
+package state
+
+import (
+	"encoding/binary"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/go-memdb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+var execSessionBucket = []byte("exec_sessions")
+
+// execSessionMax is the maximum number of exec sessions that are kept.
+// When more sessions are recorded, the oldest sessions are deleted.
+var execSessionMax = 1000
+
+func init() {
+	dbBuckets = append(dbBuckets, execSessionBucket)
+	dbIndexers = append(dbIndexers, (*State).execSessionIndexInit)
+}
+
+// ExecSessionPut records an exec session. If the session has no ID, it is
+// a new session and its ID is set to a new ULID. Otherwise the existing
+// session is updated, i.e. when it ends. Like audit events, sessions are
+// stored by a sequence number so that they keep the order they started in.
+func (s *State) ExecSessionPut(e *pb.ExecSession) error {
+	create := e.Id == ""
+	if create {
+		id, err := ulid()
+		if err != nil {
+			return err
+		}
+		e.Id = id
+	}
+
+	// The count is only modified in write transactions, which bolt
+	// serializes, so it doesn't need its own lock.
+	return s.db.Update(func(dbTxn dbTx) error {
+		b := dbTxn.Bucket(execSessionBucket)
+		if !create {
+			// Sessions are updated when they end, so the session is
+			// usually one of the newest. If the session was pruned while
+			// it was running, we don't resurrect it.
+			key, err := execSessionKey(b, e.Id)
+			if err != nil {
+				return err
+			}
+
+			return dbPut(b, key, e)
+		}
+
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, seq)
+		if err := dbPut(b, key, e); err != nil {
+			return err
+		}
+		count := s.execSessions + 1
+
+		// Delete the oldest sessions to stay within our maximum.
+		c := b.Cursor()
+		for count > execSessionMax {
+			if k, _ := c.First(); k == nil {
+				break
+			}
+			if err := c.Delete(); err != nil {
+				return err
+			}
+			count--
+		}
+
+		s.execSessions = count
+		return nil
+	})
+}
+
+// ExecSessionGet returns the exec session with the given ID.
+func (s *State) ExecSessionGet(id string) (*pb.ExecSession, error) {
+	var result pb.ExecSession
+	err := s.db.View(func(dbTxn dbTx) error {
+		b := dbTxn.Bucket(execSessionBucket)
+		key, err := execSessionKey(b, id)
+		if err != nil {
+			return err
+		}
+
+		return dbGet(b, key, &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ExecSessionList returns the exec sessions newest first for which the
+// filter returns true, up to the limit. If filter is nil, every session
+// matches. If limit is zero or less, every matching session is returned.
+// The transcripts of the sessions are omitted.
+func (s *State) ExecSessionList(filter func(*pb.ExecSession) bool, limit int) ([]*pb.ExecSession, error) {
+	var result []*pb.ExecSession
+	err := s.db.View(func(dbTxn dbTx) error {
+		c := dbTxn.Bucket(execSessionBucket).Cursor()
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			var e pb.ExecSession
+			if err := proto.Unmarshal(v, &e); err != nil {
+				return err
+			}
+
+			if filter != nil && !filter(&e) {
+				continue
+			}
+
+			e.Transcript = nil
+			result = append(result, &e)
+			if limit > 0 && len(result) >= limit {
+				return nil
+			}
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// execSessionKey returns the key of the session with the given ID. This
+// searches the sessions newest first, which is fine since the number of
+// sessions is bounded by execSessionMax.
+func execSessionKey(b dbBucket, id string) ([]byte, error) {
+	c := b.Cursor()
+	for k, v := c.Last(); k != nil; k, v = c.Prev() {
+		var e pb.ExecSession
+		if err := proto.Unmarshal(v, &e); err != nil {
+			return nil, err
+		}
+
+		if e.Id == id {
+			return k, nil
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, "exec session not found: %s", id)
+}
+
+// execSessionIndexInit counts the persisted exec sessions. Exec sessions
+// aren't indexed in memory since they're only listed in order.
+func (s *State) execSessionIndexInit(dbTxn dbTx, memTxn *memdb.Txn) error {
+	s.execSessions = dbTxn.Bucket(execSessionBucket).KeyN()
+	return nil
+}
@@ -0,0 +1,98 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestExecSession(t *testing.T) {
+	t.Run("Put, Get and List", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		var sessions []*pb.ExecSession
+		for _, instance := range []string{"A", "B", "A"} {
+			e := &pb.ExecSession{
+				InstanceId: instance,
+				Transcript: []*pb.ExecSession_TranscriptEntry{
+					{Data: []byte("hello")},
+				},
+			}
+			require.NoError(s.ExecSessionPut(e))
+			require.NotEmpty(e.Id)
+			sessions = append(sessions, e)
+		}
+
+		// Update
+		sessions[0].ExitCode = 2
+		require.NoError(s.ExecSessionPut(sessions[0]))
+
+		// Get includes the transcript
+		e, err := s.ExecSessionGet(sessions[0].Id)
+		require.NoError(err)
+		require.Equal(int32(2), e.ExitCode)
+		require.Len(e.Transcript, 1)
+
+		// Newest first without transcripts
+		result, err := s.ExecSessionList(nil, 0)
+		require.NoError(err)
+		require.Len(result, 3)
+		require.Equal(sessions[2].Id, result[0].Id)
+		require.Equal(sessions[1].Id, result[1].Id)
+		require.Equal(sessions[0].Id, result[2].Id)
+		require.Empty(result[0].Transcript)
+
+		// Limit
+		result, err = s.ExecSessionList(nil, 1)
+		require.NoError(err)
+		require.Len(result, 1)
+
+		// Filter
+		result, err = s.ExecSessionList(func(e *pb.ExecSession) bool {
+			return e.InstanceId == "A"
+		}, 0)
+		require.NoError(err)
+		require.Len(result, 2)
+
+		// Not found
+		_, err = s.ExecSessionGet("nope")
+		require.Error(err)
+		require.Equal(codes.NotFound, status.Code(err))
+	})
+
+	t.Run("prunes the oldest sessions", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		old := execSessionMax
+		defer func() { execSessionMax = old }()
+		execSessionMax = 2
+
+		var sessions []*pb.ExecSession
+		for _, instance := range []string{"A", "B", "C"} {
+			e := &pb.ExecSession{InstanceId: instance}
+			require.NoError(s.ExecSessionPut(e))
+			sessions = append(sessions, e)
+		}
+
+		result, err := s.ExecSessionList(nil, 0)
+		require.NoError(err)
+		require.Len(result, 2)
+		require.Equal("C", result[0].InstanceId)
+		require.Equal("B", result[1].InstanceId)
+
+		// Pruned sessions aren't recreated when they end
+		err = s.ExecSessionPut(sessions[0])
+		require.Error(err)
+		require.Equal(codes.NotFound, status.Code(err))
+	})
+}
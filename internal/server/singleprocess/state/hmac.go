@@ -7,7 +7,6 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hashicorp/go-memdb"
-	bolt "go.etcd.io/bbolt"
 
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 )
@@ -33,7 +32,7 @@ func (s *State) HMACKeyCreateIfNotExist(id string, size int) (*pb.HMACKey, error
 	defer memTxn.Abort()
 
 	var result *pb.HMACKey
-	err := s.db.Update(func(dbTxn *bolt.Tx) error {
+	err := s.db.Update(func(dbTxn dbTx) error {
 		var err error
 
 		// If we have this key already, then return that.
@@ -57,7 +56,7 @@ func (s *State) HMACKeyCreateIfNotExist(id string, size int) (*pb.HMACKey, error
 }
 
 func (s *State) hmacKeyCreate(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	id string,
 	size int,
@@ -92,7 +91,7 @@ func (s *State) HMACKeyGet(id string) (*pb.HMACKey, error) {
 }
 
 func (s *State) hmacKeyGet(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	id string,
 ) (*pb.HMACKey, error) {
@@ -129,7 +128,7 @@ func (s *State) hmacKeyIndexSet(txn *memdb.Txn, value *pb.HMACKey) error {
 }
 
 // hmacKeyIndexInit initializes the hmacKey index from persisted data.
-func (s *State) hmacKeyIndexInit(dbTxn *bolt.Tx, memTxn *memdb.Txn) error {
+func (s *State) hmacKeyIndexInit(dbTxn dbTx, memTxn *memdb.Txn) error {
 	// Reset that we're empty. In practice this doesn't happen but
 	// for tests we might load the state store multiple times in-process.
 	atomic.StoreUint32(&s.hmacKeyNotEmpty, 0)
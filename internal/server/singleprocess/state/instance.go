@@ -101,6 +101,10 @@ type Instance struct {
 	Workspace    string
 	LogBuffer    *logbuffer.Buffer
 	Type         pb.Instance_Type
+
+	// HealthChecks are the latest results of the health checks that the
+	// entrypoint of the instance runs.
+	HealthChecks []*pb.HealthCheckResult
 }
 
 func (i *Instance) Proto() *pb.Instance {
@@ -148,6 +152,32 @@ func (s *State) InstanceDelete(id string) error {
 	return nil
 }
 
+// InstanceHealthChecksSet sets the latest health check results of the
+// instance with the given ID.
+func (s *State) InstanceHealthChecksSet(id string, results []*pb.HealthCheckResult) error {
+	txn := s.inmem.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(instanceTableName, instanceIdIndexName, id)
+	if err != nil {
+		return status.Errorf(codes.Aborted, err.Error())
+	}
+	if raw == nil {
+		return status.Errorf(codes.NotFound, "instance ID not found")
+	}
+
+	// Readers use the records without a lock, so we insert a copy.
+	rec := *raw.(*Instance)
+	rec.HealthChecks = results
+	if err := txn.Insert(instanceTableName, &rec); err != nil {
+		return status.Errorf(codes.Aborted, err.Error())
+	}
+
+	txn.Commit()
+
+	return nil
+}
+
 func (s *State) InstanceById(id string) (*Instance, error) {
 	txn := s.inmem.Txn(false)
 	raw, err := txn.First(instanceTableName, instanceIdIndexName, id)
@@ -162,6 +192,25 @@ func (s *State) InstanceById(id string) (*Instance, error) {
 	return raw.(*Instance), nil
 }
 
+// InstanceCount returns the number of instances, which is the number of
+// entrypoints that are connected to the server.
+func (s *State) InstanceCount() (int, error) {
+	txn := s.inmem.Txn(false)
+	defer txn.Abort()
+
+	iter, err := txn.Get(instanceTableName, instanceIdIndexName+"_prefix", "")
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	for iter.Next() != nil {
+		count++
+	}
+
+	return count, nil
+}
+
 // instanceByIdWaiting waits for an instance with +id+ to connect before returning
 // itself record.
 func (s *State) instanceByIdWaiting(ctx context.Context, id string) (*Instance, error) {
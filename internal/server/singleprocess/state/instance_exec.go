@@ -54,8 +54,10 @@ type InstanceExec struct {
 	Id         int64
 	InstanceId string
 
-	Args []string
-	Pty  *pb.ExecStreamRequest_PTY
+	Args        []string
+	Pty         *pb.ExecStreamRequest_PTY
+	PortForward *pb.ExecStreamRequest_PortForward
+	FileCopy    *pb.ExecStreamRequest_FileCopy
 
 	ClientEventCh     <-chan *pb.ExecStreamRequest
 	EntrypointEventCh chan<- *pb.EntrypointExecRequest
@@ -35,6 +35,47 @@ func TestInstance_crud(t *testing.T) {
 	require.NoError(s.InstanceDelete(rec.Id))
 }
 
+func TestInstanceCount(t *testing.T) {
+	require := require.New(t)
+
+	s := TestState(t)
+	defer s.Close()
+
+	count, err := s.InstanceCount()
+	require.NoError(err)
+	require.Equal(0, count)
+
+	require.NoError(s.InstanceCreate(testInstance(t, &Instance{Id: "A"})))
+	require.NoError(s.InstanceCreate(testInstance(t, &Instance{Id: "B"})))
+
+	count, err = s.InstanceCount()
+	require.NoError(err)
+	require.Equal(2, count)
+}
+
+func TestInstanceHealthChecksSet(t *testing.T) {
+	require := require.New(t)
+
+	s := TestState(t)
+	defer s.Close()
+
+	// Unknown instances are an error
+	err := s.InstanceHealthChecksSet("nope", nil)
+	require.Error(err)
+	require.Equal(codes.NotFound, status.Code(err))
+
+	rec := testInstance(t, nil)
+	require.NoError(s.InstanceCreate(rec))
+
+	results := []*pb.HealthCheckResult{{Name: "web", HealthStatus: "READY"}}
+	require.NoError(s.InstanceHealthChecksSet(rec.Id, results))
+
+	found, err := s.InstanceById(rec.Id)
+	require.NoError(err)
+	require.Equal(results, found.HealthChecks)
+	require.Nil(rec.HealthChecks)
+}
+
 func TestInstanceById_notFound(t *testing.T) {
 	require := require.New(t)
 
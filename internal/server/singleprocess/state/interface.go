@@ -0,0 +1,229 @@
+package state
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// Interface is the API that the singleprocess server uses to read and
+// mutate state. State is the BoltDB implementation. Other storage backends
+// must implement this with the same semantics, including the errors
+// returned (codes.NotFound, codes.AlreadyExists, etc.) and closing the
+// channels added to memdb.WatchSet arguments when the watched data changes.
+type Interface interface {
+	Close() error
+	DBSize() (int64, error)
+	LockLost() <-chan struct{}
+	Prune() error
+	PruneRetention(p *RetentionPolicy, dryRun bool) (map[string]int, error)
+
+	// Snapshots
+	CreateSnapshot(w io.Writer) error
+	StageRestoreSnapshot(r io.Reader) error
+
+	// Server
+	ServerConfigSet(c *pb.ServerConfig) error
+	ServerConfigGet() (*pb.ServerConfig, error)
+	ServerIdSet(id string) error
+	ServerIdGet() (string, error)
+	ServerURLTokenSet(token string) error
+	ServerURLTokenGet() (string, error)
+	HMACKeyEmpty() bool
+	HMACKeyCreateIfNotExist(id string, size int) (*pb.HMACKey, error)
+	HMACKeyGet(id string) (*pb.HMACKey, error)
+
+	// Auth
+	AuthMethodPut(v *pb.AuthMethod) error
+	AuthMethodGet(ref *pb.Ref_AuthMethod) (*pb.AuthMethod, error)
+	AuthMethodDelete(ref *pb.Ref_AuthMethod) error
+	AuthMethodList() ([]*pb.AuthMethod, error)
+	UserPut(user *pb.User) error
+	UserGet(ref *pb.Ref_User) (*pb.User, error)
+	UserDelete(ref *pb.Ref_User) error
+	UserList() ([]*pb.User, error)
+	UserEmpty() (bool, error)
+	UserGetOIDC(iss, sub string) (*pb.User, error)
+	UserGetPAT(apiURL, id string) (*pb.User, error)
+	UserGetEmail(email string) (*pb.User, error)
+	ServiceAccountCreate(v *pb.ServiceAccount) error
+	ServiceAccountGet(ref *pb.Ref_ServiceAccount) (*pb.ServiceAccount, error)
+	ServiceAccountGetId(id string) (*pb.ServiceAccount, error)
+	ServiceAccountList() ([]*pb.ServiceAccount, error)
+	ServiceAccountDelete(ref *pb.Ref_ServiceAccount) error
+	TokenRevocationPut(r *pb.TokenRevocation) error
+	TokenRevoked(t *pb.Token) (bool, error)
+	AuditEventPut(e *pb.AuditEvent) error
+	AuditEventList(filter func(*pb.AuditEvent) bool, limit int) ([]*pb.AuditEvent, error)
+	ExecSessionPut(e *pb.ExecSession) error
+	ExecSessionGet(id string) (*pb.ExecSession, error)
+	ExecSessionList(filter func(*pb.ExecSession) bool, limit int) ([]*pb.ExecSession, error)
+
+	// Projects, applications, and workspaces
+	ProjectPut(p *pb.Project) error
+	ProjectGet(ref *pb.Ref_Project) (*pb.Project, error)
+	ProjectDelete(ref *pb.Ref_Project) error
+	ProjectList() ([]*pb.Ref_Project, error)
+	ProjectListPage(p *Page, labels map[string]string) ([]*pb.Ref_Project, error)
+	ProjectListWatch(ws memdb.WatchSet) ([]*pb.Ref_Project, error)
+	ProjectListWorkspaces(ref *pb.Ref_Project) ([]*pb.Workspace_Project, error)
+	ProjectPollPeek(ws memdb.WatchSet) (*pb.Project, time.Time, error)
+	ProjectPollComplete(p *pb.Project, t time.Time) error
+	ProjectUpdateDataRef(ref *pb.Ref_Project, ws *pb.Ref_Workspace, dataRef *pb.Job_DataSource_Ref) error
+	ProjectTemplatePut(v *pb.ProjectTemplate) error
+	ProjectTemplateGet(ref *pb.Ref_ProjectTemplate) (*pb.ProjectTemplate, error)
+	ProjectTemplateDelete(ref *pb.Ref_ProjectTemplate) error
+	ProjectTemplateList() ([]*pb.ProjectTemplate, error)
+
+	// On-demand runner configurations
+	OnDemandRunnerConfigPut(v *pb.OnDemandRunnerConfig) error
+	OnDemandRunnerConfigGet(ref *pb.Ref_OnDemandRunnerConfig) (*pb.OnDemandRunnerConfig, error)
+	OnDemandRunnerConfigDelete(ref *pb.Ref_OnDemandRunnerConfig) error
+	OnDemandRunnerConfigList() ([]*pb.OnDemandRunnerConfig, error)
+
+	AppPut(app *pb.Application) (*pb.Application, error)
+	AppDelete(ref *pb.Ref_Application) error
+	AppGet(ref *pb.Ref_Application) (*pb.Application, error)
+	ApplicationPollPeek(ws memdb.WatchSet) (*pb.Project, time.Time, error)
+	ApplicationPollComplete(project *pb.Project, t time.Time) error
+	GetFileChangeSignal(scope *pb.Ref_Application) (string, error)
+	WorkspaceList() ([]*pb.Workspace, error)
+	WorkspaceListByProject(ref *pb.Ref_Project) ([]*pb.Workspace, error)
+	WorkspaceListByApp(ref *pb.Ref_Application) ([]*pb.Workspace, error)
+	WorkspaceGet(n string) (*pb.Workspace, error)
+	WorkspacePut(ws *pb.Workspace) error
+	WorkspaceDelete(n string) error
+
+	// Pipelines
+	PipelinePut(p *pb.Pipeline) error
+	PipelineGet(ref *pb.Ref_Project, name string) (*pb.Pipeline, error)
+	PipelineList(ref *pb.Ref_Project) ([]*pb.Pipeline, error)
+	PipelineRunCreate(r *pb.PipelineRun) error
+	PipelineRunUpdate(r *pb.PipelineRun) error
+	PipelineRunGet(id string) (*pb.PipelineRun, error)
+	PipelineRunList(ref *pb.Ref_Project, name string, limit int) ([]*pb.PipelineRun, error)
+	PipelineRunListActive(ws memdb.WatchSet) ([]*pb.PipelineRun, error)
+
+	// Job Schedules
+	JobSchedulePut(v *pb.JobSchedule) error
+	JobScheduleGet(ref *pb.Ref_Project, name string) (*pb.JobSchedule, error)
+	JobScheduleList(ref *pb.Ref_Project) ([]*pb.JobSchedule, error)
+	JobScheduleDelete(ref *pb.Ref_Project, name string) error
+	JobSchedulePeek(ws memdb.WatchSet) (*pb.JobSchedule, time.Time, error)
+
+	// Notifiers
+	NotifierPut(v *pb.Notifier) error
+	NotifierGet(ref *pb.Ref_Project, name string) (*pb.Notifier, error)
+	NotifierList(ref *pb.Ref_Project) ([]*pb.Notifier, error)
+	NotifierDelete(ref *pb.Ref_Project, name string) error
+
+	// Previews
+	PreviewPut(v *pb.Preview) error
+	PreviewGet(ref *pb.Ref_Project, number uint64) (*pb.Preview, error)
+	PreviewList(ref *pb.Ref_Project) ([]*pb.Preview, error)
+	PreviewListActive(ws memdb.WatchSet) ([]*pb.Preview, error)
+
+	// Operations
+	ArtifactPut(update bool, b *pb.PushedArtifact) error
+	ArtifactGet(ref *pb.Ref_Operation) (*pb.PushedArtifact, error)
+	ArtifactList(ref *pb.Ref_Application, opts ...ListOperationOption) ([]*pb.PushedArtifact, error)
+	ArtifactLatest(ref *pb.Ref_Application, ws *pb.Ref_Workspace) (*pb.PushedArtifact, error)
+	BuildPut(update bool, b *pb.Build) error
+	BuildGet(ref *pb.Ref_Operation) (*pb.Build, error)
+	BuildList(ref *pb.Ref_Application, opts ...ListOperationOption) ([]*pb.Build, error)
+	BuildLatest(ref *pb.Ref_Application, ws *pb.Ref_Workspace) (*pb.Build, error)
+	BuildCachePut(e *pb.BuildCacheEntry, r io.Reader) error
+	BuildCacheGet(ref *pb.Ref_Application, key string) (*pb.BuildCacheEntry, error)
+	BuildCacheRead(digest string, w io.Writer) error
+	DeploymentPut(update bool, b *pb.Deployment) error
+	DeploymentGet(ref *pb.Ref_Operation) (*pb.Deployment, error)
+	DeploymentList(ref *pb.Ref_Application, opts ...ListOperationOption) ([]*pb.Deployment, error)
+	DeploymentLatest(ref *pb.Ref_Application, ws *pb.Ref_Workspace) (*pb.Deployment, error)
+	ReleasePut(update bool, b *pb.Release) error
+	ReleaseGet(ref *pb.Ref_Operation) (*pb.Release, error)
+	ReleaseList(ref *pb.Ref_Application, opts ...ListOperationOption) ([]*pb.Release, error)
+	ReleaseLatest(ref *pb.Ref_Application, ws *pb.Ref_Workspace) (*pb.Release, error)
+	StatusReportPut(update bool, report *pb.StatusReport) error
+	StatusReportGet(ref *pb.Ref_Operation) (*pb.StatusReport, error)
+	StatusReportList(ref *pb.Ref_Application, opts ...ListOperationOption) ([]*pb.StatusReport, error)
+	StatusReportLatest(ref *pb.Ref_Application, ws *pb.Ref_Workspace) (*pb.StatusReport, error)
+
+	// App events
+	AppEventPut(e *pb.AppEvent) error
+	AppEventList(ref *pb.Ref_Application, ws *pb.Ref_Workspace, types []pb.AppEvent_Type, p *Page) ([]*pb.AppEvent, error)
+
+	// Config
+	ConfigSet(vs ...*pb.ConfigVar) error
+	ConfigSetBy(user *pb.User, vs ...*pb.ConfigVar) error
+	ConfigGet(req *pb.ConfigGetRequest) ([]*pb.ConfigVar, error)
+	ConfigGetWatch(req *pb.ConfigGetRequest, ws memdb.WatchSet) ([]*pb.ConfigVar, error)
+	ConfigHistory(v *pb.ConfigVar) ([]*pb.ConfigVarVersion, error)
+	ConfigRollback(user *pb.User, v *pb.ConfigVar, version uint64) (*pb.ConfigVarVersion, error)
+	ConfigSourceSet(vs ...*pb.ConfigSource) error
+	ConfigSourceGet(req *pb.GetConfigSourceRequest) ([]*pb.ConfigSource, error)
+	ConfigSourceGetWatch(req *pb.GetConfigSourceRequest, ws memdb.WatchSet) ([]*pb.ConfigSource, error)
+	ConfigEncryptionEnable(ctx context.Context, wrapper KeyWrapper, previous ...KeyWrapper) (int, error)
+	ConfigEncryptionRotate(ctx context.Context) (string, int, error)
+
+	// Instances
+	InstanceCreate(rec *Instance) error
+	InstanceDelete(id string) error
+	InstanceById(id string) (*Instance, error)
+	InstanceCount() (int, error)
+	InstancesByDeployment(id string, ws memdb.WatchSet) ([]*Instance, error)
+	InstanceHealthChecksSet(id string, results []*pb.HealthCheckResult) error
+	InstancesByApp(ref *pb.Ref_Application, refws *pb.Ref_Workspace, ws memdb.WatchSet) ([]*Instance, error)
+	InstanceExecCreateByTargetedInstance(id string, exec *InstanceExec) error
+	InstanceExecCreateForVirtualInstance(ctx context.Context, id string, exec *InstanceExec) error
+	CalculateInstanceExecByDeployment(did string) (*Instance, error)
+	InstanceExecCreateByDeployment(did string, exec *InstanceExec) error
+	InstanceExecDelete(id int64) error
+	InstanceExecById(id int64) (*InstanceExec, error)
+	InstanceExecListByInstanceId(id string, ws memdb.WatchSet) ([]*InstanceExec, error)
+	InstanceLogsCreate(id string, logs *InstanceLogs) error
+	InstanceLogsDelete(id int64) error
+	InstanceLogsById(id int64) (*InstanceLogs, error)
+	InstanceLogsByInstanceId(id string) (*InstanceLogs, error)
+	InstanceLogsListByInstanceId(id string, ws memdb.WatchSet) ([]*InstanceLogs, error)
+
+	// Plugins
+	PluginBinaryPut(v *pb.PluginBinary, r io.Reader) error
+	PluginBinaryGet(name, version, os, arch string) (*pb.PluginBinary, error)
+	PluginBinaryList(req *pb.ListPluginsRequest) ([]*pb.PluginBinary, error)
+	PluginBinaryRead(checksum string, w io.Writer) error
+	PluginBinaryDelete(name, version, os, arch string) error
+
+	// Jobs and runners
+	JobCreate(jobs ...*pb.Job) error
+	JobList() ([]*pb.Job, error)
+	JobListWatch(ws memdb.WatchSet) ([]*pb.Job, error)
+	JobCountByState(state pb.Job_State) (int, error)
+	JobById(id string, ws memdb.WatchSet) (*Job, error)
+	JobAssignForRunner(ctx context.Context, r *pb.Runner) (*Job, error)
+	JobAck(id string, ack bool) (*Job, error)
+	JobUpdateRef(id string, ref *pb.Job_DataSource_Ref) error
+	JobUpdateOnDemandRunner(id string, v *pb.Job_OnDemandRunner) error
+	JobListOnDemandRunner(ws memdb.WatchSet) ([]*pb.Job, error)
+	JobIdsByRunner(id string) ([]string, error)
+	JobComplete(id string, result *pb.Job_Result, cerr error) error
+	JobCancel(id string, force bool) error
+	JobHeartbeat(id string) error
+	JobExpire(id string) error
+	JobTimeout(id string) error
+	JobIsAssignable(ctx context.Context, jobpb *pb.Job) (bool, error)
+	JobLogAppend(id string, events []*pb.GetJobStreamResponse_Terminal_Event) error
+	JobLogList(id string) ([]*pb.GetJobStreamResponse_Terminal_Event, error)
+	RunnerCreate(r *pb.Runner) error
+	RunnerDelete(id string) error
+	RunnerById(id string, ws memdb.WatchSet) (*pb.Runner, error)
+	RunnerHeartbeat(id string) error
+	RunnerAdopt(id string) error
+	RunnerReject(id string) error
+	RunnerList() ([]*pb.Runner, error)
+}
+
+var _ Interface = (*State)(nil)
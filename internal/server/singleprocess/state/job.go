@@ -11,12 +11,12 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/hashicorp/go-memdb"
-	bolt "go.etcd.io/bbolt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 	"github.com/hashicorp/waypoint/internal/server/logbuffer"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
 )
 
 var (
@@ -27,12 +27,16 @@ var (
 )
 
 const (
-	jobTableName            = "jobs"
-	jobIdIndexName          = "id"
-	jobStateIndexName       = "state"
-	jobQueueTimeIndexName   = "queue-time"
-	jobTargetIdIndexName    = "target-id"
-	jobSingletonIdIndexName = "singleton-id"
+	jobTableName               = "jobs"
+	jobIdIndexName             = "id"
+	jobStateIndexName          = "state"
+	jobQueueTimeIndexName      = "queue-time"
+	jobTargetIdIndexName       = "target-id"
+	jobSingletonIdIndexName    = "singleton-id"
+	jobPriorityIndexName       = "priority"
+	jobProjectIndexName        = "project-state"
+	jobRunnerIndexName         = "runner-state"
+	jobOnDemandRunnerIndexName = "ondemand-runner"
 
 	maximumJobsIndexed = 10000
 )
@@ -98,6 +102,10 @@ func jobSchema() *memdb.TableSchema {
 							Lowercase: true,
 						},
 
+						&memdb.UintFieldIndex{
+							Field: "PriorityOrder",
+						},
+
 						&IndexTime{
 							Field: "QueueTime",
 							Asc:   true,
@@ -106,6 +114,73 @@ func jobSchema() *memdb.TableSchema {
 				},
 			},
 
+			jobPriorityIndexName: {
+				Name:         jobPriorityIndexName,
+				AllowMissing: true,
+				Unique:       false,
+				Indexer: &memdb.CompoundIndex{
+					Indexes: []memdb.Indexer{
+						&memdb.IntFieldIndex{
+							Field: "State",
+						},
+
+						&memdb.UintFieldIndex{
+							Field: "PriorityOrder",
+						},
+
+						&IndexTime{
+							Field: "QueueTime",
+							Asc:   true,
+						},
+					},
+				},
+			},
+
+			jobProjectIndexName: {
+				Name:         jobProjectIndexName,
+				AllowMissing: true,
+				Unique:       false,
+				Indexer: &memdb.CompoundIndex{
+					Indexes: []memdb.Indexer{
+						&memdb.StringFieldIndex{
+							Field:     "Project",
+							Lowercase: true,
+						},
+
+						&memdb.IntFieldIndex{
+							Field: "State",
+						},
+					},
+				},
+			},
+
+			jobRunnerIndexName: {
+				Name:         jobRunnerIndexName,
+				AllowMissing: true,
+				Unique:       false,
+				Indexer: &memdb.CompoundIndex{
+					Indexes: []memdb.Indexer{
+						&memdb.StringFieldIndex{
+							Field:     "AssignedRunnerId",
+							Lowercase: true,
+						},
+
+						&memdb.IntFieldIndex{
+							Field: "State",
+						},
+					},
+				},
+			},
+
+			jobOnDemandRunnerIndexName: {
+				Name:         jobOnDemandRunnerIndexName,
+				AllowMissing: true,
+				Unique:       false,
+				Indexer: &memdb.BoolFieldIndex{
+					Field: "OnDemandRunnerActive",
+				},
+			},
+
 			jobSingletonIdIndexName: {
 				Name:         jobSingletonIdIndexName,
 				AllowMissing: true,
@@ -141,6 +216,14 @@ type jobIndex struct {
 	Application *pb.Ref_Application
 	Workspace   *pb.Ref_Workspace
 
+	// Project is the project of Application. This is used to enforce the
+	// concurrency limit of the project.
+	Project string
+
+	// PriorityOrder is the order that the job is assigned in based on its
+	// priority. Lower values are assigned first.
+	PriorityOrder uint
+
 	// QueueTime is the time that the job was queued.
 	QueueTime time.Time
 
@@ -150,6 +233,18 @@ type jobIndex struct {
 	// TargetRunnerId is the ID of the runner to target.
 	TargetRunnerId string
 
+	// TargetRunnerLabels are the labels of the runners to target. This is
+	// nil unless the job targets runners by label.
+	TargetRunnerLabels map[string]string
+
+	// AssignedRunnerId is the ID of the runner the job is assigned to.
+	// This is used to enforce the concurrency limit of the runner.
+	AssignedRunnerId string
+
+	// OnDemandRunnerActive is true if the server launched a runner for
+	// this job and hasn't stopped it yet.
+	OnDemandRunnerActive bool
+
 	// State is the current state of this job.
 	State pb.Job_State
 
@@ -161,6 +256,12 @@ type jobIndex struct {
 	OutputBuffer *logbuffer.Buffer
 }
 
+// jobOnDemandRunnerActive returns true if the server launched a runner
+// for the job that it hasn't stopped yet.
+func jobOnDemandRunnerActive(jobpb *pb.Job) bool {
+	return jobpb.OnDemandRunner != nil && !jobpb.OnDemandRunner.Complete
+}
+
 // A helper, pulled out rather than on a value to allow it to be used against
 // pb.Job,s and jobIndex's alike.
 func jobIsCompleted(state pb.Job_State) bool {
@@ -195,7 +296,7 @@ func (s *State) JobCreate(jobs ...*pb.Job) error {
 	txn := s.inmem.Txn(true)
 	defer txn.Abort()
 
-	err := s.db.Update(func(dbTxn *bolt.Tx) error {
+	err := s.db.Update(func(dbTxn dbTx) error {
 		// Go through each job one at a time. If any fail, the transaction
 		// is aborted so the whole thing will fail.
 		for _, job := range jobs {
@@ -215,6 +316,12 @@ func (s *State) JobCreate(jobs ...*pb.Job) error {
 
 // JobList returns the list of jobs.
 func (s *State) JobList() ([]*pb.Job, error) {
+	return s.JobListWatch(nil)
+}
+
+// JobListWatch is the same as JobList but if ws is non-nil, it is
+// notified when any job is created or changes state.
+func (s *State) JobListWatch(ws memdb.WatchSet) ([]*pb.Job, error) {
 	memTxn := s.inmem.Txn(false)
 	defer memTxn.Abort()
 
@@ -222,6 +329,9 @@ func (s *State) JobList() ([]*pb.Job, error) {
 	if err != nil {
 		return nil, err
 	}
+	if ws != nil {
+		ws.Add(iter.WatchCh())
+	}
 
 	var result []*pb.Job
 	for {
@@ -232,7 +342,7 @@ func (s *State) JobList() ([]*pb.Job, error) {
 		idx := next.(*jobIndex)
 
 		var job *pb.Job
-		err = s.db.View(func(dbTxn *bolt.Tx) error {
+		err = s.db.View(func(dbTxn dbTx) error {
 			job, err = s.jobById(dbTxn, idx.Id)
 			return err
 		})
@@ -243,6 +353,24 @@ func (s *State) JobList() ([]*pb.Job, error) {
 	return result, nil
 }
 
+// JobCountByState returns the number of jobs in the given state.
+func (s *State) JobCountByState(state pb.Job_State) (int, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	iter, err := memTxn.Get(jobTableName, jobStateIndexName, state)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	for iter.Next() != nil {
+		count++
+	}
+
+	return count, nil
+}
+
 // JobById looks up a job by ID. The returned Job will be a deep copy
 // of the job so it is safe to read/write. If the job can't be found,
 // a nil result with no error is returned.
@@ -272,7 +400,7 @@ func (s *State) JobById(id string, ws memdb.WatchSet) (*Job, error) {
 	}
 
 	var job *pb.Job
-	err = s.db.View(func(dbTxn *bolt.Tx) error {
+	err = s.db.View(func(dbTxn dbTx) error {
 		job, err = s.jobById(dbTxn, jobIdx.Id)
 		return err
 	})
@@ -314,9 +442,25 @@ RETRY_ASSIGN:
 		candidateQuery = []candidateFunc{s.jobCandidateById}
 	}
 
+	// If the runner is already running as many jobs as it allows, we
+	// wait for one of them to complete before finding any candidates.
+	ws := memdb.NewWatchSet()
+	full, err := s.jobRunnerIsFull(txn, runnerRec, ws)
+	if err != nil {
+		return nil, err
+	}
+	if full {
+		txn.Abort()
+		ws.WatchCtx(ctx)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		goto RETRY_ASSIGN
+	}
+
 	// Build the list of candidates
 	var candidates []*jobIndex
-	ws := memdb.NewWatchSet()
 	for _, f := range candidateQuery {
 		job, err := f(txn, ws, runnerRec)
 		if err != nil {
@@ -354,8 +498,13 @@ RETRY_ASSIGN:
 		goto RETRY_ASSIGN
 	}
 
-	// We sort our candidates by queue time so that we can find the earliest
+	// We sort our candidates by priority and then queue time so that we
+	// can find the most important, earliest job.
 	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].PriorityOrder != candidates[j].PriorityOrder {
+			return candidates[i].PriorityOrder < candidates[j].PriorityOrder
+		}
+
 		return candidates[i].QueueTime.Before(candidates[j].QueueTime)
 	})
 
@@ -367,6 +516,16 @@ RETRY_ASSIGN:
 	// writing at a time. This lets us be sure we're the only one "assigning"
 	// a job candidate.
 	txn = s.inmem.Txn(true)
+
+	// Another assignment for this runner may have happened since our
+	// check above, so recheck that the runner has room for the job.
+	if full, err := s.jobRunnerIsFull(txn, runnerRec, nil); err != nil {
+		return nil, err
+	} else if full {
+		txn.Abort()
+		goto RETRY_ASSIGN
+	}
+
 	for _, job := range candidates {
 		// Get the job
 		raw, err := txn.First(jobTableName, jobIdIndexName, job.Id)
@@ -400,8 +559,10 @@ RETRY_ASSIGN:
 
 		// Update our state and update our on-disk job
 		job.State = pb.Job_WAITING
+		job.AssignedRunnerId = r.Id
 		result, err := s.jobReadAndUpdate(job.Id, func(jobpb *pb.Job) error {
 			jobpb.State = job.State
+			jobpb.AssignedRunner = &pb.Ref_RunnerId{Id: r.Id}
 			jobpb.AssignTime, err = ptypes.TimestampProto(time.Now())
 			if err != nil {
 				// This should never happen since encoding a time now should be safe
@@ -483,8 +644,10 @@ func (s *State) JobAck(id string, ack bool) (*Job, error) {
 		} else {
 			// Set to queued
 			job.State = pb.Job_QUEUED
+			job.AssignedRunnerId = ""
 			jobpb.State = job.State
 			jobpb.AssignTime = nil
+			jobpb.AssignedRunner = nil
 		}
 
 		return nil
@@ -512,6 +675,14 @@ func (s *State) JobAck(id string, ack bool) (*Job, error) {
 
 	s.log.Debug("heartbeat timer set", "job", job.Id, "timeout", jobHeartbeatTimeout)
 
+	// If the job has a timeout, set a timer to time it out. This timer
+	// isn't tracked since JobTimeout does nothing once the job completes.
+	if ack && result.Timeout != "" {
+		if dur, err := time.ParseDuration(result.Timeout); err == nil {
+			time.AfterFunc(dur, func() { s.JobTimeout(job.Id) })
+		}
+	}
+
 	// Insert to update
 	if err := txn.Insert(jobTableName, job); err != nil {
 		return nil, err
@@ -561,6 +732,99 @@ func (s *State) JobUpdateRef(id string, ref *pb.Job_DataSource_Ref) error {
 	return nil
 }
 
+// JobUpdateOnDemandRunner updates the on-demand runner of a job.
+func (s *State) JobUpdateOnDemandRunner(id string, v *pb.Job_OnDemandRunner) error {
+	txn := s.inmem.Txn(true)
+	defer txn.Abort()
+
+	// Get the job
+	raw, err := txn.First(jobTableName, jobIdIndexName, id)
+	if err != nil {
+		return err
+	}
+	if raw == nil {
+		return status.Errorf(codes.NotFound, "job not found: %s", id)
+	}
+	job := raw.(*jobIndex)
+
+	jobpb, err := s.jobReadAndUpdate(job.Id, func(jobpb *pb.Job) error {
+		jobpb.OnDemandRunner = v
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Insert to update
+	job = job.Copy()
+	job.OnDemandRunnerActive = jobOnDemandRunnerActive(jobpb)
+	if err := txn.Insert(jobTableName, job); err != nil {
+		return err
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// JobListOnDemandRunner returns the jobs that the server launched a
+// runner for and hasn't stopped yet. If ws is non-nil, it is notified
+// when the set of these jobs changes.
+func (s *State) JobListOnDemandRunner(ws memdb.WatchSet) ([]*pb.Job, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	iter, err := memTxn.Get(jobTableName, jobOnDemandRunnerIndexName, true)
+	if err != nil {
+		return nil, err
+	}
+	if ws != nil {
+		ws.Add(iter.WatchCh())
+	}
+
+	var result []*pb.Job
+	for {
+		next := iter.Next()
+		if next == nil {
+			break
+		}
+		idx := next.(*jobIndex)
+
+		var job *pb.Job
+		err = s.db.View(func(dbTxn dbTx) error {
+			job, err = s.jobById(dbTxn, idx.Id)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, job)
+	}
+
+	return result, nil
+}
+
+// JobIdsByRunner returns the IDs of the jobs that are assigned to the
+// runner with the given ID and haven't completed yet.
+func (s *State) JobIdsByRunner(id string) ([]string, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	var result []string
+	for _, state := range []pb.Job_State{pb.Job_WAITING, pb.Job_RUNNING} {
+		iter, err := memTxn.Get(jobTableName, jobRunnerIndexName, id, state)
+		if err != nil {
+			return nil, err
+		}
+
+		for raw := iter.Next(); raw != nil; raw = iter.Next() {
+			result = append(result, raw.(*jobIndex).Id)
+		}
+	}
+
+	return result, nil
+}
+
 // JobComplete marks a running job as complete. If an error is given,
 // the job is marked as failed (a completed state). If no error is given,
 // the job is marked as successful.
@@ -799,6 +1063,47 @@ func (s *State) JobExpire(id string) error {
 	return nil
 }
 
+// JobTimeout times out a job. If the job is still running, this force
+// cancels it and marks it as timed out. Otherwise this does nothing.
+func (s *State) JobTimeout(id string) error {
+	txn := s.inmem.Txn(true)
+	defer txn.Abort()
+
+	// Get the job
+	raw, err := txn.First(jobTableName, jobIdIndexName, id)
+	if err != nil {
+		return err
+	}
+	if raw == nil {
+		return status.Errorf(codes.NotFound, "job not found: %s", id)
+	}
+	job := raw.(*jobIndex)
+
+	// Only running jobs time out
+	if job.State != pb.Job_RUNNING {
+		return nil
+	}
+
+	s.log.Info("canceling job due to timeout", "job", job.Id)
+	if err := s.jobCancel(txn, job, true); err != nil {
+		return err
+	}
+
+	// Note the timeout as the error rather than a plain cancellation
+	_, err = s.jobReadAndUpdate(job.Id, func(jobpb *pb.Job) error {
+		jobpb.TimedOut = true
+		jobpb.Error = status.Newf(codes.DeadlineExceeded,
+			"job exceeded its timeout of %s", jobpb.Timeout).Proto()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	txn.Commit()
+	return nil
+}
+
 // JobIsAssignable returns whether there is a registered runner that
 // meets the requirements to run this job.
 //
@@ -837,6 +1142,13 @@ func (s *State) JobIsAssignable(ctx context.Context, jobpb *pb.Job) (bool, error
 	case *pb.Ref_Runner_Id:
 		iter, err = memTxn.Get(runnerTableName, runnerIdIndexName, v.Id.Id)
 
+	case *pb.Ref_Runner_Labels:
+		targetCheck = func(r *pb.Runner) (bool, error) {
+			return !r.ByIdOnly && serverptypes.LabelsMatch(v.Labels.Labels, r.Labels), nil
+		}
+
+		iter, err = memTxn.LowerBound(runnerTableName, runnerIdIndexName, "")
+
 	default:
 		return false, fmt.Errorf("unknown runner target value: %#v", jobpb.TargetRunner.Target)
 	}
@@ -869,7 +1181,7 @@ func (s *State) JobIsAssignable(ctx context.Context, jobpb *pb.Job) (bool, error
 }
 
 // jobIndexInit initializes the config index from persisted data.
-func (s *State) jobIndexInit(dbTxn *bolt.Tx, memTxn *memdb.Txn) error {
+func (s *State) jobIndexInit(dbTxn dbTx, memTxn *memdb.Txn) error {
 	bucket := dbTxn.Bucket(jobBucket)
 	c := bucket.Cursor()
 
@@ -911,6 +1223,15 @@ func (s *State) jobIndexSet(txn *memdb.Txn, id []byte, jobpb *pb.Job) (*jobIndex
 		Application: jobpb.Application,
 		Workspace:   jobpb.Workspace,
 		OpType:      reflect.TypeOf(jobpb.Operation),
+		Project:     jobpb.Application.Project,
+
+		PriorityOrder: jobPriorityOrder(jobpb.Priority),
+
+		OnDemandRunnerActive: jobOnDemandRunnerActive(jobpb),
+	}
+
+	if jobpb.AssignedRunner != nil {
+		rec.AssignedRunnerId = jobpb.AssignedRunner.Id
 	}
 
 	// Target
@@ -924,6 +1245,12 @@ func (s *State) jobIndexSet(txn *memdb.Txn, id []byte, jobpb *pb.Job) (*jobIndex
 	case *pb.Ref_Runner_Id:
 		rec.TargetRunnerId = v.Id.Id
 
+	case *pb.Ref_Runner_Labels:
+		rec.TargetRunnerLabels = v.Labels.Labels
+		if rec.TargetRunnerLabels == nil {
+			rec.TargetRunnerLabels = map[string]string{}
+		}
+
 	default:
 		return nil, fmt.Errorf("unknown runner target value: %#v", jobpb.TargetRunner.Target)
 	}
@@ -960,6 +1287,26 @@ func (s *State) jobIndexSet(txn *memdb.Txn, id []byte, jobpb *pb.Job) (*jobIndex
 			// Force cancel
 			s.JobCancel(rec.Id, true)
 		})
+
+		// Restart the timeout with the time remaining since the ack.
+		if jobpb.Timeout != "" && jobpb.AckTime != nil {
+			timeout, err := time.ParseDuration(jobpb.Timeout)
+			if err != nil {
+				return nil, err
+			}
+
+			t, err := ptypes.Timestamp(jobpb.AckTime)
+			if err != nil {
+				return nil, err
+			}
+
+			dur := time.Until(t.Add(timeout))
+			if dur < 0 {
+				dur = 1
+			}
+
+			time.AfterFunc(dur, func() { s.JobTimeout(jobpb.Id) })
+		}
 	}
 
 	// If we have an expiry, we need to set a timer to expire this job.
@@ -983,7 +1330,7 @@ func (s *State) jobIndexSet(txn *memdb.Txn, id []byte, jobpb *pb.Job) (*jobIndex
 	return rec, txn.Insert(jobTableName, rec)
 }
 
-func (s *State) jobCreate(dbTxn *bolt.Tx, memTxn *memdb.Txn, jobpb *pb.Job) error {
+func (s *State) jobCreate(dbTxn dbTx, memTxn *memdb.Txn, jobpb *pb.Job) error {
 	// Setup our initial job state
 	var err error
 	jobpb.State = pb.Job_QUEUED
@@ -1071,7 +1418,7 @@ func (s *State) jobsPruneOld(memTxn *memdb.Txn, max int) (int, error) {
 	})
 }
 
-func (s *State) jobById(dbTxn *bolt.Tx, id string) (*pb.Job, error) {
+func (s *State) jobById(dbTxn dbTx, id string) (*pb.Job, error) {
 	var result pb.Job
 	b := dbTxn.Bucket(jobBucket)
 	return &result, dbGet(b, []byte(id), &result)
@@ -1080,7 +1427,7 @@ func (s *State) jobById(dbTxn *bolt.Tx, id string) (*pb.Job, error) {
 func (s *State) jobReadAndUpdate(id string, f func(*pb.Job) error) (*pb.Job, error) {
 	var result *pb.Job
 	var err error
-	return result, s.db.Update(func(dbTxn *bolt.Tx) error {
+	return result, s.db.Update(func(dbTxn dbTx) error {
 		result, err = s.jobById(dbTxn, id)
 		if err != nil {
 			return err
@@ -1104,6 +1451,7 @@ func (s *State) jobCandidateById(memTxn *memdb.Txn, ws memdb.WatchSet, r *runner
 		jobTargetIdIndexName,
 		pb.Job_QUEUED,
 		r.Id,
+		uint(0),
 		time.Unix(0, 0),
 	)
 	if err != nil {
@@ -1134,12 +1482,15 @@ func (s *State) jobCandidateById(memTxn *memdb.Txn, ws memdb.WatchSet, r *runner
 	return nil, nil
 }
 
-// jobCandidateAny returns the first candidate job that targets any runner.
+// jobCandidateAny returns the first candidate job that targets any runner
+// or targets runners with labels that the runner has. Jobs are considered
+// in order of priority and then queue time.
 func (s *State) jobCandidateAny(memTxn *memdb.Txn, ws memdb.WatchSet, r *runnerRecord) (*jobIndex, error) {
 	iter, err := memTxn.LowerBound(
 		jobTableName,
-		jobQueueTimeIndexName,
+		jobPriorityIndexName,
 		pb.Job_QUEUED,
+		uint(0),
 		time.Unix(0, 0),
 	)
 	if err != nil {
@@ -1153,7 +1504,11 @@ func (s *State) jobCandidateAny(memTxn *memdb.Txn, ws memdb.WatchSet, r *runnerR
 		}
 
 		job := raw.(*jobIndex)
-		if job.State != pb.Job_QUEUED || !job.TargetAny {
+		if job.State != pb.Job_QUEUED {
+			continue
+		}
+		if !job.TargetAny && (job.TargetRunnerLabels == nil ||
+			!serverptypes.LabelsMatch(job.TargetRunnerLabels, r.Runner.Labels)) {
 			continue
 		}
 
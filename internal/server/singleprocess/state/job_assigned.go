@@ -2,6 +2,7 @@ package state
 
 import (
 	"reflect"
+	"strings"
 
 	"github.com/hashicorp/go-memdb"
 
@@ -67,12 +68,19 @@ type jobAssignedIndex struct {
 }
 
 // jobIsBlocked will return true if the given job is currently blocked because
-// a job with the same project/app/workspace is executing.
+// a job with the same project/app/workspace is executing or because the
+// project is at its limit of concurrent jobs.
 //
 // If ws is set then a watch will be added for any changes in assigned jobs.
 // Note that this trigger doesn't mean that the blocking is necessarily gone
 // but something changed to warrant rechecking.
 func (s *State) jobIsBlocked(memTxn *memdb.Txn, idx *jobIndex, ws memdb.WatchSet) (bool, error) {
+	// If the project is already running as many jobs as it allows, then
+	// every job of the project is blocked.
+	if full, err := s.jobProjectIsFull(memTxn, idx.Project, ws); err != nil || full {
+		return full, err
+	}
+
 	// If this job represents a parallelizable operation type, then allow it.
 	if _, ok := blockOps[idx.OpType]; !ok {
 		return false, nil
@@ -116,3 +124,95 @@ func (s *State) jobAssignedSet(memTxn *memdb.Txn, idx *jobIndex, assigned bool)
 
 	return memTxn.Delete(jobAssignedTableName, rec)
 }
+
+// jobProjectIsFull returns true if the project has a concurrency limit and
+// it has that many jobs assigned to runners.
+//
+// If ws is set then a watch will be added for changes to the project and
+// its assigned jobs.
+func (s *State) jobProjectIsFull(memTxn *memdb.Txn, project string, ws memdb.WatchSet) (bool, error) {
+	watchCh, raw, err := memTxn.FirstWatch(
+		projectIndexTableName,
+		projectIndexIdIndexName,
+		strings.ToLower(project),
+	)
+	if err != nil {
+		return false, err
+	}
+	if ws != nil {
+		ws.Add(watchCh)
+	}
+	if raw == nil {
+		return false, nil
+	}
+
+	limit := raw.(*projectIndexRecord).MaxConcurrentJobs
+	if limit == 0 {
+		return false, nil
+	}
+
+	count, err := s.jobCountAssigned(memTxn, jobProjectIndexName, project, ws)
+	if err != nil {
+		return false, err
+	}
+
+	return count >= int(limit), nil
+}
+
+// jobRunnerIsFull returns true if the runner has a concurrency limit and
+// it has that many jobs assigned.
+//
+// If ws is set then a watch will be added for changes to the jobs
+// assigned to the runner.
+func (s *State) jobRunnerIsFull(memTxn *memdb.Txn, r *runnerRecord, ws memdb.WatchSet) (bool, error) {
+	limit := r.Runner.MaxConcurrentJobs
+	if limit == 0 {
+		return false, nil
+	}
+
+	count, err := s.jobCountAssigned(memTxn, jobRunnerIndexName, r.Id, ws)
+	if err != nil {
+		return false, err
+	}
+
+	return count >= int(limit), nil
+}
+
+// jobCountAssigned returns the number of jobs that are waiting or running
+// with the given value for a project or runner index.
+func (s *State) jobCountAssigned(
+	memTxn *memdb.Txn,
+	index string,
+	value string,
+	ws memdb.WatchSet,
+) (int, error) {
+	var count int
+	for _, state := range []pb.Job_State{pb.Job_WAITING, pb.Job_RUNNING} {
+		iter, err := memTxn.Get(jobTableName, index, value, state)
+		if err != nil {
+			return 0, err
+		}
+		if ws != nil {
+			ws.Add(iter.WatchCh())
+		}
+
+		for raw := iter.Next(); raw != nil; raw = iter.Next() {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// jobPriorityOrder returns the order that jobs of the given priority are
+// assigned in. Lower values are assigned first.
+func jobPriorityOrder(p pb.Job_Priority) uint {
+	switch p {
+	case pb.Job_HIGH:
+		return 0
+	case pb.Job_LOW:
+		return 2
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,84 @@
+package state
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/golang/protobuf/proto"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// jobLogBucket stores the terminal output of jobs. Each batch of events
+// sent by a runner is a record keyed by the job ID and a sequence number
+// so that the output of a job can be read in order with a prefix scan.
+var jobLogBucket = []byte("job_logs")
+
+func init() {
+	dbBuckets = append(dbBuckets, jobLogBucket)
+}
+
+// JobLogAppend stores the terminal output events of a job after any
+// output that was already stored for the job.
+func (s *State) JobLogAppend(id string, events []*pb.GetJobStreamResponse_Terminal_Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	return s.db.Update(func(dbTxn dbTx) error {
+		b := dbTxn.Bucket(jobLogBucket)
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		return dbPut(b, jobLogKey(id, seq), &pb.GetJobStreamResponse_Terminal{
+			Events: events,
+		})
+	})
+}
+
+// JobLogList returns the stored terminal output events of a job in the
+// order they were stored. This returns no events if the job has no
+// stored output, including if the job doesn't exist.
+func (s *State) JobLogList(id string) ([]*pb.GetJobStreamResponse_Terminal_Event, error) {
+	var result []*pb.GetJobStreamResponse_Terminal_Event
+	err := s.db.View(func(dbTxn dbTx) error {
+		prefix := jobLogKey(id, 0)[:len(id)+1]
+		c := dbTxn.Bucket(jobLogBucket).Cursor()
+		for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			var batch pb.GetJobStreamResponse_Terminal
+			if err := proto.Unmarshal(v, &batch); err != nil {
+				return err
+			}
+
+			result = append(result, batch.Events...)
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// jobLogDelete deletes the stored terminal output of a job.
+func (s *State) jobLogDelete(dbTxn dbTx, id string) error {
+	prefix := jobLogKey(id, 0)[:len(id)+1]
+	c := dbTxn.Bucket(jobLogBucket).Cursor()
+	for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Seek(prefix) {
+		if err := c.Delete(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// jobLogKey returns the key of a batch of output of a job.
+func jobLogKey(id string, seq uint64) []byte {
+	key := make([]byte, len(id)+1+8)
+	copy(key, id)
+	key[len(id)] = '/'
+	binary.BigEndian.PutUint64(key[len(id)+1:], seq)
+	return key
+}
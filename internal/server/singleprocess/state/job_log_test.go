@@ -0,0 +1,78 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestJobLog(t *testing.T) {
+	line := func(msg string) *pb.GetJobStreamResponse_Terminal_Event {
+		return &pb.GetJobStreamResponse_Terminal_Event{
+			Event: &pb.GetJobStreamResponse_Terminal_Event_Line_{
+				Line: &pb.GetJobStreamResponse_Terminal_Event_Line{Msg: msg},
+			},
+		}
+	}
+
+	t.Run("Append and List", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		// No output
+		result, err := s.JobLogList("A")
+		require.NoError(err)
+		require.Empty(result)
+
+		// Jobs with IDs that are prefixes of each other are kept apart
+		require.NoError(s.JobLogAppend("A", []*pb.GetJobStreamResponse_Terminal_Event{
+			line("a1"), line("a2"),
+		}))
+		require.NoError(s.JobLogAppend("AB", []*pb.GetJobStreamResponse_Terminal_Event{
+			line("ab1"),
+		}))
+		require.NoError(s.JobLogAppend("A", []*pb.GetJobStreamResponse_Terminal_Event{
+			line("a3"),
+		}))
+
+		result, err = s.JobLogList("A")
+		require.NoError(err)
+		require.Len(result, 3)
+		for i, msg := range []string{"a1", "a2", "a3"} {
+			require.Equal(msg, result[i].GetLine().Msg)
+		}
+
+		result, err = s.JobLogList("AB")
+		require.NoError(err)
+		require.Len(result, 1)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		for _, id := range []string{"A", "AB", "A"} {
+			require.NoError(s.JobLogAppend(id, []*pb.GetJobStreamResponse_Terminal_Event{
+				line(id),
+			}))
+		}
+
+		require.NoError(s.db.Update(func(dbTxn dbTx) error {
+			return s.jobLogDelete(dbTxn, "A")
+		}))
+
+		result, err := s.JobLogList("A")
+		require.NoError(err)
+		require.Empty(result)
+
+		result, err = s.JobLogList("AB")
+		require.NoError(err)
+		require.Len(result, 1)
+	})
+}
@@ -0,0 +1,261 @@
+package state
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-memdb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+var jobScheduleBucket = []byte("job_schedules")
+
+func init() {
+	dbBuckets = append(dbBuckets, jobScheduleBucket)
+	dbIndexers = append(dbIndexers, (*State).jobScheduleIndexInit)
+	schemas = append(schemas, jobScheduleIndexSchema)
+}
+
+// JobSchedulePut creates or updates the given job schedule.
+func (s *State) JobSchedulePut(v *pb.JobSchedule) error {
+	memTxn := s.inmem.Txn(true)
+	defer memTxn.Abort()
+
+	id := jobScheduleId(v.Project, v.Name)
+	err := s.db.Update(func(dbTxn dbTx) error {
+		if err := dbPut(dbTxn.Bucket(jobScheduleBucket), []byte(id), v); err != nil {
+			return err
+		}
+
+		return s.jobScheduleIndexSet(memTxn, id, v)
+	})
+	if err == nil {
+		memTxn.Commit()
+	}
+
+	return err
+}
+
+// JobScheduleGet gets a job schedule by project and name. This returns a
+// NotFound error if the schedule doesn't exist.
+func (s *State) JobScheduleGet(ref *pb.Ref_Project, name string) (*pb.JobSchedule, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	raw, err := memTxn.First(
+		jobScheduleIndexTableName,
+		jobScheduleIndexIdIndexName,
+		jobScheduleId(ref, name),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, status.Errorf(codes.NotFound, "job schedule not found: %s", name)
+	}
+
+	return proto.Clone(raw.(*jobScheduleIndexRecord).Schedule).(*pb.JobSchedule), nil
+}
+
+// JobScheduleList returns the job schedules of a project sorted by name.
+func (s *State) JobScheduleList(ref *pb.Ref_Project) ([]*pb.JobSchedule, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	iter, err := memTxn.Get(
+		jobScheduleIndexTableName,
+		jobScheduleIndexProjectIndexName,
+		ref.Project,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*pb.JobSchedule
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		result = append(result,
+			proto.Clone(raw.(*jobScheduleIndexRecord).Schedule).(*pb.JobSchedule))
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+// JobScheduleDelete deletes a job schedule. If the schedule doesn't
+// exist, this does nothing.
+func (s *State) JobScheduleDelete(ref *pb.Ref_Project, name string) error {
+	memTxn := s.inmem.Txn(true)
+	defer memTxn.Abort()
+
+	id := jobScheduleId(ref, name)
+	err := s.db.Update(func(dbTxn dbTx) error {
+		if err := dbTxn.Bucket(jobScheduleBucket).Delete([]byte(id)); err != nil {
+			return err
+		}
+
+		_, err := memTxn.DeleteAll(
+			jobScheduleIndexTableName,
+			jobScheduleIndexIdIndexName,
+			id,
+		)
+		return err
+	})
+	if err == nil {
+		memTxn.Commit()
+	}
+
+	return err
+}
+
+// JobSchedulePeek returns the schedule with the earliest next time and
+// that time. This returns a nil schedule if no schedule has a next time.
+// The WatchSet is notified when any schedule changes.
+func (s *State) JobSchedulePeek(ws memdb.WatchSet) (*pb.JobSchedule, time.Time, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	// LowerBound doesn't support watches so we watch every schedule
+	// with a Get first.
+	iter, err := memTxn.Get(
+		jobScheduleIndexTableName,
+		jobScheduleIndexIdIndexName+"_prefix",
+		"",
+	)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	ws.Add(iter.WatchCh())
+
+	iter, err = memTxn.LowerBound(
+		jobScheduleIndexTableName,
+		jobScheduleIndexNextTimeIndexName,
+		true,            // has a next time
+		time.Unix(0, 0), // lowest next time
+	)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	raw := iter.Next()
+	if raw == nil {
+		return nil, time.Time{}, nil
+	}
+
+	rec := raw.(*jobScheduleIndexRecord)
+	if !rec.Scheduled {
+		return nil, time.Time{}, nil
+	}
+
+	return proto.Clone(rec.Schedule).(*pb.JobSchedule), rec.NextTime, nil
+}
+
+// jobScheduleIndexSet writes an index record for a job schedule.
+func (s *State) jobScheduleIndexSet(txn *memdb.Txn, id string, value *pb.JobSchedule) error {
+	rec := &jobScheduleIndexRecord{
+		Id:       id,
+		Project:  value.Project.Project,
+		Schedule: proto.Clone(value).(*pb.JobSchedule),
+	}
+
+	if value.NextTime != nil {
+		t, err := ptypes.Timestamp(value.NextTime)
+		if err != nil {
+			return err
+		}
+
+		rec.Scheduled = true
+		rec.NextTime = t
+	} else {
+		// The time must still be valid to index so we use the epoch.
+		rec.NextTime = time.Unix(0, 0)
+	}
+
+	return txn.Insert(jobScheduleIndexTableName, rec)
+}
+
+// jobScheduleIndexInit initializes the job schedule index from persisted data.
+func (s *State) jobScheduleIndexInit(dbTxn dbTx, memTxn *memdb.Txn) error {
+	bucket := dbTxn.Bucket(jobScheduleBucket)
+	return bucket.ForEach(func(k, v []byte) error {
+		var value pb.JobSchedule
+		if err := proto.Unmarshal(v, &value); err != nil {
+			return err
+		}
+
+		return s.jobScheduleIndexSet(memTxn, string(k), &value)
+	})
+}
+
+// jobScheduleId returns the ID used to store a job schedule.
+func jobScheduleId(ref *pb.Ref_Project, name string) string {
+	return strings.ToLower(fmt.Sprintf("%s/%s", ref.Project, name))
+}
+
+func jobScheduleIndexSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: jobScheduleIndexTableName,
+		Indexes: map[string]*memdb.IndexSchema{
+			jobScheduleIndexIdIndexName: {
+				Name:         jobScheduleIndexIdIndexName,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "Id",
+				},
+			},
+
+			jobScheduleIndexProjectIndexName: {
+				Name:         jobScheduleIndexProjectIndexName,
+				AllowMissing: false,
+				Unique:       false,
+				Indexer: &memdb.StringFieldIndex{
+					Field:     "Project",
+					Lowercase: true,
+				},
+			},
+
+			jobScheduleIndexNextTimeIndexName: {
+				Name:         jobScheduleIndexNextTimeIndexName,
+				AllowMissing: false,
+				Unique:       false,
+				Indexer: &memdb.CompoundIndex{
+					Indexes: []memdb.Indexer{
+						&memdb.BoolFieldIndex{
+							Field: "Scheduled",
+						},
+
+						&IndexTime{
+							Field: "NextTime",
+							Asc:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	jobScheduleIndexTableName         = "job-schedule-index"
+	jobScheduleIndexIdIndexName       = "id"
+	jobScheduleIndexProjectIndexName  = "project"
+	jobScheduleIndexNextTimeIndexName = "next-time"
+)
+
+type jobScheduleIndexRecord struct {
+	Id        string
+	Project   string
+	Scheduled bool
+	NextTime  time.Time
+	Schedule  *pb.JobSchedule
+}
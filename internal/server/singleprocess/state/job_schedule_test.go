@@ -0,0 +1,113 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-memdb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestJobSchedule(t *testing.T) {
+	t.Run("put, get, list, and delete", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		v := serverptypes.TestJobSchedule(t, &pb.JobSchedule{Name: "b"})
+		require.NoError(s.JobSchedulePut(v))
+		require.NoError(s.JobSchedulePut(serverptypes.TestJobSchedule(t, &pb.JobSchedule{Name: "a"})))
+		require.NoError(s.JobSchedulePut(serverptypes.TestJobSchedule(t, &pb.JobSchedule{
+			Project: &pb.Ref_Project{Project: "other"},
+			Name:    "c",
+		})))
+
+		// Get is case insensitive
+		result, err := s.JobScheduleGet(&pb.Ref_Project{Project: "P_TEST"}, "B")
+		require.NoError(err)
+		require.Equal("b", result.Name)
+
+		_, err = s.JobScheduleGet(v.Project, "nope")
+		require.Equal(codes.NotFound, status.Code(err))
+
+		list, err := s.JobScheduleList(v.Project)
+		require.NoError(err)
+		require.Len(list, 2)
+		require.Equal("a", list[0].Name)
+		require.Equal("b", list[1].Name)
+
+		// Delete
+		require.NoError(s.JobScheduleDelete(v.Project, "b"))
+		_, err = s.JobScheduleGet(v.Project, "b")
+		require.Equal(codes.NotFound, status.Code(err))
+		list, err = s.JobScheduleList(v.Project)
+		require.NoError(err)
+		require.Len(list, 1)
+
+		// Deleting again does nothing
+		require.NoError(s.JobScheduleDelete(v.Project, "b"))
+	})
+
+	t.Run("peek", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		// Nothing to peek
+		ws := memdb.NewWatchSet()
+		v, _, err := s.JobSchedulePeek(ws)
+		require.NoError(err)
+		require.Nil(v)
+
+		// Schedules without a next time are never peeked
+		require.NoError(s.JobSchedulePut(serverptypes.TestJobSchedule(t, &pb.JobSchedule{
+			Name: "never",
+		})))
+		require.False(ws.Watch(time.After(time.Second)))
+
+		ws = memdb.NewWatchSet()
+		v, _, err = s.JobSchedulePeek(ws)
+		require.NoError(err)
+		require.Nil(v)
+
+		// The earliest schedule is peeked
+		now := time.Now()
+		later, err := ptypes.TimestampProto(now.Add(time.Hour))
+		require.NoError(err)
+		sooner, err := ptypes.TimestampProto(now.Add(time.Minute))
+		require.NoError(err)
+		require.NoError(s.JobSchedulePut(serverptypes.TestJobSchedule(t, &pb.JobSchedule{
+			Name:     "later",
+			NextTime: later,
+		})))
+		require.NoError(s.JobSchedulePut(serverptypes.TestJobSchedule(t, &pb.JobSchedule{
+			Name:     "sooner",
+			NextTime: sooner,
+		})))
+		require.False(ws.Watch(time.After(time.Second)))
+
+		ws = memdb.NewWatchSet()
+		v, next, err := s.JobSchedulePeek(ws)
+		require.NoError(err)
+		require.NotNil(v)
+		require.Equal("sooner", v.Name)
+		require.True(next.Equal(now.Add(time.Minute)))
+
+		// Deleting it notifies and peeks the next one
+		require.NoError(s.JobScheduleDelete(v.Project, v.Name))
+		require.False(ws.Watch(time.After(time.Second)))
+
+		v, _, err = s.JobSchedulePeek(memdb.NewWatchSet())
+		require.NoError(err)
+		require.NotNil(v)
+		require.Equal("later", v.Name)
+	})
+}
@@ -635,6 +635,211 @@ func TestJobAssign(t *testing.T) {
 		require.NotNil(job)
 		require.Equal("B", job.Id)
 	})
+
+	t.Run("labels target", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		// Create a job targeting prod runners
+		require.NoError(s.JobCreate(serverptypes.TestJobNew(t, &pb.Job{
+			Id: "A",
+			TargetRunner: &pb.Ref_Runner{
+				Target: &pb.Ref_Runner_Labels{
+					Labels: &pb.Ref_RunnerLabels{
+						Labels: map[string]string{"env": "prod"},
+					},
+				},
+			},
+		})))
+
+		// Should block for a runner without the labels
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		job, err := s.JobAssignForRunner(ctx, &pb.Runner{
+			Id:     "R_A",
+			Labels: map[string]string{"env": "dev"},
+		})
+		require.Error(err)
+		require.Nil(job)
+		require.Equal(ctx.Err(), err)
+
+		// A runner with the labels gets the job
+		job, err = s.JobAssignForRunner(context.Background(), &pb.Runner{
+			Id:     "R_B",
+			Labels: map[string]string{"env": "prod", "arch": "arm64"},
+		})
+		require.NoError(err)
+		require.NotNil(job)
+		require.Equal("A", job.Id)
+	})
+
+	t.Run("assigns higher priority jobs first", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		// Create jobs of each priority, highest last
+		require.NoError(s.JobCreate(serverptypes.TestJobNew(t, &pb.Job{
+			Id: "A",
+		})))
+		require.NoError(s.JobCreate(serverptypes.TestJobNew(t, &pb.Job{
+			Id:       "B",
+			Priority: pb.Job_LOW,
+		})))
+		require.NoError(s.JobCreate(serverptypes.TestJobNew(t, &pb.Job{
+			Id:       "C",
+			Priority: pb.Job_HIGH,
+		})))
+
+		// Assign them, we should get them by priority
+		for _, expected := range []string{"C", "A", "B"} {
+			job, err := s.JobAssignForRunner(context.Background(), &pb.Runner{Id: "R_A"})
+			require.NoError(err)
+			require.NotNil(job)
+			require.Equal(expected, job.Id)
+		}
+	})
+
+	t.Run("blocks when the project is at its concurrency limit", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		// Limit the project to one job
+		require.NoError(s.ProjectPut(serverptypes.TestProject(t, &pb.Project{
+			Name:              "p_test",
+			MaxConcurrentJobs: 1,
+		})))
+
+		// Create two jobs that could otherwise run in parallel
+		require.NoError(s.JobCreate(serverptypes.TestJobNew(t, &pb.Job{
+			Id: "A",
+		})))
+		require.NoError(s.JobCreate(serverptypes.TestJobNew(t, &pb.Job{
+			Id: "B",
+		})))
+
+		// Assign it, we should get this build
+		job1, err := s.JobAssignForRunner(context.Background(), &pb.Runner{Id: "R_A"})
+		require.NoError(err)
+		require.NotNil(job1)
+		require.Equal("A", job1.Id)
+
+		// Get the next value in a goroutine
+		{
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			var job *Job
+			var jerr error
+			doneCh := make(chan struct{})
+			go func() {
+				defer close(doneCh)
+				job, jerr = s.JobAssignForRunner(ctx, &pb.Runner{Id: "R_B"})
+			}()
+
+			// We should be blocking
+			select {
+			case <-doneCh:
+				t.Fatal("should wait")
+
+			case <-time.After(500 * time.Millisecond):
+			}
+
+			// Complete the job
+			_, err = s.JobAck(job1.Id, true)
+			require.NoError(err)
+			require.NoError(s.JobComplete(job1.Id, nil, nil))
+
+			// We should get a result
+			select {
+			case <-doneCh:
+
+			case <-time.After(500 * time.Millisecond):
+				t.Fatal("should have a result")
+			}
+
+			require.NoError(jerr)
+			require.NotNil(job)
+			require.Equal("B", job.Id)
+		}
+	})
+
+	t.Run("blocks when the runner is at its concurrency limit", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		r := &pb.Runner{Id: "R_A", MaxConcurrentJobs: 1}
+
+		// Create two jobs that could otherwise run in parallel
+		require.NoError(s.JobCreate(serverptypes.TestJobNew(t, &pb.Job{
+			Id: "A",
+		})))
+		require.NoError(s.JobCreate(serverptypes.TestJobNew(t, &pb.Job{
+			Id: "B",
+		})))
+
+		// Assign it, we should get this build
+		job1, err := s.JobAssignForRunner(context.Background(), r)
+		require.NoError(err)
+		require.NotNil(job1)
+		require.Equal("A", job1.Id)
+
+		// Another runner can still get the next job
+		{
+			ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+			defer cancel()
+			job, err := s.JobAssignForRunner(ctx, &pb.Runner{Id: "R_B"})
+			require.NoError(err)
+			require.NotNil(job)
+			require.Equal("B", job.Id)
+			_, err = s.JobAck(job.Id, false)
+			require.NoError(err)
+		}
+
+		// Get the next value in a goroutine
+		{
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			var job *Job
+			var jerr error
+			doneCh := make(chan struct{})
+			go func() {
+				defer close(doneCh)
+				job, jerr = s.JobAssignForRunner(ctx, r)
+			}()
+
+			// We should be blocking
+			select {
+			case <-doneCh:
+				t.Fatal("should wait")
+
+			case <-time.After(500 * time.Millisecond):
+			}
+
+			// Complete the job
+			_, err = s.JobAck(job1.Id, true)
+			require.NoError(err)
+			require.NoError(s.JobComplete(job1.Id, nil, nil))
+
+			// We should get a result
+			select {
+			case <-doneCh:
+
+			case <-time.After(500 * time.Millisecond):
+				t.Fatal("should have a result")
+			}
+
+			require.NoError(jerr)
+			require.NotNil(job)
+			require.Equal("B", job.Id)
+		}
+	})
 }
 
 func TestJobAck(t *testing.T) {
@@ -806,6 +1011,50 @@ func TestJobComplete(t *testing.T) {
 	})
 }
 
+func TestJobIdsByRunner(t *testing.T) {
+	require := require.New(t)
+
+	s := TestState(t)
+	defer s.Close()
+
+	// Create some jobs
+	require.NoError(s.JobCreate(serverptypes.TestJobNew(t, &pb.Job{Id: "A"})))
+	require.NoError(s.JobCreate(serverptypes.TestJobNew(t, &pb.Job{Id: "B"})))
+
+	// Nothing is assigned yet
+	ids, err := s.JobIdsByRunner("R_A")
+	require.NoError(err)
+	require.Empty(ids)
+
+	// Assign one and leave it waiting for an ack
+	job, err := s.JobAssignForRunner(context.Background(), &pb.Runner{Id: "R_A"})
+	require.NoError(err)
+	require.Equal("A", job.Id)
+
+	ids, err = s.JobIdsByRunner("R_A")
+	require.NoError(err)
+	require.Equal([]string{"A"}, ids)
+
+	// Run it
+	_, err = s.JobAck(job.Id, true)
+	require.NoError(err)
+
+	ids, err = s.JobIdsByRunner("R_A")
+	require.NoError(err)
+	require.Equal([]string{"A"}, ids)
+
+	// Other runners have no jobs
+	ids, err = s.JobIdsByRunner("R_B")
+	require.NoError(err)
+	require.Empty(ids)
+
+	// Completed jobs aren't included
+	require.NoError(s.JobComplete(job.Id, nil, nil))
+	ids, err = s.JobIdsByRunner("R_A")
+	require.NoError(err)
+	require.Empty(ids)
+}
+
 func TestJobIsAssignable(t *testing.T) {
 	t.Run("no runners", func(t *testing.T) {
 		require := require.New(t)
@@ -920,6 +1169,40 @@ func TestJobIsAssignable(t *testing.T) {
 		require.NoError(err)
 		require.True(result)
 	})
+
+	t.Run("labels target", func(t *testing.T) {
+		require := require.New(t)
+		ctx := context.Background()
+
+		s := TestState(t)
+		defer s.Close()
+
+		// Register a runner
+		require.NoError(s.RunnerCreate(serverptypes.TestRunner(t, &pb.Runner{
+			Labels: map[string]string{"env": "prod", "arch": "arm64"},
+		})))
+
+		job := func(labels map[string]string) *pb.Job {
+			return serverptypes.TestJobNew(t, &pb.Job{
+				Id: "A",
+				TargetRunner: &pb.Ref_Runner{
+					Target: &pb.Ref_Runner_Labels{
+						Labels: &pb.Ref_RunnerLabels{Labels: labels},
+					},
+				},
+			})
+		}
+
+		// Should be assignable
+		result, err := s.JobIsAssignable(ctx, job(map[string]string{"env": "prod"}))
+		require.NoError(err)
+		require.True(result)
+
+		// Should not be assignable
+		result, err = s.JobIsAssignable(ctx, job(map[string]string{"env": "dev"}))
+		require.NoError(err)
+		require.False(result)
+	})
 }
 
 func TestJobCancel(t *testing.T) {
@@ -1323,6 +1606,59 @@ func TestJobHeartbeat(t *testing.T) {
 	})
 }
 
+func TestJobTimeout(t *testing.T) {
+	t.Run("times out after ack", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		// Create a build
+		require.NoError(s.JobCreate(serverptypes.TestJobNew(t, &pb.Job{
+			Id:      "A",
+			Timeout: "5ms",
+		})))
+
+		// Assign and ack it
+		job, err := s.JobAssignForRunner(context.Background(), &pb.Runner{Id: "R_A"})
+		require.NoError(err)
+		require.NotNil(job)
+		_, err = s.JobAck(job.Id, true)
+		require.NoError(err)
+
+		// Should time out
+		require.Eventually(func() bool {
+			job, err = s.JobById("A", nil)
+			require.NoError(err)
+			return job.Job.State == pb.Job_ERROR
+		}, 1*time.Second, 10*time.Millisecond)
+
+		require.True(job.TimedOut)
+		require.Equal(codes.DeadlineExceeded, codes.Code(job.Error.Code))
+	})
+
+	t.Run("does nothing if not running", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		// Create a build
+		require.NoError(s.JobCreate(serverptypes.TestJobNew(t, &pb.Job{
+			Id:      "A",
+			Timeout: "1h",
+		})))
+
+		// Time it out while queued
+		require.NoError(s.JobTimeout("A"))
+
+		job, err := s.JobById("A", nil)
+		require.NoError(err)
+		require.Equal(pb.Job_QUEUED, job.State)
+		require.False(job.TimedOut)
+	})
+}
+
 func TestJobUpdateRef(t *testing.T) {
 	t.Run("running", func(t *testing.T) {
 		require := require.New(t)
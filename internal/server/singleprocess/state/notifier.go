@@ -0,0 +1,181 @@
+package state
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/go-memdb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+var notifierBucket = []byte("notifiers")
+
+func init() {
+	dbBuckets = append(dbBuckets, notifierBucket)
+	dbIndexers = append(dbIndexers, (*State).notifierIndexInit)
+	schemas = append(schemas, notifierIndexSchema)
+}
+
+// NotifierPut creates or updates the given notifier.
+func (s *State) NotifierPut(v *pb.Notifier) error {
+	memTxn := s.inmem.Txn(true)
+	defer memTxn.Abort()
+
+	id := notifierId(v.Project, v.Name)
+	err := s.db.Update(func(dbTxn dbTx) error {
+		if err := dbPut(dbTxn.Bucket(notifierBucket), []byte(id), v); err != nil {
+			return err
+		}
+
+		return s.notifierIndexSet(memTxn, id, v)
+	})
+	if err == nil {
+		memTxn.Commit()
+	}
+
+	return err
+}
+
+// NotifierGet gets a notifier by project and name. This returns a NotFound
+// error if the notifier doesn't exist.
+func (s *State) NotifierGet(ref *pb.Ref_Project, name string) (*pb.Notifier, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	raw, err := memTxn.First(
+		notifierIndexTableName,
+		notifierIndexIdIndexName,
+		notifierId(ref, name),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, status.Errorf(codes.NotFound, "notifier not found: %s", name)
+	}
+
+	return proto.Clone(raw.(*notifierIndexRecord).Notifier).(*pb.Notifier), nil
+}
+
+// NotifierList returns the notifiers of a project sorted by name.
+func (s *State) NotifierList(ref *pb.Ref_Project) ([]*pb.Notifier, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	iter, err := memTxn.Get(
+		notifierIndexTableName,
+		notifierIndexProjectIndexName,
+		ref.Project,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*pb.Notifier
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		result = append(result,
+			proto.Clone(raw.(*notifierIndexRecord).Notifier).(*pb.Notifier))
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+// NotifierDelete deletes a notifier. If the notifier doesn't exist, this
+// does nothing.
+func (s *State) NotifierDelete(ref *pb.Ref_Project, name string) error {
+	memTxn := s.inmem.Txn(true)
+	defer memTxn.Abort()
+
+	id := notifierId(ref, name)
+	err := s.db.Update(func(dbTxn dbTx) error {
+		if err := dbTxn.Bucket(notifierBucket).Delete([]byte(id)); err != nil {
+			return err
+		}
+
+		_, err := memTxn.DeleteAll(
+			notifierIndexTableName,
+			notifierIndexIdIndexName,
+			id,
+		)
+		return err
+	})
+	if err == nil {
+		memTxn.Commit()
+	}
+
+	return err
+}
+
+// notifierIndexSet writes an index record for a notifier.
+func (s *State) notifierIndexSet(txn *memdb.Txn, id string, value *pb.Notifier) error {
+	return txn.Insert(notifierIndexTableName, &notifierIndexRecord{
+		Id:       id,
+		Project:  value.Project.Project,
+		Notifier: proto.Clone(value).(*pb.Notifier),
+	})
+}
+
+// notifierIndexInit initializes the notifier index from persisted data.
+func (s *State) notifierIndexInit(dbTxn dbTx, memTxn *memdb.Txn) error {
+	bucket := dbTxn.Bucket(notifierBucket)
+	return bucket.ForEach(func(k, v []byte) error {
+		var value pb.Notifier
+		if err := proto.Unmarshal(v, &value); err != nil {
+			return err
+		}
+
+		return s.notifierIndexSet(memTxn, string(k), &value)
+	})
+}
+
+// notifierId returns the ID used to store a notifier.
+func notifierId(ref *pb.Ref_Project, name string) string {
+	return strings.ToLower(fmt.Sprintf("%s/%s", ref.Project, name))
+}
+
+func notifierIndexSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: notifierIndexTableName,
+		Indexes: map[string]*memdb.IndexSchema{
+			notifierIndexIdIndexName: {
+				Name:         notifierIndexIdIndexName,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "Id",
+				},
+			},
+
+			notifierIndexProjectIndexName: {
+				Name:         notifierIndexProjectIndexName,
+				AllowMissing: false,
+				Unique:       false,
+				Indexer: &memdb.StringFieldIndex{
+					Field:     "Project",
+					Lowercase: true,
+				},
+			},
+		},
+	}
+}
+
+const (
+	notifierIndexTableName        = "notifier-index"
+	notifierIndexIdIndexName      = "id"
+	notifierIndexProjectIndexName = "project"
+)
+
+type notifierIndexRecord struct {
+	Id       string
+	Project  string
+	Notifier *pb.Notifier
+}
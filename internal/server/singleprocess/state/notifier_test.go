@@ -0,0 +1,52 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestNotifier(t *testing.T) {
+	require := require.New(t)
+
+	s := TestState(t)
+	defer s.Close()
+
+	v := serverptypes.TestNotifier(t, &pb.Notifier{Name: "b"})
+	require.NoError(s.NotifierPut(v))
+	require.NoError(s.NotifierPut(serverptypes.TestNotifier(t, &pb.Notifier{Name: "a"})))
+	require.NoError(s.NotifierPut(serverptypes.TestNotifier(t, &pb.Notifier{
+		Project: &pb.Ref_Project{Project: "other"},
+		Name:    "c",
+	})))
+
+	// Get is case insensitive
+	result, err := s.NotifierGet(&pb.Ref_Project{Project: "P_TEST"}, "B")
+	require.NoError(err)
+	require.Equal("b", result.Name)
+
+	_, err = s.NotifierGet(v.Project, "nope")
+	require.Equal(codes.NotFound, status.Code(err))
+
+	list, err := s.NotifierList(v.Project)
+	require.NoError(err)
+	require.Len(list, 2)
+	require.Equal("a", list[0].Name)
+	require.Equal("b", list[1].Name)
+
+	// Delete
+	require.NoError(s.NotifierDelete(v.Project, "b"))
+	_, err = s.NotifierGet(v.Project, "b")
+	require.Equal(codes.NotFound, status.Code(err))
+	list, err = s.NotifierList(v.Project)
+	require.NoError(err)
+	require.Len(list, 1)
+
+	// Deleting again does nothing
+	require.NoError(s.NotifierDelete(v.Project, "b"))
+}
@@ -0,0 +1,224 @@
+package state
+
+import (
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/go-memdb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+var onDemandRunnerConfigBucket = []byte("ondemand_runner_config")
+
+func init() {
+	dbBuckets = append(dbBuckets, onDemandRunnerConfigBucket)
+	dbIndexers = append(dbIndexers, (*State).onDemandRunnerConfigIndexInit)
+	schemas = append(schemas, onDemandRunnerConfigSchema)
+}
+
+// OnDemandRunnerConfigPut creates or updates the given on-demand runner
+// configuration.
+func (s *State) OnDemandRunnerConfigPut(v *pb.OnDemandRunnerConfig) error {
+	memTxn := s.inmem.Txn(true)
+	defer memTxn.Abort()
+
+	err := s.db.Update(func(dbTxn dbTx) error {
+		return s.onDemandRunnerConfigPut(dbTxn, memTxn, v)
+	})
+	if err == nil {
+		memTxn.Commit()
+	}
+
+	return err
+}
+
+// OnDemandRunnerConfigGet gets an on-demand runner configuration by
+// reference.
+func (s *State) OnDemandRunnerConfigGet(ref *pb.Ref_OnDemandRunnerConfig) (*pb.OnDemandRunnerConfig, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	var result *pb.OnDemandRunnerConfig
+	err := s.db.View(func(dbTxn dbTx) error {
+		var err error
+		result, err = s.onDemandRunnerConfigGet(dbTxn, memTxn, ref)
+		return err
+	})
+
+	return result, err
+}
+
+// OnDemandRunnerConfigDelete deletes an on-demand runner configuration by
+// reference.
+func (s *State) OnDemandRunnerConfigDelete(ref *pb.Ref_OnDemandRunnerConfig) error {
+	memTxn := s.inmem.Txn(true)
+	defer memTxn.Abort()
+
+	err := s.db.Update(func(dbTxn dbTx) error {
+		return s.onDemandRunnerConfigDelete(dbTxn, memTxn, ref)
+	})
+	if err == nil {
+		memTxn.Commit()
+	}
+
+	return err
+}
+
+// OnDemandRunnerConfigList returns the list of on-demand runner
+// configurations.
+func (s *State) OnDemandRunnerConfigList() ([]*pb.OnDemandRunnerConfig, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	iter, err := memTxn.Get(onDemandRunnerConfigTableName, onDemandRunnerConfigIdIndexName+"_prefix", "")
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*pb.OnDemandRunnerConfig
+	for {
+		next := iter.Next()
+		if next == nil {
+			break
+		}
+		idx := next.(*onDemandRunnerConfigIndex)
+
+		var v *pb.OnDemandRunnerConfig
+		err = s.db.View(func(dbTxn dbTx) error {
+			v, err = s.onDemandRunnerConfigGet(dbTxn, memTxn, &pb.Ref_OnDemandRunnerConfig{Name: idx.Id})
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, v)
+	}
+
+	return result, nil
+}
+
+func (s *State) onDemandRunnerConfigPut(
+	dbTxn dbTx,
+	memTxn *memdb.Txn,
+	value *pb.OnDemandRunnerConfig,
+) error {
+	id := s.onDemandRunnerConfigId(value)
+
+	// Get the global bucket and write the value to it.
+	b := dbTxn.Bucket(onDemandRunnerConfigBucket)
+	if err := dbPut(b, id, value); err != nil {
+		return err
+	}
+
+	// Create our index value and write that.
+	return s.onDemandRunnerConfigIndexSet(memTxn, id, value)
+}
+
+func (s *State) onDemandRunnerConfigGet(
+	dbTxn dbTx,
+	memTxn *memdb.Txn,
+	ref *pb.Ref_OnDemandRunnerConfig,
+) (*pb.OnDemandRunnerConfig, error) {
+	var result pb.OnDemandRunnerConfig
+	b := dbTxn.Bucket(onDemandRunnerConfigBucket)
+	return &result, dbGet(b, []byte(strings.ToLower(ref.Name)), &result)
+}
+
+func (s *State) onDemandRunnerConfigDelete(
+	dbTxn dbTx,
+	memTxn *memdb.Txn,
+	ref *pb.Ref_OnDemandRunnerConfig,
+) error {
+	// Get the configuration. If it doesn't exist then we're successful.
+	v, err := s.onDemandRunnerConfigGet(dbTxn, memTxn, ref)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil
+		}
+
+		return err
+	}
+
+	// Delete from bolt
+	id := s.onDemandRunnerConfigId(v)
+	bucket := dbTxn.Bucket(onDemandRunnerConfigBucket)
+	if err := bucket.Delete(id); err != nil {
+		return err
+	}
+
+	// Delete from memdb
+	if err := memTxn.Delete(onDemandRunnerConfigTableName, &onDemandRunnerConfigIndex{Id: string(id)}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// onDemandRunnerConfigIndexSet writes an index record for a single
+// on-demand runner configuration.
+func (s *State) onDemandRunnerConfigIndexSet(txn *memdb.Txn, id []byte, value *pb.OnDemandRunnerConfig) error {
+	record := &onDemandRunnerConfigIndex{
+		Id: string(id),
+	}
+
+	// Insert the index
+	return txn.Insert(onDemandRunnerConfigTableName, record)
+}
+
+// onDemandRunnerConfigIndexInit initializes the on-demand runner
+// configuration index from persisted data.
+func (s *State) onDemandRunnerConfigIndexInit(dbTxn dbTx, memTxn *memdb.Txn) error {
+	bucket := dbTxn.Bucket(onDemandRunnerConfigBucket)
+	return bucket.ForEach(func(k, v []byte) error {
+		var value pb.OnDemandRunnerConfig
+		if err := proto.Unmarshal(v, &value); err != nil {
+			return err
+		}
+		if err := s.onDemandRunnerConfigIndexSet(memTxn, k, &value); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (s *State) onDemandRunnerConfigId(v *pb.OnDemandRunnerConfig) []byte {
+	return []byte(strings.ToLower(v.Name))
+}
+
+func onDemandRunnerConfigSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: onDemandRunnerConfigTableName,
+		Indexes: map[string]*memdb.IndexSchema{
+			onDemandRunnerConfigIdIndexName: {
+				Name:         onDemandRunnerConfigIdIndexName,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.StringFieldIndex{
+					Field:     "Id",
+					Lowercase: true,
+				},
+			},
+		},
+	}
+}
+
+const (
+	onDemandRunnerConfigTableName   = "ondemand-runner-configs"
+	onDemandRunnerConfigIdIndexName = "id"
+)
+
+type onDemandRunnerConfigIndex struct {
+	Id string // Unique ID, we just use the configuration name
+}
+
+// Copy should be called prior to any modifications to an existing record.
+func (idx *onDemandRunnerConfigIndex) Copy() *onDemandRunnerConfigIndex {
+	// A shallow copy is good enough since we only modify top-level fields.
+	copy := *idx
+	return &copy
+}
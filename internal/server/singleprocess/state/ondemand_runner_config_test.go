@@ -0,0 +1,104 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestOnDemandRunnerConfig(t *testing.T) {
+	t.Run("Get returns not found error if not exist", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		_, err := s.OnDemandRunnerConfigGet(&pb.Ref_OnDemandRunnerConfig{Name: "foo"})
+		require.Error(err)
+		require.Equal(codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Put and Get", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		// Set
+		err := s.OnDemandRunnerConfigPut(serverptypes.TestOnDemandRunnerConfig(t, &pb.OnDemandRunnerConfig{
+			Name: "foo",
+		}))
+		require.NoError(err)
+
+		// Get by name
+		{
+			resp, err := s.OnDemandRunnerConfigGet(&pb.Ref_OnDemandRunnerConfig{Name: "foo"})
+			require.NoError(err)
+			require.NotNil(resp)
+		}
+
+		// Get by name, case insensitive
+		{
+			resp, err := s.OnDemandRunnerConfigGet(&pb.Ref_OnDemandRunnerConfig{Name: "Foo"})
+			require.NoError(err)
+			require.NotNil(resp)
+		}
+
+		// List
+		{
+			resp, err := s.OnDemandRunnerConfigList()
+			require.NoError(err)
+			require.NotNil(resp)
+			require.Len(resp, 1)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		// We need two configurations
+		require.NoError(s.OnDemandRunnerConfigPut(serverptypes.TestOnDemandRunnerConfig(t, &pb.OnDemandRunnerConfig{
+			Name: "bar",
+		})))
+
+		// Set
+		err := s.OnDemandRunnerConfigPut(serverptypes.TestOnDemandRunnerConfig(t, &pb.OnDemandRunnerConfig{
+			Name: "baz",
+		}))
+		require.NoError(err)
+
+		// Read
+		resp, err := s.OnDemandRunnerConfigGet(&pb.Ref_OnDemandRunnerConfig{Name: "bar"})
+		require.NoError(err)
+		require.NotNil(resp)
+
+		// Delete
+		{
+			err := s.OnDemandRunnerConfigDelete(&pb.Ref_OnDemandRunnerConfig{Name: "bar"})
+			require.NoError(err)
+		}
+
+		// Read
+		{
+			_, err := s.OnDemandRunnerConfigGet(&pb.Ref_OnDemandRunnerConfig{Name: "bar"})
+			require.Error(err)
+			require.Equal(codes.NotFound, status.Code(err))
+		}
+
+		// List
+		{
+			resp, err := s.OnDemandRunnerConfigList()
+			require.NoError(err)
+			require.NotNil(resp)
+			require.Len(resp, 1)
+		}
+	})
+}
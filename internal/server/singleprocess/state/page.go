@@ -0,0 +1,52 @@
+package state
+
+import (
+	"encoding/base64"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Page requests a single page of results from a List call.
+//
+// The token is opaque to callers and is the NextToken of the previous
+// page, or empty for the first page. After the call, NextToken is set to
+// the token for the next page, or empty if this was the last page.
+type Page struct {
+	// Size is the maximum number of results to return. Zero or less
+	// returns all results after the token.
+	Size int
+
+	// Token is the token of the page to return.
+	Token string
+
+	// NextToken is set by the List call.
+	NextToken string
+}
+
+// after returns the ID of the last result of the previous page, or an
+// empty string if this is the first page.
+func (p *Page) after() (string, error) {
+	if p == nil || p.Token == "" {
+		return "", nil
+	}
+
+	id, err := base64.RawURLEncoding.DecodeString(p.Token)
+	if err != nil || len(id) == 0 {
+		return "", status.Errorf(codes.InvalidArgument, "invalid page token")
+	}
+
+	return string(id), nil
+}
+
+// full returns true if n results fill the page.
+func (p *Page) full(n int) bool {
+	return p != nil && p.Size > 0 && n >= p.Size
+}
+
+// setNext sets the token for the page after the result with the given ID.
+func (p *Page) setNext(id string) {
+	if p != nil {
+		p.NextToken = base64.RawURLEncoding.EncodeToString([]byte(id))
+	}
+}
@@ -0,0 +1,391 @@
+package state
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-memdb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+var (
+	pipelineBucket    = []byte("pipelines")
+	pipelineRunBucket = []byte("pipeline_runs")
+)
+
+func init() {
+	dbBuckets = append(dbBuckets, pipelineBucket, pipelineRunBucket)
+	dbIndexers = append(dbIndexers, (*State).pipelineIndexInit, (*State).pipelineRunIndexInit)
+	schemas = append(schemas, pipelineIndexSchema, pipelineRunIndexSchema)
+}
+
+// PipelinePut creates or updates the given pipeline.
+func (s *State) PipelinePut(p *pb.Pipeline) error {
+	memTxn := s.inmem.Txn(true)
+	defer memTxn.Abort()
+
+	id := pipelineId(p.Project, p.Name)
+	err := s.db.Update(func(dbTxn dbTx) error {
+		if err := dbPut(dbTxn.Bucket(pipelineBucket), []byte(id), p); err != nil {
+			return err
+		}
+
+		return s.pipelineIndexSet(memTxn, id, p)
+	})
+	if err == nil {
+		memTxn.Commit()
+	}
+
+	return err
+}
+
+// PipelineGet gets a pipeline by project and name. This returns a NotFound
+// error if the pipeline doesn't exist.
+func (s *State) PipelineGet(ref *pb.Ref_Project, name string) (*pb.Pipeline, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	raw, err := memTxn.First(
+		pipelineIndexTableName,
+		pipelineIndexIdIndexName,
+		pipelineId(ref, name),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, status.Errorf(codes.NotFound, "pipeline not found: %s", name)
+	}
+
+	return proto.Clone(raw.(*pipelineIndexRecord).Pipeline).(*pb.Pipeline), nil
+}
+
+// PipelineList returns the pipelines of a project sorted by name.
+func (s *State) PipelineList(ref *pb.Ref_Project) ([]*pb.Pipeline, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	iter, err := memTxn.Get(
+		pipelineIndexTableName,
+		pipelineIndexProjectIndexName,
+		ref.Project,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*pb.Pipeline
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		result = append(result,
+			proto.Clone(raw.(*pipelineIndexRecord).Pipeline).(*pb.Pipeline))
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+// PipelineRunCreate creates a new run of a pipeline. This sets the ID,
+// sequence, and queue time of the run.
+func (s *State) PipelineRunCreate(r *pb.PipelineRun) error {
+	id, err := ulid()
+	if err != nil {
+		return err
+	}
+
+	memTxn := s.inmem.Txn(true)
+	defer memTxn.Abort()
+
+	// Determine the sequence number. Runs are never deleted so the next
+	// sequence is one more than the largest one.
+	iter, err := memTxn.Get(
+		pipelineRunIndexTableName,
+		pipelineRunIndexPipelineIndexName,
+		pipelineId(r.Pipeline.Project, r.Pipeline.Name),
+	)
+	if err != nil {
+		return err
+	}
+	var seq uint64
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		if v := raw.(*pipelineRunIndexRecord).Sequence; v > seq {
+			seq = v
+		}
+	}
+
+	r.Id = id
+	r.Sequence = seq + 1
+	r.QueueTime = ptypes.TimestampNow()
+
+	err = s.db.Update(func(dbTxn dbTx) error {
+		return s.pipelineRunPut(dbTxn, memTxn, r)
+	})
+	if err == nil {
+		memTxn.Commit()
+	}
+
+	return err
+}
+
+// PipelineRunUpdate updates an existing run of a pipeline.
+func (s *State) PipelineRunUpdate(r *pb.PipelineRun) error {
+	memTxn := s.inmem.Txn(true)
+	defer memTxn.Abort()
+
+	raw, err := memTxn.First(
+		pipelineRunIndexTableName,
+		pipelineRunIndexIdIndexName,
+		r.Id,
+	)
+	if err != nil {
+		return err
+	}
+	if raw == nil {
+		return status.Errorf(codes.NotFound, "pipeline run not found: %s", r.Id)
+	}
+
+	err = s.db.Update(func(dbTxn dbTx) error {
+		return s.pipelineRunPut(dbTxn, memTxn, r)
+	})
+	if err == nil {
+		memTxn.Commit()
+	}
+
+	return err
+}
+
+// PipelineRunGet gets a pipeline run by ID. This returns a NotFound
+// error if the run doesn't exist.
+func (s *State) PipelineRunGet(id string) (*pb.PipelineRun, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	raw, err := memTxn.First(
+		pipelineRunIndexTableName,
+		pipelineRunIndexIdIndexName,
+		id,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, status.Errorf(codes.NotFound, "pipeline run not found: %s", id)
+	}
+
+	return proto.Clone(raw.(*pipelineRunIndexRecord).Run).(*pb.PipelineRun), nil
+}
+
+// PipelineRunList returns the runs of a pipeline, newest first. If
+// limit is greater than zero, at most that many runs are returned.
+func (s *State) PipelineRunList(ref *pb.Ref_Project, name string, limit int) ([]*pb.PipelineRun, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	iter, err := memTxn.Get(
+		pipelineRunIndexTableName,
+		pipelineRunIndexPipelineIndexName,
+		pipelineId(ref, name),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*pb.PipelineRun
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		result = append(result, raw.(*pipelineRunIndexRecord).Run)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Sequence > result[j].Sequence
+	})
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	for i, r := range result {
+		result[i] = proto.Clone(r).(*pb.PipelineRun)
+	}
+
+	return result, nil
+}
+
+// PipelineRunListActive returns the runs that are pending, running, or
+// waiting for approval. If ws is non-nil, it is notified when the set of
+// active runs or any of their states change.
+func (s *State) PipelineRunListActive(ws memdb.WatchSet) ([]*pb.PipelineRun, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	iter, err := memTxn.Get(
+		pipelineRunIndexTableName,
+		pipelineRunIndexActiveIndexName,
+		true,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if ws != nil {
+		ws.Add(iter.WatchCh())
+	}
+
+	var result []*pb.PipelineRun
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		result = append(result,
+			proto.Clone(raw.(*pipelineRunIndexRecord).Run).(*pb.PipelineRun))
+	}
+
+	return result, nil
+}
+
+func (s *State) pipelineRunPut(dbTxn dbTx, memTxn *memdb.Txn, r *pb.PipelineRun) error {
+	if err := dbPut(dbTxn.Bucket(pipelineRunBucket), []byte(r.Id), r); err != nil {
+		return err
+	}
+
+	return s.pipelineRunIndexSet(memTxn, r)
+}
+
+// pipelineIndexSet writes an index record for a pipeline.
+func (s *State) pipelineIndexSet(txn *memdb.Txn, id string, value *pb.Pipeline) error {
+	return txn.Insert(pipelineIndexTableName, &pipelineIndexRecord{
+		Id:       id,
+		Project:  value.Project.Project,
+		Pipeline: proto.Clone(value).(*pb.Pipeline),
+	})
+}
+
+// pipelineIndexInit initializes the pipeline index from persisted data.
+func (s *State) pipelineIndexInit(dbTxn dbTx, memTxn *memdb.Txn) error {
+	bucket := dbTxn.Bucket(pipelineBucket)
+	return bucket.ForEach(func(k, v []byte) error {
+		var value pb.Pipeline
+		if err := proto.Unmarshal(v, &value); err != nil {
+			return err
+		}
+
+		return s.pipelineIndexSet(memTxn, string(k), &value)
+	})
+}
+
+// pipelineRunIndexSet writes an index record for a pipeline run.
+func (s *State) pipelineRunIndexSet(txn *memdb.Txn, value *pb.PipelineRun) error {
+	return txn.Insert(pipelineRunIndexTableName, &pipelineRunIndexRecord{
+		Id:       value.Id,
+		Pipeline: pipelineId(value.Pipeline.Project, value.Pipeline.Name),
+		Sequence: value.Sequence,
+		Active: value.State == pb.PipelineRun_PENDING ||
+			value.State == pb.PipelineRun_RUNNING ||
+			value.State == pb.PipelineRun_WAITING_APPROVAL,
+		Run: proto.Clone(value).(*pb.PipelineRun),
+	})
+}
+
+// pipelineRunIndexInit initializes the pipeline run index from persisted data.
+func (s *State) pipelineRunIndexInit(dbTxn dbTx, memTxn *memdb.Txn) error {
+	bucket := dbTxn.Bucket(pipelineRunBucket)
+	return bucket.ForEach(func(k, v []byte) error {
+		var value pb.PipelineRun
+		if err := proto.Unmarshal(v, &value); err != nil {
+			return err
+		}
+
+		return s.pipelineRunIndexSet(memTxn, &value)
+	})
+}
+
+// pipelineId returns the ID used to store a pipeline.
+func pipelineId(ref *pb.Ref_Project, name string) string {
+	return strings.ToLower(fmt.Sprintf("%s/%s", ref.Project, name))
+}
+
+func pipelineIndexSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: pipelineIndexTableName,
+		Indexes: map[string]*memdb.IndexSchema{
+			pipelineIndexIdIndexName: {
+				Name:         pipelineIndexIdIndexName,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "Id",
+				},
+			},
+
+			pipelineIndexProjectIndexName: {
+				Name:         pipelineIndexProjectIndexName,
+				AllowMissing: false,
+				Unique:       false,
+				Indexer: &memdb.StringFieldIndex{
+					Field:     "Project",
+					Lowercase: true,
+				},
+			},
+		},
+	}
+}
+
+func pipelineRunIndexSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: pipelineRunIndexTableName,
+		Indexes: map[string]*memdb.IndexSchema{
+			pipelineRunIndexIdIndexName: {
+				Name:         pipelineRunIndexIdIndexName,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "Id",
+				},
+			},
+
+			pipelineRunIndexPipelineIndexName: {
+				Name:         pipelineRunIndexPipelineIndexName,
+				AllowMissing: false,
+				Unique:       false,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "Pipeline",
+				},
+			},
+
+			pipelineRunIndexActiveIndexName: {
+				Name:         pipelineRunIndexActiveIndexName,
+				AllowMissing: false,
+				Unique:       false,
+				Indexer: &memdb.BoolFieldIndex{
+					Field: "Active",
+				},
+			},
+		},
+	}
+}
+
+const (
+	pipelineIndexTableName        = "pipeline-index"
+	pipelineIndexIdIndexName      = "id"
+	pipelineIndexProjectIndexName = "project"
+
+	pipelineRunIndexTableName         = "pipeline-run-index"
+	pipelineRunIndexIdIndexName       = "id"
+	pipelineRunIndexPipelineIndexName = "pipeline"
+	pipelineRunIndexActiveIndexName   = "active"
+)
+
+type pipelineIndexRecord struct {
+	Id       string
+	Project  string
+	Pipeline *pb.Pipeline
+}
+
+type pipelineRunIndexRecord struct {
+	Id       string
+	Pipeline string
+	Sequence uint64
+	Active   bool
+	Run      *pb.PipelineRun
+}
@@ -0,0 +1,134 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestPipeline(t *testing.T) {
+	t.Run("put, get, and list", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		p := serverptypes.TestPipeline(t, &pb.Pipeline{Name: "b"})
+		require.NoError(s.PipelinePut(p))
+		require.NoError(s.PipelinePut(serverptypes.TestPipeline(t, &pb.Pipeline{Name: "a"})))
+		require.NoError(s.PipelinePut(serverptypes.TestPipeline(t, &pb.Pipeline{
+			Project: &pb.Ref_Project{Project: "other"},
+			Name:    "c",
+		})))
+
+		// Get is case insensitive
+		v, err := s.PipelineGet(&pb.Ref_Project{Project: "P_TEST"}, "B")
+		require.NoError(err)
+		require.Equal("b", v.Name)
+		require.Len(v.Steps, 1)
+
+		_, err = s.PipelineGet(p.Project, "nope")
+		require.Equal(codes.NotFound, status.Code(err))
+
+		list, err := s.PipelineList(p.Project)
+		require.NoError(err)
+		require.Len(list, 2)
+		require.Equal("a", list[0].Name)
+		require.Equal("b", list[1].Name)
+
+		// Update
+		p.Steps[0].Name = "compile"
+		require.NoError(s.PipelinePut(p))
+		v, err = s.PipelineGet(p.Project, "b")
+		require.NoError(err)
+		require.Equal("compile", v.Steps[0].Name)
+	})
+}
+
+func TestPipelineRun(t *testing.T) {
+	t.Run("create, update, and list", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		p := serverptypes.TestPipeline(t, nil)
+		run := func() *pb.PipelineRun {
+			return &pb.PipelineRun{
+				Pipeline: p,
+				State:    pb.PipelineRun_PENDING,
+			}
+		}
+
+		r1 := run()
+		require.NoError(s.PipelineRunCreate(r1))
+		require.NotEmpty(r1.Id)
+		require.Equal(uint64(1), r1.Sequence)
+		require.NotNil(r1.QueueTime)
+
+		r2 := run()
+		require.NoError(s.PipelineRunCreate(r2))
+		require.Equal(uint64(2), r2.Sequence)
+
+		// Both are active
+		active, err := s.PipelineRunListActive(nil)
+		require.NoError(err)
+		require.Len(active, 2)
+
+		// Complete one and make sure we're notified
+		ws := memdb.NewWatchSet()
+		_, err = s.PipelineRunListActive(ws)
+		require.NoError(err)
+
+		r1.State = pb.PipelineRun_SUCCESS
+		require.NoError(s.PipelineRunUpdate(r1))
+		require.False(ws.Watch(time.After(time.Second)))
+
+		active, err = s.PipelineRunListActive(nil)
+		require.NoError(err)
+		require.Len(active, 1)
+		require.Equal(r2.Id, active[0].Id)
+
+		// Runs waiting for approval are still active
+		r2.State = pb.PipelineRun_WAITING_APPROVAL
+		require.NoError(s.PipelineRunUpdate(r2))
+		active, err = s.PipelineRunListActive(nil)
+		require.NoError(err)
+		require.Len(active, 1)
+
+		v, err := s.PipelineRunGet(r1.Id)
+		require.NoError(err)
+		require.Equal(pb.PipelineRun_SUCCESS, v.State)
+
+		// Newest first
+		list, err := s.PipelineRunList(p.Project, p.Name, 0)
+		require.NoError(err)
+		require.Len(list, 2)
+		require.Equal(r2.Id, list[0].Id)
+
+		list, err = s.PipelineRunList(p.Project, p.Name, 1)
+		require.NoError(err)
+		require.Len(list, 1)
+		require.Equal(r2.Id, list[0].Id)
+	})
+
+	t.Run("update missing", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		err := s.PipelineRunUpdate(&pb.PipelineRun{Id: "nope"})
+		require.Equal(codes.NotFound, status.Code(err))
+
+		_, err = s.PipelineRunGet("nope")
+		require.Equal(codes.NotFound, status.Code(err))
+	})
+}
@@ -0,0 +1,251 @@
+package state
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	goversion "github.com/hashicorp/go-version"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+var (
+	pluginBinaryBucket     = []byte("plugin_binaries")
+	pluginBinaryBlobBucket = []byte("plugin_binary_blobs")
+)
+
+func init() {
+	dbBuckets = append(dbBuckets, pluginBinaryBucket, pluginBinaryBlobBucket)
+}
+
+// PluginBinaryPut stores the binary read from r. The checksum and size of
+// v must be set and must match the data. A binary that is already stored
+// for the name, version and platform can't be replaced, but storing the
+// same binary again succeeds without reading r.
+func (s *State) PluginBinaryPut(v *pb.PluginBinary, r io.Reader) error {
+	id := pluginBinaryId(v.Name, v.Version, v.Os, v.Arch)
+	v.UploadTime = ptypes.TimestampNow()
+
+	return s.db.Update(func(dbTxn dbTx) error {
+		b := dbTxn.Bucket(pluginBinaryBucket)
+
+		var existing pb.PluginBinary
+		err := dbGet(b, id, &existing)
+		if err == nil {
+			if existing.Checksum == v.Checksum {
+				proto.Merge(v, &existing)
+				return nil
+			}
+
+			return status.Errorf(codes.AlreadyExists,
+				"plugin %q version %s for %s/%s already exists with a different checksum",
+				v.Name, v.Version, v.Os, v.Arch)
+		}
+		if status.Code(err) != codes.NotFound {
+			return err
+		}
+
+		blobs := dbTxn.Bucket(pluginBinaryBlobBucket)
+		if blobs.Get(buildCacheChunkKey(v.Checksum, 0)) == nil {
+			if err := pluginBinaryBlobWrite(blobs, v, r); err != nil {
+				return err
+			}
+		}
+
+		return dbPut(b, id, v)
+	})
+}
+
+// PluginBinaryGet returns the binary of a plugin for a version and
+// platform. This returns a NotFound error if there is no such binary.
+func (s *State) PluginBinaryGet(name, version, os, arch string) (*pb.PluginBinary, error) {
+	var result pb.PluginBinary
+	err := s.db.View(func(dbTxn dbTx) error {
+		err := dbGet(dbTxn.Bucket(pluginBinaryBucket),
+			pluginBinaryId(name, version, os, arch), &result)
+		if status.Code(err) == codes.NotFound {
+			return status.Errorf(codes.NotFound,
+				"plugin %q version %s for %s/%s not found", name, version, os, arch)
+		}
+
+		return err
+	})
+
+	return &result, err
+}
+
+// PluginBinaryList returns the binaries that match the request. Empty
+// fields of the request match all binaries. The result is sorted by name
+// and then by version, newest first.
+func (s *State) PluginBinaryList(req *pb.ListPluginsRequest) ([]*pb.PluginBinary, error) {
+	var result []*pb.PluginBinary
+	err := s.db.View(func(dbTxn dbTx) error {
+		return dbTxn.Bucket(pluginBinaryBucket).ForEach(func(k, v []byte) error {
+			var value pb.PluginBinary
+			if err := proto.Unmarshal(v, &value); err != nil {
+				return err
+			}
+
+			if (req.Name != "" && !strings.EqualFold(req.Name, value.Name)) ||
+				(req.Os != "" && req.Os != value.Os) ||
+				(req.Arch != "" && req.Arch != value.Arch) {
+				return nil
+			}
+
+			result = append(result, &value)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+
+		// Versions are validated when they're stored, but be safe.
+		av, aerr := goversion.NewVersion(a.Version)
+		bv, berr := goversion.NewVersion(b.Version)
+		if aerr != nil || berr != nil {
+			return a.Version > b.Version
+		}
+
+		return av.GreaterThan(bv)
+	})
+
+	return result, nil
+}
+
+// PluginBinaryRead writes the binary with the given checksum to w.
+func (s *State) PluginBinaryRead(checksum string, w io.Writer) error {
+	return s.db.View(func(dbTxn dbTx) error {
+		prefix := []byte(checksum + "/")
+		c := dbTxn.Bucket(pluginBinaryBlobBucket).Cursor()
+		k, v := c.Seek(prefix)
+		if k == nil || !bytes.HasPrefix(k, prefix) {
+			return status.Errorf(codes.NotFound,
+				"plugin binary not found for checksum: %s", checksum)
+		}
+
+		for ; k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			if _, err := w.Write(v); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// PluginBinaryDelete deletes the binary of a plugin for a version and
+// platform. Deleting a binary that doesn't exist succeeds.
+func (s *State) PluginBinaryDelete(name, version, os, arch string) error {
+	return s.db.Update(func(dbTxn dbTx) error {
+		b := dbTxn.Bucket(pluginBinaryBucket)
+		id := pluginBinaryId(name, version, os, arch)
+
+		var existing pb.PluginBinary
+		if err := dbGet(b, id, &existing); err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		if err := b.Delete(id); err != nil {
+			return err
+		}
+
+		return pluginBinaryBlobGC(dbTxn, existing.Checksum)
+	})
+}
+
+// pluginBinaryBlobWrite writes the binary in chunks, verifying the checksum
+// and size. The chunks use the same layout as the build cache.
+func pluginBinaryBlobWrite(b dbBucket, v *pb.PluginBinary, r io.Reader) error {
+	h := sha256.New()
+	var size uint64
+	for idx := uint64(0); ; idx++ {
+		// Bolt requires values to stay valid for the life of the
+		// transaction so every chunk gets its own buffer.
+		buf := make([]byte, buildCacheChunkSize)
+		n, err := io.ReadFull(r, buf)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return status.Errorf(codes.Aborted, "error reading plugin binary: %s", err)
+		}
+
+		if n > 0 || idx == 0 {
+			h.Write(buf[:n])
+			size += uint64(n)
+			if err := b.Put(buildCacheChunkKey(v.Checksum, idx), buf[:n]); err != nil {
+				return status.Errorf(codes.Aborted, "failed to write data: %s", err)
+			}
+		}
+
+		if err != nil {
+			break
+		}
+	}
+
+	if checksum := hex.EncodeToString(h.Sum(nil)); checksum != v.Checksum || size != v.Size {
+		return status.Errorf(codes.InvalidArgument,
+			"plugin binary doesn't match, expected %s (%d bytes), got %s (%d bytes)",
+			v.Checksum, v.Size, checksum, size)
+	}
+
+	return nil
+}
+
+// pluginBinaryBlobGC deletes the data for the checksum if no binary
+// references it.
+func pluginBinaryBlobGC(dbTxn dbTx, checksum string) error {
+	inUse := false
+	err := dbTxn.Bucket(pluginBinaryBucket).ForEach(func(k, v []byte) error {
+		var value pb.PluginBinary
+		if err := proto.Unmarshal(v, &value); err != nil {
+			return err
+		}
+
+		inUse = inUse || value.Checksum == checksum
+		return nil
+	})
+	if err != nil || inUse {
+		return err
+	}
+
+	// Collect the keys first since deleting while iterating with a
+	// cursor skips keys.
+	b := dbTxn.Bucket(pluginBinaryBlobBucket)
+	prefix := []byte(checksum + "/")
+	var keys [][]byte
+	c := b.Cursor()
+	for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+		keys = append(keys, k)
+	}
+	for _, k := range keys {
+		if err := b.Delete(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pluginBinaryId returns the ID used to store a binary. Plugin names are
+// case-insensitive.
+func pluginBinaryId(name, version, os, arch string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s", strings.ToLower(name), version, os, arch))
+}
@@ -0,0 +1,115 @@
+package state
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestPluginBinary(t *testing.T) {
+	binary := func(name, version string, data []byte) *pb.PluginBinary {
+		sum := sha256.Sum256(data)
+		return &pb.PluginBinary{
+			Name:     name,
+			Version:  version,
+			Os:       "linux",
+			Arch:     "amd64",
+			Checksum: hex.EncodeToString(sum[:]),
+			Size:     uint64(len(data)),
+		}
+	}
+
+	t.Run("put, read and list", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		data := []byte("v1")
+		require.NoError(s.PluginBinaryPut(binary("kubernetes", "0.4.0", data), bytes.NewReader(data)))
+		data2 := []byte("v2")
+		require.NoError(s.PluginBinaryPut(binary("kubernetes", "0.10.0", data2), bytes.NewReader(data2)))
+		require.NoError(s.PluginBinaryPut(binary("nomad", "1.0.0", data), bytes.NewReader(data)))
+
+		v, err := s.PluginBinaryGet("kubernetes", "0.4.0", "linux", "amd64")
+		require.NoError(err)
+		require.NotNil(v.UploadTime)
+
+		var buf bytes.Buffer
+		require.NoError(s.PluginBinaryRead(v.Checksum, &buf))
+		require.Equal(data, buf.Bytes())
+
+		// Newest version first
+		list, err := s.PluginBinaryList(&pb.ListPluginsRequest{Name: "kubernetes"})
+		require.NoError(err)
+		require.Len(list, 2)
+		require.Equal("0.10.0", list[0].Version)
+		require.Equal("0.4.0", list[1].Version)
+
+		list, err = s.PluginBinaryList(&pb.ListPluginsRequest{Os: "darwin"})
+		require.NoError(err)
+		require.Empty(list)
+	})
+
+	t.Run("binaries can't be replaced", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		data := []byte("v1")
+		require.NoError(s.PluginBinaryPut(binary("kubernetes", "0.4.0", data), bytes.NewReader(data)))
+
+		// The same binary again is fine
+		require.NoError(s.PluginBinaryPut(binary("kubernetes", "0.4.0", data), bytes.NewReader(data)))
+
+		other := []byte("other")
+		err := s.PluginBinaryPut(binary("kubernetes", "0.4.0", other), bytes.NewReader(other))
+		require.Error(err)
+		require.Equal(codes.AlreadyExists, status.Code(err))
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		err := s.PluginBinaryPut(binary("kubernetes", "0.4.0", []byte("v1")), bytes.NewReader([]byte("v2")))
+		require.Error(err)
+		require.Equal(codes.InvalidArgument, status.Code(err))
+
+		_, err = s.PluginBinaryGet("kubernetes", "0.4.0", "linux", "amd64")
+		require.Equal(codes.NotFound, status.Code(err))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		data := []byte("v1")
+		v1 := binary("kubernetes", "0.4.0", data)
+		require.NoError(s.PluginBinaryPut(v1, bytes.NewReader(data)))
+		require.NoError(s.PluginBinaryPut(binary("nomad", "1.0.0", data), bytes.NewReader(data)))
+
+		// The data is kept while another binary uses it
+		require.NoError(s.PluginBinaryDelete("kubernetes", "0.4.0", "linux", "amd64"))
+		require.NoError(s.PluginBinaryRead(v1.Checksum, &bytes.Buffer{}))
+
+		require.NoError(s.PluginBinaryDelete("nomad", "1.0.0", "linux", "amd64"))
+		err := s.PluginBinaryRead(v1.Checksum, &bytes.Buffer{})
+		require.Equal(codes.NotFound, status.Code(err))
+
+		// Deleting again is fine
+		require.NoError(s.PluginBinaryDelete("nomad", "1.0.0", "linux", "amd64"))
+	})
+}
@@ -0,0 +1,197 @@
+package state
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/go-memdb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+var previewBucket = []byte("previews")
+
+func init() {
+	dbBuckets = append(dbBuckets, previewBucket)
+	dbIndexers = append(dbIndexers, (*State).previewIndexInit)
+	schemas = append(schemas, previewIndexSchema)
+}
+
+// PreviewPut creates or updates the given preview.
+func (s *State) PreviewPut(v *pb.Preview) error {
+	memTxn := s.inmem.Txn(true)
+	defer memTxn.Abort()
+
+	id := previewId(v.Project, v.Number)
+	err := s.db.Update(func(dbTxn dbTx) error {
+		if err := dbPut(dbTxn.Bucket(previewBucket), []byte(id), v); err != nil {
+			return err
+		}
+
+		return s.previewIndexSet(memTxn, id, v)
+	})
+	if err == nil {
+		memTxn.Commit()
+	}
+
+	return err
+}
+
+// PreviewGet gets a preview by project and pull request number. This
+// returns a NotFound error if the preview doesn't exist.
+func (s *State) PreviewGet(ref *pb.Ref_Project, number uint64) (*pb.Preview, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	raw, err := memTxn.First(
+		previewIndexTableName,
+		previewIndexIdIndexName,
+		previewId(ref, number),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, status.Errorf(codes.NotFound, "preview not found: %d", number)
+	}
+
+	return proto.Clone(raw.(*previewIndexRecord).Preview).(*pb.Preview), nil
+}
+
+// PreviewList returns the previews of a project sorted by pull request
+// number.
+func (s *State) PreviewList(ref *pb.Ref_Project) ([]*pb.Preview, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	iter, err := memTxn.Get(
+		previewIndexTableName,
+		previewIndexProjectIndexName,
+		ref.Project,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*pb.Preview
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		result = append(result,
+			proto.Clone(raw.(*previewIndexRecord).Preview).(*pb.Preview))
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Number < result[j].Number
+	})
+
+	return result, nil
+}
+
+// PreviewListActive returns the previews that are deploying or being
+// destroyed. If ws is non-nil, it is notified when the set of active
+// previews or any of their states change.
+func (s *State) PreviewListActive(ws memdb.WatchSet) ([]*pb.Preview, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	iter, err := memTxn.Get(
+		previewIndexTableName,
+		previewIndexActiveIndexName,
+		true,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if ws != nil {
+		ws.Add(iter.WatchCh())
+	}
+
+	var result []*pb.Preview
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		result = append(result,
+			proto.Clone(raw.(*previewIndexRecord).Preview).(*pb.Preview))
+	}
+
+	return result, nil
+}
+
+// previewIndexSet writes an index record for a preview.
+func (s *State) previewIndexSet(txn *memdb.Txn, id string, value *pb.Preview) error {
+	return txn.Insert(previewIndexTableName, &previewIndexRecord{
+		Id:      id,
+		Project: value.Project.Project,
+		Active: value.State == pb.Preview_DEPLOYING ||
+			value.State == pb.Preview_DESTROYING,
+		Preview: proto.Clone(value).(*pb.Preview),
+	})
+}
+
+// previewIndexInit initializes the preview index from persisted data.
+func (s *State) previewIndexInit(dbTxn dbTx, memTxn *memdb.Txn) error {
+	bucket := dbTxn.Bucket(previewBucket)
+	return bucket.ForEach(func(k, v []byte) error {
+		var value pb.Preview
+		if err := proto.Unmarshal(v, &value); err != nil {
+			return err
+		}
+
+		return s.previewIndexSet(memTxn, string(k), &value)
+	})
+}
+
+// previewId returns the ID used to store a preview.
+func previewId(ref *pb.Ref_Project, number uint64) string {
+	return strings.ToLower(fmt.Sprintf("%s/%d", ref.Project, number))
+}
+
+func previewIndexSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: previewIndexTableName,
+		Indexes: map[string]*memdb.IndexSchema{
+			previewIndexIdIndexName: {
+				Name:         previewIndexIdIndexName,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "Id",
+				},
+			},
+
+			previewIndexProjectIndexName: {
+				Name:         previewIndexProjectIndexName,
+				AllowMissing: false,
+				Unique:       false,
+				Indexer: &memdb.StringFieldIndex{
+					Field:     "Project",
+					Lowercase: true,
+				},
+			},
+
+			previewIndexActiveIndexName: {
+				Name:         previewIndexActiveIndexName,
+				AllowMissing: false,
+				Unique:       false,
+				Indexer: &memdb.BoolFieldIndex{
+					Field: "Active",
+				},
+			},
+		},
+	}
+}
+
+const (
+	previewIndexTableName        = "preview-index"
+	previewIndexIdIndexName      = "id"
+	previewIndexProjectIndexName = "project"
+	previewIndexActiveIndexName  = "active"
+)
+
+type previewIndexRecord struct {
+	Id      string
+	Project string
+	Active  bool
+	Preview *pb.Preview
+}
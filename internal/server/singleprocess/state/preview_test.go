@@ -0,0 +1,68 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestPreview(t *testing.T) {
+	t.Run("put, get, and list", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		v := serverptypes.TestPreview(t, &pb.Preview{Number: 2})
+		require.NoError(s.PreviewPut(v))
+		require.NoError(s.PreviewPut(serverptypes.TestPreview(t, &pb.Preview{Number: 1})))
+		require.NoError(s.PreviewPut(serverptypes.TestPreview(t, &pb.Preview{
+			Project: &pb.Ref_Project{Project: "other"},
+		})))
+
+		// Get is case insensitive
+		result, err := s.PreviewGet(&pb.Ref_Project{Project: "P_TEST"}, 2)
+		require.NoError(err)
+		require.Equal(uint64(2), result.Number)
+
+		_, err = s.PreviewGet(v.Project, 42)
+		require.Equal(codes.NotFound, status.Code(err))
+
+		list, err := s.PreviewList(v.Project)
+		require.NoError(err)
+		require.Len(list, 2)
+		require.Equal(uint64(1), list[0].Number)
+		require.Equal(uint64(2), list[1].Number)
+	})
+
+	t.Run("list active", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		v := serverptypes.TestPreview(t, nil)
+		require.NoError(s.PreviewPut(v))
+
+		ws := memdb.NewWatchSet()
+		list, err := s.PreviewListActive(ws)
+		require.NoError(err)
+		require.Len(list, 1)
+
+		// Ready previews aren't active
+		v.State = pb.Preview_READY
+		require.NoError(s.PreviewPut(v))
+		require.False(ws.Watch(time.After(50 * time.Millisecond)))
+
+		list, err = s.PreviewListActive(nil)
+		require.NoError(err)
+		require.Empty(list)
+	})
+}
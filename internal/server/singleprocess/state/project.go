@@ -6,11 +6,11 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hashicorp/go-memdb"
-	bolt "go.etcd.io/bbolt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
 )
 
 var projectBucket = []byte("project")
@@ -28,7 +28,7 @@ func (s *State) ProjectPut(p *pb.Project) error {
 	memTxn := s.inmem.Txn(true)
 	defer memTxn.Abort()
 
-	err := s.db.Update(func(dbTxn *bolt.Tx) error {
+	err := s.db.Update(func(dbTxn dbTx) error {
 		prev, err := s.projectGet(dbTxn, memTxn, &pb.Ref_Project{
 			Project: p.Name,
 		})
@@ -56,7 +56,7 @@ func (s *State) ProjectGet(ref *pb.Ref_Project) (*pb.Project, error) {
 	defer memTxn.Abort()
 
 	var result *pb.Project
-	err := s.db.View(func(dbTxn *bolt.Tx) error {
+	err := s.db.View(func(dbTxn dbTx) error {
 		var err error
 		result, err = s.projectGet(dbTxn, memTxn, ref)
 		return err
@@ -72,7 +72,7 @@ func (s *State) ProjectDelete(ref *pb.Ref_Project) error {
 	memTxn := s.inmem.Txn(true)
 	defer memTxn.Abort()
 
-	err := s.db.Update(func(dbTxn *bolt.Tx) error {
+	err := s.db.Update(func(dbTxn dbTx) error {
 		return s.projectDelete(dbTxn, memTxn, ref)
 	})
 	if err == nil {
@@ -87,7 +87,34 @@ func (s *State) ProjectList() ([]*pb.Ref_Project, error) {
 	memTxn := s.inmem.Txn(false)
 	defer memTxn.Abort()
 
-	return s.projectList(memTxn)
+	return s.projectList(memTxn, nil, nil)
+}
+
+// ProjectListPage returns a single page of projects sorted by name. The
+// token for the next page is set on p. If labels is non-empty, only
+// projects that have all the labels with the same values are returned.
+func (s *State) ProjectListPage(p *Page, labels map[string]string) ([]*pb.Ref_Project, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	return s.projectList(memTxn, p, labels)
+}
+
+// ProjectListWatch returns the list of all projects. The WatchSet is
+// notified when any project is created, updated, or deleted.
+func (s *State) ProjectListWatch(ws memdb.WatchSet) ([]*pb.Ref_Project, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	// LowerBound doesn't support watches so we have to do a Get first
+	// to get a valid watch channel on the projects.
+	iter, err := memTxn.Get(projectIndexTableName, projectIndexIdIndexName+"_prefix", "")
+	if err != nil {
+		return nil, err
+	}
+	ws.Add(iter.WatchCh())
+
+	return s.projectList(memTxn, nil, nil)
 }
 
 // ProjectListWorkspaces returns the list of workspaces that a project is in.
@@ -96,7 +123,7 @@ func (s *State) ProjectListWorkspaces(ref *pb.Ref_Project) ([]*pb.Workspace_Proj
 	defer memTxn.Abort()
 
 	var results []*pb.Workspace_Project
-	err := s.db.View(func(dbTxn *bolt.Tx) error {
+	err := s.db.View(func(dbTxn dbTx) error {
 		var err error
 		results, err = s.workspaceListProjects(dbTxn, memTxn, ref)
 		return err
@@ -158,7 +185,7 @@ func (s *State) ProjectPollPeek(ws memdb.WatchSet) (*pb.Project, time.Time, erro
 	}
 
 	var result *pb.Project
-	err = s.db.View(func(dbTxn *bolt.Tx) error {
+	err = s.db.View(func(dbTxn dbTx) error {
 		var err error
 		result, err = s.projectGet(dbTxn, memTxn, &pb.Ref_Project{
 			Project: rec.Id,
@@ -218,7 +245,7 @@ func (s *State) ProjectUpdateDataRef(
 	memTxn := s.inmem.Txn(true)
 	defer memTxn.Abort()
 
-	err := s.db.Update(func(dbTxn *bolt.Tx) error {
+	err := s.db.Update(func(dbTxn dbTx) error {
 		return s.workspaceUpdateProjectDataRef(dbTxn, memTxn, ws, ref, dataRef)
 	})
 	if err != nil {
@@ -229,7 +256,7 @@ func (s *State) ProjectUpdateDataRef(
 	return nil
 }
 
-func (s *State) projectGetOrCreate(dbTxn *bolt.Tx, memTxn *memdb.Txn, ref *pb.Ref_Project) (*pb.Project, error) {
+func (s *State) projectGetOrCreate(dbTxn dbTx, memTxn *memdb.Txn, ref *pb.Ref_Project) (*pb.Project, error) {
 	result, err := s.projectGet(dbTxn, memTxn, ref)
 	if status.Code(err) == codes.NotFound {
 		result = nil
@@ -250,7 +277,7 @@ func (s *State) projectGetOrCreate(dbTxn *bolt.Tx, memTxn *memdb.Txn, ref *pb.Re
 }
 
 func (s *State) projectPut(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	value *pb.Project,
 ) error {
@@ -276,7 +303,7 @@ func (s *State) projectPut(
 }
 
 func (s *State) projectGet(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	ref *pb.Ref_Project,
 ) (*pb.Project, error) {
@@ -287,8 +314,21 @@ func (s *State) projectGet(
 
 func (s *State) projectList(
 	memTxn *memdb.Txn,
+	p *Page,
+	labels map[string]string,
 ) ([]*pb.Ref_Project, error) {
-	iter, err := memTxn.Get(projectIndexTableName, projectIndexIdIndexName+"_prefix", "")
+	// Projects are sorted by ID, so the next page starts at the first
+	// project after the last one of the previous page. This works even
+	// if that project was deleted.
+	after, err := p.after()
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		p.NextToken = ""
+	}
+
+	iter, err := memTxn.LowerBound(projectIndexTableName, projectIndexIdIndexName, after)
 	if err != nil {
 		return nil, err
 	}
@@ -300,6 +340,17 @@ func (s *State) projectList(
 			break
 		}
 		idx := next.(*projectIndexRecord)
+		if after != "" && idx.Id == after {
+			continue
+		}
+		if !serverptypes.LabelsMatch(labels, idx.Labels) {
+			continue
+		}
+
+		if p.full(len(result)) {
+			p.setNext(result[len(result)-1].Project)
+			break
+		}
 
 		result = append(result, &pb.Ref_Project{
 			Project: idx.Id,
@@ -310,7 +361,7 @@ func (s *State) projectList(
 }
 
 func (s *State) projectDelete(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	ref *pb.Ref_Project,
 ) error {
@@ -352,8 +403,11 @@ func (s *State) projectDelete(
 func (s *State) projectIndexSet(txn *memdb.Txn, id []byte, value *pb.Project) error {
 	record := &projectIndexRecord{
 		Id:            string(id),
+		Labels:        value.Labels,
 		Poll:          false, // being explicit that we want to default poll to false
 		AppStatusPoll: false, // application polling off by default until a deployment or release happens
+
+		MaxConcurrentJobs: value.MaxConcurrentJobs,
 	}
 
 	// This entire if block sets up polling tracking for the project. In the
@@ -464,7 +518,7 @@ func (s *State) projectIndexSet(txn *memdb.Txn, id []byte, value *pb.Project) er
 }
 
 // projectIndexInit initializes the project index from persisted data.
-func (s *State) projectIndexInit(dbTxn *bolt.Tx, memTxn *memdb.Txn) error {
+func (s *State) projectIndexInit(dbTxn dbTx, memTxn *memdb.Txn) error {
 	bucket := dbTxn.Bucket(projectBucket)
 	return bucket.ForEach(func(k, v []byte) error {
 		var value pb.Project
@@ -562,6 +616,14 @@ const (
 type projectIndexRecord struct {
 	Id string
 
+	// Labels are the labels of the project, used to select projects
+	// when listing.
+	Labels map[string]string
+
+	// MaxConcurrentJobs is the limit of jobs of the project that can be
+	// assigned at once. This is zero if there is no limit.
+	MaxConcurrentJobs uint32
+
 	// Project polling is used for updating the project from a remote source
 	// on an interval
 
@@ -0,0 +1,215 @@
+package state
+
+import (
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/go-memdb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+var projectTemplateBucket = []byte("project_template")
+
+func init() {
+	dbBuckets = append(dbBuckets, projectTemplateBucket)
+	dbIndexers = append(dbIndexers, (*State).projectTemplateIndexInit)
+	schemas = append(schemas, projectTemplateSchema)
+}
+
+// ProjectTemplatePut creates or updates the given project template.
+func (s *State) ProjectTemplatePut(v *pb.ProjectTemplate) error {
+	memTxn := s.inmem.Txn(true)
+	defer memTxn.Abort()
+
+	err := s.db.Update(func(dbTxn dbTx) error {
+		return s.projectTemplatePut(dbTxn, memTxn, v)
+	})
+	if err == nil {
+		memTxn.Commit()
+	}
+
+	return err
+}
+
+// ProjectTemplateGet gets a project template by reference.
+func (s *State) ProjectTemplateGet(ref *pb.Ref_ProjectTemplate) (*pb.ProjectTemplate, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	var result *pb.ProjectTemplate
+	err := s.db.View(func(dbTxn dbTx) error {
+		var err error
+		result, err = s.projectTemplateGet(dbTxn, memTxn, ref)
+		return err
+	})
+
+	return result, err
+}
+
+// ProjectTemplateDelete deletes a project template by reference.
+func (s *State) ProjectTemplateDelete(ref *pb.Ref_ProjectTemplate) error {
+	memTxn := s.inmem.Txn(true)
+	defer memTxn.Abort()
+
+	err := s.db.Update(func(dbTxn dbTx) error {
+		return s.projectTemplateDelete(dbTxn, memTxn, ref)
+	})
+	if err == nil {
+		memTxn.Commit()
+	}
+
+	return err
+}
+
+// ProjectTemplateList returns the list of project templates.
+func (s *State) ProjectTemplateList() ([]*pb.ProjectTemplate, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	iter, err := memTxn.Get(projectTemplateTableName, projectTemplateIdIndexName+"_prefix", "")
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*pb.ProjectTemplate
+	for {
+		next := iter.Next()
+		if next == nil {
+			break
+		}
+		idx := next.(*projectTemplateIndex)
+
+		var v *pb.ProjectTemplate
+		err = s.db.View(func(dbTxn dbTx) error {
+			v, err = s.projectTemplateGet(dbTxn, memTxn, &pb.Ref_ProjectTemplate{Name: idx.Id})
+			return err
+		})
+
+		result = append(result, v)
+	}
+
+	return result, nil
+}
+
+func (s *State) projectTemplatePut(
+	dbTxn dbTx,
+	memTxn *memdb.Txn,
+	value *pb.ProjectTemplate,
+) error {
+	id := s.projectTemplateId(value)
+
+	// Get the global bucket and write the value to it.
+	b := dbTxn.Bucket(projectTemplateBucket)
+	if err := dbPut(b, id, value); err != nil {
+		return err
+	}
+
+	// Create our index value and write that.
+	return s.projectTemplateIndexSet(memTxn, id, value)
+}
+
+func (s *State) projectTemplateGet(
+	dbTxn dbTx,
+	memTxn *memdb.Txn,
+	ref *pb.Ref_ProjectTemplate,
+) (*pb.ProjectTemplate, error) {
+	var result pb.ProjectTemplate
+	b := dbTxn.Bucket(projectTemplateBucket)
+	return &result, dbGet(b, []byte(strings.ToLower(ref.Name)), &result)
+}
+
+func (s *State) projectTemplateDelete(
+	dbTxn dbTx,
+	memTxn *memdb.Txn,
+	ref *pb.Ref_ProjectTemplate,
+) error {
+	// Get the project template. If it doesn't exist then we're successful.
+	v, err := s.projectTemplateGet(dbTxn, memTxn, ref)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil
+		}
+
+		return err
+	}
+
+	// Delete from bolt
+	id := s.projectTemplateId(v)
+	bucket := dbTxn.Bucket(projectTemplateBucket)
+	if err := bucket.Delete(id); err != nil {
+		return err
+	}
+
+	// Delete from memdb
+	if err := memTxn.Delete(projectTemplateTableName, &projectTemplateIndex{Id: string(id)}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// projectTemplateIndexSet writes an index record for a single project template.
+func (s *State) projectTemplateIndexSet(txn *memdb.Txn, id []byte, value *pb.ProjectTemplate) error {
+	record := &projectTemplateIndex{
+		Id: string(id),
+	}
+
+	// Insert the index
+	return txn.Insert(projectTemplateTableName, record)
+}
+
+// projectTemplateIndexInit initializes the project template index from persisted data.
+func (s *State) projectTemplateIndexInit(dbTxn dbTx, memTxn *memdb.Txn) error {
+	bucket := dbTxn.Bucket(projectTemplateBucket)
+	return bucket.ForEach(func(k, v []byte) error {
+		var value pb.ProjectTemplate
+		if err := proto.Unmarshal(v, &value); err != nil {
+			return err
+		}
+		if err := s.projectTemplateIndexSet(memTxn, k, &value); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (s *State) projectTemplateId(v *pb.ProjectTemplate) []byte {
+	return []byte(strings.ToLower(v.Name))
+}
+
+func projectTemplateSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: projectTemplateTableName,
+		Indexes: map[string]*memdb.IndexSchema{
+			projectTemplateIdIndexName: {
+				Name:         projectTemplateIdIndexName,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.StringFieldIndex{
+					Field:     "Id",
+					Lowercase: true,
+				},
+			},
+		},
+	}
+}
+
+const (
+	projectTemplateTableName   = "project-templates"
+	projectTemplateIdIndexName = "id"
+)
+
+type projectTemplateIndex struct {
+	Id string // Unique ID, we just use the template name
+}
+
+// Copy should be called prior to any modifications to an existing record.
+func (idx *projectTemplateIndex) Copy() *projectTemplateIndex {
+	// A shallow copy is good enough since we only modify top-level fields.
+	copy := *idx
+	return &copy
+}
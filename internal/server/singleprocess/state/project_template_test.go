@@ -0,0 +1,104 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestProjectTemplate(t *testing.T) {
+	t.Run("Get returns not found error if not exist", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		_, err := s.ProjectTemplateGet(&pb.Ref_ProjectTemplate{Name: "foo"})
+		require.Error(err)
+		require.Equal(codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Put and Get", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		// Set
+		err := s.ProjectTemplatePut(serverptypes.TestProjectTemplate(t, &pb.ProjectTemplate{
+			Name: "foo",
+		}))
+		require.NoError(err)
+
+		// Get by name
+		{
+			resp, err := s.ProjectTemplateGet(&pb.Ref_ProjectTemplate{Name: "foo"})
+			require.NoError(err)
+			require.NotNil(resp)
+		}
+
+		// Get by name, case insensitive
+		{
+			resp, err := s.ProjectTemplateGet(&pb.Ref_ProjectTemplate{Name: "Foo"})
+			require.NoError(err)
+			require.NotNil(resp)
+		}
+
+		// List
+		{
+			resp, err := s.ProjectTemplateList()
+			require.NoError(err)
+			require.NotNil(resp)
+			require.Len(resp, 1)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		// We need two templates
+		require.NoError(s.ProjectTemplatePut(serverptypes.TestProjectTemplate(t, &pb.ProjectTemplate{
+			Name: "bar",
+		})))
+
+		// Set
+		err := s.ProjectTemplatePut(serverptypes.TestProjectTemplate(t, &pb.ProjectTemplate{
+			Name: "baz",
+		}))
+		require.NoError(err)
+
+		// Read
+		resp, err := s.ProjectTemplateGet(&pb.Ref_ProjectTemplate{Name: "bar"})
+		require.NoError(err)
+		require.NotNil(resp)
+
+		// Delete
+		{
+			err := s.ProjectTemplateDelete(&pb.Ref_ProjectTemplate{Name: "bar"})
+			require.NoError(err)
+		}
+
+		// Read
+		{
+			_, err := s.ProjectTemplateGet(&pb.Ref_ProjectTemplate{Name: "bar"})
+			require.Error(err)
+			require.Equal(codes.NotFound, status.Code(err))
+		}
+
+		// List
+		{
+			resp, err := s.ProjectTemplateList()
+			require.NoError(err)
+			require.NotNil(resp)
+			require.Len(resp, 1)
+		}
+	})
+}
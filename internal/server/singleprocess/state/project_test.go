@@ -160,6 +160,92 @@ func TestProject(t *testing.T) {
 			require.Len(resp, 0)
 		}
 	})
+
+	t.Run("List with paging", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		for _, name := range []string{"c", "a", "e", "b", "d"} {
+			require.NoError(s.ProjectPut(serverptypes.TestProject(t, &pb.Project{
+				Name: name,
+			})))
+		}
+
+		// First page
+		page := &Page{Size: 2}
+		resp, err := s.ProjectListPage(page, nil)
+		require.NoError(err)
+		require.Equal([]string{"a", "b"}, projectNames(resp))
+		require.NotEmpty(page.NextToken)
+
+		// Deleting the last project of a page doesn't affect the next page
+		require.NoError(s.ProjectDelete(&pb.Ref_Project{Project: "b"}))
+
+		page.Token = page.NextToken
+		resp, err = s.ProjectListPage(page, nil)
+		require.NoError(err)
+		require.Equal([]string{"c", "d"}, projectNames(resp))
+
+		// Last page
+		page.Token = page.NextToken
+		resp, err = s.ProjectListPage(page, nil)
+		require.NoError(err)
+		require.Equal([]string{"e"}, projectNames(resp))
+		require.Empty(page.NextToken)
+
+		// No page size returns everything
+		resp, err = s.ProjectListPage(&Page{}, nil)
+		require.NoError(err)
+		require.Len(resp, 4)
+	})
+
+	t.Run("List with labels", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		require.NoError(s.ProjectPut(serverptypes.TestProject(t, &pb.Project{
+			Name:   "a",
+			Labels: map[string]string{"team": "payments", "env": "prod"},
+		})))
+		require.NoError(s.ProjectPut(serverptypes.TestProject(t, &pb.Project{
+			Name:   "b",
+			Labels: map[string]string{"team": "search"},
+		})))
+		require.NoError(s.ProjectPut(serverptypes.TestProject(t, &pb.Project{
+			Name: "c",
+		})))
+
+		resp, err := s.ProjectListPage(nil, map[string]string{"team": "payments"})
+		require.NoError(err)
+		require.Equal([]string{"a"}, projectNames(resp))
+
+		resp, err = s.ProjectListPage(nil, map[string]string{"team": "payments", "env": "dev"})
+		require.NoError(err)
+		require.Empty(resp)
+
+		// Labels are updated with the project
+		require.NoError(s.ProjectPut(serverptypes.TestProject(t, &pb.Project{
+			Name:   "c",
+			Labels: map[string]string{"team": "payments"},
+		})))
+
+		resp, err = s.ProjectListPage(nil, map[string]string{"team": "payments"})
+		require.NoError(err)
+		require.Equal([]string{"a", "c"}, projectNames(resp))
+	})
+}
+
+func projectNames(refs []*pb.Ref_Project) []string {
+	var result []string
+	for _, ref := range refs {
+		result = append(result, ref.Project)
+	}
+
+	return result
 }
 
 func TestProjectPollPeek(t *testing.T) {
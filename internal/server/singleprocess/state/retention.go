@@ -0,0 +1,323 @@
+package state
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// RetentionPolicy configures which old records PruneRetention deletes.
+//
+// A record is kept if any of the enabled rules keeps it. If neither
+// rule is enabled, nothing is deleted. Regardless of the policy, the
+// newest record of each application and workspace, records that haven't
+// completed, live deployments and releases, and the operations they
+// reference are always kept.
+type RetentionPolicy struct {
+	// KeepLast keeps the newest KeepLast records of each type for each
+	// application and workspace. Zero disables this rule.
+	KeepLast int
+
+	// MaxAge keeps records that completed within MaxAge. Zero disables
+	// this rule.
+	MaxAge time.Duration
+}
+
+// errRetentionDryRun is returned in the transaction to roll it back
+// for dry runs.
+var errRetentionDryRun = errors.New("dry run")
+
+// retentionOps are the operations pruned by PruneRetention. The order
+// matters: records that are kept protect the records they reference, so
+// referencing operations must come before the operations they reference.
+var retentionOps = []*appOperation{
+	statusReportOp,
+	releaseOp,
+	deploymentOp,
+	artifactOp,
+	buildOp,
+}
+
+// PruneRetention deletes the records that the policy doesn't keep from
+// the database. Unlike Prune, which only removes old records from the
+// in-memory indexes, this frees space on disk. It returns the number of
+// records deleted by table. If dryRun is true, nothing is deleted and
+// the returned counts are the records that would have been deleted.
+func (s *State) PruneRetention(p *RetentionPolicy, dryRun bool) (map[string]int, error) {
+	result := map[string]int{}
+	if p == nil || (p.KeepLast <= 0 && p.MaxAge <= 0) {
+		return result, nil
+	}
+
+	var cutoff time.Time
+	if p.MaxAge > 0 {
+		cutoff = time.Now().Add(-p.MaxAge)
+	}
+
+	memTxn := s.inmem.Txn(true)
+	defer memTxn.Abort()
+
+	// The number of records removed from the in-memory indexes. The index
+	// counters are only updated once the transaction is committed.
+	unindexed := map[*appOperation]int{}
+	var unindexedJobs int
+
+	err := s.db.Update(func(dbTxn dbTx) error {
+		protected := map[string]struct{}{}
+		for _, op := range retentionOps {
+			var records []*retentionRecord
+			err := dbTxn.Bucket(op.Bucket).ForEach(func(k, v []byte) error {
+				value := op.newStruct()
+				if err := proto.Unmarshal(v, value); err != nil {
+					return err
+				}
+
+				rec := op.retentionRecord(value)
+				if _, ok := protected[rec.Id]; ok {
+					rec.Keep = true
+				}
+
+				records = append(records, rec)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			deleted := retentionSelect(records, p, cutoff)
+			for _, rec := range records {
+				if _, ok := deleted[rec.Id]; ok {
+					continue
+				}
+
+				for _, ref := range rec.Refs {
+					protected[ref] = struct{}{}
+				}
+			}
+
+			for id := range deleted {
+				if err := dbTxn.Bucket(op.Bucket).Delete([]byte(id)); err != nil {
+					return err
+				}
+
+				raw, err := memTxn.First(op.memTableName(), opIdIndexName, id)
+				if err != nil {
+					return err
+				}
+				if raw != nil {
+					if err := memTxn.Delete(op.memTableName(), raw); err != nil {
+						return err
+					}
+
+					unindexed[op]++
+				}
+			}
+
+			result[op.memTableName()] = len(deleted)
+		}
+
+		// Jobs. Their stored output is deleted with them.
+		var records []*retentionRecord
+		err := dbTxn.Bucket(jobBucket).ForEach(func(k, v []byte) error {
+			var job pb.Job
+			if err := proto.Unmarshal(v, &job); err != nil {
+				return err
+			}
+
+			rec := &retentionRecord{
+				Id:    job.Id,
+				Group: retentionGroup(job.Application, job.Workspace),
+				Time:  retentionTime(job.QueueTime, job.CompleteTime),
+				Keep:  !jobIsCompleted(job.State),
+			}
+
+			records = append(records, rec)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		deleted := retentionSelect(records, p, cutoff)
+		for id := range deleted {
+			if err := dbTxn.Bucket(jobBucket).Delete([]byte(id)); err != nil {
+				return err
+			}
+			if err := s.jobLogDelete(dbTxn, id); err != nil {
+				return err
+			}
+
+			raw, err := memTxn.First(jobTableName, jobIdIndexName, id)
+			if err != nil {
+				return err
+			}
+			if raw != nil {
+				if err := memTxn.Delete(jobTableName, raw); err != nil {
+					return err
+				}
+
+				unindexedJobs++
+			}
+		}
+		result[jobTableName] = len(deleted)
+
+		if dryRun {
+			return errRetentionDryRun
+		}
+
+		return nil
+	})
+	if err == errRetentionDryRun {
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	memTxn.Commit()
+
+	// Update our index counters for the records we removed.
+	for op, n := range unindexed {
+		if op.MaximumIndexedRecords != 0 {
+			op.pruneMu.Lock()
+			op.indexedRecords -= n
+			op.pruneMu.Unlock()
+		}
+	}
+
+	s.pruneMu.Lock()
+	s.indexedJobs -= unindexedJobs
+	s.pruneMu.Unlock()
+
+	s.log.Debug("finished pruning records by retention policy", "deleted", result)
+	return result, nil
+}
+
+// retentionRecord is a record considered for pruning by PruneRetention.
+type retentionRecord struct {
+	Id string
+
+	// Group is the key that KeepLast applies to, usually the application
+	// and workspace.
+	Group string
+
+	// Time is the time of the record used for ordering and MaxAge.
+	Time time.Time
+
+	// Keep is true if this record must be kept regardless of the policy.
+	Keep bool
+
+	// Refs are the IDs of other records that must be kept if this record
+	// is kept.
+	Refs []string
+}
+
+// retentionRecord returns the retention record for an operation value.
+func (op *appOperation) retentionRecord(value proto.Message) *retentionRecord {
+	rec := &retentionRecord{
+		Id: op.valueField(value, "Id").(string),
+		Group: retentionGroup(
+			op.valueField(value, "Application").(*pb.Ref_Application),
+			op.valueField(value, "Workspace").(*pb.Ref_Workspace),
+		),
+	}
+
+	// Operations that haven't completed are always kept.
+	st, _ := op.valueField(value, "Status").(*pb.Status)
+	switch st.GetState() {
+	case pb.Status_SUCCESS, pb.Status_ERROR:
+		rec.Time = retentionTime(st.StartTime, st.CompleteTime)
+	default:
+		rec.Keep = true
+	}
+
+	// Live deployments and releases are always kept, and the operations
+	// they are made from are kept with them.
+	switch v := value.(type) {
+	case *pb.Release:
+		rec.Keep = rec.Keep || v.State == pb.Operation_CREATED
+		rec.Refs = []string{v.DeploymentId}
+
+	case *pb.Deployment:
+		rec.Keep = rec.Keep || v.State == pb.Operation_CREATED
+		rec.Refs = []string{v.ArtifactId}
+
+	case *pb.PushedArtifact:
+		rec.Refs = []string{v.BuildId}
+	}
+
+	return rec
+}
+
+// retentionSelect returns the IDs of the records that the policy doesn't
+// keep. The newest record of each group is always kept.
+func retentionSelect(
+	records []*retentionRecord,
+	p *RetentionPolicy,
+	cutoff time.Time,
+) map[string]struct{} {
+	groups := map[string][]*retentionRecord{}
+	for _, rec := range records {
+		groups[rec.Group] = append(groups[rec.Group], rec)
+	}
+
+	result := map[string]struct{}{}
+	for _, group := range groups {
+		// Newest first
+		sort.Slice(group, func(i, j int) bool {
+			if group[i].Time.Equal(group[j].Time) {
+				return group[i].Id > group[j].Id
+			}
+
+			return group[i].Time.After(group[j].Time)
+		})
+
+		for i, rec := range group {
+			if i == 0 || rec.Keep {
+				continue
+			}
+			if p.KeepLast > 0 && i < p.KeepLast {
+				continue
+			}
+			if p.MaxAge > 0 && rec.Time.After(cutoff) {
+				continue
+			}
+
+			result[rec.Id] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// retentionGroup returns the group key for an application and workspace.
+func retentionGroup(app *pb.Ref_Application, ws *pb.Ref_Workspace) string {
+	return strings.ToLower(app.GetProject()) + "/" +
+		strings.ToLower(app.GetApplication()) + "/" +
+		ws.GetWorkspace()
+}
+
+// retentionTime returns the latest of the given timestamps. Unset and
+// invalid timestamps are ignored.
+func retentionTime(ts ...*timestamp.Timestamp) time.Time {
+	var result time.Time
+	for _, t := range ts {
+		if t == nil {
+			continue
+		}
+
+		v, err := ptypes.Timestamp(t)
+		if err == nil && v.After(result) {
+			result = v
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,215 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestPruneRetention(t *testing.T) {
+	// testStatus returns a completed status that is the given age.
+	testStatus := func(t *testing.T, age time.Duration) *pb.Status {
+		ts, err := ptypes.TimestampProto(time.Now().Add(-age))
+		require.NoError(t, err)
+
+		return &pb.Status{
+			State:        pb.Status_SUCCESS,
+			StartTime:    ts,
+			CompleteTime: ts,
+		}
+	}
+
+	day := 24 * time.Hour
+
+	// Creates builds "A" to "E" where "A" is the oldest.
+	putBuilds := func(t *testing.T, s *State) {
+		for i, id := range []string{"A", "B", "C", "D", "E"} {
+			require.NoError(t, s.BuildPut(false, serverptypes.TestValidBuild(t, &pb.Build{
+				Id:     id,
+				Status: testStatus(t, time.Duration(5-i)*day),
+			})))
+		}
+	}
+
+	// Returns the IDs of the builds that exist.
+	buildIds := func(t *testing.T, s *State) []string {
+		var result []string
+		for _, id := range []string{"A", "B", "C", "D", "E"} {
+			_, err := s.BuildGet(appOpById(id))
+			if status.Code(err) == codes.NotFound {
+				continue
+			}
+			require.NoError(t, err)
+
+			result = append(result, id)
+		}
+
+		return result
+	}
+
+	t.Run("no policy", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+		putBuilds(t, s)
+
+		deleted, err := s.PruneRetention(&RetentionPolicy{}, false)
+		require.NoError(err)
+		require.Empty(deleted)
+		require.Len(buildIds(t, s), 5)
+	})
+
+	t.Run("keep last", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+		putBuilds(t, s)
+
+		// Dry run
+		deleted, err := s.PruneRetention(&RetentionPolicy{KeepLast: 2}, true)
+		require.NoError(err)
+		require.Equal(3, deleted["build"])
+		require.Len(buildIds(t, s), 5)
+
+		// Prune
+		deleted, err = s.PruneRetention(&RetentionPolicy{KeepLast: 2}, false)
+		require.NoError(err)
+		require.Equal(3, deleted["build"])
+		require.Equal([]string{"D", "E"}, buildIds(t, s))
+	})
+
+	t.Run("max age", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+		putBuilds(t, s)
+
+		deleted, err := s.PruneRetention(&RetentionPolicy{MaxAge: 3*day + time.Hour}, false)
+		require.NoError(err)
+		require.Equal(2, deleted["build"])
+		require.Equal([]string{"C", "D", "E"}, buildIds(t, s))
+	})
+
+	t.Run("both rules keep", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+		putBuilds(t, s)
+
+		// Keeping the last 4 keeps more than the max age
+		_, err := s.PruneRetention(&RetentionPolicy{
+			KeepLast: 4,
+			MaxAge:   day + time.Hour,
+		}, false)
+		require.NoError(err)
+		require.Equal([]string{"B", "C", "D", "E"}, buildIds(t, s))
+	})
+
+	t.Run("newest is always kept", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+		putBuilds(t, s)
+
+		_, err := s.PruneRetention(&RetentionPolicy{MaxAge: time.Hour}, false)
+		require.NoError(err)
+		require.Equal([]string{"E"}, buildIds(t, s))
+	})
+
+	t.Run("live deployments and their operations are kept", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+		putBuilds(t, s)
+
+		// An old live deployment of build "A"
+		require.NoError(s.ArtifactPut(false, serverptypes.TestValidArtifact(t, &pb.PushedArtifact{
+			Id:      "art-A",
+			BuildId: "A",
+			Status:  testStatus(t, 5*day),
+		})))
+		require.NoError(s.ArtifactPut(false, serverptypes.TestValidArtifact(t, &pb.PushedArtifact{
+			Id:      "art-E",
+			BuildId: "E",
+			Status:  testStatus(t, day),
+		})))
+		require.NoError(s.DeploymentPut(false, serverptypes.TestValidDeployment(t, &pb.Deployment{
+			Id:         "dep-A",
+			ArtifactId: "art-A",
+			State:      pb.Operation_CREATED,
+			Status:     testStatus(t, 5*day),
+		})))
+		require.NoError(s.DeploymentPut(false, serverptypes.TestValidDeployment(t, &pb.Deployment{
+			Id:         "dep-E",
+			ArtifactId: "art-E",
+			State:      pb.Operation_CREATED,
+			Status:     testStatus(t, day),
+		})))
+
+		deleted, err := s.PruneRetention(&RetentionPolicy{KeepLast: 1}, false)
+		require.NoError(err)
+		require.Equal(0, deleted["deployment"])
+		require.Equal(0, deleted["artifact"])
+		require.Equal([]string{"A", "E"}, buildIds(t, s))
+	})
+
+	t.Run("jobs", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		old, err := ptypes.TimestampProto(time.Now().Add(-5 * day))
+		require.NoError(err)
+		recent, err := ptypes.TimestampProto(time.Now().Add(-time.Hour))
+		require.NoError(err)
+
+		// "A" completed long ago, "B" completed recently, and "C" was
+		// queued long ago but hasn't completed.
+		require.NoError(s.JobCreate(serverptypes.TestJobNew(t, &pb.Job{Id: "A"})))
+		require.NoError(s.JobCreate(serverptypes.TestJobNew(t, &pb.Job{Id: "B"})))
+		require.NoError(s.JobCreate(serverptypes.TestJobNew(t, &pb.Job{Id: "C"})))
+		for id, ts := range map[string]*timestamp.Timestamp{"A": old, "B": recent} {
+			_, err := s.jobReadAndUpdate(id, func(job *pb.Job) error {
+				job.State = pb.Job_SUCCESS
+				job.QueueTime = ts
+				job.CompleteTime = ts
+				return nil
+			})
+			require.NoError(err)
+		}
+		_, err = s.jobReadAndUpdate("C", func(job *pb.Job) error {
+			job.QueueTime = old
+			return nil
+		})
+		require.NoError(err)
+
+		// Only "A" is deleted
+		deleted, err := s.PruneRetention(&RetentionPolicy{MaxAge: day}, false)
+		require.NoError(err)
+		require.Equal(1, deleted["jobs"])
+
+		job, err := s.JobById("A", nil)
+		require.NoError(err)
+		require.Nil(job)
+		for _, id := range []string{"B", "C"} {
+			job, err = s.JobById(id, nil)
+			require.NoError(err)
+			require.NotNil(job)
+		}
+	})
+}
@@ -1,6 +1,10 @@
 package state
 
 import (
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/hashicorp/go-memdb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -13,7 +17,13 @@ const (
 	runnerIdIndexName = "id"
 )
 
+// runnerAdoptionBucket stores the adoption decisions of runners by ID.
+// Runners are only stored in memory while they're connected, but their
+// adoption must survive reconnects and server restarts.
+var runnerAdoptionBucket = []byte("runner_adoption")
+
 func init() {
+	dbBuckets = append(dbBuckets, runnerAdoptionBucket)
 	schemas = append(schemas, runnerSchema)
 }
 
@@ -42,7 +52,21 @@ type runnerRecord struct {
 	Id string
 }
 
+// RunnerCreate registers a runner. If the runner was rejected before, or
+// is pending adoption and was adopted before, its adoption state is set
+// to that.
 func (s *State) RunnerCreate(r *pb.Runner) error {
+	state, err := s.runnerAdoptionGet(r.Id)
+	if err != nil {
+		return err
+	}
+	if state == pb.Runner_REJECTED || r.AdoptionState == pb.Runner_PENDING {
+		r.AdoptionState = state
+	}
+
+	r.FirstSeen = ptypes.TimestampNow()
+	r.LastSeen = r.FirstSeen
+
 	txn := s.inmem.Txn(true)
 	defer txn.Abort()
 
@@ -67,13 +91,16 @@ func (s *State) RunnerDelete(id string) error {
 	return nil
 }
 
-func (s *State) RunnerById(id string) (*pb.Runner, error) {
+// RunnerById returns a registered runner by ID. If ws is not nil, it is
+// notified when the runner changes or is deleted.
+func (s *State) RunnerById(id string, ws memdb.WatchSet) (*pb.Runner, error) {
 	txn := s.inmem.Txn(false)
-	raw, err := txn.First(runnerTableName, runnerIdIndexName, id)
+	watchCh, raw, err := txn.FirstWatch(runnerTableName, runnerIdIndexName, id)
 	txn.Abort()
 	if err != nil {
 		return nil, err
 	}
+	ws.Add(watchCh)
 	if raw == nil {
 		return nil, status.Errorf(codes.NotFound, "runner ID not found")
 	}
@@ -81,6 +108,121 @@ func (s *State) RunnerById(id string) (*pb.Runner, error) {
 	return raw.(*runnerRecord).Runner, nil
 }
 
+// RunnerList returns all of the registered runners.
+func (s *State) RunnerList() ([]*pb.Runner, error) {
+	txn := s.inmem.Txn(false)
+	defer txn.Abort()
+
+	iter, err := txn.Get(runnerTableName, runnerIdIndexName+"_prefix", "")
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*pb.Runner
+	for {
+		raw := iter.Next()
+		if raw == nil {
+			break
+		}
+
+		result = append(result, raw.(*runnerRecord).Runner)
+	}
+
+	return result, nil
+}
+
+// RunnerHeartbeat updates the last time the registered runner with the
+// given ID was seen to now.
+func (s *State) RunnerHeartbeat(id string) error {
+	txn := s.inmem.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(runnerTableName, runnerIdIndexName, id)
+	if err != nil {
+		return err
+	}
+	if raw == nil {
+		return status.Errorf(codes.NotFound, "runner ID not found")
+	}
+
+	r := proto.Clone(raw.(*runnerRecord).Runner).(*pb.Runner)
+	r.LastSeen = ptypes.TimestampNow()
+	if err := txn.Insert(runnerTableName, newRunnerRecord(r)); err != nil {
+		return status.Errorf(codes.Aborted, err.Error())
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// RunnerAdopt adopts the runner with the given ID so that it's assigned
+// jobs. The runner doesn't have to be registered.
+func (s *State) RunnerAdopt(id string) error {
+	return s.runnerAdoptionSet(id, pb.Runner_ADOPTED)
+}
+
+// RunnerReject rejects the runner with the given ID so that it can't
+// register. The runner doesn't have to be registered.
+func (s *State) RunnerReject(id string) error {
+	return s.runnerAdoptionSet(id, pb.Runner_REJECTED)
+}
+
+// runnerAdoptionSet stores the adoption state of a runner and updates the
+// runner if it is registered.
+func (s *State) runnerAdoptionSet(id string, state pb.Runner_AdoptionState) error {
+	memTxn := s.inmem.Txn(true)
+	defer memTxn.Abort()
+
+	err := s.db.Update(func(dbTxn dbTx) error {
+		return dbPut(dbTxn.Bucket(runnerAdoptionBucket), runnerAdoptionId(id), &pb.Runner{
+			Id:            id,
+			AdoptionState: state,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	raw, err := memTxn.First(runnerTableName, runnerIdIndexName, id)
+	if err != nil {
+		return err
+	}
+	if raw != nil {
+		// Records are never modified in place so that readers don't race.
+		r := proto.Clone(raw.(*runnerRecord).Runner).(*pb.Runner)
+		r.AdoptionState = state
+		if err := memTxn.Insert(runnerTableName, newRunnerRecord(r)); err != nil {
+			return status.Errorf(codes.Aborted, err.Error())
+		}
+	}
+
+	memTxn.Commit()
+	return nil
+}
+
+// runnerAdoptionGet returns the stored adoption state of a runner. This is
+// PENDING if the runner was never adopted or rejected.
+func (s *State) runnerAdoptionGet(id string) (pb.Runner_AdoptionState, error) {
+	var result pb.Runner
+	err := s.db.View(func(dbTxn dbTx) error {
+		return dbGet(dbTxn.Bucket(runnerAdoptionBucket), runnerAdoptionId(id), &result)
+	})
+	if status.Code(err) == codes.NotFound {
+		return pb.Runner_PENDING, nil
+	}
+	if err != nil {
+		return pb.Runner_PENDING, err
+	}
+
+	return result.AdoptionState, nil
+}
+
+// runnerAdoptionId returns the key of a runner in the adoption bucket.
+// Runner IDs are case-insensitive like the memdb index.
+func runnerAdoptionId(id string) []byte {
+	return []byte(strings.ToLower(id))
+}
+
 // runnerEmpty returns true if there are no runners registered.
 func (s *State) runnerEmpty(memTxn *memdb.Txn) (bool, error) {
 	iter, err := memTxn.LowerBound(runnerTableName, runnerIdIndexName, "")
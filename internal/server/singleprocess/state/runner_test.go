@@ -2,7 +2,9 @@ package state
 
 import (
 	"testing"
+	"time"
 
+	"github.com/hashicorp/go-memdb"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -21,7 +23,7 @@ func TestRunner_crud(t *testing.T) {
 	require.NoError(s.RunnerCreate(rec))
 
 	// We should be able to find it
-	found, err := s.RunnerById(rec.Id)
+	found, err := s.RunnerById(rec.Id, nil)
 	require.NoError(err)
 	require.Equal(rec, found)
 
@@ -29,7 +31,7 @@ func TestRunner_crud(t *testing.T) {
 	require.NoError(s.RunnerDelete(rec.Id))
 
 	// We should not find it
-	found, err = s.RunnerById(rec.Id)
+	found, err = s.RunnerById(rec.Id, nil)
 	require.Error(err)
 	require.Nil(found)
 	require.Equal(codes.NotFound, status.Code(err))
@@ -38,6 +40,26 @@ func TestRunner_crud(t *testing.T) {
 	require.NoError(s.RunnerDelete(rec.Id))
 }
 
+func TestRunnerList(t *testing.T) {
+	require := require.New(t)
+
+	s := TestState(t)
+	defer s.Close()
+
+	// Empty to start
+	list, err := s.RunnerList()
+	require.NoError(err)
+	require.Empty(list)
+
+	// Create some runners
+	require.NoError(s.RunnerCreate(&pb.Runner{Id: "A"}))
+	require.NoError(s.RunnerCreate(&pb.Runner{Id: "B"}))
+
+	list, err = s.RunnerList()
+	require.NoError(err)
+	require.Len(list, 2)
+}
+
 func TestRunnerById_notFound(t *testing.T) {
 	require := require.New(t)
 
@@ -45,8 +67,97 @@ func TestRunnerById_notFound(t *testing.T) {
 	defer s.Close()
 
 	// We should be able to find it
-	found, err := s.RunnerById("nope")
+	found, err := s.RunnerById("nope", nil)
 	require.Error(err)
 	require.Nil(found)
 	require.Equal(codes.NotFound, status.Code(err))
 }
+
+func TestRunnerHeartbeat(t *testing.T) {
+	require := require.New(t)
+
+	s := TestState(t)
+	defer s.Close()
+
+	// Not registered
+	err := s.RunnerHeartbeat("A")
+	require.Error(err)
+	require.Equal(codes.NotFound, status.Code(err))
+
+	// Registering sets the seen times
+	require.NoError(s.RunnerCreate(&pb.Runner{Id: "A"}))
+	found, err := s.RunnerById("A", nil)
+	require.NoError(err)
+	require.NotNil(found.FirstSeen)
+	require.Equal(found.FirstSeen, found.LastSeen)
+
+	// Heartbeat
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(s.RunnerHeartbeat("A"))
+	updated, err := s.RunnerById("A", nil)
+	require.NoError(err)
+	require.Equal(found.FirstSeen, updated.FirstSeen)
+	require.True(updated.LastSeen.AsTime().After(found.LastSeen.AsTime()))
+}
+
+func TestRunnerAdoption(t *testing.T) {
+	t.Run("pending until adopted", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		require.NoError(s.RunnerCreate(&pb.Runner{Id: "A"}))
+
+		ws := memdb.NewWatchSet()
+		found, err := s.RunnerById("A", ws)
+		require.NoError(err)
+		require.Equal(pb.Runner_PENDING, found.AdoptionState)
+
+		// Adopting updates the registered runner
+		require.NoError(s.RunnerAdopt("A"))
+		require.False(ws.Watch(time.After(time.Second)))
+
+		found, err = s.RunnerById("A", nil)
+		require.NoError(err)
+		require.Equal(pb.Runner_ADOPTED, found.AdoptionState)
+
+		// The adoption is kept when the runner registers again
+		require.NoError(s.RunnerDelete("A"))
+		require.NoError(s.RunnerCreate(&pb.Runner{Id: "a"}))
+		found, err = s.RunnerById("A", nil)
+		require.NoError(err)
+		require.Equal(pb.Runner_ADOPTED, found.AdoptionState)
+	})
+
+	t.Run("rejected before registering", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		require.NoError(s.RunnerReject("A"))
+		require.NoError(s.RunnerCreate(&pb.Runner{Id: "A"}))
+
+		found, err := s.RunnerById("A", nil)
+		require.NoError(err)
+		require.Equal(pb.Runner_REJECTED, found.AdoptionState)
+	})
+
+	t.Run("rejection overrides preadoption", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		require.NoError(s.RunnerReject("A"))
+		require.NoError(s.RunnerCreate(&pb.Runner{
+			Id:            "A",
+			AdoptionState: pb.Runner_PREADOPTED,
+		}))
+
+		found, err := s.RunnerById("A", nil)
+		require.NoError(err)
+		require.Equal(pb.Runner_REJECTED, found.AdoptionState)
+	})
+}
@@ -3,7 +3,6 @@ package state
 import (
 	"github.com/golang/protobuf/proto"
 	"github.com/hashicorp/go-memdb"
-	bolt "go.etcd.io/bbolt"
 
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 )
@@ -24,7 +23,7 @@ func (s *State) ServerConfigSet(c *pb.ServerConfig) error {
 	memTxn := s.inmem.Txn(true)
 	defer memTxn.Abort()
 
-	err := s.db.Update(func(dbTxn *bolt.Tx) error {
+	err := s.db.Update(func(dbTxn dbTx) error {
 		return s.serverConfigSet(dbTxn, memTxn, c)
 	})
 	if err == nil {
@@ -55,7 +54,7 @@ func (s *State) ServerConfigGet() (*pb.ServerConfig, error) {
 }
 
 func (s *State) serverConfigSet(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	value *pb.ServerConfig,
 ) error {
@@ -94,7 +93,7 @@ func (s *State) serverConfigIndexSet(txn *memdb.Txn, id []byte, value *pb.Server
 }
 
 // serverConfigIndexInit initializes the server config index from persisted data.
-func (s *State) serverConfigIndexInit(dbTxn *bolt.Tx, memTxn *memdb.Txn) error {
+func (s *State) serverConfigIndexInit(dbTxn dbTx, memTxn *memdb.Txn) error {
 	bucket := dbTxn.Bucket(serverConfigBucket)
 
 	data := bucket.Get(serverConfigId)
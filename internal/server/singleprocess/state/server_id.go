@@ -1,16 +1,12 @@
 package state
 
-import (
-	bolt "go.etcd.io/bbolt"
-)
-
 var (
 	serverIdKey = []byte("id")
 )
 
 // ServerIdSet writes the server ID.
 func (s *State) ServerIdSet(id string) error {
-	return s.db.Update(func(dbTxn *bolt.Tx) error {
+	return s.db.Update(func(dbTxn dbTx) error {
 		return dbTxn.Bucket(serverConfigBucket).Put(serverIdKey, []byte(id))
 	})
 }
@@ -18,7 +14,7 @@ func (s *State) ServerIdSet(id string) error {
 // ServerIdGet gets the server ID.
 func (s *State) ServerIdGet() (string, error) {
 	var result string
-	err := s.db.View(func(dbTxn *bolt.Tx) error {
+	err := s.db.View(func(dbTxn dbTx) error {
 		result = string(dbTxn.Bucket(serverConfigBucket).Get(serverIdKey))
 		return nil
 	})
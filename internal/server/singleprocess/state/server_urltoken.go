@@ -1,16 +1,12 @@
 package state
 
-import (
-	bolt "go.etcd.io/bbolt"
-)
-
 var (
 	serverURLToken = []byte("url_token")
 )
 
 // ServerURLTokenSet writes the server URL token.
 func (s *State) ServerURLTokenSet(token string) error {
-	return s.db.Update(func(dbTxn *bolt.Tx) error {
+	return s.db.Update(func(dbTxn dbTx) error {
 		return dbTxn.Bucket(serverConfigBucket).Put(serverURLToken, []byte(token))
 	})
 }
@@ -18,7 +14,7 @@ func (s *State) ServerURLTokenSet(token string) error {
 // ServerURLTokenGet gets the server URL token.
 func (s *State) ServerURLTokenGet() (string, error) {
 	var result string
-	err := s.db.View(func(dbTxn *bolt.Tx) error {
+	err := s.db.View(func(dbTxn dbTx) error {
 		result = string(dbTxn.Bucket(serverConfigBucket).Get(serverURLToken))
 		return nil
 	})
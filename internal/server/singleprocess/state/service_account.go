@@ -0,0 +1,252 @@
+package state
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-memdb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+var serviceAccountBucket = []byte("service_account")
+
+func init() {
+	dbBuckets = append(dbBuckets, serviceAccountBucket)
+	dbIndexers = append(dbIndexers, (*State).serviceAccountIndexInit)
+	schemas = append(schemas, serviceAccountSchema)
+}
+
+// ServiceAccountCreate creates a new service account. The ID and created
+// time are set on the given value. This returns a codes.AlreadyExists
+// error if a service account with the same name exists.
+func (s *State) ServiceAccountCreate(v *pb.ServiceAccount) error {
+	id, err := ulid()
+	if err != nil {
+		return err
+	}
+	v.Id = id
+	v.CreatedTime = ptypes.TimestampNow()
+
+	memTxn := s.inmem.Txn(true)
+	defer memTxn.Abort()
+
+	err = s.db.Update(func(dbTxn dbTx) error {
+		existing, err := memTxn.First(
+			serviceAccountTableName, serviceAccountNameIndexName, v.Name)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return status.Errorf(codes.AlreadyExists,
+				"service account %q already exists", v.Name)
+		}
+
+		return s.serviceAccountPut(dbTxn, memTxn, v)
+	})
+	if err == nil {
+		memTxn.Commit()
+	}
+
+	return err
+}
+
+// ServiceAccountGet gets a service account by reference.
+func (s *State) ServiceAccountGet(ref *pb.Ref_ServiceAccount) (*pb.ServiceAccount, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	var result *pb.ServiceAccount
+	err := s.db.View(func(dbTxn dbTx) error {
+		var err error
+		result, err = s.serviceAccountGet(dbTxn, memTxn, ref)
+		return err
+	})
+
+	return result, err
+}
+
+// ServiceAccountGetId gets a service account by ID. This is used to look
+// up the service account of a token.
+func (s *State) ServiceAccountGetId(id string) (*pb.ServiceAccount, error) {
+	var result pb.ServiceAccount
+	err := s.db.View(func(dbTxn dbTx) error {
+		b := dbTxn.Bucket(serviceAccountBucket)
+		return dbGet(b, []byte(id), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ServiceAccountList returns the list of service accounts.
+func (s *State) ServiceAccountList() ([]*pb.ServiceAccount, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	iter, err := memTxn.Get(serviceAccountTableName, serviceAccountNameIndexName+"_prefix", "")
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*pb.ServiceAccount
+	err = s.db.View(func(dbTxn dbTx) error {
+		b := dbTxn.Bucket(serviceAccountBucket)
+		for {
+			next := iter.Next()
+			if next == nil {
+				return nil
+			}
+			idx := next.(*serviceAccountIndexRecord)
+
+			var v pb.ServiceAccount
+			if err := dbGet(b, []byte(idx.Id), &v); err != nil {
+				return err
+			}
+
+			result = append(result, &v)
+		}
+	})
+
+	return result, err
+}
+
+// ServiceAccountDelete deletes a service account by reference. This
+// succeeds if the service account doesn't exist.
+func (s *State) ServiceAccountDelete(ref *pb.Ref_ServiceAccount) error {
+	memTxn := s.inmem.Txn(true)
+	defer memTxn.Abort()
+
+	err := s.db.Update(func(dbTxn dbTx) error {
+		v, err := s.serviceAccountGet(dbTxn, memTxn, ref)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		if err := dbTxn.Bucket(serviceAccountBucket).Delete([]byte(v.Id)); err != nil {
+			return err
+		}
+
+		return memTxn.Delete(serviceAccountTableName, &serviceAccountIndexRecord{Id: v.Id})
+	})
+	if err == nil {
+		memTxn.Commit()
+	}
+
+	return err
+}
+
+func (s *State) serviceAccountPut(
+	dbTxn dbTx,
+	memTxn *memdb.Txn,
+	value *pb.ServiceAccount,
+) error {
+	id := []byte(value.Id)
+
+	// Get the global bucket and write the value to it.
+	b := dbTxn.Bucket(serviceAccountBucket)
+	if err := dbPut(b, id, value); err != nil {
+		return err
+	}
+
+	// Create our index value and write that.
+	return s.serviceAccountIndexSet(memTxn, id, value)
+}
+
+func (s *State) serviceAccountGet(
+	dbTxn dbTx,
+	memTxn *memdb.Txn,
+	ref *pb.Ref_ServiceAccount,
+) (*pb.ServiceAccount, error) {
+	raw, err := memTxn.First(
+		serviceAccountTableName, serviceAccountNameIndexName, ref.Name)
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, status.Errorf(codes.NotFound,
+			"service account %q not found", ref.Name)
+	}
+	idx := raw.(*serviceAccountIndexRecord)
+
+	var result pb.ServiceAccount
+	b := dbTxn.Bucket(serviceAccountBucket)
+	if err := dbGet(b, []byte(idx.Id), &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// serviceAccountIndexSet writes an index record for a single service account.
+func (s *State) serviceAccountIndexSet(txn *memdb.Txn, id []byte, value *pb.ServiceAccount) error {
+	return txn.Insert(serviceAccountTableName, &serviceAccountIndexRecord{
+		Id:   string(id),
+		Name: value.Name,
+	})
+}
+
+// serviceAccountIndexInit initializes the service account index from
+// persisted data.
+func (s *State) serviceAccountIndexInit(dbTxn dbTx, memTxn *memdb.Txn) error {
+	bucket := dbTxn.Bucket(serviceAccountBucket)
+	return bucket.ForEach(func(k, v []byte) error {
+		var value pb.ServiceAccount
+		if err := proto.Unmarshal(v, &value); err != nil {
+			return err
+		}
+
+		return s.serviceAccountIndexSet(memTxn, k, &value)
+	})
+}
+
+func serviceAccountSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: serviceAccountTableName,
+		Indexes: map[string]*memdb.IndexSchema{
+			serviceAccountIdIndexName: {
+				Name:         serviceAccountIdIndexName,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "Id",
+				},
+			},
+
+			serviceAccountNameIndexName: {
+				Name:         serviceAccountNameIndexName,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.StringFieldIndex{
+					Field:     "Name",
+					Lowercase: true,
+				},
+			},
+		},
+	}
+}
+
+const (
+	serviceAccountTableName     = "service-account-index"
+	serviceAccountIdIndexName   = "id"
+	serviceAccountNameIndexName = "name"
+)
+
+type serviceAccountIndexRecord struct {
+	Id   string
+	Name string
+}
+
+// Copy should be called prior to any modifications to an existing record.
+func (idx *serviceAccountIndexRecord) Copy() *serviceAccountIndexRecord {
+	// A shallow copy is good enough since we only modify top-level fields.
+	copy := *idx
+	return &copy
+}
@@ -0,0 +1,79 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestServiceAccount(t *testing.T) {
+	t.Run("Get returns not found error if not exist", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		_, err := s.ServiceAccountGet(&pb.Ref_ServiceAccount{Name: "foo"})
+		require.Error(err)
+		require.Equal(codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Create, Get, List, and Delete", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		// Create
+		sa := serverptypes.TestServiceAccount(t, &pb.ServiceAccount{Name: "ci"})
+		require.NoError(s.ServiceAccountCreate(sa))
+		require.NotEmpty(sa.Id)
+		require.NotNil(sa.CreatedTime)
+
+		// Names are unique, case insensitive
+		err := s.ServiceAccountCreate(serverptypes.TestServiceAccount(t, &pb.ServiceAccount{Name: "CI"}))
+		require.Error(err)
+		require.Equal(codes.AlreadyExists, status.Code(err))
+
+		// Get by name, case insensitive
+		{
+			resp, err := s.ServiceAccountGet(&pb.Ref_ServiceAccount{Name: "Ci"})
+			require.NoError(err)
+			require.Equal(sa.Id, resp.Id)
+		}
+
+		// Get by ID
+		{
+			resp, err := s.ServiceAccountGetId(sa.Id)
+			require.NoError(err)
+			require.Equal("ci", resp.Name)
+		}
+
+		// List
+		{
+			resp, err := s.ServiceAccountList()
+			require.NoError(err)
+			require.Len(resp, 1)
+		}
+
+		// Delete
+		require.NoError(s.ServiceAccountDelete(&pb.Ref_ServiceAccount{Name: "ci"}))
+		{
+			_, err := s.ServiceAccountGetId(sa.Id)
+			require.Error(err)
+			require.Equal(codes.NotFound, status.Code(err))
+
+			resp, err := s.ServiceAccountList()
+			require.NoError(err)
+			require.Empty(resp)
+		}
+
+		// Deleting again is fine
+		require.NoError(s.ServiceAccountDelete(&pb.Ref_ServiceAccount{Name: "ci"}))
+	})
+}
@@ -48,8 +48,8 @@ func (s *State) CreateSnapshot(w io.Writer) error {
 		return err
 	}
 
-	return s.db.View(func(dbTxn *bolt.Tx) error {
-		if err := dbTxn.ForEach(func(name []byte, b *bolt.Bucket) error {
+	return s.db.View(func(dbTxn dbTx) error {
+		if err := dbTxn.ForEach(func(name []byte, b dbBucket) error {
 			const chunkLenMax = 1024 * 1024 // 1 MB
 			chunkLen := 0
 			chunkItems := map[string][]byte{}
@@ -111,17 +111,26 @@ func (s *State) CreateSnapshot(w io.Writer) error {
 // This will create a temporary file alongside the data file so we must have
 // write access to the directory containing the database.
 func (s *State) StageRestoreSnapshot(r io.Reader) error {
+	// Restores replace the BoltDB file when the server restarts. Other
+	// backends have their own tools to back up and restore data.
+	bdb, ok := s.db.(*boltBackend)
+	if !ok {
+		return status.Errorf(codes.Unimplemented,
+			"snapshots can only be restored to servers that use BoltDB. Use "+
+				"the tools of the database instead, such as pg_dump and pg_restore")
+	}
+
 	log := s.log.Named("restore")
 	log.Warn("beginning to stage snapshot restore")
 
-	ri := newRestoreInfo(log, s.db)
+	ri := newRestoreInfo(log, bdb.db)
 	if err := ri.Lock(); err != nil {
 		return err
 	}
 	defer ri.Unlock()
 
 	// Get our file info
-	fi, err := os.Stat(s.db.Path())
+	fi, err := os.Stat(bdb.db.Path())
 	if err != nil {
 		return err
 	}
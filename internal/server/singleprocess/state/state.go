@@ -39,12 +39,12 @@ type State struct {
 	// but most of this data is meant to be lost when the process restarts.
 	inmem *memdb.MemDB
 
-	// db is our persisted on-disk database. This stores the bulk of data
+	// db is our persisted database. This stores the bulk of data
 	// and supports a transactional model for safe concurrent access.
 	// inmem is used alongside db to store in-memory indexing information
 	// for more efficient lookups into db. This index is built online at
 	// boot.
-	db *bolt.DB
+	db backend
 
 	// hmacKeyNotEmpty is flipped to 1 when an hmac entry is set. This is
 	// used to determine if we're in a bootstrap state and can create a
@@ -63,9 +63,22 @@ type State struct {
 
 	// Used to track indexedJobs and prune records
 	pruneMu sync.Mutex
+
+	// auditEvents is the number of persisted audit events. This is used
+	// to prune the oldest events.
+	auditEvents int
+
+	// execSessions is the number of persisted exec sessions. This is used
+	// to prune the oldest sessions.
+	execSessions int
+
+	// configKeys are the keys that config values are encrypted with. This
+	// is nil if config encryption isn't enabled.
+	configKeys   *configKeyring
+	configKeysMu sync.RWMutex
 }
 
-// New initializes a new State store.
+// New initializes a new State store that persists data to the BoltDB db.
 func New(log hclog.Logger, db *bolt.DB) (*State, error) {
 	// Restore DB if necessary
 	db, err := finalizeRestore(log, db)
@@ -73,6 +86,11 @@ func New(log hclog.Logger, db *bolt.DB) (*State, error) {
 		return nil, err
 	}
 
+	return newState(log, &boltBackend{db: db})
+}
+
+// newState initializes a new State store with the given backend.
+func newState(log hclog.Logger, db backend) (*State, error) {
 	// Create the in-memory DB.
 	inmem, err := memdb.NewMemDB(stateStoreSchema())
 	if err != nil {
@@ -95,7 +113,7 @@ func New(log hclog.Logger, db *bolt.DB) (*State, error) {
 	// Initialize our in-memory indexes
 	memTxn := s.inmem.Txn(true)
 	defer memTxn.Abort()
-	err = s.db.View(func(dbTxn *bolt.Tx) error {
+	err = s.db.View(func(dbTxn dbTx) error {
 		for _, indexer := range dbIndexers {
 			// TODO: this should use callIndexer but it's broken as it prevents the multiple op indexers
 			// from properly running.
@@ -117,7 +135,7 @@ func New(log hclog.Logger, db *bolt.DB) (*State, error) {
 // callIndexer calls the specified indexer exactly once. If it has been called
 // before this returns no error. This must not be called concurrently. This
 // can be used from indexers to ensure other data is indexed first.
-func (s *State) callIndexer(fn indexFn, dbTxn *bolt.Tx, memTxn *memdb.Txn) error {
+func (s *State) callIndexer(fn indexFn, dbTxn dbTx, memTxn *memdb.Txn) error {
 	fnptr := reflect.ValueOf(fn).Pointer()
 	if _, ok := s.indexers[fnptr]; ok {
 		return nil
@@ -132,6 +150,22 @@ func (s *State) Close() error {
 	return s.db.Close()
 }
 
+// LockLost returns a channel that is closed if this server lost the lock of
+// its PostgreSQL database schema and must stop, since a standby server may
+// take over. This is nil for other databases, which never lose their lock.
+func (s *State) LockLost() <-chan struct{} {
+	if b, ok := s.db.(*postgresBackend); ok {
+		return b.Lost()
+	}
+
+	return nil
+}
+
+// DBSize returns the size of the persisted database in bytes.
+func (s *State) DBSize() (int64, error) {
+	return s.db.Size()
+}
+
 // Prune should be called in a on a regular interval to allow State
 // to prune out old data.
 func (s *State) Prune() error {
@@ -194,5 +228,5 @@ func stateStoreSchema() *memdb.DBSchema {
 // persisted data. This is usually specified as a method handle to a
 // *State method.
 //
-// The bolt.Tx is read-only while the memdb.Txn is a write transaction.
-type indexFn func(*State, *bolt.Tx, *memdb.Txn) error
+// The dbTx is read-only while the memdb.Txn is a write transaction.
+type indexFn func(*State, dbTx, *memdb.Txn) error
@@ -1,18 +1,36 @@
 package state
 
 import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
 	"io/ioutil"
+	"net/url"
 	"os"
 	"path/filepath"
 
 	"github.com/hashicorp/go-hclog"
+	"github.com/lib/pq"
 	"github.com/mitchellh/go-testing-interface"
 	"github.com/stretchr/testify/require"
 	bolt "go.etcd.io/bbolt"
 )
 
-// TestState returns an initialized State for testing.
+// testPostgresURLEnv is the environment variable with the URL of a
+// PostgreSQL database to run the tests against. If it isn't set, the
+// tests use BoltDB.
+const testPostgresURLEnv = "WAYPOINT_TEST_POSTGRES_URL"
+
+// TestState returns an initialized State for testing. If the
+// WAYPOINT_TEST_POSTGRES_URL environment variable is set, the State is
+// stored in a new schema of that PostgreSQL database.
 func TestState(t testing.T) *State {
+	if os.Getenv(testPostgresURLEnv) != "" {
+		return testStatePostgres(t, testPostgresDB(t, testPostgresSchema(t)))
+	}
+
 	result, err := New(hclog.L(), testDB(t))
 	require.NoError(t, err)
 	return result
@@ -25,6 +43,10 @@ func TestState(t testing.T) *State {
 // This safely copies the entire DB so the old state can continue running
 // with zero impact.
 func TestStateReinit(t testing.T, s *State) *State {
+	if pg, ok := s.db.(*postgresBackend); ok {
+		return testStateReinitPostgres(t, pg)
+	}
+
 	// Copy the old database to a brand new path
 	td, err := ioutil.TempDir("", "test")
 	require.NoError(t, err)
@@ -32,7 +54,7 @@ func TestStateReinit(t testing.T, s *State) *State {
 	path := filepath.Join(td, "test.db")
 
 	// Start db copy
-	require.NoError(t, s.db.View(func(tx *bolt.Tx) error {
+	require.NoError(t, s.db.(*boltBackend).db.View(func(tx *bolt.Tx) error {
 		return tx.CopyFile(path, 0600)
 	}))
 
@@ -51,7 +73,18 @@ func TestStateReinit(t testing.T, s *State) *State {
 // same DB file. Unlike TestStateReinit, this does not copy the data and
 // the old state is no longer usable.
 func TestStateRestart(t testing.T, s *State) (*State, error) {
-	path := s.db.Path()
+	if pg, ok := s.db.(*postgresBackend); ok {
+		schema := testPostgresCurrentSchema(t, pg.db)
+		require.NoError(t, s.Close())
+
+		result, err := NewPostgres(hclog.L(), testPostgresDB(t, schema))
+		if err == nil {
+			t.Cleanup(func() { result.Close() })
+		}
+		return result, err
+	}
+
+	path := s.db.(*boltBackend).db.Path()
 	require.NoError(t, s.Close())
 
 	// Open the new DB
@@ -78,3 +111,80 @@ func testDB(t testing.T) *bolt.DB {
 
 	return db
 }
+
+// testStateReinitPostgres is TestStateReinit for the PostgreSQL backend. It
+// copies the data to a new schema.
+func testStateReinitPostgres(t testing.T, pg *postgresBackend) *State {
+	old := pq.QuoteIdentifier(testPostgresCurrentSchema(t, pg.db))
+	db := testPostgresDB(t, testPostgresSchema(t))
+	require.NoError(t, postgresMigrate(context.Background(), hclog.L(), db))
+
+	_, err := db.Exec(fmt.Sprintf(`
+		INSERT INTO waypoint_buckets SELECT * FROM %[1]s.waypoint_buckets;
+		INSERT INTO waypoint_items SELECT * FROM %[1]s.waypoint_items;
+		SELECT setval('waypoint_buckets_id_seq',
+			(SELECT COALESCE(MAX(id), 1) FROM waypoint_buckets));`, old))
+	require.NoError(t, err)
+
+	return testStatePostgres(t, db)
+}
+
+// testStatePostgres returns an initialized State stored in db. The State
+// is closed when the test completes to release its connection.
+func testStatePostgres(t testing.T, db *sql.DB) *State {
+	result, err := NewPostgres(hclog.L(), db)
+	require.NoError(t, err)
+	t.Cleanup(func() { result.Close() })
+	return result
+}
+
+// testPostgresSchema creates a schema for a test in the database of
+// WAYPOINT_TEST_POSTGRES_URL and returns its name. The schema is dropped
+// when the test completes.
+func testPostgresSchema(t testing.T) string {
+	t.Helper()
+
+	var suffix [8]byte
+	_, err := rand.Read(suffix[:])
+	require.NoError(t, err)
+	schema := "waypoint_test_" + hex.EncodeToString(suffix[:])
+
+	db, err := sql.Open(PostgresDriver, os.Getenv(testPostgresURLEnv))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		db.Exec(`DROP SCHEMA ` + schema + ` CASCADE`)
+		db.Close()
+	})
+
+	_, err = db.Exec(`CREATE SCHEMA ` + schema)
+	require.NoError(t, err)
+
+	return schema
+}
+
+// testPostgresDB opens the database of WAYPOINT_TEST_POSTGRES_URL using
+// the given schema.
+func testPostgresDB(t testing.T, schema string) *sql.DB {
+	t.Helper()
+
+	u, err := url.Parse(os.Getenv(testPostgresURLEnv))
+	require.NoError(t, err)
+	q := u.Query()
+	q.Set("search_path", schema)
+	u.RawQuery = q.Encode()
+
+	db, err := sql.Open(PostgresDriver, u.String())
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+// testPostgresCurrentSchema returns the schema that db uses.
+func testPostgresCurrentSchema(t testing.T, db *sql.DB) string {
+	t.Helper()
+
+	var schema string
+	require.NoError(t, db.QueryRow(`SELECT current_schema()`).Scan(&schema))
+	return schema
+}
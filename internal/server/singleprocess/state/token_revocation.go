@@ -0,0 +1,179 @@
+package state
+
+import (
+	"encoding/hex"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-memdb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+var tokenRevocationBucket = []byte("token_revocations")
+
+func init() {
+	dbBuckets = append(dbBuckets, tokenRevocationBucket)
+	dbIndexers = append(dbIndexers, (*State).tokenRevocationIndexInit)
+	schemas = append(schemas, tokenRevocationIndexSchema)
+}
+
+// TokenRevocationPut stores a token revocation. If the revoked time isn't
+// set, it is set to the current time. A revocation for a user replaces any
+// previous revocation for that user.
+func (s *State) TokenRevocationPut(r *pb.TokenRevocation) error {
+	id, err := tokenRevocationId(r)
+	if err != nil {
+		return err
+	}
+
+	if r.RevokedTime == nil {
+		r.RevokedTime = ptypes.TimestampNow()
+	}
+
+	memTxn := s.inmem.Txn(true)
+	defer memTxn.Abort()
+
+	err = s.db.Update(func(dbTxn dbTx) error {
+		if err := dbPut(dbTxn.Bucket(tokenRevocationBucket), []byte(id), r); err != nil {
+			return err
+		}
+
+		return s.tokenRevocationIndexSet(memTxn, id, r)
+	})
+	if err == nil {
+		memTxn.Commit()
+	}
+
+	return err
+}
+
+// TokenRevoked returns true if the token was revoked, either by its
+// accessor ID or by a revocation of every token of its user.
+func (s *State) TokenRevoked(t *pb.Token) (bool, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	ids := []string{tokenRevocationAccessorId(t.AccessorId)}
+	if login, ok := t.Kind.(*pb.Token_Login_); ok && login.Login != nil {
+		ids = append(ids, tokenRevocationUserId(login.Login.UserId))
+	}
+
+	for _, id := range ids {
+		raw, err := memTxn.First(
+			tokenRevocationIndexTableName,
+			tokenRevocationIndexIdIndexName,
+			id,
+		)
+		if err != nil {
+			return false, err
+		}
+		if raw == nil {
+			continue
+		}
+
+		r := raw.(*tokenRevocationIndexRecord).Revocation
+		if _, ok := r.Target.(*pb.TokenRevocation_AccessorId); ok {
+			return true, nil
+		}
+
+		// User revocations only apply to tokens issued up to the time of
+		// the revocation. Tokens without an issued time are from older
+		// servers and are always revoked.
+		if t.IssuedTime == nil {
+			return true, nil
+		}
+
+		issued, err := ptypes.Timestamp(t.IssuedTime)
+		if err != nil {
+			return false, err
+		}
+
+		revoked, err := ptypes.Timestamp(r.RevokedTime)
+		if err != nil {
+			return false, err
+		}
+
+		if !issued.After(revoked) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// tokenRevocationIndexSet writes an index record for a token revocation.
+func (s *State) tokenRevocationIndexSet(txn *memdb.Txn, id string, value *pb.TokenRevocation) error {
+	return txn.Insert(tokenRevocationIndexTableName, &tokenRevocationIndexRecord{
+		Id:         id,
+		Revocation: value,
+	})
+}
+
+// tokenRevocationIndexInit initializes the token revocation index from
+// persisted data.
+func (s *State) tokenRevocationIndexInit(dbTxn dbTx, memTxn *memdb.Txn) error {
+	bucket := dbTxn.Bucket(tokenRevocationBucket)
+	return bucket.ForEach(func(k, v []byte) error {
+		var value pb.TokenRevocation
+		if err := proto.Unmarshal(v, &value); err != nil {
+			return err
+		}
+
+		return s.tokenRevocationIndexSet(memTxn, string(k), &value)
+	})
+}
+
+// tokenRevocationId returns the ID used to store the revocation.
+func tokenRevocationId(r *pb.TokenRevocation) (string, error) {
+	switch t := r.Target.(type) {
+	case *pb.TokenRevocation_AccessorId:
+		if len(t.AccessorId) > 0 {
+			return tokenRevocationAccessorId(t.AccessorId), nil
+		}
+
+	case *pb.TokenRevocation_UserId:
+		if t.UserId != "" {
+			return tokenRevocationUserId(t.UserId), nil
+		}
+	}
+
+	return "", status.Errorf(codes.InvalidArgument,
+		"token revocation must have an accessor ID or user ID")
+}
+
+func tokenRevocationAccessorId(v []byte) string {
+	return "accessor/" + hex.EncodeToString(v)
+}
+
+func tokenRevocationUserId(v string) string {
+	return "user/" + v
+}
+
+func tokenRevocationIndexSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: tokenRevocationIndexTableName,
+		Indexes: map[string]*memdb.IndexSchema{
+			tokenRevocationIndexIdIndexName: {
+				Name:         tokenRevocationIndexIdIndexName,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "Id",
+				},
+			},
+		},
+	}
+}
+
+const (
+	tokenRevocationIndexTableName   = "token-revocation-index"
+	tokenRevocationIndexIdIndexName = "id"
+)
+
+type tokenRevocationIndexRecord struct {
+	Id         string
+	Revocation *pb.TokenRevocation
+}
@@ -0,0 +1,90 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestTokenRevocation(t *testing.T) {
+	token := func(accessor, userId string, issued time.Time) *pb.Token {
+		ts, err := ptypes.TimestampProto(issued)
+		require.NoError(t, err)
+
+		return &pb.Token{
+			AccessorId: []byte(accessor),
+			IssuedTime: ts,
+			Kind: &pb.Token_Login_{
+				Login: &pb.Token_Login{UserId: userId},
+			},
+		}
+	}
+
+	t.Run("revoke by accessor ID", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		a := token("A", "alice", time.Now())
+		b := token("B", "alice", time.Now())
+
+		revoked, err := s.TokenRevoked(a)
+		require.NoError(err)
+		require.False(revoked)
+
+		require.NoError(s.TokenRevocationPut(&pb.TokenRevocation{
+			Target: &pb.TokenRevocation_AccessorId{AccessorId: a.AccessorId},
+		}))
+
+		revoked, err = s.TokenRevoked(a)
+		require.NoError(err)
+		require.True(revoked)
+
+		revoked, err = s.TokenRevoked(b)
+		require.NoError(err)
+		require.False(revoked)
+	})
+
+	t.Run("revoke by user", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		now := time.Now()
+		before := token("A", "alice", now.Add(-1*time.Hour))
+		after := token("B", "alice", now.Add(1*time.Hour))
+		other := token("C", "bob", now.Add(-1*time.Hour))
+
+		ts, err := ptypes.TimestampProto(now)
+		require.NoError(err)
+		require.NoError(s.TokenRevocationPut(&pb.TokenRevocation{
+			Target:      &pb.TokenRevocation_UserId{UserId: "alice"},
+			RevokedTime: ts,
+		}))
+
+		revoked, err := s.TokenRevoked(before)
+		require.NoError(err)
+		require.True(revoked)
+
+		revoked, err = s.TokenRevoked(after)
+		require.NoError(err)
+		require.False(revoked)
+
+		revoked, err = s.TokenRevoked(other)
+		require.NoError(err)
+		require.False(revoked)
+	})
+
+	t.Run("requires a target", func(t *testing.T) {
+		s := TestState(t)
+		defer s.Close()
+
+		require.Error(t, s.TokenRevocationPut(&pb.TokenRevocation{}))
+	})
+}
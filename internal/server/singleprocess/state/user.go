@@ -5,7 +5,6 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hashicorp/go-memdb"
-	bolt "go.etcd.io/bbolt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -33,7 +32,7 @@ func (s *State) UserPut(user *pb.User) error {
 	memTxn := s.inmem.Txn(true)
 	defer memTxn.Abort()
 
-	err := s.db.Update(func(dbTxn *bolt.Tx) error {
+	err := s.db.Update(func(dbTxn dbTx) error {
 		return s.userPut(dbTxn, memTxn, user)
 	})
 	if err == nil {
@@ -49,7 +48,7 @@ func (s *State) UserGet(ref *pb.Ref_User) (*pb.User, error) {
 	defer memTxn.Abort()
 
 	var result *pb.User
-	err := s.db.View(func(dbTxn *bolt.Tx) error {
+	err := s.db.View(func(dbTxn dbTx) error {
 		var err error
 		result, err = s.userGet(dbTxn, memTxn, ref)
 		return err
@@ -63,7 +62,7 @@ func (s *State) UserDelete(ref *pb.Ref_User) error {
 	memTxn := s.inmem.Txn(true)
 	defer memTxn.Abort()
 
-	err := s.db.Update(func(dbTxn *bolt.Tx) error {
+	err := s.db.Update(func(dbTxn dbTx) error {
 		return s.userDelete(dbTxn, memTxn, ref)
 	})
 	if err == nil {
@@ -92,7 +91,7 @@ func (s *State) UserList() ([]*pb.User, error) {
 		idx := next.(*userIndexRecord)
 
 		var v *pb.User
-		err = s.db.View(func(dbTxn *bolt.Tx) error {
+		err = s.db.View(func(dbTxn dbTx) error {
 			v, err = s.userGet(dbTxn, memTxn, &pb.Ref_User{
 				Ref: &pb.Ref_User_Id{
 					Id: &pb.Ref_UserId{Id: idx.Id},
@@ -127,7 +126,7 @@ func (s *State) UserGetOIDC(iss, sub string) (*pb.User, error) {
 	defer memTxn.Abort()
 
 	var result *pb.User
-	err := s.db.View(func(dbTxn *bolt.Tx) error {
+	err := s.db.View(func(dbTxn dbTx) error {
 		var err error
 		result, err = s.userGetOIDC(dbTxn, memTxn, iss, sub)
 		return err
@@ -137,7 +136,7 @@ func (s *State) UserGetOIDC(iss, sub string) (*pb.User, error) {
 }
 
 func (s *State) userGetOIDC(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	iss, sub string,
 ) (*pb.User, error) {
@@ -183,6 +182,49 @@ func (s *State) userGetOIDC(
 	return nil, status.Errorf(codes.NotFound, "user not found")
 }
 
+// UserGetPAT gets a user by PAT link lookup. This will return a
+// codes.NotFound error if the user is not found.
+func (s *State) UserGetPAT(apiURL, id string) (*pb.User, error) {
+	memTxn := s.inmem.Txn(false)
+	defer memTxn.Abort()
+
+	var result *pb.User
+	err := s.db.View(func(dbTxn dbTx) error {
+		var err error
+		result, err = s.userGetPAT(dbTxn, memTxn, apiURL, id)
+		return err
+	})
+
+	return result, err
+}
+
+func (s *State) userGetPAT(
+	dbTxn dbTx,
+	memTxn *memdb.Txn,
+	apiURL, id string,
+) (*pb.User, error) {
+	raw, err := memTxn.First(
+		userTableName,
+		userPATIndexName,
+		userPATIndexValue(apiURL, id),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	idx := raw.(*userIndexRecord)
+
+	var result pb.User
+	b := dbTxn.Bucket(userBucket)
+	if err := dbGet(b, []byte(strings.ToLower(idx.Id)), &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 // UserGetEmail gets a user by by email lookup. This will return
 // a codes.NotFound error if the user is not found.
 func (s *State) UserGetEmail(email string) (*pb.User, error) {
@@ -190,7 +232,7 @@ func (s *State) UserGetEmail(email string) (*pb.User, error) {
 	defer memTxn.Abort()
 
 	var result *pb.User
-	err := s.db.View(func(dbTxn *bolt.Tx) error {
+	err := s.db.View(func(dbTxn dbTx) error {
 		var err error
 		result, err = s.userGetEmail(dbTxn, memTxn, email)
 		return err
@@ -200,7 +242,7 @@ func (s *State) UserGetEmail(email string) (*pb.User, error) {
 }
 
 func (s *State) userGetEmail(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	email string,
 ) (*pb.User, error) {
@@ -227,7 +269,7 @@ func (s *State) userGetEmail(
 }
 
 func (s *State) userPut(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	value *pb.User,
 ) error {
@@ -259,7 +301,7 @@ func (s *State) userPut(
 }
 
 func (s *State) userGet(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	ref *pb.Ref_User,
 ) (*pb.User, error) {
@@ -294,7 +336,7 @@ func (s *State) userGet(
 }
 
 func (s *State) userDelete(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	ref *pb.Ref_User,
 ) error {
@@ -319,7 +361,7 @@ func (s *State) userDelete(
 	// We can't delete the final user or the system will get into a state
 	// where it can't do anything!
 	bucket := dbTxn.Bucket(userBucket)
-	if bucket.Stats().KeyN <= 1 {
+	if bucket.KeyN() <= 1 {
 		return status.Errorf(codes.FailedPrecondition,
 			"the final user in the system can't be deleted until another one is created")
 	}
@@ -350,6 +392,10 @@ func (s *State) userIndexSet(txn *memdb.Txn, id []byte, value *pb.User) error {
 		switch method := link.Method.(type) {
 		case *pb.User_Link_Oidc:
 			record.OIDCSub = append(record.OIDCSub, method.Oidc.Sub)
+
+		case *pb.User_Link_Pat:
+			record.PAT = append(record.PAT,
+				userPATIndexValue(method.Pat.ApiUrl, method.Pat.Id))
 		}
 	}
 
@@ -358,7 +404,7 @@ func (s *State) userIndexSet(txn *memdb.Txn, id []byte, value *pb.User) error {
 }
 
 // userIndexInit initializes the user index from persisted data.
-func (s *State) userIndexInit(dbTxn *bolt.Tx, memTxn *memdb.Txn) error {
+func (s *State) userIndexInit(dbTxn dbTx, memTxn *memdb.Txn) error {
 	bucket := dbTxn.Bucket(userBucket)
 	return bucket.ForEach(func(k, v []byte) error {
 		var value pb.User
@@ -423,6 +469,16 @@ func userIndexSchema() *memdb.TableSchema {
 				// since uniqueness depends on the issuer + sub combo.
 				Unique: false,
 			},
+
+			userPATIndexName: {
+				Name:         userPATIndexName,
+				AllowMissing: true,
+				Unique:       true,
+				Indexer: &memdb.StringSliceFieldIndex{
+					Field:     "PAT",
+					Lowercase: true,
+				},
+			},
 		},
 	}
 }
@@ -433,8 +489,16 @@ const (
 	userUsernameIndexName = "username"
 	userEmailIndexName    = "email"
 	userOIDCSubIndexName  = "oidc-sub"
+	userPATIndexName      = "pat"
 )
 
+// userPATIndexValue returns the value of a PAT link in the PAT index.
+// The API URL and ID together are unique, so unlike the OIDC index we
+// index both.
+func userPATIndexValue(apiURL, id string) string {
+	return strings.TrimSuffix(apiURL, "/") + " " + id
+}
+
 type userIndexRecord struct {
 	Id       string
 	Username string
@@ -443,6 +507,9 @@ type userIndexRecord struct {
 	// OIDCSub is a list of OIDC sub claims that are linked to this user.
 	// This can be used to look up a user by OIDC.
 	OIDCSub []string
+
+	// PAT is a list of PAT links of this user, see userPATIndexValue.
+	PAT []string
 }
 
 // Copy should be called prior to any modifications to an existing record.
@@ -276,4 +276,51 @@ func TestUser(t *testing.T) {
 			require.Equal(codes.NotFound, status.Code(err))
 		}
 	})
+
+	t.Run("User lookup by PAT", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		// Set
+		require.NoError(s.UserPut(serverptypes.TestUser(t, &pb.User{
+			Id:       id,
+			Username: "foo",
+			Links: []*pb.User_Link{
+				{
+					Method: &pb.User_Link_Pat{
+						Pat: &pb.User_Link_PAT{
+							ApiUrl: "https://api.github.com",
+							Id:     "42",
+						},
+					},
+				},
+			},
+		})))
+
+		// Read
+		{
+			resp, err := s.UserGetPAT("https://api.github.com/", "42")
+			require.NoError(err)
+			require.NotNil(resp)
+			require.Equal("foo", resp.Username)
+		}
+
+		// Not matching API URL
+		{
+			resp, err := s.UserGetPAT("https://gitlab.com/api/v4", "42")
+			require.Error(err)
+			require.Nil(resp)
+			require.Equal(codes.NotFound, status.Code(err))
+		}
+
+		// Not matching ID
+		{
+			resp, err := s.UserGetPAT("https://api.github.com", "4")
+			require.Error(err)
+			require.Nil(resp)
+			require.Equal(codes.NotFound, status.Code(err))
+		}
+	})
 }
@@ -7,7 +7,6 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/hashicorp/go-memdb"
-	bolt "go.etcd.io/bbolt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -100,7 +99,7 @@ func (s *State) workspaceListFromIter(iter memdb.ResultIterator) ([]*pb.Workspac
 		idx := next.(*workspaceIndex)
 
 		var ws *pb.Workspace
-		err := s.db.View(func(dbTxn *bolt.Tx) error {
+		err := s.db.View(func(dbTxn dbTx) error {
 			var err error
 			ws, err = s.workspaceFromDB(dbTxn, idx.Id)
 			return err
@@ -134,11 +133,85 @@ func (s *State) WorkspaceGet(n string) (*pb.Workspace, error) {
 		"not found for name: %q", n)
 }
 
+// WorkspacePut creates a workspace or updates its description. Any
+// other fields of ws are ignored since the projects and active time of
+// a workspace are tracked by the operations within it.
+func (s *State) WorkspacePut(ws *pb.Workspace) error {
+	memTxn := s.inmem.Txn(true)
+	defer memTxn.Abort()
+
+	err := s.db.Update(func(dbTxn dbTx) error {
+		id := strings.ToLower(ws.Name)
+
+		raw, err := memTxn.First(workspaceTableName, workspaceIdIndexName, id)
+		if err != nil {
+			return err
+		}
+
+		value := &pb.Workspace{Name: ws.Name}
+		if raw != nil {
+			value, err = s.workspaceFromDB(dbTxn, id)
+			if err != nil {
+				return err
+			}
+		}
+		value.Description = ws.Description
+
+		if err := dbPut(dbTxn.Bucket(workspaceBucket), []byte(id), value); err != nil {
+			return err
+		}
+		_, err = s.workspaceIndexSet(memTxn, []byte(id), value)
+		return err
+	})
+	if err == nil {
+		memTxn.Commit()
+	}
+
+	return err
+}
+
+// WorkspaceDelete deletes the workspace with the given name. This returns
+// an error with codes.NotFound if the workspace doesn't exist and with
+// codes.FailedPrecondition if any project has been used in it.
+func (s *State) WorkspaceDelete(n string) error {
+	memTxn := s.inmem.Txn(true)
+	defer memTxn.Abort()
+
+	err := s.db.Update(func(dbTxn dbTx) error {
+		id := strings.ToLower(n)
+
+		raw, err := memTxn.First(workspaceTableName, workspaceIdIndexName, id)
+		if err != nil {
+			return err
+		}
+		if raw == nil {
+			return status.Errorf(codes.NotFound, "not found for name: %q", n)
+		}
+
+		if len(raw.(*workspaceIndex).Projects) > 0 {
+			return status.Errorf(codes.FailedPrecondition,
+				"workspace %q is in use by %d project(s) and can't be deleted",
+				n, len(raw.(*workspaceIndex).Projects))
+		}
+
+		if err := dbTxn.Bucket(workspaceBucket).Delete([]byte(id)); err != nil {
+			return err
+		}
+
+		return memTxn.Delete(workspaceTableName, raw)
+	})
+	if err == nil {
+		memTxn.Commit()
+	}
+
+	return err
+}
+
 // workspaceTouchApp updates a workspace with the given project/application
 // usage. This will create the workspace if it does not exist. This updates
 // the LastActiveAt times.
 func (s *State) workspaceTouchApp(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	ref *pb.Ref_Workspace,
 	app *pb.Ref_Application,
@@ -198,7 +271,7 @@ func (s *State) workspaceTouchApp(
 
 // workspaceUpdateProjectDataRef updates the latest data ref used for a project.
 func (s *State) workspaceUpdateProjectDataRef(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	ref *pb.Ref_Workspace,
 	project *pb.Ref_Project,
@@ -245,7 +318,7 @@ func (s *State) workspaceUpdateProjectDataRef(
 // workspaceListProjects lists the project information for all the workspaces
 // that a specific project is in.
 func (s *State) workspaceListProjects(
-	dbTxn *bolt.Tx,
+	dbTxn dbTx,
 	memTxn *memdb.Txn,
 	ref *pb.Ref_Project,
 ) ([]*pb.Workspace_Project, error) {
@@ -285,7 +358,7 @@ func (s *State) workspaceListProjects(
 }
 
 // workspaceFromDB loads the Workspace structure from disk.
-func (s *State) workspaceFromDB(dbTxn *bolt.Tx, id string) (*pb.Workspace, error) {
+func (s *State) workspaceFromDB(dbTxn dbTx, id string) (*pb.Workspace, error) {
 	var result pb.Workspace
 	b := dbTxn.Bucket(workspaceBucket)
 	return &result, dbGet(b, []byte(strings.ToLower(id)), &result)
@@ -336,7 +409,7 @@ func (s *State) workspaceInitApp(
 }
 
 // workspaceIndexInit initializes the config index from persisted data.
-func (s *State) workspaceIndexInit(dbTxn *bolt.Tx, memTxn *memdb.Txn) error {
+func (s *State) workspaceIndexInit(dbTxn dbTx, memTxn *memdb.Txn) error {
 	bucket := dbTxn.Bucket(workspaceBucket)
 	return bucket.ForEach(func(k, v []byte) error {
 		var value pb.Workspace
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
@@ -74,6 +76,81 @@ func TestWorkspace(t *testing.T) {
 	})
 }
 
+func TestWorkspacePut(t *testing.T) {
+	t.Run("Put, Get, and Delete", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		require.NoError(s.WorkspacePut(&pb.Workspace{
+			Name:        "staging",
+			Description: "pre-production",
+		}))
+
+		ws, err := s.WorkspaceGet("Staging")
+		require.NoError(err)
+		require.Equal("staging", ws.Name)
+		require.Equal("pre-production", ws.Description)
+		require.Empty(ws.Projects)
+
+		// Update the description
+		require.NoError(s.WorkspacePut(&pb.Workspace{
+			Name:        "staging",
+			Description: "shared staging",
+		}))
+		ws, err = s.WorkspaceGet("staging")
+		require.NoError(err)
+		require.Equal("shared staging", ws.Description)
+
+		// Delete
+		require.NoError(s.WorkspaceDelete("staging"))
+		_, err = s.WorkspaceGet("staging")
+		require.Error(err)
+		require.Equal(codes.NotFound, status.Code(err))
+
+		// Delete again
+		err = s.WorkspaceDelete("staging")
+		require.Error(err)
+		require.Equal(codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Put preserves projects and Delete fails if in use", func(t *testing.T) {
+		require := require.New(t)
+
+		s := TestState(t)
+		defer s.Close()
+
+		require.NoError(s.BuildPut(false, serverptypes.TestValidBuild(t, &pb.Build{
+			Id:        "1",
+			Workspace: &pb.Ref_Workspace{Workspace: "prod"},
+		})))
+
+		require.NoError(s.WorkspacePut(&pb.Workspace{
+			Name:        "prod",
+			Description: "production",
+		}))
+
+		ws, err := s.WorkspaceGet("prod")
+		require.NoError(err)
+		require.Equal("production", ws.Description)
+		require.Len(ws.Projects, 1)
+
+		err = s.WorkspaceDelete("prod")
+		require.Error(err)
+		require.Equal(codes.FailedPrecondition, status.Code(err))
+
+		// The description is kept when operations touch the workspace
+		require.NoError(s.BuildPut(false, serverptypes.TestValidBuild(t, &pb.Build{
+			Id:        "2",
+			Workspace: &pb.Ref_Workspace{Workspace: "prod"},
+		})))
+		ws, err = s.WorkspaceGet("prod")
+		require.NoError(err)
+		require.Equal("production", ws.Description)
+	})
+}
+
 func TestWorkspaceProject(t *testing.T) {
 	t.Run("List non-empty", func(t *testing.T) {
 		require := require.New(t)
@@ -0,0 +1,121 @@
+package singleprocess
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// runStatusReportPoller queues a status report job for the latest
+// deployment or release of every app in every workspace each interval, so
+// that status reports don't only update when someone runs a command.
+//
+// Projects that configure their own status report polling are skipped
+// since they're polled by the application poll queuer. Projects without a
+// remote data source are skipped too since a runner can't load their
+// configuration.
+func (s *service) runStatusReportPoller(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	interval time.Duration,
+	funclog hclog.Logger,
+) {
+	defer wg.Done()
+
+	funclog.Info("starting", "interval", interval)
+	defer funclog.Info("exiting")
+
+	tk := time.NewTicker(interval)
+	defer tk.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-tk.C:
+			jobs, err := s.statusReportPollJobs(funclog)
+			if err != nil {
+				funclog.Error("error building status report jobs", "err", err)
+				continue
+			}
+
+			if len(jobs) == 0 {
+				continue
+			}
+
+			funclog.Debug("queueing status report jobs", "job_total", len(jobs))
+			if _, err := s.queueJobMulti(ctx, jobs); err != nil {
+				funclog.Warn("error queueing status report jobs", "err", err)
+			}
+		}
+	}
+}
+
+// statusReportPollJobs returns the status report jobs to queue for all the
+// projects that are polled by runStatusReportPoller.
+func (s *service) statusReportPollJobs(log hclog.Logger) ([]*pb.QueueJobRequest, error) {
+	refs, err := s.state.ProjectList()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*pb.QueueJobRequest
+	for _, ref := range refs {
+		project, err := s.state.ProjectGet(ref)
+		if err != nil {
+			return nil, err
+		}
+
+		if project.StatusReportPoll != nil {
+			continue
+		}
+		if project.DataSource == nil {
+			continue
+		}
+		if _, ok := project.DataSource.Source.(*pb.Job_DataSource_Local); ok {
+			continue
+		}
+
+		for _, app := range project.Applications {
+			workspaces, err := s.state.WorkspaceListByApp(&pb.Ref_Application{
+				Project:     project.Name,
+				Application: app.Name,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			for _, ws := range workspaces {
+				poll := &applicationPoll{state: s.state, workspace: ws.Name}
+				job, err := poll.buildPollJob(log, app)
+				if status.Code(err) == codes.NotFound {
+					// Nothing has been deployed in this workspace.
+					continue
+				}
+				if err != nil {
+					return nil, err
+				}
+				if job == nil {
+					continue
+				}
+
+				// The application poll queuer only polls the default
+				// workspace so its singleton ID doesn't include one.
+				job.Job.SingletonId = fmt.Sprintf(
+					"status-report-poll/%s/%s/%s", project.Name, app.Name, ws.Name)
+
+				result = append(result, job)
+			}
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,92 @@
+package singleprocess
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/server"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+)
+
+func TestServiceStatusReportPollJobs(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	gitSource := &pb.Job_DataSource{
+		Source: &pb.Job_DataSource_Git{
+			Git: &pb.Job_Git{Url: "https://example.com/repo.git"},
+		},
+	}
+
+	// A project that is polled, one that configures its own polling and
+	// one without a remote data source.
+	for _, p := range []*pb.Project{
+		{Name: "polled", DataSource: gitSource},
+		{
+			Name:       "own",
+			DataSource: gitSource,
+			StatusReportPoll: &pb.Project_AppStatusPoll{
+				Enabled: false,
+			},
+		},
+		{
+			Name: "local",
+			DataSource: &pb.Job_DataSource{
+				Source: &pb.Job_DataSource_Local{
+					Local: &pb.Job_Local{},
+				},
+			},
+		},
+	} {
+		p.Applications = []*pb.Application{
+			{
+				Project: &pb.Ref_Project{Project: p.Name},
+				Name:    "app",
+			},
+		}
+		_, err := client.UpsertProject(ctx, &pb.UpsertProjectRequest{
+			Project: serverptypes.TestProject(t, p),
+		})
+		require.NoError(err)
+
+		// Deploy the app to two workspaces
+		for _, ws := range []string{"default", "staging"} {
+			_, err := client.UpsertDeployment(ctx, &pb.UpsertDeploymentRequest{
+				Deployment: serverptypes.TestValidDeployment(t, &pb.Deployment{
+					Application: &pb.Ref_Application{
+						Application: "app",
+						Project:     p.Name,
+					},
+					Workspace: &pb.Ref_Workspace{Workspace: ws},
+				}),
+			})
+			require.NoError(err)
+		}
+	}
+
+	jobs, err := testServiceImpl(impl).statusReportPollJobs(hclog.L())
+	require.NoError(err)
+	require.Len(jobs, 2)
+
+	var ids []string
+	for _, job := range jobs {
+		require.Equal("polled", job.Job.Application.Project)
+		require.NotNil(job.Job.Operation)
+		ids = append(ids, job.Job.SingletonId)
+	}
+	sort.Strings(ids)
+	require.Equal([]string{
+		"status-report-poll/polled/app/default",
+		"status-report-poll/polled/app/staging",
+	}, ids)
+}
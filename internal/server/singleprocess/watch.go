@@ -0,0 +1,42 @@
+package singleprocess
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/go-memdb"
+)
+
+// watchList implements the Watch RPCs. It calls list to get the current
+// result and sends it, then waits for the WatchSet that list populated
+// to fire and repeats until the context is cancelled. Results that are
+// equal to the last result sent aren't sent again, since the watches can
+// fire for changes that don't affect the result.
+func watchList(
+	ctx context.Context,
+	list func(ws memdb.WatchSet) (proto.Message, error),
+	send func(proto.Message) error,
+) error {
+	var last proto.Message
+	for {
+		ws := memdb.NewWatchSet()
+		resp, err := list(ws)
+		if err != nil {
+			return err
+		}
+
+		if last == nil || !proto.Equal(last, resp) {
+			if err := send(resp); err != nil {
+				return err
+			}
+
+			last = resp
+		}
+
+		// WatchCtx only returns an error if the context is done, which
+		// means the client went away.
+		if err := ws.WatchCtx(ctx); err != nil {
+			return nil
+		}
+	}
+}
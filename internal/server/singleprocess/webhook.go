@@ -0,0 +1,690 @@
+package singleprocess
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/waypoint/internal/server"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// webhookMaxBodySize is the largest webhook payload that we read. Push
+// payloads include the commits that were pushed so they can be large.
+const webhookMaxBodySize = 25 * 1024 * 1024
+
+// webhookProvider parses and verifies the webhooks of a Git provider.
+type webhookProvider interface {
+	// Parse returns the push of the webhook. This returns nil if the
+	// webhook isn't for a push of a branch and should be ignored.
+	Parse(r *http.Request, body []byte) (*webhookPush, error)
+
+	// ParsePullRequest returns the pull request of the webhook. This
+	// returns nil if the webhook isn't for a pull request that was opened,
+	// updated with new commits, or closed.
+	ParsePullRequest(r *http.Request, body []byte) (*webhookPullRequest, error)
+
+	// Verify returns true if the webhook was sent with the secret.
+	Verify(r *http.Request, body []byte, secret string) bool
+}
+
+// webhookProviders are the supported Git providers by the name used in
+// the webhook path.
+var webhookProviders = map[string]webhookProvider{
+	"github":    webhookGitHub{},
+	"gitlab":    webhookGitLab{},
+	"bitbucket": webhookBitbucket{},
+}
+
+// webhookPush is a push of a branch to a Git repository.
+type webhookPush struct {
+	// URLs are the URLs of the repository, such as the HTTPS and SSH URLs.
+	URLs []string
+
+	// Branch is the branch that was pushed and Commit is its new commit.
+	Branch string
+	Commit string
+
+	// DefaultBranch is the default branch of the repository. This is
+	// empty if the provider doesn't send it.
+	DefaultBranch string
+}
+
+// webhookPullRequest is a pull request that was opened, updated, or closed.
+type webhookPullRequest struct {
+	// Target is the branch that the pull request merges into. Its commit
+	// is the head commit of the pull request.
+	Target webhookPush
+
+	// Number is the number of the pull request.
+	Number uint64
+
+	// Closed is true if the pull request was closed or merged.
+	Closed bool
+
+	// CommentURL is the API URL to post comments on the pull request to.
+	CommentURL string
+}
+
+// ServeWebhook implements server.WebhookHandler.
+//
+// A push to a Git repository queues a "waypoint up" for every project
+// with webhooks enabled whose Git data source is the repository and
+// branch that was pushed. Pull requests into that branch update the
+// preview environments of projects with previews enabled. The webhook
+// must be signed with the secret of the project.
+func (s *service) ServeWebhook(w http.ResponseWriter, r *http.Request) {
+	log := hclog.FromContext(r.Context()).Named("webhook")
+
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := strings.Trim(strings.TrimPrefix(r.URL.Path, server.WebhookPath), "/")
+	provider, ok := webhookProviders[name]
+	if !ok {
+		http.Error(w, "unknown webhook provider", http.StatusNotFound)
+		return
+	}
+	log = log.With("provider", name)
+
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, webhookMaxBodySize))
+	if err != nil {
+		http.Error(w, "error reading request body", http.StatusBadRequest)
+		return
+	}
+
+	push, err := provider.Parse(r, body)
+	if err == nil && push != nil {
+		s.webhookPush(w, r, log, provider, body, push)
+		return
+	}
+
+	var pr *webhookPullRequest
+	if err == nil {
+		pr, err = provider.ParsePullRequest(r, body)
+	}
+	if err != nil {
+		log.Debug("invalid webhook", "err", err)
+		http.Error(w, fmt.Sprintf("invalid webhook: %s", err), http.StatusBadRequest)
+		return
+	}
+	if pr != nil {
+		s.webhookPullRequest(w, r, log, provider, body, pr)
+		return
+	}
+
+	fmt.Fprintln(w, "ignored: not a push of a branch or a pull request")
+}
+
+// webhookPush queues a "waypoint up" for the projects of a push.
+func (s *service) webhookPush(
+	w http.ResponseWriter,
+	r *http.Request,
+	log hclog.Logger,
+	provider webhookProvider,
+	body []byte,
+	push *webhookPush,
+) {
+	log = log.With("branch", push.Branch, "commit", push.Commit)
+
+	projects, ok := s.webhookProjects(w, r, log, provider, body, push, false)
+	if !ok {
+		return
+	}
+
+	var reqs []*pb.QueueJobRequest
+	for _, project := range projects {
+		reqs = append(reqs, webhookJob(project, push.Commit,
+			fmt.Sprintf("webhook/%s", project.Name),
+			&pb.Job{
+				Workspace: &pb.Ref_Workspace{Workspace: "default"},
+				Operation: &pb.Job_Up{
+					Up: &pb.Job_UpOp{},
+				},
+			},
+		))
+	}
+
+	resps, err := s.queueJobMulti(r.Context(), reqs)
+	if err != nil {
+		log.Error("error queueing jobs", "err", err)
+		http.Error(w, "error queueing jobs", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	for i, resp := range resps {
+		project := reqs[i].Job.Application.Project
+		log.Info("queued job for push", "project", project, "job_id", resp.JobId)
+		fmt.Fprintf(w, "queued job %s for project %s\n", resp.JobId, project)
+	}
+}
+
+// webhookPullRequest updates the previews of the projects of a pull request.
+func (s *service) webhookPullRequest(
+	w http.ResponseWriter,
+	r *http.Request,
+	log hclog.Logger,
+	provider webhookProvider,
+	body []byte,
+	pr *webhookPullRequest,
+) {
+	log = log.With("number", pr.Number, "commit", pr.Target.Commit, "closed", pr.Closed)
+
+	projects, ok := s.webhookProjects(w, r, log, provider, body, &pr.Target, true)
+	if !ok {
+		return
+	}
+
+	var previews []*pb.Preview
+	for _, project := range projects {
+		preview, err := s.previewQueue(r.Context(), log, project, pr)
+		if err != nil {
+			log.Error("error updating preview", "project", project.Name, "err", err)
+			http.Error(w, "error updating preview", http.StatusInternalServerError)
+			return
+		}
+		if preview != nil {
+			previews = append(previews, preview)
+		}
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	for _, preview := range previews {
+		log.Info("queued job for preview",
+			"project", preview.Project.Project, "job_id", preview.JobId)
+		fmt.Fprintf(w, "queued job %s for the preview of project %s\n",
+			preview.JobId, preview.Project.Project)
+	}
+}
+
+// webhookProjects returns the projects that the webhook applies to. These
+// are the projects with webhooks enabled whose Git data source is the
+// repository and branch of push, and that have previews enabled if
+// previews is true. Projects whose secret doesn't verify the webhook are
+// excluded.
+//
+// If this returns false, there are no projects and the response was
+// already written.
+func (s *service) webhookProjects(
+	w http.ResponseWriter,
+	r *http.Request,
+	log hclog.Logger,
+	provider webhookProvider,
+	body []byte,
+	push *webhookPush,
+	previews bool,
+) ([]*pb.Project, bool) {
+	refs, err := s.state.ProjectList()
+	if err != nil {
+		log.Error("error listing projects", "err", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return nil, false
+	}
+
+	var matched bool
+	var result []*pb.Project
+	for _, ref := range refs {
+		project, err := s.state.ProjectGet(ref)
+		if err != nil {
+			log.Error("error getting project", "project", ref.Project, "err", err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return nil, false
+		}
+		if !webhookMatch(project, push) {
+			continue
+		}
+		if previews && !project.Webhook.Previews {
+			continue
+		}
+
+		matched = true
+		if !provider.Verify(r, body, project.Webhook.Secret) {
+			log.Warn("webhook signature doesn't match the project secret",
+				"project", project.Name)
+			continue
+		}
+
+		result = append(result, project)
+	}
+
+	switch {
+	case len(result) > 0:
+		return result, true
+
+	case matched:
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+
+	default:
+		log.Debug("no projects for the branch", "urls", push.URLs)
+		fmt.Fprintln(w, "ignored: no projects use the branch")
+	}
+
+	return nil, false
+}
+
+// webhookMatch returns true if the project accepts webhooks and its Git
+// data source is the repository and branch of the push. A data source
+// without a ref uses the default branch of the repository.
+func webhookMatch(project *pb.Project, push *webhookPush) bool {
+	if project.Webhook == nil || !project.Webhook.Enabled {
+		return false
+	}
+
+	source, ok := project.DataSource.GetSource().(*pb.Job_DataSource_Git)
+	if !ok {
+		return false
+	}
+
+	branch := strings.TrimPrefix(source.Git.Ref, "refs/heads/")
+	if branch == "" {
+		branch = push.DefaultBranch
+	}
+	if branch == "" || branch != push.Branch {
+		return false
+	}
+
+	want := webhookNormalizeURL(source.Git.Url)
+	for _, u := range push.URLs {
+		if webhookNormalizeURL(u) == want {
+			return true
+		}
+	}
+
+	return false
+}
+
+// webhookNormalizeURL returns the host and path of a Git URL so that
+// URLs for the same repository compare equal regardless of the protocol,
+// credentials, or ".git" suffix. Both URLs and SCP-like SSH addresses
+// such as "git@github.com:org/repo.git" are supported.
+func webhookNormalizeURL(v string) string {
+	v = strings.TrimSpace(v)
+
+	var host, path string
+	if u, err := url.Parse(v); err == nil && u.Host != "" {
+		host, path = u.Hostname(), u.Path
+	} else if idx := strings.Index(v, ":"); idx > 0 {
+		// SCP-like address, optionally with a user.
+		host, path = v[:idx], v[idx+1:]
+		if at := strings.LastIndex(host, "@"); at >= 0 {
+			host = host[at+1:]
+		}
+	} else {
+		path = v
+	}
+
+	path = strings.Trim(path, "/")
+	path = strings.TrimSuffix(path, ".git")
+	return strings.ToLower(host) + "/" + path
+}
+
+// webhookJob returns the job to queue the template job for every app of
+// the project at the given commit. The template must set the workspace and
+// operation. For pushes, this is the same job that polling queues when it
+// detects a change.
+func webhookJob(
+	project *pb.Project,
+	commit string,
+	singletonId string,
+	template *pb.Job,
+) *pb.QueueJobRequest {
+	template.TargetRunner = &pb.Ref_Runner{
+		Target: &pb.Ref_Runner_Any{
+			Any: &pb.Ref_RunnerAny{},
+		},
+	}
+	template.DataSource = project.DataSource
+	template.DataSourceOverrides = map[string]string{"ref": commit}
+
+	return &pb.QueueJobRequest{
+		Job: &pb.Job{
+			// SingletonId so that only the latest commit is queued if
+			// webhooks arrive faster than the runners.
+			SingletonId: strings.ToLower(singletonId),
+
+			Application: &pb.Ref_Application{
+				Project: project.Name,
+			},
+
+			TargetRunner:        template.TargetRunner,
+			Workspace:           template.Workspace,
+			DataSource:          template.DataSource,
+			DataSourceOverrides: template.DataSourceOverrides,
+
+			Operation: &pb.Job_QueueProject{
+				QueueProject: &pb.Job_QueueProjectOp{
+					JobTemplate: template,
+				},
+			},
+		},
+	}
+}
+
+// webhookVerifySHA256 verifies a "sha256=HEX" signature of the body.
+func webhookVerifySHA256(sig string, body []byte, secret string) bool {
+	if secret == "" || !strings.HasPrefix(sig, "sha256=") {
+		return false
+	}
+
+	actual, err := hex.DecodeString(strings.TrimPrefix(sig, "sha256="))
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(actual, mac.Sum(nil))
+}
+
+// webhookBranch returns the branch of a Git ref such as "refs/heads/main".
+// This returns an empty string if the ref isn't a branch.
+func webhookBranch(ref string) string {
+	if !strings.HasPrefix(ref, "refs/heads/") {
+		return ""
+	}
+
+	return strings.TrimPrefix(ref, "refs/heads/")
+}
+
+// webhookGitHub handles GitHub webhooks.
+type webhookGitHub struct{}
+
+func (webhookGitHub) Parse(r *http.Request, body []byte) (*webhookPush, error) {
+	if r.Header.Get("X-GitHub-Event") != "push" {
+		return nil, nil
+	}
+
+	var payload struct {
+		Ref     string `json:"ref"`
+		After   string `json:"after"`
+		Deleted bool   `json:"deleted"`
+
+		Repository struct {
+			CloneURL      string `json:"clone_url"`
+			SSHURL        string `json:"ssh_url"`
+			HTMLURL       string `json:"html_url"`
+			DefaultBranch string `json:"default_branch"`
+		} `json:"repository"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+
+	branch := webhookBranch(payload.Ref)
+	if branch == "" || payload.Deleted {
+		return nil, nil
+	}
+
+	return &webhookPush{
+		URLs: []string{
+			payload.Repository.CloneURL,
+			payload.Repository.SSHURL,
+			payload.Repository.HTMLURL,
+		},
+		Branch:        branch,
+		Commit:        payload.After,
+		DefaultBranch: payload.Repository.DefaultBranch,
+	}, nil
+}
+
+func (webhookGitHub) ParsePullRequest(r *http.Request, body []byte) (*webhookPullRequest, error) {
+	if r.Header.Get("X-GitHub-Event") != "pull_request" {
+		return nil, nil
+	}
+
+	var payload struct {
+		Action string `json:"action"`
+		Number uint64 `json:"number"`
+
+		PullRequest struct {
+			CommentsURL string `json:"comments_url"`
+			Head        struct {
+				SHA string `json:"sha"`
+			} `json:"head"`
+			Base struct {
+				Ref string `json:"ref"`
+			} `json:"base"`
+		} `json:"pull_request"`
+
+		Repository struct {
+			CloneURL      string `json:"clone_url"`
+			SSHURL        string `json:"ssh_url"`
+			HTMLURL       string `json:"html_url"`
+			DefaultBranch string `json:"default_branch"`
+		} `json:"repository"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+
+	var closed bool
+	switch payload.Action {
+	case "opened", "reopened", "synchronize":
+	case "closed":
+		closed = true
+	default:
+		return nil, nil
+	}
+
+	return &webhookPullRequest{
+		Target: webhookPush{
+			URLs: []string{
+				payload.Repository.CloneURL,
+				payload.Repository.SSHURL,
+				payload.Repository.HTMLURL,
+			},
+			Branch:        payload.PullRequest.Base.Ref,
+			Commit:        payload.PullRequest.Head.SHA,
+			DefaultBranch: payload.Repository.DefaultBranch,
+		},
+		Number:     payload.Number,
+		Closed:     closed,
+		CommentURL: payload.PullRequest.CommentsURL,
+	}, nil
+}
+
+func (webhookGitHub) Verify(r *http.Request, body []byte, secret string) bool {
+	return webhookVerifySHA256(r.Header.Get("X-Hub-Signature-256"), body, secret)
+}
+
+// webhookGitLab handles GitLab webhooks.
+type webhookGitLab struct{}
+
+func (webhookGitLab) Parse(r *http.Request, body []byte) (*webhookPush, error) {
+	if r.Header.Get("X-Gitlab-Event") != "Push Hook" {
+		return nil, nil
+	}
+
+	var payload struct {
+		Ref         string `json:"ref"`
+		CheckoutSHA string `json:"checkout_sha"`
+
+		Project struct {
+			GitHTTPURL    string `json:"git_http_url"`
+			GitSSHURL     string `json:"git_ssh_url"`
+			WebURL        string `json:"web_url"`
+			DefaultBranch string `json:"default_branch"`
+		} `json:"project"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+
+	// The checkout SHA is null when the branch is deleted.
+	branch := webhookBranch(payload.Ref)
+	if branch == "" || payload.CheckoutSHA == "" {
+		return nil, nil
+	}
+
+	return &webhookPush{
+		URLs: []string{
+			payload.Project.GitHTTPURL,
+			payload.Project.GitSSHURL,
+			payload.Project.WebURL,
+		},
+		Branch:        branch,
+		Commit:        payload.CheckoutSHA,
+		DefaultBranch: payload.Project.DefaultBranch,
+	}, nil
+}
+
+func (webhookGitLab) ParsePullRequest(r *http.Request, body []byte) (*webhookPullRequest, error) {
+	if r.Header.Get("X-Gitlab-Event") != "Merge Request Hook" {
+		return nil, nil
+	}
+
+	var payload struct {
+		ObjectAttributes struct {
+			IID          uint64 `json:"iid"`
+			Action       string `json:"action"`
+			TargetBranch string `json:"target_branch"`
+			OldRev       string `json:"oldrev"`
+			LastCommit   struct {
+				ID string `json:"id"`
+			} `json:"last_commit"`
+		} `json:"object_attributes"`
+
+		Project struct {
+			ID            uint64 `json:"id"`
+			GitHTTPURL    string `json:"git_http_url"`
+			GitSSHURL     string `json:"git_ssh_url"`
+			WebURL        string `json:"web_url"`
+			DefaultBranch string `json:"default_branch"`
+		} `json:"project"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+
+	attrs := payload.ObjectAttributes
+	var closed bool
+	switch attrs.Action {
+	case "open", "reopen":
+	case "update":
+		// Updates without an old revision only change the description,
+		// labels, etc. and not the commits.
+		if attrs.OldRev == "" {
+			return nil, nil
+		}
+	case "close", "merge":
+		closed = true
+	default:
+		return nil, nil
+	}
+
+	// The notes API is on the same host as the project.
+	var commentURL string
+	if u, err := url.Parse(payload.Project.WebURL); err == nil && u.Host != "" {
+		commentURL = fmt.Sprintf("%s://%s/api/v4/projects/%d/merge_requests/%d/notes",
+			u.Scheme, u.Host, payload.Project.ID, attrs.IID)
+	}
+
+	return &webhookPullRequest{
+		Target: webhookPush{
+			URLs: []string{
+				payload.Project.GitHTTPURL,
+				payload.Project.GitSSHURL,
+				payload.Project.WebURL,
+			},
+			Branch:        attrs.TargetBranch,
+			Commit:        attrs.LastCommit.ID,
+			DefaultBranch: payload.Project.DefaultBranch,
+		},
+		Number:     attrs.IID,
+		Closed:     closed,
+		CommentURL: commentURL,
+	}, nil
+}
+
+func (webhookGitLab) Verify(r *http.Request, body []byte, secret string) bool {
+	// GitLab doesn't sign webhooks, it sends the secret token as is.
+	token := r.Header.Get("X-Gitlab-Token")
+	return secret != "" &&
+		subtle.ConstantTimeCompare([]byte(token), []byte(secret)) == 1
+}
+
+// webhookBitbucket handles Bitbucket Cloud webhooks.
+type webhookBitbucket struct{}
+
+func (webhookBitbucket) Parse(r *http.Request, body []byte) (*webhookPush, error) {
+	if r.Header.Get("X-Event-Key") != "repo:push" {
+		return nil, nil
+	}
+
+	var payload struct {
+		Push struct {
+			Changes []struct {
+				New *struct {
+					Type   string `json:"type"`
+					Name   string `json:"name"`
+					Target struct {
+						Hash string `json:"hash"`
+					} `json:"target"`
+				} `json:"new"`
+			} `json:"changes"`
+		} `json:"push"`
+
+		Repository struct {
+			FullName string `json:"full_name"`
+			Links    struct {
+				HTML struct {
+					Href string `json:"href"`
+				} `json:"html"`
+			} `json:"links"`
+		} `json:"repository"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+
+	// A push can update multiple branches. We use the last branch that
+	// was updated. A nil "new" means the branch was deleted.
+	var push *webhookPush
+	for _, c := range payload.Push.Changes {
+		if c.New == nil || c.New.Type != "branch" {
+			continue
+		}
+
+		push = &webhookPush{
+			Branch: c.New.Name,
+			Commit: c.New.Target.Hash,
+		}
+	}
+	if push == nil {
+		return nil, nil
+	}
+
+	// Bitbucket doesn't send the clone URLs or the default branch. The
+	// clone URLs are derived from the full name of the repository.
+	push.URLs = []string{payload.Repository.Links.HTML.Href}
+	if name := payload.Repository.FullName; name != "" {
+		push.URLs = append(push.URLs,
+			"https://bitbucket.org/"+name+".git",
+			"git@bitbucket.org:"+name+".git",
+		)
+	}
+
+	return push, nil
+}
+
+func (webhookBitbucket) ParsePullRequest(r *http.Request, body []byte) (*webhookPullRequest, error) {
+	// Previews aren't supported for Bitbucket yet.
+	return nil, nil
+}
+
+func (webhookBitbucket) Verify(r *http.Request, body []byte, secret string) bool {
+	return webhookVerifySHA256(r.Header.Get("X-Hub-Signature"), body, secret)
+}
@@ -15,7 +15,9 @@ import (
 	"github.com/hashicorp/waypoint/internal/clicontext"
 	"github.com/hashicorp/waypoint/internal/env"
 	"github.com/hashicorp/waypoint/internal/protocolversion"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
 	"github.com/hashicorp/waypoint/internal/serverconfig"
+	"github.com/hashicorp/waypoint/internal/telemetry"
 )
 
 // ErrNoServerConfig is the error when there is no server configuration
@@ -66,6 +68,7 @@ func Connect(ctx context.Context, opts ...ConnectOption) (*grpc.ClientConn, erro
 				PermitWithoutStream: true,
 			}),
 	}
+	grpcOpts = append(grpcOpts, telemetry.ClientOptions()...)
 
 	if !cfg.Tls {
 		grpcOpts = append(grpcOpts, grpc.WithInsecure())
@@ -79,21 +82,39 @@ func Connect(ctx context.Context, opts ...ConnectOption) (*grpc.ClientConn, erro
 		))
 	}
 
+	var creds *refreshToken
 	if cfg.Auth {
 		token := cfg.Token
+		refreshed := cfg.TokenRefreshed
 		if v := os.Getenv(EnvServerToken); v != "" {
 			token = v
+
+			// A refreshed token can't be saved back to the environment.
+			refreshed = nil
 		}
 
 		if token == "" {
 			return nil, fmt.Errorf("No token available at the WAYPOINT_SERVER_TOKEN environment variable")
 		}
 
-		grpcOpts = append(grpcOpts, grpc.WithPerRPCCredentials(StaticToken(token)))
+		creds = &refreshToken{token: token, refreshed: refreshed}
+		grpcOpts = append(grpcOpts, grpc.WithPerRPCCredentials(creds))
 	}
 
 	// Connect to this server
-	return grpc.DialContext(ctx, cfg.Addr, grpcOpts...)
+	conn, err := grpc.DialContext(ctx, cfg.Addr, grpcOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Now that we're connected, the token can be refreshed using it.
+	if creds != nil {
+		creds.mu.Lock()
+		creds.client = pb.NewWaypointClient(conn)
+		creds.mu.Unlock()
+	}
+
+	return conn, nil
 }
 
 // ContextConfig will return the context configuration for the given connection
@@ -127,6 +148,10 @@ type connectConfig struct {
 	Token         string
 	Optional      bool // See Optional func
 	Timeout       time.Duration
+
+	// TokenRefreshed is called with the new token if the token is
+	// refreshed, so that it can be saved to where Token came from.
+	TokenRefreshed func(token string)
 }
 
 // FromEnv sources the connection information from the environment
@@ -165,6 +190,10 @@ func FromContextConfig(cfg *clicontext.Config) ConnectOption {
 				c.Auth = true
 				c.Token = cfg.Server.AuthToken
 			}
+
+			// This config replaces any previous source of the token, so
+			// refreshed tokens can't be saved there anymore.
+			c.TokenRefreshed = nil
 		}
 
 		return nil
@@ -205,7 +234,26 @@ func FromContext(st *clicontext.Storage, n string) ConnectOption {
 		}
 
 		opt := FromContextConfig(cfg)
-		return opt(c)
+		if err := opt(c); err != nil {
+			return err
+		}
+
+		// Save refreshed tokens to the context so that later commands use
+		// them. This is best effort: if it fails, the next command will
+		// refresh the token again.
+		if cfg.Server.Address != "" && cfg.Server.RequireAuth {
+			c.TokenRefreshed = func(token string) {
+				cfg, err := st.Load(n)
+				if err != nil {
+					return
+				}
+
+				cfg.Server.AuthToken = token
+				st.Set(n, cfg)
+			}
+		}
+
+		return nil
 	}
 }
 
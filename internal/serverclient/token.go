@@ -0,0 +1,107 @@
+package serverclient
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/mr-tron/base58"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// tokenMagic is the prefix of encoded tokens. This must match the server.
+const tokenMagic = "wp24"
+
+// refreshToken implements grpc.PerRPCCredentials. It sends the token with
+// every request like StaticToken, but when the token is close to expiring
+// it is exchanged for a new token with the RefreshToken RPC first. This
+// lets long-running commands and saved contexts keep working with tokens
+// that have a limited lifetime.
+type refreshToken struct {
+	mu    sync.Mutex
+	token string
+
+	// client is used to refresh the token. This is set after the
+	// connection is established. If this is nil, tokens aren't refreshed.
+	client pb.WaypointClient
+
+	// refreshed is called with the new token after a refresh, if set.
+	refreshed func(token string)
+}
+
+// refreshKey is the context key used to mark the RefreshToken request
+// itself. The value is the token to send.
+type refreshKey struct{}
+
+func (t *refreshToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	// The refresh request is authenticated with the current token.
+	if token, ok := ctx.Value(refreshKey{}).(string); ok {
+		return StaticToken(token).GetRequestMetadata(ctx, uri...)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.client != nil && tokenNeedsRefresh(t.token, time.Now()) {
+		// If the refresh fails, we continue with the current token since
+		// it is still valid. The server will reject it once it expires.
+		resp, err := t.client.RefreshToken(
+			context.WithValue(ctx, refreshKey{}, t.token), &empty.Empty{})
+		if err == nil {
+			t.token = resp.Token
+			if t.refreshed != nil {
+				t.refreshed(t.token)
+			}
+		}
+	}
+
+	return StaticToken(t.token).GetRequestMetadata(ctx, uri...)
+}
+
+func (t *refreshToken) RequireTransportSecurity() bool {
+	return false
+}
+
+// tokenNeedsRefresh returns true if the token expires and is in the last
+// quarter of its lifetime at the given time. Tokens that can't be decoded
+// or that have already expired are never refreshed.
+func tokenNeedsRefresh(token string, now time.Time) bool {
+	data, err := base58.Decode(token)
+	if err != nil || !bytes.HasPrefix(data, []byte(tokenMagic)) {
+		return false
+	}
+
+	// We only read the token here, so we don't need to verify the
+	// signature. The server does that when we use the token.
+	var tt pb.TokenTransport
+	if err := proto.Unmarshal(data[len(tokenMagic):], &tt); err != nil {
+		return false
+	}
+
+	var body pb.Token
+	if err := proto.Unmarshal(tt.Body, &body); err != nil {
+		return false
+	}
+
+	if body.ValidUntil == nil || body.IssuedTime == nil {
+		return false
+	}
+
+	issued, err := ptypes.Timestamp(body.IssuedTime)
+	if err != nil {
+		return false
+	}
+
+	validUntil, err := ptypes.Timestamp(body.ValidUntil)
+	if err != nil {
+		return false
+	}
+
+	remaining := validUntil.Sub(now)
+	return remaining > 0 && remaining <= validUntil.Sub(issued)/4
+}
@@ -0,0 +1,80 @@
+package serverclient
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestTokenNeedsRefresh(t *testing.T) {
+	now := time.Now()
+
+	// encode returns an encoded token issued and valid until the given
+	// offsets from now. A zero validUntil means the token doesn't expire.
+	encode := func(t *testing.T, issued, validUntil time.Duration) string {
+		var body pb.Token
+		var err error
+		body.IssuedTime, err = ptypes.TimestampProto(now.Add(issued))
+		require.NoError(t, err)
+		if validUntil != 0 {
+			body.ValidUntil, err = ptypes.TimestampProto(now.Add(validUntil))
+			require.NoError(t, err)
+		}
+
+		bodyData, err := proto.Marshal(&body)
+		require.NoError(t, err)
+
+		data, err := proto.Marshal(&pb.TokenTransport{Body: bodyData})
+		require.NoError(t, err)
+
+		return base58.Encode(append([]byte(tokenMagic), data...))
+	}
+
+	cases := []struct {
+		Name     string
+		Token    func(t *testing.T) string
+		Expected bool
+	}{
+		{
+			"fresh token",
+			func(t *testing.T) string { return encode(t, -1*time.Hour, 3*time.Hour) },
+			false,
+		},
+
+		{
+			"near expiry",
+			func(t *testing.T) string { return encode(t, -3*time.Hour, 30*time.Minute) },
+			true,
+		},
+
+		{
+			"expired",
+			func(t *testing.T) string { return encode(t, -3*time.Hour, -1*time.Minute) },
+			false,
+		},
+
+		{
+			"doesn't expire",
+			func(t *testing.T) string { return encode(t, -3*time.Hour, 0) },
+			false,
+		},
+
+		{
+			"not a token",
+			func(t *testing.T) string { return "hello" },
+			false,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require.Equal(t, tt.Expected, tokenNeedsRefresh(tt.Token(t), now))
+		})
+	}
+}
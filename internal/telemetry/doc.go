@@ -0,0 +1,9 @@
+// Package telemetry configures the OpenTelemetry tracing of the CLI, the
+// server, and runners.
+//
+// The trace context of a CLI command is passed to the server with every
+// API call. When a job is queued, the server stores the trace context on
+// the job so that the runner that executes the job continues the trace,
+// including the calls to plugins. Each process exports its own spans to
+// the OTLP endpoint it's configured with.
+package telemetry
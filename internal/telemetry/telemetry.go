@@ -0,0 +1,162 @@
+package telemetry
+
+import (
+	"context"
+	"os"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpgrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+
+	"github.com/hashicorp/waypoint/internal/env"
+	"github.com/hashicorp/waypoint/internal/version"
+)
+
+const (
+	// EnvOTLPEndpoint is the env var to set with the OTLP endpoint that
+	// the CLI exports spans to, such as "localhost:4317".
+	EnvOTLPEndpoint = "WAYPOINT_OTLP_ENDPOINT"
+
+	// EnvOTLPInsecure is the env var that can be set to connect to the
+	// OTLP endpoint without TLS.
+	EnvOTLPInsecure = "WAYPOINT_OTLP_INSECURE"
+
+	// tracerName is the name of the tracer of Waypoint spans.
+	tracerName = "github.com/hashicorp/waypoint"
+)
+
+// Config configures the export of spans.
+type Config struct {
+	// ServiceName is the name of the process in the exported spans, such
+	// as "waypoint-server".
+	ServiceName string
+
+	// Endpoint is the address of the OTLP gRPC endpoint to export spans
+	// to. If this is empty, spans aren't exported.
+	Endpoint string
+
+	// Insecure connects to the endpoint without TLS.
+	Insecure bool
+}
+
+// ConfigFromEnv returns the configuration from the EnvOTLPEndpoint and
+// EnvOTLPInsecure env vars.
+func ConfigFromEnv(name string) (Config, error) {
+	insecure, err := env.GetBool(EnvOTLPInsecure, false)
+	if err != nil {
+		return Config{}, err
+	}
+
+	return Config{
+		ServiceName: name,
+		Endpoint:    os.Getenv(EnvOTLPEndpoint),
+		Insecure:    insecure,
+	}, nil
+}
+
+// Init configures the global tracer provider to export spans as configured.
+// The returned function flushes the remaining spans and must be called
+// before the process exits.
+//
+// Spans are recorded even if they aren't exported so that the trace
+// context of incoming calls and jobs is passed on to the calls this
+// process makes.
+func Init(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.ServiceNameKey.String(cfg.ServiceName),
+			semconv.ServiceVersionKey.String(version.GetVersion().VersionNumber()),
+		)),
+	}
+
+	if cfg.Endpoint != "" {
+		driverOpts := []otlpgrpc.Option{otlpgrpc.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			driverOpts = append(driverOpts, otlpgrpc.WithInsecure())
+		}
+
+		exporter, err := otlp.NewExporter(ctx, otlpgrpc.NewDriver(driverOpts...))
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+
+	tp := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	return tp.Shutdown, nil
+}
+
+// Tracer returns the tracer for Waypoint spans.
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// ClientOptions returns the gRPC dial options that pass the trace context
+// with every call and record a span for it.
+func ClientOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(otelgrpc.StreamClientInterceptor()),
+	}
+}
+
+// ServerOptions returns the gRPC server options that continue the trace
+// context of incoming calls and record a span for every call.
+func ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(otelgrpc.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(otelgrpc.StreamServerInterceptor()),
+	}
+}
+
+// Inject returns the trace context of ctx in a form that can be stored,
+// such as on a job. This returns nil if ctx has no trace context.
+func Inject(ctx context.Context) map[string]string {
+	carrier := mapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return nil
+	}
+
+	return carrier
+}
+
+// Extract returns a copy of ctx with the trace context that was stored
+// with Inject, so that spans started with the returned context continue
+// the trace.
+func Extract(ctx context.Context, v map[string]string) context.Context {
+	if len(v) == 0 {
+		return ctx
+	}
+
+	return otel.GetTextMapPropagator().Extract(ctx, mapCarrier(v))
+}
+
+// mapCarrier implements propagation.TextMapCarrier for a map.
+type mapCarrier map[string]string
+
+func (c mapCarrier) Get(key string) string      { return c[key] }
+func (c mapCarrier) Set(key string, val string) { c[key] = val }
+
+func (c mapCarrier) Keys() []string {
+	result := make([]string, 0, len(c))
+	for k := range c {
+		result = append(result, k)
+	}
+
+	return result
+}
@@ -0,0 +1,32 @@
+package telemetry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInjectExtract(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.Background()
+	shutdown, err := Init(ctx, Config{ServiceName: "test"})
+	require.NoError(err)
+	defer shutdown(ctx)
+
+	// No trace context
+	require.Nil(Inject(ctx))
+
+	parentCtx, parent := Tracer().Start(ctx, "parent")
+	defer parent.End()
+
+	v := Inject(parentCtx)
+	require.NotEmpty(v)
+
+	// Spans started from the extracted context are in the same trace
+	_, child := Tracer().Start(Extract(ctx, v), "child")
+	defer child.End()
+	require.Equal(parent.SpanContext().TraceID(), child.SpanContext().TraceID())
+	require.NotEqual(parent.SpanContext().SpanID(), child.SpanContext().SpanID())
+}
@@ -1,7 +1,7 @@
 import Route from '@ember/routing/route';
 import { inject as service } from '@ember/service';
 import ApiService from 'waypoint/services/api';
-import { Empty } from 'google-protobuf/google/protobuf/empty_pb';
+import { ListProjectsRequest } from 'waypoint-pb';
 import PollModelService from 'waypoint/services/poll-model';
 import ProjectsIndex from 'waypoint/controllers/workspace/projects';
 
@@ -10,7 +10,7 @@ export default class Index extends Route {
   @service pollModel!: PollModelService;
 
   async model() {
-    let resp = await this.api.client.listProjects(new Empty(), this.api.WithMeta());
+    let resp = await this.api.client.listProjects(new ListProjectsRequest(), this.api.WithMeta());
     let projects = resp.getProjectsList().map((p) => p.toObject());
 
     return projects;